@@ -2,16 +2,20 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/internal/config"
 	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
 )
 
 func main() {
@@ -35,11 +39,14 @@ func main() {
 	dsn := cfg.Postgres.GetDSN()
 	logger.Infow("Connecting to database", "host", cfg.Postgres.Host)
 
-	// Create Ent client
-	client, err := ent.Open("postgres", dsn)
+	// Open PostgreSQL connection, shared with the ent client for migrations it can't express
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		logger.Fatalw("Failed to connect to postgres", "error", err)
 	}
+
+	// Create Ent client
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	//nolint:errcheck
 	defer client.Close()
 
@@ -63,6 +70,10 @@ func main() {
 		if err != nil {
 			logger.Fatalw("Failed to create schema resources", "error", err)
 		}
+
+		if err := postgres.MigrateSearch(ctx, db); err != nil {
+			logger.Fatalw("Failed to create search resources", "error", err)
+		}
 		logger.Info("Migration completed successfully")
 	}
 
@@ -9,13 +9,19 @@ import (
 	"github.com/omkar273/codegeeky/internal/api"
 	v1 "github.com/omkar273/codegeeky/internal/api/v1"
 	"github.com/omkar273/codegeeky/internal/auth"
+	"github.com/omkar273/codegeeky/internal/auth/abac"
+	"github.com/omkar273/codegeeky/internal/certificate"
 	"github.com/omkar273/codegeeky/internal/config"
+	"github.com/omkar273/codegeeky/internal/fileupload"
 	"github.com/omkar273/codegeeky/internal/httpclient"
 	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/notification"
+	notificationHandler "github.com/omkar273/codegeeky/internal/notification/handler"
 	gateway "github.com/omkar273/codegeeky/internal/payment"
 	"github.com/omkar273/codegeeky/internal/postgres"
 	pubsubRouter "github.com/omkar273/codegeeky/internal/pubsub/router"
 	"github.com/omkar273/codegeeky/internal/repository"
+	"github.com/omkar273/codegeeky/internal/scheduler"
 	"github.com/omkar273/codegeeky/internal/security"
 	"github.com/omkar273/codegeeky/internal/service"
 	"github.com/omkar273/codegeeky/internal/validator"
@@ -68,6 +74,9 @@ func main() {
 			// auth provider
 			auth.NewSupabaseProvider,
 
+			// authorization service
+			auth.NewUnifiedAuthorizationService,
+
 			// http client
 			httpclient.NewDefaultClient,
 
@@ -94,6 +103,61 @@ func main() {
 
 			// internship batch repository
 			repository.NewInternshipBatchRepository,
+			repository.NewInternshipRevisionRepository,
+			repository.NewInternshipInstructorRepository,
+
+			// referral repository
+			repository.NewReferralRepository,
+
+			// wallet repository
+			repository.NewWalletRepository,
+
+			// payment plan repository
+			repository.NewPaymentPlanRepository,
+
+			// subscription repositories
+			repository.NewSubscriptionPlanRepository,
+			repository.NewSubscriptionRepository,
+
+			// course content repositories
+			repository.NewModuleRepository,
+			repository.NewLessonRepository,
+			repository.NewAssignmentRepository,
+			repository.NewResourceRepository,
+			repository.NewLessonProgressRepository,
+			repository.NewSubmissionRepository,
+			repository.NewQuizRepository,
+			repository.NewQuizAttemptRepository,
+			repository.NewFileUploadRepository,
+
+			// certificate repository
+			repository.NewCertificateRepository,
+
+			// live session repositories
+			repository.NewLiveSessionRepository,
+			repository.NewSessionAttendanceRepository,
+
+			// internship application repository
+			repository.NewInternshipApplicationRepository,
+			repository.NewInternshipReviewRepository,
+			repository.NewWishlistRepository,
+
+			// notification repository
+			repository.NewNotificationRepository,
+
+			// file storage
+			fileupload.NewCloudinaryProvider,
+
+			// certificate documents
+			certificate.NewPDFRenderer,
+
+			// notifications
+			notification.NewProvider,
+			notification.NewTemplates,
+			notification.NewStream,
+
+			// background job scheduler
+			scheduler.NewScheduler,
 
 			// pubsub router
 			pubsubRouter.NewRouter,
@@ -108,16 +172,40 @@ func main() {
 
 		// all services
 		security.NewEncryptionService,
+		security.NewSigningService,
 		service.NewAuthService,
 		service.NewUserService,
 		service.NewOnboardingService,
 		service.NewInternshipService,
 		service.NewInternshipBatchService,
+		service.NewInternshipInstructorService,
+		service.NewContentService,
+		service.NewAssignmentService,
 		service.NewCategoryService,
 		service.NewDiscountService,
 		service.NewPricingService,
 		service.NewPaymentService,
 		service.NewInternshipEnrollmentService,
+		service.NewReferralService,
+		service.NewWalletService,
+		service.NewPaymentPlanService,
+		service.NewSubscriptionService,
+		service.NewProgressService,
+		service.NewSubmissionService,
+		service.NewQuizService,
+		service.NewLiveSessionService,
+		service.NewInternshipApplicationService,
+		service.NewCertificateService,
+		service.NewRecommendationService,
+		service.NewInternshipReviewService,
+		service.NewWishlistService,
+		service.NewNotificationService,
+
+		// email notifications of domain events
+		notificationHandler.NewHandler,
+
+		// abac attribute providers
+		service.NewEnrollmentAttributeProvider,
 	))
 
 	// factory layer
@@ -131,6 +219,9 @@ func main() {
 
 	// start the application
 	opts = append(opts, fx.Invoke(
+		// load abac attributes from the database
+		registerAttributeProviders,
+
 		// start server
 		startServer,
 	))
@@ -147,32 +238,89 @@ func startServer(
 	log *logger.Logger,
 	router *pubsubRouter.Router,
 	webhookService *webhook.WebhookService,
+	notifier notificationHandler.Handler,
+	jobScheduler *scheduler.Scheduler,
+	referralService service.ReferralService,
+	walletService service.WalletService,
+	paymentPlanService service.PaymentPlanService,
+	internshipBatchService service.InternshipBatchService,
+	internshipEnrollmentService service.InternshipEnrollmentService,
 ) {
 	// start api server
 	startAPIServer(lc, r, cfg, log)
 
 	// start message router
-	startMessageRouter(lc, router, webhookService, log)
+	startMessageRouter(lc, router, webhookService, notifier, log)
+
+	// start background jobs
+	startScheduler(lc, jobScheduler, cfg, referralService, walletService, paymentPlanService, internshipBatchService, internshipEnrollmentService, log)
 }
 
 func provideHandlers(
+	cfg *config.Configuration,
 	logger *logger.Logger,
 	authService service.AuthService,
 	userService service.UserService,
 	internshipService service.InternshipService,
 	categoryService service.CategoryService,
 	discountService service.DiscountService,
+	referralService service.ReferralService,
+	walletService service.WalletService,
+	paymentService service.PaymentService,
+	paymentPlanService service.PaymentPlanService,
+	subscriptionService service.SubscriptionService,
+	internshipBatchService service.InternshipBatchService,
+	internshipInstructorService service.InternshipInstructorService,
+	contentService service.ContentService,
+	assignmentService service.AssignmentService,
+	progressService service.ProgressService,
+	submissionService service.SubmissionService,
+	quizService service.QuizService,
+	liveSessionService service.LiveSessionService,
+	applicationService service.InternshipApplicationService,
+	certificateService service.CertificateService,
+	recommendationService service.RecommendationService,
+	reviewService service.InternshipReviewService,
+	wishlistService service.WishlistService,
+	notificationService service.NotificationService,
 ) *api.Handlers {
 	return &api.Handlers{
-		Health:     v1.NewHealthHandler(logger),
-		Auth:       v1.NewAuthHandler(authService),
-		User:       v1.NewUserHandler(userService),
-		Internship: v1.NewInternshipHandler(internshipService, logger),
-		Category:   v1.NewCategoryHandler(categoryService, logger),
-		Discount:   v1.NewDiscountHandler(discountService, logger),
+		Health:       v1.NewHealthHandler(logger),
+		Auth:         v1.NewAuthHandler(authService),
+		User:         v1.NewUserHandler(userService),
+		Internship:   v1.NewInternshipHandler(internshipService, logger),
+		Category:     v1.NewCategoryHandler(categoryService, logger),
+		Discount:     v1.NewDiscountHandler(discountService, logger),
+		Referral:     v1.NewReferralHandler(referralService, logger),
+		Wallet:       v1.NewWalletHandler(walletService, logger),
+		Payment:      v1.NewPaymentHandler(paymentService, logger),
+		PaymentPlan:  v1.NewPaymentPlanHandler(paymentPlanService, logger),
+		Subscription: v1.NewSubscriptionHandler(subscriptionService, logger),
+		Batch:        v1.NewInternshipBatchHandler(internshipBatchService, logger),
+		Instructor:   v1.NewInternshipInstructorHandler(internshipInstructorService, logger),
+		Content:      v1.NewContentHandler(contentService, logger),
+		Assignment:   v1.NewAssignmentHandler(assignmentService, logger),
+		Progress:     v1.NewProgressHandler(progressService, logger),
+		Submission:   v1.NewSubmissionHandler(submissionService, logger),
+		Quiz:         v1.NewQuizHandler(quizService, logger),
+		LiveSession:  v1.NewLiveSessionHandler(liveSessionService, logger),
+		Application:  v1.NewInternshipApplicationHandler(applicationService, logger),
+		Certificate:  v1.NewCertificateHandler(certificateService, logger),
+		Recommender:  v1.NewRecommendationHandler(recommendationService, logger),
+		Review:       v1.NewInternshipReviewHandler(reviewService, logger),
+		Wishlist:     v1.NewWishlistHandler(wishlistService, logger),
+		Notification: v1.NewNotificationHandler(notificationService, cfg, logger),
 	}
 }
 
+func registerAttributeProviders(authzService auth.UnifiedAuthorizationService, provider abac.AttributeProvider, log *logger.Logger) error {
+	if err := authzService.RegisterAttributeProvider(provider); err != nil {
+		log.Errorw("failed to register attribute provider", "provider", provider.GetName(), "error", err)
+		return err
+	}
+	return nil
+}
+
 func provideRouter(handlers *api.Handlers, cfg *config.Configuration, logger *logger.Logger) *gin.Engine {
 	return api.NewRouter(handlers, cfg, logger)
 }
@@ -207,10 +355,14 @@ func startMessageRouter(
 	lc fx.Lifecycle,
 	router *pubsubRouter.Router,
 	webhookService *webhook.WebhookService,
+	notifier notificationHandler.Handler,
 	logger *logger.Logger,
 ) {
 	// Register handlers before starting the router
 	webhookService.RegisterHandler(router)
+	if err := notifier.RegisterHandler(router); err != nil {
+		logger.Errorw("failed to register notification handler", "error", err)
+	}
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -228,3 +380,105 @@ func startMessageRouter(
 		},
 	})
 }
+
+func startScheduler(
+	lc fx.Lifecycle,
+	jobScheduler *scheduler.Scheduler,
+	cfg *config.Configuration,
+	referralService service.ReferralService,
+	walletService service.WalletService,
+	paymentPlanService service.PaymentPlanService,
+	internshipBatchService service.InternshipBatchService,
+	internshipEnrollmentService service.InternshipEnrollmentService,
+	logger *logger.Logger,
+) {
+	if cfg.Referral.Enabled {
+		jobScheduler.Register(scheduler.Job{
+			Name:     "referral_rewards",
+			Interval: cfg.Referral.RewardCheckInterval,
+			Run: func(ctx context.Context) error {
+				rewarded, err := referralService.ProcessRewards(ctx)
+				if err != nil {
+					return err
+				}
+				if rewarded > 0 {
+					logger.Infow("rewarded referrals", "count", rewarded)
+				}
+				return nil
+			},
+		})
+	}
+
+	jobScheduler.Register(scheduler.Job{
+		Name:     "wallet_credit_expiry",
+		Interval: cfg.Wallet.ExpiryCheckInterval,
+		Run: func(ctx context.Context) error {
+			expired, err := walletService.ExpireCredits(ctx)
+			if err != nil {
+				return err
+			}
+			if expired > 0 {
+				logger.Infow("expired wallet credits", "count", expired)
+			}
+			return nil
+		},
+	})
+
+	jobScheduler.Register(scheduler.Job{
+		Name:     "installment_check",
+		Interval: cfg.PaymentPlan.InstallmentCheckInterval,
+		Run: func(ctx context.Context) error {
+			suspended, err := paymentPlanService.ProcessInstallments(ctx)
+			if err != nil {
+				return err
+			}
+			if suspended > 0 {
+				logger.Infow("suspended enrollments with overdue installments", "count", suspended)
+			}
+			return nil
+		},
+	})
+
+	jobScheduler.Register(scheduler.Job{
+		Name:     "batch_lifecycle",
+		Interval: cfg.Batch.LifecycleCheckInterval,
+		Run: func(ctx context.Context) error {
+			changed, err := internshipBatchService.ProcessLifecycle(ctx)
+			if err != nil {
+				return err
+			}
+			if changed > 0 {
+				logger.Infow("advanced internship batches", "count", changed)
+			}
+			return nil
+		},
+	})
+
+	jobScheduler.Register(scheduler.Job{
+		Name:     "checkout_expiry",
+		Interval: cfg.Enrollment.CheckoutCheckInterval,
+		Run: func(ctx context.Context) error {
+			expired, err := internshipEnrollmentService.ExpireCheckouts(ctx)
+			if err != nil {
+				return err
+			}
+			if expired > 0 {
+				logger.Infow("expired unpaid checkouts", "count", expired)
+			}
+			return nil
+		},
+	})
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("starting scheduler")
+			jobScheduler.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("stopping scheduler")
+			jobScheduler.Stop()
+			return nil
+		},
+	})
+}
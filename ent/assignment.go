@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/assignment"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Assignment is the model entity for the Assignment schema.
+type Assignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// ModuleID holds the value of the "module_id" field.
+	ModuleID string `json:"module_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Instructions holds the value of the "instructions" field.
+	Instructions string `json:"instructions,omitempty"`
+	// DueDays holds the value of the "due_days" field.
+	DueDays *int `json:"due_days,omitempty"`
+	// MaxScore holds the value of the "max_score" field.
+	MaxScore int `json:"max_score,omitempty"`
+	// PassingScore holds the value of the "passing_score" field.
+	PassingScore *int `json:"passing_score,omitempty"`
+	// Rubric holds the value of the "rubric" field.
+	Rubric []types.RubricCriterion `json:"rubric,omitempty"`
+	// LatePolicy holds the value of the "late_policy" field.
+	LatePolicy string `json:"late_policy,omitempty"`
+	// LatePenaltyPercent holds the value of the "late_penalty_percent" field.
+	LatePenaltyPercent float64 `json:"late_penalty_percent,omitempty"`
+	// MaxSubmissions holds the value of the "max_submissions" field.
+	MaxSubmissions *int `json:"max_submissions,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder    int `json:"sort_order,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Assignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case assignment.FieldRubric:
+			values[i] = new([]byte)
+		case assignment.FieldLatePenaltyPercent:
+			values[i] = new(sql.NullFloat64)
+		case assignment.FieldDueDays, assignment.FieldMaxScore, assignment.FieldPassingScore, assignment.FieldMaxSubmissions, assignment.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case assignment.FieldID, assignment.FieldStatus, assignment.FieldCreatedBy, assignment.FieldUpdatedBy, assignment.FieldInternshipID, assignment.FieldModuleID, assignment.FieldTitle, assignment.FieldInstructions, assignment.FieldLatePolicy:
+			values[i] = new(sql.NullString)
+		case assignment.FieldCreatedAt, assignment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Assignment fields.
+func (a *Assignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case assignment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				a.ID = value.String
+			}
+		case assignment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				a.Status = value.String
+			}
+		case assignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		case assignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				a.UpdatedAt = value.Time
+			}
+		case assignment.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				a.CreatedBy = value.String
+			}
+		case assignment.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				a.UpdatedBy = value.String
+			}
+		case assignment.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				a.InternshipID = value.String
+			}
+		case assignment.FieldModuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_id", values[i])
+			} else if value.Valid {
+				a.ModuleID = value.String
+			}
+		case assignment.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				a.Title = value.String
+			}
+		case assignment.FieldInstructions:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instructions", values[i])
+			} else if value.Valid {
+				a.Instructions = value.String
+			}
+		case assignment.FieldDueDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_days", values[i])
+			} else if value.Valid {
+				a.DueDays = new(int)
+				*a.DueDays = int(value.Int64)
+			}
+		case assignment.FieldMaxScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_score", values[i])
+			} else if value.Valid {
+				a.MaxScore = int(value.Int64)
+			}
+		case assignment.FieldPassingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field passing_score", values[i])
+			} else if value.Valid {
+				a.PassingScore = new(int)
+				*a.PassingScore = int(value.Int64)
+			}
+		case assignment.FieldRubric:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rubric", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Rubric); err != nil {
+					return fmt.Errorf("unmarshal field rubric: %w", err)
+				}
+			}
+		case assignment.FieldLatePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field late_policy", values[i])
+			} else if value.Valid {
+				a.LatePolicy = value.String
+			}
+		case assignment.FieldLatePenaltyPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_penalty_percent", values[i])
+			} else if value.Valid {
+				a.LatePenaltyPercent = value.Float64
+			}
+		case assignment.FieldMaxSubmissions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_submissions", values[i])
+			} else if value.Valid {
+				a.MaxSubmissions = new(int)
+				*a.MaxSubmissions = int(value.Int64)
+			}
+		case assignment.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				a.SortOrder = int(value.Int64)
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Assignment.
+// This includes values selected through modifiers, order, etc.
+func (a *Assignment) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Assignment.
+// Note that you need to call Assignment.Unwrap() before calling this method if this Assignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Assignment) Update() *AssignmentUpdateOne {
+	return NewAssignmentClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Assignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Assignment) Unwrap() *Assignment {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Assignment is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Assignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Assignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("status=")
+	builder.WriteString(a.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(a.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(a.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(a.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("module_id=")
+	builder.WriteString(a.ModuleID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(a.Title)
+	builder.WriteString(", ")
+	builder.WriteString("instructions=")
+	builder.WriteString(a.Instructions)
+	builder.WriteString(", ")
+	if v := a.DueDays; v != nil {
+		builder.WriteString("due_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_score=")
+	builder.WriteString(fmt.Sprintf("%v", a.MaxScore))
+	builder.WriteString(", ")
+	if v := a.PassingScore; v != nil {
+		builder.WriteString("passing_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rubric=")
+	builder.WriteString(fmt.Sprintf("%v", a.Rubric))
+	builder.WriteString(", ")
+	builder.WriteString("late_policy=")
+	builder.WriteString(a.LatePolicy)
+	builder.WriteString(", ")
+	builder.WriteString("late_penalty_percent=")
+	builder.WriteString(fmt.Sprintf("%v", a.LatePenaltyPercent))
+	builder.WriteString(", ")
+	if v := a.MaxSubmissions; v != nil {
+		builder.WriteString("max_submissions=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", a.SortOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Assignments is a parsable slice of Assignment.
+type Assignments []*Assignment
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the assignment type in the database.
+	Label = "assignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldInternshipID holds the string denoting the internship_id field in the database.
+	FieldInternshipID = "internship_id"
+	// FieldModuleID holds the string denoting the module_id field in the database.
+	FieldModuleID = "module_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldInstructions holds the string denoting the instructions field in the database.
+	FieldInstructions = "instructions"
+	// FieldDueDays holds the string denoting the due_days field in the database.
+	FieldDueDays = "due_days"
+	// FieldMaxScore holds the string denoting the max_score field in the database.
+	FieldMaxScore = "max_score"
+	// FieldPassingScore holds the string denoting the passing_score field in the database.
+	FieldPassingScore = "passing_score"
+	// FieldRubric holds the string denoting the rubric field in the database.
+	FieldRubric = "rubric"
+	// FieldLatePolicy holds the string denoting the late_policy field in the database.
+	FieldLatePolicy = "late_policy"
+	// FieldLatePenaltyPercent holds the string denoting the late_penalty_percent field in the database.
+	FieldLatePenaltyPercent = "late_penalty_percent"
+	// FieldMaxSubmissions holds the string denoting the max_submissions field in the database.
+	FieldMaxSubmissions = "max_submissions"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// Table holds the table name of the assignment in the database.
+	Table = "assignments"
+)
+
+// Columns holds all SQL columns for assignment fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldInternshipID,
+	FieldModuleID,
+	FieldTitle,
+	FieldInstructions,
+	FieldDueDays,
+	FieldMaxScore,
+	FieldPassingScore,
+	FieldRubric,
+	FieldLatePolicy,
+	FieldLatePenaltyPercent,
+	FieldMaxSubmissions,
+	FieldSortOrder,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	InternshipIDValidator func(string) error
+	// ModuleIDValidator is a validator for the "module_id" field. It is called by the builders before save.
+	ModuleIDValidator func(string) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DueDaysValidator is a validator for the "due_days" field. It is called by the builders before save.
+	DueDaysValidator func(int) error
+	// DefaultMaxScore holds the default value on creation for the "max_score" field.
+	DefaultMaxScore int
+	// MaxScoreValidator is a validator for the "max_score" field. It is called by the builders before save.
+	MaxScoreValidator func(int) error
+	// PassingScoreValidator is a validator for the "passing_score" field. It is called by the builders before save.
+	PassingScoreValidator func(int) error
+	// DefaultLatePolicy holds the default value on creation for the "late_policy" field.
+	DefaultLatePolicy string
+	// DefaultLatePenaltyPercent holds the default value on creation for the "late_penalty_percent" field.
+	DefaultLatePenaltyPercent float64
+	// LatePenaltyPercentValidator is a validator for the "late_penalty_percent" field. It is called by the builders before save.
+	LatePenaltyPercentValidator func(float64) error
+	// MaxSubmissionsValidator is a validator for the "max_submissions" field. It is called by the builders before save.
+	MaxSubmissionsValidator func(int) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Assignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByInternshipID orders the results by the internship_id field.
+func ByInternshipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipID, opts...).ToFunc()
+}
+
+// ByModuleID orders the results by the module_id field.
+func ByModuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByInstructions orders the results by the instructions field.
+func ByInstructions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstructions, opts...).ToFunc()
+}
+
+// ByDueDays orders the results by the due_days field.
+func ByDueDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDays, opts...).ToFunc()
+}
+
+// ByMaxScore orders the results by the max_score field.
+func ByMaxScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxScore, opts...).ToFunc()
+}
+
+// ByPassingScore orders the results by the passing_score field.
+func ByPassingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassingScore, opts...).ToFunc()
+}
+
+// ByLatePolicy orders the results by the late_policy field.
+func ByLatePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatePolicy, opts...).ToFunc()
+}
+
+// ByLatePenaltyPercent orders the results by the late_penalty_percent field.
+func ByLatePenaltyPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatePenaltyPercent, opts...).ToFunc()
+}
+
+// ByMaxSubmissions orders the results by the max_submissions field.
+func ByMaxSubmissions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSubmissions, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
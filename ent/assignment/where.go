@@ -0,0 +1,1070 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// ModuleID applies equality check predicate on the "module_id" field. It's identical to ModuleIDEQ.
+func ModuleID(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldModuleID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldTitle, v))
+}
+
+// Instructions applies equality check predicate on the "instructions" field. It's identical to InstructionsEQ.
+func Instructions(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldInstructions, v))
+}
+
+// DueDays applies equality check predicate on the "due_days" field. It's identical to DueDaysEQ.
+func DueDays(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldDueDays, v))
+}
+
+// MaxScore applies equality check predicate on the "max_score" field. It's identical to MaxScoreEQ.
+func MaxScore(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// PassingScore applies equality check predicate on the "passing_score" field. It's identical to PassingScoreEQ.
+func PassingScore(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldPassingScore, v))
+}
+
+// LatePolicy applies equality check predicate on the "late_policy" field. It's identical to LatePolicyEQ.
+func LatePolicy(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldLatePolicy, v))
+}
+
+// LatePenaltyPercent applies equality check predicate on the "late_penalty_percent" field. It's identical to LatePenaltyPercentEQ.
+func LatePenaltyPercent(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldLatePenaltyPercent, v))
+}
+
+// MaxSubmissions applies equality check predicate on the "max_submissions" field. It's identical to MaxSubmissionsEQ.
+func MaxSubmissions(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldMaxSubmissions, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// ModuleIDEQ applies the EQ predicate on the "module_id" field.
+func ModuleIDEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldModuleID, v))
+}
+
+// ModuleIDNEQ applies the NEQ predicate on the "module_id" field.
+func ModuleIDNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldModuleID, v))
+}
+
+// ModuleIDIn applies the In predicate on the "module_id" field.
+func ModuleIDIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldModuleID, vs...))
+}
+
+// ModuleIDNotIn applies the NotIn predicate on the "module_id" field.
+func ModuleIDNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldModuleID, vs...))
+}
+
+// ModuleIDGT applies the GT predicate on the "module_id" field.
+func ModuleIDGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldModuleID, v))
+}
+
+// ModuleIDGTE applies the GTE predicate on the "module_id" field.
+func ModuleIDGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldModuleID, v))
+}
+
+// ModuleIDLT applies the LT predicate on the "module_id" field.
+func ModuleIDLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldModuleID, v))
+}
+
+// ModuleIDLTE applies the LTE predicate on the "module_id" field.
+func ModuleIDLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldModuleID, v))
+}
+
+// ModuleIDContains applies the Contains predicate on the "module_id" field.
+func ModuleIDContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldModuleID, v))
+}
+
+// ModuleIDHasPrefix applies the HasPrefix predicate on the "module_id" field.
+func ModuleIDHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldModuleID, v))
+}
+
+// ModuleIDHasSuffix applies the HasSuffix predicate on the "module_id" field.
+func ModuleIDHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldModuleID, v))
+}
+
+// ModuleIDEqualFold applies the EqualFold predicate on the "module_id" field.
+func ModuleIDEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldModuleID, v))
+}
+
+// ModuleIDContainsFold applies the ContainsFold predicate on the "module_id" field.
+func ModuleIDContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldModuleID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// InstructionsEQ applies the EQ predicate on the "instructions" field.
+func InstructionsEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldInstructions, v))
+}
+
+// InstructionsNEQ applies the NEQ predicate on the "instructions" field.
+func InstructionsNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldInstructions, v))
+}
+
+// InstructionsIn applies the In predicate on the "instructions" field.
+func InstructionsIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldInstructions, vs...))
+}
+
+// InstructionsNotIn applies the NotIn predicate on the "instructions" field.
+func InstructionsNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldInstructions, vs...))
+}
+
+// InstructionsGT applies the GT predicate on the "instructions" field.
+func InstructionsGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldInstructions, v))
+}
+
+// InstructionsGTE applies the GTE predicate on the "instructions" field.
+func InstructionsGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldInstructions, v))
+}
+
+// InstructionsLT applies the LT predicate on the "instructions" field.
+func InstructionsLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldInstructions, v))
+}
+
+// InstructionsLTE applies the LTE predicate on the "instructions" field.
+func InstructionsLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldInstructions, v))
+}
+
+// InstructionsContains applies the Contains predicate on the "instructions" field.
+func InstructionsContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldInstructions, v))
+}
+
+// InstructionsHasPrefix applies the HasPrefix predicate on the "instructions" field.
+func InstructionsHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldInstructions, v))
+}
+
+// InstructionsHasSuffix applies the HasSuffix predicate on the "instructions" field.
+func InstructionsHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldInstructions, v))
+}
+
+// InstructionsIsNil applies the IsNil predicate on the "instructions" field.
+func InstructionsIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldInstructions))
+}
+
+// InstructionsNotNil applies the NotNil predicate on the "instructions" field.
+func InstructionsNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldInstructions))
+}
+
+// InstructionsEqualFold applies the EqualFold predicate on the "instructions" field.
+func InstructionsEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldInstructions, v))
+}
+
+// InstructionsContainsFold applies the ContainsFold predicate on the "instructions" field.
+func InstructionsContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldInstructions, v))
+}
+
+// DueDaysEQ applies the EQ predicate on the "due_days" field.
+func DueDaysEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldDueDays, v))
+}
+
+// DueDaysNEQ applies the NEQ predicate on the "due_days" field.
+func DueDaysNEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldDueDays, v))
+}
+
+// DueDaysIn applies the In predicate on the "due_days" field.
+func DueDaysIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldDueDays, vs...))
+}
+
+// DueDaysNotIn applies the NotIn predicate on the "due_days" field.
+func DueDaysNotIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldDueDays, vs...))
+}
+
+// DueDaysGT applies the GT predicate on the "due_days" field.
+func DueDaysGT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldDueDays, v))
+}
+
+// DueDaysGTE applies the GTE predicate on the "due_days" field.
+func DueDaysGTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldDueDays, v))
+}
+
+// DueDaysLT applies the LT predicate on the "due_days" field.
+func DueDaysLT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldDueDays, v))
+}
+
+// DueDaysLTE applies the LTE predicate on the "due_days" field.
+func DueDaysLTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldDueDays, v))
+}
+
+// DueDaysIsNil applies the IsNil predicate on the "due_days" field.
+func DueDaysIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldDueDays))
+}
+
+// DueDaysNotNil applies the NotNil predicate on the "due_days" field.
+func DueDaysNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldDueDays))
+}
+
+// MaxScoreEQ applies the EQ predicate on the "max_score" field.
+func MaxScoreEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// MaxScoreNEQ applies the NEQ predicate on the "max_score" field.
+func MaxScoreNEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldMaxScore, v))
+}
+
+// MaxScoreIn applies the In predicate on the "max_score" field.
+func MaxScoreIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreNotIn applies the NotIn predicate on the "max_score" field.
+func MaxScoreNotIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreGT applies the GT predicate on the "max_score" field.
+func MaxScoreGT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldMaxScore, v))
+}
+
+// MaxScoreGTE applies the GTE predicate on the "max_score" field.
+func MaxScoreGTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldMaxScore, v))
+}
+
+// MaxScoreLT applies the LT predicate on the "max_score" field.
+func MaxScoreLT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldMaxScore, v))
+}
+
+// MaxScoreLTE applies the LTE predicate on the "max_score" field.
+func MaxScoreLTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldMaxScore, v))
+}
+
+// PassingScoreEQ applies the EQ predicate on the "passing_score" field.
+func PassingScoreEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldPassingScore, v))
+}
+
+// PassingScoreNEQ applies the NEQ predicate on the "passing_score" field.
+func PassingScoreNEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldPassingScore, v))
+}
+
+// PassingScoreIn applies the In predicate on the "passing_score" field.
+func PassingScoreIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldPassingScore, vs...))
+}
+
+// PassingScoreNotIn applies the NotIn predicate on the "passing_score" field.
+func PassingScoreNotIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldPassingScore, vs...))
+}
+
+// PassingScoreGT applies the GT predicate on the "passing_score" field.
+func PassingScoreGT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldPassingScore, v))
+}
+
+// PassingScoreGTE applies the GTE predicate on the "passing_score" field.
+func PassingScoreGTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldPassingScore, v))
+}
+
+// PassingScoreLT applies the LT predicate on the "passing_score" field.
+func PassingScoreLT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldPassingScore, v))
+}
+
+// PassingScoreLTE applies the LTE predicate on the "passing_score" field.
+func PassingScoreLTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldPassingScore, v))
+}
+
+// PassingScoreIsNil applies the IsNil predicate on the "passing_score" field.
+func PassingScoreIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldPassingScore))
+}
+
+// PassingScoreNotNil applies the NotNil predicate on the "passing_score" field.
+func PassingScoreNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldPassingScore))
+}
+
+// RubricIsNil applies the IsNil predicate on the "rubric" field.
+func RubricIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldRubric))
+}
+
+// RubricNotNil applies the NotNil predicate on the "rubric" field.
+func RubricNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldRubric))
+}
+
+// LatePolicyEQ applies the EQ predicate on the "late_policy" field.
+func LatePolicyEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldLatePolicy, v))
+}
+
+// LatePolicyNEQ applies the NEQ predicate on the "late_policy" field.
+func LatePolicyNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldLatePolicy, v))
+}
+
+// LatePolicyIn applies the In predicate on the "late_policy" field.
+func LatePolicyIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldLatePolicy, vs...))
+}
+
+// LatePolicyNotIn applies the NotIn predicate on the "late_policy" field.
+func LatePolicyNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldLatePolicy, vs...))
+}
+
+// LatePolicyGT applies the GT predicate on the "late_policy" field.
+func LatePolicyGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldLatePolicy, v))
+}
+
+// LatePolicyGTE applies the GTE predicate on the "late_policy" field.
+func LatePolicyGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldLatePolicy, v))
+}
+
+// LatePolicyLT applies the LT predicate on the "late_policy" field.
+func LatePolicyLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldLatePolicy, v))
+}
+
+// LatePolicyLTE applies the LTE predicate on the "late_policy" field.
+func LatePolicyLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldLatePolicy, v))
+}
+
+// LatePolicyContains applies the Contains predicate on the "late_policy" field.
+func LatePolicyContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldLatePolicy, v))
+}
+
+// LatePolicyHasPrefix applies the HasPrefix predicate on the "late_policy" field.
+func LatePolicyHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldLatePolicy, v))
+}
+
+// LatePolicyHasSuffix applies the HasSuffix predicate on the "late_policy" field.
+func LatePolicyHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldLatePolicy, v))
+}
+
+// LatePolicyEqualFold applies the EqualFold predicate on the "late_policy" field.
+func LatePolicyEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldLatePolicy, v))
+}
+
+// LatePolicyContainsFold applies the ContainsFold predicate on the "late_policy" field.
+func LatePolicyContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldLatePolicy, v))
+}
+
+// LatePenaltyPercentEQ applies the EQ predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentEQ(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldLatePenaltyPercent, v))
+}
+
+// LatePenaltyPercentNEQ applies the NEQ predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentNEQ(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldLatePenaltyPercent, v))
+}
+
+// LatePenaltyPercentIn applies the In predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentIn(vs ...float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldLatePenaltyPercent, vs...))
+}
+
+// LatePenaltyPercentNotIn applies the NotIn predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentNotIn(vs ...float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldLatePenaltyPercent, vs...))
+}
+
+// LatePenaltyPercentGT applies the GT predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentGT(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldLatePenaltyPercent, v))
+}
+
+// LatePenaltyPercentGTE applies the GTE predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentGTE(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldLatePenaltyPercent, v))
+}
+
+// LatePenaltyPercentLT applies the LT predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentLT(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldLatePenaltyPercent, v))
+}
+
+// LatePenaltyPercentLTE applies the LTE predicate on the "late_penalty_percent" field.
+func LatePenaltyPercentLTE(v float64) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldLatePenaltyPercent, v))
+}
+
+// MaxSubmissionsEQ applies the EQ predicate on the "max_submissions" field.
+func MaxSubmissionsEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldMaxSubmissions, v))
+}
+
+// MaxSubmissionsNEQ applies the NEQ predicate on the "max_submissions" field.
+func MaxSubmissionsNEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldMaxSubmissions, v))
+}
+
+// MaxSubmissionsIn applies the In predicate on the "max_submissions" field.
+func MaxSubmissionsIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldMaxSubmissions, vs...))
+}
+
+// MaxSubmissionsNotIn applies the NotIn predicate on the "max_submissions" field.
+func MaxSubmissionsNotIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldMaxSubmissions, vs...))
+}
+
+// MaxSubmissionsGT applies the GT predicate on the "max_submissions" field.
+func MaxSubmissionsGT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldMaxSubmissions, v))
+}
+
+// MaxSubmissionsGTE applies the GTE predicate on the "max_submissions" field.
+func MaxSubmissionsGTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldMaxSubmissions, v))
+}
+
+// MaxSubmissionsLT applies the LT predicate on the "max_submissions" field.
+func MaxSubmissionsLT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldMaxSubmissions, v))
+}
+
+// MaxSubmissionsLTE applies the LTE predicate on the "max_submissions" field.
+func MaxSubmissionsLTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldMaxSubmissions, v))
+}
+
+// MaxSubmissionsIsNil applies the IsNil predicate on the "max_submissions" field.
+func MaxSubmissionsIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldMaxSubmissions))
+}
+
+// MaxSubmissionsNotNil applies the NotNil predicate on the "max_submissions" field.
+func MaxSubmissionsNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldMaxSubmissions))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Assignment) predicate.Assignment {
+	return predicate.Assignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Assignment) predicate.Assignment {
+	return predicate.Assignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Assignment) predicate.Assignment {
+	return predicate.Assignment(sql.NotPredicates(p))
+}
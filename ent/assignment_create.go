@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/assignment"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// AssignmentCreate is the builder for creating a Assignment entity.
+type AssignmentCreate struct {
+	config
+	mutation *AssignmentMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (ac *AssignmentCreate) SetStatus(s string) *AssignmentCreate {
+	ac.mutation.SetStatus(s)
+	return ac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableStatus(s *string) *AssignmentCreate {
+	if s != nil {
+		ac.SetStatus(*s)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AssignmentCreate) SetCreatedAt(t time.Time) *AssignmentCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableCreatedAt(t *time.Time) *AssignmentCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *AssignmentCreate) SetUpdatedAt(t time.Time) *AssignmentCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableUpdatedAt(t *time.Time) *AssignmentCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ac *AssignmentCreate) SetCreatedBy(s string) *AssignmentCreate {
+	ac.mutation.SetCreatedBy(s)
+	return ac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableCreatedBy(s *string) *AssignmentCreate {
+	if s != nil {
+		ac.SetCreatedBy(*s)
+	}
+	return ac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ac *AssignmentCreate) SetUpdatedBy(s string) *AssignmentCreate {
+	ac.mutation.SetUpdatedBy(s)
+	return ac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableUpdatedBy(s *string) *AssignmentCreate {
+	if s != nil {
+		ac.SetUpdatedBy(*s)
+	}
+	return ac
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (ac *AssignmentCreate) SetInternshipID(s string) *AssignmentCreate {
+	ac.mutation.SetInternshipID(s)
+	return ac
+}
+
+// SetModuleID sets the "module_id" field.
+func (ac *AssignmentCreate) SetModuleID(s string) *AssignmentCreate {
+	ac.mutation.SetModuleID(s)
+	return ac
+}
+
+// SetTitle sets the "title" field.
+func (ac *AssignmentCreate) SetTitle(s string) *AssignmentCreate {
+	ac.mutation.SetTitle(s)
+	return ac
+}
+
+// SetInstructions sets the "instructions" field.
+func (ac *AssignmentCreate) SetInstructions(s string) *AssignmentCreate {
+	ac.mutation.SetInstructions(s)
+	return ac
+}
+
+// SetNillableInstructions sets the "instructions" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableInstructions(s *string) *AssignmentCreate {
+	if s != nil {
+		ac.SetInstructions(*s)
+	}
+	return ac
+}
+
+// SetDueDays sets the "due_days" field.
+func (ac *AssignmentCreate) SetDueDays(i int) *AssignmentCreate {
+	ac.mutation.SetDueDays(i)
+	return ac
+}
+
+// SetNillableDueDays sets the "due_days" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableDueDays(i *int) *AssignmentCreate {
+	if i != nil {
+		ac.SetDueDays(*i)
+	}
+	return ac
+}
+
+// SetMaxScore sets the "max_score" field.
+func (ac *AssignmentCreate) SetMaxScore(i int) *AssignmentCreate {
+	ac.mutation.SetMaxScore(i)
+	return ac
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableMaxScore(i *int) *AssignmentCreate {
+	if i != nil {
+		ac.SetMaxScore(*i)
+	}
+	return ac
+}
+
+// SetPassingScore sets the "passing_score" field.
+func (ac *AssignmentCreate) SetPassingScore(i int) *AssignmentCreate {
+	ac.mutation.SetPassingScore(i)
+	return ac
+}
+
+// SetNillablePassingScore sets the "passing_score" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillablePassingScore(i *int) *AssignmentCreate {
+	if i != nil {
+		ac.SetPassingScore(*i)
+	}
+	return ac
+}
+
+// SetRubric sets the "rubric" field.
+func (ac *AssignmentCreate) SetRubric(tc []types.RubricCriterion) *AssignmentCreate {
+	ac.mutation.SetRubric(tc)
+	return ac
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (ac *AssignmentCreate) SetLatePolicy(s string) *AssignmentCreate {
+	ac.mutation.SetLatePolicy(s)
+	return ac
+}
+
+// SetNillableLatePolicy sets the "late_policy" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableLatePolicy(s *string) *AssignmentCreate {
+	if s != nil {
+		ac.SetLatePolicy(*s)
+	}
+	return ac
+}
+
+// SetLatePenaltyPercent sets the "late_penalty_percent" field.
+func (ac *AssignmentCreate) SetLatePenaltyPercent(f float64) *AssignmentCreate {
+	ac.mutation.SetLatePenaltyPercent(f)
+	return ac
+}
+
+// SetNillableLatePenaltyPercent sets the "late_penalty_percent" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableLatePenaltyPercent(f *float64) *AssignmentCreate {
+	if f != nil {
+		ac.SetLatePenaltyPercent(*f)
+	}
+	return ac
+}
+
+// SetMaxSubmissions sets the "max_submissions" field.
+func (ac *AssignmentCreate) SetMaxSubmissions(i int) *AssignmentCreate {
+	ac.mutation.SetMaxSubmissions(i)
+	return ac
+}
+
+// SetNillableMaxSubmissions sets the "max_submissions" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableMaxSubmissions(i *int) *AssignmentCreate {
+	if i != nil {
+		ac.SetMaxSubmissions(*i)
+	}
+	return ac
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (ac *AssignmentCreate) SetSortOrder(i int) *AssignmentCreate {
+	ac.mutation.SetSortOrder(i)
+	return ac
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableSortOrder(i *int) *AssignmentCreate {
+	if i != nil {
+		ac.SetSortOrder(*i)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AssignmentCreate) SetID(s string) *AssignmentCreate {
+	ac.mutation.SetID(s)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *AssignmentCreate) SetNillableID(s *string) *AssignmentCreate {
+	if s != nil {
+		ac.SetID(*s)
+	}
+	return ac
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (ac *AssignmentCreate) Mutation() *AssignmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Assignment in the database.
+func (ac *AssignmentCreate) Save(ctx context.Context) (*Assignment, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AssignmentCreate) SaveX(ctx context.Context) *Assignment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AssignmentCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AssignmentCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AssignmentCreate) defaults() {
+	if _, ok := ac.mutation.Status(); !ok {
+		v := assignment.DefaultStatus
+		ac.mutation.SetStatus(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := assignment.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		v := assignment.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ac.mutation.MaxScore(); !ok {
+		v := assignment.DefaultMaxScore
+		ac.mutation.SetMaxScore(v)
+	}
+	if _, ok := ac.mutation.LatePolicy(); !ok {
+		v := assignment.DefaultLatePolicy
+		ac.mutation.SetLatePolicy(v)
+	}
+	if _, ok := ac.mutation.LatePenaltyPercent(); !ok {
+		v := assignment.DefaultLatePenaltyPercent
+		ac.mutation.SetLatePenaltyPercent(v)
+	}
+	if _, ok := ac.mutation.SortOrder(); !ok {
+		v := assignment.DefaultSortOrder
+		ac.mutation.SetSortOrder(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := assignment.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AssignmentCreate) check() error {
+	if _, ok := ac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Assignment.status"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Assignment.created_at"`)}
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Assignment.updated_at"`)}
+	}
+	if _, ok := ac.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Assignment.internship_id"`)}
+	}
+	if v, ok := ac.mutation.InternshipID(); ok {
+		if err := assignment.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Assignment.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ModuleID(); !ok {
+		return &ValidationError{Name: "module_id", err: errors.New(`ent: missing required field "Assignment.module_id"`)}
+	}
+	if v, ok := ac.mutation.ModuleID(); ok {
+		if err := assignment.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Assignment.module_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Assignment.title"`)}
+	}
+	if v, ok := ac.mutation.Title(); ok {
+		if err := assignment.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Assignment.title": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.DueDays(); ok {
+		if err := assignment.DueDaysValidator(v); err != nil {
+			return &ValidationError{Name: "due_days", err: fmt.Errorf(`ent: validator failed for field "Assignment.due_days": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.MaxScore(); !ok {
+		return &ValidationError{Name: "max_score", err: errors.New(`ent: missing required field "Assignment.max_score"`)}
+	}
+	if v, ok := ac.mutation.MaxScore(); ok {
+		if err := assignment.MaxScoreValidator(v); err != nil {
+			return &ValidationError{Name: "max_score", err: fmt.Errorf(`ent: validator failed for field "Assignment.max_score": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.PassingScore(); ok {
+		if err := assignment.PassingScoreValidator(v); err != nil {
+			return &ValidationError{Name: "passing_score", err: fmt.Errorf(`ent: validator failed for field "Assignment.passing_score": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.LatePolicy(); !ok {
+		return &ValidationError{Name: "late_policy", err: errors.New(`ent: missing required field "Assignment.late_policy"`)}
+	}
+	if _, ok := ac.mutation.LatePenaltyPercent(); !ok {
+		return &ValidationError{Name: "late_penalty_percent", err: errors.New(`ent: missing required field "Assignment.late_penalty_percent"`)}
+	}
+	if v, ok := ac.mutation.LatePenaltyPercent(); ok {
+		if err := assignment.LatePenaltyPercentValidator(v); err != nil {
+			return &ValidationError{Name: "late_penalty_percent", err: fmt.Errorf(`ent: validator failed for field "Assignment.late_penalty_percent": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.MaxSubmissions(); ok {
+		if err := assignment.MaxSubmissionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_submissions", err: fmt.Errorf(`ent: validator failed for field "Assignment.max_submissions": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Assignment.sort_order"`)}
+	}
+	return nil
+}
+
+func (ac *AssignmentCreate) sqlSave(ctx context.Context) (*Assignment, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Assignment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AssignmentCreate) createSpec() (*Assignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Assignment{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(assignment.Table, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeString))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.Status(); ok {
+		_spec.SetField(assignment.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(assignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ac.mutation.CreatedBy(); ok {
+		_spec.SetField(assignment.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ac.mutation.UpdatedBy(); ok {
+		_spec.SetField(assignment.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := ac.mutation.InternshipID(); ok {
+		_spec.SetField(assignment.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := ac.mutation.ModuleID(); ok {
+		_spec.SetField(assignment.FieldModuleID, field.TypeString, value)
+		_node.ModuleID = value
+	}
+	if value, ok := ac.mutation.Title(); ok {
+		_spec.SetField(assignment.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ac.mutation.Instructions(); ok {
+		_spec.SetField(assignment.FieldInstructions, field.TypeString, value)
+		_node.Instructions = value
+	}
+	if value, ok := ac.mutation.DueDays(); ok {
+		_spec.SetField(assignment.FieldDueDays, field.TypeInt, value)
+		_node.DueDays = &value
+	}
+	if value, ok := ac.mutation.MaxScore(); ok {
+		_spec.SetField(assignment.FieldMaxScore, field.TypeInt, value)
+		_node.MaxScore = value
+	}
+	if value, ok := ac.mutation.PassingScore(); ok {
+		_spec.SetField(assignment.FieldPassingScore, field.TypeInt, value)
+		_node.PassingScore = &value
+	}
+	if value, ok := ac.mutation.Rubric(); ok {
+		_spec.SetField(assignment.FieldRubric, field.TypeJSON, value)
+		_node.Rubric = value
+	}
+	if value, ok := ac.mutation.LatePolicy(); ok {
+		_spec.SetField(assignment.FieldLatePolicy, field.TypeString, value)
+		_node.LatePolicy = value
+	}
+	if value, ok := ac.mutation.LatePenaltyPercent(); ok {
+		_spec.SetField(assignment.FieldLatePenaltyPercent, field.TypeFloat64, value)
+		_node.LatePenaltyPercent = value
+	}
+	if value, ok := ac.mutation.MaxSubmissions(); ok {
+		_spec.SetField(assignment.FieldMaxSubmissions, field.TypeInt, value)
+		_node.MaxSubmissions = &value
+	}
+	if value, ok := ac.mutation.SortOrder(); ok {
+		_spec.SetField(assignment.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// AssignmentCreateBulk is the builder for creating many Assignment entities in bulk.
+type AssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*AssignmentCreate
+}
+
+// Save creates the Assignment entities in the database.
+func (acb *AssignmentCreateBulk) Save(ctx context.Context) ([]*Assignment, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Assignment, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AssignmentCreateBulk) SaveX(ctx context.Context) []*Assignment {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,982 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/assignment"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// AssignmentUpdate is the builder for updating Assignment entities.
+type AssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AssignmentMutation
+}
+
+// Where appends a list predicates to the AssignmentUpdate builder.
+func (au *AssignmentUpdate) Where(ps ...predicate.Assignment) *AssignmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetStatus sets the "status" field.
+func (au *AssignmentUpdate) SetStatus(s string) *AssignmentUpdate {
+	au.mutation.SetStatus(s)
+	return au
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableStatus(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetStatus(*s)
+	}
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AssignmentUpdate) SetUpdatedAt(t time.Time) *AssignmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (au *AssignmentUpdate) SetUpdatedBy(s string) *AssignmentUpdate {
+	au.mutation.SetUpdatedBy(s)
+	return au
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableUpdatedBy(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetUpdatedBy(*s)
+	}
+	return au
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (au *AssignmentUpdate) ClearUpdatedBy() *AssignmentUpdate {
+	au.mutation.ClearUpdatedBy()
+	return au
+}
+
+// SetModuleID sets the "module_id" field.
+func (au *AssignmentUpdate) SetModuleID(s string) *AssignmentUpdate {
+	au.mutation.SetModuleID(s)
+	return au
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableModuleID(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetModuleID(*s)
+	}
+	return au
+}
+
+// SetTitle sets the "title" field.
+func (au *AssignmentUpdate) SetTitle(s string) *AssignmentUpdate {
+	au.mutation.SetTitle(s)
+	return au
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableTitle(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetTitle(*s)
+	}
+	return au
+}
+
+// SetInstructions sets the "instructions" field.
+func (au *AssignmentUpdate) SetInstructions(s string) *AssignmentUpdate {
+	au.mutation.SetInstructions(s)
+	return au
+}
+
+// SetNillableInstructions sets the "instructions" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableInstructions(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetInstructions(*s)
+	}
+	return au
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (au *AssignmentUpdate) ClearInstructions() *AssignmentUpdate {
+	au.mutation.ClearInstructions()
+	return au
+}
+
+// SetDueDays sets the "due_days" field.
+func (au *AssignmentUpdate) SetDueDays(i int) *AssignmentUpdate {
+	au.mutation.ResetDueDays()
+	au.mutation.SetDueDays(i)
+	return au
+}
+
+// SetNillableDueDays sets the "due_days" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableDueDays(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetDueDays(*i)
+	}
+	return au
+}
+
+// AddDueDays adds i to the "due_days" field.
+func (au *AssignmentUpdate) AddDueDays(i int) *AssignmentUpdate {
+	au.mutation.AddDueDays(i)
+	return au
+}
+
+// ClearDueDays clears the value of the "due_days" field.
+func (au *AssignmentUpdate) ClearDueDays() *AssignmentUpdate {
+	au.mutation.ClearDueDays()
+	return au
+}
+
+// SetMaxScore sets the "max_score" field.
+func (au *AssignmentUpdate) SetMaxScore(i int) *AssignmentUpdate {
+	au.mutation.ResetMaxScore()
+	au.mutation.SetMaxScore(i)
+	return au
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableMaxScore(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetMaxScore(*i)
+	}
+	return au
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (au *AssignmentUpdate) AddMaxScore(i int) *AssignmentUpdate {
+	au.mutation.AddMaxScore(i)
+	return au
+}
+
+// SetPassingScore sets the "passing_score" field.
+func (au *AssignmentUpdate) SetPassingScore(i int) *AssignmentUpdate {
+	au.mutation.ResetPassingScore()
+	au.mutation.SetPassingScore(i)
+	return au
+}
+
+// SetNillablePassingScore sets the "passing_score" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillablePassingScore(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetPassingScore(*i)
+	}
+	return au
+}
+
+// AddPassingScore adds i to the "passing_score" field.
+func (au *AssignmentUpdate) AddPassingScore(i int) *AssignmentUpdate {
+	au.mutation.AddPassingScore(i)
+	return au
+}
+
+// ClearPassingScore clears the value of the "passing_score" field.
+func (au *AssignmentUpdate) ClearPassingScore() *AssignmentUpdate {
+	au.mutation.ClearPassingScore()
+	return au
+}
+
+// SetRubric sets the "rubric" field.
+func (au *AssignmentUpdate) SetRubric(tc []types.RubricCriterion) *AssignmentUpdate {
+	au.mutation.SetRubric(tc)
+	return au
+}
+
+// AppendRubric appends tc to the "rubric" field.
+func (au *AssignmentUpdate) AppendRubric(tc []types.RubricCriterion) *AssignmentUpdate {
+	au.mutation.AppendRubric(tc)
+	return au
+}
+
+// ClearRubric clears the value of the "rubric" field.
+func (au *AssignmentUpdate) ClearRubric() *AssignmentUpdate {
+	au.mutation.ClearRubric()
+	return au
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (au *AssignmentUpdate) SetLatePolicy(s string) *AssignmentUpdate {
+	au.mutation.SetLatePolicy(s)
+	return au
+}
+
+// SetNillableLatePolicy sets the "late_policy" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableLatePolicy(s *string) *AssignmentUpdate {
+	if s != nil {
+		au.SetLatePolicy(*s)
+	}
+	return au
+}
+
+// SetLatePenaltyPercent sets the "late_penalty_percent" field.
+func (au *AssignmentUpdate) SetLatePenaltyPercent(f float64) *AssignmentUpdate {
+	au.mutation.ResetLatePenaltyPercent()
+	au.mutation.SetLatePenaltyPercent(f)
+	return au
+}
+
+// SetNillableLatePenaltyPercent sets the "late_penalty_percent" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableLatePenaltyPercent(f *float64) *AssignmentUpdate {
+	if f != nil {
+		au.SetLatePenaltyPercent(*f)
+	}
+	return au
+}
+
+// AddLatePenaltyPercent adds f to the "late_penalty_percent" field.
+func (au *AssignmentUpdate) AddLatePenaltyPercent(f float64) *AssignmentUpdate {
+	au.mutation.AddLatePenaltyPercent(f)
+	return au
+}
+
+// SetMaxSubmissions sets the "max_submissions" field.
+func (au *AssignmentUpdate) SetMaxSubmissions(i int) *AssignmentUpdate {
+	au.mutation.ResetMaxSubmissions()
+	au.mutation.SetMaxSubmissions(i)
+	return au
+}
+
+// SetNillableMaxSubmissions sets the "max_submissions" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableMaxSubmissions(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetMaxSubmissions(*i)
+	}
+	return au
+}
+
+// AddMaxSubmissions adds i to the "max_submissions" field.
+func (au *AssignmentUpdate) AddMaxSubmissions(i int) *AssignmentUpdate {
+	au.mutation.AddMaxSubmissions(i)
+	return au
+}
+
+// ClearMaxSubmissions clears the value of the "max_submissions" field.
+func (au *AssignmentUpdate) ClearMaxSubmissions() *AssignmentUpdate {
+	au.mutation.ClearMaxSubmissions()
+	return au
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (au *AssignmentUpdate) SetSortOrder(i int) *AssignmentUpdate {
+	au.mutation.ResetSortOrder()
+	au.mutation.SetSortOrder(i)
+	return au
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (au *AssignmentUpdate) SetNillableSortOrder(i *int) *AssignmentUpdate {
+	if i != nil {
+		au.SetSortOrder(*i)
+	}
+	return au
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (au *AssignmentUpdate) AddSortOrder(i int) *AssignmentUpdate {
+	au.mutation.AddSortOrder(i)
+	return au
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (au *AssignmentUpdate) Mutation() *AssignmentMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AssignmentUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AssignmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AssignmentUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := assignment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AssignmentUpdate) check() error {
+	if v, ok := au.mutation.ModuleID(); ok {
+		if err := assignment.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Assignment.module_id": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Title(); ok {
+		if err := assignment.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Assignment.title": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.DueDays(); ok {
+		if err := assignment.DueDaysValidator(v); err != nil {
+			return &ValidationError{Name: "due_days", err: fmt.Errorf(`ent: validator failed for field "Assignment.due_days": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.MaxScore(); ok {
+		if err := assignment.MaxScoreValidator(v); err != nil {
+			return &ValidationError{Name: "max_score", err: fmt.Errorf(`ent: validator failed for field "Assignment.max_score": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.PassingScore(); ok {
+		if err := assignment.PassingScoreValidator(v); err != nil {
+			return &ValidationError{Name: "passing_score", err: fmt.Errorf(`ent: validator failed for field "Assignment.passing_score": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.LatePenaltyPercent(); ok {
+		if err := assignment.LatePenaltyPercentValidator(v); err != nil {
+			return &ValidationError{Name: "late_penalty_percent", err: fmt.Errorf(`ent: validator failed for field "Assignment.late_penalty_percent": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.MaxSubmissions(); ok {
+		if err := assignment.MaxSubmissionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_submissions", err: fmt.Errorf(`ent: validator failed for field "Assignment.max_submissions": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (au *AssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assignment.Table, assignment.Columns, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeString))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Status(); ok {
+		_spec.SetField(assignment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if au.mutation.CreatedByCleared() {
+		_spec.ClearField(assignment.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := au.mutation.UpdatedBy(); ok {
+		_spec.SetField(assignment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if au.mutation.UpdatedByCleared() {
+		_spec.ClearField(assignment.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := au.mutation.ModuleID(); ok {
+		_spec.SetField(assignment.FieldModuleID, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Title(); ok {
+		_spec.SetField(assignment.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Instructions(); ok {
+		_spec.SetField(assignment.FieldInstructions, field.TypeString, value)
+	}
+	if au.mutation.InstructionsCleared() {
+		_spec.ClearField(assignment.FieldInstructions, field.TypeString)
+	}
+	if value, ok := au.mutation.DueDays(); ok {
+		_spec.SetField(assignment.FieldDueDays, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedDueDays(); ok {
+		_spec.AddField(assignment.FieldDueDays, field.TypeInt, value)
+	}
+	if au.mutation.DueDaysCleared() {
+		_spec.ClearField(assignment.FieldDueDays, field.TypeInt)
+	}
+	if value, ok := au.mutation.MaxScore(); ok {
+		_spec.SetField(assignment.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedMaxScore(); ok {
+		_spec.AddField(assignment.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.PassingScore(); ok {
+		_spec.SetField(assignment.FieldPassingScore, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedPassingScore(); ok {
+		_spec.AddField(assignment.FieldPassingScore, field.TypeInt, value)
+	}
+	if au.mutation.PassingScoreCleared() {
+		_spec.ClearField(assignment.FieldPassingScore, field.TypeInt)
+	}
+	if value, ok := au.mutation.Rubric(); ok {
+		_spec.SetField(assignment.FieldRubric, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedRubric(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, assignment.FieldRubric, value)
+		})
+	}
+	if au.mutation.RubricCleared() {
+		_spec.ClearField(assignment.FieldRubric, field.TypeJSON)
+	}
+	if value, ok := au.mutation.LatePolicy(); ok {
+		_spec.SetField(assignment.FieldLatePolicy, field.TypeString, value)
+	}
+	if value, ok := au.mutation.LatePenaltyPercent(); ok {
+		_spec.SetField(assignment.FieldLatePenaltyPercent, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedLatePenaltyPercent(); ok {
+		_spec.AddField(assignment.FieldLatePenaltyPercent, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.MaxSubmissions(); ok {
+		_spec.SetField(assignment.FieldMaxSubmissions, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedMaxSubmissions(); ok {
+		_spec.AddField(assignment.FieldMaxSubmissions, field.TypeInt, value)
+	}
+	if au.mutation.MaxSubmissionsCleared() {
+		_spec.ClearField(assignment.FieldMaxSubmissions, field.TypeInt)
+	}
+	if value, ok := au.mutation.SortOrder(); ok {
+		_spec.SetField(assignment.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedSortOrder(); ok {
+		_spec.AddField(assignment.FieldSortOrder, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AssignmentUpdateOne is the builder for updating a single Assignment entity.
+type AssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AssignmentMutation
+}
+
+// SetStatus sets the "status" field.
+func (auo *AssignmentUpdateOne) SetStatus(s string) *AssignmentUpdateOne {
+	auo.mutation.SetStatus(s)
+	return auo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableStatus(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetStatus(*s)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AssignmentUpdateOne) SetUpdatedAt(t time.Time) *AssignmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (auo *AssignmentUpdateOne) SetUpdatedBy(s string) *AssignmentUpdateOne {
+	auo.mutation.SetUpdatedBy(s)
+	return auo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableUpdatedBy(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetUpdatedBy(*s)
+	}
+	return auo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (auo *AssignmentUpdateOne) ClearUpdatedBy() *AssignmentUpdateOne {
+	auo.mutation.ClearUpdatedBy()
+	return auo
+}
+
+// SetModuleID sets the "module_id" field.
+func (auo *AssignmentUpdateOne) SetModuleID(s string) *AssignmentUpdateOne {
+	auo.mutation.SetModuleID(s)
+	return auo
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableModuleID(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetModuleID(*s)
+	}
+	return auo
+}
+
+// SetTitle sets the "title" field.
+func (auo *AssignmentUpdateOne) SetTitle(s string) *AssignmentUpdateOne {
+	auo.mutation.SetTitle(s)
+	return auo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableTitle(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetTitle(*s)
+	}
+	return auo
+}
+
+// SetInstructions sets the "instructions" field.
+func (auo *AssignmentUpdateOne) SetInstructions(s string) *AssignmentUpdateOne {
+	auo.mutation.SetInstructions(s)
+	return auo
+}
+
+// SetNillableInstructions sets the "instructions" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableInstructions(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetInstructions(*s)
+	}
+	return auo
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (auo *AssignmentUpdateOne) ClearInstructions() *AssignmentUpdateOne {
+	auo.mutation.ClearInstructions()
+	return auo
+}
+
+// SetDueDays sets the "due_days" field.
+func (auo *AssignmentUpdateOne) SetDueDays(i int) *AssignmentUpdateOne {
+	auo.mutation.ResetDueDays()
+	auo.mutation.SetDueDays(i)
+	return auo
+}
+
+// SetNillableDueDays sets the "due_days" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableDueDays(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetDueDays(*i)
+	}
+	return auo
+}
+
+// AddDueDays adds i to the "due_days" field.
+func (auo *AssignmentUpdateOne) AddDueDays(i int) *AssignmentUpdateOne {
+	auo.mutation.AddDueDays(i)
+	return auo
+}
+
+// ClearDueDays clears the value of the "due_days" field.
+func (auo *AssignmentUpdateOne) ClearDueDays() *AssignmentUpdateOne {
+	auo.mutation.ClearDueDays()
+	return auo
+}
+
+// SetMaxScore sets the "max_score" field.
+func (auo *AssignmentUpdateOne) SetMaxScore(i int) *AssignmentUpdateOne {
+	auo.mutation.ResetMaxScore()
+	auo.mutation.SetMaxScore(i)
+	return auo
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableMaxScore(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetMaxScore(*i)
+	}
+	return auo
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (auo *AssignmentUpdateOne) AddMaxScore(i int) *AssignmentUpdateOne {
+	auo.mutation.AddMaxScore(i)
+	return auo
+}
+
+// SetPassingScore sets the "passing_score" field.
+func (auo *AssignmentUpdateOne) SetPassingScore(i int) *AssignmentUpdateOne {
+	auo.mutation.ResetPassingScore()
+	auo.mutation.SetPassingScore(i)
+	return auo
+}
+
+// SetNillablePassingScore sets the "passing_score" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillablePassingScore(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetPassingScore(*i)
+	}
+	return auo
+}
+
+// AddPassingScore adds i to the "passing_score" field.
+func (auo *AssignmentUpdateOne) AddPassingScore(i int) *AssignmentUpdateOne {
+	auo.mutation.AddPassingScore(i)
+	return auo
+}
+
+// ClearPassingScore clears the value of the "passing_score" field.
+func (auo *AssignmentUpdateOne) ClearPassingScore() *AssignmentUpdateOne {
+	auo.mutation.ClearPassingScore()
+	return auo
+}
+
+// SetRubric sets the "rubric" field.
+func (auo *AssignmentUpdateOne) SetRubric(tc []types.RubricCriterion) *AssignmentUpdateOne {
+	auo.mutation.SetRubric(tc)
+	return auo
+}
+
+// AppendRubric appends tc to the "rubric" field.
+func (auo *AssignmentUpdateOne) AppendRubric(tc []types.RubricCriterion) *AssignmentUpdateOne {
+	auo.mutation.AppendRubric(tc)
+	return auo
+}
+
+// ClearRubric clears the value of the "rubric" field.
+func (auo *AssignmentUpdateOne) ClearRubric() *AssignmentUpdateOne {
+	auo.mutation.ClearRubric()
+	return auo
+}
+
+// SetLatePolicy sets the "late_policy" field.
+func (auo *AssignmentUpdateOne) SetLatePolicy(s string) *AssignmentUpdateOne {
+	auo.mutation.SetLatePolicy(s)
+	return auo
+}
+
+// SetNillableLatePolicy sets the "late_policy" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableLatePolicy(s *string) *AssignmentUpdateOne {
+	if s != nil {
+		auo.SetLatePolicy(*s)
+	}
+	return auo
+}
+
+// SetLatePenaltyPercent sets the "late_penalty_percent" field.
+func (auo *AssignmentUpdateOne) SetLatePenaltyPercent(f float64) *AssignmentUpdateOne {
+	auo.mutation.ResetLatePenaltyPercent()
+	auo.mutation.SetLatePenaltyPercent(f)
+	return auo
+}
+
+// SetNillableLatePenaltyPercent sets the "late_penalty_percent" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableLatePenaltyPercent(f *float64) *AssignmentUpdateOne {
+	if f != nil {
+		auo.SetLatePenaltyPercent(*f)
+	}
+	return auo
+}
+
+// AddLatePenaltyPercent adds f to the "late_penalty_percent" field.
+func (auo *AssignmentUpdateOne) AddLatePenaltyPercent(f float64) *AssignmentUpdateOne {
+	auo.mutation.AddLatePenaltyPercent(f)
+	return auo
+}
+
+// SetMaxSubmissions sets the "max_submissions" field.
+func (auo *AssignmentUpdateOne) SetMaxSubmissions(i int) *AssignmentUpdateOne {
+	auo.mutation.ResetMaxSubmissions()
+	auo.mutation.SetMaxSubmissions(i)
+	return auo
+}
+
+// SetNillableMaxSubmissions sets the "max_submissions" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableMaxSubmissions(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetMaxSubmissions(*i)
+	}
+	return auo
+}
+
+// AddMaxSubmissions adds i to the "max_submissions" field.
+func (auo *AssignmentUpdateOne) AddMaxSubmissions(i int) *AssignmentUpdateOne {
+	auo.mutation.AddMaxSubmissions(i)
+	return auo
+}
+
+// ClearMaxSubmissions clears the value of the "max_submissions" field.
+func (auo *AssignmentUpdateOne) ClearMaxSubmissions() *AssignmentUpdateOne {
+	auo.mutation.ClearMaxSubmissions()
+	return auo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (auo *AssignmentUpdateOne) SetSortOrder(i int) *AssignmentUpdateOne {
+	auo.mutation.ResetSortOrder()
+	auo.mutation.SetSortOrder(i)
+	return auo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (auo *AssignmentUpdateOne) SetNillableSortOrder(i *int) *AssignmentUpdateOne {
+	if i != nil {
+		auo.SetSortOrder(*i)
+	}
+	return auo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (auo *AssignmentUpdateOne) AddSortOrder(i int) *AssignmentUpdateOne {
+	auo.mutation.AddSortOrder(i)
+	return auo
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (auo *AssignmentUpdateOne) Mutation() *AssignmentMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the AssignmentUpdate builder.
+func (auo *AssignmentUpdateOne) Where(ps ...predicate.Assignment) *AssignmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AssignmentUpdateOne) Select(field string, fields ...string) *AssignmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Assignment entity.
+func (auo *AssignmentUpdateOne) Save(ctx context.Context) (*Assignment, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AssignmentUpdateOne) SaveX(ctx context.Context) *Assignment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AssignmentUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := assignment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AssignmentUpdateOne) check() error {
+	if v, ok := auo.mutation.ModuleID(); ok {
+		if err := assignment.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Assignment.module_id": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Title(); ok {
+		if err := assignment.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Assignment.title": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.DueDays(); ok {
+		if err := assignment.DueDaysValidator(v); err != nil {
+			return &ValidationError{Name: "due_days", err: fmt.Errorf(`ent: validator failed for field "Assignment.due_days": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.MaxScore(); ok {
+		if err := assignment.MaxScoreValidator(v); err != nil {
+			return &ValidationError{Name: "max_score", err: fmt.Errorf(`ent: validator failed for field "Assignment.max_score": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.PassingScore(); ok {
+		if err := assignment.PassingScoreValidator(v); err != nil {
+			return &ValidationError{Name: "passing_score", err: fmt.Errorf(`ent: validator failed for field "Assignment.passing_score": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.LatePenaltyPercent(); ok {
+		if err := assignment.LatePenaltyPercentValidator(v); err != nil {
+			return &ValidationError{Name: "late_penalty_percent", err: fmt.Errorf(`ent: validator failed for field "Assignment.late_penalty_percent": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.MaxSubmissions(); ok {
+		if err := assignment.MaxSubmissionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_submissions", err: fmt.Errorf(`ent: validator failed for field "Assignment.max_submissions": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (auo *AssignmentUpdateOne) sqlSave(ctx context.Context) (_node *Assignment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assignment.Table, assignment.Columns, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeString))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Assignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, assignment.FieldID)
+		for _, f := range fields {
+			if !assignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != assignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Status(); ok {
+		_spec.SetField(assignment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if auo.mutation.CreatedByCleared() {
+		_spec.ClearField(assignment.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := auo.mutation.UpdatedBy(); ok {
+		_spec.SetField(assignment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if auo.mutation.UpdatedByCleared() {
+		_spec.ClearField(assignment.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := auo.mutation.ModuleID(); ok {
+		_spec.SetField(assignment.FieldModuleID, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Title(); ok {
+		_spec.SetField(assignment.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Instructions(); ok {
+		_spec.SetField(assignment.FieldInstructions, field.TypeString, value)
+	}
+	if auo.mutation.InstructionsCleared() {
+		_spec.ClearField(assignment.FieldInstructions, field.TypeString)
+	}
+	if value, ok := auo.mutation.DueDays(); ok {
+		_spec.SetField(assignment.FieldDueDays, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedDueDays(); ok {
+		_spec.AddField(assignment.FieldDueDays, field.TypeInt, value)
+	}
+	if auo.mutation.DueDaysCleared() {
+		_spec.ClearField(assignment.FieldDueDays, field.TypeInt)
+	}
+	if value, ok := auo.mutation.MaxScore(); ok {
+		_spec.SetField(assignment.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedMaxScore(); ok {
+		_spec.AddField(assignment.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.PassingScore(); ok {
+		_spec.SetField(assignment.FieldPassingScore, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedPassingScore(); ok {
+		_spec.AddField(assignment.FieldPassingScore, field.TypeInt, value)
+	}
+	if auo.mutation.PassingScoreCleared() {
+		_spec.ClearField(assignment.FieldPassingScore, field.TypeInt)
+	}
+	if value, ok := auo.mutation.Rubric(); ok {
+		_spec.SetField(assignment.FieldRubric, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedRubric(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, assignment.FieldRubric, value)
+		})
+	}
+	if auo.mutation.RubricCleared() {
+		_spec.ClearField(assignment.FieldRubric, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.LatePolicy(); ok {
+		_spec.SetField(assignment.FieldLatePolicy, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.LatePenaltyPercent(); ok {
+		_spec.SetField(assignment.FieldLatePenaltyPercent, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedLatePenaltyPercent(); ok {
+		_spec.AddField(assignment.FieldLatePenaltyPercent, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.MaxSubmissions(); ok {
+		_spec.SetField(assignment.FieldMaxSubmissions, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedMaxSubmissions(); ok {
+		_spec.AddField(assignment.FieldMaxSubmissions, field.TypeInt, value)
+	}
+	if auo.mutation.MaxSubmissionsCleared() {
+		_spec.ClearField(assignment.FieldMaxSubmissions, field.TypeInt)
+	}
+	if value, ok := auo.mutation.SortOrder(); ok {
+		_spec.SetField(assignment.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(assignment.FieldSortOrder, field.TypeInt, value)
+	}
+	_node = &Assignment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
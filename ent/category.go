@@ -36,6 +36,20 @@ type Category struct {
 	LookupKey string `json:"lookup_key,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *string `json:"parent_id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Depth holds the value of the "depth" field.
+	Depth int `json:"depth,omitempty"`
+	// SortWeight holds the value of the "sort_weight" field.
+	SortWeight int `json:"sort_weight,omitempty"`
+	// InternshipCount holds the value of the "internship_count" field.
+	InternshipCount int `json:"internship_count,omitempty"`
+	// TotalInternshipCount holds the value of the "total_internship_count" field.
+	TotalInternshipCount int `json:"total_internship_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CategoryQuery when eager-loading is set.
 	Edges         CategoryEdges `json:"edges"`
@@ -68,7 +82,9 @@ func (*Category) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case category.FieldMetadata:
 			values[i] = new([]byte)
-		case category.FieldID, category.FieldStatus, category.FieldCreatedBy, category.FieldUpdatedBy, category.FieldName, category.FieldLookupKey, category.FieldDescription:
+		case category.FieldDepth, category.FieldSortWeight, category.FieldInternshipCount, category.FieldTotalInternshipCount:
+			values[i] = new(sql.NullInt64)
+		case category.FieldID, category.FieldStatus, category.FieldCreatedBy, category.FieldUpdatedBy, category.FieldName, category.FieldLookupKey, category.FieldDescription, category.FieldParentID, category.FieldSlug, category.FieldPath:
 			values[i] = new(sql.NullString)
 		case category.FieldCreatedAt, category.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -151,6 +167,49 @@ func (c *Category) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Description = value.String
 			}
+		case category.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				c.ParentID = new(string)
+				*c.ParentID = value.String
+			}
+		case category.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				c.Slug = value.String
+			}
+		case category.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				c.Path = value.String
+			}
+		case category.FieldDepth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field depth", values[i])
+			} else if value.Valid {
+				c.Depth = int(value.Int64)
+			}
+		case category.FieldSortWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_weight", values[i])
+			} else if value.Valid {
+				c.SortWeight = int(value.Int64)
+			}
+		case category.FieldInternshipCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_count", values[i])
+			} else if value.Valid {
+				c.InternshipCount = int(value.Int64)
+			}
+		case category.FieldTotalInternshipCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_internship_count", values[i])
+			} else if value.Valid {
+				c.TotalInternshipCount = int(value.Int64)
+			}
 		case category.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
@@ -225,6 +284,29 @@ func (c *Category) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(c.Description)
+	builder.WriteString(", ")
+	if v := c.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(c.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(c.Path)
+	builder.WriteString(", ")
+	builder.WriteString("depth=")
+	builder.WriteString(fmt.Sprintf("%v", c.Depth))
+	builder.WriteString(", ")
+	builder.WriteString("sort_weight=")
+	builder.WriteString(fmt.Sprintf("%v", c.SortWeight))
+	builder.WriteString(", ")
+	builder.WriteString("internship_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.InternshipCount))
+	builder.WriteString(", ")
+	builder.WriteString("total_internship_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.TotalInternshipCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,20 @@ const (
 	FieldLookupKey = "lookup_key"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldDepth holds the string denoting the depth field in the database.
+	FieldDepth = "depth"
+	// FieldSortWeight holds the string denoting the sort_weight field in the database.
+	FieldSortWeight = "sort_weight"
+	// FieldInternshipCount holds the string denoting the internship_count field in the database.
+	FieldInternshipCount = "internship_count"
+	// FieldTotalInternshipCount holds the string denoting the total_internship_count field in the database.
+	FieldTotalInternshipCount = "total_internship_count"
 	// EdgeInternships holds the string denoting the internships edge name in mutations.
 	EdgeInternships = "internships"
 	// Table holds the table name of the category in the database.
@@ -57,6 +71,13 @@ var Columns = []string{
 	FieldName,
 	FieldLookupKey,
 	FieldDescription,
+	FieldParentID,
+	FieldSlug,
+	FieldPath,
+	FieldDepth,
+	FieldSortWeight,
+	FieldInternshipCount,
+	FieldTotalInternshipCount,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "categories"
@@ -95,6 +116,24 @@ var (
 	NameValidator func(string) error
 	// LookupKeyValidator is a validator for the "lookup_key" field. It is called by the builders before save.
 	LookupKeyValidator func(string) error
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+	// DefaultDepth holds the default value on creation for the "depth" field.
+	DefaultDepth int
+	// DepthValidator is a validator for the "depth" field. It is called by the builders before save.
+	DepthValidator func(int) error
+	// DefaultSortWeight holds the default value on creation for the "sort_weight" field.
+	DefaultSortWeight int
+	// DefaultInternshipCount holds the default value on creation for the "internship_count" field.
+	DefaultInternshipCount int
+	// InternshipCountValidator is a validator for the "internship_count" field. It is called by the builders before save.
+	InternshipCountValidator func(int) error
+	// DefaultTotalInternshipCount holds the default value on creation for the "total_internship_count" field.
+	DefaultTotalInternshipCount int
+	// TotalInternshipCountValidator is a validator for the "total_internship_count" field. It is called by the builders before save.
+	TotalInternshipCountValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -147,6 +186,41 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByDepth orders the results by the depth field.
+func ByDepth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepth, opts...).ToFunc()
+}
+
+// BySortWeight orders the results by the sort_weight field.
+func BySortWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortWeight, opts...).ToFunc()
+}
+
+// ByInternshipCount orders the results by the internship_count field.
+func ByInternshipCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipCount, opts...).ToFunc()
+}
+
+// ByTotalInternshipCount orders the results by the total_internship_count field.
+func ByTotalInternshipCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalInternshipCount, opts...).ToFunc()
+}
+
 // ByInternshipsCount orders the results by internships count.
 func ByInternshipsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -105,6 +105,41 @@ func Description(v string) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldDescription, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldParentID, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSlug, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldPath, v))
+}
+
+// Depth applies equality check predicate on the "depth" field. It's identical to DepthEQ.
+func Depth(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldDepth, v))
+}
+
+// SortWeight applies equality check predicate on the "sort_weight" field. It's identical to SortWeightEQ.
+func SortWeight(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSortWeight, v))
+}
+
+// InternshipCount applies equality check predicate on the "internship_count" field. It's identical to InternshipCountEQ.
+func InternshipCount(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldInternshipCount, v))
+}
+
+// TotalInternshipCount applies equality check predicate on the "total_internship_count" field. It's identical to TotalInternshipCountEQ.
+func TotalInternshipCount(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldTotalInternshipCount, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldStatus, v))
@@ -615,6 +650,371 @@ func DescriptionContainsFold(v string) predicate.Category {
 	return predicate.Category(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDContains applies the Contains predicate on the "parent_id" field.
+func ParentIDContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldParentID, v))
+}
+
+// ParentIDHasPrefix applies the HasPrefix predicate on the "parent_id" field.
+func ParentIDHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldParentID, v))
+}
+
+// ParentIDHasSuffix applies the HasSuffix predicate on the "parent_id" field.
+func ParentIDHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldParentID))
+}
+
+// ParentIDEqualFold applies the EqualFold predicate on the "parent_id" field.
+func ParentIDEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldParentID, v))
+}
+
+// ParentIDContainsFold applies the ContainsFold predicate on the "parent_id" field.
+func ParentIDContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldParentID, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldPath, v))
+}
+
+// DepthEQ applies the EQ predicate on the "depth" field.
+func DepthEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldDepth, v))
+}
+
+// DepthNEQ applies the NEQ predicate on the "depth" field.
+func DepthNEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldDepth, v))
+}
+
+// DepthIn applies the In predicate on the "depth" field.
+func DepthIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldDepth, vs...))
+}
+
+// DepthNotIn applies the NotIn predicate on the "depth" field.
+func DepthNotIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldDepth, vs...))
+}
+
+// DepthGT applies the GT predicate on the "depth" field.
+func DepthGT(v int) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldDepth, v))
+}
+
+// DepthGTE applies the GTE predicate on the "depth" field.
+func DepthGTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldDepth, v))
+}
+
+// DepthLT applies the LT predicate on the "depth" field.
+func DepthLT(v int) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldDepth, v))
+}
+
+// DepthLTE applies the LTE predicate on the "depth" field.
+func DepthLTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldDepth, v))
+}
+
+// SortWeightEQ applies the EQ predicate on the "sort_weight" field.
+func SortWeightEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSortWeight, v))
+}
+
+// SortWeightNEQ applies the NEQ predicate on the "sort_weight" field.
+func SortWeightNEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldSortWeight, v))
+}
+
+// SortWeightIn applies the In predicate on the "sort_weight" field.
+func SortWeightIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldSortWeight, vs...))
+}
+
+// SortWeightNotIn applies the NotIn predicate on the "sort_weight" field.
+func SortWeightNotIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldSortWeight, vs...))
+}
+
+// SortWeightGT applies the GT predicate on the "sort_weight" field.
+func SortWeightGT(v int) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldSortWeight, v))
+}
+
+// SortWeightGTE applies the GTE predicate on the "sort_weight" field.
+func SortWeightGTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldSortWeight, v))
+}
+
+// SortWeightLT applies the LT predicate on the "sort_weight" field.
+func SortWeightLT(v int) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldSortWeight, v))
+}
+
+// SortWeightLTE applies the LTE predicate on the "sort_weight" field.
+func SortWeightLTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldSortWeight, v))
+}
+
+// InternshipCountEQ applies the EQ predicate on the "internship_count" field.
+func InternshipCountEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldInternshipCount, v))
+}
+
+// InternshipCountNEQ applies the NEQ predicate on the "internship_count" field.
+func InternshipCountNEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldInternshipCount, v))
+}
+
+// InternshipCountIn applies the In predicate on the "internship_count" field.
+func InternshipCountIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldInternshipCount, vs...))
+}
+
+// InternshipCountNotIn applies the NotIn predicate on the "internship_count" field.
+func InternshipCountNotIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldInternshipCount, vs...))
+}
+
+// InternshipCountGT applies the GT predicate on the "internship_count" field.
+func InternshipCountGT(v int) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldInternshipCount, v))
+}
+
+// InternshipCountGTE applies the GTE predicate on the "internship_count" field.
+func InternshipCountGTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldInternshipCount, v))
+}
+
+// InternshipCountLT applies the LT predicate on the "internship_count" field.
+func InternshipCountLT(v int) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldInternshipCount, v))
+}
+
+// InternshipCountLTE applies the LTE predicate on the "internship_count" field.
+func InternshipCountLTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldInternshipCount, v))
+}
+
+// TotalInternshipCountEQ applies the EQ predicate on the "total_internship_count" field.
+func TotalInternshipCountEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldTotalInternshipCount, v))
+}
+
+// TotalInternshipCountNEQ applies the NEQ predicate on the "total_internship_count" field.
+func TotalInternshipCountNEQ(v int) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldTotalInternshipCount, v))
+}
+
+// TotalInternshipCountIn applies the In predicate on the "total_internship_count" field.
+func TotalInternshipCountIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldTotalInternshipCount, vs...))
+}
+
+// TotalInternshipCountNotIn applies the NotIn predicate on the "total_internship_count" field.
+func TotalInternshipCountNotIn(vs ...int) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldTotalInternshipCount, vs...))
+}
+
+// TotalInternshipCountGT applies the GT predicate on the "total_internship_count" field.
+func TotalInternshipCountGT(v int) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldTotalInternshipCount, v))
+}
+
+// TotalInternshipCountGTE applies the GTE predicate on the "total_internship_count" field.
+func TotalInternshipCountGTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldTotalInternshipCount, v))
+}
+
+// TotalInternshipCountLT applies the LT predicate on the "total_internship_count" field.
+func TotalInternshipCountLT(v int) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldTotalInternshipCount, v))
+}
+
+// TotalInternshipCountLTE applies the LTE predicate on the "total_internship_count" field.
+func TotalInternshipCountLTE(v int) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldTotalInternshipCount, v))
+}
+
 // HasInternships applies the HasEdge predicate on the "internships" edge.
 func HasInternships() predicate.Category {
 	return predicate.Category(func(s *sql.Selector) {
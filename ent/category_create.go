@@ -123,6 +123,88 @@ func (cc *CategoryCreate) SetNillableDescription(s *string) *CategoryCreate {
 	return cc
 }
 
+// SetParentID sets the "parent_id" field.
+func (cc *CategoryCreate) SetParentID(s string) *CategoryCreate {
+	cc.mutation.SetParentID(s)
+	return cc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableParentID(s *string) *CategoryCreate {
+	if s != nil {
+		cc.SetParentID(*s)
+	}
+	return cc
+}
+
+// SetSlug sets the "slug" field.
+func (cc *CategoryCreate) SetSlug(s string) *CategoryCreate {
+	cc.mutation.SetSlug(s)
+	return cc
+}
+
+// SetPath sets the "path" field.
+func (cc *CategoryCreate) SetPath(s string) *CategoryCreate {
+	cc.mutation.SetPath(s)
+	return cc
+}
+
+// SetDepth sets the "depth" field.
+func (cc *CategoryCreate) SetDepth(i int) *CategoryCreate {
+	cc.mutation.SetDepth(i)
+	return cc
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableDepth(i *int) *CategoryCreate {
+	if i != nil {
+		cc.SetDepth(*i)
+	}
+	return cc
+}
+
+// SetSortWeight sets the "sort_weight" field.
+func (cc *CategoryCreate) SetSortWeight(i int) *CategoryCreate {
+	cc.mutation.SetSortWeight(i)
+	return cc
+}
+
+// SetNillableSortWeight sets the "sort_weight" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableSortWeight(i *int) *CategoryCreate {
+	if i != nil {
+		cc.SetSortWeight(*i)
+	}
+	return cc
+}
+
+// SetInternshipCount sets the "internship_count" field.
+func (cc *CategoryCreate) SetInternshipCount(i int) *CategoryCreate {
+	cc.mutation.SetInternshipCount(i)
+	return cc
+}
+
+// SetNillableInternshipCount sets the "internship_count" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableInternshipCount(i *int) *CategoryCreate {
+	if i != nil {
+		cc.SetInternshipCount(*i)
+	}
+	return cc
+}
+
+// SetTotalInternshipCount sets the "total_internship_count" field.
+func (cc *CategoryCreate) SetTotalInternshipCount(i int) *CategoryCreate {
+	cc.mutation.SetTotalInternshipCount(i)
+	return cc
+}
+
+// SetNillableTotalInternshipCount sets the "total_internship_count" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableTotalInternshipCount(i *int) *CategoryCreate {
+	if i != nil {
+		cc.SetTotalInternshipCount(*i)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CategoryCreate) SetID(s string) *CategoryCreate {
 	cc.mutation.SetID(s)
@@ -203,6 +285,22 @@ func (cc *CategoryCreate) defaults() {
 		v := category.DefaultMetadata
 		cc.mutation.SetMetadata(v)
 	}
+	if _, ok := cc.mutation.Depth(); !ok {
+		v := category.DefaultDepth
+		cc.mutation.SetDepth(v)
+	}
+	if _, ok := cc.mutation.SortWeight(); !ok {
+		v := category.DefaultSortWeight
+		cc.mutation.SetSortWeight(v)
+	}
+	if _, ok := cc.mutation.InternshipCount(); !ok {
+		v := category.DefaultInternshipCount
+		cc.mutation.SetInternshipCount(v)
+	}
+	if _, ok := cc.mutation.TotalInternshipCount(); !ok {
+		v := category.DefaultTotalInternshipCount
+		cc.mutation.SetTotalInternshipCount(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
 		v := category.DefaultID()
 		cc.mutation.SetID(v)
@@ -236,6 +334,49 @@ func (cc *CategoryCreate) check() error {
 			return &ValidationError{Name: "lookup_key", err: fmt.Errorf(`ent: validator failed for field "Category.lookup_key": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Category.slug"`)}
+	}
+	if v, ok := cc.mutation.Slug(); ok {
+		if err := category.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Category.slug": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Category.path"`)}
+	}
+	if v, ok := cc.mutation.Path(); ok {
+		if err := category.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Category.path": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Depth(); !ok {
+		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Category.depth"`)}
+	}
+	if v, ok := cc.mutation.Depth(); ok {
+		if err := category.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Category.depth": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.SortWeight(); !ok {
+		return &ValidationError{Name: "sort_weight", err: errors.New(`ent: missing required field "Category.sort_weight"`)}
+	}
+	if _, ok := cc.mutation.InternshipCount(); !ok {
+		return &ValidationError{Name: "internship_count", err: errors.New(`ent: missing required field "Category.internship_count"`)}
+	}
+	if v, ok := cc.mutation.InternshipCount(); ok {
+		if err := category.InternshipCountValidator(v); err != nil {
+			return &ValidationError{Name: "internship_count", err: fmt.Errorf(`ent: validator failed for field "Category.internship_count": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.TotalInternshipCount(); !ok {
+		return &ValidationError{Name: "total_internship_count", err: errors.New(`ent: missing required field "Category.total_internship_count"`)}
+	}
+	if v, ok := cc.mutation.TotalInternshipCount(); ok {
+		if err := category.TotalInternshipCountValidator(v); err != nil {
+			return &ValidationError{Name: "total_internship_count", err: fmt.Errorf(`ent: validator failed for field "Category.total_internship_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -307,6 +448,34 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := cc.mutation.ParentID(); ok {
+		_spec.SetField(category.FieldParentID, field.TypeString, value)
+		_node.ParentID = &value
+	}
+	if value, ok := cc.mutation.Slug(); ok {
+		_spec.SetField(category.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := cc.mutation.Path(); ok {
+		_spec.SetField(category.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := cc.mutation.Depth(); ok {
+		_spec.SetField(category.FieldDepth, field.TypeInt, value)
+		_node.Depth = value
+	}
+	if value, ok := cc.mutation.SortWeight(); ok {
+		_spec.SetField(category.FieldSortWeight, field.TypeInt, value)
+		_node.SortWeight = value
+	}
+	if value, ok := cc.mutation.InternshipCount(); ok {
+		_spec.SetField(category.FieldInternshipCount, field.TypeInt, value)
+		_node.InternshipCount = value
+	}
+	if value, ok := cc.mutation.TotalInternshipCount(); ok {
+		_spec.SetField(category.FieldTotalInternshipCount, field.TypeInt, value)
+		_node.TotalInternshipCount = value
+	}
 	if nodes := cc.mutation.InternshipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
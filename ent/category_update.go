@@ -129,6 +129,138 @@ func (cu *CategoryUpdate) ClearDescription() *CategoryUpdate {
 	return cu
 }
 
+// SetParentID sets the "parent_id" field.
+func (cu *CategoryUpdate) SetParentID(s string) *CategoryUpdate {
+	cu.mutation.SetParentID(s)
+	return cu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableParentID(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetParentID(*s)
+	}
+	return cu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cu *CategoryUpdate) ClearParentID() *CategoryUpdate {
+	cu.mutation.ClearParentID()
+	return cu
+}
+
+// SetSlug sets the "slug" field.
+func (cu *CategoryUpdate) SetSlug(s string) *CategoryUpdate {
+	cu.mutation.SetSlug(s)
+	return cu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableSlug(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetSlug(*s)
+	}
+	return cu
+}
+
+// SetPath sets the "path" field.
+func (cu *CategoryUpdate) SetPath(s string) *CategoryUpdate {
+	cu.mutation.SetPath(s)
+	return cu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillablePath(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetPath(*s)
+	}
+	return cu
+}
+
+// SetDepth sets the "depth" field.
+func (cu *CategoryUpdate) SetDepth(i int) *CategoryUpdate {
+	cu.mutation.ResetDepth()
+	cu.mutation.SetDepth(i)
+	return cu
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableDepth(i *int) *CategoryUpdate {
+	if i != nil {
+		cu.SetDepth(*i)
+	}
+	return cu
+}
+
+// AddDepth adds i to the "depth" field.
+func (cu *CategoryUpdate) AddDepth(i int) *CategoryUpdate {
+	cu.mutation.AddDepth(i)
+	return cu
+}
+
+// SetSortWeight sets the "sort_weight" field.
+func (cu *CategoryUpdate) SetSortWeight(i int) *CategoryUpdate {
+	cu.mutation.ResetSortWeight()
+	cu.mutation.SetSortWeight(i)
+	return cu
+}
+
+// SetNillableSortWeight sets the "sort_weight" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableSortWeight(i *int) *CategoryUpdate {
+	if i != nil {
+		cu.SetSortWeight(*i)
+	}
+	return cu
+}
+
+// AddSortWeight adds i to the "sort_weight" field.
+func (cu *CategoryUpdate) AddSortWeight(i int) *CategoryUpdate {
+	cu.mutation.AddSortWeight(i)
+	return cu
+}
+
+// SetInternshipCount sets the "internship_count" field.
+func (cu *CategoryUpdate) SetInternshipCount(i int) *CategoryUpdate {
+	cu.mutation.ResetInternshipCount()
+	cu.mutation.SetInternshipCount(i)
+	return cu
+}
+
+// SetNillableInternshipCount sets the "internship_count" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableInternshipCount(i *int) *CategoryUpdate {
+	if i != nil {
+		cu.SetInternshipCount(*i)
+	}
+	return cu
+}
+
+// AddInternshipCount adds i to the "internship_count" field.
+func (cu *CategoryUpdate) AddInternshipCount(i int) *CategoryUpdate {
+	cu.mutation.AddInternshipCount(i)
+	return cu
+}
+
+// SetTotalInternshipCount sets the "total_internship_count" field.
+func (cu *CategoryUpdate) SetTotalInternshipCount(i int) *CategoryUpdate {
+	cu.mutation.ResetTotalInternshipCount()
+	cu.mutation.SetTotalInternshipCount(i)
+	return cu
+}
+
+// SetNillableTotalInternshipCount sets the "total_internship_count" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableTotalInternshipCount(i *int) *CategoryUpdate {
+	if i != nil {
+		cu.SetTotalInternshipCount(*i)
+	}
+	return cu
+}
+
+// AddTotalInternshipCount adds i to the "total_internship_count" field.
+func (cu *CategoryUpdate) AddTotalInternshipCount(i int) *CategoryUpdate {
+	cu.mutation.AddTotalInternshipCount(i)
+	return cu
+}
+
 // AddInternshipIDs adds the "internships" edge to the Internship entity by IDs.
 func (cu *CategoryUpdate) AddInternshipIDs(ids ...string) *CategoryUpdate {
 	cu.mutation.AddInternshipIDs(ids...)
@@ -218,6 +350,31 @@ func (cu *CategoryUpdate) check() error {
 			return &ValidationError{Name: "lookup_key", err: fmt.Errorf(`ent: validator failed for field "Category.lookup_key": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Slug(); ok {
+		if err := category.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Category.slug": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Path(); ok {
+		if err := category.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Category.path": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Depth(); ok {
+		if err := category.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Category.depth": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.InternshipCount(); ok {
+		if err := category.InternshipCountValidator(v); err != nil {
+			return &ValidationError{Name: "internship_count", err: fmt.Errorf(`ent: validator failed for field "Category.internship_count": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.TotalInternshipCount(); ok {
+		if err := category.TotalInternshipCountValidator(v); err != nil {
+			return &ValidationError{Name: "total_internship_count", err: fmt.Errorf(`ent: validator failed for field "Category.total_internship_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -266,6 +423,42 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.DescriptionCleared() {
 		_spec.ClearField(category.FieldDescription, field.TypeString)
 	}
+	if value, ok := cu.mutation.ParentID(); ok {
+		_spec.SetField(category.FieldParentID, field.TypeString, value)
+	}
+	if cu.mutation.ParentIDCleared() {
+		_spec.ClearField(category.FieldParentID, field.TypeString)
+	}
+	if value, ok := cu.mutation.Slug(); ok {
+		_spec.SetField(category.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Path(); ok {
+		_spec.SetField(category.FieldPath, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Depth(); ok {
+		_spec.SetField(category.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedDepth(); ok {
+		_spec.AddField(category.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.SortWeight(); ok {
+		_spec.SetField(category.FieldSortWeight, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedSortWeight(); ok {
+		_spec.AddField(category.FieldSortWeight, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.InternshipCount(); ok {
+		_spec.SetField(category.FieldInternshipCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedInternshipCount(); ok {
+		_spec.AddField(category.FieldInternshipCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.TotalInternshipCount(); ok {
+		_spec.SetField(category.FieldTotalInternshipCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedTotalInternshipCount(); ok {
+		_spec.AddField(category.FieldTotalInternshipCount, field.TypeInt, value)
+	}
 	if cu.mutation.InternshipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -431,6 +624,138 @@ func (cuo *CategoryUpdateOne) ClearDescription() *CategoryUpdateOne {
 	return cuo
 }
 
+// SetParentID sets the "parent_id" field.
+func (cuo *CategoryUpdateOne) SetParentID(s string) *CategoryUpdateOne {
+	cuo.mutation.SetParentID(s)
+	return cuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableParentID(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetParentID(*s)
+	}
+	return cuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cuo *CategoryUpdateOne) ClearParentID() *CategoryUpdateOne {
+	cuo.mutation.ClearParentID()
+	return cuo
+}
+
+// SetSlug sets the "slug" field.
+func (cuo *CategoryUpdateOne) SetSlug(s string) *CategoryUpdateOne {
+	cuo.mutation.SetSlug(s)
+	return cuo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableSlug(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetSlug(*s)
+	}
+	return cuo
+}
+
+// SetPath sets the "path" field.
+func (cuo *CategoryUpdateOne) SetPath(s string) *CategoryUpdateOne {
+	cuo.mutation.SetPath(s)
+	return cuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillablePath(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetPath(*s)
+	}
+	return cuo
+}
+
+// SetDepth sets the "depth" field.
+func (cuo *CategoryUpdateOne) SetDepth(i int) *CategoryUpdateOne {
+	cuo.mutation.ResetDepth()
+	cuo.mutation.SetDepth(i)
+	return cuo
+}
+
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableDepth(i *int) *CategoryUpdateOne {
+	if i != nil {
+		cuo.SetDepth(*i)
+	}
+	return cuo
+}
+
+// AddDepth adds i to the "depth" field.
+func (cuo *CategoryUpdateOne) AddDepth(i int) *CategoryUpdateOne {
+	cuo.mutation.AddDepth(i)
+	return cuo
+}
+
+// SetSortWeight sets the "sort_weight" field.
+func (cuo *CategoryUpdateOne) SetSortWeight(i int) *CategoryUpdateOne {
+	cuo.mutation.ResetSortWeight()
+	cuo.mutation.SetSortWeight(i)
+	return cuo
+}
+
+// SetNillableSortWeight sets the "sort_weight" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableSortWeight(i *int) *CategoryUpdateOne {
+	if i != nil {
+		cuo.SetSortWeight(*i)
+	}
+	return cuo
+}
+
+// AddSortWeight adds i to the "sort_weight" field.
+func (cuo *CategoryUpdateOne) AddSortWeight(i int) *CategoryUpdateOne {
+	cuo.mutation.AddSortWeight(i)
+	return cuo
+}
+
+// SetInternshipCount sets the "internship_count" field.
+func (cuo *CategoryUpdateOne) SetInternshipCount(i int) *CategoryUpdateOne {
+	cuo.mutation.ResetInternshipCount()
+	cuo.mutation.SetInternshipCount(i)
+	return cuo
+}
+
+// SetNillableInternshipCount sets the "internship_count" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableInternshipCount(i *int) *CategoryUpdateOne {
+	if i != nil {
+		cuo.SetInternshipCount(*i)
+	}
+	return cuo
+}
+
+// AddInternshipCount adds i to the "internship_count" field.
+func (cuo *CategoryUpdateOne) AddInternshipCount(i int) *CategoryUpdateOne {
+	cuo.mutation.AddInternshipCount(i)
+	return cuo
+}
+
+// SetTotalInternshipCount sets the "total_internship_count" field.
+func (cuo *CategoryUpdateOne) SetTotalInternshipCount(i int) *CategoryUpdateOne {
+	cuo.mutation.ResetTotalInternshipCount()
+	cuo.mutation.SetTotalInternshipCount(i)
+	return cuo
+}
+
+// SetNillableTotalInternshipCount sets the "total_internship_count" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableTotalInternshipCount(i *int) *CategoryUpdateOne {
+	if i != nil {
+		cuo.SetTotalInternshipCount(*i)
+	}
+	return cuo
+}
+
+// AddTotalInternshipCount adds i to the "total_internship_count" field.
+func (cuo *CategoryUpdateOne) AddTotalInternshipCount(i int) *CategoryUpdateOne {
+	cuo.mutation.AddTotalInternshipCount(i)
+	return cuo
+}
+
 // AddInternshipIDs adds the "internships" edge to the Internship entity by IDs.
 func (cuo *CategoryUpdateOne) AddInternshipIDs(ids ...string) *CategoryUpdateOne {
 	cuo.mutation.AddInternshipIDs(ids...)
@@ -533,6 +858,31 @@ func (cuo *CategoryUpdateOne) check() error {
 			return &ValidationError{Name: "lookup_key", err: fmt.Errorf(`ent: validator failed for field "Category.lookup_key": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Slug(); ok {
+		if err := category.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Category.slug": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Path(); ok {
+		if err := category.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Category.path": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Depth(); ok {
+		if err := category.DepthValidator(v); err != nil {
+			return &ValidationError{Name: "depth", err: fmt.Errorf(`ent: validator failed for field "Category.depth": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.InternshipCount(); ok {
+		if err := category.InternshipCountValidator(v); err != nil {
+			return &ValidationError{Name: "internship_count", err: fmt.Errorf(`ent: validator failed for field "Category.internship_count": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.TotalInternshipCount(); ok {
+		if err := category.TotalInternshipCountValidator(v); err != nil {
+			return &ValidationError{Name: "total_internship_count", err: fmt.Errorf(`ent: validator failed for field "Category.total_internship_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -598,6 +948,42 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if cuo.mutation.DescriptionCleared() {
 		_spec.ClearField(category.FieldDescription, field.TypeString)
 	}
+	if value, ok := cuo.mutation.ParentID(); ok {
+		_spec.SetField(category.FieldParentID, field.TypeString, value)
+	}
+	if cuo.mutation.ParentIDCleared() {
+		_spec.ClearField(category.FieldParentID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Slug(); ok {
+		_spec.SetField(category.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Path(); ok {
+		_spec.SetField(category.FieldPath, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Depth(); ok {
+		_spec.SetField(category.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedDepth(); ok {
+		_spec.AddField(category.FieldDepth, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.SortWeight(); ok {
+		_spec.SetField(category.FieldSortWeight, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedSortWeight(); ok {
+		_spec.AddField(category.FieldSortWeight, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.InternshipCount(); ok {
+		_spec.SetField(category.FieldInternshipCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedInternshipCount(); ok {
+		_spec.AddField(category.FieldInternshipCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.TotalInternshipCount(); ok {
+		_spec.SetField(category.FieldTotalInternshipCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedTotalInternshipCount(); ok {
+		_spec.AddField(category.FieldTotalInternshipCount, field.TypeInt, value)
+	}
 	if cuo.mutation.InternshipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/certificate"
+)
+
+// Certificate is the model entity for the Certificate schema.
+type Certificate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// VerificationID holds the value of the "verification_id" field.
+	VerificationID string `json:"verification_id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID string `json:"enrollment_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// InternshipBatchID holds the value of the "internship_batch_id" field.
+	InternshipBatchID string `json:"internship_batch_id,omitempty"`
+	// RecipientName holds the value of the "recipient_name" field.
+	RecipientName string `json:"recipient_name,omitempty"`
+	// InternshipTitle holds the value of the "internship_title" field.
+	InternshipTitle string `json:"internship_title,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt time.Time `json:"issued_at,omitempty"`
+	// Signature holds the value of the "signature" field.
+	Signature string `json:"signature,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID *string `json:"file_id,omitempty"`
+	// CertificateStatus holds the value of the "certificate_status" field.
+	CertificateStatus string `json:"certificate_status,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// RevocationReason holds the value of the "revocation_reason" field.
+	RevocationReason *string `json:"revocation_reason,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Certificate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certificate.FieldID, certificate.FieldStatus, certificate.FieldCreatedBy, certificate.FieldUpdatedBy, certificate.FieldVerificationID, certificate.FieldEnrollmentID, certificate.FieldUserID, certificate.FieldInternshipID, certificate.FieldInternshipBatchID, certificate.FieldRecipientName, certificate.FieldInternshipTitle, certificate.FieldSignature, certificate.FieldFileID, certificate.FieldCertificateStatus, certificate.FieldRevocationReason:
+			values[i] = new(sql.NullString)
+		case certificate.FieldCreatedAt, certificate.FieldUpdatedAt, certificate.FieldCompletedAt, certificate.FieldIssuedAt, certificate.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Certificate fields.
+func (c *Certificate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certificate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				c.ID = value.String
+			}
+		case certificate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = value.String
+			}
+		case certificate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case certificate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case certificate.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				c.CreatedBy = value.String
+			}
+		case certificate.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				c.UpdatedBy = value.String
+			}
+		case certificate.FieldVerificationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_id", values[i])
+			} else if value.Valid {
+				c.VerificationID = value.String
+			}
+		case certificate.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				c.EnrollmentID = value.String
+			}
+		case certificate.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				c.UserID = value.String
+			}
+		case certificate.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				c.InternshipID = value.String
+			}
+		case certificate.FieldInternshipBatchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_batch_id", values[i])
+			} else if value.Valid {
+				c.InternshipBatchID = value.String
+			}
+		case certificate.FieldRecipientName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_name", values[i])
+			} else if value.Valid {
+				c.RecipientName = value.String
+			}
+		case certificate.FieldInternshipTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_title", values[i])
+			} else if value.Valid {
+				c.InternshipTitle = value.String
+			}
+		case certificate.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				c.CompletedAt = value.Time
+			}
+		case certificate.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				c.IssuedAt = value.Time
+			}
+		case certificate.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				c.Signature = value.String
+			}
+		case certificate.FieldFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				c.FileID = new(string)
+				*c.FileID = value.String
+			}
+		case certificate.FieldCertificateStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_status", values[i])
+			} else if value.Valid {
+				c.CertificateStatus = value.String
+			}
+		case certificate.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				c.RevokedAt = new(time.Time)
+				*c.RevokedAt = value.Time
+			}
+		case certificate.FieldRevocationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revocation_reason", values[i])
+			} else if value.Valid {
+				c.RevocationReason = new(string)
+				*c.RevocationReason = value.String
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Certificate.
+// This includes values selected through modifiers, order, etc.
+func (c *Certificate) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Certificate.
+// Note that you need to call Certificate.Unwrap() before calling this method if this Certificate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Certificate) Update() *CertificateUpdateOne {
+	return NewCertificateClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Certificate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Certificate) Unwrap() *Certificate {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Certificate is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Certificate) String() string {
+	var builder strings.Builder
+	builder.WriteString("Certificate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("status=")
+	builder.WriteString(c.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(c.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(c.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("verification_id=")
+	builder.WriteString(c.VerificationID)
+	builder.WriteString(", ")
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(c.EnrollmentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(c.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(c.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_batch_id=")
+	builder.WriteString(c.InternshipBatchID)
+	builder.WriteString(", ")
+	builder.WriteString("recipient_name=")
+	builder.WriteString(c.RecipientName)
+	builder.WriteString(", ")
+	builder.WriteString("internship_title=")
+	builder.WriteString(c.InternshipTitle)
+	builder.WriteString(", ")
+	builder.WriteString("completed_at=")
+	builder.WriteString(c.CompletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(c.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(c.Signature)
+	builder.WriteString(", ")
+	if v := c.FileID; v != nil {
+		builder.WriteString("file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("certificate_status=")
+	builder.WriteString(c.CertificateStatus)
+	builder.WriteString(", ")
+	if v := c.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.RevocationReason; v != nil {
+		builder.WriteString("revocation_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Certificates is a parsable slice of Certificate.
+type Certificates []*Certificate
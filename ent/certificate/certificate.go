@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the certificate type in the database.
+	Label = "certificate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldVerificationID holds the string denoting the verification_id field in the database.
+	FieldVerificationID = "verification_id"
+	// FieldEnrollmentID holds the string denoting the enrollment_id field in the database.
+	FieldEnrollmentID = "enrollment_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldInternshipID holds the string denoting the internship_id field in the database.
+	FieldInternshipID = "internship_id"
+	// FieldInternshipBatchID holds the string denoting the internship_batch_id field in the database.
+	FieldInternshipBatchID = "internship_batch_id"
+	// FieldRecipientName holds the string denoting the recipient_name field in the database.
+	FieldRecipientName = "recipient_name"
+	// FieldInternshipTitle holds the string denoting the internship_title field in the database.
+	FieldInternshipTitle = "internship_title"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldSignature holds the string denoting the signature field in the database.
+	FieldSignature = "signature"
+	// FieldFileID holds the string denoting the file_id field in the database.
+	FieldFileID = "file_id"
+	// FieldCertificateStatus holds the string denoting the certificate_status field in the database.
+	FieldCertificateStatus = "certificate_status"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldRevocationReason holds the string denoting the revocation_reason field in the database.
+	FieldRevocationReason = "revocation_reason"
+	// Table holds the table name of the certificate in the database.
+	Table = "certificates"
+)
+
+// Columns holds all SQL columns for certificate fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldVerificationID,
+	FieldEnrollmentID,
+	FieldUserID,
+	FieldInternshipID,
+	FieldInternshipBatchID,
+	FieldRecipientName,
+	FieldInternshipTitle,
+	FieldCompletedAt,
+	FieldIssuedAt,
+	FieldSignature,
+	FieldFileID,
+	FieldCertificateStatus,
+	FieldRevokedAt,
+	FieldRevocationReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// VerificationIDValidator is a validator for the "verification_id" field. It is called by the builders before save.
+	VerificationIDValidator func(string) error
+	// EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	EnrollmentIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	InternshipIDValidator func(string) error
+	// InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	InternshipBatchIDValidator func(string) error
+	// RecipientNameValidator is a validator for the "recipient_name" field. It is called by the builders before save.
+	RecipientNameValidator func(string) error
+	// InternshipTitleValidator is a validator for the "internship_title" field. It is called by the builders before save.
+	InternshipTitleValidator func(string) error
+	// SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	SignatureValidator func(string) error
+	// DefaultCertificateStatus holds the default value on creation for the "certificate_status" field.
+	DefaultCertificateStatus string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Certificate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByVerificationID orders the results by the verification_id field.
+func ByVerificationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationID, opts...).ToFunc()
+}
+
+// ByEnrollmentID orders the results by the enrollment_id field.
+func ByEnrollmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrollmentID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByInternshipID orders the results by the internship_id field.
+func ByInternshipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipID, opts...).ToFunc()
+}
+
+// ByInternshipBatchID orders the results by the internship_batch_id field.
+func ByInternshipBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipBatchID, opts...).ToFunc()
+}
+
+// ByRecipientName orders the results by the recipient_name field.
+func ByRecipientName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecipientName, opts...).ToFunc()
+}
+
+// ByInternshipTitle orders the results by the internship_title field.
+func ByInternshipTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipTitle, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByIssuedAt orders the results by the issued_at field.
+func ByIssuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuedAt, opts...).ToFunc()
+}
+
+// BySignature orders the results by the signature field.
+func BySignature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignature, opts...).ToFunc()
+}
+
+// ByFileID orders the results by the file_id field.
+func ByFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileID, opts...).ToFunc()
+}
+
+// ByCertificateStatus orders the results by the certificate_status field.
+func ByCertificateStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateStatus, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
+// ByRevocationReason orders the results by the revocation_reason field.
+func ByRevocationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevocationReason, opts...).ToFunc()
+}
@@ -0,0 +1,1335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certificate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// VerificationID applies equality check predicate on the "verification_id" field. It's identical to VerificationIDEQ.
+func VerificationID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldVerificationID, v))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUserID, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipBatchID applies equality check predicate on the "internship_batch_id" field. It's identical to InternshipBatchIDEQ.
+func InternshipBatchID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// RecipientName applies equality check predicate on the "recipient_name" field. It's identical to RecipientNameEQ.
+func RecipientName(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRecipientName, v))
+}
+
+// InternshipTitle applies equality check predicate on the "internship_title" field. It's identical to InternshipTitleEQ.
+func InternshipTitle(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldInternshipTitle, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// Signature applies equality check predicate on the "signature" field. It's identical to SignatureEQ.
+func Signature(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSignature, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldFileID, v))
+}
+
+// CertificateStatus applies equality check predicate on the "certificate_status" field. It's identical to CertificateStatusEQ.
+func CertificateStatus(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCertificateStatus, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevocationReason applies equality check predicate on the "revocation_reason" field. It's identical to RevocationReasonEQ.
+func RevocationReason(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRevocationReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// VerificationIDEQ applies the EQ predicate on the "verification_id" field.
+func VerificationIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldVerificationID, v))
+}
+
+// VerificationIDNEQ applies the NEQ predicate on the "verification_id" field.
+func VerificationIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldVerificationID, v))
+}
+
+// VerificationIDIn applies the In predicate on the "verification_id" field.
+func VerificationIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldVerificationID, vs...))
+}
+
+// VerificationIDNotIn applies the NotIn predicate on the "verification_id" field.
+func VerificationIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldVerificationID, vs...))
+}
+
+// VerificationIDGT applies the GT predicate on the "verification_id" field.
+func VerificationIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldVerificationID, v))
+}
+
+// VerificationIDGTE applies the GTE predicate on the "verification_id" field.
+func VerificationIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldVerificationID, v))
+}
+
+// VerificationIDLT applies the LT predicate on the "verification_id" field.
+func VerificationIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldVerificationID, v))
+}
+
+// VerificationIDLTE applies the LTE predicate on the "verification_id" field.
+func VerificationIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldVerificationID, v))
+}
+
+// VerificationIDContains applies the Contains predicate on the "verification_id" field.
+func VerificationIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldVerificationID, v))
+}
+
+// VerificationIDHasPrefix applies the HasPrefix predicate on the "verification_id" field.
+func VerificationIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldVerificationID, v))
+}
+
+// VerificationIDHasSuffix applies the HasSuffix predicate on the "verification_id" field.
+func VerificationIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldVerificationID, v))
+}
+
+// VerificationIDEqualFold applies the EqualFold predicate on the "verification_id" field.
+func VerificationIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldVerificationID, v))
+}
+
+// VerificationIDContainsFold applies the ContainsFold predicate on the "verification_id" field.
+func VerificationIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldVerificationID, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDGT applies the GT predicate on the "enrollment_id" field.
+func EnrollmentIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDGTE applies the GTE predicate on the "enrollment_id" field.
+func EnrollmentIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLT applies the LT predicate on the "enrollment_id" field.
+func EnrollmentIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLTE applies the LTE predicate on the "enrollment_id" field.
+func EnrollmentIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContains applies the Contains predicate on the "enrollment_id" field.
+func EnrollmentIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasPrefix applies the HasPrefix predicate on the "enrollment_id" field.
+func EnrollmentIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasSuffix applies the HasSuffix predicate on the "enrollment_id" field.
+func EnrollmentIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDEqualFold applies the EqualFold predicate on the "enrollment_id" field.
+func EnrollmentIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContainsFold applies the ContainsFold predicate on the "enrollment_id" field.
+func EnrollmentIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldEnrollmentID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// InternshipBatchIDEQ applies the EQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDNEQ applies the NEQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDIn applies the In predicate on the "internship_batch_id" field.
+func InternshipBatchIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDNotIn applies the NotIn predicate on the "internship_batch_id" field.
+func InternshipBatchIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDGT applies the GT predicate on the "internship_batch_id" field.
+func InternshipBatchIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDGTE applies the GTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLT applies the LT predicate on the "internship_batch_id" field.
+func InternshipBatchIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLTE applies the LTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContains applies the Contains predicate on the "internship_batch_id" field.
+func InternshipBatchIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasPrefix applies the HasPrefix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasSuffix applies the HasSuffix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDEqualFold applies the EqualFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContainsFold applies the ContainsFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldInternshipBatchID, v))
+}
+
+// RecipientNameEQ applies the EQ predicate on the "recipient_name" field.
+func RecipientNameEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRecipientName, v))
+}
+
+// RecipientNameNEQ applies the NEQ predicate on the "recipient_name" field.
+func RecipientNameNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldRecipientName, v))
+}
+
+// RecipientNameIn applies the In predicate on the "recipient_name" field.
+func RecipientNameIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldRecipientName, vs...))
+}
+
+// RecipientNameNotIn applies the NotIn predicate on the "recipient_name" field.
+func RecipientNameNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldRecipientName, vs...))
+}
+
+// RecipientNameGT applies the GT predicate on the "recipient_name" field.
+func RecipientNameGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldRecipientName, v))
+}
+
+// RecipientNameGTE applies the GTE predicate on the "recipient_name" field.
+func RecipientNameGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldRecipientName, v))
+}
+
+// RecipientNameLT applies the LT predicate on the "recipient_name" field.
+func RecipientNameLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldRecipientName, v))
+}
+
+// RecipientNameLTE applies the LTE predicate on the "recipient_name" field.
+func RecipientNameLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldRecipientName, v))
+}
+
+// RecipientNameContains applies the Contains predicate on the "recipient_name" field.
+func RecipientNameContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldRecipientName, v))
+}
+
+// RecipientNameHasPrefix applies the HasPrefix predicate on the "recipient_name" field.
+func RecipientNameHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldRecipientName, v))
+}
+
+// RecipientNameHasSuffix applies the HasSuffix predicate on the "recipient_name" field.
+func RecipientNameHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldRecipientName, v))
+}
+
+// RecipientNameEqualFold applies the EqualFold predicate on the "recipient_name" field.
+func RecipientNameEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldRecipientName, v))
+}
+
+// RecipientNameContainsFold applies the ContainsFold predicate on the "recipient_name" field.
+func RecipientNameContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldRecipientName, v))
+}
+
+// InternshipTitleEQ applies the EQ predicate on the "internship_title" field.
+func InternshipTitleEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldInternshipTitle, v))
+}
+
+// InternshipTitleNEQ applies the NEQ predicate on the "internship_title" field.
+func InternshipTitleNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldInternshipTitle, v))
+}
+
+// InternshipTitleIn applies the In predicate on the "internship_title" field.
+func InternshipTitleIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldInternshipTitle, vs...))
+}
+
+// InternshipTitleNotIn applies the NotIn predicate on the "internship_title" field.
+func InternshipTitleNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldInternshipTitle, vs...))
+}
+
+// InternshipTitleGT applies the GT predicate on the "internship_title" field.
+func InternshipTitleGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldInternshipTitle, v))
+}
+
+// InternshipTitleGTE applies the GTE predicate on the "internship_title" field.
+func InternshipTitleGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldInternshipTitle, v))
+}
+
+// InternshipTitleLT applies the LT predicate on the "internship_title" field.
+func InternshipTitleLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldInternshipTitle, v))
+}
+
+// InternshipTitleLTE applies the LTE predicate on the "internship_title" field.
+func InternshipTitleLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldInternshipTitle, v))
+}
+
+// InternshipTitleContains applies the Contains predicate on the "internship_title" field.
+func InternshipTitleContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldInternshipTitle, v))
+}
+
+// InternshipTitleHasPrefix applies the HasPrefix predicate on the "internship_title" field.
+func InternshipTitleHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldInternshipTitle, v))
+}
+
+// InternshipTitleHasSuffix applies the HasSuffix predicate on the "internship_title" field.
+func InternshipTitleHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldInternshipTitle, v))
+}
+
+// InternshipTitleEqualFold applies the EqualFold predicate on the "internship_title" field.
+func InternshipTitleEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldInternshipTitle, v))
+}
+
+// InternshipTitleContainsFold applies the ContainsFold predicate on the "internship_title" field.
+func InternshipTitleContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldInternshipTitle, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldIssuedAt, v))
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldIssuedAt, v))
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldIssuedAt, v))
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldIssuedAt, v))
+}
+
+// SignatureEQ applies the EQ predicate on the "signature" field.
+func SignatureEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSignature, v))
+}
+
+// SignatureNEQ applies the NEQ predicate on the "signature" field.
+func SignatureNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSignature, v))
+}
+
+// SignatureIn applies the In predicate on the "signature" field.
+func SignatureIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSignature, vs...))
+}
+
+// SignatureNotIn applies the NotIn predicate on the "signature" field.
+func SignatureNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSignature, vs...))
+}
+
+// SignatureGT applies the GT predicate on the "signature" field.
+func SignatureGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSignature, v))
+}
+
+// SignatureGTE applies the GTE predicate on the "signature" field.
+func SignatureGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSignature, v))
+}
+
+// SignatureLT applies the LT predicate on the "signature" field.
+func SignatureLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSignature, v))
+}
+
+// SignatureLTE applies the LTE predicate on the "signature" field.
+func SignatureLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSignature, v))
+}
+
+// SignatureContains applies the Contains predicate on the "signature" field.
+func SignatureContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSignature, v))
+}
+
+// SignatureHasPrefix applies the HasPrefix predicate on the "signature" field.
+func SignatureHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSignature, v))
+}
+
+// SignatureHasSuffix applies the HasSuffix predicate on the "signature" field.
+func SignatureHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSignature, v))
+}
+
+// SignatureEqualFold applies the EqualFold predicate on the "signature" field.
+func SignatureEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSignature, v))
+}
+
+// SignatureContainsFold applies the ContainsFold predicate on the "signature" field.
+func SignatureContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSignature, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldFileID, v))
+}
+
+// FileIDContains applies the Contains predicate on the "file_id" field.
+func FileIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldFileID, v))
+}
+
+// FileIDHasPrefix applies the HasPrefix predicate on the "file_id" field.
+func FileIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldFileID, v))
+}
+
+// FileIDHasSuffix applies the HasSuffix predicate on the "file_id" field.
+func FileIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldFileID, v))
+}
+
+// FileIDIsNil applies the IsNil predicate on the "file_id" field.
+func FileIDIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldFileID))
+}
+
+// FileIDNotNil applies the NotNil predicate on the "file_id" field.
+func FileIDNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldFileID))
+}
+
+// FileIDEqualFold applies the EqualFold predicate on the "file_id" field.
+func FileIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldFileID, v))
+}
+
+// FileIDContainsFold applies the ContainsFold predicate on the "file_id" field.
+func FileIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldFileID, v))
+}
+
+// CertificateStatusEQ applies the EQ predicate on the "certificate_status" field.
+func CertificateStatusEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCertificateStatus, v))
+}
+
+// CertificateStatusNEQ applies the NEQ predicate on the "certificate_status" field.
+func CertificateStatusNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCertificateStatus, v))
+}
+
+// CertificateStatusIn applies the In predicate on the "certificate_status" field.
+func CertificateStatusIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCertificateStatus, vs...))
+}
+
+// CertificateStatusNotIn applies the NotIn predicate on the "certificate_status" field.
+func CertificateStatusNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCertificateStatus, vs...))
+}
+
+// CertificateStatusGT applies the GT predicate on the "certificate_status" field.
+func CertificateStatusGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCertificateStatus, v))
+}
+
+// CertificateStatusGTE applies the GTE predicate on the "certificate_status" field.
+func CertificateStatusGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCertificateStatus, v))
+}
+
+// CertificateStatusLT applies the LT predicate on the "certificate_status" field.
+func CertificateStatusLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCertificateStatus, v))
+}
+
+// CertificateStatusLTE applies the LTE predicate on the "certificate_status" field.
+func CertificateStatusLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCertificateStatus, v))
+}
+
+// CertificateStatusContains applies the Contains predicate on the "certificate_status" field.
+func CertificateStatusContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldCertificateStatus, v))
+}
+
+// CertificateStatusHasPrefix applies the HasPrefix predicate on the "certificate_status" field.
+func CertificateStatusHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldCertificateStatus, v))
+}
+
+// CertificateStatusHasSuffix applies the HasSuffix predicate on the "certificate_status" field.
+func CertificateStatusHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldCertificateStatus, v))
+}
+
+// CertificateStatusEqualFold applies the EqualFold predicate on the "certificate_status" field.
+func CertificateStatusEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldCertificateStatus, v))
+}
+
+// CertificateStatusContainsFold applies the ContainsFold predicate on the "certificate_status" field.
+func CertificateStatusContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldCertificateStatus, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RevocationReasonEQ applies the EQ predicate on the "revocation_reason" field.
+func RevocationReasonEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldRevocationReason, v))
+}
+
+// RevocationReasonNEQ applies the NEQ predicate on the "revocation_reason" field.
+func RevocationReasonNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldRevocationReason, v))
+}
+
+// RevocationReasonIn applies the In predicate on the "revocation_reason" field.
+func RevocationReasonIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldRevocationReason, vs...))
+}
+
+// RevocationReasonNotIn applies the NotIn predicate on the "revocation_reason" field.
+func RevocationReasonNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldRevocationReason, vs...))
+}
+
+// RevocationReasonGT applies the GT predicate on the "revocation_reason" field.
+func RevocationReasonGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldRevocationReason, v))
+}
+
+// RevocationReasonGTE applies the GTE predicate on the "revocation_reason" field.
+func RevocationReasonGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldRevocationReason, v))
+}
+
+// RevocationReasonLT applies the LT predicate on the "revocation_reason" field.
+func RevocationReasonLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldRevocationReason, v))
+}
+
+// RevocationReasonLTE applies the LTE predicate on the "revocation_reason" field.
+func RevocationReasonLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldRevocationReason, v))
+}
+
+// RevocationReasonContains applies the Contains predicate on the "revocation_reason" field.
+func RevocationReasonContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldRevocationReason, v))
+}
+
+// RevocationReasonHasPrefix applies the HasPrefix predicate on the "revocation_reason" field.
+func RevocationReasonHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldRevocationReason, v))
+}
+
+// RevocationReasonHasSuffix applies the HasSuffix predicate on the "revocation_reason" field.
+func RevocationReasonHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldRevocationReason, v))
+}
+
+// RevocationReasonIsNil applies the IsNil predicate on the "revocation_reason" field.
+func RevocationReasonIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldRevocationReason))
+}
+
+// RevocationReasonNotNil applies the NotNil predicate on the "revocation_reason" field.
+func RevocationReasonNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldRevocationReason))
+}
+
+// RevocationReasonEqualFold applies the EqualFold predicate on the "revocation_reason" field.
+func RevocationReasonEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldRevocationReason, v))
+}
+
+// RevocationReasonContainsFold applies the ContainsFold predicate on the "revocation_reason" field.
+func RevocationReasonContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldRevocationReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Certificate) predicate.Certificate {
+	return predicate.Certificate(sql.NotPredicates(p))
+}
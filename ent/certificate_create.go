@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/certificate"
+)
+
+// CertificateCreate is the builder for creating a Certificate entity.
+type CertificateCreate struct {
+	config
+	mutation *CertificateMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (cc *CertificateCreate) SetStatus(s string) *CertificateCreate {
+	cc.mutation.SetStatus(s)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableStatus(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetStatus(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CertificateCreate) SetCreatedAt(t time.Time) *CertificateCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableCreatedAt(t *time.Time) *CertificateCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CertificateCreate) SetUpdatedAt(t time.Time) *CertificateCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableUpdatedAt(t *time.Time) *CertificateCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cc *CertificateCreate) SetCreatedBy(s string) *CertificateCreate {
+	cc.mutation.SetCreatedBy(s)
+	return cc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableCreatedBy(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetCreatedBy(*s)
+	}
+	return cc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cc *CertificateCreate) SetUpdatedBy(s string) *CertificateCreate {
+	cc.mutation.SetUpdatedBy(s)
+	return cc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableUpdatedBy(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetUpdatedBy(*s)
+	}
+	return cc
+}
+
+// SetVerificationID sets the "verification_id" field.
+func (cc *CertificateCreate) SetVerificationID(s string) *CertificateCreate {
+	cc.mutation.SetVerificationID(s)
+	return cc
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (cc *CertificateCreate) SetEnrollmentID(s string) *CertificateCreate {
+	cc.mutation.SetEnrollmentID(s)
+	return cc
+}
+
+// SetUserID sets the "user_id" field.
+func (cc *CertificateCreate) SetUserID(s string) *CertificateCreate {
+	cc.mutation.SetUserID(s)
+	return cc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (cc *CertificateCreate) SetInternshipID(s string) *CertificateCreate {
+	cc.mutation.SetInternshipID(s)
+	return cc
+}
+
+// SetInternshipBatchID sets the "internship_batch_id" field.
+func (cc *CertificateCreate) SetInternshipBatchID(s string) *CertificateCreate {
+	cc.mutation.SetInternshipBatchID(s)
+	return cc
+}
+
+// SetRecipientName sets the "recipient_name" field.
+func (cc *CertificateCreate) SetRecipientName(s string) *CertificateCreate {
+	cc.mutation.SetRecipientName(s)
+	return cc
+}
+
+// SetInternshipTitle sets the "internship_title" field.
+func (cc *CertificateCreate) SetInternshipTitle(s string) *CertificateCreate {
+	cc.mutation.SetInternshipTitle(s)
+	return cc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (cc *CertificateCreate) SetCompletedAt(t time.Time) *CertificateCreate {
+	cc.mutation.SetCompletedAt(t)
+	return cc
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (cc *CertificateCreate) SetIssuedAt(t time.Time) *CertificateCreate {
+	cc.mutation.SetIssuedAt(t)
+	return cc
+}
+
+// SetSignature sets the "signature" field.
+func (cc *CertificateCreate) SetSignature(s string) *CertificateCreate {
+	cc.mutation.SetSignature(s)
+	return cc
+}
+
+// SetFileID sets the "file_id" field.
+func (cc *CertificateCreate) SetFileID(s string) *CertificateCreate {
+	cc.mutation.SetFileID(s)
+	return cc
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableFileID(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetFileID(*s)
+	}
+	return cc
+}
+
+// SetCertificateStatus sets the "certificate_status" field.
+func (cc *CertificateCreate) SetCertificateStatus(s string) *CertificateCreate {
+	cc.mutation.SetCertificateStatus(s)
+	return cc
+}
+
+// SetNillableCertificateStatus sets the "certificate_status" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableCertificateStatus(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetCertificateStatus(*s)
+	}
+	return cc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cc *CertificateCreate) SetRevokedAt(t time.Time) *CertificateCreate {
+	cc.mutation.SetRevokedAt(t)
+	return cc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableRevokedAt(t *time.Time) *CertificateCreate {
+	if t != nil {
+		cc.SetRevokedAt(*t)
+	}
+	return cc
+}
+
+// SetRevocationReason sets the "revocation_reason" field.
+func (cc *CertificateCreate) SetRevocationReason(s string) *CertificateCreate {
+	cc.mutation.SetRevocationReason(s)
+	return cc
+}
+
+// SetNillableRevocationReason sets the "revocation_reason" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableRevocationReason(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetRevocationReason(*s)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CertificateCreate) SetID(s string) *CertificateCreate {
+	cc.mutation.SetID(s)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableID(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetID(*s)
+	}
+	return cc
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (cc *CertificateCreate) Mutation() *CertificateMutation {
+	return cc.mutation
+}
+
+// Save creates the Certificate in the database.
+func (cc *CertificateCreate) Save(ctx context.Context) (*Certificate, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CertificateCreate) SaveX(ctx context.Context) *Certificate {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CertificateCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CertificateCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CertificateCreate) defaults() {
+	if _, ok := cc.mutation.Status(); !ok {
+		v := certificate.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := certificate.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := certificate.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.CertificateStatus(); !ok {
+		v := certificate.DefaultCertificateStatus
+		cc.mutation.SetCertificateStatus(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := certificate.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CertificateCreate) check() error {
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Certificate.status"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Certificate.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Certificate.updated_at"`)}
+	}
+	if _, ok := cc.mutation.VerificationID(); !ok {
+		return &ValidationError{Name: "verification_id", err: errors.New(`ent: missing required field "Certificate.verification_id"`)}
+	}
+	if v, ok := cc.mutation.VerificationID(); ok {
+		if err := certificate.VerificationIDValidator(v); err != nil {
+			return &ValidationError{Name: "verification_id", err: fmt.Errorf(`ent: validator failed for field "Certificate.verification_id": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "Certificate.enrollment_id"`)}
+	}
+	if v, ok := cc.mutation.EnrollmentID(); ok {
+		if err := certificate.EnrollmentIDValidator(v); err != nil {
+			return &ValidationError{Name: "enrollment_id", err: fmt.Errorf(`ent: validator failed for field "Certificate.enrollment_id": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Certificate.user_id"`)}
+	}
+	if v, ok := cc.mutation.UserID(); ok {
+		if err := certificate.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Certificate.user_id": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Certificate.internship_id"`)}
+	}
+	if v, ok := cc.mutation.InternshipID(); ok {
+		if err := certificate.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Certificate.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.InternshipBatchID(); !ok {
+		return &ValidationError{Name: "internship_batch_id", err: errors.New(`ent: missing required field "Certificate.internship_batch_id"`)}
+	}
+	if v, ok := cc.mutation.InternshipBatchID(); ok {
+		if err := certificate.InternshipBatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_batch_id", err: fmt.Errorf(`ent: validator failed for field "Certificate.internship_batch_id": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.RecipientName(); !ok {
+		return &ValidationError{Name: "recipient_name", err: errors.New(`ent: missing required field "Certificate.recipient_name"`)}
+	}
+	if v, ok := cc.mutation.RecipientName(); ok {
+		if err := certificate.RecipientNameValidator(v); err != nil {
+			return &ValidationError{Name: "recipient_name", err: fmt.Errorf(`ent: validator failed for field "Certificate.recipient_name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.InternshipTitle(); !ok {
+		return &ValidationError{Name: "internship_title", err: errors.New(`ent: missing required field "Certificate.internship_title"`)}
+	}
+	if v, ok := cc.mutation.InternshipTitle(); ok {
+		if err := certificate.InternshipTitleValidator(v); err != nil {
+			return &ValidationError{Name: "internship_title", err: fmt.Errorf(`ent: validator failed for field "Certificate.internship_title": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CompletedAt(); !ok {
+		return &ValidationError{Name: "completed_at", err: errors.New(`ent: missing required field "Certificate.completed_at"`)}
+	}
+	if _, ok := cc.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "Certificate.issued_at"`)}
+	}
+	if _, ok := cc.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "Certificate.signature"`)}
+	}
+	if v, ok := cc.mutation.Signature(); ok {
+		if err := certificate.SignatureValidator(v); err != nil {
+			return &ValidationError{Name: "signature", err: fmt.Errorf(`ent: validator failed for field "Certificate.signature": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CertificateStatus(); !ok {
+		return &ValidationError{Name: "certificate_status", err: errors.New(`ent: missing required field "Certificate.certificate_status"`)}
+	}
+	return nil
+}
+
+func (cc *CertificateCreate) sqlSave(ctx context.Context) (*Certificate, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Certificate.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Certificate{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(certificate.Table, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeString))
+	)
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(certificate.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(certificate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(certificate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.CreatedBy(); ok {
+		_spec.SetField(certificate.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := cc.mutation.UpdatedBy(); ok {
+		_spec.SetField(certificate.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := cc.mutation.VerificationID(); ok {
+		_spec.SetField(certificate.FieldVerificationID, field.TypeString, value)
+		_node.VerificationID = value
+	}
+	if value, ok := cc.mutation.EnrollmentID(); ok {
+		_spec.SetField(certificate.FieldEnrollmentID, field.TypeString, value)
+		_node.EnrollmentID = value
+	}
+	if value, ok := cc.mutation.UserID(); ok {
+		_spec.SetField(certificate.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := cc.mutation.InternshipID(); ok {
+		_spec.SetField(certificate.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := cc.mutation.InternshipBatchID(); ok {
+		_spec.SetField(certificate.FieldInternshipBatchID, field.TypeString, value)
+		_node.InternshipBatchID = value
+	}
+	if value, ok := cc.mutation.RecipientName(); ok {
+		_spec.SetField(certificate.FieldRecipientName, field.TypeString, value)
+		_node.RecipientName = value
+	}
+	if value, ok := cc.mutation.InternshipTitle(); ok {
+		_spec.SetField(certificate.FieldInternshipTitle, field.TypeString, value)
+		_node.InternshipTitle = value
+	}
+	if value, ok := cc.mutation.CompletedAt(); ok {
+		_spec.SetField(certificate.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = value
+	}
+	if value, ok := cc.mutation.IssuedAt(); ok {
+		_spec.SetField(certificate.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
+	if value, ok := cc.mutation.Signature(); ok {
+		_spec.SetField(certificate.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	if value, ok := cc.mutation.FileID(); ok {
+		_spec.SetField(certificate.FieldFileID, field.TypeString, value)
+		_node.FileID = &value
+	}
+	if value, ok := cc.mutation.CertificateStatus(); ok {
+		_spec.SetField(certificate.FieldCertificateStatus, field.TypeString, value)
+		_node.CertificateStatus = value
+	}
+	if value, ok := cc.mutation.RevokedAt(); ok {
+		_spec.SetField(certificate.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := cc.mutation.RevocationReason(); ok {
+		_spec.SetField(certificate.FieldRevocationReason, field.TypeString, value)
+		_node.RevocationReason = &value
+	}
+	return _node, _spec
+}
+
+// CertificateCreateBulk is the builder for creating many Certificate entities in bulk.
+type CertificateCreateBulk struct {
+	config
+	err      error
+	builders []*CertificateCreate
+}
+
+// Save creates the Certificate entities in the database.
+func (ccb *CertificateCreateBulk) Save(ctx context.Context) ([]*Certificate, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Certificate, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertificateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CertificateCreateBulk) SaveX(ctx context.Context) []*Certificate {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CertificateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CertificateCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/certificate"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// CertificateUpdate is the builder for updating Certificate entities.
+type CertificateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertificateMutation
+}
+
+// Where appends a list predicates to the CertificateUpdate builder.
+func (cu *CertificateUpdate) Where(ps ...predicate.Certificate) *CertificateUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *CertificateUpdate) SetStatus(s string) *CertificateUpdate {
+	cu.mutation.SetStatus(s)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableStatus(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetStatus(*s)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CertificateUpdate) SetUpdatedAt(t time.Time) *CertificateUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cu *CertificateUpdate) SetUpdatedBy(s string) *CertificateUpdate {
+	cu.mutation.SetUpdatedBy(s)
+	return cu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableUpdatedBy(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetUpdatedBy(*s)
+	}
+	return cu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cu *CertificateUpdate) ClearUpdatedBy() *CertificateUpdate {
+	cu.mutation.ClearUpdatedBy()
+	return cu
+}
+
+// SetFileID sets the "file_id" field.
+func (cu *CertificateUpdate) SetFileID(s string) *CertificateUpdate {
+	cu.mutation.SetFileID(s)
+	return cu
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableFileID(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetFileID(*s)
+	}
+	return cu
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (cu *CertificateUpdate) ClearFileID() *CertificateUpdate {
+	cu.mutation.ClearFileID()
+	return cu
+}
+
+// SetCertificateStatus sets the "certificate_status" field.
+func (cu *CertificateUpdate) SetCertificateStatus(s string) *CertificateUpdate {
+	cu.mutation.SetCertificateStatus(s)
+	return cu
+}
+
+// SetNillableCertificateStatus sets the "certificate_status" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableCertificateStatus(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetCertificateStatus(*s)
+	}
+	return cu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cu *CertificateUpdate) SetRevokedAt(t time.Time) *CertificateUpdate {
+	cu.mutation.SetRevokedAt(t)
+	return cu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableRevokedAt(t *time.Time) *CertificateUpdate {
+	if t != nil {
+		cu.SetRevokedAt(*t)
+	}
+	return cu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (cu *CertificateUpdate) ClearRevokedAt() *CertificateUpdate {
+	cu.mutation.ClearRevokedAt()
+	return cu
+}
+
+// SetRevocationReason sets the "revocation_reason" field.
+func (cu *CertificateUpdate) SetRevocationReason(s string) *CertificateUpdate {
+	cu.mutation.SetRevocationReason(s)
+	return cu
+}
+
+// SetNillableRevocationReason sets the "revocation_reason" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableRevocationReason(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetRevocationReason(*s)
+	}
+	return cu
+}
+
+// ClearRevocationReason clears the value of the "revocation_reason" field.
+func (cu *CertificateUpdate) ClearRevocationReason() *CertificateUpdate {
+	cu.mutation.ClearRevocationReason()
+	return cu
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (cu *CertificateUpdate) Mutation() *CertificateMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CertificateUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CertificateUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CertificateUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CertificateUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CertificateUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := certificate.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cu *CertificateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(certificate.Table, certificate.Columns, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeString))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(certificate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(certificate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.CreatedByCleared() {
+		_spec.ClearField(certificate.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cu.mutation.UpdatedBy(); ok {
+		_spec.SetField(certificate.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cu.mutation.UpdatedByCleared() {
+		_spec.ClearField(certificate.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := cu.mutation.FileID(); ok {
+		_spec.SetField(certificate.FieldFileID, field.TypeString, value)
+	}
+	if cu.mutation.FileIDCleared() {
+		_spec.ClearField(certificate.FieldFileID, field.TypeString)
+	}
+	if value, ok := cu.mutation.CertificateStatus(); ok {
+		_spec.SetField(certificate.FieldCertificateStatus, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.RevokedAt(); ok {
+		_spec.SetField(certificate.FieldRevokedAt, field.TypeTime, value)
+	}
+	if cu.mutation.RevokedAtCleared() {
+		_spec.ClearField(certificate.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.RevocationReason(); ok {
+		_spec.SetField(certificate.FieldRevocationReason, field.TypeString, value)
+	}
+	if cu.mutation.RevocationReasonCleared() {
+		_spec.ClearField(certificate.FieldRevocationReason, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CertificateUpdateOne is the builder for updating a single Certificate entity.
+type CertificateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertificateMutation
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CertificateUpdateOne) SetStatus(s string) *CertificateUpdateOne {
+	cuo.mutation.SetStatus(s)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableStatus(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetStatus(*s)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CertificateUpdateOne) SetUpdatedAt(t time.Time) *CertificateUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cuo *CertificateUpdateOne) SetUpdatedBy(s string) *CertificateUpdateOne {
+	cuo.mutation.SetUpdatedBy(s)
+	return cuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableUpdatedBy(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetUpdatedBy(*s)
+	}
+	return cuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cuo *CertificateUpdateOne) ClearUpdatedBy() *CertificateUpdateOne {
+	cuo.mutation.ClearUpdatedBy()
+	return cuo
+}
+
+// SetFileID sets the "file_id" field.
+func (cuo *CertificateUpdateOne) SetFileID(s string) *CertificateUpdateOne {
+	cuo.mutation.SetFileID(s)
+	return cuo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableFileID(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetFileID(*s)
+	}
+	return cuo
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (cuo *CertificateUpdateOne) ClearFileID() *CertificateUpdateOne {
+	cuo.mutation.ClearFileID()
+	return cuo
+}
+
+// SetCertificateStatus sets the "certificate_status" field.
+func (cuo *CertificateUpdateOne) SetCertificateStatus(s string) *CertificateUpdateOne {
+	cuo.mutation.SetCertificateStatus(s)
+	return cuo
+}
+
+// SetNillableCertificateStatus sets the "certificate_status" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableCertificateStatus(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetCertificateStatus(*s)
+	}
+	return cuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cuo *CertificateUpdateOne) SetRevokedAt(t time.Time) *CertificateUpdateOne {
+	cuo.mutation.SetRevokedAt(t)
+	return cuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableRevokedAt(t *time.Time) *CertificateUpdateOne {
+	if t != nil {
+		cuo.SetRevokedAt(*t)
+	}
+	return cuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (cuo *CertificateUpdateOne) ClearRevokedAt() *CertificateUpdateOne {
+	cuo.mutation.ClearRevokedAt()
+	return cuo
+}
+
+// SetRevocationReason sets the "revocation_reason" field.
+func (cuo *CertificateUpdateOne) SetRevocationReason(s string) *CertificateUpdateOne {
+	cuo.mutation.SetRevocationReason(s)
+	return cuo
+}
+
+// SetNillableRevocationReason sets the "revocation_reason" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableRevocationReason(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetRevocationReason(*s)
+	}
+	return cuo
+}
+
+// ClearRevocationReason clears the value of the "revocation_reason" field.
+func (cuo *CertificateUpdateOne) ClearRevocationReason() *CertificateUpdateOne {
+	cuo.mutation.ClearRevocationReason()
+	return cuo
+}
+
+// Mutation returns the CertificateMutation object of the builder.
+func (cuo *CertificateUpdateOne) Mutation() *CertificateMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CertificateUpdate builder.
+func (cuo *CertificateUpdateOne) Where(ps ...predicate.Certificate) *CertificateUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CertificateUpdateOne) Select(field string, fields ...string) *CertificateUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Certificate entity.
+func (cuo *CertificateUpdateOne) Save(ctx context.Context) (*Certificate, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CertificateUpdateOne) SaveX(ctx context.Context) *Certificate {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CertificateUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CertificateUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CertificateUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := certificate.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cuo *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificate, err error) {
+	_spec := sqlgraph.NewUpdateSpec(certificate.Table, certificate.Columns, sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeString))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Certificate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certificate.FieldID)
+		for _, f := range fields {
+			if !certificate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != certificate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(certificate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(certificate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.CreatedByCleared() {
+		_spec.ClearField(certificate.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(certificate.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(certificate.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := cuo.mutation.FileID(); ok {
+		_spec.SetField(certificate.FieldFileID, field.TypeString, value)
+	}
+	if cuo.mutation.FileIDCleared() {
+		_spec.ClearField(certificate.FieldFileID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.CertificateStatus(); ok {
+		_spec.SetField(certificate.FieldCertificateStatus, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.RevokedAt(); ok {
+		_spec.SetField(certificate.FieldRevokedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(certificate.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.RevocationReason(); ok {
+		_spec.SetField(certificate.FieldRevocationReason, field.TypeString, value)
+	}
+	if cuo.mutation.RevocationReasonCleared() {
+		_spec.ClearField(certificate.FieldRevocationReason, field.TypeString)
+	}
+	_node = &Certificate{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
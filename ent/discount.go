@@ -50,6 +50,12 @@ type Discount struct {
 	MinOrderValue *decimal.Decimal `json:"min_order_value,omitempty"`
 	// IsCombinable holds the value of the "is_combinable" field.
 	IsCombinable bool `json:"is_combinable,omitempty"`
+	// UsedCount holds the value of the "used_count" field.
+	UsedCount int `json:"used_count,omitempty"`
+	// IsCampaign holds the value of the "is_campaign" field.
+	IsCampaign bool `json:"is_campaign,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *string `json:"parent_id,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata     map[string]string `json:"metadata,omitempty"`
 	selectValues sql.SelectValues
@@ -66,11 +72,11 @@ func (*Discount) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case discount.FieldDiscountValue:
 			values[i] = new(decimal.Decimal)
-		case discount.FieldIsActive, discount.FieldIsCombinable:
+		case discount.FieldIsActive, discount.FieldIsCombinable, discount.FieldIsCampaign:
 			values[i] = new(sql.NullBool)
-		case discount.FieldMaxUses:
+		case discount.FieldMaxUses, discount.FieldUsedCount:
 			values[i] = new(sql.NullInt64)
-		case discount.FieldID, discount.FieldStatus, discount.FieldCreatedBy, discount.FieldUpdatedBy, discount.FieldCode, discount.FieldDescription, discount.FieldDiscountType:
+		case discount.FieldID, discount.FieldStatus, discount.FieldCreatedBy, discount.FieldUpdatedBy, discount.FieldCode, discount.FieldDescription, discount.FieldDiscountType, discount.FieldParentID:
 			values[i] = new(sql.NullString)
 		case discount.FieldCreatedAt, discount.FieldUpdatedAt, discount.FieldValidFrom, discount.FieldValidUntil:
 			values[i] = new(sql.NullTime)
@@ -188,6 +194,25 @@ func (d *Discount) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.IsCombinable = value.Bool
 			}
+		case discount.FieldUsedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used_count", values[i])
+			} else if value.Valid {
+				d.UsedCount = int(value.Int64)
+			}
+		case discount.FieldIsCampaign:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_campaign", values[i])
+			} else if value.Valid {
+				d.IsCampaign = value.Bool
+			}
+		case discount.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				d.ParentID = new(string)
+				*d.ParentID = value.String
+			}
 		case discount.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -283,6 +308,17 @@ func (d *Discount) String() string {
 	builder.WriteString("is_combinable=")
 	builder.WriteString(fmt.Sprintf("%v", d.IsCombinable))
 	builder.WriteString(", ")
+	builder.WriteString("used_count=")
+	builder.WriteString(fmt.Sprintf("%v", d.UsedCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_campaign=")
+	builder.WriteString(fmt.Sprintf("%v", d.IsCampaign))
+	builder.WriteString(", ")
+	if v := d.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", d.Metadata))
 	builder.WriteByte(')')
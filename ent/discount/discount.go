@@ -45,6 +45,12 @@ const (
 	FieldMinOrderValue = "min_order_value"
 	// FieldIsCombinable holds the string denoting the is_combinable field in the database.
 	FieldIsCombinable = "is_combinable"
+	// FieldUsedCount holds the string denoting the used_count field in the database.
+	FieldUsedCount = "used_count"
+	// FieldIsCampaign holds the string denoting the is_campaign field in the database.
+	FieldIsCampaign = "is_campaign"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// Table holds the table name of the discount in the database.
@@ -69,6 +75,9 @@ var Columns = []string{
 	FieldMaxUses,
 	FieldMinOrderValue,
 	FieldIsCombinable,
+	FieldUsedCount,
+	FieldIsCampaign,
+	FieldParentID,
 	FieldMetadata,
 }
 
@@ -103,6 +112,12 @@ var (
 	DefaultIsActive bool
 	// DefaultIsCombinable holds the default value on creation for the "is_combinable" field.
 	DefaultIsCombinable bool
+	// DefaultUsedCount holds the default value on creation for the "used_count" field.
+	DefaultUsedCount int
+	// UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	UsedCountValidator func(int) error
+	// DefaultIsCampaign holds the default value on creation for the "is_campaign" field.
+	DefaultIsCampaign bool
 	// DefaultMetadata holds the default value on creation for the "metadata" field.
 	DefaultMetadata map[string]string
 	// DefaultID holds the default value on creation for the "id" field.
@@ -191,3 +206,18 @@ func ByMinOrderValue(opts ...sql.OrderTermOption) OrderOption {
 func ByIsCombinable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsCombinable, opts...).ToFunc()
 }
+
+// ByUsedCount orders the results by the used_count field.
+func ByUsedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedCount, opts...).ToFunc()
+}
+
+// ByIsCampaign orders the results by the is_campaign field.
+func ByIsCampaign(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsCampaign, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
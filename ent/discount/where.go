@@ -142,6 +142,21 @@ func IsCombinable(v bool) predicate.Discount {
 	return predicate.Discount(sql.FieldEQ(FieldIsCombinable, v))
 }
 
+// UsedCount applies equality check predicate on the "used_count" field. It's identical to UsedCountEQ.
+func UsedCount(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldUsedCount, v))
+}
+
+// IsCampaign applies equality check predicate on the "is_campaign" field. It's identical to IsCampaignEQ.
+func IsCampaign(v bool) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldIsCampaign, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldParentID, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Discount {
 	return predicate.Discount(sql.FieldEQ(FieldStatus, v))
@@ -911,6 +926,131 @@ func IsCombinableNEQ(v bool) predicate.Discount {
 	return predicate.Discount(sql.FieldNEQ(FieldIsCombinable, v))
 }
 
+// UsedCountEQ applies the EQ predicate on the "used_count" field.
+func UsedCountEQ(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldUsedCount, v))
+}
+
+// UsedCountNEQ applies the NEQ predicate on the "used_count" field.
+func UsedCountNEQ(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldUsedCount, v))
+}
+
+// UsedCountIn applies the In predicate on the "used_count" field.
+func UsedCountIn(vs ...int) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldUsedCount, vs...))
+}
+
+// UsedCountNotIn applies the NotIn predicate on the "used_count" field.
+func UsedCountNotIn(vs ...int) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldUsedCount, vs...))
+}
+
+// UsedCountGT applies the GT predicate on the "used_count" field.
+func UsedCountGT(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldUsedCount, v))
+}
+
+// UsedCountGTE applies the GTE predicate on the "used_count" field.
+func UsedCountGTE(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldUsedCount, v))
+}
+
+// UsedCountLT applies the LT predicate on the "used_count" field.
+func UsedCountLT(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldUsedCount, v))
+}
+
+// UsedCountLTE applies the LTE predicate on the "used_count" field.
+func UsedCountLTE(v int) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldUsedCount, v))
+}
+
+// IsCampaignEQ applies the EQ predicate on the "is_campaign" field.
+func IsCampaignEQ(v bool) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldIsCampaign, v))
+}
+
+// IsCampaignNEQ applies the NEQ predicate on the "is_campaign" field.
+func IsCampaignNEQ(v bool) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldIsCampaign, v))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...string) predicate.Discount {
+	return predicate.Discount(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDContains applies the Contains predicate on the "parent_id" field.
+func ParentIDContains(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContains(FieldParentID, v))
+}
+
+// ParentIDHasPrefix applies the HasPrefix predicate on the "parent_id" field.
+func ParentIDHasPrefix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasPrefix(FieldParentID, v))
+}
+
+// ParentIDHasSuffix applies the HasSuffix predicate on the "parent_id" field.
+func ParentIDHasSuffix(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldHasSuffix(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Discount {
+	return predicate.Discount(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Discount {
+	return predicate.Discount(sql.FieldNotNull(FieldParentID))
+}
+
+// ParentIDEqualFold applies the EqualFold predicate on the "parent_id" field.
+func ParentIDEqualFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldEqualFold(FieldParentID, v))
+}
+
+// ParentIDContainsFold applies the ContainsFold predicate on the "parent_id" field.
+func ParentIDContainsFold(v string) predicate.Discount {
+	return predicate.Discount(sql.FieldContainsFold(FieldParentID, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Discount {
 	return predicate.Discount(sql.FieldIsNull(FieldMetadata))
@@ -224,6 +224,48 @@ func (dc *DiscountCreate) SetNillableIsCombinable(b *bool) *DiscountCreate {
 	return dc
 }
 
+// SetUsedCount sets the "used_count" field.
+func (dc *DiscountCreate) SetUsedCount(i int) *DiscountCreate {
+	dc.mutation.SetUsedCount(i)
+	return dc
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableUsedCount(i *int) *DiscountCreate {
+	if i != nil {
+		dc.SetUsedCount(*i)
+	}
+	return dc
+}
+
+// SetIsCampaign sets the "is_campaign" field.
+func (dc *DiscountCreate) SetIsCampaign(b bool) *DiscountCreate {
+	dc.mutation.SetIsCampaign(b)
+	return dc
+}
+
+// SetNillableIsCampaign sets the "is_campaign" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableIsCampaign(b *bool) *DiscountCreate {
+	if b != nil {
+		dc.SetIsCampaign(*b)
+	}
+	return dc
+}
+
+// SetParentID sets the "parent_id" field.
+func (dc *DiscountCreate) SetParentID(s string) *DiscountCreate {
+	dc.mutation.SetParentID(s)
+	return dc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (dc *DiscountCreate) SetNillableParentID(s *string) *DiscountCreate {
+	if s != nil {
+		dc.SetParentID(*s)
+	}
+	return dc
+}
+
 // SetMetadata sets the "metadata" field.
 func (dc *DiscountCreate) SetMetadata(m map[string]string) *DiscountCreate {
 	dc.mutation.SetMetadata(m)
@@ -311,6 +353,14 @@ func (dc *DiscountCreate) defaults() {
 		v := discount.DefaultIsCombinable
 		dc.mutation.SetIsCombinable(v)
 	}
+	if _, ok := dc.mutation.UsedCount(); !ok {
+		v := discount.DefaultUsedCount
+		dc.mutation.SetUsedCount(v)
+	}
+	if _, ok := dc.mutation.IsCampaign(); !ok {
+		v := discount.DefaultIsCampaign
+		dc.mutation.SetIsCampaign(v)
+	}
 	if _, ok := dc.mutation.Metadata(); !ok {
 		v := discount.DefaultMetadata
 		dc.mutation.SetMetadata(v)
@@ -360,6 +410,17 @@ func (dc *DiscountCreate) check() error {
 	if _, ok := dc.mutation.IsCombinable(); !ok {
 		return &ValidationError{Name: "is_combinable", err: errors.New(`ent: missing required field "Discount.is_combinable"`)}
 	}
+	if _, ok := dc.mutation.UsedCount(); !ok {
+		return &ValidationError{Name: "used_count", err: errors.New(`ent: missing required field "Discount.used_count"`)}
+	}
+	if v, ok := dc.mutation.UsedCount(); ok {
+		if err := discount.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "Discount.used_count": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.IsCampaign(); !ok {
+		return &ValidationError{Name: "is_campaign", err: errors.New(`ent: missing required field "Discount.is_campaign"`)}
+	}
 	return nil
 }
 
@@ -455,6 +516,18 @@ func (dc *DiscountCreate) createSpec() (*Discount, *sqlgraph.CreateSpec) {
 		_spec.SetField(discount.FieldIsCombinable, field.TypeBool, value)
 		_node.IsCombinable = value
 	}
+	if value, ok := dc.mutation.UsedCount(); ok {
+		_spec.SetField(discount.FieldUsedCount, field.TypeInt, value)
+		_node.UsedCount = value
+	}
+	if value, ok := dc.mutation.IsCampaign(); ok {
+		_spec.SetField(discount.FieldIsCampaign, field.TypeBool, value)
+		_node.IsCampaign = value
+	}
+	if value, ok := dc.mutation.ParentID(); ok {
+		_spec.SetField(discount.FieldParentID, field.TypeString, value)
+		_node.ParentID = &value
+	}
 	if value, ok := dc.mutation.Metadata(); ok {
 		_spec.SetField(discount.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -184,6 +184,27 @@ func (du *DiscountUpdate) ClearMinOrderValue() *DiscountUpdate {
 	return du
 }
 
+// SetUsedCount sets the "used_count" field.
+func (du *DiscountUpdate) SetUsedCount(i int) *DiscountUpdate {
+	du.mutation.ResetUsedCount()
+	du.mutation.SetUsedCount(i)
+	return du
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (du *DiscountUpdate) SetNillableUsedCount(i *int) *DiscountUpdate {
+	if i != nil {
+		du.SetUsedCount(*i)
+	}
+	return du
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (du *DiscountUpdate) AddUsedCount(i int) *DiscountUpdate {
+	du.mutation.AddUsedCount(i)
+	return du
+}
+
 // SetMetadata sets the "metadata" field.
 func (du *DiscountUpdate) SetMetadata(m map[string]string) *DiscountUpdate {
 	du.mutation.SetMetadata(m)
@@ -237,7 +258,20 @@ func (du *DiscountUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (du *DiscountUpdate) check() error {
+	if v, ok := du.mutation.UsedCount(); ok {
+		if err := discount.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "Discount.used_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(discount.Table, discount.Columns, sqlgraph.NewFieldSpec(discount.FieldID, field.TypeString))
 	if ps := du.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -294,6 +328,15 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.MinOrderValueCleared() {
 		_spec.ClearField(discount.FieldMinOrderValue, field.TypeOther)
 	}
+	if value, ok := du.mutation.UsedCount(); ok {
+		_spec.SetField(discount.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedUsedCount(); ok {
+		_spec.AddField(discount.FieldUsedCount, field.TypeInt, value)
+	}
+	if du.mutation.ParentIDCleared() {
+		_spec.ClearField(discount.FieldParentID, field.TypeString)
+	}
 	if value, ok := du.mutation.Metadata(); ok {
 		_spec.SetField(discount.FieldMetadata, field.TypeJSON, value)
 	}
@@ -475,6 +518,27 @@ func (duo *DiscountUpdateOne) ClearMinOrderValue() *DiscountUpdateOne {
 	return duo
 }
 
+// SetUsedCount sets the "used_count" field.
+func (duo *DiscountUpdateOne) SetUsedCount(i int) *DiscountUpdateOne {
+	duo.mutation.ResetUsedCount()
+	duo.mutation.SetUsedCount(i)
+	return duo
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (duo *DiscountUpdateOne) SetNillableUsedCount(i *int) *DiscountUpdateOne {
+	if i != nil {
+		duo.SetUsedCount(*i)
+	}
+	return duo
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (duo *DiscountUpdateOne) AddUsedCount(i int) *DiscountUpdateOne {
+	duo.mutation.AddUsedCount(i)
+	return duo
+}
+
 // SetMetadata sets the "metadata" field.
 func (duo *DiscountUpdateOne) SetMetadata(m map[string]string) *DiscountUpdateOne {
 	duo.mutation.SetMetadata(m)
@@ -541,7 +605,20 @@ func (duo *DiscountUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (duo *DiscountUpdateOne) check() error {
+	if v, ok := duo.mutation.UsedCount(); ok {
+		if err := discount.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "Discount.used_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(discount.Table, discount.Columns, sqlgraph.NewFieldSpec(discount.FieldID, field.TypeString))
 	id, ok := duo.mutation.ID()
 	if !ok {
@@ -615,6 +692,15 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 	if duo.mutation.MinOrderValueCleared() {
 		_spec.ClearField(discount.FieldMinOrderValue, field.TypeOther)
 	}
+	if value, ok := duo.mutation.UsedCount(); ok {
+		_spec.SetField(discount.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedUsedCount(); ok {
+		_spec.AddField(discount.FieldUsedCount, field.TypeInt, value)
+	}
+	if duo.mutation.ParentIDCleared() {
+		_spec.ClearField(discount.FieldParentID, field.TypeString)
+	}
 	if value, ok := duo.mutation.Metadata(); ok {
 		_spec.SetField(discount.FieldMetadata, field.TypeJSON, value)
 	}
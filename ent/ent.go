@@ -12,18 +12,42 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/codegeeky/ent/assignment"
 	"github.com/omkar273/codegeeky/ent/cart"
 	"github.com/omkar273/codegeeky/ent/cartlineitems"
 	"github.com/omkar273/codegeeky/ent/category"
+	"github.com/omkar273/codegeeky/ent/certificate"
 	"github.com/omkar273/codegeeky/ent/discount"
 	"github.com/omkar273/codegeeky/ent/fileupload"
 	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
 	"github.com/omkar273/codegeeky/ent/internshipbatch"
 	"github.com/omkar273/codegeeky/ent/internshipenrollment"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/ent/lesson"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+	"github.com/omkar273/codegeeky/ent/livesession"
+	"github.com/omkar273/codegeeky/ent/module"
+	"github.com/omkar273/codegeeky/ent/notification"
 	"github.com/omkar273/codegeeky/ent/order"
 	"github.com/omkar273/codegeeky/ent/payment"
 	"github.com/omkar273/codegeeky/ent/paymentattempt"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
+	"github.com/omkar273/codegeeky/ent/quiz"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+	"github.com/omkar273/codegeeky/ent/referral"
+	"github.com/omkar273/codegeeky/ent/resource"
+	"github.com/omkar273/codegeeky/ent/sessionattendance"
+	"github.com/omkar273/codegeeky/ent/submission"
+	"github.com/omkar273/codegeeky/ent/subscription"
+	"github.com/omkar273/codegeeky/ent/subscriptionevent"
+	"github.com/omkar273/codegeeky/ent/subscriptionplan"
 	"github.com/omkar273/codegeeky/ent/user"
+	"github.com/omkar273/codegeeky/ent/wallettransaction"
+	"github.com/omkar273/codegeeky/ent/wishlist"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -84,18 +108,42 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			cart.Table:                 cart.ValidColumn,
-			cartlineitems.Table:        cartlineitems.ValidColumn,
-			category.Table:             category.ValidColumn,
-			discount.Table:             discount.ValidColumn,
-			fileupload.Table:           fileupload.ValidColumn,
-			internship.Table:           internship.ValidColumn,
-			internshipbatch.Table:      internshipbatch.ValidColumn,
-			internshipenrollment.Table: internshipenrollment.ValidColumn,
-			order.Table:                order.ValidColumn,
-			payment.Table:              payment.ValidColumn,
-			paymentattempt.Table:       paymentattempt.ValidColumn,
-			user.Table:                 user.ValidColumn,
+			assignment.Table:            assignment.ValidColumn,
+			cart.Table:                  cart.ValidColumn,
+			cartlineitems.Table:         cartlineitems.ValidColumn,
+			category.Table:              category.ValidColumn,
+			certificate.Table:           certificate.ValidColumn,
+			discount.Table:              discount.ValidColumn,
+			fileupload.Table:            fileupload.ValidColumn,
+			internship.Table:            internship.ValidColumn,
+			internshipapplication.Table: internshipapplication.ValidColumn,
+			internshipbatch.Table:       internshipbatch.ValidColumn,
+			internshipenrollment.Table:  internshipenrollment.ValidColumn,
+			internshipinstructor.Table:  internshipinstructor.ValidColumn,
+			internshipreview.Table:      internshipreview.ValidColumn,
+			internshiprevision.Table:    internshiprevision.ValidColumn,
+			lesson.Table:                lesson.ValidColumn,
+			lessonprogress.Table:        lessonprogress.ValidColumn,
+			livesession.Table:           livesession.ValidColumn,
+			module.Table:                module.ValidColumn,
+			notification.Table:          notification.ValidColumn,
+			order.Table:                 order.ValidColumn,
+			payment.Table:               payment.ValidColumn,
+			paymentattempt.Table:        paymentattempt.ValidColumn,
+			paymentplan.Table:           paymentplan.ValidColumn,
+			paymentrefund.Table:         paymentrefund.ValidColumn,
+			quiz.Table:                  quiz.ValidColumn,
+			quizattempt.Table:           quizattempt.ValidColumn,
+			referral.Table:              referral.ValidColumn,
+			resource.Table:              resource.ValidColumn,
+			sessionattendance.Table:     sessionattendance.ValidColumn,
+			submission.Table:            submission.ValidColumn,
+			subscription.Table:          subscription.ValidColumn,
+			subscriptionevent.Table:     subscriptionevent.ValidColumn,
+			subscriptionplan.Table:      subscriptionplan.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			wallettransaction.Table:     wallettransaction.ValidColumn,
+			wishlist.Table:              wishlist.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
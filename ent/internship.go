@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -61,6 +62,32 @@ type Internship struct {
 	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
 	// Price of the internship
 	Total decimal.Decimal `json:"total,omitempty"`
+	// Editorial status: draft, in_review, published, rejected
+	PublishStatus types.InternshipPublishStatus `json:"publish_status,omitempty"`
+	// Pending revision of a published internship
+	Draft *types.InternshipSnapshot `json:"draft,omitempty"`
+	// Editorial status of the pending revision
+	DraftStatus *types.InternshipPublishStatus `json:"draft_status,omitempty"`
+	// Comment left by the reviewer on the last rejection
+	ReviewComment *string `json:"review_comment,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// Revision the live content was taken from
+	CurrentRevisionID *string `json:"current_revision_id,omitempty"`
+	// ApplicationRequired holds the value of the "application_required" field.
+	ApplicationRequired bool `json:"application_required,omitempty"`
+	// Questions applicants answer and whether they upload a resume
+	ApplicationForm *types.ApplicationForm `json:"application_form,omitempty"`
+	// RatingAverage holds the value of the "rating_average" field.
+	RatingAverage float64 `json:"rating_average,omitempty"`
+	// RatingCount holds the value of the "rating_count" field.
+	RatingCount int `json:"rating_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InternshipQuery when eager-loading is set.
 	Edges        InternshipEdges `json:"edges"`
@@ -93,15 +120,19 @@ func (*Internship) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case internship.FieldFlatDiscount, internship.FieldPercentageDiscount:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case internship.FieldSkills, internship.FieldLearningOutcomes, internship.FieldPrerequisites, internship.FieldBenefits:
+		case internship.FieldSkills, internship.FieldLearningOutcomes, internship.FieldPrerequisites, internship.FieldBenefits, internship.FieldDraft, internship.FieldApplicationForm:
 			values[i] = new([]byte)
 		case internship.FieldPrice, internship.FieldSubtotal, internship.FieldTotal:
 			values[i] = new(decimal.Decimal)
-		case internship.FieldDurationInWeeks:
+		case internship.FieldApplicationRequired:
+			values[i] = new(sql.NullBool)
+		case internship.FieldRatingAverage:
+			values[i] = new(sql.NullFloat64)
+		case internship.FieldDurationInWeeks, internship.FieldRatingCount:
 			values[i] = new(sql.NullInt64)
-		case internship.FieldID, internship.FieldStatus, internship.FieldCreatedBy, internship.FieldUpdatedBy, internship.FieldTitle, internship.FieldLookupKey, internship.FieldDescription, internship.FieldLevel, internship.FieldMode, internship.FieldCurrency:
+		case internship.FieldID, internship.FieldStatus, internship.FieldCreatedBy, internship.FieldUpdatedBy, internship.FieldTitle, internship.FieldLookupKey, internship.FieldDescription, internship.FieldLevel, internship.FieldMode, internship.FieldCurrency, internship.FieldPublishStatus, internship.FieldDraftStatus, internship.FieldReviewComment, internship.FieldReviewedBy, internship.FieldCurrentRevisionID:
 			values[i] = new(sql.NullString)
-		case internship.FieldCreatedAt, internship.FieldUpdatedAt:
+		case internship.FieldCreatedAt, internship.FieldUpdatedAt, internship.FieldSubmittedAt, internship.FieldReviewedAt, internship.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
 		case internship.ForeignKeys[0]: // category_id
 			values[i] = new(sql.NullString)
@@ -262,6 +293,95 @@ func (i *Internship) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				i.Total = *value
 			}
+		case internship.FieldPublishStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publish_status", values[j])
+			} else if value.Valid {
+				i.PublishStatus = types.InternshipPublishStatus(value.String)
+			}
+		case internship.FieldDraft:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field draft", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Draft); err != nil {
+					return fmt.Errorf("unmarshal field draft: %w", err)
+				}
+			}
+		case internship.FieldDraftStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field draft_status", values[j])
+			} else if value.Valid {
+				i.DraftStatus = new(types.InternshipPublishStatus)
+				*i.DraftStatus = types.InternshipPublishStatus(value.String)
+			}
+		case internship.FieldReviewComment:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[j])
+			} else if value.Valid {
+				i.ReviewComment = new(string)
+				*i.ReviewComment = value.String
+			}
+		case internship.FieldSubmittedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[j])
+			} else if value.Valid {
+				i.SubmittedAt = new(time.Time)
+				*i.SubmittedAt = value.Time
+			}
+		case internship.FieldReviewedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[j])
+			} else if value.Valid {
+				i.ReviewedAt = new(time.Time)
+				*i.ReviewedAt = value.Time
+			}
+		case internship.FieldReviewedBy:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[j])
+			} else if value.Valid {
+				i.ReviewedBy = new(string)
+				*i.ReviewedBy = value.String
+			}
+		case internship.FieldPublishedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[j])
+			} else if value.Valid {
+				i.PublishedAt = new(time.Time)
+				*i.PublishedAt = value.Time
+			}
+		case internship.FieldCurrentRevisionID:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field current_revision_id", values[j])
+			} else if value.Valid {
+				i.CurrentRevisionID = new(string)
+				*i.CurrentRevisionID = value.String
+			}
+		case internship.FieldApplicationRequired:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field application_required", values[j])
+			} else if value.Valid {
+				i.ApplicationRequired = value.Bool
+			}
+		case internship.FieldApplicationForm:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field application_form", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.ApplicationForm); err != nil {
+					return fmt.Errorf("unmarshal field application_form: %w", err)
+				}
+			}
+		case internship.FieldRatingAverage:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating_average", values[j])
+			} else if value.Valid {
+				i.RatingAverage = value.Float64
+			}
+		case internship.FieldRatingCount:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating_count", values[j])
+			} else if value.Valid {
+				i.RatingCount = int(value.Int64)
+			}
 		case internship.ForeignKeys[0]:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field category_id", values[j])
@@ -376,6 +496,59 @@ func (i *Internship) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("total=")
 	builder.WriteString(fmt.Sprintf("%v", i.Total))
+	builder.WriteString(", ")
+	builder.WriteString("publish_status=")
+	builder.WriteString(fmt.Sprintf("%v", i.PublishStatus))
+	builder.WriteString(", ")
+	builder.WriteString("draft=")
+	builder.WriteString(fmt.Sprintf("%v", i.Draft))
+	builder.WriteString(", ")
+	if v := i.DraftStatus; v != nil {
+		builder.WriteString("draft_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := i.ReviewComment; v != nil {
+		builder.WriteString("review_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := i.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := i.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.CurrentRevisionID; v != nil {
+		builder.WriteString("current_revision_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("application_required=")
+	builder.WriteString(fmt.Sprintf("%v", i.ApplicationRequired))
+	builder.WriteString(", ")
+	builder.WriteString("application_form=")
+	builder.WriteString(fmt.Sprintf("%v", i.ApplicationForm))
+	builder.WriteString(", ")
+	builder.WriteString("rating_average=")
+	builder.WriteString(fmt.Sprintf("%v", i.RatingAverage))
+	builder.WriteString(", ")
+	builder.WriteString("rating_count=")
+	builder.WriteString(fmt.Sprintf("%v", i.RatingCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
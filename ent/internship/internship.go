@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -57,6 +58,32 @@ const (
 	FieldSubtotal = "subtotal"
 	// FieldTotal holds the string denoting the total field in the database.
 	FieldTotal = "total"
+	// FieldPublishStatus holds the string denoting the publish_status field in the database.
+	FieldPublishStatus = "publish_status"
+	// FieldDraft holds the string denoting the draft field in the database.
+	FieldDraft = "draft"
+	// FieldDraftStatus holds the string denoting the draft_status field in the database.
+	FieldDraftStatus = "draft_status"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// FieldCurrentRevisionID holds the string denoting the current_revision_id field in the database.
+	FieldCurrentRevisionID = "current_revision_id"
+	// FieldApplicationRequired holds the string denoting the application_required field in the database.
+	FieldApplicationRequired = "application_required"
+	// FieldApplicationForm holds the string denoting the application_form field in the database.
+	FieldApplicationForm = "application_form"
+	// FieldRatingAverage holds the string denoting the rating_average field in the database.
+	FieldRatingAverage = "rating_average"
+	// FieldRatingCount holds the string denoting the rating_count field in the database.
+	FieldRatingCount = "rating_count"
 	// EdgeCategories holds the string denoting the categories edge name in mutations.
 	EdgeCategories = "categories"
 	// Table holds the table name of the internship in the database.
@@ -94,6 +121,19 @@ var Columns = []string{
 	FieldPercentageDiscount,
 	FieldSubtotal,
 	FieldTotal,
+	FieldPublishStatus,
+	FieldDraft,
+	FieldDraftStatus,
+	FieldReviewComment,
+	FieldSubmittedAt,
+	FieldReviewedAt,
+	FieldReviewedBy,
+	FieldPublishedAt,
+	FieldCurrentRevisionID,
+	FieldApplicationRequired,
+	FieldApplicationForm,
+	FieldRatingAverage,
+	FieldRatingCount,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "internships"
@@ -140,6 +180,18 @@ var (
 	DefaultSubtotal decimal.Decimal
 	// DefaultTotal holds the default value on creation for the "total" field.
 	DefaultTotal decimal.Decimal
+	// DefaultPublishStatus holds the default value on creation for the "publish_status" field.
+	DefaultPublishStatus types.InternshipPublishStatus
+	// DefaultApplicationRequired holds the default value on creation for the "application_required" field.
+	DefaultApplicationRequired bool
+	// DefaultRatingAverage holds the default value on creation for the "rating_average" field.
+	DefaultRatingAverage float64
+	// RatingAverageValidator is a validator for the "rating_average" field. It is called by the builders before save.
+	RatingAverageValidator func(float64) error
+	// DefaultRatingCount holds the default value on creation for the "rating_count" field.
+	DefaultRatingCount int
+	// RatingCountValidator is a validator for the "rating_count" field. It is called by the builders before save.
+	RatingCountValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -237,6 +289,61 @@ func ByTotal(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotal, opts...).ToFunc()
 }
 
+// ByPublishStatus orders the results by the publish_status field.
+func ByPublishStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishStatus, opts...).ToFunc()
+}
+
+// ByDraftStatus orders the results by the draft_status field.
+func ByDraftStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDraftStatus, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
+
+// ByCurrentRevisionID orders the results by the current_revision_id field.
+func ByCurrentRevisionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentRevisionID, opts...).ToFunc()
+}
+
+// ByApplicationRequired orders the results by the application_required field.
+func ByApplicationRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationRequired, opts...).ToFunc()
+}
+
+// ByRatingAverage orders the results by the rating_average field.
+func ByRatingAverage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatingAverage, opts...).ToFunc()
+}
+
+// ByRatingCount orders the results by the rating_count field.
+func ByRatingCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatingCount, opts...).ToFunc()
+}
+
 // ByCategoriesCount orders the results by categories count.
 func ByCategoriesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -151,6 +152,63 @@ func Total(v decimal.Decimal) predicate.Internship {
 	return predicate.Internship(sql.FieldEQ(FieldTotal, v))
 }
 
+// PublishStatus applies equality check predicate on the "publish_status" field. It's identical to PublishStatusEQ.
+func PublishStatus(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldEQ(FieldPublishStatus, vc))
+}
+
+// DraftStatus applies equality check predicate on the "draft_status" field. It's identical to DraftStatusEQ.
+func DraftStatus(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldEQ(FieldDraftStatus, vc))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// CurrentRevisionID applies equality check predicate on the "current_revision_id" field. It's identical to CurrentRevisionIDEQ.
+func CurrentRevisionID(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldCurrentRevisionID, v))
+}
+
+// ApplicationRequired applies equality check predicate on the "application_required" field. It's identical to ApplicationRequiredEQ.
+func ApplicationRequired(v bool) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldApplicationRequired, v))
+}
+
+// RatingAverage applies equality check predicate on the "rating_average" field. It's identical to RatingAverageEQ.
+func RatingAverage(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldRatingAverage, v))
+}
+
+// RatingCount applies equality check predicate on the "rating_count" field. It's identical to RatingCountEQ.
+func RatingCount(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldRatingCount, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Internship {
 	return predicate.Internship(sql.FieldEQ(FieldStatus, v))
@@ -1166,6 +1224,669 @@ func TotalLTE(v decimal.Decimal) predicate.Internship {
 	return predicate.Internship(sql.FieldLTE(FieldTotal, v))
 }
 
+// PublishStatusEQ applies the EQ predicate on the "publish_status" field.
+func PublishStatusEQ(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldEQ(FieldPublishStatus, vc))
+}
+
+// PublishStatusNEQ applies the NEQ predicate on the "publish_status" field.
+func PublishStatusNEQ(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldNEQ(FieldPublishStatus, vc))
+}
+
+// PublishStatusIn applies the In predicate on the "publish_status" field.
+func PublishStatusIn(vs ...types.InternshipPublishStatus) predicate.Internship {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Internship(sql.FieldIn(FieldPublishStatus, v...))
+}
+
+// PublishStatusNotIn applies the NotIn predicate on the "publish_status" field.
+func PublishStatusNotIn(vs ...types.InternshipPublishStatus) predicate.Internship {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Internship(sql.FieldNotIn(FieldPublishStatus, v...))
+}
+
+// PublishStatusGT applies the GT predicate on the "publish_status" field.
+func PublishStatusGT(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldGT(FieldPublishStatus, vc))
+}
+
+// PublishStatusGTE applies the GTE predicate on the "publish_status" field.
+func PublishStatusGTE(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldGTE(FieldPublishStatus, vc))
+}
+
+// PublishStatusLT applies the LT predicate on the "publish_status" field.
+func PublishStatusLT(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldLT(FieldPublishStatus, vc))
+}
+
+// PublishStatusLTE applies the LTE predicate on the "publish_status" field.
+func PublishStatusLTE(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldLTE(FieldPublishStatus, vc))
+}
+
+// PublishStatusContains applies the Contains predicate on the "publish_status" field.
+func PublishStatusContains(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldContains(FieldPublishStatus, vc))
+}
+
+// PublishStatusHasPrefix applies the HasPrefix predicate on the "publish_status" field.
+func PublishStatusHasPrefix(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldHasPrefix(FieldPublishStatus, vc))
+}
+
+// PublishStatusHasSuffix applies the HasSuffix predicate on the "publish_status" field.
+func PublishStatusHasSuffix(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldHasSuffix(FieldPublishStatus, vc))
+}
+
+// PublishStatusEqualFold applies the EqualFold predicate on the "publish_status" field.
+func PublishStatusEqualFold(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldEqualFold(FieldPublishStatus, vc))
+}
+
+// PublishStatusContainsFold applies the ContainsFold predicate on the "publish_status" field.
+func PublishStatusContainsFold(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldContainsFold(FieldPublishStatus, vc))
+}
+
+// DraftIsNil applies the IsNil predicate on the "draft" field.
+func DraftIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldDraft))
+}
+
+// DraftNotNil applies the NotNil predicate on the "draft" field.
+func DraftNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldDraft))
+}
+
+// DraftStatusEQ applies the EQ predicate on the "draft_status" field.
+func DraftStatusEQ(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldEQ(FieldDraftStatus, vc))
+}
+
+// DraftStatusNEQ applies the NEQ predicate on the "draft_status" field.
+func DraftStatusNEQ(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldNEQ(FieldDraftStatus, vc))
+}
+
+// DraftStatusIn applies the In predicate on the "draft_status" field.
+func DraftStatusIn(vs ...types.InternshipPublishStatus) predicate.Internship {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Internship(sql.FieldIn(FieldDraftStatus, v...))
+}
+
+// DraftStatusNotIn applies the NotIn predicate on the "draft_status" field.
+func DraftStatusNotIn(vs ...types.InternshipPublishStatus) predicate.Internship {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Internship(sql.FieldNotIn(FieldDraftStatus, v...))
+}
+
+// DraftStatusGT applies the GT predicate on the "draft_status" field.
+func DraftStatusGT(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldGT(FieldDraftStatus, vc))
+}
+
+// DraftStatusGTE applies the GTE predicate on the "draft_status" field.
+func DraftStatusGTE(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldGTE(FieldDraftStatus, vc))
+}
+
+// DraftStatusLT applies the LT predicate on the "draft_status" field.
+func DraftStatusLT(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldLT(FieldDraftStatus, vc))
+}
+
+// DraftStatusLTE applies the LTE predicate on the "draft_status" field.
+func DraftStatusLTE(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldLTE(FieldDraftStatus, vc))
+}
+
+// DraftStatusContains applies the Contains predicate on the "draft_status" field.
+func DraftStatusContains(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldContains(FieldDraftStatus, vc))
+}
+
+// DraftStatusHasPrefix applies the HasPrefix predicate on the "draft_status" field.
+func DraftStatusHasPrefix(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldHasPrefix(FieldDraftStatus, vc))
+}
+
+// DraftStatusHasSuffix applies the HasSuffix predicate on the "draft_status" field.
+func DraftStatusHasSuffix(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldHasSuffix(FieldDraftStatus, vc))
+}
+
+// DraftStatusIsNil applies the IsNil predicate on the "draft_status" field.
+func DraftStatusIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldDraftStatus))
+}
+
+// DraftStatusNotNil applies the NotNil predicate on the "draft_status" field.
+func DraftStatusNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldDraftStatus))
+}
+
+// DraftStatusEqualFold applies the EqualFold predicate on the "draft_status" field.
+func DraftStatusEqualFold(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldEqualFold(FieldDraftStatus, vc))
+}
+
+// DraftStatusContainsFold applies the ContainsFold predicate on the "draft_status" field.
+func DraftStatusContainsFold(v types.InternshipPublishStatus) predicate.Internship {
+	vc := string(v)
+	return predicate.Internship(sql.FieldContainsFold(FieldDraftStatus, vc))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldContains(FieldReviewedBy, v))
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldHasPrefix(FieldReviewedBy, v))
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldHasSuffix(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEqualFold(FieldReviewedBy, v))
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldContainsFold(FieldReviewedBy, v))
+}
+
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// PublishedAtIsNil applies the IsNil predicate on the "published_at" field.
+func PublishedAtIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldPublishedAt))
+}
+
+// PublishedAtNotNil applies the NotNil predicate on the "published_at" field.
+func PublishedAtNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldPublishedAt))
+}
+
+// CurrentRevisionIDEQ applies the EQ predicate on the "current_revision_id" field.
+func CurrentRevisionIDEQ(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDNEQ applies the NEQ predicate on the "current_revision_id" field.
+func CurrentRevisionIDNEQ(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDIn applies the In predicate on the "current_revision_id" field.
+func CurrentRevisionIDIn(vs ...string) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldCurrentRevisionID, vs...))
+}
+
+// CurrentRevisionIDNotIn applies the NotIn predicate on the "current_revision_id" field.
+func CurrentRevisionIDNotIn(vs ...string) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldCurrentRevisionID, vs...))
+}
+
+// CurrentRevisionIDGT applies the GT predicate on the "current_revision_id" field.
+func CurrentRevisionIDGT(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDGTE applies the GTE predicate on the "current_revision_id" field.
+func CurrentRevisionIDGTE(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDLT applies the LT predicate on the "current_revision_id" field.
+func CurrentRevisionIDLT(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDLTE applies the LTE predicate on the "current_revision_id" field.
+func CurrentRevisionIDLTE(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDContains applies the Contains predicate on the "current_revision_id" field.
+func CurrentRevisionIDContains(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldContains(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDHasPrefix applies the HasPrefix predicate on the "current_revision_id" field.
+func CurrentRevisionIDHasPrefix(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldHasPrefix(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDHasSuffix applies the HasSuffix predicate on the "current_revision_id" field.
+func CurrentRevisionIDHasSuffix(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldHasSuffix(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDIsNil applies the IsNil predicate on the "current_revision_id" field.
+func CurrentRevisionIDIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldCurrentRevisionID))
+}
+
+// CurrentRevisionIDNotNil applies the NotNil predicate on the "current_revision_id" field.
+func CurrentRevisionIDNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldCurrentRevisionID))
+}
+
+// CurrentRevisionIDEqualFold applies the EqualFold predicate on the "current_revision_id" field.
+func CurrentRevisionIDEqualFold(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldEqualFold(FieldCurrentRevisionID, v))
+}
+
+// CurrentRevisionIDContainsFold applies the ContainsFold predicate on the "current_revision_id" field.
+func CurrentRevisionIDContainsFold(v string) predicate.Internship {
+	return predicate.Internship(sql.FieldContainsFold(FieldCurrentRevisionID, v))
+}
+
+// ApplicationRequiredEQ applies the EQ predicate on the "application_required" field.
+func ApplicationRequiredEQ(v bool) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldApplicationRequired, v))
+}
+
+// ApplicationRequiredNEQ applies the NEQ predicate on the "application_required" field.
+func ApplicationRequiredNEQ(v bool) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldApplicationRequired, v))
+}
+
+// ApplicationFormIsNil applies the IsNil predicate on the "application_form" field.
+func ApplicationFormIsNil() predicate.Internship {
+	return predicate.Internship(sql.FieldIsNull(FieldApplicationForm))
+}
+
+// ApplicationFormNotNil applies the NotNil predicate on the "application_form" field.
+func ApplicationFormNotNil() predicate.Internship {
+	return predicate.Internship(sql.FieldNotNull(FieldApplicationForm))
+}
+
+// RatingAverageEQ applies the EQ predicate on the "rating_average" field.
+func RatingAverageEQ(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldRatingAverage, v))
+}
+
+// RatingAverageNEQ applies the NEQ predicate on the "rating_average" field.
+func RatingAverageNEQ(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldRatingAverage, v))
+}
+
+// RatingAverageIn applies the In predicate on the "rating_average" field.
+func RatingAverageIn(vs ...float64) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldRatingAverage, vs...))
+}
+
+// RatingAverageNotIn applies the NotIn predicate on the "rating_average" field.
+func RatingAverageNotIn(vs ...float64) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldRatingAverage, vs...))
+}
+
+// RatingAverageGT applies the GT predicate on the "rating_average" field.
+func RatingAverageGT(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldRatingAverage, v))
+}
+
+// RatingAverageGTE applies the GTE predicate on the "rating_average" field.
+func RatingAverageGTE(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldRatingAverage, v))
+}
+
+// RatingAverageLT applies the LT predicate on the "rating_average" field.
+func RatingAverageLT(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldRatingAverage, v))
+}
+
+// RatingAverageLTE applies the LTE predicate on the "rating_average" field.
+func RatingAverageLTE(v float64) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldRatingAverage, v))
+}
+
+// RatingCountEQ applies the EQ predicate on the "rating_count" field.
+func RatingCountEQ(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldEQ(FieldRatingCount, v))
+}
+
+// RatingCountNEQ applies the NEQ predicate on the "rating_count" field.
+func RatingCountNEQ(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldNEQ(FieldRatingCount, v))
+}
+
+// RatingCountIn applies the In predicate on the "rating_count" field.
+func RatingCountIn(vs ...int) predicate.Internship {
+	return predicate.Internship(sql.FieldIn(FieldRatingCount, vs...))
+}
+
+// RatingCountNotIn applies the NotIn predicate on the "rating_count" field.
+func RatingCountNotIn(vs ...int) predicate.Internship {
+	return predicate.Internship(sql.FieldNotIn(FieldRatingCount, vs...))
+}
+
+// RatingCountGT applies the GT predicate on the "rating_count" field.
+func RatingCountGT(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldGT(FieldRatingCount, v))
+}
+
+// RatingCountGTE applies the GTE predicate on the "rating_count" field.
+func RatingCountGTE(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldGTE(FieldRatingCount, v))
+}
+
+// RatingCountLT applies the LT predicate on the "rating_count" field.
+func RatingCountLT(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldLT(FieldRatingCount, v))
+}
+
+// RatingCountLTE applies the LTE predicate on the "rating_count" field.
+func RatingCountLTE(v int) predicate.Internship {
+	return predicate.Internship(sql.FieldLTE(FieldRatingCount, v))
+}
+
 // HasCategories applies the HasEdge predicate on the "categories" edge.
 func HasCategories() predicate.Internship {
 	return predicate.Internship(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/codegeeky/ent/category"
 	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -244,6 +245,172 @@ func (ic *InternshipCreate) SetNillableTotal(d *decimal.Decimal) *InternshipCrea
 	return ic
 }
 
+// SetPublishStatus sets the "publish_status" field.
+func (ic *InternshipCreate) SetPublishStatus(tps types.InternshipPublishStatus) *InternshipCreate {
+	ic.mutation.SetPublishStatus(tps)
+	return ic
+}
+
+// SetNillablePublishStatus sets the "publish_status" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillablePublishStatus(tps *types.InternshipPublishStatus) *InternshipCreate {
+	if tps != nil {
+		ic.SetPublishStatus(*tps)
+	}
+	return ic
+}
+
+// SetDraft sets the "draft" field.
+func (ic *InternshipCreate) SetDraft(ts *types.InternshipSnapshot) *InternshipCreate {
+	ic.mutation.SetDraft(ts)
+	return ic
+}
+
+// SetDraftStatus sets the "draft_status" field.
+func (ic *InternshipCreate) SetDraftStatus(tps types.InternshipPublishStatus) *InternshipCreate {
+	ic.mutation.SetDraftStatus(tps)
+	return ic
+}
+
+// SetNillableDraftStatus sets the "draft_status" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableDraftStatus(tps *types.InternshipPublishStatus) *InternshipCreate {
+	if tps != nil {
+		ic.SetDraftStatus(*tps)
+	}
+	return ic
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (ic *InternshipCreate) SetReviewComment(s string) *InternshipCreate {
+	ic.mutation.SetReviewComment(s)
+	return ic
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableReviewComment(s *string) *InternshipCreate {
+	if s != nil {
+		ic.SetReviewComment(*s)
+	}
+	return ic
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (ic *InternshipCreate) SetSubmittedAt(t time.Time) *InternshipCreate {
+	ic.mutation.SetSubmittedAt(t)
+	return ic
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableSubmittedAt(t *time.Time) *InternshipCreate {
+	if t != nil {
+		ic.SetSubmittedAt(*t)
+	}
+	return ic
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ic *InternshipCreate) SetReviewedAt(t time.Time) *InternshipCreate {
+	ic.mutation.SetReviewedAt(t)
+	return ic
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableReviewedAt(t *time.Time) *InternshipCreate {
+	if t != nil {
+		ic.SetReviewedAt(*t)
+	}
+	return ic
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (ic *InternshipCreate) SetReviewedBy(s string) *InternshipCreate {
+	ic.mutation.SetReviewedBy(s)
+	return ic
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableReviewedBy(s *string) *InternshipCreate {
+	if s != nil {
+		ic.SetReviewedBy(*s)
+	}
+	return ic
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (ic *InternshipCreate) SetPublishedAt(t time.Time) *InternshipCreate {
+	ic.mutation.SetPublishedAt(t)
+	return ic
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillablePublishedAt(t *time.Time) *InternshipCreate {
+	if t != nil {
+		ic.SetPublishedAt(*t)
+	}
+	return ic
+}
+
+// SetCurrentRevisionID sets the "current_revision_id" field.
+func (ic *InternshipCreate) SetCurrentRevisionID(s string) *InternshipCreate {
+	ic.mutation.SetCurrentRevisionID(s)
+	return ic
+}
+
+// SetNillableCurrentRevisionID sets the "current_revision_id" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableCurrentRevisionID(s *string) *InternshipCreate {
+	if s != nil {
+		ic.SetCurrentRevisionID(*s)
+	}
+	return ic
+}
+
+// SetApplicationRequired sets the "application_required" field.
+func (ic *InternshipCreate) SetApplicationRequired(b bool) *InternshipCreate {
+	ic.mutation.SetApplicationRequired(b)
+	return ic
+}
+
+// SetNillableApplicationRequired sets the "application_required" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableApplicationRequired(b *bool) *InternshipCreate {
+	if b != nil {
+		ic.SetApplicationRequired(*b)
+	}
+	return ic
+}
+
+// SetApplicationForm sets the "application_form" field.
+func (ic *InternshipCreate) SetApplicationForm(tf *types.ApplicationForm) *InternshipCreate {
+	ic.mutation.SetApplicationForm(tf)
+	return ic
+}
+
+// SetRatingAverage sets the "rating_average" field.
+func (ic *InternshipCreate) SetRatingAverage(f float64) *InternshipCreate {
+	ic.mutation.SetRatingAverage(f)
+	return ic
+}
+
+// SetNillableRatingAverage sets the "rating_average" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableRatingAverage(f *float64) *InternshipCreate {
+	if f != nil {
+		ic.SetRatingAverage(*f)
+	}
+	return ic
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (ic *InternshipCreate) SetRatingCount(i int) *InternshipCreate {
+	ic.mutation.SetRatingCount(i)
+	return ic
+}
+
+// SetNillableRatingCount sets the "rating_count" field if the given value is not nil.
+func (ic *InternshipCreate) SetNillableRatingCount(i *int) *InternshipCreate {
+	if i != nil {
+		ic.SetRatingCount(*i)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *InternshipCreate) SetID(s string) *InternshipCreate {
 	ic.mutation.SetID(s)
@@ -332,6 +499,22 @@ func (ic *InternshipCreate) defaults() {
 		v := internship.DefaultTotal
 		ic.mutation.SetTotal(v)
 	}
+	if _, ok := ic.mutation.PublishStatus(); !ok {
+		v := internship.DefaultPublishStatus
+		ic.mutation.SetPublishStatus(v)
+	}
+	if _, ok := ic.mutation.ApplicationRequired(); !ok {
+		v := internship.DefaultApplicationRequired
+		ic.mutation.SetApplicationRequired(v)
+	}
+	if _, ok := ic.mutation.RatingAverage(); !ok {
+		v := internship.DefaultRatingAverage
+		ic.mutation.SetRatingAverage(v)
+	}
+	if _, ok := ic.mutation.RatingCount(); !ok {
+		v := internship.DefaultRatingCount
+		ic.mutation.SetRatingCount(v)
+	}
 	if _, ok := ic.mutation.ID(); !ok {
 		v := internship.DefaultID()
 		ic.mutation.SetID(v)
@@ -390,6 +573,38 @@ func (ic *InternshipCreate) check() error {
 	if _, ok := ic.mutation.Total(); !ok {
 		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Internship.total"`)}
 	}
+	if _, ok := ic.mutation.PublishStatus(); !ok {
+		return &ValidationError{Name: "publish_status", err: errors.New(`ent: missing required field "Internship.publish_status"`)}
+	}
+	if v, ok := ic.mutation.PublishStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "publish_status", err: fmt.Errorf(`ent: validator failed for field "Internship.publish_status": %w`, err)}
+		}
+	}
+	if v, ok := ic.mutation.DraftStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "draft_status", err: fmt.Errorf(`ent: validator failed for field "Internship.draft_status": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ApplicationRequired(); !ok {
+		return &ValidationError{Name: "application_required", err: errors.New(`ent: missing required field "Internship.application_required"`)}
+	}
+	if _, ok := ic.mutation.RatingAverage(); !ok {
+		return &ValidationError{Name: "rating_average", err: errors.New(`ent: missing required field "Internship.rating_average"`)}
+	}
+	if v, ok := ic.mutation.RatingAverage(); ok {
+		if err := internship.RatingAverageValidator(v); err != nil {
+			return &ValidationError{Name: "rating_average", err: fmt.Errorf(`ent: validator failed for field "Internship.rating_average": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.RatingCount(); !ok {
+		return &ValidationError{Name: "rating_count", err: errors.New(`ent: missing required field "Internship.rating_count"`)}
+	}
+	if v, ok := ic.mutation.RatingCount(); ok {
+		if err := internship.RatingCountValidator(v); err != nil {
+			return &ValidationError{Name: "rating_count", err: fmt.Errorf(`ent: validator failed for field "Internship.rating_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -509,6 +724,58 @@ func (ic *InternshipCreate) createSpec() (*Internship, *sqlgraph.CreateSpec) {
 		_spec.SetField(internship.FieldTotal, field.TypeOther, value)
 		_node.Total = value
 	}
+	if value, ok := ic.mutation.PublishStatus(); ok {
+		_spec.SetField(internship.FieldPublishStatus, field.TypeString, value)
+		_node.PublishStatus = value
+	}
+	if value, ok := ic.mutation.Draft(); ok {
+		_spec.SetField(internship.FieldDraft, field.TypeJSON, value)
+		_node.Draft = value
+	}
+	if value, ok := ic.mutation.DraftStatus(); ok {
+		_spec.SetField(internship.FieldDraftStatus, field.TypeString, value)
+		_node.DraftStatus = &value
+	}
+	if value, ok := ic.mutation.ReviewComment(); ok {
+		_spec.SetField(internship.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = &value
+	}
+	if value, ok := ic.mutation.SubmittedAt(); ok {
+		_spec.SetField(internship.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := ic.mutation.ReviewedAt(); ok {
+		_spec.SetField(internship.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := ic.mutation.ReviewedBy(); ok {
+		_spec.SetField(internship.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := ic.mutation.PublishedAt(); ok {
+		_spec.SetField(internship.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := ic.mutation.CurrentRevisionID(); ok {
+		_spec.SetField(internship.FieldCurrentRevisionID, field.TypeString, value)
+		_node.CurrentRevisionID = &value
+	}
+	if value, ok := ic.mutation.ApplicationRequired(); ok {
+		_spec.SetField(internship.FieldApplicationRequired, field.TypeBool, value)
+		_node.ApplicationRequired = value
+	}
+	if value, ok := ic.mutation.ApplicationForm(); ok {
+		_spec.SetField(internship.FieldApplicationForm, field.TypeJSON, value)
+		_node.ApplicationForm = value
+	}
+	if value, ok := ic.mutation.RatingAverage(); ok {
+		_spec.SetField(internship.FieldRatingAverage, field.TypeFloat64, value)
+		_node.RatingAverage = value
+	}
+	if value, ok := ic.mutation.RatingCount(); ok {
+		_spec.SetField(internship.FieldRatingCount, field.TypeInt, value)
+		_node.RatingCount = value
+	}
 	if nodes := ic.mutation.CategoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -15,6 +15,7 @@ import (
 	"github.com/omkar273/codegeeky/ent/category"
 	"github.com/omkar273/codegeeky/ent/internship"
 	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -348,6 +349,240 @@ func (iu *InternshipUpdate) SetNillableTotal(d *decimal.Decimal) *InternshipUpda
 	return iu
 }
 
+// SetPublishStatus sets the "publish_status" field.
+func (iu *InternshipUpdate) SetPublishStatus(tps types.InternshipPublishStatus) *InternshipUpdate {
+	iu.mutation.SetPublishStatus(tps)
+	return iu
+}
+
+// SetNillablePublishStatus sets the "publish_status" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillablePublishStatus(tps *types.InternshipPublishStatus) *InternshipUpdate {
+	if tps != nil {
+		iu.SetPublishStatus(*tps)
+	}
+	return iu
+}
+
+// SetDraft sets the "draft" field.
+func (iu *InternshipUpdate) SetDraft(ts *types.InternshipSnapshot) *InternshipUpdate {
+	iu.mutation.SetDraft(ts)
+	return iu
+}
+
+// ClearDraft clears the value of the "draft" field.
+func (iu *InternshipUpdate) ClearDraft() *InternshipUpdate {
+	iu.mutation.ClearDraft()
+	return iu
+}
+
+// SetDraftStatus sets the "draft_status" field.
+func (iu *InternshipUpdate) SetDraftStatus(tps types.InternshipPublishStatus) *InternshipUpdate {
+	iu.mutation.SetDraftStatus(tps)
+	return iu
+}
+
+// SetNillableDraftStatus sets the "draft_status" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableDraftStatus(tps *types.InternshipPublishStatus) *InternshipUpdate {
+	if tps != nil {
+		iu.SetDraftStatus(*tps)
+	}
+	return iu
+}
+
+// ClearDraftStatus clears the value of the "draft_status" field.
+func (iu *InternshipUpdate) ClearDraftStatus() *InternshipUpdate {
+	iu.mutation.ClearDraftStatus()
+	return iu
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (iu *InternshipUpdate) SetReviewComment(s string) *InternshipUpdate {
+	iu.mutation.SetReviewComment(s)
+	return iu
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableReviewComment(s *string) *InternshipUpdate {
+	if s != nil {
+		iu.SetReviewComment(*s)
+	}
+	return iu
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (iu *InternshipUpdate) ClearReviewComment() *InternshipUpdate {
+	iu.mutation.ClearReviewComment()
+	return iu
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (iu *InternshipUpdate) SetSubmittedAt(t time.Time) *InternshipUpdate {
+	iu.mutation.SetSubmittedAt(t)
+	return iu
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableSubmittedAt(t *time.Time) *InternshipUpdate {
+	if t != nil {
+		iu.SetSubmittedAt(*t)
+	}
+	return iu
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (iu *InternshipUpdate) ClearSubmittedAt() *InternshipUpdate {
+	iu.mutation.ClearSubmittedAt()
+	return iu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (iu *InternshipUpdate) SetReviewedAt(t time.Time) *InternshipUpdate {
+	iu.mutation.SetReviewedAt(t)
+	return iu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableReviewedAt(t *time.Time) *InternshipUpdate {
+	if t != nil {
+		iu.SetReviewedAt(*t)
+	}
+	return iu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (iu *InternshipUpdate) ClearReviewedAt() *InternshipUpdate {
+	iu.mutation.ClearReviewedAt()
+	return iu
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (iu *InternshipUpdate) SetReviewedBy(s string) *InternshipUpdate {
+	iu.mutation.SetReviewedBy(s)
+	return iu
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableReviewedBy(s *string) *InternshipUpdate {
+	if s != nil {
+		iu.SetReviewedBy(*s)
+	}
+	return iu
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (iu *InternshipUpdate) ClearReviewedBy() *InternshipUpdate {
+	iu.mutation.ClearReviewedBy()
+	return iu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (iu *InternshipUpdate) SetPublishedAt(t time.Time) *InternshipUpdate {
+	iu.mutation.SetPublishedAt(t)
+	return iu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillablePublishedAt(t *time.Time) *InternshipUpdate {
+	if t != nil {
+		iu.SetPublishedAt(*t)
+	}
+	return iu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (iu *InternshipUpdate) ClearPublishedAt() *InternshipUpdate {
+	iu.mutation.ClearPublishedAt()
+	return iu
+}
+
+// SetCurrentRevisionID sets the "current_revision_id" field.
+func (iu *InternshipUpdate) SetCurrentRevisionID(s string) *InternshipUpdate {
+	iu.mutation.SetCurrentRevisionID(s)
+	return iu
+}
+
+// SetNillableCurrentRevisionID sets the "current_revision_id" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableCurrentRevisionID(s *string) *InternshipUpdate {
+	if s != nil {
+		iu.SetCurrentRevisionID(*s)
+	}
+	return iu
+}
+
+// ClearCurrentRevisionID clears the value of the "current_revision_id" field.
+func (iu *InternshipUpdate) ClearCurrentRevisionID() *InternshipUpdate {
+	iu.mutation.ClearCurrentRevisionID()
+	return iu
+}
+
+// SetApplicationRequired sets the "application_required" field.
+func (iu *InternshipUpdate) SetApplicationRequired(b bool) *InternshipUpdate {
+	iu.mutation.SetApplicationRequired(b)
+	return iu
+}
+
+// SetNillableApplicationRequired sets the "application_required" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableApplicationRequired(b *bool) *InternshipUpdate {
+	if b != nil {
+		iu.SetApplicationRequired(*b)
+	}
+	return iu
+}
+
+// SetApplicationForm sets the "application_form" field.
+func (iu *InternshipUpdate) SetApplicationForm(tf *types.ApplicationForm) *InternshipUpdate {
+	iu.mutation.SetApplicationForm(tf)
+	return iu
+}
+
+// ClearApplicationForm clears the value of the "application_form" field.
+func (iu *InternshipUpdate) ClearApplicationForm() *InternshipUpdate {
+	iu.mutation.ClearApplicationForm()
+	return iu
+}
+
+// SetRatingAverage sets the "rating_average" field.
+func (iu *InternshipUpdate) SetRatingAverage(f float64) *InternshipUpdate {
+	iu.mutation.ResetRatingAverage()
+	iu.mutation.SetRatingAverage(f)
+	return iu
+}
+
+// SetNillableRatingAverage sets the "rating_average" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableRatingAverage(f *float64) *InternshipUpdate {
+	if f != nil {
+		iu.SetRatingAverage(*f)
+	}
+	return iu
+}
+
+// AddRatingAverage adds f to the "rating_average" field.
+func (iu *InternshipUpdate) AddRatingAverage(f float64) *InternshipUpdate {
+	iu.mutation.AddRatingAverage(f)
+	return iu
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (iu *InternshipUpdate) SetRatingCount(i int) *InternshipUpdate {
+	iu.mutation.ResetRatingCount()
+	iu.mutation.SetRatingCount(i)
+	return iu
+}
+
+// SetNillableRatingCount sets the "rating_count" field if the given value is not nil.
+func (iu *InternshipUpdate) SetNillableRatingCount(i *int) *InternshipUpdate {
+	if i != nil {
+		iu.SetRatingCount(*i)
+	}
+	return iu
+}
+
+// AddRatingCount adds i to the "rating_count" field.
+func (iu *InternshipUpdate) AddRatingCount(i int) *InternshipUpdate {
+	iu.mutation.AddRatingCount(i)
+	return iu
+}
+
 // AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
 func (iu *InternshipUpdate) AddCategoryIDs(ids ...string) *InternshipUpdate {
 	iu.mutation.AddCategoryIDs(ids...)
@@ -447,6 +682,26 @@ func (iu *InternshipUpdate) check() error {
 			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Internship.mode": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.PublishStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "publish_status", err: fmt.Errorf(`ent: validator failed for field "Internship.publish_status": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.DraftStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "draft_status", err: fmt.Errorf(`ent: validator failed for field "Internship.draft_status": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.RatingAverage(); ok {
+		if err := internship.RatingAverageValidator(v); err != nil {
+			return &ValidationError{Name: "rating_average", err: fmt.Errorf(`ent: validator failed for field "Internship.rating_average": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.RatingCount(); ok {
+		if err := internship.RatingCountValidator(v); err != nil {
+			return &ValidationError{Name: "rating_count", err: fmt.Errorf(`ent: validator failed for field "Internship.rating_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -575,6 +830,78 @@ func (iu *InternshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.Total(); ok {
 		_spec.SetField(internship.FieldTotal, field.TypeOther, value)
 	}
+	if value, ok := iu.mutation.PublishStatus(); ok {
+		_spec.SetField(internship.FieldPublishStatus, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Draft(); ok {
+		_spec.SetField(internship.FieldDraft, field.TypeJSON, value)
+	}
+	if iu.mutation.DraftCleared() {
+		_spec.ClearField(internship.FieldDraft, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.DraftStatus(); ok {
+		_spec.SetField(internship.FieldDraftStatus, field.TypeString, value)
+	}
+	if iu.mutation.DraftStatusCleared() {
+		_spec.ClearField(internship.FieldDraftStatus, field.TypeString)
+	}
+	if value, ok := iu.mutation.ReviewComment(); ok {
+		_spec.SetField(internship.FieldReviewComment, field.TypeString, value)
+	}
+	if iu.mutation.ReviewCommentCleared() {
+		_spec.ClearField(internship.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := iu.mutation.SubmittedAt(); ok {
+		_spec.SetField(internship.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if iu.mutation.SubmittedAtCleared() {
+		_spec.ClearField(internship.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.ReviewedAt(); ok {
+		_spec.SetField(internship.FieldReviewedAt, field.TypeTime, value)
+	}
+	if iu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(internship.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.ReviewedBy(); ok {
+		_spec.SetField(internship.FieldReviewedBy, field.TypeString, value)
+	}
+	if iu.mutation.ReviewedByCleared() {
+		_spec.ClearField(internship.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := iu.mutation.PublishedAt(); ok {
+		_spec.SetField(internship.FieldPublishedAt, field.TypeTime, value)
+	}
+	if iu.mutation.PublishedAtCleared() {
+		_spec.ClearField(internship.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.CurrentRevisionID(); ok {
+		_spec.SetField(internship.FieldCurrentRevisionID, field.TypeString, value)
+	}
+	if iu.mutation.CurrentRevisionIDCleared() {
+		_spec.ClearField(internship.FieldCurrentRevisionID, field.TypeString)
+	}
+	if value, ok := iu.mutation.ApplicationRequired(); ok {
+		_spec.SetField(internship.FieldApplicationRequired, field.TypeBool, value)
+	}
+	if value, ok := iu.mutation.ApplicationForm(); ok {
+		_spec.SetField(internship.FieldApplicationForm, field.TypeJSON, value)
+	}
+	if iu.mutation.ApplicationFormCleared() {
+		_spec.ClearField(internship.FieldApplicationForm, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.RatingAverage(); ok {
+		_spec.SetField(internship.FieldRatingAverage, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedRatingAverage(); ok {
+		_spec.AddField(internship.FieldRatingAverage, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.RatingCount(); ok {
+		_spec.SetField(internship.FieldRatingCount, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedRatingCount(); ok {
+		_spec.AddField(internship.FieldRatingCount, field.TypeInt, value)
+	}
 	if iu.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -957,6 +1284,240 @@ func (iuo *InternshipUpdateOne) SetNillableTotal(d *decimal.Decimal) *Internship
 	return iuo
 }
 
+// SetPublishStatus sets the "publish_status" field.
+func (iuo *InternshipUpdateOne) SetPublishStatus(tps types.InternshipPublishStatus) *InternshipUpdateOne {
+	iuo.mutation.SetPublishStatus(tps)
+	return iuo
+}
+
+// SetNillablePublishStatus sets the "publish_status" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillablePublishStatus(tps *types.InternshipPublishStatus) *InternshipUpdateOne {
+	if tps != nil {
+		iuo.SetPublishStatus(*tps)
+	}
+	return iuo
+}
+
+// SetDraft sets the "draft" field.
+func (iuo *InternshipUpdateOne) SetDraft(ts *types.InternshipSnapshot) *InternshipUpdateOne {
+	iuo.mutation.SetDraft(ts)
+	return iuo
+}
+
+// ClearDraft clears the value of the "draft" field.
+func (iuo *InternshipUpdateOne) ClearDraft() *InternshipUpdateOne {
+	iuo.mutation.ClearDraft()
+	return iuo
+}
+
+// SetDraftStatus sets the "draft_status" field.
+func (iuo *InternshipUpdateOne) SetDraftStatus(tps types.InternshipPublishStatus) *InternshipUpdateOne {
+	iuo.mutation.SetDraftStatus(tps)
+	return iuo
+}
+
+// SetNillableDraftStatus sets the "draft_status" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableDraftStatus(tps *types.InternshipPublishStatus) *InternshipUpdateOne {
+	if tps != nil {
+		iuo.SetDraftStatus(*tps)
+	}
+	return iuo
+}
+
+// ClearDraftStatus clears the value of the "draft_status" field.
+func (iuo *InternshipUpdateOne) ClearDraftStatus() *InternshipUpdateOne {
+	iuo.mutation.ClearDraftStatus()
+	return iuo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (iuo *InternshipUpdateOne) SetReviewComment(s string) *InternshipUpdateOne {
+	iuo.mutation.SetReviewComment(s)
+	return iuo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableReviewComment(s *string) *InternshipUpdateOne {
+	if s != nil {
+		iuo.SetReviewComment(*s)
+	}
+	return iuo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (iuo *InternshipUpdateOne) ClearReviewComment() *InternshipUpdateOne {
+	iuo.mutation.ClearReviewComment()
+	return iuo
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (iuo *InternshipUpdateOne) SetSubmittedAt(t time.Time) *InternshipUpdateOne {
+	iuo.mutation.SetSubmittedAt(t)
+	return iuo
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableSubmittedAt(t *time.Time) *InternshipUpdateOne {
+	if t != nil {
+		iuo.SetSubmittedAt(*t)
+	}
+	return iuo
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (iuo *InternshipUpdateOne) ClearSubmittedAt() *InternshipUpdateOne {
+	iuo.mutation.ClearSubmittedAt()
+	return iuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (iuo *InternshipUpdateOne) SetReviewedAt(t time.Time) *InternshipUpdateOne {
+	iuo.mutation.SetReviewedAt(t)
+	return iuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableReviewedAt(t *time.Time) *InternshipUpdateOne {
+	if t != nil {
+		iuo.SetReviewedAt(*t)
+	}
+	return iuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (iuo *InternshipUpdateOne) ClearReviewedAt() *InternshipUpdateOne {
+	iuo.mutation.ClearReviewedAt()
+	return iuo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (iuo *InternshipUpdateOne) SetReviewedBy(s string) *InternshipUpdateOne {
+	iuo.mutation.SetReviewedBy(s)
+	return iuo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableReviewedBy(s *string) *InternshipUpdateOne {
+	if s != nil {
+		iuo.SetReviewedBy(*s)
+	}
+	return iuo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (iuo *InternshipUpdateOne) ClearReviewedBy() *InternshipUpdateOne {
+	iuo.mutation.ClearReviewedBy()
+	return iuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (iuo *InternshipUpdateOne) SetPublishedAt(t time.Time) *InternshipUpdateOne {
+	iuo.mutation.SetPublishedAt(t)
+	return iuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillablePublishedAt(t *time.Time) *InternshipUpdateOne {
+	if t != nil {
+		iuo.SetPublishedAt(*t)
+	}
+	return iuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (iuo *InternshipUpdateOne) ClearPublishedAt() *InternshipUpdateOne {
+	iuo.mutation.ClearPublishedAt()
+	return iuo
+}
+
+// SetCurrentRevisionID sets the "current_revision_id" field.
+func (iuo *InternshipUpdateOne) SetCurrentRevisionID(s string) *InternshipUpdateOne {
+	iuo.mutation.SetCurrentRevisionID(s)
+	return iuo
+}
+
+// SetNillableCurrentRevisionID sets the "current_revision_id" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableCurrentRevisionID(s *string) *InternshipUpdateOne {
+	if s != nil {
+		iuo.SetCurrentRevisionID(*s)
+	}
+	return iuo
+}
+
+// ClearCurrentRevisionID clears the value of the "current_revision_id" field.
+func (iuo *InternshipUpdateOne) ClearCurrentRevisionID() *InternshipUpdateOne {
+	iuo.mutation.ClearCurrentRevisionID()
+	return iuo
+}
+
+// SetApplicationRequired sets the "application_required" field.
+func (iuo *InternshipUpdateOne) SetApplicationRequired(b bool) *InternshipUpdateOne {
+	iuo.mutation.SetApplicationRequired(b)
+	return iuo
+}
+
+// SetNillableApplicationRequired sets the "application_required" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableApplicationRequired(b *bool) *InternshipUpdateOne {
+	if b != nil {
+		iuo.SetApplicationRequired(*b)
+	}
+	return iuo
+}
+
+// SetApplicationForm sets the "application_form" field.
+func (iuo *InternshipUpdateOne) SetApplicationForm(tf *types.ApplicationForm) *InternshipUpdateOne {
+	iuo.mutation.SetApplicationForm(tf)
+	return iuo
+}
+
+// ClearApplicationForm clears the value of the "application_form" field.
+func (iuo *InternshipUpdateOne) ClearApplicationForm() *InternshipUpdateOne {
+	iuo.mutation.ClearApplicationForm()
+	return iuo
+}
+
+// SetRatingAverage sets the "rating_average" field.
+func (iuo *InternshipUpdateOne) SetRatingAverage(f float64) *InternshipUpdateOne {
+	iuo.mutation.ResetRatingAverage()
+	iuo.mutation.SetRatingAverage(f)
+	return iuo
+}
+
+// SetNillableRatingAverage sets the "rating_average" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableRatingAverage(f *float64) *InternshipUpdateOne {
+	if f != nil {
+		iuo.SetRatingAverage(*f)
+	}
+	return iuo
+}
+
+// AddRatingAverage adds f to the "rating_average" field.
+func (iuo *InternshipUpdateOne) AddRatingAverage(f float64) *InternshipUpdateOne {
+	iuo.mutation.AddRatingAverage(f)
+	return iuo
+}
+
+// SetRatingCount sets the "rating_count" field.
+func (iuo *InternshipUpdateOne) SetRatingCount(i int) *InternshipUpdateOne {
+	iuo.mutation.ResetRatingCount()
+	iuo.mutation.SetRatingCount(i)
+	return iuo
+}
+
+// SetNillableRatingCount sets the "rating_count" field if the given value is not nil.
+func (iuo *InternshipUpdateOne) SetNillableRatingCount(i *int) *InternshipUpdateOne {
+	if i != nil {
+		iuo.SetRatingCount(*i)
+	}
+	return iuo
+}
+
+// AddRatingCount adds i to the "rating_count" field.
+func (iuo *InternshipUpdateOne) AddRatingCount(i int) *InternshipUpdateOne {
+	iuo.mutation.AddRatingCount(i)
+	return iuo
+}
+
 // AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
 func (iuo *InternshipUpdateOne) AddCategoryIDs(ids ...string) *InternshipUpdateOne {
 	iuo.mutation.AddCategoryIDs(ids...)
@@ -1069,6 +1630,26 @@ func (iuo *InternshipUpdateOne) check() error {
 			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Internship.mode": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.PublishStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "publish_status", err: fmt.Errorf(`ent: validator failed for field "Internship.publish_status": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.DraftStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "draft_status", err: fmt.Errorf(`ent: validator failed for field "Internship.draft_status": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.RatingAverage(); ok {
+		if err := internship.RatingAverageValidator(v); err != nil {
+			return &ValidationError{Name: "rating_average", err: fmt.Errorf(`ent: validator failed for field "Internship.rating_average": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.RatingCount(); ok {
+		if err := internship.RatingCountValidator(v); err != nil {
+			return &ValidationError{Name: "rating_count", err: fmt.Errorf(`ent: validator failed for field "Internship.rating_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1214,6 +1795,78 @@ func (iuo *InternshipUpdateOne) sqlSave(ctx context.Context) (_node *Internship,
 	if value, ok := iuo.mutation.Total(); ok {
 		_spec.SetField(internship.FieldTotal, field.TypeOther, value)
 	}
+	if value, ok := iuo.mutation.PublishStatus(); ok {
+		_spec.SetField(internship.FieldPublishStatus, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Draft(); ok {
+		_spec.SetField(internship.FieldDraft, field.TypeJSON, value)
+	}
+	if iuo.mutation.DraftCleared() {
+		_spec.ClearField(internship.FieldDraft, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.DraftStatus(); ok {
+		_spec.SetField(internship.FieldDraftStatus, field.TypeString, value)
+	}
+	if iuo.mutation.DraftStatusCleared() {
+		_spec.ClearField(internship.FieldDraftStatus, field.TypeString)
+	}
+	if value, ok := iuo.mutation.ReviewComment(); ok {
+		_spec.SetField(internship.FieldReviewComment, field.TypeString, value)
+	}
+	if iuo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(internship.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := iuo.mutation.SubmittedAt(); ok {
+		_spec.SetField(internship.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.SubmittedAtCleared() {
+		_spec.ClearField(internship.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(internship.FieldReviewedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(internship.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.ReviewedBy(); ok {
+		_spec.SetField(internship.FieldReviewedBy, field.TypeString, value)
+	}
+	if iuo.mutation.ReviewedByCleared() {
+		_spec.ClearField(internship.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := iuo.mutation.PublishedAt(); ok {
+		_spec.SetField(internship.FieldPublishedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(internship.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.CurrentRevisionID(); ok {
+		_spec.SetField(internship.FieldCurrentRevisionID, field.TypeString, value)
+	}
+	if iuo.mutation.CurrentRevisionIDCleared() {
+		_spec.ClearField(internship.FieldCurrentRevisionID, field.TypeString)
+	}
+	if value, ok := iuo.mutation.ApplicationRequired(); ok {
+		_spec.SetField(internship.FieldApplicationRequired, field.TypeBool, value)
+	}
+	if value, ok := iuo.mutation.ApplicationForm(); ok {
+		_spec.SetField(internship.FieldApplicationForm, field.TypeJSON, value)
+	}
+	if iuo.mutation.ApplicationFormCleared() {
+		_spec.ClearField(internship.FieldApplicationForm, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.RatingAverage(); ok {
+		_spec.SetField(internship.FieldRatingAverage, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedRatingAverage(); ok {
+		_spec.AddField(internship.FieldRatingAverage, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.RatingCount(); ok {
+		_spec.SetField(internship.FieldRatingCount, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedRatingCount(); ok {
+		_spec.AddField(internship.FieldRatingCount, field.TypeInt, value)
+	}
 	if iuo.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
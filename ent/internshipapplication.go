@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipApplication is the model entity for the InternshipApplication schema.
+type InternshipApplication struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// InternshipBatchID holds the value of the "internship_batch_id" field.
+	InternshipBatchID string `json:"internship_batch_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Answers holds the value of the "answers" field.
+	Answers []types.ApplicationAnswer `json:"answers,omitempty"`
+	// ResumeFileID holds the value of the "resume_file_id" field.
+	ResumeFileID *string `json:"resume_file_id,omitempty"`
+	// ApplicationStatus holds the value of the "application_status" field.
+	ApplicationStatus string `json:"application_status,omitempty"`
+	// InterviewAt holds the value of the "interview_at" field.
+	InterviewAt *time.Time `json:"interview_at,omitempty"`
+	// ReviewerNote holds the value of the "reviewer_note" field.
+	ReviewerNote *string `json:"reviewer_note,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// OfferExpiresAt holds the value of the "offer_expires_at" field.
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InternshipApplication) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case internshipapplication.FieldAnswers:
+			values[i] = new([]byte)
+		case internshipapplication.FieldID, internshipapplication.FieldStatus, internshipapplication.FieldCreatedBy, internshipapplication.FieldUpdatedBy, internshipapplication.FieldInternshipID, internshipapplication.FieldInternshipBatchID, internshipapplication.FieldUserID, internshipapplication.FieldResumeFileID, internshipapplication.FieldApplicationStatus, internshipapplication.FieldReviewerNote, internshipapplication.FieldReviewedBy:
+			values[i] = new(sql.NullString)
+		case internshipapplication.FieldCreatedAt, internshipapplication.FieldUpdatedAt, internshipapplication.FieldInterviewAt, internshipapplication.FieldReviewedAt, internshipapplication.FieldOfferExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InternshipApplication fields.
+func (ia *InternshipApplication) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case internshipapplication.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ia.ID = value.String
+			}
+		case internshipapplication.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ia.Status = value.String
+			}
+		case internshipapplication.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ia.CreatedAt = value.Time
+			}
+		case internshipapplication.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ia.UpdatedAt = value.Time
+			}
+		case internshipapplication.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ia.CreatedBy = value.String
+			}
+		case internshipapplication.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ia.UpdatedBy = value.String
+			}
+		case internshipapplication.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				ia.InternshipID = value.String
+			}
+		case internshipapplication.FieldInternshipBatchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_batch_id", values[i])
+			} else if value.Valid {
+				ia.InternshipBatchID = value.String
+			}
+		case internshipapplication.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ia.UserID = value.String
+			}
+		case internshipapplication.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ia.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case internshipapplication.FieldResumeFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_file_id", values[i])
+			} else if value.Valid {
+				ia.ResumeFileID = new(string)
+				*ia.ResumeFileID = value.String
+			}
+		case internshipapplication.FieldApplicationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_status", values[i])
+			} else if value.Valid {
+				ia.ApplicationStatus = value.String
+			}
+		case internshipapplication.FieldInterviewAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field interview_at", values[i])
+			} else if value.Valid {
+				ia.InterviewAt = new(time.Time)
+				*ia.InterviewAt = value.Time
+			}
+		case internshipapplication.FieldReviewerNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_note", values[i])
+			} else if value.Valid {
+				ia.ReviewerNote = new(string)
+				*ia.ReviewerNote = value.String
+			}
+		case internshipapplication.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				ia.ReviewedBy = new(string)
+				*ia.ReviewedBy = value.String
+			}
+		case internshipapplication.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				ia.ReviewedAt = new(time.Time)
+				*ia.ReviewedAt = value.Time
+			}
+		case internshipapplication.FieldOfferExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offer_expires_at", values[i])
+			} else if value.Valid {
+				ia.OfferExpiresAt = new(time.Time)
+				*ia.OfferExpiresAt = value.Time
+			}
+		default:
+			ia.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InternshipApplication.
+// This includes values selected through modifiers, order, etc.
+func (ia *InternshipApplication) Value(name string) (ent.Value, error) {
+	return ia.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InternshipApplication.
+// Note that you need to call InternshipApplication.Unwrap() before calling this method if this InternshipApplication
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ia *InternshipApplication) Update() *InternshipApplicationUpdateOne {
+	return NewInternshipApplicationClient(ia.config).UpdateOne(ia)
+}
+
+// Unwrap unwraps the InternshipApplication entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ia *InternshipApplication) Unwrap() *InternshipApplication {
+	_tx, ok := ia.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InternshipApplication is not a transactional entity")
+	}
+	ia.config.driver = _tx.drv
+	return ia
+}
+
+// String implements the fmt.Stringer.
+func (ia *InternshipApplication) String() string {
+	var builder strings.Builder
+	builder.WriteString("InternshipApplication(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ia.ID))
+	builder.WriteString("status=")
+	builder.WriteString(ia.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ia.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ia.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ia.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ia.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(ia.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_batch_id=")
+	builder.WriteString(ia.InternshipBatchID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ia.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", ia.Answers))
+	builder.WriteString(", ")
+	if v := ia.ResumeFileID; v != nil {
+		builder.WriteString("resume_file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("application_status=")
+	builder.WriteString(ia.ApplicationStatus)
+	builder.WriteString(", ")
+	if v := ia.InterviewAt; v != nil {
+		builder.WriteString("interview_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ia.ReviewerNote; v != nil {
+		builder.WriteString("reviewer_note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ia.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ia.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ia.OfferExpiresAt; v != nil {
+		builder.WriteString("offer_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InternshipApplications is a parsable slice of InternshipApplication.
+type InternshipApplications []*InternshipApplication
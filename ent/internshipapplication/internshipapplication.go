@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internshipapplication
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the internshipapplication type in the database.
+	Label = "internship_application"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldInternshipID holds the string denoting the internship_id field in the database.
+	FieldInternshipID = "internship_id"
+	// FieldInternshipBatchID holds the string denoting the internship_batch_id field in the database.
+	FieldInternshipBatchID = "internship_batch_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAnswers holds the string denoting the answers field in the database.
+	FieldAnswers = "answers"
+	// FieldResumeFileID holds the string denoting the resume_file_id field in the database.
+	FieldResumeFileID = "resume_file_id"
+	// FieldApplicationStatus holds the string denoting the application_status field in the database.
+	FieldApplicationStatus = "application_status"
+	// FieldInterviewAt holds the string denoting the interview_at field in the database.
+	FieldInterviewAt = "interview_at"
+	// FieldReviewerNote holds the string denoting the reviewer_note field in the database.
+	FieldReviewerNote = "reviewer_note"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldOfferExpiresAt holds the string denoting the offer_expires_at field in the database.
+	FieldOfferExpiresAt = "offer_expires_at"
+	// Table holds the table name of the internshipapplication in the database.
+	Table = "internship_applications"
+)
+
+// Columns holds all SQL columns for internshipapplication fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldInternshipID,
+	FieldInternshipBatchID,
+	FieldUserID,
+	FieldAnswers,
+	FieldResumeFileID,
+	FieldApplicationStatus,
+	FieldInterviewAt,
+	FieldReviewerNote,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldOfferExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	InternshipIDValidator func(string) error
+	// InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	InternshipBatchIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultAnswers holds the default value on creation for the "answers" field.
+	DefaultAnswers []types.ApplicationAnswer
+	// DefaultApplicationStatus holds the default value on creation for the "application_status" field.
+	DefaultApplicationStatus string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the InternshipApplication queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByInternshipID orders the results by the internship_id field.
+func ByInternshipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipID, opts...).ToFunc()
+}
+
+// ByInternshipBatchID orders the results by the internship_batch_id field.
+func ByInternshipBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipBatchID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByResumeFileID orders the results by the resume_file_id field.
+func ByResumeFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeFileID, opts...).ToFunc()
+}
+
+// ByApplicationStatus orders the results by the application_status field.
+func ByApplicationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationStatus, opts...).ToFunc()
+}
+
+// ByInterviewAt orders the results by the interview_at field.
+func ByInterviewAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterviewAt, opts...).ToFunc()
+}
+
+// ByReviewerNote orders the results by the reviewer_note field.
+func ByReviewerNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerNote, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByOfferExpiresAt orders the results by the offer_expires_at field.
+func ByOfferExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOfferExpiresAt, opts...).ToFunc()
+}
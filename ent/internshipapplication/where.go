@@ -0,0 +1,1085 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internshipapplication
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipBatchID applies equality check predicate on the "internship_batch_id" field. It's identical to InternshipBatchIDEQ.
+func InternshipBatchID(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldUserID, v))
+}
+
+// ResumeFileID applies equality check predicate on the "resume_file_id" field. It's identical to ResumeFileIDEQ.
+func ResumeFileID(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldResumeFileID, v))
+}
+
+// ApplicationStatus applies equality check predicate on the "application_status" field. It's identical to ApplicationStatusEQ.
+func ApplicationStatus(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldApplicationStatus, v))
+}
+
+// InterviewAt applies equality check predicate on the "interview_at" field. It's identical to InterviewAtEQ.
+func InterviewAt(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldInterviewAt, v))
+}
+
+// ReviewerNote applies equality check predicate on the "reviewer_note" field. It's identical to ReviewerNoteEQ.
+func ReviewerNote(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldReviewerNote, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// OfferExpiresAt applies equality check predicate on the "offer_expires_at" field. It's identical to OfferExpiresAtEQ.
+func OfferExpiresAt(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldOfferExpiresAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// InternshipBatchIDEQ applies the EQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDNEQ applies the NEQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDIn applies the In predicate on the "internship_batch_id" field.
+func InternshipBatchIDIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDNotIn applies the NotIn predicate on the "internship_batch_id" field.
+func InternshipBatchIDNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDGT applies the GT predicate on the "internship_batch_id" field.
+func InternshipBatchIDGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDGTE applies the GTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLT applies the LT predicate on the "internship_batch_id" field.
+func InternshipBatchIDLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLTE applies the LTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContains applies the Contains predicate on the "internship_batch_id" field.
+func InternshipBatchIDContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasPrefix applies the HasPrefix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasSuffix applies the HasSuffix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDEqualFold applies the EqualFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContainsFold applies the ContainsFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldInternshipBatchID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ResumeFileIDEQ applies the EQ predicate on the "resume_file_id" field.
+func ResumeFileIDEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldResumeFileID, v))
+}
+
+// ResumeFileIDNEQ applies the NEQ predicate on the "resume_file_id" field.
+func ResumeFileIDNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldResumeFileID, v))
+}
+
+// ResumeFileIDIn applies the In predicate on the "resume_file_id" field.
+func ResumeFileIDIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldResumeFileID, vs...))
+}
+
+// ResumeFileIDNotIn applies the NotIn predicate on the "resume_file_id" field.
+func ResumeFileIDNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldResumeFileID, vs...))
+}
+
+// ResumeFileIDGT applies the GT predicate on the "resume_file_id" field.
+func ResumeFileIDGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldResumeFileID, v))
+}
+
+// ResumeFileIDGTE applies the GTE predicate on the "resume_file_id" field.
+func ResumeFileIDGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldResumeFileID, v))
+}
+
+// ResumeFileIDLT applies the LT predicate on the "resume_file_id" field.
+func ResumeFileIDLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldResumeFileID, v))
+}
+
+// ResumeFileIDLTE applies the LTE predicate on the "resume_file_id" field.
+func ResumeFileIDLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldResumeFileID, v))
+}
+
+// ResumeFileIDContains applies the Contains predicate on the "resume_file_id" field.
+func ResumeFileIDContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldResumeFileID, v))
+}
+
+// ResumeFileIDHasPrefix applies the HasPrefix predicate on the "resume_file_id" field.
+func ResumeFileIDHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldResumeFileID, v))
+}
+
+// ResumeFileIDHasSuffix applies the HasSuffix predicate on the "resume_file_id" field.
+func ResumeFileIDHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldResumeFileID, v))
+}
+
+// ResumeFileIDIsNil applies the IsNil predicate on the "resume_file_id" field.
+func ResumeFileIDIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldResumeFileID))
+}
+
+// ResumeFileIDNotNil applies the NotNil predicate on the "resume_file_id" field.
+func ResumeFileIDNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldResumeFileID))
+}
+
+// ResumeFileIDEqualFold applies the EqualFold predicate on the "resume_file_id" field.
+func ResumeFileIDEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldResumeFileID, v))
+}
+
+// ResumeFileIDContainsFold applies the ContainsFold predicate on the "resume_file_id" field.
+func ResumeFileIDContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldResumeFileID, v))
+}
+
+// ApplicationStatusEQ applies the EQ predicate on the "application_status" field.
+func ApplicationStatusEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusNEQ applies the NEQ predicate on the "application_status" field.
+func ApplicationStatusNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusIn applies the In predicate on the "application_status" field.
+func ApplicationStatusIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldApplicationStatus, vs...))
+}
+
+// ApplicationStatusNotIn applies the NotIn predicate on the "application_status" field.
+func ApplicationStatusNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldApplicationStatus, vs...))
+}
+
+// ApplicationStatusGT applies the GT predicate on the "application_status" field.
+func ApplicationStatusGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusGTE applies the GTE predicate on the "application_status" field.
+func ApplicationStatusGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusLT applies the LT predicate on the "application_status" field.
+func ApplicationStatusLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusLTE applies the LTE predicate on the "application_status" field.
+func ApplicationStatusLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusContains applies the Contains predicate on the "application_status" field.
+func ApplicationStatusContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusHasPrefix applies the HasPrefix predicate on the "application_status" field.
+func ApplicationStatusHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusHasSuffix applies the HasSuffix predicate on the "application_status" field.
+func ApplicationStatusHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusEqualFold applies the EqualFold predicate on the "application_status" field.
+func ApplicationStatusEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldApplicationStatus, v))
+}
+
+// ApplicationStatusContainsFold applies the ContainsFold predicate on the "application_status" field.
+func ApplicationStatusContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldApplicationStatus, v))
+}
+
+// InterviewAtEQ applies the EQ predicate on the "interview_at" field.
+func InterviewAtEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldInterviewAt, v))
+}
+
+// InterviewAtNEQ applies the NEQ predicate on the "interview_at" field.
+func InterviewAtNEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldInterviewAt, v))
+}
+
+// InterviewAtIn applies the In predicate on the "interview_at" field.
+func InterviewAtIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldInterviewAt, vs...))
+}
+
+// InterviewAtNotIn applies the NotIn predicate on the "interview_at" field.
+func InterviewAtNotIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldInterviewAt, vs...))
+}
+
+// InterviewAtGT applies the GT predicate on the "interview_at" field.
+func InterviewAtGT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldInterviewAt, v))
+}
+
+// InterviewAtGTE applies the GTE predicate on the "interview_at" field.
+func InterviewAtGTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldInterviewAt, v))
+}
+
+// InterviewAtLT applies the LT predicate on the "interview_at" field.
+func InterviewAtLT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldInterviewAt, v))
+}
+
+// InterviewAtLTE applies the LTE predicate on the "interview_at" field.
+func InterviewAtLTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldInterviewAt, v))
+}
+
+// InterviewAtIsNil applies the IsNil predicate on the "interview_at" field.
+func InterviewAtIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldInterviewAt))
+}
+
+// InterviewAtNotNil applies the NotNil predicate on the "interview_at" field.
+func InterviewAtNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldInterviewAt))
+}
+
+// ReviewerNoteEQ applies the EQ predicate on the "reviewer_note" field.
+func ReviewerNoteEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldReviewerNote, v))
+}
+
+// ReviewerNoteNEQ applies the NEQ predicate on the "reviewer_note" field.
+func ReviewerNoteNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldReviewerNote, v))
+}
+
+// ReviewerNoteIn applies the In predicate on the "reviewer_note" field.
+func ReviewerNoteIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldReviewerNote, vs...))
+}
+
+// ReviewerNoteNotIn applies the NotIn predicate on the "reviewer_note" field.
+func ReviewerNoteNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldReviewerNote, vs...))
+}
+
+// ReviewerNoteGT applies the GT predicate on the "reviewer_note" field.
+func ReviewerNoteGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldReviewerNote, v))
+}
+
+// ReviewerNoteGTE applies the GTE predicate on the "reviewer_note" field.
+func ReviewerNoteGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldReviewerNote, v))
+}
+
+// ReviewerNoteLT applies the LT predicate on the "reviewer_note" field.
+func ReviewerNoteLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldReviewerNote, v))
+}
+
+// ReviewerNoteLTE applies the LTE predicate on the "reviewer_note" field.
+func ReviewerNoteLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldReviewerNote, v))
+}
+
+// ReviewerNoteContains applies the Contains predicate on the "reviewer_note" field.
+func ReviewerNoteContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldReviewerNote, v))
+}
+
+// ReviewerNoteHasPrefix applies the HasPrefix predicate on the "reviewer_note" field.
+func ReviewerNoteHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldReviewerNote, v))
+}
+
+// ReviewerNoteHasSuffix applies the HasSuffix predicate on the "reviewer_note" field.
+func ReviewerNoteHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldReviewerNote, v))
+}
+
+// ReviewerNoteIsNil applies the IsNil predicate on the "reviewer_note" field.
+func ReviewerNoteIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldReviewerNote))
+}
+
+// ReviewerNoteNotNil applies the NotNil predicate on the "reviewer_note" field.
+func ReviewerNoteNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldReviewerNote))
+}
+
+// ReviewerNoteEqualFold applies the EqualFold predicate on the "reviewer_note" field.
+func ReviewerNoteEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldReviewerNote, v))
+}
+
+// ReviewerNoteContainsFold applies the ContainsFold predicate on the "reviewer_note" field.
+func ReviewerNoteContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldReviewerNote, v))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContains(FieldReviewedBy, v))
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasPrefix(FieldReviewedBy, v))
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldHasSuffix(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEqualFold(FieldReviewedBy, v))
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldContainsFold(FieldReviewedBy, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// OfferExpiresAtEQ applies the EQ predicate on the "offer_expires_at" field.
+func OfferExpiresAtEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldEQ(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtNEQ applies the NEQ predicate on the "offer_expires_at" field.
+func OfferExpiresAtNEQ(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNEQ(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtIn applies the In predicate on the "offer_expires_at" field.
+func OfferExpiresAtIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIn(FieldOfferExpiresAt, vs...))
+}
+
+// OfferExpiresAtNotIn applies the NotIn predicate on the "offer_expires_at" field.
+func OfferExpiresAtNotIn(vs ...time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotIn(FieldOfferExpiresAt, vs...))
+}
+
+// OfferExpiresAtGT applies the GT predicate on the "offer_expires_at" field.
+func OfferExpiresAtGT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGT(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtGTE applies the GTE predicate on the "offer_expires_at" field.
+func OfferExpiresAtGTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldGTE(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtLT applies the LT predicate on the "offer_expires_at" field.
+func OfferExpiresAtLT(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLT(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtLTE applies the LTE predicate on the "offer_expires_at" field.
+func OfferExpiresAtLTE(v time.Time) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldLTE(FieldOfferExpiresAt, v))
+}
+
+// OfferExpiresAtIsNil applies the IsNil predicate on the "offer_expires_at" field.
+func OfferExpiresAtIsNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldIsNull(FieldOfferExpiresAt))
+}
+
+// OfferExpiresAtNotNil applies the NotNil predicate on the "offer_expires_at" field.
+func OfferExpiresAtNotNil() predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.FieldNotNull(FieldOfferExpiresAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InternshipApplication) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InternshipApplication) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InternshipApplication) predicate.InternshipApplication {
+	return predicate.InternshipApplication(sql.NotPredicates(p))
+}
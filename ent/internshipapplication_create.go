@@ -0,0 +1,516 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipApplicationCreate is the builder for creating a InternshipApplication entity.
+type InternshipApplicationCreate struct {
+	config
+	mutation *InternshipApplicationMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (iac *InternshipApplicationCreate) SetStatus(s string) *InternshipApplicationCreate {
+	iac.mutation.SetStatus(s)
+	return iac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableStatus(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetStatus(*s)
+	}
+	return iac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iac *InternshipApplicationCreate) SetCreatedAt(t time.Time) *InternshipApplicationCreate {
+	iac.mutation.SetCreatedAt(t)
+	return iac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableCreatedAt(t *time.Time) *InternshipApplicationCreate {
+	if t != nil {
+		iac.SetCreatedAt(*t)
+	}
+	return iac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iac *InternshipApplicationCreate) SetUpdatedAt(t time.Time) *InternshipApplicationCreate {
+	iac.mutation.SetUpdatedAt(t)
+	return iac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableUpdatedAt(t *time.Time) *InternshipApplicationCreate {
+	if t != nil {
+		iac.SetUpdatedAt(*t)
+	}
+	return iac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iac *InternshipApplicationCreate) SetCreatedBy(s string) *InternshipApplicationCreate {
+	iac.mutation.SetCreatedBy(s)
+	return iac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableCreatedBy(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetCreatedBy(*s)
+	}
+	return iac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iac *InternshipApplicationCreate) SetUpdatedBy(s string) *InternshipApplicationCreate {
+	iac.mutation.SetUpdatedBy(s)
+	return iac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableUpdatedBy(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetUpdatedBy(*s)
+	}
+	return iac
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (iac *InternshipApplicationCreate) SetInternshipID(s string) *InternshipApplicationCreate {
+	iac.mutation.SetInternshipID(s)
+	return iac
+}
+
+// SetInternshipBatchID sets the "internship_batch_id" field.
+func (iac *InternshipApplicationCreate) SetInternshipBatchID(s string) *InternshipApplicationCreate {
+	iac.mutation.SetInternshipBatchID(s)
+	return iac
+}
+
+// SetUserID sets the "user_id" field.
+func (iac *InternshipApplicationCreate) SetUserID(s string) *InternshipApplicationCreate {
+	iac.mutation.SetUserID(s)
+	return iac
+}
+
+// SetAnswers sets the "answers" field.
+func (iac *InternshipApplicationCreate) SetAnswers(ta []types.ApplicationAnswer) *InternshipApplicationCreate {
+	iac.mutation.SetAnswers(ta)
+	return iac
+}
+
+// SetResumeFileID sets the "resume_file_id" field.
+func (iac *InternshipApplicationCreate) SetResumeFileID(s string) *InternshipApplicationCreate {
+	iac.mutation.SetResumeFileID(s)
+	return iac
+}
+
+// SetNillableResumeFileID sets the "resume_file_id" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableResumeFileID(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetResumeFileID(*s)
+	}
+	return iac
+}
+
+// SetApplicationStatus sets the "application_status" field.
+func (iac *InternshipApplicationCreate) SetApplicationStatus(s string) *InternshipApplicationCreate {
+	iac.mutation.SetApplicationStatus(s)
+	return iac
+}
+
+// SetNillableApplicationStatus sets the "application_status" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableApplicationStatus(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetApplicationStatus(*s)
+	}
+	return iac
+}
+
+// SetInterviewAt sets the "interview_at" field.
+func (iac *InternshipApplicationCreate) SetInterviewAt(t time.Time) *InternshipApplicationCreate {
+	iac.mutation.SetInterviewAt(t)
+	return iac
+}
+
+// SetNillableInterviewAt sets the "interview_at" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableInterviewAt(t *time.Time) *InternshipApplicationCreate {
+	if t != nil {
+		iac.SetInterviewAt(*t)
+	}
+	return iac
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (iac *InternshipApplicationCreate) SetReviewerNote(s string) *InternshipApplicationCreate {
+	iac.mutation.SetReviewerNote(s)
+	return iac
+}
+
+// SetNillableReviewerNote sets the "reviewer_note" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableReviewerNote(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetReviewerNote(*s)
+	}
+	return iac
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (iac *InternshipApplicationCreate) SetReviewedBy(s string) *InternshipApplicationCreate {
+	iac.mutation.SetReviewedBy(s)
+	return iac
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableReviewedBy(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetReviewedBy(*s)
+	}
+	return iac
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (iac *InternshipApplicationCreate) SetReviewedAt(t time.Time) *InternshipApplicationCreate {
+	iac.mutation.SetReviewedAt(t)
+	return iac
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableReviewedAt(t *time.Time) *InternshipApplicationCreate {
+	if t != nil {
+		iac.SetReviewedAt(*t)
+	}
+	return iac
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (iac *InternshipApplicationCreate) SetOfferExpiresAt(t time.Time) *InternshipApplicationCreate {
+	iac.mutation.SetOfferExpiresAt(t)
+	return iac
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableOfferExpiresAt(t *time.Time) *InternshipApplicationCreate {
+	if t != nil {
+		iac.SetOfferExpiresAt(*t)
+	}
+	return iac
+}
+
+// SetID sets the "id" field.
+func (iac *InternshipApplicationCreate) SetID(s string) *InternshipApplicationCreate {
+	iac.mutation.SetID(s)
+	return iac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (iac *InternshipApplicationCreate) SetNillableID(s *string) *InternshipApplicationCreate {
+	if s != nil {
+		iac.SetID(*s)
+	}
+	return iac
+}
+
+// Mutation returns the InternshipApplicationMutation object of the builder.
+func (iac *InternshipApplicationCreate) Mutation() *InternshipApplicationMutation {
+	return iac.mutation
+}
+
+// Save creates the InternshipApplication in the database.
+func (iac *InternshipApplicationCreate) Save(ctx context.Context) (*InternshipApplication, error) {
+	iac.defaults()
+	return withHooks(ctx, iac.sqlSave, iac.mutation, iac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iac *InternshipApplicationCreate) SaveX(ctx context.Context) *InternshipApplication {
+	v, err := iac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iac *InternshipApplicationCreate) Exec(ctx context.Context) error {
+	_, err := iac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iac *InternshipApplicationCreate) ExecX(ctx context.Context) {
+	if err := iac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iac *InternshipApplicationCreate) defaults() {
+	if _, ok := iac.mutation.Status(); !ok {
+		v := internshipapplication.DefaultStatus
+		iac.mutation.SetStatus(v)
+	}
+	if _, ok := iac.mutation.CreatedAt(); !ok {
+		v := internshipapplication.DefaultCreatedAt()
+		iac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := iac.mutation.UpdatedAt(); !ok {
+		v := internshipapplication.DefaultUpdatedAt()
+		iac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := iac.mutation.Answers(); !ok {
+		v := internshipapplication.DefaultAnswers
+		iac.mutation.SetAnswers(v)
+	}
+	if _, ok := iac.mutation.ApplicationStatus(); !ok {
+		v := internshipapplication.DefaultApplicationStatus
+		iac.mutation.SetApplicationStatus(v)
+	}
+	if _, ok := iac.mutation.ID(); !ok {
+		v := internshipapplication.DefaultID()
+		iac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iac *InternshipApplicationCreate) check() error {
+	if _, ok := iac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InternshipApplication.status"`)}
+	}
+	if _, ok := iac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InternshipApplication.created_at"`)}
+	}
+	if _, ok := iac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InternshipApplication.updated_at"`)}
+	}
+	if _, ok := iac.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "InternshipApplication.internship_id"`)}
+	}
+	if v, ok := iac.mutation.InternshipID(); ok {
+		if err := internshipapplication.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "InternshipApplication.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := iac.mutation.InternshipBatchID(); !ok {
+		return &ValidationError{Name: "internship_batch_id", err: errors.New(`ent: missing required field "InternshipApplication.internship_batch_id"`)}
+	}
+	if v, ok := iac.mutation.InternshipBatchID(); ok {
+		if err := internshipapplication.InternshipBatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_batch_id", err: fmt.Errorf(`ent: validator failed for field "InternshipApplication.internship_batch_id": %w`, err)}
+		}
+	}
+	if _, ok := iac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InternshipApplication.user_id"`)}
+	}
+	if v, ok := iac.mutation.UserID(); ok {
+		if err := internshipapplication.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "InternshipApplication.user_id": %w`, err)}
+		}
+	}
+	if _, ok := iac.mutation.Answers(); !ok {
+		return &ValidationError{Name: "answers", err: errors.New(`ent: missing required field "InternshipApplication.answers"`)}
+	}
+	if _, ok := iac.mutation.ApplicationStatus(); !ok {
+		return &ValidationError{Name: "application_status", err: errors.New(`ent: missing required field "InternshipApplication.application_status"`)}
+	}
+	return nil
+}
+
+func (iac *InternshipApplicationCreate) sqlSave(ctx context.Context) (*InternshipApplication, error) {
+	if err := iac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InternshipApplication.ID type: %T", _spec.ID.Value)
+		}
+	}
+	iac.mutation.id = &_node.ID
+	iac.mutation.done = true
+	return _node, nil
+}
+
+func (iac *InternshipApplicationCreate) createSpec() (*InternshipApplication, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InternshipApplication{config: iac.config}
+		_spec = sqlgraph.NewCreateSpec(internshipapplication.Table, sqlgraph.NewFieldSpec(internshipapplication.FieldID, field.TypeString))
+	)
+	if id, ok := iac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iac.mutation.Status(); ok {
+		_spec.SetField(internshipapplication.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := iac.mutation.CreatedAt(); ok {
+		_spec.SetField(internshipapplication.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := iac.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipapplication.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := iac.mutation.CreatedBy(); ok {
+		_spec.SetField(internshipapplication.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := iac.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipapplication.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := iac.mutation.InternshipID(); ok {
+		_spec.SetField(internshipapplication.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := iac.mutation.InternshipBatchID(); ok {
+		_spec.SetField(internshipapplication.FieldInternshipBatchID, field.TypeString, value)
+		_node.InternshipBatchID = value
+	}
+	if value, ok := iac.mutation.UserID(); ok {
+		_spec.SetField(internshipapplication.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := iac.mutation.Answers(); ok {
+		_spec.SetField(internshipapplication.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := iac.mutation.ResumeFileID(); ok {
+		_spec.SetField(internshipapplication.FieldResumeFileID, field.TypeString, value)
+		_node.ResumeFileID = &value
+	}
+	if value, ok := iac.mutation.ApplicationStatus(); ok {
+		_spec.SetField(internshipapplication.FieldApplicationStatus, field.TypeString, value)
+		_node.ApplicationStatus = value
+	}
+	if value, ok := iac.mutation.InterviewAt(); ok {
+		_spec.SetField(internshipapplication.FieldInterviewAt, field.TypeTime, value)
+		_node.InterviewAt = &value
+	}
+	if value, ok := iac.mutation.ReviewerNote(); ok {
+		_spec.SetField(internshipapplication.FieldReviewerNote, field.TypeString, value)
+		_node.ReviewerNote = &value
+	}
+	if value, ok := iac.mutation.ReviewedBy(); ok {
+		_spec.SetField(internshipapplication.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := iac.mutation.ReviewedAt(); ok {
+		_spec.SetField(internshipapplication.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := iac.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(internshipapplication.FieldOfferExpiresAt, field.TypeTime, value)
+		_node.OfferExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// InternshipApplicationCreateBulk is the builder for creating many InternshipApplication entities in bulk.
+type InternshipApplicationCreateBulk struct {
+	config
+	err      error
+	builders []*InternshipApplicationCreate
+}
+
+// Save creates the InternshipApplication entities in the database.
+func (iacb *InternshipApplicationCreateBulk) Save(ctx context.Context) ([]*InternshipApplication, error) {
+	if iacb.err != nil {
+		return nil, iacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iacb.builders))
+	nodes := make([]*InternshipApplication, len(iacb.builders))
+	mutators := make([]Mutator, len(iacb.builders))
+	for i := range iacb.builders {
+		func(i int, root context.Context) {
+			builder := iacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InternshipApplicationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iacb *InternshipApplicationCreateBulk) SaveX(ctx context.Context) []*InternshipApplication {
+	v, err := iacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iacb *InternshipApplicationCreateBulk) Exec(ctx context.Context) error {
+	_, err := iacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iacb *InternshipApplicationCreateBulk) ExecX(ctx context.Context) {
+	if err := iacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipApplicationDelete is the builder for deleting a InternshipApplication entity.
+type InternshipApplicationDelete struct {
+	config
+	hooks    []Hook
+	mutation *InternshipApplicationMutation
+}
+
+// Where appends a list predicates to the InternshipApplicationDelete builder.
+func (iad *InternshipApplicationDelete) Where(ps ...predicate.InternshipApplication) *InternshipApplicationDelete {
+	iad.mutation.Where(ps...)
+	return iad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iad *InternshipApplicationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iad.sqlExec, iad.mutation, iad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iad *InternshipApplicationDelete) ExecX(ctx context.Context) int {
+	n, err := iad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iad *InternshipApplicationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(internshipapplication.Table, sqlgraph.NewFieldSpec(internshipapplication.FieldID, field.TypeString))
+	if ps := iad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iad.mutation.done = true
+	return affected, err
+}
+
+// InternshipApplicationDeleteOne is the builder for deleting a single InternshipApplication entity.
+type InternshipApplicationDeleteOne struct {
+	iad *InternshipApplicationDelete
+}
+
+// Where appends a list predicates to the InternshipApplicationDelete builder.
+func (iado *InternshipApplicationDeleteOne) Where(ps ...predicate.InternshipApplication) *InternshipApplicationDeleteOne {
+	iado.iad.mutation.Where(ps...)
+	return iado
+}
+
+// Exec executes the deletion query.
+func (iado *InternshipApplicationDeleteOne) Exec(ctx context.Context) error {
+	n, err := iado.iad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{internshipapplication.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iado *InternshipApplicationDeleteOne) ExecX(ctx context.Context) {
+	if err := iado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
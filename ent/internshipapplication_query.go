@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipApplicationQuery is the builder for querying InternshipApplication entities.
+type InternshipApplicationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []internshipapplication.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InternshipApplication
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InternshipApplicationQuery builder.
+func (iaq *InternshipApplicationQuery) Where(ps ...predicate.InternshipApplication) *InternshipApplicationQuery {
+	iaq.predicates = append(iaq.predicates, ps...)
+	return iaq
+}
+
+// Limit the number of records to be returned by this query.
+func (iaq *InternshipApplicationQuery) Limit(limit int) *InternshipApplicationQuery {
+	iaq.ctx.Limit = &limit
+	return iaq
+}
+
+// Offset to start from.
+func (iaq *InternshipApplicationQuery) Offset(offset int) *InternshipApplicationQuery {
+	iaq.ctx.Offset = &offset
+	return iaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iaq *InternshipApplicationQuery) Unique(unique bool) *InternshipApplicationQuery {
+	iaq.ctx.Unique = &unique
+	return iaq
+}
+
+// Order specifies how the records should be ordered.
+func (iaq *InternshipApplicationQuery) Order(o ...internshipapplication.OrderOption) *InternshipApplicationQuery {
+	iaq.order = append(iaq.order, o...)
+	return iaq
+}
+
+// First returns the first InternshipApplication entity from the query.
+// Returns a *NotFoundError when no InternshipApplication was found.
+func (iaq *InternshipApplicationQuery) First(ctx context.Context) (*InternshipApplication, error) {
+	nodes, err := iaq.Limit(1).All(setContextOp(ctx, iaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{internshipapplication.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) FirstX(ctx context.Context) *InternshipApplication {
+	node, err := iaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InternshipApplication ID from the query.
+// Returns a *NotFoundError when no InternshipApplication ID was found.
+func (iaq *InternshipApplicationQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iaq.Limit(1).IDs(setContextOp(ctx, iaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{internshipapplication.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) FirstIDX(ctx context.Context) string {
+	id, err := iaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InternshipApplication entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InternshipApplication entity is found.
+// Returns a *NotFoundError when no InternshipApplication entities are found.
+func (iaq *InternshipApplicationQuery) Only(ctx context.Context) (*InternshipApplication, error) {
+	nodes, err := iaq.Limit(2).All(setContextOp(ctx, iaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{internshipapplication.Label}
+	default:
+		return nil, &NotSingularError{internshipapplication.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) OnlyX(ctx context.Context) *InternshipApplication {
+	node, err := iaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InternshipApplication ID in the query.
+// Returns a *NotSingularError when more than one InternshipApplication ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iaq *InternshipApplicationQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iaq.Limit(2).IDs(setContextOp(ctx, iaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{internshipapplication.Label}
+	default:
+		err = &NotSingularError{internshipapplication.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) OnlyIDX(ctx context.Context) string {
+	id, err := iaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InternshipApplications.
+func (iaq *InternshipApplicationQuery) All(ctx context.Context) ([]*InternshipApplication, error) {
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryAll)
+	if err := iaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InternshipApplication, *InternshipApplicationQuery]()
+	return withInterceptors[[]*InternshipApplication](ctx, iaq, qr, iaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) AllX(ctx context.Context) []*InternshipApplication {
+	nodes, err := iaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InternshipApplication IDs.
+func (iaq *InternshipApplicationQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if iaq.ctx.Unique == nil && iaq.path != nil {
+		iaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryIDs)
+	if err = iaq.Select(internshipapplication.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) IDsX(ctx context.Context) []string {
+	ids, err := iaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iaq *InternshipApplicationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryCount)
+	if err := iaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iaq, querierCount[*InternshipApplicationQuery](), iaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) CountX(ctx context.Context) int {
+	count, err := iaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iaq *InternshipApplicationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryExist)
+	switch _, err := iaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iaq *InternshipApplicationQuery) ExistX(ctx context.Context) bool {
+	exist, err := iaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InternshipApplicationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iaq *InternshipApplicationQuery) Clone() *InternshipApplicationQuery {
+	if iaq == nil {
+		return nil
+	}
+	return &InternshipApplicationQuery{
+		config:     iaq.config,
+		ctx:        iaq.ctx.Clone(),
+		order:      append([]internshipapplication.OrderOption{}, iaq.order...),
+		inters:     append([]Interceptor{}, iaq.inters...),
+		predicates: append([]predicate.InternshipApplication{}, iaq.predicates...),
+		// clone intermediate query.
+		sql:  iaq.sql.Clone(),
+		path: iaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InternshipApplication.Query().
+//		GroupBy(internshipapplication.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iaq *InternshipApplicationQuery) GroupBy(field string, fields ...string) *InternshipApplicationGroupBy {
+	iaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InternshipApplicationGroupBy{build: iaq}
+	grbuild.flds = &iaq.ctx.Fields
+	grbuild.label = internshipapplication.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.InternshipApplication.Query().
+//		Select(internshipapplication.FieldStatus).
+//		Scan(ctx, &v)
+func (iaq *InternshipApplicationQuery) Select(fields ...string) *InternshipApplicationSelect {
+	iaq.ctx.Fields = append(iaq.ctx.Fields, fields...)
+	sbuild := &InternshipApplicationSelect{InternshipApplicationQuery: iaq}
+	sbuild.label = internshipapplication.Label
+	sbuild.flds, sbuild.scan = &iaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InternshipApplicationSelect configured with the given aggregations.
+func (iaq *InternshipApplicationQuery) Aggregate(fns ...AggregateFunc) *InternshipApplicationSelect {
+	return iaq.Select().Aggregate(fns...)
+}
+
+func (iaq *InternshipApplicationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iaq.ctx.Fields {
+		if !internshipapplication.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iaq.path != nil {
+		prev, err := iaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iaq.sql = prev
+	}
+	return nil
+}
+
+func (iaq *InternshipApplicationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InternshipApplication, error) {
+	var (
+		nodes = []*InternshipApplication{}
+		_spec = iaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InternshipApplication).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InternshipApplication{config: iaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iaq *InternshipApplicationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iaq.querySpec()
+	_spec.Node.Columns = iaq.ctx.Fields
+	if len(iaq.ctx.Fields) > 0 {
+		_spec.Unique = iaq.ctx.Unique != nil && *iaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iaq.driver, _spec)
+}
+
+func (iaq *InternshipApplicationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(internshipapplication.Table, internshipapplication.Columns, sqlgraph.NewFieldSpec(internshipapplication.FieldID, field.TypeString))
+	_spec.From = iaq.sql
+	if unique := iaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshipapplication.FieldID)
+		for i := range fields {
+			if fields[i] != internshipapplication.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iaq *InternshipApplicationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iaq.driver.Dialect())
+	t1 := builder.Table(internshipapplication.Table)
+	columns := iaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = internshipapplication.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iaq.sql != nil {
+		selector = iaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iaq.ctx.Unique != nil && *iaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range iaq.predicates {
+		p(selector)
+	}
+	for _, p := range iaq.order {
+		p(selector)
+	}
+	if offset := iaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InternshipApplicationGroupBy is the group-by builder for InternshipApplication entities.
+type InternshipApplicationGroupBy struct {
+	selector
+	build *InternshipApplicationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iagb *InternshipApplicationGroupBy) Aggregate(fns ...AggregateFunc) *InternshipApplicationGroupBy {
+	iagb.fns = append(iagb.fns, fns...)
+	return iagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iagb *InternshipApplicationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iagb.build.ctx, ent.OpQueryGroupBy)
+	if err := iagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InternshipApplicationQuery, *InternshipApplicationGroupBy](ctx, iagb.build, iagb, iagb.build.inters, v)
+}
+
+func (iagb *InternshipApplicationGroupBy) sqlScan(ctx context.Context, root *InternshipApplicationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iagb.fns))
+	for _, fn := range iagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iagb.flds)+len(iagb.fns))
+		for _, f := range *iagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InternshipApplicationSelect is the builder for selecting fields of InternshipApplication entities.
+type InternshipApplicationSelect struct {
+	*InternshipApplicationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ias *InternshipApplicationSelect) Aggregate(fns ...AggregateFunc) *InternshipApplicationSelect {
+	ias.fns = append(ias.fns, fns...)
+	return ias
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ias *InternshipApplicationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ias.ctx, ent.OpQuerySelect)
+	if err := ias.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InternshipApplicationQuery, *InternshipApplicationSelect](ctx, ias.InternshipApplicationQuery, ias, ias.inters, v)
+}
+
+func (ias *InternshipApplicationSelect) sqlScan(ctx context.Context, root *InternshipApplicationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ias.fns))
+	for _, fn := range ias.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ias.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ias.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipApplicationUpdate is the builder for updating InternshipApplication entities.
+type InternshipApplicationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InternshipApplicationMutation
+}
+
+// Where appends a list predicates to the InternshipApplicationUpdate builder.
+func (iau *InternshipApplicationUpdate) Where(ps ...predicate.InternshipApplication) *InternshipApplicationUpdate {
+	iau.mutation.Where(ps...)
+	return iau
+}
+
+// SetStatus sets the "status" field.
+func (iau *InternshipApplicationUpdate) SetStatus(s string) *InternshipApplicationUpdate {
+	iau.mutation.SetStatus(s)
+	return iau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableStatus(s *string) *InternshipApplicationUpdate {
+	if s != nil {
+		iau.SetStatus(*s)
+	}
+	return iau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iau *InternshipApplicationUpdate) SetUpdatedAt(t time.Time) *InternshipApplicationUpdate {
+	iau.mutation.SetUpdatedAt(t)
+	return iau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iau *InternshipApplicationUpdate) SetUpdatedBy(s string) *InternshipApplicationUpdate {
+	iau.mutation.SetUpdatedBy(s)
+	return iau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableUpdatedBy(s *string) *InternshipApplicationUpdate {
+	if s != nil {
+		iau.SetUpdatedBy(*s)
+	}
+	return iau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iau *InternshipApplicationUpdate) ClearUpdatedBy() *InternshipApplicationUpdate {
+	iau.mutation.ClearUpdatedBy()
+	return iau
+}
+
+// SetApplicationStatus sets the "application_status" field.
+func (iau *InternshipApplicationUpdate) SetApplicationStatus(s string) *InternshipApplicationUpdate {
+	iau.mutation.SetApplicationStatus(s)
+	return iau
+}
+
+// SetNillableApplicationStatus sets the "application_status" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableApplicationStatus(s *string) *InternshipApplicationUpdate {
+	if s != nil {
+		iau.SetApplicationStatus(*s)
+	}
+	return iau
+}
+
+// SetInterviewAt sets the "interview_at" field.
+func (iau *InternshipApplicationUpdate) SetInterviewAt(t time.Time) *InternshipApplicationUpdate {
+	iau.mutation.SetInterviewAt(t)
+	return iau
+}
+
+// SetNillableInterviewAt sets the "interview_at" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableInterviewAt(t *time.Time) *InternshipApplicationUpdate {
+	if t != nil {
+		iau.SetInterviewAt(*t)
+	}
+	return iau
+}
+
+// ClearInterviewAt clears the value of the "interview_at" field.
+func (iau *InternshipApplicationUpdate) ClearInterviewAt() *InternshipApplicationUpdate {
+	iau.mutation.ClearInterviewAt()
+	return iau
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (iau *InternshipApplicationUpdate) SetReviewerNote(s string) *InternshipApplicationUpdate {
+	iau.mutation.SetReviewerNote(s)
+	return iau
+}
+
+// SetNillableReviewerNote sets the "reviewer_note" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableReviewerNote(s *string) *InternshipApplicationUpdate {
+	if s != nil {
+		iau.SetReviewerNote(*s)
+	}
+	return iau
+}
+
+// ClearReviewerNote clears the value of the "reviewer_note" field.
+func (iau *InternshipApplicationUpdate) ClearReviewerNote() *InternshipApplicationUpdate {
+	iau.mutation.ClearReviewerNote()
+	return iau
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (iau *InternshipApplicationUpdate) SetReviewedBy(s string) *InternshipApplicationUpdate {
+	iau.mutation.SetReviewedBy(s)
+	return iau
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableReviewedBy(s *string) *InternshipApplicationUpdate {
+	if s != nil {
+		iau.SetReviewedBy(*s)
+	}
+	return iau
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (iau *InternshipApplicationUpdate) ClearReviewedBy() *InternshipApplicationUpdate {
+	iau.mutation.ClearReviewedBy()
+	return iau
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (iau *InternshipApplicationUpdate) SetReviewedAt(t time.Time) *InternshipApplicationUpdate {
+	iau.mutation.SetReviewedAt(t)
+	return iau
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableReviewedAt(t *time.Time) *InternshipApplicationUpdate {
+	if t != nil {
+		iau.SetReviewedAt(*t)
+	}
+	return iau
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (iau *InternshipApplicationUpdate) ClearReviewedAt() *InternshipApplicationUpdate {
+	iau.mutation.ClearReviewedAt()
+	return iau
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (iau *InternshipApplicationUpdate) SetOfferExpiresAt(t time.Time) *InternshipApplicationUpdate {
+	iau.mutation.SetOfferExpiresAt(t)
+	return iau
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (iau *InternshipApplicationUpdate) SetNillableOfferExpiresAt(t *time.Time) *InternshipApplicationUpdate {
+	if t != nil {
+		iau.SetOfferExpiresAt(*t)
+	}
+	return iau
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (iau *InternshipApplicationUpdate) ClearOfferExpiresAt() *InternshipApplicationUpdate {
+	iau.mutation.ClearOfferExpiresAt()
+	return iau
+}
+
+// Mutation returns the InternshipApplicationMutation object of the builder.
+func (iau *InternshipApplicationUpdate) Mutation() *InternshipApplicationMutation {
+	return iau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iau *InternshipApplicationUpdate) Save(ctx context.Context) (int, error) {
+	iau.defaults()
+	return withHooks(ctx, iau.sqlSave, iau.mutation, iau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iau *InternshipApplicationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iau *InternshipApplicationUpdate) Exec(ctx context.Context) error {
+	_, err := iau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iau *InternshipApplicationUpdate) ExecX(ctx context.Context) {
+	if err := iau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iau *InternshipApplicationUpdate) defaults() {
+	if _, ok := iau.mutation.UpdatedAt(); !ok {
+		v := internshipapplication.UpdateDefaultUpdatedAt()
+		iau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (iau *InternshipApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(internshipapplication.Table, internshipapplication.Columns, sqlgraph.NewFieldSpec(internshipapplication.FieldID, field.TypeString))
+	if ps := iau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iau.mutation.Status(); ok {
+		_spec.SetField(internshipapplication.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iau.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipapplication.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iau.mutation.CreatedByCleared() {
+		_spec.ClearField(internshipapplication.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iau.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipapplication.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iau.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshipapplication.FieldUpdatedBy, field.TypeString)
+	}
+	if iau.mutation.ResumeFileIDCleared() {
+		_spec.ClearField(internshipapplication.FieldResumeFileID, field.TypeString)
+	}
+	if value, ok := iau.mutation.ApplicationStatus(); ok {
+		_spec.SetField(internshipapplication.FieldApplicationStatus, field.TypeString, value)
+	}
+	if value, ok := iau.mutation.InterviewAt(); ok {
+		_spec.SetField(internshipapplication.FieldInterviewAt, field.TypeTime, value)
+	}
+	if iau.mutation.InterviewAtCleared() {
+		_spec.ClearField(internshipapplication.FieldInterviewAt, field.TypeTime)
+	}
+	if value, ok := iau.mutation.ReviewerNote(); ok {
+		_spec.SetField(internshipapplication.FieldReviewerNote, field.TypeString, value)
+	}
+	if iau.mutation.ReviewerNoteCleared() {
+		_spec.ClearField(internshipapplication.FieldReviewerNote, field.TypeString)
+	}
+	if value, ok := iau.mutation.ReviewedBy(); ok {
+		_spec.SetField(internshipapplication.FieldReviewedBy, field.TypeString, value)
+	}
+	if iau.mutation.ReviewedByCleared() {
+		_spec.ClearField(internshipapplication.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := iau.mutation.ReviewedAt(); ok {
+		_spec.SetField(internshipapplication.FieldReviewedAt, field.TypeTime, value)
+	}
+	if iau.mutation.ReviewedAtCleared() {
+		_spec.ClearField(internshipapplication.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := iau.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(internshipapplication.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if iau.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(internshipapplication.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshipapplication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iau.mutation.done = true
+	return n, nil
+}
+
+// InternshipApplicationUpdateOne is the builder for updating a single InternshipApplication entity.
+type InternshipApplicationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InternshipApplicationMutation
+}
+
+// SetStatus sets the "status" field.
+func (iauo *InternshipApplicationUpdateOne) SetStatus(s string) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetStatus(s)
+	return iauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableStatus(s *string) *InternshipApplicationUpdateOne {
+	if s != nil {
+		iauo.SetStatus(*s)
+	}
+	return iauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iauo *InternshipApplicationUpdateOne) SetUpdatedAt(t time.Time) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetUpdatedAt(t)
+	return iauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iauo *InternshipApplicationUpdateOne) SetUpdatedBy(s string) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetUpdatedBy(s)
+	return iauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableUpdatedBy(s *string) *InternshipApplicationUpdateOne {
+	if s != nil {
+		iauo.SetUpdatedBy(*s)
+	}
+	return iauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iauo *InternshipApplicationUpdateOne) ClearUpdatedBy() *InternshipApplicationUpdateOne {
+	iauo.mutation.ClearUpdatedBy()
+	return iauo
+}
+
+// SetApplicationStatus sets the "application_status" field.
+func (iauo *InternshipApplicationUpdateOne) SetApplicationStatus(s string) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetApplicationStatus(s)
+	return iauo
+}
+
+// SetNillableApplicationStatus sets the "application_status" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableApplicationStatus(s *string) *InternshipApplicationUpdateOne {
+	if s != nil {
+		iauo.SetApplicationStatus(*s)
+	}
+	return iauo
+}
+
+// SetInterviewAt sets the "interview_at" field.
+func (iauo *InternshipApplicationUpdateOne) SetInterviewAt(t time.Time) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetInterviewAt(t)
+	return iauo
+}
+
+// SetNillableInterviewAt sets the "interview_at" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableInterviewAt(t *time.Time) *InternshipApplicationUpdateOne {
+	if t != nil {
+		iauo.SetInterviewAt(*t)
+	}
+	return iauo
+}
+
+// ClearInterviewAt clears the value of the "interview_at" field.
+func (iauo *InternshipApplicationUpdateOne) ClearInterviewAt() *InternshipApplicationUpdateOne {
+	iauo.mutation.ClearInterviewAt()
+	return iauo
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (iauo *InternshipApplicationUpdateOne) SetReviewerNote(s string) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetReviewerNote(s)
+	return iauo
+}
+
+// SetNillableReviewerNote sets the "reviewer_note" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableReviewerNote(s *string) *InternshipApplicationUpdateOne {
+	if s != nil {
+		iauo.SetReviewerNote(*s)
+	}
+	return iauo
+}
+
+// ClearReviewerNote clears the value of the "reviewer_note" field.
+func (iauo *InternshipApplicationUpdateOne) ClearReviewerNote() *InternshipApplicationUpdateOne {
+	iauo.mutation.ClearReviewerNote()
+	return iauo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (iauo *InternshipApplicationUpdateOne) SetReviewedBy(s string) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetReviewedBy(s)
+	return iauo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableReviewedBy(s *string) *InternshipApplicationUpdateOne {
+	if s != nil {
+		iauo.SetReviewedBy(*s)
+	}
+	return iauo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (iauo *InternshipApplicationUpdateOne) ClearReviewedBy() *InternshipApplicationUpdateOne {
+	iauo.mutation.ClearReviewedBy()
+	return iauo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (iauo *InternshipApplicationUpdateOne) SetReviewedAt(t time.Time) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetReviewedAt(t)
+	return iauo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableReviewedAt(t *time.Time) *InternshipApplicationUpdateOne {
+	if t != nil {
+		iauo.SetReviewedAt(*t)
+	}
+	return iauo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (iauo *InternshipApplicationUpdateOne) ClearReviewedAt() *InternshipApplicationUpdateOne {
+	iauo.mutation.ClearReviewedAt()
+	return iauo
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (iauo *InternshipApplicationUpdateOne) SetOfferExpiresAt(t time.Time) *InternshipApplicationUpdateOne {
+	iauo.mutation.SetOfferExpiresAt(t)
+	return iauo
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (iauo *InternshipApplicationUpdateOne) SetNillableOfferExpiresAt(t *time.Time) *InternshipApplicationUpdateOne {
+	if t != nil {
+		iauo.SetOfferExpiresAt(*t)
+	}
+	return iauo
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (iauo *InternshipApplicationUpdateOne) ClearOfferExpiresAt() *InternshipApplicationUpdateOne {
+	iauo.mutation.ClearOfferExpiresAt()
+	return iauo
+}
+
+// Mutation returns the InternshipApplicationMutation object of the builder.
+func (iauo *InternshipApplicationUpdateOne) Mutation() *InternshipApplicationMutation {
+	return iauo.mutation
+}
+
+// Where appends a list predicates to the InternshipApplicationUpdate builder.
+func (iauo *InternshipApplicationUpdateOne) Where(ps ...predicate.InternshipApplication) *InternshipApplicationUpdateOne {
+	iauo.mutation.Where(ps...)
+	return iauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iauo *InternshipApplicationUpdateOne) Select(field string, fields ...string) *InternshipApplicationUpdateOne {
+	iauo.fields = append([]string{field}, fields...)
+	return iauo
+}
+
+// Save executes the query and returns the updated InternshipApplication entity.
+func (iauo *InternshipApplicationUpdateOne) Save(ctx context.Context) (*InternshipApplication, error) {
+	iauo.defaults()
+	return withHooks(ctx, iauo.sqlSave, iauo.mutation, iauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iauo *InternshipApplicationUpdateOne) SaveX(ctx context.Context) *InternshipApplication {
+	node, err := iauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iauo *InternshipApplicationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iauo *InternshipApplicationUpdateOne) ExecX(ctx context.Context) {
+	if err := iauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iauo *InternshipApplicationUpdateOne) defaults() {
+	if _, ok := iauo.mutation.UpdatedAt(); !ok {
+		v := internshipapplication.UpdateDefaultUpdatedAt()
+		iauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (iauo *InternshipApplicationUpdateOne) sqlSave(ctx context.Context) (_node *InternshipApplication, err error) {
+	_spec := sqlgraph.NewUpdateSpec(internshipapplication.Table, internshipapplication.Columns, sqlgraph.NewFieldSpec(internshipapplication.FieldID, field.TypeString))
+	id, ok := iauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InternshipApplication.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshipapplication.FieldID)
+		for _, f := range fields {
+			if !internshipapplication.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != internshipapplication.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iauo.mutation.Status(); ok {
+		_spec.SetField(internshipapplication.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipapplication.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iauo.mutation.CreatedByCleared() {
+		_spec.ClearField(internshipapplication.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipapplication.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshipapplication.FieldUpdatedBy, field.TypeString)
+	}
+	if iauo.mutation.ResumeFileIDCleared() {
+		_spec.ClearField(internshipapplication.FieldResumeFileID, field.TypeString)
+	}
+	if value, ok := iauo.mutation.ApplicationStatus(); ok {
+		_spec.SetField(internshipapplication.FieldApplicationStatus, field.TypeString, value)
+	}
+	if value, ok := iauo.mutation.InterviewAt(); ok {
+		_spec.SetField(internshipapplication.FieldInterviewAt, field.TypeTime, value)
+	}
+	if iauo.mutation.InterviewAtCleared() {
+		_spec.ClearField(internshipapplication.FieldInterviewAt, field.TypeTime)
+	}
+	if value, ok := iauo.mutation.ReviewerNote(); ok {
+		_spec.SetField(internshipapplication.FieldReviewerNote, field.TypeString, value)
+	}
+	if iauo.mutation.ReviewerNoteCleared() {
+		_spec.ClearField(internshipapplication.FieldReviewerNote, field.TypeString)
+	}
+	if value, ok := iauo.mutation.ReviewedBy(); ok {
+		_spec.SetField(internshipapplication.FieldReviewedBy, field.TypeString, value)
+	}
+	if iauo.mutation.ReviewedByCleared() {
+		_spec.ClearField(internshipapplication.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := iauo.mutation.ReviewedAt(); ok {
+		_spec.SetField(internshipapplication.FieldReviewedAt, field.TypeTime, value)
+	}
+	if iauo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(internshipapplication.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := iauo.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(internshipapplication.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if iauo.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(internshipapplication.FieldOfferExpiresAt, field.TypeTime)
+	}
+	_node = &InternshipApplication{config: iauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshipapplication.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iauo.mutation.done = true
+	return _node, nil
+}
@@ -41,8 +41,18 @@ type InternshipBatch struct {
 	// EndDate holds the value of the "end_date" field.
 	EndDate time.Time `json:"end_date,omitempty"`
 	// BatchStatus holds the value of the "batch_status" field.
-	BatchStatus  string `json:"batch_status,omitempty"`
-	selectValues sql.SelectValues
+	BatchStatus string `json:"batch_status,omitempty"`
+	// EnrollmentClosedAt holds the value of the "enrollment_closed_at" field.
+	EnrollmentClosedAt *time.Time `json:"enrollment_closed_at,omitempty"`
+	// PausedAt holds the value of the "paused_at" field.
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// RemindedAt holds the value of the "reminded_at" field.
+	RemindedAt *time.Time `json:"reminded_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancellationReason holds the value of the "cancellation_reason" field.
+	CancellationReason *string `json:"cancellation_reason,omitempty"`
+	selectValues       sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -52,9 +62,9 @@ func (*InternshipBatch) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case internshipbatch.FieldMetadata:
 			values[i] = new([]byte)
-		case internshipbatch.FieldID, internshipbatch.FieldStatus, internshipbatch.FieldCreatedBy, internshipbatch.FieldUpdatedBy, internshipbatch.FieldInternshipID, internshipbatch.FieldName, internshipbatch.FieldDescription, internshipbatch.FieldBatchStatus:
+		case internshipbatch.FieldID, internshipbatch.FieldStatus, internshipbatch.FieldCreatedBy, internshipbatch.FieldUpdatedBy, internshipbatch.FieldInternshipID, internshipbatch.FieldName, internshipbatch.FieldDescription, internshipbatch.FieldBatchStatus, internshipbatch.FieldCancellationReason:
 			values[i] = new(sql.NullString)
-		case internshipbatch.FieldCreatedAt, internshipbatch.FieldUpdatedAt, internshipbatch.FieldStartDate, internshipbatch.FieldEndDate:
+		case internshipbatch.FieldCreatedAt, internshipbatch.FieldUpdatedAt, internshipbatch.FieldStartDate, internshipbatch.FieldEndDate, internshipbatch.FieldEnrollmentClosedAt, internshipbatch.FieldPausedAt, internshipbatch.FieldRemindedAt, internshipbatch.FieldCancelledAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -151,6 +161,41 @@ func (ib *InternshipBatch) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ib.BatchStatus = value.String
 			}
+		case internshipbatch.FieldEnrollmentClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_closed_at", values[i])
+			} else if value.Valid {
+				ib.EnrollmentClosedAt = new(time.Time)
+				*ib.EnrollmentClosedAt = value.Time
+			}
+		case internshipbatch.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				ib.PausedAt = new(time.Time)
+				*ib.PausedAt = value.Time
+			}
+		case internshipbatch.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				ib.RemindedAt = new(time.Time)
+				*ib.RemindedAt = value.Time
+			}
+		case internshipbatch.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				ib.CancelledAt = new(time.Time)
+				*ib.CancelledAt = value.Time
+			}
+		case internshipbatch.FieldCancellationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_reason", values[i])
+			} else if value.Valid {
+				ib.CancellationReason = new(string)
+				*ib.CancellationReason = value.String
+			}
 		default:
 			ib.selectValues.Set(columns[i], values[i])
 		}
@@ -222,6 +267,31 @@ func (ib *InternshipBatch) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("batch_status=")
 	builder.WriteString(ib.BatchStatus)
+	builder.WriteString(", ")
+	if v := ib.EnrollmentClosedAt; v != nil {
+		builder.WriteString("enrollment_closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ib.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ib.RemindedAt; v != nil {
+		builder.WriteString("reminded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ib.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ib.CancellationReason; v != nil {
+		builder.WriteString("cancellation_reason=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -37,6 +37,16 @@ const (
 	FieldEndDate = "end_date"
 	// FieldBatchStatus holds the string denoting the batch_status field in the database.
 	FieldBatchStatus = "batch_status"
+	// FieldEnrollmentClosedAt holds the string denoting the enrollment_closed_at field in the database.
+	FieldEnrollmentClosedAt = "enrollment_closed_at"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldRemindedAt holds the string denoting the reminded_at field in the database.
+	FieldRemindedAt = "reminded_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCancellationReason holds the string denoting the cancellation_reason field in the database.
+	FieldCancellationReason = "cancellation_reason"
 	// Table holds the table name of the internshipbatch in the database.
 	Table = "internship_batches"
 )
@@ -56,6 +66,11 @@ var Columns = []string{
 	FieldStartDate,
 	FieldEndDate,
 	FieldBatchStatus,
+	FieldEnrollmentClosedAt,
+	FieldPausedAt,
+	FieldRemindedAt,
+	FieldCancelledAt,
+	FieldCancellationReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -153,3 +168,28 @@ func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
 func ByBatchStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBatchStatus, opts...).ToFunc()
 }
+
+// ByEnrollmentClosedAt orders the results by the enrollment_closed_at field.
+func ByEnrollmentClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrollmentClosedAt, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByRemindedAt orders the results by the reminded_at field.
+func ByRemindedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemindedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCancellationReason orders the results by the cancellation_reason field.
+func ByCancellationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationReason, opts...).ToFunc()
+}
@@ -119,6 +119,31 @@ func BatchStatus(v string) predicate.InternshipBatch {
 	return predicate.InternshipBatch(sql.FieldEQ(FieldBatchStatus, v))
 }
 
+// EnrollmentClosedAt applies equality check predicate on the "enrollment_closed_at" field. It's identical to EnrollmentClosedAtEQ.
+func EnrollmentClosedAt(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldEnrollmentClosedAt, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// RemindedAt applies equality check predicate on the "reminded_at" field. It's identical to RemindedAtEQ.
+func RemindedAt(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancellationReason applies equality check predicate on the "cancellation_reason" field. It's identical to CancellationReasonEQ.
+func CancellationReason(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldCancellationReason, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.InternshipBatch {
 	return predicate.InternshipBatch(sql.FieldEQ(FieldStatus, v))
@@ -794,6 +819,281 @@ func BatchStatusContainsFold(v string) predicate.InternshipBatch {
 	return predicate.InternshipBatch(sql.FieldContainsFold(FieldBatchStatus, v))
 }
 
+// EnrollmentClosedAtEQ applies the EQ predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldEnrollmentClosedAt, v))
+}
+
+// EnrollmentClosedAtNEQ applies the NEQ predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtNEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNEQ(FieldEnrollmentClosedAt, v))
+}
+
+// EnrollmentClosedAtIn applies the In predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIn(FieldEnrollmentClosedAt, vs...))
+}
+
+// EnrollmentClosedAtNotIn applies the NotIn predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtNotIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotIn(FieldEnrollmentClosedAt, vs...))
+}
+
+// EnrollmentClosedAtGT applies the GT predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtGT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGT(FieldEnrollmentClosedAt, v))
+}
+
+// EnrollmentClosedAtGTE applies the GTE predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtGTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGTE(FieldEnrollmentClosedAt, v))
+}
+
+// EnrollmentClosedAtLT applies the LT predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtLT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLT(FieldEnrollmentClosedAt, v))
+}
+
+// EnrollmentClosedAtLTE applies the LTE predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtLTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLTE(FieldEnrollmentClosedAt, v))
+}
+
+// EnrollmentClosedAtIsNil applies the IsNil predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtIsNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIsNull(FieldEnrollmentClosedAt))
+}
+
+// EnrollmentClosedAtNotNil applies the NotNil predicate on the "enrollment_closed_at" field.
+func EnrollmentClosedAtNotNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotNull(FieldEnrollmentClosedAt))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotNull(FieldPausedAt))
+}
+
+// RemindedAtEQ applies the EQ predicate on the "reminded_at" field.
+func RemindedAtEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtNEQ applies the NEQ predicate on the "reminded_at" field.
+func RemindedAtNEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtIn applies the In predicate on the "reminded_at" field.
+func RemindedAtIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtNotIn applies the NotIn predicate on the "reminded_at" field.
+func RemindedAtNotIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtGT applies the GT predicate on the "reminded_at" field.
+func RemindedAtGT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGT(FieldRemindedAt, v))
+}
+
+// RemindedAtGTE applies the GTE predicate on the "reminded_at" field.
+func RemindedAtGTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGTE(FieldRemindedAt, v))
+}
+
+// RemindedAtLT applies the LT predicate on the "reminded_at" field.
+func RemindedAtLT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLT(FieldRemindedAt, v))
+}
+
+// RemindedAtLTE applies the LTE predicate on the "reminded_at" field.
+func RemindedAtLTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLTE(FieldRemindedAt, v))
+}
+
+// RemindedAtIsNil applies the IsNil predicate on the "reminded_at" field.
+func RemindedAtIsNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIsNull(FieldRemindedAt))
+}
+
+// RemindedAtNotNil applies the NotNil predicate on the "reminded_at" field.
+func RemindedAtNotNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotNull(FieldRemindedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancellationReasonEQ applies the EQ predicate on the "cancellation_reason" field.
+func CancellationReasonEQ(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonNEQ applies the NEQ predicate on the "cancellation_reason" field.
+func CancellationReasonNEQ(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonIn applies the In predicate on the "cancellation_reason" field.
+func CancellationReasonIn(vs ...string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonNotIn applies the NotIn predicate on the "cancellation_reason" field.
+func CancellationReasonNotIn(vs ...string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonGT applies the GT predicate on the "cancellation_reason" field.
+func CancellationReasonGT(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGT(FieldCancellationReason, v))
+}
+
+// CancellationReasonGTE applies the GTE predicate on the "cancellation_reason" field.
+func CancellationReasonGTE(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldGTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonLT applies the LT predicate on the "cancellation_reason" field.
+func CancellationReasonLT(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLT(FieldCancellationReason, v))
+}
+
+// CancellationReasonLTE applies the LTE predicate on the "cancellation_reason" field.
+func CancellationReasonLTE(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldLTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonContains applies the Contains predicate on the "cancellation_reason" field.
+func CancellationReasonContains(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldContains(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasPrefix applies the HasPrefix predicate on the "cancellation_reason" field.
+func CancellationReasonHasPrefix(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldHasPrefix(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasSuffix applies the HasSuffix predicate on the "cancellation_reason" field.
+func CancellationReasonHasSuffix(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldHasSuffix(FieldCancellationReason, v))
+}
+
+// CancellationReasonIsNil applies the IsNil predicate on the "cancellation_reason" field.
+func CancellationReasonIsNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldIsNull(FieldCancellationReason))
+}
+
+// CancellationReasonNotNil applies the NotNil predicate on the "cancellation_reason" field.
+func CancellationReasonNotNil() predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldNotNull(FieldCancellationReason))
+}
+
+// CancellationReasonEqualFold applies the EqualFold predicate on the "cancellation_reason" field.
+func CancellationReasonEqualFold(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldEqualFold(FieldCancellationReason, v))
+}
+
+// CancellationReasonContainsFold applies the ContainsFold predicate on the "cancellation_reason" field.
+func CancellationReasonContainsFold(v string) predicate.InternshipBatch {
+	return predicate.InternshipBatch(sql.FieldContainsFold(FieldCancellationReason, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.InternshipBatch) predicate.InternshipBatch {
 	return predicate.InternshipBatch(sql.AndPredicates(predicates...))
@@ -164,6 +164,76 @@ func (ibc *InternshipBatchCreate) SetNillableBatchStatus(s *string) *InternshipB
 	return ibc
 }
 
+// SetEnrollmentClosedAt sets the "enrollment_closed_at" field.
+func (ibc *InternshipBatchCreate) SetEnrollmentClosedAt(t time.Time) *InternshipBatchCreate {
+	ibc.mutation.SetEnrollmentClosedAt(t)
+	return ibc
+}
+
+// SetNillableEnrollmentClosedAt sets the "enrollment_closed_at" field if the given value is not nil.
+func (ibc *InternshipBatchCreate) SetNillableEnrollmentClosedAt(t *time.Time) *InternshipBatchCreate {
+	if t != nil {
+		ibc.SetEnrollmentClosedAt(*t)
+	}
+	return ibc
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (ibc *InternshipBatchCreate) SetPausedAt(t time.Time) *InternshipBatchCreate {
+	ibc.mutation.SetPausedAt(t)
+	return ibc
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (ibc *InternshipBatchCreate) SetNillablePausedAt(t *time.Time) *InternshipBatchCreate {
+	if t != nil {
+		ibc.SetPausedAt(*t)
+	}
+	return ibc
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (ibc *InternshipBatchCreate) SetRemindedAt(t time.Time) *InternshipBatchCreate {
+	ibc.mutation.SetRemindedAt(t)
+	return ibc
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (ibc *InternshipBatchCreate) SetNillableRemindedAt(t *time.Time) *InternshipBatchCreate {
+	if t != nil {
+		ibc.SetRemindedAt(*t)
+	}
+	return ibc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ibc *InternshipBatchCreate) SetCancelledAt(t time.Time) *InternshipBatchCreate {
+	ibc.mutation.SetCancelledAt(t)
+	return ibc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ibc *InternshipBatchCreate) SetNillableCancelledAt(t *time.Time) *InternshipBatchCreate {
+	if t != nil {
+		ibc.SetCancelledAt(*t)
+	}
+	return ibc
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (ibc *InternshipBatchCreate) SetCancellationReason(s string) *InternshipBatchCreate {
+	ibc.mutation.SetCancellationReason(s)
+	return ibc
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (ibc *InternshipBatchCreate) SetNillableCancellationReason(s *string) *InternshipBatchCreate {
+	if s != nil {
+		ibc.SetCancellationReason(*s)
+	}
+	return ibc
+}
+
 // SetID sets the "id" field.
 func (ibc *InternshipBatchCreate) SetID(s string) *InternshipBatchCreate {
 	ibc.mutation.SetID(s)
@@ -357,6 +427,26 @@ func (ibc *InternshipBatchCreate) createSpec() (*InternshipBatch, *sqlgraph.Crea
 		_spec.SetField(internshipbatch.FieldBatchStatus, field.TypeString, value)
 		_node.BatchStatus = value
 	}
+	if value, ok := ibc.mutation.EnrollmentClosedAt(); ok {
+		_spec.SetField(internshipbatch.FieldEnrollmentClosedAt, field.TypeTime, value)
+		_node.EnrollmentClosedAt = &value
+	}
+	if value, ok := ibc.mutation.PausedAt(); ok {
+		_spec.SetField(internshipbatch.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := ibc.mutation.RemindedAt(); ok {
+		_spec.SetField(internshipbatch.FieldRemindedAt, field.TypeTime, value)
+		_node.RemindedAt = &value
+	}
+	if value, ok := ibc.mutation.CancelledAt(); ok {
+		_spec.SetField(internshipbatch.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := ibc.mutation.CancellationReason(); ok {
+		_spec.SetField(internshipbatch.FieldCancellationReason, field.TypeString, value)
+		_node.CancellationReason = &value
+	}
 	return _node, _spec
 }
 
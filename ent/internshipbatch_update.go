@@ -168,6 +168,106 @@ func (ibu *InternshipBatchUpdate) SetNillableBatchStatus(s *string) *InternshipB
 	return ibu
 }
 
+// SetEnrollmentClosedAt sets the "enrollment_closed_at" field.
+func (ibu *InternshipBatchUpdate) SetEnrollmentClosedAt(t time.Time) *InternshipBatchUpdate {
+	ibu.mutation.SetEnrollmentClosedAt(t)
+	return ibu
+}
+
+// SetNillableEnrollmentClosedAt sets the "enrollment_closed_at" field if the given value is not nil.
+func (ibu *InternshipBatchUpdate) SetNillableEnrollmentClosedAt(t *time.Time) *InternshipBatchUpdate {
+	if t != nil {
+		ibu.SetEnrollmentClosedAt(*t)
+	}
+	return ibu
+}
+
+// ClearEnrollmentClosedAt clears the value of the "enrollment_closed_at" field.
+func (ibu *InternshipBatchUpdate) ClearEnrollmentClosedAt() *InternshipBatchUpdate {
+	ibu.mutation.ClearEnrollmentClosedAt()
+	return ibu
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (ibu *InternshipBatchUpdate) SetPausedAt(t time.Time) *InternshipBatchUpdate {
+	ibu.mutation.SetPausedAt(t)
+	return ibu
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (ibu *InternshipBatchUpdate) SetNillablePausedAt(t *time.Time) *InternshipBatchUpdate {
+	if t != nil {
+		ibu.SetPausedAt(*t)
+	}
+	return ibu
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (ibu *InternshipBatchUpdate) ClearPausedAt() *InternshipBatchUpdate {
+	ibu.mutation.ClearPausedAt()
+	return ibu
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (ibu *InternshipBatchUpdate) SetRemindedAt(t time.Time) *InternshipBatchUpdate {
+	ibu.mutation.SetRemindedAt(t)
+	return ibu
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (ibu *InternshipBatchUpdate) SetNillableRemindedAt(t *time.Time) *InternshipBatchUpdate {
+	if t != nil {
+		ibu.SetRemindedAt(*t)
+	}
+	return ibu
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (ibu *InternshipBatchUpdate) ClearRemindedAt() *InternshipBatchUpdate {
+	ibu.mutation.ClearRemindedAt()
+	return ibu
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ibu *InternshipBatchUpdate) SetCancelledAt(t time.Time) *InternshipBatchUpdate {
+	ibu.mutation.SetCancelledAt(t)
+	return ibu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ibu *InternshipBatchUpdate) SetNillableCancelledAt(t *time.Time) *InternshipBatchUpdate {
+	if t != nil {
+		ibu.SetCancelledAt(*t)
+	}
+	return ibu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (ibu *InternshipBatchUpdate) ClearCancelledAt() *InternshipBatchUpdate {
+	ibu.mutation.ClearCancelledAt()
+	return ibu
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (ibu *InternshipBatchUpdate) SetCancellationReason(s string) *InternshipBatchUpdate {
+	ibu.mutation.SetCancellationReason(s)
+	return ibu
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (ibu *InternshipBatchUpdate) SetNillableCancellationReason(s *string) *InternshipBatchUpdate {
+	if s != nil {
+		ibu.SetCancellationReason(*s)
+	}
+	return ibu
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (ibu *InternshipBatchUpdate) ClearCancellationReason() *InternshipBatchUpdate {
+	ibu.mutation.ClearCancellationReason()
+	return ibu
+}
+
 // Mutation returns the InternshipBatchMutation object of the builder.
 func (ibu *InternshipBatchUpdate) Mutation() *InternshipBatchMutation {
 	return ibu.mutation
@@ -281,6 +381,36 @@ func (ibu *InternshipBatchUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := ibu.mutation.BatchStatus(); ok {
 		_spec.SetField(internshipbatch.FieldBatchStatus, field.TypeString, value)
 	}
+	if value, ok := ibu.mutation.EnrollmentClosedAt(); ok {
+		_spec.SetField(internshipbatch.FieldEnrollmentClosedAt, field.TypeTime, value)
+	}
+	if ibu.mutation.EnrollmentClosedAtCleared() {
+		_spec.ClearField(internshipbatch.FieldEnrollmentClosedAt, field.TypeTime)
+	}
+	if value, ok := ibu.mutation.PausedAt(); ok {
+		_spec.SetField(internshipbatch.FieldPausedAt, field.TypeTime, value)
+	}
+	if ibu.mutation.PausedAtCleared() {
+		_spec.ClearField(internshipbatch.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := ibu.mutation.RemindedAt(); ok {
+		_spec.SetField(internshipbatch.FieldRemindedAt, field.TypeTime, value)
+	}
+	if ibu.mutation.RemindedAtCleared() {
+		_spec.ClearField(internshipbatch.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := ibu.mutation.CancelledAt(); ok {
+		_spec.SetField(internshipbatch.FieldCancelledAt, field.TypeTime, value)
+	}
+	if ibu.mutation.CancelledAtCleared() {
+		_spec.ClearField(internshipbatch.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := ibu.mutation.CancellationReason(); ok {
+		_spec.SetField(internshipbatch.FieldCancellationReason, field.TypeString, value)
+	}
+	if ibu.mutation.CancellationReasonCleared() {
+		_spec.ClearField(internshipbatch.FieldCancellationReason, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ibu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{internshipbatch.Label}
@@ -441,6 +571,106 @@ func (ibuo *InternshipBatchUpdateOne) SetNillableBatchStatus(s *string) *Interns
 	return ibuo
 }
 
+// SetEnrollmentClosedAt sets the "enrollment_closed_at" field.
+func (ibuo *InternshipBatchUpdateOne) SetEnrollmentClosedAt(t time.Time) *InternshipBatchUpdateOne {
+	ibuo.mutation.SetEnrollmentClosedAt(t)
+	return ibuo
+}
+
+// SetNillableEnrollmentClosedAt sets the "enrollment_closed_at" field if the given value is not nil.
+func (ibuo *InternshipBatchUpdateOne) SetNillableEnrollmentClosedAt(t *time.Time) *InternshipBatchUpdateOne {
+	if t != nil {
+		ibuo.SetEnrollmentClosedAt(*t)
+	}
+	return ibuo
+}
+
+// ClearEnrollmentClosedAt clears the value of the "enrollment_closed_at" field.
+func (ibuo *InternshipBatchUpdateOne) ClearEnrollmentClosedAt() *InternshipBatchUpdateOne {
+	ibuo.mutation.ClearEnrollmentClosedAt()
+	return ibuo
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (ibuo *InternshipBatchUpdateOne) SetPausedAt(t time.Time) *InternshipBatchUpdateOne {
+	ibuo.mutation.SetPausedAt(t)
+	return ibuo
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (ibuo *InternshipBatchUpdateOne) SetNillablePausedAt(t *time.Time) *InternshipBatchUpdateOne {
+	if t != nil {
+		ibuo.SetPausedAt(*t)
+	}
+	return ibuo
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (ibuo *InternshipBatchUpdateOne) ClearPausedAt() *InternshipBatchUpdateOne {
+	ibuo.mutation.ClearPausedAt()
+	return ibuo
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (ibuo *InternshipBatchUpdateOne) SetRemindedAt(t time.Time) *InternshipBatchUpdateOne {
+	ibuo.mutation.SetRemindedAt(t)
+	return ibuo
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (ibuo *InternshipBatchUpdateOne) SetNillableRemindedAt(t *time.Time) *InternshipBatchUpdateOne {
+	if t != nil {
+		ibuo.SetRemindedAt(*t)
+	}
+	return ibuo
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (ibuo *InternshipBatchUpdateOne) ClearRemindedAt() *InternshipBatchUpdateOne {
+	ibuo.mutation.ClearRemindedAt()
+	return ibuo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ibuo *InternshipBatchUpdateOne) SetCancelledAt(t time.Time) *InternshipBatchUpdateOne {
+	ibuo.mutation.SetCancelledAt(t)
+	return ibuo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ibuo *InternshipBatchUpdateOne) SetNillableCancelledAt(t *time.Time) *InternshipBatchUpdateOne {
+	if t != nil {
+		ibuo.SetCancelledAt(*t)
+	}
+	return ibuo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (ibuo *InternshipBatchUpdateOne) ClearCancelledAt() *InternshipBatchUpdateOne {
+	ibuo.mutation.ClearCancelledAt()
+	return ibuo
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (ibuo *InternshipBatchUpdateOne) SetCancellationReason(s string) *InternshipBatchUpdateOne {
+	ibuo.mutation.SetCancellationReason(s)
+	return ibuo
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (ibuo *InternshipBatchUpdateOne) SetNillableCancellationReason(s *string) *InternshipBatchUpdateOne {
+	if s != nil {
+		ibuo.SetCancellationReason(*s)
+	}
+	return ibuo
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (ibuo *InternshipBatchUpdateOne) ClearCancellationReason() *InternshipBatchUpdateOne {
+	ibuo.mutation.ClearCancellationReason()
+	return ibuo
+}
+
 // Mutation returns the InternshipBatchMutation object of the builder.
 func (ibuo *InternshipBatchUpdateOne) Mutation() *InternshipBatchMutation {
 	return ibuo.mutation
@@ -584,6 +814,36 @@ func (ibuo *InternshipBatchUpdateOne) sqlSave(ctx context.Context) (_node *Inter
 	if value, ok := ibuo.mutation.BatchStatus(); ok {
 		_spec.SetField(internshipbatch.FieldBatchStatus, field.TypeString, value)
 	}
+	if value, ok := ibuo.mutation.EnrollmentClosedAt(); ok {
+		_spec.SetField(internshipbatch.FieldEnrollmentClosedAt, field.TypeTime, value)
+	}
+	if ibuo.mutation.EnrollmentClosedAtCleared() {
+		_spec.ClearField(internshipbatch.FieldEnrollmentClosedAt, field.TypeTime)
+	}
+	if value, ok := ibuo.mutation.PausedAt(); ok {
+		_spec.SetField(internshipbatch.FieldPausedAt, field.TypeTime, value)
+	}
+	if ibuo.mutation.PausedAtCleared() {
+		_spec.ClearField(internshipbatch.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := ibuo.mutation.RemindedAt(); ok {
+		_spec.SetField(internshipbatch.FieldRemindedAt, field.TypeTime, value)
+	}
+	if ibuo.mutation.RemindedAtCleared() {
+		_spec.ClearField(internshipbatch.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := ibuo.mutation.CancelledAt(); ok {
+		_spec.SetField(internshipbatch.FieldCancelledAt, field.TypeTime, value)
+	}
+	if ibuo.mutation.CancelledAtCleared() {
+		_spec.ClearField(internshipbatch.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := ibuo.mutation.CancellationReason(); ok {
+		_spec.SetField(internshipbatch.FieldCancellationReason, field.TypeString, value)
+	}
+	if ibuo.mutation.CancellationReasonCleared() {
+		_spec.ClearField(internshipbatch.FieldCancellationReason, field.TypeString)
+	}
 	_node = &InternshipBatch{config: ibuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
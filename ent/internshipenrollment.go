@@ -37,6 +37,8 @@ type InternshipEnrollment struct {
 	InternshipID string `json:"internship_id,omitempty"`
 	// InternshipBatchID holds the value of the "internship_batch_id" field.
 	InternshipBatchID string `json:"internship_batch_id,omitempty"`
+	// InternshipRevisionID holds the value of the "internship_revision_id" field.
+	InternshipRevisionID *string `json:"internship_revision_id,omitempty"`
 	// EnrollmentStatus holds the value of the "enrollment_status" field.
 	EnrollmentStatus types.InternshipEnrollmentStatus `json:"enrollment_status,omitempty"`
 	// PaymentStatus holds the value of the "payment_status" field.
@@ -47,13 +49,17 @@ type InternshipEnrollment struct {
 	PaymentID *string `json:"payment_id,omitempty"`
 	// RefundedAt holds the value of the "refunded_at" field.
 	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// CancellationReason holds the value of the "cancellation_reason" field.
 	CancellationReason *string `json:"cancellation_reason,omitempty"`
 	// RefundReason holds the value of the "refund_reason" field.
 	RefundReason *string `json:"refund_reason,omitempty"`
 	// IdempotencyKey holds the value of the "idempotency_key" field.
 	IdempotencyKey *string `json:"idempotency_key,omitempty"`
-	selectValues   sql.SelectValues
+	// PaymentPlanID holds the value of the "payment_plan_id" field.
+	PaymentPlanID *string `json:"payment_plan_id,omitempty"`
+	selectValues  sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -63,9 +69,9 @@ func (*InternshipEnrollment) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case internshipenrollment.FieldMetadata:
 			values[i] = new([]byte)
-		case internshipenrollment.FieldID, internshipenrollment.FieldStatus, internshipenrollment.FieldCreatedBy, internshipenrollment.FieldUpdatedBy, internshipenrollment.FieldUserID, internshipenrollment.FieldInternshipID, internshipenrollment.FieldInternshipBatchID, internshipenrollment.FieldEnrollmentStatus, internshipenrollment.FieldPaymentStatus, internshipenrollment.FieldPaymentID, internshipenrollment.FieldCancellationReason, internshipenrollment.FieldRefundReason, internshipenrollment.FieldIdempotencyKey:
+		case internshipenrollment.FieldID, internshipenrollment.FieldStatus, internshipenrollment.FieldCreatedBy, internshipenrollment.FieldUpdatedBy, internshipenrollment.FieldUserID, internshipenrollment.FieldInternshipID, internshipenrollment.FieldInternshipBatchID, internshipenrollment.FieldInternshipRevisionID, internshipenrollment.FieldEnrollmentStatus, internshipenrollment.FieldPaymentStatus, internshipenrollment.FieldPaymentID, internshipenrollment.FieldCancellationReason, internshipenrollment.FieldRefundReason, internshipenrollment.FieldIdempotencyKey, internshipenrollment.FieldPaymentPlanID:
 			values[i] = new(sql.NullString)
-		case internshipenrollment.FieldCreatedAt, internshipenrollment.FieldUpdatedAt, internshipenrollment.FieldEnrolledAt, internshipenrollment.FieldRefundedAt:
+		case internshipenrollment.FieldCreatedAt, internshipenrollment.FieldUpdatedAt, internshipenrollment.FieldEnrolledAt, internshipenrollment.FieldRefundedAt, internshipenrollment.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -144,6 +150,13 @@ func (ie *InternshipEnrollment) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				ie.InternshipBatchID = value.String
 			}
+		case internshipenrollment.FieldInternshipRevisionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_revision_id", values[i])
+			} else if value.Valid {
+				ie.InternshipRevisionID = new(string)
+				*ie.InternshipRevisionID = value.String
+			}
 		case internshipenrollment.FieldEnrollmentStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field enrollment_status", values[i])
@@ -177,6 +190,13 @@ func (ie *InternshipEnrollment) assignValues(columns []string, values []any) err
 				ie.RefundedAt = new(time.Time)
 				*ie.RefundedAt = value.Time
 			}
+		case internshipenrollment.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				ie.CompletedAt = new(time.Time)
+				*ie.CompletedAt = value.Time
+			}
 		case internshipenrollment.FieldCancellationReason:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field cancellation_reason", values[i])
@@ -198,6 +218,13 @@ func (ie *InternshipEnrollment) assignValues(columns []string, values []any) err
 				ie.IdempotencyKey = new(string)
 				*ie.IdempotencyKey = value.String
 			}
+		case internshipenrollment.FieldPaymentPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_plan_id", values[i])
+			} else if value.Valid {
+				ie.PaymentPlanID = new(string)
+				*ie.PaymentPlanID = value.String
+			}
 		default:
 			ie.selectValues.Set(columns[i], values[i])
 		}
@@ -261,6 +288,11 @@ func (ie *InternshipEnrollment) String() string {
 	builder.WriteString("internship_batch_id=")
 	builder.WriteString(ie.InternshipBatchID)
 	builder.WriteString(", ")
+	if v := ie.InternshipRevisionID; v != nil {
+		builder.WriteString("internship_revision_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enrollment_status=")
 	builder.WriteString(fmt.Sprintf("%v", ie.EnrollmentStatus))
 	builder.WriteString(", ")
@@ -282,6 +314,11 @@ func (ie *InternshipEnrollment) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := ie.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := ie.CancellationReason; v != nil {
 		builder.WriteString("cancellation_reason=")
 		builder.WriteString(*v)
@@ -296,6 +333,11 @@ func (ie *InternshipEnrollment) String() string {
 		builder.WriteString("idempotency_key=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := ie.PaymentPlanID; v != nil {
+		builder.WriteString("payment_plan_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
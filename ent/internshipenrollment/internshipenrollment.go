@@ -32,6 +32,8 @@ const (
 	FieldInternshipID = "internship_id"
 	// FieldInternshipBatchID holds the string denoting the internship_batch_id field in the database.
 	FieldInternshipBatchID = "internship_batch_id"
+	// FieldInternshipRevisionID holds the string denoting the internship_revision_id field in the database.
+	FieldInternshipRevisionID = "internship_revision_id"
 	// FieldEnrollmentStatus holds the string denoting the enrollment_status field in the database.
 	FieldEnrollmentStatus = "enrollment_status"
 	// FieldPaymentStatus holds the string denoting the payment_status field in the database.
@@ -42,12 +44,16 @@ const (
 	FieldPaymentID = "payment_id"
 	// FieldRefundedAt holds the string denoting the refunded_at field in the database.
 	FieldRefundedAt = "refunded_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
 	// FieldCancellationReason holds the string denoting the cancellation_reason field in the database.
 	FieldCancellationReason = "cancellation_reason"
 	// FieldRefundReason holds the string denoting the refund_reason field in the database.
 	FieldRefundReason = "refund_reason"
 	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
 	FieldIdempotencyKey = "idempotency_key"
+	// FieldPaymentPlanID holds the string denoting the payment_plan_id field in the database.
+	FieldPaymentPlanID = "payment_plan_id"
 	// Table holds the table name of the internshipenrollment in the database.
 	Table = "internship_enrollments"
 )
@@ -64,14 +70,17 @@ var Columns = []string{
 	FieldUserID,
 	FieldInternshipID,
 	FieldInternshipBatchID,
+	FieldInternshipRevisionID,
 	FieldEnrollmentStatus,
 	FieldPaymentStatus,
 	FieldEnrolledAt,
 	FieldPaymentID,
 	FieldRefundedAt,
+	FieldCompletedAt,
 	FieldCancellationReason,
 	FieldRefundReason,
 	FieldIdempotencyKey,
+	FieldPaymentPlanID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -161,6 +170,11 @@ func ByInternshipBatchID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInternshipBatchID, opts...).ToFunc()
 }
 
+// ByInternshipRevisionID orders the results by the internship_revision_id field.
+func ByInternshipRevisionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipRevisionID, opts...).ToFunc()
+}
+
 // ByEnrollmentStatus orders the results by the enrollment_status field.
 func ByEnrollmentStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnrollmentStatus, opts...).ToFunc()
@@ -186,6 +200,11 @@ func ByRefundedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRefundedAt, opts...).ToFunc()
 }
 
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
 // ByCancellationReason orders the results by the cancellation_reason field.
 func ByCancellationReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCancellationReason, opts...).ToFunc()
@@ -200,3 +219,8 @@ func ByRefundReason(opts ...sql.OrderTermOption) OrderOption {
 func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
 }
+
+// ByPaymentPlanID orders the results by the payment_plan_id field.
+func ByPaymentPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentPlanID, opts...).ToFunc()
+}
@@ -105,6 +105,11 @@ func InternshipBatchID(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldEQ(FieldInternshipBatchID, v))
 }
 
+// InternshipRevisionID applies equality check predicate on the "internship_revision_id" field. It's identical to InternshipRevisionIDEQ.
+func InternshipRevisionID(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEQ(FieldInternshipRevisionID, v))
+}
+
 // EnrollmentStatus applies equality check predicate on the "enrollment_status" field. It's identical to EnrollmentStatusEQ.
 func EnrollmentStatus(v types.InternshipEnrollmentStatus) predicate.InternshipEnrollment {
 	vc := string(v)
@@ -132,6 +137,11 @@ func RefundedAt(v time.Time) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldEQ(FieldRefundedAt, v))
 }
 
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEQ(FieldCompletedAt, v))
+}
+
 // CancellationReason applies equality check predicate on the "cancellation_reason" field. It's identical to CancellationReasonEQ.
 func CancellationReason(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldEQ(FieldCancellationReason, v))
@@ -147,6 +157,11 @@ func IdempotencyKey(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldEQ(FieldIdempotencyKey, v))
 }
 
+// PaymentPlanID applies equality check predicate on the "payment_plan_id" field. It's identical to PaymentPlanIDEQ.
+func PaymentPlanID(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEQ(FieldPaymentPlanID, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldEQ(FieldStatus, v))
@@ -647,6 +662,81 @@ func InternshipBatchIDContainsFold(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldContainsFold(FieldInternshipBatchID, v))
 }
 
+// InternshipRevisionIDEQ applies the EQ predicate on the "internship_revision_id" field.
+func InternshipRevisionIDEQ(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEQ(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDNEQ applies the NEQ predicate on the "internship_revision_id" field.
+func InternshipRevisionIDNEQ(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNEQ(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDIn applies the In predicate on the "internship_revision_id" field.
+func InternshipRevisionIDIn(vs ...string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldIn(FieldInternshipRevisionID, vs...))
+}
+
+// InternshipRevisionIDNotIn applies the NotIn predicate on the "internship_revision_id" field.
+func InternshipRevisionIDNotIn(vs ...string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNotIn(FieldInternshipRevisionID, vs...))
+}
+
+// InternshipRevisionIDGT applies the GT predicate on the "internship_revision_id" field.
+func InternshipRevisionIDGT(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldGT(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDGTE applies the GTE predicate on the "internship_revision_id" field.
+func InternshipRevisionIDGTE(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldGTE(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDLT applies the LT predicate on the "internship_revision_id" field.
+func InternshipRevisionIDLT(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldLT(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDLTE applies the LTE predicate on the "internship_revision_id" field.
+func InternshipRevisionIDLTE(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldLTE(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDContains applies the Contains predicate on the "internship_revision_id" field.
+func InternshipRevisionIDContains(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldContains(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDHasPrefix applies the HasPrefix predicate on the "internship_revision_id" field.
+func InternshipRevisionIDHasPrefix(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldHasPrefix(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDHasSuffix applies the HasSuffix predicate on the "internship_revision_id" field.
+func InternshipRevisionIDHasSuffix(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldHasSuffix(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDIsNil applies the IsNil predicate on the "internship_revision_id" field.
+func InternshipRevisionIDIsNil() predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldIsNull(FieldInternshipRevisionID))
+}
+
+// InternshipRevisionIDNotNil applies the NotNil predicate on the "internship_revision_id" field.
+func InternshipRevisionIDNotNil() predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNotNull(FieldInternshipRevisionID))
+}
+
+// InternshipRevisionIDEqualFold applies the EqualFold predicate on the "internship_revision_id" field.
+func InternshipRevisionIDEqualFold(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEqualFold(FieldInternshipRevisionID, v))
+}
+
+// InternshipRevisionIDContainsFold applies the ContainsFold predicate on the "internship_revision_id" field.
+func InternshipRevisionIDContainsFold(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldContainsFold(FieldInternshipRevisionID, v))
+}
+
 // EnrollmentStatusEQ applies the EQ predicate on the "enrollment_status" field.
 func EnrollmentStatusEQ(v types.InternshipEnrollmentStatus) predicate.InternshipEnrollment {
 	vc := string(v)
@@ -990,6 +1080,56 @@ func RefundedAtNotNil() predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldNotNull(FieldRefundedAt))
 }
 
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNotNull(FieldCompletedAt))
+}
+
 // CancellationReasonEQ applies the EQ predicate on the "cancellation_reason" field.
 func CancellationReasonEQ(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldEQ(FieldCancellationReason, v))
@@ -1215,6 +1355,81 @@ func IdempotencyKeyContainsFold(v string) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.FieldContainsFold(FieldIdempotencyKey, v))
 }
 
+// PaymentPlanIDEQ applies the EQ predicate on the "payment_plan_id" field.
+func PaymentPlanIDEQ(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEQ(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDNEQ applies the NEQ predicate on the "payment_plan_id" field.
+func PaymentPlanIDNEQ(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNEQ(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDIn applies the In predicate on the "payment_plan_id" field.
+func PaymentPlanIDIn(vs ...string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldIn(FieldPaymentPlanID, vs...))
+}
+
+// PaymentPlanIDNotIn applies the NotIn predicate on the "payment_plan_id" field.
+func PaymentPlanIDNotIn(vs ...string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNotIn(FieldPaymentPlanID, vs...))
+}
+
+// PaymentPlanIDGT applies the GT predicate on the "payment_plan_id" field.
+func PaymentPlanIDGT(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldGT(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDGTE applies the GTE predicate on the "payment_plan_id" field.
+func PaymentPlanIDGTE(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldGTE(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDLT applies the LT predicate on the "payment_plan_id" field.
+func PaymentPlanIDLT(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldLT(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDLTE applies the LTE predicate on the "payment_plan_id" field.
+func PaymentPlanIDLTE(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldLTE(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDContains applies the Contains predicate on the "payment_plan_id" field.
+func PaymentPlanIDContains(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldContains(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDHasPrefix applies the HasPrefix predicate on the "payment_plan_id" field.
+func PaymentPlanIDHasPrefix(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldHasPrefix(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDHasSuffix applies the HasSuffix predicate on the "payment_plan_id" field.
+func PaymentPlanIDHasSuffix(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldHasSuffix(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDIsNil applies the IsNil predicate on the "payment_plan_id" field.
+func PaymentPlanIDIsNil() predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldIsNull(FieldPaymentPlanID))
+}
+
+// PaymentPlanIDNotNil applies the NotNil predicate on the "payment_plan_id" field.
+func PaymentPlanIDNotNil() predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldNotNull(FieldPaymentPlanID))
+}
+
+// PaymentPlanIDEqualFold applies the EqualFold predicate on the "payment_plan_id" field.
+func PaymentPlanIDEqualFold(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldEqualFold(FieldPaymentPlanID, v))
+}
+
+// PaymentPlanIDContainsFold applies the ContainsFold predicate on the "payment_plan_id" field.
+func PaymentPlanIDContainsFold(v string) predicate.InternshipEnrollment {
+	return predicate.InternshipEnrollment(sql.FieldContainsFold(FieldPaymentPlanID, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.InternshipEnrollment) predicate.InternshipEnrollment {
 	return predicate.InternshipEnrollment(sql.AndPredicates(predicates...))
@@ -115,6 +115,20 @@ func (iec *InternshipEnrollmentCreate) SetInternshipBatchID(s string) *Internshi
 	return iec
 }
 
+// SetInternshipRevisionID sets the "internship_revision_id" field.
+func (iec *InternshipEnrollmentCreate) SetInternshipRevisionID(s string) *InternshipEnrollmentCreate {
+	iec.mutation.SetInternshipRevisionID(s)
+	return iec
+}
+
+// SetNillableInternshipRevisionID sets the "internship_revision_id" field if the given value is not nil.
+func (iec *InternshipEnrollmentCreate) SetNillableInternshipRevisionID(s *string) *InternshipEnrollmentCreate {
+	if s != nil {
+		iec.SetInternshipRevisionID(*s)
+	}
+	return iec
+}
+
 // SetEnrollmentStatus sets the "enrollment_status" field.
 func (iec *InternshipEnrollmentCreate) SetEnrollmentStatus(tes types.InternshipEnrollmentStatus) *InternshipEnrollmentCreate {
 	iec.mutation.SetEnrollmentStatus(tes)
@@ -185,6 +199,20 @@ func (iec *InternshipEnrollmentCreate) SetNillableRefundedAt(t *time.Time) *Inte
 	return iec
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (iec *InternshipEnrollmentCreate) SetCompletedAt(t time.Time) *InternshipEnrollmentCreate {
+	iec.mutation.SetCompletedAt(t)
+	return iec
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (iec *InternshipEnrollmentCreate) SetNillableCompletedAt(t *time.Time) *InternshipEnrollmentCreate {
+	if t != nil {
+		iec.SetCompletedAt(*t)
+	}
+	return iec
+}
+
 // SetCancellationReason sets the "cancellation_reason" field.
 func (iec *InternshipEnrollmentCreate) SetCancellationReason(s string) *InternshipEnrollmentCreate {
 	iec.mutation.SetCancellationReason(s)
@@ -227,6 +255,20 @@ func (iec *InternshipEnrollmentCreate) SetNillableIdempotencyKey(s *string) *Int
 	return iec
 }
 
+// SetPaymentPlanID sets the "payment_plan_id" field.
+func (iec *InternshipEnrollmentCreate) SetPaymentPlanID(s string) *InternshipEnrollmentCreate {
+	iec.mutation.SetPaymentPlanID(s)
+	return iec
+}
+
+// SetNillablePaymentPlanID sets the "payment_plan_id" field if the given value is not nil.
+func (iec *InternshipEnrollmentCreate) SetNillablePaymentPlanID(s *string) *InternshipEnrollmentCreate {
+	if s != nil {
+		iec.SetPaymentPlanID(*s)
+	}
+	return iec
+}
+
 // SetID sets the "id" field.
 func (iec *InternshipEnrollmentCreate) SetID(s string) *InternshipEnrollmentCreate {
 	iec.mutation.SetID(s)
@@ -428,6 +470,10 @@ func (iec *InternshipEnrollmentCreate) createSpec() (*InternshipEnrollment, *sql
 		_spec.SetField(internshipenrollment.FieldInternshipBatchID, field.TypeString, value)
 		_node.InternshipBatchID = value
 	}
+	if value, ok := iec.mutation.InternshipRevisionID(); ok {
+		_spec.SetField(internshipenrollment.FieldInternshipRevisionID, field.TypeString, value)
+		_node.InternshipRevisionID = &value
+	}
 	if value, ok := iec.mutation.EnrollmentStatus(); ok {
 		_spec.SetField(internshipenrollment.FieldEnrollmentStatus, field.TypeString, value)
 		_node.EnrollmentStatus = value
@@ -448,6 +494,10 @@ func (iec *InternshipEnrollmentCreate) createSpec() (*InternshipEnrollment, *sql
 		_spec.SetField(internshipenrollment.FieldRefundedAt, field.TypeTime, value)
 		_node.RefundedAt = &value
 	}
+	if value, ok := iec.mutation.CompletedAt(); ok {
+		_spec.SetField(internshipenrollment.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
 	if value, ok := iec.mutation.CancellationReason(); ok {
 		_spec.SetField(internshipenrollment.FieldCancellationReason, field.TypeString, value)
 		_node.CancellationReason = &value
@@ -460,6 +510,10 @@ func (iec *InternshipEnrollmentCreate) createSpec() (*InternshipEnrollment, *sql
 		_spec.SetField(internshipenrollment.FieldIdempotencyKey, field.TypeString, value)
 		_node.IdempotencyKey = &value
 	}
+	if value, ok := iec.mutation.PaymentPlanID(); ok {
+		_spec.SetField(internshipenrollment.FieldPaymentPlanID, field.TypeString, value)
+		_node.PaymentPlanID = &value
+	}
 	return _node, _spec
 }
 
@@ -211,6 +211,26 @@ func (ieu *InternshipEnrollmentUpdate) ClearRefundedAt() *InternshipEnrollmentUp
 	return ieu
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (ieu *InternshipEnrollmentUpdate) SetCompletedAt(t time.Time) *InternshipEnrollmentUpdate {
+	ieu.mutation.SetCompletedAt(t)
+	return ieu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ieu *InternshipEnrollmentUpdate) SetNillableCompletedAt(t *time.Time) *InternshipEnrollmentUpdate {
+	if t != nil {
+		ieu.SetCompletedAt(*t)
+	}
+	return ieu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ieu *InternshipEnrollmentUpdate) ClearCompletedAt() *InternshipEnrollmentUpdate {
+	ieu.mutation.ClearCompletedAt()
+	return ieu
+}
+
 // SetCancellationReason sets the "cancellation_reason" field.
 func (ieu *InternshipEnrollmentUpdate) SetCancellationReason(s string) *InternshipEnrollmentUpdate {
 	ieu.mutation.SetCancellationReason(s)
@@ -384,6 +404,9 @@ func (ieu *InternshipEnrollmentUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := ieu.mutation.InternshipBatchID(); ok {
 		_spec.SetField(internshipenrollment.FieldInternshipBatchID, field.TypeString, value)
 	}
+	if ieu.mutation.InternshipRevisionIDCleared() {
+		_spec.ClearField(internshipenrollment.FieldInternshipRevisionID, field.TypeString)
+	}
 	if value, ok := ieu.mutation.EnrollmentStatus(); ok {
 		_spec.SetField(internshipenrollment.FieldEnrollmentStatus, field.TypeString, value)
 	}
@@ -408,6 +431,12 @@ func (ieu *InternshipEnrollmentUpdate) sqlSave(ctx context.Context) (n int, err
 	if ieu.mutation.RefundedAtCleared() {
 		_spec.ClearField(internshipenrollment.FieldRefundedAt, field.TypeTime)
 	}
+	if value, ok := ieu.mutation.CompletedAt(); ok {
+		_spec.SetField(internshipenrollment.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ieu.mutation.CompletedAtCleared() {
+		_spec.ClearField(internshipenrollment.FieldCompletedAt, field.TypeTime)
+	}
 	if value, ok := ieu.mutation.CancellationReason(); ok {
 		_spec.SetField(internshipenrollment.FieldCancellationReason, field.TypeString, value)
 	}
@@ -426,6 +455,9 @@ func (ieu *InternshipEnrollmentUpdate) sqlSave(ctx context.Context) (n int, err
 	if ieu.mutation.IdempotencyKeyCleared() {
 		_spec.ClearField(internshipenrollment.FieldIdempotencyKey, field.TypeString)
 	}
+	if ieu.mutation.PaymentPlanIDCleared() {
+		_spec.ClearField(internshipenrollment.FieldPaymentPlanID, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ieu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{internshipenrollment.Label}
@@ -628,6 +660,26 @@ func (ieuo *InternshipEnrollmentUpdateOne) ClearRefundedAt() *InternshipEnrollme
 	return ieuo
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (ieuo *InternshipEnrollmentUpdateOne) SetCompletedAt(t time.Time) *InternshipEnrollmentUpdateOne {
+	ieuo.mutation.SetCompletedAt(t)
+	return ieuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ieuo *InternshipEnrollmentUpdateOne) SetNillableCompletedAt(t *time.Time) *InternshipEnrollmentUpdateOne {
+	if t != nil {
+		ieuo.SetCompletedAt(*t)
+	}
+	return ieuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ieuo *InternshipEnrollmentUpdateOne) ClearCompletedAt() *InternshipEnrollmentUpdateOne {
+	ieuo.mutation.ClearCompletedAt()
+	return ieuo
+}
+
 // SetCancellationReason sets the "cancellation_reason" field.
 func (ieuo *InternshipEnrollmentUpdateOne) SetCancellationReason(s string) *InternshipEnrollmentUpdateOne {
 	ieuo.mutation.SetCancellationReason(s)
@@ -831,6 +883,9 @@ func (ieuo *InternshipEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *
 	if value, ok := ieuo.mutation.InternshipBatchID(); ok {
 		_spec.SetField(internshipenrollment.FieldInternshipBatchID, field.TypeString, value)
 	}
+	if ieuo.mutation.InternshipRevisionIDCleared() {
+		_spec.ClearField(internshipenrollment.FieldInternshipRevisionID, field.TypeString)
+	}
 	if value, ok := ieuo.mutation.EnrollmentStatus(); ok {
 		_spec.SetField(internshipenrollment.FieldEnrollmentStatus, field.TypeString, value)
 	}
@@ -855,6 +910,12 @@ func (ieuo *InternshipEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *
 	if ieuo.mutation.RefundedAtCleared() {
 		_spec.ClearField(internshipenrollment.FieldRefundedAt, field.TypeTime)
 	}
+	if value, ok := ieuo.mutation.CompletedAt(); ok {
+		_spec.SetField(internshipenrollment.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ieuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(internshipenrollment.FieldCompletedAt, field.TypeTime)
+	}
 	if value, ok := ieuo.mutation.CancellationReason(); ok {
 		_spec.SetField(internshipenrollment.FieldCancellationReason, field.TypeString, value)
 	}
@@ -873,6 +934,9 @@ func (ieuo *InternshipEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *
 	if ieuo.mutation.IdempotencyKeyCleared() {
 		_spec.ClearField(internshipenrollment.FieldIdempotencyKey, field.TypeString)
 	}
+	if ieuo.mutation.PaymentPlanIDCleared() {
+		_spec.ClearField(internshipenrollment.FieldPaymentPlanID, field.TypeString)
+	}
 	_node = &InternshipEnrollment{config: ieuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+)
+
+// InternshipInstructor is the model entity for the InternshipInstructor schema.
+type InternshipInstructor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role         string `json:"role,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InternshipInstructor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case internshipinstructor.FieldID, internshipinstructor.FieldStatus, internshipinstructor.FieldCreatedBy, internshipinstructor.FieldUpdatedBy, internshipinstructor.FieldInternshipID, internshipinstructor.FieldUserID, internshipinstructor.FieldRole:
+			values[i] = new(sql.NullString)
+		case internshipinstructor.FieldCreatedAt, internshipinstructor.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InternshipInstructor fields.
+func (ii *InternshipInstructor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case internshipinstructor.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ii.ID = value.String
+			}
+		case internshipinstructor.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ii.Status = value.String
+			}
+		case internshipinstructor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ii.CreatedAt = value.Time
+			}
+		case internshipinstructor.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ii.UpdatedAt = value.Time
+			}
+		case internshipinstructor.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ii.CreatedBy = value.String
+			}
+		case internshipinstructor.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ii.UpdatedBy = value.String
+			}
+		case internshipinstructor.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				ii.InternshipID = value.String
+			}
+		case internshipinstructor.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ii.UserID = value.String
+			}
+		case internshipinstructor.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				ii.Role = value.String
+			}
+		default:
+			ii.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InternshipInstructor.
+// This includes values selected through modifiers, order, etc.
+func (ii *InternshipInstructor) Value(name string) (ent.Value, error) {
+	return ii.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InternshipInstructor.
+// Note that you need to call InternshipInstructor.Unwrap() before calling this method if this InternshipInstructor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ii *InternshipInstructor) Update() *InternshipInstructorUpdateOne {
+	return NewInternshipInstructorClient(ii.config).UpdateOne(ii)
+}
+
+// Unwrap unwraps the InternshipInstructor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ii *InternshipInstructor) Unwrap() *InternshipInstructor {
+	_tx, ok := ii.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InternshipInstructor is not a transactional entity")
+	}
+	ii.config.driver = _tx.drv
+	return ii
+}
+
+// String implements the fmt.Stringer.
+func (ii *InternshipInstructor) String() string {
+	var builder strings.Builder
+	builder.WriteString("InternshipInstructor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ii.ID))
+	builder.WriteString("status=")
+	builder.WriteString(ii.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ii.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ii.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ii.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ii.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(ii.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ii.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(ii.Role)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InternshipInstructors is a parsable slice of InternshipInstructor.
+type InternshipInstructors []*InternshipInstructor
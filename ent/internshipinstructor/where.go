@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internshipinstructor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldUserID, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldRole, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.FieldContainsFold(FieldRole, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InternshipInstructor) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InternshipInstructor) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InternshipInstructor) predicate.InternshipInstructor {
+	return predicate.InternshipInstructor(sql.NotPredicates(p))
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+)
+
+// InternshipInstructorCreate is the builder for creating a InternshipInstructor entity.
+type InternshipInstructorCreate struct {
+	config
+	mutation *InternshipInstructorMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (iic *InternshipInstructorCreate) SetStatus(s string) *InternshipInstructorCreate {
+	iic.mutation.SetStatus(s)
+	return iic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iic *InternshipInstructorCreate) SetNillableStatus(s *string) *InternshipInstructorCreate {
+	if s != nil {
+		iic.SetStatus(*s)
+	}
+	return iic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iic *InternshipInstructorCreate) SetCreatedAt(t time.Time) *InternshipInstructorCreate {
+	iic.mutation.SetCreatedAt(t)
+	return iic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iic *InternshipInstructorCreate) SetNillableCreatedAt(t *time.Time) *InternshipInstructorCreate {
+	if t != nil {
+		iic.SetCreatedAt(*t)
+	}
+	return iic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iic *InternshipInstructorCreate) SetUpdatedAt(t time.Time) *InternshipInstructorCreate {
+	iic.mutation.SetUpdatedAt(t)
+	return iic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iic *InternshipInstructorCreate) SetNillableUpdatedAt(t *time.Time) *InternshipInstructorCreate {
+	if t != nil {
+		iic.SetUpdatedAt(*t)
+	}
+	return iic
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iic *InternshipInstructorCreate) SetCreatedBy(s string) *InternshipInstructorCreate {
+	iic.mutation.SetCreatedBy(s)
+	return iic
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iic *InternshipInstructorCreate) SetNillableCreatedBy(s *string) *InternshipInstructorCreate {
+	if s != nil {
+		iic.SetCreatedBy(*s)
+	}
+	return iic
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iic *InternshipInstructorCreate) SetUpdatedBy(s string) *InternshipInstructorCreate {
+	iic.mutation.SetUpdatedBy(s)
+	return iic
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iic *InternshipInstructorCreate) SetNillableUpdatedBy(s *string) *InternshipInstructorCreate {
+	if s != nil {
+		iic.SetUpdatedBy(*s)
+	}
+	return iic
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (iic *InternshipInstructorCreate) SetInternshipID(s string) *InternshipInstructorCreate {
+	iic.mutation.SetInternshipID(s)
+	return iic
+}
+
+// SetUserID sets the "user_id" field.
+func (iic *InternshipInstructorCreate) SetUserID(s string) *InternshipInstructorCreate {
+	iic.mutation.SetUserID(s)
+	return iic
+}
+
+// SetRole sets the "role" field.
+func (iic *InternshipInstructorCreate) SetRole(s string) *InternshipInstructorCreate {
+	iic.mutation.SetRole(s)
+	return iic
+}
+
+// SetID sets the "id" field.
+func (iic *InternshipInstructorCreate) SetID(s string) *InternshipInstructorCreate {
+	iic.mutation.SetID(s)
+	return iic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (iic *InternshipInstructorCreate) SetNillableID(s *string) *InternshipInstructorCreate {
+	if s != nil {
+		iic.SetID(*s)
+	}
+	return iic
+}
+
+// Mutation returns the InternshipInstructorMutation object of the builder.
+func (iic *InternshipInstructorCreate) Mutation() *InternshipInstructorMutation {
+	return iic.mutation
+}
+
+// Save creates the InternshipInstructor in the database.
+func (iic *InternshipInstructorCreate) Save(ctx context.Context) (*InternshipInstructor, error) {
+	iic.defaults()
+	return withHooks(ctx, iic.sqlSave, iic.mutation, iic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iic *InternshipInstructorCreate) SaveX(ctx context.Context) *InternshipInstructor {
+	v, err := iic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iic *InternshipInstructorCreate) Exec(ctx context.Context) error {
+	_, err := iic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iic *InternshipInstructorCreate) ExecX(ctx context.Context) {
+	if err := iic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iic *InternshipInstructorCreate) defaults() {
+	if _, ok := iic.mutation.Status(); !ok {
+		v := internshipinstructor.DefaultStatus
+		iic.mutation.SetStatus(v)
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		v := internshipinstructor.DefaultCreatedAt()
+		iic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := iic.mutation.UpdatedAt(); !ok {
+		v := internshipinstructor.DefaultUpdatedAt()
+		iic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := iic.mutation.ID(); !ok {
+		v := internshipinstructor.DefaultID()
+		iic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iic *InternshipInstructorCreate) check() error {
+	if _, ok := iic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InternshipInstructor.status"`)}
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InternshipInstructor.created_at"`)}
+	}
+	if _, ok := iic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InternshipInstructor.updated_at"`)}
+	}
+	if _, ok := iic.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "InternshipInstructor.internship_id"`)}
+	}
+	if v, ok := iic.mutation.InternshipID(); ok {
+		if err := internshipinstructor.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "InternshipInstructor.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InternshipInstructor.user_id"`)}
+	}
+	if v, ok := iic.mutation.UserID(); ok {
+		if err := internshipinstructor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "InternshipInstructor.user_id": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "InternshipInstructor.role"`)}
+	}
+	if v, ok := iic.mutation.Role(); ok {
+		if err := internshipinstructor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "InternshipInstructor.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iic *InternshipInstructorCreate) sqlSave(ctx context.Context) (*InternshipInstructor, error) {
+	if err := iic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InternshipInstructor.ID type: %T", _spec.ID.Value)
+		}
+	}
+	iic.mutation.id = &_node.ID
+	iic.mutation.done = true
+	return _node, nil
+}
+
+func (iic *InternshipInstructorCreate) createSpec() (*InternshipInstructor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InternshipInstructor{config: iic.config}
+		_spec = sqlgraph.NewCreateSpec(internshipinstructor.Table, sqlgraph.NewFieldSpec(internshipinstructor.FieldID, field.TypeString))
+	)
+	if id, ok := iic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iic.mutation.Status(); ok {
+		_spec.SetField(internshipinstructor.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := iic.mutation.CreatedAt(); ok {
+		_spec.SetField(internshipinstructor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := iic.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipinstructor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := iic.mutation.CreatedBy(); ok {
+		_spec.SetField(internshipinstructor.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := iic.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipinstructor.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := iic.mutation.InternshipID(); ok {
+		_spec.SetField(internshipinstructor.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := iic.mutation.UserID(); ok {
+		_spec.SetField(internshipinstructor.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := iic.mutation.Role(); ok {
+		_spec.SetField(internshipinstructor.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	return _node, _spec
+}
+
+// InternshipInstructorCreateBulk is the builder for creating many InternshipInstructor entities in bulk.
+type InternshipInstructorCreateBulk struct {
+	config
+	err      error
+	builders []*InternshipInstructorCreate
+}
+
+// Save creates the InternshipInstructor entities in the database.
+func (iicb *InternshipInstructorCreateBulk) Save(ctx context.Context) ([]*InternshipInstructor, error) {
+	if iicb.err != nil {
+		return nil, iicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iicb.builders))
+	nodes := make([]*InternshipInstructor, len(iicb.builders))
+	mutators := make([]Mutator, len(iicb.builders))
+	for i := range iicb.builders {
+		func(i int, root context.Context) {
+			builder := iicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InternshipInstructorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicb *InternshipInstructorCreateBulk) SaveX(ctx context.Context) []*InternshipInstructor {
+	v, err := iicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicb *InternshipInstructorCreateBulk) Exec(ctx context.Context) error {
+	_, err := iicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicb *InternshipInstructorCreateBulk) ExecX(ctx context.Context) {
+	if err := iicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipInstructorDelete is the builder for deleting a InternshipInstructor entity.
+type InternshipInstructorDelete struct {
+	config
+	hooks    []Hook
+	mutation *InternshipInstructorMutation
+}
+
+// Where appends a list predicates to the InternshipInstructorDelete builder.
+func (iid *InternshipInstructorDelete) Where(ps ...predicate.InternshipInstructor) *InternshipInstructorDelete {
+	iid.mutation.Where(ps...)
+	return iid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iid *InternshipInstructorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iid.sqlExec, iid.mutation, iid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iid *InternshipInstructorDelete) ExecX(ctx context.Context) int {
+	n, err := iid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iid *InternshipInstructorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(internshipinstructor.Table, sqlgraph.NewFieldSpec(internshipinstructor.FieldID, field.TypeString))
+	if ps := iid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iid.mutation.done = true
+	return affected, err
+}
+
+// InternshipInstructorDeleteOne is the builder for deleting a single InternshipInstructor entity.
+type InternshipInstructorDeleteOne struct {
+	iid *InternshipInstructorDelete
+}
+
+// Where appends a list predicates to the InternshipInstructorDelete builder.
+func (iido *InternshipInstructorDeleteOne) Where(ps ...predicate.InternshipInstructor) *InternshipInstructorDeleteOne {
+	iido.iid.mutation.Where(ps...)
+	return iido
+}
+
+// Exec executes the deletion query.
+func (iido *InternshipInstructorDeleteOne) Exec(ctx context.Context) error {
+	n, err := iido.iid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{internshipinstructor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iido *InternshipInstructorDeleteOne) ExecX(ctx context.Context) {
+	if err := iido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
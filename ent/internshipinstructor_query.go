@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipInstructorQuery is the builder for querying InternshipInstructor entities.
+type InternshipInstructorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []internshipinstructor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InternshipInstructor
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InternshipInstructorQuery builder.
+func (iiq *InternshipInstructorQuery) Where(ps ...predicate.InternshipInstructor) *InternshipInstructorQuery {
+	iiq.predicates = append(iiq.predicates, ps...)
+	return iiq
+}
+
+// Limit the number of records to be returned by this query.
+func (iiq *InternshipInstructorQuery) Limit(limit int) *InternshipInstructorQuery {
+	iiq.ctx.Limit = &limit
+	return iiq
+}
+
+// Offset to start from.
+func (iiq *InternshipInstructorQuery) Offset(offset int) *InternshipInstructorQuery {
+	iiq.ctx.Offset = &offset
+	return iiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iiq *InternshipInstructorQuery) Unique(unique bool) *InternshipInstructorQuery {
+	iiq.ctx.Unique = &unique
+	return iiq
+}
+
+// Order specifies how the records should be ordered.
+func (iiq *InternshipInstructorQuery) Order(o ...internshipinstructor.OrderOption) *InternshipInstructorQuery {
+	iiq.order = append(iiq.order, o...)
+	return iiq
+}
+
+// First returns the first InternshipInstructor entity from the query.
+// Returns a *NotFoundError when no InternshipInstructor was found.
+func (iiq *InternshipInstructorQuery) First(ctx context.Context) (*InternshipInstructor, error) {
+	nodes, err := iiq.Limit(1).All(setContextOp(ctx, iiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{internshipinstructor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) FirstX(ctx context.Context) *InternshipInstructor {
+	node, err := iiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InternshipInstructor ID from the query.
+// Returns a *NotFoundError when no InternshipInstructor ID was found.
+func (iiq *InternshipInstructorQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iiq.Limit(1).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{internshipinstructor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) FirstIDX(ctx context.Context) string {
+	id, err := iiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InternshipInstructor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InternshipInstructor entity is found.
+// Returns a *NotFoundError when no InternshipInstructor entities are found.
+func (iiq *InternshipInstructorQuery) Only(ctx context.Context) (*InternshipInstructor, error) {
+	nodes, err := iiq.Limit(2).All(setContextOp(ctx, iiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{internshipinstructor.Label}
+	default:
+		return nil, &NotSingularError{internshipinstructor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) OnlyX(ctx context.Context) *InternshipInstructor {
+	node, err := iiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InternshipInstructor ID in the query.
+// Returns a *NotSingularError when more than one InternshipInstructor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iiq *InternshipInstructorQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iiq.Limit(2).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{internshipinstructor.Label}
+	default:
+		err = &NotSingularError{internshipinstructor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) OnlyIDX(ctx context.Context) string {
+	id, err := iiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InternshipInstructors.
+func (iiq *InternshipInstructorQuery) All(ctx context.Context) ([]*InternshipInstructor, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryAll)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InternshipInstructor, *InternshipInstructorQuery]()
+	return withInterceptors[[]*InternshipInstructor](ctx, iiq, qr, iiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) AllX(ctx context.Context) []*InternshipInstructor {
+	nodes, err := iiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InternshipInstructor IDs.
+func (iiq *InternshipInstructorQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if iiq.ctx.Unique == nil && iiq.path != nil {
+		iiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryIDs)
+	if err = iiq.Select(internshipinstructor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) IDsX(ctx context.Context) []string {
+	ids, err := iiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iiq *InternshipInstructorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryCount)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iiq, querierCount[*InternshipInstructorQuery](), iiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) CountX(ctx context.Context) int {
+	count, err := iiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iiq *InternshipInstructorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryExist)
+	switch _, err := iiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iiq *InternshipInstructorQuery) ExistX(ctx context.Context) bool {
+	exist, err := iiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InternshipInstructorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iiq *InternshipInstructorQuery) Clone() *InternshipInstructorQuery {
+	if iiq == nil {
+		return nil
+	}
+	return &InternshipInstructorQuery{
+		config:     iiq.config,
+		ctx:        iiq.ctx.Clone(),
+		order:      append([]internshipinstructor.OrderOption{}, iiq.order...),
+		inters:     append([]Interceptor{}, iiq.inters...),
+		predicates: append([]predicate.InternshipInstructor{}, iiq.predicates...),
+		// clone intermediate query.
+		sql:  iiq.sql.Clone(),
+		path: iiq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InternshipInstructor.Query().
+//		GroupBy(internshipinstructor.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iiq *InternshipInstructorQuery) GroupBy(field string, fields ...string) *InternshipInstructorGroupBy {
+	iiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InternshipInstructorGroupBy{build: iiq}
+	grbuild.flds = &iiq.ctx.Fields
+	grbuild.label = internshipinstructor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.InternshipInstructor.Query().
+//		Select(internshipinstructor.FieldStatus).
+//		Scan(ctx, &v)
+func (iiq *InternshipInstructorQuery) Select(fields ...string) *InternshipInstructorSelect {
+	iiq.ctx.Fields = append(iiq.ctx.Fields, fields...)
+	sbuild := &InternshipInstructorSelect{InternshipInstructorQuery: iiq}
+	sbuild.label = internshipinstructor.Label
+	sbuild.flds, sbuild.scan = &iiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InternshipInstructorSelect configured with the given aggregations.
+func (iiq *InternshipInstructorQuery) Aggregate(fns ...AggregateFunc) *InternshipInstructorSelect {
+	return iiq.Select().Aggregate(fns...)
+}
+
+func (iiq *InternshipInstructorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iiq.ctx.Fields {
+		if !internshipinstructor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iiq.path != nil {
+		prev, err := iiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iiq.sql = prev
+	}
+	return nil
+}
+
+func (iiq *InternshipInstructorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InternshipInstructor, error) {
+	var (
+		nodes = []*InternshipInstructor{}
+		_spec = iiq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InternshipInstructor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InternshipInstructor{config: iiq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iiq *InternshipInstructorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iiq.querySpec()
+	_spec.Node.Columns = iiq.ctx.Fields
+	if len(iiq.ctx.Fields) > 0 {
+		_spec.Unique = iiq.ctx.Unique != nil && *iiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iiq.driver, _spec)
+}
+
+func (iiq *InternshipInstructorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(internshipinstructor.Table, internshipinstructor.Columns, sqlgraph.NewFieldSpec(internshipinstructor.FieldID, field.TypeString))
+	_spec.From = iiq.sql
+	if unique := iiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshipinstructor.FieldID)
+		for i := range fields {
+			if fields[i] != internshipinstructor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iiq *InternshipInstructorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iiq.driver.Dialect())
+	t1 := builder.Table(internshipinstructor.Table)
+	columns := iiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = internshipinstructor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iiq.sql != nil {
+		selector = iiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iiq.ctx.Unique != nil && *iiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range iiq.predicates {
+		p(selector)
+	}
+	for _, p := range iiq.order {
+		p(selector)
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InternshipInstructorGroupBy is the group-by builder for InternshipInstructor entities.
+type InternshipInstructorGroupBy struct {
+	selector
+	build *InternshipInstructorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iigb *InternshipInstructorGroupBy) Aggregate(fns ...AggregateFunc) *InternshipInstructorGroupBy {
+	iigb.fns = append(iigb.fns, fns...)
+	return iigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iigb *InternshipInstructorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iigb.build.ctx, ent.OpQueryGroupBy)
+	if err := iigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InternshipInstructorQuery, *InternshipInstructorGroupBy](ctx, iigb.build, iigb, iigb.build.inters, v)
+}
+
+func (iigb *InternshipInstructorGroupBy) sqlScan(ctx context.Context, root *InternshipInstructorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iigb.fns))
+	for _, fn := range iigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iigb.flds)+len(iigb.fns))
+		for _, f := range *iigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InternshipInstructorSelect is the builder for selecting fields of InternshipInstructor entities.
+type InternshipInstructorSelect struct {
+	*InternshipInstructorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iis *InternshipInstructorSelect) Aggregate(fns ...AggregateFunc) *InternshipInstructorSelect {
+	iis.fns = append(iis.fns, fns...)
+	return iis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iis *InternshipInstructorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iis.ctx, ent.OpQuerySelect)
+	if err := iis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InternshipInstructorQuery, *InternshipInstructorSelect](ctx, iis.InternshipInstructorQuery, iis, iis.inters, v)
+}
+
+func (iis *InternshipInstructorSelect) sqlScan(ctx context.Context, root *InternshipInstructorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iis.fns))
+	for _, fn := range iis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipInstructorUpdate is the builder for updating InternshipInstructor entities.
+type InternshipInstructorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InternshipInstructorMutation
+}
+
+// Where appends a list predicates to the InternshipInstructorUpdate builder.
+func (iiu *InternshipInstructorUpdate) Where(ps ...predicate.InternshipInstructor) *InternshipInstructorUpdate {
+	iiu.mutation.Where(ps...)
+	return iiu
+}
+
+// SetStatus sets the "status" field.
+func (iiu *InternshipInstructorUpdate) SetStatus(s string) *InternshipInstructorUpdate {
+	iiu.mutation.SetStatus(s)
+	return iiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiu *InternshipInstructorUpdate) SetNillableStatus(s *string) *InternshipInstructorUpdate {
+	if s != nil {
+		iiu.SetStatus(*s)
+	}
+	return iiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iiu *InternshipInstructorUpdate) SetUpdatedAt(t time.Time) *InternshipInstructorUpdate {
+	iiu.mutation.SetUpdatedAt(t)
+	return iiu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iiu *InternshipInstructorUpdate) SetUpdatedBy(s string) *InternshipInstructorUpdate {
+	iiu.mutation.SetUpdatedBy(s)
+	return iiu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iiu *InternshipInstructorUpdate) SetNillableUpdatedBy(s *string) *InternshipInstructorUpdate {
+	if s != nil {
+		iiu.SetUpdatedBy(*s)
+	}
+	return iiu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iiu *InternshipInstructorUpdate) ClearUpdatedBy() *InternshipInstructorUpdate {
+	iiu.mutation.ClearUpdatedBy()
+	return iiu
+}
+
+// SetRole sets the "role" field.
+func (iiu *InternshipInstructorUpdate) SetRole(s string) *InternshipInstructorUpdate {
+	iiu.mutation.SetRole(s)
+	return iiu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iiu *InternshipInstructorUpdate) SetNillableRole(s *string) *InternshipInstructorUpdate {
+	if s != nil {
+		iiu.SetRole(*s)
+	}
+	return iiu
+}
+
+// Mutation returns the InternshipInstructorMutation object of the builder.
+func (iiu *InternshipInstructorUpdate) Mutation() *InternshipInstructorMutation {
+	return iiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iiu *InternshipInstructorUpdate) Save(ctx context.Context) (int, error) {
+	iiu.defaults()
+	return withHooks(ctx, iiu.sqlSave, iiu.mutation, iiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiu *InternshipInstructorUpdate) SaveX(ctx context.Context) int {
+	affected, err := iiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iiu *InternshipInstructorUpdate) Exec(ctx context.Context) error {
+	_, err := iiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiu *InternshipInstructorUpdate) ExecX(ctx context.Context) {
+	if err := iiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiu *InternshipInstructorUpdate) defaults() {
+	if _, ok := iiu.mutation.UpdatedAt(); !ok {
+		v := internshipinstructor.UpdateDefaultUpdatedAt()
+		iiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiu *InternshipInstructorUpdate) check() error {
+	if v, ok := iiu.mutation.Role(); ok {
+		if err := internshipinstructor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "InternshipInstructor.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iiu *InternshipInstructorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(internshipinstructor.Table, internshipinstructor.Columns, sqlgraph.NewFieldSpec(internshipinstructor.FieldID, field.TypeString))
+	if ps := iiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiu.mutation.Status(); ok {
+		_spec.SetField(internshipinstructor.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipinstructor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iiu.mutation.CreatedByCleared() {
+		_spec.ClearField(internshipinstructor.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iiu.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipinstructor.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iiu.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshipinstructor.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iiu.mutation.Role(); ok {
+		_spec.SetField(internshipinstructor.FieldRole, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshipinstructor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iiu.mutation.done = true
+	return n, nil
+}
+
+// InternshipInstructorUpdateOne is the builder for updating a single InternshipInstructor entity.
+type InternshipInstructorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InternshipInstructorMutation
+}
+
+// SetStatus sets the "status" field.
+func (iiuo *InternshipInstructorUpdateOne) SetStatus(s string) *InternshipInstructorUpdateOne {
+	iiuo.mutation.SetStatus(s)
+	return iiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiuo *InternshipInstructorUpdateOne) SetNillableStatus(s *string) *InternshipInstructorUpdateOne {
+	if s != nil {
+		iiuo.SetStatus(*s)
+	}
+	return iiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iiuo *InternshipInstructorUpdateOne) SetUpdatedAt(t time.Time) *InternshipInstructorUpdateOne {
+	iiuo.mutation.SetUpdatedAt(t)
+	return iiuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iiuo *InternshipInstructorUpdateOne) SetUpdatedBy(s string) *InternshipInstructorUpdateOne {
+	iiuo.mutation.SetUpdatedBy(s)
+	return iiuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iiuo *InternshipInstructorUpdateOne) SetNillableUpdatedBy(s *string) *InternshipInstructorUpdateOne {
+	if s != nil {
+		iiuo.SetUpdatedBy(*s)
+	}
+	return iiuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iiuo *InternshipInstructorUpdateOne) ClearUpdatedBy() *InternshipInstructorUpdateOne {
+	iiuo.mutation.ClearUpdatedBy()
+	return iiuo
+}
+
+// SetRole sets the "role" field.
+func (iiuo *InternshipInstructorUpdateOne) SetRole(s string) *InternshipInstructorUpdateOne {
+	iiuo.mutation.SetRole(s)
+	return iiuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iiuo *InternshipInstructorUpdateOne) SetNillableRole(s *string) *InternshipInstructorUpdateOne {
+	if s != nil {
+		iiuo.SetRole(*s)
+	}
+	return iiuo
+}
+
+// Mutation returns the InternshipInstructorMutation object of the builder.
+func (iiuo *InternshipInstructorUpdateOne) Mutation() *InternshipInstructorMutation {
+	return iiuo.mutation
+}
+
+// Where appends a list predicates to the InternshipInstructorUpdate builder.
+func (iiuo *InternshipInstructorUpdateOne) Where(ps ...predicate.InternshipInstructor) *InternshipInstructorUpdateOne {
+	iiuo.mutation.Where(ps...)
+	return iiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iiuo *InternshipInstructorUpdateOne) Select(field string, fields ...string) *InternshipInstructorUpdateOne {
+	iiuo.fields = append([]string{field}, fields...)
+	return iiuo
+}
+
+// Save executes the query and returns the updated InternshipInstructor entity.
+func (iiuo *InternshipInstructorUpdateOne) Save(ctx context.Context) (*InternshipInstructor, error) {
+	iiuo.defaults()
+	return withHooks(ctx, iiuo.sqlSave, iiuo.mutation, iiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiuo *InternshipInstructorUpdateOne) SaveX(ctx context.Context) *InternshipInstructor {
+	node, err := iiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iiuo *InternshipInstructorUpdateOne) Exec(ctx context.Context) error {
+	_, err := iiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiuo *InternshipInstructorUpdateOne) ExecX(ctx context.Context) {
+	if err := iiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiuo *InternshipInstructorUpdateOne) defaults() {
+	if _, ok := iiuo.mutation.UpdatedAt(); !ok {
+		v := internshipinstructor.UpdateDefaultUpdatedAt()
+		iiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiuo *InternshipInstructorUpdateOne) check() error {
+	if v, ok := iiuo.mutation.Role(); ok {
+		if err := internshipinstructor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "InternshipInstructor.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iiuo *InternshipInstructorUpdateOne) sqlSave(ctx context.Context) (_node *InternshipInstructor, err error) {
+	if err := iiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(internshipinstructor.Table, internshipinstructor.Columns, sqlgraph.NewFieldSpec(internshipinstructor.FieldID, field.TypeString))
+	id, ok := iiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InternshipInstructor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshipinstructor.FieldID)
+		for _, f := range fields {
+			if !internshipinstructor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != internshipinstructor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiuo.mutation.Status(); ok {
+		_spec.SetField(internshipinstructor.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipinstructor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iiuo.mutation.CreatedByCleared() {
+		_spec.ClearField(internshipinstructor.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iiuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipinstructor.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iiuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshipinstructor.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iiuo.mutation.Role(); ok {
+		_spec.SetField(internshipinstructor.FieldRole, field.TypeString, value)
+	}
+	_node = &InternshipInstructor{config: iiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshipinstructor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipReview is the model entity for the InternshipReview schema.
+type InternshipReview struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID string `json:"enrollment_id,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating int `json:"rating,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// ReviewStatus holds the value of the "review_status" field.
+	ReviewStatus string `json:"review_status,omitempty"`
+	// Reply holds the value of the "reply" field.
+	Reply *string `json:"reply,omitempty"`
+	// RepliedBy holds the value of the "replied_by" field.
+	RepliedBy *string `json:"replied_by,omitempty"`
+	// RepliedAt holds the value of the "replied_at" field.
+	RepliedAt *time.Time `json:"replied_at,omitempty"`
+	// Reports holds the value of the "reports" field.
+	Reports []types.ReviewReport `json:"reports,omitempty"`
+	// ReportCount holds the value of the "report_count" field.
+	ReportCount int `json:"report_count,omitempty"`
+	// ModerationNote holds the value of the "moderation_note" field.
+	ModerationNote *string `json:"moderation_note,omitempty"`
+	// ModeratedBy holds the value of the "moderated_by" field.
+	ModeratedBy *string `json:"moderated_by,omitempty"`
+	// ModeratedAt holds the value of the "moderated_at" field.
+	ModeratedAt  *time.Time `json:"moderated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InternshipReview) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case internshipreview.FieldReports:
+			values[i] = new([]byte)
+		case internshipreview.FieldRating, internshipreview.FieldReportCount:
+			values[i] = new(sql.NullInt64)
+		case internshipreview.FieldID, internshipreview.FieldStatus, internshipreview.FieldCreatedBy, internshipreview.FieldUpdatedBy, internshipreview.FieldInternshipID, internshipreview.FieldUserID, internshipreview.FieldEnrollmentID, internshipreview.FieldTitle, internshipreview.FieldBody, internshipreview.FieldReviewStatus, internshipreview.FieldReply, internshipreview.FieldRepliedBy, internshipreview.FieldModerationNote, internshipreview.FieldModeratedBy:
+			values[i] = new(sql.NullString)
+		case internshipreview.FieldCreatedAt, internshipreview.FieldUpdatedAt, internshipreview.FieldRepliedAt, internshipreview.FieldModeratedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InternshipReview fields.
+func (ir *InternshipReview) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case internshipreview.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ir.ID = value.String
+			}
+		case internshipreview.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ir.Status = value.String
+			}
+		case internshipreview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ir.CreatedAt = value.Time
+			}
+		case internshipreview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ir.UpdatedAt = value.Time
+			}
+		case internshipreview.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ir.CreatedBy = value.String
+			}
+		case internshipreview.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ir.UpdatedBy = value.String
+			}
+		case internshipreview.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				ir.InternshipID = value.String
+			}
+		case internshipreview.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ir.UserID = value.String
+			}
+		case internshipreview.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				ir.EnrollmentID = value.String
+			}
+		case internshipreview.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				ir.Rating = int(value.Int64)
+			}
+		case internshipreview.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				ir.Title = new(string)
+				*ir.Title = value.String
+			}
+		case internshipreview.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				ir.Body = value.String
+			}
+		case internshipreview.FieldReviewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_status", values[i])
+			} else if value.Valid {
+				ir.ReviewStatus = value.String
+			}
+		case internshipreview.FieldReply:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reply", values[i])
+			} else if value.Valid {
+				ir.Reply = new(string)
+				*ir.Reply = value.String
+			}
+		case internshipreview.FieldRepliedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replied_by", values[i])
+			} else if value.Valid {
+				ir.RepliedBy = new(string)
+				*ir.RepliedBy = value.String
+			}
+		case internshipreview.FieldRepliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field replied_at", values[i])
+			} else if value.Valid {
+				ir.RepliedAt = new(time.Time)
+				*ir.RepliedAt = value.Time
+			}
+		case internshipreview.FieldReports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Reports); err != nil {
+					return fmt.Errorf("unmarshal field reports: %w", err)
+				}
+			}
+		case internshipreview.FieldReportCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field report_count", values[i])
+			} else if value.Valid {
+				ir.ReportCount = int(value.Int64)
+			}
+		case internshipreview.FieldModerationNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_note", values[i])
+			} else if value.Valid {
+				ir.ModerationNote = new(string)
+				*ir.ModerationNote = value.String
+			}
+		case internshipreview.FieldModeratedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderated_by", values[i])
+			} else if value.Valid {
+				ir.ModeratedBy = new(string)
+				*ir.ModeratedBy = value.String
+			}
+		case internshipreview.FieldModeratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field moderated_at", values[i])
+			} else if value.Valid {
+				ir.ModeratedAt = new(time.Time)
+				*ir.ModeratedAt = value.Time
+			}
+		default:
+			ir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InternshipReview.
+// This includes values selected through modifiers, order, etc.
+func (ir *InternshipReview) Value(name string) (ent.Value, error) {
+	return ir.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InternshipReview.
+// Note that you need to call InternshipReview.Unwrap() before calling this method if this InternshipReview
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *InternshipReview) Update() *InternshipReviewUpdateOne {
+	return NewInternshipReviewClient(ir.config).UpdateOne(ir)
+}
+
+// Unwrap unwraps the InternshipReview entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *InternshipReview) Unwrap() *InternshipReview {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InternshipReview is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *InternshipReview) String() string {
+	var builder strings.Builder
+	builder.WriteString("InternshipReview(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("status=")
+	builder.WriteString(ir.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ir.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ir.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ir.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ir.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(ir.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ir.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(ir.EnrollmentID)
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Rating))
+	builder.WriteString(", ")
+	if v := ir.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(ir.Body)
+	builder.WriteString(", ")
+	builder.WriteString("review_status=")
+	builder.WriteString(ir.ReviewStatus)
+	builder.WriteString(", ")
+	if v := ir.Reply; v != nil {
+		builder.WriteString("reply=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ir.RepliedBy; v != nil {
+		builder.WriteString("replied_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ir.RepliedAt; v != nil {
+		builder.WriteString("replied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reports=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Reports))
+	builder.WriteString(", ")
+	builder.WriteString("report_count=")
+	builder.WriteString(fmt.Sprintf("%v", ir.ReportCount))
+	builder.WriteString(", ")
+	if v := ir.ModerationNote; v != nil {
+		builder.WriteString("moderation_note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ir.ModeratedBy; v != nil {
+		builder.WriteString("moderated_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ir.ModeratedAt; v != nil {
+		builder.WriteString("moderated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InternshipReviews is a parsable slice of InternshipReview.
+type InternshipReviews []*InternshipReview
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internshipreview
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the internshipreview type in the database.
+	Label = "internship_review"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldInternshipID holds the string denoting the internship_id field in the database.
+	FieldInternshipID = "internship_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldEnrollmentID holds the string denoting the enrollment_id field in the database.
+	FieldEnrollmentID = "enrollment_id"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldReviewStatus holds the string denoting the review_status field in the database.
+	FieldReviewStatus = "review_status"
+	// FieldReply holds the string denoting the reply field in the database.
+	FieldReply = "reply"
+	// FieldRepliedBy holds the string denoting the replied_by field in the database.
+	FieldRepliedBy = "replied_by"
+	// FieldRepliedAt holds the string denoting the replied_at field in the database.
+	FieldRepliedAt = "replied_at"
+	// FieldReports holds the string denoting the reports field in the database.
+	FieldReports = "reports"
+	// FieldReportCount holds the string denoting the report_count field in the database.
+	FieldReportCount = "report_count"
+	// FieldModerationNote holds the string denoting the moderation_note field in the database.
+	FieldModerationNote = "moderation_note"
+	// FieldModeratedBy holds the string denoting the moderated_by field in the database.
+	FieldModeratedBy = "moderated_by"
+	// FieldModeratedAt holds the string denoting the moderated_at field in the database.
+	FieldModeratedAt = "moderated_at"
+	// Table holds the table name of the internshipreview in the database.
+	Table = "internship_reviews"
+)
+
+// Columns holds all SQL columns for internshipreview fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldInternshipID,
+	FieldUserID,
+	FieldEnrollmentID,
+	FieldRating,
+	FieldTitle,
+	FieldBody,
+	FieldReviewStatus,
+	FieldReply,
+	FieldRepliedBy,
+	FieldRepliedAt,
+	FieldReports,
+	FieldReportCount,
+	FieldModerationNote,
+	FieldModeratedBy,
+	FieldModeratedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	InternshipIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	EnrollmentIDValidator func(string) error
+	// RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	RatingValidator func(int) error
+	// DefaultReviewStatus holds the default value on creation for the "review_status" field.
+	DefaultReviewStatus string
+	// DefaultReports holds the default value on creation for the "reports" field.
+	DefaultReports []types.ReviewReport
+	// DefaultReportCount holds the default value on creation for the "report_count" field.
+	DefaultReportCount int
+	// ReportCountValidator is a validator for the "report_count" field. It is called by the builders before save.
+	ReportCountValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the InternshipReview queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByInternshipID orders the results by the internship_id field.
+func ByInternshipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByEnrollmentID orders the results by the enrollment_id field.
+func ByEnrollmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrollmentID, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByReviewStatus orders the results by the review_status field.
+func ByReviewStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewStatus, opts...).ToFunc()
+}
+
+// ByReply orders the results by the reply field.
+func ByReply(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReply, opts...).ToFunc()
+}
+
+// ByRepliedBy orders the results by the replied_by field.
+func ByRepliedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepliedBy, opts...).ToFunc()
+}
+
+// ByRepliedAt orders the results by the replied_at field.
+func ByRepliedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepliedAt, opts...).ToFunc()
+}
+
+// ByReportCount orders the results by the report_count field.
+func ByReportCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportCount, opts...).ToFunc()
+}
+
+// ByModerationNote orders the results by the moderation_note field.
+func ByModerationNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationNote, opts...).ToFunc()
+}
+
+// ByModeratedBy orders the results by the moderated_by field.
+func ByModeratedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModeratedBy, opts...).ToFunc()
+}
+
+// ByModeratedAt orders the results by the moderated_at field.
+func ByModeratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModeratedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internshipreview
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldUserID, v))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldRating, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldTitle, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldBody, v))
+}
+
+// ReviewStatus applies equality check predicate on the "review_status" field. It's identical to ReviewStatusEQ.
+func ReviewStatus(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldReviewStatus, v))
+}
+
+// Reply applies equality check predicate on the "reply" field. It's identical to ReplyEQ.
+func Reply(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldReply, v))
+}
+
+// RepliedBy applies equality check predicate on the "replied_by" field. It's identical to RepliedByEQ.
+func RepliedBy(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldRepliedBy, v))
+}
+
+// RepliedAt applies equality check predicate on the "replied_at" field. It's identical to RepliedAtEQ.
+func RepliedAt(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldRepliedAt, v))
+}
+
+// ReportCount applies equality check predicate on the "report_count" field. It's identical to ReportCountEQ.
+func ReportCount(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldReportCount, v))
+}
+
+// ModerationNote applies equality check predicate on the "moderation_note" field. It's identical to ModerationNoteEQ.
+func ModerationNote(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldModerationNote, v))
+}
+
+// ModeratedBy applies equality check predicate on the "moderated_by" field. It's identical to ModeratedByEQ.
+func ModeratedBy(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldModeratedBy, v))
+}
+
+// ModeratedAt applies equality check predicate on the "moderated_at" field. It's identical to ModeratedAtEQ.
+func ModeratedAt(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldModeratedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDGT applies the GT predicate on the "enrollment_id" field.
+func EnrollmentIDGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDGTE applies the GTE predicate on the "enrollment_id" field.
+func EnrollmentIDGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLT applies the LT predicate on the "enrollment_id" field.
+func EnrollmentIDLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLTE applies the LTE predicate on the "enrollment_id" field.
+func EnrollmentIDLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContains applies the Contains predicate on the "enrollment_id" field.
+func EnrollmentIDContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasPrefix applies the HasPrefix predicate on the "enrollment_id" field.
+func EnrollmentIDHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasSuffix applies the HasSuffix predicate on the "enrollment_id" field.
+func EnrollmentIDHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDEqualFold applies the EqualFold predicate on the "enrollment_id" field.
+func EnrollmentIDEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContainsFold applies the ContainsFold predicate on the "enrollment_id" field.
+func EnrollmentIDContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldEnrollmentID, v))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldRating, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldBody))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldBody, v))
+}
+
+// ReviewStatusEQ applies the EQ predicate on the "review_status" field.
+func ReviewStatusEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldReviewStatus, v))
+}
+
+// ReviewStatusNEQ applies the NEQ predicate on the "review_status" field.
+func ReviewStatusNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldReviewStatus, v))
+}
+
+// ReviewStatusIn applies the In predicate on the "review_status" field.
+func ReviewStatusIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldReviewStatus, vs...))
+}
+
+// ReviewStatusNotIn applies the NotIn predicate on the "review_status" field.
+func ReviewStatusNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldReviewStatus, vs...))
+}
+
+// ReviewStatusGT applies the GT predicate on the "review_status" field.
+func ReviewStatusGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldReviewStatus, v))
+}
+
+// ReviewStatusGTE applies the GTE predicate on the "review_status" field.
+func ReviewStatusGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldReviewStatus, v))
+}
+
+// ReviewStatusLT applies the LT predicate on the "review_status" field.
+func ReviewStatusLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldReviewStatus, v))
+}
+
+// ReviewStatusLTE applies the LTE predicate on the "review_status" field.
+func ReviewStatusLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldReviewStatus, v))
+}
+
+// ReviewStatusContains applies the Contains predicate on the "review_status" field.
+func ReviewStatusContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldReviewStatus, v))
+}
+
+// ReviewStatusHasPrefix applies the HasPrefix predicate on the "review_status" field.
+func ReviewStatusHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldReviewStatus, v))
+}
+
+// ReviewStatusHasSuffix applies the HasSuffix predicate on the "review_status" field.
+func ReviewStatusHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldReviewStatus, v))
+}
+
+// ReviewStatusEqualFold applies the EqualFold predicate on the "review_status" field.
+func ReviewStatusEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldReviewStatus, v))
+}
+
+// ReviewStatusContainsFold applies the ContainsFold predicate on the "review_status" field.
+func ReviewStatusContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldReviewStatus, v))
+}
+
+// ReplyEQ applies the EQ predicate on the "reply" field.
+func ReplyEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldReply, v))
+}
+
+// ReplyNEQ applies the NEQ predicate on the "reply" field.
+func ReplyNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldReply, v))
+}
+
+// ReplyIn applies the In predicate on the "reply" field.
+func ReplyIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldReply, vs...))
+}
+
+// ReplyNotIn applies the NotIn predicate on the "reply" field.
+func ReplyNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldReply, vs...))
+}
+
+// ReplyGT applies the GT predicate on the "reply" field.
+func ReplyGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldReply, v))
+}
+
+// ReplyGTE applies the GTE predicate on the "reply" field.
+func ReplyGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldReply, v))
+}
+
+// ReplyLT applies the LT predicate on the "reply" field.
+func ReplyLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldReply, v))
+}
+
+// ReplyLTE applies the LTE predicate on the "reply" field.
+func ReplyLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldReply, v))
+}
+
+// ReplyContains applies the Contains predicate on the "reply" field.
+func ReplyContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldReply, v))
+}
+
+// ReplyHasPrefix applies the HasPrefix predicate on the "reply" field.
+func ReplyHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldReply, v))
+}
+
+// ReplyHasSuffix applies the HasSuffix predicate on the "reply" field.
+func ReplyHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldReply, v))
+}
+
+// ReplyIsNil applies the IsNil predicate on the "reply" field.
+func ReplyIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldReply))
+}
+
+// ReplyNotNil applies the NotNil predicate on the "reply" field.
+func ReplyNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldReply))
+}
+
+// ReplyEqualFold applies the EqualFold predicate on the "reply" field.
+func ReplyEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldReply, v))
+}
+
+// ReplyContainsFold applies the ContainsFold predicate on the "reply" field.
+func ReplyContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldReply, v))
+}
+
+// RepliedByEQ applies the EQ predicate on the "replied_by" field.
+func RepliedByEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldRepliedBy, v))
+}
+
+// RepliedByNEQ applies the NEQ predicate on the "replied_by" field.
+func RepliedByNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldRepliedBy, v))
+}
+
+// RepliedByIn applies the In predicate on the "replied_by" field.
+func RepliedByIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldRepliedBy, vs...))
+}
+
+// RepliedByNotIn applies the NotIn predicate on the "replied_by" field.
+func RepliedByNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldRepliedBy, vs...))
+}
+
+// RepliedByGT applies the GT predicate on the "replied_by" field.
+func RepliedByGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldRepliedBy, v))
+}
+
+// RepliedByGTE applies the GTE predicate on the "replied_by" field.
+func RepliedByGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldRepliedBy, v))
+}
+
+// RepliedByLT applies the LT predicate on the "replied_by" field.
+func RepliedByLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldRepliedBy, v))
+}
+
+// RepliedByLTE applies the LTE predicate on the "replied_by" field.
+func RepliedByLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldRepliedBy, v))
+}
+
+// RepliedByContains applies the Contains predicate on the "replied_by" field.
+func RepliedByContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldRepliedBy, v))
+}
+
+// RepliedByHasPrefix applies the HasPrefix predicate on the "replied_by" field.
+func RepliedByHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldRepliedBy, v))
+}
+
+// RepliedByHasSuffix applies the HasSuffix predicate on the "replied_by" field.
+func RepliedByHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldRepliedBy, v))
+}
+
+// RepliedByIsNil applies the IsNil predicate on the "replied_by" field.
+func RepliedByIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldRepliedBy))
+}
+
+// RepliedByNotNil applies the NotNil predicate on the "replied_by" field.
+func RepliedByNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldRepliedBy))
+}
+
+// RepliedByEqualFold applies the EqualFold predicate on the "replied_by" field.
+func RepliedByEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldRepliedBy, v))
+}
+
+// RepliedByContainsFold applies the ContainsFold predicate on the "replied_by" field.
+func RepliedByContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldRepliedBy, v))
+}
+
+// RepliedAtEQ applies the EQ predicate on the "replied_at" field.
+func RepliedAtEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldRepliedAt, v))
+}
+
+// RepliedAtNEQ applies the NEQ predicate on the "replied_at" field.
+func RepliedAtNEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldRepliedAt, v))
+}
+
+// RepliedAtIn applies the In predicate on the "replied_at" field.
+func RepliedAtIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldRepliedAt, vs...))
+}
+
+// RepliedAtNotIn applies the NotIn predicate on the "replied_at" field.
+func RepliedAtNotIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldRepliedAt, vs...))
+}
+
+// RepliedAtGT applies the GT predicate on the "replied_at" field.
+func RepliedAtGT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldRepliedAt, v))
+}
+
+// RepliedAtGTE applies the GTE predicate on the "replied_at" field.
+func RepliedAtGTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldRepliedAt, v))
+}
+
+// RepliedAtLT applies the LT predicate on the "replied_at" field.
+func RepliedAtLT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldRepliedAt, v))
+}
+
+// RepliedAtLTE applies the LTE predicate on the "replied_at" field.
+func RepliedAtLTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldRepliedAt, v))
+}
+
+// RepliedAtIsNil applies the IsNil predicate on the "replied_at" field.
+func RepliedAtIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldRepliedAt))
+}
+
+// RepliedAtNotNil applies the NotNil predicate on the "replied_at" field.
+func RepliedAtNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldRepliedAt))
+}
+
+// ReportCountEQ applies the EQ predicate on the "report_count" field.
+func ReportCountEQ(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldReportCount, v))
+}
+
+// ReportCountNEQ applies the NEQ predicate on the "report_count" field.
+func ReportCountNEQ(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldReportCount, v))
+}
+
+// ReportCountIn applies the In predicate on the "report_count" field.
+func ReportCountIn(vs ...int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldReportCount, vs...))
+}
+
+// ReportCountNotIn applies the NotIn predicate on the "report_count" field.
+func ReportCountNotIn(vs ...int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldReportCount, vs...))
+}
+
+// ReportCountGT applies the GT predicate on the "report_count" field.
+func ReportCountGT(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldReportCount, v))
+}
+
+// ReportCountGTE applies the GTE predicate on the "report_count" field.
+func ReportCountGTE(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldReportCount, v))
+}
+
+// ReportCountLT applies the LT predicate on the "report_count" field.
+func ReportCountLT(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldReportCount, v))
+}
+
+// ReportCountLTE applies the LTE predicate on the "report_count" field.
+func ReportCountLTE(v int) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldReportCount, v))
+}
+
+// ModerationNoteEQ applies the EQ predicate on the "moderation_note" field.
+func ModerationNoteEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldModerationNote, v))
+}
+
+// ModerationNoteNEQ applies the NEQ predicate on the "moderation_note" field.
+func ModerationNoteNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldModerationNote, v))
+}
+
+// ModerationNoteIn applies the In predicate on the "moderation_note" field.
+func ModerationNoteIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldModerationNote, vs...))
+}
+
+// ModerationNoteNotIn applies the NotIn predicate on the "moderation_note" field.
+func ModerationNoteNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldModerationNote, vs...))
+}
+
+// ModerationNoteGT applies the GT predicate on the "moderation_note" field.
+func ModerationNoteGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldModerationNote, v))
+}
+
+// ModerationNoteGTE applies the GTE predicate on the "moderation_note" field.
+func ModerationNoteGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldModerationNote, v))
+}
+
+// ModerationNoteLT applies the LT predicate on the "moderation_note" field.
+func ModerationNoteLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldModerationNote, v))
+}
+
+// ModerationNoteLTE applies the LTE predicate on the "moderation_note" field.
+func ModerationNoteLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldModerationNote, v))
+}
+
+// ModerationNoteContains applies the Contains predicate on the "moderation_note" field.
+func ModerationNoteContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldModerationNote, v))
+}
+
+// ModerationNoteHasPrefix applies the HasPrefix predicate on the "moderation_note" field.
+func ModerationNoteHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldModerationNote, v))
+}
+
+// ModerationNoteHasSuffix applies the HasSuffix predicate on the "moderation_note" field.
+func ModerationNoteHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldModerationNote, v))
+}
+
+// ModerationNoteIsNil applies the IsNil predicate on the "moderation_note" field.
+func ModerationNoteIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldModerationNote))
+}
+
+// ModerationNoteNotNil applies the NotNil predicate on the "moderation_note" field.
+func ModerationNoteNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldModerationNote))
+}
+
+// ModerationNoteEqualFold applies the EqualFold predicate on the "moderation_note" field.
+func ModerationNoteEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldModerationNote, v))
+}
+
+// ModerationNoteContainsFold applies the ContainsFold predicate on the "moderation_note" field.
+func ModerationNoteContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldModerationNote, v))
+}
+
+// ModeratedByEQ applies the EQ predicate on the "moderated_by" field.
+func ModeratedByEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldModeratedBy, v))
+}
+
+// ModeratedByNEQ applies the NEQ predicate on the "moderated_by" field.
+func ModeratedByNEQ(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldModeratedBy, v))
+}
+
+// ModeratedByIn applies the In predicate on the "moderated_by" field.
+func ModeratedByIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldModeratedBy, vs...))
+}
+
+// ModeratedByNotIn applies the NotIn predicate on the "moderated_by" field.
+func ModeratedByNotIn(vs ...string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldModeratedBy, vs...))
+}
+
+// ModeratedByGT applies the GT predicate on the "moderated_by" field.
+func ModeratedByGT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldModeratedBy, v))
+}
+
+// ModeratedByGTE applies the GTE predicate on the "moderated_by" field.
+func ModeratedByGTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldModeratedBy, v))
+}
+
+// ModeratedByLT applies the LT predicate on the "moderated_by" field.
+func ModeratedByLT(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldModeratedBy, v))
+}
+
+// ModeratedByLTE applies the LTE predicate on the "moderated_by" field.
+func ModeratedByLTE(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldModeratedBy, v))
+}
+
+// ModeratedByContains applies the Contains predicate on the "moderated_by" field.
+func ModeratedByContains(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContains(FieldModeratedBy, v))
+}
+
+// ModeratedByHasPrefix applies the HasPrefix predicate on the "moderated_by" field.
+func ModeratedByHasPrefix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasPrefix(FieldModeratedBy, v))
+}
+
+// ModeratedByHasSuffix applies the HasSuffix predicate on the "moderated_by" field.
+func ModeratedByHasSuffix(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldHasSuffix(FieldModeratedBy, v))
+}
+
+// ModeratedByIsNil applies the IsNil predicate on the "moderated_by" field.
+func ModeratedByIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldModeratedBy))
+}
+
+// ModeratedByNotNil applies the NotNil predicate on the "moderated_by" field.
+func ModeratedByNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldModeratedBy))
+}
+
+// ModeratedByEqualFold applies the EqualFold predicate on the "moderated_by" field.
+func ModeratedByEqualFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEqualFold(FieldModeratedBy, v))
+}
+
+// ModeratedByContainsFold applies the ContainsFold predicate on the "moderated_by" field.
+func ModeratedByContainsFold(v string) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldContainsFold(FieldModeratedBy, v))
+}
+
+// ModeratedAtEQ applies the EQ predicate on the "moderated_at" field.
+func ModeratedAtEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldEQ(FieldModeratedAt, v))
+}
+
+// ModeratedAtNEQ applies the NEQ predicate on the "moderated_at" field.
+func ModeratedAtNEQ(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNEQ(FieldModeratedAt, v))
+}
+
+// ModeratedAtIn applies the In predicate on the "moderated_at" field.
+func ModeratedAtIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIn(FieldModeratedAt, vs...))
+}
+
+// ModeratedAtNotIn applies the NotIn predicate on the "moderated_at" field.
+func ModeratedAtNotIn(vs ...time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotIn(FieldModeratedAt, vs...))
+}
+
+// ModeratedAtGT applies the GT predicate on the "moderated_at" field.
+func ModeratedAtGT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGT(FieldModeratedAt, v))
+}
+
+// ModeratedAtGTE applies the GTE predicate on the "moderated_at" field.
+func ModeratedAtGTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldGTE(FieldModeratedAt, v))
+}
+
+// ModeratedAtLT applies the LT predicate on the "moderated_at" field.
+func ModeratedAtLT(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLT(FieldModeratedAt, v))
+}
+
+// ModeratedAtLTE applies the LTE predicate on the "moderated_at" field.
+func ModeratedAtLTE(v time.Time) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldLTE(FieldModeratedAt, v))
+}
+
+// ModeratedAtIsNil applies the IsNil predicate on the "moderated_at" field.
+func ModeratedAtIsNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldIsNull(FieldModeratedAt))
+}
+
+// ModeratedAtNotNil applies the NotNil predicate on the "moderated_at" field.
+func ModeratedAtNotNil() predicate.InternshipReview {
+	return predicate.InternshipReview(sql.FieldNotNull(FieldModeratedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InternshipReview) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InternshipReview) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InternshipReview) predicate.InternshipReview {
+	return predicate.InternshipReview(sql.NotPredicates(p))
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipReviewCreate is the builder for creating a InternshipReview entity.
+type InternshipReviewCreate struct {
+	config
+	mutation *InternshipReviewMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (irc *InternshipReviewCreate) SetStatus(s string) *InternshipReviewCreate {
+	irc.mutation.SetStatus(s)
+	return irc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableStatus(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetStatus(*s)
+	}
+	return irc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irc *InternshipReviewCreate) SetCreatedAt(t time.Time) *InternshipReviewCreate {
+	irc.mutation.SetCreatedAt(t)
+	return irc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableCreatedAt(t *time.Time) *InternshipReviewCreate {
+	if t != nil {
+		irc.SetCreatedAt(*t)
+	}
+	return irc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (irc *InternshipReviewCreate) SetUpdatedAt(t time.Time) *InternshipReviewCreate {
+	irc.mutation.SetUpdatedAt(t)
+	return irc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableUpdatedAt(t *time.Time) *InternshipReviewCreate {
+	if t != nil {
+		irc.SetUpdatedAt(*t)
+	}
+	return irc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (irc *InternshipReviewCreate) SetCreatedBy(s string) *InternshipReviewCreate {
+	irc.mutation.SetCreatedBy(s)
+	return irc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableCreatedBy(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetCreatedBy(*s)
+	}
+	return irc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (irc *InternshipReviewCreate) SetUpdatedBy(s string) *InternshipReviewCreate {
+	irc.mutation.SetUpdatedBy(s)
+	return irc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableUpdatedBy(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetUpdatedBy(*s)
+	}
+	return irc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (irc *InternshipReviewCreate) SetInternshipID(s string) *InternshipReviewCreate {
+	irc.mutation.SetInternshipID(s)
+	return irc
+}
+
+// SetUserID sets the "user_id" field.
+func (irc *InternshipReviewCreate) SetUserID(s string) *InternshipReviewCreate {
+	irc.mutation.SetUserID(s)
+	return irc
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (irc *InternshipReviewCreate) SetEnrollmentID(s string) *InternshipReviewCreate {
+	irc.mutation.SetEnrollmentID(s)
+	return irc
+}
+
+// SetRating sets the "rating" field.
+func (irc *InternshipReviewCreate) SetRating(i int) *InternshipReviewCreate {
+	irc.mutation.SetRating(i)
+	return irc
+}
+
+// SetTitle sets the "title" field.
+func (irc *InternshipReviewCreate) SetTitle(s string) *InternshipReviewCreate {
+	irc.mutation.SetTitle(s)
+	return irc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableTitle(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetTitle(*s)
+	}
+	return irc
+}
+
+// SetBody sets the "body" field.
+func (irc *InternshipReviewCreate) SetBody(s string) *InternshipReviewCreate {
+	irc.mutation.SetBody(s)
+	return irc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableBody(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetBody(*s)
+	}
+	return irc
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (irc *InternshipReviewCreate) SetReviewStatus(s string) *InternshipReviewCreate {
+	irc.mutation.SetReviewStatus(s)
+	return irc
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableReviewStatus(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetReviewStatus(*s)
+	}
+	return irc
+}
+
+// SetReply sets the "reply" field.
+func (irc *InternshipReviewCreate) SetReply(s string) *InternshipReviewCreate {
+	irc.mutation.SetReply(s)
+	return irc
+}
+
+// SetNillableReply sets the "reply" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableReply(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetReply(*s)
+	}
+	return irc
+}
+
+// SetRepliedBy sets the "replied_by" field.
+func (irc *InternshipReviewCreate) SetRepliedBy(s string) *InternshipReviewCreate {
+	irc.mutation.SetRepliedBy(s)
+	return irc
+}
+
+// SetNillableRepliedBy sets the "replied_by" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableRepliedBy(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetRepliedBy(*s)
+	}
+	return irc
+}
+
+// SetRepliedAt sets the "replied_at" field.
+func (irc *InternshipReviewCreate) SetRepliedAt(t time.Time) *InternshipReviewCreate {
+	irc.mutation.SetRepliedAt(t)
+	return irc
+}
+
+// SetNillableRepliedAt sets the "replied_at" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableRepliedAt(t *time.Time) *InternshipReviewCreate {
+	if t != nil {
+		irc.SetRepliedAt(*t)
+	}
+	return irc
+}
+
+// SetReports sets the "reports" field.
+func (irc *InternshipReviewCreate) SetReports(tr []types.ReviewReport) *InternshipReviewCreate {
+	irc.mutation.SetReports(tr)
+	return irc
+}
+
+// SetReportCount sets the "report_count" field.
+func (irc *InternshipReviewCreate) SetReportCount(i int) *InternshipReviewCreate {
+	irc.mutation.SetReportCount(i)
+	return irc
+}
+
+// SetNillableReportCount sets the "report_count" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableReportCount(i *int) *InternshipReviewCreate {
+	if i != nil {
+		irc.SetReportCount(*i)
+	}
+	return irc
+}
+
+// SetModerationNote sets the "moderation_note" field.
+func (irc *InternshipReviewCreate) SetModerationNote(s string) *InternshipReviewCreate {
+	irc.mutation.SetModerationNote(s)
+	return irc
+}
+
+// SetNillableModerationNote sets the "moderation_note" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableModerationNote(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetModerationNote(*s)
+	}
+	return irc
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (irc *InternshipReviewCreate) SetModeratedBy(s string) *InternshipReviewCreate {
+	irc.mutation.SetModeratedBy(s)
+	return irc
+}
+
+// SetNillableModeratedBy sets the "moderated_by" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableModeratedBy(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetModeratedBy(*s)
+	}
+	return irc
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (irc *InternshipReviewCreate) SetModeratedAt(t time.Time) *InternshipReviewCreate {
+	irc.mutation.SetModeratedAt(t)
+	return irc
+}
+
+// SetNillableModeratedAt sets the "moderated_at" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableModeratedAt(t *time.Time) *InternshipReviewCreate {
+	if t != nil {
+		irc.SetModeratedAt(*t)
+	}
+	return irc
+}
+
+// SetID sets the "id" field.
+func (irc *InternshipReviewCreate) SetID(s string) *InternshipReviewCreate {
+	irc.mutation.SetID(s)
+	return irc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (irc *InternshipReviewCreate) SetNillableID(s *string) *InternshipReviewCreate {
+	if s != nil {
+		irc.SetID(*s)
+	}
+	return irc
+}
+
+// Mutation returns the InternshipReviewMutation object of the builder.
+func (irc *InternshipReviewCreate) Mutation() *InternshipReviewMutation {
+	return irc.mutation
+}
+
+// Save creates the InternshipReview in the database.
+func (irc *InternshipReviewCreate) Save(ctx context.Context) (*InternshipReview, error) {
+	irc.defaults()
+	return withHooks(ctx, irc.sqlSave, irc.mutation, irc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *InternshipReviewCreate) SaveX(ctx context.Context) *InternshipReview {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *InternshipReviewCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *InternshipReviewCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *InternshipReviewCreate) defaults() {
+	if _, ok := irc.mutation.Status(); !ok {
+		v := internshipreview.DefaultStatus
+		irc.mutation.SetStatus(v)
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		v := internshipreview.DefaultCreatedAt()
+		irc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		v := internshipreview.DefaultUpdatedAt()
+		irc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := irc.mutation.ReviewStatus(); !ok {
+		v := internshipreview.DefaultReviewStatus
+		irc.mutation.SetReviewStatus(v)
+	}
+	if _, ok := irc.mutation.Reports(); !ok {
+		v := internshipreview.DefaultReports
+		irc.mutation.SetReports(v)
+	}
+	if _, ok := irc.mutation.ReportCount(); !ok {
+		v := internshipreview.DefaultReportCount
+		irc.mutation.SetReportCount(v)
+	}
+	if _, ok := irc.mutation.ID(); !ok {
+		v := internshipreview.DefaultID()
+		irc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *InternshipReviewCreate) check() error {
+	if _, ok := irc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InternshipReview.status"`)}
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InternshipReview.created_at"`)}
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InternshipReview.updated_at"`)}
+	}
+	if _, ok := irc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "InternshipReview.internship_id"`)}
+	}
+	if v, ok := irc.mutation.InternshipID(); ok {
+		if err := internshipreview.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InternshipReview.user_id"`)}
+	}
+	if v, ok := irc.mutation.UserID(); ok {
+		if err := internshipreview.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.user_id": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "InternshipReview.enrollment_id"`)}
+	}
+	if v, ok := irc.mutation.EnrollmentID(); ok {
+		if err := internshipreview.EnrollmentIDValidator(v); err != nil {
+			return &ValidationError{Name: "enrollment_id", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.enrollment_id": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "InternshipReview.rating"`)}
+	}
+	if v, ok := irc.mutation.Rating(); ok {
+		if err := internshipreview.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.rating": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.ReviewStatus(); !ok {
+		return &ValidationError{Name: "review_status", err: errors.New(`ent: missing required field "InternshipReview.review_status"`)}
+	}
+	if _, ok := irc.mutation.Reports(); !ok {
+		return &ValidationError{Name: "reports", err: errors.New(`ent: missing required field "InternshipReview.reports"`)}
+	}
+	if _, ok := irc.mutation.ReportCount(); !ok {
+		return &ValidationError{Name: "report_count", err: errors.New(`ent: missing required field "InternshipReview.report_count"`)}
+	}
+	if v, ok := irc.mutation.ReportCount(); ok {
+		if err := internshipreview.ReportCountValidator(v); err != nil {
+			return &ValidationError{Name: "report_count", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.report_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (irc *InternshipReviewCreate) sqlSave(ctx context.Context) (*InternshipReview, error) {
+	if err := irc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InternshipReview.ID type: %T", _spec.ID.Value)
+		}
+	}
+	irc.mutation.id = &_node.ID
+	irc.mutation.done = true
+	return _node, nil
+}
+
+func (irc *InternshipReviewCreate) createSpec() (*InternshipReview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InternshipReview{config: irc.config}
+		_spec = sqlgraph.NewCreateSpec(internshipreview.Table, sqlgraph.NewFieldSpec(internshipreview.FieldID, field.TypeString))
+	)
+	if id, ok := irc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := irc.mutation.Status(); ok {
+		_spec.SetField(internshipreview.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := irc.mutation.CreatedAt(); ok {
+		_spec.SetField(internshipreview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := irc.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipreview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := irc.mutation.CreatedBy(); ok {
+		_spec.SetField(internshipreview.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := irc.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipreview.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := irc.mutation.InternshipID(); ok {
+		_spec.SetField(internshipreview.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := irc.mutation.UserID(); ok {
+		_spec.SetField(internshipreview.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := irc.mutation.EnrollmentID(); ok {
+		_spec.SetField(internshipreview.FieldEnrollmentID, field.TypeString, value)
+		_node.EnrollmentID = value
+	}
+	if value, ok := irc.mutation.Rating(); ok {
+		_spec.SetField(internshipreview.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := irc.mutation.Title(); ok {
+		_spec.SetField(internshipreview.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := irc.mutation.Body(); ok {
+		_spec.SetField(internshipreview.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := irc.mutation.ReviewStatus(); ok {
+		_spec.SetField(internshipreview.FieldReviewStatus, field.TypeString, value)
+		_node.ReviewStatus = value
+	}
+	if value, ok := irc.mutation.Reply(); ok {
+		_spec.SetField(internshipreview.FieldReply, field.TypeString, value)
+		_node.Reply = &value
+	}
+	if value, ok := irc.mutation.RepliedBy(); ok {
+		_spec.SetField(internshipreview.FieldRepliedBy, field.TypeString, value)
+		_node.RepliedBy = &value
+	}
+	if value, ok := irc.mutation.RepliedAt(); ok {
+		_spec.SetField(internshipreview.FieldRepliedAt, field.TypeTime, value)
+		_node.RepliedAt = &value
+	}
+	if value, ok := irc.mutation.Reports(); ok {
+		_spec.SetField(internshipreview.FieldReports, field.TypeJSON, value)
+		_node.Reports = value
+	}
+	if value, ok := irc.mutation.ReportCount(); ok {
+		_spec.SetField(internshipreview.FieldReportCount, field.TypeInt, value)
+		_node.ReportCount = value
+	}
+	if value, ok := irc.mutation.ModerationNote(); ok {
+		_spec.SetField(internshipreview.FieldModerationNote, field.TypeString, value)
+		_node.ModerationNote = &value
+	}
+	if value, ok := irc.mutation.ModeratedBy(); ok {
+		_spec.SetField(internshipreview.FieldModeratedBy, field.TypeString, value)
+		_node.ModeratedBy = &value
+	}
+	if value, ok := irc.mutation.ModeratedAt(); ok {
+		_spec.SetField(internshipreview.FieldModeratedAt, field.TypeTime, value)
+		_node.ModeratedAt = &value
+	}
+	return _node, _spec
+}
+
+// InternshipReviewCreateBulk is the builder for creating many InternshipReview entities in bulk.
+type InternshipReviewCreateBulk struct {
+	config
+	err      error
+	builders []*InternshipReviewCreate
+}
+
+// Save creates the InternshipReview entities in the database.
+func (ircb *InternshipReviewCreateBulk) Save(ctx context.Context) ([]*InternshipReview, error) {
+	if ircb.err != nil {
+		return nil, ircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*InternshipReview, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InternshipReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *InternshipReviewCreateBulk) SaveX(ctx context.Context) []*InternshipReview {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *InternshipReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *InternshipReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
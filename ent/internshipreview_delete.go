@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipReviewDelete is the builder for deleting a InternshipReview entity.
+type InternshipReviewDelete struct {
+	config
+	hooks    []Hook
+	mutation *InternshipReviewMutation
+}
+
+// Where appends a list predicates to the InternshipReviewDelete builder.
+func (ird *InternshipReviewDelete) Where(ps ...predicate.InternshipReview) *InternshipReviewDelete {
+	ird.mutation.Where(ps...)
+	return ird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ird *InternshipReviewDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ird.sqlExec, ird.mutation, ird.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ird *InternshipReviewDelete) ExecX(ctx context.Context) int {
+	n, err := ird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ird *InternshipReviewDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(internshipreview.Table, sqlgraph.NewFieldSpec(internshipreview.FieldID, field.TypeString))
+	if ps := ird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ird.mutation.done = true
+	return affected, err
+}
+
+// InternshipReviewDeleteOne is the builder for deleting a single InternshipReview entity.
+type InternshipReviewDeleteOne struct {
+	ird *InternshipReviewDelete
+}
+
+// Where appends a list predicates to the InternshipReviewDelete builder.
+func (irdo *InternshipReviewDeleteOne) Where(ps ...predicate.InternshipReview) *InternshipReviewDeleteOne {
+	irdo.ird.mutation.Where(ps...)
+	return irdo
+}
+
+// Exec executes the deletion query.
+func (irdo *InternshipReviewDeleteOne) Exec(ctx context.Context) error {
+	n, err := irdo.ird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{internshipreview.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irdo *InternshipReviewDeleteOne) ExecX(ctx context.Context) {
+	if err := irdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,940 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipReviewUpdate is the builder for updating InternshipReview entities.
+type InternshipReviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InternshipReviewMutation
+}
+
+// Where appends a list predicates to the InternshipReviewUpdate builder.
+func (iru *InternshipReviewUpdate) Where(ps ...predicate.InternshipReview) *InternshipReviewUpdate {
+	iru.mutation.Where(ps...)
+	return iru
+}
+
+// SetStatus sets the "status" field.
+func (iru *InternshipReviewUpdate) SetStatus(s string) *InternshipReviewUpdate {
+	iru.mutation.SetStatus(s)
+	return iru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableStatus(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetStatus(*s)
+	}
+	return iru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iru *InternshipReviewUpdate) SetUpdatedAt(t time.Time) *InternshipReviewUpdate {
+	iru.mutation.SetUpdatedAt(t)
+	return iru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iru *InternshipReviewUpdate) SetUpdatedBy(s string) *InternshipReviewUpdate {
+	iru.mutation.SetUpdatedBy(s)
+	return iru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableUpdatedBy(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetUpdatedBy(*s)
+	}
+	return iru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iru *InternshipReviewUpdate) ClearUpdatedBy() *InternshipReviewUpdate {
+	iru.mutation.ClearUpdatedBy()
+	return iru
+}
+
+// SetRating sets the "rating" field.
+func (iru *InternshipReviewUpdate) SetRating(i int) *InternshipReviewUpdate {
+	iru.mutation.ResetRating()
+	iru.mutation.SetRating(i)
+	return iru
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableRating(i *int) *InternshipReviewUpdate {
+	if i != nil {
+		iru.SetRating(*i)
+	}
+	return iru
+}
+
+// AddRating adds i to the "rating" field.
+func (iru *InternshipReviewUpdate) AddRating(i int) *InternshipReviewUpdate {
+	iru.mutation.AddRating(i)
+	return iru
+}
+
+// SetTitle sets the "title" field.
+func (iru *InternshipReviewUpdate) SetTitle(s string) *InternshipReviewUpdate {
+	iru.mutation.SetTitle(s)
+	return iru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableTitle(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetTitle(*s)
+	}
+	return iru
+}
+
+// ClearTitle clears the value of the "title" field.
+func (iru *InternshipReviewUpdate) ClearTitle() *InternshipReviewUpdate {
+	iru.mutation.ClearTitle()
+	return iru
+}
+
+// SetBody sets the "body" field.
+func (iru *InternshipReviewUpdate) SetBody(s string) *InternshipReviewUpdate {
+	iru.mutation.SetBody(s)
+	return iru
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableBody(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetBody(*s)
+	}
+	return iru
+}
+
+// ClearBody clears the value of the "body" field.
+func (iru *InternshipReviewUpdate) ClearBody() *InternshipReviewUpdate {
+	iru.mutation.ClearBody()
+	return iru
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (iru *InternshipReviewUpdate) SetReviewStatus(s string) *InternshipReviewUpdate {
+	iru.mutation.SetReviewStatus(s)
+	return iru
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableReviewStatus(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetReviewStatus(*s)
+	}
+	return iru
+}
+
+// SetReply sets the "reply" field.
+func (iru *InternshipReviewUpdate) SetReply(s string) *InternshipReviewUpdate {
+	iru.mutation.SetReply(s)
+	return iru
+}
+
+// SetNillableReply sets the "reply" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableReply(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetReply(*s)
+	}
+	return iru
+}
+
+// ClearReply clears the value of the "reply" field.
+func (iru *InternshipReviewUpdate) ClearReply() *InternshipReviewUpdate {
+	iru.mutation.ClearReply()
+	return iru
+}
+
+// SetRepliedBy sets the "replied_by" field.
+func (iru *InternshipReviewUpdate) SetRepliedBy(s string) *InternshipReviewUpdate {
+	iru.mutation.SetRepliedBy(s)
+	return iru
+}
+
+// SetNillableRepliedBy sets the "replied_by" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableRepliedBy(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetRepliedBy(*s)
+	}
+	return iru
+}
+
+// ClearRepliedBy clears the value of the "replied_by" field.
+func (iru *InternshipReviewUpdate) ClearRepliedBy() *InternshipReviewUpdate {
+	iru.mutation.ClearRepliedBy()
+	return iru
+}
+
+// SetRepliedAt sets the "replied_at" field.
+func (iru *InternshipReviewUpdate) SetRepliedAt(t time.Time) *InternshipReviewUpdate {
+	iru.mutation.SetRepliedAt(t)
+	return iru
+}
+
+// SetNillableRepliedAt sets the "replied_at" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableRepliedAt(t *time.Time) *InternshipReviewUpdate {
+	if t != nil {
+		iru.SetRepliedAt(*t)
+	}
+	return iru
+}
+
+// ClearRepliedAt clears the value of the "replied_at" field.
+func (iru *InternshipReviewUpdate) ClearRepliedAt() *InternshipReviewUpdate {
+	iru.mutation.ClearRepliedAt()
+	return iru
+}
+
+// SetReports sets the "reports" field.
+func (iru *InternshipReviewUpdate) SetReports(tr []types.ReviewReport) *InternshipReviewUpdate {
+	iru.mutation.SetReports(tr)
+	return iru
+}
+
+// AppendReports appends tr to the "reports" field.
+func (iru *InternshipReviewUpdate) AppendReports(tr []types.ReviewReport) *InternshipReviewUpdate {
+	iru.mutation.AppendReports(tr)
+	return iru
+}
+
+// SetReportCount sets the "report_count" field.
+func (iru *InternshipReviewUpdate) SetReportCount(i int) *InternshipReviewUpdate {
+	iru.mutation.ResetReportCount()
+	iru.mutation.SetReportCount(i)
+	return iru
+}
+
+// SetNillableReportCount sets the "report_count" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableReportCount(i *int) *InternshipReviewUpdate {
+	if i != nil {
+		iru.SetReportCount(*i)
+	}
+	return iru
+}
+
+// AddReportCount adds i to the "report_count" field.
+func (iru *InternshipReviewUpdate) AddReportCount(i int) *InternshipReviewUpdate {
+	iru.mutation.AddReportCount(i)
+	return iru
+}
+
+// SetModerationNote sets the "moderation_note" field.
+func (iru *InternshipReviewUpdate) SetModerationNote(s string) *InternshipReviewUpdate {
+	iru.mutation.SetModerationNote(s)
+	return iru
+}
+
+// SetNillableModerationNote sets the "moderation_note" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableModerationNote(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetModerationNote(*s)
+	}
+	return iru
+}
+
+// ClearModerationNote clears the value of the "moderation_note" field.
+func (iru *InternshipReviewUpdate) ClearModerationNote() *InternshipReviewUpdate {
+	iru.mutation.ClearModerationNote()
+	return iru
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (iru *InternshipReviewUpdate) SetModeratedBy(s string) *InternshipReviewUpdate {
+	iru.mutation.SetModeratedBy(s)
+	return iru
+}
+
+// SetNillableModeratedBy sets the "moderated_by" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableModeratedBy(s *string) *InternshipReviewUpdate {
+	if s != nil {
+		iru.SetModeratedBy(*s)
+	}
+	return iru
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (iru *InternshipReviewUpdate) ClearModeratedBy() *InternshipReviewUpdate {
+	iru.mutation.ClearModeratedBy()
+	return iru
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (iru *InternshipReviewUpdate) SetModeratedAt(t time.Time) *InternshipReviewUpdate {
+	iru.mutation.SetModeratedAt(t)
+	return iru
+}
+
+// SetNillableModeratedAt sets the "moderated_at" field if the given value is not nil.
+func (iru *InternshipReviewUpdate) SetNillableModeratedAt(t *time.Time) *InternshipReviewUpdate {
+	if t != nil {
+		iru.SetModeratedAt(*t)
+	}
+	return iru
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (iru *InternshipReviewUpdate) ClearModeratedAt() *InternshipReviewUpdate {
+	iru.mutation.ClearModeratedAt()
+	return iru
+}
+
+// Mutation returns the InternshipReviewMutation object of the builder.
+func (iru *InternshipReviewUpdate) Mutation() *InternshipReviewMutation {
+	return iru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *InternshipReviewUpdate) Save(ctx context.Context) (int, error) {
+	iru.defaults()
+	return withHooks(ctx, iru.sqlSave, iru.mutation, iru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *InternshipReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *InternshipReviewUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *InternshipReviewUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iru *InternshipReviewUpdate) defaults() {
+	if _, ok := iru.mutation.UpdatedAt(); !ok {
+		v := internshipreview.UpdateDefaultUpdatedAt()
+		iru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iru *InternshipReviewUpdate) check() error {
+	if v, ok := iru.mutation.Rating(); ok {
+		if err := internshipreview.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.rating": %w`, err)}
+		}
+	}
+	if v, ok := iru.mutation.ReportCount(); ok {
+		if err := internshipreview.ReportCountValidator(v); err != nil {
+			return &ValidationError{Name: "report_count", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.report_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iru *InternshipReviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(internshipreview.Table, internshipreview.Columns, sqlgraph.NewFieldSpec(internshipreview.FieldID, field.TypeString))
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iru.mutation.Status(); ok {
+		_spec.SetField(internshipreview.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iru.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipreview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iru.mutation.CreatedByCleared() {
+		_spec.ClearField(internshipreview.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iru.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipreview.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iru.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshipreview.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iru.mutation.Rating(); ok {
+		_spec.SetField(internshipreview.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := iru.mutation.AddedRating(); ok {
+		_spec.AddField(internshipreview.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := iru.mutation.Title(); ok {
+		_spec.SetField(internshipreview.FieldTitle, field.TypeString, value)
+	}
+	if iru.mutation.TitleCleared() {
+		_spec.ClearField(internshipreview.FieldTitle, field.TypeString)
+	}
+	if value, ok := iru.mutation.Body(); ok {
+		_spec.SetField(internshipreview.FieldBody, field.TypeString, value)
+	}
+	if iru.mutation.BodyCleared() {
+		_spec.ClearField(internshipreview.FieldBody, field.TypeString)
+	}
+	if value, ok := iru.mutation.ReviewStatus(); ok {
+		_spec.SetField(internshipreview.FieldReviewStatus, field.TypeString, value)
+	}
+	if value, ok := iru.mutation.Reply(); ok {
+		_spec.SetField(internshipreview.FieldReply, field.TypeString, value)
+	}
+	if iru.mutation.ReplyCleared() {
+		_spec.ClearField(internshipreview.FieldReply, field.TypeString)
+	}
+	if value, ok := iru.mutation.RepliedBy(); ok {
+		_spec.SetField(internshipreview.FieldRepliedBy, field.TypeString, value)
+	}
+	if iru.mutation.RepliedByCleared() {
+		_spec.ClearField(internshipreview.FieldRepliedBy, field.TypeString)
+	}
+	if value, ok := iru.mutation.RepliedAt(); ok {
+		_spec.SetField(internshipreview.FieldRepliedAt, field.TypeTime, value)
+	}
+	if iru.mutation.RepliedAtCleared() {
+		_spec.ClearField(internshipreview.FieldRepliedAt, field.TypeTime)
+	}
+	if value, ok := iru.mutation.Reports(); ok {
+		_spec.SetField(internshipreview.FieldReports, field.TypeJSON, value)
+	}
+	if value, ok := iru.mutation.AppendedReports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, internshipreview.FieldReports, value)
+		})
+	}
+	if value, ok := iru.mutation.ReportCount(); ok {
+		_spec.SetField(internshipreview.FieldReportCount, field.TypeInt, value)
+	}
+	if value, ok := iru.mutation.AddedReportCount(); ok {
+		_spec.AddField(internshipreview.FieldReportCount, field.TypeInt, value)
+	}
+	if value, ok := iru.mutation.ModerationNote(); ok {
+		_spec.SetField(internshipreview.FieldModerationNote, field.TypeString, value)
+	}
+	if iru.mutation.ModerationNoteCleared() {
+		_spec.ClearField(internshipreview.FieldModerationNote, field.TypeString)
+	}
+	if value, ok := iru.mutation.ModeratedBy(); ok {
+		_spec.SetField(internshipreview.FieldModeratedBy, field.TypeString, value)
+	}
+	if iru.mutation.ModeratedByCleared() {
+		_spec.ClearField(internshipreview.FieldModeratedBy, field.TypeString)
+	}
+	if value, ok := iru.mutation.ModeratedAt(); ok {
+		_spec.SetField(internshipreview.FieldModeratedAt, field.TypeTime, value)
+	}
+	if iru.mutation.ModeratedAtCleared() {
+		_spec.ClearField(internshipreview.FieldModeratedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshipreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iru.mutation.done = true
+	return n, nil
+}
+
+// InternshipReviewUpdateOne is the builder for updating a single InternshipReview entity.
+type InternshipReviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InternshipReviewMutation
+}
+
+// SetStatus sets the "status" field.
+func (iruo *InternshipReviewUpdateOne) SetStatus(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetStatus(s)
+	return iruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableStatus(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetStatus(*s)
+	}
+	return iruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iruo *InternshipReviewUpdateOne) SetUpdatedAt(t time.Time) *InternshipReviewUpdateOne {
+	iruo.mutation.SetUpdatedAt(t)
+	return iruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iruo *InternshipReviewUpdateOne) SetUpdatedBy(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetUpdatedBy(s)
+	return iruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableUpdatedBy(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetUpdatedBy(*s)
+	}
+	return iruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iruo *InternshipReviewUpdateOne) ClearUpdatedBy() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearUpdatedBy()
+	return iruo
+}
+
+// SetRating sets the "rating" field.
+func (iruo *InternshipReviewUpdateOne) SetRating(i int) *InternshipReviewUpdateOne {
+	iruo.mutation.ResetRating()
+	iruo.mutation.SetRating(i)
+	return iruo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableRating(i *int) *InternshipReviewUpdateOne {
+	if i != nil {
+		iruo.SetRating(*i)
+	}
+	return iruo
+}
+
+// AddRating adds i to the "rating" field.
+func (iruo *InternshipReviewUpdateOne) AddRating(i int) *InternshipReviewUpdateOne {
+	iruo.mutation.AddRating(i)
+	return iruo
+}
+
+// SetTitle sets the "title" field.
+func (iruo *InternshipReviewUpdateOne) SetTitle(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetTitle(s)
+	return iruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableTitle(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetTitle(*s)
+	}
+	return iruo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (iruo *InternshipReviewUpdateOne) ClearTitle() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearTitle()
+	return iruo
+}
+
+// SetBody sets the "body" field.
+func (iruo *InternshipReviewUpdateOne) SetBody(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetBody(s)
+	return iruo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableBody(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetBody(*s)
+	}
+	return iruo
+}
+
+// ClearBody clears the value of the "body" field.
+func (iruo *InternshipReviewUpdateOne) ClearBody() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearBody()
+	return iruo
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (iruo *InternshipReviewUpdateOne) SetReviewStatus(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetReviewStatus(s)
+	return iruo
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableReviewStatus(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetReviewStatus(*s)
+	}
+	return iruo
+}
+
+// SetReply sets the "reply" field.
+func (iruo *InternshipReviewUpdateOne) SetReply(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetReply(s)
+	return iruo
+}
+
+// SetNillableReply sets the "reply" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableReply(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetReply(*s)
+	}
+	return iruo
+}
+
+// ClearReply clears the value of the "reply" field.
+func (iruo *InternshipReviewUpdateOne) ClearReply() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearReply()
+	return iruo
+}
+
+// SetRepliedBy sets the "replied_by" field.
+func (iruo *InternshipReviewUpdateOne) SetRepliedBy(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetRepliedBy(s)
+	return iruo
+}
+
+// SetNillableRepliedBy sets the "replied_by" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableRepliedBy(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetRepliedBy(*s)
+	}
+	return iruo
+}
+
+// ClearRepliedBy clears the value of the "replied_by" field.
+func (iruo *InternshipReviewUpdateOne) ClearRepliedBy() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearRepliedBy()
+	return iruo
+}
+
+// SetRepliedAt sets the "replied_at" field.
+func (iruo *InternshipReviewUpdateOne) SetRepliedAt(t time.Time) *InternshipReviewUpdateOne {
+	iruo.mutation.SetRepliedAt(t)
+	return iruo
+}
+
+// SetNillableRepliedAt sets the "replied_at" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableRepliedAt(t *time.Time) *InternshipReviewUpdateOne {
+	if t != nil {
+		iruo.SetRepliedAt(*t)
+	}
+	return iruo
+}
+
+// ClearRepliedAt clears the value of the "replied_at" field.
+func (iruo *InternshipReviewUpdateOne) ClearRepliedAt() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearRepliedAt()
+	return iruo
+}
+
+// SetReports sets the "reports" field.
+func (iruo *InternshipReviewUpdateOne) SetReports(tr []types.ReviewReport) *InternshipReviewUpdateOne {
+	iruo.mutation.SetReports(tr)
+	return iruo
+}
+
+// AppendReports appends tr to the "reports" field.
+func (iruo *InternshipReviewUpdateOne) AppendReports(tr []types.ReviewReport) *InternshipReviewUpdateOne {
+	iruo.mutation.AppendReports(tr)
+	return iruo
+}
+
+// SetReportCount sets the "report_count" field.
+func (iruo *InternshipReviewUpdateOne) SetReportCount(i int) *InternshipReviewUpdateOne {
+	iruo.mutation.ResetReportCount()
+	iruo.mutation.SetReportCount(i)
+	return iruo
+}
+
+// SetNillableReportCount sets the "report_count" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableReportCount(i *int) *InternshipReviewUpdateOne {
+	if i != nil {
+		iruo.SetReportCount(*i)
+	}
+	return iruo
+}
+
+// AddReportCount adds i to the "report_count" field.
+func (iruo *InternshipReviewUpdateOne) AddReportCount(i int) *InternshipReviewUpdateOne {
+	iruo.mutation.AddReportCount(i)
+	return iruo
+}
+
+// SetModerationNote sets the "moderation_note" field.
+func (iruo *InternshipReviewUpdateOne) SetModerationNote(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetModerationNote(s)
+	return iruo
+}
+
+// SetNillableModerationNote sets the "moderation_note" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableModerationNote(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetModerationNote(*s)
+	}
+	return iruo
+}
+
+// ClearModerationNote clears the value of the "moderation_note" field.
+func (iruo *InternshipReviewUpdateOne) ClearModerationNote() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearModerationNote()
+	return iruo
+}
+
+// SetModeratedBy sets the "moderated_by" field.
+func (iruo *InternshipReviewUpdateOne) SetModeratedBy(s string) *InternshipReviewUpdateOne {
+	iruo.mutation.SetModeratedBy(s)
+	return iruo
+}
+
+// SetNillableModeratedBy sets the "moderated_by" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableModeratedBy(s *string) *InternshipReviewUpdateOne {
+	if s != nil {
+		iruo.SetModeratedBy(*s)
+	}
+	return iruo
+}
+
+// ClearModeratedBy clears the value of the "moderated_by" field.
+func (iruo *InternshipReviewUpdateOne) ClearModeratedBy() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearModeratedBy()
+	return iruo
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (iruo *InternshipReviewUpdateOne) SetModeratedAt(t time.Time) *InternshipReviewUpdateOne {
+	iruo.mutation.SetModeratedAt(t)
+	return iruo
+}
+
+// SetNillableModeratedAt sets the "moderated_at" field if the given value is not nil.
+func (iruo *InternshipReviewUpdateOne) SetNillableModeratedAt(t *time.Time) *InternshipReviewUpdateOne {
+	if t != nil {
+		iruo.SetModeratedAt(*t)
+	}
+	return iruo
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (iruo *InternshipReviewUpdateOne) ClearModeratedAt() *InternshipReviewUpdateOne {
+	iruo.mutation.ClearModeratedAt()
+	return iruo
+}
+
+// Mutation returns the InternshipReviewMutation object of the builder.
+func (iruo *InternshipReviewUpdateOne) Mutation() *InternshipReviewMutation {
+	return iruo.mutation
+}
+
+// Where appends a list predicates to the InternshipReviewUpdate builder.
+func (iruo *InternshipReviewUpdateOne) Where(ps ...predicate.InternshipReview) *InternshipReviewUpdateOne {
+	iruo.mutation.Where(ps...)
+	return iruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iruo *InternshipReviewUpdateOne) Select(field string, fields ...string) *InternshipReviewUpdateOne {
+	iruo.fields = append([]string{field}, fields...)
+	return iruo
+}
+
+// Save executes the query and returns the updated InternshipReview entity.
+func (iruo *InternshipReviewUpdateOne) Save(ctx context.Context) (*InternshipReview, error) {
+	iruo.defaults()
+	return withHooks(ctx, iruo.sqlSave, iruo.mutation, iruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *InternshipReviewUpdateOne) SaveX(ctx context.Context) *InternshipReview {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *InternshipReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *InternshipReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iruo *InternshipReviewUpdateOne) defaults() {
+	if _, ok := iruo.mutation.UpdatedAt(); !ok {
+		v := internshipreview.UpdateDefaultUpdatedAt()
+		iruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iruo *InternshipReviewUpdateOne) check() error {
+	if v, ok := iruo.mutation.Rating(); ok {
+		if err := internshipreview.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.rating": %w`, err)}
+		}
+	}
+	if v, ok := iruo.mutation.ReportCount(); ok {
+		if err := internshipreview.ReportCountValidator(v); err != nil {
+			return &ValidationError{Name: "report_count", err: fmt.Errorf(`ent: validator failed for field "InternshipReview.report_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iruo *InternshipReviewUpdateOne) sqlSave(ctx context.Context) (_node *InternshipReview, err error) {
+	if err := iruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(internshipreview.Table, internshipreview.Columns, sqlgraph.NewFieldSpec(internshipreview.FieldID, field.TypeString))
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InternshipReview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshipreview.FieldID)
+		for _, f := range fields {
+			if !internshipreview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != internshipreview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iruo.mutation.Status(); ok {
+		_spec.SetField(internshipreview.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshipreview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iruo.mutation.CreatedByCleared() {
+		_spec.ClearField(internshipreview.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshipreview.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshipreview.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iruo.mutation.Rating(); ok {
+		_spec.SetField(internshipreview.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := iruo.mutation.AddedRating(); ok {
+		_spec.AddField(internshipreview.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := iruo.mutation.Title(); ok {
+		_spec.SetField(internshipreview.FieldTitle, field.TypeString, value)
+	}
+	if iruo.mutation.TitleCleared() {
+		_spec.ClearField(internshipreview.FieldTitle, field.TypeString)
+	}
+	if value, ok := iruo.mutation.Body(); ok {
+		_spec.SetField(internshipreview.FieldBody, field.TypeString, value)
+	}
+	if iruo.mutation.BodyCleared() {
+		_spec.ClearField(internshipreview.FieldBody, field.TypeString)
+	}
+	if value, ok := iruo.mutation.ReviewStatus(); ok {
+		_spec.SetField(internshipreview.FieldReviewStatus, field.TypeString, value)
+	}
+	if value, ok := iruo.mutation.Reply(); ok {
+		_spec.SetField(internshipreview.FieldReply, field.TypeString, value)
+	}
+	if iruo.mutation.ReplyCleared() {
+		_spec.ClearField(internshipreview.FieldReply, field.TypeString)
+	}
+	if value, ok := iruo.mutation.RepliedBy(); ok {
+		_spec.SetField(internshipreview.FieldRepliedBy, field.TypeString, value)
+	}
+	if iruo.mutation.RepliedByCleared() {
+		_spec.ClearField(internshipreview.FieldRepliedBy, field.TypeString)
+	}
+	if value, ok := iruo.mutation.RepliedAt(); ok {
+		_spec.SetField(internshipreview.FieldRepliedAt, field.TypeTime, value)
+	}
+	if iruo.mutation.RepliedAtCleared() {
+		_spec.ClearField(internshipreview.FieldRepliedAt, field.TypeTime)
+	}
+	if value, ok := iruo.mutation.Reports(); ok {
+		_spec.SetField(internshipreview.FieldReports, field.TypeJSON, value)
+	}
+	if value, ok := iruo.mutation.AppendedReports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, internshipreview.FieldReports, value)
+		})
+	}
+	if value, ok := iruo.mutation.ReportCount(); ok {
+		_spec.SetField(internshipreview.FieldReportCount, field.TypeInt, value)
+	}
+	if value, ok := iruo.mutation.AddedReportCount(); ok {
+		_spec.AddField(internshipreview.FieldReportCount, field.TypeInt, value)
+	}
+	if value, ok := iruo.mutation.ModerationNote(); ok {
+		_spec.SetField(internshipreview.FieldModerationNote, field.TypeString, value)
+	}
+	if iruo.mutation.ModerationNoteCleared() {
+		_spec.ClearField(internshipreview.FieldModerationNote, field.TypeString)
+	}
+	if value, ok := iruo.mutation.ModeratedBy(); ok {
+		_spec.SetField(internshipreview.FieldModeratedBy, field.TypeString, value)
+	}
+	if iruo.mutation.ModeratedByCleared() {
+		_spec.ClearField(internshipreview.FieldModeratedBy, field.TypeString)
+	}
+	if value, ok := iruo.mutation.ModeratedAt(); ok {
+		_spec.SetField(internshipreview.FieldModeratedAt, field.TypeTime, value)
+	}
+	if iruo.mutation.ModeratedAtCleared() {
+		_spec.ClearField(internshipreview.FieldModeratedAt, field.TypeTime)
+	}
+	_node = &InternshipReview{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshipreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iruo.mutation.done = true
+	return _node, nil
+}
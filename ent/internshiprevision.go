@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipRevision is the model entity for the InternshipRevision schema.
+type InternshipRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot *types.InternshipSnapshot `json:"snapshot,omitempty"`
+	// RestoredFromID holds the value of the "restored_from_id" field.
+	RestoredFromID *string `json:"restored_from_id,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InternshipRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case internshiprevision.FieldSnapshot:
+			values[i] = new([]byte)
+		case internshiprevision.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case internshiprevision.FieldID, internshiprevision.FieldStatus, internshiprevision.FieldCreatedBy, internshiprevision.FieldUpdatedBy, internshiprevision.FieldInternshipID, internshiprevision.FieldRestoredFromID:
+			values[i] = new(sql.NullString)
+		case internshiprevision.FieldCreatedAt, internshiprevision.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InternshipRevision fields.
+func (ir *InternshipRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case internshiprevision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ir.ID = value.String
+			}
+		case internshiprevision.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ir.Status = value.String
+			}
+		case internshiprevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ir.CreatedAt = value.Time
+			}
+		case internshiprevision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ir.UpdatedAt = value.Time
+			}
+		case internshiprevision.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ir.CreatedBy = value.String
+			}
+		case internshiprevision.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ir.UpdatedBy = value.String
+			}
+		case internshiprevision.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				ir.InternshipID = value.String
+			}
+		case internshiprevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				ir.Revision = int(value.Int64)
+			}
+		case internshiprevision.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case internshiprevision.FieldRestoredFromID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_from_id", values[i])
+			} else if value.Valid {
+				ir.RestoredFromID = new(string)
+				*ir.RestoredFromID = value.String
+			}
+		default:
+			ir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InternshipRevision.
+// This includes values selected through modifiers, order, etc.
+func (ir *InternshipRevision) Value(name string) (ent.Value, error) {
+	return ir.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InternshipRevision.
+// Note that you need to call InternshipRevision.Unwrap() before calling this method if this InternshipRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *InternshipRevision) Update() *InternshipRevisionUpdateOne {
+	return NewInternshipRevisionClient(ir.config).UpdateOne(ir)
+}
+
+// Unwrap unwraps the InternshipRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *InternshipRevision) Unwrap() *InternshipRevision {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InternshipRevision is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *InternshipRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("InternshipRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("status=")
+	builder.WriteString(ir.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ir.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ir.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ir.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ir.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(ir.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Snapshot))
+	builder.WriteString(", ")
+	if v := ir.RestoredFromID; v != nil {
+		builder.WriteString("restored_from_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InternshipRevisions is a parsable slice of InternshipRevision.
+type InternshipRevisions []*InternshipRevision
@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internshiprevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RestoredFromID applies equality check predicate on the "restored_from_id" field. It's identical to RestoredFromIDEQ.
+func RestoredFromID(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldRestoredFromID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// RestoredFromIDEQ applies the EQ predicate on the "restored_from_id" field.
+func RestoredFromIDEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEQ(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDNEQ applies the NEQ predicate on the "restored_from_id" field.
+func RestoredFromIDNEQ(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNEQ(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDIn applies the In predicate on the "restored_from_id" field.
+func RestoredFromIDIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIn(FieldRestoredFromID, vs...))
+}
+
+// RestoredFromIDNotIn applies the NotIn predicate on the "restored_from_id" field.
+func RestoredFromIDNotIn(vs ...string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotIn(FieldRestoredFromID, vs...))
+}
+
+// RestoredFromIDGT applies the GT predicate on the "restored_from_id" field.
+func RestoredFromIDGT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGT(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDGTE applies the GTE predicate on the "restored_from_id" field.
+func RestoredFromIDGTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldGTE(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDLT applies the LT predicate on the "restored_from_id" field.
+func RestoredFromIDLT(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLT(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDLTE applies the LTE predicate on the "restored_from_id" field.
+func RestoredFromIDLTE(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldLTE(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDContains applies the Contains predicate on the "restored_from_id" field.
+func RestoredFromIDContains(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContains(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDHasPrefix applies the HasPrefix predicate on the "restored_from_id" field.
+func RestoredFromIDHasPrefix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasPrefix(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDHasSuffix applies the HasSuffix predicate on the "restored_from_id" field.
+func RestoredFromIDHasSuffix(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldHasSuffix(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDIsNil applies the IsNil predicate on the "restored_from_id" field.
+func RestoredFromIDIsNil() predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldIsNull(FieldRestoredFromID))
+}
+
+// RestoredFromIDNotNil applies the NotNil predicate on the "restored_from_id" field.
+func RestoredFromIDNotNil() predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldNotNull(FieldRestoredFromID))
+}
+
+// RestoredFromIDEqualFold applies the EqualFold predicate on the "restored_from_id" field.
+func RestoredFromIDEqualFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldEqualFold(FieldRestoredFromID, v))
+}
+
+// RestoredFromIDContainsFold applies the ContainsFold predicate on the "restored_from_id" field.
+func RestoredFromIDContainsFold(v string) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.FieldContainsFold(FieldRestoredFromID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InternshipRevision) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InternshipRevision) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InternshipRevision) predicate.InternshipRevision {
+	return predicate.InternshipRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipRevisionCreate is the builder for creating a InternshipRevision entity.
+type InternshipRevisionCreate struct {
+	config
+	mutation *InternshipRevisionMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (irc *InternshipRevisionCreate) SetStatus(s string) *InternshipRevisionCreate {
+	irc.mutation.SetStatus(s)
+	return irc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableStatus(s *string) *InternshipRevisionCreate {
+	if s != nil {
+		irc.SetStatus(*s)
+	}
+	return irc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irc *InternshipRevisionCreate) SetCreatedAt(t time.Time) *InternshipRevisionCreate {
+	irc.mutation.SetCreatedAt(t)
+	return irc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableCreatedAt(t *time.Time) *InternshipRevisionCreate {
+	if t != nil {
+		irc.SetCreatedAt(*t)
+	}
+	return irc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (irc *InternshipRevisionCreate) SetUpdatedAt(t time.Time) *InternshipRevisionCreate {
+	irc.mutation.SetUpdatedAt(t)
+	return irc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableUpdatedAt(t *time.Time) *InternshipRevisionCreate {
+	if t != nil {
+		irc.SetUpdatedAt(*t)
+	}
+	return irc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (irc *InternshipRevisionCreate) SetCreatedBy(s string) *InternshipRevisionCreate {
+	irc.mutation.SetCreatedBy(s)
+	return irc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableCreatedBy(s *string) *InternshipRevisionCreate {
+	if s != nil {
+		irc.SetCreatedBy(*s)
+	}
+	return irc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (irc *InternshipRevisionCreate) SetUpdatedBy(s string) *InternshipRevisionCreate {
+	irc.mutation.SetUpdatedBy(s)
+	return irc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableUpdatedBy(s *string) *InternshipRevisionCreate {
+	if s != nil {
+		irc.SetUpdatedBy(*s)
+	}
+	return irc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (irc *InternshipRevisionCreate) SetInternshipID(s string) *InternshipRevisionCreate {
+	irc.mutation.SetInternshipID(s)
+	return irc
+}
+
+// SetRevision sets the "revision" field.
+func (irc *InternshipRevisionCreate) SetRevision(i int) *InternshipRevisionCreate {
+	irc.mutation.SetRevision(i)
+	return irc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (irc *InternshipRevisionCreate) SetSnapshot(ts *types.InternshipSnapshot) *InternshipRevisionCreate {
+	irc.mutation.SetSnapshot(ts)
+	return irc
+}
+
+// SetRestoredFromID sets the "restored_from_id" field.
+func (irc *InternshipRevisionCreate) SetRestoredFromID(s string) *InternshipRevisionCreate {
+	irc.mutation.SetRestoredFromID(s)
+	return irc
+}
+
+// SetNillableRestoredFromID sets the "restored_from_id" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableRestoredFromID(s *string) *InternshipRevisionCreate {
+	if s != nil {
+		irc.SetRestoredFromID(*s)
+	}
+	return irc
+}
+
+// SetID sets the "id" field.
+func (irc *InternshipRevisionCreate) SetID(s string) *InternshipRevisionCreate {
+	irc.mutation.SetID(s)
+	return irc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (irc *InternshipRevisionCreate) SetNillableID(s *string) *InternshipRevisionCreate {
+	if s != nil {
+		irc.SetID(*s)
+	}
+	return irc
+}
+
+// Mutation returns the InternshipRevisionMutation object of the builder.
+func (irc *InternshipRevisionCreate) Mutation() *InternshipRevisionMutation {
+	return irc.mutation
+}
+
+// Save creates the InternshipRevision in the database.
+func (irc *InternshipRevisionCreate) Save(ctx context.Context) (*InternshipRevision, error) {
+	irc.defaults()
+	return withHooks(ctx, irc.sqlSave, irc.mutation, irc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *InternshipRevisionCreate) SaveX(ctx context.Context) *InternshipRevision {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *InternshipRevisionCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *InternshipRevisionCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *InternshipRevisionCreate) defaults() {
+	if _, ok := irc.mutation.Status(); !ok {
+		v := internshiprevision.DefaultStatus
+		irc.mutation.SetStatus(v)
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		v := internshiprevision.DefaultCreatedAt()
+		irc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		v := internshiprevision.DefaultUpdatedAt()
+		irc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := irc.mutation.ID(); !ok {
+		v := internshiprevision.DefaultID()
+		irc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *InternshipRevisionCreate) check() error {
+	if _, ok := irc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InternshipRevision.status"`)}
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InternshipRevision.created_at"`)}
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InternshipRevision.updated_at"`)}
+	}
+	if _, ok := irc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "InternshipRevision.internship_id"`)}
+	}
+	if v, ok := irc.mutation.InternshipID(); ok {
+		if err := internshiprevision.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "InternshipRevision.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "InternshipRevision.revision"`)}
+	}
+	if v, ok := irc.mutation.Revision(); ok {
+		if err := internshiprevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "InternshipRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "InternshipRevision.snapshot"`)}
+	}
+	return nil
+}
+
+func (irc *InternshipRevisionCreate) sqlSave(ctx context.Context) (*InternshipRevision, error) {
+	if err := irc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InternshipRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	irc.mutation.id = &_node.ID
+	irc.mutation.done = true
+	return _node, nil
+}
+
+func (irc *InternshipRevisionCreate) createSpec() (*InternshipRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InternshipRevision{config: irc.config}
+		_spec = sqlgraph.NewCreateSpec(internshiprevision.Table, sqlgraph.NewFieldSpec(internshiprevision.FieldID, field.TypeString))
+	)
+	if id, ok := irc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := irc.mutation.Status(); ok {
+		_spec.SetField(internshiprevision.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := irc.mutation.CreatedAt(); ok {
+		_spec.SetField(internshiprevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := irc.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshiprevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := irc.mutation.CreatedBy(); ok {
+		_spec.SetField(internshiprevision.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := irc.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshiprevision.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := irc.mutation.InternshipID(); ok {
+		_spec.SetField(internshiprevision.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := irc.mutation.Revision(); ok {
+		_spec.SetField(internshiprevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := irc.mutation.Snapshot(); ok {
+		_spec.SetField(internshiprevision.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := irc.mutation.RestoredFromID(); ok {
+		_spec.SetField(internshiprevision.FieldRestoredFromID, field.TypeString, value)
+		_node.RestoredFromID = &value
+	}
+	return _node, _spec
+}
+
+// InternshipRevisionCreateBulk is the builder for creating many InternshipRevision entities in bulk.
+type InternshipRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*InternshipRevisionCreate
+}
+
+// Save creates the InternshipRevision entities in the database.
+func (ircb *InternshipRevisionCreateBulk) Save(ctx context.Context) ([]*InternshipRevision, error) {
+	if ircb.err != nil {
+		return nil, ircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*InternshipRevision, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InternshipRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *InternshipRevisionCreateBulk) SaveX(ctx context.Context) []*InternshipRevision {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *InternshipRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *InternshipRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipRevisionDelete is the builder for deleting a InternshipRevision entity.
+type InternshipRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *InternshipRevisionMutation
+}
+
+// Where appends a list predicates to the InternshipRevisionDelete builder.
+func (ird *InternshipRevisionDelete) Where(ps ...predicate.InternshipRevision) *InternshipRevisionDelete {
+	ird.mutation.Where(ps...)
+	return ird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ird *InternshipRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ird.sqlExec, ird.mutation, ird.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ird *InternshipRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := ird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ird *InternshipRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(internshiprevision.Table, sqlgraph.NewFieldSpec(internshiprevision.FieldID, field.TypeString))
+	if ps := ird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ird.mutation.done = true
+	return affected, err
+}
+
+// InternshipRevisionDeleteOne is the builder for deleting a single InternshipRevision entity.
+type InternshipRevisionDeleteOne struct {
+	ird *InternshipRevisionDelete
+}
+
+// Where appends a list predicates to the InternshipRevisionDelete builder.
+func (irdo *InternshipRevisionDeleteOne) Where(ps ...predicate.InternshipRevision) *InternshipRevisionDeleteOne {
+	irdo.ird.mutation.Where(ps...)
+	return irdo
+}
+
+// Exec executes the deletion query.
+func (irdo *InternshipRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := irdo.ird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{internshiprevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irdo *InternshipRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := irdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipRevisionQuery is the builder for querying InternshipRevision entities.
+type InternshipRevisionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []internshiprevision.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InternshipRevision
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InternshipRevisionQuery builder.
+func (irq *InternshipRevisionQuery) Where(ps ...predicate.InternshipRevision) *InternshipRevisionQuery {
+	irq.predicates = append(irq.predicates, ps...)
+	return irq
+}
+
+// Limit the number of records to be returned by this query.
+func (irq *InternshipRevisionQuery) Limit(limit int) *InternshipRevisionQuery {
+	irq.ctx.Limit = &limit
+	return irq
+}
+
+// Offset to start from.
+func (irq *InternshipRevisionQuery) Offset(offset int) *InternshipRevisionQuery {
+	irq.ctx.Offset = &offset
+	return irq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (irq *InternshipRevisionQuery) Unique(unique bool) *InternshipRevisionQuery {
+	irq.ctx.Unique = &unique
+	return irq
+}
+
+// Order specifies how the records should be ordered.
+func (irq *InternshipRevisionQuery) Order(o ...internshiprevision.OrderOption) *InternshipRevisionQuery {
+	irq.order = append(irq.order, o...)
+	return irq
+}
+
+// First returns the first InternshipRevision entity from the query.
+// Returns a *NotFoundError when no InternshipRevision was found.
+func (irq *InternshipRevisionQuery) First(ctx context.Context) (*InternshipRevision, error) {
+	nodes, err := irq.Limit(1).All(setContextOp(ctx, irq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{internshiprevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) FirstX(ctx context.Context) *InternshipRevision {
+	node, err := irq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InternshipRevision ID from the query.
+// Returns a *NotFoundError when no InternshipRevision ID was found.
+func (irq *InternshipRevisionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = irq.Limit(1).IDs(setContextOp(ctx, irq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{internshiprevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) FirstIDX(ctx context.Context) string {
+	id, err := irq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InternshipRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InternshipRevision entity is found.
+// Returns a *NotFoundError when no InternshipRevision entities are found.
+func (irq *InternshipRevisionQuery) Only(ctx context.Context) (*InternshipRevision, error) {
+	nodes, err := irq.Limit(2).All(setContextOp(ctx, irq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{internshiprevision.Label}
+	default:
+		return nil, &NotSingularError{internshiprevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) OnlyX(ctx context.Context) *InternshipRevision {
+	node, err := irq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InternshipRevision ID in the query.
+// Returns a *NotSingularError when more than one InternshipRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (irq *InternshipRevisionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = irq.Limit(2).IDs(setContextOp(ctx, irq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{internshiprevision.Label}
+	default:
+		err = &NotSingularError{internshiprevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := irq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InternshipRevisions.
+func (irq *InternshipRevisionQuery) All(ctx context.Context) ([]*InternshipRevision, error) {
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryAll)
+	if err := irq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InternshipRevision, *InternshipRevisionQuery]()
+	return withInterceptors[[]*InternshipRevision](ctx, irq, qr, irq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) AllX(ctx context.Context) []*InternshipRevision {
+	nodes, err := irq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InternshipRevision IDs.
+func (irq *InternshipRevisionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if irq.ctx.Unique == nil && irq.path != nil {
+		irq.Unique(true)
+	}
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryIDs)
+	if err = irq.Select(internshiprevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) IDsX(ctx context.Context) []string {
+	ids, err := irq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (irq *InternshipRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryCount)
+	if err := irq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, irq, querierCount[*InternshipRevisionQuery](), irq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) CountX(ctx context.Context) int {
+	count, err := irq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (irq *InternshipRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryExist)
+	switch _, err := irq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (irq *InternshipRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := irq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InternshipRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (irq *InternshipRevisionQuery) Clone() *InternshipRevisionQuery {
+	if irq == nil {
+		return nil
+	}
+	return &InternshipRevisionQuery{
+		config:     irq.config,
+		ctx:        irq.ctx.Clone(),
+		order:      append([]internshiprevision.OrderOption{}, irq.order...),
+		inters:     append([]Interceptor{}, irq.inters...),
+		predicates: append([]predicate.InternshipRevision{}, irq.predicates...),
+		// clone intermediate query.
+		sql:  irq.sql.Clone(),
+		path: irq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InternshipRevision.Query().
+//		GroupBy(internshiprevision.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (irq *InternshipRevisionQuery) GroupBy(field string, fields ...string) *InternshipRevisionGroupBy {
+	irq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InternshipRevisionGroupBy{build: irq}
+	grbuild.flds = &irq.ctx.Fields
+	grbuild.label = internshiprevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.InternshipRevision.Query().
+//		Select(internshiprevision.FieldStatus).
+//		Scan(ctx, &v)
+func (irq *InternshipRevisionQuery) Select(fields ...string) *InternshipRevisionSelect {
+	irq.ctx.Fields = append(irq.ctx.Fields, fields...)
+	sbuild := &InternshipRevisionSelect{InternshipRevisionQuery: irq}
+	sbuild.label = internshiprevision.Label
+	sbuild.flds, sbuild.scan = &irq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InternshipRevisionSelect configured with the given aggregations.
+func (irq *InternshipRevisionQuery) Aggregate(fns ...AggregateFunc) *InternshipRevisionSelect {
+	return irq.Select().Aggregate(fns...)
+}
+
+func (irq *InternshipRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range irq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, irq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range irq.ctx.Fields {
+		if !internshiprevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if irq.path != nil {
+		prev, err := irq.path(ctx)
+		if err != nil {
+			return err
+		}
+		irq.sql = prev
+	}
+	return nil
+}
+
+func (irq *InternshipRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InternshipRevision, error) {
+	var (
+		nodes = []*InternshipRevision{}
+		_spec = irq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InternshipRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InternshipRevision{config: irq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, irq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (irq *InternshipRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := irq.querySpec()
+	_spec.Node.Columns = irq.ctx.Fields
+	if len(irq.ctx.Fields) > 0 {
+		_spec.Unique = irq.ctx.Unique != nil && *irq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, irq.driver, _spec)
+}
+
+func (irq *InternshipRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(internshiprevision.Table, internshiprevision.Columns, sqlgraph.NewFieldSpec(internshiprevision.FieldID, field.TypeString))
+	_spec.From = irq.sql
+	if unique := irq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if irq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := irq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshiprevision.FieldID)
+		for i := range fields {
+			if fields[i] != internshiprevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := irq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := irq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := irq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := irq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (irq *InternshipRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(irq.driver.Dialect())
+	t1 := builder.Table(internshiprevision.Table)
+	columns := irq.ctx.Fields
+	if len(columns) == 0 {
+		columns = internshiprevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if irq.sql != nil {
+		selector = irq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if irq.ctx.Unique != nil && *irq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range irq.predicates {
+		p(selector)
+	}
+	for _, p := range irq.order {
+		p(selector)
+	}
+	if offset := irq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := irq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InternshipRevisionGroupBy is the group-by builder for InternshipRevision entities.
+type InternshipRevisionGroupBy struct {
+	selector
+	build *InternshipRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (irgb *InternshipRevisionGroupBy) Aggregate(fns ...AggregateFunc) *InternshipRevisionGroupBy {
+	irgb.fns = append(irgb.fns, fns...)
+	return irgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irgb *InternshipRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, irgb.build.ctx, ent.OpQueryGroupBy)
+	if err := irgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InternshipRevisionQuery, *InternshipRevisionGroupBy](ctx, irgb.build, irgb, irgb.build.inters, v)
+}
+
+func (irgb *InternshipRevisionGroupBy) sqlScan(ctx context.Context, root *InternshipRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(irgb.fns))
+	for _, fn := range irgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*irgb.flds)+len(irgb.fns))
+		for _, f := range *irgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*irgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InternshipRevisionSelect is the builder for selecting fields of InternshipRevision entities.
+type InternshipRevisionSelect struct {
+	*InternshipRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (irs *InternshipRevisionSelect) Aggregate(fns ...AggregateFunc) *InternshipRevisionSelect {
+	irs.fns = append(irs.fns, fns...)
+	return irs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irs *InternshipRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, irs.ctx, ent.OpQuerySelect)
+	if err := irs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InternshipRevisionQuery, *InternshipRevisionSelect](ctx, irs.InternshipRevisionQuery, irs, irs.inters, v)
+}
+
+func (irs *InternshipRevisionSelect) sqlScan(ctx context.Context, root *InternshipRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(irs.fns))
+	for _, fn := range irs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*irs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
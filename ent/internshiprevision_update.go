@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// InternshipRevisionUpdate is the builder for updating InternshipRevision entities.
+type InternshipRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InternshipRevisionMutation
+}
+
+// Where appends a list predicates to the InternshipRevisionUpdate builder.
+func (iru *InternshipRevisionUpdate) Where(ps ...predicate.InternshipRevision) *InternshipRevisionUpdate {
+	iru.mutation.Where(ps...)
+	return iru
+}
+
+// SetStatus sets the "status" field.
+func (iru *InternshipRevisionUpdate) SetStatus(s string) *InternshipRevisionUpdate {
+	iru.mutation.SetStatus(s)
+	return iru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iru *InternshipRevisionUpdate) SetNillableStatus(s *string) *InternshipRevisionUpdate {
+	if s != nil {
+		iru.SetStatus(*s)
+	}
+	return iru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iru *InternshipRevisionUpdate) SetUpdatedAt(t time.Time) *InternshipRevisionUpdate {
+	iru.mutation.SetUpdatedAt(t)
+	return iru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iru *InternshipRevisionUpdate) SetUpdatedBy(s string) *InternshipRevisionUpdate {
+	iru.mutation.SetUpdatedBy(s)
+	return iru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iru *InternshipRevisionUpdate) SetNillableUpdatedBy(s *string) *InternshipRevisionUpdate {
+	if s != nil {
+		iru.SetUpdatedBy(*s)
+	}
+	return iru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iru *InternshipRevisionUpdate) ClearUpdatedBy() *InternshipRevisionUpdate {
+	iru.mutation.ClearUpdatedBy()
+	return iru
+}
+
+// Mutation returns the InternshipRevisionMutation object of the builder.
+func (iru *InternshipRevisionUpdate) Mutation() *InternshipRevisionMutation {
+	return iru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *InternshipRevisionUpdate) Save(ctx context.Context) (int, error) {
+	iru.defaults()
+	return withHooks(ctx, iru.sqlSave, iru.mutation, iru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *InternshipRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *InternshipRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *InternshipRevisionUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iru *InternshipRevisionUpdate) defaults() {
+	if _, ok := iru.mutation.UpdatedAt(); !ok {
+		v := internshiprevision.UpdateDefaultUpdatedAt()
+		iru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (iru *InternshipRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(internshiprevision.Table, internshiprevision.Columns, sqlgraph.NewFieldSpec(internshiprevision.FieldID, field.TypeString))
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iru.mutation.Status(); ok {
+		_spec.SetField(internshiprevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iru.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshiprevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iru.mutation.CreatedByCleared() {
+		_spec.ClearField(internshiprevision.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iru.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshiprevision.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iru.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshiprevision.FieldUpdatedBy, field.TypeString)
+	}
+	if iru.mutation.RestoredFromIDCleared() {
+		_spec.ClearField(internshiprevision.FieldRestoredFromID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshiprevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iru.mutation.done = true
+	return n, nil
+}
+
+// InternshipRevisionUpdateOne is the builder for updating a single InternshipRevision entity.
+type InternshipRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InternshipRevisionMutation
+}
+
+// SetStatus sets the "status" field.
+func (iruo *InternshipRevisionUpdateOne) SetStatus(s string) *InternshipRevisionUpdateOne {
+	iruo.mutation.SetStatus(s)
+	return iruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iruo *InternshipRevisionUpdateOne) SetNillableStatus(s *string) *InternshipRevisionUpdateOne {
+	if s != nil {
+		iruo.SetStatus(*s)
+	}
+	return iruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iruo *InternshipRevisionUpdateOne) SetUpdatedAt(t time.Time) *InternshipRevisionUpdateOne {
+	iruo.mutation.SetUpdatedAt(t)
+	return iruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iruo *InternshipRevisionUpdateOne) SetUpdatedBy(s string) *InternshipRevisionUpdateOne {
+	iruo.mutation.SetUpdatedBy(s)
+	return iruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iruo *InternshipRevisionUpdateOne) SetNillableUpdatedBy(s *string) *InternshipRevisionUpdateOne {
+	if s != nil {
+		iruo.SetUpdatedBy(*s)
+	}
+	return iruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iruo *InternshipRevisionUpdateOne) ClearUpdatedBy() *InternshipRevisionUpdateOne {
+	iruo.mutation.ClearUpdatedBy()
+	return iruo
+}
+
+// Mutation returns the InternshipRevisionMutation object of the builder.
+func (iruo *InternshipRevisionUpdateOne) Mutation() *InternshipRevisionMutation {
+	return iruo.mutation
+}
+
+// Where appends a list predicates to the InternshipRevisionUpdate builder.
+func (iruo *InternshipRevisionUpdateOne) Where(ps ...predicate.InternshipRevision) *InternshipRevisionUpdateOne {
+	iruo.mutation.Where(ps...)
+	return iruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iruo *InternshipRevisionUpdateOne) Select(field string, fields ...string) *InternshipRevisionUpdateOne {
+	iruo.fields = append([]string{field}, fields...)
+	return iruo
+}
+
+// Save executes the query and returns the updated InternshipRevision entity.
+func (iruo *InternshipRevisionUpdateOne) Save(ctx context.Context) (*InternshipRevision, error) {
+	iruo.defaults()
+	return withHooks(ctx, iruo.sqlSave, iruo.mutation, iruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *InternshipRevisionUpdateOne) SaveX(ctx context.Context) *InternshipRevision {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *InternshipRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *InternshipRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iruo *InternshipRevisionUpdateOne) defaults() {
+	if _, ok := iruo.mutation.UpdatedAt(); !ok {
+		v := internshiprevision.UpdateDefaultUpdatedAt()
+		iruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (iruo *InternshipRevisionUpdateOne) sqlSave(ctx context.Context) (_node *InternshipRevision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(internshiprevision.Table, internshiprevision.Columns, sqlgraph.NewFieldSpec(internshiprevision.FieldID, field.TypeString))
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InternshipRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internshiprevision.FieldID)
+		for _, f := range fields {
+			if !internshiprevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != internshiprevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iruo.mutation.Status(); ok {
+		_spec.SetField(internshiprevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(internshiprevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iruo.mutation.CreatedByCleared() {
+		_spec.ClearField(internshiprevision.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(internshiprevision.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(internshiprevision.FieldUpdatedBy, field.TypeString)
+	}
+	if iruo.mutation.RestoredFromIDCleared() {
+		_spec.ClearField(internshiprevision.FieldRestoredFromID, field.TypeString)
+	}
+	_node = &InternshipRevision{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internshiprevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/lesson"
+)
+
+// Lesson is the model entity for the Lesson schema.
+type Lesson struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// ModuleID holds the value of the "module_id" field.
+	ModuleID string `json:"module_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// LessonType holds the value of the "lesson_type" field.
+	LessonType string `json:"lesson_type,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// VideoURL holds the value of the "video_url" field.
+	VideoURL *string `json:"video_url,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID *string `json:"file_id,omitempty"`
+	// DurationSeconds holds the value of the "duration_seconds" field.
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// IsPreview holds the value of the "is_preview" field.
+	IsPreview bool `json:"is_preview,omitempty"`
+	// RequiredProgress holds the value of the "required_progress" field.
+	RequiredProgress *float64 `json:"required_progress,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder    int `json:"sort_order,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Lesson) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lesson.FieldIsPreview:
+			values[i] = new(sql.NullBool)
+		case lesson.FieldRequiredProgress:
+			values[i] = new(sql.NullFloat64)
+		case lesson.FieldDurationSeconds, lesson.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case lesson.FieldID, lesson.FieldStatus, lesson.FieldCreatedBy, lesson.FieldUpdatedBy, lesson.FieldInternshipID, lesson.FieldModuleID, lesson.FieldTitle, lesson.FieldDescription, lesson.FieldLessonType, lesson.FieldContent, lesson.FieldVideoURL, lesson.FieldFileID:
+			values[i] = new(sql.NullString)
+		case lesson.FieldCreatedAt, lesson.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Lesson fields.
+func (l *Lesson) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lesson.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				l.ID = value.String
+			}
+		case lesson.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				l.Status = value.String
+			}
+		case lesson.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				l.CreatedAt = value.Time
+			}
+		case lesson.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				l.UpdatedAt = value.Time
+			}
+		case lesson.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				l.CreatedBy = value.String
+			}
+		case lesson.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				l.UpdatedBy = value.String
+			}
+		case lesson.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				l.InternshipID = value.String
+			}
+		case lesson.FieldModuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_id", values[i])
+			} else if value.Valid {
+				l.ModuleID = value.String
+			}
+		case lesson.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				l.Title = value.String
+			}
+		case lesson.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				l.Description = value.String
+			}
+		case lesson.FieldLessonType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lesson_type", values[i])
+			} else if value.Valid {
+				l.LessonType = value.String
+			}
+		case lesson.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				l.Content = value.String
+			}
+		case lesson.FieldVideoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field video_url", values[i])
+			} else if value.Valid {
+				l.VideoURL = new(string)
+				*l.VideoURL = value.String
+			}
+		case lesson.FieldFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				l.FileID = new(string)
+				*l.FileID = value.String
+			}
+		case lesson.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				l.DurationSeconds = int(value.Int64)
+			}
+		case lesson.FieldIsPreview:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_preview", values[i])
+			} else if value.Valid {
+				l.IsPreview = value.Bool
+			}
+		case lesson.FieldRequiredProgress:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_progress", values[i])
+			} else if value.Valid {
+				l.RequiredProgress = new(float64)
+				*l.RequiredProgress = value.Float64
+			}
+		case lesson.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				l.SortOrder = int(value.Int64)
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Lesson.
+// This includes values selected through modifiers, order, etc.
+func (l *Lesson) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Lesson.
+// Note that you need to call Lesson.Unwrap() before calling this method if this Lesson
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Lesson) Update() *LessonUpdateOne {
+	return NewLessonClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Lesson entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Lesson) Unwrap() *Lesson {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Lesson is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Lesson) String() string {
+	var builder strings.Builder
+	builder.WriteString("Lesson(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("status=")
+	builder.WriteString(l.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(l.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(l.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(l.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(l.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(l.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("module_id=")
+	builder.WriteString(l.ModuleID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(l.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(l.Description)
+	builder.WriteString(", ")
+	builder.WriteString("lesson_type=")
+	builder.WriteString(l.LessonType)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(l.Content)
+	builder.WriteString(", ")
+	if v := l.VideoURL; v != nil {
+		builder.WriteString("video_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := l.FileID; v != nil {
+		builder.WriteString("file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", l.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("is_preview=")
+	builder.WriteString(fmt.Sprintf("%v", l.IsPreview))
+	builder.WriteString(", ")
+	if v := l.RequiredProgress; v != nil {
+		builder.WriteString("required_progress=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", l.SortOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Lessons is a parsable slice of Lesson.
+type Lessons []*Lesson
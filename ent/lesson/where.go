@@ -0,0 +1,1160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lesson
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// ModuleID applies equality check predicate on the "module_id" field. It's identical to ModuleIDEQ.
+func ModuleID(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldModuleID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldDescription, v))
+}
+
+// LessonType applies equality check predicate on the "lesson_type" field. It's identical to LessonTypeEQ.
+func LessonType(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldLessonType, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldContent, v))
+}
+
+// VideoURL applies equality check predicate on the "video_url" field. It's identical to VideoURLEQ.
+func VideoURL(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldVideoURL, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldFileID, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// IsPreview applies equality check predicate on the "is_preview" field. It's identical to IsPreviewEQ.
+func IsPreview(v bool) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldIsPreview, v))
+}
+
+// RequiredProgress applies equality check predicate on the "required_progress" field. It's identical to RequiredProgressEQ.
+func RequiredProgress(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldRequiredProgress, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// ModuleIDEQ applies the EQ predicate on the "module_id" field.
+func ModuleIDEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldModuleID, v))
+}
+
+// ModuleIDNEQ applies the NEQ predicate on the "module_id" field.
+func ModuleIDNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldModuleID, v))
+}
+
+// ModuleIDIn applies the In predicate on the "module_id" field.
+func ModuleIDIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldModuleID, vs...))
+}
+
+// ModuleIDNotIn applies the NotIn predicate on the "module_id" field.
+func ModuleIDNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldModuleID, vs...))
+}
+
+// ModuleIDGT applies the GT predicate on the "module_id" field.
+func ModuleIDGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldModuleID, v))
+}
+
+// ModuleIDGTE applies the GTE predicate on the "module_id" field.
+func ModuleIDGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldModuleID, v))
+}
+
+// ModuleIDLT applies the LT predicate on the "module_id" field.
+func ModuleIDLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldModuleID, v))
+}
+
+// ModuleIDLTE applies the LTE predicate on the "module_id" field.
+func ModuleIDLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldModuleID, v))
+}
+
+// ModuleIDContains applies the Contains predicate on the "module_id" field.
+func ModuleIDContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldModuleID, v))
+}
+
+// ModuleIDHasPrefix applies the HasPrefix predicate on the "module_id" field.
+func ModuleIDHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldModuleID, v))
+}
+
+// ModuleIDHasSuffix applies the HasSuffix predicate on the "module_id" field.
+func ModuleIDHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldModuleID, v))
+}
+
+// ModuleIDEqualFold applies the EqualFold predicate on the "module_id" field.
+func ModuleIDEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldModuleID, v))
+}
+
+// ModuleIDContainsFold applies the ContainsFold predicate on the "module_id" field.
+func ModuleIDContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldModuleID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LessonTypeEQ applies the EQ predicate on the "lesson_type" field.
+func LessonTypeEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldLessonType, v))
+}
+
+// LessonTypeNEQ applies the NEQ predicate on the "lesson_type" field.
+func LessonTypeNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldLessonType, v))
+}
+
+// LessonTypeIn applies the In predicate on the "lesson_type" field.
+func LessonTypeIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldLessonType, vs...))
+}
+
+// LessonTypeNotIn applies the NotIn predicate on the "lesson_type" field.
+func LessonTypeNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldLessonType, vs...))
+}
+
+// LessonTypeGT applies the GT predicate on the "lesson_type" field.
+func LessonTypeGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldLessonType, v))
+}
+
+// LessonTypeGTE applies the GTE predicate on the "lesson_type" field.
+func LessonTypeGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldLessonType, v))
+}
+
+// LessonTypeLT applies the LT predicate on the "lesson_type" field.
+func LessonTypeLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldLessonType, v))
+}
+
+// LessonTypeLTE applies the LTE predicate on the "lesson_type" field.
+func LessonTypeLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldLessonType, v))
+}
+
+// LessonTypeContains applies the Contains predicate on the "lesson_type" field.
+func LessonTypeContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldLessonType, v))
+}
+
+// LessonTypeHasPrefix applies the HasPrefix predicate on the "lesson_type" field.
+func LessonTypeHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldLessonType, v))
+}
+
+// LessonTypeHasSuffix applies the HasSuffix predicate on the "lesson_type" field.
+func LessonTypeHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldLessonType, v))
+}
+
+// LessonTypeEqualFold applies the EqualFold predicate on the "lesson_type" field.
+func LessonTypeEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldLessonType, v))
+}
+
+// LessonTypeContainsFold applies the ContainsFold predicate on the "lesson_type" field.
+func LessonTypeContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldLessonType, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldContent, v))
+}
+
+// VideoURLEQ applies the EQ predicate on the "video_url" field.
+func VideoURLEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldVideoURL, v))
+}
+
+// VideoURLNEQ applies the NEQ predicate on the "video_url" field.
+func VideoURLNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldVideoURL, v))
+}
+
+// VideoURLIn applies the In predicate on the "video_url" field.
+func VideoURLIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldVideoURL, vs...))
+}
+
+// VideoURLNotIn applies the NotIn predicate on the "video_url" field.
+func VideoURLNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldVideoURL, vs...))
+}
+
+// VideoURLGT applies the GT predicate on the "video_url" field.
+func VideoURLGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldVideoURL, v))
+}
+
+// VideoURLGTE applies the GTE predicate on the "video_url" field.
+func VideoURLGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldVideoURL, v))
+}
+
+// VideoURLLT applies the LT predicate on the "video_url" field.
+func VideoURLLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldVideoURL, v))
+}
+
+// VideoURLLTE applies the LTE predicate on the "video_url" field.
+func VideoURLLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldVideoURL, v))
+}
+
+// VideoURLContains applies the Contains predicate on the "video_url" field.
+func VideoURLContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldVideoURL, v))
+}
+
+// VideoURLHasPrefix applies the HasPrefix predicate on the "video_url" field.
+func VideoURLHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldVideoURL, v))
+}
+
+// VideoURLHasSuffix applies the HasSuffix predicate on the "video_url" field.
+func VideoURLHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldVideoURL, v))
+}
+
+// VideoURLIsNil applies the IsNil predicate on the "video_url" field.
+func VideoURLIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldVideoURL))
+}
+
+// VideoURLNotNil applies the NotNil predicate on the "video_url" field.
+func VideoURLNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldVideoURL))
+}
+
+// VideoURLEqualFold applies the EqualFold predicate on the "video_url" field.
+func VideoURLEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldVideoURL, v))
+}
+
+// VideoURLContainsFold applies the ContainsFold predicate on the "video_url" field.
+func VideoURLContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldVideoURL, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldFileID, v))
+}
+
+// FileIDContains applies the Contains predicate on the "file_id" field.
+func FileIDContains(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContains(FieldFileID, v))
+}
+
+// FileIDHasPrefix applies the HasPrefix predicate on the "file_id" field.
+func FileIDHasPrefix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasPrefix(FieldFileID, v))
+}
+
+// FileIDHasSuffix applies the HasSuffix predicate on the "file_id" field.
+func FileIDHasSuffix(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldHasSuffix(FieldFileID, v))
+}
+
+// FileIDIsNil applies the IsNil predicate on the "file_id" field.
+func FileIDIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldFileID))
+}
+
+// FileIDNotNil applies the NotNil predicate on the "file_id" field.
+func FileIDNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldFileID))
+}
+
+// FileIDEqualFold applies the EqualFold predicate on the "file_id" field.
+func FileIDEqualFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEqualFold(FieldFileID, v))
+}
+
+// FileIDContainsFold applies the ContainsFold predicate on the "file_id" field.
+func FileIDContainsFold(v string) predicate.Lesson {
+	return predicate.Lesson(sql.FieldContainsFold(FieldFileID, v))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// IsPreviewEQ applies the EQ predicate on the "is_preview" field.
+func IsPreviewEQ(v bool) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldIsPreview, v))
+}
+
+// IsPreviewNEQ applies the NEQ predicate on the "is_preview" field.
+func IsPreviewNEQ(v bool) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldIsPreview, v))
+}
+
+// RequiredProgressEQ applies the EQ predicate on the "required_progress" field.
+func RequiredProgressEQ(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldRequiredProgress, v))
+}
+
+// RequiredProgressNEQ applies the NEQ predicate on the "required_progress" field.
+func RequiredProgressNEQ(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldRequiredProgress, v))
+}
+
+// RequiredProgressIn applies the In predicate on the "required_progress" field.
+func RequiredProgressIn(vs ...float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldRequiredProgress, vs...))
+}
+
+// RequiredProgressNotIn applies the NotIn predicate on the "required_progress" field.
+func RequiredProgressNotIn(vs ...float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldRequiredProgress, vs...))
+}
+
+// RequiredProgressGT applies the GT predicate on the "required_progress" field.
+func RequiredProgressGT(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldRequiredProgress, v))
+}
+
+// RequiredProgressGTE applies the GTE predicate on the "required_progress" field.
+func RequiredProgressGTE(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldRequiredProgress, v))
+}
+
+// RequiredProgressLT applies the LT predicate on the "required_progress" field.
+func RequiredProgressLT(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldRequiredProgress, v))
+}
+
+// RequiredProgressLTE applies the LTE predicate on the "required_progress" field.
+func RequiredProgressLTE(v float64) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldRequiredProgress, v))
+}
+
+// RequiredProgressIsNil applies the IsNil predicate on the "required_progress" field.
+func RequiredProgressIsNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldIsNull(FieldRequiredProgress))
+}
+
+// RequiredProgressNotNil applies the NotNil predicate on the "required_progress" field.
+func RequiredProgressNotNil() predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotNull(FieldRequiredProgress))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.Lesson {
+	return predicate.Lesson(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Lesson) predicate.Lesson {
+	return predicate.Lesson(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Lesson) predicate.Lesson {
+	return predicate.Lesson(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Lesson) predicate.Lesson {
+	return predicate.Lesson(sql.NotPredicates(p))
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/lesson"
+)
+
+// LessonCreate is the builder for creating a Lesson entity.
+type LessonCreate struct {
+	config
+	mutation *LessonMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (lc *LessonCreate) SetStatus(s string) *LessonCreate {
+	lc.mutation.SetStatus(s)
+	return lc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableStatus(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetStatus(*s)
+	}
+	return lc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lc *LessonCreate) SetCreatedAt(t time.Time) *LessonCreate {
+	lc.mutation.SetCreatedAt(t)
+	return lc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableCreatedAt(t *time.Time) *LessonCreate {
+	if t != nil {
+		lc.SetCreatedAt(*t)
+	}
+	return lc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lc *LessonCreate) SetUpdatedAt(t time.Time) *LessonCreate {
+	lc.mutation.SetUpdatedAt(t)
+	return lc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableUpdatedAt(t *time.Time) *LessonCreate {
+	if t != nil {
+		lc.SetUpdatedAt(*t)
+	}
+	return lc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (lc *LessonCreate) SetCreatedBy(s string) *LessonCreate {
+	lc.mutation.SetCreatedBy(s)
+	return lc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableCreatedBy(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetCreatedBy(*s)
+	}
+	return lc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lc *LessonCreate) SetUpdatedBy(s string) *LessonCreate {
+	lc.mutation.SetUpdatedBy(s)
+	return lc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableUpdatedBy(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetUpdatedBy(*s)
+	}
+	return lc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (lc *LessonCreate) SetInternshipID(s string) *LessonCreate {
+	lc.mutation.SetInternshipID(s)
+	return lc
+}
+
+// SetModuleID sets the "module_id" field.
+func (lc *LessonCreate) SetModuleID(s string) *LessonCreate {
+	lc.mutation.SetModuleID(s)
+	return lc
+}
+
+// SetTitle sets the "title" field.
+func (lc *LessonCreate) SetTitle(s string) *LessonCreate {
+	lc.mutation.SetTitle(s)
+	return lc
+}
+
+// SetDescription sets the "description" field.
+func (lc *LessonCreate) SetDescription(s string) *LessonCreate {
+	lc.mutation.SetDescription(s)
+	return lc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableDescription(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetDescription(*s)
+	}
+	return lc
+}
+
+// SetLessonType sets the "lesson_type" field.
+func (lc *LessonCreate) SetLessonType(s string) *LessonCreate {
+	lc.mutation.SetLessonType(s)
+	return lc
+}
+
+// SetContent sets the "content" field.
+func (lc *LessonCreate) SetContent(s string) *LessonCreate {
+	lc.mutation.SetContent(s)
+	return lc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableContent(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetContent(*s)
+	}
+	return lc
+}
+
+// SetVideoURL sets the "video_url" field.
+func (lc *LessonCreate) SetVideoURL(s string) *LessonCreate {
+	lc.mutation.SetVideoURL(s)
+	return lc
+}
+
+// SetNillableVideoURL sets the "video_url" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableVideoURL(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetVideoURL(*s)
+	}
+	return lc
+}
+
+// SetFileID sets the "file_id" field.
+func (lc *LessonCreate) SetFileID(s string) *LessonCreate {
+	lc.mutation.SetFileID(s)
+	return lc
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableFileID(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetFileID(*s)
+	}
+	return lc
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (lc *LessonCreate) SetDurationSeconds(i int) *LessonCreate {
+	lc.mutation.SetDurationSeconds(i)
+	return lc
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableDurationSeconds(i *int) *LessonCreate {
+	if i != nil {
+		lc.SetDurationSeconds(*i)
+	}
+	return lc
+}
+
+// SetIsPreview sets the "is_preview" field.
+func (lc *LessonCreate) SetIsPreview(b bool) *LessonCreate {
+	lc.mutation.SetIsPreview(b)
+	return lc
+}
+
+// SetNillableIsPreview sets the "is_preview" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableIsPreview(b *bool) *LessonCreate {
+	if b != nil {
+		lc.SetIsPreview(*b)
+	}
+	return lc
+}
+
+// SetRequiredProgress sets the "required_progress" field.
+func (lc *LessonCreate) SetRequiredProgress(f float64) *LessonCreate {
+	lc.mutation.SetRequiredProgress(f)
+	return lc
+}
+
+// SetNillableRequiredProgress sets the "required_progress" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableRequiredProgress(f *float64) *LessonCreate {
+	if f != nil {
+		lc.SetRequiredProgress(*f)
+	}
+	return lc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (lc *LessonCreate) SetSortOrder(i int) *LessonCreate {
+	lc.mutation.SetSortOrder(i)
+	return lc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableSortOrder(i *int) *LessonCreate {
+	if i != nil {
+		lc.SetSortOrder(*i)
+	}
+	return lc
+}
+
+// SetID sets the "id" field.
+func (lc *LessonCreate) SetID(s string) *LessonCreate {
+	lc.mutation.SetID(s)
+	return lc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lc *LessonCreate) SetNillableID(s *string) *LessonCreate {
+	if s != nil {
+		lc.SetID(*s)
+	}
+	return lc
+}
+
+// Mutation returns the LessonMutation object of the builder.
+func (lc *LessonCreate) Mutation() *LessonMutation {
+	return lc.mutation
+}
+
+// Save creates the Lesson in the database.
+func (lc *LessonCreate) Save(ctx context.Context) (*Lesson, error) {
+	lc.defaults()
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LessonCreate) SaveX(ctx context.Context) *Lesson {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LessonCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LessonCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *LessonCreate) defaults() {
+	if _, ok := lc.mutation.Status(); !ok {
+		v := lesson.DefaultStatus
+		lc.mutation.SetStatus(v)
+	}
+	if _, ok := lc.mutation.CreatedAt(); !ok {
+		v := lesson.DefaultCreatedAt()
+		lc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lc.mutation.UpdatedAt(); !ok {
+		v := lesson.DefaultUpdatedAt()
+		lc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := lc.mutation.DurationSeconds(); !ok {
+		v := lesson.DefaultDurationSeconds
+		lc.mutation.SetDurationSeconds(v)
+	}
+	if _, ok := lc.mutation.IsPreview(); !ok {
+		v := lesson.DefaultIsPreview
+		lc.mutation.SetIsPreview(v)
+	}
+	if _, ok := lc.mutation.SortOrder(); !ok {
+		v := lesson.DefaultSortOrder
+		lc.mutation.SetSortOrder(v)
+	}
+	if _, ok := lc.mutation.ID(); !ok {
+		v := lesson.DefaultID()
+		lc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LessonCreate) check() error {
+	if _, ok := lc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Lesson.status"`)}
+	}
+	if _, ok := lc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Lesson.created_at"`)}
+	}
+	if _, ok := lc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Lesson.updated_at"`)}
+	}
+	if _, ok := lc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Lesson.internship_id"`)}
+	}
+	if v, ok := lc.mutation.InternshipID(); ok {
+		if err := lesson.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Lesson.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.ModuleID(); !ok {
+		return &ValidationError{Name: "module_id", err: errors.New(`ent: missing required field "Lesson.module_id"`)}
+	}
+	if v, ok := lc.mutation.ModuleID(); ok {
+		if err := lesson.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Lesson.module_id": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Lesson.title"`)}
+	}
+	if v, ok := lc.mutation.Title(); ok {
+		if err := lesson.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Lesson.title": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.LessonType(); !ok {
+		return &ValidationError{Name: "lesson_type", err: errors.New(`ent: missing required field "Lesson.lesson_type"`)}
+	}
+	if v, ok := lc.mutation.LessonType(); ok {
+		if err := lesson.LessonTypeValidator(v); err != nil {
+			return &ValidationError{Name: "lesson_type", err: fmt.Errorf(`ent: validator failed for field "Lesson.lesson_type": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`ent: missing required field "Lesson.duration_seconds"`)}
+	}
+	if v, ok := lc.mutation.DurationSeconds(); ok {
+		if err := lesson.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`ent: validator failed for field "Lesson.duration_seconds": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.IsPreview(); !ok {
+		return &ValidationError{Name: "is_preview", err: errors.New(`ent: missing required field "Lesson.is_preview"`)}
+	}
+	if v, ok := lc.mutation.RequiredProgress(); ok {
+		if err := lesson.RequiredProgressValidator(v); err != nil {
+			return &ValidationError{Name: "required_progress", err: fmt.Errorf(`ent: validator failed for field "Lesson.required_progress": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Lesson.sort_order"`)}
+	}
+	return nil
+}
+
+func (lc *LessonCreate) sqlSave(ctx context.Context) (*Lesson, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Lesson.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *LessonCreate) createSpec() (*Lesson, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Lesson{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(lesson.Table, sqlgraph.NewFieldSpec(lesson.FieldID, field.TypeString))
+	)
+	if id, ok := lc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lc.mutation.Status(); ok {
+		_spec.SetField(lesson.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := lc.mutation.CreatedAt(); ok {
+		_spec.SetField(lesson.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lc.mutation.UpdatedAt(); ok {
+		_spec.SetField(lesson.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := lc.mutation.CreatedBy(); ok {
+		_spec.SetField(lesson.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := lc.mutation.UpdatedBy(); ok {
+		_spec.SetField(lesson.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := lc.mutation.InternshipID(); ok {
+		_spec.SetField(lesson.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := lc.mutation.ModuleID(); ok {
+		_spec.SetField(lesson.FieldModuleID, field.TypeString, value)
+		_node.ModuleID = value
+	}
+	if value, ok := lc.mutation.Title(); ok {
+		_spec.SetField(lesson.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := lc.mutation.Description(); ok {
+		_spec.SetField(lesson.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := lc.mutation.LessonType(); ok {
+		_spec.SetField(lesson.FieldLessonType, field.TypeString, value)
+		_node.LessonType = value
+	}
+	if value, ok := lc.mutation.Content(); ok {
+		_spec.SetField(lesson.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := lc.mutation.VideoURL(); ok {
+		_spec.SetField(lesson.FieldVideoURL, field.TypeString, value)
+		_node.VideoURL = &value
+	}
+	if value, ok := lc.mutation.FileID(); ok {
+		_spec.SetField(lesson.FieldFileID, field.TypeString, value)
+		_node.FileID = &value
+	}
+	if value, ok := lc.mutation.DurationSeconds(); ok {
+		_spec.SetField(lesson.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := lc.mutation.IsPreview(); ok {
+		_spec.SetField(lesson.FieldIsPreview, field.TypeBool, value)
+		_node.IsPreview = value
+	}
+	if value, ok := lc.mutation.RequiredProgress(); ok {
+		_spec.SetField(lesson.FieldRequiredProgress, field.TypeFloat64, value)
+		_node.RequiredProgress = &value
+	}
+	if value, ok := lc.mutation.SortOrder(); ok {
+		_spec.SetField(lesson.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// LessonCreateBulk is the builder for creating many Lesson entities in bulk.
+type LessonCreateBulk struct {
+	config
+	err      error
+	builders []*LessonCreate
+}
+
+// Save creates the Lesson entities in the database.
+func (lcb *LessonCreateBulk) Save(ctx context.Context) ([]*Lesson, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Lesson, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LessonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LessonCreateBulk) SaveX(ctx context.Context) []*Lesson {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LessonCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LessonCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,894 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/lesson"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LessonUpdate is the builder for updating Lesson entities.
+type LessonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LessonMutation
+}
+
+// Where appends a list predicates to the LessonUpdate builder.
+func (lu *LessonUpdate) Where(ps ...predicate.Lesson) *LessonUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetStatus sets the "status" field.
+func (lu *LessonUpdate) SetStatus(s string) *LessonUpdate {
+	lu.mutation.SetStatus(s)
+	return lu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableStatus(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetStatus(*s)
+	}
+	return lu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lu *LessonUpdate) SetUpdatedAt(t time.Time) *LessonUpdate {
+	lu.mutation.SetUpdatedAt(t)
+	return lu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lu *LessonUpdate) SetUpdatedBy(s string) *LessonUpdate {
+	lu.mutation.SetUpdatedBy(s)
+	return lu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableUpdatedBy(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetUpdatedBy(*s)
+	}
+	return lu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (lu *LessonUpdate) ClearUpdatedBy() *LessonUpdate {
+	lu.mutation.ClearUpdatedBy()
+	return lu
+}
+
+// SetModuleID sets the "module_id" field.
+func (lu *LessonUpdate) SetModuleID(s string) *LessonUpdate {
+	lu.mutation.SetModuleID(s)
+	return lu
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableModuleID(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetModuleID(*s)
+	}
+	return lu
+}
+
+// SetTitle sets the "title" field.
+func (lu *LessonUpdate) SetTitle(s string) *LessonUpdate {
+	lu.mutation.SetTitle(s)
+	return lu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableTitle(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetTitle(*s)
+	}
+	return lu
+}
+
+// SetDescription sets the "description" field.
+func (lu *LessonUpdate) SetDescription(s string) *LessonUpdate {
+	lu.mutation.SetDescription(s)
+	return lu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableDescription(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetDescription(*s)
+	}
+	return lu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (lu *LessonUpdate) ClearDescription() *LessonUpdate {
+	lu.mutation.ClearDescription()
+	return lu
+}
+
+// SetLessonType sets the "lesson_type" field.
+func (lu *LessonUpdate) SetLessonType(s string) *LessonUpdate {
+	lu.mutation.SetLessonType(s)
+	return lu
+}
+
+// SetNillableLessonType sets the "lesson_type" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableLessonType(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetLessonType(*s)
+	}
+	return lu
+}
+
+// SetContent sets the "content" field.
+func (lu *LessonUpdate) SetContent(s string) *LessonUpdate {
+	lu.mutation.SetContent(s)
+	return lu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableContent(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetContent(*s)
+	}
+	return lu
+}
+
+// ClearContent clears the value of the "content" field.
+func (lu *LessonUpdate) ClearContent() *LessonUpdate {
+	lu.mutation.ClearContent()
+	return lu
+}
+
+// SetVideoURL sets the "video_url" field.
+func (lu *LessonUpdate) SetVideoURL(s string) *LessonUpdate {
+	lu.mutation.SetVideoURL(s)
+	return lu
+}
+
+// SetNillableVideoURL sets the "video_url" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableVideoURL(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetVideoURL(*s)
+	}
+	return lu
+}
+
+// ClearVideoURL clears the value of the "video_url" field.
+func (lu *LessonUpdate) ClearVideoURL() *LessonUpdate {
+	lu.mutation.ClearVideoURL()
+	return lu
+}
+
+// SetFileID sets the "file_id" field.
+func (lu *LessonUpdate) SetFileID(s string) *LessonUpdate {
+	lu.mutation.SetFileID(s)
+	return lu
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableFileID(s *string) *LessonUpdate {
+	if s != nil {
+		lu.SetFileID(*s)
+	}
+	return lu
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (lu *LessonUpdate) ClearFileID() *LessonUpdate {
+	lu.mutation.ClearFileID()
+	return lu
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (lu *LessonUpdate) SetDurationSeconds(i int) *LessonUpdate {
+	lu.mutation.ResetDurationSeconds()
+	lu.mutation.SetDurationSeconds(i)
+	return lu
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableDurationSeconds(i *int) *LessonUpdate {
+	if i != nil {
+		lu.SetDurationSeconds(*i)
+	}
+	return lu
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (lu *LessonUpdate) AddDurationSeconds(i int) *LessonUpdate {
+	lu.mutation.AddDurationSeconds(i)
+	return lu
+}
+
+// SetIsPreview sets the "is_preview" field.
+func (lu *LessonUpdate) SetIsPreview(b bool) *LessonUpdate {
+	lu.mutation.SetIsPreview(b)
+	return lu
+}
+
+// SetNillableIsPreview sets the "is_preview" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableIsPreview(b *bool) *LessonUpdate {
+	if b != nil {
+		lu.SetIsPreview(*b)
+	}
+	return lu
+}
+
+// SetRequiredProgress sets the "required_progress" field.
+func (lu *LessonUpdate) SetRequiredProgress(f float64) *LessonUpdate {
+	lu.mutation.ResetRequiredProgress()
+	lu.mutation.SetRequiredProgress(f)
+	return lu
+}
+
+// SetNillableRequiredProgress sets the "required_progress" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableRequiredProgress(f *float64) *LessonUpdate {
+	if f != nil {
+		lu.SetRequiredProgress(*f)
+	}
+	return lu
+}
+
+// AddRequiredProgress adds f to the "required_progress" field.
+func (lu *LessonUpdate) AddRequiredProgress(f float64) *LessonUpdate {
+	lu.mutation.AddRequiredProgress(f)
+	return lu
+}
+
+// ClearRequiredProgress clears the value of the "required_progress" field.
+func (lu *LessonUpdate) ClearRequiredProgress() *LessonUpdate {
+	lu.mutation.ClearRequiredProgress()
+	return lu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (lu *LessonUpdate) SetSortOrder(i int) *LessonUpdate {
+	lu.mutation.ResetSortOrder()
+	lu.mutation.SetSortOrder(i)
+	return lu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (lu *LessonUpdate) SetNillableSortOrder(i *int) *LessonUpdate {
+	if i != nil {
+		lu.SetSortOrder(*i)
+	}
+	return lu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (lu *LessonUpdate) AddSortOrder(i int) *LessonUpdate {
+	lu.mutation.AddSortOrder(i)
+	return lu
+}
+
+// Mutation returns the LessonMutation object of the builder.
+func (lu *LessonUpdate) Mutation() *LessonMutation {
+	return lu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LessonUpdate) Save(ctx context.Context) (int, error) {
+	lu.defaults()
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LessonUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LessonUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LessonUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lu *LessonUpdate) defaults() {
+	if _, ok := lu.mutation.UpdatedAt(); !ok {
+		v := lesson.UpdateDefaultUpdatedAt()
+		lu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LessonUpdate) check() error {
+	if v, ok := lu.mutation.ModuleID(); ok {
+		if err := lesson.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Lesson.module_id": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.Title(); ok {
+		if err := lesson.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Lesson.title": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.LessonType(); ok {
+		if err := lesson.LessonTypeValidator(v); err != nil {
+			return &ValidationError{Name: "lesson_type", err: fmt.Errorf(`ent: validator failed for field "Lesson.lesson_type": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.DurationSeconds(); ok {
+		if err := lesson.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`ent: validator failed for field "Lesson.duration_seconds": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.RequiredProgress(); ok {
+		if err := lesson.RequiredProgressValidator(v); err != nil {
+			return &ValidationError{Name: "required_progress", err: fmt.Errorf(`ent: validator failed for field "Lesson.required_progress": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LessonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lesson.Table, lesson.Columns, sqlgraph.NewFieldSpec(lesson.FieldID, field.TypeString))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Status(); ok {
+		_spec.SetField(lesson.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.UpdatedAt(); ok {
+		_spec.SetField(lesson.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if lu.mutation.CreatedByCleared() {
+		_spec.ClearField(lesson.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := lu.mutation.UpdatedBy(); ok {
+		_spec.SetField(lesson.FieldUpdatedBy, field.TypeString, value)
+	}
+	if lu.mutation.UpdatedByCleared() {
+		_spec.ClearField(lesson.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := lu.mutation.ModuleID(); ok {
+		_spec.SetField(lesson.FieldModuleID, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Title(); ok {
+		_spec.SetField(lesson.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Description(); ok {
+		_spec.SetField(lesson.FieldDescription, field.TypeString, value)
+	}
+	if lu.mutation.DescriptionCleared() {
+		_spec.ClearField(lesson.FieldDescription, field.TypeString)
+	}
+	if value, ok := lu.mutation.LessonType(); ok {
+		_spec.SetField(lesson.FieldLessonType, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Content(); ok {
+		_spec.SetField(lesson.FieldContent, field.TypeString, value)
+	}
+	if lu.mutation.ContentCleared() {
+		_spec.ClearField(lesson.FieldContent, field.TypeString)
+	}
+	if value, ok := lu.mutation.VideoURL(); ok {
+		_spec.SetField(lesson.FieldVideoURL, field.TypeString, value)
+	}
+	if lu.mutation.VideoURLCleared() {
+		_spec.ClearField(lesson.FieldVideoURL, field.TypeString)
+	}
+	if value, ok := lu.mutation.FileID(); ok {
+		_spec.SetField(lesson.FieldFileID, field.TypeString, value)
+	}
+	if lu.mutation.FileIDCleared() {
+		_spec.ClearField(lesson.FieldFileID, field.TypeString)
+	}
+	if value, ok := lu.mutation.DurationSeconds(); ok {
+		_spec.SetField(lesson.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(lesson.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.IsPreview(); ok {
+		_spec.SetField(lesson.FieldIsPreview, field.TypeBool, value)
+	}
+	if value, ok := lu.mutation.RequiredProgress(); ok {
+		_spec.SetField(lesson.FieldRequiredProgress, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.AddedRequiredProgress(); ok {
+		_spec.AddField(lesson.FieldRequiredProgress, field.TypeFloat64, value)
+	}
+	if lu.mutation.RequiredProgressCleared() {
+		_spec.ClearField(lesson.FieldRequiredProgress, field.TypeFloat64)
+	}
+	if value, ok := lu.mutation.SortOrder(); ok {
+		_spec.SetField(lesson.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedSortOrder(); ok {
+		_spec.AddField(lesson.FieldSortOrder, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lesson.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LessonUpdateOne is the builder for updating a single Lesson entity.
+type LessonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LessonMutation
+}
+
+// SetStatus sets the "status" field.
+func (luo *LessonUpdateOne) SetStatus(s string) *LessonUpdateOne {
+	luo.mutation.SetStatus(s)
+	return luo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableStatus(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetStatus(*s)
+	}
+	return luo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (luo *LessonUpdateOne) SetUpdatedAt(t time.Time) *LessonUpdateOne {
+	luo.mutation.SetUpdatedAt(t)
+	return luo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (luo *LessonUpdateOne) SetUpdatedBy(s string) *LessonUpdateOne {
+	luo.mutation.SetUpdatedBy(s)
+	return luo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableUpdatedBy(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetUpdatedBy(*s)
+	}
+	return luo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (luo *LessonUpdateOne) ClearUpdatedBy() *LessonUpdateOne {
+	luo.mutation.ClearUpdatedBy()
+	return luo
+}
+
+// SetModuleID sets the "module_id" field.
+func (luo *LessonUpdateOne) SetModuleID(s string) *LessonUpdateOne {
+	luo.mutation.SetModuleID(s)
+	return luo
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableModuleID(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetModuleID(*s)
+	}
+	return luo
+}
+
+// SetTitle sets the "title" field.
+func (luo *LessonUpdateOne) SetTitle(s string) *LessonUpdateOne {
+	luo.mutation.SetTitle(s)
+	return luo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableTitle(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetTitle(*s)
+	}
+	return luo
+}
+
+// SetDescription sets the "description" field.
+func (luo *LessonUpdateOne) SetDescription(s string) *LessonUpdateOne {
+	luo.mutation.SetDescription(s)
+	return luo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableDescription(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetDescription(*s)
+	}
+	return luo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (luo *LessonUpdateOne) ClearDescription() *LessonUpdateOne {
+	luo.mutation.ClearDescription()
+	return luo
+}
+
+// SetLessonType sets the "lesson_type" field.
+func (luo *LessonUpdateOne) SetLessonType(s string) *LessonUpdateOne {
+	luo.mutation.SetLessonType(s)
+	return luo
+}
+
+// SetNillableLessonType sets the "lesson_type" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableLessonType(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetLessonType(*s)
+	}
+	return luo
+}
+
+// SetContent sets the "content" field.
+func (luo *LessonUpdateOne) SetContent(s string) *LessonUpdateOne {
+	luo.mutation.SetContent(s)
+	return luo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableContent(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetContent(*s)
+	}
+	return luo
+}
+
+// ClearContent clears the value of the "content" field.
+func (luo *LessonUpdateOne) ClearContent() *LessonUpdateOne {
+	luo.mutation.ClearContent()
+	return luo
+}
+
+// SetVideoURL sets the "video_url" field.
+func (luo *LessonUpdateOne) SetVideoURL(s string) *LessonUpdateOne {
+	luo.mutation.SetVideoURL(s)
+	return luo
+}
+
+// SetNillableVideoURL sets the "video_url" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableVideoURL(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetVideoURL(*s)
+	}
+	return luo
+}
+
+// ClearVideoURL clears the value of the "video_url" field.
+func (luo *LessonUpdateOne) ClearVideoURL() *LessonUpdateOne {
+	luo.mutation.ClearVideoURL()
+	return luo
+}
+
+// SetFileID sets the "file_id" field.
+func (luo *LessonUpdateOne) SetFileID(s string) *LessonUpdateOne {
+	luo.mutation.SetFileID(s)
+	return luo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableFileID(s *string) *LessonUpdateOne {
+	if s != nil {
+		luo.SetFileID(*s)
+	}
+	return luo
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (luo *LessonUpdateOne) ClearFileID() *LessonUpdateOne {
+	luo.mutation.ClearFileID()
+	return luo
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (luo *LessonUpdateOne) SetDurationSeconds(i int) *LessonUpdateOne {
+	luo.mutation.ResetDurationSeconds()
+	luo.mutation.SetDurationSeconds(i)
+	return luo
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableDurationSeconds(i *int) *LessonUpdateOne {
+	if i != nil {
+		luo.SetDurationSeconds(*i)
+	}
+	return luo
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (luo *LessonUpdateOne) AddDurationSeconds(i int) *LessonUpdateOne {
+	luo.mutation.AddDurationSeconds(i)
+	return luo
+}
+
+// SetIsPreview sets the "is_preview" field.
+func (luo *LessonUpdateOne) SetIsPreview(b bool) *LessonUpdateOne {
+	luo.mutation.SetIsPreview(b)
+	return luo
+}
+
+// SetNillableIsPreview sets the "is_preview" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableIsPreview(b *bool) *LessonUpdateOne {
+	if b != nil {
+		luo.SetIsPreview(*b)
+	}
+	return luo
+}
+
+// SetRequiredProgress sets the "required_progress" field.
+func (luo *LessonUpdateOne) SetRequiredProgress(f float64) *LessonUpdateOne {
+	luo.mutation.ResetRequiredProgress()
+	luo.mutation.SetRequiredProgress(f)
+	return luo
+}
+
+// SetNillableRequiredProgress sets the "required_progress" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableRequiredProgress(f *float64) *LessonUpdateOne {
+	if f != nil {
+		luo.SetRequiredProgress(*f)
+	}
+	return luo
+}
+
+// AddRequiredProgress adds f to the "required_progress" field.
+func (luo *LessonUpdateOne) AddRequiredProgress(f float64) *LessonUpdateOne {
+	luo.mutation.AddRequiredProgress(f)
+	return luo
+}
+
+// ClearRequiredProgress clears the value of the "required_progress" field.
+func (luo *LessonUpdateOne) ClearRequiredProgress() *LessonUpdateOne {
+	luo.mutation.ClearRequiredProgress()
+	return luo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (luo *LessonUpdateOne) SetSortOrder(i int) *LessonUpdateOne {
+	luo.mutation.ResetSortOrder()
+	luo.mutation.SetSortOrder(i)
+	return luo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (luo *LessonUpdateOne) SetNillableSortOrder(i *int) *LessonUpdateOne {
+	if i != nil {
+		luo.SetSortOrder(*i)
+	}
+	return luo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (luo *LessonUpdateOne) AddSortOrder(i int) *LessonUpdateOne {
+	luo.mutation.AddSortOrder(i)
+	return luo
+}
+
+// Mutation returns the LessonMutation object of the builder.
+func (luo *LessonUpdateOne) Mutation() *LessonMutation {
+	return luo.mutation
+}
+
+// Where appends a list predicates to the LessonUpdate builder.
+func (luo *LessonUpdateOne) Where(ps ...predicate.Lesson) *LessonUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LessonUpdateOne) Select(field string, fields ...string) *LessonUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Lesson entity.
+func (luo *LessonUpdateOne) Save(ctx context.Context) (*Lesson, error) {
+	luo.defaults()
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LessonUpdateOne) SaveX(ctx context.Context) *Lesson {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LessonUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LessonUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (luo *LessonUpdateOne) defaults() {
+	if _, ok := luo.mutation.UpdatedAt(); !ok {
+		v := lesson.UpdateDefaultUpdatedAt()
+		luo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LessonUpdateOne) check() error {
+	if v, ok := luo.mutation.ModuleID(); ok {
+		if err := lesson.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Lesson.module_id": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.Title(); ok {
+		if err := lesson.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Lesson.title": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.LessonType(); ok {
+		if err := lesson.LessonTypeValidator(v); err != nil {
+			return &ValidationError{Name: "lesson_type", err: fmt.Errorf(`ent: validator failed for field "Lesson.lesson_type": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.DurationSeconds(); ok {
+		if err := lesson.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`ent: validator failed for field "Lesson.duration_seconds": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.RequiredProgress(); ok {
+		if err := lesson.RequiredProgressValidator(v); err != nil {
+			return &ValidationError{Name: "required_progress", err: fmt.Errorf(`ent: validator failed for field "Lesson.required_progress": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LessonUpdateOne) sqlSave(ctx context.Context) (_node *Lesson, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lesson.Table, lesson.Columns, sqlgraph.NewFieldSpec(lesson.FieldID, field.TypeString))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Lesson.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lesson.FieldID)
+		for _, f := range fields {
+			if !lesson.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lesson.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Status(); ok {
+		_spec.SetField(lesson.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.UpdatedAt(); ok {
+		_spec.SetField(lesson.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if luo.mutation.CreatedByCleared() {
+		_spec.ClearField(lesson.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := luo.mutation.UpdatedBy(); ok {
+		_spec.SetField(lesson.FieldUpdatedBy, field.TypeString, value)
+	}
+	if luo.mutation.UpdatedByCleared() {
+		_spec.ClearField(lesson.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := luo.mutation.ModuleID(); ok {
+		_spec.SetField(lesson.FieldModuleID, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Title(); ok {
+		_spec.SetField(lesson.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Description(); ok {
+		_spec.SetField(lesson.FieldDescription, field.TypeString, value)
+	}
+	if luo.mutation.DescriptionCleared() {
+		_spec.ClearField(lesson.FieldDescription, field.TypeString)
+	}
+	if value, ok := luo.mutation.LessonType(); ok {
+		_spec.SetField(lesson.FieldLessonType, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Content(); ok {
+		_spec.SetField(lesson.FieldContent, field.TypeString, value)
+	}
+	if luo.mutation.ContentCleared() {
+		_spec.ClearField(lesson.FieldContent, field.TypeString)
+	}
+	if value, ok := luo.mutation.VideoURL(); ok {
+		_spec.SetField(lesson.FieldVideoURL, field.TypeString, value)
+	}
+	if luo.mutation.VideoURLCleared() {
+		_spec.ClearField(lesson.FieldVideoURL, field.TypeString)
+	}
+	if value, ok := luo.mutation.FileID(); ok {
+		_spec.SetField(lesson.FieldFileID, field.TypeString, value)
+	}
+	if luo.mutation.FileIDCleared() {
+		_spec.ClearField(lesson.FieldFileID, field.TypeString)
+	}
+	if value, ok := luo.mutation.DurationSeconds(); ok {
+		_spec.SetField(lesson.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(lesson.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.IsPreview(); ok {
+		_spec.SetField(lesson.FieldIsPreview, field.TypeBool, value)
+	}
+	if value, ok := luo.mutation.RequiredProgress(); ok {
+		_spec.SetField(lesson.FieldRequiredProgress, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedRequiredProgress(); ok {
+		_spec.AddField(lesson.FieldRequiredProgress, field.TypeFloat64, value)
+	}
+	if luo.mutation.RequiredProgressCleared() {
+		_spec.ClearField(lesson.FieldRequiredProgress, field.TypeFloat64)
+	}
+	if value, ok := luo.mutation.SortOrder(); ok {
+		_spec.SetField(lesson.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(lesson.FieldSortOrder, field.TypeInt, value)
+	}
+	_node = &Lesson{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lesson.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+)
+
+// LessonProgress is the model entity for the LessonProgress schema.
+type LessonProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// LessonID holds the value of the "lesson_id" field.
+	LessonID string `json:"lesson_id,omitempty"`
+	// PositionSeconds holds the value of the "position_seconds" field.
+	PositionSeconds int `json:"position_seconds,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LessonProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lessonprogress.FieldPositionSeconds:
+			values[i] = new(sql.NullInt64)
+		case lessonprogress.FieldID, lessonprogress.FieldStatus, lessonprogress.FieldCreatedBy, lessonprogress.FieldUpdatedBy, lessonprogress.FieldUserID, lessonprogress.FieldInternshipID, lessonprogress.FieldLessonID:
+			values[i] = new(sql.NullString)
+		case lessonprogress.FieldCreatedAt, lessonprogress.FieldUpdatedAt, lessonprogress.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LessonProgress fields.
+func (lp *LessonProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lessonprogress.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				lp.ID = value.String
+			}
+		case lessonprogress.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				lp.Status = value.String
+			}
+		case lessonprogress.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lp.CreatedAt = value.Time
+			}
+		case lessonprogress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				lp.UpdatedAt = value.Time
+			}
+		case lessonprogress.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				lp.CreatedBy = value.String
+			}
+		case lessonprogress.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				lp.UpdatedBy = value.String
+			}
+		case lessonprogress.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				lp.UserID = value.String
+			}
+		case lessonprogress.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				lp.InternshipID = value.String
+			}
+		case lessonprogress.FieldLessonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lesson_id", values[i])
+			} else if value.Valid {
+				lp.LessonID = value.String
+			}
+		case lessonprogress.FieldPositionSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position_seconds", values[i])
+			} else if value.Valid {
+				lp.PositionSeconds = int(value.Int64)
+			}
+		case lessonprogress.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				lp.CompletedAt = new(time.Time)
+				*lp.CompletedAt = value.Time
+			}
+		default:
+			lp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LessonProgress.
+// This includes values selected through modifiers, order, etc.
+func (lp *LessonProgress) Value(name string) (ent.Value, error) {
+	return lp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LessonProgress.
+// Note that you need to call LessonProgress.Unwrap() before calling this method if this LessonProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lp *LessonProgress) Update() *LessonProgressUpdateOne {
+	return NewLessonProgressClient(lp.config).UpdateOne(lp)
+}
+
+// Unwrap unwraps the LessonProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lp *LessonProgress) Unwrap() *LessonProgress {
+	_tx, ok := lp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LessonProgress is not a transactional entity")
+	}
+	lp.config.driver = _tx.drv
+	return lp
+}
+
+// String implements the fmt.Stringer.
+func (lp *LessonProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("LessonProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lp.ID))
+	builder.WriteString("status=")
+	builder.WriteString(lp.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(lp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(lp.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(lp.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(lp.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(lp.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("lesson_id=")
+	builder.WriteString(lp.LessonID)
+	builder.WriteString(", ")
+	builder.WriteString("position_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", lp.PositionSeconds))
+	builder.WriteString(", ")
+	if v := lp.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LessonProgresses is a parsable slice of LessonProgress.
+type LessonProgresses []*LessonProgress
@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lessonprogress
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldUserID, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// LessonID applies equality check predicate on the "lesson_id" field. It's identical to LessonIDEQ.
+func LessonID(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldLessonID, v))
+}
+
+// PositionSeconds applies equality check predicate on the "position_seconds" field. It's identical to PositionSecondsEQ.
+func PositionSeconds(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldPositionSeconds, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// LessonIDEQ applies the EQ predicate on the "lesson_id" field.
+func LessonIDEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldLessonID, v))
+}
+
+// LessonIDNEQ applies the NEQ predicate on the "lesson_id" field.
+func LessonIDNEQ(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldLessonID, v))
+}
+
+// LessonIDIn applies the In predicate on the "lesson_id" field.
+func LessonIDIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldLessonID, vs...))
+}
+
+// LessonIDNotIn applies the NotIn predicate on the "lesson_id" field.
+func LessonIDNotIn(vs ...string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldLessonID, vs...))
+}
+
+// LessonIDGT applies the GT predicate on the "lesson_id" field.
+func LessonIDGT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldLessonID, v))
+}
+
+// LessonIDGTE applies the GTE predicate on the "lesson_id" field.
+func LessonIDGTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldLessonID, v))
+}
+
+// LessonIDLT applies the LT predicate on the "lesson_id" field.
+func LessonIDLT(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldLessonID, v))
+}
+
+// LessonIDLTE applies the LTE predicate on the "lesson_id" field.
+func LessonIDLTE(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldLessonID, v))
+}
+
+// LessonIDContains applies the Contains predicate on the "lesson_id" field.
+func LessonIDContains(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContains(FieldLessonID, v))
+}
+
+// LessonIDHasPrefix applies the HasPrefix predicate on the "lesson_id" field.
+func LessonIDHasPrefix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasPrefix(FieldLessonID, v))
+}
+
+// LessonIDHasSuffix applies the HasSuffix predicate on the "lesson_id" field.
+func LessonIDHasSuffix(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldHasSuffix(FieldLessonID, v))
+}
+
+// LessonIDEqualFold applies the EqualFold predicate on the "lesson_id" field.
+func LessonIDEqualFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEqualFold(FieldLessonID, v))
+}
+
+// LessonIDContainsFold applies the ContainsFold predicate on the "lesson_id" field.
+func LessonIDContainsFold(v string) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldContainsFold(FieldLessonID, v))
+}
+
+// PositionSecondsEQ applies the EQ predicate on the "position_seconds" field.
+func PositionSecondsEQ(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldPositionSeconds, v))
+}
+
+// PositionSecondsNEQ applies the NEQ predicate on the "position_seconds" field.
+func PositionSecondsNEQ(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldPositionSeconds, v))
+}
+
+// PositionSecondsIn applies the In predicate on the "position_seconds" field.
+func PositionSecondsIn(vs ...int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldPositionSeconds, vs...))
+}
+
+// PositionSecondsNotIn applies the NotIn predicate on the "position_seconds" field.
+func PositionSecondsNotIn(vs ...int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldPositionSeconds, vs...))
+}
+
+// PositionSecondsGT applies the GT predicate on the "position_seconds" field.
+func PositionSecondsGT(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldPositionSeconds, v))
+}
+
+// PositionSecondsGTE applies the GTE predicate on the "position_seconds" field.
+func PositionSecondsGTE(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldPositionSeconds, v))
+}
+
+// PositionSecondsLT applies the LT predicate on the "position_seconds" field.
+func PositionSecondsLT(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldPositionSeconds, v))
+}
+
+// PositionSecondsLTE applies the LTE predicate on the "position_seconds" field.
+func PositionSecondsLTE(v int) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldPositionSeconds, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.LessonProgress {
+	return predicate.LessonProgress(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LessonProgress) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LessonProgress) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LessonProgress) predicate.LessonProgress {
+	return predicate.LessonProgress(sql.NotPredicates(p))
+}
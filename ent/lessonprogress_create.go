@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+)
+
+// LessonProgressCreate is the builder for creating a LessonProgress entity.
+type LessonProgressCreate struct {
+	config
+	mutation *LessonProgressMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (lpc *LessonProgressCreate) SetStatus(s string) *LessonProgressCreate {
+	lpc.mutation.SetStatus(s)
+	return lpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableStatus(s *string) *LessonProgressCreate {
+	if s != nil {
+		lpc.SetStatus(*s)
+	}
+	return lpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lpc *LessonProgressCreate) SetCreatedAt(t time.Time) *LessonProgressCreate {
+	lpc.mutation.SetCreatedAt(t)
+	return lpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableCreatedAt(t *time.Time) *LessonProgressCreate {
+	if t != nil {
+		lpc.SetCreatedAt(*t)
+	}
+	return lpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lpc *LessonProgressCreate) SetUpdatedAt(t time.Time) *LessonProgressCreate {
+	lpc.mutation.SetUpdatedAt(t)
+	return lpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableUpdatedAt(t *time.Time) *LessonProgressCreate {
+	if t != nil {
+		lpc.SetUpdatedAt(*t)
+	}
+	return lpc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (lpc *LessonProgressCreate) SetCreatedBy(s string) *LessonProgressCreate {
+	lpc.mutation.SetCreatedBy(s)
+	return lpc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableCreatedBy(s *string) *LessonProgressCreate {
+	if s != nil {
+		lpc.SetCreatedBy(*s)
+	}
+	return lpc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lpc *LessonProgressCreate) SetUpdatedBy(s string) *LessonProgressCreate {
+	lpc.mutation.SetUpdatedBy(s)
+	return lpc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableUpdatedBy(s *string) *LessonProgressCreate {
+	if s != nil {
+		lpc.SetUpdatedBy(*s)
+	}
+	return lpc
+}
+
+// SetUserID sets the "user_id" field.
+func (lpc *LessonProgressCreate) SetUserID(s string) *LessonProgressCreate {
+	lpc.mutation.SetUserID(s)
+	return lpc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (lpc *LessonProgressCreate) SetInternshipID(s string) *LessonProgressCreate {
+	lpc.mutation.SetInternshipID(s)
+	return lpc
+}
+
+// SetLessonID sets the "lesson_id" field.
+func (lpc *LessonProgressCreate) SetLessonID(s string) *LessonProgressCreate {
+	lpc.mutation.SetLessonID(s)
+	return lpc
+}
+
+// SetPositionSeconds sets the "position_seconds" field.
+func (lpc *LessonProgressCreate) SetPositionSeconds(i int) *LessonProgressCreate {
+	lpc.mutation.SetPositionSeconds(i)
+	return lpc
+}
+
+// SetNillablePositionSeconds sets the "position_seconds" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillablePositionSeconds(i *int) *LessonProgressCreate {
+	if i != nil {
+		lpc.SetPositionSeconds(*i)
+	}
+	return lpc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (lpc *LessonProgressCreate) SetCompletedAt(t time.Time) *LessonProgressCreate {
+	lpc.mutation.SetCompletedAt(t)
+	return lpc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableCompletedAt(t *time.Time) *LessonProgressCreate {
+	if t != nil {
+		lpc.SetCompletedAt(*t)
+	}
+	return lpc
+}
+
+// SetID sets the "id" field.
+func (lpc *LessonProgressCreate) SetID(s string) *LessonProgressCreate {
+	lpc.mutation.SetID(s)
+	return lpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lpc *LessonProgressCreate) SetNillableID(s *string) *LessonProgressCreate {
+	if s != nil {
+		lpc.SetID(*s)
+	}
+	return lpc
+}
+
+// Mutation returns the LessonProgressMutation object of the builder.
+func (lpc *LessonProgressCreate) Mutation() *LessonProgressMutation {
+	return lpc.mutation
+}
+
+// Save creates the LessonProgress in the database.
+func (lpc *LessonProgressCreate) Save(ctx context.Context) (*LessonProgress, error) {
+	lpc.defaults()
+	return withHooks(ctx, lpc.sqlSave, lpc.mutation, lpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lpc *LessonProgressCreate) SaveX(ctx context.Context) *LessonProgress {
+	v, err := lpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lpc *LessonProgressCreate) Exec(ctx context.Context) error {
+	_, err := lpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpc *LessonProgressCreate) ExecX(ctx context.Context) {
+	if err := lpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpc *LessonProgressCreate) defaults() {
+	if _, ok := lpc.mutation.Status(); !ok {
+		v := lessonprogress.DefaultStatus
+		lpc.mutation.SetStatus(v)
+	}
+	if _, ok := lpc.mutation.CreatedAt(); !ok {
+		v := lessonprogress.DefaultCreatedAt()
+		lpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lpc.mutation.UpdatedAt(); !ok {
+		v := lessonprogress.DefaultUpdatedAt()
+		lpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := lpc.mutation.PositionSeconds(); !ok {
+		v := lessonprogress.DefaultPositionSeconds
+		lpc.mutation.SetPositionSeconds(v)
+	}
+	if _, ok := lpc.mutation.ID(); !ok {
+		v := lessonprogress.DefaultID()
+		lpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpc *LessonProgressCreate) check() error {
+	if _, ok := lpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LessonProgress.status"`)}
+	}
+	if _, ok := lpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LessonProgress.created_at"`)}
+	}
+	if _, ok := lpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LessonProgress.updated_at"`)}
+	}
+	if _, ok := lpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LessonProgress.user_id"`)}
+	}
+	if v, ok := lpc.mutation.UserID(); ok {
+		if err := lessonprogress.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LessonProgress.user_id": %w`, err)}
+		}
+	}
+	if _, ok := lpc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "LessonProgress.internship_id"`)}
+	}
+	if v, ok := lpc.mutation.InternshipID(); ok {
+		if err := lessonprogress.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "LessonProgress.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := lpc.mutation.LessonID(); !ok {
+		return &ValidationError{Name: "lesson_id", err: errors.New(`ent: missing required field "LessonProgress.lesson_id"`)}
+	}
+	if v, ok := lpc.mutation.LessonID(); ok {
+		if err := lessonprogress.LessonIDValidator(v); err != nil {
+			return &ValidationError{Name: "lesson_id", err: fmt.Errorf(`ent: validator failed for field "LessonProgress.lesson_id": %w`, err)}
+		}
+	}
+	if _, ok := lpc.mutation.PositionSeconds(); !ok {
+		return &ValidationError{Name: "position_seconds", err: errors.New(`ent: missing required field "LessonProgress.position_seconds"`)}
+	}
+	if v, ok := lpc.mutation.PositionSeconds(); ok {
+		if err := lessonprogress.PositionSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "position_seconds", err: fmt.Errorf(`ent: validator failed for field "LessonProgress.position_seconds": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lpc *LessonProgressCreate) sqlSave(ctx context.Context) (*LessonProgress, error) {
+	if err := lpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LessonProgress.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lpc.mutation.id = &_node.ID
+	lpc.mutation.done = true
+	return _node, nil
+}
+
+func (lpc *LessonProgressCreate) createSpec() (*LessonProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LessonProgress{config: lpc.config}
+		_spec = sqlgraph.NewCreateSpec(lessonprogress.Table, sqlgraph.NewFieldSpec(lessonprogress.FieldID, field.TypeString))
+	)
+	if id, ok := lpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lpc.mutation.Status(); ok {
+		_spec.SetField(lessonprogress.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := lpc.mutation.CreatedAt(); ok {
+		_spec.SetField(lessonprogress.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(lessonprogress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := lpc.mutation.CreatedBy(); ok {
+		_spec.SetField(lessonprogress.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := lpc.mutation.UpdatedBy(); ok {
+		_spec.SetField(lessonprogress.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := lpc.mutation.UserID(); ok {
+		_spec.SetField(lessonprogress.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := lpc.mutation.InternshipID(); ok {
+		_spec.SetField(lessonprogress.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := lpc.mutation.LessonID(); ok {
+		_spec.SetField(lessonprogress.FieldLessonID, field.TypeString, value)
+		_node.LessonID = value
+	}
+	if value, ok := lpc.mutation.PositionSeconds(); ok {
+		_spec.SetField(lessonprogress.FieldPositionSeconds, field.TypeInt, value)
+		_node.PositionSeconds = value
+	}
+	if value, ok := lpc.mutation.CompletedAt(); ok {
+		_spec.SetField(lessonprogress.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// LessonProgressCreateBulk is the builder for creating many LessonProgress entities in bulk.
+type LessonProgressCreateBulk struct {
+	config
+	err      error
+	builders []*LessonProgressCreate
+}
+
+// Save creates the LessonProgress entities in the database.
+func (lpcb *LessonProgressCreateBulk) Save(ctx context.Context) ([]*LessonProgress, error) {
+	if lpcb.err != nil {
+		return nil, lpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lpcb.builders))
+	nodes := make([]*LessonProgress, len(lpcb.builders))
+	mutators := make([]Mutator, len(lpcb.builders))
+	for i := range lpcb.builders {
+		func(i int, root context.Context) {
+			builder := lpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LessonProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpcb *LessonProgressCreateBulk) SaveX(ctx context.Context) []*LessonProgress {
+	v, err := lpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lpcb *LessonProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := lpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpcb *LessonProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := lpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LessonProgressDelete is the builder for deleting a LessonProgress entity.
+type LessonProgressDelete struct {
+	config
+	hooks    []Hook
+	mutation *LessonProgressMutation
+}
+
+// Where appends a list predicates to the LessonProgressDelete builder.
+func (lpd *LessonProgressDelete) Where(ps ...predicate.LessonProgress) *LessonProgressDelete {
+	lpd.mutation.Where(ps...)
+	return lpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lpd *LessonProgressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lpd.sqlExec, lpd.mutation, lpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpd *LessonProgressDelete) ExecX(ctx context.Context) int {
+	n, err := lpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lpd *LessonProgressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(lessonprogress.Table, sqlgraph.NewFieldSpec(lessonprogress.FieldID, field.TypeString))
+	if ps := lpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lpd.mutation.done = true
+	return affected, err
+}
+
+// LessonProgressDeleteOne is the builder for deleting a single LessonProgress entity.
+type LessonProgressDeleteOne struct {
+	lpd *LessonProgressDelete
+}
+
+// Where appends a list predicates to the LessonProgressDelete builder.
+func (lpdo *LessonProgressDeleteOne) Where(ps ...predicate.LessonProgress) *LessonProgressDeleteOne {
+	lpdo.lpd.mutation.Where(ps...)
+	return lpdo
+}
+
+// Exec executes the deletion query.
+func (lpdo *LessonProgressDeleteOne) Exec(ctx context.Context) error {
+	n, err := lpdo.lpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{lessonprogress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpdo *LessonProgressDeleteOne) ExecX(ctx context.Context) {
+	if err := lpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
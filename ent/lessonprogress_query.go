@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LessonProgressQuery is the builder for querying LessonProgress entities.
+type LessonProgressQuery struct {
+	config
+	ctx        *QueryContext
+	order      []lessonprogress.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LessonProgress
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LessonProgressQuery builder.
+func (lpq *LessonProgressQuery) Where(ps ...predicate.LessonProgress) *LessonProgressQuery {
+	lpq.predicates = append(lpq.predicates, ps...)
+	return lpq
+}
+
+// Limit the number of records to be returned by this query.
+func (lpq *LessonProgressQuery) Limit(limit int) *LessonProgressQuery {
+	lpq.ctx.Limit = &limit
+	return lpq
+}
+
+// Offset to start from.
+func (lpq *LessonProgressQuery) Offset(offset int) *LessonProgressQuery {
+	lpq.ctx.Offset = &offset
+	return lpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lpq *LessonProgressQuery) Unique(unique bool) *LessonProgressQuery {
+	lpq.ctx.Unique = &unique
+	return lpq
+}
+
+// Order specifies how the records should be ordered.
+func (lpq *LessonProgressQuery) Order(o ...lessonprogress.OrderOption) *LessonProgressQuery {
+	lpq.order = append(lpq.order, o...)
+	return lpq
+}
+
+// First returns the first LessonProgress entity from the query.
+// Returns a *NotFoundError when no LessonProgress was found.
+func (lpq *LessonProgressQuery) First(ctx context.Context) (*LessonProgress, error) {
+	nodes, err := lpq.Limit(1).All(setContextOp(ctx, lpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{lessonprogress.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lpq *LessonProgressQuery) FirstX(ctx context.Context) *LessonProgress {
+	node, err := lpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LessonProgress ID from the query.
+// Returns a *NotFoundError when no LessonProgress ID was found.
+func (lpq *LessonProgressQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lpq.Limit(1).IDs(setContextOp(ctx, lpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{lessonprogress.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lpq *LessonProgressQuery) FirstIDX(ctx context.Context) string {
+	id, err := lpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LessonProgress entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LessonProgress entity is found.
+// Returns a *NotFoundError when no LessonProgress entities are found.
+func (lpq *LessonProgressQuery) Only(ctx context.Context) (*LessonProgress, error) {
+	nodes, err := lpq.Limit(2).All(setContextOp(ctx, lpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{lessonprogress.Label}
+	default:
+		return nil, &NotSingularError{lessonprogress.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lpq *LessonProgressQuery) OnlyX(ctx context.Context) *LessonProgress {
+	node, err := lpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LessonProgress ID in the query.
+// Returns a *NotSingularError when more than one LessonProgress ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lpq *LessonProgressQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lpq.Limit(2).IDs(setContextOp(ctx, lpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{lessonprogress.Label}
+	default:
+		err = &NotSingularError{lessonprogress.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lpq *LessonProgressQuery) OnlyIDX(ctx context.Context) string {
+	id, err := lpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LessonProgresses.
+func (lpq *LessonProgressQuery) All(ctx context.Context) ([]*LessonProgress, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryAll)
+	if err := lpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LessonProgress, *LessonProgressQuery]()
+	return withInterceptors[[]*LessonProgress](ctx, lpq, qr, lpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lpq *LessonProgressQuery) AllX(ctx context.Context) []*LessonProgress {
+	nodes, err := lpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LessonProgress IDs.
+func (lpq *LessonProgressQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if lpq.ctx.Unique == nil && lpq.path != nil {
+		lpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryIDs)
+	if err = lpq.Select(lessonprogress.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lpq *LessonProgressQuery) IDsX(ctx context.Context) []string {
+	ids, err := lpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lpq *LessonProgressQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryCount)
+	if err := lpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lpq, querierCount[*LessonProgressQuery](), lpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lpq *LessonProgressQuery) CountX(ctx context.Context) int {
+	count, err := lpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lpq *LessonProgressQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryExist)
+	switch _, err := lpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lpq *LessonProgressQuery) ExistX(ctx context.Context) bool {
+	exist, err := lpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LessonProgressQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lpq *LessonProgressQuery) Clone() *LessonProgressQuery {
+	if lpq == nil {
+		return nil
+	}
+	return &LessonProgressQuery{
+		config:     lpq.config,
+		ctx:        lpq.ctx.Clone(),
+		order:      append([]lessonprogress.OrderOption{}, lpq.order...),
+		inters:     append([]Interceptor{}, lpq.inters...),
+		predicates: append([]predicate.LessonProgress{}, lpq.predicates...),
+		// clone intermediate query.
+		sql:  lpq.sql.Clone(),
+		path: lpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LessonProgress.Query().
+//		GroupBy(lessonprogress.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lpq *LessonProgressQuery) GroupBy(field string, fields ...string) *LessonProgressGroupBy {
+	lpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LessonProgressGroupBy{build: lpq}
+	grbuild.flds = &lpq.ctx.Fields
+	grbuild.label = lessonprogress.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.LessonProgress.Query().
+//		Select(lessonprogress.FieldStatus).
+//		Scan(ctx, &v)
+func (lpq *LessonProgressQuery) Select(fields ...string) *LessonProgressSelect {
+	lpq.ctx.Fields = append(lpq.ctx.Fields, fields...)
+	sbuild := &LessonProgressSelect{LessonProgressQuery: lpq}
+	sbuild.label = lessonprogress.Label
+	sbuild.flds, sbuild.scan = &lpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LessonProgressSelect configured with the given aggregations.
+func (lpq *LessonProgressQuery) Aggregate(fns ...AggregateFunc) *LessonProgressSelect {
+	return lpq.Select().Aggregate(fns...)
+}
+
+func (lpq *LessonProgressQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lpq.ctx.Fields {
+		if !lessonprogress.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lpq.path != nil {
+		prev, err := lpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lpq.sql = prev
+	}
+	return nil
+}
+
+func (lpq *LessonProgressQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LessonProgress, error) {
+	var (
+		nodes = []*LessonProgress{}
+		_spec = lpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LessonProgress).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LessonProgress{config: lpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lpq *LessonProgressQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lpq.querySpec()
+	_spec.Node.Columns = lpq.ctx.Fields
+	if len(lpq.ctx.Fields) > 0 {
+		_spec.Unique = lpq.ctx.Unique != nil && *lpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lpq.driver, _spec)
+}
+
+func (lpq *LessonProgressQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(lessonprogress.Table, lessonprogress.Columns, sqlgraph.NewFieldSpec(lessonprogress.FieldID, field.TypeString))
+	_spec.From = lpq.sql
+	if unique := lpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lessonprogress.FieldID)
+		for i := range fields {
+			if fields[i] != lessonprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lpq *LessonProgressQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lpq.driver.Dialect())
+	t1 := builder.Table(lessonprogress.Table)
+	columns := lpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = lessonprogress.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lpq.sql != nil {
+		selector = lpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lpq.ctx.Unique != nil && *lpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lpq.predicates {
+		p(selector)
+	}
+	for _, p := range lpq.order {
+		p(selector)
+	}
+	if offset := lpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LessonProgressGroupBy is the group-by builder for LessonProgress entities.
+type LessonProgressGroupBy struct {
+	selector
+	build *LessonProgressQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lpgb *LessonProgressGroupBy) Aggregate(fns ...AggregateFunc) *LessonProgressGroupBy {
+	lpgb.fns = append(lpgb.fns, fns...)
+	return lpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lpgb *LessonProgressGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LessonProgressQuery, *LessonProgressGroupBy](ctx, lpgb.build, lpgb, lpgb.build.inters, v)
+}
+
+func (lpgb *LessonProgressGroupBy) sqlScan(ctx context.Context, root *LessonProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lpgb.fns))
+	for _, fn := range lpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lpgb.flds)+len(lpgb.fns))
+		for _, f := range *lpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LessonProgressSelect is the builder for selecting fields of LessonProgress entities.
+type LessonProgressSelect struct {
+	*LessonProgressQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lps *LessonProgressSelect) Aggregate(fns ...AggregateFunc) *LessonProgressSelect {
+	lps.fns = append(lps.fns, fns...)
+	return lps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lps *LessonProgressSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lps.ctx, ent.OpQuerySelect)
+	if err := lps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LessonProgressQuery, *LessonProgressSelect](ctx, lps.LessonProgressQuery, lps, lps.inters, v)
+}
+
+func (lps *LessonProgressSelect) sqlScan(ctx context.Context, root *LessonProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lps.fns))
+	for _, fn := range lps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LessonProgressUpdate is the builder for updating LessonProgress entities.
+type LessonProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LessonProgressMutation
+}
+
+// Where appends a list predicates to the LessonProgressUpdate builder.
+func (lpu *LessonProgressUpdate) Where(ps ...predicate.LessonProgress) *LessonProgressUpdate {
+	lpu.mutation.Where(ps...)
+	return lpu
+}
+
+// SetStatus sets the "status" field.
+func (lpu *LessonProgressUpdate) SetStatus(s string) *LessonProgressUpdate {
+	lpu.mutation.SetStatus(s)
+	return lpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lpu *LessonProgressUpdate) SetNillableStatus(s *string) *LessonProgressUpdate {
+	if s != nil {
+		lpu.SetStatus(*s)
+	}
+	return lpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lpu *LessonProgressUpdate) SetUpdatedAt(t time.Time) *LessonProgressUpdate {
+	lpu.mutation.SetUpdatedAt(t)
+	return lpu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lpu *LessonProgressUpdate) SetUpdatedBy(s string) *LessonProgressUpdate {
+	lpu.mutation.SetUpdatedBy(s)
+	return lpu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lpu *LessonProgressUpdate) SetNillableUpdatedBy(s *string) *LessonProgressUpdate {
+	if s != nil {
+		lpu.SetUpdatedBy(*s)
+	}
+	return lpu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (lpu *LessonProgressUpdate) ClearUpdatedBy() *LessonProgressUpdate {
+	lpu.mutation.ClearUpdatedBy()
+	return lpu
+}
+
+// SetPositionSeconds sets the "position_seconds" field.
+func (lpu *LessonProgressUpdate) SetPositionSeconds(i int) *LessonProgressUpdate {
+	lpu.mutation.ResetPositionSeconds()
+	lpu.mutation.SetPositionSeconds(i)
+	return lpu
+}
+
+// SetNillablePositionSeconds sets the "position_seconds" field if the given value is not nil.
+func (lpu *LessonProgressUpdate) SetNillablePositionSeconds(i *int) *LessonProgressUpdate {
+	if i != nil {
+		lpu.SetPositionSeconds(*i)
+	}
+	return lpu
+}
+
+// AddPositionSeconds adds i to the "position_seconds" field.
+func (lpu *LessonProgressUpdate) AddPositionSeconds(i int) *LessonProgressUpdate {
+	lpu.mutation.AddPositionSeconds(i)
+	return lpu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (lpu *LessonProgressUpdate) SetCompletedAt(t time.Time) *LessonProgressUpdate {
+	lpu.mutation.SetCompletedAt(t)
+	return lpu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (lpu *LessonProgressUpdate) SetNillableCompletedAt(t *time.Time) *LessonProgressUpdate {
+	if t != nil {
+		lpu.SetCompletedAt(*t)
+	}
+	return lpu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (lpu *LessonProgressUpdate) ClearCompletedAt() *LessonProgressUpdate {
+	lpu.mutation.ClearCompletedAt()
+	return lpu
+}
+
+// Mutation returns the LessonProgressMutation object of the builder.
+func (lpu *LessonProgressUpdate) Mutation() *LessonProgressMutation {
+	return lpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lpu *LessonProgressUpdate) Save(ctx context.Context) (int, error) {
+	lpu.defaults()
+	return withHooks(ctx, lpu.sqlSave, lpu.mutation, lpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpu *LessonProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := lpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lpu *LessonProgressUpdate) Exec(ctx context.Context) error {
+	_, err := lpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpu *LessonProgressUpdate) ExecX(ctx context.Context) {
+	if err := lpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpu *LessonProgressUpdate) defaults() {
+	if _, ok := lpu.mutation.UpdatedAt(); !ok {
+		v := lessonprogress.UpdateDefaultUpdatedAt()
+		lpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpu *LessonProgressUpdate) check() error {
+	if v, ok := lpu.mutation.PositionSeconds(); ok {
+		if err := lessonprogress.PositionSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "position_seconds", err: fmt.Errorf(`ent: validator failed for field "LessonProgress.position_seconds": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lpu *LessonProgressUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lessonprogress.Table, lessonprogress.Columns, sqlgraph.NewFieldSpec(lessonprogress.FieldID, field.TypeString))
+	if ps := lpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lpu.mutation.Status(); ok {
+		_spec.SetField(lessonprogress.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := lpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(lessonprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if lpu.mutation.CreatedByCleared() {
+		_spec.ClearField(lessonprogress.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := lpu.mutation.UpdatedBy(); ok {
+		_spec.SetField(lessonprogress.FieldUpdatedBy, field.TypeString, value)
+	}
+	if lpu.mutation.UpdatedByCleared() {
+		_spec.ClearField(lessonprogress.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := lpu.mutation.PositionSeconds(); ok {
+		_spec.SetField(lessonprogress.FieldPositionSeconds, field.TypeInt, value)
+	}
+	if value, ok := lpu.mutation.AddedPositionSeconds(); ok {
+		_spec.AddField(lessonprogress.FieldPositionSeconds, field.TypeInt, value)
+	}
+	if value, ok := lpu.mutation.CompletedAt(); ok {
+		_spec.SetField(lessonprogress.FieldCompletedAt, field.TypeTime, value)
+	}
+	if lpu.mutation.CompletedAtCleared() {
+		_spec.ClearField(lessonprogress.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lessonprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lpu.mutation.done = true
+	return n, nil
+}
+
+// LessonProgressUpdateOne is the builder for updating a single LessonProgress entity.
+type LessonProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LessonProgressMutation
+}
+
+// SetStatus sets the "status" field.
+func (lpuo *LessonProgressUpdateOne) SetStatus(s string) *LessonProgressUpdateOne {
+	lpuo.mutation.SetStatus(s)
+	return lpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lpuo *LessonProgressUpdateOne) SetNillableStatus(s *string) *LessonProgressUpdateOne {
+	if s != nil {
+		lpuo.SetStatus(*s)
+	}
+	return lpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lpuo *LessonProgressUpdateOne) SetUpdatedAt(t time.Time) *LessonProgressUpdateOne {
+	lpuo.mutation.SetUpdatedAt(t)
+	return lpuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lpuo *LessonProgressUpdateOne) SetUpdatedBy(s string) *LessonProgressUpdateOne {
+	lpuo.mutation.SetUpdatedBy(s)
+	return lpuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lpuo *LessonProgressUpdateOne) SetNillableUpdatedBy(s *string) *LessonProgressUpdateOne {
+	if s != nil {
+		lpuo.SetUpdatedBy(*s)
+	}
+	return lpuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (lpuo *LessonProgressUpdateOne) ClearUpdatedBy() *LessonProgressUpdateOne {
+	lpuo.mutation.ClearUpdatedBy()
+	return lpuo
+}
+
+// SetPositionSeconds sets the "position_seconds" field.
+func (lpuo *LessonProgressUpdateOne) SetPositionSeconds(i int) *LessonProgressUpdateOne {
+	lpuo.mutation.ResetPositionSeconds()
+	lpuo.mutation.SetPositionSeconds(i)
+	return lpuo
+}
+
+// SetNillablePositionSeconds sets the "position_seconds" field if the given value is not nil.
+func (lpuo *LessonProgressUpdateOne) SetNillablePositionSeconds(i *int) *LessonProgressUpdateOne {
+	if i != nil {
+		lpuo.SetPositionSeconds(*i)
+	}
+	return lpuo
+}
+
+// AddPositionSeconds adds i to the "position_seconds" field.
+func (lpuo *LessonProgressUpdateOne) AddPositionSeconds(i int) *LessonProgressUpdateOne {
+	lpuo.mutation.AddPositionSeconds(i)
+	return lpuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (lpuo *LessonProgressUpdateOne) SetCompletedAt(t time.Time) *LessonProgressUpdateOne {
+	lpuo.mutation.SetCompletedAt(t)
+	return lpuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (lpuo *LessonProgressUpdateOne) SetNillableCompletedAt(t *time.Time) *LessonProgressUpdateOne {
+	if t != nil {
+		lpuo.SetCompletedAt(*t)
+	}
+	return lpuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (lpuo *LessonProgressUpdateOne) ClearCompletedAt() *LessonProgressUpdateOne {
+	lpuo.mutation.ClearCompletedAt()
+	return lpuo
+}
+
+// Mutation returns the LessonProgressMutation object of the builder.
+func (lpuo *LessonProgressUpdateOne) Mutation() *LessonProgressMutation {
+	return lpuo.mutation
+}
+
+// Where appends a list predicates to the LessonProgressUpdate builder.
+func (lpuo *LessonProgressUpdateOne) Where(ps ...predicate.LessonProgress) *LessonProgressUpdateOne {
+	lpuo.mutation.Where(ps...)
+	return lpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lpuo *LessonProgressUpdateOne) Select(field string, fields ...string) *LessonProgressUpdateOne {
+	lpuo.fields = append([]string{field}, fields...)
+	return lpuo
+}
+
+// Save executes the query and returns the updated LessonProgress entity.
+func (lpuo *LessonProgressUpdateOne) Save(ctx context.Context) (*LessonProgress, error) {
+	lpuo.defaults()
+	return withHooks(ctx, lpuo.sqlSave, lpuo.mutation, lpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpuo *LessonProgressUpdateOne) SaveX(ctx context.Context) *LessonProgress {
+	node, err := lpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lpuo *LessonProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := lpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpuo *LessonProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := lpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpuo *LessonProgressUpdateOne) defaults() {
+	if _, ok := lpuo.mutation.UpdatedAt(); !ok {
+		v := lessonprogress.UpdateDefaultUpdatedAt()
+		lpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpuo *LessonProgressUpdateOne) check() error {
+	if v, ok := lpuo.mutation.PositionSeconds(); ok {
+		if err := lessonprogress.PositionSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "position_seconds", err: fmt.Errorf(`ent: validator failed for field "LessonProgress.position_seconds": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lpuo *LessonProgressUpdateOne) sqlSave(ctx context.Context) (_node *LessonProgress, err error) {
+	if err := lpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lessonprogress.Table, lessonprogress.Columns, sqlgraph.NewFieldSpec(lessonprogress.FieldID, field.TypeString))
+	id, ok := lpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LessonProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lessonprogress.FieldID)
+		for _, f := range fields {
+			if !lessonprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lessonprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lpuo.mutation.Status(); ok {
+		_spec.SetField(lessonprogress.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := lpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(lessonprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if lpuo.mutation.CreatedByCleared() {
+		_spec.ClearField(lessonprogress.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := lpuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(lessonprogress.FieldUpdatedBy, field.TypeString, value)
+	}
+	if lpuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(lessonprogress.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := lpuo.mutation.PositionSeconds(); ok {
+		_spec.SetField(lessonprogress.FieldPositionSeconds, field.TypeInt, value)
+	}
+	if value, ok := lpuo.mutation.AddedPositionSeconds(); ok {
+		_spec.AddField(lessonprogress.FieldPositionSeconds, field.TypeInt, value)
+	}
+	if value, ok := lpuo.mutation.CompletedAt(); ok {
+		_spec.SetField(lessonprogress.FieldCompletedAt, field.TypeTime, value)
+	}
+	if lpuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(lessonprogress.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &LessonProgress{config: lpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lessonprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/livesession"
+)
+
+// LiveSession is the model entity for the LiveSession schema.
+type LiveSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// InternshipBatchID holds the value of the "internship_batch_id" field.
+	InternshipBatchID string `json:"internship_batch_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// MeetingURL holds the value of the "meeting_url" field.
+	MeetingURL string `json:"meeting_url,omitempty"`
+	// RecordingFileID holds the value of the "recording_file_id" field.
+	RecordingFileID *string `json:"recording_file_id,omitempty"`
+	// RecordingURL holds the value of the "recording_url" field.
+	RecordingURL *string `json:"recording_url,omitempty"`
+	// SessionStatus holds the value of the "session_status" field.
+	SessionStatus string `json:"session_status,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt  *time.Time `json:"cancelled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LiveSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case livesession.FieldID, livesession.FieldStatus, livesession.FieldCreatedBy, livesession.FieldUpdatedBy, livesession.FieldInternshipID, livesession.FieldInternshipBatchID, livesession.FieldTitle, livesession.FieldDescription, livesession.FieldMeetingURL, livesession.FieldRecordingFileID, livesession.FieldRecordingURL, livesession.FieldSessionStatus:
+			values[i] = new(sql.NullString)
+		case livesession.FieldCreatedAt, livesession.FieldUpdatedAt, livesession.FieldStartsAt, livesession.FieldEndsAt, livesession.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LiveSession fields.
+func (ls *LiveSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case livesession.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ls.ID = value.String
+			}
+		case livesession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ls.Status = value.String
+			}
+		case livesession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ls.CreatedAt = value.Time
+			}
+		case livesession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ls.UpdatedAt = value.Time
+			}
+		case livesession.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ls.CreatedBy = value.String
+			}
+		case livesession.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ls.UpdatedBy = value.String
+			}
+		case livesession.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				ls.InternshipID = value.String
+			}
+		case livesession.FieldInternshipBatchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_batch_id", values[i])
+			} else if value.Valid {
+				ls.InternshipBatchID = value.String
+			}
+		case livesession.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				ls.Title = value.String
+			}
+		case livesession.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ls.Description = value.String
+			}
+		case livesession.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				ls.StartsAt = value.Time
+			}
+		case livesession.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				ls.EndsAt = value.Time
+			}
+		case livesession.FieldMeetingURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meeting_url", values[i])
+			} else if value.Valid {
+				ls.MeetingURL = value.String
+			}
+		case livesession.FieldRecordingFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recording_file_id", values[i])
+			} else if value.Valid {
+				ls.RecordingFileID = new(string)
+				*ls.RecordingFileID = value.String
+			}
+		case livesession.FieldRecordingURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recording_url", values[i])
+			} else if value.Valid {
+				ls.RecordingURL = new(string)
+				*ls.RecordingURL = value.String
+			}
+		case livesession.FieldSessionStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_status", values[i])
+			} else if value.Valid {
+				ls.SessionStatus = value.String
+			}
+		case livesession.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				ls.CancelledAt = new(time.Time)
+				*ls.CancelledAt = value.Time
+			}
+		default:
+			ls.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LiveSession.
+// This includes values selected through modifiers, order, etc.
+func (ls *LiveSession) Value(name string) (ent.Value, error) {
+	return ls.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LiveSession.
+// Note that you need to call LiveSession.Unwrap() before calling this method if this LiveSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ls *LiveSession) Update() *LiveSessionUpdateOne {
+	return NewLiveSessionClient(ls.config).UpdateOne(ls)
+}
+
+// Unwrap unwraps the LiveSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ls *LiveSession) Unwrap() *LiveSession {
+	_tx, ok := ls.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LiveSession is not a transactional entity")
+	}
+	ls.config.driver = _tx.drv
+	return ls
+}
+
+// String implements the fmt.Stringer.
+func (ls *LiveSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("LiveSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ls.ID))
+	builder.WriteString("status=")
+	builder.WriteString(ls.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ls.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ls.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ls.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ls.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(ls.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_batch_id=")
+	builder.WriteString(ls.InternshipBatchID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(ls.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ls.Description)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(ls.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(ls.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("meeting_url=")
+	builder.WriteString(ls.MeetingURL)
+	builder.WriteString(", ")
+	if v := ls.RecordingFileID; v != nil {
+		builder.WriteString("recording_file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ls.RecordingURL; v != nil {
+		builder.WriteString("recording_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("session_status=")
+	builder.WriteString(ls.SessionStatus)
+	builder.WriteString(", ")
+	if v := ls.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LiveSessions is a parsable slice of LiveSession.
+type LiveSessions []*LiveSession
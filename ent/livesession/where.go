@@ -0,0 +1,1145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package livesession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipBatchID applies equality check predicate on the "internship_batch_id" field. It's identical to InternshipBatchIDEQ.
+func InternshipBatchID(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldDescription, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// MeetingURL applies equality check predicate on the "meeting_url" field. It's identical to MeetingURLEQ.
+func MeetingURL(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldMeetingURL, v))
+}
+
+// RecordingFileID applies equality check predicate on the "recording_file_id" field. It's identical to RecordingFileIDEQ.
+func RecordingFileID(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldRecordingFileID, v))
+}
+
+// RecordingURL applies equality check predicate on the "recording_url" field. It's identical to RecordingURLEQ.
+func RecordingURL(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldRecordingURL, v))
+}
+
+// SessionStatus applies equality check predicate on the "session_status" field. It's identical to SessionStatusEQ.
+func SessionStatus(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldSessionStatus, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// InternshipBatchIDEQ applies the EQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDNEQ applies the NEQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDIn applies the In predicate on the "internship_batch_id" field.
+func InternshipBatchIDIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDNotIn applies the NotIn predicate on the "internship_batch_id" field.
+func InternshipBatchIDNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDGT applies the GT predicate on the "internship_batch_id" field.
+func InternshipBatchIDGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDGTE applies the GTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLT applies the LT predicate on the "internship_batch_id" field.
+func InternshipBatchIDLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLTE applies the LTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContains applies the Contains predicate on the "internship_batch_id" field.
+func InternshipBatchIDContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasPrefix applies the HasPrefix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasSuffix applies the HasSuffix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDEqualFold applies the EqualFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContainsFold applies the ContainsFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldInternshipBatchID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// MeetingURLEQ applies the EQ predicate on the "meeting_url" field.
+func MeetingURLEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldMeetingURL, v))
+}
+
+// MeetingURLNEQ applies the NEQ predicate on the "meeting_url" field.
+func MeetingURLNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldMeetingURL, v))
+}
+
+// MeetingURLIn applies the In predicate on the "meeting_url" field.
+func MeetingURLIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldMeetingURL, vs...))
+}
+
+// MeetingURLNotIn applies the NotIn predicate on the "meeting_url" field.
+func MeetingURLNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldMeetingURL, vs...))
+}
+
+// MeetingURLGT applies the GT predicate on the "meeting_url" field.
+func MeetingURLGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldMeetingURL, v))
+}
+
+// MeetingURLGTE applies the GTE predicate on the "meeting_url" field.
+func MeetingURLGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldMeetingURL, v))
+}
+
+// MeetingURLLT applies the LT predicate on the "meeting_url" field.
+func MeetingURLLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldMeetingURL, v))
+}
+
+// MeetingURLLTE applies the LTE predicate on the "meeting_url" field.
+func MeetingURLLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldMeetingURL, v))
+}
+
+// MeetingURLContains applies the Contains predicate on the "meeting_url" field.
+func MeetingURLContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldMeetingURL, v))
+}
+
+// MeetingURLHasPrefix applies the HasPrefix predicate on the "meeting_url" field.
+func MeetingURLHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldMeetingURL, v))
+}
+
+// MeetingURLHasSuffix applies the HasSuffix predicate on the "meeting_url" field.
+func MeetingURLHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldMeetingURL, v))
+}
+
+// MeetingURLIsNil applies the IsNil predicate on the "meeting_url" field.
+func MeetingURLIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldMeetingURL))
+}
+
+// MeetingURLNotNil applies the NotNil predicate on the "meeting_url" field.
+func MeetingURLNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldMeetingURL))
+}
+
+// MeetingURLEqualFold applies the EqualFold predicate on the "meeting_url" field.
+func MeetingURLEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldMeetingURL, v))
+}
+
+// MeetingURLContainsFold applies the ContainsFold predicate on the "meeting_url" field.
+func MeetingURLContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldMeetingURL, v))
+}
+
+// RecordingFileIDEQ applies the EQ predicate on the "recording_file_id" field.
+func RecordingFileIDEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDNEQ applies the NEQ predicate on the "recording_file_id" field.
+func RecordingFileIDNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDIn applies the In predicate on the "recording_file_id" field.
+func RecordingFileIDIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldRecordingFileID, vs...))
+}
+
+// RecordingFileIDNotIn applies the NotIn predicate on the "recording_file_id" field.
+func RecordingFileIDNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldRecordingFileID, vs...))
+}
+
+// RecordingFileIDGT applies the GT predicate on the "recording_file_id" field.
+func RecordingFileIDGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDGTE applies the GTE predicate on the "recording_file_id" field.
+func RecordingFileIDGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDLT applies the LT predicate on the "recording_file_id" field.
+func RecordingFileIDLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDLTE applies the LTE predicate on the "recording_file_id" field.
+func RecordingFileIDLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDContains applies the Contains predicate on the "recording_file_id" field.
+func RecordingFileIDContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDHasPrefix applies the HasPrefix predicate on the "recording_file_id" field.
+func RecordingFileIDHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDHasSuffix applies the HasSuffix predicate on the "recording_file_id" field.
+func RecordingFileIDHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDIsNil applies the IsNil predicate on the "recording_file_id" field.
+func RecordingFileIDIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldRecordingFileID))
+}
+
+// RecordingFileIDNotNil applies the NotNil predicate on the "recording_file_id" field.
+func RecordingFileIDNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldRecordingFileID))
+}
+
+// RecordingFileIDEqualFold applies the EqualFold predicate on the "recording_file_id" field.
+func RecordingFileIDEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldRecordingFileID, v))
+}
+
+// RecordingFileIDContainsFold applies the ContainsFold predicate on the "recording_file_id" field.
+func RecordingFileIDContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldRecordingFileID, v))
+}
+
+// RecordingURLEQ applies the EQ predicate on the "recording_url" field.
+func RecordingURLEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldRecordingURL, v))
+}
+
+// RecordingURLNEQ applies the NEQ predicate on the "recording_url" field.
+func RecordingURLNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldRecordingURL, v))
+}
+
+// RecordingURLIn applies the In predicate on the "recording_url" field.
+func RecordingURLIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldRecordingURL, vs...))
+}
+
+// RecordingURLNotIn applies the NotIn predicate on the "recording_url" field.
+func RecordingURLNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldRecordingURL, vs...))
+}
+
+// RecordingURLGT applies the GT predicate on the "recording_url" field.
+func RecordingURLGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldRecordingURL, v))
+}
+
+// RecordingURLGTE applies the GTE predicate on the "recording_url" field.
+func RecordingURLGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldRecordingURL, v))
+}
+
+// RecordingURLLT applies the LT predicate on the "recording_url" field.
+func RecordingURLLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldRecordingURL, v))
+}
+
+// RecordingURLLTE applies the LTE predicate on the "recording_url" field.
+func RecordingURLLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldRecordingURL, v))
+}
+
+// RecordingURLContains applies the Contains predicate on the "recording_url" field.
+func RecordingURLContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldRecordingURL, v))
+}
+
+// RecordingURLHasPrefix applies the HasPrefix predicate on the "recording_url" field.
+func RecordingURLHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldRecordingURL, v))
+}
+
+// RecordingURLHasSuffix applies the HasSuffix predicate on the "recording_url" field.
+func RecordingURLHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldRecordingURL, v))
+}
+
+// RecordingURLIsNil applies the IsNil predicate on the "recording_url" field.
+func RecordingURLIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldRecordingURL))
+}
+
+// RecordingURLNotNil applies the NotNil predicate on the "recording_url" field.
+func RecordingURLNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldRecordingURL))
+}
+
+// RecordingURLEqualFold applies the EqualFold predicate on the "recording_url" field.
+func RecordingURLEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldRecordingURL, v))
+}
+
+// RecordingURLContainsFold applies the ContainsFold predicate on the "recording_url" field.
+func RecordingURLContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldRecordingURL, v))
+}
+
+// SessionStatusEQ applies the EQ predicate on the "session_status" field.
+func SessionStatusEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldSessionStatus, v))
+}
+
+// SessionStatusNEQ applies the NEQ predicate on the "session_status" field.
+func SessionStatusNEQ(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldSessionStatus, v))
+}
+
+// SessionStatusIn applies the In predicate on the "session_status" field.
+func SessionStatusIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldSessionStatus, vs...))
+}
+
+// SessionStatusNotIn applies the NotIn predicate on the "session_status" field.
+func SessionStatusNotIn(vs ...string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldSessionStatus, vs...))
+}
+
+// SessionStatusGT applies the GT predicate on the "session_status" field.
+func SessionStatusGT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldSessionStatus, v))
+}
+
+// SessionStatusGTE applies the GTE predicate on the "session_status" field.
+func SessionStatusGTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldSessionStatus, v))
+}
+
+// SessionStatusLT applies the LT predicate on the "session_status" field.
+func SessionStatusLT(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldSessionStatus, v))
+}
+
+// SessionStatusLTE applies the LTE predicate on the "session_status" field.
+func SessionStatusLTE(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldSessionStatus, v))
+}
+
+// SessionStatusContains applies the Contains predicate on the "session_status" field.
+func SessionStatusContains(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContains(FieldSessionStatus, v))
+}
+
+// SessionStatusHasPrefix applies the HasPrefix predicate on the "session_status" field.
+func SessionStatusHasPrefix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasPrefix(FieldSessionStatus, v))
+}
+
+// SessionStatusHasSuffix applies the HasSuffix predicate on the "session_status" field.
+func SessionStatusHasSuffix(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldHasSuffix(FieldSessionStatus, v))
+}
+
+// SessionStatusEqualFold applies the EqualFold predicate on the "session_status" field.
+func SessionStatusEqualFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEqualFold(FieldSessionStatus, v))
+}
+
+// SessionStatusContainsFold applies the ContainsFold predicate on the "session_status" field.
+func SessionStatusContainsFold(v string) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldContainsFold(FieldSessionStatus, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.LiveSession {
+	return predicate.LiveSession(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LiveSession) predicate.LiveSession {
+	return predicate.LiveSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LiveSession) predicate.LiveSession {
+	return predicate.LiveSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LiveSession) predicate.LiveSession {
+	return predicate.LiveSession(sql.NotPredicates(p))
+}
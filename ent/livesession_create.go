@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/livesession"
+)
+
+// LiveSessionCreate is the builder for creating a LiveSession entity.
+type LiveSessionCreate struct {
+	config
+	mutation *LiveSessionMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (lsc *LiveSessionCreate) SetStatus(s string) *LiveSessionCreate {
+	lsc.mutation.SetStatus(s)
+	return lsc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableStatus(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetStatus(*s)
+	}
+	return lsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lsc *LiveSessionCreate) SetCreatedAt(t time.Time) *LiveSessionCreate {
+	lsc.mutation.SetCreatedAt(t)
+	return lsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableCreatedAt(t *time.Time) *LiveSessionCreate {
+	if t != nil {
+		lsc.SetCreatedAt(*t)
+	}
+	return lsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lsc *LiveSessionCreate) SetUpdatedAt(t time.Time) *LiveSessionCreate {
+	lsc.mutation.SetUpdatedAt(t)
+	return lsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableUpdatedAt(t *time.Time) *LiveSessionCreate {
+	if t != nil {
+		lsc.SetUpdatedAt(*t)
+	}
+	return lsc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (lsc *LiveSessionCreate) SetCreatedBy(s string) *LiveSessionCreate {
+	lsc.mutation.SetCreatedBy(s)
+	return lsc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableCreatedBy(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetCreatedBy(*s)
+	}
+	return lsc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lsc *LiveSessionCreate) SetUpdatedBy(s string) *LiveSessionCreate {
+	lsc.mutation.SetUpdatedBy(s)
+	return lsc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableUpdatedBy(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetUpdatedBy(*s)
+	}
+	return lsc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (lsc *LiveSessionCreate) SetInternshipID(s string) *LiveSessionCreate {
+	lsc.mutation.SetInternshipID(s)
+	return lsc
+}
+
+// SetInternshipBatchID sets the "internship_batch_id" field.
+func (lsc *LiveSessionCreate) SetInternshipBatchID(s string) *LiveSessionCreate {
+	lsc.mutation.SetInternshipBatchID(s)
+	return lsc
+}
+
+// SetTitle sets the "title" field.
+func (lsc *LiveSessionCreate) SetTitle(s string) *LiveSessionCreate {
+	lsc.mutation.SetTitle(s)
+	return lsc
+}
+
+// SetDescription sets the "description" field.
+func (lsc *LiveSessionCreate) SetDescription(s string) *LiveSessionCreate {
+	lsc.mutation.SetDescription(s)
+	return lsc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableDescription(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetDescription(*s)
+	}
+	return lsc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (lsc *LiveSessionCreate) SetStartsAt(t time.Time) *LiveSessionCreate {
+	lsc.mutation.SetStartsAt(t)
+	return lsc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (lsc *LiveSessionCreate) SetEndsAt(t time.Time) *LiveSessionCreate {
+	lsc.mutation.SetEndsAt(t)
+	return lsc
+}
+
+// SetMeetingURL sets the "meeting_url" field.
+func (lsc *LiveSessionCreate) SetMeetingURL(s string) *LiveSessionCreate {
+	lsc.mutation.SetMeetingURL(s)
+	return lsc
+}
+
+// SetNillableMeetingURL sets the "meeting_url" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableMeetingURL(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetMeetingURL(*s)
+	}
+	return lsc
+}
+
+// SetRecordingFileID sets the "recording_file_id" field.
+func (lsc *LiveSessionCreate) SetRecordingFileID(s string) *LiveSessionCreate {
+	lsc.mutation.SetRecordingFileID(s)
+	return lsc
+}
+
+// SetNillableRecordingFileID sets the "recording_file_id" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableRecordingFileID(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetRecordingFileID(*s)
+	}
+	return lsc
+}
+
+// SetRecordingURL sets the "recording_url" field.
+func (lsc *LiveSessionCreate) SetRecordingURL(s string) *LiveSessionCreate {
+	lsc.mutation.SetRecordingURL(s)
+	return lsc
+}
+
+// SetNillableRecordingURL sets the "recording_url" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableRecordingURL(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetRecordingURL(*s)
+	}
+	return lsc
+}
+
+// SetSessionStatus sets the "session_status" field.
+func (lsc *LiveSessionCreate) SetSessionStatus(s string) *LiveSessionCreate {
+	lsc.mutation.SetSessionStatus(s)
+	return lsc
+}
+
+// SetNillableSessionStatus sets the "session_status" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableSessionStatus(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetSessionStatus(*s)
+	}
+	return lsc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (lsc *LiveSessionCreate) SetCancelledAt(t time.Time) *LiveSessionCreate {
+	lsc.mutation.SetCancelledAt(t)
+	return lsc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableCancelledAt(t *time.Time) *LiveSessionCreate {
+	if t != nil {
+		lsc.SetCancelledAt(*t)
+	}
+	return lsc
+}
+
+// SetID sets the "id" field.
+func (lsc *LiveSessionCreate) SetID(s string) *LiveSessionCreate {
+	lsc.mutation.SetID(s)
+	return lsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lsc *LiveSessionCreate) SetNillableID(s *string) *LiveSessionCreate {
+	if s != nil {
+		lsc.SetID(*s)
+	}
+	return lsc
+}
+
+// Mutation returns the LiveSessionMutation object of the builder.
+func (lsc *LiveSessionCreate) Mutation() *LiveSessionMutation {
+	return lsc.mutation
+}
+
+// Save creates the LiveSession in the database.
+func (lsc *LiveSessionCreate) Save(ctx context.Context) (*LiveSession, error) {
+	lsc.defaults()
+	return withHooks(ctx, lsc.sqlSave, lsc.mutation, lsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lsc *LiveSessionCreate) SaveX(ctx context.Context) *LiveSession {
+	v, err := lsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lsc *LiveSessionCreate) Exec(ctx context.Context) error {
+	_, err := lsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsc *LiveSessionCreate) ExecX(ctx context.Context) {
+	if err := lsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsc *LiveSessionCreate) defaults() {
+	if _, ok := lsc.mutation.Status(); !ok {
+		v := livesession.DefaultStatus
+		lsc.mutation.SetStatus(v)
+	}
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		v := livesession.DefaultCreatedAt()
+		lsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lsc.mutation.UpdatedAt(); !ok {
+		v := livesession.DefaultUpdatedAt()
+		lsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := lsc.mutation.SessionStatus(); !ok {
+		v := livesession.DefaultSessionStatus
+		lsc.mutation.SetSessionStatus(v)
+	}
+	if _, ok := lsc.mutation.ID(); !ok {
+		v := livesession.DefaultID()
+		lsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsc *LiveSessionCreate) check() error {
+	if _, ok := lsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LiveSession.status"`)}
+	}
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LiveSession.created_at"`)}
+	}
+	if _, ok := lsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LiveSession.updated_at"`)}
+	}
+	if _, ok := lsc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "LiveSession.internship_id"`)}
+	}
+	if v, ok := lsc.mutation.InternshipID(); ok {
+		if err := livesession.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "LiveSession.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := lsc.mutation.InternshipBatchID(); !ok {
+		return &ValidationError{Name: "internship_batch_id", err: errors.New(`ent: missing required field "LiveSession.internship_batch_id"`)}
+	}
+	if v, ok := lsc.mutation.InternshipBatchID(); ok {
+		if err := livesession.InternshipBatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_batch_id", err: fmt.Errorf(`ent: validator failed for field "LiveSession.internship_batch_id": %w`, err)}
+		}
+	}
+	if _, ok := lsc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "LiveSession.title"`)}
+	}
+	if v, ok := lsc.mutation.Title(); ok {
+		if err := livesession.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "LiveSession.title": %w`, err)}
+		}
+	}
+	if _, ok := lsc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "LiveSession.starts_at"`)}
+	}
+	if _, ok := lsc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "LiveSession.ends_at"`)}
+	}
+	if _, ok := lsc.mutation.SessionStatus(); !ok {
+		return &ValidationError{Name: "session_status", err: errors.New(`ent: missing required field "LiveSession.session_status"`)}
+	}
+	return nil
+}
+
+func (lsc *LiveSessionCreate) sqlSave(ctx context.Context) (*LiveSession, error) {
+	if err := lsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LiveSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lsc.mutation.id = &_node.ID
+	lsc.mutation.done = true
+	return _node, nil
+}
+
+func (lsc *LiveSessionCreate) createSpec() (*LiveSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LiveSession{config: lsc.config}
+		_spec = sqlgraph.NewCreateSpec(livesession.Table, sqlgraph.NewFieldSpec(livesession.FieldID, field.TypeString))
+	)
+	if id, ok := lsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lsc.mutation.Status(); ok {
+		_spec.SetField(livesession.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := lsc.mutation.CreatedAt(); ok {
+		_spec.SetField(livesession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(livesession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := lsc.mutation.CreatedBy(); ok {
+		_spec.SetField(livesession.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := lsc.mutation.UpdatedBy(); ok {
+		_spec.SetField(livesession.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := lsc.mutation.InternshipID(); ok {
+		_spec.SetField(livesession.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := lsc.mutation.InternshipBatchID(); ok {
+		_spec.SetField(livesession.FieldInternshipBatchID, field.TypeString, value)
+		_node.InternshipBatchID = value
+	}
+	if value, ok := lsc.mutation.Title(); ok {
+		_spec.SetField(livesession.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := lsc.mutation.Description(); ok {
+		_spec.SetField(livesession.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := lsc.mutation.StartsAt(); ok {
+		_spec.SetField(livesession.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := lsc.mutation.EndsAt(); ok {
+		_spec.SetField(livesession.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := lsc.mutation.MeetingURL(); ok {
+		_spec.SetField(livesession.FieldMeetingURL, field.TypeString, value)
+		_node.MeetingURL = value
+	}
+	if value, ok := lsc.mutation.RecordingFileID(); ok {
+		_spec.SetField(livesession.FieldRecordingFileID, field.TypeString, value)
+		_node.RecordingFileID = &value
+	}
+	if value, ok := lsc.mutation.RecordingURL(); ok {
+		_spec.SetField(livesession.FieldRecordingURL, field.TypeString, value)
+		_node.RecordingURL = &value
+	}
+	if value, ok := lsc.mutation.SessionStatus(); ok {
+		_spec.SetField(livesession.FieldSessionStatus, field.TypeString, value)
+		_node.SessionStatus = value
+	}
+	if value, ok := lsc.mutation.CancelledAt(); ok {
+		_spec.SetField(livesession.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	return _node, _spec
+}
+
+// LiveSessionCreateBulk is the builder for creating many LiveSession entities in bulk.
+type LiveSessionCreateBulk struct {
+	config
+	err      error
+	builders []*LiveSessionCreate
+}
+
+// Save creates the LiveSession entities in the database.
+func (lscb *LiveSessionCreateBulk) Save(ctx context.Context) ([]*LiveSession, error) {
+	if lscb.err != nil {
+		return nil, lscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lscb.builders))
+	nodes := make([]*LiveSession, len(lscb.builders))
+	mutators := make([]Mutator, len(lscb.builders))
+	for i := range lscb.builders {
+		func(i int, root context.Context) {
+			builder := lscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LiveSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lscb *LiveSessionCreateBulk) SaveX(ctx context.Context) []*LiveSession {
+	v, err := lscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lscb *LiveSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := lscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lscb *LiveSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := lscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
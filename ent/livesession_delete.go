@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/livesession"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LiveSessionDelete is the builder for deleting a LiveSession entity.
+type LiveSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *LiveSessionMutation
+}
+
+// Where appends a list predicates to the LiveSessionDelete builder.
+func (lsd *LiveSessionDelete) Where(ps ...predicate.LiveSession) *LiveSessionDelete {
+	lsd.mutation.Where(ps...)
+	return lsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lsd *LiveSessionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lsd.sqlExec, lsd.mutation, lsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsd *LiveSessionDelete) ExecX(ctx context.Context) int {
+	n, err := lsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lsd *LiveSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(livesession.Table, sqlgraph.NewFieldSpec(livesession.FieldID, field.TypeString))
+	if ps := lsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lsd.mutation.done = true
+	return affected, err
+}
+
+// LiveSessionDeleteOne is the builder for deleting a single LiveSession entity.
+type LiveSessionDeleteOne struct {
+	lsd *LiveSessionDelete
+}
+
+// Where appends a list predicates to the LiveSessionDelete builder.
+func (lsdo *LiveSessionDeleteOne) Where(ps ...predicate.LiveSession) *LiveSessionDeleteOne {
+	lsdo.lsd.mutation.Where(ps...)
+	return lsdo
+}
+
+// Exec executes the deletion query.
+func (lsdo *LiveSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := lsdo.lsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{livesession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsdo *LiveSessionDeleteOne) ExecX(ctx context.Context) {
+	if err := lsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
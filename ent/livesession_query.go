@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/livesession"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LiveSessionQuery is the builder for querying LiveSession entities.
+type LiveSessionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []livesession.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LiveSession
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LiveSessionQuery builder.
+func (lsq *LiveSessionQuery) Where(ps ...predicate.LiveSession) *LiveSessionQuery {
+	lsq.predicates = append(lsq.predicates, ps...)
+	return lsq
+}
+
+// Limit the number of records to be returned by this query.
+func (lsq *LiveSessionQuery) Limit(limit int) *LiveSessionQuery {
+	lsq.ctx.Limit = &limit
+	return lsq
+}
+
+// Offset to start from.
+func (lsq *LiveSessionQuery) Offset(offset int) *LiveSessionQuery {
+	lsq.ctx.Offset = &offset
+	return lsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lsq *LiveSessionQuery) Unique(unique bool) *LiveSessionQuery {
+	lsq.ctx.Unique = &unique
+	return lsq
+}
+
+// Order specifies how the records should be ordered.
+func (lsq *LiveSessionQuery) Order(o ...livesession.OrderOption) *LiveSessionQuery {
+	lsq.order = append(lsq.order, o...)
+	return lsq
+}
+
+// First returns the first LiveSession entity from the query.
+// Returns a *NotFoundError when no LiveSession was found.
+func (lsq *LiveSessionQuery) First(ctx context.Context) (*LiveSession, error) {
+	nodes, err := lsq.Limit(1).All(setContextOp(ctx, lsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{livesession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lsq *LiveSessionQuery) FirstX(ctx context.Context) *LiveSession {
+	node, err := lsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LiveSession ID from the query.
+// Returns a *NotFoundError when no LiveSession ID was found.
+func (lsq *LiveSessionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lsq.Limit(1).IDs(setContextOp(ctx, lsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{livesession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lsq *LiveSessionQuery) FirstIDX(ctx context.Context) string {
+	id, err := lsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LiveSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LiveSession entity is found.
+// Returns a *NotFoundError when no LiveSession entities are found.
+func (lsq *LiveSessionQuery) Only(ctx context.Context) (*LiveSession, error) {
+	nodes, err := lsq.Limit(2).All(setContextOp(ctx, lsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{livesession.Label}
+	default:
+		return nil, &NotSingularError{livesession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lsq *LiveSessionQuery) OnlyX(ctx context.Context) *LiveSession {
+	node, err := lsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LiveSession ID in the query.
+// Returns a *NotSingularError when more than one LiveSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lsq *LiveSessionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lsq.Limit(2).IDs(setContextOp(ctx, lsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{livesession.Label}
+	default:
+		err = &NotSingularError{livesession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lsq *LiveSessionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := lsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LiveSessions.
+func (lsq *LiveSessionQuery) All(ctx context.Context) ([]*LiveSession, error) {
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryAll)
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LiveSession, *LiveSessionQuery]()
+	return withInterceptors[[]*LiveSession](ctx, lsq, qr, lsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lsq *LiveSessionQuery) AllX(ctx context.Context) []*LiveSession {
+	nodes, err := lsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LiveSession IDs.
+func (lsq *LiveSessionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if lsq.ctx.Unique == nil && lsq.path != nil {
+		lsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryIDs)
+	if err = lsq.Select(livesession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lsq *LiveSessionQuery) IDsX(ctx context.Context) []string {
+	ids, err := lsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lsq *LiveSessionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryCount)
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lsq, querierCount[*LiveSessionQuery](), lsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lsq *LiveSessionQuery) CountX(ctx context.Context) int {
+	count, err := lsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lsq *LiveSessionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lsq.ctx, ent.OpQueryExist)
+	switch _, err := lsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lsq *LiveSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := lsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LiveSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lsq *LiveSessionQuery) Clone() *LiveSessionQuery {
+	if lsq == nil {
+		return nil
+	}
+	return &LiveSessionQuery{
+		config:     lsq.config,
+		ctx:        lsq.ctx.Clone(),
+		order:      append([]livesession.OrderOption{}, lsq.order...),
+		inters:     append([]Interceptor{}, lsq.inters...),
+		predicates: append([]predicate.LiveSession{}, lsq.predicates...),
+		// clone intermediate query.
+		sql:  lsq.sql.Clone(),
+		path: lsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LiveSession.Query().
+//		GroupBy(livesession.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lsq *LiveSessionQuery) GroupBy(field string, fields ...string) *LiveSessionGroupBy {
+	lsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LiveSessionGroupBy{build: lsq}
+	grbuild.flds = &lsq.ctx.Fields
+	grbuild.label = livesession.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.LiveSession.Query().
+//		Select(livesession.FieldStatus).
+//		Scan(ctx, &v)
+func (lsq *LiveSessionQuery) Select(fields ...string) *LiveSessionSelect {
+	lsq.ctx.Fields = append(lsq.ctx.Fields, fields...)
+	sbuild := &LiveSessionSelect{LiveSessionQuery: lsq}
+	sbuild.label = livesession.Label
+	sbuild.flds, sbuild.scan = &lsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LiveSessionSelect configured with the given aggregations.
+func (lsq *LiveSessionQuery) Aggregate(fns ...AggregateFunc) *LiveSessionSelect {
+	return lsq.Select().Aggregate(fns...)
+}
+
+func (lsq *LiveSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lsq.ctx.Fields {
+		if !livesession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lsq.path != nil {
+		prev, err := lsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lsq.sql = prev
+	}
+	return nil
+}
+
+func (lsq *LiveSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LiveSession, error) {
+	var (
+		nodes = []*LiveSession{}
+		_spec = lsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LiveSession).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LiveSession{config: lsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lsq *LiveSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lsq.querySpec()
+	_spec.Node.Columns = lsq.ctx.Fields
+	if len(lsq.ctx.Fields) > 0 {
+		_spec.Unique = lsq.ctx.Unique != nil && *lsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lsq.driver, _spec)
+}
+
+func (lsq *LiveSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(livesession.Table, livesession.Columns, sqlgraph.NewFieldSpec(livesession.FieldID, field.TypeString))
+	_spec.From = lsq.sql
+	if unique := lsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, livesession.FieldID)
+		for i := range fields {
+			if fields[i] != livesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lsq *LiveSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lsq.driver.Dialect())
+	t1 := builder.Table(livesession.Table)
+	columns := lsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = livesession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lsq.sql != nil {
+		selector = lsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lsq.ctx.Unique != nil && *lsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lsq.predicates {
+		p(selector)
+	}
+	for _, p := range lsq.order {
+		p(selector)
+	}
+	if offset := lsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LiveSessionGroupBy is the group-by builder for LiveSession entities.
+type LiveSessionGroupBy struct {
+	selector
+	build *LiveSessionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lsgb *LiveSessionGroupBy) Aggregate(fns ...AggregateFunc) *LiveSessionGroupBy {
+	lsgb.fns = append(lsgb.fns, fns...)
+	return lsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lsgb *LiveSessionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LiveSessionQuery, *LiveSessionGroupBy](ctx, lsgb.build, lsgb, lsgb.build.inters, v)
+}
+
+func (lsgb *LiveSessionGroupBy) sqlScan(ctx context.Context, root *LiveSessionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lsgb.fns))
+	for _, fn := range lsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lsgb.flds)+len(lsgb.fns))
+		for _, f := range *lsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LiveSessionSelect is the builder for selecting fields of LiveSession entities.
+type LiveSessionSelect struct {
+	*LiveSessionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lss *LiveSessionSelect) Aggregate(fns ...AggregateFunc) *LiveSessionSelect {
+	lss.fns = append(lss.fns, fns...)
+	return lss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lss *LiveSessionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lss.ctx, ent.OpQuerySelect)
+	if err := lss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LiveSessionQuery, *LiveSessionSelect](ctx, lss.LiveSessionQuery, lss, lss.inters, v)
+}
+
+func (lss *LiveSessionSelect) sqlScan(ctx context.Context, root *LiveSessionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lss.fns))
+	for _, fn := range lss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
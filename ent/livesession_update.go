@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/livesession"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// LiveSessionUpdate is the builder for updating LiveSession entities.
+type LiveSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LiveSessionMutation
+}
+
+// Where appends a list predicates to the LiveSessionUpdate builder.
+func (lsu *LiveSessionUpdate) Where(ps ...predicate.LiveSession) *LiveSessionUpdate {
+	lsu.mutation.Where(ps...)
+	return lsu
+}
+
+// SetStatus sets the "status" field.
+func (lsu *LiveSessionUpdate) SetStatus(s string) *LiveSessionUpdate {
+	lsu.mutation.SetStatus(s)
+	return lsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableStatus(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetStatus(*s)
+	}
+	return lsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lsu *LiveSessionUpdate) SetUpdatedAt(t time.Time) *LiveSessionUpdate {
+	lsu.mutation.SetUpdatedAt(t)
+	return lsu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lsu *LiveSessionUpdate) SetUpdatedBy(s string) *LiveSessionUpdate {
+	lsu.mutation.SetUpdatedBy(s)
+	return lsu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableUpdatedBy(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetUpdatedBy(*s)
+	}
+	return lsu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (lsu *LiveSessionUpdate) ClearUpdatedBy() *LiveSessionUpdate {
+	lsu.mutation.ClearUpdatedBy()
+	return lsu
+}
+
+// SetTitle sets the "title" field.
+func (lsu *LiveSessionUpdate) SetTitle(s string) *LiveSessionUpdate {
+	lsu.mutation.SetTitle(s)
+	return lsu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableTitle(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetTitle(*s)
+	}
+	return lsu
+}
+
+// SetDescription sets the "description" field.
+func (lsu *LiveSessionUpdate) SetDescription(s string) *LiveSessionUpdate {
+	lsu.mutation.SetDescription(s)
+	return lsu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableDescription(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetDescription(*s)
+	}
+	return lsu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (lsu *LiveSessionUpdate) ClearDescription() *LiveSessionUpdate {
+	lsu.mutation.ClearDescription()
+	return lsu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (lsu *LiveSessionUpdate) SetStartsAt(t time.Time) *LiveSessionUpdate {
+	lsu.mutation.SetStartsAt(t)
+	return lsu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableStartsAt(t *time.Time) *LiveSessionUpdate {
+	if t != nil {
+		lsu.SetStartsAt(*t)
+	}
+	return lsu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (lsu *LiveSessionUpdate) SetEndsAt(t time.Time) *LiveSessionUpdate {
+	lsu.mutation.SetEndsAt(t)
+	return lsu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableEndsAt(t *time.Time) *LiveSessionUpdate {
+	if t != nil {
+		lsu.SetEndsAt(*t)
+	}
+	return lsu
+}
+
+// SetMeetingURL sets the "meeting_url" field.
+func (lsu *LiveSessionUpdate) SetMeetingURL(s string) *LiveSessionUpdate {
+	lsu.mutation.SetMeetingURL(s)
+	return lsu
+}
+
+// SetNillableMeetingURL sets the "meeting_url" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableMeetingURL(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetMeetingURL(*s)
+	}
+	return lsu
+}
+
+// ClearMeetingURL clears the value of the "meeting_url" field.
+func (lsu *LiveSessionUpdate) ClearMeetingURL() *LiveSessionUpdate {
+	lsu.mutation.ClearMeetingURL()
+	return lsu
+}
+
+// SetRecordingFileID sets the "recording_file_id" field.
+func (lsu *LiveSessionUpdate) SetRecordingFileID(s string) *LiveSessionUpdate {
+	lsu.mutation.SetRecordingFileID(s)
+	return lsu
+}
+
+// SetNillableRecordingFileID sets the "recording_file_id" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableRecordingFileID(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetRecordingFileID(*s)
+	}
+	return lsu
+}
+
+// ClearRecordingFileID clears the value of the "recording_file_id" field.
+func (lsu *LiveSessionUpdate) ClearRecordingFileID() *LiveSessionUpdate {
+	lsu.mutation.ClearRecordingFileID()
+	return lsu
+}
+
+// SetRecordingURL sets the "recording_url" field.
+func (lsu *LiveSessionUpdate) SetRecordingURL(s string) *LiveSessionUpdate {
+	lsu.mutation.SetRecordingURL(s)
+	return lsu
+}
+
+// SetNillableRecordingURL sets the "recording_url" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableRecordingURL(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetRecordingURL(*s)
+	}
+	return lsu
+}
+
+// ClearRecordingURL clears the value of the "recording_url" field.
+func (lsu *LiveSessionUpdate) ClearRecordingURL() *LiveSessionUpdate {
+	lsu.mutation.ClearRecordingURL()
+	return lsu
+}
+
+// SetSessionStatus sets the "session_status" field.
+func (lsu *LiveSessionUpdate) SetSessionStatus(s string) *LiveSessionUpdate {
+	lsu.mutation.SetSessionStatus(s)
+	return lsu
+}
+
+// SetNillableSessionStatus sets the "session_status" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableSessionStatus(s *string) *LiveSessionUpdate {
+	if s != nil {
+		lsu.SetSessionStatus(*s)
+	}
+	return lsu
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (lsu *LiveSessionUpdate) SetCancelledAt(t time.Time) *LiveSessionUpdate {
+	lsu.mutation.SetCancelledAt(t)
+	return lsu
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (lsu *LiveSessionUpdate) SetNillableCancelledAt(t *time.Time) *LiveSessionUpdate {
+	if t != nil {
+		lsu.SetCancelledAt(*t)
+	}
+	return lsu
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (lsu *LiveSessionUpdate) ClearCancelledAt() *LiveSessionUpdate {
+	lsu.mutation.ClearCancelledAt()
+	return lsu
+}
+
+// Mutation returns the LiveSessionMutation object of the builder.
+func (lsu *LiveSessionUpdate) Mutation() *LiveSessionMutation {
+	return lsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lsu *LiveSessionUpdate) Save(ctx context.Context) (int, error) {
+	lsu.defaults()
+	return withHooks(ctx, lsu.sqlSave, lsu.mutation, lsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsu *LiveSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := lsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lsu *LiveSessionUpdate) Exec(ctx context.Context) error {
+	_, err := lsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsu *LiveSessionUpdate) ExecX(ctx context.Context) {
+	if err := lsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsu *LiveSessionUpdate) defaults() {
+	if _, ok := lsu.mutation.UpdatedAt(); !ok {
+		v := livesession.UpdateDefaultUpdatedAt()
+		lsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsu *LiveSessionUpdate) check() error {
+	if v, ok := lsu.mutation.Title(); ok {
+		if err := livesession.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "LiveSession.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lsu *LiveSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(livesession.Table, livesession.Columns, sqlgraph.NewFieldSpec(livesession.FieldID, field.TypeString))
+	if ps := lsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsu.mutation.Status(); ok {
+		_spec.SetField(livesession.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := lsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(livesession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if lsu.mutation.CreatedByCleared() {
+		_spec.ClearField(livesession.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := lsu.mutation.UpdatedBy(); ok {
+		_spec.SetField(livesession.FieldUpdatedBy, field.TypeString, value)
+	}
+	if lsu.mutation.UpdatedByCleared() {
+		_spec.ClearField(livesession.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := lsu.mutation.Title(); ok {
+		_spec.SetField(livesession.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := lsu.mutation.Description(); ok {
+		_spec.SetField(livesession.FieldDescription, field.TypeString, value)
+	}
+	if lsu.mutation.DescriptionCleared() {
+		_spec.ClearField(livesession.FieldDescription, field.TypeString)
+	}
+	if value, ok := lsu.mutation.StartsAt(); ok {
+		_spec.SetField(livesession.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := lsu.mutation.EndsAt(); ok {
+		_spec.SetField(livesession.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := lsu.mutation.MeetingURL(); ok {
+		_spec.SetField(livesession.FieldMeetingURL, field.TypeString, value)
+	}
+	if lsu.mutation.MeetingURLCleared() {
+		_spec.ClearField(livesession.FieldMeetingURL, field.TypeString)
+	}
+	if value, ok := lsu.mutation.RecordingFileID(); ok {
+		_spec.SetField(livesession.FieldRecordingFileID, field.TypeString, value)
+	}
+	if lsu.mutation.RecordingFileIDCleared() {
+		_spec.ClearField(livesession.FieldRecordingFileID, field.TypeString)
+	}
+	if value, ok := lsu.mutation.RecordingURL(); ok {
+		_spec.SetField(livesession.FieldRecordingURL, field.TypeString, value)
+	}
+	if lsu.mutation.RecordingURLCleared() {
+		_spec.ClearField(livesession.FieldRecordingURL, field.TypeString)
+	}
+	if value, ok := lsu.mutation.SessionStatus(); ok {
+		_spec.SetField(livesession.FieldSessionStatus, field.TypeString, value)
+	}
+	if value, ok := lsu.mutation.CancelledAt(); ok {
+		_spec.SetField(livesession.FieldCancelledAt, field.TypeTime, value)
+	}
+	if lsu.mutation.CancelledAtCleared() {
+		_spec.ClearField(livesession.FieldCancelledAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{livesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lsu.mutation.done = true
+	return n, nil
+}
+
+// LiveSessionUpdateOne is the builder for updating a single LiveSession entity.
+type LiveSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LiveSessionMutation
+}
+
+// SetStatus sets the "status" field.
+func (lsuo *LiveSessionUpdateOne) SetStatus(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetStatus(s)
+	return lsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableStatus(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetStatus(*s)
+	}
+	return lsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lsuo *LiveSessionUpdateOne) SetUpdatedAt(t time.Time) *LiveSessionUpdateOne {
+	lsuo.mutation.SetUpdatedAt(t)
+	return lsuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (lsuo *LiveSessionUpdateOne) SetUpdatedBy(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetUpdatedBy(s)
+	return lsuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableUpdatedBy(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetUpdatedBy(*s)
+	}
+	return lsuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (lsuo *LiveSessionUpdateOne) ClearUpdatedBy() *LiveSessionUpdateOne {
+	lsuo.mutation.ClearUpdatedBy()
+	return lsuo
+}
+
+// SetTitle sets the "title" field.
+func (lsuo *LiveSessionUpdateOne) SetTitle(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetTitle(s)
+	return lsuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableTitle(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetTitle(*s)
+	}
+	return lsuo
+}
+
+// SetDescription sets the "description" field.
+func (lsuo *LiveSessionUpdateOne) SetDescription(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetDescription(s)
+	return lsuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableDescription(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetDescription(*s)
+	}
+	return lsuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (lsuo *LiveSessionUpdateOne) ClearDescription() *LiveSessionUpdateOne {
+	lsuo.mutation.ClearDescription()
+	return lsuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (lsuo *LiveSessionUpdateOne) SetStartsAt(t time.Time) *LiveSessionUpdateOne {
+	lsuo.mutation.SetStartsAt(t)
+	return lsuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableStartsAt(t *time.Time) *LiveSessionUpdateOne {
+	if t != nil {
+		lsuo.SetStartsAt(*t)
+	}
+	return lsuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (lsuo *LiveSessionUpdateOne) SetEndsAt(t time.Time) *LiveSessionUpdateOne {
+	lsuo.mutation.SetEndsAt(t)
+	return lsuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableEndsAt(t *time.Time) *LiveSessionUpdateOne {
+	if t != nil {
+		lsuo.SetEndsAt(*t)
+	}
+	return lsuo
+}
+
+// SetMeetingURL sets the "meeting_url" field.
+func (lsuo *LiveSessionUpdateOne) SetMeetingURL(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetMeetingURL(s)
+	return lsuo
+}
+
+// SetNillableMeetingURL sets the "meeting_url" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableMeetingURL(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetMeetingURL(*s)
+	}
+	return lsuo
+}
+
+// ClearMeetingURL clears the value of the "meeting_url" field.
+func (lsuo *LiveSessionUpdateOne) ClearMeetingURL() *LiveSessionUpdateOne {
+	lsuo.mutation.ClearMeetingURL()
+	return lsuo
+}
+
+// SetRecordingFileID sets the "recording_file_id" field.
+func (lsuo *LiveSessionUpdateOne) SetRecordingFileID(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetRecordingFileID(s)
+	return lsuo
+}
+
+// SetNillableRecordingFileID sets the "recording_file_id" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableRecordingFileID(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetRecordingFileID(*s)
+	}
+	return lsuo
+}
+
+// ClearRecordingFileID clears the value of the "recording_file_id" field.
+func (lsuo *LiveSessionUpdateOne) ClearRecordingFileID() *LiveSessionUpdateOne {
+	lsuo.mutation.ClearRecordingFileID()
+	return lsuo
+}
+
+// SetRecordingURL sets the "recording_url" field.
+func (lsuo *LiveSessionUpdateOne) SetRecordingURL(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetRecordingURL(s)
+	return lsuo
+}
+
+// SetNillableRecordingURL sets the "recording_url" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableRecordingURL(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetRecordingURL(*s)
+	}
+	return lsuo
+}
+
+// ClearRecordingURL clears the value of the "recording_url" field.
+func (lsuo *LiveSessionUpdateOne) ClearRecordingURL() *LiveSessionUpdateOne {
+	lsuo.mutation.ClearRecordingURL()
+	return lsuo
+}
+
+// SetSessionStatus sets the "session_status" field.
+func (lsuo *LiveSessionUpdateOne) SetSessionStatus(s string) *LiveSessionUpdateOne {
+	lsuo.mutation.SetSessionStatus(s)
+	return lsuo
+}
+
+// SetNillableSessionStatus sets the "session_status" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableSessionStatus(s *string) *LiveSessionUpdateOne {
+	if s != nil {
+		lsuo.SetSessionStatus(*s)
+	}
+	return lsuo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (lsuo *LiveSessionUpdateOne) SetCancelledAt(t time.Time) *LiveSessionUpdateOne {
+	lsuo.mutation.SetCancelledAt(t)
+	return lsuo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (lsuo *LiveSessionUpdateOne) SetNillableCancelledAt(t *time.Time) *LiveSessionUpdateOne {
+	if t != nil {
+		lsuo.SetCancelledAt(*t)
+	}
+	return lsuo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (lsuo *LiveSessionUpdateOne) ClearCancelledAt() *LiveSessionUpdateOne {
+	lsuo.mutation.ClearCancelledAt()
+	return lsuo
+}
+
+// Mutation returns the LiveSessionMutation object of the builder.
+func (lsuo *LiveSessionUpdateOne) Mutation() *LiveSessionMutation {
+	return lsuo.mutation
+}
+
+// Where appends a list predicates to the LiveSessionUpdate builder.
+func (lsuo *LiveSessionUpdateOne) Where(ps ...predicate.LiveSession) *LiveSessionUpdateOne {
+	lsuo.mutation.Where(ps...)
+	return lsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lsuo *LiveSessionUpdateOne) Select(field string, fields ...string) *LiveSessionUpdateOne {
+	lsuo.fields = append([]string{field}, fields...)
+	return lsuo
+}
+
+// Save executes the query and returns the updated LiveSession entity.
+func (lsuo *LiveSessionUpdateOne) Save(ctx context.Context) (*LiveSession, error) {
+	lsuo.defaults()
+	return withHooks(ctx, lsuo.sqlSave, lsuo.mutation, lsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsuo *LiveSessionUpdateOne) SaveX(ctx context.Context) *LiveSession {
+	node, err := lsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lsuo *LiveSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := lsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsuo *LiveSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := lsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsuo *LiveSessionUpdateOne) defaults() {
+	if _, ok := lsuo.mutation.UpdatedAt(); !ok {
+		v := livesession.UpdateDefaultUpdatedAt()
+		lsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsuo *LiveSessionUpdateOne) check() error {
+	if v, ok := lsuo.mutation.Title(); ok {
+		if err := livesession.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "LiveSession.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lsuo *LiveSessionUpdateOne) sqlSave(ctx context.Context) (_node *LiveSession, err error) {
+	if err := lsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(livesession.Table, livesession.Columns, sqlgraph.NewFieldSpec(livesession.FieldID, field.TypeString))
+	id, ok := lsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LiveSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, livesession.FieldID)
+		for _, f := range fields {
+			if !livesession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != livesession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsuo.mutation.Status(); ok {
+		_spec.SetField(livesession.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := lsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(livesession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if lsuo.mutation.CreatedByCleared() {
+		_spec.ClearField(livesession.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := lsuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(livesession.FieldUpdatedBy, field.TypeString, value)
+	}
+	if lsuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(livesession.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := lsuo.mutation.Title(); ok {
+		_spec.SetField(livesession.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := lsuo.mutation.Description(); ok {
+		_spec.SetField(livesession.FieldDescription, field.TypeString, value)
+	}
+	if lsuo.mutation.DescriptionCleared() {
+		_spec.ClearField(livesession.FieldDescription, field.TypeString)
+	}
+	if value, ok := lsuo.mutation.StartsAt(); ok {
+		_spec.SetField(livesession.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := lsuo.mutation.EndsAt(); ok {
+		_spec.SetField(livesession.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := lsuo.mutation.MeetingURL(); ok {
+		_spec.SetField(livesession.FieldMeetingURL, field.TypeString, value)
+	}
+	if lsuo.mutation.MeetingURLCleared() {
+		_spec.ClearField(livesession.FieldMeetingURL, field.TypeString)
+	}
+	if value, ok := lsuo.mutation.RecordingFileID(); ok {
+		_spec.SetField(livesession.FieldRecordingFileID, field.TypeString, value)
+	}
+	if lsuo.mutation.RecordingFileIDCleared() {
+		_spec.ClearField(livesession.FieldRecordingFileID, field.TypeString)
+	}
+	if value, ok := lsuo.mutation.RecordingURL(); ok {
+		_spec.SetField(livesession.FieldRecordingURL, field.TypeString, value)
+	}
+	if lsuo.mutation.RecordingURLCleared() {
+		_spec.ClearField(livesession.FieldRecordingURL, field.TypeString)
+	}
+	if value, ok := lsuo.mutation.SessionStatus(); ok {
+		_spec.SetField(livesession.FieldSessionStatus, field.TypeString, value)
+	}
+	if value, ok := lsuo.mutation.CancelledAt(); ok {
+		_spec.SetField(livesession.FieldCancelledAt, field.TypeTime, value)
+	}
+	if lsuo.mutation.CancelledAtCleared() {
+		_spec.ClearField(livesession.FieldCancelledAt, field.TypeTime)
+	}
+	_node = &LiveSession{config: lsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{livesession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lsuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,45 @@ import (
 )
 
 var (
+	// AssignmentsColumns holds the columns for the "assignments" table.
+	AssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "module_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "instructions", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "due_days", Type: field.TypeInt, Nullable: true},
+		{Name: "max_score", Type: field.TypeInt, Default: 100},
+		{Name: "passing_score", Type: field.TypeInt, Nullable: true},
+		{Name: "rubric", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "late_policy", Type: field.TypeString, Default: "accept", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "late_penalty_percent", Type: field.TypeFloat64, Default: 0},
+		{Name: "max_submissions", Type: field.TypeInt, Nullable: true},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// AssignmentsTable holds the schema information for the "assignments" table.
+	AssignmentsTable = &schema.Table{
+		Name:       "assignments",
+		Columns:    AssignmentsColumns,
+		PrimaryKey: []*schema.Column{AssignmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "assignment_module_id_sort_order",
+				Unique:  false,
+				Columns: []*schema.Column{AssignmentsColumns[7], AssignmentsColumns[17]},
+			},
+			{
+				Name:    "assignment_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{AssignmentsColumns[6]},
+			},
+		},
+	}
 	// CartsColumns holds the columns for the "carts" table.
 	CartsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
@@ -85,6 +124,13 @@ var (
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "lookup_key", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "slug", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "path", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "depth", Type: field.TypeInt, Default: 0},
+		{Name: "sort_weight", Type: field.TypeInt, Default: 0},
+		{Name: "internship_count", Type: field.TypeInt, Default: 0},
+		{Name: "total_internship_count", Type: field.TypeInt, Default: 0},
 		{Name: "internship_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 	}
 	// CategoriesTable holds the schema information for the "categories" table.
@@ -95,7 +141,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "categories_internships_categories",
-				Columns:    []*schema.Column{CategoriesColumns[10]},
+				Columns:    []*schema.Column{CategoriesColumns[17]},
 				RefColumns: []*schema.Column{InternshipsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -106,6 +152,63 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{CategoriesColumns[7]},
 			},
+			{
+				Name:    "category_slug",
+				Unique:  true,
+				Columns: []*schema.Column{CategoriesColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "category_parent_id_sort_weight",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[10], CategoriesColumns[14]},
+			},
+		},
+	}
+	// CertificatesColumns holds the columns for the "certificates" table.
+	CertificatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "verification_id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "enrollment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_batch_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "recipient_name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "completed_at", Type: field.TypeTime},
+		{Name: "issued_at", Type: field.TypeTime},
+		{Name: "signature", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(128)"}},
+		{Name: "file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "certificate_status", Type: field.TypeString, Default: "issued", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revocation_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// CertificatesTable holds the schema information for the "certificates" table.
+	CertificatesTable = &schema.Table{
+		Name:       "certificates",
+		Columns:    CertificatesColumns,
+		PrimaryKey: []*schema.Column{CertificatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certificate_enrollment_id",
+				Unique:  true,
+				Columns: []*schema.Column{CertificatesColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "certificate_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[8]},
+			},
 		},
 	}
 	// DiscountsColumns holds the columns for the "discounts" table.
@@ -126,6 +229,9 @@ var (
 		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
 		{Name: "min_order_value", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
 		{Name: "is_combinable", Type: field.TypeBool, Default: false},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_campaign", Type: field.TypeBool, Default: false},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 	}
 	// DiscountsTable holds the schema information for the "discounts" table.
@@ -133,6 +239,13 @@ var (
 		Name:       "discounts",
 		Columns:    DiscountsColumns,
 		PrimaryKey: []*schema.Column{DiscountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discount_parent_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{DiscountsColumns[18], DiscountsColumns[12]},
+			},
+		},
 	}
 	// FileUploadsColumns holds the columns for the "file_uploads" table.
 	FileUploadsColumns = []*schema.Column{
@@ -183,6 +296,19 @@ var (
 		{Name: "percentage_discount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
 		{Name: "subtotal", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
 		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "publish_status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "draft", Type: field.TypeJSON, Nullable: true},
+		{Name: "draft_status", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "current_revision_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "application_required", Type: field.TypeBool, Default: false},
+		{Name: "application_form", Type: field.TypeJSON, Nullable: true},
+		{Name: "rating_average", Type: field.TypeFloat64, Default: 0},
+		{Name: "rating_count", Type: field.TypeInt, Default: 0},
 		{Name: "category_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 	}
 	// InternshipsTable holds the schema information for the "internships" table.
@@ -193,11 +319,69 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "internships_categories_internships",
-				Columns:    []*schema.Column{InternshipsColumns[22]},
+				Columns:    []*schema.Column{InternshipsColumns[35]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "internship_publish_status",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipsColumns[22]},
+			},
+			{
+				Name:    "internship_rating_average",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipsColumns[33]},
+			},
+		},
+	}
+	// InternshipApplicationsColumns holds the columns for the "internship_applications" table.
+	InternshipApplicationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_batch_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "answers", Type: field.TypeJSON},
+		{Name: "resume_file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "application_status", Type: field.TypeString, Default: "submitted", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "interview_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reviewer_note", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "offer_expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// InternshipApplicationsTable holds the schema information for the "internship_applications" table.
+	InternshipApplicationsTable = &schema.Table{
+		Name:       "internship_applications",
+		Columns:    InternshipApplicationsColumns,
+		PrimaryKey: []*schema.Column{InternshipApplicationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "internshipapplication_internship_batch_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{InternshipApplicationsColumns[7], InternshipApplicationsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted' AND application_status != 'withdrawn'",
+				},
+			},
+			{
+				Name:    "internshipapplication_internship_id_application_status",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipApplicationsColumns[6], InternshipApplicationsColumns[11]},
+			},
+			{
+				Name:    "internshipapplication_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipApplicationsColumns[8]},
+			},
+		},
 	}
 	// InternshipBatchesColumns holds the columns for the "internship_batches" table.
 	InternshipBatchesColumns = []*schema.Column{
@@ -214,6 +398,11 @@ var (
 		{Name: "start_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp"}},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamp"}},
 		{Name: "batch_status", Type: field.TypeString, Default: "upcoming", SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "enrollment_closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reminded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true},
 	}
 	// InternshipBatchesTable holds the schema information for the "internship_batches" table.
 	InternshipBatchesTable = &schema.Table{
@@ -233,14 +422,17 @@ var (
 		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "internship_batch_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_revision_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "enrollment_status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "payment_status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "enrolled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "payment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true},
 		{Name: "refund_reason", Type: field.TypeString, Nullable: true},
 		{Name: "idempotency_key", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "payment_plan_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 	}
 	// InternshipEnrollmentsTable holds the schema information for the "internship_enrollments" table.
 	InternshipEnrollmentsTable = &schema.Table{
@@ -248,6 +440,298 @@ var (
 		Columns:    InternshipEnrollmentsColumns,
 		PrimaryKey: []*schema.Column{InternshipEnrollmentsColumns[0]},
 	}
+	// InternshipInstructorsColumns holds the columns for the "internship_instructors" table.
+	InternshipInstructorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "role", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// InternshipInstructorsTable holds the schema information for the "internship_instructors" table.
+	InternshipInstructorsTable = &schema.Table{
+		Name:       "internship_instructors",
+		Columns:    InternshipInstructorsColumns,
+		PrimaryKey: []*schema.Column{InternshipInstructorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "internshipinstructor_internship_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{InternshipInstructorsColumns[6], InternshipInstructorsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "internshipinstructor_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipInstructorsColumns[7]},
+			},
+		},
+	}
+	// InternshipReviewsColumns holds the columns for the "internship_reviews" table.
+	InternshipReviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "enrollment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "rating", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "body", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "review_status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "reply", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "replied_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "replied_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reports", Type: field.TypeJSON},
+		{Name: "report_count", Type: field.TypeInt, Default: 0},
+		{Name: "moderation_note", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "moderated_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "moderated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// InternshipReviewsTable holds the schema information for the "internship_reviews" table.
+	InternshipReviewsTable = &schema.Table{
+		Name:       "internship_reviews",
+		Columns:    InternshipReviewsColumns,
+		PrimaryKey: []*schema.Column{InternshipReviewsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "internshipreview_internship_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{InternshipReviewsColumns[6], InternshipReviewsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "internshipreview_internship_id_review_status_rating",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipReviewsColumns[6], InternshipReviewsColumns[12], InternshipReviewsColumns[9]},
+			},
+			{
+				Name:    "internshipreview_report_count",
+				Unique:  false,
+				Columns: []*schema.Column{InternshipReviewsColumns[17]},
+			},
+		},
+	}
+	// InternshipRevisionsColumns holds the columns for the "internship_revisions" table.
+	InternshipRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "restored_from_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+	}
+	// InternshipRevisionsTable holds the schema information for the "internship_revisions" table.
+	InternshipRevisionsTable = &schema.Table{
+		Name:       "internship_revisions",
+		Columns:    InternshipRevisionsColumns,
+		PrimaryKey: []*schema.Column{InternshipRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "internshiprevision_internship_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{InternshipRevisionsColumns[6], InternshipRevisionsColumns[7]},
+			},
+		},
+	}
+	// LessonsColumns holds the columns for the "lessons" table.
+	LessonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "module_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "lesson_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "video_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(2048)"}},
+		{Name: "file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "is_preview", Type: field.TypeBool, Default: false},
+		{Name: "required_progress", Type: field.TypeFloat64, Nullable: true},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// LessonsTable holds the schema information for the "lessons" table.
+	LessonsTable = &schema.Table{
+		Name:       "lessons",
+		Columns:    LessonsColumns,
+		PrimaryKey: []*schema.Column{LessonsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lesson_module_id_sort_order",
+				Unique:  false,
+				Columns: []*schema.Column{LessonsColumns[7], LessonsColumns[17]},
+			},
+			{
+				Name:    "lesson_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{LessonsColumns[6]},
+			},
+		},
+	}
+	// LessonProgressesColumns holds the columns for the "lesson_progresses" table.
+	LessonProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "lesson_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "position_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// LessonProgressesTable holds the schema information for the "lesson_progresses" table.
+	LessonProgressesTable = &schema.Table{
+		Name:       "lesson_progresses",
+		Columns:    LessonProgressesColumns,
+		PrimaryKey: []*schema.Column{LessonProgressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lessonprogress_user_id_lesson_id",
+				Unique:  true,
+				Columns: []*schema.Column{LessonProgressesColumns[6], LessonProgressesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "lessonprogress_user_id_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{LessonProgressesColumns[6], LessonProgressesColumns[7]},
+			},
+			{
+				Name:    "lessonprogress_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{LessonProgressesColumns[7]},
+			},
+		},
+	}
+	// LiveSessionsColumns holds the columns for the "live_sessions" table.
+	LiveSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_batch_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "meeting_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "recording_file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "recording_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "session_status", Type: field.TypeString, Default: "scheduled", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// LiveSessionsTable holds the schema information for the "live_sessions" table.
+	LiveSessionsTable = &schema.Table{
+		Name:       "live_sessions",
+		Columns:    LiveSessionsColumns,
+		PrimaryKey: []*schema.Column{LiveSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "livesession_internship_batch_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{LiveSessionsColumns[7], LiveSessionsColumns[10]},
+			},
+			{
+				Name:    "livesession_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{LiveSessionsColumns[6]},
+			},
+		},
+	}
+	// ModulesColumns holds the columns for the "modules" table.
+	ModulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// ModulesTable holds the schema information for the "modules" table.
+	ModulesTable = &schema.Table{
+		Name:       "modules",
+		Columns:    ModulesColumns,
+		PrimaryKey: []*schema.Column{ModulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "module_internship_id_sort_order",
+				Unique:  false,
+				Columns: []*schema.Column{ModulesColumns[6], ModulesColumns[9]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "event_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "event_name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "category", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[6], NotificationsColumns[7]},
+			},
+			{
+				Name:    "notification_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[6], NotificationsColumns[13]},
+			},
+			{
+				Name:    "notification_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[6], NotificationsColumns[2]},
+			},
+		},
+	}
 	// OrdersColumns holds the columns for the "orders" table.
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -281,6 +765,8 @@ var (
 		{Name: "succeeded_at", Type: field.TypeTime, Nullable: true},
 		{Name: "failed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "installment_number", Type: field.TypeInt, Nullable: true},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// PaymentsTable holds the schema information for the "payments" table.
@@ -299,6 +785,14 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{PaymentsColumns[9], PaymentsColumns[10], PaymentsColumns[15], PaymentsColumns[1]},
 			},
+			{
+				Name:    "idx_payment_status_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentsColumns[15], PaymentsColumns[22]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "due_date IS NOT NULL",
+				},
+			},
 			{
 				Name:    "idx_gateway_payment",
 				Unique:  false,
@@ -358,20 +852,481 @@ var (
 			},
 		},
 	}
-	// UsersColumns holds the columns for the "users" table.
-	UsersColumns = []*schema.Column{
+	// PaymentPlansColumns holds the columns for the "payment_plans" table.
+	PaymentPlansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
-		{Name: "full_name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
-		{Name: "email", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
-		{Name: "phone_number", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
-		{Name: "role", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "installments", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "grace_period_days", Type: field.TypeInt, Default: 0},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
 	}
-	// UsersTable holds the schema information for the "users" table.
+	// PaymentPlansTable holds the schema information for the "payment_plans" table.
+	PaymentPlansTable = &schema.Table{
+		Name:       "payment_plans",
+		Columns:    PaymentPlansColumns,
+		PrimaryKey: []*schema.Column{PaymentPlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentplan_internship_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentPlansColumns[7], PaymentPlansColumns[12]},
+			},
+		},
+	}
+	// PaymentRefundsColumns holds the columns for the "payment_refunds" table.
+	PaymentRefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "payment_gateway_provider", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "gateway_payment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "refund_status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "gateway_refund_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "payment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// PaymentRefundsTable holds the schema information for the "payment_refunds" table.
+	PaymentRefundsTable = &schema.Table{
+		Name:       "payment_refunds",
+		Columns:    PaymentRefundsColumns,
+		PrimaryKey: []*schema.Column{PaymentRefundsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_refunds_payments_refunds",
+				Columns:    []*schema.Column{PaymentRefundsColumns[15]},
+				RefColumns: []*schema.Column{PaymentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_payment_refund_payment_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentRefundsColumns[15]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "idx_payment_refund_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRefundsColumns[11], PaymentRefundsColumns[1]},
+			},
+		},
+	}
+	// QuizsColumns holds the columns for the "quizs" table.
+	QuizsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "module_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "questions", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "questions_per_attempt", Type: field.TypeInt, Nullable: true},
+		{Name: "shuffle_questions", Type: field.TypeBool, Default: true},
+		{Name: "time_limit_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "max_attempts", Type: field.TypeInt, Nullable: true},
+		{Name: "passing_percent", Type: field.TypeInt, Nullable: true},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// QuizsTable holds the schema information for the "quizs" table.
+	QuizsTable = &schema.Table{
+		Name:       "quizs",
+		Columns:    QuizsColumns,
+		PrimaryKey: []*schema.Column{QuizsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quiz_module_id_sort_order",
+				Unique:  false,
+				Columns: []*schema.Column{QuizsColumns[7], QuizsColumns[16]},
+			},
+			{
+				Name:    "quiz_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuizsColumns[6]},
+			},
+		},
+	}
+	// QuizAttemptsColumns holds the columns for the "quiz_attempts" table.
+	QuizAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "quiz_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "enrollment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "question_ids", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempt_status", Type: field.TypeString, Default: "in_progress", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "answers", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "results", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "max_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "score_percent", Type: field.TypeFloat64, Nullable: true},
+	}
+	// QuizAttemptsTable holds the schema information for the "quiz_attempts" table.
+	QuizAttemptsTable = &schema.Table{
+		Name:       "quiz_attempts",
+		Columns:    QuizAttemptsColumns,
+		PrimaryKey: []*schema.Column{QuizAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quizattempt_quiz_id_user_id_attempt",
+				Unique:  true,
+				Columns: []*schema.Column{QuizAttemptsColumns[6], QuizAttemptsColumns[9], QuizAttemptsColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "quizattempt_enrollment_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[8]},
+			},
+			{
+				Name:    "quizattempt_internship_id_attempt_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[7], QuizAttemptsColumns[15]},
+			},
+		},
+	}
+	// ReferralsColumns holds the columns for the "referrals" table.
+	ReferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "referrer_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "referee_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "referral_code", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "referral_status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "referee_email", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "referee_phone", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "referee_discount_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "payment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "qualified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reward_eligible_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rewarded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reward_discount_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+	}
+	// ReferralsTable holds the schema information for the "referrals" table.
+	ReferralsTable = &schema.Table{
+		Name:       "referrals",
+		Columns:    ReferralsColumns,
+		PrimaryKey: []*schema.Column{ReferralsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referral_referee_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReferralsColumns[8]},
+			},
+			{
+				Name:    "referral_referrer_id_referral_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[7], ReferralsColumns[10]},
+			},
+			{
+				Name:    "referral_referral_status_reward_eligible_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[10], ReferralsColumns[17]},
+			},
+			{
+				Name:    "referral_referee_email",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[12]},
+			},
+			{
+				Name:    "referral_referee_phone",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[13]},
+			},
+		},
+	}
+	// ResourcesColumns holds the columns for the "resources" table.
+	ResourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "module_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "lesson_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "title", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "file_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// ResourcesTable holds the schema information for the "resources" table.
+	ResourcesTable = &schema.Table{
+		Name:       "resources",
+		Columns:    ResourcesColumns,
+		PrimaryKey: []*schema.Column{ResourcesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resource_internship_id_sort_order",
+				Unique:  false,
+				Columns: []*schema.Column{ResourcesColumns[6], ResourcesColumns[12]},
+			},
+		},
+	}
+	// SessionAttendancesColumns holds the columns for the "session_attendances" table.
+	SessionAttendancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "live_session_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_batch_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "enrollment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "attendance_status", Type: field.TypeString, Default: "present", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "joined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_joined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "left_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "marked_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+	}
+	// SessionAttendancesTable holds the schema information for the "session_attendances" table.
+	SessionAttendancesTable = &schema.Table{
+		Name:       "session_attendances",
+		Columns:    SessionAttendancesColumns,
+		PrimaryKey: []*schema.Column{SessionAttendancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sessionattendance_live_session_id_enrollment_id",
+				Unique:  true,
+				Columns: []*schema.Column{SessionAttendancesColumns[6], SessionAttendancesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "sessionattendance_internship_batch_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionAttendancesColumns[7], SessionAttendancesColumns[9]},
+			},
+		},
+	}
+	// SubmissionsColumns holds the columns for the "submissions" table.
+	SubmissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "assignment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "enrollment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "submission_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "repository_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(2048)"}},
+		{Name: "text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "submitted_at", Type: field.TypeTime},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "late_days", Type: field.TypeInt, Default: 0},
+		{Name: "submission_status", Type: field.TypeString, Default: "submitted", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "penalty", Type: field.TypeFloat64, Default: 0},
+		{Name: "rubric_scores", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "feedback", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "graded_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "graded_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SubmissionsTable holds the schema information for the "submissions" table.
+	SubmissionsTable = &schema.Table{
+		Name:       "submissions",
+		Columns:    SubmissionsColumns,
+		PrimaryKey: []*schema.Column{SubmissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "submission_assignment_id_user_id_attempt",
+				Unique:  true,
+				Columns: []*schema.Column{SubmissionsColumns[7], SubmissionsColumns[9], SubmissionsColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "submission_enrollment_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubmissionsColumns[8]},
+			},
+			{
+				Name:    "submission_internship_id_submission_status",
+				Unique:  false,
+				Columns: []*schema.Column{SubmissionsColumns[6], SubmissionsColumns[18]},
+			},
+		},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "plan_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "subscription_status", Type: field.TypeString, Default: "created", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "gateway_provider", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "gateway_subscription_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "checkout_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "current_period_start", Type: field.TypeTime, Nullable: true},
+		{Name: "current_period_end", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_at_period_end", Type: field.TypeBool, Default: false},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_payment_count", Type: field.TypeInt, Default: 0},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscription_user_id_subscription_status",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[7], SubscriptionsColumns[9]},
+			},
+			{
+				Name:    "subscription_gateway_provider_gateway_subscription_id",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionsColumns[10], SubscriptionsColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "gateway_subscription_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// SubscriptionEventsColumns holds the columns for the "subscription_events" table.
+	SubscriptionEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "gateway_provider", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "gateway_event_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "event_status", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// SubscriptionEventsTable holds the schema information for the "subscription_events" table.
+	SubscriptionEventsTable = &schema.Table{
+		Name:       "subscription_events",
+		Columns:    SubscriptionEventsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscriptionevent_gateway_provider_gateway_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionEventsColumns[7], SubscriptionEventsColumns[8]},
+			},
+			{
+				Name:    "subscriptionevent_subscription_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionEventsColumns[6]},
+			},
+		},
+	}
+	// SubscriptionPlansColumns holds the columns for the "subscription_plans" table.
+	SubscriptionPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "billing_period", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "category_ids", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "gateway_provider", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "gateway_plan_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+	}
+	// SubscriptionPlansTable holds the schema information for the "subscription_plans" table.
+	SubscriptionPlansTable = &schema.Table{
+		Name:       "subscription_plans",
+		Columns:    SubscriptionPlansColumns,
+		PrimaryKey: []*schema.Column{SubscriptionPlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscriptionplan_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionPlansColumns[15]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "full_name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "email", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "phone_number", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "role", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "referral_code", Type: field.TypeString, Unique: true, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "referred_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "headline", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "skills", Type: field.TypeJSON, Nullable: true},
+		{Name: "education", Type: field.TypeJSON, Nullable: true},
+		{Name: "links", Type: field.TypeJSON, Nullable: true},
+		{Name: "resume_file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "photo_file_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "profile_visibility", Type: field.TypeString, Default: "private", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "notification_preferences", Type: field.TypeJSON, Nullable: true},
+	}
+	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
@@ -394,20 +1349,124 @@ var (
 			},
 		},
 	}
+	// WalletTransactionsColumns holds the columns for the "wallet_transactions" table.
+	WalletTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "transaction_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "transaction_reason", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "remaining_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "source_transaction_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "reference_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+	}
+	// WalletTransactionsTable holds the schema information for the "wallet_transactions" table.
+	WalletTransactionsTable = &schema.Table{
+		Name:       "wallet_transactions",
+		Columns:    WalletTransactionsColumns,
+		PrimaryKey: []*schema.Column{WalletTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallettransaction_user_id_currency_transaction_type",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[7], WalletTransactionsColumns[11], WalletTransactionsColumns[8]},
+			},
+			{
+				Name:    "wallettransaction_transaction_type_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[8], WalletTransactionsColumns[13]},
+			},
+			{
+				Name:    "wallettransaction_reference_id_transaction_reason",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[15], WalletTransactionsColumns[9]},
+			},
+			{
+				Name:    "wallettransaction_source_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[14]},
+			},
+		},
+	}
+	// WishlistsColumns holds the columns for the "wishlists" table.
+	WishlistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "internship_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+	}
+	// WishlistsTable holds the schema information for the "wishlists" table.
+	WishlistsTable = &schema.Table{
+		Name:       "wishlists",
+		Columns:    WishlistsColumns,
+		PrimaryKey: []*schema.Column{WishlistsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wishlist_user_id_internship_id",
+				Unique:  true,
+				Columns: []*schema.Column{WishlistsColumns[6], WishlistsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status != 'deleted'",
+				},
+			},
+			{
+				Name:    "wishlist_internship_id",
+				Unique:  false,
+				Columns: []*schema.Column{WishlistsColumns[7]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AssignmentsTable,
 		CartsTable,
 		CartLineItemsTable,
 		CategoriesTable,
+		CertificatesTable,
 		DiscountsTable,
 		FileUploadsTable,
 		InternshipsTable,
+		InternshipApplicationsTable,
 		InternshipBatchesTable,
 		InternshipEnrollmentsTable,
+		InternshipInstructorsTable,
+		InternshipReviewsTable,
+		InternshipRevisionsTable,
+		LessonsTable,
+		LessonProgressesTable,
+		LiveSessionsTable,
+		ModulesTable,
+		NotificationsTable,
 		OrdersTable,
 		PaymentsTable,
 		PaymentAttemptsTable,
+		PaymentPlansTable,
+		PaymentRefundsTable,
+		QuizsTable,
+		QuizAttemptsTable,
+		ReferralsTable,
+		ResourcesTable,
+		SessionAttendancesTable,
+		SubmissionsTable,
+		SubscriptionsTable,
+		SubscriptionEventsTable,
+		SubscriptionPlansTable,
 		UsersTable,
+		WalletTransactionsTable,
+		WishlistsTable,
 	}
 )
 
@@ -417,4 +1476,5 @@ func init() {
 	CategoriesTable.ForeignKeys[0].RefTable = InternshipsTable
 	InternshipsTable.ForeignKeys[0].RefTable = CategoriesTable
 	PaymentAttemptsTable.ForeignKeys[0].RefTable = PaymentsTable
+	PaymentRefundsTable.ForeignKeys[0].RefTable = PaymentsTable
 }
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/module"
+)
+
+// ModuleCreate is the builder for creating a Module entity.
+type ModuleCreate struct {
+	config
+	mutation *ModuleMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (mc *ModuleCreate) SetStatus(s string) *ModuleCreate {
+	mc.mutation.SetStatus(s)
+	return mc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableStatus(s *string) *ModuleCreate {
+	if s != nil {
+		mc.SetStatus(*s)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *ModuleCreate) SetCreatedAt(t time.Time) *ModuleCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableCreatedAt(t *time.Time) *ModuleCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *ModuleCreate) SetUpdatedAt(t time.Time) *ModuleCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableUpdatedAt(t *time.Time) *ModuleCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mc *ModuleCreate) SetCreatedBy(s string) *ModuleCreate {
+	mc.mutation.SetCreatedBy(s)
+	return mc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableCreatedBy(s *string) *ModuleCreate {
+	if s != nil {
+		mc.SetCreatedBy(*s)
+	}
+	return mc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (mc *ModuleCreate) SetUpdatedBy(s string) *ModuleCreate {
+	mc.mutation.SetUpdatedBy(s)
+	return mc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableUpdatedBy(s *string) *ModuleCreate {
+	if s != nil {
+		mc.SetUpdatedBy(*s)
+	}
+	return mc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (mc *ModuleCreate) SetInternshipID(s string) *ModuleCreate {
+	mc.mutation.SetInternshipID(s)
+	return mc
+}
+
+// SetTitle sets the "title" field.
+func (mc *ModuleCreate) SetTitle(s string) *ModuleCreate {
+	mc.mutation.SetTitle(s)
+	return mc
+}
+
+// SetDescription sets the "description" field.
+func (mc *ModuleCreate) SetDescription(s string) *ModuleCreate {
+	mc.mutation.SetDescription(s)
+	return mc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableDescription(s *string) *ModuleCreate {
+	if s != nil {
+		mc.SetDescription(*s)
+	}
+	return mc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (mc *ModuleCreate) SetSortOrder(i int) *ModuleCreate {
+	mc.mutation.SetSortOrder(i)
+	return mc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableSortOrder(i *int) *ModuleCreate {
+	if i != nil {
+		mc.SetSortOrder(*i)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *ModuleCreate) SetID(s string) *ModuleCreate {
+	mc.mutation.SetID(s)
+	return mc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mc *ModuleCreate) SetNillableID(s *string) *ModuleCreate {
+	if s != nil {
+		mc.SetID(*s)
+	}
+	return mc
+}
+
+// Mutation returns the ModuleMutation object of the builder.
+func (mc *ModuleCreate) Mutation() *ModuleMutation {
+	return mc.mutation
+}
+
+// Save creates the Module in the database.
+func (mc *ModuleCreate) Save(ctx context.Context) (*Module, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *ModuleCreate) SaveX(ctx context.Context) *Module {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *ModuleCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *ModuleCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *ModuleCreate) defaults() {
+	if _, ok := mc.mutation.Status(); !ok {
+		v := module.DefaultStatus
+		mc.mutation.SetStatus(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := module.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := module.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mc.mutation.SortOrder(); !ok {
+		v := module.DefaultSortOrder
+		mc.mutation.SetSortOrder(v)
+	}
+	if _, ok := mc.mutation.ID(); !ok {
+		v := module.DefaultID()
+		mc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *ModuleCreate) check() error {
+	if _, ok := mc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Module.status"`)}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Module.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Module.updated_at"`)}
+	}
+	if _, ok := mc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Module.internship_id"`)}
+	}
+	if v, ok := mc.mutation.InternshipID(); ok {
+		if err := module.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Module.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Module.title"`)}
+	}
+	if v, ok := mc.mutation.Title(); ok {
+		if err := module.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Module.title": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Module.sort_order"`)}
+	}
+	return nil
+}
+
+func (mc *ModuleCreate) sqlSave(ctx context.Context) (*Module, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Module.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *ModuleCreate) createSpec() (*Module, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Module{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(module.Table, sqlgraph.NewFieldSpec(module.FieldID, field.TypeString))
+	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.Status(); ok {
+		_spec.SetField(module.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(module.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mc.mutation.CreatedBy(); ok {
+		_spec.SetField(module.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := mc.mutation.UpdatedBy(); ok {
+		_spec.SetField(module.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := mc.mutation.InternshipID(); ok {
+		_spec.SetField(module.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := mc.mutation.Title(); ok {
+		_spec.SetField(module.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := mc.mutation.Description(); ok {
+		_spec.SetField(module.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := mc.mutation.SortOrder(); ok {
+		_spec.SetField(module.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// ModuleCreateBulk is the builder for creating many Module entities in bulk.
+type ModuleCreateBulk struct {
+	config
+	err      error
+	builders []*ModuleCreate
+}
+
+// Save creates the Module entities in the database.
+func (mcb *ModuleCreateBulk) Save(ctx context.Context) ([]*Module, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Module, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *ModuleCreateBulk) SaveX(ctx context.Context) []*Module {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *ModuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *ModuleCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/module"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ModuleUpdate is the builder for updating Module entities.
+type ModuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModuleMutation
+}
+
+// Where appends a list predicates to the ModuleUpdate builder.
+func (mu *ModuleUpdate) Where(ps ...predicate.Module) *ModuleUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetStatus sets the "status" field.
+func (mu *ModuleUpdate) SetStatus(s string) *ModuleUpdate {
+	mu.mutation.SetStatus(s)
+	return mu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableStatus(s *string) *ModuleUpdate {
+	if s != nil {
+		mu.SetStatus(*s)
+	}
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *ModuleUpdate) SetUpdatedAt(t time.Time) *ModuleUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (mu *ModuleUpdate) SetUpdatedBy(s string) *ModuleUpdate {
+	mu.mutation.SetUpdatedBy(s)
+	return mu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableUpdatedBy(s *string) *ModuleUpdate {
+	if s != nil {
+		mu.SetUpdatedBy(*s)
+	}
+	return mu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (mu *ModuleUpdate) ClearUpdatedBy() *ModuleUpdate {
+	mu.mutation.ClearUpdatedBy()
+	return mu
+}
+
+// SetTitle sets the "title" field.
+func (mu *ModuleUpdate) SetTitle(s string) *ModuleUpdate {
+	mu.mutation.SetTitle(s)
+	return mu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableTitle(s *string) *ModuleUpdate {
+	if s != nil {
+		mu.SetTitle(*s)
+	}
+	return mu
+}
+
+// SetDescription sets the "description" field.
+func (mu *ModuleUpdate) SetDescription(s string) *ModuleUpdate {
+	mu.mutation.SetDescription(s)
+	return mu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableDescription(s *string) *ModuleUpdate {
+	if s != nil {
+		mu.SetDescription(*s)
+	}
+	return mu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (mu *ModuleUpdate) ClearDescription() *ModuleUpdate {
+	mu.mutation.ClearDescription()
+	return mu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (mu *ModuleUpdate) SetSortOrder(i int) *ModuleUpdate {
+	mu.mutation.ResetSortOrder()
+	mu.mutation.SetSortOrder(i)
+	return mu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (mu *ModuleUpdate) SetNillableSortOrder(i *int) *ModuleUpdate {
+	if i != nil {
+		mu.SetSortOrder(*i)
+	}
+	return mu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (mu *ModuleUpdate) AddSortOrder(i int) *ModuleUpdate {
+	mu.mutation.AddSortOrder(i)
+	return mu
+}
+
+// Mutation returns the ModuleMutation object of the builder.
+func (mu *ModuleUpdate) Mutation() *ModuleMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *ModuleUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *ModuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *ModuleUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *ModuleUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *ModuleUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := module.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *ModuleUpdate) check() error {
+	if v, ok := mu.mutation.Title(); ok {
+		if err := module.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Module.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *ModuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(module.Table, module.Columns, sqlgraph.NewFieldSpec(module.FieldID, field.TypeString))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Status(); ok {
+		_spec.SetField(module.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.CreatedByCleared() {
+		_spec.ClearField(module.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := mu.mutation.UpdatedBy(); ok {
+		_spec.SetField(module.FieldUpdatedBy, field.TypeString, value)
+	}
+	if mu.mutation.UpdatedByCleared() {
+		_spec.ClearField(module.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := mu.mutation.Title(); ok {
+		_spec.SetField(module.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Description(); ok {
+		_spec.SetField(module.FieldDescription, field.TypeString, value)
+	}
+	if mu.mutation.DescriptionCleared() {
+		_spec.ClearField(module.FieldDescription, field.TypeString)
+	}
+	if value, ok := mu.mutation.SortOrder(); ok {
+		_spec.SetField(module.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedSortOrder(); ok {
+		_spec.AddField(module.FieldSortOrder, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{module.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// ModuleUpdateOne is the builder for updating a single Module entity.
+type ModuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModuleMutation
+}
+
+// SetStatus sets the "status" field.
+func (muo *ModuleUpdateOne) SetStatus(s string) *ModuleUpdateOne {
+	muo.mutation.SetStatus(s)
+	return muo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableStatus(s *string) *ModuleUpdateOne {
+	if s != nil {
+		muo.SetStatus(*s)
+	}
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *ModuleUpdateOne) SetUpdatedAt(t time.Time) *ModuleUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (muo *ModuleUpdateOne) SetUpdatedBy(s string) *ModuleUpdateOne {
+	muo.mutation.SetUpdatedBy(s)
+	return muo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableUpdatedBy(s *string) *ModuleUpdateOne {
+	if s != nil {
+		muo.SetUpdatedBy(*s)
+	}
+	return muo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (muo *ModuleUpdateOne) ClearUpdatedBy() *ModuleUpdateOne {
+	muo.mutation.ClearUpdatedBy()
+	return muo
+}
+
+// SetTitle sets the "title" field.
+func (muo *ModuleUpdateOne) SetTitle(s string) *ModuleUpdateOne {
+	muo.mutation.SetTitle(s)
+	return muo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableTitle(s *string) *ModuleUpdateOne {
+	if s != nil {
+		muo.SetTitle(*s)
+	}
+	return muo
+}
+
+// SetDescription sets the "description" field.
+func (muo *ModuleUpdateOne) SetDescription(s string) *ModuleUpdateOne {
+	muo.mutation.SetDescription(s)
+	return muo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableDescription(s *string) *ModuleUpdateOne {
+	if s != nil {
+		muo.SetDescription(*s)
+	}
+	return muo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (muo *ModuleUpdateOne) ClearDescription() *ModuleUpdateOne {
+	muo.mutation.ClearDescription()
+	return muo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (muo *ModuleUpdateOne) SetSortOrder(i int) *ModuleUpdateOne {
+	muo.mutation.ResetSortOrder()
+	muo.mutation.SetSortOrder(i)
+	return muo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (muo *ModuleUpdateOne) SetNillableSortOrder(i *int) *ModuleUpdateOne {
+	if i != nil {
+		muo.SetSortOrder(*i)
+	}
+	return muo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (muo *ModuleUpdateOne) AddSortOrder(i int) *ModuleUpdateOne {
+	muo.mutation.AddSortOrder(i)
+	return muo
+}
+
+// Mutation returns the ModuleMutation object of the builder.
+func (muo *ModuleUpdateOne) Mutation() *ModuleMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the ModuleUpdate builder.
+func (muo *ModuleUpdateOne) Where(ps ...predicate.Module) *ModuleUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *ModuleUpdateOne) Select(field string, fields ...string) *ModuleUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Module entity.
+func (muo *ModuleUpdateOne) Save(ctx context.Context) (*Module, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *ModuleUpdateOne) SaveX(ctx context.Context) *Module {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *ModuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *ModuleUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *ModuleUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := module.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *ModuleUpdateOne) check() error {
+	if v, ok := muo.mutation.Title(); ok {
+		if err := module.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Module.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *ModuleUpdateOne) sqlSave(ctx context.Context) (_node *Module, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(module.Table, module.Columns, sqlgraph.NewFieldSpec(module.FieldID, field.TypeString))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Module.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, module.FieldID)
+		for _, f := range fields {
+			if !module.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != module.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Status(); ok {
+		_spec.SetField(module.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(module.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.CreatedByCleared() {
+		_spec.ClearField(module.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := muo.mutation.UpdatedBy(); ok {
+		_spec.SetField(module.FieldUpdatedBy, field.TypeString, value)
+	}
+	if muo.mutation.UpdatedByCleared() {
+		_spec.ClearField(module.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := muo.mutation.Title(); ok {
+		_spec.SetField(module.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Description(); ok {
+		_spec.SetField(module.FieldDescription, field.TypeString, value)
+	}
+	if muo.mutation.DescriptionCleared() {
+		_spec.ClearField(module.FieldDescription, field.TypeString)
+	}
+	if value, ok := muo.mutation.SortOrder(); ok {
+		_spec.SetField(module.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(module.FieldSortOrder, field.TypeInt, value)
+	}
+	_node = &Module{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{module.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
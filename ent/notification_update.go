@@ -0,0 +1,504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/notification"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetStatus sets the "status" field.
+func (nu *NotificationUpdate) SetStatus(s string) *NotificationUpdate {
+	nu.mutation.SetStatus(s)
+	return nu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableStatus(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetStatus(*s)
+	}
+	return nu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nu *NotificationUpdate) SetUpdatedAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetUpdatedAt(t)
+	return nu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (nu *NotificationUpdate) SetUpdatedBy(s string) *NotificationUpdate {
+	nu.mutation.SetUpdatedBy(s)
+	return nu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableUpdatedBy(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetUpdatedBy(*s)
+	}
+	return nu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (nu *NotificationUpdate) ClearUpdatedBy() *NotificationUpdate {
+	nu.mutation.ClearUpdatedBy()
+	return nu
+}
+
+// SetTitle sets the "title" field.
+func (nu *NotificationUpdate) SetTitle(s string) *NotificationUpdate {
+	nu.mutation.SetTitle(s)
+	return nu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableTitle(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetTitle(*s)
+	}
+	return nu
+}
+
+// SetBody sets the "body" field.
+func (nu *NotificationUpdate) SetBody(s string) *NotificationUpdate {
+	nu.mutation.SetBody(s)
+	return nu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableBody(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetBody(*s)
+	}
+	return nu
+}
+
+// ClearBody clears the value of the "body" field.
+func (nu *NotificationUpdate) ClearBody() *NotificationUpdate {
+	nu.mutation.ClearBody()
+	return nu
+}
+
+// SetData sets the "data" field.
+func (nu *NotificationUpdate) SetData(m map[string]interface{}) *NotificationUpdate {
+	nu.mutation.SetData(m)
+	return nu
+}
+
+// ClearData clears the value of the "data" field.
+func (nu *NotificationUpdate) ClearData() *NotificationUpdate {
+	nu.mutation.ClearData()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	nu.defaults()
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nu *NotificationUpdate) defaults() {
+	if _, ok := nu.mutation.UpdatedAt(); !ok {
+		v := notification.UpdateDefaultUpdatedAt()
+		nu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.Title(); ok {
+		if err := notification.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Notification.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Status(); ok {
+		_spec.SetField(notification.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nu.mutation.CreatedByCleared() {
+		_spec.ClearField(notification.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := nu.mutation.UpdatedBy(); ok {
+		_spec.SetField(notification.FieldUpdatedBy, field.TypeString, value)
+	}
+	if nu.mutation.UpdatedByCleared() {
+		_spec.ClearField(notification.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := nu.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if nu.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := nu.mutation.Data(); ok {
+		_spec.SetField(notification.FieldData, field.TypeJSON, value)
+	}
+	if nu.mutation.DataCleared() {
+		_spec.ClearField(notification.FieldData, field.TypeJSON)
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetStatus sets the "status" field.
+func (nuo *NotificationUpdateOne) SetStatus(s string) *NotificationUpdateOne {
+	nuo.mutation.SetStatus(s)
+	return nuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableStatus(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetStatus(*s)
+	}
+	return nuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nuo *NotificationUpdateOne) SetUpdatedAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetUpdatedAt(t)
+	return nuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (nuo *NotificationUpdateOne) SetUpdatedBy(s string) *NotificationUpdateOne {
+	nuo.mutation.SetUpdatedBy(s)
+	return nuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableUpdatedBy(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetUpdatedBy(*s)
+	}
+	return nuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (nuo *NotificationUpdateOne) ClearUpdatedBy() *NotificationUpdateOne {
+	nuo.mutation.ClearUpdatedBy()
+	return nuo
+}
+
+// SetTitle sets the "title" field.
+func (nuo *NotificationUpdateOne) SetTitle(s string) *NotificationUpdateOne {
+	nuo.mutation.SetTitle(s)
+	return nuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableTitle(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetTitle(*s)
+	}
+	return nuo
+}
+
+// SetBody sets the "body" field.
+func (nuo *NotificationUpdateOne) SetBody(s string) *NotificationUpdateOne {
+	nuo.mutation.SetBody(s)
+	return nuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableBody(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetBody(*s)
+	}
+	return nuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (nuo *NotificationUpdateOne) ClearBody() *NotificationUpdateOne {
+	nuo.mutation.ClearBody()
+	return nuo
+}
+
+// SetData sets the "data" field.
+func (nuo *NotificationUpdateOne) SetData(m map[string]interface{}) *NotificationUpdateOne {
+	nuo.mutation.SetData(m)
+	return nuo
+}
+
+// ClearData clears the value of the "data" field.
+func (nuo *NotificationUpdateOne) ClearData() *NotificationUpdateOne {
+	nuo.mutation.ClearData()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	nuo.defaults()
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nuo *NotificationUpdateOne) defaults() {
+	if _, ok := nuo.mutation.UpdatedAt(); !ok {
+		v := notification.UpdateDefaultUpdatedAt()
+		nuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.Title(); ok {
+		if err := notification.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Notification.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Status(); ok {
+		_spec.SetField(notification.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nuo.mutation.CreatedByCleared() {
+		_spec.ClearField(notification.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := nuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(notification.FieldUpdatedBy, field.TypeString, value)
+	}
+	if nuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(notification.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if nuo.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Data(); ok {
+		_spec.SetField(notification.FieldData, field.TypeJSON, value)
+	}
+	if nuo.mutation.DataCleared() {
+		_spec.ClearField(notification.FieldData, field.TypeJSON)
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
@@ -60,6 +60,10 @@ type Payment struct {
 	FailedAt *time.Time `json:"failed_at,omitempty"`
 	// RefundedAt holds the value of the "refunded_at" field.
 	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	// InstallmentNumber holds the value of the "installment_number" field.
+	InstallmentNumber *int `json:"installment_number,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate *time.Time `json:"due_date,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage *string `json:"error_message,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -72,9 +76,11 @@ type Payment struct {
 type PaymentEdges struct {
 	// Attempts holds the value of the attempts edge.
 	Attempts []*PaymentAttempt `json:"attempts,omitempty"`
+	// Refunds holds the value of the refunds edge.
+	Refunds []*PaymentRefund `json:"refunds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // AttemptsOrErr returns the Attempts value or an error if the edge
@@ -86,6 +92,15 @@ func (e PaymentEdges) AttemptsOrErr() ([]*PaymentAttempt, error) {
 	return nil, &NotLoadedError{edge: "attempts"}
 }
 
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentEdges) RefundsOrErr() ([]*PaymentRefund, error) {
+	if e.loadedTypes[1] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Payment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,9 +112,11 @@ func (*Payment) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case payment.FieldTrackAttempts:
 			values[i] = new(sql.NullBool)
+		case payment.FieldInstallmentNumber:
+			values[i] = new(sql.NullInt64)
 		case payment.FieldID, payment.FieldStatus, payment.FieldCreatedBy, payment.FieldUpdatedBy, payment.FieldIdempotencyKey, payment.FieldDestinationType, payment.FieldDestinationID, payment.FieldPaymentMethodType, payment.FieldPaymentMethodID, payment.FieldPaymentGatewayProvider, payment.FieldGatewayPaymentID, payment.FieldCurrency, payment.FieldPaymentStatus, payment.FieldErrorMessage:
 			values[i] = new(sql.NullString)
-		case payment.FieldCreatedAt, payment.FieldUpdatedAt, payment.FieldSucceededAt, payment.FieldFailedAt, payment.FieldRefundedAt:
+		case payment.FieldCreatedAt, payment.FieldUpdatedAt, payment.FieldSucceededAt, payment.FieldFailedAt, payment.FieldRefundedAt, payment.FieldDueDate:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -249,6 +266,20 @@ func (pa *Payment) assignValues(columns []string, values []any) error {
 				pa.RefundedAt = new(time.Time)
 				*pa.RefundedAt = value.Time
 			}
+		case payment.FieldInstallmentNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field installment_number", values[i])
+			} else if value.Valid {
+				pa.InstallmentNumber = new(int)
+				*pa.InstallmentNumber = int(value.Int64)
+			}
+		case payment.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				pa.DueDate = new(time.Time)
+				*pa.DueDate = value.Time
+			}
 		case payment.FieldErrorMessage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field error_message", values[i])
@@ -274,6 +305,11 @@ func (pa *Payment) QueryAttempts() *PaymentAttemptQuery {
 	return NewPaymentClient(pa.config).QueryAttempts(pa)
 }
 
+// QueryRefunds queries the "refunds" edge of the Payment entity.
+func (pa *Payment) QueryRefunds() *PaymentRefundQuery {
+	return NewPaymentClient(pa.config).QueryRefunds(pa)
+}
+
 // Update returns a builder for updating this Payment.
 // Note that you need to call Payment.Unwrap() before calling this method if this Payment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -367,6 +403,16 @@ func (pa *Payment) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := pa.InstallmentNumber; v != nil {
+		builder.WriteString("installment_number=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pa.DueDate; v != nil {
+		builder.WriteString("due_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := pa.ErrorMessage; v != nil {
 		builder.WriteString("error_message=")
 		builder.WriteString(*v)
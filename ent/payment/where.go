@@ -167,6 +167,16 @@ func RefundedAt(v time.Time) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldRefundedAt, v))
 }
 
+// InstallmentNumber applies equality check predicate on the "installment_number" field. It's identical to InstallmentNumberEQ.
+func InstallmentNumber(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldInstallmentNumber, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldDueDate, v))
+}
+
 // ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
 func ErrorMessage(v string) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldErrorMessage, v))
@@ -1387,6 +1397,106 @@ func RefundedAtNotNil() predicate.Payment {
 	return predicate.Payment(sql.FieldNotNull(FieldRefundedAt))
 }
 
+// InstallmentNumberEQ applies the EQ predicate on the "installment_number" field.
+func InstallmentNumberEQ(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberNEQ applies the NEQ predicate on the "installment_number" field.
+func InstallmentNumberNEQ(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberIn applies the In predicate on the "installment_number" field.
+func InstallmentNumberIn(vs ...int) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldInstallmentNumber, vs...))
+}
+
+// InstallmentNumberNotIn applies the NotIn predicate on the "installment_number" field.
+func InstallmentNumberNotIn(vs ...int) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldInstallmentNumber, vs...))
+}
+
+// InstallmentNumberGT applies the GT predicate on the "installment_number" field.
+func InstallmentNumberGT(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberGTE applies the GTE predicate on the "installment_number" field.
+func InstallmentNumberGTE(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberLT applies the LT predicate on the "installment_number" field.
+func InstallmentNumberLT(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberLTE applies the LTE predicate on the "installment_number" field.
+func InstallmentNumberLTE(v int) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberIsNil applies the IsNil predicate on the "installment_number" field.
+func InstallmentNumberIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldInstallmentNumber))
+}
+
+// InstallmentNumberNotNil applies the NotNil predicate on the "installment_number" field.
+func InstallmentNumberNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldInstallmentNumber))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldDueDate, v))
+}
+
+// DueDateIsNil applies the IsNil predicate on the "due_date" field.
+func DueDateIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldDueDate))
+}
+
+// DueDateNotNil applies the NotNil predicate on the "due_date" field.
+func DueDateNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldDueDate))
+}
+
 // ErrorMessageEQ applies the EQ predicate on the "error_message" field.
 func ErrorMessageEQ(v string) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldErrorMessage, v))
@@ -1485,6 +1595,29 @@ func HasAttemptsWith(preds ...predicate.PaymentAttempt) predicate.Payment {
 	})
 }
 
+// HasRefunds applies the HasEdge predicate on the "refunds" edge.
+func HasRefunds() predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RefundsTable, RefundsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundsWith applies the HasEdge predicate on the "refunds" edge with a given conditions (other predicates).
+func HasRefundsWith(preds ...predicate.PaymentRefund) predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := newRefundsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Payment) predicate.Payment {
 	return predicate.Payment(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/codegeeky/ent/payment"
 	"github.com/omkar273/codegeeky/ent/paymentattempt"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -255,6 +256,34 @@ func (pc *PaymentCreate) SetNillableRefundedAt(t *time.Time) *PaymentCreate {
 	return pc
 }
 
+// SetInstallmentNumber sets the "installment_number" field.
+func (pc *PaymentCreate) SetInstallmentNumber(i int) *PaymentCreate {
+	pc.mutation.SetInstallmentNumber(i)
+	return pc
+}
+
+// SetNillableInstallmentNumber sets the "installment_number" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableInstallmentNumber(i *int) *PaymentCreate {
+	if i != nil {
+		pc.SetInstallmentNumber(*i)
+	}
+	return pc
+}
+
+// SetDueDate sets the "due_date" field.
+func (pc *PaymentCreate) SetDueDate(t time.Time) *PaymentCreate {
+	pc.mutation.SetDueDate(t)
+	return pc
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableDueDate(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetDueDate(*t)
+	}
+	return pc
+}
+
 // SetErrorMessage sets the "error_message" field.
 func (pc *PaymentCreate) SetErrorMessage(s string) *PaymentCreate {
 	pc.mutation.SetErrorMessage(s)
@@ -290,6 +319,21 @@ func (pc *PaymentCreate) AddAttempts(p ...*PaymentAttempt) *PaymentCreate {
 	return pc.AddAttemptIDs(ids...)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (pc *PaymentCreate) AddRefundIDs(ids ...string) *PaymentCreate {
+	pc.mutation.AddRefundIDs(ids...)
+	return pc
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (pc *PaymentCreate) AddRefunds(p ...*PaymentRefund) *PaymentCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRefundIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (pc *PaymentCreate) Mutation() *PaymentMutation {
 	return pc.mutation
@@ -530,6 +574,14 @@ func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		_spec.SetField(payment.FieldRefundedAt, field.TypeTime, value)
 		_node.RefundedAt = &value
 	}
+	if value, ok := pc.mutation.InstallmentNumber(); ok {
+		_spec.SetField(payment.FieldInstallmentNumber, field.TypeInt, value)
+		_node.InstallmentNumber = &value
+	}
+	if value, ok := pc.mutation.DueDate(); ok {
+		_spec.SetField(payment.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = &value
+	}
 	if value, ok := pc.mutation.ErrorMessage(); ok {
 		_spec.SetField(payment.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = &value
@@ -550,6 +602,22 @@ func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/codegeeky/ent/payment"
 	"github.com/omkar273/codegeeky/ent/paymentattempt"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
 	"github.com/omkar273/codegeeky/ent/predicate"
 )
 
@@ -25,6 +26,7 @@ type PaymentQuery struct {
 	inters       []Interceptor
 	predicates   []predicate.Payment
 	withAttempts *PaymentAttemptQuery
+	withRefunds  *PaymentRefundQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (pq *PaymentQuery) QueryAttempts() *PaymentAttemptQuery {
 	return query
 }
 
+// QueryRefunds chains the current query on the "refunds" edge.
+func (pq *PaymentQuery) QueryRefunds() *PaymentRefundQuery {
+	query := (&PaymentRefundClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, selector),
+			sqlgraph.To(paymentrefund.Table, paymentrefund.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payment.RefundsTable, payment.RefundsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Payment entity from the query.
 // Returns a *NotFoundError when no Payment was found.
 func (pq *PaymentQuery) First(ctx context.Context) (*Payment, error) {
@@ -276,6 +300,7 @@ func (pq *PaymentQuery) Clone() *PaymentQuery {
 		inters:       append([]Interceptor{}, pq.inters...),
 		predicates:   append([]predicate.Payment{}, pq.predicates...),
 		withAttempts: pq.withAttempts.Clone(),
+		withRefunds:  pq.withRefunds.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -293,6 +318,17 @@ func (pq *PaymentQuery) WithAttempts(opts ...func(*PaymentAttemptQuery)) *Paymen
 	return pq
 }
 
+// WithRefunds tells the query-builder to eager-load the nodes that are connected to
+// the "refunds" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PaymentQuery) WithRefunds(opts ...func(*PaymentRefundQuery)) *PaymentQuery {
+	query := (&PaymentRefundClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRefunds = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (pq *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 	var (
 		nodes       = []*Payment{}
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			pq.withAttempts != nil,
+			pq.withRefunds != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (pq *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 			return nil, err
 		}
 	}
+	if query := pq.withRefunds; query != nil {
+		if err := pq.loadRefunds(ctx, query, nodes,
+			func(n *Payment) { n.Edges.Refunds = []*PaymentRefund{} },
+			func(n *Payment, e *PaymentRefund) { n.Edges.Refunds = append(n.Edges.Refunds, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +477,36 @@ func (pq *PaymentQuery) loadAttempts(ctx context.Context, query *PaymentAttemptQ
 	}
 	return nil
 }
+func (pq *PaymentQuery) loadRefunds(ctx context.Context, query *PaymentRefundQuery, nodes []*Payment, init func(*Payment), assign func(*Payment, *PaymentRefund)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Payment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(paymentrefund.FieldPaymentID)
+	}
+	query.Where(predicate.PaymentRefund(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(payment.RefundsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PaymentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PaymentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
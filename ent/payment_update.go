@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/codegeeky/ent/payment"
 	"github.com/omkar273/codegeeky/ent/paymentattempt"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
 	"github.com/omkar273/codegeeky/ent/predicate"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
@@ -245,6 +246,26 @@ func (pu *PaymentUpdate) ClearRefundedAt() *PaymentUpdate {
 	return pu
 }
 
+// SetDueDate sets the "due_date" field.
+func (pu *PaymentUpdate) SetDueDate(t time.Time) *PaymentUpdate {
+	pu.mutation.SetDueDate(t)
+	return pu
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableDueDate(t *time.Time) *PaymentUpdate {
+	if t != nil {
+		pu.SetDueDate(*t)
+	}
+	return pu
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (pu *PaymentUpdate) ClearDueDate() *PaymentUpdate {
+	pu.mutation.ClearDueDate()
+	return pu
+}
+
 // SetErrorMessage sets the "error_message" field.
 func (pu *PaymentUpdate) SetErrorMessage(s string) *PaymentUpdate {
 	pu.mutation.SetErrorMessage(s)
@@ -280,6 +301,21 @@ func (pu *PaymentUpdate) AddAttempts(p ...*PaymentAttempt) *PaymentUpdate {
 	return pu.AddAttemptIDs(ids...)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (pu *PaymentUpdate) AddRefundIDs(ids ...string) *PaymentUpdate {
+	pu.mutation.AddRefundIDs(ids...)
+	return pu
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (pu *PaymentUpdate) AddRefunds(p ...*PaymentRefund) *PaymentUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRefundIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (pu *PaymentUpdate) Mutation() *PaymentMutation {
 	return pu.mutation
@@ -306,6 +342,27 @@ func (pu *PaymentUpdate) RemoveAttempts(p ...*PaymentAttempt) *PaymentUpdate {
 	return pu.RemoveAttemptIDs(ids...)
 }
 
+// ClearRefunds clears all "refunds" edges to the PaymentRefund entity.
+func (pu *PaymentUpdate) ClearRefunds() *PaymentUpdate {
+	pu.mutation.ClearRefunds()
+	return pu
+}
+
+// RemoveRefundIDs removes the "refunds" edge to PaymentRefund entities by IDs.
+func (pu *PaymentUpdate) RemoveRefundIDs(ids ...string) *PaymentUpdate {
+	pu.mutation.RemoveRefundIDs(ids...)
+	return pu
+}
+
+// RemoveRefunds removes "refunds" edges to PaymentRefund entities.
+func (pu *PaymentUpdate) RemoveRefunds(p ...*PaymentRefund) *PaymentUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRefundIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PaymentUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -435,6 +492,15 @@ func (pu *PaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.RefundedAtCleared() {
 		_spec.ClearField(payment.FieldRefundedAt, field.TypeTime)
 	}
+	if pu.mutation.InstallmentNumberCleared() {
+		_spec.ClearField(payment.FieldInstallmentNumber, field.TypeInt)
+	}
+	if value, ok := pu.mutation.DueDate(); ok {
+		_spec.SetField(payment.FieldDueDate, field.TypeTime, value)
+	}
+	if pu.mutation.DueDateCleared() {
+		_spec.ClearField(payment.FieldDueDate, field.TypeTime)
+	}
 	if value, ok := pu.mutation.ErrorMessage(); ok {
 		_spec.SetField(payment.FieldErrorMessage, field.TypeString, value)
 	}
@@ -486,6 +552,51 @@ func (pu *PaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !pu.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{payment.Label}
@@ -720,6 +831,26 @@ func (puo *PaymentUpdateOne) ClearRefundedAt() *PaymentUpdateOne {
 	return puo
 }
 
+// SetDueDate sets the "due_date" field.
+func (puo *PaymentUpdateOne) SetDueDate(t time.Time) *PaymentUpdateOne {
+	puo.mutation.SetDueDate(t)
+	return puo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableDueDate(t *time.Time) *PaymentUpdateOne {
+	if t != nil {
+		puo.SetDueDate(*t)
+	}
+	return puo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (puo *PaymentUpdateOne) ClearDueDate() *PaymentUpdateOne {
+	puo.mutation.ClearDueDate()
+	return puo
+}
+
 // SetErrorMessage sets the "error_message" field.
 func (puo *PaymentUpdateOne) SetErrorMessage(s string) *PaymentUpdateOne {
 	puo.mutation.SetErrorMessage(s)
@@ -755,6 +886,21 @@ func (puo *PaymentUpdateOne) AddAttempts(p ...*PaymentAttempt) *PaymentUpdateOne
 	return puo.AddAttemptIDs(ids...)
 }
 
+// AddRefundIDs adds the "refunds" edge to the PaymentRefund entity by IDs.
+func (puo *PaymentUpdateOne) AddRefundIDs(ids ...string) *PaymentUpdateOne {
+	puo.mutation.AddRefundIDs(ids...)
+	return puo
+}
+
+// AddRefunds adds the "refunds" edges to the PaymentRefund entity.
+func (puo *PaymentUpdateOne) AddRefunds(p ...*PaymentRefund) *PaymentUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRefundIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (puo *PaymentUpdateOne) Mutation() *PaymentMutation {
 	return puo.mutation
@@ -781,6 +927,27 @@ func (puo *PaymentUpdateOne) RemoveAttempts(p ...*PaymentAttempt) *PaymentUpdate
 	return puo.RemoveAttemptIDs(ids...)
 }
 
+// ClearRefunds clears all "refunds" edges to the PaymentRefund entity.
+func (puo *PaymentUpdateOne) ClearRefunds() *PaymentUpdateOne {
+	puo.mutation.ClearRefunds()
+	return puo
+}
+
+// RemoveRefundIDs removes the "refunds" edge to PaymentRefund entities by IDs.
+func (puo *PaymentUpdateOne) RemoveRefundIDs(ids ...string) *PaymentUpdateOne {
+	puo.mutation.RemoveRefundIDs(ids...)
+	return puo
+}
+
+// RemoveRefunds removes "refunds" edges to PaymentRefund entities.
+func (puo *PaymentUpdateOne) RemoveRefunds(p ...*PaymentRefund) *PaymentUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveRefundIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentUpdate builder.
 func (puo *PaymentUpdateOne) Where(ps ...predicate.Payment) *PaymentUpdateOne {
 	puo.mutation.Where(ps...)
@@ -940,6 +1107,15 @@ func (puo *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err e
 	if puo.mutation.RefundedAtCleared() {
 		_spec.ClearField(payment.FieldRefundedAt, field.TypeTime)
 	}
+	if puo.mutation.InstallmentNumberCleared() {
+		_spec.ClearField(payment.FieldInstallmentNumber, field.TypeInt)
+	}
+	if value, ok := puo.mutation.DueDate(); ok {
+		_spec.SetField(payment.FieldDueDate, field.TypeTime, value)
+	}
+	if puo.mutation.DueDateCleared() {
+		_spec.ClearField(payment.FieldDueDate, field.TypeTime)
+	}
 	if value, ok := puo.mutation.ErrorMessage(); ok {
 		_spec.SetField(payment.FieldErrorMessage, field.TypeString, value)
 	}
@@ -991,6 +1167,51 @@ func (puo *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRefundsIDs(); len(nodes) > 0 && !puo.mutation.RefundsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RefundsTable,
+			Columns: []string{payment.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Payment{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
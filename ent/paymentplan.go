@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// PaymentPlan is the model entity for the PaymentPlan schema.
+type PaymentPlan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Installments holds the value of the "installments" field.
+	Installments []types.PaymentPlanInstallment `json:"installments,omitempty"`
+	// GracePeriodDays holds the value of the "grace_period_days" field.
+	GracePeriodDays int `json:"grace_period_days,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive     bool `json:"is_active,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentPlan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentplan.FieldMetadata, paymentplan.FieldInstallments:
+			values[i] = new([]byte)
+		case paymentplan.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case paymentplan.FieldGracePeriodDays:
+			values[i] = new(sql.NullInt64)
+		case paymentplan.FieldID, paymentplan.FieldStatus, paymentplan.FieldCreatedBy, paymentplan.FieldUpdatedBy, paymentplan.FieldInternshipID, paymentplan.FieldName, paymentplan.FieldDescription:
+			values[i] = new(sql.NullString)
+		case paymentplan.FieldCreatedAt, paymentplan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentPlan fields.
+func (pp *PaymentPlan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentplan.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pp.ID = value.String
+			}
+		case paymentplan.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pp.Status = value.String
+			}
+		case paymentplan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pp.CreatedAt = value.Time
+			}
+		case paymentplan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pp.UpdatedAt = value.Time
+			}
+		case paymentplan.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pp.CreatedBy = value.String
+			}
+		case paymentplan.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pp.UpdatedBy = value.String
+			}
+		case paymentplan.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case paymentplan.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				pp.InternshipID = value.String
+			}
+		case paymentplan.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pp.Name = value.String
+			}
+		case paymentplan.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pp.Description = value.String
+			}
+		case paymentplan.FieldInstallments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field installments", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.Installments); err != nil {
+					return fmt.Errorf("unmarshal field installments: %w", err)
+				}
+			}
+		case paymentplan.FieldGracePeriodDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field grace_period_days", values[i])
+			} else if value.Valid {
+				pp.GracePeriodDays = int(value.Int64)
+			}
+		case paymentplan.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				pp.IsActive = value.Bool
+			}
+		default:
+			pp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentPlan.
+// This includes values selected through modifiers, order, etc.
+func (pp *PaymentPlan) Value(name string) (ent.Value, error) {
+	return pp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PaymentPlan.
+// Note that you need to call PaymentPlan.Unwrap() before calling this method if this PaymentPlan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pp *PaymentPlan) Update() *PaymentPlanUpdateOne {
+	return NewPaymentPlanClient(pp.config).UpdateOne(pp)
+}
+
+// Unwrap unwraps the PaymentPlan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pp *PaymentPlan) Unwrap() *PaymentPlan {
+	_tx, ok := pp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentPlan is not a transactional entity")
+	}
+	pp.config.driver = _tx.drv
+	return pp
+}
+
+// String implements the fmt.Stringer.
+func (pp *PaymentPlan) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentPlan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pp.ID))
+	builder.WriteString("status=")
+	builder.WriteString(pp.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pp.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pp.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", pp.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(pp.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pp.Description)
+	builder.WriteString(", ")
+	builder.WriteString("installments=")
+	builder.WriteString(fmt.Sprintf("%v", pp.Installments))
+	builder.WriteString(", ")
+	builder.WriteString("grace_period_days=")
+	builder.WriteString(fmt.Sprintf("%v", pp.GracePeriodDays))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", pp.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentPlans is a parsable slice of PaymentPlan.
+type PaymentPlans []*PaymentPlan
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// PaymentPlanCreate is the builder for creating a PaymentPlan entity.
+type PaymentPlanCreate struct {
+	config
+	mutation *PaymentPlanMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (ppc *PaymentPlanCreate) SetStatus(s string) *PaymentPlanCreate {
+	ppc.mutation.SetStatus(s)
+	return ppc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableStatus(s *string) *PaymentPlanCreate {
+	if s != nil {
+		ppc.SetStatus(*s)
+	}
+	return ppc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ppc *PaymentPlanCreate) SetCreatedAt(t time.Time) *PaymentPlanCreate {
+	ppc.mutation.SetCreatedAt(t)
+	return ppc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableCreatedAt(t *time.Time) *PaymentPlanCreate {
+	if t != nil {
+		ppc.SetCreatedAt(*t)
+	}
+	return ppc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppc *PaymentPlanCreate) SetUpdatedAt(t time.Time) *PaymentPlanCreate {
+	ppc.mutation.SetUpdatedAt(t)
+	return ppc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableUpdatedAt(t *time.Time) *PaymentPlanCreate {
+	if t != nil {
+		ppc.SetUpdatedAt(*t)
+	}
+	return ppc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ppc *PaymentPlanCreate) SetCreatedBy(s string) *PaymentPlanCreate {
+	ppc.mutation.SetCreatedBy(s)
+	return ppc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableCreatedBy(s *string) *PaymentPlanCreate {
+	if s != nil {
+		ppc.SetCreatedBy(*s)
+	}
+	return ppc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ppc *PaymentPlanCreate) SetUpdatedBy(s string) *PaymentPlanCreate {
+	ppc.mutation.SetUpdatedBy(s)
+	return ppc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableUpdatedBy(s *string) *PaymentPlanCreate {
+	if s != nil {
+		ppc.SetUpdatedBy(*s)
+	}
+	return ppc
+}
+
+// SetMetadata sets the "metadata" field.
+func (ppc *PaymentPlanCreate) SetMetadata(m map[string]string) *PaymentPlanCreate {
+	ppc.mutation.SetMetadata(m)
+	return ppc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (ppc *PaymentPlanCreate) SetInternshipID(s string) *PaymentPlanCreate {
+	ppc.mutation.SetInternshipID(s)
+	return ppc
+}
+
+// SetName sets the "name" field.
+func (ppc *PaymentPlanCreate) SetName(s string) *PaymentPlanCreate {
+	ppc.mutation.SetName(s)
+	return ppc
+}
+
+// SetDescription sets the "description" field.
+func (ppc *PaymentPlanCreate) SetDescription(s string) *PaymentPlanCreate {
+	ppc.mutation.SetDescription(s)
+	return ppc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableDescription(s *string) *PaymentPlanCreate {
+	if s != nil {
+		ppc.SetDescription(*s)
+	}
+	return ppc
+}
+
+// SetInstallments sets the "installments" field.
+func (ppc *PaymentPlanCreate) SetInstallments(tpi []types.PaymentPlanInstallment) *PaymentPlanCreate {
+	ppc.mutation.SetInstallments(tpi)
+	return ppc
+}
+
+// SetGracePeriodDays sets the "grace_period_days" field.
+func (ppc *PaymentPlanCreate) SetGracePeriodDays(i int) *PaymentPlanCreate {
+	ppc.mutation.SetGracePeriodDays(i)
+	return ppc
+}
+
+// SetNillableGracePeriodDays sets the "grace_period_days" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableGracePeriodDays(i *int) *PaymentPlanCreate {
+	if i != nil {
+		ppc.SetGracePeriodDays(*i)
+	}
+	return ppc
+}
+
+// SetIsActive sets the "is_active" field.
+func (ppc *PaymentPlanCreate) SetIsActive(b bool) *PaymentPlanCreate {
+	ppc.mutation.SetIsActive(b)
+	return ppc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableIsActive(b *bool) *PaymentPlanCreate {
+	if b != nil {
+		ppc.SetIsActive(*b)
+	}
+	return ppc
+}
+
+// SetID sets the "id" field.
+func (ppc *PaymentPlanCreate) SetID(s string) *PaymentPlanCreate {
+	ppc.mutation.SetID(s)
+	return ppc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ppc *PaymentPlanCreate) SetNillableID(s *string) *PaymentPlanCreate {
+	if s != nil {
+		ppc.SetID(*s)
+	}
+	return ppc
+}
+
+// Mutation returns the PaymentPlanMutation object of the builder.
+func (ppc *PaymentPlanCreate) Mutation() *PaymentPlanMutation {
+	return ppc.mutation
+}
+
+// Save creates the PaymentPlan in the database.
+func (ppc *PaymentPlanCreate) Save(ctx context.Context) (*PaymentPlan, error) {
+	ppc.defaults()
+	return withHooks(ctx, ppc.sqlSave, ppc.mutation, ppc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *PaymentPlanCreate) SaveX(ctx context.Context) *PaymentPlan {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppc *PaymentPlanCreate) Exec(ctx context.Context) error {
+	_, err := ppc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppc *PaymentPlanCreate) ExecX(ctx context.Context) {
+	if err := ppc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppc *PaymentPlanCreate) defaults() {
+	if _, ok := ppc.mutation.Status(); !ok {
+		v := paymentplan.DefaultStatus
+		ppc.mutation.SetStatus(v)
+	}
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		v := paymentplan.DefaultCreatedAt()
+		ppc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ppc.mutation.UpdatedAt(); !ok {
+		v := paymentplan.DefaultUpdatedAt()
+		ppc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ppc.mutation.Metadata(); !ok {
+		v := paymentplan.DefaultMetadata
+		ppc.mutation.SetMetadata(v)
+	}
+	if _, ok := ppc.mutation.GracePeriodDays(); !ok {
+		v := paymentplan.DefaultGracePeriodDays
+		ppc.mutation.SetGracePeriodDays(v)
+	}
+	if _, ok := ppc.mutation.IsActive(); !ok {
+		v := paymentplan.DefaultIsActive
+		ppc.mutation.SetIsActive(v)
+	}
+	if _, ok := ppc.mutation.ID(); !ok {
+		v := paymentplan.DefaultID()
+		ppc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *PaymentPlanCreate) check() error {
+	if _, ok := ppc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentPlan.status"`)}
+	}
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentPlan.created_at"`)}
+	}
+	if _, ok := ppc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentPlan.updated_at"`)}
+	}
+	if _, ok := ppc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "PaymentPlan.internship_id"`)}
+	}
+	if v, ok := ppc.mutation.InternshipID(); ok {
+		if err := paymentplan.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := ppc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PaymentPlan.name"`)}
+	}
+	if v, ok := ppc.mutation.Name(); ok {
+		if err := paymentplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.name": %w`, err)}
+		}
+	}
+	if _, ok := ppc.mutation.Installments(); !ok {
+		return &ValidationError{Name: "installments", err: errors.New(`ent: missing required field "PaymentPlan.installments"`)}
+	}
+	if _, ok := ppc.mutation.GracePeriodDays(); !ok {
+		return &ValidationError{Name: "grace_period_days", err: errors.New(`ent: missing required field "PaymentPlan.grace_period_days"`)}
+	}
+	if v, ok := ppc.mutation.GracePeriodDays(); ok {
+		if err := paymentplan.GracePeriodDaysValidator(v); err != nil {
+			return &ValidationError{Name: "grace_period_days", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.grace_period_days": %w`, err)}
+		}
+	}
+	if _, ok := ppc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "PaymentPlan.is_active"`)}
+	}
+	return nil
+}
+
+func (ppc *PaymentPlanCreate) sqlSave(ctx context.Context) (*PaymentPlan, error) {
+	if err := ppc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PaymentPlan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ppc.mutation.id = &_node.ID
+	ppc.mutation.done = true
+	return _node, nil
+}
+
+func (ppc *PaymentPlanCreate) createSpec() (*PaymentPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentPlan{config: ppc.config}
+		_spec = sqlgraph.NewCreateSpec(paymentplan.Table, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeString))
+	)
+	if id, ok := ppc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ppc.mutation.Status(); ok {
+		_spec.SetField(paymentplan.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ppc.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ppc.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentplan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ppc.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentplan.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ppc.mutation.UpdatedBy(); ok {
+		_spec.SetField(paymentplan.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := ppc.mutation.Metadata(); ok {
+		_spec.SetField(paymentplan.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := ppc.mutation.InternshipID(); ok {
+		_spec.SetField(paymentplan.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := ppc.mutation.Name(); ok {
+		_spec.SetField(paymentplan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ppc.mutation.Description(); ok {
+		_spec.SetField(paymentplan.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ppc.mutation.Installments(); ok {
+		_spec.SetField(paymentplan.FieldInstallments, field.TypeJSON, value)
+		_node.Installments = value
+	}
+	if value, ok := ppc.mutation.GracePeriodDays(); ok {
+		_spec.SetField(paymentplan.FieldGracePeriodDays, field.TypeInt, value)
+		_node.GracePeriodDays = value
+	}
+	if value, ok := ppc.mutation.IsActive(); ok {
+		_spec.SetField(paymentplan.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	return _node, _spec
+}
+
+// PaymentPlanCreateBulk is the builder for creating many PaymentPlan entities in bulk.
+type PaymentPlanCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentPlanCreate
+}
+
+// Save creates the PaymentPlan entities in the database.
+func (ppcb *PaymentPlanCreateBulk) Save(ctx context.Context) ([]*PaymentPlan, error) {
+	if ppcb.err != nil {
+		return nil, ppcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*PaymentPlan, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *PaymentPlanCreateBulk) SaveX(ctx context.Context) []*PaymentPlan {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppcb *PaymentPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppcb *PaymentPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := ppcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
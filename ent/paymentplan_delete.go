@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// PaymentPlanDelete is the builder for deleting a PaymentPlan entity.
+type PaymentPlanDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentPlanMutation
+}
+
+// Where appends a list predicates to the PaymentPlanDelete builder.
+func (ppd *PaymentPlanDelete) Where(ps ...predicate.PaymentPlan) *PaymentPlanDelete {
+	ppd.mutation.Where(ps...)
+	return ppd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ppd *PaymentPlanDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ppd.sqlExec, ppd.mutation, ppd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppd *PaymentPlanDelete) ExecX(ctx context.Context) int {
+	n, err := ppd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ppd *PaymentPlanDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentplan.Table, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeString))
+	if ps := ppd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ppd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ppd.mutation.done = true
+	return affected, err
+}
+
+// PaymentPlanDeleteOne is the builder for deleting a single PaymentPlan entity.
+type PaymentPlanDeleteOne struct {
+	ppd *PaymentPlanDelete
+}
+
+// Where appends a list predicates to the PaymentPlanDelete builder.
+func (ppdo *PaymentPlanDeleteOne) Where(ps ...predicate.PaymentPlan) *PaymentPlanDeleteOne {
+	ppdo.ppd.mutation.Where(ps...)
+	return ppdo
+}
+
+// Exec executes the deletion query.
+func (ppdo *PaymentPlanDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppdo.ppd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentplan.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppdo *PaymentPlanDeleteOne) ExecX(ctx context.Context) {
+	if err := ppdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
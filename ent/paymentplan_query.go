@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// PaymentPlanQuery is the builder for querying PaymentPlan entities.
+type PaymentPlanQuery struct {
+	config
+	ctx        *QueryContext
+	order      []paymentplan.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PaymentPlan
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PaymentPlanQuery builder.
+func (ppq *PaymentPlanQuery) Where(ps ...predicate.PaymentPlan) *PaymentPlanQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit the number of records to be returned by this query.
+func (ppq *PaymentPlanQuery) Limit(limit int) *PaymentPlanQuery {
+	ppq.ctx.Limit = &limit
+	return ppq
+}
+
+// Offset to start from.
+func (ppq *PaymentPlanQuery) Offset(offset int) *PaymentPlanQuery {
+	ppq.ctx.Offset = &offset
+	return ppq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppq *PaymentPlanQuery) Unique(unique bool) *PaymentPlanQuery {
+	ppq.ctx.Unique = &unique
+	return ppq
+}
+
+// Order specifies how the records should be ordered.
+func (ppq *PaymentPlanQuery) Order(o ...paymentplan.OrderOption) *PaymentPlanQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// First returns the first PaymentPlan entity from the query.
+// Returns a *NotFoundError when no PaymentPlan was found.
+func (ppq *PaymentPlanQuery) First(ctx context.Context) (*PaymentPlan, error) {
+	nodes, err := ppq.Limit(1).All(setContextOp(ctx, ppq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{paymentplan.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) FirstX(ctx context.Context) *PaymentPlan {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PaymentPlan ID from the query.
+// Returns a *NotFoundError when no PaymentPlan ID was found.
+func (ppq *PaymentPlanQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ppq.Limit(1).IDs(setContextOp(ctx, ppq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{paymentplan.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) FirstIDX(ctx context.Context) string {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PaymentPlan entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PaymentPlan entity is found.
+// Returns a *NotFoundError when no PaymentPlan entities are found.
+func (ppq *PaymentPlanQuery) Only(ctx context.Context) (*PaymentPlan, error) {
+	nodes, err := ppq.Limit(2).All(setContextOp(ctx, ppq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{paymentplan.Label}
+	default:
+		return nil, &NotSingularError{paymentplan.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) OnlyX(ctx context.Context) *PaymentPlan {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PaymentPlan ID in the query.
+// Returns a *NotSingularError when more than one PaymentPlan ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *PaymentPlanQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ppq.Limit(2).IDs(setContextOp(ctx, ppq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{paymentplan.Label}
+	default:
+		err = &NotSingularError{paymentplan.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PaymentPlans.
+func (ppq *PaymentPlanQuery) All(ctx context.Context) ([]*PaymentPlan, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryAll)
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PaymentPlan, *PaymentPlanQuery]()
+	return withInterceptors[[]*PaymentPlan](ctx, ppq, qr, ppq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) AllX(ctx context.Context) []*PaymentPlan {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PaymentPlan IDs.
+func (ppq *PaymentPlanQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ppq.ctx.Unique == nil && ppq.path != nil {
+		ppq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryIDs)
+	if err = ppq.Select(paymentplan.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) IDsX(ctx context.Context) []string {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *PaymentPlanQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryCount)
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ppq, querierCount[*PaymentPlanQuery](), ppq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *PaymentPlanQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryExist)
+	switch _, err := ppq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *PaymentPlanQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PaymentPlanQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *PaymentPlanQuery) Clone() *PaymentPlanQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &PaymentPlanQuery{
+		config:     ppq.config,
+		ctx:        ppq.ctx.Clone(),
+		order:      append([]paymentplan.OrderOption{}, ppq.order...),
+		inters:     append([]Interceptor{}, ppq.inters...),
+		predicates: append([]predicate.PaymentPlan{}, ppq.predicates...),
+		// clone intermediate query.
+		sql:  ppq.sql.Clone(),
+		path: ppq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PaymentPlan.Query().
+//		GroupBy(paymentplan.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppq *PaymentPlanQuery) GroupBy(field string, fields ...string) *PaymentPlanGroupBy {
+	ppq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PaymentPlanGroupBy{build: ppq}
+	grbuild.flds = &ppq.ctx.Fields
+	grbuild.label = paymentplan.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.PaymentPlan.Query().
+//		Select(paymentplan.FieldStatus).
+//		Scan(ctx, &v)
+func (ppq *PaymentPlanQuery) Select(fields ...string) *PaymentPlanSelect {
+	ppq.ctx.Fields = append(ppq.ctx.Fields, fields...)
+	sbuild := &PaymentPlanSelect{PaymentPlanQuery: ppq}
+	sbuild.label = paymentplan.Label
+	sbuild.flds, sbuild.scan = &ppq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PaymentPlanSelect configured with the given aggregations.
+func (ppq *PaymentPlanQuery) Aggregate(fns ...AggregateFunc) *PaymentPlanSelect {
+	return ppq.Select().Aggregate(fns...)
+}
+
+func (ppq *PaymentPlanQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ppq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ppq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ppq.ctx.Fields {
+		if !paymentplan.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *PaymentPlanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PaymentPlan, error) {
+	var (
+		nodes = []*PaymentPlan{}
+		_spec = ppq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PaymentPlan).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PaymentPlan{config: ppq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ppq *PaymentPlanQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	_spec.Node.Columns = ppq.ctx.Fields
+	if len(ppq.ctx.Fields) > 0 {
+		_spec.Unique = ppq.ctx.Unique != nil && *ppq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *PaymentPlanQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(paymentplan.Table, paymentplan.Columns, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeString))
+	_spec.From = ppq.sql
+	if unique := ppq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ppq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ppq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentplan.FieldID)
+		for i := range fields {
+			if fields[i] != paymentplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *PaymentPlanQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(paymentplan.Table)
+	columns := ppq.ctx.Fields
+	if len(columns) == 0 {
+		columns = paymentplan.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppq.ctx.Unique != nil && *ppq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector)
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PaymentPlanGroupBy is the group-by builder for PaymentPlan entities.
+type PaymentPlanGroupBy struct {
+	selector
+	build *PaymentPlanQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *PaymentPlanGroupBy) Aggregate(fns ...AggregateFunc) *PaymentPlanGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppgb *PaymentPlanGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ppgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentPlanQuery, *PaymentPlanGroupBy](ctx, ppgb.build, ppgb, ppgb.build.inters, v)
+}
+
+func (ppgb *PaymentPlanGroupBy) sqlScan(ctx context.Context, root *PaymentPlanQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ppgb.fns))
+	for _, fn := range ppgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ppgb.flds)+len(ppgb.fns))
+		for _, f := range *ppgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ppgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PaymentPlanSelect is the builder for selecting fields of PaymentPlan entities.
+type PaymentPlanSelect struct {
+	*PaymentPlanQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pps *PaymentPlanSelect) Aggregate(fns ...AggregateFunc) *PaymentPlanSelect {
+	pps.fns = append(pps.fns, fns...)
+	return pps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *PaymentPlanSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pps.ctx, ent.OpQuerySelect)
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentPlanQuery, *PaymentPlanSelect](ctx, pps.PaymentPlanQuery, pps, pps.inters, v)
+}
+
+func (pps *PaymentPlanSelect) sqlScan(ctx context.Context, root *PaymentPlanQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pps.fns))
+	for _, fn := range pps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// PaymentPlanUpdate is the builder for updating PaymentPlan entities.
+type PaymentPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentPlanMutation
+}
+
+// Where appends a list predicates to the PaymentPlanUpdate builder.
+func (ppu *PaymentPlanUpdate) Where(ps ...predicate.PaymentPlan) *PaymentPlanUpdate {
+	ppu.mutation.Where(ps...)
+	return ppu
+}
+
+// SetStatus sets the "status" field.
+func (ppu *PaymentPlanUpdate) SetStatus(s string) *PaymentPlanUpdate {
+	ppu.mutation.SetStatus(s)
+	return ppu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppu *PaymentPlanUpdate) SetNillableStatus(s *string) *PaymentPlanUpdate {
+	if s != nil {
+		ppu.SetStatus(*s)
+	}
+	return ppu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppu *PaymentPlanUpdate) SetUpdatedAt(t time.Time) *PaymentPlanUpdate {
+	ppu.mutation.SetUpdatedAt(t)
+	return ppu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ppu *PaymentPlanUpdate) SetUpdatedBy(s string) *PaymentPlanUpdate {
+	ppu.mutation.SetUpdatedBy(s)
+	return ppu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ppu *PaymentPlanUpdate) SetNillableUpdatedBy(s *string) *PaymentPlanUpdate {
+	if s != nil {
+		ppu.SetUpdatedBy(*s)
+	}
+	return ppu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ppu *PaymentPlanUpdate) ClearUpdatedBy() *PaymentPlanUpdate {
+	ppu.mutation.ClearUpdatedBy()
+	return ppu
+}
+
+// SetMetadata sets the "metadata" field.
+func (ppu *PaymentPlanUpdate) SetMetadata(m map[string]string) *PaymentPlanUpdate {
+	ppu.mutation.SetMetadata(m)
+	return ppu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ppu *PaymentPlanUpdate) ClearMetadata() *PaymentPlanUpdate {
+	ppu.mutation.ClearMetadata()
+	return ppu
+}
+
+// SetName sets the "name" field.
+func (ppu *PaymentPlanUpdate) SetName(s string) *PaymentPlanUpdate {
+	ppu.mutation.SetName(s)
+	return ppu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ppu *PaymentPlanUpdate) SetNillableName(s *string) *PaymentPlanUpdate {
+	if s != nil {
+		ppu.SetName(*s)
+	}
+	return ppu
+}
+
+// SetDescription sets the "description" field.
+func (ppu *PaymentPlanUpdate) SetDescription(s string) *PaymentPlanUpdate {
+	ppu.mutation.SetDescription(s)
+	return ppu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ppu *PaymentPlanUpdate) SetNillableDescription(s *string) *PaymentPlanUpdate {
+	if s != nil {
+		ppu.SetDescription(*s)
+	}
+	return ppu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ppu *PaymentPlanUpdate) ClearDescription() *PaymentPlanUpdate {
+	ppu.mutation.ClearDescription()
+	return ppu
+}
+
+// SetInstallments sets the "installments" field.
+func (ppu *PaymentPlanUpdate) SetInstallments(tpi []types.PaymentPlanInstallment) *PaymentPlanUpdate {
+	ppu.mutation.SetInstallments(tpi)
+	return ppu
+}
+
+// AppendInstallments appends tpi to the "installments" field.
+func (ppu *PaymentPlanUpdate) AppendInstallments(tpi []types.PaymentPlanInstallment) *PaymentPlanUpdate {
+	ppu.mutation.AppendInstallments(tpi)
+	return ppu
+}
+
+// SetGracePeriodDays sets the "grace_period_days" field.
+func (ppu *PaymentPlanUpdate) SetGracePeriodDays(i int) *PaymentPlanUpdate {
+	ppu.mutation.ResetGracePeriodDays()
+	ppu.mutation.SetGracePeriodDays(i)
+	return ppu
+}
+
+// SetNillableGracePeriodDays sets the "grace_period_days" field if the given value is not nil.
+func (ppu *PaymentPlanUpdate) SetNillableGracePeriodDays(i *int) *PaymentPlanUpdate {
+	if i != nil {
+		ppu.SetGracePeriodDays(*i)
+	}
+	return ppu
+}
+
+// AddGracePeriodDays adds i to the "grace_period_days" field.
+func (ppu *PaymentPlanUpdate) AddGracePeriodDays(i int) *PaymentPlanUpdate {
+	ppu.mutation.AddGracePeriodDays(i)
+	return ppu
+}
+
+// SetIsActive sets the "is_active" field.
+func (ppu *PaymentPlanUpdate) SetIsActive(b bool) *PaymentPlanUpdate {
+	ppu.mutation.SetIsActive(b)
+	return ppu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ppu *PaymentPlanUpdate) SetNillableIsActive(b *bool) *PaymentPlanUpdate {
+	if b != nil {
+		ppu.SetIsActive(*b)
+	}
+	return ppu
+}
+
+// Mutation returns the PaymentPlanMutation object of the builder.
+func (ppu *PaymentPlanUpdate) Mutation() *PaymentPlanMutation {
+	return ppu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *PaymentPlanUpdate) Save(ctx context.Context) (int, error) {
+	ppu.defaults()
+	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *PaymentPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *PaymentPlanUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *PaymentPlanUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppu *PaymentPlanUpdate) defaults() {
+	if _, ok := ppu.mutation.UpdatedAt(); !ok {
+		v := paymentplan.UpdateDefaultUpdatedAt()
+		ppu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *PaymentPlanUpdate) check() error {
+	if v, ok := ppu.mutation.Name(); ok {
+		if err := paymentplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := ppu.mutation.GracePeriodDays(); ok {
+		if err := paymentplan.GracePeriodDaysValidator(v); err != nil {
+			return &ValidationError{Name: "grace_period_days", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.grace_period_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ppu *PaymentPlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ppu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentplan.Table, paymentplan.Columns, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeString))
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.Status(); ok {
+		_spec.SetField(paymentplan.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ppu.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ppu.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentplan.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ppu.mutation.UpdatedBy(); ok {
+		_spec.SetField(paymentplan.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ppu.mutation.UpdatedByCleared() {
+		_spec.ClearField(paymentplan.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ppu.mutation.Metadata(); ok {
+		_spec.SetField(paymentplan.FieldMetadata, field.TypeJSON, value)
+	}
+	if ppu.mutation.MetadataCleared() {
+		_spec.ClearField(paymentplan.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.Name(); ok {
+		_spec.SetField(paymentplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := ppu.mutation.Description(); ok {
+		_spec.SetField(paymentplan.FieldDescription, field.TypeString, value)
+	}
+	if ppu.mutation.DescriptionCleared() {
+		_spec.ClearField(paymentplan.FieldDescription, field.TypeString)
+	}
+	if value, ok := ppu.mutation.Installments(); ok {
+		_spec.SetField(paymentplan.FieldInstallments, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedInstallments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, paymentplan.FieldInstallments, value)
+		})
+	}
+	if value, ok := ppu.mutation.GracePeriodDays(); ok {
+		_spec.SetField(paymentplan.FieldGracePeriodDays, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedGracePeriodDays(); ok {
+		_spec.AddField(paymentplan.FieldGracePeriodDays, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.IsActive(); ok {
+		_spec.SetField(paymentplan.FieldIsActive, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppu.mutation.done = true
+	return n, nil
+}
+
+// PaymentPlanUpdateOne is the builder for updating a single PaymentPlan entity.
+type PaymentPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentPlanMutation
+}
+
+// SetStatus sets the "status" field.
+func (ppuo *PaymentPlanUpdateOne) SetStatus(s string) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetStatus(s)
+	return ppuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppuo *PaymentPlanUpdateOne) SetNillableStatus(s *string) *PaymentPlanUpdateOne {
+	if s != nil {
+		ppuo.SetStatus(*s)
+	}
+	return ppuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppuo *PaymentPlanUpdateOne) SetUpdatedAt(t time.Time) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetUpdatedAt(t)
+	return ppuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ppuo *PaymentPlanUpdateOne) SetUpdatedBy(s string) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetUpdatedBy(s)
+	return ppuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ppuo *PaymentPlanUpdateOne) SetNillableUpdatedBy(s *string) *PaymentPlanUpdateOne {
+	if s != nil {
+		ppuo.SetUpdatedBy(*s)
+	}
+	return ppuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ppuo *PaymentPlanUpdateOne) ClearUpdatedBy() *PaymentPlanUpdateOne {
+	ppuo.mutation.ClearUpdatedBy()
+	return ppuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (ppuo *PaymentPlanUpdateOne) SetMetadata(m map[string]string) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetMetadata(m)
+	return ppuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ppuo *PaymentPlanUpdateOne) ClearMetadata() *PaymentPlanUpdateOne {
+	ppuo.mutation.ClearMetadata()
+	return ppuo
+}
+
+// SetName sets the "name" field.
+func (ppuo *PaymentPlanUpdateOne) SetName(s string) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetName(s)
+	return ppuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ppuo *PaymentPlanUpdateOne) SetNillableName(s *string) *PaymentPlanUpdateOne {
+	if s != nil {
+		ppuo.SetName(*s)
+	}
+	return ppuo
+}
+
+// SetDescription sets the "description" field.
+func (ppuo *PaymentPlanUpdateOne) SetDescription(s string) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetDescription(s)
+	return ppuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ppuo *PaymentPlanUpdateOne) SetNillableDescription(s *string) *PaymentPlanUpdateOne {
+	if s != nil {
+		ppuo.SetDescription(*s)
+	}
+	return ppuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ppuo *PaymentPlanUpdateOne) ClearDescription() *PaymentPlanUpdateOne {
+	ppuo.mutation.ClearDescription()
+	return ppuo
+}
+
+// SetInstallments sets the "installments" field.
+func (ppuo *PaymentPlanUpdateOne) SetInstallments(tpi []types.PaymentPlanInstallment) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetInstallments(tpi)
+	return ppuo
+}
+
+// AppendInstallments appends tpi to the "installments" field.
+func (ppuo *PaymentPlanUpdateOne) AppendInstallments(tpi []types.PaymentPlanInstallment) *PaymentPlanUpdateOne {
+	ppuo.mutation.AppendInstallments(tpi)
+	return ppuo
+}
+
+// SetGracePeriodDays sets the "grace_period_days" field.
+func (ppuo *PaymentPlanUpdateOne) SetGracePeriodDays(i int) *PaymentPlanUpdateOne {
+	ppuo.mutation.ResetGracePeriodDays()
+	ppuo.mutation.SetGracePeriodDays(i)
+	return ppuo
+}
+
+// SetNillableGracePeriodDays sets the "grace_period_days" field if the given value is not nil.
+func (ppuo *PaymentPlanUpdateOne) SetNillableGracePeriodDays(i *int) *PaymentPlanUpdateOne {
+	if i != nil {
+		ppuo.SetGracePeriodDays(*i)
+	}
+	return ppuo
+}
+
+// AddGracePeriodDays adds i to the "grace_period_days" field.
+func (ppuo *PaymentPlanUpdateOne) AddGracePeriodDays(i int) *PaymentPlanUpdateOne {
+	ppuo.mutation.AddGracePeriodDays(i)
+	return ppuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (ppuo *PaymentPlanUpdateOne) SetIsActive(b bool) *PaymentPlanUpdateOne {
+	ppuo.mutation.SetIsActive(b)
+	return ppuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (ppuo *PaymentPlanUpdateOne) SetNillableIsActive(b *bool) *PaymentPlanUpdateOne {
+	if b != nil {
+		ppuo.SetIsActive(*b)
+	}
+	return ppuo
+}
+
+// Mutation returns the PaymentPlanMutation object of the builder.
+func (ppuo *PaymentPlanUpdateOne) Mutation() *PaymentPlanMutation {
+	return ppuo.mutation
+}
+
+// Where appends a list predicates to the PaymentPlanUpdate builder.
+func (ppuo *PaymentPlanUpdateOne) Where(ps ...predicate.PaymentPlan) *PaymentPlanUpdateOne {
+	ppuo.mutation.Where(ps...)
+	return ppuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppuo *PaymentPlanUpdateOne) Select(field string, fields ...string) *PaymentPlanUpdateOne {
+	ppuo.fields = append([]string{field}, fields...)
+	return ppuo
+}
+
+// Save executes the query and returns the updated PaymentPlan entity.
+func (ppuo *PaymentPlanUpdateOne) Save(ctx context.Context) (*PaymentPlan, error) {
+	ppuo.defaults()
+	return withHooks(ctx, ppuo.sqlSave, ppuo.mutation, ppuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *PaymentPlanUpdateOne) SaveX(ctx context.Context) *PaymentPlan {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *PaymentPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *PaymentPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppuo *PaymentPlanUpdateOne) defaults() {
+	if _, ok := ppuo.mutation.UpdatedAt(); !ok {
+		v := paymentplan.UpdateDefaultUpdatedAt()
+		ppuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *PaymentPlanUpdateOne) check() error {
+	if v, ok := ppuo.mutation.Name(); ok {
+		if err := paymentplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := ppuo.mutation.GracePeriodDays(); ok {
+		if err := paymentplan.GracePeriodDaysValidator(v); err != nil {
+			return &ValidationError{Name: "grace_period_days", err: fmt.Errorf(`ent: validator failed for field "PaymentPlan.grace_period_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ppuo *PaymentPlanUpdateOne) sqlSave(ctx context.Context) (_node *PaymentPlan, err error) {
+	if err := ppuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentplan.Table, paymentplan.Columns, sqlgraph.NewFieldSpec(paymentplan.FieldID, field.TypeString))
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentplan.FieldID)
+		for _, f := range fields {
+			if !paymentplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.Status(); ok {
+		_spec.SetField(paymentplan.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ppuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ppuo.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentplan.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(paymentplan.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ppuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(paymentplan.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.Metadata(); ok {
+		_spec.SetField(paymentplan.FieldMetadata, field.TypeJSON, value)
+	}
+	if ppuo.mutation.MetadataCleared() {
+		_spec.ClearField(paymentplan.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.Name(); ok {
+		_spec.SetField(paymentplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := ppuo.mutation.Description(); ok {
+		_spec.SetField(paymentplan.FieldDescription, field.TypeString, value)
+	}
+	if ppuo.mutation.DescriptionCleared() {
+		_spec.ClearField(paymentplan.FieldDescription, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.Installments(); ok {
+		_spec.SetField(paymentplan.FieldInstallments, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedInstallments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, paymentplan.FieldInstallments, value)
+		})
+	}
+	if value, ok := ppuo.mutation.GracePeriodDays(); ok {
+		_spec.SetField(paymentplan.FieldGracePeriodDays, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedGracePeriodDays(); ok {
+		_spec.AddField(paymentplan.FieldGracePeriodDays, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.IsActive(); ok {
+		_spec.SetField(paymentplan.FieldIsActive, field.TypeBool, value)
+	}
+	_node = &PaymentPlan{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ppuo.mutation.done = true
+	return _node, nil
+}
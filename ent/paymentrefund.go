@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/payment"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRefund is the model entity for the PaymentRefund schema.
+type PaymentRefund struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// PaymentID holds the value of the "payment_id" field.
+	PaymentID string `json:"payment_id,omitempty"`
+	// PaymentGatewayProvider holds the value of the "payment_gateway_provider" field.
+	PaymentGatewayProvider types.PaymentGatewayProvider `json:"payment_gateway_provider,omitempty"`
+	// GatewayPaymentID holds the value of the "gateway_payment_id" field.
+	GatewayPaymentID string `json:"gateway_payment_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency types.Currency `json:"currency,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// RefundStatus holds the value of the "refund_status" field.
+	RefundStatus types.PaymentRefundStatus `json:"refund_status,omitempty"`
+	// GatewayRefundID holds the value of the "gateway_refund_id" field.
+	GatewayRefundID *string `json:"gateway_refund_id,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// ProcessedAt holds the value of the "processed_at" field.
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentRefundQuery when eager-loading is set.
+	Edges        PaymentRefundEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentRefundEdges holds the relations/edges for other nodes in the graph.
+type PaymentRefundEdges struct {
+	// Payment holds the value of the payment edge.
+	Payment *Payment `json:"payment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PaymentOrErr returns the Payment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRefundEdges) PaymentOrErr() (*Payment, error) {
+	if e.Payment != nil {
+		return e.Payment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: payment.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRefund) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrefund.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case paymentrefund.FieldID, paymentrefund.FieldStatus, paymentrefund.FieldCreatedBy, paymentrefund.FieldUpdatedBy, paymentrefund.FieldPaymentID, paymentrefund.FieldPaymentGatewayProvider, paymentrefund.FieldGatewayPaymentID, paymentrefund.FieldCurrency, paymentrefund.FieldReason, paymentrefund.FieldRefundStatus, paymentrefund.FieldGatewayRefundID, paymentrefund.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case paymentrefund.FieldCreatedAt, paymentrefund.FieldUpdatedAt, paymentrefund.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRefund fields.
+func (pr *PaymentRefund) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrefund.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pr.ID = value.String
+			}
+		case paymentrefund.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pr.Status = value.String
+			}
+		case paymentrefund.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case paymentrefund.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case paymentrefund.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pr.CreatedBy = value.String
+			}
+		case paymentrefund.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pr.UpdatedBy = value.String
+			}
+		case paymentrefund.FieldPaymentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_id", values[i])
+			} else if value.Valid {
+				pr.PaymentID = value.String
+			}
+		case paymentrefund.FieldPaymentGatewayProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_gateway_provider", values[i])
+			} else if value.Valid {
+				pr.PaymentGatewayProvider = types.PaymentGatewayProvider(value.String)
+			}
+		case paymentrefund.FieldGatewayPaymentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_payment_id", values[i])
+			} else if value.Valid {
+				pr.GatewayPaymentID = value.String
+			}
+		case paymentrefund.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				pr.Amount = *value
+			}
+		case paymentrefund.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				pr.Currency = types.Currency(value.String)
+			}
+		case paymentrefund.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				pr.Reason = value.String
+			}
+		case paymentrefund.FieldRefundStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_status", values[i])
+			} else if value.Valid {
+				pr.RefundStatus = types.PaymentRefundStatus(value.String)
+			}
+		case paymentrefund.FieldGatewayRefundID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_refund_id", values[i])
+			} else if value.Valid {
+				pr.GatewayRefundID = new(string)
+				*pr.GatewayRefundID = value.String
+			}
+		case paymentrefund.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				pr.ErrorMessage = new(string)
+				*pr.ErrorMessage = value.String
+			}
+		case paymentrefund.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				pr.ProcessedAt = new(time.Time)
+				*pr.ProcessedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRefund.
+// This includes values selected through modifiers, order, etc.
+func (pr *PaymentRefund) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryPayment queries the "payment" edge of the PaymentRefund entity.
+func (pr *PaymentRefund) QueryPayment() *PaymentQuery {
+	return NewPaymentRefundClient(pr.config).QueryPayment(pr)
+}
+
+// Update returns a builder for updating this PaymentRefund.
+// Note that you need to call PaymentRefund.Unwrap() before calling this method if this PaymentRefund
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PaymentRefund) Update() *PaymentRefundUpdateOne {
+	return NewPaymentRefundClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PaymentRefund entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PaymentRefund) Unwrap() *PaymentRefund {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentRefund is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PaymentRefund) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRefund(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("status=")
+	builder.WriteString(pr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("payment_id=")
+	builder.WriteString(pr.PaymentID)
+	builder.WriteString(", ")
+	builder.WriteString("payment_gateway_provider=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PaymentGatewayProvider))
+	builder.WriteString(", ")
+	builder.WriteString("gateway_payment_id=")
+	builder.WriteString(pr.GatewayPaymentID)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Currency))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(pr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("refund_status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.RefundStatus))
+	builder.WriteString(", ")
+	if v := pr.GatewayRefundID; v != nil {
+		builder.WriteString("gateway_refund_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.ProcessedAt; v != nil {
+		builder.WriteString("processed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRefunds is a parsable slice of PaymentRefund.
+type PaymentRefunds []*PaymentRefund
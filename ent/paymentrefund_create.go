@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/payment"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRefundCreate is the builder for creating a PaymentRefund entity.
+type PaymentRefundCreate struct {
+	config
+	mutation *PaymentRefundMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (prc *PaymentRefundCreate) SetStatus(s string) *PaymentRefundCreate {
+	prc.mutation.SetStatus(s)
+	return prc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableStatus(s *string) *PaymentRefundCreate {
+	if s != nil {
+		prc.SetStatus(*s)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PaymentRefundCreate) SetCreatedAt(t time.Time) *PaymentRefundCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableCreatedAt(t *time.Time) *PaymentRefundCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prc *PaymentRefundCreate) SetUpdatedAt(t time.Time) *PaymentRefundCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableUpdatedAt(t *time.Time) *PaymentRefundCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (prc *PaymentRefundCreate) SetCreatedBy(s string) *PaymentRefundCreate {
+	prc.mutation.SetCreatedBy(s)
+	return prc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableCreatedBy(s *string) *PaymentRefundCreate {
+	if s != nil {
+		prc.SetCreatedBy(*s)
+	}
+	return prc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (prc *PaymentRefundCreate) SetUpdatedBy(s string) *PaymentRefundCreate {
+	prc.mutation.SetUpdatedBy(s)
+	return prc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableUpdatedBy(s *string) *PaymentRefundCreate {
+	if s != nil {
+		prc.SetUpdatedBy(*s)
+	}
+	return prc
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (prc *PaymentRefundCreate) SetPaymentID(s string) *PaymentRefundCreate {
+	prc.mutation.SetPaymentID(s)
+	return prc
+}
+
+// SetPaymentGatewayProvider sets the "payment_gateway_provider" field.
+func (prc *PaymentRefundCreate) SetPaymentGatewayProvider(tgp types.PaymentGatewayProvider) *PaymentRefundCreate {
+	prc.mutation.SetPaymentGatewayProvider(tgp)
+	return prc
+}
+
+// SetGatewayPaymentID sets the "gateway_payment_id" field.
+func (prc *PaymentRefundCreate) SetGatewayPaymentID(s string) *PaymentRefundCreate {
+	prc.mutation.SetGatewayPaymentID(s)
+	return prc
+}
+
+// SetAmount sets the "amount" field.
+func (prc *PaymentRefundCreate) SetAmount(d decimal.Decimal) *PaymentRefundCreate {
+	prc.mutation.SetAmount(d)
+	return prc
+}
+
+// SetCurrency sets the "currency" field.
+func (prc *PaymentRefundCreate) SetCurrency(t types.Currency) *PaymentRefundCreate {
+	prc.mutation.SetCurrency(t)
+	return prc
+}
+
+// SetReason sets the "reason" field.
+func (prc *PaymentRefundCreate) SetReason(s string) *PaymentRefundCreate {
+	prc.mutation.SetReason(s)
+	return prc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableReason(s *string) *PaymentRefundCreate {
+	if s != nil {
+		prc.SetReason(*s)
+	}
+	return prc
+}
+
+// SetRefundStatus sets the "refund_status" field.
+func (prc *PaymentRefundCreate) SetRefundStatus(trs types.PaymentRefundStatus) *PaymentRefundCreate {
+	prc.mutation.SetRefundStatus(trs)
+	return prc
+}
+
+// SetNillableRefundStatus sets the "refund_status" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableRefundStatus(trs *types.PaymentRefundStatus) *PaymentRefundCreate {
+	if trs != nil {
+		prc.SetRefundStatus(*trs)
+	}
+	return prc
+}
+
+// SetGatewayRefundID sets the "gateway_refund_id" field.
+func (prc *PaymentRefundCreate) SetGatewayRefundID(s string) *PaymentRefundCreate {
+	prc.mutation.SetGatewayRefundID(s)
+	return prc
+}
+
+// SetNillableGatewayRefundID sets the "gateway_refund_id" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableGatewayRefundID(s *string) *PaymentRefundCreate {
+	if s != nil {
+		prc.SetGatewayRefundID(*s)
+	}
+	return prc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (prc *PaymentRefundCreate) SetErrorMessage(s string) *PaymentRefundCreate {
+	prc.mutation.SetErrorMessage(s)
+	return prc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableErrorMessage(s *string) *PaymentRefundCreate {
+	if s != nil {
+		prc.SetErrorMessage(*s)
+	}
+	return prc
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (prc *PaymentRefundCreate) SetProcessedAt(t time.Time) *PaymentRefundCreate {
+	prc.mutation.SetProcessedAt(t)
+	return prc
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (prc *PaymentRefundCreate) SetNillableProcessedAt(t *time.Time) *PaymentRefundCreate {
+	if t != nil {
+		prc.SetProcessedAt(*t)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PaymentRefundCreate) SetID(s string) *PaymentRefundCreate {
+	prc.mutation.SetID(s)
+	return prc
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (prc *PaymentRefundCreate) SetPayment(p *Payment) *PaymentRefundCreate {
+	return prc.SetPaymentID(p.ID)
+}
+
+// Mutation returns the PaymentRefundMutation object of the builder.
+func (prc *PaymentRefundCreate) Mutation() *PaymentRefundMutation {
+	return prc.mutation
+}
+
+// Save creates the PaymentRefund in the database.
+func (prc *PaymentRefundCreate) Save(ctx context.Context) (*PaymentRefund, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PaymentRefundCreate) SaveX(ctx context.Context) *PaymentRefund {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PaymentRefundCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PaymentRefundCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PaymentRefundCreate) defaults() {
+	if _, ok := prc.mutation.Status(); !ok {
+		v := paymentrefund.DefaultStatus
+		prc.mutation.SetStatus(v)
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := paymentrefund.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		v := paymentrefund.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prc.mutation.RefundStatus(); !ok {
+		v := paymentrefund.DefaultRefundStatus
+		prc.mutation.SetRefundStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PaymentRefundCreate) check() error {
+	if _, ok := prc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentRefund.status"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentRefund.created_at"`)}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentRefund.updated_at"`)}
+	}
+	if _, ok := prc.mutation.PaymentID(); !ok {
+		return &ValidationError{Name: "payment_id", err: errors.New(`ent: missing required field "PaymentRefund.payment_id"`)}
+	}
+	if v, ok := prc.mutation.PaymentID(); ok {
+		if err := paymentrefund.PaymentIDValidator(v); err != nil {
+			return &ValidationError{Name: "payment_id", err: fmt.Errorf(`ent: validator failed for field "PaymentRefund.payment_id": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.PaymentGatewayProvider(); !ok {
+		return &ValidationError{Name: "payment_gateway_provider", err: errors.New(`ent: missing required field "PaymentRefund.payment_gateway_provider"`)}
+	}
+	if v, ok := prc.mutation.PaymentGatewayProvider(); ok {
+		if err := paymentrefund.PaymentGatewayProviderValidator(string(v)); err != nil {
+			return &ValidationError{Name: "payment_gateway_provider", err: fmt.Errorf(`ent: validator failed for field "PaymentRefund.payment_gateway_provider": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.GatewayPaymentID(); !ok {
+		return &ValidationError{Name: "gateway_payment_id", err: errors.New(`ent: missing required field "PaymentRefund.gateway_payment_id"`)}
+	}
+	if v, ok := prc.mutation.GatewayPaymentID(); ok {
+		if err := paymentrefund.GatewayPaymentIDValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_payment_id", err: fmt.Errorf(`ent: validator failed for field "PaymentRefund.gateway_payment_id": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PaymentRefund.amount"`)}
+	}
+	if _, ok := prc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PaymentRefund.currency"`)}
+	}
+	if v, ok := prc.mutation.Currency(); ok {
+		if err := paymentrefund.CurrencyValidator(string(v)); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PaymentRefund.currency": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.RefundStatus(); !ok {
+		return &ValidationError{Name: "refund_status", err: errors.New(`ent: missing required field "PaymentRefund.refund_status"`)}
+	}
+	if len(prc.mutation.PaymentIDs()) == 0 {
+		return &ValidationError{Name: "payment", err: errors.New(`ent: missing required edge "PaymentRefund.payment"`)}
+	}
+	return nil
+}
+
+func (prc *PaymentRefundCreate) sqlSave(ctx context.Context) (*PaymentRefund, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PaymentRefund.ID type: %T", _spec.ID.Value)
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PaymentRefundCreate) createSpec() (*PaymentRefund, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRefund{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrefund.Table, sqlgraph.NewFieldSpec(paymentrefund.FieldID, field.TypeString))
+	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := prc.mutation.Status(); ok {
+		_spec.SetField(paymentrefund.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := prc.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrefund.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := prc.mutation.UpdatedBy(); ok {
+		_spec.SetField(paymentrefund.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := prc.mutation.PaymentGatewayProvider(); ok {
+		_spec.SetField(paymentrefund.FieldPaymentGatewayProvider, field.TypeString, value)
+		_node.PaymentGatewayProvider = value
+	}
+	if value, ok := prc.mutation.GatewayPaymentID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayPaymentID, field.TypeString, value)
+		_node.GatewayPaymentID = value
+	}
+	if value, ok := prc.mutation.Amount(); ok {
+		_spec.SetField(paymentrefund.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := prc.mutation.Currency(); ok {
+		_spec.SetField(paymentrefund.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := prc.mutation.Reason(); ok {
+		_spec.SetField(paymentrefund.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := prc.mutation.RefundStatus(); ok {
+		_spec.SetField(paymentrefund.FieldRefundStatus, field.TypeString, value)
+		_node.RefundStatus = value
+	}
+	if value, ok := prc.mutation.GatewayRefundID(); ok {
+		_spec.SetField(paymentrefund.FieldGatewayRefundID, field.TypeString, value)
+		_node.GatewayRefundID = &value
+	}
+	if value, ok := prc.mutation.ErrorMessage(); ok {
+		_spec.SetField(paymentrefund.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := prc.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentrefund.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	if nodes := prc.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrefund.PaymentTable,
+			Columns: []string{paymentrefund.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PaymentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentRefundCreateBulk is the builder for creating many PaymentRefund entities in bulk.
+type PaymentRefundCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRefundCreate
+}
+
+// Save creates the PaymentRefund entities in the database.
+func (prcb *PaymentRefundCreateBulk) Save(ctx context.Context) ([]*PaymentRefund, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PaymentRefund, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRefundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PaymentRefundCreateBulk) SaveX(ctx context.Context) []*PaymentRefund {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PaymentRefundCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PaymentRefundCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Assignment is the predicate function for assignment builders.
+type Assignment func(*sql.Selector)
+
 // Cart is the predicate function for cart builders.
 type Cart func(*sql.Selector)
 
@@ -15,6 +18,9 @@ type CartLineItems func(*sql.Selector)
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// Certificate is the predicate function for certificate builders.
+type Certificate func(*sql.Selector)
+
 // Discount is the predicate function for discount builders.
 type Discount func(*sql.Selector)
 
@@ -24,12 +30,39 @@ type FileUpload func(*sql.Selector)
 // Internship is the predicate function for internship builders.
 type Internship func(*sql.Selector)
 
+// InternshipApplication is the predicate function for internshipapplication builders.
+type InternshipApplication func(*sql.Selector)
+
 // InternshipBatch is the predicate function for internshipbatch builders.
 type InternshipBatch func(*sql.Selector)
 
 // InternshipEnrollment is the predicate function for internshipenrollment builders.
 type InternshipEnrollment func(*sql.Selector)
 
+// InternshipInstructor is the predicate function for internshipinstructor builders.
+type InternshipInstructor func(*sql.Selector)
+
+// InternshipReview is the predicate function for internshipreview builders.
+type InternshipReview func(*sql.Selector)
+
+// InternshipRevision is the predicate function for internshiprevision builders.
+type InternshipRevision func(*sql.Selector)
+
+// Lesson is the predicate function for lesson builders.
+type Lesson func(*sql.Selector)
+
+// LessonProgress is the predicate function for lessonprogress builders.
+type LessonProgress func(*sql.Selector)
+
+// LiveSession is the predicate function for livesession builders.
+type LiveSession func(*sql.Selector)
+
+// Module is the predicate function for module builders.
+type Module func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
 // Order is the predicate function for order builders.
 type Order func(*sql.Selector)
 
@@ -39,5 +72,44 @@ type Payment func(*sql.Selector)
 // PaymentAttempt is the predicate function for paymentattempt builders.
 type PaymentAttempt func(*sql.Selector)
 
+// PaymentPlan is the predicate function for paymentplan builders.
+type PaymentPlan func(*sql.Selector)
+
+// PaymentRefund is the predicate function for paymentrefund builders.
+type PaymentRefund func(*sql.Selector)
+
+// Quiz is the predicate function for quiz builders.
+type Quiz func(*sql.Selector)
+
+// QuizAttempt is the predicate function for quizattempt builders.
+type QuizAttempt func(*sql.Selector)
+
+// Referral is the predicate function for referral builders.
+type Referral func(*sql.Selector)
+
+// Resource is the predicate function for resource builders.
+type Resource func(*sql.Selector)
+
+// SessionAttendance is the predicate function for sessionattendance builders.
+type SessionAttendance func(*sql.Selector)
+
+// Submission is the predicate function for submission builders.
+type Submission func(*sql.Selector)
+
+// Subscription is the predicate function for subscription builders.
+type Subscription func(*sql.Selector)
+
+// SubscriptionEvent is the predicate function for subscriptionevent builders.
+type SubscriptionEvent func(*sql.Selector)
+
+// SubscriptionPlan is the predicate function for subscriptionplan builders.
+type SubscriptionPlan func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WalletTransaction is the predicate function for wallettransaction builders.
+type WalletTransaction func(*sql.Selector)
+
+// Wishlist is the predicate function for wishlist builders.
+type Wishlist func(*sql.Selector)
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/quiz"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Quiz is the model entity for the Quiz schema.
+type Quiz struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// ModuleID holds the value of the "module_id" field.
+	ModuleID string `json:"module_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Questions holds the value of the "questions" field.
+	Questions []types.QuizQuestion `json:"questions,omitempty"`
+	// QuestionsPerAttempt holds the value of the "questions_per_attempt" field.
+	QuestionsPerAttempt *int `json:"questions_per_attempt,omitempty"`
+	// ShuffleQuestions holds the value of the "shuffle_questions" field.
+	ShuffleQuestions bool `json:"shuffle_questions,omitempty"`
+	// TimeLimitMinutes holds the value of the "time_limit_minutes" field.
+	TimeLimitMinutes *int `json:"time_limit_minutes,omitempty"`
+	// MaxAttempts holds the value of the "max_attempts" field.
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	// PassingPercent holds the value of the "passing_percent" field.
+	PassingPercent *int `json:"passing_percent,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder    int `json:"sort_order,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quiz) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quiz.FieldQuestions:
+			values[i] = new([]byte)
+		case quiz.FieldShuffleQuestions:
+			values[i] = new(sql.NullBool)
+		case quiz.FieldQuestionsPerAttempt, quiz.FieldTimeLimitMinutes, quiz.FieldMaxAttempts, quiz.FieldPassingPercent, quiz.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case quiz.FieldID, quiz.FieldStatus, quiz.FieldCreatedBy, quiz.FieldUpdatedBy, quiz.FieldInternshipID, quiz.FieldModuleID, quiz.FieldTitle, quiz.FieldDescription:
+			values[i] = new(sql.NullString)
+		case quiz.FieldCreatedAt, quiz.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quiz fields.
+func (q *Quiz) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quiz.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				q.ID = value.String
+			}
+		case quiz.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				q.Status = value.String
+			}
+		case quiz.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		case quiz.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Time
+			}
+		case quiz.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				q.CreatedBy = value.String
+			}
+		case quiz.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				q.UpdatedBy = value.String
+			}
+		case quiz.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				q.InternshipID = value.String
+			}
+		case quiz.FieldModuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_id", values[i])
+			} else if value.Valid {
+				q.ModuleID = value.String
+			}
+		case quiz.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				q.Title = value.String
+			}
+		case quiz.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				q.Description = value.String
+			}
+		case quiz.FieldQuestions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field questions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.Questions); err != nil {
+					return fmt.Errorf("unmarshal field questions: %w", err)
+				}
+			}
+		case quiz.FieldQuestionsPerAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field questions_per_attempt", values[i])
+			} else if value.Valid {
+				q.QuestionsPerAttempt = new(int)
+				*q.QuestionsPerAttempt = int(value.Int64)
+			}
+		case quiz.FieldShuffleQuestions:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shuffle_questions", values[i])
+			} else if value.Valid {
+				q.ShuffleQuestions = value.Bool
+			}
+		case quiz.FieldTimeLimitMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_limit_minutes", values[i])
+			} else if value.Valid {
+				q.TimeLimitMinutes = new(int)
+				*q.TimeLimitMinutes = int(value.Int64)
+			}
+		case quiz.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				q.MaxAttempts = new(int)
+				*q.MaxAttempts = int(value.Int64)
+			}
+		case quiz.FieldPassingPercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field passing_percent", values[i])
+			} else if value.Valid {
+				q.PassingPercent = new(int)
+				*q.PassingPercent = int(value.Int64)
+			}
+		case quiz.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				q.SortOrder = int(value.Int64)
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quiz.
+// This includes values selected through modifiers, order, etc.
+func (q *Quiz) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Quiz.
+// Note that you need to call Quiz.Unwrap() before calling this method if this Quiz
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quiz) Update() *QuizUpdateOne {
+	return NewQuizClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quiz entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quiz) Unwrap() *Quiz {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quiz is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quiz) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quiz(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("status=")
+	builder.WriteString(q.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(q.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(q.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(q.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("module_id=")
+	builder.WriteString(q.ModuleID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(q.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(q.Description)
+	builder.WriteString(", ")
+	builder.WriteString("questions=")
+	builder.WriteString(fmt.Sprintf("%v", q.Questions))
+	builder.WriteString(", ")
+	if v := q.QuestionsPerAttempt; v != nil {
+		builder.WriteString("questions_per_attempt=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("shuffle_questions=")
+	builder.WriteString(fmt.Sprintf("%v", q.ShuffleQuestions))
+	builder.WriteString(", ")
+	if v := q.TimeLimitMinutes; v != nil {
+		builder.WriteString("time_limit_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.MaxAttempts; v != nil {
+		builder.WriteString("max_attempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.PassingPercent; v != nil {
+		builder.WriteString("passing_percent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", q.SortOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Quizs is a parsable slice of Quiz.
+type Quizs []*Quiz
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quiz
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the quiz type in the database.
+	Label = "quiz"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldInternshipID holds the string denoting the internship_id field in the database.
+	FieldInternshipID = "internship_id"
+	// FieldModuleID holds the string denoting the module_id field in the database.
+	FieldModuleID = "module_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldQuestions holds the string denoting the questions field in the database.
+	FieldQuestions = "questions"
+	// FieldQuestionsPerAttempt holds the string denoting the questions_per_attempt field in the database.
+	FieldQuestionsPerAttempt = "questions_per_attempt"
+	// FieldShuffleQuestions holds the string denoting the shuffle_questions field in the database.
+	FieldShuffleQuestions = "shuffle_questions"
+	// FieldTimeLimitMinutes holds the string denoting the time_limit_minutes field in the database.
+	FieldTimeLimitMinutes = "time_limit_minutes"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldPassingPercent holds the string denoting the passing_percent field in the database.
+	FieldPassingPercent = "passing_percent"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// Table holds the table name of the quiz in the database.
+	Table = "quizs"
+)
+
+// Columns holds all SQL columns for quiz fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldInternshipID,
+	FieldModuleID,
+	FieldTitle,
+	FieldDescription,
+	FieldQuestions,
+	FieldQuestionsPerAttempt,
+	FieldShuffleQuestions,
+	FieldTimeLimitMinutes,
+	FieldMaxAttempts,
+	FieldPassingPercent,
+	FieldSortOrder,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	InternshipIDValidator func(string) error
+	// ModuleIDValidator is a validator for the "module_id" field. It is called by the builders before save.
+	ModuleIDValidator func(string) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// QuestionsPerAttemptValidator is a validator for the "questions_per_attempt" field. It is called by the builders before save.
+	QuestionsPerAttemptValidator func(int) error
+	// DefaultShuffleQuestions holds the default value on creation for the "shuffle_questions" field.
+	DefaultShuffleQuestions bool
+	// TimeLimitMinutesValidator is a validator for the "time_limit_minutes" field. It is called by the builders before save.
+	TimeLimitMinutesValidator func(int) error
+	// MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	MaxAttemptsValidator func(int) error
+	// PassingPercentValidator is a validator for the "passing_percent" field. It is called by the builders before save.
+	PassingPercentValidator func(int) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Quiz queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByInternshipID orders the results by the internship_id field.
+func ByInternshipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipID, opts...).ToFunc()
+}
+
+// ByModuleID orders the results by the module_id field.
+func ByModuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByQuestionsPerAttempt orders the results by the questions_per_attempt field.
+func ByQuestionsPerAttempt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionsPerAttempt, opts...).ToFunc()
+}
+
+// ByShuffleQuestions orders the results by the shuffle_questions field.
+func ByShuffleQuestions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShuffleQuestions, opts...).ToFunc()
+}
+
+// ByTimeLimitMinutes orders the results by the time_limit_minutes field.
+func ByTimeLimitMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeLimitMinutes, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByPassingPercent orders the results by the passing_percent field.
+func ByPassingPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassingPercent, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
@@ -0,0 +1,980 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quiz
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// ModuleID applies equality check predicate on the "module_id" field. It's identical to ModuleIDEQ.
+func ModuleID(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldModuleID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDescription, v))
+}
+
+// QuestionsPerAttempt applies equality check predicate on the "questions_per_attempt" field. It's identical to QuestionsPerAttemptEQ.
+func QuestionsPerAttempt(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionsPerAttempt, v))
+}
+
+// ShuffleQuestions applies equality check predicate on the "shuffle_questions" field. It's identical to ShuffleQuestionsEQ.
+func ShuffleQuestions(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldShuffleQuestions, v))
+}
+
+// TimeLimitMinutes applies equality check predicate on the "time_limit_minutes" field. It's identical to TimeLimitMinutesEQ.
+func TimeLimitMinutes(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimitMinutes, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// PassingPercent applies equality check predicate on the "passing_percent" field. It's identical to PassingPercentEQ.
+func PassingPercent(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldPassingPercent, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// ModuleIDEQ applies the EQ predicate on the "module_id" field.
+func ModuleIDEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldModuleID, v))
+}
+
+// ModuleIDNEQ applies the NEQ predicate on the "module_id" field.
+func ModuleIDNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldModuleID, v))
+}
+
+// ModuleIDIn applies the In predicate on the "module_id" field.
+func ModuleIDIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldModuleID, vs...))
+}
+
+// ModuleIDNotIn applies the NotIn predicate on the "module_id" field.
+func ModuleIDNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldModuleID, vs...))
+}
+
+// ModuleIDGT applies the GT predicate on the "module_id" field.
+func ModuleIDGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldModuleID, v))
+}
+
+// ModuleIDGTE applies the GTE predicate on the "module_id" field.
+func ModuleIDGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldModuleID, v))
+}
+
+// ModuleIDLT applies the LT predicate on the "module_id" field.
+func ModuleIDLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldModuleID, v))
+}
+
+// ModuleIDLTE applies the LTE predicate on the "module_id" field.
+func ModuleIDLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldModuleID, v))
+}
+
+// ModuleIDContains applies the Contains predicate on the "module_id" field.
+func ModuleIDContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldModuleID, v))
+}
+
+// ModuleIDHasPrefix applies the HasPrefix predicate on the "module_id" field.
+func ModuleIDHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldModuleID, v))
+}
+
+// ModuleIDHasSuffix applies the HasSuffix predicate on the "module_id" field.
+func ModuleIDHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldModuleID, v))
+}
+
+// ModuleIDEqualFold applies the EqualFold predicate on the "module_id" field.
+func ModuleIDEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldModuleID, v))
+}
+
+// ModuleIDContainsFold applies the ContainsFold predicate on the "module_id" field.
+func ModuleIDContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldModuleID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// QuestionsIsNil applies the IsNil predicate on the "questions" field.
+func QuestionsIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldQuestions))
+}
+
+// QuestionsNotNil applies the NotNil predicate on the "questions" field.
+func QuestionsNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldQuestions))
+}
+
+// QuestionsPerAttemptEQ applies the EQ predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionsPerAttempt, v))
+}
+
+// QuestionsPerAttemptNEQ applies the NEQ predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldQuestionsPerAttempt, v))
+}
+
+// QuestionsPerAttemptIn applies the In predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldQuestionsPerAttempt, vs...))
+}
+
+// QuestionsPerAttemptNotIn applies the NotIn predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldQuestionsPerAttempt, vs...))
+}
+
+// QuestionsPerAttemptGT applies the GT predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldQuestionsPerAttempt, v))
+}
+
+// QuestionsPerAttemptGTE applies the GTE predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldQuestionsPerAttempt, v))
+}
+
+// QuestionsPerAttemptLT applies the LT predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldQuestionsPerAttempt, v))
+}
+
+// QuestionsPerAttemptLTE applies the LTE predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldQuestionsPerAttempt, v))
+}
+
+// QuestionsPerAttemptIsNil applies the IsNil predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldQuestionsPerAttempt))
+}
+
+// QuestionsPerAttemptNotNil applies the NotNil predicate on the "questions_per_attempt" field.
+func QuestionsPerAttemptNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldQuestionsPerAttempt))
+}
+
+// ShuffleQuestionsEQ applies the EQ predicate on the "shuffle_questions" field.
+func ShuffleQuestionsEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldShuffleQuestions, v))
+}
+
+// ShuffleQuestionsNEQ applies the NEQ predicate on the "shuffle_questions" field.
+func ShuffleQuestionsNEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldShuffleQuestions, v))
+}
+
+// TimeLimitMinutesEQ applies the EQ predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimitMinutes, v))
+}
+
+// TimeLimitMinutesNEQ applies the NEQ predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTimeLimitMinutes, v))
+}
+
+// TimeLimitMinutesIn applies the In predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTimeLimitMinutes, vs...))
+}
+
+// TimeLimitMinutesNotIn applies the NotIn predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTimeLimitMinutes, vs...))
+}
+
+// TimeLimitMinutesGT applies the GT predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTimeLimitMinutes, v))
+}
+
+// TimeLimitMinutesGTE applies the GTE predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTimeLimitMinutes, v))
+}
+
+// TimeLimitMinutesLT applies the LT predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTimeLimitMinutes, v))
+}
+
+// TimeLimitMinutesLTE applies the LTE predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTimeLimitMinutes, v))
+}
+
+// TimeLimitMinutesIsNil applies the IsNil predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldTimeLimitMinutes))
+}
+
+// TimeLimitMinutesNotNil applies the NotNil predicate on the "time_limit_minutes" field.
+func TimeLimitMinutesNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldTimeLimitMinutes))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIsNil applies the IsNil predicate on the "max_attempts" field.
+func MaxAttemptsIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldMaxAttempts))
+}
+
+// MaxAttemptsNotNil applies the NotNil predicate on the "max_attempts" field.
+func MaxAttemptsNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldMaxAttempts))
+}
+
+// PassingPercentEQ applies the EQ predicate on the "passing_percent" field.
+func PassingPercentEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldPassingPercent, v))
+}
+
+// PassingPercentNEQ applies the NEQ predicate on the "passing_percent" field.
+func PassingPercentNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldPassingPercent, v))
+}
+
+// PassingPercentIn applies the In predicate on the "passing_percent" field.
+func PassingPercentIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldPassingPercent, vs...))
+}
+
+// PassingPercentNotIn applies the NotIn predicate on the "passing_percent" field.
+func PassingPercentNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldPassingPercent, vs...))
+}
+
+// PassingPercentGT applies the GT predicate on the "passing_percent" field.
+func PassingPercentGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldPassingPercent, v))
+}
+
+// PassingPercentGTE applies the GTE predicate on the "passing_percent" field.
+func PassingPercentGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldPassingPercent, v))
+}
+
+// PassingPercentLT applies the LT predicate on the "passing_percent" field.
+func PassingPercentLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldPassingPercent, v))
+}
+
+// PassingPercentLTE applies the LTE predicate on the "passing_percent" field.
+func PassingPercentLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldPassingPercent, v))
+}
+
+// PassingPercentIsNil applies the IsNil predicate on the "passing_percent" field.
+func PassingPercentIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldPassingPercent))
+}
+
+// PassingPercentNotNil applies the NotNil predicate on the "passing_percent" field.
+func PassingPercentNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldPassingPercent))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.NotPredicates(p))
+}
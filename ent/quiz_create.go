@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/quiz"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// QuizCreate is the builder for creating a Quiz entity.
+type QuizCreate struct {
+	config
+	mutation *QuizMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (qc *QuizCreate) SetStatus(s string) *QuizCreate {
+	qc.mutation.SetStatus(s)
+	return qc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableStatus(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetStatus(*s)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuizCreate) SetCreatedAt(t time.Time) *QuizCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableCreatedAt(t *time.Time) *QuizCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuizCreate) SetUpdatedAt(t time.Time) *QuizCreate {
+	qc.mutation.SetUpdatedAt(t)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableUpdatedAt(t *time.Time) *QuizCreate {
+	if t != nil {
+		qc.SetUpdatedAt(*t)
+	}
+	return qc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (qc *QuizCreate) SetCreatedBy(s string) *QuizCreate {
+	qc.mutation.SetCreatedBy(s)
+	return qc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableCreatedBy(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetCreatedBy(*s)
+	}
+	return qc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qc *QuizCreate) SetUpdatedBy(s string) *QuizCreate {
+	qc.mutation.SetUpdatedBy(s)
+	return qc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableUpdatedBy(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetUpdatedBy(*s)
+	}
+	return qc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (qc *QuizCreate) SetInternshipID(s string) *QuizCreate {
+	qc.mutation.SetInternshipID(s)
+	return qc
+}
+
+// SetModuleID sets the "module_id" field.
+func (qc *QuizCreate) SetModuleID(s string) *QuizCreate {
+	qc.mutation.SetModuleID(s)
+	return qc
+}
+
+// SetTitle sets the "title" field.
+func (qc *QuizCreate) SetTitle(s string) *QuizCreate {
+	qc.mutation.SetTitle(s)
+	return qc
+}
+
+// SetDescription sets the "description" field.
+func (qc *QuizCreate) SetDescription(s string) *QuizCreate {
+	qc.mutation.SetDescription(s)
+	return qc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableDescription(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetDescription(*s)
+	}
+	return qc
+}
+
+// SetQuestions sets the "questions" field.
+func (qc *QuizCreate) SetQuestions(tq []types.QuizQuestion) *QuizCreate {
+	qc.mutation.SetQuestions(tq)
+	return qc
+}
+
+// SetQuestionsPerAttempt sets the "questions_per_attempt" field.
+func (qc *QuizCreate) SetQuestionsPerAttempt(i int) *QuizCreate {
+	qc.mutation.SetQuestionsPerAttempt(i)
+	return qc
+}
+
+// SetNillableQuestionsPerAttempt sets the "questions_per_attempt" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableQuestionsPerAttempt(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetQuestionsPerAttempt(*i)
+	}
+	return qc
+}
+
+// SetShuffleQuestions sets the "shuffle_questions" field.
+func (qc *QuizCreate) SetShuffleQuestions(b bool) *QuizCreate {
+	qc.mutation.SetShuffleQuestions(b)
+	return qc
+}
+
+// SetNillableShuffleQuestions sets the "shuffle_questions" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableShuffleQuestions(b *bool) *QuizCreate {
+	if b != nil {
+		qc.SetShuffleQuestions(*b)
+	}
+	return qc
+}
+
+// SetTimeLimitMinutes sets the "time_limit_minutes" field.
+func (qc *QuizCreate) SetTimeLimitMinutes(i int) *QuizCreate {
+	qc.mutation.SetTimeLimitMinutes(i)
+	return qc
+}
+
+// SetNillableTimeLimitMinutes sets the "time_limit_minutes" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableTimeLimitMinutes(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetTimeLimitMinutes(*i)
+	}
+	return qc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (qc *QuizCreate) SetMaxAttempts(i int) *QuizCreate {
+	qc.mutation.SetMaxAttempts(i)
+	return qc
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableMaxAttempts(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetMaxAttempts(*i)
+	}
+	return qc
+}
+
+// SetPassingPercent sets the "passing_percent" field.
+func (qc *QuizCreate) SetPassingPercent(i int) *QuizCreate {
+	qc.mutation.SetPassingPercent(i)
+	return qc
+}
+
+// SetNillablePassingPercent sets the "passing_percent" field if the given value is not nil.
+func (qc *QuizCreate) SetNillablePassingPercent(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetPassingPercent(*i)
+	}
+	return qc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (qc *QuizCreate) SetSortOrder(i int) *QuizCreate {
+	qc.mutation.SetSortOrder(i)
+	return qc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableSortOrder(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetSortOrder(*i)
+	}
+	return qc
+}
+
+// SetID sets the "id" field.
+func (qc *QuizCreate) SetID(s string) *QuizCreate {
+	qc.mutation.SetID(s)
+	return qc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableID(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetID(*s)
+	}
+	return qc
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (qc *QuizCreate) Mutation() *QuizMutation {
+	return qc.mutation
+}
+
+// Save creates the Quiz in the database.
+func (qc *QuizCreate) Save(ctx context.Context) (*Quiz, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuizCreate) SaveX(ctx context.Context) *Quiz {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuizCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuizCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuizCreate) defaults() {
+	if _, ok := qc.mutation.Status(); !ok {
+		v := quiz.DefaultStatus
+		qc.mutation.SetStatus(v)
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quiz.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quiz.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qc.mutation.ShuffleQuestions(); !ok {
+		v := quiz.DefaultShuffleQuestions
+		qc.mutation.SetShuffleQuestions(v)
+	}
+	if _, ok := qc.mutation.SortOrder(); !ok {
+		v := quiz.DefaultSortOrder
+		qc.mutation.SetSortOrder(v)
+	}
+	if _, ok := qc.mutation.ID(); !ok {
+		v := quiz.DefaultID()
+		qc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuizCreate) check() error {
+	if _, ok := qc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Quiz.status"`)}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quiz.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quiz.updated_at"`)}
+	}
+	if _, ok := qc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Quiz.internship_id"`)}
+	}
+	if v, ok := qc.mutation.InternshipID(); ok {
+		if err := quiz.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Quiz.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.ModuleID(); !ok {
+		return &ValidationError{Name: "module_id", err: errors.New(`ent: missing required field "Quiz.module_id"`)}
+	}
+	if v, ok := qc.mutation.ModuleID(); ok {
+		if err := quiz.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Quiz.module_id": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Quiz.title"`)}
+	}
+	if v, ok := qc.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := qc.mutation.QuestionsPerAttempt(); ok {
+		if err := quiz.QuestionsPerAttemptValidator(v); err != nil {
+			return &ValidationError{Name: "questions_per_attempt", err: fmt.Errorf(`ent: validator failed for field "Quiz.questions_per_attempt": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.ShuffleQuestions(); !ok {
+		return &ValidationError{Name: "shuffle_questions", err: errors.New(`ent: missing required field "Quiz.shuffle_questions"`)}
+	}
+	if v, ok := qc.mutation.TimeLimitMinutes(); ok {
+		if err := quiz.TimeLimitMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_minutes", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_minutes": %w`, err)}
+		}
+	}
+	if v, ok := qc.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := qc.mutation.PassingPercent(); ok {
+		if err := quiz.PassingPercentValidator(v); err != nil {
+			return &ValidationError{Name: "passing_percent", err: fmt.Errorf(`ent: validator failed for field "Quiz.passing_percent": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Quiz.sort_order"`)}
+	}
+	return nil
+}
+
+func (qc *QuizCreate) sqlSave(ctx context.Context) (*Quiz, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Quiz.ID type: %T", _spec.ID.Value)
+		}
+	}
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuizCreate) createSpec() (*Quiz, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quiz{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quiz.Table, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeString))
+	)
+	if id, ok := qc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qc.mutation.Status(); ok {
+		_spec.SetField(quiz.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quiz.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qc.mutation.CreatedBy(); ok {
+		_spec.SetField(quiz.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := qc.mutation.UpdatedBy(); ok {
+		_spec.SetField(quiz.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := qc.mutation.InternshipID(); ok {
+		_spec.SetField(quiz.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := qc.mutation.ModuleID(); ok {
+		_spec.SetField(quiz.FieldModuleID, field.TypeString, value)
+		_node.ModuleID = value
+	}
+	if value, ok := qc.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := qc.mutation.Description(); ok {
+		_spec.SetField(quiz.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := qc.mutation.Questions(); ok {
+		_spec.SetField(quiz.FieldQuestions, field.TypeJSON, value)
+		_node.Questions = value
+	}
+	if value, ok := qc.mutation.QuestionsPerAttempt(); ok {
+		_spec.SetField(quiz.FieldQuestionsPerAttempt, field.TypeInt, value)
+		_node.QuestionsPerAttempt = &value
+	}
+	if value, ok := qc.mutation.ShuffleQuestions(); ok {
+		_spec.SetField(quiz.FieldShuffleQuestions, field.TypeBool, value)
+		_node.ShuffleQuestions = value
+	}
+	if value, ok := qc.mutation.TimeLimitMinutes(); ok {
+		_spec.SetField(quiz.FieldTimeLimitMinutes, field.TypeInt, value)
+		_node.TimeLimitMinutes = &value
+	}
+	if value, ok := qc.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = &value
+	}
+	if value, ok := qc.mutation.PassingPercent(); ok {
+		_spec.SetField(quiz.FieldPassingPercent, field.TypeInt, value)
+		_node.PassingPercent = &value
+	}
+	if value, ok := qc.mutation.SortOrder(); ok {
+		_spec.SetField(quiz.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// QuizCreateBulk is the builder for creating many Quiz entities in bulk.
+type QuizCreateBulk struct {
+	config
+	err      error
+	builders []*QuizCreate
+}
+
+// Save creates the Quiz entities in the database.
+func (qcb *QuizCreateBulk) Save(ctx context.Context) ([]*Quiz, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quiz, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuizCreateBulk) SaveX(ctx context.Context) []*Quiz {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuizCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuizCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,936 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/quiz"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// QuizUpdate is the builder for updating Quiz entities.
+type QuizUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuizMutation
+}
+
+// Where appends a list predicates to the QuizUpdate builder.
+func (qu *QuizUpdate) Where(ps ...predicate.Quiz) *QuizUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetStatus sets the "status" field.
+func (qu *QuizUpdate) SetStatus(s string) *QuizUpdate {
+	qu.mutation.SetStatus(s)
+	return qu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableStatus(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetStatus(*s)
+	}
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuizUpdate) SetUpdatedAt(t time.Time) *QuizUpdate {
+	qu.mutation.SetUpdatedAt(t)
+	return qu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qu *QuizUpdate) SetUpdatedBy(s string) *QuizUpdate {
+	qu.mutation.SetUpdatedBy(s)
+	return qu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableUpdatedBy(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetUpdatedBy(*s)
+	}
+	return qu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (qu *QuizUpdate) ClearUpdatedBy() *QuizUpdate {
+	qu.mutation.ClearUpdatedBy()
+	return qu
+}
+
+// SetModuleID sets the "module_id" field.
+func (qu *QuizUpdate) SetModuleID(s string) *QuizUpdate {
+	qu.mutation.SetModuleID(s)
+	return qu
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableModuleID(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetModuleID(*s)
+	}
+	return qu
+}
+
+// SetTitle sets the "title" field.
+func (qu *QuizUpdate) SetTitle(s string) *QuizUpdate {
+	qu.mutation.SetTitle(s)
+	return qu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableTitle(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetTitle(*s)
+	}
+	return qu
+}
+
+// SetDescription sets the "description" field.
+func (qu *QuizUpdate) SetDescription(s string) *QuizUpdate {
+	qu.mutation.SetDescription(s)
+	return qu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableDescription(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetDescription(*s)
+	}
+	return qu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (qu *QuizUpdate) ClearDescription() *QuizUpdate {
+	qu.mutation.ClearDescription()
+	return qu
+}
+
+// SetQuestions sets the "questions" field.
+func (qu *QuizUpdate) SetQuestions(tq []types.QuizQuestion) *QuizUpdate {
+	qu.mutation.SetQuestions(tq)
+	return qu
+}
+
+// AppendQuestions appends tq to the "questions" field.
+func (qu *QuizUpdate) AppendQuestions(tq []types.QuizQuestion) *QuizUpdate {
+	qu.mutation.AppendQuestions(tq)
+	return qu
+}
+
+// ClearQuestions clears the value of the "questions" field.
+func (qu *QuizUpdate) ClearQuestions() *QuizUpdate {
+	qu.mutation.ClearQuestions()
+	return qu
+}
+
+// SetQuestionsPerAttempt sets the "questions_per_attempt" field.
+func (qu *QuizUpdate) SetQuestionsPerAttempt(i int) *QuizUpdate {
+	qu.mutation.ResetQuestionsPerAttempt()
+	qu.mutation.SetQuestionsPerAttempt(i)
+	return qu
+}
+
+// SetNillableQuestionsPerAttempt sets the "questions_per_attempt" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableQuestionsPerAttempt(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetQuestionsPerAttempt(*i)
+	}
+	return qu
+}
+
+// AddQuestionsPerAttempt adds i to the "questions_per_attempt" field.
+func (qu *QuizUpdate) AddQuestionsPerAttempt(i int) *QuizUpdate {
+	qu.mutation.AddQuestionsPerAttempt(i)
+	return qu
+}
+
+// ClearQuestionsPerAttempt clears the value of the "questions_per_attempt" field.
+func (qu *QuizUpdate) ClearQuestionsPerAttempt() *QuizUpdate {
+	qu.mutation.ClearQuestionsPerAttempt()
+	return qu
+}
+
+// SetShuffleQuestions sets the "shuffle_questions" field.
+func (qu *QuizUpdate) SetShuffleQuestions(b bool) *QuizUpdate {
+	qu.mutation.SetShuffleQuestions(b)
+	return qu
+}
+
+// SetNillableShuffleQuestions sets the "shuffle_questions" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableShuffleQuestions(b *bool) *QuizUpdate {
+	if b != nil {
+		qu.SetShuffleQuestions(*b)
+	}
+	return qu
+}
+
+// SetTimeLimitMinutes sets the "time_limit_minutes" field.
+func (qu *QuizUpdate) SetTimeLimitMinutes(i int) *QuizUpdate {
+	qu.mutation.ResetTimeLimitMinutes()
+	qu.mutation.SetTimeLimitMinutes(i)
+	return qu
+}
+
+// SetNillableTimeLimitMinutes sets the "time_limit_minutes" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableTimeLimitMinutes(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetTimeLimitMinutes(*i)
+	}
+	return qu
+}
+
+// AddTimeLimitMinutes adds i to the "time_limit_minutes" field.
+func (qu *QuizUpdate) AddTimeLimitMinutes(i int) *QuizUpdate {
+	qu.mutation.AddTimeLimitMinutes(i)
+	return qu
+}
+
+// ClearTimeLimitMinutes clears the value of the "time_limit_minutes" field.
+func (qu *QuizUpdate) ClearTimeLimitMinutes() *QuizUpdate {
+	qu.mutation.ClearTimeLimitMinutes()
+	return qu
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (qu *QuizUpdate) SetMaxAttempts(i int) *QuizUpdate {
+	qu.mutation.ResetMaxAttempts()
+	qu.mutation.SetMaxAttempts(i)
+	return qu
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableMaxAttempts(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetMaxAttempts(*i)
+	}
+	return qu
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (qu *QuizUpdate) AddMaxAttempts(i int) *QuizUpdate {
+	qu.mutation.AddMaxAttempts(i)
+	return qu
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (qu *QuizUpdate) ClearMaxAttempts() *QuizUpdate {
+	qu.mutation.ClearMaxAttempts()
+	return qu
+}
+
+// SetPassingPercent sets the "passing_percent" field.
+func (qu *QuizUpdate) SetPassingPercent(i int) *QuizUpdate {
+	qu.mutation.ResetPassingPercent()
+	qu.mutation.SetPassingPercent(i)
+	return qu
+}
+
+// SetNillablePassingPercent sets the "passing_percent" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillablePassingPercent(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetPassingPercent(*i)
+	}
+	return qu
+}
+
+// AddPassingPercent adds i to the "passing_percent" field.
+func (qu *QuizUpdate) AddPassingPercent(i int) *QuizUpdate {
+	qu.mutation.AddPassingPercent(i)
+	return qu
+}
+
+// ClearPassingPercent clears the value of the "passing_percent" field.
+func (qu *QuizUpdate) ClearPassingPercent() *QuizUpdate {
+	qu.mutation.ClearPassingPercent()
+	return qu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (qu *QuizUpdate) SetSortOrder(i int) *QuizUpdate {
+	qu.mutation.ResetSortOrder()
+	qu.mutation.SetSortOrder(i)
+	return qu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableSortOrder(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetSortOrder(*i)
+	}
+	return qu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (qu *QuizUpdate) AddSortOrder(i int) *QuizUpdate {
+	qu.mutation.AddSortOrder(i)
+	return qu
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (qu *QuizUpdate) Mutation() *QuizMutation {
+	return qu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuizUpdate) Save(ctx context.Context) (int, error) {
+	qu.defaults()
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuizUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuizUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuizUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qu *QuizUpdate) defaults() {
+	if _, ok := qu.mutation.UpdatedAt(); !ok {
+		v := quiz.UpdateDefaultUpdatedAt()
+		qu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qu *QuizUpdate) check() error {
+	if v, ok := qu.mutation.ModuleID(); ok {
+		if err := quiz.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Quiz.module_id": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.QuestionsPerAttempt(); ok {
+		if err := quiz.QuestionsPerAttemptValidator(v); err != nil {
+			return &ValidationError{Name: "questions_per_attempt", err: fmt.Errorf(`ent: validator failed for field "Quiz.questions_per_attempt": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.TimeLimitMinutes(); ok {
+		if err := quiz.TimeLimitMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_minutes", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_minutes": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.PassingPercent(); ok {
+		if err := quiz.PassingPercentValidator(v); err != nil {
+			return &ValidationError{Name: "passing_percent", err: fmt.Errorf(`ent: validator failed for field "Quiz.passing_percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qu *QuizUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quiz.Table, quiz.Columns, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeString))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.Status(); ok {
+		_spec.SetField(quiz.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qu.mutation.CreatedByCleared() {
+		_spec.ClearField(quiz.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := qu.mutation.UpdatedBy(); ok {
+		_spec.SetField(quiz.FieldUpdatedBy, field.TypeString, value)
+	}
+	if qu.mutation.UpdatedByCleared() {
+		_spec.ClearField(quiz.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := qu.mutation.ModuleID(); ok {
+		_spec.SetField(quiz.FieldModuleID, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Description(); ok {
+		_spec.SetField(quiz.FieldDescription, field.TypeString, value)
+	}
+	if qu.mutation.DescriptionCleared() {
+		_spec.ClearField(quiz.FieldDescription, field.TypeString)
+	}
+	if value, ok := qu.mutation.Questions(); ok {
+		_spec.SetField(quiz.FieldQuestions, field.TypeJSON, value)
+	}
+	if value, ok := qu.mutation.AppendedQuestions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quiz.FieldQuestions, value)
+		})
+	}
+	if qu.mutation.QuestionsCleared() {
+		_spec.ClearField(quiz.FieldQuestions, field.TypeJSON)
+	}
+	if value, ok := qu.mutation.QuestionsPerAttempt(); ok {
+		_spec.SetField(quiz.FieldQuestionsPerAttempt, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedQuestionsPerAttempt(); ok {
+		_spec.AddField(quiz.FieldQuestionsPerAttempt, field.TypeInt, value)
+	}
+	if qu.mutation.QuestionsPerAttemptCleared() {
+		_spec.ClearField(quiz.FieldQuestionsPerAttempt, field.TypeInt)
+	}
+	if value, ok := qu.mutation.ShuffleQuestions(); ok {
+		_spec.SetField(quiz.FieldShuffleQuestions, field.TypeBool, value)
+	}
+	if value, ok := qu.mutation.TimeLimitMinutes(); ok {
+		_spec.SetField(quiz.FieldTimeLimitMinutes, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedTimeLimitMinutes(); ok {
+		_spec.AddField(quiz.FieldTimeLimitMinutes, field.TypeInt, value)
+	}
+	if qu.mutation.TimeLimitMinutesCleared() {
+		_spec.ClearField(quiz.FieldTimeLimitMinutes, field.TypeInt)
+	}
+	if value, ok := qu.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if qu.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(quiz.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := qu.mutation.PassingPercent(); ok {
+		_spec.SetField(quiz.FieldPassingPercent, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedPassingPercent(); ok {
+		_spec.AddField(quiz.FieldPassingPercent, field.TypeInt, value)
+	}
+	if qu.mutation.PassingPercentCleared() {
+		_spec.ClearField(quiz.FieldPassingPercent, field.TypeInt)
+	}
+	if value, ok := qu.mutation.SortOrder(); ok {
+		_spec.SetField(quiz.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedSortOrder(); ok {
+		_spec.AddField(quiz.FieldSortOrder, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quiz.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuizUpdateOne is the builder for updating a single Quiz entity.
+type QuizUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuizMutation
+}
+
+// SetStatus sets the "status" field.
+func (quo *QuizUpdateOne) SetStatus(s string) *QuizUpdateOne {
+	quo.mutation.SetStatus(s)
+	return quo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableStatus(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetStatus(*s)
+	}
+	return quo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuizUpdateOne) SetUpdatedAt(t time.Time) *QuizUpdateOne {
+	quo.mutation.SetUpdatedAt(t)
+	return quo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (quo *QuizUpdateOne) SetUpdatedBy(s string) *QuizUpdateOne {
+	quo.mutation.SetUpdatedBy(s)
+	return quo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableUpdatedBy(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetUpdatedBy(*s)
+	}
+	return quo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (quo *QuizUpdateOne) ClearUpdatedBy() *QuizUpdateOne {
+	quo.mutation.ClearUpdatedBy()
+	return quo
+}
+
+// SetModuleID sets the "module_id" field.
+func (quo *QuizUpdateOne) SetModuleID(s string) *QuizUpdateOne {
+	quo.mutation.SetModuleID(s)
+	return quo
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableModuleID(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetModuleID(*s)
+	}
+	return quo
+}
+
+// SetTitle sets the "title" field.
+func (quo *QuizUpdateOne) SetTitle(s string) *QuizUpdateOne {
+	quo.mutation.SetTitle(s)
+	return quo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableTitle(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetTitle(*s)
+	}
+	return quo
+}
+
+// SetDescription sets the "description" field.
+func (quo *QuizUpdateOne) SetDescription(s string) *QuizUpdateOne {
+	quo.mutation.SetDescription(s)
+	return quo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableDescription(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetDescription(*s)
+	}
+	return quo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (quo *QuizUpdateOne) ClearDescription() *QuizUpdateOne {
+	quo.mutation.ClearDescription()
+	return quo
+}
+
+// SetQuestions sets the "questions" field.
+func (quo *QuizUpdateOne) SetQuestions(tq []types.QuizQuestion) *QuizUpdateOne {
+	quo.mutation.SetQuestions(tq)
+	return quo
+}
+
+// AppendQuestions appends tq to the "questions" field.
+func (quo *QuizUpdateOne) AppendQuestions(tq []types.QuizQuestion) *QuizUpdateOne {
+	quo.mutation.AppendQuestions(tq)
+	return quo
+}
+
+// ClearQuestions clears the value of the "questions" field.
+func (quo *QuizUpdateOne) ClearQuestions() *QuizUpdateOne {
+	quo.mutation.ClearQuestions()
+	return quo
+}
+
+// SetQuestionsPerAttempt sets the "questions_per_attempt" field.
+func (quo *QuizUpdateOne) SetQuestionsPerAttempt(i int) *QuizUpdateOne {
+	quo.mutation.ResetQuestionsPerAttempt()
+	quo.mutation.SetQuestionsPerAttempt(i)
+	return quo
+}
+
+// SetNillableQuestionsPerAttempt sets the "questions_per_attempt" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableQuestionsPerAttempt(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetQuestionsPerAttempt(*i)
+	}
+	return quo
+}
+
+// AddQuestionsPerAttempt adds i to the "questions_per_attempt" field.
+func (quo *QuizUpdateOne) AddQuestionsPerAttempt(i int) *QuizUpdateOne {
+	quo.mutation.AddQuestionsPerAttempt(i)
+	return quo
+}
+
+// ClearQuestionsPerAttempt clears the value of the "questions_per_attempt" field.
+func (quo *QuizUpdateOne) ClearQuestionsPerAttempt() *QuizUpdateOne {
+	quo.mutation.ClearQuestionsPerAttempt()
+	return quo
+}
+
+// SetShuffleQuestions sets the "shuffle_questions" field.
+func (quo *QuizUpdateOne) SetShuffleQuestions(b bool) *QuizUpdateOne {
+	quo.mutation.SetShuffleQuestions(b)
+	return quo
+}
+
+// SetNillableShuffleQuestions sets the "shuffle_questions" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableShuffleQuestions(b *bool) *QuizUpdateOne {
+	if b != nil {
+		quo.SetShuffleQuestions(*b)
+	}
+	return quo
+}
+
+// SetTimeLimitMinutes sets the "time_limit_minutes" field.
+func (quo *QuizUpdateOne) SetTimeLimitMinutes(i int) *QuizUpdateOne {
+	quo.mutation.ResetTimeLimitMinutes()
+	quo.mutation.SetTimeLimitMinutes(i)
+	return quo
+}
+
+// SetNillableTimeLimitMinutes sets the "time_limit_minutes" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableTimeLimitMinutes(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetTimeLimitMinutes(*i)
+	}
+	return quo
+}
+
+// AddTimeLimitMinutes adds i to the "time_limit_minutes" field.
+func (quo *QuizUpdateOne) AddTimeLimitMinutes(i int) *QuizUpdateOne {
+	quo.mutation.AddTimeLimitMinutes(i)
+	return quo
+}
+
+// ClearTimeLimitMinutes clears the value of the "time_limit_minutes" field.
+func (quo *QuizUpdateOne) ClearTimeLimitMinutes() *QuizUpdateOne {
+	quo.mutation.ClearTimeLimitMinutes()
+	return quo
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (quo *QuizUpdateOne) SetMaxAttempts(i int) *QuizUpdateOne {
+	quo.mutation.ResetMaxAttempts()
+	quo.mutation.SetMaxAttempts(i)
+	return quo
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableMaxAttempts(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetMaxAttempts(*i)
+	}
+	return quo
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (quo *QuizUpdateOne) AddMaxAttempts(i int) *QuizUpdateOne {
+	quo.mutation.AddMaxAttempts(i)
+	return quo
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (quo *QuizUpdateOne) ClearMaxAttempts() *QuizUpdateOne {
+	quo.mutation.ClearMaxAttempts()
+	return quo
+}
+
+// SetPassingPercent sets the "passing_percent" field.
+func (quo *QuizUpdateOne) SetPassingPercent(i int) *QuizUpdateOne {
+	quo.mutation.ResetPassingPercent()
+	quo.mutation.SetPassingPercent(i)
+	return quo
+}
+
+// SetNillablePassingPercent sets the "passing_percent" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillablePassingPercent(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetPassingPercent(*i)
+	}
+	return quo
+}
+
+// AddPassingPercent adds i to the "passing_percent" field.
+func (quo *QuizUpdateOne) AddPassingPercent(i int) *QuizUpdateOne {
+	quo.mutation.AddPassingPercent(i)
+	return quo
+}
+
+// ClearPassingPercent clears the value of the "passing_percent" field.
+func (quo *QuizUpdateOne) ClearPassingPercent() *QuizUpdateOne {
+	quo.mutation.ClearPassingPercent()
+	return quo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (quo *QuizUpdateOne) SetSortOrder(i int) *QuizUpdateOne {
+	quo.mutation.ResetSortOrder()
+	quo.mutation.SetSortOrder(i)
+	return quo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableSortOrder(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetSortOrder(*i)
+	}
+	return quo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (quo *QuizUpdateOne) AddSortOrder(i int) *QuizUpdateOne {
+	quo.mutation.AddSortOrder(i)
+	return quo
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (quo *QuizUpdateOne) Mutation() *QuizMutation {
+	return quo.mutation
+}
+
+// Where appends a list predicates to the QuizUpdate builder.
+func (quo *QuizUpdateOne) Where(ps ...predicate.Quiz) *QuizUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuizUpdateOne) Select(field string, fields ...string) *QuizUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quiz entity.
+func (quo *QuizUpdateOne) Save(ctx context.Context) (*Quiz, error) {
+	quo.defaults()
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuizUpdateOne) SaveX(ctx context.Context) *Quiz {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuizUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuizUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (quo *QuizUpdateOne) defaults() {
+	if _, ok := quo.mutation.UpdatedAt(); !ok {
+		v := quiz.UpdateDefaultUpdatedAt()
+		quo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (quo *QuizUpdateOne) check() error {
+	if v, ok := quo.mutation.ModuleID(); ok {
+		if err := quiz.ModuleIDValidator(v); err != nil {
+			return &ValidationError{Name: "module_id", err: fmt.Errorf(`ent: validator failed for field "Quiz.module_id": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.QuestionsPerAttempt(); ok {
+		if err := quiz.QuestionsPerAttemptValidator(v); err != nil {
+			return &ValidationError{Name: "questions_per_attempt", err: fmt.Errorf(`ent: validator failed for field "Quiz.questions_per_attempt": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.TimeLimitMinutes(); ok {
+		if err := quiz.TimeLimitMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_minutes", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_minutes": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.PassingPercent(); ok {
+		if err := quiz.PassingPercentValidator(v); err != nil {
+			return &ValidationError{Name: "passing_percent", err: fmt.Errorf(`ent: validator failed for field "Quiz.passing_percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (quo *QuizUpdateOne) sqlSave(ctx context.Context) (_node *Quiz, err error) {
+	if err := quo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quiz.Table, quiz.Columns, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeString))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quiz.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quiz.FieldID)
+		for _, f := range fields {
+			if !quiz.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quiz.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.Status(); ok {
+		_spec.SetField(quiz.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if quo.mutation.CreatedByCleared() {
+		_spec.ClearField(quiz.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := quo.mutation.UpdatedBy(); ok {
+		_spec.SetField(quiz.FieldUpdatedBy, field.TypeString, value)
+	}
+	if quo.mutation.UpdatedByCleared() {
+		_spec.ClearField(quiz.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := quo.mutation.ModuleID(); ok {
+		_spec.SetField(quiz.FieldModuleID, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Description(); ok {
+		_spec.SetField(quiz.FieldDescription, field.TypeString, value)
+	}
+	if quo.mutation.DescriptionCleared() {
+		_spec.ClearField(quiz.FieldDescription, field.TypeString)
+	}
+	if value, ok := quo.mutation.Questions(); ok {
+		_spec.SetField(quiz.FieldQuestions, field.TypeJSON, value)
+	}
+	if value, ok := quo.mutation.AppendedQuestions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quiz.FieldQuestions, value)
+		})
+	}
+	if quo.mutation.QuestionsCleared() {
+		_spec.ClearField(quiz.FieldQuestions, field.TypeJSON)
+	}
+	if value, ok := quo.mutation.QuestionsPerAttempt(); ok {
+		_spec.SetField(quiz.FieldQuestionsPerAttempt, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedQuestionsPerAttempt(); ok {
+		_spec.AddField(quiz.FieldQuestionsPerAttempt, field.TypeInt, value)
+	}
+	if quo.mutation.QuestionsPerAttemptCleared() {
+		_spec.ClearField(quiz.FieldQuestionsPerAttempt, field.TypeInt)
+	}
+	if value, ok := quo.mutation.ShuffleQuestions(); ok {
+		_spec.SetField(quiz.FieldShuffleQuestions, field.TypeBool, value)
+	}
+	if value, ok := quo.mutation.TimeLimitMinutes(); ok {
+		_spec.SetField(quiz.FieldTimeLimitMinutes, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedTimeLimitMinutes(); ok {
+		_spec.AddField(quiz.FieldTimeLimitMinutes, field.TypeInt, value)
+	}
+	if quo.mutation.TimeLimitMinutesCleared() {
+		_spec.ClearField(quiz.FieldTimeLimitMinutes, field.TypeInt)
+	}
+	if value, ok := quo.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if quo.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(quiz.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := quo.mutation.PassingPercent(); ok {
+		_spec.SetField(quiz.FieldPassingPercent, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedPassingPercent(); ok {
+		_spec.AddField(quiz.FieldPassingPercent, field.TypeInt, value)
+	}
+	if quo.mutation.PassingPercentCleared() {
+		_spec.ClearField(quiz.FieldPassingPercent, field.TypeInt)
+	}
+	if value, ok := quo.mutation.SortOrder(); ok {
+		_spec.SetField(quiz.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(quiz.FieldSortOrder, field.TypeInt, value)
+	}
+	_node = &Quiz{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quiz.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
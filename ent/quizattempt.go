@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// QuizAttempt is the model entity for the QuizAttempt schema.
+type QuizAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// QuizID holds the value of the "quiz_id" field.
+	QuizID string `json:"quiz_id,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID string `json:"enrollment_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Attempt holds the value of the "attempt" field.
+	Attempt int `json:"attempt,omitempty"`
+	// QuestionIds holds the value of the "question_ids" field.
+	QuestionIds []string `json:"question_ids,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// AttemptStatus holds the value of the "attempt_status" field.
+	AttemptStatus string `json:"attempt_status,omitempty"`
+	// Answers holds the value of the "answers" field.
+	Answers []types.QuizAnswer `json:"answers,omitempty"`
+	// Results holds the value of the "results" field.
+	Results []types.QuizQuestionResult `json:"results,omitempty"`
+	// Score holds the value of the "score" field.
+	Score *float64 `json:"score,omitempty"`
+	// MaxScore holds the value of the "max_score" field.
+	MaxScore float64 `json:"max_score,omitempty"`
+	// ScorePercent holds the value of the "score_percent" field.
+	ScorePercent *float64 `json:"score_percent,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuizAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quizattempt.FieldQuestionIds, quizattempt.FieldAnswers, quizattempt.FieldResults:
+			values[i] = new([]byte)
+		case quizattempt.FieldScore, quizattempt.FieldMaxScore, quizattempt.FieldScorePercent:
+			values[i] = new(sql.NullFloat64)
+		case quizattempt.FieldAttempt:
+			values[i] = new(sql.NullInt64)
+		case quizattempt.FieldID, quizattempt.FieldStatus, quizattempt.FieldCreatedBy, quizattempt.FieldUpdatedBy, quizattempt.FieldQuizID, quizattempt.FieldInternshipID, quizattempt.FieldEnrollmentID, quizattempt.FieldUserID, quizattempt.FieldAttemptStatus:
+			values[i] = new(sql.NullString)
+		case quizattempt.FieldCreatedAt, quizattempt.FieldUpdatedAt, quizattempt.FieldStartedAt, quizattempt.FieldExpiresAt, quizattempt.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuizAttempt fields.
+func (qa *QuizAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quizattempt.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				qa.ID = value.String
+			}
+		case quizattempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				qa.Status = value.String
+			}
+		case quizattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qa.CreatedAt = value.Time
+			}
+		case quizattempt.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				qa.UpdatedAt = value.Time
+			}
+		case quizattempt.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				qa.CreatedBy = value.String
+			}
+		case quizattempt.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				qa.UpdatedBy = value.String
+			}
+		case quizattempt.FieldQuizID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quiz_id", values[i])
+			} else if value.Valid {
+				qa.QuizID = value.String
+			}
+		case quizattempt.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				qa.InternshipID = value.String
+			}
+		case quizattempt.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				qa.EnrollmentID = value.String
+			}
+		case quizattempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				qa.UserID = value.String
+			}
+		case quizattempt.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				qa.Attempt = int(value.Int64)
+			}
+		case quizattempt.FieldQuestionIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field question_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qa.QuestionIds); err != nil {
+					return fmt.Errorf("unmarshal field question_ids: %w", err)
+				}
+			}
+		case quizattempt.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				qa.StartedAt = value.Time
+			}
+		case quizattempt.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				qa.ExpiresAt = new(time.Time)
+				*qa.ExpiresAt = value.Time
+			}
+		case quizattempt.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				qa.SubmittedAt = new(time.Time)
+				*qa.SubmittedAt = value.Time
+			}
+		case quizattempt.FieldAttemptStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_status", values[i])
+			} else if value.Valid {
+				qa.AttemptStatus = value.String
+			}
+		case quizattempt.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qa.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case quizattempt.FieldResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qa.Results); err != nil {
+					return fmt.Errorf("unmarshal field results: %w", err)
+				}
+			}
+		case quizattempt.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				qa.Score = new(float64)
+				*qa.Score = value.Float64
+			}
+		case quizattempt.FieldMaxScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_score", values[i])
+			} else if value.Valid {
+				qa.MaxScore = value.Float64
+			}
+		case quizattempt.FieldScorePercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score_percent", values[i])
+			} else if value.Valid {
+				qa.ScorePercent = new(float64)
+				*qa.ScorePercent = value.Float64
+			}
+		default:
+			qa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuizAttempt.
+// This includes values selected through modifiers, order, etc.
+func (qa *QuizAttempt) Value(name string) (ent.Value, error) {
+	return qa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QuizAttempt.
+// Note that you need to call QuizAttempt.Unwrap() before calling this method if this QuizAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qa *QuizAttempt) Update() *QuizAttemptUpdateOne {
+	return NewQuizAttemptClient(qa.config).UpdateOne(qa)
+}
+
+// Unwrap unwraps the QuizAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qa *QuizAttempt) Unwrap() *QuizAttempt {
+	_tx, ok := qa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuizAttempt is not a transactional entity")
+	}
+	qa.config.driver = _tx.drv
+	return qa
+}
+
+// String implements the fmt.Stringer.
+func (qa *QuizAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuizAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qa.ID))
+	builder.WriteString("status=")
+	builder.WriteString(qa.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(qa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(qa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(qa.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(qa.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("quiz_id=")
+	builder.WriteString(qa.QuizID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(qa.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(qa.EnrollmentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(qa.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", qa.Attempt))
+	builder.WriteString(", ")
+	builder.WriteString("question_ids=")
+	builder.WriteString(fmt.Sprintf("%v", qa.QuestionIds))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(qa.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := qa.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := qa.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempt_status=")
+	builder.WriteString(qa.AttemptStatus)
+	builder.WriteString(", ")
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", qa.Answers))
+	builder.WriteString(", ")
+	builder.WriteString("results=")
+	builder.WriteString(fmt.Sprintf("%v", qa.Results))
+	builder.WriteString(", ")
+	if v := qa.Score; v != nil {
+		builder.WriteString("score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_score=")
+	builder.WriteString(fmt.Sprintf("%v", qa.MaxScore))
+	builder.WriteString(", ")
+	if v := qa.ScorePercent; v != nil {
+		builder.WriteString("score_percent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuizAttempts is a parsable slice of QuizAttempt.
+type QuizAttempts []*QuizAttempt
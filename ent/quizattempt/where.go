@@ -0,0 +1,1125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// QuizID applies equality check predicate on the "quiz_id" field. It's identical to QuizIDEQ.
+func QuizID(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldQuizID, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAttempt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// AttemptStatus applies equality check predicate on the "attempt_status" field. It's identical to AttemptStatusEQ.
+func AttemptStatus(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAttemptStatus, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// MaxScore applies equality check predicate on the "max_score" field. It's identical to MaxScoreEQ.
+func MaxScore(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// ScorePercent applies equality check predicate on the "score_percent" field. It's identical to ScorePercentEQ.
+func ScorePercent(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScorePercent, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// QuizIDEQ applies the EQ predicate on the "quiz_id" field.
+func QuizIDEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldQuizID, v))
+}
+
+// QuizIDNEQ applies the NEQ predicate on the "quiz_id" field.
+func QuizIDNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldQuizID, v))
+}
+
+// QuizIDIn applies the In predicate on the "quiz_id" field.
+func QuizIDIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldQuizID, vs...))
+}
+
+// QuizIDNotIn applies the NotIn predicate on the "quiz_id" field.
+func QuizIDNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldQuizID, vs...))
+}
+
+// QuizIDGT applies the GT predicate on the "quiz_id" field.
+func QuizIDGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldQuizID, v))
+}
+
+// QuizIDGTE applies the GTE predicate on the "quiz_id" field.
+func QuizIDGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldQuizID, v))
+}
+
+// QuizIDLT applies the LT predicate on the "quiz_id" field.
+func QuizIDLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldQuizID, v))
+}
+
+// QuizIDLTE applies the LTE predicate on the "quiz_id" field.
+func QuizIDLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldQuizID, v))
+}
+
+// QuizIDContains applies the Contains predicate on the "quiz_id" field.
+func QuizIDContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldQuizID, v))
+}
+
+// QuizIDHasPrefix applies the HasPrefix predicate on the "quiz_id" field.
+func QuizIDHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldQuizID, v))
+}
+
+// QuizIDHasSuffix applies the HasSuffix predicate on the "quiz_id" field.
+func QuizIDHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldQuizID, v))
+}
+
+// QuizIDEqualFold applies the EqualFold predicate on the "quiz_id" field.
+func QuizIDEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldQuizID, v))
+}
+
+// QuizIDContainsFold applies the ContainsFold predicate on the "quiz_id" field.
+func QuizIDContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldQuizID, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDGT applies the GT predicate on the "enrollment_id" field.
+func EnrollmentIDGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDGTE applies the GTE predicate on the "enrollment_id" field.
+func EnrollmentIDGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLT applies the LT predicate on the "enrollment_id" field.
+func EnrollmentIDLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLTE applies the LTE predicate on the "enrollment_id" field.
+func EnrollmentIDLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContains applies the Contains predicate on the "enrollment_id" field.
+func EnrollmentIDContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasPrefix applies the HasPrefix predicate on the "enrollment_id" field.
+func EnrollmentIDHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasSuffix applies the HasSuffix predicate on the "enrollment_id" field.
+func EnrollmentIDHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDEqualFold applies the EqualFold predicate on the "enrollment_id" field.
+func EnrollmentIDEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContainsFold applies the ContainsFold predicate on the "enrollment_id" field.
+func EnrollmentIDContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldEnrollmentID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldAttempt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// AttemptStatusEQ applies the EQ predicate on the "attempt_status" field.
+func AttemptStatusEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAttemptStatus, v))
+}
+
+// AttemptStatusNEQ applies the NEQ predicate on the "attempt_status" field.
+func AttemptStatusNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldAttemptStatus, v))
+}
+
+// AttemptStatusIn applies the In predicate on the "attempt_status" field.
+func AttemptStatusIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldAttemptStatus, vs...))
+}
+
+// AttemptStatusNotIn applies the NotIn predicate on the "attempt_status" field.
+func AttemptStatusNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldAttemptStatus, vs...))
+}
+
+// AttemptStatusGT applies the GT predicate on the "attempt_status" field.
+func AttemptStatusGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldAttemptStatus, v))
+}
+
+// AttemptStatusGTE applies the GTE predicate on the "attempt_status" field.
+func AttemptStatusGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldAttemptStatus, v))
+}
+
+// AttemptStatusLT applies the LT predicate on the "attempt_status" field.
+func AttemptStatusLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldAttemptStatus, v))
+}
+
+// AttemptStatusLTE applies the LTE predicate on the "attempt_status" field.
+func AttemptStatusLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldAttemptStatus, v))
+}
+
+// AttemptStatusContains applies the Contains predicate on the "attempt_status" field.
+func AttemptStatusContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldAttemptStatus, v))
+}
+
+// AttemptStatusHasPrefix applies the HasPrefix predicate on the "attempt_status" field.
+func AttemptStatusHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldAttemptStatus, v))
+}
+
+// AttemptStatusHasSuffix applies the HasSuffix predicate on the "attempt_status" field.
+func AttemptStatusHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldAttemptStatus, v))
+}
+
+// AttemptStatusEqualFold applies the EqualFold predicate on the "attempt_status" field.
+func AttemptStatusEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldAttemptStatus, v))
+}
+
+// AttemptStatusContainsFold applies the ContainsFold predicate on the "attempt_status" field.
+func AttemptStatusContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldAttemptStatus, v))
+}
+
+// AnswersIsNil applies the IsNil predicate on the "answers" field.
+func AnswersIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldAnswers))
+}
+
+// AnswersNotNil applies the NotNil predicate on the "answers" field.
+func AnswersNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldAnswers))
+}
+
+// ResultsIsNil applies the IsNil predicate on the "results" field.
+func ResultsIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldResults))
+}
+
+// ResultsNotNil applies the NotNil predicate on the "results" field.
+func ResultsNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldResults))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldScore))
+}
+
+// MaxScoreEQ applies the EQ predicate on the "max_score" field.
+func MaxScoreEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// MaxScoreNEQ applies the NEQ predicate on the "max_score" field.
+func MaxScoreNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldMaxScore, v))
+}
+
+// MaxScoreIn applies the In predicate on the "max_score" field.
+func MaxScoreIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreNotIn applies the NotIn predicate on the "max_score" field.
+func MaxScoreNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreGT applies the GT predicate on the "max_score" field.
+func MaxScoreGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldMaxScore, v))
+}
+
+// MaxScoreGTE applies the GTE predicate on the "max_score" field.
+func MaxScoreGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldMaxScore, v))
+}
+
+// MaxScoreLT applies the LT predicate on the "max_score" field.
+func MaxScoreLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldMaxScore, v))
+}
+
+// MaxScoreLTE applies the LTE predicate on the "max_score" field.
+func MaxScoreLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldMaxScore, v))
+}
+
+// ScorePercentEQ applies the EQ predicate on the "score_percent" field.
+func ScorePercentEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScorePercent, v))
+}
+
+// ScorePercentNEQ applies the NEQ predicate on the "score_percent" field.
+func ScorePercentNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldScorePercent, v))
+}
+
+// ScorePercentIn applies the In predicate on the "score_percent" field.
+func ScorePercentIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldScorePercent, vs...))
+}
+
+// ScorePercentNotIn applies the NotIn predicate on the "score_percent" field.
+func ScorePercentNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldScorePercent, vs...))
+}
+
+// ScorePercentGT applies the GT predicate on the "score_percent" field.
+func ScorePercentGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldScorePercent, v))
+}
+
+// ScorePercentGTE applies the GTE predicate on the "score_percent" field.
+func ScorePercentGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldScorePercent, v))
+}
+
+// ScorePercentLT applies the LT predicate on the "score_percent" field.
+func ScorePercentLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldScorePercent, v))
+}
+
+// ScorePercentLTE applies the LTE predicate on the "score_percent" field.
+func ScorePercentLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldScorePercent, v))
+}
+
+// ScorePercentIsNil applies the IsNil predicate on the "score_percent" field.
+func ScorePercentIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldScorePercent))
+}
+
+// ScorePercentNotNil applies the NotNil predicate on the "score_percent" field.
+func ScorePercentNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldScorePercent))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.NotPredicates(p))
+}
@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// QuizAttemptCreate is the builder for creating a QuizAttempt entity.
+type QuizAttemptCreate struct {
+	config
+	mutation *QuizAttemptMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (qac *QuizAttemptCreate) SetStatus(s string) *QuizAttemptCreate {
+	qac.mutation.SetStatus(s)
+	return qac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableStatus(s *string) *QuizAttemptCreate {
+	if s != nil {
+		qac.SetStatus(*s)
+	}
+	return qac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qac *QuizAttemptCreate) SetCreatedAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetCreatedAt(t)
+	return qac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableCreatedAt(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetCreatedAt(*t)
+	}
+	return qac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qac *QuizAttemptCreate) SetUpdatedAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetUpdatedAt(t)
+	return qac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableUpdatedAt(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetUpdatedAt(*t)
+	}
+	return qac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (qac *QuizAttemptCreate) SetCreatedBy(s string) *QuizAttemptCreate {
+	qac.mutation.SetCreatedBy(s)
+	return qac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableCreatedBy(s *string) *QuizAttemptCreate {
+	if s != nil {
+		qac.SetCreatedBy(*s)
+	}
+	return qac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qac *QuizAttemptCreate) SetUpdatedBy(s string) *QuizAttemptCreate {
+	qac.mutation.SetUpdatedBy(s)
+	return qac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableUpdatedBy(s *string) *QuizAttemptCreate {
+	if s != nil {
+		qac.SetUpdatedBy(*s)
+	}
+	return qac
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (qac *QuizAttemptCreate) SetQuizID(s string) *QuizAttemptCreate {
+	qac.mutation.SetQuizID(s)
+	return qac
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (qac *QuizAttemptCreate) SetInternshipID(s string) *QuizAttemptCreate {
+	qac.mutation.SetInternshipID(s)
+	return qac
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (qac *QuizAttemptCreate) SetEnrollmentID(s string) *QuizAttemptCreate {
+	qac.mutation.SetEnrollmentID(s)
+	return qac
+}
+
+// SetUserID sets the "user_id" field.
+func (qac *QuizAttemptCreate) SetUserID(s string) *QuizAttemptCreate {
+	qac.mutation.SetUserID(s)
+	return qac
+}
+
+// SetAttempt sets the "attempt" field.
+func (qac *QuizAttemptCreate) SetAttempt(i int) *QuizAttemptCreate {
+	qac.mutation.SetAttempt(i)
+	return qac
+}
+
+// SetQuestionIds sets the "question_ids" field.
+func (qac *QuizAttemptCreate) SetQuestionIds(s []string) *QuizAttemptCreate {
+	qac.mutation.SetQuestionIds(s)
+	return qac
+}
+
+// SetStartedAt sets the "started_at" field.
+func (qac *QuizAttemptCreate) SetStartedAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetStartedAt(t)
+	return qac
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qac *QuizAttemptCreate) SetExpiresAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetExpiresAt(t)
+	return qac
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableExpiresAt(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetExpiresAt(*t)
+	}
+	return qac
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (qac *QuizAttemptCreate) SetSubmittedAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetSubmittedAt(t)
+	return qac
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableSubmittedAt(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetSubmittedAt(*t)
+	}
+	return qac
+}
+
+// SetAttemptStatus sets the "attempt_status" field.
+func (qac *QuizAttemptCreate) SetAttemptStatus(s string) *QuizAttemptCreate {
+	qac.mutation.SetAttemptStatus(s)
+	return qac
+}
+
+// SetNillableAttemptStatus sets the "attempt_status" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableAttemptStatus(s *string) *QuizAttemptCreate {
+	if s != nil {
+		qac.SetAttemptStatus(*s)
+	}
+	return qac
+}
+
+// SetAnswers sets the "answers" field.
+func (qac *QuizAttemptCreate) SetAnswers(ta []types.QuizAnswer) *QuizAttemptCreate {
+	qac.mutation.SetAnswers(ta)
+	return qac
+}
+
+// SetResults sets the "results" field.
+func (qac *QuizAttemptCreate) SetResults(tqr []types.QuizQuestionResult) *QuizAttemptCreate {
+	qac.mutation.SetResults(tqr)
+	return qac
+}
+
+// SetScore sets the "score" field.
+func (qac *QuizAttemptCreate) SetScore(f float64) *QuizAttemptCreate {
+	qac.mutation.SetScore(f)
+	return qac
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableScore(f *float64) *QuizAttemptCreate {
+	if f != nil {
+		qac.SetScore(*f)
+	}
+	return qac
+}
+
+// SetMaxScore sets the "max_score" field.
+func (qac *QuizAttemptCreate) SetMaxScore(f float64) *QuizAttemptCreate {
+	qac.mutation.SetMaxScore(f)
+	return qac
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableMaxScore(f *float64) *QuizAttemptCreate {
+	if f != nil {
+		qac.SetMaxScore(*f)
+	}
+	return qac
+}
+
+// SetScorePercent sets the "score_percent" field.
+func (qac *QuizAttemptCreate) SetScorePercent(f float64) *QuizAttemptCreate {
+	qac.mutation.SetScorePercent(f)
+	return qac
+}
+
+// SetNillableScorePercent sets the "score_percent" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableScorePercent(f *float64) *QuizAttemptCreate {
+	if f != nil {
+		qac.SetScorePercent(*f)
+	}
+	return qac
+}
+
+// SetID sets the "id" field.
+func (qac *QuizAttemptCreate) SetID(s string) *QuizAttemptCreate {
+	qac.mutation.SetID(s)
+	return qac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableID(s *string) *QuizAttemptCreate {
+	if s != nil {
+		qac.SetID(*s)
+	}
+	return qac
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (qac *QuizAttemptCreate) Mutation() *QuizAttemptMutation {
+	return qac.mutation
+}
+
+// Save creates the QuizAttempt in the database.
+func (qac *QuizAttemptCreate) Save(ctx context.Context) (*QuizAttempt, error) {
+	qac.defaults()
+	return withHooks(ctx, qac.sqlSave, qac.mutation, qac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qac *QuizAttemptCreate) SaveX(ctx context.Context) *QuizAttempt {
+	v, err := qac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qac *QuizAttemptCreate) Exec(ctx context.Context) error {
+	_, err := qac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qac *QuizAttemptCreate) ExecX(ctx context.Context) {
+	if err := qac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qac *QuizAttemptCreate) defaults() {
+	if _, ok := qac.mutation.Status(); !ok {
+		v := quizattempt.DefaultStatus
+		qac.mutation.SetStatus(v)
+	}
+	if _, ok := qac.mutation.CreatedAt(); !ok {
+		v := quizattempt.DefaultCreatedAt()
+		qac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qac.mutation.UpdatedAt(); !ok {
+		v := quizattempt.DefaultUpdatedAt()
+		qac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qac.mutation.AttemptStatus(); !ok {
+		v := quizattempt.DefaultAttemptStatus
+		qac.mutation.SetAttemptStatus(v)
+	}
+	if _, ok := qac.mutation.MaxScore(); !ok {
+		v := quizattempt.DefaultMaxScore
+		qac.mutation.SetMaxScore(v)
+	}
+	if _, ok := qac.mutation.ID(); !ok {
+		v := quizattempt.DefaultID()
+		qac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qac *QuizAttemptCreate) check() error {
+	if _, ok := qac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QuizAttempt.status"`)}
+	}
+	if _, ok := qac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuizAttempt.created_at"`)}
+	}
+	if _, ok := qac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuizAttempt.updated_at"`)}
+	}
+	if _, ok := qac.mutation.QuizID(); !ok {
+		return &ValidationError{Name: "quiz_id", err: errors.New(`ent: missing required field "QuizAttempt.quiz_id"`)}
+	}
+	if v, ok := qac.mutation.QuizID(); ok {
+		if err := quizattempt.QuizIDValidator(v); err != nil {
+			return &ValidationError{Name: "quiz_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.quiz_id": %w`, err)}
+		}
+	}
+	if _, ok := qac.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "QuizAttempt.internship_id"`)}
+	}
+	if v, ok := qac.mutation.InternshipID(); ok {
+		if err := quizattempt.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := qac.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "QuizAttempt.enrollment_id"`)}
+	}
+	if v, ok := qac.mutation.EnrollmentID(); ok {
+		if err := quizattempt.EnrollmentIDValidator(v); err != nil {
+			return &ValidationError{Name: "enrollment_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.enrollment_id": %w`, err)}
+		}
+	}
+	if _, ok := qac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "QuizAttempt.user_id"`)}
+	}
+	if v, ok := qac.mutation.UserID(); ok {
+		if err := quizattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.user_id": %w`, err)}
+		}
+	}
+	if _, ok := qac.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "QuizAttempt.attempt"`)}
+	}
+	if v, ok := qac.mutation.Attempt(); ok {
+		if err := quizattempt.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.attempt": %w`, err)}
+		}
+	}
+	if _, ok := qac.mutation.QuestionIds(); !ok {
+		return &ValidationError{Name: "question_ids", err: errors.New(`ent: missing required field "QuizAttempt.question_ids"`)}
+	}
+	if _, ok := qac.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "QuizAttempt.started_at"`)}
+	}
+	if _, ok := qac.mutation.AttemptStatus(); !ok {
+		return &ValidationError{Name: "attempt_status", err: errors.New(`ent: missing required field "QuizAttempt.attempt_status"`)}
+	}
+	if _, ok := qac.mutation.MaxScore(); !ok {
+		return &ValidationError{Name: "max_score", err: errors.New(`ent: missing required field "QuizAttempt.max_score"`)}
+	}
+	return nil
+}
+
+func (qac *QuizAttemptCreate) sqlSave(ctx context.Context) (*QuizAttempt, error) {
+	if err := qac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected QuizAttempt.ID type: %T", _spec.ID.Value)
+		}
+	}
+	qac.mutation.id = &_node.ID
+	qac.mutation.done = true
+	return _node, nil
+}
+
+func (qac *QuizAttemptCreate) createSpec() (*QuizAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuizAttempt{config: qac.config}
+		_spec = sqlgraph.NewCreateSpec(quizattempt.Table, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeString))
+	)
+	if id, ok := qac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qac.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := qac.mutation.CreatedAt(); ok {
+		_spec.SetField(quizattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qac.mutation.UpdatedAt(); ok {
+		_spec.SetField(quizattempt.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qac.mutation.CreatedBy(); ok {
+		_spec.SetField(quizattempt.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := qac.mutation.UpdatedBy(); ok {
+		_spec.SetField(quizattempt.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := qac.mutation.QuizID(); ok {
+		_spec.SetField(quizattempt.FieldQuizID, field.TypeString, value)
+		_node.QuizID = value
+	}
+	if value, ok := qac.mutation.InternshipID(); ok {
+		_spec.SetField(quizattempt.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := qac.mutation.EnrollmentID(); ok {
+		_spec.SetField(quizattempt.FieldEnrollmentID, field.TypeString, value)
+		_node.EnrollmentID = value
+	}
+	if value, ok := qac.mutation.UserID(); ok {
+		_spec.SetField(quizattempt.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := qac.mutation.Attempt(); ok {
+		_spec.SetField(quizattempt.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := qac.mutation.QuestionIds(); ok {
+		_spec.SetField(quizattempt.FieldQuestionIds, field.TypeJSON, value)
+		_node.QuestionIds = value
+	}
+	if value, ok := qac.mutation.StartedAt(); ok {
+		_spec.SetField(quizattempt.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := qac.mutation.ExpiresAt(); ok {
+		_spec.SetField(quizattempt.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := qac.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := qac.mutation.AttemptStatus(); ok {
+		_spec.SetField(quizattempt.FieldAttemptStatus, field.TypeString, value)
+		_node.AttemptStatus = value
+	}
+	if value, ok := qac.mutation.Answers(); ok {
+		_spec.SetField(quizattempt.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := qac.mutation.Results(); ok {
+		_spec.SetField(quizattempt.FieldResults, field.TypeJSON, value)
+		_node.Results = value
+	}
+	if value, ok := qac.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeFloat64, value)
+		_node.Score = &value
+	}
+	if value, ok := qac.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+		_node.MaxScore = value
+	}
+	if value, ok := qac.mutation.ScorePercent(); ok {
+		_spec.SetField(quizattempt.FieldScorePercent, field.TypeFloat64, value)
+		_node.ScorePercent = &value
+	}
+	return _node, _spec
+}
+
+// QuizAttemptCreateBulk is the builder for creating many QuizAttempt entities in bulk.
+type QuizAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*QuizAttemptCreate
+}
+
+// Save creates the QuizAttempt entities in the database.
+func (qacb *QuizAttemptCreateBulk) Save(ctx context.Context) ([]*QuizAttempt, error) {
+	if qacb.err != nil {
+		return nil, qacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qacb.builders))
+	nodes := make([]*QuizAttempt, len(qacb.builders))
+	mutators := make([]Mutator, len(qacb.builders))
+	for i := range qacb.builders {
+		func(i int, root context.Context) {
+			builder := qacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qacb *QuizAttemptCreateBulk) SaveX(ctx context.Context) []*QuizAttempt {
+	v, err := qacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qacb *QuizAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := qacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qacb *QuizAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := qacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
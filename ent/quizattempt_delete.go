@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+)
+
+// QuizAttemptDelete is the builder for deleting a QuizAttempt entity.
+type QuizAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuizAttemptMutation
+}
+
+// Where appends a list predicates to the QuizAttemptDelete builder.
+func (qad *QuizAttemptDelete) Where(ps ...predicate.QuizAttempt) *QuizAttemptDelete {
+	qad.mutation.Where(ps...)
+	return qad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qad *QuizAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qad.sqlExec, qad.mutation, qad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qad *QuizAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := qad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qad *QuizAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(quizattempt.Table, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeString))
+	if ps := qad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qad.mutation.done = true
+	return affected, err
+}
+
+// QuizAttemptDeleteOne is the builder for deleting a single QuizAttempt entity.
+type QuizAttemptDeleteOne struct {
+	qad *QuizAttemptDelete
+}
+
+// Where appends a list predicates to the QuizAttemptDelete builder.
+func (qado *QuizAttemptDeleteOne) Where(ps ...predicate.QuizAttempt) *QuizAttemptDeleteOne {
+	qado.qad.mutation.Where(ps...)
+	return qado
+}
+
+// Exec executes the deletion query.
+func (qado *QuizAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := qado.qad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{quizattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qado *QuizAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := qado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+)
+
+// QuizAttemptQuery is the builder for querying QuizAttempt entities.
+type QuizAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []quizattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QuizAttempt
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuizAttemptQuery builder.
+func (qaq *QuizAttemptQuery) Where(ps ...predicate.QuizAttempt) *QuizAttemptQuery {
+	qaq.predicates = append(qaq.predicates, ps...)
+	return qaq
+}
+
+// Limit the number of records to be returned by this query.
+func (qaq *QuizAttemptQuery) Limit(limit int) *QuizAttemptQuery {
+	qaq.ctx.Limit = &limit
+	return qaq
+}
+
+// Offset to start from.
+func (qaq *QuizAttemptQuery) Offset(offset int) *QuizAttemptQuery {
+	qaq.ctx.Offset = &offset
+	return qaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qaq *QuizAttemptQuery) Unique(unique bool) *QuizAttemptQuery {
+	qaq.ctx.Unique = &unique
+	return qaq
+}
+
+// Order specifies how the records should be ordered.
+func (qaq *QuizAttemptQuery) Order(o ...quizattempt.OrderOption) *QuizAttemptQuery {
+	qaq.order = append(qaq.order, o...)
+	return qaq
+}
+
+// First returns the first QuizAttempt entity from the query.
+// Returns a *NotFoundError when no QuizAttempt was found.
+func (qaq *QuizAttemptQuery) First(ctx context.Context) (*QuizAttempt, error) {
+	nodes, err := qaq.Limit(1).All(setContextOp(ctx, qaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{quizattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) FirstX(ctx context.Context) *QuizAttempt {
+	node, err := qaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuizAttempt ID from the query.
+// Returns a *NotFoundError when no QuizAttempt ID was found.
+func (qaq *QuizAttemptQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = qaq.Limit(1).IDs(setContextOp(ctx, qaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{quizattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) FirstIDX(ctx context.Context) string {
+	id, err := qaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuizAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuizAttempt entity is found.
+// Returns a *NotFoundError when no QuizAttempt entities are found.
+func (qaq *QuizAttemptQuery) Only(ctx context.Context) (*QuizAttempt, error) {
+	nodes, err := qaq.Limit(2).All(setContextOp(ctx, qaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{quizattempt.Label}
+	default:
+		return nil, &NotSingularError{quizattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) OnlyX(ctx context.Context) *QuizAttempt {
+	node, err := qaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuizAttempt ID in the query.
+// Returns a *NotSingularError when more than one QuizAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qaq *QuizAttemptQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = qaq.Limit(2).IDs(setContextOp(ctx, qaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{quizattempt.Label}
+	default:
+		err = &NotSingularError{quizattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) OnlyIDX(ctx context.Context) string {
+	id, err := qaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuizAttempts.
+func (qaq *QuizAttemptQuery) All(ctx context.Context) ([]*QuizAttempt, error) {
+	ctx = setContextOp(ctx, qaq.ctx, ent.OpQueryAll)
+	if err := qaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuizAttempt, *QuizAttemptQuery]()
+	return withInterceptors[[]*QuizAttempt](ctx, qaq, qr, qaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) AllX(ctx context.Context) []*QuizAttempt {
+	nodes, err := qaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuizAttempt IDs.
+func (qaq *QuizAttemptQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if qaq.ctx.Unique == nil && qaq.path != nil {
+		qaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qaq.ctx, ent.OpQueryIDs)
+	if err = qaq.Select(quizattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) IDsX(ctx context.Context) []string {
+	ids, err := qaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qaq *QuizAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qaq.ctx, ent.OpQueryCount)
+	if err := qaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qaq, querierCount[*QuizAttemptQuery](), qaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) CountX(ctx context.Context) int {
+	count, err := qaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qaq *QuizAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qaq.ctx, ent.OpQueryExist)
+	switch _, err := qaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qaq *QuizAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := qaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuizAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qaq *QuizAttemptQuery) Clone() *QuizAttemptQuery {
+	if qaq == nil {
+		return nil
+	}
+	return &QuizAttemptQuery{
+		config:     qaq.config,
+		ctx:        qaq.ctx.Clone(),
+		order:      append([]quizattempt.OrderOption{}, qaq.order...),
+		inters:     append([]Interceptor{}, qaq.inters...),
+		predicates: append([]predicate.QuizAttempt{}, qaq.predicates...),
+		// clone intermediate query.
+		sql:  qaq.sql.Clone(),
+		path: qaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuizAttempt.Query().
+//		GroupBy(quizattempt.FieldStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qaq *QuizAttemptQuery) GroupBy(field string, fields ...string) *QuizAttemptGroupBy {
+	qaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuizAttemptGroupBy{build: qaq}
+	grbuild.flds = &qaq.ctx.Fields
+	grbuild.label = quizattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Status string `json:"status,omitempty"`
+//	}
+//
+//	client.QuizAttempt.Query().
+//		Select(quizattempt.FieldStatus).
+//		Scan(ctx, &v)
+func (qaq *QuizAttemptQuery) Select(fields ...string) *QuizAttemptSelect {
+	qaq.ctx.Fields = append(qaq.ctx.Fields, fields...)
+	sbuild := &QuizAttemptSelect{QuizAttemptQuery: qaq}
+	sbuild.label = quizattempt.Label
+	sbuild.flds, sbuild.scan = &qaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuizAttemptSelect configured with the given aggregations.
+func (qaq *QuizAttemptQuery) Aggregate(fns ...AggregateFunc) *QuizAttemptSelect {
+	return qaq.Select().Aggregate(fns...)
+}
+
+func (qaq *QuizAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qaq.ctx.Fields {
+		if !quizattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qaq.path != nil {
+		prev, err := qaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qaq.sql = prev
+	}
+	return nil
+}
+
+func (qaq *QuizAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuizAttempt, error) {
+	var (
+		nodes = []*QuizAttempt{}
+		_spec = qaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuizAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuizAttempt{config: qaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qaq *QuizAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qaq.querySpec()
+	_spec.Node.Columns = qaq.ctx.Fields
+	if len(qaq.ctx.Fields) > 0 {
+		_spec.Unique = qaq.ctx.Unique != nil && *qaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qaq.driver, _spec)
+}
+
+func (qaq *QuizAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeString))
+	_spec.From = qaq.sql
+	if unique := qaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizattempt.FieldID)
+		for i := range fields {
+			if fields[i] != quizattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qaq *QuizAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qaq.driver.Dialect())
+	t1 := builder.Table(quizattempt.Table)
+	columns := qaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = quizattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qaq.sql != nil {
+		selector = qaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qaq.ctx.Unique != nil && *qaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range qaq.predicates {
+		p(selector)
+	}
+	for _, p := range qaq.order {
+		p(selector)
+	}
+	if offset := qaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QuizAttemptGroupBy is the group-by builder for QuizAttempt entities.
+type QuizAttemptGroupBy struct {
+	selector
+	build *QuizAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qagb *QuizAttemptGroupBy) Aggregate(fns ...AggregateFunc) *QuizAttemptGroupBy {
+	qagb.fns = append(qagb.fns, fns...)
+	return qagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qagb *QuizAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qagb.build.ctx, ent.OpQueryGroupBy)
+	if err := qagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuizAttemptQuery, *QuizAttemptGroupBy](ctx, qagb.build, qagb, qagb.build.inters, v)
+}
+
+func (qagb *QuizAttemptGroupBy) sqlScan(ctx context.Context, root *QuizAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qagb.fns))
+	for _, fn := range qagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qagb.flds)+len(qagb.fns))
+		for _, f := range *qagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuizAttemptSelect is the builder for selecting fields of QuizAttempt entities.
+type QuizAttemptSelect struct {
+	*QuizAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qas *QuizAttemptSelect) Aggregate(fns ...AggregateFunc) *QuizAttemptSelect {
+	qas.fns = append(qas.fns, fns...)
+	return qas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qas *QuizAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qas.ctx, ent.OpQuerySelect)
+	if err := qas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuizAttemptQuery, *QuizAttemptSelect](ctx, qas.QuizAttemptQuery, qas, qas.inters, v)
+}
+
+func (qas *QuizAttemptSelect) sqlScan(ctx context.Context, root *QuizAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qas.fns))
+	for _, fn := range qas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
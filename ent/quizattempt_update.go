@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// QuizAttemptUpdate is the builder for updating QuizAttempt entities.
+type QuizAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuizAttemptMutation
+}
+
+// Where appends a list predicates to the QuizAttemptUpdate builder.
+func (qau *QuizAttemptUpdate) Where(ps ...predicate.QuizAttempt) *QuizAttemptUpdate {
+	qau.mutation.Where(ps...)
+	return qau
+}
+
+// SetStatus sets the "status" field.
+func (qau *QuizAttemptUpdate) SetStatus(s string) *QuizAttemptUpdate {
+	qau.mutation.SetStatus(s)
+	return qau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableStatus(s *string) *QuizAttemptUpdate {
+	if s != nil {
+		qau.SetStatus(*s)
+	}
+	return qau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qau *QuizAttemptUpdate) SetUpdatedAt(t time.Time) *QuizAttemptUpdate {
+	qau.mutation.SetUpdatedAt(t)
+	return qau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qau *QuizAttemptUpdate) SetUpdatedBy(s string) *QuizAttemptUpdate {
+	qau.mutation.SetUpdatedBy(s)
+	return qau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableUpdatedBy(s *string) *QuizAttemptUpdate {
+	if s != nil {
+		qau.SetUpdatedBy(*s)
+	}
+	return qau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (qau *QuizAttemptUpdate) ClearUpdatedBy() *QuizAttemptUpdate {
+	qau.mutation.ClearUpdatedBy()
+	return qau
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (qau *QuizAttemptUpdate) SetSubmittedAt(t time.Time) *QuizAttemptUpdate {
+	qau.mutation.SetSubmittedAt(t)
+	return qau
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableSubmittedAt(t *time.Time) *QuizAttemptUpdate {
+	if t != nil {
+		qau.SetSubmittedAt(*t)
+	}
+	return qau
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (qau *QuizAttemptUpdate) ClearSubmittedAt() *QuizAttemptUpdate {
+	qau.mutation.ClearSubmittedAt()
+	return qau
+}
+
+// SetAttemptStatus sets the "attempt_status" field.
+func (qau *QuizAttemptUpdate) SetAttemptStatus(s string) *QuizAttemptUpdate {
+	qau.mutation.SetAttemptStatus(s)
+	return qau
+}
+
+// SetNillableAttemptStatus sets the "attempt_status" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableAttemptStatus(s *string) *QuizAttemptUpdate {
+	if s != nil {
+		qau.SetAttemptStatus(*s)
+	}
+	return qau
+}
+
+// SetAnswers sets the "answers" field.
+func (qau *QuizAttemptUpdate) SetAnswers(ta []types.QuizAnswer) *QuizAttemptUpdate {
+	qau.mutation.SetAnswers(ta)
+	return qau
+}
+
+// AppendAnswers appends ta to the "answers" field.
+func (qau *QuizAttemptUpdate) AppendAnswers(ta []types.QuizAnswer) *QuizAttemptUpdate {
+	qau.mutation.AppendAnswers(ta)
+	return qau
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (qau *QuizAttemptUpdate) ClearAnswers() *QuizAttemptUpdate {
+	qau.mutation.ClearAnswers()
+	return qau
+}
+
+// SetResults sets the "results" field.
+func (qau *QuizAttemptUpdate) SetResults(tqr []types.QuizQuestionResult) *QuizAttemptUpdate {
+	qau.mutation.SetResults(tqr)
+	return qau
+}
+
+// AppendResults appends tqr to the "results" field.
+func (qau *QuizAttemptUpdate) AppendResults(tqr []types.QuizQuestionResult) *QuizAttemptUpdate {
+	qau.mutation.AppendResults(tqr)
+	return qau
+}
+
+// ClearResults clears the value of the "results" field.
+func (qau *QuizAttemptUpdate) ClearResults() *QuizAttemptUpdate {
+	qau.mutation.ClearResults()
+	return qau
+}
+
+// SetScore sets the "score" field.
+func (qau *QuizAttemptUpdate) SetScore(f float64) *QuizAttemptUpdate {
+	qau.mutation.ResetScore()
+	qau.mutation.SetScore(f)
+	return qau
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableScore(f *float64) *QuizAttemptUpdate {
+	if f != nil {
+		qau.SetScore(*f)
+	}
+	return qau
+}
+
+// AddScore adds f to the "score" field.
+func (qau *QuizAttemptUpdate) AddScore(f float64) *QuizAttemptUpdate {
+	qau.mutation.AddScore(f)
+	return qau
+}
+
+// ClearScore clears the value of the "score" field.
+func (qau *QuizAttemptUpdate) ClearScore() *QuizAttemptUpdate {
+	qau.mutation.ClearScore()
+	return qau
+}
+
+// SetMaxScore sets the "max_score" field.
+func (qau *QuizAttemptUpdate) SetMaxScore(f float64) *QuizAttemptUpdate {
+	qau.mutation.ResetMaxScore()
+	qau.mutation.SetMaxScore(f)
+	return qau
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableMaxScore(f *float64) *QuizAttemptUpdate {
+	if f != nil {
+		qau.SetMaxScore(*f)
+	}
+	return qau
+}
+
+// AddMaxScore adds f to the "max_score" field.
+func (qau *QuizAttemptUpdate) AddMaxScore(f float64) *QuizAttemptUpdate {
+	qau.mutation.AddMaxScore(f)
+	return qau
+}
+
+// SetScorePercent sets the "score_percent" field.
+func (qau *QuizAttemptUpdate) SetScorePercent(f float64) *QuizAttemptUpdate {
+	qau.mutation.ResetScorePercent()
+	qau.mutation.SetScorePercent(f)
+	return qau
+}
+
+// SetNillableScorePercent sets the "score_percent" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableScorePercent(f *float64) *QuizAttemptUpdate {
+	if f != nil {
+		qau.SetScorePercent(*f)
+	}
+	return qau
+}
+
+// AddScorePercent adds f to the "score_percent" field.
+func (qau *QuizAttemptUpdate) AddScorePercent(f float64) *QuizAttemptUpdate {
+	qau.mutation.AddScorePercent(f)
+	return qau
+}
+
+// ClearScorePercent clears the value of the "score_percent" field.
+func (qau *QuizAttemptUpdate) ClearScorePercent() *QuizAttemptUpdate {
+	qau.mutation.ClearScorePercent()
+	return qau
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (qau *QuizAttemptUpdate) Mutation() *QuizAttemptMutation {
+	return qau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qau *QuizAttemptUpdate) Save(ctx context.Context) (int, error) {
+	qau.defaults()
+	return withHooks(ctx, qau.sqlSave, qau.mutation, qau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qau *QuizAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := qau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qau *QuizAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := qau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qau *QuizAttemptUpdate) ExecX(ctx context.Context) {
+	if err := qau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qau *QuizAttemptUpdate) defaults() {
+	if _, ok := qau.mutation.UpdatedAt(); !ok {
+		v := quizattempt.UpdateDefaultUpdatedAt()
+		qau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (qau *QuizAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeString))
+	if ps := qau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qau.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qau.mutation.UpdatedAt(); ok {
+		_spec.SetField(quizattempt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qau.mutation.CreatedByCleared() {
+		_spec.ClearField(quizattempt.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := qau.mutation.UpdatedBy(); ok {
+		_spec.SetField(quizattempt.FieldUpdatedBy, field.TypeString, value)
+	}
+	if qau.mutation.UpdatedByCleared() {
+		_spec.ClearField(quizattempt.FieldUpdatedBy, field.TypeString)
+	}
+	if qau.mutation.ExpiresAtCleared() {
+		_spec.ClearField(quizattempt.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := qau.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if qau.mutation.SubmittedAtCleared() {
+		_spec.ClearField(quizattempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := qau.mutation.AttemptStatus(); ok {
+		_spec.SetField(quizattempt.FieldAttemptStatus, field.TypeString, value)
+	}
+	if value, ok := qau.mutation.Answers(); ok {
+		_spec.SetField(quizattempt.FieldAnswers, field.TypeJSON, value)
+	}
+	if value, ok := qau.mutation.AppendedAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizattempt.FieldAnswers, value)
+		})
+	}
+	if qau.mutation.AnswersCleared() {
+		_spec.ClearField(quizattempt.FieldAnswers, field.TypeJSON)
+	}
+	if value, ok := qau.mutation.Results(); ok {
+		_spec.SetField(quizattempt.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := qau.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizattempt.FieldResults, value)
+		})
+	}
+	if qau.mutation.ResultsCleared() {
+		_spec.ClearField(quizattempt.FieldResults, field.TypeJSON)
+	}
+	if value, ok := qau.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := qau.mutation.AddedScore(); ok {
+		_spec.AddField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if qau.mutation.ScoreCleared() {
+		_spec.ClearField(quizattempt.FieldScore, field.TypeFloat64)
+	}
+	if value, ok := qau.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := qau.mutation.AddedMaxScore(); ok {
+		_spec.AddField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := qau.mutation.ScorePercent(); ok {
+		_spec.SetField(quizattempt.FieldScorePercent, field.TypeFloat64, value)
+	}
+	if value, ok := qau.mutation.AddedScorePercent(); ok {
+		_spec.AddField(quizattempt.FieldScorePercent, field.TypeFloat64, value)
+	}
+	if qau.mutation.ScorePercentCleared() {
+		_spec.ClearField(quizattempt.FieldScorePercent, field.TypeFloat64)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qau.mutation.done = true
+	return n, nil
+}
+
+// QuizAttemptUpdateOne is the builder for updating a single QuizAttempt entity.
+type QuizAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuizAttemptMutation
+}
+
+// SetStatus sets the "status" field.
+func (qauo *QuizAttemptUpdateOne) SetStatus(s string) *QuizAttemptUpdateOne {
+	qauo.mutation.SetStatus(s)
+	return qauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableStatus(s *string) *QuizAttemptUpdateOne {
+	if s != nil {
+		qauo.SetStatus(*s)
+	}
+	return qauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qauo *QuizAttemptUpdateOne) SetUpdatedAt(t time.Time) *QuizAttemptUpdateOne {
+	qauo.mutation.SetUpdatedAt(t)
+	return qauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qauo *QuizAttemptUpdateOne) SetUpdatedBy(s string) *QuizAttemptUpdateOne {
+	qauo.mutation.SetUpdatedBy(s)
+	return qauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableUpdatedBy(s *string) *QuizAttemptUpdateOne {
+	if s != nil {
+		qauo.SetUpdatedBy(*s)
+	}
+	return qauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (qauo *QuizAttemptUpdateOne) ClearUpdatedBy() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearUpdatedBy()
+	return qauo
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (qauo *QuizAttemptUpdateOne) SetSubmittedAt(t time.Time) *QuizAttemptUpdateOne {
+	qauo.mutation.SetSubmittedAt(t)
+	return qauo
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableSubmittedAt(t *time.Time) *QuizAttemptUpdateOne {
+	if t != nil {
+		qauo.SetSubmittedAt(*t)
+	}
+	return qauo
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (qauo *QuizAttemptUpdateOne) ClearSubmittedAt() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearSubmittedAt()
+	return qauo
+}
+
+// SetAttemptStatus sets the "attempt_status" field.
+func (qauo *QuizAttemptUpdateOne) SetAttemptStatus(s string) *QuizAttemptUpdateOne {
+	qauo.mutation.SetAttemptStatus(s)
+	return qauo
+}
+
+// SetNillableAttemptStatus sets the "attempt_status" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableAttemptStatus(s *string) *QuizAttemptUpdateOne {
+	if s != nil {
+		qauo.SetAttemptStatus(*s)
+	}
+	return qauo
+}
+
+// SetAnswers sets the "answers" field.
+func (qauo *QuizAttemptUpdateOne) SetAnswers(ta []types.QuizAnswer) *QuizAttemptUpdateOne {
+	qauo.mutation.SetAnswers(ta)
+	return qauo
+}
+
+// AppendAnswers appends ta to the "answers" field.
+func (qauo *QuizAttemptUpdateOne) AppendAnswers(ta []types.QuizAnswer) *QuizAttemptUpdateOne {
+	qauo.mutation.AppendAnswers(ta)
+	return qauo
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (qauo *QuizAttemptUpdateOne) ClearAnswers() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearAnswers()
+	return qauo
+}
+
+// SetResults sets the "results" field.
+func (qauo *QuizAttemptUpdateOne) SetResults(tqr []types.QuizQuestionResult) *QuizAttemptUpdateOne {
+	qauo.mutation.SetResults(tqr)
+	return qauo
+}
+
+// AppendResults appends tqr to the "results" field.
+func (qauo *QuizAttemptUpdateOne) AppendResults(tqr []types.QuizQuestionResult) *QuizAttemptUpdateOne {
+	qauo.mutation.AppendResults(tqr)
+	return qauo
+}
+
+// ClearResults clears the value of the "results" field.
+func (qauo *QuizAttemptUpdateOne) ClearResults() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearResults()
+	return qauo
+}
+
+// SetScore sets the "score" field.
+func (qauo *QuizAttemptUpdateOne) SetScore(f float64) *QuizAttemptUpdateOne {
+	qauo.mutation.ResetScore()
+	qauo.mutation.SetScore(f)
+	return qauo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableScore(f *float64) *QuizAttemptUpdateOne {
+	if f != nil {
+		qauo.SetScore(*f)
+	}
+	return qauo
+}
+
+// AddScore adds f to the "score" field.
+func (qauo *QuizAttemptUpdateOne) AddScore(f float64) *QuizAttemptUpdateOne {
+	qauo.mutation.AddScore(f)
+	return qauo
+}
+
+// ClearScore clears the value of the "score" field.
+func (qauo *QuizAttemptUpdateOne) ClearScore() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearScore()
+	return qauo
+}
+
+// SetMaxScore sets the "max_score" field.
+func (qauo *QuizAttemptUpdateOne) SetMaxScore(f float64) *QuizAttemptUpdateOne {
+	qauo.mutation.ResetMaxScore()
+	qauo.mutation.SetMaxScore(f)
+	return qauo
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableMaxScore(f *float64) *QuizAttemptUpdateOne {
+	if f != nil {
+		qauo.SetMaxScore(*f)
+	}
+	return qauo
+}
+
+// AddMaxScore adds f to the "max_score" field.
+func (qauo *QuizAttemptUpdateOne) AddMaxScore(f float64) *QuizAttemptUpdateOne {
+	qauo.mutation.AddMaxScore(f)
+	return qauo
+}
+
+// SetScorePercent sets the "score_percent" field.
+func (qauo *QuizAttemptUpdateOne) SetScorePercent(f float64) *QuizAttemptUpdateOne {
+	qauo.mutation.ResetScorePercent()
+	qauo.mutation.SetScorePercent(f)
+	return qauo
+}
+
+// SetNillableScorePercent sets the "score_percent" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableScorePercent(f *float64) *QuizAttemptUpdateOne {
+	if f != nil {
+		qauo.SetScorePercent(*f)
+	}
+	return qauo
+}
+
+// AddScorePercent adds f to the "score_percent" field.
+func (qauo *QuizAttemptUpdateOne) AddScorePercent(f float64) *QuizAttemptUpdateOne {
+	qauo.mutation.AddScorePercent(f)
+	return qauo
+}
+
+// ClearScorePercent clears the value of the "score_percent" field.
+func (qauo *QuizAttemptUpdateOne) ClearScorePercent() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearScorePercent()
+	return qauo
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (qauo *QuizAttemptUpdateOne) Mutation() *QuizAttemptMutation {
+	return qauo.mutation
+}
+
+// Where appends a list predicates to the QuizAttemptUpdate builder.
+func (qauo *QuizAttemptUpdateOne) Where(ps ...predicate.QuizAttempt) *QuizAttemptUpdateOne {
+	qauo.mutation.Where(ps...)
+	return qauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qauo *QuizAttemptUpdateOne) Select(field string, fields ...string) *QuizAttemptUpdateOne {
+	qauo.fields = append([]string{field}, fields...)
+	return qauo
+}
+
+// Save executes the query and returns the updated QuizAttempt entity.
+func (qauo *QuizAttemptUpdateOne) Save(ctx context.Context) (*QuizAttempt, error) {
+	qauo.defaults()
+	return withHooks(ctx, qauo.sqlSave, qauo.mutation, qauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qauo *QuizAttemptUpdateOne) SaveX(ctx context.Context) *QuizAttempt {
+	node, err := qauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qauo *QuizAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := qauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qauo *QuizAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := qauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qauo *QuizAttemptUpdateOne) defaults() {
+	if _, ok := qauo.mutation.UpdatedAt(); !ok {
+		v := quizattempt.UpdateDefaultUpdatedAt()
+		qauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (qauo *QuizAttemptUpdateOne) sqlSave(ctx context.Context) (_node *QuizAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeString))
+	id, ok := qauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuizAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizattempt.FieldID)
+		for _, f := range fields {
+			if !quizattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quizattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qauo.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quizattempt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qauo.mutation.CreatedByCleared() {
+		_spec.ClearField(quizattempt.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := qauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(quizattempt.FieldUpdatedBy, field.TypeString, value)
+	}
+	if qauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(quizattempt.FieldUpdatedBy, field.TypeString)
+	}
+	if qauo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(quizattempt.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := qauo.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if qauo.mutation.SubmittedAtCleared() {
+		_spec.ClearField(quizattempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := qauo.mutation.AttemptStatus(); ok {
+		_spec.SetField(quizattempt.FieldAttemptStatus, field.TypeString, value)
+	}
+	if value, ok := qauo.mutation.Answers(); ok {
+		_spec.SetField(quizattempt.FieldAnswers, field.TypeJSON, value)
+	}
+	if value, ok := qauo.mutation.AppendedAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizattempt.FieldAnswers, value)
+		})
+	}
+	if qauo.mutation.AnswersCleared() {
+		_spec.ClearField(quizattempt.FieldAnswers, field.TypeJSON)
+	}
+	if value, ok := qauo.mutation.Results(); ok {
+		_spec.SetField(quizattempt.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := qauo.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizattempt.FieldResults, value)
+		})
+	}
+	if qauo.mutation.ResultsCleared() {
+		_spec.ClearField(quizattempt.FieldResults, field.TypeJSON)
+	}
+	if value, ok := qauo.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := qauo.mutation.AddedScore(); ok {
+		_spec.AddField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if qauo.mutation.ScoreCleared() {
+		_spec.ClearField(quizattempt.FieldScore, field.TypeFloat64)
+	}
+	if value, ok := qauo.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := qauo.mutation.AddedMaxScore(); ok {
+		_spec.AddField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := qauo.mutation.ScorePercent(); ok {
+		_spec.SetField(quizattempt.FieldScorePercent, field.TypeFloat64, value)
+	}
+	if value, ok := qauo.mutation.AddedScorePercent(); ok {
+		_spec.AddField(quizattempt.FieldScorePercent, field.TypeFloat64, value)
+	}
+	if qauo.mutation.ScorePercentCleared() {
+		_spec.ClearField(quizattempt.FieldScorePercent, field.TypeFloat64)
+	}
+	_node = &QuizAttempt{config: qauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qauo.mutation.done = true
+	return _node, nil
+}
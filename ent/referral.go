@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/referral"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Referral is the model entity for the Referral schema.
+type Referral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// ReferrerID holds the value of the "referrer_id" field.
+	ReferrerID string `json:"referrer_id,omitempty"`
+	// RefereeID holds the value of the "referee_id" field.
+	RefereeID string `json:"referee_id,omitempty"`
+	// ReferralCode holds the value of the "referral_code" field.
+	ReferralCode string `json:"referral_code,omitempty"`
+	// ReferralStatus holds the value of the "referral_status" field.
+	ReferralStatus types.ReferralStatus `json:"referral_status,omitempty"`
+	// RejectionReason holds the value of the "rejection_reason" field.
+	RejectionReason *string `json:"rejection_reason,omitempty"`
+	// RefereeEmail holds the value of the "referee_email" field.
+	RefereeEmail string `json:"referee_email,omitempty"`
+	// RefereePhone holds the value of the "referee_phone" field.
+	RefereePhone string `json:"referee_phone,omitempty"`
+	// RefereeDiscountID holds the value of the "referee_discount_id" field.
+	RefereeDiscountID *string `json:"referee_discount_id,omitempty"`
+	// PaymentID holds the value of the "payment_id" field.
+	PaymentID *string `json:"payment_id,omitempty"`
+	// QualifiedAt holds the value of the "qualified_at" field.
+	QualifiedAt *time.Time `json:"qualified_at,omitempty"`
+	// RewardEligibleAt holds the value of the "reward_eligible_at" field.
+	RewardEligibleAt *time.Time `json:"reward_eligible_at,omitempty"`
+	// RewardedAt holds the value of the "rewarded_at" field.
+	RewardedAt *time.Time `json:"rewarded_at,omitempty"`
+	// RewardDiscountID holds the value of the "reward_discount_id" field.
+	RewardDiscountID *string `json:"reward_discount_id,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Referral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldMetadata:
+			values[i] = new([]byte)
+		case referral.FieldID, referral.FieldStatus, referral.FieldCreatedBy, referral.FieldUpdatedBy, referral.FieldReferrerID, referral.FieldRefereeID, referral.FieldReferralCode, referral.FieldReferralStatus, referral.FieldRejectionReason, referral.FieldRefereeEmail, referral.FieldRefereePhone, referral.FieldRefereeDiscountID, referral.FieldPaymentID, referral.FieldRewardDiscountID:
+			values[i] = new(sql.NullString)
+		case referral.FieldCreatedAt, referral.FieldUpdatedAt, referral.FieldQualifiedAt, referral.FieldRewardEligibleAt, referral.FieldRewardedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Referral fields.
+func (r *Referral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				r.ID = value.String
+			}
+		case referral.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = value.String
+			}
+		case referral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case referral.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		case referral.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				r.CreatedBy = value.String
+			}
+		case referral.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				r.UpdatedBy = value.String
+			}
+		case referral.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case referral.FieldReferrerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_id", values[i])
+			} else if value.Valid {
+				r.ReferrerID = value.String
+			}
+		case referral.FieldRefereeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_id", values[i])
+			} else if value.Valid {
+				r.RefereeID = value.String
+			}
+		case referral.FieldReferralCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_code", values[i])
+			} else if value.Valid {
+				r.ReferralCode = value.String
+			}
+		case referral.FieldReferralStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_status", values[i])
+			} else if value.Valid {
+				r.ReferralStatus = types.ReferralStatus(value.String)
+			}
+		case referral.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				r.RejectionReason = new(string)
+				*r.RejectionReason = value.String
+			}
+		case referral.FieldRefereeEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_email", values[i])
+			} else if value.Valid {
+				r.RefereeEmail = value.String
+			}
+		case referral.FieldRefereePhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_phone", values[i])
+			} else if value.Valid {
+				r.RefereePhone = value.String
+			}
+		case referral.FieldRefereeDiscountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_discount_id", values[i])
+			} else if value.Valid {
+				r.RefereeDiscountID = new(string)
+				*r.RefereeDiscountID = value.String
+			}
+		case referral.FieldPaymentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_id", values[i])
+			} else if value.Valid {
+				r.PaymentID = new(string)
+				*r.PaymentID = value.String
+			}
+		case referral.FieldQualifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field qualified_at", values[i])
+			} else if value.Valid {
+				r.QualifiedAt = new(time.Time)
+				*r.QualifiedAt = value.Time
+			}
+		case referral.FieldRewardEligibleAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reward_eligible_at", values[i])
+			} else if value.Valid {
+				r.RewardEligibleAt = new(time.Time)
+				*r.RewardEligibleAt = value.Time
+			}
+		case referral.FieldRewardedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rewarded_at", values[i])
+			} else if value.Valid {
+				r.RewardedAt = new(time.Time)
+				*r.RewardedAt = value.Time
+			}
+		case referral.FieldRewardDiscountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reward_discount_id", values[i])
+			} else if value.Valid {
+				r.RewardDiscountID = new(string)
+				*r.RewardDiscountID = value.String
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Referral.
+// This includes values selected through modifiers, order, etc.
+func (r *Referral) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Referral.
+// Note that you need to call Referral.Unwrap() before calling this method if this Referral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Referral) Update() *ReferralUpdateOne {
+	return NewReferralClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Referral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Referral) Unwrap() *Referral {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Referral is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Referral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Referral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("status=")
+	builder.WriteString(r.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(r.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(r.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", r.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("referrer_id=")
+	builder.WriteString(r.ReferrerID)
+	builder.WriteString(", ")
+	builder.WriteString("referee_id=")
+	builder.WriteString(r.RefereeID)
+	builder.WriteString(", ")
+	builder.WriteString("referral_code=")
+	builder.WriteString(r.ReferralCode)
+	builder.WriteString(", ")
+	builder.WriteString("referral_status=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReferralStatus))
+	builder.WriteString(", ")
+	if v := r.RejectionReason; v != nil {
+		builder.WriteString("rejection_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("referee_email=")
+	builder.WriteString(r.RefereeEmail)
+	builder.WriteString(", ")
+	builder.WriteString("referee_phone=")
+	builder.WriteString(r.RefereePhone)
+	builder.WriteString(", ")
+	if v := r.RefereeDiscountID; v != nil {
+		builder.WriteString("referee_discount_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := r.PaymentID; v != nil {
+		builder.WriteString("payment_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := r.QualifiedAt; v != nil {
+		builder.WriteString("qualified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.RewardEligibleAt; v != nil {
+		builder.WriteString("reward_eligible_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.RewardedAt; v != nil {
+		builder.WriteString("rewarded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := r.RewardDiscountID; v != nil {
+		builder.WriteString("reward_discount_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Referrals is a parsable slice of Referral.
+type Referrals []*Referral
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the referral type in the database.
+	Label = "referral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldReferrerID holds the string denoting the referrer_id field in the database.
+	FieldReferrerID = "referrer_id"
+	// FieldRefereeID holds the string denoting the referee_id field in the database.
+	FieldRefereeID = "referee_id"
+	// FieldReferralCode holds the string denoting the referral_code field in the database.
+	FieldReferralCode = "referral_code"
+	// FieldReferralStatus holds the string denoting the referral_status field in the database.
+	FieldReferralStatus = "referral_status"
+	// FieldRejectionReason holds the string denoting the rejection_reason field in the database.
+	FieldRejectionReason = "rejection_reason"
+	// FieldRefereeEmail holds the string denoting the referee_email field in the database.
+	FieldRefereeEmail = "referee_email"
+	// FieldRefereePhone holds the string denoting the referee_phone field in the database.
+	FieldRefereePhone = "referee_phone"
+	// FieldRefereeDiscountID holds the string denoting the referee_discount_id field in the database.
+	FieldRefereeDiscountID = "referee_discount_id"
+	// FieldPaymentID holds the string denoting the payment_id field in the database.
+	FieldPaymentID = "payment_id"
+	// FieldQualifiedAt holds the string denoting the qualified_at field in the database.
+	FieldQualifiedAt = "qualified_at"
+	// FieldRewardEligibleAt holds the string denoting the reward_eligible_at field in the database.
+	FieldRewardEligibleAt = "reward_eligible_at"
+	// FieldRewardedAt holds the string denoting the rewarded_at field in the database.
+	FieldRewardedAt = "rewarded_at"
+	// FieldRewardDiscountID holds the string denoting the reward_discount_id field in the database.
+	FieldRewardDiscountID = "reward_discount_id"
+	// Table holds the table name of the referral in the database.
+	Table = "referrals"
+)
+
+// Columns holds all SQL columns for referral fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldMetadata,
+	FieldReferrerID,
+	FieldRefereeID,
+	FieldReferralCode,
+	FieldReferralStatus,
+	FieldRejectionReason,
+	FieldRefereeEmail,
+	FieldRefereePhone,
+	FieldRefereeDiscountID,
+	FieldPaymentID,
+	FieldQualifiedAt,
+	FieldRewardEligibleAt,
+	FieldRewardedAt,
+	FieldRewardDiscountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]string
+	// ReferrerIDValidator is a validator for the "referrer_id" field. It is called by the builders before save.
+	ReferrerIDValidator func(string) error
+	// RefereeIDValidator is a validator for the "referee_id" field. It is called by the builders before save.
+	RefereeIDValidator func(string) error
+	// ReferralCodeValidator is a validator for the "referral_code" field. It is called by the builders before save.
+	ReferralCodeValidator func(string) error
+	// DefaultReferralStatus holds the default value on creation for the "referral_status" field.
+	DefaultReferralStatus types.ReferralStatus
+	// ReferralStatusValidator is a validator for the "referral_status" field. It is called by the builders before save.
+	ReferralStatusValidator func(string) error
+	// RefereeEmailValidator is a validator for the "referee_email" field. It is called by the builders before save.
+	RefereeEmailValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Referral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByReferrerID orders the results by the referrer_id field.
+func ByReferrerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerID, opts...).ToFunc()
+}
+
+// ByRefereeID orders the results by the referee_id field.
+func ByRefereeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereeID, opts...).ToFunc()
+}
+
+// ByReferralCode orders the results by the referral_code field.
+func ByReferralCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralCode, opts...).ToFunc()
+}
+
+// ByReferralStatus orders the results by the referral_status field.
+func ByReferralStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralStatus, opts...).ToFunc()
+}
+
+// ByRejectionReason orders the results by the rejection_reason field.
+func ByRejectionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectionReason, opts...).ToFunc()
+}
+
+// ByRefereeEmail orders the results by the referee_email field.
+func ByRefereeEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereeEmail, opts...).ToFunc()
+}
+
+// ByRefereePhone orders the results by the referee_phone field.
+func ByRefereePhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereePhone, opts...).ToFunc()
+}
+
+// ByRefereeDiscountID orders the results by the referee_discount_id field.
+func ByRefereeDiscountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereeDiscountID, opts...).ToFunc()
+}
+
+// ByPaymentID orders the results by the payment_id field.
+func ByPaymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentID, opts...).ToFunc()
+}
+
+// ByQualifiedAt orders the results by the qualified_at field.
+func ByQualifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQualifiedAt, opts...).ToFunc()
+}
+
+// ByRewardEligibleAt orders the results by the reward_eligible_at field.
+func ByRewardEligibleAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRewardEligibleAt, opts...).ToFunc()
+}
+
+// ByRewardedAt orders the results by the rewarded_at field.
+func ByRewardedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRewardedAt, opts...).ToFunc()
+}
+
+// ByRewardDiscountID orders the results by the reward_discount_id field.
+func ByRewardDiscountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRewardDiscountID, opts...).ToFunc()
+}
@@ -0,0 +1,1346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// ReferrerID applies equality check predicate on the "referrer_id" field. It's identical to ReferrerIDEQ.
+func ReferrerID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// RefereeID applies equality check predicate on the "referee_id" field. It's identical to RefereeIDEQ.
+func RefereeID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeID, v))
+}
+
+// ReferralCode applies equality check predicate on the "referral_code" field. It's identical to ReferralCodeEQ.
+func ReferralCode(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferralCode, v))
+}
+
+// ReferralStatus applies equality check predicate on the "referral_status" field. It's identical to ReferralStatusEQ.
+func ReferralStatus(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldEQ(FieldReferralStatus, vc))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RefereeEmail applies equality check predicate on the "referee_email" field. It's identical to RefereeEmailEQ.
+func RefereeEmail(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeEmail, v))
+}
+
+// RefereePhone applies equality check predicate on the "referee_phone" field. It's identical to RefereePhoneEQ.
+func RefereePhone(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereePhone, v))
+}
+
+// RefereeDiscountID applies equality check predicate on the "referee_discount_id" field. It's identical to RefereeDiscountIDEQ.
+func RefereeDiscountID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeDiscountID, v))
+}
+
+// PaymentID applies equality check predicate on the "payment_id" field. It's identical to PaymentIDEQ.
+func PaymentID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPaymentID, v))
+}
+
+// QualifiedAt applies equality check predicate on the "qualified_at" field. It's identical to QualifiedAtEQ.
+func QualifiedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifiedAt, v))
+}
+
+// RewardEligibleAt applies equality check predicate on the "reward_eligible_at" field. It's identical to RewardEligibleAtEQ.
+func RewardEligibleAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardEligibleAt, v))
+}
+
+// RewardedAt applies equality check predicate on the "rewarded_at" field. It's identical to RewardedAtEQ.
+func RewardedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardedAt, v))
+}
+
+// RewardDiscountID applies equality check predicate on the "reward_discount_id" field. It's identical to RewardDiscountIDEQ.
+func RewardDiscountID(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardDiscountID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldMetadata))
+}
+
+// ReferrerIDEQ applies the EQ predicate on the "referrer_id" field.
+func ReferrerIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDNEQ applies the NEQ predicate on the "referrer_id" field.
+func ReferrerIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDIn applies the In predicate on the "referrer_id" field.
+func ReferrerIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDNotIn applies the NotIn predicate on the "referrer_id" field.
+func ReferrerIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDGT applies the GT predicate on the "referrer_id" field.
+func ReferrerIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferrerID, v))
+}
+
+// ReferrerIDGTE applies the GTE predicate on the "referrer_id" field.
+func ReferrerIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferrerID, v))
+}
+
+// ReferrerIDLT applies the LT predicate on the "referrer_id" field.
+func ReferrerIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferrerID, v))
+}
+
+// ReferrerIDLTE applies the LTE predicate on the "referrer_id" field.
+func ReferrerIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferrerID, v))
+}
+
+// ReferrerIDContains applies the Contains predicate on the "referrer_id" field.
+func ReferrerIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldReferrerID, v))
+}
+
+// ReferrerIDHasPrefix applies the HasPrefix predicate on the "referrer_id" field.
+func ReferrerIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldReferrerID, v))
+}
+
+// ReferrerIDHasSuffix applies the HasSuffix predicate on the "referrer_id" field.
+func ReferrerIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldReferrerID, v))
+}
+
+// ReferrerIDEqualFold applies the EqualFold predicate on the "referrer_id" field.
+func ReferrerIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldReferrerID, v))
+}
+
+// ReferrerIDContainsFold applies the ContainsFold predicate on the "referrer_id" field.
+func ReferrerIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldReferrerID, v))
+}
+
+// RefereeIDEQ applies the EQ predicate on the "referee_id" field.
+func RefereeIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeID, v))
+}
+
+// RefereeIDNEQ applies the NEQ predicate on the "referee_id" field.
+func RefereeIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRefereeID, v))
+}
+
+// RefereeIDIn applies the In predicate on the "referee_id" field.
+func RefereeIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRefereeID, vs...))
+}
+
+// RefereeIDNotIn applies the NotIn predicate on the "referee_id" field.
+func RefereeIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRefereeID, vs...))
+}
+
+// RefereeIDGT applies the GT predicate on the "referee_id" field.
+func RefereeIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRefereeID, v))
+}
+
+// RefereeIDGTE applies the GTE predicate on the "referee_id" field.
+func RefereeIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRefereeID, v))
+}
+
+// RefereeIDLT applies the LT predicate on the "referee_id" field.
+func RefereeIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRefereeID, v))
+}
+
+// RefereeIDLTE applies the LTE predicate on the "referee_id" field.
+func RefereeIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRefereeID, v))
+}
+
+// RefereeIDContains applies the Contains predicate on the "referee_id" field.
+func RefereeIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRefereeID, v))
+}
+
+// RefereeIDHasPrefix applies the HasPrefix predicate on the "referee_id" field.
+func RefereeIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRefereeID, v))
+}
+
+// RefereeIDHasSuffix applies the HasSuffix predicate on the "referee_id" field.
+func RefereeIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRefereeID, v))
+}
+
+// RefereeIDEqualFold applies the EqualFold predicate on the "referee_id" field.
+func RefereeIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRefereeID, v))
+}
+
+// RefereeIDContainsFold applies the ContainsFold predicate on the "referee_id" field.
+func RefereeIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRefereeID, v))
+}
+
+// ReferralCodeEQ applies the EQ predicate on the "referral_code" field.
+func ReferralCodeEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferralCode, v))
+}
+
+// ReferralCodeNEQ applies the NEQ predicate on the "referral_code" field.
+func ReferralCodeNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferralCode, v))
+}
+
+// ReferralCodeIn applies the In predicate on the "referral_code" field.
+func ReferralCodeIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferralCode, vs...))
+}
+
+// ReferralCodeNotIn applies the NotIn predicate on the "referral_code" field.
+func ReferralCodeNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferralCode, vs...))
+}
+
+// ReferralCodeGT applies the GT predicate on the "referral_code" field.
+func ReferralCodeGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldReferralCode, v))
+}
+
+// ReferralCodeGTE applies the GTE predicate on the "referral_code" field.
+func ReferralCodeGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldReferralCode, v))
+}
+
+// ReferralCodeLT applies the LT predicate on the "referral_code" field.
+func ReferralCodeLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldReferralCode, v))
+}
+
+// ReferralCodeLTE applies the LTE predicate on the "referral_code" field.
+func ReferralCodeLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldReferralCode, v))
+}
+
+// ReferralCodeContains applies the Contains predicate on the "referral_code" field.
+func ReferralCodeContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldReferralCode, v))
+}
+
+// ReferralCodeHasPrefix applies the HasPrefix predicate on the "referral_code" field.
+func ReferralCodeHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldReferralCode, v))
+}
+
+// ReferralCodeHasSuffix applies the HasSuffix predicate on the "referral_code" field.
+func ReferralCodeHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldReferralCode, v))
+}
+
+// ReferralCodeEqualFold applies the EqualFold predicate on the "referral_code" field.
+func ReferralCodeEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldReferralCode, v))
+}
+
+// ReferralCodeContainsFold applies the ContainsFold predicate on the "referral_code" field.
+func ReferralCodeContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldReferralCode, v))
+}
+
+// ReferralStatusEQ applies the EQ predicate on the "referral_status" field.
+func ReferralStatusEQ(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldEQ(FieldReferralStatus, vc))
+}
+
+// ReferralStatusNEQ applies the NEQ predicate on the "referral_status" field.
+func ReferralStatusNEQ(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldNEQ(FieldReferralStatus, vc))
+}
+
+// ReferralStatusIn applies the In predicate on the "referral_status" field.
+func ReferralStatusIn(vs ...types.ReferralStatus) predicate.Referral {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Referral(sql.FieldIn(FieldReferralStatus, v...))
+}
+
+// ReferralStatusNotIn applies the NotIn predicate on the "referral_status" field.
+func ReferralStatusNotIn(vs ...types.ReferralStatus) predicate.Referral {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Referral(sql.FieldNotIn(FieldReferralStatus, v...))
+}
+
+// ReferralStatusGT applies the GT predicate on the "referral_status" field.
+func ReferralStatusGT(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldGT(FieldReferralStatus, vc))
+}
+
+// ReferralStatusGTE applies the GTE predicate on the "referral_status" field.
+func ReferralStatusGTE(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldGTE(FieldReferralStatus, vc))
+}
+
+// ReferralStatusLT applies the LT predicate on the "referral_status" field.
+func ReferralStatusLT(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldLT(FieldReferralStatus, vc))
+}
+
+// ReferralStatusLTE applies the LTE predicate on the "referral_status" field.
+func ReferralStatusLTE(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldLTE(FieldReferralStatus, vc))
+}
+
+// ReferralStatusContains applies the Contains predicate on the "referral_status" field.
+func ReferralStatusContains(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldContains(FieldReferralStatus, vc))
+}
+
+// ReferralStatusHasPrefix applies the HasPrefix predicate on the "referral_status" field.
+func ReferralStatusHasPrefix(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldHasPrefix(FieldReferralStatus, vc))
+}
+
+// ReferralStatusHasSuffix applies the HasSuffix predicate on the "referral_status" field.
+func ReferralStatusHasSuffix(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldHasSuffix(FieldReferralStatus, vc))
+}
+
+// ReferralStatusEqualFold applies the EqualFold predicate on the "referral_status" field.
+func ReferralStatusEqualFold(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldEqualFold(FieldReferralStatus, vc))
+}
+
+// ReferralStatusContainsFold applies the ContainsFold predicate on the "referral_status" field.
+func ReferralStatusContainsFold(v types.ReferralStatus) predicate.Referral {
+	vc := string(v)
+	return predicate.Referral(sql.FieldContainsFold(FieldReferralStatus, vc))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// RefereeEmailEQ applies the EQ predicate on the "referee_email" field.
+func RefereeEmailEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeEmail, v))
+}
+
+// RefereeEmailNEQ applies the NEQ predicate on the "referee_email" field.
+func RefereeEmailNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRefereeEmail, v))
+}
+
+// RefereeEmailIn applies the In predicate on the "referee_email" field.
+func RefereeEmailIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRefereeEmail, vs...))
+}
+
+// RefereeEmailNotIn applies the NotIn predicate on the "referee_email" field.
+func RefereeEmailNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRefereeEmail, vs...))
+}
+
+// RefereeEmailGT applies the GT predicate on the "referee_email" field.
+func RefereeEmailGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRefereeEmail, v))
+}
+
+// RefereeEmailGTE applies the GTE predicate on the "referee_email" field.
+func RefereeEmailGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRefereeEmail, v))
+}
+
+// RefereeEmailLT applies the LT predicate on the "referee_email" field.
+func RefereeEmailLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRefereeEmail, v))
+}
+
+// RefereeEmailLTE applies the LTE predicate on the "referee_email" field.
+func RefereeEmailLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRefereeEmail, v))
+}
+
+// RefereeEmailContains applies the Contains predicate on the "referee_email" field.
+func RefereeEmailContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRefereeEmail, v))
+}
+
+// RefereeEmailHasPrefix applies the HasPrefix predicate on the "referee_email" field.
+func RefereeEmailHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRefereeEmail, v))
+}
+
+// RefereeEmailHasSuffix applies the HasSuffix predicate on the "referee_email" field.
+func RefereeEmailHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRefereeEmail, v))
+}
+
+// RefereeEmailEqualFold applies the EqualFold predicate on the "referee_email" field.
+func RefereeEmailEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRefereeEmail, v))
+}
+
+// RefereeEmailContainsFold applies the ContainsFold predicate on the "referee_email" field.
+func RefereeEmailContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRefereeEmail, v))
+}
+
+// RefereePhoneEQ applies the EQ predicate on the "referee_phone" field.
+func RefereePhoneEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereePhone, v))
+}
+
+// RefereePhoneNEQ applies the NEQ predicate on the "referee_phone" field.
+func RefereePhoneNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRefereePhone, v))
+}
+
+// RefereePhoneIn applies the In predicate on the "referee_phone" field.
+func RefereePhoneIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRefereePhone, vs...))
+}
+
+// RefereePhoneNotIn applies the NotIn predicate on the "referee_phone" field.
+func RefereePhoneNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRefereePhone, vs...))
+}
+
+// RefereePhoneGT applies the GT predicate on the "referee_phone" field.
+func RefereePhoneGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRefereePhone, v))
+}
+
+// RefereePhoneGTE applies the GTE predicate on the "referee_phone" field.
+func RefereePhoneGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRefereePhone, v))
+}
+
+// RefereePhoneLT applies the LT predicate on the "referee_phone" field.
+func RefereePhoneLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRefereePhone, v))
+}
+
+// RefereePhoneLTE applies the LTE predicate on the "referee_phone" field.
+func RefereePhoneLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRefereePhone, v))
+}
+
+// RefereePhoneContains applies the Contains predicate on the "referee_phone" field.
+func RefereePhoneContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRefereePhone, v))
+}
+
+// RefereePhoneHasPrefix applies the HasPrefix predicate on the "referee_phone" field.
+func RefereePhoneHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRefereePhone, v))
+}
+
+// RefereePhoneHasSuffix applies the HasSuffix predicate on the "referee_phone" field.
+func RefereePhoneHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRefereePhone, v))
+}
+
+// RefereePhoneIsNil applies the IsNil predicate on the "referee_phone" field.
+func RefereePhoneIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRefereePhone))
+}
+
+// RefereePhoneNotNil applies the NotNil predicate on the "referee_phone" field.
+func RefereePhoneNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRefereePhone))
+}
+
+// RefereePhoneEqualFold applies the EqualFold predicate on the "referee_phone" field.
+func RefereePhoneEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRefereePhone, v))
+}
+
+// RefereePhoneContainsFold applies the ContainsFold predicate on the "referee_phone" field.
+func RefereePhoneContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRefereePhone, v))
+}
+
+// RefereeDiscountIDEQ applies the EQ predicate on the "referee_discount_id" field.
+func RefereeDiscountIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDNEQ applies the NEQ predicate on the "referee_discount_id" field.
+func RefereeDiscountIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDIn applies the In predicate on the "referee_discount_id" field.
+func RefereeDiscountIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRefereeDiscountID, vs...))
+}
+
+// RefereeDiscountIDNotIn applies the NotIn predicate on the "referee_discount_id" field.
+func RefereeDiscountIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRefereeDiscountID, vs...))
+}
+
+// RefereeDiscountIDGT applies the GT predicate on the "referee_discount_id" field.
+func RefereeDiscountIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDGTE applies the GTE predicate on the "referee_discount_id" field.
+func RefereeDiscountIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDLT applies the LT predicate on the "referee_discount_id" field.
+func RefereeDiscountIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDLTE applies the LTE predicate on the "referee_discount_id" field.
+func RefereeDiscountIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDContains applies the Contains predicate on the "referee_discount_id" field.
+func RefereeDiscountIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDHasPrefix applies the HasPrefix predicate on the "referee_discount_id" field.
+func RefereeDiscountIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDHasSuffix applies the HasSuffix predicate on the "referee_discount_id" field.
+func RefereeDiscountIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDIsNil applies the IsNil predicate on the "referee_discount_id" field.
+func RefereeDiscountIDIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRefereeDiscountID))
+}
+
+// RefereeDiscountIDNotNil applies the NotNil predicate on the "referee_discount_id" field.
+func RefereeDiscountIDNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRefereeDiscountID))
+}
+
+// RefereeDiscountIDEqualFold applies the EqualFold predicate on the "referee_discount_id" field.
+func RefereeDiscountIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRefereeDiscountID, v))
+}
+
+// RefereeDiscountIDContainsFold applies the ContainsFold predicate on the "referee_discount_id" field.
+func RefereeDiscountIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRefereeDiscountID, v))
+}
+
+// PaymentIDEQ applies the EQ predicate on the "payment_id" field.
+func PaymentIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldPaymentID, v))
+}
+
+// PaymentIDNEQ applies the NEQ predicate on the "payment_id" field.
+func PaymentIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldPaymentID, v))
+}
+
+// PaymentIDIn applies the In predicate on the "payment_id" field.
+func PaymentIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldPaymentID, vs...))
+}
+
+// PaymentIDNotIn applies the NotIn predicate on the "payment_id" field.
+func PaymentIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldPaymentID, vs...))
+}
+
+// PaymentIDGT applies the GT predicate on the "payment_id" field.
+func PaymentIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldPaymentID, v))
+}
+
+// PaymentIDGTE applies the GTE predicate on the "payment_id" field.
+func PaymentIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldPaymentID, v))
+}
+
+// PaymentIDLT applies the LT predicate on the "payment_id" field.
+func PaymentIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldPaymentID, v))
+}
+
+// PaymentIDLTE applies the LTE predicate on the "payment_id" field.
+func PaymentIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldPaymentID, v))
+}
+
+// PaymentIDContains applies the Contains predicate on the "payment_id" field.
+func PaymentIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldPaymentID, v))
+}
+
+// PaymentIDHasPrefix applies the HasPrefix predicate on the "payment_id" field.
+func PaymentIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldPaymentID, v))
+}
+
+// PaymentIDHasSuffix applies the HasSuffix predicate on the "payment_id" field.
+func PaymentIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldPaymentID, v))
+}
+
+// PaymentIDIsNil applies the IsNil predicate on the "payment_id" field.
+func PaymentIDIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldPaymentID))
+}
+
+// PaymentIDNotNil applies the NotNil predicate on the "payment_id" field.
+func PaymentIDNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldPaymentID))
+}
+
+// PaymentIDEqualFold applies the EqualFold predicate on the "payment_id" field.
+func PaymentIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldPaymentID, v))
+}
+
+// PaymentIDContainsFold applies the ContainsFold predicate on the "payment_id" field.
+func PaymentIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldPaymentID, v))
+}
+
+// QualifiedAtEQ applies the EQ predicate on the "qualified_at" field.
+func QualifiedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldQualifiedAt, v))
+}
+
+// QualifiedAtNEQ applies the NEQ predicate on the "qualified_at" field.
+func QualifiedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldQualifiedAt, v))
+}
+
+// QualifiedAtIn applies the In predicate on the "qualified_at" field.
+func QualifiedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldQualifiedAt, vs...))
+}
+
+// QualifiedAtNotIn applies the NotIn predicate on the "qualified_at" field.
+func QualifiedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldQualifiedAt, vs...))
+}
+
+// QualifiedAtGT applies the GT predicate on the "qualified_at" field.
+func QualifiedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldQualifiedAt, v))
+}
+
+// QualifiedAtGTE applies the GTE predicate on the "qualified_at" field.
+func QualifiedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldQualifiedAt, v))
+}
+
+// QualifiedAtLT applies the LT predicate on the "qualified_at" field.
+func QualifiedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldQualifiedAt, v))
+}
+
+// QualifiedAtLTE applies the LTE predicate on the "qualified_at" field.
+func QualifiedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldQualifiedAt, v))
+}
+
+// QualifiedAtIsNil applies the IsNil predicate on the "qualified_at" field.
+func QualifiedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldQualifiedAt))
+}
+
+// QualifiedAtNotNil applies the NotNil predicate on the "qualified_at" field.
+func QualifiedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldQualifiedAt))
+}
+
+// RewardEligibleAtEQ applies the EQ predicate on the "reward_eligible_at" field.
+func RewardEligibleAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardEligibleAt, v))
+}
+
+// RewardEligibleAtNEQ applies the NEQ predicate on the "reward_eligible_at" field.
+func RewardEligibleAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRewardEligibleAt, v))
+}
+
+// RewardEligibleAtIn applies the In predicate on the "reward_eligible_at" field.
+func RewardEligibleAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRewardEligibleAt, vs...))
+}
+
+// RewardEligibleAtNotIn applies the NotIn predicate on the "reward_eligible_at" field.
+func RewardEligibleAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRewardEligibleAt, vs...))
+}
+
+// RewardEligibleAtGT applies the GT predicate on the "reward_eligible_at" field.
+func RewardEligibleAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRewardEligibleAt, v))
+}
+
+// RewardEligibleAtGTE applies the GTE predicate on the "reward_eligible_at" field.
+func RewardEligibleAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRewardEligibleAt, v))
+}
+
+// RewardEligibleAtLT applies the LT predicate on the "reward_eligible_at" field.
+func RewardEligibleAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRewardEligibleAt, v))
+}
+
+// RewardEligibleAtLTE applies the LTE predicate on the "reward_eligible_at" field.
+func RewardEligibleAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRewardEligibleAt, v))
+}
+
+// RewardEligibleAtIsNil applies the IsNil predicate on the "reward_eligible_at" field.
+func RewardEligibleAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRewardEligibleAt))
+}
+
+// RewardEligibleAtNotNil applies the NotNil predicate on the "reward_eligible_at" field.
+func RewardEligibleAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRewardEligibleAt))
+}
+
+// RewardedAtEQ applies the EQ predicate on the "rewarded_at" field.
+func RewardedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardedAt, v))
+}
+
+// RewardedAtNEQ applies the NEQ predicate on the "rewarded_at" field.
+func RewardedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRewardedAt, v))
+}
+
+// RewardedAtIn applies the In predicate on the "rewarded_at" field.
+func RewardedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRewardedAt, vs...))
+}
+
+// RewardedAtNotIn applies the NotIn predicate on the "rewarded_at" field.
+func RewardedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRewardedAt, vs...))
+}
+
+// RewardedAtGT applies the GT predicate on the "rewarded_at" field.
+func RewardedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRewardedAt, v))
+}
+
+// RewardedAtGTE applies the GTE predicate on the "rewarded_at" field.
+func RewardedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRewardedAt, v))
+}
+
+// RewardedAtLT applies the LT predicate on the "rewarded_at" field.
+func RewardedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRewardedAt, v))
+}
+
+// RewardedAtLTE applies the LTE predicate on the "rewarded_at" field.
+func RewardedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRewardedAt, v))
+}
+
+// RewardedAtIsNil applies the IsNil predicate on the "rewarded_at" field.
+func RewardedAtIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRewardedAt))
+}
+
+// RewardedAtNotNil applies the NotNil predicate on the "rewarded_at" field.
+func RewardedAtNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRewardedAt))
+}
+
+// RewardDiscountIDEQ applies the EQ predicate on the "reward_discount_id" field.
+func RewardDiscountIDEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDNEQ applies the NEQ predicate on the "reward_discount_id" field.
+func RewardDiscountIDNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDIn applies the In predicate on the "reward_discount_id" field.
+func RewardDiscountIDIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRewardDiscountID, vs...))
+}
+
+// RewardDiscountIDNotIn applies the NotIn predicate on the "reward_discount_id" field.
+func RewardDiscountIDNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRewardDiscountID, vs...))
+}
+
+// RewardDiscountIDGT applies the GT predicate on the "reward_discount_id" field.
+func RewardDiscountIDGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDGTE applies the GTE predicate on the "reward_discount_id" field.
+func RewardDiscountIDGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDLT applies the LT predicate on the "reward_discount_id" field.
+func RewardDiscountIDLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDLTE applies the LTE predicate on the "reward_discount_id" field.
+func RewardDiscountIDLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDContains applies the Contains predicate on the "reward_discount_id" field.
+func RewardDiscountIDContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDHasPrefix applies the HasPrefix predicate on the "reward_discount_id" field.
+func RewardDiscountIDHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDHasSuffix applies the HasSuffix predicate on the "reward_discount_id" field.
+func RewardDiscountIDHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDIsNil applies the IsNil predicate on the "reward_discount_id" field.
+func RewardDiscountIDIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldRewardDiscountID))
+}
+
+// RewardDiscountIDNotNil applies the NotNil predicate on the "reward_discount_id" field.
+func RewardDiscountIDNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldRewardDiscountID))
+}
+
+// RewardDiscountIDEqualFold applies the EqualFold predicate on the "reward_discount_id" field.
+func RewardDiscountIDEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldRewardDiscountID, v))
+}
+
+// RewardDiscountIDContainsFold applies the ContainsFold predicate on the "reward_discount_id" field.
+func RewardDiscountIDContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldRewardDiscountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.NotPredicates(p))
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/referral"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// ReferralCreate is the builder for creating a Referral entity.
+type ReferralCreate struct {
+	config
+	mutation *ReferralMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReferralCreate) SetStatus(s string) *ReferralCreate {
+	rc.mutation.SetStatus(s)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableStatus(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetStatus(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReferralCreate) SetCreatedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableCreatedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ReferralCreate) SetUpdatedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableUpdatedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rc *ReferralCreate) SetCreatedBy(s string) *ReferralCreate {
+	rc.mutation.SetCreatedBy(s)
+	return rc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableCreatedBy(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetCreatedBy(*s)
+	}
+	return rc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rc *ReferralCreate) SetUpdatedBy(s string) *ReferralCreate {
+	rc.mutation.SetUpdatedBy(s)
+	return rc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableUpdatedBy(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetUpdatedBy(*s)
+	}
+	return rc
+}
+
+// SetMetadata sets the "metadata" field.
+func (rc *ReferralCreate) SetMetadata(m map[string]string) *ReferralCreate {
+	rc.mutation.SetMetadata(m)
+	return rc
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (rc *ReferralCreate) SetReferrerID(s string) *ReferralCreate {
+	rc.mutation.SetReferrerID(s)
+	return rc
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (rc *ReferralCreate) SetRefereeID(s string) *ReferralCreate {
+	rc.mutation.SetRefereeID(s)
+	return rc
+}
+
+// SetReferralCode sets the "referral_code" field.
+func (rc *ReferralCreate) SetReferralCode(s string) *ReferralCreate {
+	rc.mutation.SetReferralCode(s)
+	return rc
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (rc *ReferralCreate) SetReferralStatus(ts types.ReferralStatus) *ReferralCreate {
+	rc.mutation.SetReferralStatus(ts)
+	return rc
+}
+
+// SetNillableReferralStatus sets the "referral_status" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableReferralStatus(ts *types.ReferralStatus) *ReferralCreate {
+	if ts != nil {
+		rc.SetReferralStatus(*ts)
+	}
+	return rc
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (rc *ReferralCreate) SetRejectionReason(s string) *ReferralCreate {
+	rc.mutation.SetRejectionReason(s)
+	return rc
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableRejectionReason(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetRejectionReason(*s)
+	}
+	return rc
+}
+
+// SetRefereeEmail sets the "referee_email" field.
+func (rc *ReferralCreate) SetRefereeEmail(s string) *ReferralCreate {
+	rc.mutation.SetRefereeEmail(s)
+	return rc
+}
+
+// SetRefereePhone sets the "referee_phone" field.
+func (rc *ReferralCreate) SetRefereePhone(s string) *ReferralCreate {
+	rc.mutation.SetRefereePhone(s)
+	return rc
+}
+
+// SetNillableRefereePhone sets the "referee_phone" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableRefereePhone(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetRefereePhone(*s)
+	}
+	return rc
+}
+
+// SetRefereeDiscountID sets the "referee_discount_id" field.
+func (rc *ReferralCreate) SetRefereeDiscountID(s string) *ReferralCreate {
+	rc.mutation.SetRefereeDiscountID(s)
+	return rc
+}
+
+// SetNillableRefereeDiscountID sets the "referee_discount_id" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableRefereeDiscountID(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetRefereeDiscountID(*s)
+	}
+	return rc
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (rc *ReferralCreate) SetPaymentID(s string) *ReferralCreate {
+	rc.mutation.SetPaymentID(s)
+	return rc
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillablePaymentID(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetPaymentID(*s)
+	}
+	return rc
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (rc *ReferralCreate) SetQualifiedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetQualifiedAt(t)
+	return rc
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableQualifiedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetQualifiedAt(*t)
+	}
+	return rc
+}
+
+// SetRewardEligibleAt sets the "reward_eligible_at" field.
+func (rc *ReferralCreate) SetRewardEligibleAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetRewardEligibleAt(t)
+	return rc
+}
+
+// SetNillableRewardEligibleAt sets the "reward_eligible_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableRewardEligibleAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetRewardEligibleAt(*t)
+	}
+	return rc
+}
+
+// SetRewardedAt sets the "rewarded_at" field.
+func (rc *ReferralCreate) SetRewardedAt(t time.Time) *ReferralCreate {
+	rc.mutation.SetRewardedAt(t)
+	return rc
+}
+
+// SetNillableRewardedAt sets the "rewarded_at" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableRewardedAt(t *time.Time) *ReferralCreate {
+	if t != nil {
+		rc.SetRewardedAt(*t)
+	}
+	return rc
+}
+
+// SetRewardDiscountID sets the "reward_discount_id" field.
+func (rc *ReferralCreate) SetRewardDiscountID(s string) *ReferralCreate {
+	rc.mutation.SetRewardDiscountID(s)
+	return rc
+}
+
+// SetNillableRewardDiscountID sets the "reward_discount_id" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableRewardDiscountID(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetRewardDiscountID(*s)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReferralCreate) SetID(s string) *ReferralCreate {
+	rc.mutation.SetID(s)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReferralCreate) SetNillableID(s *string) *ReferralCreate {
+	if s != nil {
+		rc.SetID(*s)
+	}
+	return rc
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (rc *ReferralCreate) Mutation() *ReferralMutation {
+	return rc.mutation
+}
+
+// Save creates the Referral in the database.
+func (rc *ReferralCreate) Save(ctx context.Context) (*Referral, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReferralCreate) SaveX(ctx context.Context) *Referral {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReferralCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReferralCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReferralCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := referral.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := referral.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := referral.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rc.mutation.Metadata(); !ok {
+		v := referral.DefaultMetadata
+		rc.mutation.SetMetadata(v)
+	}
+	if _, ok := rc.mutation.ReferralStatus(); !ok {
+		v := referral.DefaultReferralStatus
+		rc.mutation.SetReferralStatus(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := referral.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReferralCreate) check() error {
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Referral.status"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Referral.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Referral.updated_at"`)}
+	}
+	if _, ok := rc.mutation.ReferrerID(); !ok {
+		return &ValidationError{Name: "referrer_id", err: errors.New(`ent: missing required field "Referral.referrer_id"`)}
+	}
+	if v, ok := rc.mutation.ReferrerID(); ok {
+		if err := referral.ReferrerIDValidator(v); err != nil {
+			return &ValidationError{Name: "referrer_id", err: fmt.Errorf(`ent: validator failed for field "Referral.referrer_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.RefereeID(); !ok {
+		return &ValidationError{Name: "referee_id", err: errors.New(`ent: missing required field "Referral.referee_id"`)}
+	}
+	if v, ok := rc.mutation.RefereeID(); ok {
+		if err := referral.RefereeIDValidator(v); err != nil {
+			return &ValidationError{Name: "referee_id", err: fmt.Errorf(`ent: validator failed for field "Referral.referee_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ReferralCode(); !ok {
+		return &ValidationError{Name: "referral_code", err: errors.New(`ent: missing required field "Referral.referral_code"`)}
+	}
+	if v, ok := rc.mutation.ReferralCode(); ok {
+		if err := referral.ReferralCodeValidator(v); err != nil {
+			return &ValidationError{Name: "referral_code", err: fmt.Errorf(`ent: validator failed for field "Referral.referral_code": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ReferralStatus(); !ok {
+		return &ValidationError{Name: "referral_status", err: errors.New(`ent: missing required field "Referral.referral_status"`)}
+	}
+	if v, ok := rc.mutation.ReferralStatus(); ok {
+		if err := referral.ReferralStatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "referral_status", err: fmt.Errorf(`ent: validator failed for field "Referral.referral_status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.RefereeEmail(); !ok {
+		return &ValidationError{Name: "referee_email", err: errors.New(`ent: missing required field "Referral.referee_email"`)}
+	}
+	if v, ok := rc.mutation.RefereeEmail(); ok {
+		if err := referral.RefereeEmailValidator(v); err != nil {
+			return &ValidationError{Name: "referee_email", err: fmt.Errorf(`ent: validator failed for field "Referral.referee_email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rc *ReferralCreate) sqlSave(ctx context.Context) (*Referral, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Referral.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReferralCreate) createSpec() (*Referral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Referral{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(referral.Table, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(referral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(referral.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rc.mutation.CreatedBy(); ok {
+		_spec.SetField(referral.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rc.mutation.UpdatedBy(); ok {
+		_spec.SetField(referral.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rc.mutation.Metadata(); ok {
+		_spec.SetField(referral.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := rc.mutation.ReferrerID(); ok {
+		_spec.SetField(referral.FieldReferrerID, field.TypeString, value)
+		_node.ReferrerID = value
+	}
+	if value, ok := rc.mutation.RefereeID(); ok {
+		_spec.SetField(referral.FieldRefereeID, field.TypeString, value)
+		_node.RefereeID = value
+	}
+	if value, ok := rc.mutation.ReferralCode(); ok {
+		_spec.SetField(referral.FieldReferralCode, field.TypeString, value)
+		_node.ReferralCode = value
+	}
+	if value, ok := rc.mutation.ReferralStatus(); ok {
+		_spec.SetField(referral.FieldReferralStatus, field.TypeString, value)
+		_node.ReferralStatus = value
+	}
+	if value, ok := rc.mutation.RejectionReason(); ok {
+		_spec.SetField(referral.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = &value
+	}
+	if value, ok := rc.mutation.RefereeEmail(); ok {
+		_spec.SetField(referral.FieldRefereeEmail, field.TypeString, value)
+		_node.RefereeEmail = value
+	}
+	if value, ok := rc.mutation.RefereePhone(); ok {
+		_spec.SetField(referral.FieldRefereePhone, field.TypeString, value)
+		_node.RefereePhone = value
+	}
+	if value, ok := rc.mutation.RefereeDiscountID(); ok {
+		_spec.SetField(referral.FieldRefereeDiscountID, field.TypeString, value)
+		_node.RefereeDiscountID = &value
+	}
+	if value, ok := rc.mutation.PaymentID(); ok {
+		_spec.SetField(referral.FieldPaymentID, field.TypeString, value)
+		_node.PaymentID = &value
+	}
+	if value, ok := rc.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+		_node.QualifiedAt = &value
+	}
+	if value, ok := rc.mutation.RewardEligibleAt(); ok {
+		_spec.SetField(referral.FieldRewardEligibleAt, field.TypeTime, value)
+		_node.RewardEligibleAt = &value
+	}
+	if value, ok := rc.mutation.RewardedAt(); ok {
+		_spec.SetField(referral.FieldRewardedAt, field.TypeTime, value)
+		_node.RewardedAt = &value
+	}
+	if value, ok := rc.mutation.RewardDiscountID(); ok {
+		_spec.SetField(referral.FieldRewardDiscountID, field.TypeString, value)
+		_node.RewardDiscountID = &value
+	}
+	return _node, _spec
+}
+
+// ReferralCreateBulk is the builder for creating many Referral entities in bulk.
+type ReferralCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCreate
+}
+
+// Save creates the Referral entities in the database.
+func (rcb *ReferralCreateBulk) Save(ctx context.Context) ([]*Referral, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Referral, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReferralCreateBulk) SaveX(ctx context.Context) []*Referral {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReferralCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,771 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/referral"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// ReferralUpdate is the builder for updating Referral entities.
+type ReferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (ru *ReferralUpdate) Where(ps ...predicate.Referral) *ReferralUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReferralUpdate) SetStatus(s string) *ReferralUpdate {
+	ru.mutation.SetStatus(s)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableStatus(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetStatus(*s)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReferralUpdate) SetUpdatedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ru *ReferralUpdate) SetUpdatedBy(s string) *ReferralUpdate {
+	ru.mutation.SetUpdatedBy(s)
+	return ru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableUpdatedBy(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetUpdatedBy(*s)
+	}
+	return ru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ru *ReferralUpdate) ClearUpdatedBy() *ReferralUpdate {
+	ru.mutation.ClearUpdatedBy()
+	return ru
+}
+
+// SetMetadata sets the "metadata" field.
+func (ru *ReferralUpdate) SetMetadata(m map[string]string) *ReferralUpdate {
+	ru.mutation.SetMetadata(m)
+	return ru
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ru *ReferralUpdate) ClearMetadata() *ReferralUpdate {
+	ru.mutation.ClearMetadata()
+	return ru
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (ru *ReferralUpdate) SetReferralStatus(ts types.ReferralStatus) *ReferralUpdate {
+	ru.mutation.SetReferralStatus(ts)
+	return ru
+}
+
+// SetNillableReferralStatus sets the "referral_status" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableReferralStatus(ts *types.ReferralStatus) *ReferralUpdate {
+	if ts != nil {
+		ru.SetReferralStatus(*ts)
+	}
+	return ru
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (ru *ReferralUpdate) SetRejectionReason(s string) *ReferralUpdate {
+	ru.mutation.SetRejectionReason(s)
+	return ru
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableRejectionReason(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetRejectionReason(*s)
+	}
+	return ru
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (ru *ReferralUpdate) ClearRejectionReason() *ReferralUpdate {
+	ru.mutation.ClearRejectionReason()
+	return ru
+}
+
+// SetRefereeDiscountID sets the "referee_discount_id" field.
+func (ru *ReferralUpdate) SetRefereeDiscountID(s string) *ReferralUpdate {
+	ru.mutation.SetRefereeDiscountID(s)
+	return ru
+}
+
+// SetNillableRefereeDiscountID sets the "referee_discount_id" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableRefereeDiscountID(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetRefereeDiscountID(*s)
+	}
+	return ru
+}
+
+// ClearRefereeDiscountID clears the value of the "referee_discount_id" field.
+func (ru *ReferralUpdate) ClearRefereeDiscountID() *ReferralUpdate {
+	ru.mutation.ClearRefereeDiscountID()
+	return ru
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (ru *ReferralUpdate) SetPaymentID(s string) *ReferralUpdate {
+	ru.mutation.SetPaymentID(s)
+	return ru
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillablePaymentID(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetPaymentID(*s)
+	}
+	return ru
+}
+
+// ClearPaymentID clears the value of the "payment_id" field.
+func (ru *ReferralUpdate) ClearPaymentID() *ReferralUpdate {
+	ru.mutation.ClearPaymentID()
+	return ru
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (ru *ReferralUpdate) SetQualifiedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetQualifiedAt(t)
+	return ru
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableQualifiedAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetQualifiedAt(*t)
+	}
+	return ru
+}
+
+// ClearQualifiedAt clears the value of the "qualified_at" field.
+func (ru *ReferralUpdate) ClearQualifiedAt() *ReferralUpdate {
+	ru.mutation.ClearQualifiedAt()
+	return ru
+}
+
+// SetRewardEligibleAt sets the "reward_eligible_at" field.
+func (ru *ReferralUpdate) SetRewardEligibleAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetRewardEligibleAt(t)
+	return ru
+}
+
+// SetNillableRewardEligibleAt sets the "reward_eligible_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableRewardEligibleAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetRewardEligibleAt(*t)
+	}
+	return ru
+}
+
+// ClearRewardEligibleAt clears the value of the "reward_eligible_at" field.
+func (ru *ReferralUpdate) ClearRewardEligibleAt() *ReferralUpdate {
+	ru.mutation.ClearRewardEligibleAt()
+	return ru
+}
+
+// SetRewardedAt sets the "rewarded_at" field.
+func (ru *ReferralUpdate) SetRewardedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetRewardedAt(t)
+	return ru
+}
+
+// SetNillableRewardedAt sets the "rewarded_at" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableRewardedAt(t *time.Time) *ReferralUpdate {
+	if t != nil {
+		ru.SetRewardedAt(*t)
+	}
+	return ru
+}
+
+// ClearRewardedAt clears the value of the "rewarded_at" field.
+func (ru *ReferralUpdate) ClearRewardedAt() *ReferralUpdate {
+	ru.mutation.ClearRewardedAt()
+	return ru
+}
+
+// SetRewardDiscountID sets the "reward_discount_id" field.
+func (ru *ReferralUpdate) SetRewardDiscountID(s string) *ReferralUpdate {
+	ru.mutation.SetRewardDiscountID(s)
+	return ru
+}
+
+// SetNillableRewardDiscountID sets the "reward_discount_id" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableRewardDiscountID(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetRewardDiscountID(*s)
+	}
+	return ru
+}
+
+// ClearRewardDiscountID clears the value of the "reward_discount_id" field.
+func (ru *ReferralUpdate) ClearRewardDiscountID() *ReferralUpdate {
+	ru.mutation.ClearRewardDiscountID()
+	return ru
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (ru *ReferralUpdate) Mutation() *ReferralMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReferralUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReferralUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReferralUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ReferralUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := referral.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReferralUpdate) check() error {
+	if v, ok := ru.mutation.ReferralStatus(); ok {
+		if err := referral.ReferralStatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "referral_status", err: fmt.Errorf(`ent: validator failed for field "Referral.referral_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ru *ReferralUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(referral.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.CreatedByCleared() {
+		_spec.ClearField(referral.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ru.mutation.UpdatedBy(); ok {
+		_spec.SetField(referral.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ru.mutation.UpdatedByCleared() {
+		_spec.ClearField(referral.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ru.mutation.Metadata(); ok {
+		_spec.SetField(referral.FieldMetadata, field.TypeJSON, value)
+	}
+	if ru.mutation.MetadataCleared() {
+		_spec.ClearField(referral.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.ReferralStatus(); ok {
+		_spec.SetField(referral.FieldReferralStatus, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.RejectionReason(); ok {
+		_spec.SetField(referral.FieldRejectionReason, field.TypeString, value)
+	}
+	if ru.mutation.RejectionReasonCleared() {
+		_spec.ClearField(referral.FieldRejectionReason, field.TypeString)
+	}
+	if ru.mutation.RefereePhoneCleared() {
+		_spec.ClearField(referral.FieldRefereePhone, field.TypeString)
+	}
+	if value, ok := ru.mutation.RefereeDiscountID(); ok {
+		_spec.SetField(referral.FieldRefereeDiscountID, field.TypeString, value)
+	}
+	if ru.mutation.RefereeDiscountIDCleared() {
+		_spec.ClearField(referral.FieldRefereeDiscountID, field.TypeString)
+	}
+	if value, ok := ru.mutation.PaymentID(); ok {
+		_spec.SetField(referral.FieldPaymentID, field.TypeString, value)
+	}
+	if ru.mutation.PaymentIDCleared() {
+		_spec.ClearField(referral.FieldPaymentID, field.TypeString)
+	}
+	if value, ok := ru.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+	}
+	if ru.mutation.QualifiedAtCleared() {
+		_spec.ClearField(referral.FieldQualifiedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.RewardEligibleAt(); ok {
+		_spec.SetField(referral.FieldRewardEligibleAt, field.TypeTime, value)
+	}
+	if ru.mutation.RewardEligibleAtCleared() {
+		_spec.ClearField(referral.FieldRewardEligibleAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.RewardedAt(); ok {
+		_spec.SetField(referral.FieldRewardedAt, field.TypeTime, value)
+	}
+	if ru.mutation.RewardedAtCleared() {
+		_spec.ClearField(referral.FieldRewardedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.RewardDiscountID(); ok {
+		_spec.SetField(referral.FieldRewardDiscountID, field.TypeString, value)
+	}
+	if ru.mutation.RewardDiscountIDCleared() {
+		_spec.ClearField(referral.FieldRewardDiscountID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReferralUpdateOne is the builder for updating a single Referral entity.
+type ReferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReferralUpdateOne) SetStatus(s string) *ReferralUpdateOne {
+	ruo.mutation.SetStatus(s)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableStatus(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetStatus(*s)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReferralUpdateOne) SetUpdatedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ruo *ReferralUpdateOne) SetUpdatedBy(s string) *ReferralUpdateOne {
+	ruo.mutation.SetUpdatedBy(s)
+	return ruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableUpdatedBy(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetUpdatedBy(*s)
+	}
+	return ruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ruo *ReferralUpdateOne) ClearUpdatedBy() *ReferralUpdateOne {
+	ruo.mutation.ClearUpdatedBy()
+	return ruo
+}
+
+// SetMetadata sets the "metadata" field.
+func (ruo *ReferralUpdateOne) SetMetadata(m map[string]string) *ReferralUpdateOne {
+	ruo.mutation.SetMetadata(m)
+	return ruo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ruo *ReferralUpdateOne) ClearMetadata() *ReferralUpdateOne {
+	ruo.mutation.ClearMetadata()
+	return ruo
+}
+
+// SetReferralStatus sets the "referral_status" field.
+func (ruo *ReferralUpdateOne) SetReferralStatus(ts types.ReferralStatus) *ReferralUpdateOne {
+	ruo.mutation.SetReferralStatus(ts)
+	return ruo
+}
+
+// SetNillableReferralStatus sets the "referral_status" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableReferralStatus(ts *types.ReferralStatus) *ReferralUpdateOne {
+	if ts != nil {
+		ruo.SetReferralStatus(*ts)
+	}
+	return ruo
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (ruo *ReferralUpdateOne) SetRejectionReason(s string) *ReferralUpdateOne {
+	ruo.mutation.SetRejectionReason(s)
+	return ruo
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableRejectionReason(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetRejectionReason(*s)
+	}
+	return ruo
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (ruo *ReferralUpdateOne) ClearRejectionReason() *ReferralUpdateOne {
+	ruo.mutation.ClearRejectionReason()
+	return ruo
+}
+
+// SetRefereeDiscountID sets the "referee_discount_id" field.
+func (ruo *ReferralUpdateOne) SetRefereeDiscountID(s string) *ReferralUpdateOne {
+	ruo.mutation.SetRefereeDiscountID(s)
+	return ruo
+}
+
+// SetNillableRefereeDiscountID sets the "referee_discount_id" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableRefereeDiscountID(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetRefereeDiscountID(*s)
+	}
+	return ruo
+}
+
+// ClearRefereeDiscountID clears the value of the "referee_discount_id" field.
+func (ruo *ReferralUpdateOne) ClearRefereeDiscountID() *ReferralUpdateOne {
+	ruo.mutation.ClearRefereeDiscountID()
+	return ruo
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (ruo *ReferralUpdateOne) SetPaymentID(s string) *ReferralUpdateOne {
+	ruo.mutation.SetPaymentID(s)
+	return ruo
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillablePaymentID(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetPaymentID(*s)
+	}
+	return ruo
+}
+
+// ClearPaymentID clears the value of the "payment_id" field.
+func (ruo *ReferralUpdateOne) ClearPaymentID() *ReferralUpdateOne {
+	ruo.mutation.ClearPaymentID()
+	return ruo
+}
+
+// SetQualifiedAt sets the "qualified_at" field.
+func (ruo *ReferralUpdateOne) SetQualifiedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetQualifiedAt(t)
+	return ruo
+}
+
+// SetNillableQualifiedAt sets the "qualified_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableQualifiedAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetQualifiedAt(*t)
+	}
+	return ruo
+}
+
+// ClearQualifiedAt clears the value of the "qualified_at" field.
+func (ruo *ReferralUpdateOne) ClearQualifiedAt() *ReferralUpdateOne {
+	ruo.mutation.ClearQualifiedAt()
+	return ruo
+}
+
+// SetRewardEligibleAt sets the "reward_eligible_at" field.
+func (ruo *ReferralUpdateOne) SetRewardEligibleAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetRewardEligibleAt(t)
+	return ruo
+}
+
+// SetNillableRewardEligibleAt sets the "reward_eligible_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableRewardEligibleAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetRewardEligibleAt(*t)
+	}
+	return ruo
+}
+
+// ClearRewardEligibleAt clears the value of the "reward_eligible_at" field.
+func (ruo *ReferralUpdateOne) ClearRewardEligibleAt() *ReferralUpdateOne {
+	ruo.mutation.ClearRewardEligibleAt()
+	return ruo
+}
+
+// SetRewardedAt sets the "rewarded_at" field.
+func (ruo *ReferralUpdateOne) SetRewardedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetRewardedAt(t)
+	return ruo
+}
+
+// SetNillableRewardedAt sets the "rewarded_at" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableRewardedAt(t *time.Time) *ReferralUpdateOne {
+	if t != nil {
+		ruo.SetRewardedAt(*t)
+	}
+	return ruo
+}
+
+// ClearRewardedAt clears the value of the "rewarded_at" field.
+func (ruo *ReferralUpdateOne) ClearRewardedAt() *ReferralUpdateOne {
+	ruo.mutation.ClearRewardedAt()
+	return ruo
+}
+
+// SetRewardDiscountID sets the "reward_discount_id" field.
+func (ruo *ReferralUpdateOne) SetRewardDiscountID(s string) *ReferralUpdateOne {
+	ruo.mutation.SetRewardDiscountID(s)
+	return ruo
+}
+
+// SetNillableRewardDiscountID sets the "reward_discount_id" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableRewardDiscountID(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetRewardDiscountID(*s)
+	}
+	return ruo
+}
+
+// ClearRewardDiscountID clears the value of the "reward_discount_id" field.
+func (ruo *ReferralUpdateOne) ClearRewardDiscountID() *ReferralUpdateOne {
+	ruo.mutation.ClearRewardDiscountID()
+	return ruo
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (ruo *ReferralUpdateOne) Mutation() *ReferralMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (ruo *ReferralUpdateOne) Where(ps ...predicate.Referral) *ReferralUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReferralUpdateOne) Select(field string, fields ...string) *ReferralUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Referral entity.
+func (ruo *ReferralUpdateOne) Save(ctx context.Context) (*Referral, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReferralUpdateOne) SaveX(ctx context.Context) *Referral {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReferralUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ReferralUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := referral.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReferralUpdateOne) check() error {
+	if v, ok := ruo.mutation.ReferralStatus(); ok {
+		if err := referral.ReferralStatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "referral_status", err: fmt.Errorf(`ent: validator failed for field "Referral.referral_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *ReferralUpdateOne) sqlSave(ctx context.Context) (_node *Referral, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Referral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referral.FieldID)
+		for _, f := range fields {
+			if !referral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(referral.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.CreatedByCleared() {
+		_spec.ClearField(referral.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(referral.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(referral.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ruo.mutation.Metadata(); ok {
+		_spec.SetField(referral.FieldMetadata, field.TypeJSON, value)
+	}
+	if ruo.mutation.MetadataCleared() {
+		_spec.ClearField(referral.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.ReferralStatus(); ok {
+		_spec.SetField(referral.FieldReferralStatus, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.RejectionReason(); ok {
+		_spec.SetField(referral.FieldRejectionReason, field.TypeString, value)
+	}
+	if ruo.mutation.RejectionReasonCleared() {
+		_spec.ClearField(referral.FieldRejectionReason, field.TypeString)
+	}
+	if ruo.mutation.RefereePhoneCleared() {
+		_spec.ClearField(referral.FieldRefereePhone, field.TypeString)
+	}
+	if value, ok := ruo.mutation.RefereeDiscountID(); ok {
+		_spec.SetField(referral.FieldRefereeDiscountID, field.TypeString, value)
+	}
+	if ruo.mutation.RefereeDiscountIDCleared() {
+		_spec.ClearField(referral.FieldRefereeDiscountID, field.TypeString)
+	}
+	if value, ok := ruo.mutation.PaymentID(); ok {
+		_spec.SetField(referral.FieldPaymentID, field.TypeString, value)
+	}
+	if ruo.mutation.PaymentIDCleared() {
+		_spec.ClearField(referral.FieldPaymentID, field.TypeString)
+	}
+	if value, ok := ruo.mutation.QualifiedAt(); ok {
+		_spec.SetField(referral.FieldQualifiedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.QualifiedAtCleared() {
+		_spec.ClearField(referral.FieldQualifiedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.RewardEligibleAt(); ok {
+		_spec.SetField(referral.FieldRewardEligibleAt, field.TypeTime, value)
+	}
+	if ruo.mutation.RewardEligibleAtCleared() {
+		_spec.ClearField(referral.FieldRewardEligibleAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.RewardedAt(); ok {
+		_spec.SetField(referral.FieldRewardedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.RewardedAtCleared() {
+		_spec.ClearField(referral.FieldRewardedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.RewardDiscountID(); ok {
+		_spec.SetField(referral.FieldRewardDiscountID, field.TypeString, value)
+	}
+	if ruo.mutation.RewardDiscountIDCleared() {
+		_spec.ClearField(referral.FieldRewardDiscountID, field.TypeString)
+	}
+	_node = &Referral{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
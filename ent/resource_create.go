@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/resource"
+)
+
+// ResourceCreate is the builder for creating a Resource entity.
+type ResourceCreate struct {
+	config
+	mutation *ResourceMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (rc *ResourceCreate) SetStatus(s string) *ResourceCreate {
+	rc.mutation.SetStatus(s)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableStatus(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetStatus(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ResourceCreate) SetCreatedAt(t time.Time) *ResourceCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableCreatedAt(t *time.Time) *ResourceCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ResourceCreate) SetUpdatedAt(t time.Time) *ResourceCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableUpdatedAt(t *time.Time) *ResourceCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rc *ResourceCreate) SetCreatedBy(s string) *ResourceCreate {
+	rc.mutation.SetCreatedBy(s)
+	return rc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableCreatedBy(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetCreatedBy(*s)
+	}
+	return rc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rc *ResourceCreate) SetUpdatedBy(s string) *ResourceCreate {
+	rc.mutation.SetUpdatedBy(s)
+	return rc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableUpdatedBy(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetUpdatedBy(*s)
+	}
+	return rc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (rc *ResourceCreate) SetInternshipID(s string) *ResourceCreate {
+	rc.mutation.SetInternshipID(s)
+	return rc
+}
+
+// SetModuleID sets the "module_id" field.
+func (rc *ResourceCreate) SetModuleID(s string) *ResourceCreate {
+	rc.mutation.SetModuleID(s)
+	return rc
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableModuleID(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetModuleID(*s)
+	}
+	return rc
+}
+
+// SetLessonID sets the "lesson_id" field.
+func (rc *ResourceCreate) SetLessonID(s string) *ResourceCreate {
+	rc.mutation.SetLessonID(s)
+	return rc
+}
+
+// SetNillableLessonID sets the "lesson_id" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableLessonID(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetLessonID(*s)
+	}
+	return rc
+}
+
+// SetTitle sets the "title" field.
+func (rc *ResourceCreate) SetTitle(s string) *ResourceCreate {
+	rc.mutation.SetTitle(s)
+	return rc
+}
+
+// SetDescription sets the "description" field.
+func (rc *ResourceCreate) SetDescription(s string) *ResourceCreate {
+	rc.mutation.SetDescription(s)
+	return rc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableDescription(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetDescription(*s)
+	}
+	return rc
+}
+
+// SetFileID sets the "file_id" field.
+func (rc *ResourceCreate) SetFileID(s string) *ResourceCreate {
+	rc.mutation.SetFileID(s)
+	return rc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (rc *ResourceCreate) SetSortOrder(i int) *ResourceCreate {
+	rc.mutation.SetSortOrder(i)
+	return rc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableSortOrder(i *int) *ResourceCreate {
+	if i != nil {
+		rc.SetSortOrder(*i)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ResourceCreate) SetID(s string) *ResourceCreate {
+	rc.mutation.SetID(s)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableID(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetID(*s)
+	}
+	return rc
+}
+
+// Mutation returns the ResourceMutation object of the builder.
+func (rc *ResourceCreate) Mutation() *ResourceMutation {
+	return rc.mutation
+}
+
+// Save creates the Resource in the database.
+func (rc *ResourceCreate) Save(ctx context.Context) (*Resource, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ResourceCreate) SaveX(ctx context.Context) *Resource {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ResourceCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ResourceCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ResourceCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := resource.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := resource.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := resource.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rc.mutation.SortOrder(); !ok {
+		v := resource.DefaultSortOrder
+		rc.mutation.SetSortOrder(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := resource.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ResourceCreate) check() error {
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Resource.status"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Resource.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Resource.updated_at"`)}
+	}
+	if _, ok := rc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Resource.internship_id"`)}
+	}
+	if v, ok := rc.mutation.InternshipID(); ok {
+		if err := resource.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Resource.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Resource.title"`)}
+	}
+	if v, ok := rc.mutation.Title(); ok {
+		if err := resource.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Resource.title": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "Resource.file_id"`)}
+	}
+	if v, ok := rc.mutation.FileID(); ok {
+		if err := resource.FileIDValidator(v); err != nil {
+			return &ValidationError{Name: "file_id", err: fmt.Errorf(`ent: validator failed for field "Resource.file_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Resource.sort_order"`)}
+	}
+	return nil
+}
+
+func (rc *ResourceCreate) sqlSave(ctx context.Context) (*Resource, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Resource.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Resource{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(resource.Table, sqlgraph.NewFieldSpec(resource.FieldID, field.TypeString))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(resource.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(resource.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(resource.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rc.mutation.CreatedBy(); ok {
+		_spec.SetField(resource.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rc.mutation.UpdatedBy(); ok {
+		_spec.SetField(resource.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rc.mutation.InternshipID(); ok {
+		_spec.SetField(resource.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := rc.mutation.ModuleID(); ok {
+		_spec.SetField(resource.FieldModuleID, field.TypeString, value)
+		_node.ModuleID = &value
+	}
+	if value, ok := rc.mutation.LessonID(); ok {
+		_spec.SetField(resource.FieldLessonID, field.TypeString, value)
+		_node.LessonID = &value
+	}
+	if value, ok := rc.mutation.Title(); ok {
+		_spec.SetField(resource.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := rc.mutation.Description(); ok {
+		_spec.SetField(resource.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := rc.mutation.FileID(); ok {
+		_spec.SetField(resource.FieldFileID, field.TypeString, value)
+		_node.FileID = value
+	}
+	if value, ok := rc.mutation.SortOrder(); ok {
+		_spec.SetField(resource.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// ResourceCreateBulk is the builder for creating many Resource entities in bulk.
+type ResourceCreateBulk struct {
+	config
+	err      error
+	builders []*ResourceCreate
+}
+
+// Save creates the Resource entities in the database.
+func (rcb *ResourceCreateBulk) Save(ctx context.Context) ([]*Resource, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Resource, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ResourceCreateBulk) SaveX(ctx context.Context) []*Resource {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ResourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ResourceCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/resource"
+)
+
+// ResourceUpdate is the builder for updating Resource entities.
+type ResourceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResourceMutation
+}
+
+// Where appends a list predicates to the ResourceUpdate builder.
+func (ru *ResourceUpdate) Where(ps ...predicate.Resource) *ResourceUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ResourceUpdate) SetStatus(s string) *ResourceUpdate {
+	ru.mutation.SetStatus(s)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableStatus(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetStatus(*s)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ResourceUpdate) SetUpdatedAt(t time.Time) *ResourceUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ru *ResourceUpdate) SetUpdatedBy(s string) *ResourceUpdate {
+	ru.mutation.SetUpdatedBy(s)
+	return ru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableUpdatedBy(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetUpdatedBy(*s)
+	}
+	return ru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ru *ResourceUpdate) ClearUpdatedBy() *ResourceUpdate {
+	ru.mutation.ClearUpdatedBy()
+	return ru
+}
+
+// SetModuleID sets the "module_id" field.
+func (ru *ResourceUpdate) SetModuleID(s string) *ResourceUpdate {
+	ru.mutation.SetModuleID(s)
+	return ru
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableModuleID(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetModuleID(*s)
+	}
+	return ru
+}
+
+// ClearModuleID clears the value of the "module_id" field.
+func (ru *ResourceUpdate) ClearModuleID() *ResourceUpdate {
+	ru.mutation.ClearModuleID()
+	return ru
+}
+
+// SetLessonID sets the "lesson_id" field.
+func (ru *ResourceUpdate) SetLessonID(s string) *ResourceUpdate {
+	ru.mutation.SetLessonID(s)
+	return ru
+}
+
+// SetNillableLessonID sets the "lesson_id" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableLessonID(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetLessonID(*s)
+	}
+	return ru
+}
+
+// ClearLessonID clears the value of the "lesson_id" field.
+func (ru *ResourceUpdate) ClearLessonID() *ResourceUpdate {
+	ru.mutation.ClearLessonID()
+	return ru
+}
+
+// SetTitle sets the "title" field.
+func (ru *ResourceUpdate) SetTitle(s string) *ResourceUpdate {
+	ru.mutation.SetTitle(s)
+	return ru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableTitle(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetTitle(*s)
+	}
+	return ru
+}
+
+// SetDescription sets the "description" field.
+func (ru *ResourceUpdate) SetDescription(s string) *ResourceUpdate {
+	ru.mutation.SetDescription(s)
+	return ru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableDescription(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetDescription(*s)
+	}
+	return ru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ru *ResourceUpdate) ClearDescription() *ResourceUpdate {
+	ru.mutation.ClearDescription()
+	return ru
+}
+
+// SetFileID sets the "file_id" field.
+func (ru *ResourceUpdate) SetFileID(s string) *ResourceUpdate {
+	ru.mutation.SetFileID(s)
+	return ru
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableFileID(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetFileID(*s)
+	}
+	return ru
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (ru *ResourceUpdate) SetSortOrder(i int) *ResourceUpdate {
+	ru.mutation.ResetSortOrder()
+	ru.mutation.SetSortOrder(i)
+	return ru
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableSortOrder(i *int) *ResourceUpdate {
+	if i != nil {
+		ru.SetSortOrder(*i)
+	}
+	return ru
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (ru *ResourceUpdate) AddSortOrder(i int) *ResourceUpdate {
+	ru.mutation.AddSortOrder(i)
+	return ru
+}
+
+// Mutation returns the ResourceMutation object of the builder.
+func (ru *ResourceUpdate) Mutation() *ResourceMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ResourceUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ResourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ResourceUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ResourceUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ResourceUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := resource.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ResourceUpdate) check() error {
+	if v, ok := ru.mutation.Title(); ok {
+		if err := resource.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Resource.title": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.FileID(); ok {
+		if err := resource.FileIDValidator(v); err != nil {
+			return &ValidationError{Name: "file_id", err: fmt.Errorf(`ent: validator failed for field "Resource.file_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resource.Table, resource.Columns, sqlgraph.NewFieldSpec(resource.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(resource.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(resource.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.CreatedByCleared() {
+		_spec.ClearField(resource.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ru.mutation.UpdatedBy(); ok {
+		_spec.SetField(resource.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ru.mutation.UpdatedByCleared() {
+		_spec.ClearField(resource.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ru.mutation.ModuleID(); ok {
+		_spec.SetField(resource.FieldModuleID, field.TypeString, value)
+	}
+	if ru.mutation.ModuleIDCleared() {
+		_spec.ClearField(resource.FieldModuleID, field.TypeString)
+	}
+	if value, ok := ru.mutation.LessonID(); ok {
+		_spec.SetField(resource.FieldLessonID, field.TypeString, value)
+	}
+	if ru.mutation.LessonIDCleared() {
+		_spec.ClearField(resource.FieldLessonID, field.TypeString)
+	}
+	if value, ok := ru.mutation.Title(); ok {
+		_spec.SetField(resource.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Description(); ok {
+		_spec.SetField(resource.FieldDescription, field.TypeString, value)
+	}
+	if ru.mutation.DescriptionCleared() {
+		_spec.ClearField(resource.FieldDescription, field.TypeString)
+	}
+	if value, ok := ru.mutation.FileID(); ok {
+		_spec.SetField(resource.FieldFileID, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.SortOrder(); ok {
+		_spec.SetField(resource.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedSortOrder(); ok {
+		_spec.AddField(resource.FieldSortOrder, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ResourceUpdateOne is the builder for updating a single Resource entity.
+type ResourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResourceMutation
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ResourceUpdateOne) SetStatus(s string) *ResourceUpdateOne {
+	ruo.mutation.SetStatus(s)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableStatus(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetStatus(*s)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ResourceUpdateOne) SetUpdatedAt(t time.Time) *ResourceUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ruo *ResourceUpdateOne) SetUpdatedBy(s string) *ResourceUpdateOne {
+	ruo.mutation.SetUpdatedBy(s)
+	return ruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableUpdatedBy(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetUpdatedBy(*s)
+	}
+	return ruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ruo *ResourceUpdateOne) ClearUpdatedBy() *ResourceUpdateOne {
+	ruo.mutation.ClearUpdatedBy()
+	return ruo
+}
+
+// SetModuleID sets the "module_id" field.
+func (ruo *ResourceUpdateOne) SetModuleID(s string) *ResourceUpdateOne {
+	ruo.mutation.SetModuleID(s)
+	return ruo
+}
+
+// SetNillableModuleID sets the "module_id" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableModuleID(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetModuleID(*s)
+	}
+	return ruo
+}
+
+// ClearModuleID clears the value of the "module_id" field.
+func (ruo *ResourceUpdateOne) ClearModuleID() *ResourceUpdateOne {
+	ruo.mutation.ClearModuleID()
+	return ruo
+}
+
+// SetLessonID sets the "lesson_id" field.
+func (ruo *ResourceUpdateOne) SetLessonID(s string) *ResourceUpdateOne {
+	ruo.mutation.SetLessonID(s)
+	return ruo
+}
+
+// SetNillableLessonID sets the "lesson_id" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableLessonID(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetLessonID(*s)
+	}
+	return ruo
+}
+
+// ClearLessonID clears the value of the "lesson_id" field.
+func (ruo *ResourceUpdateOne) ClearLessonID() *ResourceUpdateOne {
+	ruo.mutation.ClearLessonID()
+	return ruo
+}
+
+// SetTitle sets the "title" field.
+func (ruo *ResourceUpdateOne) SetTitle(s string) *ResourceUpdateOne {
+	ruo.mutation.SetTitle(s)
+	return ruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableTitle(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetTitle(*s)
+	}
+	return ruo
+}
+
+// SetDescription sets the "description" field.
+func (ruo *ResourceUpdateOne) SetDescription(s string) *ResourceUpdateOne {
+	ruo.mutation.SetDescription(s)
+	return ruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableDescription(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetDescription(*s)
+	}
+	return ruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ruo *ResourceUpdateOne) ClearDescription() *ResourceUpdateOne {
+	ruo.mutation.ClearDescription()
+	return ruo
+}
+
+// SetFileID sets the "file_id" field.
+func (ruo *ResourceUpdateOne) SetFileID(s string) *ResourceUpdateOne {
+	ruo.mutation.SetFileID(s)
+	return ruo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableFileID(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetFileID(*s)
+	}
+	return ruo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (ruo *ResourceUpdateOne) SetSortOrder(i int) *ResourceUpdateOne {
+	ruo.mutation.ResetSortOrder()
+	ruo.mutation.SetSortOrder(i)
+	return ruo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableSortOrder(i *int) *ResourceUpdateOne {
+	if i != nil {
+		ruo.SetSortOrder(*i)
+	}
+	return ruo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (ruo *ResourceUpdateOne) AddSortOrder(i int) *ResourceUpdateOne {
+	ruo.mutation.AddSortOrder(i)
+	return ruo
+}
+
+// Mutation returns the ResourceMutation object of the builder.
+func (ruo *ResourceUpdateOne) Mutation() *ResourceMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ResourceUpdate builder.
+func (ruo *ResourceUpdateOne) Where(ps ...predicate.Resource) *ResourceUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ResourceUpdateOne) Select(field string, fields ...string) *ResourceUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Resource entity.
+func (ruo *ResourceUpdateOne) Save(ctx context.Context) (*Resource, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ResourceUpdateOne) SaveX(ctx context.Context) *Resource {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ResourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ResourceUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ResourceUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := resource.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ResourceUpdateOne) check() error {
+	if v, ok := ruo.mutation.Title(); ok {
+		if err := resource.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Resource.title": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.FileID(); ok {
+		if err := resource.FileIDValidator(v); err != nil {
+			return &ValidationError{Name: "file_id", err: fmt.Errorf(`ent: validator failed for field "Resource.file_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resource.Table, resource.Columns, sqlgraph.NewFieldSpec(resource.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Resource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resource.FieldID)
+		for _, f := range fields {
+			if !resource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != resource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(resource.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(resource.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.CreatedByCleared() {
+		_spec.ClearField(resource.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(resource.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(resource.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := ruo.mutation.ModuleID(); ok {
+		_spec.SetField(resource.FieldModuleID, field.TypeString, value)
+	}
+	if ruo.mutation.ModuleIDCleared() {
+		_spec.ClearField(resource.FieldModuleID, field.TypeString)
+	}
+	if value, ok := ruo.mutation.LessonID(); ok {
+		_spec.SetField(resource.FieldLessonID, field.TypeString, value)
+	}
+	if ruo.mutation.LessonIDCleared() {
+		_spec.ClearField(resource.FieldLessonID, field.TypeString)
+	}
+	if value, ok := ruo.mutation.Title(); ok {
+		_spec.SetField(resource.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Description(); ok {
+		_spec.SetField(resource.FieldDescription, field.TypeString, value)
+	}
+	if ruo.mutation.DescriptionCleared() {
+		_spec.ClearField(resource.FieldDescription, field.TypeString)
+	}
+	if value, ok := ruo.mutation.FileID(); ok {
+		_spec.SetField(resource.FieldFileID, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.SortOrder(); ok {
+		_spec.SetField(resource.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(resource.FieldSortOrder, field.TypeInt, value)
+	}
+	_node = &Resource{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -5,18 +5,42 @@ package ent
 import (
 	"time"
 
+	"github.com/omkar273/codegeeky/ent/assignment"
 	"github.com/omkar273/codegeeky/ent/cart"
 	"github.com/omkar273/codegeeky/ent/cartlineitems"
 	"github.com/omkar273/codegeeky/ent/category"
+	"github.com/omkar273/codegeeky/ent/certificate"
 	"github.com/omkar273/codegeeky/ent/discount"
 	"github.com/omkar273/codegeeky/ent/fileupload"
 	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
 	"github.com/omkar273/codegeeky/ent/internshipbatch"
 	"github.com/omkar273/codegeeky/ent/internshipenrollment"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/ent/lesson"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+	"github.com/omkar273/codegeeky/ent/livesession"
+	"github.com/omkar273/codegeeky/ent/module"
+	"github.com/omkar273/codegeeky/ent/notification"
 	"github.com/omkar273/codegeeky/ent/payment"
 	"github.com/omkar273/codegeeky/ent/paymentattempt"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
+	"github.com/omkar273/codegeeky/ent/quiz"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+	"github.com/omkar273/codegeeky/ent/referral"
+	"github.com/omkar273/codegeeky/ent/resource"
 	"github.com/omkar273/codegeeky/ent/schema"
+	"github.com/omkar273/codegeeky/ent/sessionattendance"
+	"github.com/omkar273/codegeeky/ent/submission"
+	"github.com/omkar273/codegeeky/ent/subscription"
+	"github.com/omkar273/codegeeky/ent/subscriptionevent"
+	"github.com/omkar273/codegeeky/ent/subscriptionplan"
 	"github.com/omkar273/codegeeky/ent/user"
+	"github.com/omkar273/codegeeky/ent/wallettransaction"
+	"github.com/omkar273/codegeeky/ent/wishlist"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -25,6 +49,87 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	assignmentMixin := schema.Assignment{}.Mixin()
+	assignmentMixinFields0 := assignmentMixin[0].Fields()
+	_ = assignmentMixinFields0
+	assignmentFields := schema.Assignment{}.Fields()
+	_ = assignmentFields
+	// assignmentDescStatus is the schema descriptor for status field.
+	assignmentDescStatus := assignmentMixinFields0[0].Descriptor()
+	// assignment.DefaultStatus holds the default value on creation for the status field.
+	assignment.DefaultStatus = assignmentDescStatus.Default.(string)
+	// assignmentDescCreatedAt is the schema descriptor for created_at field.
+	assignmentDescCreatedAt := assignmentMixinFields0[1].Descriptor()
+	// assignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	assignment.DefaultCreatedAt = assignmentDescCreatedAt.Default.(func() time.Time)
+	// assignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	assignmentDescUpdatedAt := assignmentMixinFields0[2].Descriptor()
+	// assignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	assignment.DefaultUpdatedAt = assignmentDescUpdatedAt.Default.(func() time.Time)
+	// assignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	assignment.UpdateDefaultUpdatedAt = assignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// assignmentDescInternshipID is the schema descriptor for internship_id field.
+	assignmentDescInternshipID := assignmentFields[1].Descriptor()
+	// assignment.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	assignment.InternshipIDValidator = assignmentDescInternshipID.Validators[0].(func(string) error)
+	// assignmentDescModuleID is the schema descriptor for module_id field.
+	assignmentDescModuleID := assignmentFields[2].Descriptor()
+	// assignment.ModuleIDValidator is a validator for the "module_id" field. It is called by the builders before save.
+	assignment.ModuleIDValidator = assignmentDescModuleID.Validators[0].(func(string) error)
+	// assignmentDescTitle is the schema descriptor for title field.
+	assignmentDescTitle := assignmentFields[3].Descriptor()
+	// assignment.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	assignment.TitleValidator = assignmentDescTitle.Validators[0].(func(string) error)
+	// assignmentDescDueDays is the schema descriptor for due_days field.
+	assignmentDescDueDays := assignmentFields[5].Descriptor()
+	// assignment.DueDaysValidator is a validator for the "due_days" field. It is called by the builders before save.
+	assignment.DueDaysValidator = assignmentDescDueDays.Validators[0].(func(int) error)
+	// assignmentDescMaxScore is the schema descriptor for max_score field.
+	assignmentDescMaxScore := assignmentFields[6].Descriptor()
+	// assignment.DefaultMaxScore holds the default value on creation for the max_score field.
+	assignment.DefaultMaxScore = assignmentDescMaxScore.Default.(int)
+	// assignment.MaxScoreValidator is a validator for the "max_score" field. It is called by the builders before save.
+	assignment.MaxScoreValidator = assignmentDescMaxScore.Validators[0].(func(int) error)
+	// assignmentDescPassingScore is the schema descriptor for passing_score field.
+	assignmentDescPassingScore := assignmentFields[7].Descriptor()
+	// assignment.PassingScoreValidator is a validator for the "passing_score" field. It is called by the builders before save.
+	assignment.PassingScoreValidator = assignmentDescPassingScore.Validators[0].(func(int) error)
+	// assignmentDescLatePolicy is the schema descriptor for late_policy field.
+	assignmentDescLatePolicy := assignmentFields[9].Descriptor()
+	// assignment.DefaultLatePolicy holds the default value on creation for the late_policy field.
+	assignment.DefaultLatePolicy = assignmentDescLatePolicy.Default.(string)
+	// assignmentDescLatePenaltyPercent is the schema descriptor for late_penalty_percent field.
+	assignmentDescLatePenaltyPercent := assignmentFields[10].Descriptor()
+	// assignment.DefaultLatePenaltyPercent holds the default value on creation for the late_penalty_percent field.
+	assignment.DefaultLatePenaltyPercent = assignmentDescLatePenaltyPercent.Default.(float64)
+	// assignment.LatePenaltyPercentValidator is a validator for the "late_penalty_percent" field. It is called by the builders before save.
+	assignment.LatePenaltyPercentValidator = func() func(float64) error {
+		validators := assignmentDescLatePenaltyPercent.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(late_penalty_percent float64) error {
+			for _, fn := range fns {
+				if err := fn(late_penalty_percent); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// assignmentDescMaxSubmissions is the schema descriptor for max_submissions field.
+	assignmentDescMaxSubmissions := assignmentFields[11].Descriptor()
+	// assignment.MaxSubmissionsValidator is a validator for the "max_submissions" field. It is called by the builders before save.
+	assignment.MaxSubmissionsValidator = assignmentDescMaxSubmissions.Validators[0].(func(int) error)
+	// assignmentDescSortOrder is the schema descriptor for sort_order field.
+	assignmentDescSortOrder := assignmentFields[12].Descriptor()
+	// assignment.DefaultSortOrder holds the default value on creation for the sort_order field.
+	assignment.DefaultSortOrder = assignmentDescSortOrder.Default.(int)
+	// assignmentDescID is the schema descriptor for id field.
+	assignmentDescID := assignmentFields[0].Descriptor()
+	// assignment.DefaultID holds the default value on creation for the id field.
+	assignment.DefaultID = assignmentDescID.Default.(func() string)
 	cartMixin := schema.Cart{}.Mixin()
 	cartMixinFields0 := cartMixin[0].Fields()
 	_ = cartMixinFields0
@@ -176,10 +281,99 @@ func init() {
 	categoryDescLookupKey := categoryFields[2].Descriptor()
 	// category.LookupKeyValidator is a validator for the "lookup_key" field. It is called by the builders before save.
 	category.LookupKeyValidator = categoryDescLookupKey.Validators[0].(func(string) error)
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[5].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	// categoryDescPath is the schema descriptor for path field.
+	categoryDescPath := categoryFields[6].Descriptor()
+	// category.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	category.PathValidator = categoryDescPath.Validators[0].(func(string) error)
+	// categoryDescDepth is the schema descriptor for depth field.
+	categoryDescDepth := categoryFields[7].Descriptor()
+	// category.DefaultDepth holds the default value on creation for the depth field.
+	category.DefaultDepth = categoryDescDepth.Default.(int)
+	// category.DepthValidator is a validator for the "depth" field. It is called by the builders before save.
+	category.DepthValidator = categoryDescDepth.Validators[0].(func(int) error)
+	// categoryDescSortWeight is the schema descriptor for sort_weight field.
+	categoryDescSortWeight := categoryFields[8].Descriptor()
+	// category.DefaultSortWeight holds the default value on creation for the sort_weight field.
+	category.DefaultSortWeight = categoryDescSortWeight.Default.(int)
+	// categoryDescInternshipCount is the schema descriptor for internship_count field.
+	categoryDescInternshipCount := categoryFields[9].Descriptor()
+	// category.DefaultInternshipCount holds the default value on creation for the internship_count field.
+	category.DefaultInternshipCount = categoryDescInternshipCount.Default.(int)
+	// category.InternshipCountValidator is a validator for the "internship_count" field. It is called by the builders before save.
+	category.InternshipCountValidator = categoryDescInternshipCount.Validators[0].(func(int) error)
+	// categoryDescTotalInternshipCount is the schema descriptor for total_internship_count field.
+	categoryDescTotalInternshipCount := categoryFields[10].Descriptor()
+	// category.DefaultTotalInternshipCount holds the default value on creation for the total_internship_count field.
+	category.DefaultTotalInternshipCount = categoryDescTotalInternshipCount.Default.(int)
+	// category.TotalInternshipCountValidator is a validator for the "total_internship_count" field. It is called by the builders before save.
+	category.TotalInternshipCountValidator = categoryDescTotalInternshipCount.Validators[0].(func(int) error)
 	// categoryDescID is the schema descriptor for id field.
 	categoryDescID := categoryFields[0].Descriptor()
 	// category.DefaultID holds the default value on creation for the id field.
 	category.DefaultID = categoryDescID.Default.(func() string)
+	certificateMixin := schema.Certificate{}.Mixin()
+	certificateMixinFields0 := certificateMixin[0].Fields()
+	_ = certificateMixinFields0
+	certificateFields := schema.Certificate{}.Fields()
+	_ = certificateFields
+	// certificateDescStatus is the schema descriptor for status field.
+	certificateDescStatus := certificateMixinFields0[0].Descriptor()
+	// certificate.DefaultStatus holds the default value on creation for the status field.
+	certificate.DefaultStatus = certificateDescStatus.Default.(string)
+	// certificateDescCreatedAt is the schema descriptor for created_at field.
+	certificateDescCreatedAt := certificateMixinFields0[1].Descriptor()
+	// certificate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	certificate.DefaultCreatedAt = certificateDescCreatedAt.Default.(func() time.Time)
+	// certificateDescUpdatedAt is the schema descriptor for updated_at field.
+	certificateDescUpdatedAt := certificateMixinFields0[2].Descriptor()
+	// certificate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	certificate.DefaultUpdatedAt = certificateDescUpdatedAt.Default.(func() time.Time)
+	// certificate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	certificate.UpdateDefaultUpdatedAt = certificateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// certificateDescVerificationID is the schema descriptor for verification_id field.
+	certificateDescVerificationID := certificateFields[1].Descriptor()
+	// certificate.VerificationIDValidator is a validator for the "verification_id" field. It is called by the builders before save.
+	certificate.VerificationIDValidator = certificateDescVerificationID.Validators[0].(func(string) error)
+	// certificateDescEnrollmentID is the schema descriptor for enrollment_id field.
+	certificateDescEnrollmentID := certificateFields[2].Descriptor()
+	// certificate.EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	certificate.EnrollmentIDValidator = certificateDescEnrollmentID.Validators[0].(func(string) error)
+	// certificateDescUserID is the schema descriptor for user_id field.
+	certificateDescUserID := certificateFields[3].Descriptor()
+	// certificate.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	certificate.UserIDValidator = certificateDescUserID.Validators[0].(func(string) error)
+	// certificateDescInternshipID is the schema descriptor for internship_id field.
+	certificateDescInternshipID := certificateFields[4].Descriptor()
+	// certificate.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	certificate.InternshipIDValidator = certificateDescInternshipID.Validators[0].(func(string) error)
+	// certificateDescInternshipBatchID is the schema descriptor for internship_batch_id field.
+	certificateDescInternshipBatchID := certificateFields[5].Descriptor()
+	// certificate.InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	certificate.InternshipBatchIDValidator = certificateDescInternshipBatchID.Validators[0].(func(string) error)
+	// certificateDescRecipientName is the schema descriptor for recipient_name field.
+	certificateDescRecipientName := certificateFields[6].Descriptor()
+	// certificate.RecipientNameValidator is a validator for the "recipient_name" field. It is called by the builders before save.
+	certificate.RecipientNameValidator = certificateDescRecipientName.Validators[0].(func(string) error)
+	// certificateDescInternshipTitle is the schema descriptor for internship_title field.
+	certificateDescInternshipTitle := certificateFields[7].Descriptor()
+	// certificate.InternshipTitleValidator is a validator for the "internship_title" field. It is called by the builders before save.
+	certificate.InternshipTitleValidator = certificateDescInternshipTitle.Validators[0].(func(string) error)
+	// certificateDescSignature is the schema descriptor for signature field.
+	certificateDescSignature := certificateFields[10].Descriptor()
+	// certificate.SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	certificate.SignatureValidator = certificateDescSignature.Validators[0].(func(string) error)
+	// certificateDescCertificateStatus is the schema descriptor for certificate_status field.
+	certificateDescCertificateStatus := certificateFields[12].Descriptor()
+	// certificate.DefaultCertificateStatus holds the default value on creation for the certificate_status field.
+	certificate.DefaultCertificateStatus = certificateDescCertificateStatus.Default.(string)
+	// certificateDescID is the schema descriptor for id field.
+	certificateDescID := certificateFields[0].Descriptor()
+	// certificate.DefaultID holds the default value on creation for the id field.
+	certificate.DefaultID = certificateDescID.Default.(func() string)
 	discountMixin := schema.Discount{}.Mixin()
 	discountMixinFields0 := discountMixin[0].Fields()
 	_ = discountMixinFields0
@@ -223,8 +417,18 @@ func init() {
 	discountDescIsCombinable := discountFields[10].Descriptor()
 	// discount.DefaultIsCombinable holds the default value on creation for the is_combinable field.
 	discount.DefaultIsCombinable = discountDescIsCombinable.Default.(bool)
+	// discountDescUsedCount is the schema descriptor for used_count field.
+	discountDescUsedCount := discountFields[11].Descriptor()
+	// discount.DefaultUsedCount holds the default value on creation for the used_count field.
+	discount.DefaultUsedCount = discountDescUsedCount.Default.(int)
+	// discount.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	discount.UsedCountValidator = discountDescUsedCount.Validators[0].(func(int) error)
+	// discountDescIsCampaign is the schema descriptor for is_campaign field.
+	discountDescIsCampaign := discountFields[12].Descriptor()
+	// discount.DefaultIsCampaign holds the default value on creation for the is_campaign field.
+	discount.DefaultIsCampaign = discountDescIsCampaign.Default.(bool)
 	// discountDescMetadata is the schema descriptor for metadata field.
-	discountDescMetadata := discountFields[11].Descriptor()
+	discountDescMetadata := discountFields[14].Descriptor()
 	// discount.DefaultMetadata holds the default value on creation for the metadata field.
 	discount.DefaultMetadata = discountDescMetadata.Default.(map[string]string)
 	// discountDescID is the schema descriptor for id field.
@@ -333,10 +537,73 @@ func init() {
 	internshipDescTotal := internshipFields[16].Descriptor()
 	// internship.DefaultTotal holds the default value on creation for the total field.
 	internship.DefaultTotal = internshipDescTotal.Default.(decimal.Decimal)
+	// internshipDescPublishStatus is the schema descriptor for publish_status field.
+	internshipDescPublishStatus := internshipFields[17].Descriptor()
+	// internship.DefaultPublishStatus holds the default value on creation for the publish_status field.
+	internship.DefaultPublishStatus = types.InternshipPublishStatus(internshipDescPublishStatus.Default.(string))
+	// internshipDescApplicationRequired is the schema descriptor for application_required field.
+	internshipDescApplicationRequired := internshipFields[26].Descriptor()
+	// internship.DefaultApplicationRequired holds the default value on creation for the application_required field.
+	internship.DefaultApplicationRequired = internshipDescApplicationRequired.Default.(bool)
+	// internshipDescRatingAverage is the schema descriptor for rating_average field.
+	internshipDescRatingAverage := internshipFields[28].Descriptor()
+	// internship.DefaultRatingAverage holds the default value on creation for the rating_average field.
+	internship.DefaultRatingAverage = internshipDescRatingAverage.Default.(float64)
+	// internship.RatingAverageValidator is a validator for the "rating_average" field. It is called by the builders before save.
+	internship.RatingAverageValidator = internshipDescRatingAverage.Validators[0].(func(float64) error)
+	// internshipDescRatingCount is the schema descriptor for rating_count field.
+	internshipDescRatingCount := internshipFields[29].Descriptor()
+	// internship.DefaultRatingCount holds the default value on creation for the rating_count field.
+	internship.DefaultRatingCount = internshipDescRatingCount.Default.(int)
+	// internship.RatingCountValidator is a validator for the "rating_count" field. It is called by the builders before save.
+	internship.RatingCountValidator = internshipDescRatingCount.Validators[0].(func(int) error)
 	// internshipDescID is the schema descriptor for id field.
 	internshipDescID := internshipFields[0].Descriptor()
 	// internship.DefaultID holds the default value on creation for the id field.
 	internship.DefaultID = internshipDescID.Default.(func() string)
+	internshipapplicationMixin := schema.InternshipApplication{}.Mixin()
+	internshipapplicationMixinFields0 := internshipapplicationMixin[0].Fields()
+	_ = internshipapplicationMixinFields0
+	internshipapplicationFields := schema.InternshipApplication{}.Fields()
+	_ = internshipapplicationFields
+	// internshipapplicationDescStatus is the schema descriptor for status field.
+	internshipapplicationDescStatus := internshipapplicationMixinFields0[0].Descriptor()
+	// internshipapplication.DefaultStatus holds the default value on creation for the status field.
+	internshipapplication.DefaultStatus = internshipapplicationDescStatus.Default.(string)
+	// internshipapplicationDescCreatedAt is the schema descriptor for created_at field.
+	internshipapplicationDescCreatedAt := internshipapplicationMixinFields0[1].Descriptor()
+	// internshipapplication.DefaultCreatedAt holds the default value on creation for the created_at field.
+	internshipapplication.DefaultCreatedAt = internshipapplicationDescCreatedAt.Default.(func() time.Time)
+	// internshipapplicationDescUpdatedAt is the schema descriptor for updated_at field.
+	internshipapplicationDescUpdatedAt := internshipapplicationMixinFields0[2].Descriptor()
+	// internshipapplication.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	internshipapplication.DefaultUpdatedAt = internshipapplicationDescUpdatedAt.Default.(func() time.Time)
+	// internshipapplication.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	internshipapplication.UpdateDefaultUpdatedAt = internshipapplicationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// internshipapplicationDescInternshipID is the schema descriptor for internship_id field.
+	internshipapplicationDescInternshipID := internshipapplicationFields[1].Descriptor()
+	// internshipapplication.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	internshipapplication.InternshipIDValidator = internshipapplicationDescInternshipID.Validators[0].(func(string) error)
+	// internshipapplicationDescInternshipBatchID is the schema descriptor for internship_batch_id field.
+	internshipapplicationDescInternshipBatchID := internshipapplicationFields[2].Descriptor()
+	// internshipapplication.InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	internshipapplication.InternshipBatchIDValidator = internshipapplicationDescInternshipBatchID.Validators[0].(func(string) error)
+	// internshipapplicationDescUserID is the schema descriptor for user_id field.
+	internshipapplicationDescUserID := internshipapplicationFields[3].Descriptor()
+	// internshipapplication.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	internshipapplication.UserIDValidator = internshipapplicationDescUserID.Validators[0].(func(string) error)
+	// internshipapplicationDescAnswers is the schema descriptor for answers field.
+	internshipapplicationDescAnswers := internshipapplicationFields[4].Descriptor()
+	// internshipapplication.DefaultAnswers holds the default value on creation for the answers field.
+	internshipapplication.DefaultAnswers = internshipapplicationDescAnswers.Default.([]types.ApplicationAnswer)
+	// internshipapplicationDescApplicationStatus is the schema descriptor for application_status field.
+	internshipapplicationDescApplicationStatus := internshipapplicationFields[6].Descriptor()
+	// internshipapplication.DefaultApplicationStatus holds the default value on creation for the application_status field.
+	internshipapplication.DefaultApplicationStatus = internshipapplicationDescApplicationStatus.Default.(string)
+	// internshipapplicationDescID is the schema descriptor for id field.
+	internshipapplicationDescID := internshipapplicationFields[0].Descriptor()
+	// internshipapplication.DefaultID holds the default value on creation for the id field.
+	internshipapplication.DefaultID = internshipapplicationDescID.Default.(func() string)
 	internshipbatchMixin := schema.InternshipBatch{}.Mixin()
 	internshipbatchMixinFields0 := internshipbatchMixin[0].Fields()
 	_ = internshipbatchMixinFields0
@@ -418,13 +685,13 @@ func init() {
 	// internshipenrollment.InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
 	internshipenrollment.InternshipBatchIDValidator = internshipenrollmentDescInternshipBatchID.Validators[0].(func(string) error)
 	// internshipenrollmentDescEnrollmentStatus is the schema descriptor for enrollment_status field.
-	internshipenrollmentDescEnrollmentStatus := internshipenrollmentFields[4].Descriptor()
+	internshipenrollmentDescEnrollmentStatus := internshipenrollmentFields[5].Descriptor()
 	// internshipenrollment.DefaultEnrollmentStatus holds the default value on creation for the enrollment_status field.
 	internshipenrollment.DefaultEnrollmentStatus = types.InternshipEnrollmentStatus(internshipenrollmentDescEnrollmentStatus.Default.(string))
 	// internshipenrollment.EnrollmentStatusValidator is a validator for the "enrollment_status" field. It is called by the builders before save.
 	internshipenrollment.EnrollmentStatusValidator = internshipenrollmentDescEnrollmentStatus.Validators[0].(func(string) error)
 	// internshipenrollmentDescPaymentStatus is the schema descriptor for payment_status field.
-	internshipenrollmentDescPaymentStatus := internshipenrollmentFields[5].Descriptor()
+	internshipenrollmentDescPaymentStatus := internshipenrollmentFields[6].Descriptor()
 	// internshipenrollment.DefaultPaymentStatus holds the default value on creation for the payment_status field.
 	internshipenrollment.DefaultPaymentStatus = types.PaymentStatus(internshipenrollmentDescPaymentStatus.Default.(string))
 	// internshipenrollment.PaymentStatusValidator is a validator for the "payment_status" field. It is called by the builders before save.
@@ -433,6 +700,354 @@ func init() {
 	internshipenrollmentDescID := internshipenrollmentFields[0].Descriptor()
 	// internshipenrollment.DefaultID holds the default value on creation for the id field.
 	internshipenrollment.DefaultID = internshipenrollmentDescID.Default.(func() string)
+	internshipinstructorMixin := schema.InternshipInstructor{}.Mixin()
+	internshipinstructorMixinFields0 := internshipinstructorMixin[0].Fields()
+	_ = internshipinstructorMixinFields0
+	internshipinstructorFields := schema.InternshipInstructor{}.Fields()
+	_ = internshipinstructorFields
+	// internshipinstructorDescStatus is the schema descriptor for status field.
+	internshipinstructorDescStatus := internshipinstructorMixinFields0[0].Descriptor()
+	// internshipinstructor.DefaultStatus holds the default value on creation for the status field.
+	internshipinstructor.DefaultStatus = internshipinstructorDescStatus.Default.(string)
+	// internshipinstructorDescCreatedAt is the schema descriptor for created_at field.
+	internshipinstructorDescCreatedAt := internshipinstructorMixinFields0[1].Descriptor()
+	// internshipinstructor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	internshipinstructor.DefaultCreatedAt = internshipinstructorDescCreatedAt.Default.(func() time.Time)
+	// internshipinstructorDescUpdatedAt is the schema descriptor for updated_at field.
+	internshipinstructorDescUpdatedAt := internshipinstructorMixinFields0[2].Descriptor()
+	// internshipinstructor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	internshipinstructor.DefaultUpdatedAt = internshipinstructorDescUpdatedAt.Default.(func() time.Time)
+	// internshipinstructor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	internshipinstructor.UpdateDefaultUpdatedAt = internshipinstructorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// internshipinstructorDescInternshipID is the schema descriptor for internship_id field.
+	internshipinstructorDescInternshipID := internshipinstructorFields[1].Descriptor()
+	// internshipinstructor.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	internshipinstructor.InternshipIDValidator = internshipinstructorDescInternshipID.Validators[0].(func(string) error)
+	// internshipinstructorDescUserID is the schema descriptor for user_id field.
+	internshipinstructorDescUserID := internshipinstructorFields[2].Descriptor()
+	// internshipinstructor.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	internshipinstructor.UserIDValidator = internshipinstructorDescUserID.Validators[0].(func(string) error)
+	// internshipinstructorDescRole is the schema descriptor for role field.
+	internshipinstructorDescRole := internshipinstructorFields[3].Descriptor()
+	// internshipinstructor.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	internshipinstructor.RoleValidator = internshipinstructorDescRole.Validators[0].(func(string) error)
+	// internshipinstructorDescID is the schema descriptor for id field.
+	internshipinstructorDescID := internshipinstructorFields[0].Descriptor()
+	// internshipinstructor.DefaultID holds the default value on creation for the id field.
+	internshipinstructor.DefaultID = internshipinstructorDescID.Default.(func() string)
+	internshipreviewMixin := schema.InternshipReview{}.Mixin()
+	internshipreviewMixinFields0 := internshipreviewMixin[0].Fields()
+	_ = internshipreviewMixinFields0
+	internshipreviewFields := schema.InternshipReview{}.Fields()
+	_ = internshipreviewFields
+	// internshipreviewDescStatus is the schema descriptor for status field.
+	internshipreviewDescStatus := internshipreviewMixinFields0[0].Descriptor()
+	// internshipreview.DefaultStatus holds the default value on creation for the status field.
+	internshipreview.DefaultStatus = internshipreviewDescStatus.Default.(string)
+	// internshipreviewDescCreatedAt is the schema descriptor for created_at field.
+	internshipreviewDescCreatedAt := internshipreviewMixinFields0[1].Descriptor()
+	// internshipreview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	internshipreview.DefaultCreatedAt = internshipreviewDescCreatedAt.Default.(func() time.Time)
+	// internshipreviewDescUpdatedAt is the schema descriptor for updated_at field.
+	internshipreviewDescUpdatedAt := internshipreviewMixinFields0[2].Descriptor()
+	// internshipreview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	internshipreview.DefaultUpdatedAt = internshipreviewDescUpdatedAt.Default.(func() time.Time)
+	// internshipreview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	internshipreview.UpdateDefaultUpdatedAt = internshipreviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// internshipreviewDescInternshipID is the schema descriptor for internship_id field.
+	internshipreviewDescInternshipID := internshipreviewFields[1].Descriptor()
+	// internshipreview.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	internshipreview.InternshipIDValidator = internshipreviewDescInternshipID.Validators[0].(func(string) error)
+	// internshipreviewDescUserID is the schema descriptor for user_id field.
+	internshipreviewDescUserID := internshipreviewFields[2].Descriptor()
+	// internshipreview.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	internshipreview.UserIDValidator = internshipreviewDescUserID.Validators[0].(func(string) error)
+	// internshipreviewDescEnrollmentID is the schema descriptor for enrollment_id field.
+	internshipreviewDescEnrollmentID := internshipreviewFields[3].Descriptor()
+	// internshipreview.EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	internshipreview.EnrollmentIDValidator = internshipreviewDescEnrollmentID.Validators[0].(func(string) error)
+	// internshipreviewDescRating is the schema descriptor for rating field.
+	internshipreviewDescRating := internshipreviewFields[4].Descriptor()
+	// internshipreview.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	internshipreview.RatingValidator = internshipreviewDescRating.Validators[0].(func(int) error)
+	// internshipreviewDescReviewStatus is the schema descriptor for review_status field.
+	internshipreviewDescReviewStatus := internshipreviewFields[7].Descriptor()
+	// internshipreview.DefaultReviewStatus holds the default value on creation for the review_status field.
+	internshipreview.DefaultReviewStatus = internshipreviewDescReviewStatus.Default.(string)
+	// internshipreviewDescReports is the schema descriptor for reports field.
+	internshipreviewDescReports := internshipreviewFields[11].Descriptor()
+	// internshipreview.DefaultReports holds the default value on creation for the reports field.
+	internshipreview.DefaultReports = internshipreviewDescReports.Default.([]types.ReviewReport)
+	// internshipreviewDescReportCount is the schema descriptor for report_count field.
+	internshipreviewDescReportCount := internshipreviewFields[12].Descriptor()
+	// internshipreview.DefaultReportCount holds the default value on creation for the report_count field.
+	internshipreview.DefaultReportCount = internshipreviewDescReportCount.Default.(int)
+	// internshipreview.ReportCountValidator is a validator for the "report_count" field. It is called by the builders before save.
+	internshipreview.ReportCountValidator = internshipreviewDescReportCount.Validators[0].(func(int) error)
+	// internshipreviewDescID is the schema descriptor for id field.
+	internshipreviewDescID := internshipreviewFields[0].Descriptor()
+	// internshipreview.DefaultID holds the default value on creation for the id field.
+	internshipreview.DefaultID = internshipreviewDescID.Default.(func() string)
+	internshiprevisionMixin := schema.InternshipRevision{}.Mixin()
+	internshiprevisionMixinFields0 := internshiprevisionMixin[0].Fields()
+	_ = internshiprevisionMixinFields0
+	internshiprevisionFields := schema.InternshipRevision{}.Fields()
+	_ = internshiprevisionFields
+	// internshiprevisionDescStatus is the schema descriptor for status field.
+	internshiprevisionDescStatus := internshiprevisionMixinFields0[0].Descriptor()
+	// internshiprevision.DefaultStatus holds the default value on creation for the status field.
+	internshiprevision.DefaultStatus = internshiprevisionDescStatus.Default.(string)
+	// internshiprevisionDescCreatedAt is the schema descriptor for created_at field.
+	internshiprevisionDescCreatedAt := internshiprevisionMixinFields0[1].Descriptor()
+	// internshiprevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	internshiprevision.DefaultCreatedAt = internshiprevisionDescCreatedAt.Default.(func() time.Time)
+	// internshiprevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	internshiprevisionDescUpdatedAt := internshiprevisionMixinFields0[2].Descriptor()
+	// internshiprevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	internshiprevision.DefaultUpdatedAt = internshiprevisionDescUpdatedAt.Default.(func() time.Time)
+	// internshiprevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	internshiprevision.UpdateDefaultUpdatedAt = internshiprevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// internshiprevisionDescInternshipID is the schema descriptor for internship_id field.
+	internshiprevisionDescInternshipID := internshiprevisionFields[1].Descriptor()
+	// internshiprevision.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	internshiprevision.InternshipIDValidator = internshiprevisionDescInternshipID.Validators[0].(func(string) error)
+	// internshiprevisionDescRevision is the schema descriptor for revision field.
+	internshiprevisionDescRevision := internshiprevisionFields[2].Descriptor()
+	// internshiprevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	internshiprevision.RevisionValidator = internshiprevisionDescRevision.Validators[0].(func(int) error)
+	// internshiprevisionDescID is the schema descriptor for id field.
+	internshiprevisionDescID := internshiprevisionFields[0].Descriptor()
+	// internshiprevision.DefaultID holds the default value on creation for the id field.
+	internshiprevision.DefaultID = internshiprevisionDescID.Default.(func() string)
+	lessonMixin := schema.Lesson{}.Mixin()
+	lessonMixinFields0 := lessonMixin[0].Fields()
+	_ = lessonMixinFields0
+	lessonFields := schema.Lesson{}.Fields()
+	_ = lessonFields
+	// lessonDescStatus is the schema descriptor for status field.
+	lessonDescStatus := lessonMixinFields0[0].Descriptor()
+	// lesson.DefaultStatus holds the default value on creation for the status field.
+	lesson.DefaultStatus = lessonDescStatus.Default.(string)
+	// lessonDescCreatedAt is the schema descriptor for created_at field.
+	lessonDescCreatedAt := lessonMixinFields0[1].Descriptor()
+	// lesson.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lesson.DefaultCreatedAt = lessonDescCreatedAt.Default.(func() time.Time)
+	// lessonDescUpdatedAt is the schema descriptor for updated_at field.
+	lessonDescUpdatedAt := lessonMixinFields0[2].Descriptor()
+	// lesson.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	lesson.DefaultUpdatedAt = lessonDescUpdatedAt.Default.(func() time.Time)
+	// lesson.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	lesson.UpdateDefaultUpdatedAt = lessonDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// lessonDescInternshipID is the schema descriptor for internship_id field.
+	lessonDescInternshipID := lessonFields[1].Descriptor()
+	// lesson.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	lesson.InternshipIDValidator = lessonDescInternshipID.Validators[0].(func(string) error)
+	// lessonDescModuleID is the schema descriptor for module_id field.
+	lessonDescModuleID := lessonFields[2].Descriptor()
+	// lesson.ModuleIDValidator is a validator for the "module_id" field. It is called by the builders before save.
+	lesson.ModuleIDValidator = lessonDescModuleID.Validators[0].(func(string) error)
+	// lessonDescTitle is the schema descriptor for title field.
+	lessonDescTitle := lessonFields[3].Descriptor()
+	// lesson.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	lesson.TitleValidator = lessonDescTitle.Validators[0].(func(string) error)
+	// lessonDescLessonType is the schema descriptor for lesson_type field.
+	lessonDescLessonType := lessonFields[5].Descriptor()
+	// lesson.LessonTypeValidator is a validator for the "lesson_type" field. It is called by the builders before save.
+	lesson.LessonTypeValidator = lessonDescLessonType.Validators[0].(func(string) error)
+	// lessonDescDurationSeconds is the schema descriptor for duration_seconds field.
+	lessonDescDurationSeconds := lessonFields[9].Descriptor()
+	// lesson.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	lesson.DefaultDurationSeconds = lessonDescDurationSeconds.Default.(int)
+	// lesson.DurationSecondsValidator is a validator for the "duration_seconds" field. It is called by the builders before save.
+	lesson.DurationSecondsValidator = lessonDescDurationSeconds.Validators[0].(func(int) error)
+	// lessonDescIsPreview is the schema descriptor for is_preview field.
+	lessonDescIsPreview := lessonFields[10].Descriptor()
+	// lesson.DefaultIsPreview holds the default value on creation for the is_preview field.
+	lesson.DefaultIsPreview = lessonDescIsPreview.Default.(bool)
+	// lessonDescRequiredProgress is the schema descriptor for required_progress field.
+	lessonDescRequiredProgress := lessonFields[11].Descriptor()
+	// lesson.RequiredProgressValidator is a validator for the "required_progress" field. It is called by the builders before save.
+	lesson.RequiredProgressValidator = func() func(float64) error {
+		validators := lessonDescRequiredProgress.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(required_progress float64) error {
+			for _, fn := range fns {
+				if err := fn(required_progress); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// lessonDescSortOrder is the schema descriptor for sort_order field.
+	lessonDescSortOrder := lessonFields[12].Descriptor()
+	// lesson.DefaultSortOrder holds the default value on creation for the sort_order field.
+	lesson.DefaultSortOrder = lessonDescSortOrder.Default.(int)
+	// lessonDescID is the schema descriptor for id field.
+	lessonDescID := lessonFields[0].Descriptor()
+	// lesson.DefaultID holds the default value on creation for the id field.
+	lesson.DefaultID = lessonDescID.Default.(func() string)
+	lessonprogressMixin := schema.LessonProgress{}.Mixin()
+	lessonprogressMixinFields0 := lessonprogressMixin[0].Fields()
+	_ = lessonprogressMixinFields0
+	lessonprogressFields := schema.LessonProgress{}.Fields()
+	_ = lessonprogressFields
+	// lessonprogressDescStatus is the schema descriptor for status field.
+	lessonprogressDescStatus := lessonprogressMixinFields0[0].Descriptor()
+	// lessonprogress.DefaultStatus holds the default value on creation for the status field.
+	lessonprogress.DefaultStatus = lessonprogressDescStatus.Default.(string)
+	// lessonprogressDescCreatedAt is the schema descriptor for created_at field.
+	lessonprogressDescCreatedAt := lessonprogressMixinFields0[1].Descriptor()
+	// lessonprogress.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lessonprogress.DefaultCreatedAt = lessonprogressDescCreatedAt.Default.(func() time.Time)
+	// lessonprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	lessonprogressDescUpdatedAt := lessonprogressMixinFields0[2].Descriptor()
+	// lessonprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	lessonprogress.DefaultUpdatedAt = lessonprogressDescUpdatedAt.Default.(func() time.Time)
+	// lessonprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	lessonprogress.UpdateDefaultUpdatedAt = lessonprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// lessonprogressDescUserID is the schema descriptor for user_id field.
+	lessonprogressDescUserID := lessonprogressFields[1].Descriptor()
+	// lessonprogress.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	lessonprogress.UserIDValidator = lessonprogressDescUserID.Validators[0].(func(string) error)
+	// lessonprogressDescInternshipID is the schema descriptor for internship_id field.
+	lessonprogressDescInternshipID := lessonprogressFields[2].Descriptor()
+	// lessonprogress.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	lessonprogress.InternshipIDValidator = lessonprogressDescInternshipID.Validators[0].(func(string) error)
+	// lessonprogressDescLessonID is the schema descriptor for lesson_id field.
+	lessonprogressDescLessonID := lessonprogressFields[3].Descriptor()
+	// lessonprogress.LessonIDValidator is a validator for the "lesson_id" field. It is called by the builders before save.
+	lessonprogress.LessonIDValidator = lessonprogressDescLessonID.Validators[0].(func(string) error)
+	// lessonprogressDescPositionSeconds is the schema descriptor for position_seconds field.
+	lessonprogressDescPositionSeconds := lessonprogressFields[4].Descriptor()
+	// lessonprogress.DefaultPositionSeconds holds the default value on creation for the position_seconds field.
+	lessonprogress.DefaultPositionSeconds = lessonprogressDescPositionSeconds.Default.(int)
+	// lessonprogress.PositionSecondsValidator is a validator for the "position_seconds" field. It is called by the builders before save.
+	lessonprogress.PositionSecondsValidator = lessonprogressDescPositionSeconds.Validators[0].(func(int) error)
+	// lessonprogressDescID is the schema descriptor for id field.
+	lessonprogressDescID := lessonprogressFields[0].Descriptor()
+	// lessonprogress.DefaultID holds the default value on creation for the id field.
+	lessonprogress.DefaultID = lessonprogressDescID.Default.(func() string)
+	livesessionMixin := schema.LiveSession{}.Mixin()
+	livesessionMixinFields0 := livesessionMixin[0].Fields()
+	_ = livesessionMixinFields0
+	livesessionFields := schema.LiveSession{}.Fields()
+	_ = livesessionFields
+	// livesessionDescStatus is the schema descriptor for status field.
+	livesessionDescStatus := livesessionMixinFields0[0].Descriptor()
+	// livesession.DefaultStatus holds the default value on creation for the status field.
+	livesession.DefaultStatus = livesessionDescStatus.Default.(string)
+	// livesessionDescCreatedAt is the schema descriptor for created_at field.
+	livesessionDescCreatedAt := livesessionMixinFields0[1].Descriptor()
+	// livesession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	livesession.DefaultCreatedAt = livesessionDescCreatedAt.Default.(func() time.Time)
+	// livesessionDescUpdatedAt is the schema descriptor for updated_at field.
+	livesessionDescUpdatedAt := livesessionMixinFields0[2].Descriptor()
+	// livesession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	livesession.DefaultUpdatedAt = livesessionDescUpdatedAt.Default.(func() time.Time)
+	// livesession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	livesession.UpdateDefaultUpdatedAt = livesessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// livesessionDescInternshipID is the schema descriptor for internship_id field.
+	livesessionDescInternshipID := livesessionFields[1].Descriptor()
+	// livesession.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	livesession.InternshipIDValidator = livesessionDescInternshipID.Validators[0].(func(string) error)
+	// livesessionDescInternshipBatchID is the schema descriptor for internship_batch_id field.
+	livesessionDescInternshipBatchID := livesessionFields[2].Descriptor()
+	// livesession.InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	livesession.InternshipBatchIDValidator = livesessionDescInternshipBatchID.Validators[0].(func(string) error)
+	// livesessionDescTitle is the schema descriptor for title field.
+	livesessionDescTitle := livesessionFields[3].Descriptor()
+	// livesession.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	livesession.TitleValidator = livesessionDescTitle.Validators[0].(func(string) error)
+	// livesessionDescSessionStatus is the schema descriptor for session_status field.
+	livesessionDescSessionStatus := livesessionFields[10].Descriptor()
+	// livesession.DefaultSessionStatus holds the default value on creation for the session_status field.
+	livesession.DefaultSessionStatus = livesessionDescSessionStatus.Default.(string)
+	// livesessionDescID is the schema descriptor for id field.
+	livesessionDescID := livesessionFields[0].Descriptor()
+	// livesession.DefaultID holds the default value on creation for the id field.
+	livesession.DefaultID = livesessionDescID.Default.(func() string)
+	moduleMixin := schema.Module{}.Mixin()
+	moduleMixinFields0 := moduleMixin[0].Fields()
+	_ = moduleMixinFields0
+	moduleFields := schema.Module{}.Fields()
+	_ = moduleFields
+	// moduleDescStatus is the schema descriptor for status field.
+	moduleDescStatus := moduleMixinFields0[0].Descriptor()
+	// module.DefaultStatus holds the default value on creation for the status field.
+	module.DefaultStatus = moduleDescStatus.Default.(string)
+	// moduleDescCreatedAt is the schema descriptor for created_at field.
+	moduleDescCreatedAt := moduleMixinFields0[1].Descriptor()
+	// module.DefaultCreatedAt holds the default value on creation for the created_at field.
+	module.DefaultCreatedAt = moduleDescCreatedAt.Default.(func() time.Time)
+	// moduleDescUpdatedAt is the schema descriptor for updated_at field.
+	moduleDescUpdatedAt := moduleMixinFields0[2].Descriptor()
+	// module.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	module.DefaultUpdatedAt = moduleDescUpdatedAt.Default.(func() time.Time)
+	// module.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	module.UpdateDefaultUpdatedAt = moduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// moduleDescInternshipID is the schema descriptor for internship_id field.
+	moduleDescInternshipID := moduleFields[1].Descriptor()
+	// module.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	module.InternshipIDValidator = moduleDescInternshipID.Validators[0].(func(string) error)
+	// moduleDescTitle is the schema descriptor for title field.
+	moduleDescTitle := moduleFields[2].Descriptor()
+	// module.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	module.TitleValidator = moduleDescTitle.Validators[0].(func(string) error)
+	// moduleDescSortOrder is the schema descriptor for sort_order field.
+	moduleDescSortOrder := moduleFields[4].Descriptor()
+	// module.DefaultSortOrder holds the default value on creation for the sort_order field.
+	module.DefaultSortOrder = moduleDescSortOrder.Default.(int)
+	// moduleDescID is the schema descriptor for id field.
+	moduleDescID := moduleFields[0].Descriptor()
+	// module.DefaultID holds the default value on creation for the id field.
+	module.DefaultID = moduleDescID.Default.(func() string)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescStatus is the schema descriptor for status field.
+	notificationDescStatus := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultStatus holds the default value on creation for the status field.
+	notification.DefaultStatus = notificationDescStatus.Default.(string)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields0[1].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationMixinFields0[2].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationDescUserID is the schema descriptor for user_id field.
+	notificationDescUserID := notificationFields[1].Descriptor()
+	// notification.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notification.UserIDValidator = notificationDescUserID.Validators[0].(func(string) error)
+	// notificationDescEventID is the schema descriptor for event_id field.
+	notificationDescEventID := notificationFields[2].Descriptor()
+	// notification.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	notification.EventIDValidator = notificationDescEventID.Validators[0].(func(string) error)
+	// notificationDescEventName is the schema descriptor for event_name field.
+	notificationDescEventName := notificationFields[3].Descriptor()
+	// notification.EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	notification.EventNameValidator = notificationDescEventName.Validators[0].(func(string) error)
+	// notificationDescCategory is the schema descriptor for category field.
+	notificationDescCategory := notificationFields[4].Descriptor()
+	// notification.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	notification.CategoryValidator = notificationDescCategory.Validators[0].(func(string) error)
+	// notificationDescTitle is the schema descriptor for title field.
+	notificationDescTitle := notificationFields[5].Descriptor()
+	// notification.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notification.TitleValidator = notificationDescTitle.Validators[0].(func(string) error)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() string)
 	paymentMixin := schema.Payment{}.Mixin()
 	paymentMixinFields0 := paymentMixin[0].Fields()
 	_ = paymentMixinFields0
@@ -507,6 +1122,557 @@ func init() {
 	paymentattempt.DefaultAttemptNumber = paymentattemptDescAttemptNumber.Default.(int)
 	// paymentattempt.AttemptNumberValidator is a validator for the "attempt_number" field. It is called by the builders before save.
 	paymentattempt.AttemptNumberValidator = paymentattemptDescAttemptNumber.Validators[0].(func(int) error)
+	paymentplanMixin := schema.PaymentPlan{}.Mixin()
+	paymentplanMixinFields0 := paymentplanMixin[0].Fields()
+	_ = paymentplanMixinFields0
+	paymentplanMixinFields1 := paymentplanMixin[1].Fields()
+	_ = paymentplanMixinFields1
+	paymentplanFields := schema.PaymentPlan{}.Fields()
+	_ = paymentplanFields
+	// paymentplanDescStatus is the schema descriptor for status field.
+	paymentplanDescStatus := paymentplanMixinFields0[0].Descriptor()
+	// paymentplan.DefaultStatus holds the default value on creation for the status field.
+	paymentplan.DefaultStatus = paymentplanDescStatus.Default.(string)
+	// paymentplanDescCreatedAt is the schema descriptor for created_at field.
+	paymentplanDescCreatedAt := paymentplanMixinFields0[1].Descriptor()
+	// paymentplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentplan.DefaultCreatedAt = paymentplanDescCreatedAt.Default.(func() time.Time)
+	// paymentplanDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentplanDescUpdatedAt := paymentplanMixinFields0[2].Descriptor()
+	// paymentplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentplan.DefaultUpdatedAt = paymentplanDescUpdatedAt.Default.(func() time.Time)
+	// paymentplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentplan.UpdateDefaultUpdatedAt = paymentplanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentplanDescMetadata is the schema descriptor for metadata field.
+	paymentplanDescMetadata := paymentplanMixinFields1[0].Descriptor()
+	// paymentplan.DefaultMetadata holds the default value on creation for the metadata field.
+	paymentplan.DefaultMetadata = paymentplanDescMetadata.Default.(map[string]string)
+	// paymentplanDescInternshipID is the schema descriptor for internship_id field.
+	paymentplanDescInternshipID := paymentplanFields[1].Descriptor()
+	// paymentplan.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	paymentplan.InternshipIDValidator = paymentplanDescInternshipID.Validators[0].(func(string) error)
+	// paymentplanDescName is the schema descriptor for name field.
+	paymentplanDescName := paymentplanFields[2].Descriptor()
+	// paymentplan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	paymentplan.NameValidator = paymentplanDescName.Validators[0].(func(string) error)
+	// paymentplanDescGracePeriodDays is the schema descriptor for grace_period_days field.
+	paymentplanDescGracePeriodDays := paymentplanFields[5].Descriptor()
+	// paymentplan.DefaultGracePeriodDays holds the default value on creation for the grace_period_days field.
+	paymentplan.DefaultGracePeriodDays = paymentplanDescGracePeriodDays.Default.(int)
+	// paymentplan.GracePeriodDaysValidator is a validator for the "grace_period_days" field. It is called by the builders before save.
+	paymentplan.GracePeriodDaysValidator = paymentplanDescGracePeriodDays.Validators[0].(func(int) error)
+	// paymentplanDescIsActive is the schema descriptor for is_active field.
+	paymentplanDescIsActive := paymentplanFields[6].Descriptor()
+	// paymentplan.DefaultIsActive holds the default value on creation for the is_active field.
+	paymentplan.DefaultIsActive = paymentplanDescIsActive.Default.(bool)
+	// paymentplanDescID is the schema descriptor for id field.
+	paymentplanDescID := paymentplanFields[0].Descriptor()
+	// paymentplan.DefaultID holds the default value on creation for the id field.
+	paymentplan.DefaultID = paymentplanDescID.Default.(func() string)
+	paymentrefundMixin := schema.PaymentRefund{}.Mixin()
+	paymentrefundMixinFields0 := paymentrefundMixin[0].Fields()
+	_ = paymentrefundMixinFields0
+	paymentrefundFields := schema.PaymentRefund{}.Fields()
+	_ = paymentrefundFields
+	// paymentrefundDescStatus is the schema descriptor for status field.
+	paymentrefundDescStatus := paymentrefundMixinFields0[0].Descriptor()
+	// paymentrefund.DefaultStatus holds the default value on creation for the status field.
+	paymentrefund.DefaultStatus = paymentrefundDescStatus.Default.(string)
+	// paymentrefundDescCreatedAt is the schema descriptor for created_at field.
+	paymentrefundDescCreatedAt := paymentrefundMixinFields0[1].Descriptor()
+	// paymentrefund.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrefund.DefaultCreatedAt = paymentrefundDescCreatedAt.Default.(func() time.Time)
+	// paymentrefundDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentrefundDescUpdatedAt := paymentrefundMixinFields0[2].Descriptor()
+	// paymentrefund.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentrefund.DefaultUpdatedAt = paymentrefundDescUpdatedAt.Default.(func() time.Time)
+	// paymentrefund.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentrefund.UpdateDefaultUpdatedAt = paymentrefundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentrefundDescPaymentID is the schema descriptor for payment_id field.
+	paymentrefundDescPaymentID := paymentrefundFields[1].Descriptor()
+	// paymentrefund.PaymentIDValidator is a validator for the "payment_id" field. It is called by the builders before save.
+	paymentrefund.PaymentIDValidator = paymentrefundDescPaymentID.Validators[0].(func(string) error)
+	// paymentrefundDescPaymentGatewayProvider is the schema descriptor for payment_gateway_provider field.
+	paymentrefundDescPaymentGatewayProvider := paymentrefundFields[2].Descriptor()
+	// paymentrefund.PaymentGatewayProviderValidator is a validator for the "payment_gateway_provider" field. It is called by the builders before save.
+	paymentrefund.PaymentGatewayProviderValidator = paymentrefundDescPaymentGatewayProvider.Validators[0].(func(string) error)
+	// paymentrefundDescGatewayPaymentID is the schema descriptor for gateway_payment_id field.
+	paymentrefundDescGatewayPaymentID := paymentrefundFields[3].Descriptor()
+	// paymentrefund.GatewayPaymentIDValidator is a validator for the "gateway_payment_id" field. It is called by the builders before save.
+	paymentrefund.GatewayPaymentIDValidator = paymentrefundDescGatewayPaymentID.Validators[0].(func(string) error)
+	// paymentrefundDescCurrency is the schema descriptor for currency field.
+	paymentrefundDescCurrency := paymentrefundFields[5].Descriptor()
+	// paymentrefund.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	paymentrefund.CurrencyValidator = paymentrefundDescCurrency.Validators[0].(func(string) error)
+	// paymentrefundDescRefundStatus is the schema descriptor for refund_status field.
+	paymentrefundDescRefundStatus := paymentrefundFields[7].Descriptor()
+	// paymentrefund.DefaultRefundStatus holds the default value on creation for the refund_status field.
+	paymentrefund.DefaultRefundStatus = types.PaymentRefundStatus(paymentrefundDescRefundStatus.Default.(string))
+	quizMixin := schema.Quiz{}.Mixin()
+	quizMixinFields0 := quizMixin[0].Fields()
+	_ = quizMixinFields0
+	quizFields := schema.Quiz{}.Fields()
+	_ = quizFields
+	// quizDescStatus is the schema descriptor for status field.
+	quizDescStatus := quizMixinFields0[0].Descriptor()
+	// quiz.DefaultStatus holds the default value on creation for the status field.
+	quiz.DefaultStatus = quizDescStatus.Default.(string)
+	// quizDescCreatedAt is the schema descriptor for created_at field.
+	quizDescCreatedAt := quizMixinFields0[1].Descriptor()
+	// quiz.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quiz.DefaultCreatedAt = quizDescCreatedAt.Default.(func() time.Time)
+	// quizDescUpdatedAt is the schema descriptor for updated_at field.
+	quizDescUpdatedAt := quizMixinFields0[2].Descriptor()
+	// quiz.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quiz.DefaultUpdatedAt = quizDescUpdatedAt.Default.(func() time.Time)
+	// quiz.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quiz.UpdateDefaultUpdatedAt = quizDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quizDescInternshipID is the schema descriptor for internship_id field.
+	quizDescInternshipID := quizFields[1].Descriptor()
+	// quiz.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	quiz.InternshipIDValidator = quizDescInternshipID.Validators[0].(func(string) error)
+	// quizDescModuleID is the schema descriptor for module_id field.
+	quizDescModuleID := quizFields[2].Descriptor()
+	// quiz.ModuleIDValidator is a validator for the "module_id" field. It is called by the builders before save.
+	quiz.ModuleIDValidator = quizDescModuleID.Validators[0].(func(string) error)
+	// quizDescTitle is the schema descriptor for title field.
+	quizDescTitle := quizFields[3].Descriptor()
+	// quiz.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	quiz.TitleValidator = quizDescTitle.Validators[0].(func(string) error)
+	// quizDescQuestionsPerAttempt is the schema descriptor for questions_per_attempt field.
+	quizDescQuestionsPerAttempt := quizFields[6].Descriptor()
+	// quiz.QuestionsPerAttemptValidator is a validator for the "questions_per_attempt" field. It is called by the builders before save.
+	quiz.QuestionsPerAttemptValidator = quizDescQuestionsPerAttempt.Validators[0].(func(int) error)
+	// quizDescShuffleQuestions is the schema descriptor for shuffle_questions field.
+	quizDescShuffleQuestions := quizFields[7].Descriptor()
+	// quiz.DefaultShuffleQuestions holds the default value on creation for the shuffle_questions field.
+	quiz.DefaultShuffleQuestions = quizDescShuffleQuestions.Default.(bool)
+	// quizDescTimeLimitMinutes is the schema descriptor for time_limit_minutes field.
+	quizDescTimeLimitMinutes := quizFields[8].Descriptor()
+	// quiz.TimeLimitMinutesValidator is a validator for the "time_limit_minutes" field. It is called by the builders before save.
+	quiz.TimeLimitMinutesValidator = quizDescTimeLimitMinutes.Validators[0].(func(int) error)
+	// quizDescMaxAttempts is the schema descriptor for max_attempts field.
+	quizDescMaxAttempts := quizFields[9].Descriptor()
+	// quiz.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	quiz.MaxAttemptsValidator = quizDescMaxAttempts.Validators[0].(func(int) error)
+	// quizDescPassingPercent is the schema descriptor for passing_percent field.
+	quizDescPassingPercent := quizFields[10].Descriptor()
+	// quiz.PassingPercentValidator is a validator for the "passing_percent" field. It is called by the builders before save.
+	quiz.PassingPercentValidator = func() func(int) error {
+		validators := quizDescPassingPercent.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(passing_percent int) error {
+			for _, fn := range fns {
+				if err := fn(passing_percent); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// quizDescSortOrder is the schema descriptor for sort_order field.
+	quizDescSortOrder := quizFields[11].Descriptor()
+	// quiz.DefaultSortOrder holds the default value on creation for the sort_order field.
+	quiz.DefaultSortOrder = quizDescSortOrder.Default.(int)
+	// quizDescID is the schema descriptor for id field.
+	quizDescID := quizFields[0].Descriptor()
+	// quiz.DefaultID holds the default value on creation for the id field.
+	quiz.DefaultID = quizDescID.Default.(func() string)
+	quizattemptMixin := schema.QuizAttempt{}.Mixin()
+	quizattemptMixinFields0 := quizattemptMixin[0].Fields()
+	_ = quizattemptMixinFields0
+	quizattemptFields := schema.QuizAttempt{}.Fields()
+	_ = quizattemptFields
+	// quizattemptDescStatus is the schema descriptor for status field.
+	quizattemptDescStatus := quizattemptMixinFields0[0].Descriptor()
+	// quizattempt.DefaultStatus holds the default value on creation for the status field.
+	quizattempt.DefaultStatus = quizattemptDescStatus.Default.(string)
+	// quizattemptDescCreatedAt is the schema descriptor for created_at field.
+	quizattemptDescCreatedAt := quizattemptMixinFields0[1].Descriptor()
+	// quizattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quizattempt.DefaultCreatedAt = quizattemptDescCreatedAt.Default.(func() time.Time)
+	// quizattemptDescUpdatedAt is the schema descriptor for updated_at field.
+	quizattemptDescUpdatedAt := quizattemptMixinFields0[2].Descriptor()
+	// quizattempt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quizattempt.DefaultUpdatedAt = quizattemptDescUpdatedAt.Default.(func() time.Time)
+	// quizattempt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quizattempt.UpdateDefaultUpdatedAt = quizattemptDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quizattemptDescQuizID is the schema descriptor for quiz_id field.
+	quizattemptDescQuizID := quizattemptFields[1].Descriptor()
+	// quizattempt.QuizIDValidator is a validator for the "quiz_id" field. It is called by the builders before save.
+	quizattempt.QuizIDValidator = quizattemptDescQuizID.Validators[0].(func(string) error)
+	// quizattemptDescInternshipID is the schema descriptor for internship_id field.
+	quizattemptDescInternshipID := quizattemptFields[2].Descriptor()
+	// quizattempt.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	quizattempt.InternshipIDValidator = quizattemptDescInternshipID.Validators[0].(func(string) error)
+	// quizattemptDescEnrollmentID is the schema descriptor for enrollment_id field.
+	quizattemptDescEnrollmentID := quizattemptFields[3].Descriptor()
+	// quizattempt.EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	quizattempt.EnrollmentIDValidator = quizattemptDescEnrollmentID.Validators[0].(func(string) error)
+	// quizattemptDescUserID is the schema descriptor for user_id field.
+	quizattemptDescUserID := quizattemptFields[4].Descriptor()
+	// quizattempt.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	quizattempt.UserIDValidator = quizattemptDescUserID.Validators[0].(func(string) error)
+	// quizattemptDescAttempt is the schema descriptor for attempt field.
+	quizattemptDescAttempt := quizattemptFields[5].Descriptor()
+	// quizattempt.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	quizattempt.AttemptValidator = quizattemptDescAttempt.Validators[0].(func(int) error)
+	// quizattemptDescAttemptStatus is the schema descriptor for attempt_status field.
+	quizattemptDescAttemptStatus := quizattemptFields[10].Descriptor()
+	// quizattempt.DefaultAttemptStatus holds the default value on creation for the attempt_status field.
+	quizattempt.DefaultAttemptStatus = quizattemptDescAttemptStatus.Default.(string)
+	// quizattemptDescMaxScore is the schema descriptor for max_score field.
+	quizattemptDescMaxScore := quizattemptFields[14].Descriptor()
+	// quizattempt.DefaultMaxScore holds the default value on creation for the max_score field.
+	quizattempt.DefaultMaxScore = quizattemptDescMaxScore.Default.(float64)
+	// quizattemptDescID is the schema descriptor for id field.
+	quizattemptDescID := quizattemptFields[0].Descriptor()
+	// quizattempt.DefaultID holds the default value on creation for the id field.
+	quizattempt.DefaultID = quizattemptDescID.Default.(func() string)
+	referralMixin := schema.Referral{}.Mixin()
+	referralMixinFields0 := referralMixin[0].Fields()
+	_ = referralMixinFields0
+	referralMixinFields1 := referralMixin[1].Fields()
+	_ = referralMixinFields1
+	referralFields := schema.Referral{}.Fields()
+	_ = referralFields
+	// referralDescStatus is the schema descriptor for status field.
+	referralDescStatus := referralMixinFields0[0].Descriptor()
+	// referral.DefaultStatus holds the default value on creation for the status field.
+	referral.DefaultStatus = referralDescStatus.Default.(string)
+	// referralDescCreatedAt is the schema descriptor for created_at field.
+	referralDescCreatedAt := referralMixinFields0[1].Descriptor()
+	// referral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referral.DefaultCreatedAt = referralDescCreatedAt.Default.(func() time.Time)
+	// referralDescUpdatedAt is the schema descriptor for updated_at field.
+	referralDescUpdatedAt := referralMixinFields0[2].Descriptor()
+	// referral.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	referral.DefaultUpdatedAt = referralDescUpdatedAt.Default.(func() time.Time)
+	// referral.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	referral.UpdateDefaultUpdatedAt = referralDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// referralDescMetadata is the schema descriptor for metadata field.
+	referralDescMetadata := referralMixinFields1[0].Descriptor()
+	// referral.DefaultMetadata holds the default value on creation for the metadata field.
+	referral.DefaultMetadata = referralDescMetadata.Default.(map[string]string)
+	// referralDescReferrerID is the schema descriptor for referrer_id field.
+	referralDescReferrerID := referralFields[1].Descriptor()
+	// referral.ReferrerIDValidator is a validator for the "referrer_id" field. It is called by the builders before save.
+	referral.ReferrerIDValidator = referralDescReferrerID.Validators[0].(func(string) error)
+	// referralDescRefereeID is the schema descriptor for referee_id field.
+	referralDescRefereeID := referralFields[2].Descriptor()
+	// referral.RefereeIDValidator is a validator for the "referee_id" field. It is called by the builders before save.
+	referral.RefereeIDValidator = referralDescRefereeID.Validators[0].(func(string) error)
+	// referralDescReferralCode is the schema descriptor for referral_code field.
+	referralDescReferralCode := referralFields[3].Descriptor()
+	// referral.ReferralCodeValidator is a validator for the "referral_code" field. It is called by the builders before save.
+	referral.ReferralCodeValidator = referralDescReferralCode.Validators[0].(func(string) error)
+	// referralDescReferralStatus is the schema descriptor for referral_status field.
+	referralDescReferralStatus := referralFields[4].Descriptor()
+	// referral.DefaultReferralStatus holds the default value on creation for the referral_status field.
+	referral.DefaultReferralStatus = types.ReferralStatus(referralDescReferralStatus.Default.(string))
+	// referral.ReferralStatusValidator is a validator for the "referral_status" field. It is called by the builders before save.
+	referral.ReferralStatusValidator = referralDescReferralStatus.Validators[0].(func(string) error)
+	// referralDescRefereeEmail is the schema descriptor for referee_email field.
+	referralDescRefereeEmail := referralFields[6].Descriptor()
+	// referral.RefereeEmailValidator is a validator for the "referee_email" field. It is called by the builders before save.
+	referral.RefereeEmailValidator = referralDescRefereeEmail.Validators[0].(func(string) error)
+	// referralDescID is the schema descriptor for id field.
+	referralDescID := referralFields[0].Descriptor()
+	// referral.DefaultID holds the default value on creation for the id field.
+	referral.DefaultID = referralDescID.Default.(func() string)
+	resourceMixin := schema.Resource{}.Mixin()
+	resourceMixinFields0 := resourceMixin[0].Fields()
+	_ = resourceMixinFields0
+	resourceFields := schema.Resource{}.Fields()
+	_ = resourceFields
+	// resourceDescStatus is the schema descriptor for status field.
+	resourceDescStatus := resourceMixinFields0[0].Descriptor()
+	// resource.DefaultStatus holds the default value on creation for the status field.
+	resource.DefaultStatus = resourceDescStatus.Default.(string)
+	// resourceDescCreatedAt is the schema descriptor for created_at field.
+	resourceDescCreatedAt := resourceMixinFields0[1].Descriptor()
+	// resource.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resource.DefaultCreatedAt = resourceDescCreatedAt.Default.(func() time.Time)
+	// resourceDescUpdatedAt is the schema descriptor for updated_at field.
+	resourceDescUpdatedAt := resourceMixinFields0[2].Descriptor()
+	// resource.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	resource.DefaultUpdatedAt = resourceDescUpdatedAt.Default.(func() time.Time)
+	// resource.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	resource.UpdateDefaultUpdatedAt = resourceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resourceDescInternshipID is the schema descriptor for internship_id field.
+	resourceDescInternshipID := resourceFields[1].Descriptor()
+	// resource.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	resource.InternshipIDValidator = resourceDescInternshipID.Validators[0].(func(string) error)
+	// resourceDescTitle is the schema descriptor for title field.
+	resourceDescTitle := resourceFields[4].Descriptor()
+	// resource.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	resource.TitleValidator = resourceDescTitle.Validators[0].(func(string) error)
+	// resourceDescFileID is the schema descriptor for file_id field.
+	resourceDescFileID := resourceFields[6].Descriptor()
+	// resource.FileIDValidator is a validator for the "file_id" field. It is called by the builders before save.
+	resource.FileIDValidator = resourceDescFileID.Validators[0].(func(string) error)
+	// resourceDescSortOrder is the schema descriptor for sort_order field.
+	resourceDescSortOrder := resourceFields[7].Descriptor()
+	// resource.DefaultSortOrder holds the default value on creation for the sort_order field.
+	resource.DefaultSortOrder = resourceDescSortOrder.Default.(int)
+	// resourceDescID is the schema descriptor for id field.
+	resourceDescID := resourceFields[0].Descriptor()
+	// resource.DefaultID holds the default value on creation for the id field.
+	resource.DefaultID = resourceDescID.Default.(func() string)
+	sessionattendanceMixin := schema.SessionAttendance{}.Mixin()
+	sessionattendanceMixinFields0 := sessionattendanceMixin[0].Fields()
+	_ = sessionattendanceMixinFields0
+	sessionattendanceFields := schema.SessionAttendance{}.Fields()
+	_ = sessionattendanceFields
+	// sessionattendanceDescStatus is the schema descriptor for status field.
+	sessionattendanceDescStatus := sessionattendanceMixinFields0[0].Descriptor()
+	// sessionattendance.DefaultStatus holds the default value on creation for the status field.
+	sessionattendance.DefaultStatus = sessionattendanceDescStatus.Default.(string)
+	// sessionattendanceDescCreatedAt is the schema descriptor for created_at field.
+	sessionattendanceDescCreatedAt := sessionattendanceMixinFields0[1].Descriptor()
+	// sessionattendance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sessionattendance.DefaultCreatedAt = sessionattendanceDescCreatedAt.Default.(func() time.Time)
+	// sessionattendanceDescUpdatedAt is the schema descriptor for updated_at field.
+	sessionattendanceDescUpdatedAt := sessionattendanceMixinFields0[2].Descriptor()
+	// sessionattendance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sessionattendance.DefaultUpdatedAt = sessionattendanceDescUpdatedAt.Default.(func() time.Time)
+	// sessionattendance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sessionattendance.UpdateDefaultUpdatedAt = sessionattendanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sessionattendanceDescLiveSessionID is the schema descriptor for live_session_id field.
+	sessionattendanceDescLiveSessionID := sessionattendanceFields[1].Descriptor()
+	// sessionattendance.LiveSessionIDValidator is a validator for the "live_session_id" field. It is called by the builders before save.
+	sessionattendance.LiveSessionIDValidator = sessionattendanceDescLiveSessionID.Validators[0].(func(string) error)
+	// sessionattendanceDescInternshipBatchID is the schema descriptor for internship_batch_id field.
+	sessionattendanceDescInternshipBatchID := sessionattendanceFields[2].Descriptor()
+	// sessionattendance.InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	sessionattendance.InternshipBatchIDValidator = sessionattendanceDescInternshipBatchID.Validators[0].(func(string) error)
+	// sessionattendanceDescEnrollmentID is the schema descriptor for enrollment_id field.
+	sessionattendanceDescEnrollmentID := sessionattendanceFields[3].Descriptor()
+	// sessionattendance.EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	sessionattendance.EnrollmentIDValidator = sessionattendanceDescEnrollmentID.Validators[0].(func(string) error)
+	// sessionattendanceDescUserID is the schema descriptor for user_id field.
+	sessionattendanceDescUserID := sessionattendanceFields[4].Descriptor()
+	// sessionattendance.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	sessionattendance.UserIDValidator = sessionattendanceDescUserID.Validators[0].(func(string) error)
+	// sessionattendanceDescAttendanceStatus is the schema descriptor for attendance_status field.
+	sessionattendanceDescAttendanceStatus := sessionattendanceFields[5].Descriptor()
+	// sessionattendance.DefaultAttendanceStatus holds the default value on creation for the attendance_status field.
+	sessionattendance.DefaultAttendanceStatus = sessionattendanceDescAttendanceStatus.Default.(string)
+	// sessionattendanceDescDurationSeconds is the schema descriptor for duration_seconds field.
+	sessionattendanceDescDurationSeconds := sessionattendanceFields[9].Descriptor()
+	// sessionattendance.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	sessionattendance.DefaultDurationSeconds = sessionattendanceDescDurationSeconds.Default.(int)
+	// sessionattendanceDescID is the schema descriptor for id field.
+	sessionattendanceDescID := sessionattendanceFields[0].Descriptor()
+	// sessionattendance.DefaultID holds the default value on creation for the id field.
+	sessionattendance.DefaultID = sessionattendanceDescID.Default.(func() string)
+	submissionMixin := schema.Submission{}.Mixin()
+	submissionMixinFields0 := submissionMixin[0].Fields()
+	_ = submissionMixinFields0
+	submissionFields := schema.Submission{}.Fields()
+	_ = submissionFields
+	// submissionDescStatus is the schema descriptor for status field.
+	submissionDescStatus := submissionMixinFields0[0].Descriptor()
+	// submission.DefaultStatus holds the default value on creation for the status field.
+	submission.DefaultStatus = submissionDescStatus.Default.(string)
+	// submissionDescCreatedAt is the schema descriptor for created_at field.
+	submissionDescCreatedAt := submissionMixinFields0[1].Descriptor()
+	// submission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	submission.DefaultCreatedAt = submissionDescCreatedAt.Default.(func() time.Time)
+	// submissionDescUpdatedAt is the schema descriptor for updated_at field.
+	submissionDescUpdatedAt := submissionMixinFields0[2].Descriptor()
+	// submission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	submission.DefaultUpdatedAt = submissionDescUpdatedAt.Default.(func() time.Time)
+	// submission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	submission.UpdateDefaultUpdatedAt = submissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// submissionDescInternshipID is the schema descriptor for internship_id field.
+	submissionDescInternshipID := submissionFields[1].Descriptor()
+	// submission.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	submission.InternshipIDValidator = submissionDescInternshipID.Validators[0].(func(string) error)
+	// submissionDescAssignmentID is the schema descriptor for assignment_id field.
+	submissionDescAssignmentID := submissionFields[2].Descriptor()
+	// submission.AssignmentIDValidator is a validator for the "assignment_id" field. It is called by the builders before save.
+	submission.AssignmentIDValidator = submissionDescAssignmentID.Validators[0].(func(string) error)
+	// submissionDescEnrollmentID is the schema descriptor for enrollment_id field.
+	submissionDescEnrollmentID := submissionFields[3].Descriptor()
+	// submission.EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	submission.EnrollmentIDValidator = submissionDescEnrollmentID.Validators[0].(func(string) error)
+	// submissionDescUserID is the schema descriptor for user_id field.
+	submissionDescUserID := submissionFields[4].Descriptor()
+	// submission.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	submission.UserIDValidator = submissionDescUserID.Validators[0].(func(string) error)
+	// submissionDescAttempt is the schema descriptor for attempt field.
+	submissionDescAttempt := submissionFields[5].Descriptor()
+	// submission.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	submission.AttemptValidator = submissionDescAttempt.Validators[0].(func(int) error)
+	// submissionDescSubmissionType is the schema descriptor for submission_type field.
+	submissionDescSubmissionType := submissionFields[6].Descriptor()
+	// submission.SubmissionTypeValidator is a validator for the "submission_type" field. It is called by the builders before save.
+	submission.SubmissionTypeValidator = submissionDescSubmissionType.Validators[0].(func(string) error)
+	// submissionDescLateDays is the schema descriptor for late_days field.
+	submissionDescLateDays := submissionFields[12].Descriptor()
+	// submission.DefaultLateDays holds the default value on creation for the late_days field.
+	submission.DefaultLateDays = submissionDescLateDays.Default.(int)
+	// submission.LateDaysValidator is a validator for the "late_days" field. It is called by the builders before save.
+	submission.LateDaysValidator = submissionDescLateDays.Validators[0].(func(int) error)
+	// submissionDescSubmissionStatus is the schema descriptor for submission_status field.
+	submissionDescSubmissionStatus := submissionFields[13].Descriptor()
+	// submission.DefaultSubmissionStatus holds the default value on creation for the submission_status field.
+	submission.DefaultSubmissionStatus = submissionDescSubmissionStatus.Default.(string)
+	// submissionDescPenalty is the schema descriptor for penalty field.
+	submissionDescPenalty := submissionFields[15].Descriptor()
+	// submission.DefaultPenalty holds the default value on creation for the penalty field.
+	submission.DefaultPenalty = submissionDescPenalty.Default.(float64)
+	// submissionDescID is the schema descriptor for id field.
+	submissionDescID := submissionFields[0].Descriptor()
+	// submission.DefaultID holds the default value on creation for the id field.
+	submission.DefaultID = submissionDescID.Default.(func() string)
+	subscriptionMixin := schema.Subscription{}.Mixin()
+	subscriptionMixinFields0 := subscriptionMixin[0].Fields()
+	_ = subscriptionMixinFields0
+	subscriptionMixinFields1 := subscriptionMixin[1].Fields()
+	_ = subscriptionMixinFields1
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescStatus is the schema descriptor for status field.
+	subscriptionDescStatus := subscriptionMixinFields0[0].Descriptor()
+	// subscription.DefaultStatus holds the default value on creation for the status field.
+	subscription.DefaultStatus = subscriptionDescStatus.Default.(string)
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionMixinFields0[1].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionDescUpdatedAt := subscriptionMixinFields0[2].Descriptor()
+	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
+	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionDescMetadata is the schema descriptor for metadata field.
+	subscriptionDescMetadata := subscriptionMixinFields1[0].Descriptor()
+	// subscription.DefaultMetadata holds the default value on creation for the metadata field.
+	subscription.DefaultMetadata = subscriptionDescMetadata.Default.(map[string]string)
+	// subscriptionDescUserID is the schema descriptor for user_id field.
+	subscriptionDescUserID := subscriptionFields[1].Descriptor()
+	// subscription.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	subscription.UserIDValidator = subscriptionDescUserID.Validators[0].(func(string) error)
+	// subscriptionDescPlanID is the schema descriptor for plan_id field.
+	subscriptionDescPlanID := subscriptionFields[2].Descriptor()
+	// subscription.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	subscription.PlanIDValidator = subscriptionDescPlanID.Validators[0].(func(string) error)
+	// subscriptionDescSubscriptionStatus is the schema descriptor for subscription_status field.
+	subscriptionDescSubscriptionStatus := subscriptionFields[3].Descriptor()
+	// subscription.DefaultSubscriptionStatus holds the default value on creation for the subscription_status field.
+	subscription.DefaultSubscriptionStatus = types.SubscriptionStatus(subscriptionDescSubscriptionStatus.Default.(string))
+	// subscriptionDescGatewayProvider is the schema descriptor for gateway_provider field.
+	subscriptionDescGatewayProvider := subscriptionFields[4].Descriptor()
+	// subscription.GatewayProviderValidator is a validator for the "gateway_provider" field. It is called by the builders before save.
+	subscription.GatewayProviderValidator = subscriptionDescGatewayProvider.Validators[0].(func(string) error)
+	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[9].Descriptor()
+	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
+	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
+	// subscriptionDescFailedPaymentCount is the schema descriptor for failed_payment_count field.
+	subscriptionDescFailedPaymentCount := subscriptionFields[12].Descriptor()
+	// subscription.DefaultFailedPaymentCount holds the default value on creation for the failed_payment_count field.
+	subscription.DefaultFailedPaymentCount = subscriptionDescFailedPaymentCount.Default.(int)
+	// subscription.FailedPaymentCountValidator is a validator for the "failed_payment_count" field. It is called by the builders before save.
+	subscription.FailedPaymentCountValidator = subscriptionDescFailedPaymentCount.Validators[0].(func(int) error)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionFields[0].Descriptor()
+	// subscription.DefaultID holds the default value on creation for the id field.
+	subscription.DefaultID = subscriptionDescID.Default.(func() string)
+	subscriptioneventMixin := schema.SubscriptionEvent{}.Mixin()
+	subscriptioneventMixinFields0 := subscriptioneventMixin[0].Fields()
+	_ = subscriptioneventMixinFields0
+	subscriptioneventFields := schema.SubscriptionEvent{}.Fields()
+	_ = subscriptioneventFields
+	// subscriptioneventDescStatus is the schema descriptor for status field.
+	subscriptioneventDescStatus := subscriptioneventMixinFields0[0].Descriptor()
+	// subscriptionevent.DefaultStatus holds the default value on creation for the status field.
+	subscriptionevent.DefaultStatus = subscriptioneventDescStatus.Default.(string)
+	// subscriptioneventDescCreatedAt is the schema descriptor for created_at field.
+	subscriptioneventDescCreatedAt := subscriptioneventMixinFields0[1].Descriptor()
+	// subscriptionevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionevent.DefaultCreatedAt = subscriptioneventDescCreatedAt.Default.(func() time.Time)
+	// subscriptioneventDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptioneventDescUpdatedAt := subscriptioneventMixinFields0[2].Descriptor()
+	// subscriptionevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionevent.DefaultUpdatedAt = subscriptioneventDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionevent.UpdateDefaultUpdatedAt = subscriptioneventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptioneventDescSubscriptionID is the schema descriptor for subscription_id field.
+	subscriptioneventDescSubscriptionID := subscriptioneventFields[1].Descriptor()
+	// subscriptionevent.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	subscriptionevent.SubscriptionIDValidator = subscriptioneventDescSubscriptionID.Validators[0].(func(string) error)
+	// subscriptioneventDescGatewayProvider is the schema descriptor for gateway_provider field.
+	subscriptioneventDescGatewayProvider := subscriptioneventFields[2].Descriptor()
+	// subscriptionevent.GatewayProviderValidator is a validator for the "gateway_provider" field. It is called by the builders before save.
+	subscriptionevent.GatewayProviderValidator = subscriptioneventDescGatewayProvider.Validators[0].(func(string) error)
+	// subscriptioneventDescGatewayEventID is the schema descriptor for gateway_event_id field.
+	subscriptioneventDescGatewayEventID := subscriptioneventFields[3].Descriptor()
+	// subscriptionevent.GatewayEventIDValidator is a validator for the "gateway_event_id" field. It is called by the builders before save.
+	subscriptionevent.GatewayEventIDValidator = subscriptioneventDescGatewayEventID.Validators[0].(func(string) error)
+	// subscriptioneventDescEventStatus is the schema descriptor for event_status field.
+	subscriptioneventDescEventStatus := subscriptioneventFields[4].Descriptor()
+	// subscriptionevent.EventStatusValidator is a validator for the "event_status" field. It is called by the builders before save.
+	subscriptionevent.EventStatusValidator = subscriptioneventDescEventStatus.Validators[0].(func(string) error)
+	// subscriptioneventDescID is the schema descriptor for id field.
+	subscriptioneventDescID := subscriptioneventFields[0].Descriptor()
+	// subscriptionevent.DefaultID holds the default value on creation for the id field.
+	subscriptionevent.DefaultID = subscriptioneventDescID.Default.(func() string)
+	subscriptionplanMixin := schema.SubscriptionPlan{}.Mixin()
+	subscriptionplanMixinFields0 := subscriptionplanMixin[0].Fields()
+	_ = subscriptionplanMixinFields0
+	subscriptionplanMixinFields1 := subscriptionplanMixin[1].Fields()
+	_ = subscriptionplanMixinFields1
+	subscriptionplanFields := schema.SubscriptionPlan{}.Fields()
+	_ = subscriptionplanFields
+	// subscriptionplanDescStatus is the schema descriptor for status field.
+	subscriptionplanDescStatus := subscriptionplanMixinFields0[0].Descriptor()
+	// subscriptionplan.DefaultStatus holds the default value on creation for the status field.
+	subscriptionplan.DefaultStatus = subscriptionplanDescStatus.Default.(string)
+	// subscriptionplanDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionplanDescCreatedAt := subscriptionplanMixinFields0[1].Descriptor()
+	// subscriptionplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionplan.DefaultCreatedAt = subscriptionplanDescCreatedAt.Default.(func() time.Time)
+	// subscriptionplanDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionplanDescUpdatedAt := subscriptionplanMixinFields0[2].Descriptor()
+	// subscriptionplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionplan.DefaultUpdatedAt = subscriptionplanDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionplan.UpdateDefaultUpdatedAt = subscriptionplanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionplanDescMetadata is the schema descriptor for metadata field.
+	subscriptionplanDescMetadata := subscriptionplanMixinFields1[0].Descriptor()
+	// subscriptionplan.DefaultMetadata holds the default value on creation for the metadata field.
+	subscriptionplan.DefaultMetadata = subscriptionplanDescMetadata.Default.(map[string]string)
+	// subscriptionplanDescName is the schema descriptor for name field.
+	subscriptionplanDescName := subscriptionplanFields[1].Descriptor()
+	// subscriptionplan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	subscriptionplan.NameValidator = subscriptionplanDescName.Validators[0].(func(string) error)
+	// subscriptionplanDescBillingPeriod is the schema descriptor for billing_period field.
+	subscriptionplanDescBillingPeriod := subscriptionplanFields[3].Descriptor()
+	// subscriptionplan.BillingPeriodValidator is a validator for the "billing_period" field. It is called by the builders before save.
+	subscriptionplan.BillingPeriodValidator = subscriptionplanDescBillingPeriod.Validators[0].(func(string) error)
+	// subscriptionplanDescCurrency is the schema descriptor for currency field.
+	subscriptionplanDescCurrency := subscriptionplanFields[5].Descriptor()
+	// subscriptionplan.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	subscriptionplan.CurrencyValidator = subscriptionplanDescCurrency.Validators[0].(func(string) error)
+	// subscriptionplanDescGatewayProvider is the schema descriptor for gateway_provider field.
+	subscriptionplanDescGatewayProvider := subscriptionplanFields[7].Descriptor()
+	// subscriptionplan.GatewayProviderValidator is a validator for the "gateway_provider" field. It is called by the builders before save.
+	subscriptionplan.GatewayProviderValidator = subscriptionplanDescGatewayProvider.Validators[0].(func(string) error)
+	// subscriptionplanDescIsActive is the schema descriptor for is_active field.
+	subscriptionplanDescIsActive := subscriptionplanFields[9].Descriptor()
+	// subscriptionplan.DefaultIsActive holds the default value on creation for the is_active field.
+	subscriptionplan.DefaultIsActive = subscriptionplanDescIsActive.Default.(bool)
+	// subscriptionplanDescID is the schema descriptor for id field.
+	subscriptionplanDescID := subscriptionplanFields[0].Descriptor()
+	// subscriptionplan.DefaultID holds the default value on creation for the id field.
+	subscriptionplan.DefaultID = subscriptionplanDescID.Default.(func() string)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -538,8 +1704,112 @@ func init() {
 	userDescRole := userFields[4].Descriptor()
 	// user.RoleValidator is a validator for the "role" field. It is called by the builders before save.
 	user.RoleValidator = userDescRole.Validators[0].(func(string) error)
+	// userDescSkills is the schema descriptor for skills field.
+	userDescSkills := userFields[9].Descriptor()
+	// user.DefaultSkills holds the default value on creation for the skills field.
+	user.DefaultSkills = userDescSkills.Default.([]string)
+	// userDescEducation is the schema descriptor for education field.
+	userDescEducation := userFields[10].Descriptor()
+	// user.DefaultEducation holds the default value on creation for the education field.
+	user.DefaultEducation = userDescEducation.Default.([]types.Education)
+	// userDescLinks is the schema descriptor for links field.
+	userDescLinks := userFields[11].Descriptor()
+	// user.DefaultLinks holds the default value on creation for the links field.
+	user.DefaultLinks = userDescLinks.Default.([]types.ProfileLink)
+	// userDescProfileVisibility is the schema descriptor for profile_visibility field.
+	userDescProfileVisibility := userFields[14].Descriptor()
+	// user.DefaultProfileVisibility holds the default value on creation for the profile_visibility field.
+	user.DefaultProfileVisibility = userDescProfileVisibility.Default.(string)
+	// userDescNotificationPreferences is the schema descriptor for notification_preferences field.
+	userDescNotificationPreferences := userFields[15].Descriptor()
+	// user.DefaultNotificationPreferences holds the default value on creation for the notification_preferences field.
+	user.DefaultNotificationPreferences = userDescNotificationPreferences.Default.(types.NotificationPreferences)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() string)
+	wallettransactionMixin := schema.WalletTransaction{}.Mixin()
+	wallettransactionMixinFields0 := wallettransactionMixin[0].Fields()
+	_ = wallettransactionMixinFields0
+	wallettransactionMixinFields1 := wallettransactionMixin[1].Fields()
+	_ = wallettransactionMixinFields1
+	wallettransactionFields := schema.WalletTransaction{}.Fields()
+	_ = wallettransactionFields
+	// wallettransactionDescStatus is the schema descriptor for status field.
+	wallettransactionDescStatus := wallettransactionMixinFields0[0].Descriptor()
+	// wallettransaction.DefaultStatus holds the default value on creation for the status field.
+	wallettransaction.DefaultStatus = wallettransactionDescStatus.Default.(string)
+	// wallettransactionDescCreatedAt is the schema descriptor for created_at field.
+	wallettransactionDescCreatedAt := wallettransactionMixinFields0[1].Descriptor()
+	// wallettransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallettransaction.DefaultCreatedAt = wallettransactionDescCreatedAt.Default.(func() time.Time)
+	// wallettransactionDescUpdatedAt is the schema descriptor for updated_at field.
+	wallettransactionDescUpdatedAt := wallettransactionMixinFields0[2].Descriptor()
+	// wallettransaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wallettransaction.DefaultUpdatedAt = wallettransactionDescUpdatedAt.Default.(func() time.Time)
+	// wallettransaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wallettransaction.UpdateDefaultUpdatedAt = wallettransactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// wallettransactionDescMetadata is the schema descriptor for metadata field.
+	wallettransactionDescMetadata := wallettransactionMixinFields1[0].Descriptor()
+	// wallettransaction.DefaultMetadata holds the default value on creation for the metadata field.
+	wallettransaction.DefaultMetadata = wallettransactionDescMetadata.Default.(map[string]string)
+	// wallettransactionDescUserID is the schema descriptor for user_id field.
+	wallettransactionDescUserID := wallettransactionFields[1].Descriptor()
+	// wallettransaction.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	wallettransaction.UserIDValidator = wallettransactionDescUserID.Validators[0].(func(string) error)
+	// wallettransactionDescTransactionType is the schema descriptor for transaction_type field.
+	wallettransactionDescTransactionType := wallettransactionFields[2].Descriptor()
+	// wallettransaction.TransactionTypeValidator is a validator for the "transaction_type" field. It is called by the builders before save.
+	wallettransaction.TransactionTypeValidator = wallettransactionDescTransactionType.Validators[0].(func(string) error)
+	// wallettransactionDescTransactionReason is the schema descriptor for transaction_reason field.
+	wallettransactionDescTransactionReason := wallettransactionFields[3].Descriptor()
+	// wallettransaction.TransactionReasonValidator is a validator for the "transaction_reason" field. It is called by the builders before save.
+	wallettransaction.TransactionReasonValidator = wallettransactionDescTransactionReason.Validators[0].(func(string) error)
+	// wallettransactionDescAmount is the schema descriptor for amount field.
+	wallettransactionDescAmount := wallettransactionFields[4].Descriptor()
+	// wallettransaction.DefaultAmount holds the default value on creation for the amount field.
+	wallettransaction.DefaultAmount = wallettransactionDescAmount.Default.(decimal.Decimal)
+	// wallettransactionDescCurrency is the schema descriptor for currency field.
+	wallettransactionDescCurrency := wallettransactionFields[5].Descriptor()
+	// wallettransaction.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	wallettransaction.CurrencyValidator = wallettransactionDescCurrency.Validators[0].(func(string) error)
+	// wallettransactionDescRemainingAmount is the schema descriptor for remaining_amount field.
+	wallettransactionDescRemainingAmount := wallettransactionFields[6].Descriptor()
+	// wallettransaction.DefaultRemainingAmount holds the default value on creation for the remaining_amount field.
+	wallettransaction.DefaultRemainingAmount = wallettransactionDescRemainingAmount.Default.(decimal.Decimal)
+	// wallettransactionDescID is the schema descriptor for id field.
+	wallettransactionDescID := wallettransactionFields[0].Descriptor()
+	// wallettransaction.DefaultID holds the default value on creation for the id field.
+	wallettransaction.DefaultID = wallettransactionDescID.Default.(func() string)
+	wishlistMixin := schema.Wishlist{}.Mixin()
+	wishlistMixinFields0 := wishlistMixin[0].Fields()
+	_ = wishlistMixinFields0
+	wishlistFields := schema.Wishlist{}.Fields()
+	_ = wishlistFields
+	// wishlistDescStatus is the schema descriptor for status field.
+	wishlistDescStatus := wishlistMixinFields0[0].Descriptor()
+	// wishlist.DefaultStatus holds the default value on creation for the status field.
+	wishlist.DefaultStatus = wishlistDescStatus.Default.(string)
+	// wishlistDescCreatedAt is the schema descriptor for created_at field.
+	wishlistDescCreatedAt := wishlistMixinFields0[1].Descriptor()
+	// wishlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wishlist.DefaultCreatedAt = wishlistDescCreatedAt.Default.(func() time.Time)
+	// wishlistDescUpdatedAt is the schema descriptor for updated_at field.
+	wishlistDescUpdatedAt := wishlistMixinFields0[2].Descriptor()
+	// wishlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wishlist.DefaultUpdatedAt = wishlistDescUpdatedAt.Default.(func() time.Time)
+	// wishlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wishlist.UpdateDefaultUpdatedAt = wishlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// wishlistDescUserID is the schema descriptor for user_id field.
+	wishlistDescUserID := wishlistFields[1].Descriptor()
+	// wishlist.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	wishlist.UserIDValidator = wishlistDescUserID.Validators[0].(func(string) error)
+	// wishlistDescInternshipID is the schema descriptor for internship_id field.
+	wishlistDescInternshipID := wishlistFields[2].Descriptor()
+	// wishlist.InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	wishlist.InternshipIDValidator = wishlistDescInternshipID.Validators[0].(func(string) error)
+	// wishlistDescID is the schema descriptor for id field.
+	wishlistDescID := wishlistFields[0].Descriptor()
+	// wishlist.DefaultID holds the default value on creation for the id field.
+	wishlist.DefaultID = wishlistDescID.Default.(func() string)
 }
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Assignment holds the schema definition for the Assignment entity.
+// Assignments are the project work of a module.
+type Assignment struct {
+	ent.Schema
+}
+
+// Mixin of the Assignment.
+func (Assignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Assignment.
+func (Assignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ASSIGNMENT)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("module_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.Text("instructions").
+			Optional(),
+
+		// Days after the start of a batch the assignment is due, batches run on their own dates
+		field.Int("due_days").
+			NonNegative().
+			Optional().
+			Nillable(),
+
+		field.Int("max_score").
+			Positive().
+			Default(100),
+
+		// Score a submission needs for the assignment to count towards completing the enrollment
+		field.Int("passing_score").
+			NonNegative().
+			Optional().
+			Nillable(),
+
+		// Criteria the assignment is graded on, their points add up to the max score
+		field.JSON("rubric", []types.RubricCriterion{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}).
+			Optional(),
+
+		field.String("late_policy").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.LatePolicyAccept)),
+
+		// Percentage of the max score deducted for every day a submission is late
+		field.Float("late_penalty_percent").
+			Min(0).
+			Max(100).
+			Default(0),
+
+		// How many times a student may submit, unlimited when not set
+		field.Int("max_submissions").
+			Positive().
+			Optional().
+			Nillable(),
+
+		// Position of the assignment within its module, lowest first
+		field.Int("sort_order").
+			Default(0),
+	}
+}
+
+// Indexes of the Assignment.
+func (Assignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("module_id", "sort_order"),
+		index.Fields("internship_id"),
+	}
+}
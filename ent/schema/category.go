@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -46,6 +47,39 @@ func (Category) Fields() []ent.Field {
 				"postgres": "text",
 			}).
 			Optional(),
+
+		// parent_id is nil for top level categories
+		field.String("parent_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("slug").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		// path lists the ids from the root down to the category itself as
+		// /root_id/.../id/ so a subtree is every category whose path contains /id/
+		field.String("path").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			NotEmpty(),
+		field.Int("depth").
+			NonNegative().
+			Default(0),
+		field.Int("sort_weight").
+			Default(0),
+
+		// cached counts of published internships, kept up to date by the category service
+		field.Int("internship_count").
+			NonNegative().
+			Default(0),
+		field.Int("total_internship_count").
+			NonNegative().
+			Default(0),
 	}
 }
 
@@ -61,5 +95,9 @@ func (Category) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("name").
 			Unique(),
+		index.Fields("slug").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("parent_id", "sort_weight"),
 	}
 }
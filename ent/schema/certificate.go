@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Certificate holds the schema definition for the Certificate entity.
+// A certificate is issued once per completed enrollment and keeps what it certifies
+// as it was at issue time, so its signature stays valid when the internship changes.
+type Certificate struct {
+	ent.Schema
+}
+
+// Mixin of the Certificate.
+func (Certificate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Certificate.
+func (Certificate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CERTIFICATE)
+			}).
+			Immutable().
+			Unique(),
+
+		// Public id the certificate is verified by
+		field.String("verification_id").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable().
+			Unique(),
+
+		field.String("enrollment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_batch_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("recipient_name").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.Time("completed_at").
+			Immutable(),
+
+		field.Time("issued_at").
+			Immutable(),
+
+		// HMAC of the certified fields with the server signing key
+		field.String("signature").
+			SchemaType(map[string]string{"postgres": "varchar(128)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Rendered PDF
+		field.String("file_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.String("certificate_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.CertificateStatusIssued)),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+
+		field.String("revocation_reason").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Certificate.
+func (Certificate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enrollment_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("user_id"),
+	}
+}
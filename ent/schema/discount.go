@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
@@ -86,9 +87,33 @@ func (Discount) Fields() []ent.Field {
 			Default(false).
 			Immutable(),
 
+		// Number of times this discount has been redeemed
+		field.Int("used_count").
+			Default(0).
+			NonNegative(),
+
+		// Whether this discount is a campaign holding generated child codes
+		field.Bool("is_campaign").
+			Default(false).
+			Immutable(),
+
+		// Campaign discount this code was generated from
+		field.String("parent_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
 		// Optional tag for internal grouping or analytics
 		field.JSON("metadata", map[string]string{}).
 			Default(map[string]string{}).
 			Optional(),
 	}
 }
+
+// Indexes of the Discount.
+func (Discount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id", "is_active"),
+	}
+}
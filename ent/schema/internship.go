@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/shopspring/decimal"
@@ -133,8 +134,86 @@ func (Internship) Fields() []ent.Field {
 			}).
 			Default(decimal.Zero).
 			Comment("Price of the internship"),
+
+		// Editorial workflow, internships created before the workflow existed stay live
+		field.String("publish_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			GoType(types.InternshipPublishStatus("")).
+			Default(string(types.InternshipPublishStatusPublished)).
+			Comment("Editorial status: draft, in_review, published, rejected"),
+
+		field.JSON("draft", &types.InternshipSnapshot{}).
+			Optional().
+			Comment("Pending revision of a published internship"),
+
+		field.String("draft_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			GoType(types.InternshipPublishStatus("")).
+			Optional().
+			Nillable().
+			Comment("Editorial status of the pending revision"),
+
+		field.Text("review_comment").
+			Optional().
+			Nillable().
+			Comment("Comment left by the reviewer on the last rejection"),
+
+		field.Time("submitted_at").
+			Optional().
+			Nillable(),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
+		field.String("reviewed_by").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+
+		field.String("current_revision_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Revision the live content was taken from"),
+
+		// Screening, selective internships only enroll accepted applicants
+		field.Bool("application_required").
+			Default(false),
+
+		field.JSON("application_form", &types.ApplicationForm{}).
+			Optional().
+			Comment("Questions applicants answer and whether they upload a resume"),
+
+		// Ratings, cached from the published reviews by the review service
+		field.Float("rating_average").
+			Min(0).
+			Default(0),
+
+		field.Int("rating_count").
+			NonNegative().
+			Default(0),
+	}
+}
+func (Internship) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("publish_status"),
+		index.Fields("rating_average"),
 	}
 }
+
 func (Internship) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("categories", Category.Type).
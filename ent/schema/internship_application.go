@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipApplication holds the schema definition for the InternshipApplication entity.
+// Selective internships screen applicants to a batch before they can enroll in it.
+type InternshipApplication struct {
+	ent.Schema
+}
+
+// Mixin of the InternshipApplication.
+func (InternshipApplication) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the InternshipApplication.
+func (InternshipApplication) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_APPLICATION)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_batch_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Answers to the application form of the internship
+		field.JSON("answers", []types.ApplicationAnswer{}).
+			Default([]types.ApplicationAnswer{}).
+			Immutable(),
+
+		field.String("resume_file_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("application_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.ApplicationStatusSubmitted)),
+
+		field.Time("interview_at").
+			Optional().
+			Nillable(),
+
+		// Note of the instructor who moved the application last, shared with the applicant
+		field.String("reviewer_note").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Nillable(),
+
+		field.String("reviewed_by").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
+		// Accepted applicants may enroll until then
+		field.Time("offer_expires_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the InternshipApplication.
+func (InternshipApplication) Indexes() []ent.Index {
+	return []ent.Index{
+		// one application per batch, a withdrawn application can be replaced
+		index.Fields("internship_batch_id", "user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted' AND application_status != 'withdrawn'")),
+		index.Fields("internship_id", "application_status"),
+		index.Fields("user_id"),
+	}
+}
@@ -59,5 +59,28 @@ func (InternshipBatch) Fields() []ent.Field {
 			SchemaType(map[string]string{"postgres": "varchar(255)"}).
 			Default(string(types.InternshipBatchStatusUpcoming)).
 			NotEmpty(),
+
+		// When enrollment closed, set once the enrollment cutoff passes
+		field.Time("enrollment_closed_at").
+			Optional().
+			Nillable(),
+
+		// When the batch was paused, lifecycle transitions are held while paused
+		field.Time("paused_at").
+			Optional().
+			Nillable(),
+
+		// When enrolled students were reminded of the start, cleared when the start moves
+		field.Time("reminded_at").
+			Optional().
+			Nillable(),
+
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+
+		field.String("cancellation_reason").
+			Optional().
+			Nillable(),
 	}
 }
@@ -43,6 +43,13 @@ func (InternshipEnrollment) Fields() []ent.Field {
 			SchemaType(map[string]string{"postgres": "varchar(255)"}).
 			NotEmpty(),
 
+		// Revision of the internship the student enrolled under
+		field.String("internship_revision_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
 		// InternshipEnrollment status
 		field.String("enrollment_status").
 			SchemaType(map[string]string{
@@ -77,6 +84,11 @@ func (InternshipEnrollment) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// When the enrollment was completed at the end of its batch
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+
 		// Optional reason for cancellation/refund
 		field.String("cancellation_reason").
 			Optional().
@@ -91,5 +103,12 @@ func (InternshipEnrollment) Fields() []ent.Field {
 			SchemaType(map[string]string{"postgres": "varchar(255)"}).
 			Optional().
 			Nillable(),
+
+		// Payment plan the enrollment is paid in installments with, nil for one-time payment
+		field.String("payment_plan_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
 	}
 }
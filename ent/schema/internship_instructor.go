@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipInstructor holds the schema definition for the InternshipInstructor entity.
+// It assigns instructor users to an internship with a role.
+type InternshipInstructor struct {
+	ent.Schema
+}
+
+// Mixin of the InternshipInstructor.
+func (InternshipInstructor) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the InternshipInstructor.
+func (InternshipInstructor) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_INSTRUCTOR)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("role").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty(),
+	}
+}
+
+// Indexes of the InternshipInstructor.
+func (InternshipInstructor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("internship_id", "user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("user_id"),
+	}
+}
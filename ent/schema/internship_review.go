@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipReview holds the schema definition for the InternshipReview entity.
+// Students who completed an internship rate and review it, instructors reply to reviews.
+type InternshipReview struct {
+	ent.Schema
+}
+
+// Mixin of the InternshipReview.
+func (InternshipReview) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the InternshipReview.
+func (InternshipReview) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_REVIEW)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Completed enrollment the review was left for
+		field.String("enrollment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.Int("rating").
+			Range(types.ReviewRatingMin, types.ReviewRatingMax),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.String("body").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional(),
+
+		field.String("review_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.ReviewStatusPublished)),
+
+		// Reply of an instructor of the internship, shown under the review
+		field.String("reply").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Nillable(),
+
+		field.String("replied_by").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Time("replied_at").
+			Optional().
+			Nillable(),
+
+		// Reports of users who flagged the review, report_count is kept next to them to filter on
+		field.JSON("reports", []types.ReviewReport{}).
+			Default([]types.ReviewReport{}),
+
+		field.Int("report_count").
+			NonNegative().
+			Default(0),
+
+		// Why a moderator hid the review, shared with its author
+		field.String("moderation_note").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Nillable(),
+
+		field.String("moderated_by").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Time("moderated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the InternshipReview.
+func (InternshipReview) Indexes() []ent.Index {
+	return []ent.Index{
+		// one review per internship and student
+		index.Fields("internship_id", "user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("internship_id", "review_status", "rating"),
+		index.Fields("report_count"),
+	}
+}
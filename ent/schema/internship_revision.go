@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipRevision holds the schema definition for the InternshipRevision entity.
+// Revisions are immutable snapshots of the content of an internship, one per change.
+type InternshipRevision struct {
+	ent.Schema
+}
+
+// Mixin of the InternshipRevision.
+func (InternshipRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the InternshipRevision.
+func (InternshipRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_REVISION)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Sequential number of the revision within its internship, starting at 1
+		field.Int("revision").
+			Positive().
+			Immutable(),
+
+		field.JSON("snapshot", &types.InternshipSnapshot{}).
+			Immutable(),
+
+		// Revision this one was restored from, if any
+		field.String("restored_from_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+// Indexes of the InternshipRevision.
+func (InternshipRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("internship_id", "revision").
+			Unique(),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Lesson holds the schema definition for the Lesson entity.
+// A lesson is a video, a text or a file within a module.
+type Lesson struct {
+	ent.Schema
+}
+
+// Mixin of the Lesson.
+func (Lesson) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Lesson.
+func (Lesson) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_LESSON)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("module_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.Text("description").
+			Optional(),
+
+		field.String("lesson_type").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty(),
+
+		// Body of text lessons
+		field.Text("content").
+			Optional(),
+
+		// External video of video lessons, uploaded videos use file_id instead
+		field.String("video_url").
+			SchemaType(map[string]string{"postgres": "varchar(2048)"}).
+			Optional().
+			Nillable(),
+
+		field.String("file_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Int("duration_seconds").
+			NonNegative().
+			Default(0),
+
+		// Preview lessons are open to everyone, the rest only to enrolled students
+		field.Bool("is_preview").
+			Default(false),
+
+		// Percentage of the internship a student must complete before the lesson unlocks
+		field.Float("required_progress").
+			Min(0).
+			Max(100).
+			Optional().
+			Nillable(),
+
+		// Position of the lesson within its module, lowest first
+		field.Int("sort_order").
+			Default(0),
+	}
+}
+
+// Indexes of the Lesson.
+func (Lesson) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("module_id", "sort_order"),
+		index.Fields("internship_id"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// LessonProgress holds the schema definition for the LessonProgress entity.
+// It records how far a student got in a lesson and when they completed it.
+type LessonProgress struct {
+	ent.Schema
+}
+
+// Mixin of the LessonProgress.
+func (LessonProgress) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the LessonProgress.
+func (LessonProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_LESSON_PROGRESS)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("lesson_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Where playback of a video lesson resumes
+		field.Int("position_seconds").
+			NonNegative().
+			Default(0),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the LessonProgress.
+func (LessonProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "lesson_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("user_id", "internship_id"),
+		index.Fields("internship_id"),
+	}
+}
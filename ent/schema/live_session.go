@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// LiveSession holds the schema definition for the LiveSession entity.
+// A live session is a scheduled online class of an internship batch.
+type LiveSession struct {
+	ent.Schema
+}
+
+// Mixin of the LiveSession.
+func (LiveSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the LiveSession.
+func (LiveSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_LIVE_SESSION)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_batch_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.String("description").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional(),
+
+		field.Time("starts_at"),
+
+		field.Time("ends_at"),
+
+		// Link students join the session on
+		field.String("meeting_url").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional(),
+
+		// Recording uploaded to file storage
+		field.String("recording_file_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		// Recording hosted elsewhere
+		field.String("recording_url").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Nillable(),
+
+		field.String("session_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.LiveSessionStatusScheduled)),
+
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the LiveSession.
+func (LiveSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("internship_batch_id", "starts_at"),
+		index.Fields("internship_id"),
+	}
+}
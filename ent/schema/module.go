@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Module holds the schema definition for the Module entity.
+// Modules group the lessons, assignments and resources of an internship in order.
+type Module struct {
+	ent.Schema
+}
+
+// Mixin of the Module.
+func (Module) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Module.
+func (Module) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_MODULE)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.Text("description").
+			Optional(),
+
+		// Position of the module within its internship, lowest first
+		field.Int("sort_order").
+			Default(0),
+	}
+}
+
+// Indexes of the Module.
+func (Module) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("internship_id", "sort_order"),
+	}
+}
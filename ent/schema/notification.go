@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// In-app notifications make up the notification bell of a user, one per domain event they are notified of.
+type Notification struct {
+	ent.Schema
+}
+
+// Mixin of the Notification.
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_NOTIFICATION)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Domain event the notification was created for, retried events don't notify twice
+		field.String("event_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("event_name").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("category").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.Text("body").
+			Optional(),
+
+		// Payload of the event, the frontend links the notification to what it is about
+		field.JSON("data", map[string]any{}).
+			Optional(),
+
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "event_id").
+			Unique(),
+		index.Fields("user_id", "read_at"),
+		index.Fields("user_id", "created_at"),
+	}
+}
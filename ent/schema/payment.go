@@ -154,6 +154,19 @@ func (Payment) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// installment number
+		// Position of the payment in an installment plan, nil for one-time payments
+		field.Int("installment_number").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// due date
+		// Time by which an installment must be paid
+		field.Time("due_date").
+			Optional().
+			Nillable(),
+
 		// error message
 		// Error message from the payment gateway
 		field.String("error_message").
@@ -171,6 +184,10 @@ func (Payment) Edges() []ent.Edge {
 		// attempts
 		// Payment attempts
 		edge.To("attempts", PaymentAttempt.Type),
+
+		// refunds
+		// Refund of the payment to its source, at most one
+		edge.To("refunds", PaymentRefund.Type),
 	}
 }
 
@@ -181,6 +198,9 @@ func (Payment) Indexes() []ent.Index {
 			StorageKey("idx_destination_status"),
 		index.Fields("payment_method_type", "payment_method_id", "payment_status", "status").
 			StorageKey("idx_tenant_payment_method_status"),
+		index.Fields("payment_status", "due_date").
+			StorageKey("idx_payment_status_due_date").
+			Annotations(entsql.IndexWhere("due_date IS NOT NULL")),
 		index.Fields("payment_gateway_provider", "gateway_payment_id").
 			StorageKey("idx_gateway_payment").
 			Annotations(entsql.IndexWhere("payment_gateway_provider IS NOT NULL AND gateway_payment_id IS NOT NULL")),
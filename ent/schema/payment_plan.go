@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// PaymentPlan holds the schema definition for the PaymentPlan entity.
+// A payment plan splits the price of an internship into installments.
+type PaymentPlan struct {
+	ent.Schema
+}
+
+// Mixin of the PaymentPlan.
+func (PaymentPlan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the PaymentPlan.
+func (PaymentPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT_PLAN)
+			}).
+			Immutable(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("name").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.String("description").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional(),
+
+		// Share of the price and due date of each installment
+		field.JSON("installments", []types.PaymentPlanInstallment{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}),
+
+		// Days an installment may stay unpaid after its due date before the enrollment is suspended
+		field.Int("grace_period_days").
+			Default(0).
+			NonNegative(),
+
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+// Edges of the PaymentPlan.
+func (PaymentPlan) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PaymentPlan.
+func (PaymentPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("internship_id", "is_active"),
+	}
+}
@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRefund holds the schema definition for the PaymentRefund entity.
+type PaymentRefund struct {
+	ent.Schema
+}
+
+// Mixin of the PaymentRefund.
+func (PaymentRefund) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the PaymentRefund.
+func (PaymentRefund) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+
+		// payment id
+		// A payment is refunded at most once, it is also the idempotency key sent to the gateway
+		field.String("payment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("payment_gateway_provider").
+			GoType(types.PaymentGatewayProvider("")).
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("gateway_payment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable(),
+		field.String("currency").
+			GoType(types.Currency("")).
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("reason").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Immutable(),
+
+		// refund status
+		// pending until the gateway accepted the refund, failed when it turned it down
+		field.String("refund_status").
+			GoType(types.PaymentRefundStatus("")).
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default(string(types.PaymentRefundStatusPending)),
+		field.String("gateway_refund_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("error_message").
+			SchemaType(map[string]string{
+				"postgres": "text",
+			}).
+			Optional().
+			Nillable(),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PaymentRefund.
+func (PaymentRefund) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment", Payment.Type).
+			Ref("refunds").
+			Field("payment_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the PaymentRefund.
+func (PaymentRefund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("payment_id").
+			Unique().
+			StorageKey("idx_payment_refund_payment_unique").
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("refund_status", "status").
+			StorageKey("idx_payment_refund_status"),
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Quiz holds the schema definition for the Quiz entity.
+// Quizzes are auto-graded assessments of a module, every attempt draws its questions from the pool.
+type Quiz struct {
+	ent.Schema
+}
+
+// Mixin of the Quiz.
+func (Quiz) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Quiz.
+func (Quiz) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUIZ)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("module_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.String("title").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.Text("description").
+			Optional(),
+
+		// Question pool with the answers, never sent to students before they submit
+		field.JSON("questions", []types.QuizQuestion{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}).
+			Optional(),
+
+		// How many questions every attempt draws from the pool, the whole pool when not set
+		field.Int("questions_per_attempt").
+			Positive().
+			Optional().
+			Nillable(),
+
+		field.Bool("shuffle_questions").
+			Default(true),
+
+		// Minutes an attempt may take, untimed when not set
+		field.Int("time_limit_minutes").
+			Positive().
+			Optional().
+			Nillable(),
+
+		// How many times a student may attempt the quiz, unlimited when not set
+		field.Int("max_attempts").
+			Positive().
+			Optional().
+			Nillable(),
+
+		// Percentage an attempt needs for the quiz to count towards completing the enrollment
+		field.Int("passing_percent").
+			Min(0).
+			Max(100).
+			Optional().
+			Nillable(),
+
+		// Position of the quiz within its module, lowest first
+		field.Int("sort_order").
+			Default(0),
+	}
+}
+
+// Indexes of the Quiz.
+func (Quiz) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("module_id", "sort_order"),
+		index.Fields("internship_id"),
+	}
+}
@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// QuizAttempt holds the schema definition for the QuizAttempt entity.
+// The questions of an attempt and its deadline are fixed on the server when it starts.
+type QuizAttempt struct {
+	ent.Schema
+}
+
+// Mixin of the QuizAttempt.
+func (QuizAttempt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the QuizAttempt.
+func (QuizAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUIZ_ATTEMPT)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("quiz_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("enrollment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.Int("attempt").
+			Positive().
+			Immutable(),
+
+		// Questions drawn for the attempt in the order they are shown
+		field.JSON("question_ids", []string{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}).
+			Immutable(),
+
+		field.Time("started_at").
+			Immutable(),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("submitted_at").
+			Optional().
+			Nillable(),
+
+		field.String("attempt_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.QuizAttemptStatusInProgress)),
+
+		field.JSON("answers", []types.QuizAnswer{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}).
+			Optional(),
+
+		field.JSON("results", []types.QuizQuestionResult{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}).
+			Optional(),
+
+		field.Float("score").
+			Optional().
+			Nillable(),
+
+		field.Float("max_score").
+			Default(0),
+
+		field.Float("score_percent").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the QuizAttempt.
+func (QuizAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("quiz_id", "user_id", "attempt").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("enrollment_id"),
+		index.Fields("internship_id", "attempt_status"),
+	}
+}
@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Referral holds the schema definition for the Referral entity.
+type Referral struct {
+	ent.Schema
+}
+
+// Mixin of the Referral.
+func (Referral) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the Referral.
+func (Referral) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_REFERRAL)
+			}).
+			Immutable(),
+
+		// User who shared the referral code
+		field.String("referrer_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// User who signed up with the referral code
+		field.String("referee_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Referral code used at signup
+		field.String("referral_code").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("referral_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			GoType(types.ReferralStatus("")).
+			Default(string(types.ReferralStatusPending)).
+			NotEmpty(),
+
+		// Why the referral was rejected (self referral, duplicate account)
+		field.String("rejection_reason").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		// Normalized referee identity used for abuse detection
+		field.String("referee_email").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("referee_phone").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Immutable(),
+
+		// Discount granted to the referee at signup
+		field.String("referee_discount_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		// First successful payment of the referee
+		field.String("payment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Time("qualified_at").
+			Optional().
+			Nillable(),
+
+		// Reward is released once the refund window of the payment has passed
+		field.Time("reward_eligible_at").
+			Optional().
+			Nillable(),
+
+		field.Time("rewarded_at").
+			Optional().
+			Nillable(),
+
+		// Discount issued to the referrer as reward
+		field.String("reward_discount_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Referral.
+func (Referral) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("referee_id").
+			Unique(),
+		index.Fields("referrer_id", "referral_status"),
+		index.Fields("referral_status", "reward_eligible_at"),
+		index.Fields("referee_email"),
+		index.Fields("referee_phone"),
+	}
+}
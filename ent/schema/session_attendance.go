@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SessionAttendance holds the schema definition for the SessionAttendance entity.
+// Attendance is recorded once per enrollment and live session, from the join and leave
+// events of the student or marked by an instructor.
+type SessionAttendance struct {
+	ent.Schema
+}
+
+// Mixin of the SessionAttendance.
+func (SessionAttendance) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the SessionAttendance.
+func (SessionAttendance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SESSION_ATTENDANCE)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("live_session_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_batch_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("enrollment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("attendance_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.AttendanceStatusPresent)),
+
+		// First time the student joined
+		field.Time("joined_at").
+			Optional().
+			Nillable(),
+
+		// Start of the current or latest stay of the student in the session
+		field.Time("last_joined_at").
+			Optional().
+			Nillable(),
+
+		// Last time the student left
+		field.Time("left_at").
+			Optional().
+			Nillable(),
+
+		// Time spent in the session over every completed join
+		field.Int("duration_seconds").
+			Default(0),
+
+		// Instructor who marked the attendance, empty when it was recorded from join events
+		field.String("marked_by").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the SessionAttendance.
+func (SessionAttendance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("live_session_id", "enrollment_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("internship_batch_id", "user_id"),
+	}
+}
@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Submission holds the schema definition for the Submission entity.
+// Every attempt a student makes at an assignment is its own submission.
+type Submission struct {
+	ent.Schema
+}
+
+// Mixin of the Submission.
+func (Submission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Submission.
+func (Submission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBMISSION)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("assignment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("enrollment_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Number of the attempt, the first submission is attempt 1
+		field.Int("attempt").
+			Positive().
+			Immutable(),
+
+		field.String("submission_type").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("file_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("repository_url").
+			SchemaType(map[string]string{"postgres": "varchar(2048)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Text("text").
+			Optional().
+			Immutable(),
+
+		field.Time("submitted_at").
+			Immutable(),
+
+		// Due date of the assignment in the batch of the student when they submitted
+		field.Time("due_at").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Days the submission came in after the due date, started days count in full
+		field.Int("late_days").
+			NonNegative().
+			Default(0).
+			Immutable(),
+
+		field.String("submission_status").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.SubmissionStatusSubmitted)),
+
+		// Final score, after the late penalty was deducted
+		field.Float("score").
+			Optional().
+			Nillable(),
+
+		// Points deducted from the score for being late
+		field.Float("penalty").
+			Default(0),
+
+		field.JSON("rubric_scores", []types.CriterionScore{}).
+			SchemaType(map[string]string{"postgres": "jsonb"}).
+			Optional(),
+
+		field.Text("feedback").
+			Optional(),
+
+		field.String("graded_by").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Time("graded_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Submission.
+func (Submission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("assignment_id", "user_id", "attempt").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("enrollment_id"),
+		index.Fields("internship_id", "submission_status"),
+	}
+}
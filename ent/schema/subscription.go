@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Subscription holds the schema definition for the Subscription entity.
+// Its lifecycle is driven by the payment gateway's subscription webhooks.
+type Subscription struct {
+	ent.Schema
+}
+
+// Mixin of the Subscription.
+func (Subscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION)
+			}).
+			Immutable(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("plan_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("subscription_status").
+			GoType(types.SubscriptionStatus("")).
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			Default(string(types.SubscriptionStatusCreated)),
+
+		field.String("gateway_provider").
+			GoType(types.PaymentGatewayProvider("")).
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("gateway_subscription_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		// Hosted page where the subscriber authorizes recurring charges
+		field.String("checkout_url").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Nillable(),
+
+		field.Time("current_period_start").
+			Optional().
+			Nillable(),
+
+		field.Time("current_period_end").
+			Optional().
+			Nillable(),
+
+		// The subscription stays active until the end of the paid period, then the gateway cancels it
+		field.Bool("cancel_at_period_end").
+			Default(false),
+
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+
+		// Renewal charges that failed since the last successful one
+		field.Int("failed_payment_count").
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "subscription_status"),
+		index.Fields("gateway_provider", "gateway_subscription_id").
+			Unique().
+			Annotations(entsql.IndexWhere("gateway_subscription_id IS NOT NULL")),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubscriptionEvent holds the schema definition for the SubscriptionEvent entity.
+// It records every subscription webhook applied, gateways deliver them at least once.
+type SubscriptionEvent struct {
+	ent.Schema
+}
+
+// Mixin of the SubscriptionEvent.
+func (SubscriptionEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the SubscriptionEvent.
+func (SubscriptionEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_EVENT)
+			}).
+			Immutable(),
+
+		field.String("subscription_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("gateway_provider").
+			GoType(types.PaymentGatewayProvider("")).
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+
+		// The id the gateway delivers the event under, the same on every redelivery
+		field.String("gateway_event_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// The subscription status the event reported
+		field.String("event_status").
+			GoType(types.SubscriptionStatus("")).
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+	}
+}
+
+// Edges of the SubscriptionEvent.
+func (SubscriptionEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SubscriptionEvent.
+func (SubscriptionEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("gateway_provider", "gateway_event_id").
+			Unique(),
+		index.Fields("subscription_id"),
+	}
+}
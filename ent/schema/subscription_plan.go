@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionPlan holds the schema definition for the SubscriptionPlan entity.
+// A subscription plan bills on a recurring period and grants access to every
+// internship in its categories.
+type SubscriptionPlan struct {
+	ent.Schema
+}
+
+// Mixin of the SubscriptionPlan.
+func (SubscriptionPlan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the SubscriptionPlan.
+func (SubscriptionPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_PLAN)
+			}).
+			Immutable(),
+
+		field.String("name").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty(),
+
+		field.String("description").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional(),
+
+		field.String("billing_period").
+			GoType(types.BillingPeriod("")).
+			SchemaType(map[string]string{"postgres": "varchar(20)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Price charged every billing period
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{"postgres": "numeric(20,8)"}).
+			Immutable(),
+
+		field.String("currency").
+			GoType(types.Currency("")).
+			SchemaType(map[string]string{"postgres": "varchar(10)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Categories whose internships a subscriber can access
+		field.Strings("category_ids").
+			SchemaType(map[string]string{"postgres": "jsonb"}),
+
+		field.String("gateway_provider").
+			GoType(types.PaymentGatewayProvider("")).
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			NotEmpty().
+			Immutable(),
+
+		// ID of the matching plan at the payment gateway
+		field.String("gateway_plan_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable(),
+
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+// Edges of the SubscriptionPlan.
+func (SubscriptionPlan) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SubscriptionPlan.
+func (SubscriptionPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active"),
+	}
+}
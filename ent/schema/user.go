@@ -49,6 +49,66 @@ func (User) Fields() []ent.Field {
 				"postgres": "varchar(255)",
 			}).
 			NotEmpty(),
+
+		// Code other users can sign up with to be referred by this user
+		field.String("referral_code").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Unique(),
+
+		// ID of the user who referred this user
+		field.String("referred_by").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Profile shown to recruiters
+		field.String("headline").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional(),
+		field.Text("bio").
+			Optional(),
+		field.JSON("skills", []string{}).
+			Optional().
+			Default([]string{}).
+			Comment("Skills of the user, matched against the skills of internships"),
+		field.JSON("education", []types.Education{}).
+			Optional().
+			Default([]types.Education{}),
+		field.JSON("links", []types.ProfileLink{}).
+			Optional().
+			Default([]types.ProfileLink{}).
+			Comment("Portfolio, repository and social profile links"),
+		field.String("resume_file_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("photo_file_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("profile_visibility").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.ProfileVisibilityPrivate)).
+			Comment("Who can see the profile: public, recruiters, private"),
+		field.JSON("notification_preferences", types.NotificationPreferences{}).
+			Optional().
+			Default(types.DefaultNotificationPreferences()).
+			Comment("Locale and categories the user is notified of"),
 	}
 }
 
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransaction holds the schema definition for the WalletTransaction entity.
+// Every change to a user's store credit is an immutable ledger entry, the balance
+// is the sum of credits minus the sum of debits.
+type WalletTransaction struct {
+	ent.Schema
+}
+
+// Mixin of the WalletTransaction.
+func (WalletTransaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the WalletTransaction.
+func (WalletTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION)
+			}).
+			Immutable(),
+
+		// Owner of the wallet
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		// credit or debit
+		field.String("transaction_type").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			GoType(types.WalletTransactionType("")).
+			NotEmpty().
+			Immutable(),
+
+		// refund, referral_reward, admin_grant, checkout, expiry, etc.
+		field.String("transaction_reason").
+			SchemaType(map[string]string{"postgres": "varchar(50)"}).
+			GoType(types.WalletTransactionReason("")).
+			NotEmpty().
+			Immutable(),
+
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{"postgres": "numeric(20,8)"}).
+			Default(decimal.Zero).
+			Immutable(),
+
+		field.String("currency").
+			SchemaType(map[string]string{"postgres": "varchar(10)"}).
+			NotEmpty().
+			Immutable(),
+
+		// Part of a credit not yet consumed by debits, always zero for debits
+		field.Other("remaining_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{"postgres": "numeric(20,8)"}).
+			Default(decimal.Zero),
+
+		// Credits past this time can no longer be spent
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Credit a debit was drawn from
+		field.String("source_transaction_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Payment, enrollment or referral the entry was booked for
+		field.String("reference_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("description").
+			SchemaType(map[string]string{"postgres": "text"}).
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the WalletTransaction.
+func (WalletTransaction) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WalletTransaction.
+func (WalletTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "currency", "transaction_type"),
+		index.Fields("transaction_type", "expires_at"),
+		index.Fields("reference_id", "transaction_reason"),
+		index.Fields("source_transaction_id"),
+	}
+}
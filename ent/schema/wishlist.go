@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/omkar273/codegeeky/ent/mixin"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Wishlist holds the schema definition for the Wishlist entity.
+// Students save internships for later, they are told when a wishlisted internship opens a batch or gets cheaper.
+type Wishlist struct {
+	ent.Schema
+}
+
+// Mixin of the Wishlist.
+func (Wishlist) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Wishlist.
+func (Wishlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			DefaultFunc(func() string {
+				return types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WISHLIST)
+			}).
+			Immutable().
+			Unique(),
+
+		field.String("user_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+
+		field.String("internship_id").
+			SchemaType(map[string]string{"postgres": "varchar(255)"}).
+			NotEmpty().
+			Immutable(),
+	}
+}
+
+// Indexes of the Wishlist.
+func (Wishlist) Indexes() []ent.Index {
+	return []ent.Index{
+		// an internship is saved once per user
+		index.Fields("user_id", "internship_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status != 'deleted'")),
+		index.Fields("internship_id"),
+	}
+}
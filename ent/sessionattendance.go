@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/sessionattendance"
+)
+
+// SessionAttendance is the model entity for the SessionAttendance schema.
+type SessionAttendance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// LiveSessionID holds the value of the "live_session_id" field.
+	LiveSessionID string `json:"live_session_id,omitempty"`
+	// InternshipBatchID holds the value of the "internship_batch_id" field.
+	InternshipBatchID string `json:"internship_batch_id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID string `json:"enrollment_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// AttendanceStatus holds the value of the "attendance_status" field.
+	AttendanceStatus string `json:"attendance_status,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt *time.Time `json:"joined_at,omitempty"`
+	// LastJoinedAt holds the value of the "last_joined_at" field.
+	LastJoinedAt *time.Time `json:"last_joined_at,omitempty"`
+	// LeftAt holds the value of the "left_at" field.
+	LeftAt *time.Time `json:"left_at,omitempty"`
+	// DurationSeconds holds the value of the "duration_seconds" field.
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// MarkedBy holds the value of the "marked_by" field.
+	MarkedBy     *string `json:"marked_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionAttendance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessionattendance.FieldDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case sessionattendance.FieldID, sessionattendance.FieldStatus, sessionattendance.FieldCreatedBy, sessionattendance.FieldUpdatedBy, sessionattendance.FieldLiveSessionID, sessionattendance.FieldInternshipBatchID, sessionattendance.FieldEnrollmentID, sessionattendance.FieldUserID, sessionattendance.FieldAttendanceStatus, sessionattendance.FieldMarkedBy:
+			values[i] = new(sql.NullString)
+		case sessionattendance.FieldCreatedAt, sessionattendance.FieldUpdatedAt, sessionattendance.FieldJoinedAt, sessionattendance.FieldLastJoinedAt, sessionattendance.FieldLeftAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionAttendance fields.
+func (sa *SessionAttendance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessionattendance.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sa.ID = value.String
+			}
+		case sessionattendance.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sa.Status = value.String
+			}
+		case sessionattendance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case sessionattendance.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = value.Time
+			}
+		case sessionattendance.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				sa.CreatedBy = value.String
+			}
+		case sessionattendance.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				sa.UpdatedBy = value.String
+			}
+		case sessionattendance.FieldLiveSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field live_session_id", values[i])
+			} else if value.Valid {
+				sa.LiveSessionID = value.String
+			}
+		case sessionattendance.FieldInternshipBatchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_batch_id", values[i])
+			} else if value.Valid {
+				sa.InternshipBatchID = value.String
+			}
+		case sessionattendance.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				sa.EnrollmentID = value.String
+			}
+		case sessionattendance.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sa.UserID = value.String
+			}
+		case sessionattendance.FieldAttendanceStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_status", values[i])
+			} else if value.Valid {
+				sa.AttendanceStatus = value.String
+			}
+		case sessionattendance.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				sa.JoinedAt = new(time.Time)
+				*sa.JoinedAt = value.Time
+			}
+		case sessionattendance.FieldLastJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_joined_at", values[i])
+			} else if value.Valid {
+				sa.LastJoinedAt = new(time.Time)
+				*sa.LastJoinedAt = value.Time
+			}
+		case sessionattendance.FieldLeftAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field left_at", values[i])
+			} else if value.Valid {
+				sa.LeftAt = new(time.Time)
+				*sa.LeftAt = value.Time
+			}
+		case sessionattendance.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				sa.DurationSeconds = int(value.Int64)
+			}
+		case sessionattendance.FieldMarkedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field marked_by", values[i])
+			} else if value.Valid {
+				sa.MarkedBy = new(string)
+				*sa.MarkedBy = value.String
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionAttendance.
+// This includes values selected through modifiers, order, etc.
+func (sa *SessionAttendance) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SessionAttendance.
+// Note that you need to call SessionAttendance.Unwrap() before calling this method if this SessionAttendance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SessionAttendance) Update() *SessionAttendanceUpdateOne {
+	return NewSessionAttendanceClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SessionAttendance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SessionAttendance) Unwrap() *SessionAttendance {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SessionAttendance is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SessionAttendance) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionAttendance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("status=")
+	builder.WriteString(sa.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(sa.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(sa.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("live_session_id=")
+	builder.WriteString(sa.LiveSessionID)
+	builder.WriteString(", ")
+	builder.WriteString("internship_batch_id=")
+	builder.WriteString(sa.InternshipBatchID)
+	builder.WriteString(", ")
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(sa.EnrollmentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(sa.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("attendance_status=")
+	builder.WriteString(sa.AttendanceStatus)
+	builder.WriteString(", ")
+	if v := sa.JoinedAt; v != nil {
+		builder.WriteString("joined_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sa.LastJoinedAt; v != nil {
+		builder.WriteString("last_joined_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sa.LeftAt; v != nil {
+		builder.WriteString("left_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", sa.DurationSeconds))
+	builder.WriteString(", ")
+	if v := sa.MarkedBy; v != nil {
+		builder.WriteString("marked_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionAttendances is a parsable slice of SessionAttendance.
+type SessionAttendances []*SessionAttendance
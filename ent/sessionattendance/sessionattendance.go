@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessionattendance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sessionattendance type in the database.
+	Label = "session_attendance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldLiveSessionID holds the string denoting the live_session_id field in the database.
+	FieldLiveSessionID = "live_session_id"
+	// FieldInternshipBatchID holds the string denoting the internship_batch_id field in the database.
+	FieldInternshipBatchID = "internship_batch_id"
+	// FieldEnrollmentID holds the string denoting the enrollment_id field in the database.
+	FieldEnrollmentID = "enrollment_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAttendanceStatus holds the string denoting the attendance_status field in the database.
+	FieldAttendanceStatus = "attendance_status"
+	// FieldJoinedAt holds the string denoting the joined_at field in the database.
+	FieldJoinedAt = "joined_at"
+	// FieldLastJoinedAt holds the string denoting the last_joined_at field in the database.
+	FieldLastJoinedAt = "last_joined_at"
+	// FieldLeftAt holds the string denoting the left_at field in the database.
+	FieldLeftAt = "left_at"
+	// FieldDurationSeconds holds the string denoting the duration_seconds field in the database.
+	FieldDurationSeconds = "duration_seconds"
+	// FieldMarkedBy holds the string denoting the marked_by field in the database.
+	FieldMarkedBy = "marked_by"
+	// Table holds the table name of the sessionattendance in the database.
+	Table = "session_attendances"
+)
+
+// Columns holds all SQL columns for sessionattendance fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldLiveSessionID,
+	FieldInternshipBatchID,
+	FieldEnrollmentID,
+	FieldUserID,
+	FieldAttendanceStatus,
+	FieldJoinedAt,
+	FieldLastJoinedAt,
+	FieldLeftAt,
+	FieldDurationSeconds,
+	FieldMarkedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// LiveSessionIDValidator is a validator for the "live_session_id" field. It is called by the builders before save.
+	LiveSessionIDValidator func(string) error
+	// InternshipBatchIDValidator is a validator for the "internship_batch_id" field. It is called by the builders before save.
+	InternshipBatchIDValidator func(string) error
+	// EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	EnrollmentIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultAttendanceStatus holds the default value on creation for the "attendance_status" field.
+	DefaultAttendanceStatus string
+	// DefaultDurationSeconds holds the default value on creation for the "duration_seconds" field.
+	DefaultDurationSeconds int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the SessionAttendance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByLiveSessionID orders the results by the live_session_id field.
+func ByLiveSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLiveSessionID, opts...).ToFunc()
+}
+
+// ByInternshipBatchID orders the results by the internship_batch_id field.
+func ByInternshipBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipBatchID, opts...).ToFunc()
+}
+
+// ByEnrollmentID orders the results by the enrollment_id field.
+func ByEnrollmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrollmentID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAttendanceStatus orders the results by the attendance_status field.
+func ByAttendanceStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceStatus, opts...).ToFunc()
+}
+
+// ByJoinedAt orders the results by the joined_at field.
+func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
+}
+
+// ByLastJoinedAt orders the results by the last_joined_at field.
+func ByLastJoinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastJoinedAt, opts...).ToFunc()
+}
+
+// ByLeftAt orders the results by the left_at field.
+func ByLeftAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeftAt, opts...).ToFunc()
+}
+
+// ByDurationSeconds orders the results by the duration_seconds field.
+func ByDurationSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationSeconds, opts...).ToFunc()
+}
+
+// ByMarkedBy orders the results by the marked_by field.
+func ByMarkedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMarkedBy, opts...).ToFunc()
+}
@@ -0,0 +1,1040 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessionattendance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// LiveSessionID applies equality check predicate on the "live_session_id" field. It's identical to LiveSessionIDEQ.
+func LiveSessionID(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldLiveSessionID, v))
+}
+
+// InternshipBatchID applies equality check predicate on the "internship_batch_id" field. It's identical to InternshipBatchIDEQ.
+func InternshipBatchID(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldUserID, v))
+}
+
+// AttendanceStatus applies equality check predicate on the "attendance_status" field. It's identical to AttendanceStatusEQ.
+func AttendanceStatus(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldAttendanceStatus, v))
+}
+
+// JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
+func JoinedAt(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// LastJoinedAt applies equality check predicate on the "last_joined_at" field. It's identical to LastJoinedAtEQ.
+func LastJoinedAt(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldLastJoinedAt, v))
+}
+
+// LeftAt applies equality check predicate on the "left_at" field. It's identical to LeftAtEQ.
+func LeftAt(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldLeftAt, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// MarkedBy applies equality check predicate on the "marked_by" field. It's identical to MarkedByEQ.
+func MarkedBy(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldMarkedBy, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// LiveSessionIDEQ applies the EQ predicate on the "live_session_id" field.
+func LiveSessionIDEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDNEQ applies the NEQ predicate on the "live_session_id" field.
+func LiveSessionIDNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDIn applies the In predicate on the "live_session_id" field.
+func LiveSessionIDIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldLiveSessionID, vs...))
+}
+
+// LiveSessionIDNotIn applies the NotIn predicate on the "live_session_id" field.
+func LiveSessionIDNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldLiveSessionID, vs...))
+}
+
+// LiveSessionIDGT applies the GT predicate on the "live_session_id" field.
+func LiveSessionIDGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDGTE applies the GTE predicate on the "live_session_id" field.
+func LiveSessionIDGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDLT applies the LT predicate on the "live_session_id" field.
+func LiveSessionIDLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDLTE applies the LTE predicate on the "live_session_id" field.
+func LiveSessionIDLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDContains applies the Contains predicate on the "live_session_id" field.
+func LiveSessionIDContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDHasPrefix applies the HasPrefix predicate on the "live_session_id" field.
+func LiveSessionIDHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDHasSuffix applies the HasSuffix predicate on the "live_session_id" field.
+func LiveSessionIDHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDEqualFold applies the EqualFold predicate on the "live_session_id" field.
+func LiveSessionIDEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldLiveSessionID, v))
+}
+
+// LiveSessionIDContainsFold applies the ContainsFold predicate on the "live_session_id" field.
+func LiveSessionIDContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldLiveSessionID, v))
+}
+
+// InternshipBatchIDEQ applies the EQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDNEQ applies the NEQ predicate on the "internship_batch_id" field.
+func InternshipBatchIDNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDIn applies the In predicate on the "internship_batch_id" field.
+func InternshipBatchIDIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDNotIn applies the NotIn predicate on the "internship_batch_id" field.
+func InternshipBatchIDNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldInternshipBatchID, vs...))
+}
+
+// InternshipBatchIDGT applies the GT predicate on the "internship_batch_id" field.
+func InternshipBatchIDGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDGTE applies the GTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLT applies the LT predicate on the "internship_batch_id" field.
+func InternshipBatchIDLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDLTE applies the LTE predicate on the "internship_batch_id" field.
+func InternshipBatchIDLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContains applies the Contains predicate on the "internship_batch_id" field.
+func InternshipBatchIDContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasPrefix applies the HasPrefix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDHasSuffix applies the HasSuffix predicate on the "internship_batch_id" field.
+func InternshipBatchIDHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDEqualFold applies the EqualFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldInternshipBatchID, v))
+}
+
+// InternshipBatchIDContainsFold applies the ContainsFold predicate on the "internship_batch_id" field.
+func InternshipBatchIDContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldInternshipBatchID, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDGT applies the GT predicate on the "enrollment_id" field.
+func EnrollmentIDGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDGTE applies the GTE predicate on the "enrollment_id" field.
+func EnrollmentIDGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLT applies the LT predicate on the "enrollment_id" field.
+func EnrollmentIDLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLTE applies the LTE predicate on the "enrollment_id" field.
+func EnrollmentIDLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContains applies the Contains predicate on the "enrollment_id" field.
+func EnrollmentIDContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasPrefix applies the HasPrefix predicate on the "enrollment_id" field.
+func EnrollmentIDHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasSuffix applies the HasSuffix predicate on the "enrollment_id" field.
+func EnrollmentIDHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDEqualFold applies the EqualFold predicate on the "enrollment_id" field.
+func EnrollmentIDEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContainsFold applies the ContainsFold predicate on the "enrollment_id" field.
+func EnrollmentIDContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldEnrollmentID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// AttendanceStatusEQ applies the EQ predicate on the "attendance_status" field.
+func AttendanceStatusEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusNEQ applies the NEQ predicate on the "attendance_status" field.
+func AttendanceStatusNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusIn applies the In predicate on the "attendance_status" field.
+func AttendanceStatusIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldAttendanceStatus, vs...))
+}
+
+// AttendanceStatusNotIn applies the NotIn predicate on the "attendance_status" field.
+func AttendanceStatusNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldAttendanceStatus, vs...))
+}
+
+// AttendanceStatusGT applies the GT predicate on the "attendance_status" field.
+func AttendanceStatusGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusGTE applies the GTE predicate on the "attendance_status" field.
+func AttendanceStatusGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusLT applies the LT predicate on the "attendance_status" field.
+func AttendanceStatusLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusLTE applies the LTE predicate on the "attendance_status" field.
+func AttendanceStatusLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusContains applies the Contains predicate on the "attendance_status" field.
+func AttendanceStatusContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusHasPrefix applies the HasPrefix predicate on the "attendance_status" field.
+func AttendanceStatusHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusHasSuffix applies the HasSuffix predicate on the "attendance_status" field.
+func AttendanceStatusHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusEqualFold applies the EqualFold predicate on the "attendance_status" field.
+func AttendanceStatusEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldAttendanceStatus, v))
+}
+
+// AttendanceStatusContainsFold applies the ContainsFold predicate on the "attendance_status" field.
+func AttendanceStatusContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldAttendanceStatus, v))
+}
+
+// JoinedAtEQ applies the EQ predicate on the "joined_at" field.
+func JoinedAtEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtNEQ applies the NEQ predicate on the "joined_at" field.
+func JoinedAtNEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtIn applies the In predicate on the "joined_at" field.
+func JoinedAtIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtNotIn applies the NotIn predicate on the "joined_at" field.
+func JoinedAtNotIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtGT applies the GT predicate on the "joined_at" field.
+func JoinedAtGT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldJoinedAt, v))
+}
+
+// JoinedAtGTE applies the GTE predicate on the "joined_at" field.
+func JoinedAtGTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldJoinedAt, v))
+}
+
+// JoinedAtLT applies the LT predicate on the "joined_at" field.
+func JoinedAtLT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldJoinedAt, v))
+}
+
+// JoinedAtLTE applies the LTE predicate on the "joined_at" field.
+func JoinedAtLTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldJoinedAt, v))
+}
+
+// JoinedAtIsNil applies the IsNil predicate on the "joined_at" field.
+func JoinedAtIsNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIsNull(FieldJoinedAt))
+}
+
+// JoinedAtNotNil applies the NotNil predicate on the "joined_at" field.
+func JoinedAtNotNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotNull(FieldJoinedAt))
+}
+
+// LastJoinedAtEQ applies the EQ predicate on the "last_joined_at" field.
+func LastJoinedAtEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldLastJoinedAt, v))
+}
+
+// LastJoinedAtNEQ applies the NEQ predicate on the "last_joined_at" field.
+func LastJoinedAtNEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldLastJoinedAt, v))
+}
+
+// LastJoinedAtIn applies the In predicate on the "last_joined_at" field.
+func LastJoinedAtIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldLastJoinedAt, vs...))
+}
+
+// LastJoinedAtNotIn applies the NotIn predicate on the "last_joined_at" field.
+func LastJoinedAtNotIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldLastJoinedAt, vs...))
+}
+
+// LastJoinedAtGT applies the GT predicate on the "last_joined_at" field.
+func LastJoinedAtGT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldLastJoinedAt, v))
+}
+
+// LastJoinedAtGTE applies the GTE predicate on the "last_joined_at" field.
+func LastJoinedAtGTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldLastJoinedAt, v))
+}
+
+// LastJoinedAtLT applies the LT predicate on the "last_joined_at" field.
+func LastJoinedAtLT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldLastJoinedAt, v))
+}
+
+// LastJoinedAtLTE applies the LTE predicate on the "last_joined_at" field.
+func LastJoinedAtLTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldLastJoinedAt, v))
+}
+
+// LastJoinedAtIsNil applies the IsNil predicate on the "last_joined_at" field.
+func LastJoinedAtIsNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIsNull(FieldLastJoinedAt))
+}
+
+// LastJoinedAtNotNil applies the NotNil predicate on the "last_joined_at" field.
+func LastJoinedAtNotNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotNull(FieldLastJoinedAt))
+}
+
+// LeftAtEQ applies the EQ predicate on the "left_at" field.
+func LeftAtEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldLeftAt, v))
+}
+
+// LeftAtNEQ applies the NEQ predicate on the "left_at" field.
+func LeftAtNEQ(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldLeftAt, v))
+}
+
+// LeftAtIn applies the In predicate on the "left_at" field.
+func LeftAtIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldLeftAt, vs...))
+}
+
+// LeftAtNotIn applies the NotIn predicate on the "left_at" field.
+func LeftAtNotIn(vs ...time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldLeftAt, vs...))
+}
+
+// LeftAtGT applies the GT predicate on the "left_at" field.
+func LeftAtGT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldLeftAt, v))
+}
+
+// LeftAtGTE applies the GTE predicate on the "left_at" field.
+func LeftAtGTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldLeftAt, v))
+}
+
+// LeftAtLT applies the LT predicate on the "left_at" field.
+func LeftAtLT(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldLeftAt, v))
+}
+
+// LeftAtLTE applies the LTE predicate on the "left_at" field.
+func LeftAtLTE(v time.Time) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldLeftAt, v))
+}
+
+// LeftAtIsNil applies the IsNil predicate on the "left_at" field.
+func LeftAtIsNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIsNull(FieldLeftAt))
+}
+
+// LeftAtNotNil applies the NotNil predicate on the "left_at" field.
+func LeftAtNotNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotNull(FieldLeftAt))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// MarkedByEQ applies the EQ predicate on the "marked_by" field.
+func MarkedByEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEQ(FieldMarkedBy, v))
+}
+
+// MarkedByNEQ applies the NEQ predicate on the "marked_by" field.
+func MarkedByNEQ(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNEQ(FieldMarkedBy, v))
+}
+
+// MarkedByIn applies the In predicate on the "marked_by" field.
+func MarkedByIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIn(FieldMarkedBy, vs...))
+}
+
+// MarkedByNotIn applies the NotIn predicate on the "marked_by" field.
+func MarkedByNotIn(vs ...string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotIn(FieldMarkedBy, vs...))
+}
+
+// MarkedByGT applies the GT predicate on the "marked_by" field.
+func MarkedByGT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGT(FieldMarkedBy, v))
+}
+
+// MarkedByGTE applies the GTE predicate on the "marked_by" field.
+func MarkedByGTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldGTE(FieldMarkedBy, v))
+}
+
+// MarkedByLT applies the LT predicate on the "marked_by" field.
+func MarkedByLT(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLT(FieldMarkedBy, v))
+}
+
+// MarkedByLTE applies the LTE predicate on the "marked_by" field.
+func MarkedByLTE(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldLTE(FieldMarkedBy, v))
+}
+
+// MarkedByContains applies the Contains predicate on the "marked_by" field.
+func MarkedByContains(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContains(FieldMarkedBy, v))
+}
+
+// MarkedByHasPrefix applies the HasPrefix predicate on the "marked_by" field.
+func MarkedByHasPrefix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasPrefix(FieldMarkedBy, v))
+}
+
+// MarkedByHasSuffix applies the HasSuffix predicate on the "marked_by" field.
+func MarkedByHasSuffix(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldHasSuffix(FieldMarkedBy, v))
+}
+
+// MarkedByIsNil applies the IsNil predicate on the "marked_by" field.
+func MarkedByIsNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldIsNull(FieldMarkedBy))
+}
+
+// MarkedByNotNil applies the NotNil predicate on the "marked_by" field.
+func MarkedByNotNil() predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldNotNull(FieldMarkedBy))
+}
+
+// MarkedByEqualFold applies the EqualFold predicate on the "marked_by" field.
+func MarkedByEqualFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldEqualFold(FieldMarkedBy, v))
+}
+
+// MarkedByContainsFold applies the ContainsFold predicate on the "marked_by" field.
+func MarkedByContainsFold(v string) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.FieldContainsFold(FieldMarkedBy, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SessionAttendance) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SessionAttendance) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SessionAttendance) predicate.SessionAttendance {
+	return predicate.SessionAttendance(sql.NotPredicates(p))
+}
@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/sessionattendance"
+)
+
+// SessionAttendanceCreate is the builder for creating a SessionAttendance entity.
+type SessionAttendanceCreate struct {
+	config
+	mutation *SessionAttendanceMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (sac *SessionAttendanceCreate) SetStatus(s string) *SessionAttendanceCreate {
+	sac.mutation.SetStatus(s)
+	return sac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableStatus(s *string) *SessionAttendanceCreate {
+	if s != nil {
+		sac.SetStatus(*s)
+	}
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *SessionAttendanceCreate) SetCreatedAt(t time.Time) *SessionAttendanceCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableCreatedAt(t *time.Time) *SessionAttendanceCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *SessionAttendanceCreate) SetUpdatedAt(t time.Time) *SessionAttendanceCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableUpdatedAt(t *time.Time) *SessionAttendanceCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sac *SessionAttendanceCreate) SetCreatedBy(s string) *SessionAttendanceCreate {
+	sac.mutation.SetCreatedBy(s)
+	return sac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableCreatedBy(s *string) *SessionAttendanceCreate {
+	if s != nil {
+		sac.SetCreatedBy(*s)
+	}
+	return sac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sac *SessionAttendanceCreate) SetUpdatedBy(s string) *SessionAttendanceCreate {
+	sac.mutation.SetUpdatedBy(s)
+	return sac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableUpdatedBy(s *string) *SessionAttendanceCreate {
+	if s != nil {
+		sac.SetUpdatedBy(*s)
+	}
+	return sac
+}
+
+// SetLiveSessionID sets the "live_session_id" field.
+func (sac *SessionAttendanceCreate) SetLiveSessionID(s string) *SessionAttendanceCreate {
+	sac.mutation.SetLiveSessionID(s)
+	return sac
+}
+
+// SetInternshipBatchID sets the "internship_batch_id" field.
+func (sac *SessionAttendanceCreate) SetInternshipBatchID(s string) *SessionAttendanceCreate {
+	sac.mutation.SetInternshipBatchID(s)
+	return sac
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (sac *SessionAttendanceCreate) SetEnrollmentID(s string) *SessionAttendanceCreate {
+	sac.mutation.SetEnrollmentID(s)
+	return sac
+}
+
+// SetUserID sets the "user_id" field.
+func (sac *SessionAttendanceCreate) SetUserID(s string) *SessionAttendanceCreate {
+	sac.mutation.SetUserID(s)
+	return sac
+}
+
+// SetAttendanceStatus sets the "attendance_status" field.
+func (sac *SessionAttendanceCreate) SetAttendanceStatus(s string) *SessionAttendanceCreate {
+	sac.mutation.SetAttendanceStatus(s)
+	return sac
+}
+
+// SetNillableAttendanceStatus sets the "attendance_status" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableAttendanceStatus(s *string) *SessionAttendanceCreate {
+	if s != nil {
+		sac.SetAttendanceStatus(*s)
+	}
+	return sac
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (sac *SessionAttendanceCreate) SetJoinedAt(t time.Time) *SessionAttendanceCreate {
+	sac.mutation.SetJoinedAt(t)
+	return sac
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableJoinedAt(t *time.Time) *SessionAttendanceCreate {
+	if t != nil {
+		sac.SetJoinedAt(*t)
+	}
+	return sac
+}
+
+// SetLastJoinedAt sets the "last_joined_at" field.
+func (sac *SessionAttendanceCreate) SetLastJoinedAt(t time.Time) *SessionAttendanceCreate {
+	sac.mutation.SetLastJoinedAt(t)
+	return sac
+}
+
+// SetNillableLastJoinedAt sets the "last_joined_at" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableLastJoinedAt(t *time.Time) *SessionAttendanceCreate {
+	if t != nil {
+		sac.SetLastJoinedAt(*t)
+	}
+	return sac
+}
+
+// SetLeftAt sets the "left_at" field.
+func (sac *SessionAttendanceCreate) SetLeftAt(t time.Time) *SessionAttendanceCreate {
+	sac.mutation.SetLeftAt(t)
+	return sac
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableLeftAt(t *time.Time) *SessionAttendanceCreate {
+	if t != nil {
+		sac.SetLeftAt(*t)
+	}
+	return sac
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (sac *SessionAttendanceCreate) SetDurationSeconds(i int) *SessionAttendanceCreate {
+	sac.mutation.SetDurationSeconds(i)
+	return sac
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableDurationSeconds(i *int) *SessionAttendanceCreate {
+	if i != nil {
+		sac.SetDurationSeconds(*i)
+	}
+	return sac
+}
+
+// SetMarkedBy sets the "marked_by" field.
+func (sac *SessionAttendanceCreate) SetMarkedBy(s string) *SessionAttendanceCreate {
+	sac.mutation.SetMarkedBy(s)
+	return sac
+}
+
+// SetNillableMarkedBy sets the "marked_by" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableMarkedBy(s *string) *SessionAttendanceCreate {
+	if s != nil {
+		sac.SetMarkedBy(*s)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SessionAttendanceCreate) SetID(s string) *SessionAttendanceCreate {
+	sac.mutation.SetID(s)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *SessionAttendanceCreate) SetNillableID(s *string) *SessionAttendanceCreate {
+	if s != nil {
+		sac.SetID(*s)
+	}
+	return sac
+}
+
+// Mutation returns the SessionAttendanceMutation object of the builder.
+func (sac *SessionAttendanceCreate) Mutation() *SessionAttendanceMutation {
+	return sac.mutation
+}
+
+// Save creates the SessionAttendance in the database.
+func (sac *SessionAttendanceCreate) Save(ctx context.Context) (*SessionAttendance, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SessionAttendanceCreate) SaveX(ctx context.Context) *SessionAttendance {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SessionAttendanceCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SessionAttendanceCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SessionAttendanceCreate) defaults() {
+	if _, ok := sac.mutation.Status(); !ok {
+		v := sessionattendance.DefaultStatus
+		sac.mutation.SetStatus(v)
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := sessionattendance.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		v := sessionattendance.DefaultUpdatedAt()
+		sac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sac.mutation.AttendanceStatus(); !ok {
+		v := sessionattendance.DefaultAttendanceStatus
+		sac.mutation.SetAttendanceStatus(v)
+	}
+	if _, ok := sac.mutation.DurationSeconds(); !ok {
+		v := sessionattendance.DefaultDurationSeconds
+		sac.mutation.SetDurationSeconds(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := sessionattendance.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SessionAttendanceCreate) check() error {
+	if _, ok := sac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SessionAttendance.status"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SessionAttendance.created_at"`)}
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SessionAttendance.updated_at"`)}
+	}
+	if _, ok := sac.mutation.LiveSessionID(); !ok {
+		return &ValidationError{Name: "live_session_id", err: errors.New(`ent: missing required field "SessionAttendance.live_session_id"`)}
+	}
+	if v, ok := sac.mutation.LiveSessionID(); ok {
+		if err := sessionattendance.LiveSessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "live_session_id", err: fmt.Errorf(`ent: validator failed for field "SessionAttendance.live_session_id": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.InternshipBatchID(); !ok {
+		return &ValidationError{Name: "internship_batch_id", err: errors.New(`ent: missing required field "SessionAttendance.internship_batch_id"`)}
+	}
+	if v, ok := sac.mutation.InternshipBatchID(); ok {
+		if err := sessionattendance.InternshipBatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_batch_id", err: fmt.Errorf(`ent: validator failed for field "SessionAttendance.internship_batch_id": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "SessionAttendance.enrollment_id"`)}
+	}
+	if v, ok := sac.mutation.EnrollmentID(); ok {
+		if err := sessionattendance.EnrollmentIDValidator(v); err != nil {
+			return &ValidationError{Name: "enrollment_id", err: fmt.Errorf(`ent: validator failed for field "SessionAttendance.enrollment_id": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SessionAttendance.user_id"`)}
+	}
+	if v, ok := sac.mutation.UserID(); ok {
+		if err := sessionattendance.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "SessionAttendance.user_id": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.AttendanceStatus(); !ok {
+		return &ValidationError{Name: "attendance_status", err: errors.New(`ent: missing required field "SessionAttendance.attendance_status"`)}
+	}
+	if _, ok := sac.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`ent: missing required field "SessionAttendance.duration_seconds"`)}
+	}
+	return nil
+}
+
+func (sac *SessionAttendanceCreate) sqlSave(ctx context.Context) (*SessionAttendance, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SessionAttendance.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SessionAttendanceCreate) createSpec() (*SessionAttendance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionAttendance{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(sessionattendance.Table, sqlgraph.NewFieldSpec(sessionattendance.FieldID, field.TypeString))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.Status(); ok {
+		_spec.SetField(sessionattendance.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(sessionattendance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessionattendance.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sac.mutation.CreatedBy(); ok {
+		_spec.SetField(sessionattendance.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sac.mutation.UpdatedBy(); ok {
+		_spec.SetField(sessionattendance.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sac.mutation.LiveSessionID(); ok {
+		_spec.SetField(sessionattendance.FieldLiveSessionID, field.TypeString, value)
+		_node.LiveSessionID = value
+	}
+	if value, ok := sac.mutation.InternshipBatchID(); ok {
+		_spec.SetField(sessionattendance.FieldInternshipBatchID, field.TypeString, value)
+		_node.InternshipBatchID = value
+	}
+	if value, ok := sac.mutation.EnrollmentID(); ok {
+		_spec.SetField(sessionattendance.FieldEnrollmentID, field.TypeString, value)
+		_node.EnrollmentID = value
+	}
+	if value, ok := sac.mutation.UserID(); ok {
+		_spec.SetField(sessionattendance.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := sac.mutation.AttendanceStatus(); ok {
+		_spec.SetField(sessionattendance.FieldAttendanceStatus, field.TypeString, value)
+		_node.AttendanceStatus = value
+	}
+	if value, ok := sac.mutation.JoinedAt(); ok {
+		_spec.SetField(sessionattendance.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = &value
+	}
+	if value, ok := sac.mutation.LastJoinedAt(); ok {
+		_spec.SetField(sessionattendance.FieldLastJoinedAt, field.TypeTime, value)
+		_node.LastJoinedAt = &value
+	}
+	if value, ok := sac.mutation.LeftAt(); ok {
+		_spec.SetField(sessionattendance.FieldLeftAt, field.TypeTime, value)
+		_node.LeftAt = &value
+	}
+	if value, ok := sac.mutation.DurationSeconds(); ok {
+		_spec.SetField(sessionattendance.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := sac.mutation.MarkedBy(); ok {
+		_spec.SetField(sessionattendance.FieldMarkedBy, field.TypeString, value)
+		_node.MarkedBy = &value
+	}
+	return _node, _spec
+}
+
+// SessionAttendanceCreateBulk is the builder for creating many SessionAttendance entities in bulk.
+type SessionAttendanceCreateBulk struct {
+	config
+	err      error
+	builders []*SessionAttendanceCreate
+}
+
+// Save creates the SessionAttendance entities in the database.
+func (sacb *SessionAttendanceCreateBulk) Save(ctx context.Context) ([]*SessionAttendance, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SessionAttendance, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionAttendanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SessionAttendanceCreateBulk) SaveX(ctx context.Context) []*SessionAttendance {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SessionAttendanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SessionAttendanceCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/sessionattendance"
+)
+
+// SessionAttendanceUpdate is the builder for updating SessionAttendance entities.
+type SessionAttendanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionAttendanceMutation
+}
+
+// Where appends a list predicates to the SessionAttendanceUpdate builder.
+func (sau *SessionAttendanceUpdate) Where(ps ...predicate.SessionAttendance) *SessionAttendanceUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetStatus sets the "status" field.
+func (sau *SessionAttendanceUpdate) SetStatus(s string) *SessionAttendanceUpdate {
+	sau.mutation.SetStatus(s)
+	return sau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableStatus(s *string) *SessionAttendanceUpdate {
+	if s != nil {
+		sau.SetStatus(*s)
+	}
+	return sau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sau *SessionAttendanceUpdate) SetUpdatedAt(t time.Time) *SessionAttendanceUpdate {
+	sau.mutation.SetUpdatedAt(t)
+	return sau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sau *SessionAttendanceUpdate) SetUpdatedBy(s string) *SessionAttendanceUpdate {
+	sau.mutation.SetUpdatedBy(s)
+	return sau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableUpdatedBy(s *string) *SessionAttendanceUpdate {
+	if s != nil {
+		sau.SetUpdatedBy(*s)
+	}
+	return sau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sau *SessionAttendanceUpdate) ClearUpdatedBy() *SessionAttendanceUpdate {
+	sau.mutation.ClearUpdatedBy()
+	return sau
+}
+
+// SetAttendanceStatus sets the "attendance_status" field.
+func (sau *SessionAttendanceUpdate) SetAttendanceStatus(s string) *SessionAttendanceUpdate {
+	sau.mutation.SetAttendanceStatus(s)
+	return sau
+}
+
+// SetNillableAttendanceStatus sets the "attendance_status" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableAttendanceStatus(s *string) *SessionAttendanceUpdate {
+	if s != nil {
+		sau.SetAttendanceStatus(*s)
+	}
+	return sau
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (sau *SessionAttendanceUpdate) SetJoinedAt(t time.Time) *SessionAttendanceUpdate {
+	sau.mutation.SetJoinedAt(t)
+	return sau
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableJoinedAt(t *time.Time) *SessionAttendanceUpdate {
+	if t != nil {
+		sau.SetJoinedAt(*t)
+	}
+	return sau
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (sau *SessionAttendanceUpdate) ClearJoinedAt() *SessionAttendanceUpdate {
+	sau.mutation.ClearJoinedAt()
+	return sau
+}
+
+// SetLastJoinedAt sets the "last_joined_at" field.
+func (sau *SessionAttendanceUpdate) SetLastJoinedAt(t time.Time) *SessionAttendanceUpdate {
+	sau.mutation.SetLastJoinedAt(t)
+	return sau
+}
+
+// SetNillableLastJoinedAt sets the "last_joined_at" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableLastJoinedAt(t *time.Time) *SessionAttendanceUpdate {
+	if t != nil {
+		sau.SetLastJoinedAt(*t)
+	}
+	return sau
+}
+
+// ClearLastJoinedAt clears the value of the "last_joined_at" field.
+func (sau *SessionAttendanceUpdate) ClearLastJoinedAt() *SessionAttendanceUpdate {
+	sau.mutation.ClearLastJoinedAt()
+	return sau
+}
+
+// SetLeftAt sets the "left_at" field.
+func (sau *SessionAttendanceUpdate) SetLeftAt(t time.Time) *SessionAttendanceUpdate {
+	sau.mutation.SetLeftAt(t)
+	return sau
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableLeftAt(t *time.Time) *SessionAttendanceUpdate {
+	if t != nil {
+		sau.SetLeftAt(*t)
+	}
+	return sau
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (sau *SessionAttendanceUpdate) ClearLeftAt() *SessionAttendanceUpdate {
+	sau.mutation.ClearLeftAt()
+	return sau
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (sau *SessionAttendanceUpdate) SetDurationSeconds(i int) *SessionAttendanceUpdate {
+	sau.mutation.ResetDurationSeconds()
+	sau.mutation.SetDurationSeconds(i)
+	return sau
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableDurationSeconds(i *int) *SessionAttendanceUpdate {
+	if i != nil {
+		sau.SetDurationSeconds(*i)
+	}
+	return sau
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (sau *SessionAttendanceUpdate) AddDurationSeconds(i int) *SessionAttendanceUpdate {
+	sau.mutation.AddDurationSeconds(i)
+	return sau
+}
+
+// SetMarkedBy sets the "marked_by" field.
+func (sau *SessionAttendanceUpdate) SetMarkedBy(s string) *SessionAttendanceUpdate {
+	sau.mutation.SetMarkedBy(s)
+	return sau
+}
+
+// SetNillableMarkedBy sets the "marked_by" field if the given value is not nil.
+func (sau *SessionAttendanceUpdate) SetNillableMarkedBy(s *string) *SessionAttendanceUpdate {
+	if s != nil {
+		sau.SetMarkedBy(*s)
+	}
+	return sau
+}
+
+// ClearMarkedBy clears the value of the "marked_by" field.
+func (sau *SessionAttendanceUpdate) ClearMarkedBy() *SessionAttendanceUpdate {
+	sau.mutation.ClearMarkedBy()
+	return sau
+}
+
+// Mutation returns the SessionAttendanceMutation object of the builder.
+func (sau *SessionAttendanceUpdate) Mutation() *SessionAttendanceMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SessionAttendanceUpdate) Save(ctx context.Context) (int, error) {
+	sau.defaults()
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SessionAttendanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SessionAttendanceUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SessionAttendanceUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *SessionAttendanceUpdate) defaults() {
+	if _, ok := sau.mutation.UpdatedAt(); !ok {
+		v := sessionattendance.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sau *SessionAttendanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sessionattendance.Table, sessionattendance.Columns, sqlgraph.NewFieldSpec(sessionattendance.FieldID, field.TypeString))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Status(); ok {
+		_spec.SetField(sessionattendance.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessionattendance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sau.mutation.CreatedByCleared() {
+		_spec.ClearField(sessionattendance.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sau.mutation.UpdatedBy(); ok {
+		_spec.SetField(sessionattendance.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sau.mutation.UpdatedByCleared() {
+		_spec.ClearField(sessionattendance.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := sau.mutation.AttendanceStatus(); ok {
+		_spec.SetField(sessionattendance.FieldAttendanceStatus, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.JoinedAt(); ok {
+		_spec.SetField(sessionattendance.FieldJoinedAt, field.TypeTime, value)
+	}
+	if sau.mutation.JoinedAtCleared() {
+		_spec.ClearField(sessionattendance.FieldJoinedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.LastJoinedAt(); ok {
+		_spec.SetField(sessionattendance.FieldLastJoinedAt, field.TypeTime, value)
+	}
+	if sau.mutation.LastJoinedAtCleared() {
+		_spec.ClearField(sessionattendance.FieldLastJoinedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.LeftAt(); ok {
+		_spec.SetField(sessionattendance.FieldLeftAt, field.TypeTime, value)
+	}
+	if sau.mutation.LeftAtCleared() {
+		_spec.ClearField(sessionattendance.FieldLeftAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.DurationSeconds(); ok {
+		_spec.SetField(sessionattendance.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := sau.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(sessionattendance.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := sau.mutation.MarkedBy(); ok {
+		_spec.SetField(sessionattendance.FieldMarkedBy, field.TypeString, value)
+	}
+	if sau.mutation.MarkedByCleared() {
+		_spec.ClearField(sessionattendance.FieldMarkedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionattendance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SessionAttendanceUpdateOne is the builder for updating a single SessionAttendance entity.
+type SessionAttendanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionAttendanceMutation
+}
+
+// SetStatus sets the "status" field.
+func (sauo *SessionAttendanceUpdateOne) SetStatus(s string) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetStatus(s)
+	return sauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableStatus(s *string) *SessionAttendanceUpdateOne {
+	if s != nil {
+		sauo.SetStatus(*s)
+	}
+	return sauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sauo *SessionAttendanceUpdateOne) SetUpdatedAt(t time.Time) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetUpdatedAt(t)
+	return sauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sauo *SessionAttendanceUpdateOne) SetUpdatedBy(s string) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetUpdatedBy(s)
+	return sauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableUpdatedBy(s *string) *SessionAttendanceUpdateOne {
+	if s != nil {
+		sauo.SetUpdatedBy(*s)
+	}
+	return sauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sauo *SessionAttendanceUpdateOne) ClearUpdatedBy() *SessionAttendanceUpdateOne {
+	sauo.mutation.ClearUpdatedBy()
+	return sauo
+}
+
+// SetAttendanceStatus sets the "attendance_status" field.
+func (sauo *SessionAttendanceUpdateOne) SetAttendanceStatus(s string) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetAttendanceStatus(s)
+	return sauo
+}
+
+// SetNillableAttendanceStatus sets the "attendance_status" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableAttendanceStatus(s *string) *SessionAttendanceUpdateOne {
+	if s != nil {
+		sauo.SetAttendanceStatus(*s)
+	}
+	return sauo
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (sauo *SessionAttendanceUpdateOne) SetJoinedAt(t time.Time) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetJoinedAt(t)
+	return sauo
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableJoinedAt(t *time.Time) *SessionAttendanceUpdateOne {
+	if t != nil {
+		sauo.SetJoinedAt(*t)
+	}
+	return sauo
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (sauo *SessionAttendanceUpdateOne) ClearJoinedAt() *SessionAttendanceUpdateOne {
+	sauo.mutation.ClearJoinedAt()
+	return sauo
+}
+
+// SetLastJoinedAt sets the "last_joined_at" field.
+func (sauo *SessionAttendanceUpdateOne) SetLastJoinedAt(t time.Time) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetLastJoinedAt(t)
+	return sauo
+}
+
+// SetNillableLastJoinedAt sets the "last_joined_at" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableLastJoinedAt(t *time.Time) *SessionAttendanceUpdateOne {
+	if t != nil {
+		sauo.SetLastJoinedAt(*t)
+	}
+	return sauo
+}
+
+// ClearLastJoinedAt clears the value of the "last_joined_at" field.
+func (sauo *SessionAttendanceUpdateOne) ClearLastJoinedAt() *SessionAttendanceUpdateOne {
+	sauo.mutation.ClearLastJoinedAt()
+	return sauo
+}
+
+// SetLeftAt sets the "left_at" field.
+func (sauo *SessionAttendanceUpdateOne) SetLeftAt(t time.Time) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetLeftAt(t)
+	return sauo
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableLeftAt(t *time.Time) *SessionAttendanceUpdateOne {
+	if t != nil {
+		sauo.SetLeftAt(*t)
+	}
+	return sauo
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (sauo *SessionAttendanceUpdateOne) ClearLeftAt() *SessionAttendanceUpdateOne {
+	sauo.mutation.ClearLeftAt()
+	return sauo
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (sauo *SessionAttendanceUpdateOne) SetDurationSeconds(i int) *SessionAttendanceUpdateOne {
+	sauo.mutation.ResetDurationSeconds()
+	sauo.mutation.SetDurationSeconds(i)
+	return sauo
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableDurationSeconds(i *int) *SessionAttendanceUpdateOne {
+	if i != nil {
+		sauo.SetDurationSeconds(*i)
+	}
+	return sauo
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (sauo *SessionAttendanceUpdateOne) AddDurationSeconds(i int) *SessionAttendanceUpdateOne {
+	sauo.mutation.AddDurationSeconds(i)
+	return sauo
+}
+
+// SetMarkedBy sets the "marked_by" field.
+func (sauo *SessionAttendanceUpdateOne) SetMarkedBy(s string) *SessionAttendanceUpdateOne {
+	sauo.mutation.SetMarkedBy(s)
+	return sauo
+}
+
+// SetNillableMarkedBy sets the "marked_by" field if the given value is not nil.
+func (sauo *SessionAttendanceUpdateOne) SetNillableMarkedBy(s *string) *SessionAttendanceUpdateOne {
+	if s != nil {
+		sauo.SetMarkedBy(*s)
+	}
+	return sauo
+}
+
+// ClearMarkedBy clears the value of the "marked_by" field.
+func (sauo *SessionAttendanceUpdateOne) ClearMarkedBy() *SessionAttendanceUpdateOne {
+	sauo.mutation.ClearMarkedBy()
+	return sauo
+}
+
+// Mutation returns the SessionAttendanceMutation object of the builder.
+func (sauo *SessionAttendanceUpdateOne) Mutation() *SessionAttendanceMutation {
+	return sauo.mutation
+}
+
+// Where appends a list predicates to the SessionAttendanceUpdate builder.
+func (sauo *SessionAttendanceUpdateOne) Where(ps ...predicate.SessionAttendance) *SessionAttendanceUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SessionAttendanceUpdateOne) Select(field string, fields ...string) *SessionAttendanceUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SessionAttendance entity.
+func (sauo *SessionAttendanceUpdateOne) Save(ctx context.Context) (*SessionAttendance, error) {
+	sauo.defaults()
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SessionAttendanceUpdateOne) SaveX(ctx context.Context) *SessionAttendance {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SessionAttendanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SessionAttendanceUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *SessionAttendanceUpdateOne) defaults() {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok {
+		v := sessionattendance.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sauo *SessionAttendanceUpdateOne) sqlSave(ctx context.Context) (_node *SessionAttendance, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sessionattendance.Table, sessionattendance.Columns, sqlgraph.NewFieldSpec(sessionattendance.FieldID, field.TypeString))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SessionAttendance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessionattendance.FieldID)
+		for _, f := range fields {
+			if !sessionattendance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sessionattendance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Status(); ok {
+		_spec.SetField(sessionattendance.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessionattendance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.CreatedByCleared() {
+		_spec.ClearField(sessionattendance.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(sessionattendance.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(sessionattendance.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := sauo.mutation.AttendanceStatus(); ok {
+		_spec.SetField(sessionattendance.FieldAttendanceStatus, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.JoinedAt(); ok {
+		_spec.SetField(sessionattendance.FieldJoinedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.JoinedAtCleared() {
+		_spec.ClearField(sessionattendance.FieldJoinedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.LastJoinedAt(); ok {
+		_spec.SetField(sessionattendance.FieldLastJoinedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.LastJoinedAtCleared() {
+		_spec.ClearField(sessionattendance.FieldLastJoinedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.LeftAt(); ok {
+		_spec.SetField(sessionattendance.FieldLeftAt, field.TypeTime, value)
+	}
+	if sauo.mutation.LeftAtCleared() {
+		_spec.ClearField(sessionattendance.FieldLeftAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.DurationSeconds(); ok {
+		_spec.SetField(sessionattendance.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := sauo.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(sessionattendance.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := sauo.mutation.MarkedBy(); ok {
+		_spec.SetField(sessionattendance.FieldMarkedBy, field.TypeString, value)
+	}
+	if sauo.mutation.MarkedByCleared() {
+		_spec.ClearField(sessionattendance.FieldMarkedBy, field.TypeString)
+	}
+	_node = &SessionAttendance{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionattendance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/submission"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Submission is the model entity for the Submission schema.
+type Submission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// InternshipID holds the value of the "internship_id" field.
+	InternshipID string `json:"internship_id,omitempty"`
+	// AssignmentID holds the value of the "assignment_id" field.
+	AssignmentID string `json:"assignment_id,omitempty"`
+	// EnrollmentID holds the value of the "enrollment_id" field.
+	EnrollmentID string `json:"enrollment_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Attempt holds the value of the "attempt" field.
+	Attempt int `json:"attempt,omitempty"`
+	// SubmissionType holds the value of the "submission_type" field.
+	SubmissionType string `json:"submission_type,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID *string `json:"file_id,omitempty"`
+	// RepositoryURL holds the value of the "repository_url" field.
+	RepositoryURL *string `json:"repository_url,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// LateDays holds the value of the "late_days" field.
+	LateDays int `json:"late_days,omitempty"`
+	// SubmissionStatus holds the value of the "submission_status" field.
+	SubmissionStatus string `json:"submission_status,omitempty"`
+	// Score holds the value of the "score" field.
+	Score *float64 `json:"score,omitempty"`
+	// Penalty holds the value of the "penalty" field.
+	Penalty float64 `json:"penalty,omitempty"`
+	// RubricScores holds the value of the "rubric_scores" field.
+	RubricScores []types.CriterionScore `json:"rubric_scores,omitempty"`
+	// Feedback holds the value of the "feedback" field.
+	Feedback string `json:"feedback,omitempty"`
+	// GradedBy holds the value of the "graded_by" field.
+	GradedBy *string `json:"graded_by,omitempty"`
+	// GradedAt holds the value of the "graded_at" field.
+	GradedAt     *time.Time `json:"graded_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Submission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case submission.FieldRubricScores:
+			values[i] = new([]byte)
+		case submission.FieldScore, submission.FieldPenalty:
+			values[i] = new(sql.NullFloat64)
+		case submission.FieldAttempt, submission.FieldLateDays:
+			values[i] = new(sql.NullInt64)
+		case submission.FieldID, submission.FieldStatus, submission.FieldCreatedBy, submission.FieldUpdatedBy, submission.FieldInternshipID, submission.FieldAssignmentID, submission.FieldEnrollmentID, submission.FieldUserID, submission.FieldSubmissionType, submission.FieldFileID, submission.FieldRepositoryURL, submission.FieldText, submission.FieldSubmissionStatus, submission.FieldFeedback, submission.FieldGradedBy:
+			values[i] = new(sql.NullString)
+		case submission.FieldCreatedAt, submission.FieldUpdatedAt, submission.FieldSubmittedAt, submission.FieldDueAt, submission.FieldGradedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Submission fields.
+func (s *Submission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case submission.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case submission.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = value.String
+			}
+		case submission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case submission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case submission.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				s.CreatedBy = value.String
+			}
+		case submission.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				s.UpdatedBy = value.String
+			}
+		case submission.FieldInternshipID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internship_id", values[i])
+			} else if value.Valid {
+				s.InternshipID = value.String
+			}
+		case submission.FieldAssignmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignment_id", values[i])
+			} else if value.Valid {
+				s.AssignmentID = value.String
+			}
+		case submission.FieldEnrollmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrollment_id", values[i])
+			} else if value.Valid {
+				s.EnrollmentID = value.String
+			}
+		case submission.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = value.String
+			}
+		case submission.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				s.Attempt = int(value.Int64)
+			}
+		case submission.FieldSubmissionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_type", values[i])
+			} else if value.Valid {
+				s.SubmissionType = value.String
+			}
+		case submission.FieldFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				s.FileID = new(string)
+				*s.FileID = value.String
+			}
+		case submission.FieldRepositoryURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repository_url", values[i])
+			} else if value.Valid {
+				s.RepositoryURL = new(string)
+				*s.RepositoryURL = value.String
+			}
+		case submission.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				s.Text = value.String
+			}
+		case submission.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				s.SubmittedAt = value.Time
+			}
+		case submission.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				s.DueAt = new(time.Time)
+				*s.DueAt = value.Time
+			}
+		case submission.FieldLateDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field late_days", values[i])
+			} else if value.Valid {
+				s.LateDays = int(value.Int64)
+			}
+		case submission.FieldSubmissionStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_status", values[i])
+			} else if value.Valid {
+				s.SubmissionStatus = value.String
+			}
+		case submission.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				s.Score = new(float64)
+				*s.Score = value.Float64
+			}
+		case submission.FieldPenalty:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field penalty", values[i])
+			} else if value.Valid {
+				s.Penalty = value.Float64
+			}
+		case submission.FieldRubricScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rubric_scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.RubricScores); err != nil {
+					return fmt.Errorf("unmarshal field rubric_scores: %w", err)
+				}
+			}
+		case submission.FieldFeedback:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feedback", values[i])
+			} else if value.Valid {
+				s.Feedback = value.String
+			}
+		case submission.FieldGradedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field graded_by", values[i])
+			} else if value.Valid {
+				s.GradedBy = new(string)
+				*s.GradedBy = value.String
+			}
+		case submission.FieldGradedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field graded_at", values[i])
+			} else if value.Valid {
+				s.GradedAt = new(time.Time)
+				*s.GradedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Submission.
+// This includes values selected through modifiers, order, etc.
+func (s *Submission) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Submission.
+// Note that you need to call Submission.Unwrap() before calling this method if this Submission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Submission) Update() *SubmissionUpdateOne {
+	return NewSubmissionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Submission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Submission) Unwrap() *Submission {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Submission is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Submission) String() string {
+	var builder strings.Builder
+	builder.WriteString("Submission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("status=")
+	builder.WriteString(s.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(s.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(s.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("internship_id=")
+	builder.WriteString(s.InternshipID)
+	builder.WriteString(", ")
+	builder.WriteString("assignment_id=")
+	builder.WriteString(s.AssignmentID)
+	builder.WriteString(", ")
+	builder.WriteString("enrollment_id=")
+	builder.WriteString(s.EnrollmentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(s.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", s.Attempt))
+	builder.WriteString(", ")
+	builder.WriteString("submission_type=")
+	builder.WriteString(s.SubmissionType)
+	builder.WriteString(", ")
+	if v := s.FileID; v != nil {
+		builder.WriteString("file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.RepositoryURL; v != nil {
+		builder.WriteString("repository_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(s.Text)
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(s.SubmittedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("late_days=")
+	builder.WriteString(fmt.Sprintf("%v", s.LateDays))
+	builder.WriteString(", ")
+	builder.WriteString("submission_status=")
+	builder.WriteString(s.SubmissionStatus)
+	builder.WriteString(", ")
+	if v := s.Score; v != nil {
+		builder.WriteString("score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("penalty=")
+	builder.WriteString(fmt.Sprintf("%v", s.Penalty))
+	builder.WriteString(", ")
+	builder.WriteString("rubric_scores=")
+	builder.WriteString(fmt.Sprintf("%v", s.RubricScores))
+	builder.WriteString(", ")
+	builder.WriteString("feedback=")
+	builder.WriteString(s.Feedback)
+	builder.WriteString(", ")
+	if v := s.GradedBy; v != nil {
+		builder.WriteString("graded_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.GradedAt; v != nil {
+		builder.WriteString("graded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Submissions is a parsable slice of Submission.
+type Submissions []*Submission
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package submission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the submission type in the database.
+	Label = "submission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldInternshipID holds the string denoting the internship_id field in the database.
+	FieldInternshipID = "internship_id"
+	// FieldAssignmentID holds the string denoting the assignment_id field in the database.
+	FieldAssignmentID = "assignment_id"
+	// FieldEnrollmentID holds the string denoting the enrollment_id field in the database.
+	FieldEnrollmentID = "enrollment_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAttempt holds the string denoting the attempt field in the database.
+	FieldAttempt = "attempt"
+	// FieldSubmissionType holds the string denoting the submission_type field in the database.
+	FieldSubmissionType = "submission_type"
+	// FieldFileID holds the string denoting the file_id field in the database.
+	FieldFileID = "file_id"
+	// FieldRepositoryURL holds the string denoting the repository_url field in the database.
+	FieldRepositoryURL = "repository_url"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldLateDays holds the string denoting the late_days field in the database.
+	FieldLateDays = "late_days"
+	// FieldSubmissionStatus holds the string denoting the submission_status field in the database.
+	FieldSubmissionStatus = "submission_status"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldPenalty holds the string denoting the penalty field in the database.
+	FieldPenalty = "penalty"
+	// FieldRubricScores holds the string denoting the rubric_scores field in the database.
+	FieldRubricScores = "rubric_scores"
+	// FieldFeedback holds the string denoting the feedback field in the database.
+	FieldFeedback = "feedback"
+	// FieldGradedBy holds the string denoting the graded_by field in the database.
+	FieldGradedBy = "graded_by"
+	// FieldGradedAt holds the string denoting the graded_at field in the database.
+	FieldGradedAt = "graded_at"
+	// Table holds the table name of the submission in the database.
+	Table = "submissions"
+)
+
+// Columns holds all SQL columns for submission fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldInternshipID,
+	FieldAssignmentID,
+	FieldEnrollmentID,
+	FieldUserID,
+	FieldAttempt,
+	FieldSubmissionType,
+	FieldFileID,
+	FieldRepositoryURL,
+	FieldText,
+	FieldSubmittedAt,
+	FieldDueAt,
+	FieldLateDays,
+	FieldSubmissionStatus,
+	FieldScore,
+	FieldPenalty,
+	FieldRubricScores,
+	FieldFeedback,
+	FieldGradedBy,
+	FieldGradedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InternshipIDValidator is a validator for the "internship_id" field. It is called by the builders before save.
+	InternshipIDValidator func(string) error
+	// AssignmentIDValidator is a validator for the "assignment_id" field. It is called by the builders before save.
+	AssignmentIDValidator func(string) error
+	// EnrollmentIDValidator is a validator for the "enrollment_id" field. It is called by the builders before save.
+	EnrollmentIDValidator func(string) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	AttemptValidator func(int) error
+	// SubmissionTypeValidator is a validator for the "submission_type" field. It is called by the builders before save.
+	SubmissionTypeValidator func(string) error
+	// DefaultLateDays holds the default value on creation for the "late_days" field.
+	DefaultLateDays int
+	// LateDaysValidator is a validator for the "late_days" field. It is called by the builders before save.
+	LateDaysValidator func(int) error
+	// DefaultSubmissionStatus holds the default value on creation for the "submission_status" field.
+	DefaultSubmissionStatus string
+	// DefaultPenalty holds the default value on creation for the "penalty" field.
+	DefaultPenalty float64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Submission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByInternshipID orders the results by the internship_id field.
+func ByInternshipID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternshipID, opts...).ToFunc()
+}
+
+// ByAssignmentID orders the results by the assignment_id field.
+func ByAssignmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignmentID, opts...).ToFunc()
+}
+
+// ByEnrollmentID orders the results by the enrollment_id field.
+func ByEnrollmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrollmentID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAttempt orders the results by the attempt field.
+func ByAttempt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempt, opts...).ToFunc()
+}
+
+// BySubmissionType orders the results by the submission_type field.
+func BySubmissionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionType, opts...).ToFunc()
+}
+
+// ByFileID orders the results by the file_id field.
+func ByFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileID, opts...).ToFunc()
+}
+
+// ByRepositoryURL orders the results by the repository_url field.
+func ByRepositoryURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepositoryURL, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByLateDays orders the results by the late_days field.
+func ByLateDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateDays, opts...).ToFunc()
+}
+
+// BySubmissionStatus orders the results by the submission_status field.
+func BySubmissionStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionStatus, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByPenalty orders the results by the penalty field.
+func ByPenalty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPenalty, opts...).ToFunc()
+}
+
+// ByFeedback orders the results by the feedback field.
+func ByFeedback(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedback, opts...).ToFunc()
+}
+
+// ByGradedBy orders the results by the graded_by field.
+func ByGradedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGradedBy, opts...).ToFunc()
+}
+
+// ByGradedAt orders the results by the graded_at field.
+func ByGradedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGradedAt, opts...).ToFunc()
+}
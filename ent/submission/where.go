@@ -0,0 +1,1575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package submission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// InternshipID applies equality check predicate on the "internship_id" field. It's identical to InternshipIDEQ.
+func InternshipID(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// AssignmentID applies equality check predicate on the "assignment_id" field. It's identical to AssignmentIDEQ.
+func AssignmentID(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldAssignmentID, v))
+}
+
+// EnrollmentID applies equality check predicate on the "enrollment_id" field. It's identical to EnrollmentIDEQ.
+func EnrollmentID(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldUserID, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldAttempt, v))
+}
+
+// SubmissionType applies equality check predicate on the "submission_type" field. It's identical to SubmissionTypeEQ.
+func SubmissionType(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldSubmissionType, v))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldFileID, v))
+}
+
+// RepositoryURL applies equality check predicate on the "repository_url" field. It's identical to RepositoryURLEQ.
+func RepositoryURL(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldRepositoryURL, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldText, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldDueAt, v))
+}
+
+// LateDays applies equality check predicate on the "late_days" field. It's identical to LateDaysEQ.
+func LateDays(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldLateDays, v))
+}
+
+// SubmissionStatus applies equality check predicate on the "submission_status" field. It's identical to SubmissionStatusEQ.
+func SubmissionStatus(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldSubmissionStatus, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldScore, v))
+}
+
+// Penalty applies equality check predicate on the "penalty" field. It's identical to PenaltyEQ.
+func Penalty(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldPenalty, v))
+}
+
+// Feedback applies equality check predicate on the "feedback" field. It's identical to FeedbackEQ.
+func Feedback(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldFeedback, v))
+}
+
+// GradedBy applies equality check predicate on the "graded_by" field. It's identical to GradedByEQ.
+func GradedBy(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldGradedBy, v))
+}
+
+// GradedAt applies equality check predicate on the "graded_at" field. It's identical to GradedAtEQ.
+func GradedAt(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldGradedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// InternshipIDEQ applies the EQ predicate on the "internship_id" field.
+func InternshipIDEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldInternshipID, v))
+}
+
+// InternshipIDNEQ applies the NEQ predicate on the "internship_id" field.
+func InternshipIDNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldInternshipID, v))
+}
+
+// InternshipIDIn applies the In predicate on the "internship_id" field.
+func InternshipIDIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDNotIn applies the NotIn predicate on the "internship_id" field.
+func InternshipIDNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldInternshipID, vs...))
+}
+
+// InternshipIDGT applies the GT predicate on the "internship_id" field.
+func InternshipIDGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldInternshipID, v))
+}
+
+// InternshipIDGTE applies the GTE predicate on the "internship_id" field.
+func InternshipIDGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldInternshipID, v))
+}
+
+// InternshipIDLT applies the LT predicate on the "internship_id" field.
+func InternshipIDLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldInternshipID, v))
+}
+
+// InternshipIDLTE applies the LTE predicate on the "internship_id" field.
+func InternshipIDLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldInternshipID, v))
+}
+
+// InternshipIDContains applies the Contains predicate on the "internship_id" field.
+func InternshipIDContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldInternshipID, v))
+}
+
+// InternshipIDHasPrefix applies the HasPrefix predicate on the "internship_id" field.
+func InternshipIDHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldInternshipID, v))
+}
+
+// InternshipIDHasSuffix applies the HasSuffix predicate on the "internship_id" field.
+func InternshipIDHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldInternshipID, v))
+}
+
+// InternshipIDEqualFold applies the EqualFold predicate on the "internship_id" field.
+func InternshipIDEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldInternshipID, v))
+}
+
+// InternshipIDContainsFold applies the ContainsFold predicate on the "internship_id" field.
+func InternshipIDContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldInternshipID, v))
+}
+
+// AssignmentIDEQ applies the EQ predicate on the "assignment_id" field.
+func AssignmentIDEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldAssignmentID, v))
+}
+
+// AssignmentIDNEQ applies the NEQ predicate on the "assignment_id" field.
+func AssignmentIDNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldAssignmentID, v))
+}
+
+// AssignmentIDIn applies the In predicate on the "assignment_id" field.
+func AssignmentIDIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldAssignmentID, vs...))
+}
+
+// AssignmentIDNotIn applies the NotIn predicate on the "assignment_id" field.
+func AssignmentIDNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldAssignmentID, vs...))
+}
+
+// AssignmentIDGT applies the GT predicate on the "assignment_id" field.
+func AssignmentIDGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldAssignmentID, v))
+}
+
+// AssignmentIDGTE applies the GTE predicate on the "assignment_id" field.
+func AssignmentIDGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldAssignmentID, v))
+}
+
+// AssignmentIDLT applies the LT predicate on the "assignment_id" field.
+func AssignmentIDLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldAssignmentID, v))
+}
+
+// AssignmentIDLTE applies the LTE predicate on the "assignment_id" field.
+func AssignmentIDLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldAssignmentID, v))
+}
+
+// AssignmentIDContains applies the Contains predicate on the "assignment_id" field.
+func AssignmentIDContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldAssignmentID, v))
+}
+
+// AssignmentIDHasPrefix applies the HasPrefix predicate on the "assignment_id" field.
+func AssignmentIDHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldAssignmentID, v))
+}
+
+// AssignmentIDHasSuffix applies the HasSuffix predicate on the "assignment_id" field.
+func AssignmentIDHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldAssignmentID, v))
+}
+
+// AssignmentIDEqualFold applies the EqualFold predicate on the "assignment_id" field.
+func AssignmentIDEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldAssignmentID, v))
+}
+
+// AssignmentIDContainsFold applies the ContainsFold predicate on the "assignment_id" field.
+func AssignmentIDContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldAssignmentID, v))
+}
+
+// EnrollmentIDEQ applies the EQ predicate on the "enrollment_id" field.
+func EnrollmentIDEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDNEQ applies the NEQ predicate on the "enrollment_id" field.
+func EnrollmentIDNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDIn applies the In predicate on the "enrollment_id" field.
+func EnrollmentIDIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDNotIn applies the NotIn predicate on the "enrollment_id" field.
+func EnrollmentIDNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldEnrollmentID, vs...))
+}
+
+// EnrollmentIDGT applies the GT predicate on the "enrollment_id" field.
+func EnrollmentIDGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDGTE applies the GTE predicate on the "enrollment_id" field.
+func EnrollmentIDGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLT applies the LT predicate on the "enrollment_id" field.
+func EnrollmentIDLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDLTE applies the LTE predicate on the "enrollment_id" field.
+func EnrollmentIDLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContains applies the Contains predicate on the "enrollment_id" field.
+func EnrollmentIDContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasPrefix applies the HasPrefix predicate on the "enrollment_id" field.
+func EnrollmentIDHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDHasSuffix applies the HasSuffix predicate on the "enrollment_id" field.
+func EnrollmentIDHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDEqualFold applies the EqualFold predicate on the "enrollment_id" field.
+func EnrollmentIDEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldEnrollmentID, v))
+}
+
+// EnrollmentIDContainsFold applies the ContainsFold predicate on the "enrollment_id" field.
+func EnrollmentIDContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldEnrollmentID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldAttempt, v))
+}
+
+// SubmissionTypeEQ applies the EQ predicate on the "submission_type" field.
+func SubmissionTypeEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldSubmissionType, v))
+}
+
+// SubmissionTypeNEQ applies the NEQ predicate on the "submission_type" field.
+func SubmissionTypeNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldSubmissionType, v))
+}
+
+// SubmissionTypeIn applies the In predicate on the "submission_type" field.
+func SubmissionTypeIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldSubmissionType, vs...))
+}
+
+// SubmissionTypeNotIn applies the NotIn predicate on the "submission_type" field.
+func SubmissionTypeNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldSubmissionType, vs...))
+}
+
+// SubmissionTypeGT applies the GT predicate on the "submission_type" field.
+func SubmissionTypeGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldSubmissionType, v))
+}
+
+// SubmissionTypeGTE applies the GTE predicate on the "submission_type" field.
+func SubmissionTypeGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldSubmissionType, v))
+}
+
+// SubmissionTypeLT applies the LT predicate on the "submission_type" field.
+func SubmissionTypeLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldSubmissionType, v))
+}
+
+// SubmissionTypeLTE applies the LTE predicate on the "submission_type" field.
+func SubmissionTypeLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldSubmissionType, v))
+}
+
+// SubmissionTypeContains applies the Contains predicate on the "submission_type" field.
+func SubmissionTypeContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldSubmissionType, v))
+}
+
+// SubmissionTypeHasPrefix applies the HasPrefix predicate on the "submission_type" field.
+func SubmissionTypeHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldSubmissionType, v))
+}
+
+// SubmissionTypeHasSuffix applies the HasSuffix predicate on the "submission_type" field.
+func SubmissionTypeHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldSubmissionType, v))
+}
+
+// SubmissionTypeEqualFold applies the EqualFold predicate on the "submission_type" field.
+func SubmissionTypeEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldSubmissionType, v))
+}
+
+// SubmissionTypeContainsFold applies the ContainsFold predicate on the "submission_type" field.
+func SubmissionTypeContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldSubmissionType, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// FileIDGT applies the GT predicate on the "file_id" field.
+func FileIDGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldFileID, v))
+}
+
+// FileIDGTE applies the GTE predicate on the "file_id" field.
+func FileIDGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldFileID, v))
+}
+
+// FileIDLT applies the LT predicate on the "file_id" field.
+func FileIDLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldFileID, v))
+}
+
+// FileIDLTE applies the LTE predicate on the "file_id" field.
+func FileIDLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldFileID, v))
+}
+
+// FileIDContains applies the Contains predicate on the "file_id" field.
+func FileIDContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldFileID, v))
+}
+
+// FileIDHasPrefix applies the HasPrefix predicate on the "file_id" field.
+func FileIDHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldFileID, v))
+}
+
+// FileIDHasSuffix applies the HasSuffix predicate on the "file_id" field.
+func FileIDHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldFileID, v))
+}
+
+// FileIDIsNil applies the IsNil predicate on the "file_id" field.
+func FileIDIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldFileID))
+}
+
+// FileIDNotNil applies the NotNil predicate on the "file_id" field.
+func FileIDNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldFileID))
+}
+
+// FileIDEqualFold applies the EqualFold predicate on the "file_id" field.
+func FileIDEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldFileID, v))
+}
+
+// FileIDContainsFold applies the ContainsFold predicate on the "file_id" field.
+func FileIDContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldFileID, v))
+}
+
+// RepositoryURLEQ applies the EQ predicate on the "repository_url" field.
+func RepositoryURLEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldRepositoryURL, v))
+}
+
+// RepositoryURLNEQ applies the NEQ predicate on the "repository_url" field.
+func RepositoryURLNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldRepositoryURL, v))
+}
+
+// RepositoryURLIn applies the In predicate on the "repository_url" field.
+func RepositoryURLIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldRepositoryURL, vs...))
+}
+
+// RepositoryURLNotIn applies the NotIn predicate on the "repository_url" field.
+func RepositoryURLNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldRepositoryURL, vs...))
+}
+
+// RepositoryURLGT applies the GT predicate on the "repository_url" field.
+func RepositoryURLGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldRepositoryURL, v))
+}
+
+// RepositoryURLGTE applies the GTE predicate on the "repository_url" field.
+func RepositoryURLGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldRepositoryURL, v))
+}
+
+// RepositoryURLLT applies the LT predicate on the "repository_url" field.
+func RepositoryURLLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldRepositoryURL, v))
+}
+
+// RepositoryURLLTE applies the LTE predicate on the "repository_url" field.
+func RepositoryURLLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldRepositoryURL, v))
+}
+
+// RepositoryURLContains applies the Contains predicate on the "repository_url" field.
+func RepositoryURLContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldRepositoryURL, v))
+}
+
+// RepositoryURLHasPrefix applies the HasPrefix predicate on the "repository_url" field.
+func RepositoryURLHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldRepositoryURL, v))
+}
+
+// RepositoryURLHasSuffix applies the HasSuffix predicate on the "repository_url" field.
+func RepositoryURLHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldRepositoryURL, v))
+}
+
+// RepositoryURLIsNil applies the IsNil predicate on the "repository_url" field.
+func RepositoryURLIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldRepositoryURL))
+}
+
+// RepositoryURLNotNil applies the NotNil predicate on the "repository_url" field.
+func RepositoryURLNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldRepositoryURL))
+}
+
+// RepositoryURLEqualFold applies the EqualFold predicate on the "repository_url" field.
+func RepositoryURLEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldRepositoryURL, v))
+}
+
+// RepositoryURLContainsFold applies the ContainsFold predicate on the "repository_url" field.
+func RepositoryURLContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldRepositoryURL, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextIsNil applies the IsNil predicate on the "text" field.
+func TextIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldText))
+}
+
+// TextNotNil applies the NotNil predicate on the "text" field.
+func TextNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldText))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldText, v))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldDueAt))
+}
+
+// LateDaysEQ applies the EQ predicate on the "late_days" field.
+func LateDaysEQ(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldLateDays, v))
+}
+
+// LateDaysNEQ applies the NEQ predicate on the "late_days" field.
+func LateDaysNEQ(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldLateDays, v))
+}
+
+// LateDaysIn applies the In predicate on the "late_days" field.
+func LateDaysIn(vs ...int) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldLateDays, vs...))
+}
+
+// LateDaysNotIn applies the NotIn predicate on the "late_days" field.
+func LateDaysNotIn(vs ...int) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldLateDays, vs...))
+}
+
+// LateDaysGT applies the GT predicate on the "late_days" field.
+func LateDaysGT(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldLateDays, v))
+}
+
+// LateDaysGTE applies the GTE predicate on the "late_days" field.
+func LateDaysGTE(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldLateDays, v))
+}
+
+// LateDaysLT applies the LT predicate on the "late_days" field.
+func LateDaysLT(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldLateDays, v))
+}
+
+// LateDaysLTE applies the LTE predicate on the "late_days" field.
+func LateDaysLTE(v int) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldLateDays, v))
+}
+
+// SubmissionStatusEQ applies the EQ predicate on the "submission_status" field.
+func SubmissionStatusEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusNEQ applies the NEQ predicate on the "submission_status" field.
+func SubmissionStatusNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusIn applies the In predicate on the "submission_status" field.
+func SubmissionStatusIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldSubmissionStatus, vs...))
+}
+
+// SubmissionStatusNotIn applies the NotIn predicate on the "submission_status" field.
+func SubmissionStatusNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldSubmissionStatus, vs...))
+}
+
+// SubmissionStatusGT applies the GT predicate on the "submission_status" field.
+func SubmissionStatusGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusGTE applies the GTE predicate on the "submission_status" field.
+func SubmissionStatusGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusLT applies the LT predicate on the "submission_status" field.
+func SubmissionStatusLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusLTE applies the LTE predicate on the "submission_status" field.
+func SubmissionStatusLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusContains applies the Contains predicate on the "submission_status" field.
+func SubmissionStatusContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusHasPrefix applies the HasPrefix predicate on the "submission_status" field.
+func SubmissionStatusHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusHasSuffix applies the HasSuffix predicate on the "submission_status" field.
+func SubmissionStatusHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusEqualFold applies the EqualFold predicate on the "submission_status" field.
+func SubmissionStatusEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldSubmissionStatus, v))
+}
+
+// SubmissionStatusContainsFold applies the ContainsFold predicate on the "submission_status" field.
+func SubmissionStatusContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldSubmissionStatus, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldScore))
+}
+
+// PenaltyEQ applies the EQ predicate on the "penalty" field.
+func PenaltyEQ(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldPenalty, v))
+}
+
+// PenaltyNEQ applies the NEQ predicate on the "penalty" field.
+func PenaltyNEQ(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldPenalty, v))
+}
+
+// PenaltyIn applies the In predicate on the "penalty" field.
+func PenaltyIn(vs ...float64) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldPenalty, vs...))
+}
+
+// PenaltyNotIn applies the NotIn predicate on the "penalty" field.
+func PenaltyNotIn(vs ...float64) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldPenalty, vs...))
+}
+
+// PenaltyGT applies the GT predicate on the "penalty" field.
+func PenaltyGT(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldPenalty, v))
+}
+
+// PenaltyGTE applies the GTE predicate on the "penalty" field.
+func PenaltyGTE(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldPenalty, v))
+}
+
+// PenaltyLT applies the LT predicate on the "penalty" field.
+func PenaltyLT(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldPenalty, v))
+}
+
+// PenaltyLTE applies the LTE predicate on the "penalty" field.
+func PenaltyLTE(v float64) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldPenalty, v))
+}
+
+// RubricScoresIsNil applies the IsNil predicate on the "rubric_scores" field.
+func RubricScoresIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldRubricScores))
+}
+
+// RubricScoresNotNil applies the NotNil predicate on the "rubric_scores" field.
+func RubricScoresNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldRubricScores))
+}
+
+// FeedbackEQ applies the EQ predicate on the "feedback" field.
+func FeedbackEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldFeedback, v))
+}
+
+// FeedbackNEQ applies the NEQ predicate on the "feedback" field.
+func FeedbackNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldFeedback, v))
+}
+
+// FeedbackIn applies the In predicate on the "feedback" field.
+func FeedbackIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldFeedback, vs...))
+}
+
+// FeedbackNotIn applies the NotIn predicate on the "feedback" field.
+func FeedbackNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldFeedback, vs...))
+}
+
+// FeedbackGT applies the GT predicate on the "feedback" field.
+func FeedbackGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldFeedback, v))
+}
+
+// FeedbackGTE applies the GTE predicate on the "feedback" field.
+func FeedbackGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldFeedback, v))
+}
+
+// FeedbackLT applies the LT predicate on the "feedback" field.
+func FeedbackLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldFeedback, v))
+}
+
+// FeedbackLTE applies the LTE predicate on the "feedback" field.
+func FeedbackLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldFeedback, v))
+}
+
+// FeedbackContains applies the Contains predicate on the "feedback" field.
+func FeedbackContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldFeedback, v))
+}
+
+// FeedbackHasPrefix applies the HasPrefix predicate on the "feedback" field.
+func FeedbackHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldFeedback, v))
+}
+
+// FeedbackHasSuffix applies the HasSuffix predicate on the "feedback" field.
+func FeedbackHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldFeedback, v))
+}
+
+// FeedbackIsNil applies the IsNil predicate on the "feedback" field.
+func FeedbackIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldFeedback))
+}
+
+// FeedbackNotNil applies the NotNil predicate on the "feedback" field.
+func FeedbackNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldFeedback))
+}
+
+// FeedbackEqualFold applies the EqualFold predicate on the "feedback" field.
+func FeedbackEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldFeedback, v))
+}
+
+// FeedbackContainsFold applies the ContainsFold predicate on the "feedback" field.
+func FeedbackContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldFeedback, v))
+}
+
+// GradedByEQ applies the EQ predicate on the "graded_by" field.
+func GradedByEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldGradedBy, v))
+}
+
+// GradedByNEQ applies the NEQ predicate on the "graded_by" field.
+func GradedByNEQ(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldGradedBy, v))
+}
+
+// GradedByIn applies the In predicate on the "graded_by" field.
+func GradedByIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldGradedBy, vs...))
+}
+
+// GradedByNotIn applies the NotIn predicate on the "graded_by" field.
+func GradedByNotIn(vs ...string) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldGradedBy, vs...))
+}
+
+// GradedByGT applies the GT predicate on the "graded_by" field.
+func GradedByGT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldGradedBy, v))
+}
+
+// GradedByGTE applies the GTE predicate on the "graded_by" field.
+func GradedByGTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldGradedBy, v))
+}
+
+// GradedByLT applies the LT predicate on the "graded_by" field.
+func GradedByLT(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldGradedBy, v))
+}
+
+// GradedByLTE applies the LTE predicate on the "graded_by" field.
+func GradedByLTE(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldGradedBy, v))
+}
+
+// GradedByContains applies the Contains predicate on the "graded_by" field.
+func GradedByContains(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContains(FieldGradedBy, v))
+}
+
+// GradedByHasPrefix applies the HasPrefix predicate on the "graded_by" field.
+func GradedByHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasPrefix(FieldGradedBy, v))
+}
+
+// GradedByHasSuffix applies the HasSuffix predicate on the "graded_by" field.
+func GradedByHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldHasSuffix(FieldGradedBy, v))
+}
+
+// GradedByIsNil applies the IsNil predicate on the "graded_by" field.
+func GradedByIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldGradedBy))
+}
+
+// GradedByNotNil applies the NotNil predicate on the "graded_by" field.
+func GradedByNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldGradedBy))
+}
+
+// GradedByEqualFold applies the EqualFold predicate on the "graded_by" field.
+func GradedByEqualFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldEqualFold(FieldGradedBy, v))
+}
+
+// GradedByContainsFold applies the ContainsFold predicate on the "graded_by" field.
+func GradedByContainsFold(v string) predicate.Submission {
+	return predicate.Submission(sql.FieldContainsFold(FieldGradedBy, v))
+}
+
+// GradedAtEQ applies the EQ predicate on the "graded_at" field.
+func GradedAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldEQ(FieldGradedAt, v))
+}
+
+// GradedAtNEQ applies the NEQ predicate on the "graded_at" field.
+func GradedAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNEQ(FieldGradedAt, v))
+}
+
+// GradedAtIn applies the In predicate on the "graded_at" field.
+func GradedAtIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldIn(FieldGradedAt, vs...))
+}
+
+// GradedAtNotIn applies the NotIn predicate on the "graded_at" field.
+func GradedAtNotIn(vs ...time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldNotIn(FieldGradedAt, vs...))
+}
+
+// GradedAtGT applies the GT predicate on the "graded_at" field.
+func GradedAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGT(FieldGradedAt, v))
+}
+
+// GradedAtGTE applies the GTE predicate on the "graded_at" field.
+func GradedAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldGTE(FieldGradedAt, v))
+}
+
+// GradedAtLT applies the LT predicate on the "graded_at" field.
+func GradedAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLT(FieldGradedAt, v))
+}
+
+// GradedAtLTE applies the LTE predicate on the "graded_at" field.
+func GradedAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(sql.FieldLTE(FieldGradedAt, v))
+}
+
+// GradedAtIsNil applies the IsNil predicate on the "graded_at" field.
+func GradedAtIsNil() predicate.Submission {
+	return predicate.Submission(sql.FieldIsNull(FieldGradedAt))
+}
+
+// GradedAtNotNil applies the NotNil predicate on the "graded_at" field.
+func GradedAtNotNil() predicate.Submission {
+	return predicate.Submission(sql.FieldNotNull(FieldGradedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Submission) predicate.Submission {
+	return predicate.Submission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Submission) predicate.Submission {
+	return predicate.Submission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Submission) predicate.Submission {
+	return predicate.Submission(sql.NotPredicates(p))
+}
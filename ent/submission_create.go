@@ -0,0 +1,667 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/submission"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubmissionCreate is the builder for creating a Submission entity.
+type SubmissionCreate struct {
+	config
+	mutation *SubmissionMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (sc *SubmissionCreate) SetStatus(s string) *SubmissionCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableStatus(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SubmissionCreate) SetCreatedAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableCreatedAt(t *time.Time) *SubmissionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SubmissionCreate) SetUpdatedAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableUpdatedAt(t *time.Time) *SubmissionCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sc *SubmissionCreate) SetCreatedBy(s string) *SubmissionCreate {
+	sc.mutation.SetCreatedBy(s)
+	return sc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableCreatedBy(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetCreatedBy(*s)
+	}
+	return sc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sc *SubmissionCreate) SetUpdatedBy(s string) *SubmissionCreate {
+	sc.mutation.SetUpdatedBy(s)
+	return sc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableUpdatedBy(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetUpdatedBy(*s)
+	}
+	return sc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (sc *SubmissionCreate) SetInternshipID(s string) *SubmissionCreate {
+	sc.mutation.SetInternshipID(s)
+	return sc
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (sc *SubmissionCreate) SetAssignmentID(s string) *SubmissionCreate {
+	sc.mutation.SetAssignmentID(s)
+	return sc
+}
+
+// SetEnrollmentID sets the "enrollment_id" field.
+func (sc *SubmissionCreate) SetEnrollmentID(s string) *SubmissionCreate {
+	sc.mutation.SetEnrollmentID(s)
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *SubmissionCreate) SetUserID(s string) *SubmissionCreate {
+	sc.mutation.SetUserID(s)
+	return sc
+}
+
+// SetAttempt sets the "attempt" field.
+func (sc *SubmissionCreate) SetAttempt(i int) *SubmissionCreate {
+	sc.mutation.SetAttempt(i)
+	return sc
+}
+
+// SetSubmissionType sets the "submission_type" field.
+func (sc *SubmissionCreate) SetSubmissionType(s string) *SubmissionCreate {
+	sc.mutation.SetSubmissionType(s)
+	return sc
+}
+
+// SetFileID sets the "file_id" field.
+func (sc *SubmissionCreate) SetFileID(s string) *SubmissionCreate {
+	sc.mutation.SetFileID(s)
+	return sc
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableFileID(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetFileID(*s)
+	}
+	return sc
+}
+
+// SetRepositoryURL sets the "repository_url" field.
+func (sc *SubmissionCreate) SetRepositoryURL(s string) *SubmissionCreate {
+	sc.mutation.SetRepositoryURL(s)
+	return sc
+}
+
+// SetNillableRepositoryURL sets the "repository_url" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableRepositoryURL(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetRepositoryURL(*s)
+	}
+	return sc
+}
+
+// SetText sets the "text" field.
+func (sc *SubmissionCreate) SetText(s string) *SubmissionCreate {
+	sc.mutation.SetText(s)
+	return sc
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableText(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetText(*s)
+	}
+	return sc
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (sc *SubmissionCreate) SetSubmittedAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetSubmittedAt(t)
+	return sc
+}
+
+// SetDueAt sets the "due_at" field.
+func (sc *SubmissionCreate) SetDueAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetDueAt(t)
+	return sc
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableDueAt(t *time.Time) *SubmissionCreate {
+	if t != nil {
+		sc.SetDueAt(*t)
+	}
+	return sc
+}
+
+// SetLateDays sets the "late_days" field.
+func (sc *SubmissionCreate) SetLateDays(i int) *SubmissionCreate {
+	sc.mutation.SetLateDays(i)
+	return sc
+}
+
+// SetNillableLateDays sets the "late_days" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableLateDays(i *int) *SubmissionCreate {
+	if i != nil {
+		sc.SetLateDays(*i)
+	}
+	return sc
+}
+
+// SetSubmissionStatus sets the "submission_status" field.
+func (sc *SubmissionCreate) SetSubmissionStatus(s string) *SubmissionCreate {
+	sc.mutation.SetSubmissionStatus(s)
+	return sc
+}
+
+// SetNillableSubmissionStatus sets the "submission_status" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableSubmissionStatus(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetSubmissionStatus(*s)
+	}
+	return sc
+}
+
+// SetScore sets the "score" field.
+func (sc *SubmissionCreate) SetScore(f float64) *SubmissionCreate {
+	sc.mutation.SetScore(f)
+	return sc
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableScore(f *float64) *SubmissionCreate {
+	if f != nil {
+		sc.SetScore(*f)
+	}
+	return sc
+}
+
+// SetPenalty sets the "penalty" field.
+func (sc *SubmissionCreate) SetPenalty(f float64) *SubmissionCreate {
+	sc.mutation.SetPenalty(f)
+	return sc
+}
+
+// SetNillablePenalty sets the "penalty" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillablePenalty(f *float64) *SubmissionCreate {
+	if f != nil {
+		sc.SetPenalty(*f)
+	}
+	return sc
+}
+
+// SetRubricScores sets the "rubric_scores" field.
+func (sc *SubmissionCreate) SetRubricScores(ts []types.CriterionScore) *SubmissionCreate {
+	sc.mutation.SetRubricScores(ts)
+	return sc
+}
+
+// SetFeedback sets the "feedback" field.
+func (sc *SubmissionCreate) SetFeedback(s string) *SubmissionCreate {
+	sc.mutation.SetFeedback(s)
+	return sc
+}
+
+// SetNillableFeedback sets the "feedback" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableFeedback(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetFeedback(*s)
+	}
+	return sc
+}
+
+// SetGradedBy sets the "graded_by" field.
+func (sc *SubmissionCreate) SetGradedBy(s string) *SubmissionCreate {
+	sc.mutation.SetGradedBy(s)
+	return sc
+}
+
+// SetNillableGradedBy sets the "graded_by" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableGradedBy(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetGradedBy(*s)
+	}
+	return sc
+}
+
+// SetGradedAt sets the "graded_at" field.
+func (sc *SubmissionCreate) SetGradedAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetGradedAt(t)
+	return sc
+}
+
+// SetNillableGradedAt sets the "graded_at" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableGradedAt(t *time.Time) *SubmissionCreate {
+	if t != nil {
+		sc.SetGradedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SubmissionCreate) SetID(s string) *SubmissionCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableID(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetID(*s)
+	}
+	return sc
+}
+
+// Mutation returns the SubmissionMutation object of the builder.
+func (sc *SubmissionCreate) Mutation() *SubmissionMutation {
+	return sc.mutation
+}
+
+// Save creates the Submission in the database.
+func (sc *SubmissionCreate) Save(ctx context.Context) (*Submission, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SubmissionCreate) SaveX(ctx context.Context) *Submission {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SubmissionCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SubmissionCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SubmissionCreate) defaults() {
+	if _, ok := sc.mutation.Status(); !ok {
+		v := submission.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := submission.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := submission.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.LateDays(); !ok {
+		v := submission.DefaultLateDays
+		sc.mutation.SetLateDays(v)
+	}
+	if _, ok := sc.mutation.SubmissionStatus(); !ok {
+		v := submission.DefaultSubmissionStatus
+		sc.mutation.SetSubmissionStatus(v)
+	}
+	if _, ok := sc.mutation.Penalty(); !ok {
+		v := submission.DefaultPenalty
+		sc.mutation.SetPenalty(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := submission.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SubmissionCreate) check() error {
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Submission.status"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Submission.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Submission.updated_at"`)}
+	}
+	if _, ok := sc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Submission.internship_id"`)}
+	}
+	if v, ok := sc.mutation.InternshipID(); ok {
+		if err := submission.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Submission.internship_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.AssignmentID(); !ok {
+		return &ValidationError{Name: "assignment_id", err: errors.New(`ent: missing required field "Submission.assignment_id"`)}
+	}
+	if v, ok := sc.mutation.AssignmentID(); ok {
+		if err := submission.AssignmentIDValidator(v); err != nil {
+			return &ValidationError{Name: "assignment_id", err: fmt.Errorf(`ent: validator failed for field "Submission.assignment_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.EnrollmentID(); !ok {
+		return &ValidationError{Name: "enrollment_id", err: errors.New(`ent: missing required field "Submission.enrollment_id"`)}
+	}
+	if v, ok := sc.mutation.EnrollmentID(); ok {
+		if err := submission.EnrollmentIDValidator(v); err != nil {
+			return &ValidationError{Name: "enrollment_id", err: fmt.Errorf(`ent: validator failed for field "Submission.enrollment_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Submission.user_id"`)}
+	}
+	if v, ok := sc.mutation.UserID(); ok {
+		if err := submission.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Submission.user_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "Submission.attempt"`)}
+	}
+	if v, ok := sc.mutation.Attempt(); ok {
+		if err := submission.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "Submission.attempt": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SubmissionType(); !ok {
+		return &ValidationError{Name: "submission_type", err: errors.New(`ent: missing required field "Submission.submission_type"`)}
+	}
+	if v, ok := sc.mutation.SubmissionType(); ok {
+		if err := submission.SubmissionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "submission_type", err: fmt.Errorf(`ent: validator failed for field "Submission.submission_type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SubmittedAt(); !ok {
+		return &ValidationError{Name: "submitted_at", err: errors.New(`ent: missing required field "Submission.submitted_at"`)}
+	}
+	if _, ok := sc.mutation.LateDays(); !ok {
+		return &ValidationError{Name: "late_days", err: errors.New(`ent: missing required field "Submission.late_days"`)}
+	}
+	if v, ok := sc.mutation.LateDays(); ok {
+		if err := submission.LateDaysValidator(v); err != nil {
+			return &ValidationError{Name: "late_days", err: fmt.Errorf(`ent: validator failed for field "Submission.late_days": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SubmissionStatus(); !ok {
+		return &ValidationError{Name: "submission_status", err: errors.New(`ent: missing required field "Submission.submission_status"`)}
+	}
+	if _, ok := sc.mutation.Penalty(); !ok {
+		return &ValidationError{Name: "penalty", err: errors.New(`ent: missing required field "Submission.penalty"`)}
+	}
+	return nil
+}
+
+func (sc *SubmissionCreate) sqlSave(ctx context.Context) (*Submission, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Submission.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SubmissionCreate) createSpec() (*Submission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Submission{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(submission.Table, sqlgraph.NewFieldSpec(submission.FieldID, field.TypeString))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(submission.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(submission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(submission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.CreatedBy(); ok {
+		_spec.SetField(submission.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sc.mutation.UpdatedBy(); ok {
+		_spec.SetField(submission.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sc.mutation.InternshipID(); ok {
+		_spec.SetField(submission.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	if value, ok := sc.mutation.AssignmentID(); ok {
+		_spec.SetField(submission.FieldAssignmentID, field.TypeString, value)
+		_node.AssignmentID = value
+	}
+	if value, ok := sc.mutation.EnrollmentID(); ok {
+		_spec.SetField(submission.FieldEnrollmentID, field.TypeString, value)
+		_node.EnrollmentID = value
+	}
+	if value, ok := sc.mutation.UserID(); ok {
+		_spec.SetField(submission.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := sc.mutation.Attempt(); ok {
+		_spec.SetField(submission.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := sc.mutation.SubmissionType(); ok {
+		_spec.SetField(submission.FieldSubmissionType, field.TypeString, value)
+		_node.SubmissionType = value
+	}
+	if value, ok := sc.mutation.FileID(); ok {
+		_spec.SetField(submission.FieldFileID, field.TypeString, value)
+		_node.FileID = &value
+	}
+	if value, ok := sc.mutation.RepositoryURL(); ok {
+		_spec.SetField(submission.FieldRepositoryURL, field.TypeString, value)
+		_node.RepositoryURL = &value
+	}
+	if value, ok := sc.mutation.Text(); ok {
+		_spec.SetField(submission.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := sc.mutation.SubmittedAt(); ok {
+		_spec.SetField(submission.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	if value, ok := sc.mutation.DueAt(); ok {
+		_spec.SetField(submission.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := sc.mutation.LateDays(); ok {
+		_spec.SetField(submission.FieldLateDays, field.TypeInt, value)
+		_node.LateDays = value
+	}
+	if value, ok := sc.mutation.SubmissionStatus(); ok {
+		_spec.SetField(submission.FieldSubmissionStatus, field.TypeString, value)
+		_node.SubmissionStatus = value
+	}
+	if value, ok := sc.mutation.Score(); ok {
+		_spec.SetField(submission.FieldScore, field.TypeFloat64, value)
+		_node.Score = &value
+	}
+	if value, ok := sc.mutation.Penalty(); ok {
+		_spec.SetField(submission.FieldPenalty, field.TypeFloat64, value)
+		_node.Penalty = value
+	}
+	if value, ok := sc.mutation.RubricScores(); ok {
+		_spec.SetField(submission.FieldRubricScores, field.TypeJSON, value)
+		_node.RubricScores = value
+	}
+	if value, ok := sc.mutation.Feedback(); ok {
+		_spec.SetField(submission.FieldFeedback, field.TypeString, value)
+		_node.Feedback = value
+	}
+	if value, ok := sc.mutation.GradedBy(); ok {
+		_spec.SetField(submission.FieldGradedBy, field.TypeString, value)
+		_node.GradedBy = &value
+	}
+	if value, ok := sc.mutation.GradedAt(); ok {
+		_spec.SetField(submission.FieldGradedAt, field.TypeTime, value)
+		_node.GradedAt = &value
+	}
+	return _node, _spec
+}
+
+// SubmissionCreateBulk is the builder for creating many Submission entities in bulk.
+type SubmissionCreateBulk struct {
+	config
+	err      error
+	builders []*SubmissionCreate
+}
+
+// Save creates the Submission entities in the database.
+func (scb *SubmissionCreateBulk) Save(ctx context.Context) ([]*Submission, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Submission, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubmissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SubmissionCreateBulk) SaveX(ctx context.Context) []*Submission {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SubmissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SubmissionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/submission"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubmissionUpdate is the builder for updating Submission entities.
+type SubmissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubmissionMutation
+}
+
+// Where appends a list predicates to the SubmissionUpdate builder.
+func (su *SubmissionUpdate) Where(ps ...predicate.Submission) *SubmissionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SubmissionUpdate) SetStatus(s string) *SubmissionUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableStatus(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SubmissionUpdate) SetUpdatedAt(t time.Time) *SubmissionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (su *SubmissionUpdate) SetUpdatedBy(s string) *SubmissionUpdate {
+	su.mutation.SetUpdatedBy(s)
+	return su
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableUpdatedBy(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetUpdatedBy(*s)
+	}
+	return su
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (su *SubmissionUpdate) ClearUpdatedBy() *SubmissionUpdate {
+	su.mutation.ClearUpdatedBy()
+	return su
+}
+
+// SetSubmissionStatus sets the "submission_status" field.
+func (su *SubmissionUpdate) SetSubmissionStatus(s string) *SubmissionUpdate {
+	su.mutation.SetSubmissionStatus(s)
+	return su
+}
+
+// SetNillableSubmissionStatus sets the "submission_status" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableSubmissionStatus(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetSubmissionStatus(*s)
+	}
+	return su
+}
+
+// SetScore sets the "score" field.
+func (su *SubmissionUpdate) SetScore(f float64) *SubmissionUpdate {
+	su.mutation.ResetScore()
+	su.mutation.SetScore(f)
+	return su
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableScore(f *float64) *SubmissionUpdate {
+	if f != nil {
+		su.SetScore(*f)
+	}
+	return su
+}
+
+// AddScore adds f to the "score" field.
+func (su *SubmissionUpdate) AddScore(f float64) *SubmissionUpdate {
+	su.mutation.AddScore(f)
+	return su
+}
+
+// ClearScore clears the value of the "score" field.
+func (su *SubmissionUpdate) ClearScore() *SubmissionUpdate {
+	su.mutation.ClearScore()
+	return su
+}
+
+// SetPenalty sets the "penalty" field.
+func (su *SubmissionUpdate) SetPenalty(f float64) *SubmissionUpdate {
+	su.mutation.ResetPenalty()
+	su.mutation.SetPenalty(f)
+	return su
+}
+
+// SetNillablePenalty sets the "penalty" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillablePenalty(f *float64) *SubmissionUpdate {
+	if f != nil {
+		su.SetPenalty(*f)
+	}
+	return su
+}
+
+// AddPenalty adds f to the "penalty" field.
+func (su *SubmissionUpdate) AddPenalty(f float64) *SubmissionUpdate {
+	su.mutation.AddPenalty(f)
+	return su
+}
+
+// SetRubricScores sets the "rubric_scores" field.
+func (su *SubmissionUpdate) SetRubricScores(ts []types.CriterionScore) *SubmissionUpdate {
+	su.mutation.SetRubricScores(ts)
+	return su
+}
+
+// AppendRubricScores appends ts to the "rubric_scores" field.
+func (su *SubmissionUpdate) AppendRubricScores(ts []types.CriterionScore) *SubmissionUpdate {
+	su.mutation.AppendRubricScores(ts)
+	return su
+}
+
+// ClearRubricScores clears the value of the "rubric_scores" field.
+func (su *SubmissionUpdate) ClearRubricScores() *SubmissionUpdate {
+	su.mutation.ClearRubricScores()
+	return su
+}
+
+// SetFeedback sets the "feedback" field.
+func (su *SubmissionUpdate) SetFeedback(s string) *SubmissionUpdate {
+	su.mutation.SetFeedback(s)
+	return su
+}
+
+// SetNillableFeedback sets the "feedback" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableFeedback(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetFeedback(*s)
+	}
+	return su
+}
+
+// ClearFeedback clears the value of the "feedback" field.
+func (su *SubmissionUpdate) ClearFeedback() *SubmissionUpdate {
+	su.mutation.ClearFeedback()
+	return su
+}
+
+// SetGradedBy sets the "graded_by" field.
+func (su *SubmissionUpdate) SetGradedBy(s string) *SubmissionUpdate {
+	su.mutation.SetGradedBy(s)
+	return su
+}
+
+// SetNillableGradedBy sets the "graded_by" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableGradedBy(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetGradedBy(*s)
+	}
+	return su
+}
+
+// ClearGradedBy clears the value of the "graded_by" field.
+func (su *SubmissionUpdate) ClearGradedBy() *SubmissionUpdate {
+	su.mutation.ClearGradedBy()
+	return su
+}
+
+// SetGradedAt sets the "graded_at" field.
+func (su *SubmissionUpdate) SetGradedAt(t time.Time) *SubmissionUpdate {
+	su.mutation.SetGradedAt(t)
+	return su
+}
+
+// SetNillableGradedAt sets the "graded_at" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableGradedAt(t *time.Time) *SubmissionUpdate {
+	if t != nil {
+		su.SetGradedAt(*t)
+	}
+	return su
+}
+
+// ClearGradedAt clears the value of the "graded_at" field.
+func (su *SubmissionUpdate) ClearGradedAt() *SubmissionUpdate {
+	su.mutation.ClearGradedAt()
+	return su
+}
+
+// Mutation returns the SubmissionMutation object of the builder.
+func (su *SubmissionUpdate) Mutation() *SubmissionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SubmissionUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubmissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubmissionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubmissionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SubmissionUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := submission.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (su *SubmissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(submission.Table, submission.Columns, sqlgraph.NewFieldSpec(submission.FieldID, field.TypeString))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(submission.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(submission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.CreatedByCleared() {
+		_spec.ClearField(submission.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := su.mutation.UpdatedBy(); ok {
+		_spec.SetField(submission.FieldUpdatedBy, field.TypeString, value)
+	}
+	if su.mutation.UpdatedByCleared() {
+		_spec.ClearField(submission.FieldUpdatedBy, field.TypeString)
+	}
+	if su.mutation.FileIDCleared() {
+		_spec.ClearField(submission.FieldFileID, field.TypeString)
+	}
+	if su.mutation.RepositoryURLCleared() {
+		_spec.ClearField(submission.FieldRepositoryURL, field.TypeString)
+	}
+	if su.mutation.TextCleared() {
+		_spec.ClearField(submission.FieldText, field.TypeString)
+	}
+	if su.mutation.DueAtCleared() {
+		_spec.ClearField(submission.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.SubmissionStatus(); ok {
+		_spec.SetField(submission.FieldSubmissionStatus, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Score(); ok {
+		_spec.SetField(submission.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.AddedScore(); ok {
+		_spec.AddField(submission.FieldScore, field.TypeFloat64, value)
+	}
+	if su.mutation.ScoreCleared() {
+		_spec.ClearField(submission.FieldScore, field.TypeFloat64)
+	}
+	if value, ok := su.mutation.Penalty(); ok {
+		_spec.SetField(submission.FieldPenalty, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.AddedPenalty(); ok {
+		_spec.AddField(submission.FieldPenalty, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.RubricScores(); ok {
+		_spec.SetField(submission.FieldRubricScores, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedRubricScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, submission.FieldRubricScores, value)
+		})
+	}
+	if su.mutation.RubricScoresCleared() {
+		_spec.ClearField(submission.FieldRubricScores, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Feedback(); ok {
+		_spec.SetField(submission.FieldFeedback, field.TypeString, value)
+	}
+	if su.mutation.FeedbackCleared() {
+		_spec.ClearField(submission.FieldFeedback, field.TypeString)
+	}
+	if value, ok := su.mutation.GradedBy(); ok {
+		_spec.SetField(submission.FieldGradedBy, field.TypeString, value)
+	}
+	if su.mutation.GradedByCleared() {
+		_spec.ClearField(submission.FieldGradedBy, field.TypeString)
+	}
+	if value, ok := su.mutation.GradedAt(); ok {
+		_spec.SetField(submission.FieldGradedAt, field.TypeTime, value)
+	}
+	if su.mutation.GradedAtCleared() {
+		_spec.ClearField(submission.FieldGradedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{submission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SubmissionUpdateOne is the builder for updating a single Submission entity.
+type SubmissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubmissionMutation
+}
+
+// SetStatus sets the "status" field.
+func (suo *SubmissionUpdateOne) SetStatus(s string) *SubmissionUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableStatus(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SubmissionUpdateOne) SetUpdatedAt(t time.Time) *SubmissionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (suo *SubmissionUpdateOne) SetUpdatedBy(s string) *SubmissionUpdateOne {
+	suo.mutation.SetUpdatedBy(s)
+	return suo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableUpdatedBy(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetUpdatedBy(*s)
+	}
+	return suo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (suo *SubmissionUpdateOne) ClearUpdatedBy() *SubmissionUpdateOne {
+	suo.mutation.ClearUpdatedBy()
+	return suo
+}
+
+// SetSubmissionStatus sets the "submission_status" field.
+func (suo *SubmissionUpdateOne) SetSubmissionStatus(s string) *SubmissionUpdateOne {
+	suo.mutation.SetSubmissionStatus(s)
+	return suo
+}
+
+// SetNillableSubmissionStatus sets the "submission_status" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableSubmissionStatus(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetSubmissionStatus(*s)
+	}
+	return suo
+}
+
+// SetScore sets the "score" field.
+func (suo *SubmissionUpdateOne) SetScore(f float64) *SubmissionUpdateOne {
+	suo.mutation.ResetScore()
+	suo.mutation.SetScore(f)
+	return suo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableScore(f *float64) *SubmissionUpdateOne {
+	if f != nil {
+		suo.SetScore(*f)
+	}
+	return suo
+}
+
+// AddScore adds f to the "score" field.
+func (suo *SubmissionUpdateOne) AddScore(f float64) *SubmissionUpdateOne {
+	suo.mutation.AddScore(f)
+	return suo
+}
+
+// ClearScore clears the value of the "score" field.
+func (suo *SubmissionUpdateOne) ClearScore() *SubmissionUpdateOne {
+	suo.mutation.ClearScore()
+	return suo
+}
+
+// SetPenalty sets the "penalty" field.
+func (suo *SubmissionUpdateOne) SetPenalty(f float64) *SubmissionUpdateOne {
+	suo.mutation.ResetPenalty()
+	suo.mutation.SetPenalty(f)
+	return suo
+}
+
+// SetNillablePenalty sets the "penalty" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillablePenalty(f *float64) *SubmissionUpdateOne {
+	if f != nil {
+		suo.SetPenalty(*f)
+	}
+	return suo
+}
+
+// AddPenalty adds f to the "penalty" field.
+func (suo *SubmissionUpdateOne) AddPenalty(f float64) *SubmissionUpdateOne {
+	suo.mutation.AddPenalty(f)
+	return suo
+}
+
+// SetRubricScores sets the "rubric_scores" field.
+func (suo *SubmissionUpdateOne) SetRubricScores(ts []types.CriterionScore) *SubmissionUpdateOne {
+	suo.mutation.SetRubricScores(ts)
+	return suo
+}
+
+// AppendRubricScores appends ts to the "rubric_scores" field.
+func (suo *SubmissionUpdateOne) AppendRubricScores(ts []types.CriterionScore) *SubmissionUpdateOne {
+	suo.mutation.AppendRubricScores(ts)
+	return suo
+}
+
+// ClearRubricScores clears the value of the "rubric_scores" field.
+func (suo *SubmissionUpdateOne) ClearRubricScores() *SubmissionUpdateOne {
+	suo.mutation.ClearRubricScores()
+	return suo
+}
+
+// SetFeedback sets the "feedback" field.
+func (suo *SubmissionUpdateOne) SetFeedback(s string) *SubmissionUpdateOne {
+	suo.mutation.SetFeedback(s)
+	return suo
+}
+
+// SetNillableFeedback sets the "feedback" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableFeedback(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetFeedback(*s)
+	}
+	return suo
+}
+
+// ClearFeedback clears the value of the "feedback" field.
+func (suo *SubmissionUpdateOne) ClearFeedback() *SubmissionUpdateOne {
+	suo.mutation.ClearFeedback()
+	return suo
+}
+
+// SetGradedBy sets the "graded_by" field.
+func (suo *SubmissionUpdateOne) SetGradedBy(s string) *SubmissionUpdateOne {
+	suo.mutation.SetGradedBy(s)
+	return suo
+}
+
+// SetNillableGradedBy sets the "graded_by" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableGradedBy(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetGradedBy(*s)
+	}
+	return suo
+}
+
+// ClearGradedBy clears the value of the "graded_by" field.
+func (suo *SubmissionUpdateOne) ClearGradedBy() *SubmissionUpdateOne {
+	suo.mutation.ClearGradedBy()
+	return suo
+}
+
+// SetGradedAt sets the "graded_at" field.
+func (suo *SubmissionUpdateOne) SetGradedAt(t time.Time) *SubmissionUpdateOne {
+	suo.mutation.SetGradedAt(t)
+	return suo
+}
+
+// SetNillableGradedAt sets the "graded_at" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableGradedAt(t *time.Time) *SubmissionUpdateOne {
+	if t != nil {
+		suo.SetGradedAt(*t)
+	}
+	return suo
+}
+
+// ClearGradedAt clears the value of the "graded_at" field.
+func (suo *SubmissionUpdateOne) ClearGradedAt() *SubmissionUpdateOne {
+	suo.mutation.ClearGradedAt()
+	return suo
+}
+
+// Mutation returns the SubmissionMutation object of the builder.
+func (suo *SubmissionUpdateOne) Mutation() *SubmissionMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SubmissionUpdate builder.
+func (suo *SubmissionUpdateOne) Where(ps ...predicate.Submission) *SubmissionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SubmissionUpdateOne) Select(field string, fields ...string) *SubmissionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Submission entity.
+func (suo *SubmissionUpdateOne) Save(ctx context.Context) (*Submission, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubmissionUpdateOne) SaveX(ctx context.Context) *Submission {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubmissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubmissionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SubmissionUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := submission.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (suo *SubmissionUpdateOne) sqlSave(ctx context.Context) (_node *Submission, err error) {
+	_spec := sqlgraph.NewUpdateSpec(submission.Table, submission.Columns, sqlgraph.NewFieldSpec(submission.FieldID, field.TypeString))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Submission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, submission.FieldID)
+		for _, f := range fields {
+			if !submission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != submission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(submission.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(submission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.CreatedByCleared() {
+		_spec.ClearField(submission.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := suo.mutation.UpdatedBy(); ok {
+		_spec.SetField(submission.FieldUpdatedBy, field.TypeString, value)
+	}
+	if suo.mutation.UpdatedByCleared() {
+		_spec.ClearField(submission.FieldUpdatedBy, field.TypeString)
+	}
+	if suo.mutation.FileIDCleared() {
+		_spec.ClearField(submission.FieldFileID, field.TypeString)
+	}
+	if suo.mutation.RepositoryURLCleared() {
+		_spec.ClearField(submission.FieldRepositoryURL, field.TypeString)
+	}
+	if suo.mutation.TextCleared() {
+		_spec.ClearField(submission.FieldText, field.TypeString)
+	}
+	if suo.mutation.DueAtCleared() {
+		_spec.ClearField(submission.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.SubmissionStatus(); ok {
+		_spec.SetField(submission.FieldSubmissionStatus, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Score(); ok {
+		_spec.SetField(submission.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.AddedScore(); ok {
+		_spec.AddField(submission.FieldScore, field.TypeFloat64, value)
+	}
+	if suo.mutation.ScoreCleared() {
+		_spec.ClearField(submission.FieldScore, field.TypeFloat64)
+	}
+	if value, ok := suo.mutation.Penalty(); ok {
+		_spec.SetField(submission.FieldPenalty, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.AddedPenalty(); ok {
+		_spec.AddField(submission.FieldPenalty, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.RubricScores(); ok {
+		_spec.SetField(submission.FieldRubricScores, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedRubricScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, submission.FieldRubricScores, value)
+		})
+	}
+	if suo.mutation.RubricScoresCleared() {
+		_spec.ClearField(submission.FieldRubricScores, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Feedback(); ok {
+		_spec.SetField(submission.FieldFeedback, field.TypeString, value)
+	}
+	if suo.mutation.FeedbackCleared() {
+		_spec.ClearField(submission.FieldFeedback, field.TypeString)
+	}
+	if value, ok := suo.mutation.GradedBy(); ok {
+		_spec.SetField(submission.FieldGradedBy, field.TypeString, value)
+	}
+	if suo.mutation.GradedByCleared() {
+		_spec.ClearField(submission.FieldGradedBy, field.TypeString)
+	}
+	if value, ok := suo.mutation.GradedAt(); ok {
+		_spec.SetField(submission.FieldGradedAt, field.TypeTime, value)
+	}
+	if suo.mutation.GradedAtCleared() {
+		_spec.ClearField(submission.FieldGradedAt, field.TypeTime)
+	}
+	_node = &Submission{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{submission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/subscription"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
+	// SubscriptionStatus holds the value of the "subscription_status" field.
+	SubscriptionStatus types.SubscriptionStatus `json:"subscription_status,omitempty"`
+	// GatewayProvider holds the value of the "gateway_provider" field.
+	GatewayProvider types.PaymentGatewayProvider `json:"gateway_provider,omitempty"`
+	// GatewaySubscriptionID holds the value of the "gateway_subscription_id" field.
+	GatewaySubscriptionID *string `json:"gateway_subscription_id,omitempty"`
+	// CheckoutURL holds the value of the "checkout_url" field.
+	CheckoutURL *string `json:"checkout_url,omitempty"`
+	// CurrentPeriodStart holds the value of the "current_period_start" field.
+	CurrentPeriodStart *time.Time `json:"current_period_start,omitempty"`
+	// CurrentPeriodEnd holds the value of the "current_period_end" field.
+	CurrentPeriodEnd *time.Time `json:"current_period_end,omitempty"`
+	// CancelAtPeriodEnd holds the value of the "cancel_at_period_end" field.
+	CancelAtPeriodEnd bool `json:"cancel_at_period_end,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// FailedPaymentCount holds the value of the "failed_payment_count" field.
+	FailedPaymentCount int `json:"failed_payment_count,omitempty"`
+	selectValues       sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldMetadata:
+			values[i] = new([]byte)
+		case subscription.FieldCancelAtPeriodEnd:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldFailedPaymentCount:
+			values[i] = new(sql.NullInt64)
+		case subscription.FieldID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldUserID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldGatewayProvider, subscription.FieldGatewaySubscriptionID, subscription.FieldCheckoutURL:
+			values[i] = new(sql.NullString)
+		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (s *Subscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case subscription.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = value.String
+			}
+		case subscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case subscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case subscription.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				s.CreatedBy = value.String
+			}
+		case subscription.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				s.UpdatedBy = value.String
+			}
+		case subscription.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case subscription.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = value.String
+			}
+		case subscription.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				s.PlanID = value.String
+			}
+		case subscription.FieldSubscriptionStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_status", values[i])
+			} else if value.Valid {
+				s.SubscriptionStatus = types.SubscriptionStatus(value.String)
+			}
+		case subscription.FieldGatewayProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_provider", values[i])
+			} else if value.Valid {
+				s.GatewayProvider = types.PaymentGatewayProvider(value.String)
+			}
+		case subscription.FieldGatewaySubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_subscription_id", values[i])
+			} else if value.Valid {
+				s.GatewaySubscriptionID = new(string)
+				*s.GatewaySubscriptionID = value.String
+			}
+		case subscription.FieldCheckoutURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checkout_url", values[i])
+			} else if value.Valid {
+				s.CheckoutURL = new(string)
+				*s.CheckoutURL = value.String
+			}
+		case subscription.FieldCurrentPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_period_start", values[i])
+			} else if value.Valid {
+				s.CurrentPeriodStart = new(time.Time)
+				*s.CurrentPeriodStart = value.Time
+			}
+		case subscription.FieldCurrentPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_period_end", values[i])
+			} else if value.Valid {
+				s.CurrentPeriodEnd = new(time.Time)
+				*s.CurrentPeriodEnd = value.Time
+			}
+		case subscription.FieldCancelAtPeriodEnd:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_at_period_end", values[i])
+			} else if value.Valid {
+				s.CancelAtPeriodEnd = value.Bool
+			}
+		case subscription.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				s.CancelledAt = new(time.Time)
+				*s.CancelledAt = value.Time
+			}
+		case subscription.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				s.EndedAt = new(time.Time)
+				*s.EndedAt = value.Time
+			}
+		case subscription.FieldFailedPaymentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_payment_count", values[i])
+			} else if value.Valid {
+				s.FailedPaymentCount = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subscription.
+// This includes values selected through modifiers, order, etc.
+func (s *Subscription) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Subscription) Update() *SubscriptionUpdateOne {
+	return NewSubscriptionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Subscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Subscription) Unwrap() *Subscription {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subscription is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("status=")
+	builder.WriteString(s.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(s.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(s.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", s.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(s.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("plan_id=")
+	builder.WriteString(s.PlanID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_status=")
+	builder.WriteString(fmt.Sprintf("%v", s.SubscriptionStatus))
+	builder.WriteString(", ")
+	builder.WriteString("gateway_provider=")
+	builder.WriteString(fmt.Sprintf("%v", s.GatewayProvider))
+	builder.WriteString(", ")
+	if v := s.GatewaySubscriptionID; v != nil {
+		builder.WriteString("gateway_subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.CheckoutURL; v != nil {
+		builder.WriteString("checkout_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.CurrentPeriodStart; v != nil {
+		builder.WriteString("current_period_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.CurrentPeriodEnd; v != nil {
+		builder.WriteString("current_period_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_at_period_end=")
+	builder.WriteString(fmt.Sprintf("%v", s.CancelAtPeriodEnd))
+	builder.WriteString(", ")
+	if v := s.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_payment_count=")
+	builder.WriteString(fmt.Sprintf("%v", s.FailedPaymentCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
@@ -0,0 +1,1171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanID, v))
+}
+
+// SubscriptionStatus applies equality check predicate on the "subscription_status" field. It's identical to SubscriptionStatusEQ.
+func SubscriptionStatus(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldSubscriptionStatus, vc))
+}
+
+// GatewayProvider applies equality check predicate on the "gateway_provider" field. It's identical to GatewayProviderEQ.
+func GatewayProvider(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldGatewayProvider, vc))
+}
+
+// GatewaySubscriptionID applies equality check predicate on the "gateway_subscription_id" field. It's identical to GatewaySubscriptionIDEQ.
+func GatewaySubscriptionID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldGatewaySubscriptionID, v))
+}
+
+// CheckoutURL applies equality check predicate on the "checkout_url" field. It's identical to CheckoutURLEQ.
+func CheckoutURL(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCheckoutURL, v))
+}
+
+// CurrentPeriodStart applies equality check predicate on the "current_period_start" field. It's identical to CurrentPeriodStartEQ.
+func CurrentPeriodStart(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodEnd applies equality check predicate on the "current_period_end" field. It's identical to CurrentPeriodEndEQ.
+func CurrentPeriodEnd(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CancelAtPeriodEnd applies equality check predicate on the "cancel_at_period_end" field. It's identical to CancelAtPeriodEndEQ.
+func CancelAtPeriodEnd(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// FailedPaymentCount applies equality check predicate on the "failed_payment_count" field. It's identical to FailedPaymentCountEQ.
+func FailedPaymentCount(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldFailedPaymentCount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldMetadata))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPlanID, v))
+}
+
+// PlanIDContains applies the Contains predicate on the "plan_id" field.
+func PlanIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPlanID, v))
+}
+
+// PlanIDHasPrefix applies the HasPrefix predicate on the "plan_id" field.
+func PlanIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPlanID, v))
+}
+
+// PlanIDHasSuffix applies the HasSuffix predicate on the "plan_id" field.
+func PlanIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPlanID, v))
+}
+
+// PlanIDEqualFold applies the EqualFold predicate on the "plan_id" field.
+func PlanIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPlanID, v))
+}
+
+// PlanIDContainsFold applies the ContainsFold predicate on the "plan_id" field.
+func PlanIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPlanID, v))
+}
+
+// SubscriptionStatusEQ applies the EQ predicate on the "subscription_status" field.
+func SubscriptionStatusEQ(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusNEQ applies the NEQ predicate on the "subscription_status" field.
+func SubscriptionStatusNEQ(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldNEQ(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusIn applies the In predicate on the "subscription_status" field.
+func SubscriptionStatusIn(vs ...types.SubscriptionStatus) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldIn(FieldSubscriptionStatus, v...))
+}
+
+// SubscriptionStatusNotIn applies the NotIn predicate on the "subscription_status" field.
+func SubscriptionStatusNotIn(vs ...types.SubscriptionStatus) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldNotIn(FieldSubscriptionStatus, v...))
+}
+
+// SubscriptionStatusGT applies the GT predicate on the "subscription_status" field.
+func SubscriptionStatusGT(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGT(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusGTE applies the GTE predicate on the "subscription_status" field.
+func SubscriptionStatusGTE(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGTE(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusLT applies the LT predicate on the "subscription_status" field.
+func SubscriptionStatusLT(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLT(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusLTE applies the LTE predicate on the "subscription_status" field.
+func SubscriptionStatusLTE(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLTE(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusContains applies the Contains predicate on the "subscription_status" field.
+func SubscriptionStatusContains(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContains(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusHasPrefix applies the HasPrefix predicate on the "subscription_status" field.
+func SubscriptionStatusHasPrefix(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasPrefix(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusHasSuffix applies the HasSuffix predicate on the "subscription_status" field.
+func SubscriptionStatusHasSuffix(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasSuffix(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusEqualFold applies the EqualFold predicate on the "subscription_status" field.
+func SubscriptionStatusEqualFold(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEqualFold(FieldSubscriptionStatus, vc))
+}
+
+// SubscriptionStatusContainsFold applies the ContainsFold predicate on the "subscription_status" field.
+func SubscriptionStatusContainsFold(v types.SubscriptionStatus) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContainsFold(FieldSubscriptionStatus, vc))
+}
+
+// GatewayProviderEQ applies the EQ predicate on the "gateway_provider" field.
+func GatewayProviderEQ(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderNEQ applies the NEQ predicate on the "gateway_provider" field.
+func GatewayProviderNEQ(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldNEQ(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderIn applies the In predicate on the "gateway_provider" field.
+func GatewayProviderIn(vs ...types.PaymentGatewayProvider) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldIn(FieldGatewayProvider, v...))
+}
+
+// GatewayProviderNotIn applies the NotIn predicate on the "gateway_provider" field.
+func GatewayProviderNotIn(vs ...types.PaymentGatewayProvider) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldNotIn(FieldGatewayProvider, v...))
+}
+
+// GatewayProviderGT applies the GT predicate on the "gateway_provider" field.
+func GatewayProviderGT(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGT(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderGTE applies the GTE predicate on the "gateway_provider" field.
+func GatewayProviderGTE(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGTE(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderLT applies the LT predicate on the "gateway_provider" field.
+func GatewayProviderLT(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLT(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderLTE applies the LTE predicate on the "gateway_provider" field.
+func GatewayProviderLTE(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLTE(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderContains applies the Contains predicate on the "gateway_provider" field.
+func GatewayProviderContains(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContains(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderHasPrefix applies the HasPrefix predicate on the "gateway_provider" field.
+func GatewayProviderHasPrefix(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasPrefix(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderHasSuffix applies the HasSuffix predicate on the "gateway_provider" field.
+func GatewayProviderHasSuffix(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasSuffix(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderEqualFold applies the EqualFold predicate on the "gateway_provider" field.
+func GatewayProviderEqualFold(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEqualFold(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderContainsFold applies the ContainsFold predicate on the "gateway_provider" field.
+func GatewayProviderContainsFold(v types.PaymentGatewayProvider) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContainsFold(FieldGatewayProvider, vc))
+}
+
+// GatewaySubscriptionIDEQ applies the EQ predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDNEQ applies the NEQ predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDIn applies the In predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldGatewaySubscriptionID, vs...))
+}
+
+// GatewaySubscriptionIDNotIn applies the NotIn predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldGatewaySubscriptionID, vs...))
+}
+
+// GatewaySubscriptionIDGT applies the GT predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDGTE applies the GTE predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDLT applies the LT predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDLTE applies the LTE predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDContains applies the Contains predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDHasPrefix applies the HasPrefix predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDHasSuffix applies the HasSuffix predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDIsNil applies the IsNil predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldGatewaySubscriptionID))
+}
+
+// GatewaySubscriptionIDNotNil applies the NotNil predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldGatewaySubscriptionID))
+}
+
+// GatewaySubscriptionIDEqualFold applies the EqualFold predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldGatewaySubscriptionID, v))
+}
+
+// GatewaySubscriptionIDContainsFold applies the ContainsFold predicate on the "gateway_subscription_id" field.
+func GatewaySubscriptionIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldGatewaySubscriptionID, v))
+}
+
+// CheckoutURLEQ applies the EQ predicate on the "checkout_url" field.
+func CheckoutURLEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCheckoutURL, v))
+}
+
+// CheckoutURLNEQ applies the NEQ predicate on the "checkout_url" field.
+func CheckoutURLNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCheckoutURL, v))
+}
+
+// CheckoutURLIn applies the In predicate on the "checkout_url" field.
+func CheckoutURLIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCheckoutURL, vs...))
+}
+
+// CheckoutURLNotIn applies the NotIn predicate on the "checkout_url" field.
+func CheckoutURLNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCheckoutURL, vs...))
+}
+
+// CheckoutURLGT applies the GT predicate on the "checkout_url" field.
+func CheckoutURLGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCheckoutURL, v))
+}
+
+// CheckoutURLGTE applies the GTE predicate on the "checkout_url" field.
+func CheckoutURLGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCheckoutURL, v))
+}
+
+// CheckoutURLLT applies the LT predicate on the "checkout_url" field.
+func CheckoutURLLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCheckoutURL, v))
+}
+
+// CheckoutURLLTE applies the LTE predicate on the "checkout_url" field.
+func CheckoutURLLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCheckoutURL, v))
+}
+
+// CheckoutURLContains applies the Contains predicate on the "checkout_url" field.
+func CheckoutURLContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldCheckoutURL, v))
+}
+
+// CheckoutURLHasPrefix applies the HasPrefix predicate on the "checkout_url" field.
+func CheckoutURLHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldCheckoutURL, v))
+}
+
+// CheckoutURLHasSuffix applies the HasSuffix predicate on the "checkout_url" field.
+func CheckoutURLHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldCheckoutURL, v))
+}
+
+// CheckoutURLIsNil applies the IsNil predicate on the "checkout_url" field.
+func CheckoutURLIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCheckoutURL))
+}
+
+// CheckoutURLNotNil applies the NotNil predicate on the "checkout_url" field.
+func CheckoutURLNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCheckoutURL))
+}
+
+// CheckoutURLEqualFold applies the EqualFold predicate on the "checkout_url" field.
+func CheckoutURLEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldCheckoutURL, v))
+}
+
+// CheckoutURLContainsFold applies the ContainsFold predicate on the "checkout_url" field.
+func CheckoutURLContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldCheckoutURL, v))
+}
+
+// CurrentPeriodStartEQ applies the EQ predicate on the "current_period_start" field.
+func CurrentPeriodStartEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartNEQ applies the NEQ predicate on the "current_period_start" field.
+func CurrentPeriodStartNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartIn applies the In predicate on the "current_period_start" field.
+func CurrentPeriodStartIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentPeriodStart, vs...))
+}
+
+// CurrentPeriodStartNotIn applies the NotIn predicate on the "current_period_start" field.
+func CurrentPeriodStartNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentPeriodStart, vs...))
+}
+
+// CurrentPeriodStartGT applies the GT predicate on the "current_period_start" field.
+func CurrentPeriodStartGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartGTE applies the GTE predicate on the "current_period_start" field.
+func CurrentPeriodStartGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartLT applies the LT predicate on the "current_period_start" field.
+func CurrentPeriodStartLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartLTE applies the LTE predicate on the "current_period_start" field.
+func CurrentPeriodStartLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartIsNil applies the IsNil predicate on the "current_period_start" field.
+func CurrentPeriodStartIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentPeriodStart))
+}
+
+// CurrentPeriodStartNotNil applies the NotNil predicate on the "current_period_start" field.
+func CurrentPeriodStartNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentPeriodStart))
+}
+
+// CurrentPeriodEndEQ applies the EQ predicate on the "current_period_end" field.
+func CurrentPeriodEndEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndNEQ applies the NEQ predicate on the "current_period_end" field.
+func CurrentPeriodEndNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIn applies the In predicate on the "current_period_end" field.
+func CurrentPeriodEndIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndNotIn applies the NotIn predicate on the "current_period_end" field.
+func CurrentPeriodEndNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndGT applies the GT predicate on the "current_period_end" field.
+func CurrentPeriodEndGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndGTE applies the GTE predicate on the "current_period_end" field.
+func CurrentPeriodEndGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLT applies the LT predicate on the "current_period_end" field.
+func CurrentPeriodEndLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLTE applies the LTE predicate on the "current_period_end" field.
+func CurrentPeriodEndLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIsNil applies the IsNil predicate on the "current_period_end" field.
+func CurrentPeriodEndIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentPeriodEnd))
+}
+
+// CurrentPeriodEndNotNil applies the NotNil predicate on the "current_period_end" field.
+func CurrentPeriodEndNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentPeriodEnd))
+}
+
+// CancelAtPeriodEndEQ applies the EQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelAtPeriodEndNEQ applies the NEQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldEndedAt))
+}
+
+// FailedPaymentCountEQ applies the EQ predicate on the "failed_payment_count" field.
+func FailedPaymentCountEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldFailedPaymentCount, v))
+}
+
+// FailedPaymentCountNEQ applies the NEQ predicate on the "failed_payment_count" field.
+func FailedPaymentCountNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldFailedPaymentCount, v))
+}
+
+// FailedPaymentCountIn applies the In predicate on the "failed_payment_count" field.
+func FailedPaymentCountIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldFailedPaymentCount, vs...))
+}
+
+// FailedPaymentCountNotIn applies the NotIn predicate on the "failed_payment_count" field.
+func FailedPaymentCountNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldFailedPaymentCount, vs...))
+}
+
+// FailedPaymentCountGT applies the GT predicate on the "failed_payment_count" field.
+func FailedPaymentCountGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldFailedPaymentCount, v))
+}
+
+// FailedPaymentCountGTE applies the GTE predicate on the "failed_payment_count" field.
+func FailedPaymentCountGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldFailedPaymentCount, v))
+}
+
+// FailedPaymentCountLT applies the LT predicate on the "failed_payment_count" field.
+func FailedPaymentCountLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldFailedPaymentCount, v))
+}
+
+// FailedPaymentCountLTE applies the LTE predicate on the "failed_payment_count" field.
+func FailedPaymentCountLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldFailedPaymentCount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/subscription"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (sc *SubscriptionCreate) SetStatus(s string) *SubscriptionCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableStatus(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SubscriptionCreate) SetCreatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SubscriptionCreate) SetUpdatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sc *SubscriptionCreate) SetCreatedBy(s string) *SubscriptionCreate {
+	sc.mutation.SetCreatedBy(s)
+	return sc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCreatedBy(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetCreatedBy(*s)
+	}
+	return sc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sc *SubscriptionCreate) SetUpdatedBy(s string) *SubscriptionCreate {
+	sc.mutation.SetUpdatedBy(s)
+	return sc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableUpdatedBy(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetUpdatedBy(*s)
+	}
+	return sc
+}
+
+// SetMetadata sets the "metadata" field.
+func (sc *SubscriptionCreate) SetMetadata(m map[string]string) *SubscriptionCreate {
+	sc.mutation.SetMetadata(m)
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *SubscriptionCreate) SetUserID(s string) *SubscriptionCreate {
+	sc.mutation.SetUserID(s)
+	return sc
+}
+
+// SetPlanID sets the "plan_id" field.
+func (sc *SubscriptionCreate) SetPlanID(s string) *SubscriptionCreate {
+	sc.mutation.SetPlanID(s)
+	return sc
+}
+
+// SetSubscriptionStatus sets the "subscription_status" field.
+func (sc *SubscriptionCreate) SetSubscriptionStatus(ts types.SubscriptionStatus) *SubscriptionCreate {
+	sc.mutation.SetSubscriptionStatus(ts)
+	return sc
+}
+
+// SetNillableSubscriptionStatus sets the "subscription_status" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableSubscriptionStatus(ts *types.SubscriptionStatus) *SubscriptionCreate {
+	if ts != nil {
+		sc.SetSubscriptionStatus(*ts)
+	}
+	return sc
+}
+
+// SetGatewayProvider sets the "gateway_provider" field.
+func (sc *SubscriptionCreate) SetGatewayProvider(tgp types.PaymentGatewayProvider) *SubscriptionCreate {
+	sc.mutation.SetGatewayProvider(tgp)
+	return sc
+}
+
+// SetGatewaySubscriptionID sets the "gateway_subscription_id" field.
+func (sc *SubscriptionCreate) SetGatewaySubscriptionID(s string) *SubscriptionCreate {
+	sc.mutation.SetGatewaySubscriptionID(s)
+	return sc
+}
+
+// SetNillableGatewaySubscriptionID sets the "gateway_subscription_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableGatewaySubscriptionID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetGatewaySubscriptionID(*s)
+	}
+	return sc
+}
+
+// SetCheckoutURL sets the "checkout_url" field.
+func (sc *SubscriptionCreate) SetCheckoutURL(s string) *SubscriptionCreate {
+	sc.mutation.SetCheckoutURL(s)
+	return sc
+}
+
+// SetNillableCheckoutURL sets the "checkout_url" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCheckoutURL(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetCheckoutURL(*s)
+	}
+	return sc
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (sc *SubscriptionCreate) SetCurrentPeriodStart(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCurrentPeriodStart(t)
+	return sc
+}
+
+// SetNillableCurrentPeriodStart sets the "current_period_start" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCurrentPeriodStart(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCurrentPeriodStart(*t)
+	}
+	return sc
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (sc *SubscriptionCreate) SetCurrentPeriodEnd(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCurrentPeriodEnd(t)
+	return sc
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCurrentPeriodEnd(*t)
+	}
+	return sc
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (sc *SubscriptionCreate) SetCancelAtPeriodEnd(b bool) *SubscriptionCreate {
+	sc.mutation.SetCancelAtPeriodEnd(b)
+	return sc
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetCancelAtPeriodEnd(*b)
+	}
+	return sc
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (sc *SubscriptionCreate) SetCancelledAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCancelledAt(t)
+	return sc
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCancelledAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCancelledAt(*t)
+	}
+	return sc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (sc *SubscriptionCreate) SetEndedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetEndedAt(t)
+	return sc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableEndedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetEndedAt(*t)
+	}
+	return sc
+}
+
+// SetFailedPaymentCount sets the "failed_payment_count" field.
+func (sc *SubscriptionCreate) SetFailedPaymentCount(i int) *SubscriptionCreate {
+	sc.mutation.SetFailedPaymentCount(i)
+	return sc
+}
+
+// SetNillableFailedPaymentCount sets the "failed_payment_count" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableFailedPaymentCount(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetFailedPaymentCount(*i)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SubscriptionCreate) SetID(s string) *SubscriptionCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetID(*s)
+	}
+	return sc
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return sc.mutation
+}
+
+// Save creates the Subscription in the database.
+func (sc *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SubscriptionCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SubscriptionCreate) defaults() {
+	if _, ok := sc.mutation.Status(); !ok {
+		v := subscription.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := subscription.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.Metadata(); !ok {
+		v := subscription.DefaultMetadata
+		sc.mutation.SetMetadata(v)
+	}
+	if _, ok := sc.mutation.SubscriptionStatus(); !ok {
+		v := subscription.DefaultSubscriptionStatus
+		sc.mutation.SetSubscriptionStatus(v)
+	}
+	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
+		v := subscription.DefaultCancelAtPeriodEnd
+		sc.mutation.SetCancelAtPeriodEnd(v)
+	}
+	if _, ok := sc.mutation.FailedPaymentCount(); !ok {
+		v := subscription.DefaultFailedPaymentCount
+		sc.mutation.SetFailedPaymentCount(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := subscription.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SubscriptionCreate) check() error {
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Subscription.status"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Subscription.updated_at"`)}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Subscription.user_id"`)}
+	}
+	if v, ok := sc.mutation.UserID(); ok {
+		if err := subscription.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.user_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "Subscription.plan_id"`)}
+	}
+	if v, ok := sc.mutation.PlanID(); ok {
+		if err := subscription.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SubscriptionStatus(); !ok {
+		return &ValidationError{Name: "subscription_status", err: errors.New(`ent: missing required field "Subscription.subscription_status"`)}
+	}
+	if v, ok := sc.mutation.SubscriptionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "subscription_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.subscription_status": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.GatewayProvider(); !ok {
+		return &ValidationError{Name: "gateway_provider", err: errors.New(`ent: missing required field "Subscription.gateway_provider"`)}
+	}
+	if v, ok := sc.mutation.GatewayProvider(); ok {
+		if err := subscription.GatewayProviderValidator(string(v)); err != nil {
+			return &ValidationError{Name: "gateway_provider", err: fmt.Errorf(`ent: validator failed for field "Subscription.gateway_provider": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CancelAtPeriodEnd(); !ok {
+		return &ValidationError{Name: "cancel_at_period_end", err: errors.New(`ent: missing required field "Subscription.cancel_at_period_end"`)}
+	}
+	if _, ok := sc.mutation.FailedPaymentCount(); !ok {
+		return &ValidationError{Name: "failed_payment_count", err: errors.New(`ent: missing required field "Subscription.failed_payment_count"`)}
+	}
+	if v, ok := sc.mutation.FailedPaymentCount(); ok {
+		if err := subscription.FailedPaymentCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_payment_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.failed_payment_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sc *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Subscription.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.CreatedBy(); ok {
+		_spec.SetField(subscription.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sc.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscription.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sc.mutation.Metadata(); ok {
+		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := sc.mutation.UserID(); ok {
+		_spec.SetField(subscription.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := sc.mutation.PlanID(); ok {
+		_spec.SetField(subscription.FieldPlanID, field.TypeString, value)
+		_node.PlanID = value
+	}
+	if value, ok := sc.mutation.SubscriptionStatus(); ok {
+		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
+		_node.SubscriptionStatus = value
+	}
+	if value, ok := sc.mutation.GatewayProvider(); ok {
+		_spec.SetField(subscription.FieldGatewayProvider, field.TypeString, value)
+		_node.GatewayProvider = value
+	}
+	if value, ok := sc.mutation.GatewaySubscriptionID(); ok {
+		_spec.SetField(subscription.FieldGatewaySubscriptionID, field.TypeString, value)
+		_node.GatewaySubscriptionID = &value
+	}
+	if value, ok := sc.mutation.CheckoutURL(); ok {
+		_spec.SetField(subscription.FieldCheckoutURL, field.TypeString, value)
+		_node.CheckoutURL = &value
+	}
+	if value, ok := sc.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+		_node.CurrentPeriodStart = &value
+	}
+	if value, ok := sc.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+		_node.CurrentPeriodEnd = &value
+	}
+	if value, ok := sc.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+		_node.CancelAtPeriodEnd = value
+	}
+	if value, ok := sc.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := sc.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := sc.mutation.FailedPaymentCount(); ok {
+		_spec.SetField(subscription.FieldFailedPaymentCount, field.TypeInt, value)
+		_node.FailedPaymentCount = value
+	}
+	return _node, _spec
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+}
+
+// Save creates the Subscription entities in the database.
+func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Subscription, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/subscription"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (su *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SubscriptionUpdate) SetStatus(s string) *SubscriptionUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableStatus(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SubscriptionUpdate) SetUpdatedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (su *SubscriptionUpdate) SetUpdatedBy(s string) *SubscriptionUpdate {
+	su.mutation.SetUpdatedBy(s)
+	return su
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableUpdatedBy(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetUpdatedBy(*s)
+	}
+	return su
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (su *SubscriptionUpdate) ClearUpdatedBy() *SubscriptionUpdate {
+	su.mutation.ClearUpdatedBy()
+	return su
+}
+
+// SetMetadata sets the "metadata" field.
+func (su *SubscriptionUpdate) SetMetadata(m map[string]string) *SubscriptionUpdate {
+	su.mutation.SetMetadata(m)
+	return su
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (su *SubscriptionUpdate) ClearMetadata() *SubscriptionUpdate {
+	su.mutation.ClearMetadata()
+	return su
+}
+
+// SetSubscriptionStatus sets the "subscription_status" field.
+func (su *SubscriptionUpdate) SetSubscriptionStatus(ts types.SubscriptionStatus) *SubscriptionUpdate {
+	su.mutation.SetSubscriptionStatus(ts)
+	return su
+}
+
+// SetNillableSubscriptionStatus sets the "subscription_status" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableSubscriptionStatus(ts *types.SubscriptionStatus) *SubscriptionUpdate {
+	if ts != nil {
+		su.SetSubscriptionStatus(*ts)
+	}
+	return su
+}
+
+// SetGatewaySubscriptionID sets the "gateway_subscription_id" field.
+func (su *SubscriptionUpdate) SetGatewaySubscriptionID(s string) *SubscriptionUpdate {
+	su.mutation.SetGatewaySubscriptionID(s)
+	return su
+}
+
+// SetNillableGatewaySubscriptionID sets the "gateway_subscription_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableGatewaySubscriptionID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetGatewaySubscriptionID(*s)
+	}
+	return su
+}
+
+// ClearGatewaySubscriptionID clears the value of the "gateway_subscription_id" field.
+func (su *SubscriptionUpdate) ClearGatewaySubscriptionID() *SubscriptionUpdate {
+	su.mutation.ClearGatewaySubscriptionID()
+	return su
+}
+
+// SetCheckoutURL sets the "checkout_url" field.
+func (su *SubscriptionUpdate) SetCheckoutURL(s string) *SubscriptionUpdate {
+	su.mutation.SetCheckoutURL(s)
+	return su
+}
+
+// SetNillableCheckoutURL sets the "checkout_url" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCheckoutURL(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetCheckoutURL(*s)
+	}
+	return su
+}
+
+// ClearCheckoutURL clears the value of the "checkout_url" field.
+func (su *SubscriptionUpdate) ClearCheckoutURL() *SubscriptionUpdate {
+	su.mutation.ClearCheckoutURL()
+	return su
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (su *SubscriptionUpdate) SetCurrentPeriodStart(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCurrentPeriodStart(t)
+	return su
+}
+
+// SetNillableCurrentPeriodStart sets the "current_period_start" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCurrentPeriodStart(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCurrentPeriodStart(*t)
+	}
+	return su
+}
+
+// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
+func (su *SubscriptionUpdate) ClearCurrentPeriodStart() *SubscriptionUpdate {
+	su.mutation.ClearCurrentPeriodStart()
+	return su
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (su *SubscriptionUpdate) SetCurrentPeriodEnd(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCurrentPeriodEnd(t)
+	return su
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCurrentPeriodEnd(*t)
+	}
+	return su
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (su *SubscriptionUpdate) ClearCurrentPeriodEnd() *SubscriptionUpdate {
+	su.mutation.ClearCurrentPeriodEnd()
+	return su
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (su *SubscriptionUpdate) SetCancelAtPeriodEnd(b bool) *SubscriptionUpdate {
+	su.mutation.SetCancelAtPeriodEnd(b)
+	return su
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetCancelAtPeriodEnd(*b)
+	}
+	return su
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (su *SubscriptionUpdate) SetCancelledAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCancelledAt(t)
+	return su
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCancelledAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCancelledAt(*t)
+	}
+	return su
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (su *SubscriptionUpdate) ClearCancelledAt() *SubscriptionUpdate {
+	su.mutation.ClearCancelledAt()
+	return su
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (su *SubscriptionUpdate) SetEndedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetEndedAt(t)
+	return su
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableEndedAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetEndedAt(*t)
+	}
+	return su
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (su *SubscriptionUpdate) ClearEndedAt() *SubscriptionUpdate {
+	su.mutation.ClearEndedAt()
+	return su
+}
+
+// SetFailedPaymentCount sets the "failed_payment_count" field.
+func (su *SubscriptionUpdate) SetFailedPaymentCount(i int) *SubscriptionUpdate {
+	su.mutation.ResetFailedPaymentCount()
+	su.mutation.SetFailedPaymentCount(i)
+	return su
+}
+
+// SetNillableFailedPaymentCount sets the "failed_payment_count" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableFailedPaymentCount(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetFailedPaymentCount(*i)
+	}
+	return su
+}
+
+// AddFailedPaymentCount adds i to the "failed_payment_count" field.
+func (su *SubscriptionUpdate) AddFailedPaymentCount(i int) *SubscriptionUpdate {
+	su.mutation.AddFailedPaymentCount(i)
+	return su
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SubscriptionUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if v, ok := su.mutation.SubscriptionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "subscription_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.subscription_status": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.FailedPaymentCount(); ok {
+		if err := subscription.FailedPaymentCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_payment_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.failed_payment_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.CreatedByCleared() {
+		_spec.ClearField(subscription.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := su.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscription.FieldUpdatedBy, field.TypeString, value)
+	}
+	if su.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscription.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := su.mutation.Metadata(); ok {
+		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
+	}
+	if su.mutation.MetadataCleared() {
+		_spec.ClearField(subscription.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := su.mutation.SubscriptionStatus(); ok {
+		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
+	}
+	if value, ok := su.mutation.GatewaySubscriptionID(); ok {
+		_spec.SetField(subscription.FieldGatewaySubscriptionID, field.TypeString, value)
+	}
+	if su.mutation.GatewaySubscriptionIDCleared() {
+		_spec.ClearField(subscription.FieldGatewaySubscriptionID, field.TypeString)
+	}
+	if value, ok := su.mutation.CheckoutURL(); ok {
+		_spec.SetField(subscription.FieldCheckoutURL, field.TypeString, value)
+	}
+	if su.mutation.CheckoutURLCleared() {
+		_spec.ClearField(subscription.FieldCheckoutURL, field.TypeString)
+	}
+	if value, ok := su.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+	}
+	if su.mutation.CurrentPeriodStartCleared() {
+		_spec.ClearField(subscription.FieldCurrentPeriodStart, field.TypeTime)
+	}
+	if value, ok := su.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if su.mutation.CurrentPeriodEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentPeriodEnd, field.TypeTime)
+	}
+	if value, ok := su.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if su.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+	}
+	if su.mutation.EndedAtCleared() {
+		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.FailedPaymentCount(); ok {
+		_spec.SetField(subscription.FieldFailedPaymentCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedFailedPaymentCount(); ok {
+		_spec.AddField(subscription.FieldFailedPaymentCount, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetStatus sets the "status" field.
+func (suo *SubscriptionUpdateOne) SetStatus(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableStatus(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SubscriptionUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (suo *SubscriptionUpdateOne) SetUpdatedBy(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetUpdatedBy(s)
+	return suo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableUpdatedBy(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetUpdatedBy(*s)
+	}
+	return suo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (suo *SubscriptionUpdateOne) ClearUpdatedBy() *SubscriptionUpdateOne {
+	suo.mutation.ClearUpdatedBy()
+	return suo
+}
+
+// SetMetadata sets the "metadata" field.
+func (suo *SubscriptionUpdateOne) SetMetadata(m map[string]string) *SubscriptionUpdateOne {
+	suo.mutation.SetMetadata(m)
+	return suo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (suo *SubscriptionUpdateOne) ClearMetadata() *SubscriptionUpdateOne {
+	suo.mutation.ClearMetadata()
+	return suo
+}
+
+// SetSubscriptionStatus sets the "subscription_status" field.
+func (suo *SubscriptionUpdateOne) SetSubscriptionStatus(ts types.SubscriptionStatus) *SubscriptionUpdateOne {
+	suo.mutation.SetSubscriptionStatus(ts)
+	return suo
+}
+
+// SetNillableSubscriptionStatus sets the "subscription_status" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableSubscriptionStatus(ts *types.SubscriptionStatus) *SubscriptionUpdateOne {
+	if ts != nil {
+		suo.SetSubscriptionStatus(*ts)
+	}
+	return suo
+}
+
+// SetGatewaySubscriptionID sets the "gateway_subscription_id" field.
+func (suo *SubscriptionUpdateOne) SetGatewaySubscriptionID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetGatewaySubscriptionID(s)
+	return suo
+}
+
+// SetNillableGatewaySubscriptionID sets the "gateway_subscription_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableGatewaySubscriptionID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetGatewaySubscriptionID(*s)
+	}
+	return suo
+}
+
+// ClearGatewaySubscriptionID clears the value of the "gateway_subscription_id" field.
+func (suo *SubscriptionUpdateOne) ClearGatewaySubscriptionID() *SubscriptionUpdateOne {
+	suo.mutation.ClearGatewaySubscriptionID()
+	return suo
+}
+
+// SetCheckoutURL sets the "checkout_url" field.
+func (suo *SubscriptionUpdateOne) SetCheckoutURL(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetCheckoutURL(s)
+	return suo
+}
+
+// SetNillableCheckoutURL sets the "checkout_url" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCheckoutURL(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetCheckoutURL(*s)
+	}
+	return suo
+}
+
+// ClearCheckoutURL clears the value of the "checkout_url" field.
+func (suo *SubscriptionUpdateOne) ClearCheckoutURL() *SubscriptionUpdateOne {
+	suo.mutation.ClearCheckoutURL()
+	return suo
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (suo *SubscriptionUpdateOne) SetCurrentPeriodStart(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCurrentPeriodStart(t)
+	return suo
+}
+
+// SetNillableCurrentPeriodStart sets the "current_period_start" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCurrentPeriodStart(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCurrentPeriodStart(*t)
+	}
+	return suo
+}
+
+// ClearCurrentPeriodStart clears the value of the "current_period_start" field.
+func (suo *SubscriptionUpdateOne) ClearCurrentPeriodStart() *SubscriptionUpdateOne {
+	suo.mutation.ClearCurrentPeriodStart()
+	return suo
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (suo *SubscriptionUpdateOne) SetCurrentPeriodEnd(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCurrentPeriodEnd(t)
+	return suo
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCurrentPeriodEnd(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCurrentPeriodEnd(*t)
+	}
+	return suo
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (suo *SubscriptionUpdateOne) ClearCurrentPeriodEnd() *SubscriptionUpdateOne {
+	suo.mutation.ClearCurrentPeriodEnd()
+	return suo
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (suo *SubscriptionUpdateOne) SetCancelAtPeriodEnd(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetCancelAtPeriodEnd(b)
+	return suo
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCancelAtPeriodEnd(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetCancelAtPeriodEnd(*b)
+	}
+	return suo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (suo *SubscriptionUpdateOne) SetCancelledAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCancelledAt(t)
+	return suo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCancelledAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCancelledAt(*t)
+	}
+	return suo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (suo *SubscriptionUpdateOne) ClearCancelledAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearCancelledAt()
+	return suo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (suo *SubscriptionUpdateOne) SetEndedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetEndedAt(t)
+	return suo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableEndedAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetEndedAt(*t)
+	}
+	return suo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (suo *SubscriptionUpdateOne) ClearEndedAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearEndedAt()
+	return suo
+}
+
+// SetFailedPaymentCount sets the "failed_payment_count" field.
+func (suo *SubscriptionUpdateOne) SetFailedPaymentCount(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetFailedPaymentCount()
+	suo.mutation.SetFailedPaymentCount(i)
+	return suo
+}
+
+// SetNillableFailedPaymentCount sets the "failed_payment_count" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableFailedPaymentCount(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetFailedPaymentCount(*i)
+	}
+	return suo
+}
+
+// AddFailedPaymentCount adds i to the "failed_payment_count" field.
+func (suo *SubscriptionUpdateOne) AddFailedPaymentCount(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddFailedPaymentCount(i)
+	return suo
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (suo *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SubscriptionUpdateOne) Select(field string, fields ...string) *SubscriptionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (suo *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SubscriptionUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if v, ok := suo.mutation.SubscriptionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "subscription_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.subscription_status": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.FailedPaymentCount(); ok {
+		if err := subscription.FailedPaymentCountValidator(v); err != nil {
+			return &ValidationError{Name: "failed_payment_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.failed_payment_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for _, f := range fields {
+			if !subscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.CreatedByCleared() {
+		_spec.ClearField(subscription.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := suo.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscription.FieldUpdatedBy, field.TypeString, value)
+	}
+	if suo.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscription.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := suo.mutation.Metadata(); ok {
+		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
+	}
+	if suo.mutation.MetadataCleared() {
+		_spec.ClearField(subscription.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.SubscriptionStatus(); ok {
+		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.GatewaySubscriptionID(); ok {
+		_spec.SetField(subscription.FieldGatewaySubscriptionID, field.TypeString, value)
+	}
+	if suo.mutation.GatewaySubscriptionIDCleared() {
+		_spec.ClearField(subscription.FieldGatewaySubscriptionID, field.TypeString)
+	}
+	if value, ok := suo.mutation.CheckoutURL(); ok {
+		_spec.SetField(subscription.FieldCheckoutURL, field.TypeString, value)
+	}
+	if suo.mutation.CheckoutURLCleared() {
+		_spec.ClearField(subscription.FieldCheckoutURL, field.TypeString)
+	}
+	if value, ok := suo.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+	}
+	if suo.mutation.CurrentPeriodStartCleared() {
+		_spec.ClearField(subscription.FieldCurrentPeriodStart, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if suo.mutation.CurrentPeriodEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentPeriodEnd, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if suo.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+	}
+	if suo.mutation.EndedAtCleared() {
+		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.FailedPaymentCount(); ok {
+		_spec.SetField(subscription.FieldFailedPaymentCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedFailedPaymentCount(); ok {
+		_spec.AddField(subscription.FieldFailedPaymentCount, field.TypeInt, value)
+	}
+	_node = &Subscription{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
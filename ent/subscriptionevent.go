@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/subscriptionevent"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubscriptionEvent is the model entity for the SubscriptionEvent schema.
+type SubscriptionEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// GatewayProvider holds the value of the "gateway_provider" field.
+	GatewayProvider types.PaymentGatewayProvider `json:"gateway_provider,omitempty"`
+	// GatewayEventID holds the value of the "gateway_event_id" field.
+	GatewayEventID string `json:"gateway_event_id,omitempty"`
+	// EventStatus holds the value of the "event_status" field.
+	EventStatus  types.SubscriptionStatus `json:"event_status,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionevent.FieldID, subscriptionevent.FieldStatus, subscriptionevent.FieldCreatedBy, subscriptionevent.FieldUpdatedBy, subscriptionevent.FieldSubscriptionID, subscriptionevent.FieldGatewayProvider, subscriptionevent.FieldGatewayEventID, subscriptionevent.FieldEventStatus:
+			values[i] = new(sql.NullString)
+		case subscriptionevent.FieldCreatedAt, subscriptionevent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionEvent fields.
+func (se *SubscriptionEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				se.ID = value.String
+			}
+		case subscriptionevent.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				se.Status = value.String
+			}
+		case subscriptionevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				se.CreatedAt = value.Time
+			}
+		case subscriptionevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				se.UpdatedAt = value.Time
+			}
+		case subscriptionevent.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				se.CreatedBy = value.String
+			}
+		case subscriptionevent.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				se.UpdatedBy = value.String
+			}
+		case subscriptionevent.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				se.SubscriptionID = value.String
+			}
+		case subscriptionevent.FieldGatewayProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_provider", values[i])
+			} else if value.Valid {
+				se.GatewayProvider = types.PaymentGatewayProvider(value.String)
+			}
+		case subscriptionevent.FieldGatewayEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_event_id", values[i])
+			} else if value.Valid {
+				se.GatewayEventID = value.String
+			}
+		case subscriptionevent.FieldEventStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_status", values[i])
+			} else if value.Valid {
+				se.EventStatus = types.SubscriptionStatus(value.String)
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionEvent.
+// This includes values selected through modifiers, order, etc.
+func (se *SubscriptionEvent) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SubscriptionEvent.
+// Note that you need to call SubscriptionEvent.Unwrap() before calling this method if this SubscriptionEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *SubscriptionEvent) Update() *SubscriptionEventUpdateOne {
+	return NewSubscriptionEventClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the SubscriptionEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *SubscriptionEvent) Unwrap() *SubscriptionEvent {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionEvent is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *SubscriptionEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("status=")
+	builder.WriteString(se.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(se.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(se.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(se.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(se.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(se.SubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("gateway_provider=")
+	builder.WriteString(fmt.Sprintf("%v", se.GatewayProvider))
+	builder.WriteString(", ")
+	builder.WriteString("gateway_event_id=")
+	builder.WriteString(se.GatewayEventID)
+	builder.WriteString(", ")
+	builder.WriteString("event_status=")
+	builder.WriteString(fmt.Sprintf("%v", se.EventStatus))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionEvents is a parsable slice of SubscriptionEvent.
+type SubscriptionEvents []*SubscriptionEvent
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the subscriptionevent type in the database.
+	Label = "subscription_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldGatewayProvider holds the string denoting the gateway_provider field in the database.
+	FieldGatewayProvider = "gateway_provider"
+	// FieldGatewayEventID holds the string denoting the gateway_event_id field in the database.
+	FieldGatewayEventID = "gateway_event_id"
+	// FieldEventStatus holds the string denoting the event_status field in the database.
+	FieldEventStatus = "event_status"
+	// Table holds the table name of the subscriptionevent in the database.
+	Table = "subscription_events"
+)
+
+// Columns holds all SQL columns for subscriptionevent fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldSubscriptionID,
+	FieldGatewayProvider,
+	FieldGatewayEventID,
+	FieldEventStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	SubscriptionIDValidator func(string) error
+	// GatewayProviderValidator is a validator for the "gateway_provider" field. It is called by the builders before save.
+	GatewayProviderValidator func(string) error
+	// GatewayEventIDValidator is a validator for the "gateway_event_id" field. It is called by the builders before save.
+	GatewayEventIDValidator func(string) error
+	// EventStatusValidator is a validator for the "event_status" field. It is called by the builders before save.
+	EventStatusValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the SubscriptionEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByGatewayProvider orders the results by the gateway_provider field.
+func ByGatewayProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayProvider, opts...).ToFunc()
+}
+
+// ByGatewayEventID orders the results by the gateway_event_id field.
+func ByGatewayEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayEventID, opts...).ToFunc()
+}
+
+// ByEventStatus orders the results by the event_status field.
+func ByEventStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventStatus, opts...).ToFunc()
+}
@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// GatewayProvider applies equality check predicate on the "gateway_provider" field. It's identical to GatewayProviderEQ.
+func GatewayProvider(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldGatewayProvider, vc))
+}
+
+// GatewayEventID applies equality check predicate on the "gateway_event_id" field. It's identical to GatewayEventIDEQ.
+func GatewayEventID(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldGatewayEventID, v))
+}
+
+// EventStatus applies equality check predicate on the "event_status" field. It's identical to EventStatusEQ.
+func EventStatus(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldEventStatus, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// GatewayProviderEQ applies the EQ predicate on the "gateway_provider" field.
+func GatewayProviderEQ(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderNEQ applies the NEQ predicate on the "gateway_provider" field.
+func GatewayProviderNEQ(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderIn applies the In predicate on the "gateway_provider" field.
+func GatewayProviderIn(vs ...types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldGatewayProvider, v...))
+}
+
+// GatewayProviderNotIn applies the NotIn predicate on the "gateway_provider" field.
+func GatewayProviderNotIn(vs ...types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldGatewayProvider, v...))
+}
+
+// GatewayProviderGT applies the GT predicate on the "gateway_provider" field.
+func GatewayProviderGT(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderGTE applies the GTE predicate on the "gateway_provider" field.
+func GatewayProviderGTE(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderLT applies the LT predicate on the "gateway_provider" field.
+func GatewayProviderLT(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderLTE applies the LTE predicate on the "gateway_provider" field.
+func GatewayProviderLTE(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderContains applies the Contains predicate on the "gateway_provider" field.
+func GatewayProviderContains(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderHasPrefix applies the HasPrefix predicate on the "gateway_provider" field.
+func GatewayProviderHasPrefix(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderHasSuffix applies the HasSuffix predicate on the "gateway_provider" field.
+func GatewayProviderHasSuffix(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderEqualFold applies the EqualFold predicate on the "gateway_provider" field.
+func GatewayProviderEqualFold(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldGatewayProvider, vc))
+}
+
+// GatewayProviderContainsFold applies the ContainsFold predicate on the "gateway_provider" field.
+func GatewayProviderContainsFold(v types.PaymentGatewayProvider) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldGatewayProvider, vc))
+}
+
+// GatewayEventIDEQ applies the EQ predicate on the "gateway_event_id" field.
+func GatewayEventIDEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDNEQ applies the NEQ predicate on the "gateway_event_id" field.
+func GatewayEventIDNEQ(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDIn applies the In predicate on the "gateway_event_id" field.
+func GatewayEventIDIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldGatewayEventID, vs...))
+}
+
+// GatewayEventIDNotIn applies the NotIn predicate on the "gateway_event_id" field.
+func GatewayEventIDNotIn(vs ...string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldGatewayEventID, vs...))
+}
+
+// GatewayEventIDGT applies the GT predicate on the "gateway_event_id" field.
+func GatewayEventIDGT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDGTE applies the GTE predicate on the "gateway_event_id" field.
+func GatewayEventIDGTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDLT applies the LT predicate on the "gateway_event_id" field.
+func GatewayEventIDLT(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDLTE applies the LTE predicate on the "gateway_event_id" field.
+func GatewayEventIDLTE(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDContains applies the Contains predicate on the "gateway_event_id" field.
+func GatewayEventIDContains(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDHasPrefix applies the HasPrefix predicate on the "gateway_event_id" field.
+func GatewayEventIDHasPrefix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDHasSuffix applies the HasSuffix predicate on the "gateway_event_id" field.
+func GatewayEventIDHasSuffix(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDEqualFold applies the EqualFold predicate on the "gateway_event_id" field.
+func GatewayEventIDEqualFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldGatewayEventID, v))
+}
+
+// GatewayEventIDContainsFold applies the ContainsFold predicate on the "gateway_event_id" field.
+func GatewayEventIDContainsFold(v string) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldGatewayEventID, v))
+}
+
+// EventStatusEQ applies the EQ predicate on the "event_status" field.
+func EventStatusEQ(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldEQ(FieldEventStatus, vc))
+}
+
+// EventStatusNEQ applies the NEQ predicate on the "event_status" field.
+func EventStatusNEQ(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldNEQ(FieldEventStatus, vc))
+}
+
+// EventStatusIn applies the In predicate on the "event_status" field.
+func EventStatusIn(vs ...types.SubscriptionStatus) predicate.SubscriptionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionEvent(sql.FieldIn(FieldEventStatus, v...))
+}
+
+// EventStatusNotIn applies the NotIn predicate on the "event_status" field.
+func EventStatusNotIn(vs ...types.SubscriptionStatus) predicate.SubscriptionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionEvent(sql.FieldNotIn(FieldEventStatus, v...))
+}
+
+// EventStatusGT applies the GT predicate on the "event_status" field.
+func EventStatusGT(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldGT(FieldEventStatus, vc))
+}
+
+// EventStatusGTE applies the GTE predicate on the "event_status" field.
+func EventStatusGTE(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldGTE(FieldEventStatus, vc))
+}
+
+// EventStatusLT applies the LT predicate on the "event_status" field.
+func EventStatusLT(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldLT(FieldEventStatus, vc))
+}
+
+// EventStatusLTE applies the LTE predicate on the "event_status" field.
+func EventStatusLTE(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldLTE(FieldEventStatus, vc))
+}
+
+// EventStatusContains applies the Contains predicate on the "event_status" field.
+func EventStatusContains(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldContains(FieldEventStatus, vc))
+}
+
+// EventStatusHasPrefix applies the HasPrefix predicate on the "event_status" field.
+func EventStatusHasPrefix(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldHasPrefix(FieldEventStatus, vc))
+}
+
+// EventStatusHasSuffix applies the HasSuffix predicate on the "event_status" field.
+func EventStatusHasSuffix(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldHasSuffix(FieldEventStatus, vc))
+}
+
+// EventStatusEqualFold applies the EqualFold predicate on the "event_status" field.
+func EventStatusEqualFold(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldEqualFold(FieldEventStatus, vc))
+}
+
+// EventStatusContainsFold applies the ContainsFold predicate on the "event_status" field.
+func EventStatusContainsFold(v types.SubscriptionStatus) predicate.SubscriptionEvent {
+	vc := string(v)
+	return predicate.SubscriptionEvent(sql.FieldContainsFold(FieldEventStatus, vc))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionEvent) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionEvent) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionEvent) predicate.SubscriptionEvent {
+	return predicate.SubscriptionEvent(sql.NotPredicates(p))
+}
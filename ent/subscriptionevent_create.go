@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/subscriptionevent"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// SubscriptionEventCreate is the builder for creating a SubscriptionEvent entity.
+type SubscriptionEventCreate struct {
+	config
+	mutation *SubscriptionEventMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (sec *SubscriptionEventCreate) SetStatus(s string) *SubscriptionEventCreate {
+	sec.mutation.SetStatus(s)
+	return sec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sec *SubscriptionEventCreate) SetNillableStatus(s *string) *SubscriptionEventCreate {
+	if s != nil {
+		sec.SetStatus(*s)
+	}
+	return sec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sec *SubscriptionEventCreate) SetCreatedAt(t time.Time) *SubscriptionEventCreate {
+	sec.mutation.SetCreatedAt(t)
+	return sec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sec *SubscriptionEventCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionEventCreate {
+	if t != nil {
+		sec.SetCreatedAt(*t)
+	}
+	return sec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sec *SubscriptionEventCreate) SetUpdatedAt(t time.Time) *SubscriptionEventCreate {
+	sec.mutation.SetUpdatedAt(t)
+	return sec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sec *SubscriptionEventCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionEventCreate {
+	if t != nil {
+		sec.SetUpdatedAt(*t)
+	}
+	return sec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sec *SubscriptionEventCreate) SetCreatedBy(s string) *SubscriptionEventCreate {
+	sec.mutation.SetCreatedBy(s)
+	return sec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sec *SubscriptionEventCreate) SetNillableCreatedBy(s *string) *SubscriptionEventCreate {
+	if s != nil {
+		sec.SetCreatedBy(*s)
+	}
+	return sec
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sec *SubscriptionEventCreate) SetUpdatedBy(s string) *SubscriptionEventCreate {
+	sec.mutation.SetUpdatedBy(s)
+	return sec
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sec *SubscriptionEventCreate) SetNillableUpdatedBy(s *string) *SubscriptionEventCreate {
+	if s != nil {
+		sec.SetUpdatedBy(*s)
+	}
+	return sec
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (sec *SubscriptionEventCreate) SetSubscriptionID(s string) *SubscriptionEventCreate {
+	sec.mutation.SetSubscriptionID(s)
+	return sec
+}
+
+// SetGatewayProvider sets the "gateway_provider" field.
+func (sec *SubscriptionEventCreate) SetGatewayProvider(tgp types.PaymentGatewayProvider) *SubscriptionEventCreate {
+	sec.mutation.SetGatewayProvider(tgp)
+	return sec
+}
+
+// SetGatewayEventID sets the "gateway_event_id" field.
+func (sec *SubscriptionEventCreate) SetGatewayEventID(s string) *SubscriptionEventCreate {
+	sec.mutation.SetGatewayEventID(s)
+	return sec
+}
+
+// SetEventStatus sets the "event_status" field.
+func (sec *SubscriptionEventCreate) SetEventStatus(ts types.SubscriptionStatus) *SubscriptionEventCreate {
+	sec.mutation.SetEventStatus(ts)
+	return sec
+}
+
+// SetID sets the "id" field.
+func (sec *SubscriptionEventCreate) SetID(s string) *SubscriptionEventCreate {
+	sec.mutation.SetID(s)
+	return sec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sec *SubscriptionEventCreate) SetNillableID(s *string) *SubscriptionEventCreate {
+	if s != nil {
+		sec.SetID(*s)
+	}
+	return sec
+}
+
+// Mutation returns the SubscriptionEventMutation object of the builder.
+func (sec *SubscriptionEventCreate) Mutation() *SubscriptionEventMutation {
+	return sec.mutation
+}
+
+// Save creates the SubscriptionEvent in the database.
+func (sec *SubscriptionEventCreate) Save(ctx context.Context) (*SubscriptionEvent, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *SubscriptionEventCreate) SaveX(ctx context.Context) *SubscriptionEvent {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *SubscriptionEventCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *SubscriptionEventCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *SubscriptionEventCreate) defaults() {
+	if _, ok := sec.mutation.Status(); !ok {
+		v := subscriptionevent.DefaultStatus
+		sec.mutation.SetStatus(v)
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		v := subscriptionevent.DefaultCreatedAt()
+		sec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sec.mutation.UpdatedAt(); !ok {
+		v := subscriptionevent.DefaultUpdatedAt()
+		sec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sec.mutation.ID(); !ok {
+		v := subscriptionevent.DefaultID()
+		sec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *SubscriptionEventCreate) check() error {
+	if _, ok := sec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SubscriptionEvent.status"`)}
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionEvent.created_at"`)}
+	}
+	if _, ok := sec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionEvent.updated_at"`)}
+	}
+	if _, ok := sec.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "SubscriptionEvent.subscription_id"`)}
+	}
+	if v, ok := sec.mutation.SubscriptionID(); ok {
+		if err := subscriptionevent.SubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionEvent.subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.GatewayProvider(); !ok {
+		return &ValidationError{Name: "gateway_provider", err: errors.New(`ent: missing required field "SubscriptionEvent.gateway_provider"`)}
+	}
+	if v, ok := sec.mutation.GatewayProvider(); ok {
+		if err := subscriptionevent.GatewayProviderValidator(string(v)); err != nil {
+			return &ValidationError{Name: "gateway_provider", err: fmt.Errorf(`ent: validator failed for field "SubscriptionEvent.gateway_provider": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.GatewayEventID(); !ok {
+		return &ValidationError{Name: "gateway_event_id", err: errors.New(`ent: missing required field "SubscriptionEvent.gateway_event_id"`)}
+	}
+	if v, ok := sec.mutation.GatewayEventID(); ok {
+		if err := subscriptionevent.GatewayEventIDValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_event_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionEvent.gateway_event_id": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.EventStatus(); !ok {
+		return &ValidationError{Name: "event_status", err: errors.New(`ent: missing required field "SubscriptionEvent.event_status"`)}
+	}
+	if v, ok := sec.mutation.EventStatus(); ok {
+		if err := subscriptionevent.EventStatusValidator(string(v)); err != nil {
+			return &ValidationError{Name: "event_status", err: fmt.Errorf(`ent: validator failed for field "SubscriptionEvent.event_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sec *SubscriptionEventCreate) sqlSave(ctx context.Context) (*SubscriptionEvent, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *SubscriptionEventCreate) createSpec() (*SubscriptionEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionEvent{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionevent.Table, sqlgraph.NewFieldSpec(subscriptionevent.FieldID, field.TypeString))
+	)
+	if id, ok := sec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sec.mutation.Status(); ok {
+		_spec.SetField(subscriptionevent.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sec.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sec.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sec.mutation.CreatedBy(); ok {
+		_spec.SetField(subscriptionevent.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sec.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionevent.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sec.mutation.SubscriptionID(); ok {
+		_spec.SetField(subscriptionevent.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := sec.mutation.GatewayProvider(); ok {
+		_spec.SetField(subscriptionevent.FieldGatewayProvider, field.TypeString, value)
+		_node.GatewayProvider = value
+	}
+	if value, ok := sec.mutation.GatewayEventID(); ok {
+		_spec.SetField(subscriptionevent.FieldGatewayEventID, field.TypeString, value)
+		_node.GatewayEventID = value
+	}
+	if value, ok := sec.mutation.EventStatus(); ok {
+		_spec.SetField(subscriptionevent.FieldEventStatus, field.TypeString, value)
+		_node.EventStatus = value
+	}
+	return _node, _spec
+}
+
+// SubscriptionEventCreateBulk is the builder for creating many SubscriptionEvent entities in bulk.
+type SubscriptionEventCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionEventCreate
+}
+
+// Save creates the SubscriptionEvent entities in the database.
+func (secb *SubscriptionEventCreateBulk) Save(ctx context.Context) ([]*SubscriptionEvent, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*SubscriptionEvent, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *SubscriptionEventCreateBulk) SaveX(ctx context.Context) []*SubscriptionEvent {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *SubscriptionEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *SubscriptionEventCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
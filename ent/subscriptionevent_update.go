@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/subscriptionevent"
+)
+
+// SubscriptionEventUpdate is the builder for updating SubscriptionEvent entities.
+type SubscriptionEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionEventMutation
+}
+
+// Where appends a list predicates to the SubscriptionEventUpdate builder.
+func (seu *SubscriptionEventUpdate) Where(ps ...predicate.SubscriptionEvent) *SubscriptionEventUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetStatus sets the "status" field.
+func (seu *SubscriptionEventUpdate) SetStatus(s string) *SubscriptionEventUpdate {
+	seu.mutation.SetStatus(s)
+	return seu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (seu *SubscriptionEventUpdate) SetNillableStatus(s *string) *SubscriptionEventUpdate {
+	if s != nil {
+		seu.SetStatus(*s)
+	}
+	return seu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (seu *SubscriptionEventUpdate) SetUpdatedAt(t time.Time) *SubscriptionEventUpdate {
+	seu.mutation.SetUpdatedAt(t)
+	return seu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (seu *SubscriptionEventUpdate) SetUpdatedBy(s string) *SubscriptionEventUpdate {
+	seu.mutation.SetUpdatedBy(s)
+	return seu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (seu *SubscriptionEventUpdate) SetNillableUpdatedBy(s *string) *SubscriptionEventUpdate {
+	if s != nil {
+		seu.SetUpdatedBy(*s)
+	}
+	return seu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (seu *SubscriptionEventUpdate) ClearUpdatedBy() *SubscriptionEventUpdate {
+	seu.mutation.ClearUpdatedBy()
+	return seu
+}
+
+// Mutation returns the SubscriptionEventMutation object of the builder.
+func (seu *SubscriptionEventUpdate) Mutation() *SubscriptionEventMutation {
+	return seu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *SubscriptionEventUpdate) Save(ctx context.Context) (int, error) {
+	seu.defaults()
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *SubscriptionEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *SubscriptionEventUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *SubscriptionEventUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (seu *SubscriptionEventUpdate) defaults() {
+	if _, ok := seu.mutation.UpdatedAt(); !ok {
+		v := subscriptionevent.UpdateDefaultUpdatedAt()
+		seu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (seu *SubscriptionEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscriptionevent.Table, subscriptionevent.Columns, sqlgraph.NewFieldSpec(subscriptionevent.FieldID, field.TypeString))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seu.mutation.Status(); ok {
+		_spec.SetField(subscriptionevent.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := seu.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if seu.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionevent.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := seu.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionevent.FieldUpdatedBy, field.TypeString, value)
+	}
+	if seu.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionevent.FieldUpdatedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionEventUpdateOne is the builder for updating a single SubscriptionEvent entity.
+type SubscriptionEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionEventMutation
+}
+
+// SetStatus sets the "status" field.
+func (seuo *SubscriptionEventUpdateOne) SetStatus(s string) *SubscriptionEventUpdateOne {
+	seuo.mutation.SetStatus(s)
+	return seuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (seuo *SubscriptionEventUpdateOne) SetNillableStatus(s *string) *SubscriptionEventUpdateOne {
+	if s != nil {
+		seuo.SetStatus(*s)
+	}
+	return seuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (seuo *SubscriptionEventUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionEventUpdateOne {
+	seuo.mutation.SetUpdatedAt(t)
+	return seuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (seuo *SubscriptionEventUpdateOne) SetUpdatedBy(s string) *SubscriptionEventUpdateOne {
+	seuo.mutation.SetUpdatedBy(s)
+	return seuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (seuo *SubscriptionEventUpdateOne) SetNillableUpdatedBy(s *string) *SubscriptionEventUpdateOne {
+	if s != nil {
+		seuo.SetUpdatedBy(*s)
+	}
+	return seuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (seuo *SubscriptionEventUpdateOne) ClearUpdatedBy() *SubscriptionEventUpdateOne {
+	seuo.mutation.ClearUpdatedBy()
+	return seuo
+}
+
+// Mutation returns the SubscriptionEventMutation object of the builder.
+func (seuo *SubscriptionEventUpdateOne) Mutation() *SubscriptionEventMutation {
+	return seuo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionEventUpdate builder.
+func (seuo *SubscriptionEventUpdateOne) Where(ps ...predicate.SubscriptionEvent) *SubscriptionEventUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *SubscriptionEventUpdateOne) Select(field string, fields ...string) *SubscriptionEventUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated SubscriptionEvent entity.
+func (seuo *SubscriptionEventUpdateOne) Save(ctx context.Context) (*SubscriptionEvent, error) {
+	seuo.defaults()
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *SubscriptionEventUpdateOne) SaveX(ctx context.Context) *SubscriptionEvent {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *SubscriptionEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *SubscriptionEventUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (seuo *SubscriptionEventUpdateOne) defaults() {
+	if _, ok := seuo.mutation.UpdatedAt(); !ok {
+		v := subscriptionevent.UpdateDefaultUpdatedAt()
+		seuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (seuo *SubscriptionEventUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscriptionevent.Table, subscriptionevent.Columns, sqlgraph.NewFieldSpec(subscriptionevent.FieldID, field.TypeString))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionevent.FieldID)
+		for _, f := range fields {
+			if !subscriptionevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seuo.mutation.Status(); ok {
+		_spec.SetField(subscriptionevent.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := seuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if seuo.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionevent.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := seuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionevent.FieldUpdatedBy, field.TypeString, value)
+	}
+	if seuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionevent.FieldUpdatedBy, field.TypeString)
+	}
+	_node = &SubscriptionEvent{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/subscriptionplan"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionPlanCreate is the builder for creating a SubscriptionPlan entity.
+type SubscriptionPlanCreate struct {
+	config
+	mutation *SubscriptionPlanMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (spc *SubscriptionPlanCreate) SetStatus(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetStatus(s)
+	return spc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableStatus(s *string) *SubscriptionPlanCreate {
+	if s != nil {
+		spc.SetStatus(*s)
+	}
+	return spc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (spc *SubscriptionPlanCreate) SetCreatedAt(t time.Time) *SubscriptionPlanCreate {
+	spc.mutation.SetCreatedAt(t)
+	return spc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionPlanCreate {
+	if t != nil {
+		spc.SetCreatedAt(*t)
+	}
+	return spc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (spc *SubscriptionPlanCreate) SetUpdatedAt(t time.Time) *SubscriptionPlanCreate {
+	spc.mutation.SetUpdatedAt(t)
+	return spc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionPlanCreate {
+	if t != nil {
+		spc.SetUpdatedAt(*t)
+	}
+	return spc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (spc *SubscriptionPlanCreate) SetCreatedBy(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetCreatedBy(s)
+	return spc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableCreatedBy(s *string) *SubscriptionPlanCreate {
+	if s != nil {
+		spc.SetCreatedBy(*s)
+	}
+	return spc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (spc *SubscriptionPlanCreate) SetUpdatedBy(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetUpdatedBy(s)
+	return spc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableUpdatedBy(s *string) *SubscriptionPlanCreate {
+	if s != nil {
+		spc.SetUpdatedBy(*s)
+	}
+	return spc
+}
+
+// SetMetadata sets the "metadata" field.
+func (spc *SubscriptionPlanCreate) SetMetadata(m map[string]string) *SubscriptionPlanCreate {
+	spc.mutation.SetMetadata(m)
+	return spc
+}
+
+// SetName sets the "name" field.
+func (spc *SubscriptionPlanCreate) SetName(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetName(s)
+	return spc
+}
+
+// SetDescription sets the "description" field.
+func (spc *SubscriptionPlanCreate) SetDescription(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetDescription(s)
+	return spc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableDescription(s *string) *SubscriptionPlanCreate {
+	if s != nil {
+		spc.SetDescription(*s)
+	}
+	return spc
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (spc *SubscriptionPlanCreate) SetBillingPeriod(tp types.BillingPeriod) *SubscriptionPlanCreate {
+	spc.mutation.SetBillingPeriod(tp)
+	return spc
+}
+
+// SetAmount sets the "amount" field.
+func (spc *SubscriptionPlanCreate) SetAmount(d decimal.Decimal) *SubscriptionPlanCreate {
+	spc.mutation.SetAmount(d)
+	return spc
+}
+
+// SetCurrency sets the "currency" field.
+func (spc *SubscriptionPlanCreate) SetCurrency(t types.Currency) *SubscriptionPlanCreate {
+	spc.mutation.SetCurrency(t)
+	return spc
+}
+
+// SetCategoryIds sets the "category_ids" field.
+func (spc *SubscriptionPlanCreate) SetCategoryIds(s []string) *SubscriptionPlanCreate {
+	spc.mutation.SetCategoryIds(s)
+	return spc
+}
+
+// SetGatewayProvider sets the "gateway_provider" field.
+func (spc *SubscriptionPlanCreate) SetGatewayProvider(tgp types.PaymentGatewayProvider) *SubscriptionPlanCreate {
+	spc.mutation.SetGatewayProvider(tgp)
+	return spc
+}
+
+// SetGatewayPlanID sets the "gateway_plan_id" field.
+func (spc *SubscriptionPlanCreate) SetGatewayPlanID(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetGatewayPlanID(s)
+	return spc
+}
+
+// SetNillableGatewayPlanID sets the "gateway_plan_id" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableGatewayPlanID(s *string) *SubscriptionPlanCreate {
+	if s != nil {
+		spc.SetGatewayPlanID(*s)
+	}
+	return spc
+}
+
+// SetIsActive sets the "is_active" field.
+func (spc *SubscriptionPlanCreate) SetIsActive(b bool) *SubscriptionPlanCreate {
+	spc.mutation.SetIsActive(b)
+	return spc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableIsActive(b *bool) *SubscriptionPlanCreate {
+	if b != nil {
+		spc.SetIsActive(*b)
+	}
+	return spc
+}
+
+// SetID sets the "id" field.
+func (spc *SubscriptionPlanCreate) SetID(s string) *SubscriptionPlanCreate {
+	spc.mutation.SetID(s)
+	return spc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (spc *SubscriptionPlanCreate) SetNillableID(s *string) *SubscriptionPlanCreate {
+	if s != nil {
+		spc.SetID(*s)
+	}
+	return spc
+}
+
+// Mutation returns the SubscriptionPlanMutation object of the builder.
+func (spc *SubscriptionPlanCreate) Mutation() *SubscriptionPlanMutation {
+	return spc.mutation
+}
+
+// Save creates the SubscriptionPlan in the database.
+func (spc *SubscriptionPlanCreate) Save(ctx context.Context) (*SubscriptionPlan, error) {
+	spc.defaults()
+	return withHooks(ctx, spc.sqlSave, spc.mutation, spc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (spc *SubscriptionPlanCreate) SaveX(ctx context.Context) *SubscriptionPlan {
+	v, err := spc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spc *SubscriptionPlanCreate) Exec(ctx context.Context) error {
+	_, err := spc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spc *SubscriptionPlanCreate) ExecX(ctx context.Context) {
+	if err := spc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (spc *SubscriptionPlanCreate) defaults() {
+	if _, ok := spc.mutation.Status(); !ok {
+		v := subscriptionplan.DefaultStatus
+		spc.mutation.SetStatus(v)
+	}
+	if _, ok := spc.mutation.CreatedAt(); !ok {
+		v := subscriptionplan.DefaultCreatedAt()
+		spc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := spc.mutation.UpdatedAt(); !ok {
+		v := subscriptionplan.DefaultUpdatedAt()
+		spc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := spc.mutation.Metadata(); !ok {
+		v := subscriptionplan.DefaultMetadata
+		spc.mutation.SetMetadata(v)
+	}
+	if _, ok := spc.mutation.IsActive(); !ok {
+		v := subscriptionplan.DefaultIsActive
+		spc.mutation.SetIsActive(v)
+	}
+	if _, ok := spc.mutation.ID(); !ok {
+		v := subscriptionplan.DefaultID()
+		spc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spc *SubscriptionPlanCreate) check() error {
+	if _, ok := spc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SubscriptionPlan.status"`)}
+	}
+	if _, ok := spc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionPlan.created_at"`)}
+	}
+	if _, ok := spc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionPlan.updated_at"`)}
+	}
+	if _, ok := spc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SubscriptionPlan.name"`)}
+	}
+	if v, ok := spc.mutation.Name(); ok {
+		if err := subscriptionplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.name": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.BillingPeriod(); !ok {
+		return &ValidationError{Name: "billing_period", err: errors.New(`ent: missing required field "SubscriptionPlan.billing_period"`)}
+	}
+	if v, ok := spc.mutation.BillingPeriod(); ok {
+		if err := subscriptionplan.BillingPeriodValidator(string(v)); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.billing_period": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SubscriptionPlan.amount"`)}
+	}
+	if _, ok := spc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SubscriptionPlan.currency"`)}
+	}
+	if v, ok := spc.mutation.Currency(); ok {
+		if err := subscriptionplan.CurrencyValidator(string(v)); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.currency": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.CategoryIds(); !ok {
+		return &ValidationError{Name: "category_ids", err: errors.New(`ent: missing required field "SubscriptionPlan.category_ids"`)}
+	}
+	if _, ok := spc.mutation.GatewayProvider(); !ok {
+		return &ValidationError{Name: "gateway_provider", err: errors.New(`ent: missing required field "SubscriptionPlan.gateway_provider"`)}
+	}
+	if v, ok := spc.mutation.GatewayProvider(); ok {
+		if err := subscriptionplan.GatewayProviderValidator(string(v)); err != nil {
+			return &ValidationError{Name: "gateway_provider", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.gateway_provider": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "SubscriptionPlan.is_active"`)}
+	}
+	return nil
+}
+
+func (spc *SubscriptionPlanCreate) sqlSave(ctx context.Context) (*SubscriptionPlan, error) {
+	if err := spc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := spc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, spc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionPlan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	spc.mutation.id = &_node.ID
+	spc.mutation.done = true
+	return _node, nil
+}
+
+func (spc *SubscriptionPlanCreate) createSpec() (*SubscriptionPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionPlan{config: spc.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionplan.Table, sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString))
+	)
+	if id, ok := spc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := spc.mutation.Status(); ok {
+		_spec.SetField(subscriptionplan.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := spc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := spc.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := spc.mutation.CreatedBy(); ok {
+		_spec.SetField(subscriptionplan.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := spc.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := spc.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionplan.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := spc.mutation.Name(); ok {
+		_spec.SetField(subscriptionplan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := spc.mutation.Description(); ok {
+		_spec.SetField(subscriptionplan.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := spc.mutation.BillingPeriod(); ok {
+		_spec.SetField(subscriptionplan.FieldBillingPeriod, field.TypeString, value)
+		_node.BillingPeriod = value
+	}
+	if value, ok := spc.mutation.Amount(); ok {
+		_spec.SetField(subscriptionplan.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := spc.mutation.Currency(); ok {
+		_spec.SetField(subscriptionplan.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := spc.mutation.CategoryIds(); ok {
+		_spec.SetField(subscriptionplan.FieldCategoryIds, field.TypeJSON, value)
+		_node.CategoryIds = value
+	}
+	if value, ok := spc.mutation.GatewayProvider(); ok {
+		_spec.SetField(subscriptionplan.FieldGatewayProvider, field.TypeString, value)
+		_node.GatewayProvider = value
+	}
+	if value, ok := spc.mutation.GatewayPlanID(); ok {
+		_spec.SetField(subscriptionplan.FieldGatewayPlanID, field.TypeString, value)
+		_node.GatewayPlanID = &value
+	}
+	if value, ok := spc.mutation.IsActive(); ok {
+		_spec.SetField(subscriptionplan.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	return _node, _spec
+}
+
+// SubscriptionPlanCreateBulk is the builder for creating many SubscriptionPlan entities in bulk.
+type SubscriptionPlanCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionPlanCreate
+}
+
+// Save creates the SubscriptionPlan entities in the database.
+func (spcb *SubscriptionPlanCreateBulk) Save(ctx context.Context) ([]*SubscriptionPlan, error) {
+	if spcb.err != nil {
+		return nil, spcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(spcb.builders))
+	nodes := make([]*SubscriptionPlan, len(spcb.builders))
+	mutators := make([]Mutator, len(spcb.builders))
+	for i := range spcb.builders {
+		func(i int, root context.Context) {
+			builder := spcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, spcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, spcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, spcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spcb *SubscriptionPlanCreateBulk) SaveX(ctx context.Context) []*SubscriptionPlan {
+	v, err := spcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spcb *SubscriptionPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := spcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spcb *SubscriptionPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := spcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
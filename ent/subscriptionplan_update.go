@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/subscriptionplan"
+)
+
+// SubscriptionPlanUpdate is the builder for updating SubscriptionPlan entities.
+type SubscriptionPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionPlanMutation
+}
+
+// Where appends a list predicates to the SubscriptionPlanUpdate builder.
+func (spu *SubscriptionPlanUpdate) Where(ps ...predicate.SubscriptionPlan) *SubscriptionPlanUpdate {
+	spu.mutation.Where(ps...)
+	return spu
+}
+
+// SetStatus sets the "status" field.
+func (spu *SubscriptionPlanUpdate) SetStatus(s string) *SubscriptionPlanUpdate {
+	spu.mutation.SetStatus(s)
+	return spu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (spu *SubscriptionPlanUpdate) SetNillableStatus(s *string) *SubscriptionPlanUpdate {
+	if s != nil {
+		spu.SetStatus(*s)
+	}
+	return spu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (spu *SubscriptionPlanUpdate) SetUpdatedAt(t time.Time) *SubscriptionPlanUpdate {
+	spu.mutation.SetUpdatedAt(t)
+	return spu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (spu *SubscriptionPlanUpdate) SetUpdatedBy(s string) *SubscriptionPlanUpdate {
+	spu.mutation.SetUpdatedBy(s)
+	return spu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (spu *SubscriptionPlanUpdate) SetNillableUpdatedBy(s *string) *SubscriptionPlanUpdate {
+	if s != nil {
+		spu.SetUpdatedBy(*s)
+	}
+	return spu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (spu *SubscriptionPlanUpdate) ClearUpdatedBy() *SubscriptionPlanUpdate {
+	spu.mutation.ClearUpdatedBy()
+	return spu
+}
+
+// SetMetadata sets the "metadata" field.
+func (spu *SubscriptionPlanUpdate) SetMetadata(m map[string]string) *SubscriptionPlanUpdate {
+	spu.mutation.SetMetadata(m)
+	return spu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (spu *SubscriptionPlanUpdate) ClearMetadata() *SubscriptionPlanUpdate {
+	spu.mutation.ClearMetadata()
+	return spu
+}
+
+// SetName sets the "name" field.
+func (spu *SubscriptionPlanUpdate) SetName(s string) *SubscriptionPlanUpdate {
+	spu.mutation.SetName(s)
+	return spu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (spu *SubscriptionPlanUpdate) SetNillableName(s *string) *SubscriptionPlanUpdate {
+	if s != nil {
+		spu.SetName(*s)
+	}
+	return spu
+}
+
+// SetDescription sets the "description" field.
+func (spu *SubscriptionPlanUpdate) SetDescription(s string) *SubscriptionPlanUpdate {
+	spu.mutation.SetDescription(s)
+	return spu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (spu *SubscriptionPlanUpdate) SetNillableDescription(s *string) *SubscriptionPlanUpdate {
+	if s != nil {
+		spu.SetDescription(*s)
+	}
+	return spu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (spu *SubscriptionPlanUpdate) ClearDescription() *SubscriptionPlanUpdate {
+	spu.mutation.ClearDescription()
+	return spu
+}
+
+// SetCategoryIds sets the "category_ids" field.
+func (spu *SubscriptionPlanUpdate) SetCategoryIds(s []string) *SubscriptionPlanUpdate {
+	spu.mutation.SetCategoryIds(s)
+	return spu
+}
+
+// AppendCategoryIds appends s to the "category_ids" field.
+func (spu *SubscriptionPlanUpdate) AppendCategoryIds(s []string) *SubscriptionPlanUpdate {
+	spu.mutation.AppendCategoryIds(s)
+	return spu
+}
+
+// SetGatewayPlanID sets the "gateway_plan_id" field.
+func (spu *SubscriptionPlanUpdate) SetGatewayPlanID(s string) *SubscriptionPlanUpdate {
+	spu.mutation.SetGatewayPlanID(s)
+	return spu
+}
+
+// SetNillableGatewayPlanID sets the "gateway_plan_id" field if the given value is not nil.
+func (spu *SubscriptionPlanUpdate) SetNillableGatewayPlanID(s *string) *SubscriptionPlanUpdate {
+	if s != nil {
+		spu.SetGatewayPlanID(*s)
+	}
+	return spu
+}
+
+// ClearGatewayPlanID clears the value of the "gateway_plan_id" field.
+func (spu *SubscriptionPlanUpdate) ClearGatewayPlanID() *SubscriptionPlanUpdate {
+	spu.mutation.ClearGatewayPlanID()
+	return spu
+}
+
+// SetIsActive sets the "is_active" field.
+func (spu *SubscriptionPlanUpdate) SetIsActive(b bool) *SubscriptionPlanUpdate {
+	spu.mutation.SetIsActive(b)
+	return spu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (spu *SubscriptionPlanUpdate) SetNillableIsActive(b *bool) *SubscriptionPlanUpdate {
+	if b != nil {
+		spu.SetIsActive(*b)
+	}
+	return spu
+}
+
+// Mutation returns the SubscriptionPlanMutation object of the builder.
+func (spu *SubscriptionPlanUpdate) Mutation() *SubscriptionPlanMutation {
+	return spu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (spu *SubscriptionPlanUpdate) Save(ctx context.Context) (int, error) {
+	spu.defaults()
+	return withHooks(ctx, spu.sqlSave, spu.mutation, spu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spu *SubscriptionPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := spu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (spu *SubscriptionPlanUpdate) Exec(ctx context.Context) error {
+	_, err := spu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spu *SubscriptionPlanUpdate) ExecX(ctx context.Context) {
+	if err := spu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (spu *SubscriptionPlanUpdate) defaults() {
+	if _, ok := spu.mutation.UpdatedAt(); !ok {
+		v := subscriptionplan.UpdateDefaultUpdatedAt()
+		spu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spu *SubscriptionPlanUpdate) check() error {
+	if v, ok := spu.mutation.Name(); ok {
+		if err := subscriptionplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (spu *SubscriptionPlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := spu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionplan.Table, subscriptionplan.Columns, sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString))
+	if ps := spu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spu.mutation.Status(); ok {
+		_spec.SetField(subscriptionplan.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := spu.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if spu.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionplan.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := spu.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedBy, field.TypeString, value)
+	}
+	if spu.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionplan.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := spu.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionplan.FieldMetadata, field.TypeJSON, value)
+	}
+	if spu.mutation.MetadataCleared() {
+		_spec.ClearField(subscriptionplan.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := spu.mutation.Name(); ok {
+		_spec.SetField(subscriptionplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := spu.mutation.Description(); ok {
+		_spec.SetField(subscriptionplan.FieldDescription, field.TypeString, value)
+	}
+	if spu.mutation.DescriptionCleared() {
+		_spec.ClearField(subscriptionplan.FieldDescription, field.TypeString)
+	}
+	if value, ok := spu.mutation.CategoryIds(); ok {
+		_spec.SetField(subscriptionplan.FieldCategoryIds, field.TypeJSON, value)
+	}
+	if value, ok := spu.mutation.AppendedCategoryIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscriptionplan.FieldCategoryIds, value)
+		})
+	}
+	if value, ok := spu.mutation.GatewayPlanID(); ok {
+		_spec.SetField(subscriptionplan.FieldGatewayPlanID, field.TypeString, value)
+	}
+	if spu.mutation.GatewayPlanIDCleared() {
+		_spec.ClearField(subscriptionplan.FieldGatewayPlanID, field.TypeString)
+	}
+	if value, ok := spu.mutation.IsActive(); ok {
+		_spec.SetField(subscriptionplan.FieldIsActive, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	spu.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionPlanUpdateOne is the builder for updating a single SubscriptionPlan entity.
+type SubscriptionPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionPlanMutation
+}
+
+// SetStatus sets the "status" field.
+func (spuo *SubscriptionPlanUpdateOne) SetStatus(s string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetStatus(s)
+	return spuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (spuo *SubscriptionPlanUpdateOne) SetNillableStatus(s *string) *SubscriptionPlanUpdateOne {
+	if s != nil {
+		spuo.SetStatus(*s)
+	}
+	return spuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (spuo *SubscriptionPlanUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetUpdatedAt(t)
+	return spuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (spuo *SubscriptionPlanUpdateOne) SetUpdatedBy(s string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetUpdatedBy(s)
+	return spuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (spuo *SubscriptionPlanUpdateOne) SetNillableUpdatedBy(s *string) *SubscriptionPlanUpdateOne {
+	if s != nil {
+		spuo.SetUpdatedBy(*s)
+	}
+	return spuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (spuo *SubscriptionPlanUpdateOne) ClearUpdatedBy() *SubscriptionPlanUpdateOne {
+	spuo.mutation.ClearUpdatedBy()
+	return spuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (spuo *SubscriptionPlanUpdateOne) SetMetadata(m map[string]string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetMetadata(m)
+	return spuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (spuo *SubscriptionPlanUpdateOne) ClearMetadata() *SubscriptionPlanUpdateOne {
+	spuo.mutation.ClearMetadata()
+	return spuo
+}
+
+// SetName sets the "name" field.
+func (spuo *SubscriptionPlanUpdateOne) SetName(s string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetName(s)
+	return spuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (spuo *SubscriptionPlanUpdateOne) SetNillableName(s *string) *SubscriptionPlanUpdateOne {
+	if s != nil {
+		spuo.SetName(*s)
+	}
+	return spuo
+}
+
+// SetDescription sets the "description" field.
+func (spuo *SubscriptionPlanUpdateOne) SetDescription(s string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetDescription(s)
+	return spuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (spuo *SubscriptionPlanUpdateOne) SetNillableDescription(s *string) *SubscriptionPlanUpdateOne {
+	if s != nil {
+		spuo.SetDescription(*s)
+	}
+	return spuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (spuo *SubscriptionPlanUpdateOne) ClearDescription() *SubscriptionPlanUpdateOne {
+	spuo.mutation.ClearDescription()
+	return spuo
+}
+
+// SetCategoryIds sets the "category_ids" field.
+func (spuo *SubscriptionPlanUpdateOne) SetCategoryIds(s []string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetCategoryIds(s)
+	return spuo
+}
+
+// AppendCategoryIds appends s to the "category_ids" field.
+func (spuo *SubscriptionPlanUpdateOne) AppendCategoryIds(s []string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.AppendCategoryIds(s)
+	return spuo
+}
+
+// SetGatewayPlanID sets the "gateway_plan_id" field.
+func (spuo *SubscriptionPlanUpdateOne) SetGatewayPlanID(s string) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetGatewayPlanID(s)
+	return spuo
+}
+
+// SetNillableGatewayPlanID sets the "gateway_plan_id" field if the given value is not nil.
+func (spuo *SubscriptionPlanUpdateOne) SetNillableGatewayPlanID(s *string) *SubscriptionPlanUpdateOne {
+	if s != nil {
+		spuo.SetGatewayPlanID(*s)
+	}
+	return spuo
+}
+
+// ClearGatewayPlanID clears the value of the "gateway_plan_id" field.
+func (spuo *SubscriptionPlanUpdateOne) ClearGatewayPlanID() *SubscriptionPlanUpdateOne {
+	spuo.mutation.ClearGatewayPlanID()
+	return spuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (spuo *SubscriptionPlanUpdateOne) SetIsActive(b bool) *SubscriptionPlanUpdateOne {
+	spuo.mutation.SetIsActive(b)
+	return spuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (spuo *SubscriptionPlanUpdateOne) SetNillableIsActive(b *bool) *SubscriptionPlanUpdateOne {
+	if b != nil {
+		spuo.SetIsActive(*b)
+	}
+	return spuo
+}
+
+// Mutation returns the SubscriptionPlanMutation object of the builder.
+func (spuo *SubscriptionPlanUpdateOne) Mutation() *SubscriptionPlanMutation {
+	return spuo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionPlanUpdate builder.
+func (spuo *SubscriptionPlanUpdateOne) Where(ps ...predicate.SubscriptionPlan) *SubscriptionPlanUpdateOne {
+	spuo.mutation.Where(ps...)
+	return spuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (spuo *SubscriptionPlanUpdateOne) Select(field string, fields ...string) *SubscriptionPlanUpdateOne {
+	spuo.fields = append([]string{field}, fields...)
+	return spuo
+}
+
+// Save executes the query and returns the updated SubscriptionPlan entity.
+func (spuo *SubscriptionPlanUpdateOne) Save(ctx context.Context) (*SubscriptionPlan, error) {
+	spuo.defaults()
+	return withHooks(ctx, spuo.sqlSave, spuo.mutation, spuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spuo *SubscriptionPlanUpdateOne) SaveX(ctx context.Context) *SubscriptionPlan {
+	node, err := spuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (spuo *SubscriptionPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := spuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spuo *SubscriptionPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := spuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (spuo *SubscriptionPlanUpdateOne) defaults() {
+	if _, ok := spuo.mutation.UpdatedAt(); !ok {
+		v := subscriptionplan.UpdateDefaultUpdatedAt()
+		spuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spuo *SubscriptionPlanUpdateOne) check() error {
+	if v, ok := spuo.mutation.Name(); ok {
+		if err := subscriptionplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (spuo *SubscriptionPlanUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionPlan, err error) {
+	if err := spuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionplan.Table, subscriptionplan.Columns, sqlgraph.NewFieldSpec(subscriptionplan.FieldID, field.TypeString))
+	id, ok := spuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := spuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionplan.FieldID)
+		for _, f := range fields {
+			if !subscriptionplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := spuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spuo.mutation.Status(); ok {
+		_spec.SetField(subscriptionplan.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := spuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if spuo.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionplan.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := spuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionplan.FieldUpdatedBy, field.TypeString, value)
+	}
+	if spuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionplan.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := spuo.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionplan.FieldMetadata, field.TypeJSON, value)
+	}
+	if spuo.mutation.MetadataCleared() {
+		_spec.ClearField(subscriptionplan.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := spuo.mutation.Name(); ok {
+		_spec.SetField(subscriptionplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := spuo.mutation.Description(); ok {
+		_spec.SetField(subscriptionplan.FieldDescription, field.TypeString, value)
+	}
+	if spuo.mutation.DescriptionCleared() {
+		_spec.ClearField(subscriptionplan.FieldDescription, field.TypeString)
+	}
+	if value, ok := spuo.mutation.CategoryIds(); ok {
+		_spec.SetField(subscriptionplan.FieldCategoryIds, field.TypeJSON, value)
+	}
+	if value, ok := spuo.mutation.AppendedCategoryIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscriptionplan.FieldCategoryIds, value)
+		})
+	}
+	if value, ok := spuo.mutation.GatewayPlanID(); ok {
+		_spec.SetField(subscriptionplan.FieldGatewayPlanID, field.TypeString, value)
+	}
+	if spuo.mutation.GatewayPlanIDCleared() {
+		_spec.ClearField(subscriptionplan.FieldGatewayPlanID, field.TypeString)
+	}
+	if value, ok := spuo.mutation.IsActive(); ok {
+		_spec.SetField(subscriptionplan.FieldIsActive, field.TypeBool, value)
+	}
+	_node = &SubscriptionPlan{config: spuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, spuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	spuo.mutation.done = true
+	return _node, nil
+}
@@ -12,30 +12,78 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Assignment is the client for interacting with the Assignment builders.
+	Assignment *AssignmentClient
 	// Cart is the client for interacting with the Cart builders.
 	Cart *CartClient
 	// CartLineItems is the client for interacting with the CartLineItems builders.
 	CartLineItems *CartLineItemsClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// Certificate is the client for interacting with the Certificate builders.
+	Certificate *CertificateClient
 	// Discount is the client for interacting with the Discount builders.
 	Discount *DiscountClient
 	// FileUpload is the client for interacting with the FileUpload builders.
 	FileUpload *FileUploadClient
 	// Internship is the client for interacting with the Internship builders.
 	Internship *InternshipClient
+	// InternshipApplication is the client for interacting with the InternshipApplication builders.
+	InternshipApplication *InternshipApplicationClient
 	// InternshipBatch is the client for interacting with the InternshipBatch builders.
 	InternshipBatch *InternshipBatchClient
 	// InternshipEnrollment is the client for interacting with the InternshipEnrollment builders.
 	InternshipEnrollment *InternshipEnrollmentClient
+	// InternshipInstructor is the client for interacting with the InternshipInstructor builders.
+	InternshipInstructor *InternshipInstructorClient
+	// InternshipReview is the client for interacting with the InternshipReview builders.
+	InternshipReview *InternshipReviewClient
+	// InternshipRevision is the client for interacting with the InternshipRevision builders.
+	InternshipRevision *InternshipRevisionClient
+	// Lesson is the client for interacting with the Lesson builders.
+	Lesson *LessonClient
+	// LessonProgress is the client for interacting with the LessonProgress builders.
+	LessonProgress *LessonProgressClient
+	// LiveSession is the client for interacting with the LiveSession builders.
+	LiveSession *LiveSessionClient
+	// Module is the client for interacting with the Module builders.
+	Module *ModuleClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
 	// PaymentAttempt is the client for interacting with the PaymentAttempt builders.
 	PaymentAttempt *PaymentAttemptClient
+	// PaymentPlan is the client for interacting with the PaymentPlan builders.
+	PaymentPlan *PaymentPlanClient
+	// PaymentRefund is the client for interacting with the PaymentRefund builders.
+	PaymentRefund *PaymentRefundClient
+	// Quiz is the client for interacting with the Quiz builders.
+	Quiz *QuizClient
+	// QuizAttempt is the client for interacting with the QuizAttempt builders.
+	QuizAttempt *QuizAttemptClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// Resource is the client for interacting with the Resource builders.
+	Resource *ResourceClient
+	// SessionAttendance is the client for interacting with the SessionAttendance builders.
+	SessionAttendance *SessionAttendanceClient
+	// Submission is the client for interacting with the Submission builders.
+	Submission *SubmissionClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// SubscriptionEvent is the client for interacting with the SubscriptionEvent builders.
+	SubscriptionEvent *SubscriptionEventClient
+	// SubscriptionPlan is the client for interacting with the SubscriptionPlan builders.
+	SubscriptionPlan *SubscriptionPlanClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WalletTransaction is the client for interacting with the WalletTransaction builders.
+	WalletTransaction *WalletTransactionClient
+	// Wishlist is the client for interacting with the Wishlist builders.
+	Wishlist *WishlistClient
 
 	// lazily loaded.
 	client     *Client
@@ -167,18 +215,42 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Assignment = NewAssignmentClient(tx.config)
 	tx.Cart = NewCartClient(tx.config)
 	tx.CartLineItems = NewCartLineItemsClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
+	tx.Certificate = NewCertificateClient(tx.config)
 	tx.Discount = NewDiscountClient(tx.config)
 	tx.FileUpload = NewFileUploadClient(tx.config)
 	tx.Internship = NewInternshipClient(tx.config)
+	tx.InternshipApplication = NewInternshipApplicationClient(tx.config)
 	tx.InternshipBatch = NewInternshipBatchClient(tx.config)
 	tx.InternshipEnrollment = NewInternshipEnrollmentClient(tx.config)
+	tx.InternshipInstructor = NewInternshipInstructorClient(tx.config)
+	tx.InternshipReview = NewInternshipReviewClient(tx.config)
+	tx.InternshipRevision = NewInternshipRevisionClient(tx.config)
+	tx.Lesson = NewLessonClient(tx.config)
+	tx.LessonProgress = NewLessonProgressClient(tx.config)
+	tx.LiveSession = NewLiveSessionClient(tx.config)
+	tx.Module = NewModuleClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
 	tx.Order = NewOrderClient(tx.config)
 	tx.Payment = NewPaymentClient(tx.config)
 	tx.PaymentAttempt = NewPaymentAttemptClient(tx.config)
+	tx.PaymentPlan = NewPaymentPlanClient(tx.config)
+	tx.PaymentRefund = NewPaymentRefundClient(tx.config)
+	tx.Quiz = NewQuizClient(tx.config)
+	tx.QuizAttempt = NewQuizAttemptClient(tx.config)
+	tx.Referral = NewReferralClient(tx.config)
+	tx.Resource = NewResourceClient(tx.config)
+	tx.SessionAttendance = NewSessionAttendanceClient(tx.config)
+	tx.Submission = NewSubmissionClient(tx.config)
+	tx.Subscription = NewSubscriptionClient(tx.config)
+	tx.SubscriptionEvent = NewSubscriptionEventClient(tx.config)
+	tx.SubscriptionPlan = NewSubscriptionPlanClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WalletTransaction = NewWalletTransactionClient(tx.config)
+	tx.Wishlist = NewWishlistClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -188,7 +260,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Cart.QueryXXX(), the query will be executed
+// applies a query, for example: Assignment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
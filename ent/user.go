@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/codegeeky/ent/user"
+	"github.com/omkar273/codegeeky/internal/types"
 )
 
 // User is the model entity for the User schema.
@@ -35,6 +37,28 @@ type User struct {
 	PhoneNumber string `json:"phone_number,omitempty"`
 	// Role holds the value of the "role" field.
 	Role string `json:"role,omitempty"`
+	// ReferralCode holds the value of the "referral_code" field.
+	ReferralCode *string `json:"referral_code,omitempty"`
+	// ReferredBy holds the value of the "referred_by" field.
+	ReferredBy *string `json:"referred_by,omitempty"`
+	// Headline holds the value of the "headline" field.
+	Headline string `json:"headline,omitempty"`
+	// Bio holds the value of the "bio" field.
+	Bio string `json:"bio,omitempty"`
+	// Skills of the user, matched against the skills of internships
+	Skills []string `json:"skills,omitempty"`
+	// Education holds the value of the "education" field.
+	Education []types.Education `json:"education,omitempty"`
+	// Portfolio, repository and social profile links
+	Links []types.ProfileLink `json:"links,omitempty"`
+	// ResumeFileID holds the value of the "resume_file_id" field.
+	ResumeFileID *string `json:"resume_file_id,omitempty"`
+	// PhotoFileID holds the value of the "photo_file_id" field.
+	PhotoFileID *string `json:"photo_file_id,omitempty"`
+	// Who can see the profile: public, recruiters, private
+	ProfileVisibility string `json:"profile_visibility,omitempty"`
+	// Locale and categories the user is notified of
+	NotificationPreferences types.NotificationPreferences `json:"notification_preferences,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -64,7 +88,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldStatus, user.FieldCreatedBy, user.FieldUpdatedBy, user.FieldFullName, user.FieldEmail, user.FieldPhoneNumber, user.FieldRole:
+		case user.FieldSkills, user.FieldEducation, user.FieldLinks, user.FieldNotificationPreferences:
+			values[i] = new([]byte)
+		case user.FieldID, user.FieldStatus, user.FieldCreatedBy, user.FieldUpdatedBy, user.FieldFullName, user.FieldEmail, user.FieldPhoneNumber, user.FieldRole, user.FieldReferralCode, user.FieldReferredBy, user.FieldHeadline, user.FieldBio, user.FieldResumeFileID, user.FieldPhotoFileID, user.FieldProfileVisibility:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -143,6 +169,84 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Role = value.String
 			}
+		case user.FieldReferralCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_code", values[i])
+			} else if value.Valid {
+				u.ReferralCode = new(string)
+				*u.ReferralCode = value.String
+			}
+		case user.FieldReferredBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referred_by", values[i])
+			} else if value.Valid {
+				u.ReferredBy = new(string)
+				*u.ReferredBy = value.String
+			}
+		case user.FieldHeadline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field headline", values[i])
+			} else if value.Valid {
+				u.Headline = value.String
+			}
+		case user.FieldBio:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio", values[i])
+			} else if value.Valid {
+				u.Bio = value.String
+			}
+		case user.FieldSkills:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field skills", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Skills); err != nil {
+					return fmt.Errorf("unmarshal field skills: %w", err)
+				}
+			}
+		case user.FieldEducation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field education", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Education); err != nil {
+					return fmt.Errorf("unmarshal field education: %w", err)
+				}
+			}
+		case user.FieldLinks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field links", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Links); err != nil {
+					return fmt.Errorf("unmarshal field links: %w", err)
+				}
+			}
+		case user.FieldResumeFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_file_id", values[i])
+			} else if value.Valid {
+				u.ResumeFileID = new(string)
+				*u.ResumeFileID = value.String
+			}
+		case user.FieldPhotoFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_file_id", values[i])
+			} else if value.Valid {
+				u.PhotoFileID = new(string)
+				*u.PhotoFileID = value.String
+			}
+		case user.FieldProfileVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_visibility", values[i])
+			} else if value.Valid {
+				u.ProfileVisibility = value.String
+			}
+		case user.FieldNotificationPreferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_preferences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.NotificationPreferences); err != nil {
+					return fmt.Errorf("unmarshal field notification_preferences: %w", err)
+				}
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -210,6 +314,47 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("role=")
 	builder.WriteString(u.Role)
+	builder.WriteString(", ")
+	if v := u.ReferralCode; v != nil {
+		builder.WriteString("referral_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.ReferredBy; v != nil {
+		builder.WriteString("referred_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("headline=")
+	builder.WriteString(u.Headline)
+	builder.WriteString(", ")
+	builder.WriteString("bio=")
+	builder.WriteString(u.Bio)
+	builder.WriteString(", ")
+	builder.WriteString("skills=")
+	builder.WriteString(fmt.Sprintf("%v", u.Skills))
+	builder.WriteString(", ")
+	builder.WriteString("education=")
+	builder.WriteString(fmt.Sprintf("%v", u.Education))
+	builder.WriteString(", ")
+	builder.WriteString("links=")
+	builder.WriteString(fmt.Sprintf("%v", u.Links))
+	builder.WriteString(", ")
+	if v := u.ResumeFileID; v != nil {
+		builder.WriteString("resume_file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.PhotoFileID; v != nil {
+		builder.WriteString("photo_file_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("profile_visibility=")
+	builder.WriteString(u.ProfileVisibility)
+	builder.WriteString(", ")
+	builder.WriteString("notification_preferences=")
+	builder.WriteString(fmt.Sprintf("%v", u.NotificationPreferences))
 	builder.WriteByte(')')
 	return builder.String()
 }
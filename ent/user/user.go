@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/omkar273/codegeeky/internal/types"
 )
 
 const (
@@ -32,6 +33,28 @@ const (
 	FieldPhoneNumber = "phone_number"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldReferralCode holds the string denoting the referral_code field in the database.
+	FieldReferralCode = "referral_code"
+	// FieldReferredBy holds the string denoting the referred_by field in the database.
+	FieldReferredBy = "referred_by"
+	// FieldHeadline holds the string denoting the headline field in the database.
+	FieldHeadline = "headline"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldSkills holds the string denoting the skills field in the database.
+	FieldSkills = "skills"
+	// FieldEducation holds the string denoting the education field in the database.
+	FieldEducation = "education"
+	// FieldLinks holds the string denoting the links field in the database.
+	FieldLinks = "links"
+	// FieldResumeFileID holds the string denoting the resume_file_id field in the database.
+	FieldResumeFileID = "resume_file_id"
+	// FieldPhotoFileID holds the string denoting the photo_file_id field in the database.
+	FieldPhotoFileID = "photo_file_id"
+	// FieldProfileVisibility holds the string denoting the profile_visibility field in the database.
+	FieldProfileVisibility = "profile_visibility"
+	// FieldNotificationPreferences holds the string denoting the notification_preferences field in the database.
+	FieldNotificationPreferences = "notification_preferences"
 	// EdgeCarts holds the string denoting the carts edge name in mutations.
 	EdgeCarts = "carts"
 	// Table holds the table name of the user in the database.
@@ -57,6 +80,17 @@ var Columns = []string{
 	FieldEmail,
 	FieldPhoneNumber,
 	FieldRole,
+	FieldReferralCode,
+	FieldReferredBy,
+	FieldHeadline,
+	FieldBio,
+	FieldSkills,
+	FieldEducation,
+	FieldLinks,
+	FieldResumeFileID,
+	FieldPhotoFileID,
+	FieldProfileVisibility,
+	FieldNotificationPreferences,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,6 +118,16 @@ var (
 	EmailValidator func(string) error
 	// RoleValidator is a validator for the "role" field. It is called by the builders before save.
 	RoleValidator func(string) error
+	// DefaultSkills holds the default value on creation for the "skills" field.
+	DefaultSkills []string
+	// DefaultEducation holds the default value on creation for the "education" field.
+	DefaultEducation []types.Education
+	// DefaultLinks holds the default value on creation for the "links" field.
+	DefaultLinks []types.ProfileLink
+	// DefaultProfileVisibility holds the default value on creation for the "profile_visibility" field.
+	DefaultProfileVisibility string
+	// DefaultNotificationPreferences holds the default value on creation for the "notification_preferences" field.
+	DefaultNotificationPreferences types.NotificationPreferences
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -141,6 +185,41 @@ func ByRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRole, opts...).ToFunc()
 }
 
+// ByReferralCode orders the results by the referral_code field.
+func ByReferralCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralCode, opts...).ToFunc()
+}
+
+// ByReferredBy orders the results by the referred_by field.
+func ByReferredBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferredBy, opts...).ToFunc()
+}
+
+// ByHeadline orders the results by the headline field.
+func ByHeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeadline, opts...).ToFunc()
+}
+
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
+// ByResumeFileID orders the results by the resume_file_id field.
+func ByResumeFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeFileID, opts...).ToFunc()
+}
+
+// ByPhotoFileID orders the results by the photo_file_id field.
+func ByPhotoFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhotoFileID, opts...).ToFunc()
+}
+
+// ByProfileVisibility orders the results by the profile_visibility field.
+func ByProfileVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfileVisibility, opts...).ToFunc()
+}
+
 // ByCartsCount orders the results by carts count.
 func ByCartsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
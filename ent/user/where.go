@@ -110,6 +110,41 @@ func Role(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldRole, v))
 }
 
+// ReferralCode applies equality check predicate on the "referral_code" field. It's identical to ReferralCodeEQ.
+func ReferralCode(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReferralCode, v))
+}
+
+// ReferredBy applies equality check predicate on the "referred_by" field. It's identical to ReferredByEQ.
+func ReferredBy(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReferredBy, v))
+}
+
+// Headline applies equality check predicate on the "headline" field. It's identical to HeadlineEQ.
+func Headline(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHeadline, v))
+}
+
+// Bio applies equality check predicate on the "bio" field. It's identical to BioEQ.
+func Bio(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBio, v))
+}
+
+// ResumeFileID applies equality check predicate on the "resume_file_id" field. It's identical to ResumeFileIDEQ.
+func ResumeFileID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldResumeFileID, v))
+}
+
+// PhotoFileID applies equality check predicate on the "photo_file_id" field. It's identical to PhotoFileIDEQ.
+func PhotoFileID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhotoFileID, v))
+}
+
+// ProfileVisibility applies equality check predicate on the "profile_visibility" field. It's identical to ProfileVisibilityEQ.
+func ProfileVisibility(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldProfileVisibility, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStatus, v))
@@ -665,6 +700,561 @@ func RoleContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldRole, v))
 }
 
+// ReferralCodeEQ applies the EQ predicate on the "referral_code" field.
+func ReferralCodeEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReferralCode, v))
+}
+
+// ReferralCodeNEQ applies the NEQ predicate on the "referral_code" field.
+func ReferralCodeNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldReferralCode, v))
+}
+
+// ReferralCodeIn applies the In predicate on the "referral_code" field.
+func ReferralCodeIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldReferralCode, vs...))
+}
+
+// ReferralCodeNotIn applies the NotIn predicate on the "referral_code" field.
+func ReferralCodeNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldReferralCode, vs...))
+}
+
+// ReferralCodeGT applies the GT predicate on the "referral_code" field.
+func ReferralCodeGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldReferralCode, v))
+}
+
+// ReferralCodeGTE applies the GTE predicate on the "referral_code" field.
+func ReferralCodeGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldReferralCode, v))
+}
+
+// ReferralCodeLT applies the LT predicate on the "referral_code" field.
+func ReferralCodeLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldReferralCode, v))
+}
+
+// ReferralCodeLTE applies the LTE predicate on the "referral_code" field.
+func ReferralCodeLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldReferralCode, v))
+}
+
+// ReferralCodeContains applies the Contains predicate on the "referral_code" field.
+func ReferralCodeContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldReferralCode, v))
+}
+
+// ReferralCodeHasPrefix applies the HasPrefix predicate on the "referral_code" field.
+func ReferralCodeHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldReferralCode, v))
+}
+
+// ReferralCodeHasSuffix applies the HasSuffix predicate on the "referral_code" field.
+func ReferralCodeHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldReferralCode, v))
+}
+
+// ReferralCodeIsNil applies the IsNil predicate on the "referral_code" field.
+func ReferralCodeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldReferralCode))
+}
+
+// ReferralCodeNotNil applies the NotNil predicate on the "referral_code" field.
+func ReferralCodeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldReferralCode))
+}
+
+// ReferralCodeEqualFold applies the EqualFold predicate on the "referral_code" field.
+func ReferralCodeEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldReferralCode, v))
+}
+
+// ReferralCodeContainsFold applies the ContainsFold predicate on the "referral_code" field.
+func ReferralCodeContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldReferralCode, v))
+}
+
+// ReferredByEQ applies the EQ predicate on the "referred_by" field.
+func ReferredByEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReferredBy, v))
+}
+
+// ReferredByNEQ applies the NEQ predicate on the "referred_by" field.
+func ReferredByNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldReferredBy, v))
+}
+
+// ReferredByIn applies the In predicate on the "referred_by" field.
+func ReferredByIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldReferredBy, vs...))
+}
+
+// ReferredByNotIn applies the NotIn predicate on the "referred_by" field.
+func ReferredByNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldReferredBy, vs...))
+}
+
+// ReferredByGT applies the GT predicate on the "referred_by" field.
+func ReferredByGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldReferredBy, v))
+}
+
+// ReferredByGTE applies the GTE predicate on the "referred_by" field.
+func ReferredByGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldReferredBy, v))
+}
+
+// ReferredByLT applies the LT predicate on the "referred_by" field.
+func ReferredByLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldReferredBy, v))
+}
+
+// ReferredByLTE applies the LTE predicate on the "referred_by" field.
+func ReferredByLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldReferredBy, v))
+}
+
+// ReferredByContains applies the Contains predicate on the "referred_by" field.
+func ReferredByContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldReferredBy, v))
+}
+
+// ReferredByHasPrefix applies the HasPrefix predicate on the "referred_by" field.
+func ReferredByHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldReferredBy, v))
+}
+
+// ReferredByHasSuffix applies the HasSuffix predicate on the "referred_by" field.
+func ReferredByHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldReferredBy, v))
+}
+
+// ReferredByIsNil applies the IsNil predicate on the "referred_by" field.
+func ReferredByIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldReferredBy))
+}
+
+// ReferredByNotNil applies the NotNil predicate on the "referred_by" field.
+func ReferredByNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldReferredBy))
+}
+
+// ReferredByEqualFold applies the EqualFold predicate on the "referred_by" field.
+func ReferredByEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldReferredBy, v))
+}
+
+// ReferredByContainsFold applies the ContainsFold predicate on the "referred_by" field.
+func ReferredByContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldReferredBy, v))
+}
+
+// HeadlineEQ applies the EQ predicate on the "headline" field.
+func HeadlineEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHeadline, v))
+}
+
+// HeadlineNEQ applies the NEQ predicate on the "headline" field.
+func HeadlineNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHeadline, v))
+}
+
+// HeadlineIn applies the In predicate on the "headline" field.
+func HeadlineIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldHeadline, vs...))
+}
+
+// HeadlineNotIn applies the NotIn predicate on the "headline" field.
+func HeadlineNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldHeadline, vs...))
+}
+
+// HeadlineGT applies the GT predicate on the "headline" field.
+func HeadlineGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldHeadline, v))
+}
+
+// HeadlineGTE applies the GTE predicate on the "headline" field.
+func HeadlineGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldHeadline, v))
+}
+
+// HeadlineLT applies the LT predicate on the "headline" field.
+func HeadlineLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldHeadline, v))
+}
+
+// HeadlineLTE applies the LTE predicate on the "headline" field.
+func HeadlineLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldHeadline, v))
+}
+
+// HeadlineContains applies the Contains predicate on the "headline" field.
+func HeadlineContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldHeadline, v))
+}
+
+// HeadlineHasPrefix applies the HasPrefix predicate on the "headline" field.
+func HeadlineHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldHeadline, v))
+}
+
+// HeadlineHasSuffix applies the HasSuffix predicate on the "headline" field.
+func HeadlineHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldHeadline, v))
+}
+
+// HeadlineIsNil applies the IsNil predicate on the "headline" field.
+func HeadlineIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldHeadline))
+}
+
+// HeadlineNotNil applies the NotNil predicate on the "headline" field.
+func HeadlineNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldHeadline))
+}
+
+// HeadlineEqualFold applies the EqualFold predicate on the "headline" field.
+func HeadlineEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldHeadline, v))
+}
+
+// HeadlineContainsFold applies the ContainsFold predicate on the "headline" field.
+func HeadlineContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldHeadline, v))
+}
+
+// BioEQ applies the EQ predicate on the "bio" field.
+func BioEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBio, v))
+}
+
+// BioNEQ applies the NEQ predicate on the "bio" field.
+func BioNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBio, v))
+}
+
+// BioIn applies the In predicate on the "bio" field.
+func BioIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBio, vs...))
+}
+
+// BioNotIn applies the NotIn predicate on the "bio" field.
+func BioNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBio, vs...))
+}
+
+// BioGT applies the GT predicate on the "bio" field.
+func BioGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBio, v))
+}
+
+// BioGTE applies the GTE predicate on the "bio" field.
+func BioGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBio, v))
+}
+
+// BioLT applies the LT predicate on the "bio" field.
+func BioLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBio, v))
+}
+
+// BioLTE applies the LTE predicate on the "bio" field.
+func BioLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBio, v))
+}
+
+// BioContains applies the Contains predicate on the "bio" field.
+func BioContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldBio, v))
+}
+
+// BioHasPrefix applies the HasPrefix predicate on the "bio" field.
+func BioHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldBio, v))
+}
+
+// BioHasSuffix applies the HasSuffix predicate on the "bio" field.
+func BioHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldBio, v))
+}
+
+// BioIsNil applies the IsNil predicate on the "bio" field.
+func BioIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBio))
+}
+
+// BioNotNil applies the NotNil predicate on the "bio" field.
+func BioNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBio))
+}
+
+// BioEqualFold applies the EqualFold predicate on the "bio" field.
+func BioEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldBio, v))
+}
+
+// BioContainsFold applies the ContainsFold predicate on the "bio" field.
+func BioContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldBio, v))
+}
+
+// SkillsIsNil applies the IsNil predicate on the "skills" field.
+func SkillsIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSkills))
+}
+
+// SkillsNotNil applies the NotNil predicate on the "skills" field.
+func SkillsNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSkills))
+}
+
+// EducationIsNil applies the IsNil predicate on the "education" field.
+func EducationIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEducation))
+}
+
+// EducationNotNil applies the NotNil predicate on the "education" field.
+func EducationNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEducation))
+}
+
+// LinksIsNil applies the IsNil predicate on the "links" field.
+func LinksIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLinks))
+}
+
+// LinksNotNil applies the NotNil predicate on the "links" field.
+func LinksNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLinks))
+}
+
+// ResumeFileIDEQ applies the EQ predicate on the "resume_file_id" field.
+func ResumeFileIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldResumeFileID, v))
+}
+
+// ResumeFileIDNEQ applies the NEQ predicate on the "resume_file_id" field.
+func ResumeFileIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldResumeFileID, v))
+}
+
+// ResumeFileIDIn applies the In predicate on the "resume_file_id" field.
+func ResumeFileIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldResumeFileID, vs...))
+}
+
+// ResumeFileIDNotIn applies the NotIn predicate on the "resume_file_id" field.
+func ResumeFileIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldResumeFileID, vs...))
+}
+
+// ResumeFileIDGT applies the GT predicate on the "resume_file_id" field.
+func ResumeFileIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldResumeFileID, v))
+}
+
+// ResumeFileIDGTE applies the GTE predicate on the "resume_file_id" field.
+func ResumeFileIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldResumeFileID, v))
+}
+
+// ResumeFileIDLT applies the LT predicate on the "resume_file_id" field.
+func ResumeFileIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldResumeFileID, v))
+}
+
+// ResumeFileIDLTE applies the LTE predicate on the "resume_file_id" field.
+func ResumeFileIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldResumeFileID, v))
+}
+
+// ResumeFileIDContains applies the Contains predicate on the "resume_file_id" field.
+func ResumeFileIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldResumeFileID, v))
+}
+
+// ResumeFileIDHasPrefix applies the HasPrefix predicate on the "resume_file_id" field.
+func ResumeFileIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldResumeFileID, v))
+}
+
+// ResumeFileIDHasSuffix applies the HasSuffix predicate on the "resume_file_id" field.
+func ResumeFileIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldResumeFileID, v))
+}
+
+// ResumeFileIDIsNil applies the IsNil predicate on the "resume_file_id" field.
+func ResumeFileIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldResumeFileID))
+}
+
+// ResumeFileIDNotNil applies the NotNil predicate on the "resume_file_id" field.
+func ResumeFileIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldResumeFileID))
+}
+
+// ResumeFileIDEqualFold applies the EqualFold predicate on the "resume_file_id" field.
+func ResumeFileIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldResumeFileID, v))
+}
+
+// ResumeFileIDContainsFold applies the ContainsFold predicate on the "resume_file_id" field.
+func ResumeFileIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldResumeFileID, v))
+}
+
+// PhotoFileIDEQ applies the EQ predicate on the "photo_file_id" field.
+func PhotoFileIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDNEQ applies the NEQ predicate on the "photo_file_id" field.
+func PhotoFileIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDIn applies the In predicate on the "photo_file_id" field.
+func PhotoFileIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPhotoFileID, vs...))
+}
+
+// PhotoFileIDNotIn applies the NotIn predicate on the "photo_file_id" field.
+func PhotoFileIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPhotoFileID, vs...))
+}
+
+// PhotoFileIDGT applies the GT predicate on the "photo_file_id" field.
+func PhotoFileIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDGTE applies the GTE predicate on the "photo_file_id" field.
+func PhotoFileIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDLT applies the LT predicate on the "photo_file_id" field.
+func PhotoFileIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDLTE applies the LTE predicate on the "photo_file_id" field.
+func PhotoFileIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDContains applies the Contains predicate on the "photo_file_id" field.
+func PhotoFileIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDHasPrefix applies the HasPrefix predicate on the "photo_file_id" field.
+func PhotoFileIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDHasSuffix applies the HasSuffix predicate on the "photo_file_id" field.
+func PhotoFileIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDIsNil applies the IsNil predicate on the "photo_file_id" field.
+func PhotoFileIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPhotoFileID))
+}
+
+// PhotoFileIDNotNil applies the NotNil predicate on the "photo_file_id" field.
+func PhotoFileIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPhotoFileID))
+}
+
+// PhotoFileIDEqualFold applies the EqualFold predicate on the "photo_file_id" field.
+func PhotoFileIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPhotoFileID, v))
+}
+
+// PhotoFileIDContainsFold applies the ContainsFold predicate on the "photo_file_id" field.
+func PhotoFileIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPhotoFileID, v))
+}
+
+// ProfileVisibilityEQ applies the EQ predicate on the "profile_visibility" field.
+func ProfileVisibilityEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityNEQ applies the NEQ predicate on the "profile_visibility" field.
+func ProfileVisibilityNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityIn applies the In predicate on the "profile_visibility" field.
+func ProfileVisibilityIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldProfileVisibility, vs...))
+}
+
+// ProfileVisibilityNotIn applies the NotIn predicate on the "profile_visibility" field.
+func ProfileVisibilityNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldProfileVisibility, vs...))
+}
+
+// ProfileVisibilityGT applies the GT predicate on the "profile_visibility" field.
+func ProfileVisibilityGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityGTE applies the GTE predicate on the "profile_visibility" field.
+func ProfileVisibilityGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityLT applies the LT predicate on the "profile_visibility" field.
+func ProfileVisibilityLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityLTE applies the LTE predicate on the "profile_visibility" field.
+func ProfileVisibilityLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityContains applies the Contains predicate on the "profile_visibility" field.
+func ProfileVisibilityContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityHasPrefix applies the HasPrefix predicate on the "profile_visibility" field.
+func ProfileVisibilityHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityHasSuffix applies the HasSuffix predicate on the "profile_visibility" field.
+func ProfileVisibilityHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityEqualFold applies the EqualFold predicate on the "profile_visibility" field.
+func ProfileVisibilityEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldProfileVisibility, v))
+}
+
+// ProfileVisibilityContainsFold applies the ContainsFold predicate on the "profile_visibility" field.
+func ProfileVisibilityContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldProfileVisibility, v))
+}
+
+// NotificationPreferencesIsNil applies the IsNil predicate on the "notification_preferences" field.
+func NotificationPreferencesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldNotificationPreferences))
+}
+
+// NotificationPreferencesNotNil applies the NotNil predicate on the "notification_preferences" field.
+func NotificationPreferencesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldNotificationPreferences))
+}
+
 // HasCarts applies the HasEdge predicate on the "carts" edge.
 func HasCarts() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/codegeeky/ent/cart"
 	"github.com/omkar273/codegeeky/ent/user"
+	"github.com/omkar273/codegeeky/internal/types"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -115,6 +116,136 @@ func (uc *UserCreate) SetRole(s string) *UserCreate {
 	return uc
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (uc *UserCreate) SetReferralCode(s string) *UserCreate {
+	uc.mutation.SetReferralCode(s)
+	return uc
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (uc *UserCreate) SetNillableReferralCode(s *string) *UserCreate {
+	if s != nil {
+		uc.SetReferralCode(*s)
+	}
+	return uc
+}
+
+// SetReferredBy sets the "referred_by" field.
+func (uc *UserCreate) SetReferredBy(s string) *UserCreate {
+	uc.mutation.SetReferredBy(s)
+	return uc
+}
+
+// SetNillableReferredBy sets the "referred_by" field if the given value is not nil.
+func (uc *UserCreate) SetNillableReferredBy(s *string) *UserCreate {
+	if s != nil {
+		uc.SetReferredBy(*s)
+	}
+	return uc
+}
+
+// SetHeadline sets the "headline" field.
+func (uc *UserCreate) SetHeadline(s string) *UserCreate {
+	uc.mutation.SetHeadline(s)
+	return uc
+}
+
+// SetNillableHeadline sets the "headline" field if the given value is not nil.
+func (uc *UserCreate) SetNillableHeadline(s *string) *UserCreate {
+	if s != nil {
+		uc.SetHeadline(*s)
+	}
+	return uc
+}
+
+// SetBio sets the "bio" field.
+func (uc *UserCreate) SetBio(s string) *UserCreate {
+	uc.mutation.SetBio(s)
+	return uc
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBio(s *string) *UserCreate {
+	if s != nil {
+		uc.SetBio(*s)
+	}
+	return uc
+}
+
+// SetSkills sets the "skills" field.
+func (uc *UserCreate) SetSkills(s []string) *UserCreate {
+	uc.mutation.SetSkills(s)
+	return uc
+}
+
+// SetEducation sets the "education" field.
+func (uc *UserCreate) SetEducation(t []types.Education) *UserCreate {
+	uc.mutation.SetEducation(t)
+	return uc
+}
+
+// SetLinks sets the "links" field.
+func (uc *UserCreate) SetLinks(tl []types.ProfileLink) *UserCreate {
+	uc.mutation.SetLinks(tl)
+	return uc
+}
+
+// SetResumeFileID sets the "resume_file_id" field.
+func (uc *UserCreate) SetResumeFileID(s string) *UserCreate {
+	uc.mutation.SetResumeFileID(s)
+	return uc
+}
+
+// SetNillableResumeFileID sets the "resume_file_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableResumeFileID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetResumeFileID(*s)
+	}
+	return uc
+}
+
+// SetPhotoFileID sets the "photo_file_id" field.
+func (uc *UserCreate) SetPhotoFileID(s string) *UserCreate {
+	uc.mutation.SetPhotoFileID(s)
+	return uc
+}
+
+// SetNillablePhotoFileID sets the "photo_file_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhotoFileID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPhotoFileID(*s)
+	}
+	return uc
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (uc *UserCreate) SetProfileVisibility(s string) *UserCreate {
+	uc.mutation.SetProfileVisibility(s)
+	return uc
+}
+
+// SetNillableProfileVisibility sets the "profile_visibility" field if the given value is not nil.
+func (uc *UserCreate) SetNillableProfileVisibility(s *string) *UserCreate {
+	if s != nil {
+		uc.SetProfileVisibility(*s)
+	}
+	return uc
+}
+
+// SetNotificationPreferences sets the "notification_preferences" field.
+func (uc *UserCreate) SetNotificationPreferences(tp types.NotificationPreferences) *UserCreate {
+	uc.mutation.SetNotificationPreferences(tp)
+	return uc
+}
+
+// SetNillableNotificationPreferences sets the "notification_preferences" field if the given value is not nil.
+func (uc *UserCreate) SetNillableNotificationPreferences(tp *types.NotificationPreferences) *UserCreate {
+	if tp != nil {
+		uc.SetNotificationPreferences(*tp)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(s string) *UserCreate {
 	uc.mutation.SetID(s)
@@ -191,6 +322,26 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultUpdatedAt()
 		uc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := uc.mutation.Skills(); !ok {
+		v := user.DefaultSkills
+		uc.mutation.SetSkills(v)
+	}
+	if _, ok := uc.mutation.Education(); !ok {
+		v := user.DefaultEducation
+		uc.mutation.SetEducation(v)
+	}
+	if _, ok := uc.mutation.Links(); !ok {
+		v := user.DefaultLinks
+		uc.mutation.SetLinks(v)
+	}
+	if _, ok := uc.mutation.ProfileVisibility(); !ok {
+		v := user.DefaultProfileVisibility
+		uc.mutation.SetProfileVisibility(v)
+	}
+	if _, ok := uc.mutation.NotificationPreferences(); !ok {
+		v := user.DefaultNotificationPreferences
+		uc.mutation.SetNotificationPreferences(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -235,6 +386,14 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.ProfileVisibility(); !ok {
+		return &ValidationError{Name: "profile_visibility", err: errors.New(`ent: missing required field "User.profile_visibility"`)}
+	}
+	if v, ok := uc.mutation.NotificationPreferences(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "notification_preferences", err: fmt.Errorf(`ent: validator failed for field "User.notification_preferences": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -306,6 +465,50 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 		_node.Role = value
 	}
+	if value, ok := uc.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+		_node.ReferralCode = &value
+	}
+	if value, ok := uc.mutation.ReferredBy(); ok {
+		_spec.SetField(user.FieldReferredBy, field.TypeString, value)
+		_node.ReferredBy = &value
+	}
+	if value, ok := uc.mutation.Headline(); ok {
+		_spec.SetField(user.FieldHeadline, field.TypeString, value)
+		_node.Headline = value
+	}
+	if value, ok := uc.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+		_node.Bio = value
+	}
+	if value, ok := uc.mutation.Skills(); ok {
+		_spec.SetField(user.FieldSkills, field.TypeJSON, value)
+		_node.Skills = value
+	}
+	if value, ok := uc.mutation.Education(); ok {
+		_spec.SetField(user.FieldEducation, field.TypeJSON, value)
+		_node.Education = value
+	}
+	if value, ok := uc.mutation.Links(); ok {
+		_spec.SetField(user.FieldLinks, field.TypeJSON, value)
+		_node.Links = value
+	}
+	if value, ok := uc.mutation.ResumeFileID(); ok {
+		_spec.SetField(user.FieldResumeFileID, field.TypeString, value)
+		_node.ResumeFileID = &value
+	}
+	if value, ok := uc.mutation.PhotoFileID(); ok {
+		_spec.SetField(user.FieldPhotoFileID, field.TypeString, value)
+		_node.PhotoFileID = &value
+	}
+	if value, ok := uc.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeString, value)
+		_node.ProfileVisibility = value
+	}
+	if value, ok := uc.mutation.NotificationPreferences(); ok {
+		_spec.SetField(user.FieldNotificationPreferences, field.TypeJSON, value)
+		_node.NotificationPreferences = value
+	}
 	if nodes := uc.mutation.CartsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
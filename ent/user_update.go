@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/omkar273/codegeeky/ent/cart"
 	"github.com/omkar273/codegeeky/ent/predicate"
 	"github.com/omkar273/codegeeky/ent/user"
+	"github.com/omkar273/codegeeky/internal/types"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -125,6 +127,194 @@ func (uu *UserUpdate) SetNillableRole(s *string) *UserUpdate {
 	return uu
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (uu *UserUpdate) SetReferralCode(s string) *UserUpdate {
+	uu.mutation.SetReferralCode(s)
+	return uu
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableReferralCode(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetReferralCode(*s)
+	}
+	return uu
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (uu *UserUpdate) ClearReferralCode() *UserUpdate {
+	uu.mutation.ClearReferralCode()
+	return uu
+}
+
+// SetHeadline sets the "headline" field.
+func (uu *UserUpdate) SetHeadline(s string) *UserUpdate {
+	uu.mutation.SetHeadline(s)
+	return uu
+}
+
+// SetNillableHeadline sets the "headline" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableHeadline(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetHeadline(*s)
+	}
+	return uu
+}
+
+// ClearHeadline clears the value of the "headline" field.
+func (uu *UserUpdate) ClearHeadline() *UserUpdate {
+	uu.mutation.ClearHeadline()
+	return uu
+}
+
+// SetBio sets the "bio" field.
+func (uu *UserUpdate) SetBio(s string) *UserUpdate {
+	uu.mutation.SetBio(s)
+	return uu
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBio(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetBio(*s)
+	}
+	return uu
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uu *UserUpdate) ClearBio() *UserUpdate {
+	uu.mutation.ClearBio()
+	return uu
+}
+
+// SetSkills sets the "skills" field.
+func (uu *UserUpdate) SetSkills(s []string) *UserUpdate {
+	uu.mutation.SetSkills(s)
+	return uu
+}
+
+// AppendSkills appends s to the "skills" field.
+func (uu *UserUpdate) AppendSkills(s []string) *UserUpdate {
+	uu.mutation.AppendSkills(s)
+	return uu
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (uu *UserUpdate) ClearSkills() *UserUpdate {
+	uu.mutation.ClearSkills()
+	return uu
+}
+
+// SetEducation sets the "education" field.
+func (uu *UserUpdate) SetEducation(t []types.Education) *UserUpdate {
+	uu.mutation.SetEducation(t)
+	return uu
+}
+
+// AppendEducation appends t to the "education" field.
+func (uu *UserUpdate) AppendEducation(t []types.Education) *UserUpdate {
+	uu.mutation.AppendEducation(t)
+	return uu
+}
+
+// ClearEducation clears the value of the "education" field.
+func (uu *UserUpdate) ClearEducation() *UserUpdate {
+	uu.mutation.ClearEducation()
+	return uu
+}
+
+// SetLinks sets the "links" field.
+func (uu *UserUpdate) SetLinks(tl []types.ProfileLink) *UserUpdate {
+	uu.mutation.SetLinks(tl)
+	return uu
+}
+
+// AppendLinks appends tl to the "links" field.
+func (uu *UserUpdate) AppendLinks(tl []types.ProfileLink) *UserUpdate {
+	uu.mutation.AppendLinks(tl)
+	return uu
+}
+
+// ClearLinks clears the value of the "links" field.
+func (uu *UserUpdate) ClearLinks() *UserUpdate {
+	uu.mutation.ClearLinks()
+	return uu
+}
+
+// SetResumeFileID sets the "resume_file_id" field.
+func (uu *UserUpdate) SetResumeFileID(s string) *UserUpdate {
+	uu.mutation.SetResumeFileID(s)
+	return uu
+}
+
+// SetNillableResumeFileID sets the "resume_file_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableResumeFileID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetResumeFileID(*s)
+	}
+	return uu
+}
+
+// ClearResumeFileID clears the value of the "resume_file_id" field.
+func (uu *UserUpdate) ClearResumeFileID() *UserUpdate {
+	uu.mutation.ClearResumeFileID()
+	return uu
+}
+
+// SetPhotoFileID sets the "photo_file_id" field.
+func (uu *UserUpdate) SetPhotoFileID(s string) *UserUpdate {
+	uu.mutation.SetPhotoFileID(s)
+	return uu
+}
+
+// SetNillablePhotoFileID sets the "photo_file_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePhotoFileID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPhotoFileID(*s)
+	}
+	return uu
+}
+
+// ClearPhotoFileID clears the value of the "photo_file_id" field.
+func (uu *UserUpdate) ClearPhotoFileID() *UserUpdate {
+	uu.mutation.ClearPhotoFileID()
+	return uu
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (uu *UserUpdate) SetProfileVisibility(s string) *UserUpdate {
+	uu.mutation.SetProfileVisibility(s)
+	return uu
+}
+
+// SetNillableProfileVisibility sets the "profile_visibility" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableProfileVisibility(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetProfileVisibility(*s)
+	}
+	return uu
+}
+
+// SetNotificationPreferences sets the "notification_preferences" field.
+func (uu *UserUpdate) SetNotificationPreferences(tp types.NotificationPreferences) *UserUpdate {
+	uu.mutation.SetNotificationPreferences(tp)
+	return uu
+}
+
+// SetNillableNotificationPreferences sets the "notification_preferences" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableNotificationPreferences(tp *types.NotificationPreferences) *UserUpdate {
+	if tp != nil {
+		uu.SetNotificationPreferences(*tp)
+	}
+	return uu
+}
+
+// ClearNotificationPreferences clears the value of the "notification_preferences" field.
+func (uu *UserUpdate) ClearNotificationPreferences() *UserUpdate {
+	uu.mutation.ClearNotificationPreferences()
+	return uu
+}
+
 // AddCartIDs adds the "carts" edge to the Cart entity by IDs.
 func (uu *UserUpdate) AddCartIDs(ids ...string) *UserUpdate {
 	uu.mutation.AddCartIDs(ids...)
@@ -219,6 +409,11 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.NotificationPreferences(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "notification_preferences", err: fmt.Errorf(`ent: validator failed for field "User.notification_preferences": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -261,6 +456,81 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+	}
+	if uu.mutation.ReferralCodeCleared() {
+		_spec.ClearField(user.FieldReferralCode, field.TypeString)
+	}
+	if uu.mutation.ReferredByCleared() {
+		_spec.ClearField(user.FieldReferredBy, field.TypeString)
+	}
+	if value, ok := uu.mutation.Headline(); ok {
+		_spec.SetField(user.FieldHeadline, field.TypeString, value)
+	}
+	if uu.mutation.HeadlineCleared() {
+		_spec.ClearField(user.FieldHeadline, field.TypeString)
+	}
+	if value, ok := uu.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uu.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uu.mutation.Skills(); ok {
+		_spec.SetField(user.FieldSkills, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedSkills(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldSkills, value)
+		})
+	}
+	if uu.mutation.SkillsCleared() {
+		_spec.ClearField(user.FieldSkills, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.Education(); ok {
+		_spec.SetField(user.FieldEducation, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedEducation(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldEducation, value)
+		})
+	}
+	if uu.mutation.EducationCleared() {
+		_spec.ClearField(user.FieldEducation, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.Links(); ok {
+		_spec.SetField(user.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldLinks, value)
+		})
+	}
+	if uu.mutation.LinksCleared() {
+		_spec.ClearField(user.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.ResumeFileID(); ok {
+		_spec.SetField(user.FieldResumeFileID, field.TypeString, value)
+	}
+	if uu.mutation.ResumeFileIDCleared() {
+		_spec.ClearField(user.FieldResumeFileID, field.TypeString)
+	}
+	if value, ok := uu.mutation.PhotoFileID(); ok {
+		_spec.SetField(user.FieldPhotoFileID, field.TypeString, value)
+	}
+	if uu.mutation.PhotoFileIDCleared() {
+		_spec.ClearField(user.FieldPhotoFileID, field.TypeString)
+	}
+	if value, ok := uu.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.NotificationPreferences(); ok {
+		_spec.SetField(user.FieldNotificationPreferences, field.TypeJSON, value)
+	}
+	if uu.mutation.NotificationPreferencesCleared() {
+		_spec.ClearField(user.FieldNotificationPreferences, field.TypeJSON)
+	}
 	if uu.mutation.CartsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -422,6 +692,194 @@ func (uuo *UserUpdateOne) SetNillableRole(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (uuo *UserUpdateOne) SetReferralCode(s string) *UserUpdateOne {
+	uuo.mutation.SetReferralCode(s)
+	return uuo
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableReferralCode(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetReferralCode(*s)
+	}
+	return uuo
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (uuo *UserUpdateOne) ClearReferralCode() *UserUpdateOne {
+	uuo.mutation.ClearReferralCode()
+	return uuo
+}
+
+// SetHeadline sets the "headline" field.
+func (uuo *UserUpdateOne) SetHeadline(s string) *UserUpdateOne {
+	uuo.mutation.SetHeadline(s)
+	return uuo
+}
+
+// SetNillableHeadline sets the "headline" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHeadline(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetHeadline(*s)
+	}
+	return uuo
+}
+
+// ClearHeadline clears the value of the "headline" field.
+func (uuo *UserUpdateOne) ClearHeadline() *UserUpdateOne {
+	uuo.mutation.ClearHeadline()
+	return uuo
+}
+
+// SetBio sets the "bio" field.
+func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
+	uuo.mutation.SetBio(s)
+	return uuo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBio(*s)
+	}
+	return uuo
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
+	uuo.mutation.ClearBio()
+	return uuo
+}
+
+// SetSkills sets the "skills" field.
+func (uuo *UserUpdateOne) SetSkills(s []string) *UserUpdateOne {
+	uuo.mutation.SetSkills(s)
+	return uuo
+}
+
+// AppendSkills appends s to the "skills" field.
+func (uuo *UserUpdateOne) AppendSkills(s []string) *UserUpdateOne {
+	uuo.mutation.AppendSkills(s)
+	return uuo
+}
+
+// ClearSkills clears the value of the "skills" field.
+func (uuo *UserUpdateOne) ClearSkills() *UserUpdateOne {
+	uuo.mutation.ClearSkills()
+	return uuo
+}
+
+// SetEducation sets the "education" field.
+func (uuo *UserUpdateOne) SetEducation(t []types.Education) *UserUpdateOne {
+	uuo.mutation.SetEducation(t)
+	return uuo
+}
+
+// AppendEducation appends t to the "education" field.
+func (uuo *UserUpdateOne) AppendEducation(t []types.Education) *UserUpdateOne {
+	uuo.mutation.AppendEducation(t)
+	return uuo
+}
+
+// ClearEducation clears the value of the "education" field.
+func (uuo *UserUpdateOne) ClearEducation() *UserUpdateOne {
+	uuo.mutation.ClearEducation()
+	return uuo
+}
+
+// SetLinks sets the "links" field.
+func (uuo *UserUpdateOne) SetLinks(tl []types.ProfileLink) *UserUpdateOne {
+	uuo.mutation.SetLinks(tl)
+	return uuo
+}
+
+// AppendLinks appends tl to the "links" field.
+func (uuo *UserUpdateOne) AppendLinks(tl []types.ProfileLink) *UserUpdateOne {
+	uuo.mutation.AppendLinks(tl)
+	return uuo
+}
+
+// ClearLinks clears the value of the "links" field.
+func (uuo *UserUpdateOne) ClearLinks() *UserUpdateOne {
+	uuo.mutation.ClearLinks()
+	return uuo
+}
+
+// SetResumeFileID sets the "resume_file_id" field.
+func (uuo *UserUpdateOne) SetResumeFileID(s string) *UserUpdateOne {
+	uuo.mutation.SetResumeFileID(s)
+	return uuo
+}
+
+// SetNillableResumeFileID sets the "resume_file_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableResumeFileID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetResumeFileID(*s)
+	}
+	return uuo
+}
+
+// ClearResumeFileID clears the value of the "resume_file_id" field.
+func (uuo *UserUpdateOne) ClearResumeFileID() *UserUpdateOne {
+	uuo.mutation.ClearResumeFileID()
+	return uuo
+}
+
+// SetPhotoFileID sets the "photo_file_id" field.
+func (uuo *UserUpdateOne) SetPhotoFileID(s string) *UserUpdateOne {
+	uuo.mutation.SetPhotoFileID(s)
+	return uuo
+}
+
+// SetNillablePhotoFileID sets the "photo_file_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhotoFileID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhotoFileID(*s)
+	}
+	return uuo
+}
+
+// ClearPhotoFileID clears the value of the "photo_file_id" field.
+func (uuo *UserUpdateOne) ClearPhotoFileID() *UserUpdateOne {
+	uuo.mutation.ClearPhotoFileID()
+	return uuo
+}
+
+// SetProfileVisibility sets the "profile_visibility" field.
+func (uuo *UserUpdateOne) SetProfileVisibility(s string) *UserUpdateOne {
+	uuo.mutation.SetProfileVisibility(s)
+	return uuo
+}
+
+// SetNillableProfileVisibility sets the "profile_visibility" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableProfileVisibility(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetProfileVisibility(*s)
+	}
+	return uuo
+}
+
+// SetNotificationPreferences sets the "notification_preferences" field.
+func (uuo *UserUpdateOne) SetNotificationPreferences(tp types.NotificationPreferences) *UserUpdateOne {
+	uuo.mutation.SetNotificationPreferences(tp)
+	return uuo
+}
+
+// SetNillableNotificationPreferences sets the "notification_preferences" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableNotificationPreferences(tp *types.NotificationPreferences) *UserUpdateOne {
+	if tp != nil {
+		uuo.SetNotificationPreferences(*tp)
+	}
+	return uuo
+}
+
+// ClearNotificationPreferences clears the value of the "notification_preferences" field.
+func (uuo *UserUpdateOne) ClearNotificationPreferences() *UserUpdateOne {
+	uuo.mutation.ClearNotificationPreferences()
+	return uuo
+}
+
 // AddCartIDs adds the "carts" edge to the Cart entity by IDs.
 func (uuo *UserUpdateOne) AddCartIDs(ids ...string) *UserUpdateOne {
 	uuo.mutation.AddCartIDs(ids...)
@@ -529,6 +987,11 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.NotificationPreferences(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "notification_preferences", err: fmt.Errorf(`ent: validator failed for field "User.notification_preferences": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -588,6 +1051,81 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+	}
+	if uuo.mutation.ReferralCodeCleared() {
+		_spec.ClearField(user.FieldReferralCode, field.TypeString)
+	}
+	if uuo.mutation.ReferredByCleared() {
+		_spec.ClearField(user.FieldReferredBy, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Headline(); ok {
+		_spec.SetField(user.FieldHeadline, field.TypeString, value)
+	}
+	if uuo.mutation.HeadlineCleared() {
+		_spec.ClearField(user.FieldHeadline, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uuo.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Skills(); ok {
+		_spec.SetField(user.FieldSkills, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedSkills(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldSkills, value)
+		})
+	}
+	if uuo.mutation.SkillsCleared() {
+		_spec.ClearField(user.FieldSkills, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.Education(); ok {
+		_spec.SetField(user.FieldEducation, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedEducation(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldEducation, value)
+		})
+	}
+	if uuo.mutation.EducationCleared() {
+		_spec.ClearField(user.FieldEducation, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.Links(); ok {
+		_spec.SetField(user.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldLinks, value)
+		})
+	}
+	if uuo.mutation.LinksCleared() {
+		_spec.ClearField(user.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.ResumeFileID(); ok {
+		_spec.SetField(user.FieldResumeFileID, field.TypeString, value)
+	}
+	if uuo.mutation.ResumeFileIDCleared() {
+		_spec.ClearField(user.FieldResumeFileID, field.TypeString)
+	}
+	if value, ok := uuo.mutation.PhotoFileID(); ok {
+		_spec.SetField(user.FieldPhotoFileID, field.TypeString, value)
+	}
+	if uuo.mutation.PhotoFileIDCleared() {
+		_spec.ClearField(user.FieldPhotoFileID, field.TypeString)
+	}
+	if value, ok := uuo.mutation.ProfileVisibility(); ok {
+		_spec.SetField(user.FieldProfileVisibility, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.NotificationPreferences(); ok {
+		_spec.SetField(user.FieldNotificationPreferences, field.TypeJSON, value)
+	}
+	if uuo.mutation.NotificationPreferencesCleared() {
+		_spec.ClearField(user.FieldNotificationPreferences, field.TypeJSON)
+	}
 	if uuo.mutation.CartsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
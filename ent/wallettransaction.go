@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent/wallettransaction"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransaction is the model entity for the WalletTransaction schema.
+type WalletTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// TransactionType holds the value of the "transaction_type" field.
+	TransactionType types.WalletTransactionType `json:"transaction_type,omitempty"`
+	// TransactionReason holds the value of the "transaction_reason" field.
+	TransactionReason types.WalletTransactionReason `json:"transaction_reason,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// RemainingAmount holds the value of the "remaining_amount" field.
+	RemainingAmount decimal.Decimal `json:"remaining_amount,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// SourceTransactionID holds the value of the "source_transaction_id" field.
+	SourceTransactionID *string `json:"source_transaction_id,omitempty"`
+	// ReferenceID holds the value of the "reference_id" field.
+	ReferenceID *string `json:"reference_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WalletTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wallettransaction.FieldMetadata:
+			values[i] = new([]byte)
+		case wallettransaction.FieldAmount, wallettransaction.FieldRemainingAmount:
+			values[i] = new(decimal.Decimal)
+		case wallettransaction.FieldID, wallettransaction.FieldStatus, wallettransaction.FieldCreatedBy, wallettransaction.FieldUpdatedBy, wallettransaction.FieldUserID, wallettransaction.FieldTransactionType, wallettransaction.FieldTransactionReason, wallettransaction.FieldCurrency, wallettransaction.FieldSourceTransactionID, wallettransaction.FieldReferenceID, wallettransaction.FieldDescription:
+			values[i] = new(sql.NullString)
+		case wallettransaction.FieldCreatedAt, wallettransaction.FieldUpdatedAt, wallettransaction.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WalletTransaction fields.
+func (wt *WalletTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wallettransaction.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				wt.ID = value.String
+			}
+		case wallettransaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wt.Status = value.String
+			}
+		case wallettransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wt.CreatedAt = value.Time
+			}
+		case wallettransaction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wt.UpdatedAt = value.Time
+			}
+		case wallettransaction.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				wt.CreatedBy = value.String
+			}
+		case wallettransaction.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				wt.UpdatedBy = value.String
+			}
+		case wallettransaction.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wt.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case wallettransaction.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				wt.UserID = value.String
+			}
+		case wallettransaction.FieldTransactionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_type", values[i])
+			} else if value.Valid {
+				wt.TransactionType = types.WalletTransactionType(value.String)
+			}
+		case wallettransaction.FieldTransactionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_reason", values[i])
+			} else if value.Valid {
+				wt.TransactionReason = types.WalletTransactionReason(value.String)
+			}
+		case wallettransaction.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				wt.Amount = *value
+			}
+		case wallettransaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				wt.Currency = value.String
+			}
+		case wallettransaction.FieldRemainingAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining_amount", values[i])
+			} else if value != nil {
+				wt.RemainingAmount = *value
+			}
+		case wallettransaction.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				wt.ExpiresAt = new(time.Time)
+				*wt.ExpiresAt = value.Time
+			}
+		case wallettransaction.FieldSourceTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_transaction_id", values[i])
+			} else if value.Valid {
+				wt.SourceTransactionID = new(string)
+				*wt.SourceTransactionID = value.String
+			}
+		case wallettransaction.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				wt.ReferenceID = new(string)
+				*wt.ReferenceID = value.String
+			}
+		case wallettransaction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				wt.Description = value.String
+			}
+		default:
+			wt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WalletTransaction.
+// This includes values selected through modifiers, order, etc.
+func (wt *WalletTransaction) Value(name string) (ent.Value, error) {
+	return wt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WalletTransaction.
+// Note that you need to call WalletTransaction.Unwrap() before calling this method if this WalletTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wt *WalletTransaction) Update() *WalletTransactionUpdateOne {
+	return NewWalletTransactionClient(wt.config).UpdateOne(wt)
+}
+
+// Unwrap unwraps the WalletTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wt *WalletTransaction) Unwrap() *WalletTransaction {
+	_tx, ok := wt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WalletTransaction is not a transactional entity")
+	}
+	wt.config.driver = _tx.drv
+	return wt
+}
+
+// String implements the fmt.Stringer.
+func (wt *WalletTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("WalletTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wt.ID))
+	builder.WriteString("status=")
+	builder.WriteString(wt.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(wt.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(wt.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(wt.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_type=")
+	builder.WriteString(fmt.Sprintf("%v", wt.TransactionType))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_reason=")
+	builder.WriteString(fmt.Sprintf("%v", wt.TransactionReason))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(wt.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("remaining_amount=")
+	builder.WriteString(fmt.Sprintf("%v", wt.RemainingAmount))
+	builder.WriteString(", ")
+	if v := wt.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := wt.SourceTransactionID; v != nil {
+		builder.WriteString("source_transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := wt.ReferenceID; v != nil {
+		builder.WriteString("reference_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(wt.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WalletTransactions is a parsable slice of WalletTransaction.
+type WalletTransactions []*WalletTransaction
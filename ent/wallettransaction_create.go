@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/wallettransaction"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransactionCreate is the builder for creating a WalletTransaction entity.
+type WalletTransactionCreate struct {
+	config
+	mutation *WalletTransactionMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (wtc *WalletTransactionCreate) SetStatus(s string) *WalletTransactionCreate {
+	wtc.mutation.SetStatus(s)
+	return wtc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableStatus(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetStatus(*s)
+	}
+	return wtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wtc *WalletTransactionCreate) SetCreatedAt(t time.Time) *WalletTransactionCreate {
+	wtc.mutation.SetCreatedAt(t)
+	return wtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableCreatedAt(t *time.Time) *WalletTransactionCreate {
+	if t != nil {
+		wtc.SetCreatedAt(*t)
+	}
+	return wtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wtc *WalletTransactionCreate) SetUpdatedAt(t time.Time) *WalletTransactionCreate {
+	wtc.mutation.SetUpdatedAt(t)
+	return wtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableUpdatedAt(t *time.Time) *WalletTransactionCreate {
+	if t != nil {
+		wtc.SetUpdatedAt(*t)
+	}
+	return wtc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wtc *WalletTransactionCreate) SetCreatedBy(s string) *WalletTransactionCreate {
+	wtc.mutation.SetCreatedBy(s)
+	return wtc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableCreatedBy(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetCreatedBy(*s)
+	}
+	return wtc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wtc *WalletTransactionCreate) SetUpdatedBy(s string) *WalletTransactionCreate {
+	wtc.mutation.SetUpdatedBy(s)
+	return wtc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableUpdatedBy(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetUpdatedBy(*s)
+	}
+	return wtc
+}
+
+// SetMetadata sets the "metadata" field.
+func (wtc *WalletTransactionCreate) SetMetadata(m map[string]string) *WalletTransactionCreate {
+	wtc.mutation.SetMetadata(m)
+	return wtc
+}
+
+// SetUserID sets the "user_id" field.
+func (wtc *WalletTransactionCreate) SetUserID(s string) *WalletTransactionCreate {
+	wtc.mutation.SetUserID(s)
+	return wtc
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (wtc *WalletTransactionCreate) SetTransactionType(ttt types.WalletTransactionType) *WalletTransactionCreate {
+	wtc.mutation.SetTransactionType(ttt)
+	return wtc
+}
+
+// SetTransactionReason sets the "transaction_reason" field.
+func (wtc *WalletTransactionCreate) SetTransactionReason(ttr types.WalletTransactionReason) *WalletTransactionCreate {
+	wtc.mutation.SetTransactionReason(ttr)
+	return wtc
+}
+
+// SetAmount sets the "amount" field.
+func (wtc *WalletTransactionCreate) SetAmount(d decimal.Decimal) *WalletTransactionCreate {
+	wtc.mutation.SetAmount(d)
+	return wtc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableAmount(d *decimal.Decimal) *WalletTransactionCreate {
+	if d != nil {
+		wtc.SetAmount(*d)
+	}
+	return wtc
+}
+
+// SetCurrency sets the "currency" field.
+func (wtc *WalletTransactionCreate) SetCurrency(s string) *WalletTransactionCreate {
+	wtc.mutation.SetCurrency(s)
+	return wtc
+}
+
+// SetRemainingAmount sets the "remaining_amount" field.
+func (wtc *WalletTransactionCreate) SetRemainingAmount(d decimal.Decimal) *WalletTransactionCreate {
+	wtc.mutation.SetRemainingAmount(d)
+	return wtc
+}
+
+// SetNillableRemainingAmount sets the "remaining_amount" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableRemainingAmount(d *decimal.Decimal) *WalletTransactionCreate {
+	if d != nil {
+		wtc.SetRemainingAmount(*d)
+	}
+	return wtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wtc *WalletTransactionCreate) SetExpiresAt(t time.Time) *WalletTransactionCreate {
+	wtc.mutation.SetExpiresAt(t)
+	return wtc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableExpiresAt(t *time.Time) *WalletTransactionCreate {
+	if t != nil {
+		wtc.SetExpiresAt(*t)
+	}
+	return wtc
+}
+
+// SetSourceTransactionID sets the "source_transaction_id" field.
+func (wtc *WalletTransactionCreate) SetSourceTransactionID(s string) *WalletTransactionCreate {
+	wtc.mutation.SetSourceTransactionID(s)
+	return wtc
+}
+
+// SetNillableSourceTransactionID sets the "source_transaction_id" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableSourceTransactionID(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetSourceTransactionID(*s)
+	}
+	return wtc
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (wtc *WalletTransactionCreate) SetReferenceID(s string) *WalletTransactionCreate {
+	wtc.mutation.SetReferenceID(s)
+	return wtc
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableReferenceID(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetReferenceID(*s)
+	}
+	return wtc
+}
+
+// SetDescription sets the "description" field.
+func (wtc *WalletTransactionCreate) SetDescription(s string) *WalletTransactionCreate {
+	wtc.mutation.SetDescription(s)
+	return wtc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableDescription(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetDescription(*s)
+	}
+	return wtc
+}
+
+// SetID sets the "id" field.
+func (wtc *WalletTransactionCreate) SetID(s string) *WalletTransactionCreate {
+	wtc.mutation.SetID(s)
+	return wtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableID(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetID(*s)
+	}
+	return wtc
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (wtc *WalletTransactionCreate) Mutation() *WalletTransactionMutation {
+	return wtc.mutation
+}
+
+// Save creates the WalletTransaction in the database.
+func (wtc *WalletTransactionCreate) Save(ctx context.Context) (*WalletTransaction, error) {
+	wtc.defaults()
+	return withHooks(ctx, wtc.sqlSave, wtc.mutation, wtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wtc *WalletTransactionCreate) SaveX(ctx context.Context) *WalletTransaction {
+	v, err := wtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtc *WalletTransactionCreate) Exec(ctx context.Context) error {
+	_, err := wtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtc *WalletTransactionCreate) ExecX(ctx context.Context) {
+	if err := wtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtc *WalletTransactionCreate) defaults() {
+	if _, ok := wtc.mutation.Status(); !ok {
+		v := wallettransaction.DefaultStatus
+		wtc.mutation.SetStatus(v)
+	}
+	if _, ok := wtc.mutation.CreatedAt(); !ok {
+		v := wallettransaction.DefaultCreatedAt()
+		wtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wtc.mutation.UpdatedAt(); !ok {
+		v := wallettransaction.DefaultUpdatedAt()
+		wtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wtc.mutation.Metadata(); !ok {
+		v := wallettransaction.DefaultMetadata
+		wtc.mutation.SetMetadata(v)
+	}
+	if _, ok := wtc.mutation.Amount(); !ok {
+		v := wallettransaction.DefaultAmount
+		wtc.mutation.SetAmount(v)
+	}
+	if _, ok := wtc.mutation.RemainingAmount(); !ok {
+		v := wallettransaction.DefaultRemainingAmount
+		wtc.mutation.SetRemainingAmount(v)
+	}
+	if _, ok := wtc.mutation.ID(); !ok {
+		v := wallettransaction.DefaultID()
+		wtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtc *WalletTransactionCreate) check() error {
+	if _, ok := wtc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WalletTransaction.status"`)}
+	}
+	if _, ok := wtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WalletTransaction.created_at"`)}
+	}
+	if _, ok := wtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WalletTransaction.updated_at"`)}
+	}
+	if _, ok := wtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WalletTransaction.user_id"`)}
+	}
+	if v, ok := wtc.mutation.UserID(); ok {
+		if err := wallettransaction.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.user_id": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.TransactionType(); !ok {
+		return &ValidationError{Name: "transaction_type", err: errors.New(`ent: missing required field "WalletTransaction.transaction_type"`)}
+	}
+	if v, ok := wtc.mutation.TransactionType(); ok {
+		if err := wallettransaction.TransactionTypeValidator(string(v)); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.transaction_type": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.TransactionReason(); !ok {
+		return &ValidationError{Name: "transaction_reason", err: errors.New(`ent: missing required field "WalletTransaction.transaction_reason"`)}
+	}
+	if v, ok := wtc.mutation.TransactionReason(); ok {
+		if err := wallettransaction.TransactionReasonValidator(string(v)); err != nil {
+			return &ValidationError{Name: "transaction_reason", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.transaction_reason": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletTransaction.amount"`)}
+	}
+	if _, ok := wtc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "WalletTransaction.currency"`)}
+	}
+	if v, ok := wtc.mutation.Currency(); ok {
+		if err := wallettransaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.currency": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.RemainingAmount(); !ok {
+		return &ValidationError{Name: "remaining_amount", err: errors.New(`ent: missing required field "WalletTransaction.remaining_amount"`)}
+	}
+	return nil
+}
+
+func (wtc *WalletTransactionCreate) sqlSave(ctx context.Context) (*WalletTransaction, error) {
+	if err := wtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WalletTransaction.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wtc.mutation.id = &_node.ID
+	wtc.mutation.done = true
+	return _node, nil
+}
+
+func (wtc *WalletTransactionCreate) createSpec() (*WalletTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletTransaction{config: wtc.config}
+		_spec = sqlgraph.NewCreateSpec(wallettransaction.Table, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeString))
+	)
+	if id, ok := wtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wtc.mutation.Status(); ok {
+		_spec.SetField(wallettransaction.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wtc.mutation.CreatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wtc.mutation.CreatedBy(); ok {
+		_spec.SetField(wallettransaction.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wtc.mutation.UpdatedBy(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wtc.mutation.Metadata(); ok {
+		_spec.SetField(wallettransaction.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := wtc.mutation.UserID(); ok {
+		_spec.SetField(wallettransaction.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := wtc.mutation.TransactionType(); ok {
+		_spec.SetField(wallettransaction.FieldTransactionType, field.TypeString, value)
+		_node.TransactionType = value
+	}
+	if value, ok := wtc.mutation.TransactionReason(); ok {
+		_spec.SetField(wallettransaction.FieldTransactionReason, field.TypeString, value)
+		_node.TransactionReason = value
+	}
+	if value, ok := wtc.mutation.Amount(); ok {
+		_spec.SetField(wallettransaction.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := wtc.mutation.Currency(); ok {
+		_spec.SetField(wallettransaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := wtc.mutation.RemainingAmount(); ok {
+		_spec.SetField(wallettransaction.FieldRemainingAmount, field.TypeOther, value)
+		_node.RemainingAmount = value
+	}
+	if value, ok := wtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(wallettransaction.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := wtc.mutation.SourceTransactionID(); ok {
+		_spec.SetField(wallettransaction.FieldSourceTransactionID, field.TypeString, value)
+		_node.SourceTransactionID = &value
+	}
+	if value, ok := wtc.mutation.ReferenceID(); ok {
+		_spec.SetField(wallettransaction.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = &value
+	}
+	if value, ok := wtc.mutation.Description(); ok {
+		_spec.SetField(wallettransaction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	return _node, _spec
+}
+
+// WalletTransactionCreateBulk is the builder for creating many WalletTransaction entities in bulk.
+type WalletTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*WalletTransactionCreate
+}
+
+// Save creates the WalletTransaction entities in the database.
+func (wtcb *WalletTransactionCreateBulk) Save(ctx context.Context) ([]*WalletTransaction, error) {
+	if wtcb.err != nil {
+		return nil, wtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wtcb.builders))
+	nodes := make([]*WalletTransaction, len(wtcb.builders))
+	mutators := make([]Mutator, len(wtcb.builders))
+	for i := range wtcb.builders {
+		func(i int, root context.Context) {
+			builder := wtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtcb *WalletTransactionCreateBulk) SaveX(ctx context.Context) []*WalletTransaction {
+	v, err := wtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtcb *WalletTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtcb *WalletTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := wtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
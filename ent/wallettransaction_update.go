@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/wallettransaction"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransactionUpdate is the builder for updating WalletTransaction entities.
+type WalletTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletTransactionMutation
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (wtu *WalletTransactionUpdate) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdate {
+	wtu.mutation.Where(ps...)
+	return wtu
+}
+
+// SetStatus sets the "status" field.
+func (wtu *WalletTransactionUpdate) SetStatus(s string) *WalletTransactionUpdate {
+	wtu.mutation.SetStatus(s)
+	return wtu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableStatus(s *string) *WalletTransactionUpdate {
+	if s != nil {
+		wtu.SetStatus(*s)
+	}
+	return wtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wtu *WalletTransactionUpdate) SetUpdatedAt(t time.Time) *WalletTransactionUpdate {
+	wtu.mutation.SetUpdatedAt(t)
+	return wtu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wtu *WalletTransactionUpdate) SetUpdatedBy(s string) *WalletTransactionUpdate {
+	wtu.mutation.SetUpdatedBy(s)
+	return wtu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableUpdatedBy(s *string) *WalletTransactionUpdate {
+	if s != nil {
+		wtu.SetUpdatedBy(*s)
+	}
+	return wtu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wtu *WalletTransactionUpdate) ClearUpdatedBy() *WalletTransactionUpdate {
+	wtu.mutation.ClearUpdatedBy()
+	return wtu
+}
+
+// SetMetadata sets the "metadata" field.
+func (wtu *WalletTransactionUpdate) SetMetadata(m map[string]string) *WalletTransactionUpdate {
+	wtu.mutation.SetMetadata(m)
+	return wtu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (wtu *WalletTransactionUpdate) ClearMetadata() *WalletTransactionUpdate {
+	wtu.mutation.ClearMetadata()
+	return wtu
+}
+
+// SetRemainingAmount sets the "remaining_amount" field.
+func (wtu *WalletTransactionUpdate) SetRemainingAmount(d decimal.Decimal) *WalletTransactionUpdate {
+	wtu.mutation.SetRemainingAmount(d)
+	return wtu
+}
+
+// SetNillableRemainingAmount sets the "remaining_amount" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableRemainingAmount(d *decimal.Decimal) *WalletTransactionUpdate {
+	if d != nil {
+		wtu.SetRemainingAmount(*d)
+	}
+	return wtu
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (wtu *WalletTransactionUpdate) Mutation() *WalletTransactionMutation {
+	return wtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wtu *WalletTransactionUpdate) Save(ctx context.Context) (int, error) {
+	wtu.defaults()
+	return withHooks(ctx, wtu.sqlSave, wtu.mutation, wtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtu *WalletTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wtu *WalletTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := wtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtu *WalletTransactionUpdate) ExecX(ctx context.Context) {
+	if err := wtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtu *WalletTransactionUpdate) defaults() {
+	if _, ok := wtu.mutation.UpdatedAt(); !ok {
+		v := wallettransaction.UpdateDefaultUpdatedAt()
+		wtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wtu *WalletTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeString))
+	if ps := wtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtu.mutation.Status(); ok {
+		_spec.SetField(wallettransaction.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wtu.mutation.CreatedByCleared() {
+		_spec.ClearField(wallettransaction.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wtu.mutation.UpdatedBy(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wtu.mutation.UpdatedByCleared() {
+		_spec.ClearField(wallettransaction.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := wtu.mutation.Metadata(); ok {
+		_spec.SetField(wallettransaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if wtu.mutation.MetadataCleared() {
+		_spec.ClearField(wallettransaction.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := wtu.mutation.RemainingAmount(); ok {
+		_spec.SetField(wallettransaction.FieldRemainingAmount, field.TypeOther, value)
+	}
+	if wtu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(wallettransaction.FieldExpiresAt, field.TypeTime)
+	}
+	if wtu.mutation.SourceTransactionIDCleared() {
+		_spec.ClearField(wallettransaction.FieldSourceTransactionID, field.TypeString)
+	}
+	if wtu.mutation.ReferenceIDCleared() {
+		_spec.ClearField(wallettransaction.FieldReferenceID, field.TypeString)
+	}
+	if wtu.mutation.DescriptionCleared() {
+		_spec.ClearField(wallettransaction.FieldDescription, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wtu.mutation.done = true
+	return n, nil
+}
+
+// WalletTransactionUpdateOne is the builder for updating a single WalletTransaction entity.
+type WalletTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletTransactionMutation
+}
+
+// SetStatus sets the "status" field.
+func (wtuo *WalletTransactionUpdateOne) SetStatus(s string) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetStatus(s)
+	return wtuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableStatus(s *string) *WalletTransactionUpdateOne {
+	if s != nil {
+		wtuo.SetStatus(*s)
+	}
+	return wtuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wtuo *WalletTransactionUpdateOne) SetUpdatedAt(t time.Time) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetUpdatedAt(t)
+	return wtuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wtuo *WalletTransactionUpdateOne) SetUpdatedBy(s string) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetUpdatedBy(s)
+	return wtuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableUpdatedBy(s *string) *WalletTransactionUpdateOne {
+	if s != nil {
+		wtuo.SetUpdatedBy(*s)
+	}
+	return wtuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wtuo *WalletTransactionUpdateOne) ClearUpdatedBy() *WalletTransactionUpdateOne {
+	wtuo.mutation.ClearUpdatedBy()
+	return wtuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (wtuo *WalletTransactionUpdateOne) SetMetadata(m map[string]string) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetMetadata(m)
+	return wtuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (wtuo *WalletTransactionUpdateOne) ClearMetadata() *WalletTransactionUpdateOne {
+	wtuo.mutation.ClearMetadata()
+	return wtuo
+}
+
+// SetRemainingAmount sets the "remaining_amount" field.
+func (wtuo *WalletTransactionUpdateOne) SetRemainingAmount(d decimal.Decimal) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetRemainingAmount(d)
+	return wtuo
+}
+
+// SetNillableRemainingAmount sets the "remaining_amount" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableRemainingAmount(d *decimal.Decimal) *WalletTransactionUpdateOne {
+	if d != nil {
+		wtuo.SetRemainingAmount(*d)
+	}
+	return wtuo
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (wtuo *WalletTransactionUpdateOne) Mutation() *WalletTransactionMutation {
+	return wtuo.mutation
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (wtuo *WalletTransactionUpdateOne) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdateOne {
+	wtuo.mutation.Where(ps...)
+	return wtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wtuo *WalletTransactionUpdateOne) Select(field string, fields ...string) *WalletTransactionUpdateOne {
+	wtuo.fields = append([]string{field}, fields...)
+	return wtuo
+}
+
+// Save executes the query and returns the updated WalletTransaction entity.
+func (wtuo *WalletTransactionUpdateOne) Save(ctx context.Context) (*WalletTransaction, error) {
+	wtuo.defaults()
+	return withHooks(ctx, wtuo.sqlSave, wtuo.mutation, wtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtuo *WalletTransactionUpdateOne) SaveX(ctx context.Context) *WalletTransaction {
+	node, err := wtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wtuo *WalletTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtuo *WalletTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := wtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtuo *WalletTransactionUpdateOne) defaults() {
+	if _, ok := wtuo.mutation.UpdatedAt(); !ok {
+		v := wallettransaction.UpdateDefaultUpdatedAt()
+		wtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wtuo *WalletTransactionUpdateOne) sqlSave(ctx context.Context) (_node *WalletTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeString))
+	id, ok := wtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallettransaction.FieldID)
+		for _, f := range fields {
+			if !wallettransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallettransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtuo.mutation.Status(); ok {
+		_spec.SetField(wallettransaction.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wtuo.mutation.CreatedByCleared() {
+		_spec.ClearField(wallettransaction.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wtuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wtuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(wallettransaction.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := wtuo.mutation.Metadata(); ok {
+		_spec.SetField(wallettransaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if wtuo.mutation.MetadataCleared() {
+		_spec.ClearField(wallettransaction.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := wtuo.mutation.RemainingAmount(); ok {
+		_spec.SetField(wallettransaction.FieldRemainingAmount, field.TypeOther, value)
+	}
+	if wtuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(wallettransaction.FieldExpiresAt, field.TypeTime)
+	}
+	if wtuo.mutation.SourceTransactionIDCleared() {
+		_spec.ClearField(wallettransaction.FieldSourceTransactionID, field.TypeString)
+	}
+	if wtuo.mutation.ReferenceIDCleared() {
+		_spec.ClearField(wallettransaction.FieldReferenceID, field.TypeString)
+	}
+	if wtuo.mutation.DescriptionCleared() {
+		_spec.ClearField(wallettransaction.FieldDescription, field.TypeString)
+	}
+	_node = &WalletTransaction{config: wtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wtuo.mutation.done = true
+	return _node, nil
+}
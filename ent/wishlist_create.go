@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/wishlist"
+)
+
+// WishlistCreate is the builder for creating a Wishlist entity.
+type WishlistCreate struct {
+	config
+	mutation *WishlistMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (wc *WishlistCreate) SetStatus(s string) *WishlistCreate {
+	wc.mutation.SetStatus(s)
+	return wc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableStatus(s *string) *WishlistCreate {
+	if s != nil {
+		wc.SetStatus(*s)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WishlistCreate) SetCreatedAt(t time.Time) *WishlistCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableCreatedAt(t *time.Time) *WishlistCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wc *WishlistCreate) SetUpdatedAt(t time.Time) *WishlistCreate {
+	wc.mutation.SetUpdatedAt(t)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableUpdatedAt(t *time.Time) *WishlistCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wc *WishlistCreate) SetCreatedBy(s string) *WishlistCreate {
+	wc.mutation.SetCreatedBy(s)
+	return wc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableCreatedBy(s *string) *WishlistCreate {
+	if s != nil {
+		wc.SetCreatedBy(*s)
+	}
+	return wc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wc *WishlistCreate) SetUpdatedBy(s string) *WishlistCreate {
+	wc.mutation.SetUpdatedBy(s)
+	return wc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableUpdatedBy(s *string) *WishlistCreate {
+	if s != nil {
+		wc.SetUpdatedBy(*s)
+	}
+	return wc
+}
+
+// SetUserID sets the "user_id" field.
+func (wc *WishlistCreate) SetUserID(s string) *WishlistCreate {
+	wc.mutation.SetUserID(s)
+	return wc
+}
+
+// SetInternshipID sets the "internship_id" field.
+func (wc *WishlistCreate) SetInternshipID(s string) *WishlistCreate {
+	wc.mutation.SetInternshipID(s)
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WishlistCreate) SetID(s string) *WishlistCreate {
+	wc.mutation.SetID(s)
+	return wc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableID(s *string) *WishlistCreate {
+	if s != nil {
+		wc.SetID(*s)
+	}
+	return wc
+}
+
+// Mutation returns the WishlistMutation object of the builder.
+func (wc *WishlistCreate) Mutation() *WishlistMutation {
+	return wc.mutation
+}
+
+// Save creates the Wishlist in the database.
+func (wc *WishlistCreate) Save(ctx context.Context) (*Wishlist, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WishlistCreate) SaveX(ctx context.Context) *Wishlist {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WishlistCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WishlistCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WishlistCreate) defaults() {
+	if _, ok := wc.mutation.Status(); !ok {
+		v := wishlist.DefaultStatus
+		wc.mutation.SetStatus(v)
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := wishlist.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := wishlist.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wc.mutation.ID(); !ok {
+		v := wishlist.DefaultID()
+		wc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WishlistCreate) check() error {
+	if _, ok := wc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Wishlist.status"`)}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Wishlist.created_at"`)}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Wishlist.updated_at"`)}
+	}
+	if _, ok := wc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Wishlist.user_id"`)}
+	}
+	if v, ok := wc.mutation.UserID(); ok {
+		if err := wishlist.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Wishlist.user_id": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.InternshipID(); !ok {
+		return &ValidationError{Name: "internship_id", err: errors.New(`ent: missing required field "Wishlist.internship_id"`)}
+	}
+	if v, ok := wc.mutation.InternshipID(); ok {
+		if err := wishlist.InternshipIDValidator(v); err != nil {
+			return &ValidationError{Name: "internship_id", err: fmt.Errorf(`ent: validator failed for field "Wishlist.internship_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wc *WishlistCreate) sqlSave(ctx context.Context) (*Wishlist, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Wishlist.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WishlistCreate) createSpec() (*Wishlist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wishlist{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(wishlist.Table, sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeString))
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wc.mutation.Status(); ok {
+		_spec.SetField(wishlist.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(wishlist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlist.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wc.mutation.CreatedBy(); ok {
+		_spec.SetField(wishlist.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wc.mutation.UpdatedBy(); ok {
+		_spec.SetField(wishlist.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wc.mutation.UserID(); ok {
+		_spec.SetField(wishlist.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := wc.mutation.InternshipID(); ok {
+		_spec.SetField(wishlist.FieldInternshipID, field.TypeString, value)
+		_node.InternshipID = value
+	}
+	return _node, _spec
+}
+
+// WishlistCreateBulk is the builder for creating many Wishlist entities in bulk.
+type WishlistCreateBulk struct {
+	config
+	err      error
+	builders []*WishlistCreate
+}
+
+// Save creates the Wishlist entities in the database.
+func (wcb *WishlistCreateBulk) Save(ctx context.Context) ([]*Wishlist, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Wishlist, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WishlistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WishlistCreateBulk) SaveX(ctx context.Context) []*Wishlist {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WishlistCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WishlistCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
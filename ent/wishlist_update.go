@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/wishlist"
+)
+
+// WishlistUpdate is the builder for updating Wishlist entities.
+type WishlistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WishlistMutation
+}
+
+// Where appends a list predicates to the WishlistUpdate builder.
+func (wu *WishlistUpdate) Where(ps ...predicate.Wishlist) *WishlistUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetStatus sets the "status" field.
+func (wu *WishlistUpdate) SetStatus(s string) *WishlistUpdate {
+	wu.mutation.SetStatus(s)
+	return wu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wu *WishlistUpdate) SetNillableStatus(s *string) *WishlistUpdate {
+	if s != nil {
+		wu.SetStatus(*s)
+	}
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WishlistUpdate) SetUpdatedAt(t time.Time) *WishlistUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wu *WishlistUpdate) SetUpdatedBy(s string) *WishlistUpdate {
+	wu.mutation.SetUpdatedBy(s)
+	return wu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wu *WishlistUpdate) SetNillableUpdatedBy(s *string) *WishlistUpdate {
+	if s != nil {
+		wu.SetUpdatedBy(*s)
+	}
+	return wu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wu *WishlistUpdate) ClearUpdatedBy() *WishlistUpdate {
+	wu.mutation.ClearUpdatedBy()
+	return wu
+}
+
+// Mutation returns the WishlistMutation object of the builder.
+func (wu *WishlistUpdate) Mutation() *WishlistMutation {
+	return wu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WishlistUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WishlistUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WishlistUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WishlistUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WishlistUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := wishlist.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wu *WishlistUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wishlist.Table, wishlist.Columns, sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeString))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Status(); ok {
+		_spec.SetField(wishlist.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wu.mutation.CreatedByCleared() {
+		_spec.ClearField(wishlist.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wu.mutation.UpdatedBy(); ok {
+		_spec.SetField(wishlist.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wu.mutation.UpdatedByCleared() {
+		_spec.ClearField(wishlist.FieldUpdatedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WishlistUpdateOne is the builder for updating a single Wishlist entity.
+type WishlistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WishlistMutation
+}
+
+// SetStatus sets the "status" field.
+func (wuo *WishlistUpdateOne) SetStatus(s string) *WishlistUpdateOne {
+	wuo.mutation.SetStatus(s)
+	return wuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wuo *WishlistUpdateOne) SetNillableStatus(s *string) *WishlistUpdateOne {
+	if s != nil {
+		wuo.SetStatus(*s)
+	}
+	return wuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WishlistUpdateOne) SetUpdatedAt(t time.Time) *WishlistUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wuo *WishlistUpdateOne) SetUpdatedBy(s string) *WishlistUpdateOne {
+	wuo.mutation.SetUpdatedBy(s)
+	return wuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wuo *WishlistUpdateOne) SetNillableUpdatedBy(s *string) *WishlistUpdateOne {
+	if s != nil {
+		wuo.SetUpdatedBy(*s)
+	}
+	return wuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wuo *WishlistUpdateOne) ClearUpdatedBy() *WishlistUpdateOne {
+	wuo.mutation.ClearUpdatedBy()
+	return wuo
+}
+
+// Mutation returns the WishlistMutation object of the builder.
+func (wuo *WishlistUpdateOne) Mutation() *WishlistMutation {
+	return wuo.mutation
+}
+
+// Where appends a list predicates to the WishlistUpdate builder.
+func (wuo *WishlistUpdateOne) Where(ps ...predicate.Wishlist) *WishlistUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WishlistUpdateOne) Select(field string, fields ...string) *WishlistUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Wishlist entity.
+func (wuo *WishlistUpdateOne) Save(ctx context.Context) (*Wishlist, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WishlistUpdateOne) SaveX(ctx context.Context) *Wishlist {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WishlistUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WishlistUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WishlistUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := wishlist.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wuo *WishlistUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wishlist.Table, wishlist.Columns, sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeString))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wishlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wishlist.FieldID)
+		for _, f := range fields {
+			if !wishlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wishlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Status(); ok {
+		_spec.SetField(wishlist.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.CreatedByCleared() {
+		_spec.ClearField(wishlist.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(wishlist.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(wishlist.FieldUpdatedBy, field.TypeString)
+	}
+	_node = &Wishlist{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
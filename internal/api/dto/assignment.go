@@ -0,0 +1,148 @@
+package dto
+
+import (
+	"context"
+
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateAssignmentRequest adds an assignment to a module
+type CreateAssignmentRequest struct {
+	Title        string `json:"title" validate:"required,min=3,max=255"`
+	Instructions string `json:"instructions,omitempty"`
+	DueDays      *int   `json:"due_days,omitempty" validate:"omitempty,min=0"`
+	MaxScore     int    `json:"max_score,omitempty" validate:"omitempty,min=1"`
+	SortOrder    int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+
+	PassingScore *int `json:"passing_score,omitempty" validate:"omitempty,min=0"`
+	// Rubric criteria, the max score defaults to their total points when not given
+	Rubric             []types.RubricCriterion `json:"rubric,omitempty" validate:"omitempty,dive"`
+	LatePolicy         types.LatePolicy        `json:"late_policy,omitempty"`
+	LatePenaltyPercent float64                 `json:"late_penalty_percent,omitempty" validate:"omitempty,min=0,max=100"`
+	MaxSubmissions     *int                    `json:"max_submissions,omitempty" validate:"omitempty,min=1"`
+}
+
+func (r *CreateAssignmentRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid assignment request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateAssignmentRequest) ToAssignment(ctx context.Context, module *domainContent.Module) *domainContent.Assignment {
+	maxScore := r.MaxScore
+	if maxScore == 0 {
+		maxScore = types.DefaultAssignmentMaxScore
+		if len(r.Rubric) > 0 {
+			maxScore = lo.SumBy(r.Rubric, func(criterion types.RubricCriterion) int {
+				return criterion.MaxPoints
+			})
+		}
+	}
+
+	latePolicy := r.LatePolicy
+	if latePolicy == "" {
+		latePolicy = types.LatePolicyAccept
+	}
+
+	return &domainContent.Assignment{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ASSIGNMENT),
+		InternshipID:       module.InternshipID,
+		ModuleID:           module.ID,
+		Title:              r.Title,
+		Instructions:       r.Instructions,
+		DueDays:            r.DueDays,
+		MaxScore:           maxScore,
+		SortOrder:          r.SortOrder,
+		PassingScore:       r.PassingScore,
+		Rubric:             r.Rubric,
+		LatePolicy:         latePolicy,
+		LatePenaltyPercent: r.LatePenaltyPercent,
+		MaxSubmissions:     r.MaxSubmissions,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateAssignmentRequest changes an assignment, a negative due_days or passing_score removes
+// it, an empty rubric removes the rubric and a max_submissions of zero allows unlimited submissions
+type UpdateAssignmentRequest struct {
+	ModuleID     *string `json:"module_id,omitempty"`
+	Title        *string `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Instructions *string `json:"instructions,omitempty"`
+	DueDays      *int    `json:"due_days,omitempty"`
+	MaxScore     *int    `json:"max_score,omitempty" validate:"omitempty,min=1"`
+	SortOrder    *int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+
+	PassingScore       *int                     `json:"passing_score,omitempty"`
+	Rubric             *[]types.RubricCriterion `json:"rubric,omitempty" validate:"omitempty,dive"`
+	LatePolicy         *types.LatePolicy        `json:"late_policy,omitempty"`
+	LatePenaltyPercent *float64                 `json:"late_penalty_percent,omitempty" validate:"omitempty,min=0,max=100"`
+	MaxSubmissions     *int                     `json:"max_submissions,omitempty" validate:"omitempty,min=0"`
+}
+
+func (r *UpdateAssignmentRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid assignment update request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ApplyTo applies the requested changes to an assignment
+func (r *UpdateAssignmentRequest) ApplyTo(assignment *domainContent.Assignment) {
+	if r.ModuleID != nil {
+		assignment.ModuleID = lo.FromPtr(r.ModuleID)
+	}
+	if r.Title != nil {
+		assignment.Title = lo.FromPtr(r.Title)
+	}
+	if r.Instructions != nil {
+		assignment.Instructions = lo.FromPtr(r.Instructions)
+	}
+	if r.DueDays != nil {
+		if lo.FromPtr(r.DueDays) < 0 {
+			assignment.DueDays = nil
+		} else {
+			assignment.DueDays = r.DueDays
+		}
+	}
+	if r.MaxScore != nil {
+		assignment.MaxScore = lo.FromPtr(r.MaxScore)
+	}
+	if r.SortOrder != nil {
+		assignment.SortOrder = lo.FromPtr(r.SortOrder)
+	}
+	if r.PassingScore != nil {
+		if lo.FromPtr(r.PassingScore) < 0 {
+			assignment.PassingScore = nil
+		} else {
+			assignment.PassingScore = r.PassingScore
+		}
+	}
+	if r.Rubric != nil {
+		assignment.Rubric = lo.FromPtr(r.Rubric)
+		if len(assignment.Rubric) == 0 {
+			assignment.Rubric = nil
+		}
+	}
+	if r.LatePolicy != nil {
+		assignment.LatePolicy = lo.FromPtr(r.LatePolicy)
+	}
+	if r.LatePenaltyPercent != nil {
+		assignment.LatePenaltyPercent = lo.FromPtr(r.LatePenaltyPercent)
+	}
+	if r.MaxSubmissions != nil {
+		assignment.MaxSubmissions = lo.EmptyableToPtr(lo.FromPtr(r.MaxSubmissions))
+	}
+}
+
+type AssignmentResponse struct {
+	domainContent.Assignment
+}
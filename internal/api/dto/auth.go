@@ -21,6 +21,9 @@ type SignupRequest struct {
 
 	// access token
 	AccessToken string `json:"access_token" validate:"required"`
+
+	// referral code of the user who referred this user
+	ReferralCode string `json:"referral_code,omitempty"`
 }
 
 func (r *SignupRequest) ToUser(ctx context.Context) *user.User {
@@ -52,4 +55,7 @@ func (r *SignupRequest) Validate() error {
 type SignupResponse struct {
 	ID          string `json:"id"`
 	AccessToken string `json:"access_token"`
+
+	// discount code granted for signing up with a referral code
+	ReferralDiscountCode string `json:"referral_discount_code,omitempty"`
 }
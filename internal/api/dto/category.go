@@ -10,9 +10,13 @@ import (
 )
 
 type CreateCategoryRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description" binding:"omitempty"`
-	LookupKey   string `json:"lookup_key" binding:"required"`
+	Name        string  `json:"name" binding:"required"`
+	Description string  `json:"description" binding:"omitempty"`
+	LookupKey   string  `json:"lookup_key" binding:"required"`
+	ParentID    *string `json:"parent_id,omitempty" binding:"omitempty"`
+	// Slug defaults to one derived from the name
+	Slug       string `json:"slug,omitempty" binding:"omitempty"`
+	SortWeight int    `json:"sort_weight,omitempty" binding:"omitempty"`
 }
 
 func (c *CreateCategoryRequest) Validate() error {
@@ -22,16 +26,40 @@ func (c *CreateCategoryRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if c.Slug == "" && types.Slugify(c.Name) == "" {
+		return ierr.NewError("slug is required").
+			WithHint("A slug can't be derived from this name, please provide one").
+			WithReportableDetails(map[string]any{
+				"name": c.Name,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if c.Slug != "" {
+		if err := types.ValidateSlug(c.Slug); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (c *CreateCategoryRequest) ToCategory(ctx context.Context) *domainInternship.Category {
 
+	slug := c.Slug
+	if slug == "" {
+		slug = types.Slugify(c.Name)
+	}
+
+	// Path and depth are set by the service once the parent is known
 	return &domainInternship.Category{
 		ID:          types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CATEGORY),
 		Name:        c.Name,
 		Description: c.Description,
 		LookupKey:   c.LookupKey,
+		ParentID:    c.ParentID,
+		Slug:        slug,
+		SortWeight:  c.SortWeight,
 		BaseModel:   types.GetDefaultBaseModel(ctx),
 	}
 }
@@ -47,6 +75,10 @@ type UpdateCategoryRequest struct {
 	Name        string `json:"name" binding:"omitempty"`
 	Description string `json:"description" binding:"omitempty"`
 	LookupKey   string `json:"lookup_key" binding:"omitempty"`
+	// ParentID moves the category under another one, an empty string moves it to the top level
+	ParentID   *string `json:"parent_id,omitempty" binding:"omitempty"`
+	Slug       string  `json:"slug,omitempty" binding:"omitempty"`
+	SortWeight *int    `json:"sort_weight,omitempty" binding:"omitempty"`
 }
 
 func (c *UpdateCategoryRequest) Validate() error {
@@ -56,5 +88,22 @@ func (c *UpdateCategoryRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if c.Slug != "" {
+		if err := types.ValidateSlug(c.Slug); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// CategoryTreeNode is a category with its child categories
+type CategoryTreeNode struct {
+	domainInternship.Category
+	Children []*CategoryTreeNode `json:"children"`
+}
+
+// CategoryTreeResponse represents the category hierarchy, top level categories first
+type CategoryTreeResponse struct {
+	Items []*CategoryTreeNode `json:"items"`
+}
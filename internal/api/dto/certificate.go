@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	domainCertificate "github.com/omkar273/codegeeky/internal/domain/certificate"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type CertificateResponse struct {
+	domainCertificate.Certificate
+
+	// VerifyURL is the public page anyone can check the certificate on
+	VerifyURL string `json:"verify_url,omitempty"`
+}
+
+type ListCertificateResponse = types.ListResponse[*CertificateResponse]
+
+// CertificateDownloadResponse links to the rendered certificate
+type CertificateDownloadResponse struct {
+	CertificateID string `json:"certificate_id"`
+	URL           string `json:"url"`
+}
+
+// VerifyCertificateResponse is what the public verification shows, it leaves out internal ids
+type VerifyCertificateResponse struct {
+	VerificationID    string                  `json:"verification_id"`
+	Valid             bool                    `json:"valid"`
+	CertificateStatus types.CertificateStatus `json:"certificate_status"`
+	RecipientName     string                  `json:"recipient_name"`
+	InternshipTitle   string                  `json:"internship_title"`
+	IssuerName        string                  `json:"issuer_name"`
+	CompletedAt       time.Time               `json:"completed_at"`
+	IssuedAt          time.Time               `json:"issued_at"`
+	RevokedAt         *time.Time              `json:"revoked_at,omitempty"`
+	RevocationReason  *string                 `json:"revocation_reason,omitempty"`
+}
@@ -0,0 +1,293 @@
+package dto
+
+import (
+	"context"
+
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateModuleRequest adds a module to the content of an internship
+type CreateModuleRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=255"`
+	Description string `json:"description,omitempty"`
+	SortOrder   int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+}
+
+func (r *CreateModuleRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid module request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateModuleRequest) ToModule(ctx context.Context, internshipID string) *domainContent.Module {
+	return &domainContent.Module{
+		ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_MODULE),
+		InternshipID: internshipID,
+		Title:        r.Title,
+		Description:  r.Description,
+		SortOrder:    r.SortOrder,
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateModuleRequest changes a module
+type UpdateModuleRequest struct {
+	Title       *string `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Description *string `json:"description,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+}
+
+func (r *UpdateModuleRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid module update request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ApplyTo applies the requested changes to a module
+func (r *UpdateModuleRequest) ApplyTo(module *domainContent.Module) {
+	if r.Title != nil {
+		module.Title = lo.FromPtr(r.Title)
+	}
+	if r.Description != nil {
+		module.Description = lo.FromPtr(r.Description)
+	}
+	if r.SortOrder != nil {
+		module.SortOrder = lo.FromPtr(r.SortOrder)
+	}
+}
+
+type ModuleResponse struct {
+	domainContent.Module
+}
+
+// CreateLessonRequest adds a lesson to a module
+type CreateLessonRequest struct {
+	Title           string           `json:"title" validate:"required,min=3,max=255"`
+	Description     string           `json:"description,omitempty"`
+	LessonType      types.LessonType `json:"lesson_type" validate:"required"`
+	Content         string           `json:"content,omitempty"`
+	VideoURL        *string          `json:"video_url,omitempty" validate:"omitempty,url"`
+	FileID          *string          `json:"file_id,omitempty"`
+	DurationSeconds int              `json:"duration_seconds,omitempty" validate:"omitempty,min=0"`
+	IsPreview       bool             `json:"is_preview,omitempty"`
+	SortOrder       int              `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+
+	// RequiredProgress locks the lesson until the student completed this percentage of the internship
+	RequiredProgress *float64 `json:"required_progress,omitempty" validate:"omitempty,min=0,max=100"`
+}
+
+func (r *CreateLessonRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid lesson request").
+			Mark(ierr.ErrValidation)
+	}
+	return r.LessonType.Validate()
+}
+
+func (r *CreateLessonRequest) ToLesson(ctx context.Context, module *domainContent.Module) *domainContent.Lesson {
+	return &domainContent.Lesson{
+		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_LESSON),
+		InternshipID:     module.InternshipID,
+		ModuleID:         module.ID,
+		Title:            r.Title,
+		Description:      r.Description,
+		LessonType:       r.LessonType,
+		Content:          r.Content,
+		VideoURL:         r.VideoURL,
+		FileID:           r.FileID,
+		DurationSeconds:  r.DurationSeconds,
+		IsPreview:        r.IsPreview,
+		SortOrder:        r.SortOrder,
+		RequiredProgress: r.RequiredProgress,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateLessonRequest changes a lesson, moving it to another module of the same internship
+// when module_id is given
+type UpdateLessonRequest struct {
+	ModuleID        *string           `json:"module_id,omitempty"`
+	Title           *string           `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Description     *string           `json:"description,omitempty"`
+	LessonType      *types.LessonType `json:"lesson_type,omitempty"`
+	Content         *string           `json:"content,omitempty"`
+	VideoURL        *string           `json:"video_url,omitempty"`
+	FileID          *string           `json:"file_id,omitempty"`
+	DurationSeconds *int              `json:"duration_seconds,omitempty" validate:"omitempty,min=0"`
+	IsPreview       *bool             `json:"is_preview,omitempty"`
+	SortOrder       *int              `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+
+	// RequiredProgress of zero unlocks the lesson for every student again
+	RequiredProgress *float64 `json:"required_progress,omitempty" validate:"omitempty,min=0,max=100"`
+}
+
+func (r *UpdateLessonRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid lesson update request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.LessonType != nil {
+		return r.LessonType.Validate()
+	}
+
+	return nil
+}
+
+// ApplyTo applies the requested changes to a lesson
+func (r *UpdateLessonRequest) ApplyTo(lesson *domainContent.Lesson) {
+	if r.ModuleID != nil {
+		lesson.ModuleID = lo.FromPtr(r.ModuleID)
+	}
+	if r.Title != nil {
+		lesson.Title = lo.FromPtr(r.Title)
+	}
+	if r.Description != nil {
+		lesson.Description = lo.FromPtr(r.Description)
+	}
+	if r.LessonType != nil {
+		lesson.LessonType = lo.FromPtr(r.LessonType)
+	}
+	if r.Content != nil {
+		lesson.Content = lo.FromPtr(r.Content)
+	}
+	if r.VideoURL != nil {
+		lesson.VideoURL = lo.EmptyableToPtr(lo.FromPtr(r.VideoURL))
+	}
+	if r.FileID != nil {
+		lesson.FileID = lo.EmptyableToPtr(lo.FromPtr(r.FileID))
+	}
+	if r.DurationSeconds != nil {
+		lesson.DurationSeconds = lo.FromPtr(r.DurationSeconds)
+	}
+	if r.IsPreview != nil {
+		lesson.IsPreview = lo.FromPtr(r.IsPreview)
+	}
+	if r.SortOrder != nil {
+		lesson.SortOrder = lo.FromPtr(r.SortOrder)
+	}
+	if r.RequiredProgress != nil {
+		lesson.RequiredProgress = lo.EmptyableToPtr(lo.FromPtr(r.RequiredProgress))
+	}
+}
+
+type LessonResponse struct {
+	domainContent.Lesson
+}
+
+// CreateResourceRequest attaches an uploaded file to an internship, optionally to one of its
+// modules or lessons
+type CreateResourceRequest struct {
+	ModuleID    *string `json:"module_id,omitempty"`
+	LessonID    *string `json:"lesson_id,omitempty"`
+	Title       string  `json:"title" validate:"required,min=3,max=255"`
+	Description string  `json:"description,omitempty"`
+	FileID      string  `json:"file_id" validate:"required"`
+	SortOrder   int     `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+}
+
+func (r *CreateResourceRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid resource request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateResourceRequest) ToResource(ctx context.Context, internshipID string) *domainContent.Resource {
+	return &domainContent.Resource{
+		ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_RESOURCE),
+		InternshipID: internshipID,
+		ModuleID:     r.ModuleID,
+		LessonID:     r.LessonID,
+		Title:        r.Title,
+		Description:  r.Description,
+		FileID:       r.FileID,
+		SortOrder:    r.SortOrder,
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateResourceRequest changes a resource, an empty module_id or lesson_id detaches it
+type UpdateResourceRequest struct {
+	ModuleID    *string `json:"module_id,omitempty"`
+	LessonID    *string `json:"lesson_id,omitempty"`
+	Title       *string `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Description *string `json:"description,omitempty"`
+	FileID      *string `json:"file_id,omitempty" validate:"omitempty,min=1"`
+	SortOrder   *int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+}
+
+func (r *UpdateResourceRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid resource update request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ApplyTo applies the requested changes to a resource
+func (r *UpdateResourceRequest) ApplyTo(resource *domainContent.Resource) {
+	if r.ModuleID != nil {
+		resource.ModuleID = lo.EmptyableToPtr(lo.FromPtr(r.ModuleID))
+	}
+	if r.LessonID != nil {
+		resource.LessonID = lo.EmptyableToPtr(lo.FromPtr(r.LessonID))
+	}
+	if r.Title != nil {
+		resource.Title = lo.FromPtr(r.Title)
+	}
+	if r.Description != nil {
+		resource.Description = lo.FromPtr(r.Description)
+	}
+	if r.FileID != nil {
+		resource.FileID = lo.FromPtr(r.FileID)
+	}
+	if r.SortOrder != nil {
+		resource.SortOrder = lo.FromPtr(r.SortOrder)
+	}
+}
+
+type ResourceResponse struct {
+	domainContent.Resource
+}
+
+// ResourceDownloadResponse carries the link a resource is downloaded from
+type ResourceDownloadResponse struct {
+	ResourceID string `json:"resource_id"`
+	FileName   string `json:"file_name"`
+	URL        string `json:"url"`
+}
+
+// ContentModuleResponse is a module of the outline with its lessons, assignments, quizzes and resources
+type ContentModuleResponse struct {
+	domainContent.Module
+	Lessons     []*LessonResponse     `json:"lessons"`
+	Assignments []*AssignmentResponse `json:"assignments"`
+	Quizzes     []*QuizResponse       `json:"quizzes"`
+	Resources   []*ResourceResponse   `json:"resources"`
+}
+
+// ContentOutlineResponse is the curriculum of an internship, lessons other than previews,
+// assignments, quizzes and resources are listed without their content
+type ContentOutlineResponse struct {
+	InternshipID string                   `json:"internship_id"`
+	Modules      []*ContentModuleResponse `json:"modules"`
+
+	// Resources not attached to any module
+	Resources []*ResourceResponse `json:"resources"`
+}
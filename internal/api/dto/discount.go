@@ -2,6 +2,7 @@ package dto
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
@@ -23,6 +24,7 @@ type CreateDiscountRequest struct {
 	IsCombinable  bool               `json:"is_combinable" validate:"omitempty"`
 	MaxUses       *int               `json:"max_uses" validate:"omitempty"`
 	MinOrderValue *decimal.Decimal   `json:"min_order_value" validate:"omitempty"`
+	IsCampaign    bool               `json:"is_campaign" validate:"omitempty"`
 	Metadata      types.Metadata     `json:"metadata" validate:"omitempty"`
 }
 
@@ -87,6 +89,7 @@ func (r *CreateDiscountRequest) ToDiscount(ctx context.Context) *domainDiscount.
 		MaxUses:       r.MaxUses,
 		MinOrderValue: r.MinOrderValue,
 		IsCombinable:  r.IsCombinable,
+		IsCampaign:    r.IsCampaign,
 		Metadata:      r.Metadata,
 		BaseModel:     types.GetDefaultBaseModel(ctx),
 	}
@@ -108,3 +111,76 @@ type DiscountResponse struct {
 }
 
 type ListDiscountResponse = types.ListResponse[*DiscountResponse]
+
+// GenerateDiscountCodesRequest generates single-use codes for a campaign discount
+type GenerateDiscountCodesRequest struct {
+	Count      int    `json:"count" validate:"required,min=1"`
+	Prefix     string `json:"prefix" validate:"omitempty,alphanum,max=16"`
+	Charset    string `json:"charset" validate:"omitempty,alphanum"`
+	CodeLength int    `json:"code_length" validate:"omitempty"`
+}
+
+func (r *GenerateDiscountCodesRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Count > types.DiscountCodesPerRequestMax {
+		return ierr.NewError("count exceeds the maximum allowed").
+			WithHintf("At most %d codes can be generated per request", types.DiscountCodesPerRequestMax).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.CodeLength != 0 && (r.CodeLength < types.DiscountCodeLengthMin || r.CodeLength > types.DiscountCodeLengthMax) {
+		return ierr.NewError("invalid code_length").
+			WithHintf("Code length must be between %d and %d", types.DiscountCodeLengthMin, types.DiscountCodeLengthMax).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Charset != "" && len(r.GetCharset()) < 2 {
+		return ierr.NewError("charset must contain at least two distinct characters").
+			WithHint("Charset must contain at least two distinct characters").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// GetCharset returns the charset to generate codes from. Custom charsets are
+// uppercased like the prefix so every generated code is uppercase alphanumeric.
+func (r *GenerateDiscountCodesRequest) GetCharset() string {
+	if r.Charset == "" {
+		return types.DiscountCodeCharsetDefault
+	}
+	return string(lo.Uniq([]rune(strings.ToUpper(r.Charset))))
+}
+
+// GetCodeLength returns the length of the random part of each code
+func (r *GenerateDiscountCodesRequest) GetCodeLength() int {
+	if r.CodeLength == 0 {
+		return types.DiscountCodeLengthDefault
+	}
+	return r.CodeLength
+}
+
+type GenerateDiscountCodesResponse struct {
+	CampaignID string   `json:"campaign_id"`
+	Count      int      `json:"count"`
+	Codes      []string `json:"codes"`
+}
+
+// DeactivateDiscountCodesRequest deactivates campaign codes, all of them when no codes are given
+type DeactivateDiscountCodesRequest struct {
+	Codes []string `json:"codes" validate:"omitempty"`
+}
+
+type DeactivateDiscountCodesResponse struct {
+	CampaignID  string `json:"campaign_id"`
+	Deactivated int    `json:"deactivated"`
+}
+
+type CampaignStatsResponse struct {
+	CampaignID string `json:"campaign_id"`
+	domainDiscount.CampaignStats
+	RedemptionRate decimal.Decimal `json:"redemption_rate"`
+}
@@ -114,9 +114,10 @@ func (req *CreateInternshipRequest) ToInternship(ctx context.Context) *domainInt
 				ID: id,
 			}
 		}),
-		Subtotal:  req.Price,
-		Total:     total,
-		BaseModel: types.GetDefaultBaseModel(ctx),
+		Subtotal:      req.Price,
+		Total:         total,
+		PublishStatus: types.InternshipPublishStatusDraft,
+		BaseModel:     types.GetDefaultBaseModel(ctx),
 	}
 }
 
@@ -184,4 +185,63 @@ func (i *UpdateInternshipRequest) Validate() error {
 	return nil
 }
 
+// ApplyTo applies the requested changes to the content of an internship
+func (i *UpdateInternshipRequest) ApplyTo(snapshot *types.InternshipSnapshot) {
+	if i.Title != nil {
+		snapshot.Title = lo.FromPtr(i.Title)
+	}
+	if i.Description != nil {
+		snapshot.Description = lo.FromPtr(i.Description)
+	}
+	if i.Level != nil {
+		snapshot.Level = lo.FromPtr(i.Level)
+	}
+	if i.Mode != nil {
+		snapshot.Mode = lo.FromPtr(i.Mode)
+	}
+	if i.DurationInWeeks != nil {
+		snapshot.DurationInWeeks = lo.FromPtr(i.DurationInWeeks)
+	}
+	if i.Currency != nil {
+		snapshot.Currency = lo.FromPtr(i.Currency)
+	}
+	if i.Price != nil {
+		snapshot.Price = lo.FromPtr(i.Price)
+	}
+	if i.FlatDiscount != nil {
+		snapshot.FlatDiscount = i.FlatDiscount
+	}
+	if i.PercentageDiscount != nil {
+		snapshot.PercentageDiscount = i.PercentageDiscount
+	}
+	if i.Skills != nil {
+		snapshot.Skills = i.Skills
+	}
+	if i.LearningOutcomes != nil {
+		snapshot.LearningOutcomes = i.LearningOutcomes
+	}
+	if i.Prerequisites != nil {
+		snapshot.Prerequisites = i.Prerequisites
+	}
+	if i.Benefits != nil {
+		snapshot.Benefits = i.Benefits
+	}
+}
+
 type ListInternshipResponse = types.ListResponse[*InternshipResponse]
+
+// InternshipSearchResponse is a page of search results with the facet counts of the whole search
+type InternshipSearchResponse struct {
+	Items      []*InternshipResponse         `json:"items"`
+	Pagination types.PaginationResponse      `json:"pagination"`
+	Facets     *types.InternshipSearchFacets `json:"facets"`
+}
+
+// RejectInternshipRequest rejects an internship or its pending revision with a comment for the author
+type RejectInternshipRequest struct {
+	Comment string `json:"comment" validate:"required"`
+}
+
+func (r *RejectInternshipRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
@@ -0,0 +1,151 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipapplication"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// UpdateApplicationFormRequest turns screening of an internship on or off and sets what
+// applicants fill in, questions keep their ids so earlier answers still refer to them
+type UpdateApplicationFormRequest struct {
+	ApplicationRequired bool                   `json:"application_required"`
+	ApplicationForm     *types.ApplicationForm `json:"application_form,omitempty"`
+}
+
+func (r *UpdateApplicationFormRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid application form request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.ApplicationForm == nil {
+		return nil
+	}
+
+	ids := lo.FilterMap(r.ApplicationForm.Questions, func(question types.ApplicationQuestion, _ int) (string, bool) {
+		return question.ID, question.ID != ""
+	})
+	if len(lo.Uniq(ids)) != len(ids) {
+		return ierr.NewError("duplicate question ids").
+			WithHint("Each question of the form needs its own id").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, question := range r.ApplicationForm.Questions {
+		if lo.Contains(question.Options, "") || len(lo.Uniq(question.Options)) != len(question.Options) {
+			return ierr.NewError("invalid question options").
+				WithHintf("Options of %q must be distinct and not empty", question.Prompt).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// ApplyTo sets the application settings of the internship
+func (r *UpdateApplicationFormRequest) ApplyTo(internship *domainInternship.Internship) {
+	internship.ApplicationRequired = r.ApplicationRequired
+	internship.ApplicationForm = r.ApplicationForm
+	if internship.ApplicationForm != nil {
+		internship.ApplicationForm.EnsureIDs()
+	}
+}
+
+type ApplicationFormResponse struct {
+	InternshipID        string                 `json:"internship_id"`
+	ApplicationRequired bool                   `json:"application_required"`
+	ApplicationForm     *types.ApplicationForm `json:"application_form,omitempty"`
+}
+
+func NewApplicationFormResponse(internship *domainInternship.Internship) *ApplicationFormResponse {
+	return &ApplicationFormResponse{
+		InternshipID:        internship.ID,
+		ApplicationRequired: internship.ApplicationRequired,
+		ApplicationForm:     internship.ApplicationForm,
+	}
+}
+
+// SubmitApplicationRequest applies to a batch of a selective internship
+type SubmitApplicationRequest struct {
+	InternshipBatchID string                    `json:"internship_batch_id" validate:"required"`
+	Answers           []types.ApplicationAnswer `json:"answers" validate:"omitempty,dive"`
+	ResumeFileID      *string                   `json:"resume_file_id,omitempty"`
+}
+
+func (r *SubmitApplicationRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid application request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *SubmitApplicationRequest) ToApplication(ctx context.Context, batch *domainInternship.InternshipBatch) *internshipapplication.InternshipApplication {
+	answers := r.Answers
+	if answers == nil {
+		answers = []types.ApplicationAnswer{}
+	}
+
+	return &internshipapplication.InternshipApplication{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_APPLICATION),
+		InternshipID:      batch.InternshipID,
+		InternshipBatchID: batch.ID,
+		UserID:            types.GetUserID(ctx),
+		Answers:           answers,
+		ResumeFileID:      lo.EmptyableToPtr(lo.FromPtr(r.ResumeFileID)),
+		ApplicationStatus: types.ApplicationStatusSubmitted,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// ReviewApplicationRequest moves an application on, the note is shared with the applicant
+type ReviewApplicationRequest struct {
+	Note *string `json:"note,omitempty" validate:"omitempty,max=2000"`
+}
+
+func (r *ReviewApplicationRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid application review request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ScheduleInterviewRequest invites an applicant to an interview, scheduling again reschedules it
+type ScheduleInterviewRequest struct {
+	InterviewAt time.Time `json:"interview_at" validate:"required"`
+	Note        *string   `json:"note,omitempty" validate:"omitempty,max=2000"`
+}
+
+func (r *ScheduleInterviewRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid interview request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if !r.InterviewAt.After(time.Now().UTC()) {
+		return ierr.NewError("interview is in the past").
+			WithHint("Interview time must be in the future").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type ApplicationResponse struct {
+	internshipapplication.InternshipApplication
+}
+
+// ListApplicationResponse represents the response for listing internship applications
+type ListApplicationResponse = types.ListResponse[*ApplicationResponse]
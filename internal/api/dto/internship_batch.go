@@ -113,3 +113,39 @@ func (b *UpdateInternshipBatchRequest) Validate() error {
 }
 
 type ListInternshipBatchResponse = types.ListResponse[*InternshipBatchResponse]
+
+// CancelInternshipBatchRequest cancels a batch and refunds all of its enrollments.
+// Sending it for a cancelled batch retries the refunds that failed.
+type CancelInternshipBatchRequest struct {
+	Reason string `json:"reason" validate:"required"`
+
+	// Where the gateway amount of each refunded payment goes, defaults to the original payment method
+	RefundDestination types.RefundDestination `json:"refund_destination,omitempty" validate:"omitempty"`
+}
+
+func (r *CancelInternshipBatchRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.RefundDestination != "" {
+		if err := r.RefundDestination.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CancelInternshipBatchResponse is the cancelled batch and the outcome of its bulk refund
+type CancelInternshipBatchResponse struct {
+	InternshipBatchResponse
+
+	RefundedEnrollments int `json:"refunded_enrollments"`
+
+	// Enrollments whose refund failed and has to be retried by cancelling the batch again
+	FailedEnrollmentIDs []string `json:"failed_enrollment_ids,omitempty"`
+
+	// Payments of the failed enrollments that could not be refunded
+	FailedPaymentIDs []string `json:"failed_payment_ids,omitempty"`
+}
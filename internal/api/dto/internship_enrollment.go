@@ -1,14 +1,18 @@
 package dto
 
 import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/shopspring/decimal"
 )
 
 // InitializeEnrollmentRequest is the request for initializing an internship enrollment
 type InitializeEnrollmentRequest struct {
 	InternshipBatchID string            `json:"internship_batch_id" validate:"required"`
 	CouponCodes       []string          `json:"coupon_codes,omitempty"`
+	UseWalletCredit   bool              `json:"use_wallet_credit,omitempty"`
+	PaymentPlanID     *string           `json:"payment_plan_id,omitempty" validate:"omitempty"`
 	SuccessURL        string            `json:"success_url" validate:"required,url"`
 	CancelURL         string            `json:"cancel_url" validate:"required,url"`
 	Metadata          map[string]string `json:"metadata,omitempty" validate:"omitempty"`
@@ -19,6 +23,12 @@ func (r *InitializeEnrollmentRequest) Validate() error {
 		return err
 	}
 
+	if r.PaymentPlanID != nil && r.UseWalletCredit {
+		return ierr.NewError("wallet credit cannot be combined with a payment plan").
+			WithHint("Wallet credit can't be used when paying in installments").
+			Mark(ierr.ErrValidation)
+	}
+
 	return nil
 }
 
@@ -26,4 +36,14 @@ type InitializeEnrollmentResponse struct {
 	EnrollmentID     string                           `json:"enrollment_id"`
 	EnrollmentStatus types.InternshipEnrollmentStatus `json:"enrollment_status"`
 	PaymentRequired  bool                             `json:"payment_required"`
+
+	// Split of the total between wallet credit and the payment gateway
+	Total        decimal.Decimal `json:"total"`
+	WalletAmount decimal.Decimal `json:"wallet_amount"`
+	AmountDue    decimal.Decimal `json:"amount_due"`
+	Currency     string          `json:"currency,omitempty"`
+
+	// Installment schedule when the enrollment is paid with a payment plan,
+	// the first installment is the payment to collect now
+	Installments []*InstallmentResponse `json:"installments,omitempty"`
 }
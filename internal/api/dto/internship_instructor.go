@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"context"
+
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+)
+
+// AddInternshipInstructorRequest assigns an instructor to an internship
+type AddInternshipInstructorRequest struct {
+	UserID string                         `json:"user_id" validate:"required"`
+	Role   types.InternshipInstructorRole `json:"role" validate:"required"`
+}
+
+func (r *AddInternshipInstructorRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid internship instructor request").
+			Mark(ierr.ErrValidation)
+	}
+
+	return r.Role.Validate()
+}
+
+func (r *AddInternshipInstructorRequest) ToInternshipInstructor(ctx context.Context, internshipID string) *domainInternship.InternshipInstructor {
+	return &domainInternship.InternshipInstructor{
+		ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_INSTRUCTOR),
+		InternshipID: internshipID,
+		UserID:       r.UserID,
+		Role:         r.Role,
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateInternshipInstructorRequest changes the role of an instructor
+type UpdateInternshipInstructorRequest struct {
+	Role types.InternshipInstructorRole `json:"role" validate:"required"`
+}
+
+func (r *UpdateInternshipInstructorRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid internship instructor request").
+			Mark(ierr.ErrValidation)
+	}
+
+	return r.Role.Validate()
+}
+
+type InternshipInstructorResponse struct {
+	domainInternship.InternshipInstructor
+}
+
+type ListInternshipInstructorResponse = types.ListResponse[*InternshipInstructorResponse]
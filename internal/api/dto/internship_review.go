@@ -0,0 +1,131 @@
+package dto
+
+import (
+	"context"
+	"strings"
+
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateReviewRequest rates and reviews an internship the student completed
+type CreateReviewRequest struct {
+	Rating int     `json:"rating" validate:"required,min=1,max=5"`
+	Title  *string `json:"title,omitempty" validate:"omitempty,max=255"`
+	Body   string  `json:"body,omitempty" validate:"omitempty,max=5000"`
+}
+
+func (r *CreateReviewRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid review request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateReviewRequest) ToReview(ctx context.Context, enrollment *internshipenrollment.InternshipEnrollment) *internshipreview.InternshipReview {
+	return &internshipreview.InternshipReview{
+		ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_REVIEW),
+		InternshipID: enrollment.InternshipID,
+		UserID:       enrollment.UserID,
+		EnrollmentID: enrollment.ID,
+		Rating:       r.Rating,
+		Title:        lo.EmptyableToPtr(strings.TrimSpace(lo.FromPtr(r.Title))),
+		Body:         strings.TrimSpace(r.Body),
+		ReviewStatus: types.ReviewStatusPublished,
+		Reports:      []types.ReviewReport{},
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateReviewRequest changes a review, fields left out keep their value
+type UpdateReviewRequest struct {
+	Rating *int    `json:"rating,omitempty" validate:"omitempty,min=1,max=5"`
+	Title  *string `json:"title,omitempty" validate:"omitempty,max=255"`
+	Body   *string `json:"body,omitempty" validate:"omitempty,max=5000"`
+}
+
+func (r *UpdateReviewRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid review request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ApplyTo sets the changed fields of the review, an empty title removes it
+func (r *UpdateReviewRequest) ApplyTo(review *internshipreview.InternshipReview) {
+	if r.Rating != nil {
+		review.Rating = *r.Rating
+	}
+	if r.Title != nil {
+		review.Title = lo.EmptyableToPtr(strings.TrimSpace(*r.Title))
+	}
+	if r.Body != nil {
+		review.Body = strings.TrimSpace(*r.Body)
+	}
+}
+
+// ReplyReviewRequest answers a review on behalf of the internship, replying again replaces the reply
+type ReplyReviewRequest struct {
+	Reply string `json:"reply" validate:"required,max=5000"`
+}
+
+func (r *ReplyReviewRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid reply request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ReportReviewRequest flags a review for moderators
+type ReportReviewRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+func (r *ReportReviewRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid report request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ModerateReviewRequest hides or restores a review, the note is shared with its author
+type ModerateReviewRequest struct {
+	Note *string `json:"note,omitempty" validate:"omitempty,max=2000"`
+}
+
+func (r *ModerateReviewRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid moderation request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+type ReviewResponse struct {
+	internshipreview.InternshipReview
+}
+
+// NewReviewResponse builds the response for the caller, only moderators see who reported a review
+func NewReviewResponse(review *internshipreview.InternshipReview, moderator bool) *ReviewResponse {
+	response := &ReviewResponse{InternshipReview: *review}
+	if !moderator {
+		response.Reports = nil
+	}
+	return response
+}
+
+// ListReviewResponse represents the response for listing internship reviews
+type ListReviewResponse = types.ListResponse[*ReviewResponse]
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+)
+
+type InternshipRevisionResponse struct {
+	domainInternship.InternshipRevision
+}
+
+type ListInternshipRevisionResponse = types.ListResponse[*InternshipRevisionResponse]
+
+// DiffInternshipRevisionsRequest selects the two revisions to compare
+type DiffInternshipRevisionsRequest struct {
+	From string `form:"from" json:"from" validate:"required"`
+	To   string `form:"to" json:"to" validate:"required"`
+}
+
+func (r *DiffInternshipRevisionsRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// InternshipRevisionDiffResponse lists the fields that changed between two revisions
+type InternshipRevisionDiffResponse struct {
+	InternshipID   string                        `json:"internship_id"`
+	FromRevisionID string                        `json:"from_revision_id"`
+	FromRevision   int                           `json:"from_revision"`
+	ToRevisionID   string                        `json:"to_revision_id"`
+	ToRevision     int                           `json:"to_revision"`
+	Changes        []types.InternshipFieldChange `json:"changes"`
+}
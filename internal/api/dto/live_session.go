@@ -0,0 +1,175 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateLiveSessionRequest schedules a live session for a batch, a recording is either an
+// uploaded file or a link to one hosted elsewhere
+type CreateLiveSessionRequest struct {
+	Title           string    `json:"title" validate:"required,min=3,max=255"`
+	Description     string    `json:"description,omitempty"`
+	StartsAt        time.Time `json:"starts_at" validate:"required"`
+	EndsAt          time.Time `json:"ends_at" validate:"required"`
+	MeetingURL      string    `json:"meeting_url,omitempty" validate:"omitempty,url"`
+	RecordingFileID *string   `json:"recording_file_id,omitempty"`
+	RecordingURL    *string   `json:"recording_url,omitempty" validate:"omitempty,url"`
+}
+
+func (r *CreateLiveSessionRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid live session request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateLiveSessionRequest) ToLiveSession(ctx context.Context, batch *domainInternship.InternshipBatch) *livesession.LiveSession {
+	return &livesession.LiveSession{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_LIVE_SESSION),
+		InternshipID:      batch.InternshipID,
+		InternshipBatchID: batch.ID,
+		Title:             r.Title,
+		Description:       r.Description,
+		StartsAt:          r.StartsAt.UTC(),
+		EndsAt:            r.EndsAt.UTC(),
+		MeetingURL:        r.MeetingURL,
+		RecordingFileID:   lo.EmptyableToPtr(lo.FromPtr(r.RecordingFileID)),
+		RecordingURL:      lo.EmptyableToPtr(lo.FromPtr(r.RecordingURL)),
+		SessionStatus:     types.LiveSessionStatusScheduled,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateLiveSessionRequest changes a live session, an empty recording_file_id or recording_url
+// removes the recording
+type UpdateLiveSessionRequest struct {
+	Title           *string    `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Description     *string    `json:"description,omitempty"`
+	StartsAt        *time.Time `json:"starts_at,omitempty"`
+	EndsAt          *time.Time `json:"ends_at,omitempty"`
+	MeetingURL      *string    `json:"meeting_url,omitempty" validate:"omitempty,url"`
+	RecordingFileID *string    `json:"recording_file_id,omitempty"`
+	RecordingURL    *string    `json:"recording_url,omitempty" validate:"omitempty,url"`
+}
+
+func (r *UpdateLiveSessionRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid live session update request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ApplyTo applies the requested changes to a live session
+func (r *UpdateLiveSessionRequest) ApplyTo(session *livesession.LiveSession) {
+	if r.Title != nil {
+		session.Title = lo.FromPtr(r.Title)
+	}
+	if r.Description != nil {
+		session.Description = lo.FromPtr(r.Description)
+	}
+	if r.StartsAt != nil {
+		session.StartsAt = r.StartsAt.UTC()
+	}
+	if r.EndsAt != nil {
+		session.EndsAt = r.EndsAt.UTC()
+	}
+	if r.MeetingURL != nil {
+		session.MeetingURL = lo.FromPtr(r.MeetingURL)
+	}
+	if r.RecordingFileID != nil {
+		session.RecordingFileID = lo.EmptyableToPtr(lo.FromPtr(r.RecordingFileID))
+	}
+	if r.RecordingURL != nil {
+		session.RecordingURL = lo.EmptyableToPtr(lo.FromPtr(r.RecordingURL))
+	}
+}
+
+type LiveSessionResponse struct {
+	livesession.LiveSession
+}
+
+type ListLiveSessionResponse = types.ListResponse[*LiveSessionResponse]
+
+// LiveSessionRecordingResponse is where the recording of a live session can be watched
+type LiveSessionRecordingResponse struct {
+	LiveSessionID string `json:"live_session_id"`
+	URL           string `json:"url"`
+}
+
+// JoinLiveSessionResponse is the meeting a student joins with the attendance recorded for them
+type JoinLiveSessionResponse struct {
+	LiveSessionID string                 `json:"live_session_id"`
+	MeetingURL    string                 `json:"meeting_url"`
+	Attendance    livesession.Attendance `json:"attendance"`
+}
+
+type AttendanceResponse struct {
+	livesession.Attendance
+}
+
+type ListAttendanceResponse = types.ListResponse[*AttendanceResponse]
+
+// AttendanceMark is the attendance an instructor gives the student of an enrollment
+type AttendanceMark struct {
+	EnrollmentID     string                 `json:"enrollment_id" validate:"required"`
+	AttendanceStatus types.AttendanceStatus `json:"attendance_status" validate:"required"`
+}
+
+// MarkAttendanceRequest records the attendance of students of the batch of a session,
+// marks replace what was recorded from join events
+type MarkAttendanceRequest struct {
+	Marks []AttendanceMark `json:"marks" validate:"required,min=1,dive"`
+}
+
+func (r *MarkAttendanceRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid attendance request").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, mark := range r.Marks {
+		if err := mark.AttendanceStatus.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(lo.UniqBy(r.Marks, func(mark AttendanceMark) string { return mark.EnrollmentID })) != len(r.Marks) {
+		return ierr.NewError("enrollment marked more than once").
+			WithHint("Mark every enrollment at most once").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// EnrollmentAttendanceResponse sums up the attendance of an enrollment over the sessions its
+// batch held so far, excused sessions don't count towards the percentage
+type EnrollmentAttendanceResponse struct {
+	EnrollmentID      string  `json:"enrollment_id"`
+	UserID            string  `json:"user_id"`
+	InternshipBatchID string  `json:"internship_batch_id"`
+	HeldSessions      int     `json:"held_sessions"`
+	AttendedSessions  int     `json:"attended_sessions"`
+	ExcusedSessions   int     `json:"excused_sessions"`
+	AttendancePercent float64 `json:"attendance_percent"`
+}
+
+type ListEnrollmentAttendanceResponse = types.ListResponse[*EnrollmentAttendanceResponse]
+
+// CalendarFeedResponse is the private feed of upcoming live sessions calendar apps subscribe to
+type CalendarFeedResponse struct {
+	URL string `json:"url"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"github.com/omkar273/codegeeky/internal/domain/notification"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+)
+
+type NotificationPreferencesResponse struct {
+	types.NotificationPreferences
+
+	// Categories users can mute
+	Categories []types.NotificationCategory `json:"categories"`
+}
+
+func NewNotificationPreferencesResponse(preferences types.NotificationPreferences) *NotificationPreferencesResponse {
+	if preferences.MutedCategories == nil {
+		preferences.MutedCategories = []types.NotificationCategory{}
+	}
+	preferences.Locale = preferences.GetLocale()
+
+	return &NotificationPreferencesResponse{
+		NotificationPreferences: preferences,
+		Categories:              types.NotificationCategories,
+	}
+}
+
+// UpdateNotificationPreferencesRequest changes how the current user is notified, fields left
+// out stay as they are and muted_categories replaces the muted categories
+type UpdateNotificationPreferencesRequest struct {
+	Locale          *string                      `json:"locale,omitempty" validate:"omitempty,bcp47_language_tag"`
+	DisableEmail    *bool                        `json:"disable_email,omitempty"`
+	MutedCategories []types.NotificationCategory `json:"muted_categories,omitempty"`
+}
+
+func (r *UpdateNotificationPreferencesRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid notification preferences").
+			Mark(ierr.ErrValidation)
+	}
+
+	return validator.ValidateEnums(r.MutedCategories, types.NotificationCategories, "muted_categories")
+}
+
+func (r *UpdateNotificationPreferencesRequest) ApplyTo(preferences *types.NotificationPreferences) {
+	if r.Locale != nil {
+		preferences.Locale = *r.Locale
+	}
+	if r.DisableEmail != nil {
+		preferences.DisableEmail = *r.DisableEmail
+	}
+	if r.MutedCategories != nil {
+		preferences.MutedCategories = r.MutedCategories
+	}
+}
+
+type NotificationResponse struct {
+	notification.Notification
+}
+
+// ListNotificationResponse is a page of the notifications of a user, newest first
+type ListNotificationResponse struct {
+	types.ListResponse[*NotificationResponse]
+
+	// Notifications of the user not read yet, regardless of the filter
+	UnreadCount int `json:"unread_count"`
+}
+
+type MarkAllNotificationsReadResponse struct {
+	// How many notifications were marked read
+	Updated int `json:"updated"`
+}
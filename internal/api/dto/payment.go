@@ -8,6 +8,7 @@ import (
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/idempotency"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
 	"github.com/shopspring/decimal"
 )
 
@@ -273,3 +274,34 @@ type WebhookResult struct {
 	Headers   map[string]string
 	Raw       map[string]interface{}
 }
+
+// RefundPaymentRequest refunds a successful payment
+type RefundPaymentRequest struct {
+	Destination types.RefundDestination `json:"destination" validate:"required"`
+	Reason      string                  `json:"reason,omitempty" validate:"omitempty"`
+}
+
+func (r *RefundPaymentRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	return r.Destination.Validate()
+}
+
+// GatewayRefundRequest refunds a captured payment to its source at a payment gateway
+type GatewayRefundRequest struct {
+	// IdempotencyKey identifies the refund at the gateway, a refund already made under the
+	// same key is returned instead of refunding again
+	IdempotencyKey   string
+	GatewayPaymentID string
+	Amount           decimal.Decimal
+	Currency         types.Currency
+	Notes            map[string]string
+}
+
+// GatewayRefundResponse is the refund created at the payment gateway
+type GatewayRefundResponse struct {
+	GatewayRefundID string
+	Status          string
+}
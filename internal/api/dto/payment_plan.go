@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	domainPaymentPlan "github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreatePaymentPlanRequest creates an installment plan for an internship
+type CreatePaymentPlanRequest struct {
+	InternshipID    string                         `json:"internship_id" validate:"required"`
+	Name            string                         `json:"name" validate:"required"`
+	Description     string                         `json:"description,omitempty" validate:"omitempty"`
+	Installments    []types.PaymentPlanInstallment `json:"installments" validate:"required"`
+	GracePeriodDays int                            `json:"grace_period_days,omitempty" validate:"omitempty,min=0"`
+	IsActive        *bool                          `json:"is_active,omitempty" validate:"omitempty"`
+	Metadata        types.Metadata                 `json:"metadata,omitempty" validate:"omitempty"`
+}
+
+func (r *CreatePaymentPlanRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	return types.ValidatePaymentPlanInstallments(r.Installments)
+}
+
+func (r *CreatePaymentPlanRequest) ToPaymentPlan(ctx context.Context) *domainPaymentPlan.PaymentPlan {
+	metadata := r.Metadata
+	if metadata == nil {
+		metadata = types.Metadata{}
+	}
+
+	return &domainPaymentPlan.PaymentPlan{
+		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT_PLAN),
+		InternshipID:    r.InternshipID,
+		Name:            r.Name,
+		Description:     r.Description,
+		Installments:    r.Installments,
+		GracePeriodDays: r.GracePeriodDays,
+		IsActive:        lo.FromPtrOr(r.IsActive, true),
+		Metadata:        metadata,
+		BaseModel:       types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdatePaymentPlanRequest updates a payment plan. Installments can't change
+// once a plan exists since enrollments may already be scheduled against it.
+type UpdatePaymentPlanRequest struct {
+	Name            *string         `json:"name,omitempty" validate:"omitempty"`
+	Description     *string         `json:"description,omitempty" validate:"omitempty"`
+	GracePeriodDays *int            `json:"grace_period_days,omitempty" validate:"omitempty,min=0"`
+	IsActive        *bool           `json:"is_active,omitempty" validate:"omitempty"`
+	Metadata        *types.Metadata `json:"metadata,omitempty" validate:"omitempty"`
+}
+
+func (r *UpdatePaymentPlanRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Payment plan name cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type PaymentPlanResponse struct {
+	domainPaymentPlan.PaymentPlan
+}
+
+type ListPaymentPlanResponse = types.ListResponse[*PaymentPlanResponse]
+
+// InstallmentResponse is one scheduled installment of an enrollment
+type InstallmentResponse struct {
+	PaymentID         string              `json:"payment_id"`
+	InstallmentNumber int                 `json:"installment_number"`
+	Amount            decimal.Decimal     `json:"amount"`
+	Currency          string              `json:"currency"`
+	DueDate           time.Time           `json:"due_date"`
+	PaymentStatus     types.PaymentStatus `json:"payment_status"`
+	PaidAt            *time.Time          `json:"paid_at,omitempty"`
+	IsOverdue         bool                `json:"is_overdue"`
+}
+
+// EnrollmentInstallmentsResponse is the installment schedule of an enrollment
+type EnrollmentInstallmentsResponse struct {
+	EnrollmentID     string                           `json:"enrollment_id"`
+	PaymentPlanID    string                           `json:"payment_plan_id"`
+	EnrollmentStatus types.InternshipEnrollmentStatus `json:"enrollment_status"`
+	Installments     []*InstallmentResponse           `json:"installments"`
+
+	// Whether the student can access content, false once the enrollment was suspended for an overdue installment
+	ContentAccess bool `json:"content_access"`
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"time"
+
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// UpdateLessonProgressRequest records where a student is in a lesson and whether they finished it
+type UpdateLessonProgressRequest struct {
+	PositionSeconds *int  `json:"position_seconds,omitempty" validate:"omitempty,min=0"`
+	Completed       *bool `json:"completed,omitempty"`
+}
+
+func (r *UpdateLessonProgressRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid lesson progress request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.PositionSeconds == nil && r.Completed == nil {
+		return ierr.NewError("nothing to update").
+			WithHint("Provide position_seconds or completed").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ApplyTo applies the reported progress, completing a lesson again keeps the first completion time
+func (r *UpdateLessonProgressRequest) ApplyTo(progress *domainContent.LessonProgress) {
+	if r.PositionSeconds != nil {
+		progress.PositionSeconds = lo.FromPtr(r.PositionSeconds)
+	}
+	if r.Completed != nil {
+		if !lo.FromPtr(r.Completed) {
+			progress.CompletedAt = nil
+		} else if progress.CompletedAt == nil {
+			progress.CompletedAt = lo.ToPtr(time.Now().UTC())
+		}
+	}
+}
+
+type LessonProgressResponse struct {
+	domainContent.LessonProgress
+}
+
+// ResumePosition is where a student picks the internship up again
+type ResumePosition struct {
+	LessonID        string `json:"lesson_id"`
+	PositionSeconds int    `json:"position_seconds"`
+}
+
+// InternshipProgressResponse is the progress of the current student through an internship
+type InternshipProgressResponse struct {
+	InternshipID     string                    `json:"internship_id"`
+	TotalLessons     int                       `json:"total_lessons"`
+	CompletedLessons int                       `json:"completed_lessons"`
+	TotalQuizzes     int                       `json:"total_quizzes"`
+	PassedQuizzes    int                       `json:"passed_quizzes"`
+	ProgressPercent  float64                   `json:"progress_percent"`
+	Resume           *ResumePosition           `json:"resume,omitempty"`
+	Lessons          []*LessonProgressResponse `json:"lessons"`
+}
+
+// EnrollmentProgressResponse is the progress made within an enrollment
+type EnrollmentProgressResponse struct {
+	EnrollmentID      string                           `json:"enrollment_id"`
+	UserID            string                           `json:"user_id"`
+	InternshipID      string                           `json:"internship_id"`
+	InternshipBatchID string                           `json:"internship_batch_id"`
+	EnrollmentStatus  types.InternshipEnrollmentStatus `json:"enrollment_status"`
+	TotalLessons      int                              `json:"total_lessons"`
+	CompletedLessons  int                              `json:"completed_lessons"`
+	TotalQuizzes      int                              `json:"total_quizzes"`
+	PassedQuizzes     int                              `json:"passed_quizzes"`
+	ProgressPercent   float64                          `json:"progress_percent"`
+	LastActivityAt    *time.Time                       `json:"last_activity_at,omitempty"`
+}
+
+type ListEnrollmentProgressResponse = types.ListResponse[*EnrollmentProgressResponse]
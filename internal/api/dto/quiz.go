@@ -0,0 +1,242 @@
+package dto
+
+import (
+	"context"
+
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateQuizRequest adds a quiz to a module
+type CreateQuizRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=255"`
+	Description string `json:"description,omitempty"`
+	SortOrder   int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+
+	// Questions is the pool attempts draw from, questions are worth one point unless set
+	Questions           []types.QuizQuestion `json:"questions" validate:"required,min=1,dive"`
+	QuestionsPerAttempt *int                 `json:"questions_per_attempt,omitempty" validate:"omitempty,min=1"`
+	// ShuffleQuestions defaults to true
+	ShuffleQuestions *bool `json:"shuffle_questions,omitempty"`
+	TimeLimitMinutes *int  `json:"time_limit_minutes,omitempty" validate:"omitempty,min=1"`
+	MaxAttempts      *int  `json:"max_attempts,omitempty" validate:"omitempty,min=1"`
+	PassingPercent   *int  `json:"passing_percent,omitempty" validate:"omitempty,min=0,max=100"`
+}
+
+func (r *CreateQuizRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid quiz request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateQuizRequest) ToQuiz(ctx context.Context, module *domainContent.Module) *domainContent.Quiz {
+	return &domainContent.Quiz{
+		ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUIZ),
+		InternshipID:        module.InternshipID,
+		ModuleID:            module.ID,
+		Title:               r.Title,
+		Description:         r.Description,
+		Questions:           normalizeQuizQuestions(r.Questions),
+		QuestionsPerAttempt: r.QuestionsPerAttempt,
+		ShuffleQuestions:    lo.FromPtrOr(r.ShuffleQuestions, true),
+		TimeLimitMinutes:    r.TimeLimitMinutes,
+		MaxAttempts:         r.MaxAttempts,
+		PassingPercent:      r.PassingPercent,
+		SortOrder:           r.SortOrder,
+		BaseModel:           types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateQuizRequest changes a quiz, questions replace the whole pool. A questions_per_attempt,
+// time_limit_minutes or max_attempts of zero removes the limit and a negative passing_percent removes it
+type UpdateQuizRequest struct {
+	ModuleID    *string `json:"module_id,omitempty"`
+	Title       *string `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Description *string `json:"description,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty" validate:"omitempty,min=0"`
+
+	Questions           *[]types.QuizQuestion `json:"questions,omitempty" validate:"omitempty,min=1,dive"`
+	QuestionsPerAttempt *int                  `json:"questions_per_attempt,omitempty" validate:"omitempty,min=0"`
+	ShuffleQuestions    *bool                 `json:"shuffle_questions,omitempty"`
+	TimeLimitMinutes    *int                  `json:"time_limit_minutes,omitempty" validate:"omitempty,min=0"`
+	MaxAttempts         *int                  `json:"max_attempts,omitempty" validate:"omitempty,min=0"`
+	PassingPercent      *int                  `json:"passing_percent,omitempty" validate:"omitempty,max=100"`
+}
+
+func (r *UpdateQuizRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid quiz update request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ApplyTo applies the requested changes to a quiz
+func (r *UpdateQuizRequest) ApplyTo(quiz *domainContent.Quiz) {
+	if r.ModuleID != nil {
+		quiz.ModuleID = lo.FromPtr(r.ModuleID)
+	}
+	if r.Title != nil {
+		quiz.Title = lo.FromPtr(r.Title)
+	}
+	if r.Description != nil {
+		quiz.Description = lo.FromPtr(r.Description)
+	}
+	if r.SortOrder != nil {
+		quiz.SortOrder = lo.FromPtr(r.SortOrder)
+	}
+	if r.Questions != nil {
+		quiz.Questions = normalizeQuizQuestions(lo.FromPtr(r.Questions))
+	}
+	if r.QuestionsPerAttempt != nil {
+		quiz.QuestionsPerAttempt = lo.EmptyableToPtr(lo.FromPtr(r.QuestionsPerAttempt))
+	}
+	if r.ShuffleQuestions != nil {
+		quiz.ShuffleQuestions = lo.FromPtr(r.ShuffleQuestions)
+	}
+	if r.TimeLimitMinutes != nil {
+		quiz.TimeLimitMinutes = lo.EmptyableToPtr(lo.FromPtr(r.TimeLimitMinutes))
+	}
+	if r.MaxAttempts != nil {
+		quiz.MaxAttempts = lo.EmptyableToPtr(lo.FromPtr(r.MaxAttempts))
+	}
+	if r.PassingPercent != nil {
+		if lo.FromPtr(r.PassingPercent) < 0 {
+			quiz.PassingPercent = nil
+		} else {
+			quiz.PassingPercent = r.PassingPercent
+		}
+	}
+}
+
+// normalizeQuizQuestions gives new questions and options ids and fills in the defaults
+func normalizeQuizQuestions(questions []types.QuizQuestion) []types.QuizQuestion {
+	for i := range questions {
+		questions[i].EnsureIDs()
+		if questions[i].Points == 0 {
+			questions[i].Points = 1
+		}
+		if questions[i].QuestionType == types.QuestionTypeShortAnswer && questions[i].AnswerMatch == "" {
+			questions[i].AnswerMatch = types.AnswerMatchExact
+		}
+	}
+	return questions
+}
+
+type QuizResponse struct {
+	domainContent.Quiz
+
+	// QuestionCount is how many questions an attempt has
+	QuestionCount int `json:"question_count"`
+}
+
+// NewQuizResponse builds the response for a quiz, the question pool is only kept when
+// showAnswers is set
+func NewQuizResponse(quiz *domainContent.Quiz, showAnswers bool) *QuizResponse {
+	response := &QuizResponse{
+		Quiz:          *quiz,
+		QuestionCount: quiz.AttemptQuestionCount(),
+	}
+	if !showAnswers {
+		response.HideContent()
+	}
+	return response
+}
+
+// SubmitQuizAttemptRequest hands in the answers of an attempt, unanswered questions score nothing
+type SubmitQuizAttemptRequest struct {
+	Answers []types.QuizAnswer `json:"answers" validate:"omitempty,dive"`
+}
+
+func (r *SubmitQuizAttemptRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid quiz answers").
+			Mark(ierr.ErrValidation)
+	}
+
+	if len(lo.UniqBy(r.Answers, func(answer types.QuizAnswer) string { return answer.QuestionID })) != len(r.Answers) {
+		return ierr.NewError("question answered more than once").
+			WithHint("Answer every question at most once").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// AttemptOption is an option as shown in an attempt, Correct is only set once the attempt is over
+type AttemptOption struct {
+	ID      string `json:"id"`
+	Text    string `json:"text"`
+	Correct *bool  `json:"correct,omitempty"`
+}
+
+// AttemptQuestion is a question as shown in an attempt, the answer is only included once the attempt is over
+type AttemptQuestion struct {
+	ID              string             `json:"id"`
+	QuestionType    types.QuestionType `json:"question_type"`
+	Prompt          string             `json:"prompt"`
+	Points          float64            `json:"points"`
+	Options         []AttemptOption    `json:"options,omitempty"`
+	AcceptedAnswers []string           `json:"accepted_answers,omitempty"`
+	Explanation     string             `json:"explanation,omitempty"`
+}
+
+type QuizAttemptResponse struct {
+	domainContent.QuizAttempt
+
+	// Questions of the attempt in the order they are shown
+	Questions []*AttemptQuestion `json:"questions"`
+}
+
+// NewQuizAttemptResponse builds the response for an attempt, answers to the questions are only
+// revealed when reveal is set and the attempt is no longer open
+func NewQuizAttemptResponse(quiz *domainContent.Quiz, attempt *domainContent.QuizAttempt, reveal bool) *QuizAttemptResponse {
+	reveal = reveal && !attempt.IsOpen()
+	questions := lo.SliceToMap(quiz.Questions, func(question types.QuizQuestion) (string, types.QuizQuestion) {
+		return question.ID, question
+	})
+
+	response := &QuizAttemptResponse{
+		QuizAttempt: *attempt,
+		Questions:   make([]*AttemptQuestion, 0, len(attempt.QuestionIDs)),
+	}
+
+	for _, id := range attempt.QuestionIDs {
+		question, ok := questions[id]
+		if !ok {
+			continue
+		}
+
+		shown := &AttemptQuestion{
+			ID:           question.ID,
+			QuestionType: question.QuestionType,
+			Prompt:       question.Prompt,
+			Points:       question.Points,
+			Options: lo.Map(question.Options, func(option types.QuizOption, _ int) AttemptOption {
+				shownOption := AttemptOption{ID: option.ID, Text: option.Text}
+				if reveal {
+					shownOption.Correct = lo.ToPtr(option.Correct)
+				}
+				return shownOption
+			}),
+		}
+		if reveal {
+			shown.AcceptedAnswers = question.AcceptedAnswers
+			shown.Explanation = question.Explanation
+		}
+
+		response.Questions = append(response.Questions, shown)
+	}
+
+	return response
+}
+
+type ListQuizAttemptResponse = types.ListResponse[*QuizAttemptResponse]
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// RecommendationResponse is a recommended internship with why it was recommended
+type RecommendationResponse struct {
+	Internship  *InternshipResponse          `json:"internship"`
+	Score       float64                      `json:"score"`
+	Enrollments int                          `json:"enrollments"`
+	Reasons     []types.RecommendationReason `json:"reasons"`
+}
+
+func NewRecommendationResponse(recommendation *domainInternship.Recommendation) *RecommendationResponse {
+	return &RecommendationResponse{
+		Internship:  &InternshipResponse{Internship: *recommendation.Internship},
+		Score:       recommendation.Score,
+		Enrollments: recommendation.Enrollments,
+		Reasons:     recommendation.Reasons,
+	}
+}
+
+// ListRecommendationResponse holds recommendations best first
+type ListRecommendationResponse struct {
+	Items []*RecommendationResponse `json:"items"`
+}
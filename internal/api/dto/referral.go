@@ -0,0 +1,27 @@
+package dto
+
+import (
+	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
+	domainReferral "github.com/omkar273/codegeeky/internal/domain/referral"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type ReferralResponse struct {
+	domainReferral.Referral
+
+	// RefereeDiscount is set when the referral is applied at signup
+	RefereeDiscount *domainDiscount.Discount `json:"referee_discount,omitempty"`
+}
+
+type ListReferralResponse = types.ListResponse[*ReferralResponse]
+
+// ReferralSummaryResponse is the current user's referral code along with how their referrals are doing
+type ReferralSummaryResponse struct {
+	ReferralCode string `json:"referral_code"`
+	Total        int    `json:"total"`
+	Pending      int    `json:"pending"`
+	Qualified    int    `json:"qualified"`
+	Rewarded     int    `json:"rewarded"`
+	Rejected     int    `json:"rejected"`
+	Cancelled    int    `json:"cancelled"`
+}
@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+)
+
+// CreateSubmissionRequest hands in work for an assignment as an uploaded file,
+// a GitHub repository or text
+type CreateSubmissionRequest struct {
+	SubmissionType types.SubmissionType `json:"submission_type" validate:"required"`
+	FileID         *string              `json:"file_id,omitempty"`
+	RepositoryURL  *string              `json:"repository_url,omitempty" validate:"omitempty,url"`
+	Text           string               `json:"text,omitempty"`
+}
+
+func (r *CreateSubmissionRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid submission request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := r.SubmissionType.Validate(); err != nil {
+		return err
+	}
+
+	switch r.SubmissionType {
+	case types.SubmissionTypeFile:
+		if r.FileID == nil || *r.FileID == "" {
+			return ierr.NewError("file_id is required").
+				WithHint("Upload the file first and submit its file_id").
+				Mark(ierr.ErrValidation)
+		}
+	case types.SubmissionTypeRepository:
+		if r.RepositoryURL == nil || !isGitHubRepository(*r.RepositoryURL) {
+			return ierr.NewError("repository_url is not a GitHub repository").
+				WithHint("Submit the link of a GitHub repository, like https://github.com/owner/repository").
+				Mark(ierr.ErrValidation)
+		}
+	case types.SubmissionTypeText:
+		if strings.TrimSpace(r.Text) == "" {
+			return ierr.NewError("text is required").
+				WithHint("Text submissions can't be empty").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// isGitHubRepository reports whether the link points at a repository on GitHub
+func isGitHubRepository(link string) bool {
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Scheme != "https" {
+		return false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Host), "www.")
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	return host == "github.com" && len(parts) >= 2 && parts[0] != "" && parts[1] != ""
+}
+
+func (r *CreateSubmissionRequest) ToSubmission(ctx context.Context, assignment *domainContent.Assignment, enrollmentID string) *domainContent.Submission {
+	submission := &domainContent.Submission{
+		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBMISSION),
+		InternshipID:     assignment.InternshipID,
+		AssignmentID:     assignment.ID,
+		EnrollmentID:     enrollmentID,
+		UserID:           types.GetUserID(ctx),
+		SubmissionType:   r.SubmissionType,
+		SubmittedAt:      time.Now().UTC(),
+		SubmissionStatus: types.SubmissionStatusSubmitted,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+
+	// only the content of the chosen type is kept
+	switch r.SubmissionType {
+	case types.SubmissionTypeFile:
+		submission.FileID = r.FileID
+	case types.SubmissionTypeRepository:
+		submission.RepositoryURL = r.RepositoryURL
+	case types.SubmissionTypeText:
+		submission.Text = r.Text
+	}
+
+	return submission
+}
+
+// GradeSubmissionRequest grades a submission, assignments with a rubric are graded per
+// criterion and their score is the total of the criteria
+type GradeSubmissionRequest struct {
+	Score        *float64               `json:"score,omitempty" validate:"omitempty,min=0"`
+	RubricScores []types.CriterionScore `json:"rubric_scores,omitempty" validate:"omitempty,dive"`
+	Feedback     string                 `json:"feedback,omitempty"`
+}
+
+func (r *GradeSubmissionRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid grade request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Score == nil && len(r.RubricScores) == 0 {
+		return ierr.NewError("score is required").
+			WithHint("Provide a score or the rubric scores").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type SubmissionResponse struct {
+	domainContent.Submission
+}
+
+type ListSubmissionResponse = types.ListResponse[*SubmissionResponse]
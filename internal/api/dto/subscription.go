@@ -0,0 +1,159 @@
+package dto
+
+import (
+	"context"
+
+	domainSubscription "github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreateSubscriptionPlanRequest creates a recurring plan for a set of categories
+type CreateSubscriptionPlanRequest struct {
+	Name            string                        `json:"name" validate:"required"`
+	Description     string                        `json:"description,omitempty" validate:"omitempty"`
+	BillingPeriod   types.BillingPeriod           `json:"billing_period" validate:"required"`
+	Amount          decimal.Decimal               `json:"amount" validate:"required"`
+	Currency        types.Currency                `json:"currency" validate:"required"`
+	CategoryIDs     []string                      `json:"category_ids" validate:"required,min=1,dive,required"`
+	GatewayProvider *types.PaymentGatewayProvider `json:"gateway_provider,omitempty" validate:"omitempty"`
+	IsActive        *bool                         `json:"is_active,omitempty" validate:"omitempty"`
+	Metadata        types.Metadata                `json:"metadata,omitempty" validate:"omitempty"`
+}
+
+func (r *CreateSubscriptionPlanRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := r.BillingPeriod.Validate(); err != nil {
+		return err
+	}
+
+	if !r.Amount.IsPositive() {
+		return ierr.NewError("amount must be greater than zero").
+			WithHint("Subscription plan amount must be greater than zero").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.GatewayProvider != nil {
+		if err := r.GatewayProvider.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *CreateSubscriptionPlanRequest) ToPlan(ctx context.Context) *domainSubscription.Plan {
+	metadata := r.Metadata
+	if metadata == nil {
+		metadata = types.Metadata{}
+	}
+
+	return &domainSubscription.Plan{
+		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_PLAN),
+		Name:            r.Name,
+		Description:     r.Description,
+		BillingPeriod:   r.BillingPeriod,
+		Amount:          r.Amount,
+		Currency:        r.Currency,
+		CategoryIDs:     lo.Uniq(r.CategoryIDs),
+		GatewayProvider: lo.FromPtrOr(r.GatewayProvider, types.PaymentGatewayProviderRazorpay),
+		IsActive:        lo.FromPtrOr(r.IsActive, true),
+		Metadata:        metadata,
+		BaseModel:       types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateSubscriptionPlanRequest updates a subscription plan. Price and billing period
+// can't change since existing subscriptions renew at the gateway with them.
+type UpdateSubscriptionPlanRequest struct {
+	Name        *string         `json:"name,omitempty" validate:"omitempty"`
+	Description *string         `json:"description,omitempty" validate:"omitempty"`
+	CategoryIDs []string        `json:"category_ids,omitempty" validate:"omitempty,dive,required"`
+	IsActive    *bool           `json:"is_active,omitempty" validate:"omitempty"`
+	Metadata    *types.Metadata `json:"metadata,omitempty" validate:"omitempty"`
+}
+
+func (r *UpdateSubscriptionPlanRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Subscription plan name cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type SubscriptionPlanResponse struct {
+	domainSubscription.Plan
+}
+
+type ListSubscriptionPlanResponse = types.ListResponse[*SubscriptionPlanResponse]
+
+// CreateSubscriptionRequest subscribes the current user to a plan
+type CreateSubscriptionRequest struct {
+	PlanID   string         `json:"plan_id" validate:"required"`
+	Metadata types.Metadata `json:"metadata,omitempty" validate:"omitempty"`
+}
+
+func (r *CreateSubscriptionRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// CancelSubscriptionRequest cancels a subscription, by default once the paid period ends
+type CancelSubscriptionRequest struct {
+	AtPeriodEnd *bool `json:"at_period_end,omitempty" validate:"omitempty"`
+}
+
+func (r *CancelSubscriptionRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+type SubscriptionResponse struct {
+	domainSubscription.Subscription
+
+	Plan *domainSubscription.Plan `json:"plan,omitempty"`
+}
+
+type ListSubscriptionResponse = types.ListResponse[*SubscriptionResponse]
+
+// GatewayPlanRequest registers a recurring price with a payment gateway
+type GatewayPlanRequest struct {
+	Name          string
+	Description   string
+	BillingPeriod types.BillingPeriod
+	Amount        decimal.Decimal
+	Currency      types.Currency
+	Notes         map[string]string
+}
+
+// GatewayPlanResponse is the plan created at the payment gateway
+type GatewayPlanResponse struct {
+	GatewayPlanID string
+}
+
+// GatewaySubscriptionRequest starts a subscription at a payment gateway
+type GatewaySubscriptionRequest struct {
+	GatewayPlanID string
+
+	// Number of billing cycles before the subscription completes
+	TotalCount int
+	Notes      map[string]string
+}
+
+// GatewaySubscriptionResponse is the subscription created at the payment gateway
+type GatewaySubscriptionResponse struct {
+	GatewaySubscriptionID string
+
+	// Hosted page where the customer authorizes recurring charges
+	CheckoutURL string
+}
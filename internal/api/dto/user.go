@@ -1,14 +1,145 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/user"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
 type MeResponse struct {
 	ID       string `json:"id,omitempty"`
 	Email    string `json:"email,omitempty"`
 	FullName string `json:"full_name,omitempty"`
 	Role     string `json:"role,omitempty"`
 	Phone    string `json:"phone,omitempty"`
+
+	ReferralCode string `json:"referral_code,omitempty"`
+
+	// profile
+	Headline          string                  `json:"headline,omitempty"`
+	Bio               string                  `json:"bio,omitempty"`
+	Skills            []string                `json:"skills"`
+	Education         []types.Education       `json:"education"`
+	Links             []types.ProfileLink     `json:"links"`
+	ResumeFileID      *string                 `json:"resume_file_id,omitempty"`
+	PhotoFileID       *string                 `json:"photo_file_id,omitempty"`
+	ProfileVisibility types.ProfileVisibility `json:"profile_visibility,omitempty"`
 }
 
+func NewMeResponse(user *user.User) *MeResponse {
+	return &MeResponse{
+		ID:       user.ID,
+		Email:    user.Email,
+		FullName: user.FullName,
+		Role:     string(user.Role),
+		Phone:    user.Phone,
+
+		ReferralCode: lo.FromPtr(user.ReferralCode),
+
+		Headline:          user.Headline,
+		Bio:               user.Bio,
+		Skills:            user.Skills,
+		Education:         user.Education,
+		Links:             user.Links,
+		ResumeFileID:      user.ResumeFileID,
+		PhotoFileID:       user.PhotoFileID,
+		ProfileVisibility: user.ProfileVisibility,
+	}
+}
+
+// UpdateUserRequest changes the current user, fields left out stay as they are. Lists replace
+// the ones on the profile and an empty resume_file_id or photo_file_id removes the file
 type UpdateUserRequest struct {
 	FullName string `json:"full_name,omitempty"`
 	Phone    string `json:"phone,omitempty"`
+
+	// profile
+	Headline          *string                  `json:"headline,omitempty" validate:"omitempty,max=255"`
+	Bio               *string                  `json:"bio,omitempty" validate:"omitempty,max=5000"`
+	Skills            []string                 `json:"skills,omitempty" validate:"omitempty,max=50,dive,max=100"`
+	Education         []types.Education        `json:"education,omitempty" validate:"omitempty,max=20,dive"`
+	Links             []types.ProfileLink      `json:"links,omitempty" validate:"omitempty,max=20,dive"`
+	ResumeFileID      *string                  `json:"resume_file_id,omitempty"`
+	PhotoFileID       *string                  `json:"photo_file_id,omitempty"`
+	ProfileVisibility *types.ProfileVisibility `json:"profile_visibility,omitempty"`
+}
+
+func (r *UpdateUserRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid user request").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.ProfileVisibility != nil {
+		return r.ProfileVisibility.Validate()
+	}
+
+	return nil
+}
+
+// ApplyTo sets the fields of the request on the user
+func (r *UpdateUserRequest) ApplyTo(user *user.User) {
+	if r.FullName != "" {
+		user.FullName = r.FullName
+	}
+	if r.Phone != "" {
+		user.Phone = r.Phone
+	}
+	if r.Headline != nil {
+		user.Headline = *r.Headline
+	}
+	if r.Bio != nil {
+		user.Bio = *r.Bio
+	}
+	if r.Skills != nil {
+		user.Skills = types.NormalizeSkills(r.Skills)
+	}
+	if r.Education != nil {
+		user.Education = r.Education
+	}
+	if r.Links != nil {
+		user.Links = r.Links
+	}
+	if r.ResumeFileID != nil {
+		user.ResumeFileID = lo.EmptyableToPtr(*r.ResumeFileID)
+	}
+	if r.PhotoFileID != nil {
+		user.PhotoFileID = lo.EmptyableToPtr(*r.PhotoFileID)
+	}
+	if r.ProfileVisibility != nil {
+		user.ProfileVisibility = *r.ProfileVisibility
+	}
+}
+
+// ProfileResponse is the profile of a user as other users see it
+type ProfileResponse struct {
+	ID       string   `json:"id"`
+	FullName string   `json:"full_name"`
+	Headline string   `json:"headline,omitempty"`
+	Bio      string   `json:"bio,omitempty"`
+	Skills   []string `json:"skills"`
+
+	// VerifiedSkills are skills of the profile taught by internships the user completed
+	VerifiedSkills []string `json:"verified_skills"`
+
+	Education []types.Education   `json:"education"`
+	Links     []types.ProfileLink `json:"links"`
+	PhotoURL  string              `json:"photo_url,omitempty"`
+	ResumeURL string              `json:"resume_url,omitempty"`
+
+	CompletedInternships []*CompletedInternshipResponse `json:"completed_internships"`
+	Certificates         []*CertificateResponse         `json:"certificates"`
+}
+
+type CompletedInternshipResponse struct {
+	InternshipID      string     `json:"internship_id"`
+	InternshipBatchID string     `json:"internship_batch_id"`
+	Title             string     `json:"title"`
+	Skills            []string   `json:"skills"`
+	CompletedAt       *time.Time `json:"completed_at,omitempty"`
 }
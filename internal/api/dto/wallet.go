@@ -0,0 +1,171 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	domainWallet "github.com/omkar273/codegeeky/internal/domain/wallet"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// WalletCreditRequest books credit to a user's wallet
+type WalletCreditRequest struct {
+	UserID            string                        `json:"user_id" validate:"required"`
+	Amount            decimal.Decimal               `json:"amount" validate:"required"`
+	Currency          string                        `json:"currency" validate:"required"`
+	TransactionReason types.WalletTransactionReason `json:"transaction_reason" validate:"required"`
+	ReferenceID       *string                       `json:"reference_id,omitempty" validate:"omitempty"`
+	ExpiresAt         *time.Time                    `json:"expires_at,omitempty" validate:"omitempty"`
+	Description       string                        `json:"description,omitempty" validate:"omitempty"`
+	Metadata          types.Metadata                `json:"metadata,omitempty" validate:"omitempty"`
+}
+
+func (r *WalletCreditRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if !r.Amount.IsPositive() {
+		return ierr.NewError("amount must be greater than zero").
+			WithHint("Amount must be greater than zero").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := types.Currency(strings.ToUpper(r.Currency)).Validate(); err != nil {
+		return err
+	}
+
+	if err := r.TransactionReason.Validate(); err != nil {
+		return err
+	}
+
+	if r.TransactionReason.TransactionType() != types.WalletTransactionTypeCredit {
+		return ierr.NewError("transaction reason is not a credit").
+			WithHint("Please provide a credit transaction reason").
+			WithReportableDetails(map[string]any{
+				"transaction_reason": r.TransactionReason,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.ExpiresAt != nil && r.ExpiresAt.Before(time.Now()) {
+		return ierr.NewError("expires_at must be in the future").
+			WithHint("Expiry must be in the future").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+func (r *WalletCreditRequest) ToWalletTransaction(ctx context.Context) *domainWallet.WalletTransaction {
+	metadata := r.Metadata
+	if metadata == nil {
+		metadata = types.Metadata{}
+	}
+
+	return &domainWallet.WalletTransaction{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION),
+		UserID:            r.UserID,
+		TransactionType:   types.WalletTransactionTypeCredit,
+		TransactionReason: r.TransactionReason,
+		Amount:            r.Amount,
+		Currency:          strings.ToUpper(r.Currency),
+		RemainingAmount:   r.Amount,
+		ExpiresAt:         r.ExpiresAt,
+		ReferenceID:       r.ReferenceID,
+		Description:       r.Description,
+		Metadata:          metadata,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// WalletDebitRequest spends credit from a user's wallet
+type WalletDebitRequest struct {
+	UserID            string                        `json:"user_id" validate:"required"`
+	Amount            decimal.Decimal               `json:"amount" validate:"required"`
+	Currency          string                        `json:"currency" validate:"required"`
+	TransactionReason types.WalletTransactionReason `json:"transaction_reason" validate:"required"`
+	ReferenceID       *string                       `json:"reference_id,omitempty" validate:"omitempty"`
+	Description       string                        `json:"description,omitempty" validate:"omitempty"`
+}
+
+func (r *WalletDebitRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if !r.Amount.IsPositive() {
+		return ierr.NewError("amount must be greater than zero").
+			WithHint("Amount must be greater than zero").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := r.TransactionReason.Validate(); err != nil {
+		return err
+	}
+
+	if r.TransactionReason.TransactionType() != types.WalletTransactionTypeDebit {
+		return ierr.NewError("transaction reason is not a debit").
+			WithHint("Please provide a debit transaction reason").
+			WithReportableDetails(map[string]any{
+				"transaction_reason": r.TransactionReason,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// GrantWalletCreditRequest is an admin grant of store credit
+type GrantWalletCreditRequest struct {
+	UserID      string          `json:"user_id" validate:"required"`
+	Amount      decimal.Decimal `json:"amount" validate:"required"`
+	Currency    string          `json:"currency,omitempty" validate:"omitempty"`
+	ExpiresAt   *time.Time      `json:"expires_at,omitempty" validate:"omitempty"`
+	Description string          `json:"description,omitempty" validate:"omitempty"`
+}
+
+func (r *GrantWalletCreditRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+func (r *GrantWalletCreditRequest) ToWalletCreditRequest(defaultCurrency string) *WalletCreditRequest {
+	currency := r.Currency
+	if currency == "" {
+		currency = defaultCurrency
+	}
+
+	return &WalletCreditRequest{
+		UserID:            r.UserID,
+		Amount:            r.Amount,
+		Currency:          currency,
+		TransactionReason: types.WalletTransactionReasonAdminGrant,
+		ExpiresAt:         r.ExpiresAt,
+		Description:       r.Description,
+	}
+}
+
+type WalletTransactionResponse struct {
+	domainWallet.WalletTransaction
+}
+
+type ListWalletTransactionResponse = types.ListResponse[*WalletTransactionResponse]
+
+// WalletBalanceResponse is a user's spendable store credit in one currency
+type WalletBalanceResponse struct {
+	UserID   string          `json:"user_id"`
+	Currency string          `json:"currency"`
+	Balance  decimal.Decimal `json:"balance"`
+
+	// Ledger totals the balance is derived from
+	TotalCredits decimal.Decimal `json:"total_credits"`
+	TotalDebits  decimal.Decimal `json:"total_debits"`
+
+	// Soonest expiring credit, if any
+	NextExpiryAmount *decimal.Decimal `json:"next_expiry_amount,omitempty"`
+	NextExpiryAt     *time.Time       `json:"next_expiry_at,omitempty"`
+}
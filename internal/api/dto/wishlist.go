@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/domain/wishlist"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/validator"
+)
+
+// AddWishlistRequest saves an internship for later
+type AddWishlistRequest struct {
+	InternshipID string `json:"internship_id" validate:"required"`
+}
+
+func (r *AddWishlistRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return ierr.WithError(err).
+			WithHint("invalid wishlist request").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *AddWishlistRequest) ToWishlist(ctx context.Context) *wishlist.Wishlist {
+	return &wishlist.Wishlist{
+		ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WISHLIST),
+		UserID:       types.GetUserID(ctx),
+		InternshipID: r.InternshipID,
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// WishlistResponse is a saved internship, the internship is left out once it is no longer published
+type WishlistResponse struct {
+	wishlist.Wishlist
+	Internship *InternshipResponse `json:"internship,omitempty"`
+}
+
+// ListWishlistResponse represents the response for listing the wishlist of a user
+type ListWishlistResponse = types.ListResponse[*WishlistResponse]
+
+// WishlistDemandResponse is how many users saved an internship, a demand signal for planning batches
+type WishlistDemandResponse struct {
+	Internship *InternshipResponse `json:"internship"`
+	Wishlists  int                 `json:"wishlists"`
+	// UpcomingBatches is the number of batches of the internship that did not start yet
+	UpcomingBatches int `json:"upcoming_batches"`
+}
+
+// ListWishlistDemandResponse holds wishlisted internships, most wishlisted first
+type ListWishlistDemandResponse struct {
+	Items []*WishlistDemandResponse `json:"items"`
+}
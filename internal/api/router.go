@@ -11,12 +11,31 @@ import (
 )
 
 type Handlers struct {
-	Health     *v1.HealthHandler
-	Auth       *v1.AuthHandler
-	User       *v1.UserHandler
-	Internship *v1.InternshipHandler
-	Category   *v1.CategoryHandler
-	Discount   *v1.DiscountHandler
+	Health       *v1.HealthHandler
+	Auth         *v1.AuthHandler
+	User         *v1.UserHandler
+	Internship   *v1.InternshipHandler
+	Category     *v1.CategoryHandler
+	Discount     *v1.DiscountHandler
+	Referral     *v1.ReferralHandler
+	Wallet       *v1.WalletHandler
+	Payment      *v1.PaymentHandler
+	PaymentPlan  *v1.PaymentPlanHandler
+	Subscription *v1.SubscriptionHandler
+	Batch        *v1.InternshipBatchHandler
+	Instructor   *v1.InternshipInstructorHandler
+	Content      *v1.ContentHandler
+	Assignment   *v1.AssignmentHandler
+	Progress     *v1.ProgressHandler
+	Submission   *v1.SubmissionHandler
+	Quiz         *v1.QuizHandler
+	LiveSession  *v1.LiveSessionHandler
+	Application  *v1.InternshipApplicationHandler
+	Certificate  *v1.CertificateHandler
+	Recommender  *v1.RecommendationHandler
+	Review       *v1.InternshipReviewHandler
+	Wishlist     *v1.WishlistHandler
+	Notification *v1.NotificationHandler
 }
 
 func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Logger) *gin.Engine {
@@ -40,30 +59,258 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 	v1Auth.Use(middleware.GuestAuthenticateMiddleware)
 	v1Auth.POST("/signup", handlers.Auth.Signup)
 
+	// Profiles are public or shared with recruiters, signing in is only needed for the latter
+	v1Router.GET("/users/:id/profile", middleware.OptionalAuthenticateMiddleware(cfg, logger), handlers.User.GetProfile)
+
 	// Authenticated routes
 	v1Private := v1Router.Group("/")
 	v1Private.Use(middleware.AuthenticateMiddleware(cfg, logger))
 	{
 		v1Private.GET("/user/me", handlers.User.Me)
 		v1Private.PUT("/user", handlers.User.Update)
+
+		v1Private.GET("/referrals/me", handlers.Referral.GetMySummary)
+		v1Private.GET("/referrals", handlers.Referral.ListReferrals)
+
+		v1Private.GET("/wallet/balance", handlers.Wallet.GetBalance)
+		v1Private.GET("/wallet/transactions", handlers.Wallet.ListTransactions)
+		v1Private.POST("/wallet/credits", middleware.RequireAdmin(), handlers.Wallet.GrantCredit)
+
+		v1Private.POST("/payments/:id/refund", middleware.RequireAdmin(), handlers.Payment.Refund)
+
+		v1Private.GET("/enrollments/:id/installments", handlers.PaymentPlan.GetEnrollmentInstallments)
+		v1Private.POST("/enrollments/:id/certificate", handlers.Certificate.IssueCertificate)
+
+		v1Private.POST("/subscriptions", handlers.Subscription.Subscribe)
+		v1Private.GET("/subscriptions", handlers.Subscription.ListSubscriptions)
+		v1Private.GET("/subscriptions/:id", handlers.Subscription.GetSubscription)
+		v1Private.POST("/subscriptions/:id/cancel", handlers.Subscription.CancelSubscription)
+
+		v1Private.GET("/instructor/internships", middleware.RequireInstructorOrAdmin(), handlers.Internship.ListMyInternships)
+		v1Private.GET("/instructor/batches", middleware.RequireInstructorOrAdmin(), handlers.Batch.ListMyInternshipBatches)
+		v1Private.GET("/instructor/batches/:id/progress", middleware.RequireInstructorOrAdmin(), handlers.Progress.ListBatchProgress)
+
+		v1Private.GET("/progress", handlers.Progress.ListMyProgress)
 	}
 
+	// Payment gateway webhooks, authenticated by the gateway signature
+	v1Router.POST("/webhooks/:provider/subscriptions", handlers.Subscription.HandleGatewayWebhook)
+
 	// Internship routes
 	v1Internship := v1Router.Group("/internships")
 	{
 		v1Internship.GET("", handlers.Internship.ListInternships)
+		v1Internship.GET("/search", handlers.Internship.SearchInternships)
 		v1Internship.GET("/:id", handlers.Internship.GetInternship)
+		v1Internship.GET("/:id/instructors", handlers.Instructor.ListInstructors)
+		v1Internship.GET("/:id/content", handlers.Content.GetOutline)
+		v1Internship.GET("/:id/reviews", middleware.OptionalAuthenticateMiddleware(cfg, logger), handlers.Review.ListInternshipReviews)
 
 		v1Internship.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Internship.GET("/drafts", handlers.Internship.ListDrafts)
+		v1Internship.GET("/recommendations", handlers.Recommender.ListRecommendations)
 		v1Internship.POST("", handlers.Internship.CreateInternship)
 		v1Internship.PUT("/:id", handlers.Internship.UpdateInternship)
 		v1Internship.DELETE("/:id", handlers.Internship.DeleteInternship)
+
+		// Editorial workflow
+		v1Internship.POST("/:id/submit", handlers.Internship.SubmitInternship)
+		v1Internship.POST("/:id/approve", middleware.RequireAdmin(), handlers.Internship.ApproveInternship)
+		v1Internship.POST("/:id/reject", middleware.RequireAdmin(), handlers.Internship.RejectInternship)
+
+		// Change history
+		v1Internship.GET("/:id/revisions", handlers.Internship.ListRevisions)
+		v1Internship.GET("/:id/revisions/diff", handlers.Internship.DiffRevisions)
+		v1Internship.GET("/:id/revisions/:revision_id", handlers.Internship.GetRevision)
+		v1Internship.POST("/:id/revisions/:revision_id/restore", handlers.Internship.RestoreRevision)
+
+		// Instructors
+		v1Internship.POST("/:id/instructors", handlers.Instructor.AddInstructor)
+		v1Internship.PUT("/:id/instructors/:user_id", handlers.Instructor.UpdateInstructor)
+		v1Internship.DELETE("/:id/instructors/:user_id", handlers.Instructor.RemoveInstructor)
+
+		// Applications
+		v1Internship.PUT("/:id/application-form", handlers.Application.UpdateApplicationForm)
+		v1Internship.GET("/:id/applications", handlers.Application.ListApplications)
+
+		// Content
+		v1Internship.POST("/:id/modules", handlers.Content.CreateModule)
+		v1Internship.POST("/:id/resources", handlers.Content.CreateResource)
+
+		// Progress
+		v1Internship.GET("/:id/progress", handlers.Progress.GetInternshipProgress)
+
+		// Reviews
+		v1Internship.POST("/:id/reviews", handlers.Review.CreateReview)
+	}
+
+	// Review routes
+	v1Review := v1Router.Group("/reviews")
+	{
+		v1Review.GET("/:id", middleware.OptionalAuthenticateMiddleware(cfg, logger), handlers.Review.GetReview)
+
+		v1Review.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Review.PUT("/:id", handlers.Review.UpdateReview)
+		v1Review.DELETE("/:id", handlers.Review.DeleteReview)
+		v1Review.POST("/:id/reply", handlers.Review.ReplyReview)
+		v1Review.POST("/:id/report", handlers.Review.ReportReview)
+
+		// Moderation
+		v1Review.GET("", middleware.RequireAdmin(), handlers.Review.ListReviews)
+		v1Review.POST("/:id/hide", middleware.RequireAdmin(), handlers.Review.HideReview)
+		v1Review.POST("/:id/restore", middleware.RequireAdmin(), handlers.Review.RestoreReview)
+	}
+
+	// Wishlist routes
+	v1Wishlist := v1Router.Group("/wishlist")
+	{
+		v1Wishlist.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Wishlist.GET("", handlers.Wishlist.ListWishlist)
+		v1Wishlist.POST("", handlers.Wishlist.AddToWishlist)
+		v1Wishlist.DELETE("/:internship_id", handlers.Wishlist.RemoveFromWishlist)
+		v1Wishlist.GET("/demand", middleware.RequireAdmin(), handlers.Wishlist.ListDemand)
+	}
+
+	// Notification routes
+	v1Notification := v1Router.Group("/notifications")
+	{
+		v1Notification.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Notification.GET("", handlers.Notification.ListNotifications)
+		v1Notification.GET("/stream", handlers.Notification.StreamNotifications)
+		v1Notification.POST("/read-all", handlers.Notification.MarkAllRead)
+		v1Notification.POST("/:id/read", handlers.Notification.MarkRead)
+		v1Notification.DELETE("/:id", handlers.Notification.DeleteNotification)
+		v1Notification.GET("/preferences", handlers.Notification.GetPreferences)
+		v1Notification.PUT("/preferences", handlers.Notification.UpdatePreferences)
+	}
+
+	// Course content routes
+	v1Module := v1Router.Group("/modules")
+	{
+		v1Module.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Module.PUT("/:id", handlers.Content.UpdateModule)
+		v1Module.DELETE("/:id", handlers.Content.DeleteModule)
+		v1Module.POST("/:id/lessons", handlers.Content.CreateLesson)
+		v1Module.POST("/:id/assignments", handlers.Assignment.CreateAssignment)
+		v1Module.POST("/:id/quizzes", handlers.Quiz.CreateQuiz)
+	}
+
+	v1Lesson := v1Router.Group("/lessons")
+	{
+		v1Lesson.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Lesson.GET("/:id", handlers.Content.GetLesson)
+		v1Lesson.PUT("/:id", handlers.Content.UpdateLesson)
+		v1Lesson.DELETE("/:id", handlers.Content.DeleteLesson)
+		v1Lesson.PUT("/:id/progress", handlers.Progress.UpdateLessonProgress)
+	}
+
+	v1Assignment := v1Router.Group("/assignments")
+	{
+		v1Assignment.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Assignment.GET("/:id", handlers.Assignment.GetAssignment)
+		v1Assignment.PUT("/:id", handlers.Assignment.UpdateAssignment)
+		v1Assignment.DELETE("/:id", handlers.Assignment.DeleteAssignment)
+		v1Assignment.POST("/:id/submissions", handlers.Submission.Submit)
+		v1Assignment.GET("/:id/submissions", handlers.Submission.ListSubmissions)
+	}
+
+	v1Submission := v1Router.Group("/submissions")
+	{
+		v1Submission.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Submission.GET("/:id", handlers.Submission.GetSubmission)
+		v1Submission.POST("/:id/grade", handlers.Submission.GradeSubmission)
+	}
+
+	v1Quiz := v1Router.Group("/quizzes")
+	{
+		v1Quiz.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Quiz.GET("/:id", handlers.Quiz.GetQuiz)
+		v1Quiz.PUT("/:id", handlers.Quiz.UpdateQuiz)
+		v1Quiz.DELETE("/:id", handlers.Quiz.DeleteQuiz)
+		v1Quiz.POST("/:id/attempts", handlers.Quiz.StartAttempt)
+		v1Quiz.GET("/:id/attempts", handlers.Quiz.ListAttempts)
+	}
+
+	v1QuizAttempt := v1Router.Group("/quiz-attempts")
+	{
+		v1QuizAttempt.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1QuizAttempt.GET("/:id", handlers.Quiz.GetAttempt)
+		v1QuizAttempt.POST("/:id/submit", handlers.Quiz.SubmitAttempt)
+	}
+
+	v1LiveSession := v1Router.Group("/live-sessions")
+	{
+		v1LiveSession.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1LiveSession.GET("/calendar", handlers.LiveSession.GetCalendarFeed)
+		v1LiveSession.GET("/:id", handlers.LiveSession.GetLiveSession)
+		v1LiveSession.PUT("/:id", handlers.LiveSession.UpdateLiveSession)
+		v1LiveSession.DELETE("/:id", handlers.LiveSession.DeleteLiveSession)
+		v1LiveSession.POST("/:id/cancel", handlers.LiveSession.CancelLiveSession)
+		v1LiveSession.GET("/:id/recording", handlers.LiveSession.GetRecording)
+		v1LiveSession.POST("/:id/join", handlers.LiveSession.JoinLiveSession)
+		v1LiveSession.POST("/:id/leave", handlers.LiveSession.LeaveLiveSession)
+		v1LiveSession.PUT("/:id/attendance", handlers.LiveSession.MarkAttendance)
+		v1LiveSession.GET("/:id/attendance", handlers.LiveSession.ListAttendance)
+	}
+
+	// Calendar feeds are authenticated by the token in their link
+	v1Router.GET("/calendars/:id/sessions.ics", handlers.LiveSession.RenderCalendar)
+
+	v1Application := v1Router.Group("/applications")
+	{
+		v1Application.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Application.POST("", handlers.Application.SubmitApplication)
+		v1Application.GET("", handlers.Application.ListMyApplications)
+		v1Application.GET("/:id", handlers.Application.GetApplication)
+		v1Application.POST("/:id/shortlist", handlers.Application.ShortlistApplication)
+		v1Application.POST("/:id/interview", handlers.Application.ScheduleInterview)
+		v1Application.POST("/:id/accept", handlers.Application.AcceptApplication)
+		v1Application.POST("/:id/reject", handlers.Application.RejectApplication)
+		v1Application.POST("/:id/withdraw", handlers.Application.WithdrawApplication)
+	}
+
+	// Certificate routes
+	v1Certificate := v1Router.Group("/certificates")
+	{
+		v1Certificate.GET("/:id/verify", handlers.Certificate.VerifyCertificate)
+
+		v1Certificate.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Certificate.GET("", handlers.Certificate.ListCertificates)
+		v1Certificate.GET("/:id", handlers.Certificate.GetCertificate)
+		v1Certificate.GET("/:id/download", handlers.Certificate.DownloadCertificate)
+	}
+
+	v1Resource := v1Router.Group("/resources")
+	{
+		v1Resource.Use(middleware.AuthenticateMiddleware(cfg, logger))
+		v1Resource.GET("/:id/download", handlers.Content.DownloadResource)
+		v1Resource.PUT("/:id", handlers.Content.UpdateResource)
+		v1Resource.DELETE("/:id", handlers.Content.DeleteResource)
+	}
+
+	// Internship batch routes
+	v1Batch := v1Router.Group("/internship-batches")
+	{
+		v1Batch.GET("", handlers.Batch.ListInternshipBatches)
+		v1Batch.GET("/:id", handlers.Batch.GetInternshipBatch)
+
+		// Live sessions are open to the students and instructors of the batch
+		v1Batch.POST("/:id/live-sessions", middleware.AuthenticateMiddleware(cfg, logger), handlers.LiveSession.CreateLiveSession)
+		v1Batch.GET("/:id/live-sessions", middleware.AuthenticateMiddleware(cfg, logger), handlers.LiveSession.ListLiveSessions)
+		v1Batch.GET("/:id/attendance", middleware.AuthenticateMiddleware(cfg, logger), handlers.LiveSession.ListBatchAttendance)
+
+		v1Batch.Use(middleware.AuthenticateMiddleware(cfg, logger), middleware.RequireAdmin())
+		v1Batch.POST("/:id/pause", handlers.Batch.PauseInternshipBatch)
+		v1Batch.POST("/:id/resume", handlers.Batch.ResumeInternshipBatch)
+		v1Batch.POST("/:id/cancel", handlers.Batch.CancelInternshipBatch)
 	}
 
 	// Category routes
 	v1Category := v1Router.Group("/categories")
 	{
 		v1Category.GET("", handlers.Category.ListCategories)
+		v1Category.GET("/tree", handlers.Category.GetCategoryTree)
+		v1Category.GET("/slug/:slug", handlers.Category.GetCategoryBySlug)
 		v1Category.GET("/:id", handlers.Category.GetCategory)
 
 		v1Category.Use(middleware.AuthenticateMiddleware(cfg, logger))
@@ -83,6 +330,36 @@ func NewRouter(handlers *Handlers, cfg *config.Configuration, logger *logger.Log
 		v1Discount.POST("", handlers.Discount.CreateDiscount)
 		v1Discount.PUT("/:id", handlers.Discount.UpdateDiscount)
 		v1Discount.DELETE("/:id", handlers.Discount.DeleteDiscount)
+
+		// Campaign code management
+		v1Discount.POST("/:id/codes", middleware.RequireAdmin(), handlers.Discount.GenerateCodes)
+		v1Discount.GET("/:id/codes/export", middleware.RequireAdmin(), handlers.Discount.ExportCodes)
+		v1Discount.POST("/:id/codes/deactivate", middleware.RequireAdmin(), handlers.Discount.DeactivateCodes)
+		v1Discount.GET("/:id/stats", middleware.RequireAdmin(), handlers.Discount.GetCampaignStats)
+	}
+
+	// Payment plan routes
+	v1PaymentPlan := v1Router.Group("/payment-plans")
+	{
+		v1PaymentPlan.GET("", handlers.PaymentPlan.ListPaymentPlans)
+		v1PaymentPlan.GET("/:id", handlers.PaymentPlan.GetPaymentPlan)
+
+		v1PaymentPlan.Use(middleware.AuthenticateMiddleware(cfg, logger), middleware.RequireAdmin())
+		v1PaymentPlan.POST("", handlers.PaymentPlan.CreatePaymentPlan)
+		v1PaymentPlan.PUT("/:id", handlers.PaymentPlan.UpdatePaymentPlan)
+		v1PaymentPlan.DELETE("/:id", handlers.PaymentPlan.DeletePaymentPlan)
+	}
+
+	// Subscription plan routes
+	v1SubscriptionPlan := v1Router.Group("/subscription-plans")
+	{
+		v1SubscriptionPlan.GET("", handlers.Subscription.ListPlans)
+		v1SubscriptionPlan.GET("/:id", handlers.Subscription.GetPlan)
+
+		v1SubscriptionPlan.Use(middleware.AuthenticateMiddleware(cfg, logger), middleware.RequireAdmin())
+		v1SubscriptionPlan.POST("", handlers.Subscription.CreatePlan)
+		v1SubscriptionPlan.PUT("/:id", handlers.Subscription.UpdatePlan)
+		v1SubscriptionPlan.DELETE("/:id", handlers.Subscription.DeletePlan)
 	}
 	return router
 }
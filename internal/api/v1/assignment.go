@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+)
+
+type AssignmentHandler struct {
+	assignmentService service.AssignmentService
+	logger            *logger.Logger
+}
+
+func NewAssignmentHandler(assignmentService service.AssignmentService, logger *logger.Logger) *AssignmentHandler {
+	return &AssignmentHandler{
+		assignmentService: assignmentService,
+		logger:            logger,
+	}
+}
+
+// @Summary Create an assignment
+// @Description Add an assignment to a module, only owners and admins can do this
+// @Tags Assignment
+// @Accept json
+// @Produce json
+// @Param id path string true "Module ID"
+// @Param request body dto.CreateAssignmentRequest true "Assignment to create"
+// @Success 201 {object} dto.AssignmentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /modules/{id}/assignments [post]
+// @Security ApiKeyAuth
+func (h *AssignmentHandler) CreateAssignment(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("module id is required").
+			WithHint("Module ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	assignment, err := h.assignmentService.Create(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, assignment)
+}
+
+// @Summary Get an assignment
+// @Description Get an assignment with its instructions, only available to enrolled students
+// @Tags Assignment
+// @Accept json
+// @Produce json
+// @Param id path string true "Assignment ID"
+// @Success 200 {object} dto.AssignmentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /assignments/{id} [get]
+// @Security ApiKeyAuth
+func (h *AssignmentHandler) GetAssignment(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("assignment id is required").
+			WithHint("Assignment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	assignment, err := h.assignmentService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, assignment)
+}
+
+// @Summary Update an assignment
+// @Description Update an assignment or move it to another module of the same internship
+// @Tags Assignment
+// @Accept json
+// @Produce json
+// @Param id path string true "Assignment ID"
+// @Param request body dto.UpdateAssignmentRequest true "Assignment changes"
+// @Success 200 {object} dto.AssignmentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /assignments/{id} [put]
+// @Security ApiKeyAuth
+func (h *AssignmentHandler) UpdateAssignment(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("assignment id is required").
+			WithHint("Assignment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	assignment, err := h.assignmentService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, assignment)
+}
+
+// @Summary Delete an assignment
+// @Description Delete an assignment
+// @Tags Assignment
+// @Accept json
+// @Produce json
+// @Param id path string true "Assignment ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /assignments/{id} [delete]
+// @Security ApiKeyAuth
+func (h *AssignmentHandler) DeleteAssignment(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("assignment id is required").
+			WithHint("Assignment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.assignmentService.Delete(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
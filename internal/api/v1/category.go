@@ -106,6 +106,15 @@ func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 		c.Error(err)
 		return
 	}
+
+	category, err := h.categoryService.Update(c.Request.Context(), categoryID, &req)
+	if err != nil {
+		h.logger.Errorw("Failed to update category", "error", err, "category_id", categoryID)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
 }
 
 // @Summary Delete a category
@@ -170,4 +179,52 @@ func (h *CategoryHandler) ListCategories(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, categories)
-}
\ No newline at end of file
+}
+// @Summary Get a category by slug
+// @Description Get a category by its unique URL slug
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Param slug path string true "Category slug"
+// @Success 200 {object} dto.CategoryResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /categories/slug/{slug} [get]
+func (h *CategoryHandler) GetCategoryBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+	if slug == "" {
+		c.Error(ierr.NewError("Category slug is required").
+			WithHint("Category slug is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	category, err := h.categoryService.GetBySlug(c.Request.Context(), slug)
+	if err != nil {
+		h.logger.Errorw("Failed to get category by slug", "error", err, "slug", slug)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// @Summary Get the category tree
+// @Description Get all categories nested under their parents, ordered by sort weight
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.CategoryTreeResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /categories/tree [get]
+func (h *CategoryHandler) GetCategoryTree(c *gin.Context) {
+	tree, err := h.categoryService.GetTree(c.Request.Context())
+	if err != nil {
+		h.logger.Errorw("Failed to get category tree", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tree)
+}
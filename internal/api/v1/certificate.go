@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type CertificateHandler struct {
+	certificateService service.CertificateService
+	logger             *logger.Logger
+}
+
+func NewCertificateHandler(certificateService service.CertificateService, logger *logger.Logger) *CertificateHandler {
+	return &CertificateHandler{
+		certificateService: certificateService,
+		logger:             logger,
+	}
+}
+
+// @Summary Issue a certificate
+// @Description Issue the completion certificate of an enrollment, returns the existing certificate when it was already issued
+// @Tags Certificate
+// @Accept json
+// @Produce json
+// @Param id path string true "Enrollment ID"
+// @Success 200 {object} dto.CertificateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /enrollments/{id}/certificate [post]
+// @Security ApiKeyAuth
+func (h *CertificateHandler) IssueCertificate(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("enrollment id is required").
+			WithHint("Enrollment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	certificate, err := h.certificateService.Issue(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, certificate)
+}
+
+// @Summary List my certificates
+// @Description List the certificates of the current user, admins see every certificate
+// @Tags Certificate
+// @Accept json
+// @Produce json
+// @Param filter query types.CertificateFilter true "Filter options"
+// @Success 200 {object} dto.ListCertificateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /certificates [get]
+// @Security ApiKeyAuth
+func (h *CertificateHandler) ListCertificates(c *gin.Context) {
+	filter := types.NewCertificateFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	certificates, err := h.certificateService.List(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, certificates)
+}
+
+// @Summary Get a certificate
+// @Description Get a certificate of the current user
+// @Tags Certificate
+// @Accept json
+// @Produce json
+// @Param id path string true "Certificate ID"
+// @Success 200 {object} dto.CertificateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /certificates/{id} [get]
+// @Security ApiKeyAuth
+func (h *CertificateHandler) GetCertificate(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("certificate id is required").
+			WithHint("Certificate ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	certificate, err := h.certificateService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, certificate)
+}
+
+// @Summary Download a certificate
+// @Description Get the download URL of the certificate PDF, revoked certificates can't be downloaded
+// @Tags Certificate
+// @Accept json
+// @Produce json
+// @Param id path string true "Certificate ID"
+// @Success 200 {object} dto.CertificateDownloadResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /certificates/{id}/download [get]
+// @Security ApiKeyAuth
+func (h *CertificateHandler) DownloadCertificate(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("certificate id is required").
+			WithHint("Certificate ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	download, err := h.certificateService.GetDownloadURL(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, download)
+}
+
+// @Summary Verify a certificate
+// @Description Check a certificate by its verification id, anyone can verify a certificate
+// @Tags Certificate
+// @Accept json
+// @Produce json
+// @Param id path string true "Verification ID"
+// @Success 200 {object} dto.VerifyCertificateResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /certificates/{id}/verify [get]
+func (h *CertificateHandler) VerifyCertificate(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("verification id is required").
+			WithHint("Verification ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	verification, err := h.certificateService.Verify(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, verification)
+}
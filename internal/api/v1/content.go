@@ -0,0 +1,456 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+)
+
+type ContentHandler struct {
+	contentService service.ContentService
+	logger         *logger.Logger
+}
+
+func NewContentHandler(contentService service.ContentService, logger *logger.Logger) *ContentHandler {
+	return &ContentHandler{
+		contentService: contentService,
+		logger:         logger,
+	}
+}
+
+// @Summary Get internship content
+// @Description Get the modules of an internship with their lessons, assignments and resources, only preview lessons include their content
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Success 200 {object} dto.ContentOutlineResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/content [get]
+func (h *ContentHandler) GetOutline(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	outline, err := h.contentService.GetOutline(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, outline)
+}
+
+// @Summary Create a module
+// @Description Add a module to the content of an internship, only owners and admins can do this
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param request body dto.CreateModuleRequest true "Module to create"
+// @Success 201 {object} dto.ModuleResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/modules [post]
+// @Security ApiKeyAuth
+func (h *ContentHandler) CreateModule(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateModuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	module, err := h.contentService.CreateModule(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, module)
+}
+
+// @Summary Update a module
+// @Description Update the title, description or position of a module
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Module ID"
+// @Param request body dto.UpdateModuleRequest true "Module changes"
+// @Success 200 {object} dto.ModuleResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /modules/{id} [put]
+// @Security ApiKeyAuth
+func (h *ContentHandler) UpdateModule(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("module id is required").
+			WithHint("Module ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateModuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	module, err := h.contentService.UpdateModule(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, module)
+}
+
+// @Summary Delete a module
+// @Description Delete a module, it must not have any lessons, assignments or resources left
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Module ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /modules/{id} [delete]
+// @Security ApiKeyAuth
+func (h *ContentHandler) DeleteModule(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("module id is required").
+			WithHint("Module ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.contentService.DeleteModule(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Create a lesson
+// @Description Add a video, text or file lesson to a module
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Module ID"
+// @Param request body dto.CreateLessonRequest true "Lesson to create"
+// @Success 201 {object} dto.LessonResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /modules/{id}/lessons [post]
+// @Security ApiKeyAuth
+func (h *ContentHandler) CreateLesson(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("module id is required").
+			WithHint("Module ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateLessonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	lesson, err := h.contentService.CreateLesson(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, lesson)
+}
+
+// @Summary Get a lesson
+// @Description Get a lesson with its content, non-preview lessons are only available to enrolled students
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Lesson ID"
+// @Success 200 {object} dto.LessonResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /lessons/{id} [get]
+// @Security ApiKeyAuth
+func (h *ContentHandler) GetLesson(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("lesson id is required").
+			WithHint("Lesson ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	lesson, err := h.contentService.GetLesson(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, lesson)
+}
+
+// @Summary Update a lesson
+// @Description Update a lesson or move it to another module of the same internship
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Lesson ID"
+// @Param request body dto.UpdateLessonRequest true "Lesson changes"
+// @Success 200 {object} dto.LessonResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /lessons/{id} [put]
+// @Security ApiKeyAuth
+func (h *ContentHandler) UpdateLesson(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("lesson id is required").
+			WithHint("Lesson ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateLessonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	lesson, err := h.contentService.UpdateLesson(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, lesson)
+}
+
+// @Summary Delete a lesson
+// @Description Delete a lesson, it must not have any resources left
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Lesson ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /lessons/{id} [delete]
+// @Security ApiKeyAuth
+func (h *ContentHandler) DeleteLesson(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("lesson id is required").
+			WithHint("Lesson ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.contentService.DeleteLesson(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Create a resource
+// @Description Attach an uploaded file to an internship, optionally to one of its modules or lessons
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param request body dto.CreateResourceRequest true "Resource to create"
+// @Success 201 {object} dto.ResourceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/resources [post]
+// @Security ApiKeyAuth
+func (h *ContentHandler) CreateResource(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateResourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resource, err := h.contentService.CreateResource(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resource)
+}
+
+// @Summary Update a resource
+// @Description Update a resource or attach it elsewhere within the same internship
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Resource ID"
+// @Param request body dto.UpdateResourceRequest true "Resource changes"
+// @Success 200 {object} dto.ResourceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /resources/{id} [put]
+// @Security ApiKeyAuth
+func (h *ContentHandler) UpdateResource(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("resource id is required").
+			WithHint("Resource ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateResourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resource, err := h.contentService.UpdateResource(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resource)
+}
+
+// @Summary Delete a resource
+// @Description Delete a resource, the uploaded file is kept
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Resource ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /resources/{id} [delete]
+// @Security ApiKeyAuth
+func (h *ContentHandler) DeleteResource(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("resource id is required").
+			WithHint("Resource ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.contentService.DeleteResource(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Download a resource
+// @Description Get the link to download a resource, only available to enrolled students
+// @Tags Content
+// @Accept json
+// @Produce json
+// @Param id path string true "Resource ID"
+// @Success 200 {object} dto.ResourceDownloadResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /resources/{id}/download [get]
+// @Security ApiKeyAuth
+func (h *ContentHandler) DownloadResource(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("resource id is required").
+			WithHint("Resource ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	download, err := h.contentService.DownloadResource(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, download)
+}
@@ -213,3 +213,145 @@ func (h *DiscountHandler) GetDiscountByCode(c *gin.Context) {
 
 	c.JSON(http.StatusOK, discount)
 }
+
+// @Summary Generate campaign codes
+// @Description Generate single-use codes for a campaign discount
+// @Tags Discount
+// @Accept json
+// @Produce json
+// @Param id path string true "Campaign discount ID"
+// @Param request body dto.GenerateDiscountCodesRequest true "Code generation options"
+// @Success 201 {object} dto.GenerateDiscountCodesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /discounts/{id}/codes [post]
+// @Security ApiKeyAuth
+func (h *DiscountHandler) GenerateCodes(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("discount id is required").
+			WithHint("Discount ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.GenerateDiscountCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.discountService.GenerateCodes(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// @Summary Export campaign codes
+// @Description Export all codes of a campaign discount as CSV
+// @Tags Discount
+// @Produce text/csv
+// @Param id path string true "Campaign discount ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /discounts/{id}/codes/export [get]
+// @Security ApiKeyAuth
+func (h *DiscountHandler) ExportCodes(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("discount id is required").
+			WithHint("Discount ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	data, err := h.discountService.ExportCodes(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename="+id+"_codes.csv")
+	c.Data(http.StatusOK, "text/csv", data)
+}
+
+// @Summary Deactivate campaign codes
+// @Description Deactivate the given codes of a campaign discount, or all of them when none are given
+// @Tags Discount
+// @Accept json
+// @Produce json
+// @Param id path string true "Campaign discount ID"
+// @Param request body dto.DeactivateDiscountCodesRequest false "Codes to deactivate"
+// @Success 200 {object} dto.DeactivateDiscountCodesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /discounts/{id}/codes/deactivate [post]
+// @Security ApiKeyAuth
+func (h *DiscountHandler) DeactivateCodes(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("discount id is required").
+			WithHint("Discount ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.DeactivateDiscountCodesRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Error("failed to bind request", "error", err)
+			c.Error(ierr.WithError(err).
+				WithHint("Failed to bind request").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	resp, err := h.discountService.DeactivateCodes(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get campaign stats
+// @Description Get usage stats rolled up across all codes of a campaign discount
+// @Tags Discount
+// @Accept json
+// @Produce json
+// @Param id path string true "Campaign discount ID"
+// @Success 200 {object} dto.CampaignStatsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /discounts/{id}/stats [get]
+// @Security ApiKeyAuth
+func (h *DiscountHandler) GetCampaignStats(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("discount id is required").
+			WithHint("Discount ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	stats, err := h.discountService.GetCampaignStats(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
@@ -186,3 +186,200 @@ func (h *InternshipHandler) ListInternships(c *gin.Context) {
 
 	c.JSON(http.StatusOK, internships)
 }
+
+// @Summary List internship drafts
+// @Description List internships and revisions in the editorial workflow, authors only see their own
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipFilter true "Filter options"
+// @Success 200 {object} dto.ListInternshipResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/drafts [get]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) ListDrafts(c *gin.Context) {
+	filter := types.NewInternshipFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	internships, err := h.internshipService.ListDrafts(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, internships)
+}
+
+// @Summary Submit an internship for review
+// @Description Submit a draft internship, or the pending revision of a published one, for admin review
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Success 200 {object} dto.InternshipResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/submit [post]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) SubmitInternship(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	internship, err := h.internshipService.Submit(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, internship)
+}
+
+// @Summary Approve an internship
+// @Description Publish an internship under review, or apply its pending revision to the live version
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Success 200 {object} dto.InternshipResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/approve [post]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) ApproveInternship(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	internship, err := h.internshipService.Approve(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, internship)
+}
+
+// @Summary Reject an internship
+// @Description Send an internship or its pending revision back to the author with a comment
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param reject body dto.RejectInternshipRequest true "Review comment"
+// @Success 200 {object} dto.InternshipResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/reject [post]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) RejectInternship(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.RejectInternshipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	internship, err := h.internshipService.Reject(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, internship)
+}
+
+// @Summary Search internships
+// @Description Full-text search over published internships ranked by relevance, with facet counts by category, level, mode, duration and price
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipSearchFilter true "Search options"
+// @Success 200 {object} dto.InternshipSearchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/search [get]
+func (h *InternshipHandler) SearchInternships(c *gin.Context) {
+	filter := types.NewInternshipSearchFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	results, err := h.internshipService.Search(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// @Summary List my internships
+// @Description List the internships the caller authored or teaches as an assigned instructor, in any editorial state
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipFilter true "Filter options"
+// @Success 200 {object} dto.ListInternshipResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /instructor/internships [get]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) ListMyInternships(c *gin.Context) {
+	filter := types.NewInternshipFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	internships, err := h.internshipService.ListMine(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, internships)
+}
@@ -0,0 +1,394 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type InternshipApplicationHandler struct {
+	applicationService service.InternshipApplicationService
+	logger             *logger.Logger
+}
+
+func NewInternshipApplicationHandler(applicationService service.InternshipApplicationService, logger *logger.Logger) *InternshipApplicationHandler {
+	return &InternshipApplicationHandler{
+		applicationService: applicationService,
+		logger:             logger,
+	}
+}
+
+// @Summary Update the application form
+// @Description Turn screening of an internship on or off and set the questions applicants answer, only owners and admins can do this
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param request body dto.UpdateApplicationFormRequest true "Application settings"
+// @Success 200 {object} dto.ApplicationFormResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/application-form [put]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) UpdateApplicationForm(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateApplicationFormRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	form, err := h.applicationService.UpdateForm(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, form)
+}
+
+// @Summary List the applications to an internship
+// @Description List the applications to an internship, only instructors and admins can do this
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param filter query types.InternshipApplicationFilter false "Filter"
+// @Success 200 {object} dto.ListApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/applications [get]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) ListApplications(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewInternshipApplicationFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	applications, err := h.applicationService.List(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, applications)
+}
+
+// @Summary Apply to a batch
+// @Description Apply to a batch of a selective internship with answers to its application form and a resume
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param request body dto.SubmitApplicationRequest true "Application"
+// @Success 201 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications [post]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) SubmitApplication(c *gin.Context) {
+	var req dto.SubmitApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.Submit(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application)
+}
+
+// @Summary List my applications
+// @Description List the applications of the current student
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipApplicationFilter false "Filter"
+// @Success 200 {object} dto.ListApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications [get]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) ListMyApplications(c *gin.Context) {
+	filter := types.NewInternshipApplicationFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	applications, err := h.applicationService.ListMine(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, applications)
+}
+
+// @Summary Get an application
+// @Description Get an application. Available to the applicant and instructors of the internship
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications/{id} [get]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) GetApplication(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("application id is required").
+			WithHint("Application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// @Summary Shortlist an application
+// @Description Shortlist a submitted application, only instructors and admins can do this
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body dto.ReviewApplicationRequest true "Note for the applicant"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications/{id}/shortlist [post]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) ShortlistApplication(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("application id is required").
+			WithHint("Application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ReviewApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.Shortlist(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// @Summary Schedule an interview
+// @Description Invite the applicant to an interview or reschedule it, only instructors and admins can do this
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body dto.ScheduleInterviewRequest true "Interview"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications/{id}/interview [post]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) ScheduleInterview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("application id is required").
+			WithHint("Application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ScheduleInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.ScheduleInterview(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// @Summary Accept an application
+// @Description Accept an application, the applicant may enroll in the batch until the offer expires. Only instructors and admins can do this
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body dto.ReviewApplicationRequest true "Note for the applicant"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications/{id}/accept [post]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) AcceptApplication(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("application id is required").
+			WithHint("Application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ReviewApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.Accept(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// @Summary Reject an application
+// @Description Reject an application, only instructors and admins can do this
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Param request body dto.ReviewApplicationRequest true "Note for the applicant"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications/{id}/reject [post]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) RejectApplication(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("application id is required").
+			WithHint("Application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ReviewApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.Reject(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// @Summary Withdraw an application
+// @Description Withdraw an application of the current student, they may apply to the batch again
+// @Tags InternshipApplication
+// @Accept json
+// @Produce json
+// @Param id path string true "Application ID"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /applications/{id}/withdraw [post]
+// @Security ApiKeyAuth
+func (h *InternshipApplicationHandler) WithdrawApplication(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("application id is required").
+			WithHint("Application ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	application, err := h.applicationService.Withdraw(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
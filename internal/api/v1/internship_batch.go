@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type InternshipBatchHandler struct {
+	internshipBatchService service.InternshipBatchService
+	logger                 *logger.Logger
+}
+
+func NewInternshipBatchHandler(internshipBatchService service.InternshipBatchService, logger *logger.Logger) *InternshipBatchHandler {
+	return &InternshipBatchHandler{
+		internshipBatchService: internshipBatchService,
+		logger:                 logger,
+	}
+}
+
+// @Summary Get an internship batch by ID
+// @Description Get an internship batch by its unique identifier
+// @Tags InternshipBatch
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Success 200 {object} dto.InternshipBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id} [get]
+func (h *InternshipBatchHandler) GetInternshipBatch(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	batch, err := h.internshipBatchService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, batch)
+}
+
+// @Summary List internship batches
+// @Description List internship batches with filtering
+// @Tags InternshipBatch
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipBatchFilter true "Filter options"
+// @Success 200 {object} dto.ListInternshipBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches [get]
+func (h *InternshipBatchHandler) ListInternshipBatches(c *gin.Context) {
+	filter := types.NewInternshipBatchFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	batches, err := h.internshipBatchService.List(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, batches)
+}
+
+// @Summary List my internship batches
+// @Description List the batches of the internships the caller authored or teaches as an assigned instructor
+// @Tags InternshipBatch
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipBatchFilter true "Filter options"
+// @Success 200 {object} dto.ListInternshipBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /instructor/batches [get]
+// @Security ApiKeyAuth
+func (h *InternshipBatchHandler) ListMyInternshipBatches(c *gin.Context) {
+	filter := types.NewInternshipBatchFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	batches, err := h.internshipBatchService.ListMine(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, batches)
+}
+
+// @Summary Pause an internship batch
+// @Description Hold an upcoming or ongoing batch in place, enrollment stays closed while paused
+// @Tags InternshipBatch
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Success 200 {object} dto.InternshipBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id}/pause [post]
+// @Security ApiKeyAuth
+func (h *InternshipBatchHandler) PauseInternshipBatch(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	batch, err := h.internshipBatchService.Pause(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, batch)
+}
+
+// @Summary Resume an internship batch
+// @Description Put a paused batch back on the lifecycle its dates dictate
+// @Tags InternshipBatch
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Success 200 {object} dto.InternshipBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id}/resume [post]
+// @Security ApiKeyAuth
+func (h *InternshipBatchHandler) ResumeInternshipBatch(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	batch, err := h.internshipBatchService.Resume(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, batch)
+}
+
+// @Summary Cancel an internship batch
+// @Description Cancel a batch and refund every enrollment of it, cancelling a cancelled batch again retries its failed refunds
+// @Tags InternshipBatch
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Param cancel body dto.CancelInternshipBatchRequest true "Cancellation details"
+// @Success 200 {object} dto.CancelInternshipBatchResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id}/cancel [post]
+// @Security ApiKeyAuth
+func (h *InternshipBatchHandler) CancelInternshipBatch(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CancelInternshipBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.internshipBatchService.Cancel(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type InternshipInstructorHandler struct {
+	internshipInstructorService service.InternshipInstructorService
+	logger                      *logger.Logger
+}
+
+func NewInternshipInstructorHandler(internshipInstructorService service.InternshipInstructorService, logger *logger.Logger) *InternshipInstructorHandler {
+	return &InternshipInstructorHandler{
+		internshipInstructorService: internshipInstructorService,
+		logger:                      logger,
+	}
+}
+
+// @Summary List internship instructors
+// @Description List the instructors assigned to an internship with their roles
+// @Tags InternshipInstructor
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param filter query types.InternshipInstructorFilter true "Filter options"
+// @Success 200 {object} dto.ListInternshipInstructorResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/instructors [get]
+func (h *InternshipInstructorHandler) ListInstructors(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewInternshipInstructorFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	instructors, err := h.internshipInstructorService.List(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, instructors)
+}
+
+// @Summary Add an internship instructor
+// @Description Assign an instructor to an internship as owner, co-instructor or TA, only owners and admins can do this
+// @Tags InternshipInstructor
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param request body dto.AddInternshipInstructorRequest true "Instructor to add"
+// @Success 201 {object} dto.InternshipInstructorResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/instructors [post]
+// @Security ApiKeyAuth
+func (h *InternshipInstructorHandler) AddInstructor(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.AddInternshipInstructorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	instructor, err := h.internshipInstructorService.Add(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, instructor)
+}
+
+// @Summary Change an internship instructor's role
+// @Description Change the role of an instructor on an internship, the last owner can't be demoted
+// @Tags InternshipInstructor
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param user_id path string true "Instructor user ID"
+// @Param request body dto.UpdateInternshipInstructorRequest true "New role"
+// @Success 200 {object} dto.InternshipInstructorResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/instructors/{user_id} [put]
+// @Security ApiKeyAuth
+func (h *InternshipInstructorHandler) UpdateInstructor(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.Param("user_id")
+
+	if id == "" || userID == "" {
+		c.Error(ierr.NewError("internship id and user id are required").
+			WithHint("Internship ID and user ID are required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateInternshipInstructorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	instructor, err := h.internshipInstructorService.Update(c.Request.Context(), id, userID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, instructor)
+}
+
+// @Summary Remove an internship instructor
+// @Description Remove an instructor from an internship, the last owner can't be removed
+// @Tags InternshipInstructor
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param user_id path string true "Instructor user ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/instructors/{user_id} [delete]
+// @Security ApiKeyAuth
+func (h *InternshipInstructorHandler) RemoveInstructor(c *gin.Context) {
+	id := c.Param("id")
+	userID := c.Param("user_id")
+
+	if id == "" || userID == "" {
+		c.Error(ierr.NewError("internship id and user id are required").
+			WithHint("Internship ID and user ID are required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.internshipInstructorService.Remove(c.Request.Context(), id, userID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
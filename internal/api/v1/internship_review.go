@@ -0,0 +1,405 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type InternshipReviewHandler struct {
+	reviewService service.InternshipReviewService
+	logger        *logger.Logger
+}
+
+func NewInternshipReviewHandler(reviewService service.InternshipReviewService, logger *logger.Logger) *InternshipReviewHandler {
+	return &InternshipReviewHandler{
+		reviewService: reviewService,
+		logger:        logger,
+	}
+}
+
+// @Summary Review an internship
+// @Description Rate and review an internship, only students who completed it can review it and they review it once
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param request body dto.CreateReviewRequest true "Review"
+// @Success 201 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/reviews [post]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) CreateReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	review, err := h.reviewService.Create(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, review)
+}
+
+// @Summary List the reviews of an internship
+// @Description List the published reviews of an internship, sort by rating to rank them. Instructors of the internship and admins also see hidden reviews
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param filter query types.InternshipReviewFilter false "Filter"
+// @Success 200 {object} dto.ListReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/reviews [get]
+func (h *InternshipReviewHandler) ListInternshipReviews(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewInternshipReviewFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	reviews, err := h.reviewService.List(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reviews)
+}
+
+// @Summary List all reviews
+// @Description List the reviews of every internship for moderation, filter by reported to work through reported reviews, only admins can do this
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipReviewFilter false "Filter"
+// @Success 200 {object} dto.ListReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews [get]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) ListReviews(c *gin.Context) {
+	filter := types.NewInternshipReviewFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	reviews, err := h.reviewService.ListAll(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reviews)
+}
+
+// @Summary Get a review
+// @Description Get a published review, hidden reviews are shown to their author, instructors of the internship and admins
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id} [get]
+func (h *InternshipReviewHandler) GetReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	review, err := h.reviewService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// @Summary Update a review
+// @Description Change the rating, title or body of your review
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body dto.UpdateReviewRequest true "Changes"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id} [put]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) UpdateReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	review, err := h.reviewService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// @Summary Delete a review
+// @Description Delete a review, its author and admins can do this
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id} [delete]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) DeleteReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.reviewService.Delete(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Reply to a review
+// @Description Reply to a review on behalf of the internship, replying again replaces the reply. Instructors of the internship and admins can do this
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body dto.ReplyReviewRequest true "Reply"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id}/reply [post]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) ReplyReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ReplyReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	review, err := h.reviewService.Reply(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// @Summary Report a review
+// @Description Flag a review for moderators, every user reports a review once
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body dto.ReportReviewRequest true "Report"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id}/report [post]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) ReportReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ReportReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	review, err := h.reviewService.Report(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// @Summary Hide a review
+// @Description Take a review off its internship and out of its rating, only admins can do this
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body dto.ModerateReviewRequest false "Moderation note"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id}/hide [post]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) HideReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ModerateReviewRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid request format").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	review, err := h.reviewService.Hide(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// @Summary Restore a review
+// @Description Show a hidden review again and dismiss its reports, only admins can do this
+// @Tags InternshipReview
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body dto.ModerateReviewRequest false "Moderation note"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /reviews/{id}/restore [post]
+// @Security ApiKeyAuth
+func (h *InternshipReviewHandler) RestoreReview(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("review id is required").
+			WithHint("Review ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ModerateReviewRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid request format").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	review, err := h.reviewService.Restore(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
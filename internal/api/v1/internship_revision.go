@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// @Summary List internship revisions
+// @Description List the change history of an internship, newest first
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param filter query types.InternshipRevisionFilter true "Filter options"
+// @Success 200 {object} dto.ListInternshipRevisionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/revisions [get]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) ListRevisions(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewInternshipRevisionFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	revisions, err := h.internshipService.ListRevisions(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+// @Summary Get an internship revision
+// @Description Get a revision of an internship, students can read the revision they enrolled under
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param revision_id path string true "Revision ID"
+// @Success 200 {object} dto.InternshipRevisionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/revisions/{revision_id} [get]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) GetRevision(c *gin.Context) {
+	id := c.Param("id")
+	revisionID := c.Param("revision_id")
+
+	if id == "" || revisionID == "" {
+		c.Error(ierr.NewError("internship id and revision id are required").
+			WithHint("Internship ID and revision ID are required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	revision, err := h.internshipService.GetRevision(c.Request.Context(), id, revisionID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, revision)
+}
+
+// @Summary Compare two internship revisions
+// @Description List the fields that changed between two revisions of an internship
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param request query dto.DiffInternshipRevisionsRequest true "Revisions to compare"
+// @Success 200 {object} dto.InternshipRevisionDiffResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/revisions/diff [get]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) DiffRevisions(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.DiffInternshipRevisionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	diff, err := h.internshipService.DiffRevisions(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// @Summary Restore an internship revision
+// @Description Restore the content of an older revision as a new revision, published internships get it as a pending revision
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Param revision_id path string true "Revision ID"
+// @Success 200 {object} dto.InternshipResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/revisions/{revision_id}/restore [post]
+// @Security ApiKeyAuth
+func (h *InternshipHandler) RestoreRevision(c *gin.Context) {
+	id := c.Param("id")
+	revisionID := c.Param("revision_id")
+
+	if id == "" || revisionID == "" {
+		c.Error(ierr.NewError("internship id and revision id are required").
+			WithHint("Internship ID and revision ID are required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	internship, err := h.internshipService.RestoreRevision(c.Request.Context(), id, revisionID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, internship)
+}
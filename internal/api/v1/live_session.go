@@ -0,0 +1,514 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/calendar"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type LiveSessionHandler struct {
+	liveSessionService service.LiveSessionService
+	logger             *logger.Logger
+}
+
+func NewLiveSessionHandler(liveSessionService service.LiveSessionService, logger *logger.Logger) *LiveSessionHandler {
+	return &LiveSessionHandler{
+		liveSessionService: liveSessionService,
+		logger:             logger,
+	}
+}
+
+// @Summary Schedule a live session
+// @Description Schedule a live session for a batch, only owners and admins can do this
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Param request body dto.CreateLiveSessionRequest true "Live session to schedule"
+// @Success 201 {object} dto.LiveSessionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id}/live-sessions [post]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) CreateLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateLiveSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	session, err := h.liveSessionService.Create(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+// @Summary List the live sessions of a batch
+// @Description List the schedule of a batch in the order the sessions take place. Available to students and instructors of the batch
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Param filter query types.LiveSessionFilter false "Filter"
+// @Success 200 {object} dto.ListLiveSessionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id}/live-sessions [get]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) ListLiveSessions(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewLiveSessionFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	sessions, err := h.liveSessionService.List(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// @Summary List the attendance of a batch
+// @Description List the attendance percentage of each enrollment of a batch, students only see their own
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Param filter query types.InternshipEnrollmentFilter false "Filter"
+// @Success 200 {object} dto.ListEnrollmentAttendanceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internship-batches/{id}/attendance [get]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) ListBatchAttendance(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewInternshipEnrollmentFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attendance, err := h.liveSessionService.ListBatchAttendance(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attendance)
+}
+
+// @Summary Get a live session
+// @Description Get a live session. Available to students and instructors of its batch
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Success 200 {object} dto.LiveSessionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id} [get]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) GetLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	session, err := h.liveSessionService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// @Summary Update a live session
+// @Description Reschedule a live session or change its links, only owners and admins can do this
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Param request body dto.UpdateLiveSessionRequest true "Fields to update"
+// @Success 200 {object} dto.LiveSessionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id} [put]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) UpdateLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateLiveSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	session, err := h.liveSessionService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// @Summary Cancel a live session
+// @Description Cancel a live session, it no longer counts towards attendance
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Success 200 {object} dto.LiveSessionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id}/cancel [post]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) CancelLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	session, err := h.liveSessionService.Cancel(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// @Summary Delete a live session
+// @Description Delete a live session, only owners and admins can do this
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id} [delete]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) DeleteLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.liveSessionService.Delete(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get the recording of a live session
+// @Description Get the link to the recording of a live session. Available to students and instructors of its batch
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Success 200 {object} dto.LiveSessionRecordingResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id}/recording [get]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) GetRecording(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	recording, err := h.liveSessionService.GetRecording(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, recording)
+}
+
+// @Summary Join a live session
+// @Description Record the current student joining a live session and get its meeting link
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Success 200 {object} dto.JoinLiveSessionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id}/join [post]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) JoinLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	join, err := h.liveSessionService.Join(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, join)
+}
+
+// @Summary Leave a live session
+// @Description Record the current student leaving a live session
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Success 200 {object} dto.AttendanceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id}/leave [post]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) LeaveLiveSession(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attendance, err := h.liveSessionService.Leave(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attendance)
+}
+
+// @Summary Mark attendance
+// @Description Mark the attendance of students of the batch for a live session, only instructors and admins can do this
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Param request body dto.MarkAttendanceRequest true "Attendance to record"
+// @Success 200 {object} dto.ListAttendanceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id}/attendance [put]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) MarkAttendance(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.MarkAttendanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attendance, err := h.liveSessionService.MarkAttendance(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attendance)
+}
+
+// @Summary List the attendance of a live session
+// @Description List the attendance of a live session, students only see their own
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Param id path string true "Live session ID"
+// @Param filter query types.SessionAttendanceFilter false "Filter"
+// @Success 200 {object} dto.ListAttendanceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/{id}/attendance [get]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) ListAttendance(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("live session id is required").
+			WithHint("Live session ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewSessionAttendanceFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attendance, err := h.liveSessionService.ListAttendance(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attendance)
+}
+
+// @Summary Get my calendar feed
+// @Description Get the private iCalendar feed link listing the upcoming sessions of the batches of the current student
+// @Tags LiveSession
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.CalendarFeedResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /live-sessions/calendar [get]
+// @Security ApiKeyAuth
+func (h *LiveSessionHandler) GetCalendarFeed(c *gin.Context) {
+	feed, err := h.liveSessionService.GetCalendarFeed(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feed)
+}
+
+// @Summary Get a calendar feed
+// @Description Render the upcoming live sessions of a student as an iCalendar feed, the token of the feed stands in for authentication
+// @Tags LiveSession
+// @Accept json
+// @Produce text/calendar
+// @Param id path string true "User ID"
+// @Param token query string true "Feed token"
+// @Success 200 {string} string
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /calendars/{id}/sessions.ics [get]
+func (h *LiveSessionHandler) RenderCalendar(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("user id is required").
+			WithHint("User ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	body, err := h.liveSessionService.RenderCalendar(c.Request.Context(), id, c.Query("token"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, calendar.ContentType, body)
+}
@@ -0,0 +1,235 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/config"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type NotificationHandler struct {
+	notificationService service.NotificationService
+	heartbeat           time.Duration
+	logger              *logger.Logger
+}
+
+func NewNotificationHandler(notificationService service.NotificationService, cfg *config.Configuration, logger *logger.Logger) *NotificationHandler {
+	heartbeat := cfg.Notification.StreamHeartbeat
+	if heartbeat <= 0 {
+		heartbeat = 30 * time.Second
+	}
+
+	return &NotificationHandler{
+		notificationService: notificationService,
+		heartbeat:           heartbeat,
+		logger:              logger,
+	}
+}
+
+// @Summary List your notifications
+// @Description List your in-app notifications newest first, with how many are unread
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param filter query types.NotificationFilter false "Filter"
+// @Success 200 {object} dto.ListNotificationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /notifications [get]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	filter := types.NewNotificationFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	notifications, err := h.notificationService.List(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, notifications)
+}
+
+// @Summary Stream your notifications
+// @Description Receive your in-app notifications as they are created over Server-Sent Events. Each one is a "notification" event whose data is the notification, idle streams get comment heartbeats. Notifications created while disconnected are listed from GET /notifications
+// @Tags Notifications
+// @Produce text/event-stream
+// @Success 200 {object} dto.NotificationResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Router /notifications/stream [get]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) StreamNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	notifications, unsubscribe := h.notificationService.Subscribe(ctx)
+	defer unsubscribe()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// proxies such as nginx would otherwise hold events back
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case notification, ok := <-notifications:
+			if !ok {
+				return false
+			}
+			c.SSEvent("notification", &dto.NotificationResponse{Notification: *notification})
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			return err == nil
+		}
+	})
+}
+
+// @Summary Mark a notification read
+// @Description Mark one of your notifications read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} dto.NotificationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /notifications/{id}/read [post]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("notification id is required").
+			WithHint("Notification ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	notification, err := h.notificationService.MarkRead(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, notification)
+}
+
+// @Summary Mark all notifications read
+// @Description Mark every one of your unread notifications read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.MarkAllNotificationsReadResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /notifications/read-all [post]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	response, err := h.notificationService.MarkAllRead(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete a notification
+// @Description Delete one of your notifications
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /notifications/{id} [delete]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) DeleteNotification(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("notification id is required").
+			WithHint("Notification ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.notificationService.Delete(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get your notification preferences
+// @Description Get the locale you are notified in and the categories you muted
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /notifications/preferences [get]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	preferences, err := h.notificationService.GetPreferences(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// @Summary Update your notification preferences
+// @Description Change the locale you are notified in, turn emails off or mute categories of notifications
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateNotificationPreferencesRequest true "Preferences to change"
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /notifications/preferences [put]
+// @Security ApiKeyAuth
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	preferences, err := h.notificationService.UpdatePreferences(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+)
+
+type PaymentHandler struct {
+	paymentService service.PaymentService
+	logger         *logger.Logger
+}
+
+func NewPaymentHandler(paymentService service.PaymentService, logger *logger.Logger) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+		logger:         logger,
+	}
+}
+
+// @Summary Refund a payment
+// @Description Refund a successful payment to its source or to the payer's wallet
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Param request body dto.RefundPaymentRequest true "Refund options"
+// @Success 200 {object} dto.PaymentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /payments/{id}/refund [post]
+// @Security ApiKeyAuth
+func (h *PaymentHandler) Refund(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("payment id is required").
+			WithHint("Payment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.RefundPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	payment, err := h.paymentService.Refund(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, payment)
+}
@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type PaymentPlanHandler struct {
+	paymentPlanService service.PaymentPlanService
+	logger             *logger.Logger
+}
+
+func NewPaymentPlanHandler(paymentPlanService service.PaymentPlanService, logger *logger.Logger) *PaymentPlanHandler {
+	return &PaymentPlanHandler{
+		paymentPlanService: paymentPlanService,
+		logger:             logger,
+	}
+}
+
+// @Summary Create a payment plan
+// @Description Create an installment plan for an internship
+// @Tags PaymentPlan
+// @Accept json
+// @Produce json
+// @Param payment_plan body dto.CreatePaymentPlanRequest true "Payment plan details"
+// @Success 201 {object} dto.PaymentPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /payment-plans [post]
+// @Security ApiKeyAuth
+func (h *PaymentPlanHandler) CreatePaymentPlan(c *gin.Context) {
+	var req dto.CreatePaymentPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	plan, err := h.paymentPlanService.Create(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, plan)
+}
+
+// @Summary Get a payment plan by ID
+// @Description Get a payment plan by its unique identifier
+// @Tags PaymentPlan
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment plan ID"
+// @Success 200 {object} dto.PaymentPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /payment-plans/{id} [get]
+func (h *PaymentPlanHandler) GetPaymentPlan(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("payment plan id is required").
+			WithHint("Payment plan ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	plan, err := h.paymentPlanService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// @Summary Update a payment plan by ID
+// @Description Update a payment plan, installments can't be changed once created
+// @Tags PaymentPlan
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment plan ID"
+// @Param payment_plan body dto.UpdatePaymentPlanRequest true "Payment plan details"
+// @Success 200 {object} dto.PaymentPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /payment-plans/{id} [put]
+// @Security ApiKeyAuth
+func (h *PaymentPlanHandler) UpdatePaymentPlan(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("payment plan id is required").
+			WithHint("Payment plan ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdatePaymentPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	plan, err := h.paymentPlanService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// @Summary Delete a payment plan by ID
+// @Description Delete a payment plan, enrollments already scheduled against it are unaffected
+// @Tags PaymentPlan
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment plan ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /payment-plans/{id} [delete]
+// @Security ApiKeyAuth
+func (h *PaymentPlanHandler) DeletePaymentPlan(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("payment plan id is required").
+			WithHint("Payment plan ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.paymentPlanService.Delete(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary List payment plans
+// @Description List payment plans, only active plans are visible to non-admins
+// @Tags PaymentPlan
+// @Accept json
+// @Produce json
+// @Param filter query types.PaymentPlanFilter true "Filter options"
+// @Success 200 {object} dto.ListPaymentPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /payment-plans [get]
+func (h *PaymentPlanHandler) ListPaymentPlans(c *gin.Context) {
+	filter := types.NewPaymentPlanFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	plans, err := h.paymentPlanService.List(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plans)
+}
+
+// @Summary Get enrollment installments
+// @Description Get the installment schedule and content access of an enrollment
+// @Tags PaymentPlan
+// @Accept json
+// @Produce json
+// @Param id path string true "Enrollment ID"
+// @Success 200 {object} dto.EnrollmentInstallmentsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /enrollments/{id}/installments [get]
+// @Security ApiKeyAuth
+func (h *PaymentPlanHandler) GetEnrollmentInstallments(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("enrollment id is required").
+			WithHint("Enrollment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	installments, err := h.paymentPlanService.GetEnrollmentInstallments(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, installments)
+}
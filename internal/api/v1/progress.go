@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type ProgressHandler struct {
+	progressService service.ProgressService
+	logger          *logger.Logger
+}
+
+func NewProgressHandler(progressService service.ProgressService, logger *logger.Logger) *ProgressHandler {
+	return &ProgressHandler{
+		progressService: progressService,
+		logger:          logger,
+	}
+}
+
+// @Summary Update lesson progress
+// @Description Record the video position of the current student in a lesson or mark it completed
+// @Tags Progress
+// @Accept json
+// @Produce json
+// @Param id path string true "Lesson ID"
+// @Param request body dto.UpdateLessonProgressRequest true "Progress in the lesson"
+// @Success 200 {object} dto.LessonProgressResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /lessons/{id}/progress [put]
+// @Security ApiKeyAuth
+func (h *ProgressHandler) UpdateLessonProgress(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("lesson id is required").
+			WithHint("Lesson ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateLessonProgressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	progress, err := h.progressService.UpdateLessonProgress(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// @Summary Get internship progress
+// @Description Get the progress of the current student through an internship and where to resume it
+// @Tags Progress
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship ID"
+// @Success 200 {object} dto.InternshipProgressResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/{id}/progress [get]
+// @Security ApiKeyAuth
+func (h *ProgressHandler) GetInternshipProgress(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	progress, err := h.progressService.GetInternshipProgress(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// @Summary List my progress
+// @Description List the progress of the current student in each of their enrollments
+// @Tags Progress
+// @Accept json
+// @Produce json
+// @Param filter query types.InternshipEnrollmentFilter true "Filter options"
+// @Success 200 {object} dto.ListEnrollmentProgressResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /progress [get]
+// @Security ApiKeyAuth
+func (h *ProgressHandler) ListMyProgress(c *gin.Context) {
+	filter := types.NewInternshipEnrollmentFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	progress, err := h.progressService.ListMyProgress(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// @Summary List batch progress
+// @Description List the progress of every student of a batch, only instructors of the internship and admins can do this
+// @Tags Progress
+// @Accept json
+// @Produce json
+// @Param id path string true "Internship batch ID"
+// @Param filter query types.InternshipEnrollmentFilter true "Filter options"
+// @Success 200 {object} dto.ListEnrollmentProgressResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /instructor/batches/{id}/progress [get]
+// @Security ApiKeyAuth
+func (h *ProgressHandler) ListBatchProgress(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("internship batch id is required").
+			WithHint("Internship batch ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewInternshipEnrollmentFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	progress, err := h.progressService.ListBatchProgress(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
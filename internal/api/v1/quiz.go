@@ -0,0 +1,314 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type QuizHandler struct {
+	quizService service.QuizService
+	logger      *logger.Logger
+}
+
+func NewQuizHandler(quizService service.QuizService, logger *logger.Logger) *QuizHandler {
+	return &QuizHandler{
+		quizService: quizService,
+		logger:      logger,
+	}
+}
+
+// @Summary Create a quiz
+// @Description Add a quiz with its question pool to a module, only owners and admins can do this
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Module ID"
+// @Param request body dto.CreateQuizRequest true "Quiz to create"
+// @Success 201 {object} dto.QuizResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /modules/{id}/quizzes [post]
+// @Security ApiKeyAuth
+func (h *QuizHandler) CreateQuiz(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("module id is required").
+			WithHint("Module ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateQuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	quiz, err := h.quizService.Create(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, quiz)
+}
+
+// @Summary Get a quiz
+// @Description Get a quiz, enrolled students see its settings and instructors its question pool
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz ID"
+// @Success 200 {object} dto.QuizResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quizzes/{id} [get]
+// @Security ApiKeyAuth
+func (h *QuizHandler) GetQuiz(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz id is required").
+			WithHint("Quiz ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	quiz, err := h.quizService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, quiz)
+}
+
+// @Summary Update a quiz
+// @Description Update a quiz or move it to another module of the same internship, attempts already started keep their questions
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz ID"
+// @Param request body dto.UpdateQuizRequest true "Quiz changes"
+// @Success 200 {object} dto.QuizResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quizzes/{id} [put]
+// @Security ApiKeyAuth
+func (h *QuizHandler) UpdateQuiz(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz id is required").
+			WithHint("Quiz ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateQuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	quiz, err := h.quizService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, quiz)
+}
+
+// @Summary Delete a quiz
+// @Description Delete a quiz
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quizzes/{id} [delete]
+// @Security ApiKeyAuth
+func (h *QuizHandler) DeleteQuiz(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz id is required").
+			WithHint("Quiz ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.quizService.Delete(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Start a quiz attempt
+// @Description Start an attempt at a quiz or resume the open one, the questions are returned without their answers
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz ID"
+// @Success 201 {object} dto.QuizAttemptResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quizzes/{id}/attempts [post]
+// @Security ApiKeyAuth
+func (h *QuizHandler) StartAttempt(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz id is required").
+			WithHint("Quiz ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attempt, err := h.quizService.StartAttempt(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, attempt)
+}
+
+// @Summary List quiz attempts
+// @Description List the attempts at a quiz, instructors see every student and students their own attempts
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz ID"
+// @Param filter query types.QuizAttemptFilter true "Filter options"
+// @Success 200 {object} dto.ListQuizAttemptResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quizzes/{id}/attempts [get]
+// @Security ApiKeyAuth
+func (h *QuizHandler) ListAttempts(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz id is required").
+			WithHint("Quiz ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewQuizAttemptFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attempts, err := h.quizService.ListAttempts(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
+
+// @Summary Get a quiz attempt
+// @Description Get a quiz attempt, answers are only revealed once it is over. Available to the student and instructors of the internship
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz attempt ID"
+// @Success 200 {object} dto.QuizAttemptResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quiz-attempts/{id} [get]
+// @Security ApiKeyAuth
+func (h *QuizHandler) GetAttempt(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz attempt id is required").
+			WithHint("Quiz attempt ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attempt, err := h.quizService.GetAttempt(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempt)
+}
+
+// @Summary Submit a quiz attempt
+// @Description Submit the answers of an open attempt to be graded, attempts past their time limit are not accepted
+// @Tags Quiz
+// @Accept json
+// @Produce json
+// @Param id path string true "Quiz attempt ID"
+// @Param request body dto.SubmitQuizAttemptRequest true "Answers"
+// @Success 200 {object} dto.QuizAttemptResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quiz-attempts/{id}/submit [post]
+// @Security ApiKeyAuth
+func (h *QuizHandler) SubmitAttempt(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("quiz attempt id is required").
+			WithHint("Quiz attempt ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.SubmitQuizAttemptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	attempt, err := h.quizService.SubmitAttempt(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempt)
+}
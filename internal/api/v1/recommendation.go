@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type RecommendationHandler struct {
+	recommendationService service.RecommendationService
+	logger                *logger.Logger
+}
+
+func NewRecommendationHandler(recommendationService service.RecommendationService, logger *logger.Logger) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+		logger:                logger,
+	}
+}
+
+// @Summary Recommend internships
+// @Description Rank published internships for the current user by the skills on their profile, the internships they completed, the next level up and popularity. Each recommendation explains why it was made
+// @Tags Internship
+// @Accept json
+// @Produce json
+// @Param filter query types.RecommendationFilter true "Filter options"
+// @Success 200 {object} dto.ListRecommendationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /internships/recommendations [get]
+// @Security ApiKeyAuth
+func (h *RecommendationHandler) ListRecommendations(c *gin.Context) {
+	filter := types.NewRecommendationFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	recommendations, err := h.recommendationService.Recommend(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, recommendations)
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type ReferralHandler struct {
+	referralService service.ReferralService
+	logger          *logger.Logger
+}
+
+func NewReferralHandler(referralService service.ReferralService, logger *logger.Logger) *ReferralHandler {
+	return &ReferralHandler{
+		referralService: referralService,
+		logger:          logger,
+	}
+}
+
+// @Summary Get my referral summary
+// @Description Get the current user's referral code and referral counts by status
+// @Tags Referral
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ReferralSummaryResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /referrals/me [get]
+// @Security ApiKeyAuth
+func (h *ReferralHandler) GetMySummary(c *gin.Context) {
+	summary, err := h.referralService.GetSummary(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// @Summary List referrals
+// @Description List referrals made by the current user, or all referrals for admins
+// @Tags Referral
+// @Accept json
+// @Produce json
+// @Param filter query types.ReferralFilter true "Filter options"
+// @Success 200 {object} dto.ListReferralResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /referrals [get]
+// @Security ApiKeyAuth
+func (h *ReferralHandler) ListReferrals(c *gin.Context) {
+	filter := types.NewReferralFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	referrals, err := h.referralService.List(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, referrals)
+}
@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type SubmissionHandler struct {
+	submissionService service.SubmissionService
+	logger            *logger.Logger
+}
+
+func NewSubmissionHandler(submissionService service.SubmissionService, logger *logger.Logger) *SubmissionHandler {
+	return &SubmissionHandler{
+		submissionService: submissionService,
+		logger:            logger,
+	}
+}
+
+// @Summary Submit an assignment
+// @Description Hand in work for an assignment as an uploaded file, a GitHub repository or text, only available to enrolled students
+// @Tags Submission
+// @Accept json
+// @Produce json
+// @Param id path string true "Assignment ID"
+// @Param request body dto.CreateSubmissionRequest true "Work to submit"
+// @Success 201 {object} dto.SubmissionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /assignments/{id}/submissions [post]
+// @Security ApiKeyAuth
+func (h *SubmissionHandler) Submit(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("assignment id is required").
+			WithHint("Assignment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateSubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	submission, err := h.submissionService.Submit(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, submission)
+}
+
+// @Summary List submissions
+// @Description List the submissions for an assignment, instructors see every student and students their own attempts
+// @Tags Submission
+// @Accept json
+// @Produce json
+// @Param id path string true "Assignment ID"
+// @Param filter query types.SubmissionFilter true "Filter options"
+// @Success 200 {object} dto.ListSubmissionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /assignments/{id}/submissions [get]
+// @Security ApiKeyAuth
+func (h *SubmissionHandler) ListSubmissions(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("assignment id is required").
+			WithHint("Assignment ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	filter := types.NewSubmissionFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	submissions, err := h.submissionService.List(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submissions)
+}
+
+// @Summary Get a submission
+// @Description Get a submission with its grade, available to the student who submitted it and instructors of the internship
+// @Tags Submission
+// @Accept json
+// @Produce json
+// @Param id path string true "Submission ID"
+// @Success 200 {object} dto.SubmissionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /submissions/{id} [get]
+// @Security ApiKeyAuth
+func (h *SubmissionHandler) GetSubmission(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("submission id is required").
+			WithHint("Submission ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	submission, err := h.submissionService.Get(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submission)
+}
+
+// @Summary Grade a submission
+// @Description Grade a submission with a score or rubric scores and feedback, only instructors of the internship and admins can do this
+// @Tags Submission
+// @Accept json
+// @Produce json
+// @Param id path string true "Submission ID"
+// @Param request body dto.GradeSubmissionRequest true "Grade"
+// @Success 200 {object} dto.SubmissionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /submissions/{id}/grade [post]
+// @Security ApiKeyAuth
+func (h *SubmissionHandler) GradeSubmission(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("submission id is required").
+			WithHint("Submission ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.GradeSubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	submission, err := h.submissionService.Grade(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, submission)
+}
@@ -0,0 +1,360 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type SubscriptionHandler struct {
+	subscriptionService service.SubscriptionService
+	logger              *logger.Logger
+}
+
+func NewSubscriptionHandler(subscriptionService service.SubscriptionService, logger *logger.Logger) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		subscriptionService: subscriptionService,
+		logger:              logger,
+	}
+}
+
+// @Summary Create a subscription plan
+// @Description Create a recurring plan granting access to every internship in its categories
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param subscription_plan body dto.CreateSubscriptionPlanRequest true "Subscription plan details"
+// @Success 201 {object} dto.SubscriptionPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscription-plans [post]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) CreatePlan(c *gin.Context) {
+	var req dto.CreateSubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	plan, err := h.subscriptionService.CreatePlan(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, plan)
+}
+
+// @Summary Get a subscription plan by ID
+// @Description Get a subscription plan by its unique identifier
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription plan ID"
+// @Success 200 {object} dto.SubscriptionPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscription-plans/{id} [get]
+func (h *SubscriptionHandler) GetPlan(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("subscription plan id is required").
+			WithHint("Subscription plan ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	plan, err := h.subscriptionService.GetPlan(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// @Summary Update a subscription plan by ID
+// @Description Update a subscription plan, price and billing period can't be changed once created
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription plan ID"
+// @Param subscription_plan body dto.UpdateSubscriptionPlanRequest true "Subscription plan details"
+// @Success 200 {object} dto.SubscriptionPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscription-plans/{id} [put]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) UpdatePlan(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("subscription plan id is required").
+			WithHint("Subscription plan ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateSubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	plan, err := h.subscriptionService.UpdatePlan(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// @Summary Delete a subscription plan by ID
+// @Description Delete a subscription plan that has no renewing subscriptions
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription plan ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscription-plans/{id} [delete]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) DeletePlan(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("subscription plan id is required").
+			WithHint("Subscription plan ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.subscriptionService.DeletePlan(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary List subscription plans
+// @Description List subscription plans, only active plans are visible to non-admins
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param filter query types.SubscriptionPlanFilter true "Filter options"
+// @Success 200 {object} dto.ListSubscriptionPlanResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscription-plans [get]
+func (h *SubscriptionHandler) ListPlans(c *gin.Context) {
+	filter := types.NewSubscriptionPlanFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	plans, err := h.subscriptionService.ListPlans(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, plans)
+}
+
+// @Summary Subscribe to a plan
+// @Description Start a subscription, it activates once recurring charges are authorized at the checkout URL
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param subscription body dto.CreateSubscriptionRequest true "Subscription details"
+// @Success 201 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions [post]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) Subscribe(c *gin.Context) {
+	var req dto.CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	sub, err := h.subscriptionService.Subscribe(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sub)
+}
+
+// @Summary Get a subscription by ID
+// @Description Get a subscription of the current user
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id} [get]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) GetSubscription(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("subscription id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	sub, err := h.subscriptionService.GetSubscription(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
+
+// @Summary List subscriptions
+// @Description List subscriptions, students only see their own
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param filter query types.SubscriptionFilter true "Filter options"
+// @Success 200 {object} dto.ListSubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions [get]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) ListSubscriptions(c *gin.Context) {
+	filter := types.NewSubscriptionFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	subs, err := h.subscriptionService.ListSubscriptions(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, subs)
+}
+
+// @Summary Cancel a subscription
+// @Description Cancel a subscription, by default access continues until the paid period ends
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param cancel body dto.CancelSubscriptionRequest false "Cancellation options"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/cancel [post]
+// @Security ApiKeyAuth
+func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("subscription id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	// the body is optional, an empty one cancels at the end of the period
+	var req dto.CancelSubscriptionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Error("failed to bind request", "error", err)
+			c.Error(ierr.WithError(err).
+				WithHint("Failed to bind request").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	sub, err := h.subscriptionService.Cancel(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
+
+// @Summary Handle subscription webhook
+// @Description Receive subscription lifecycle events from a payment gateway
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param provider path string true "Payment gateway provider"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /webhooks/{provider}/subscriptions [post]
+func (h *SubscriptionHandler) HandleGatewayWebhook(c *gin.Context) {
+	payload, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to read webhook payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	headers := make(map[string]string, len(c.Request.Header))
+	for key := range c.Request.Header {
+		headers[key] = c.Request.Header.Get(key)
+	}
+
+	provider := types.PaymentGatewayProvider(c.Param("provider"))
+	if err := h.subscriptionService.ProcessGatewayWebhook(c.Request.Context(), provider, payload, headers); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
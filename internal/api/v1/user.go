@@ -36,7 +36,7 @@ func (h *UserHandler) Me(c *gin.Context) {
 }
 
 // @Summary Update current user
-// @Description Update the current user's information
+// @Description Update the current user's information and profile
 // @Tags User
 // @Accept json
 // @Produce json
@@ -61,3 +61,32 @@ func (h *UserHandler) Update(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, user)
 }
+
+// @Summary Get a user profile
+// @Description Get the profile of a user with the internships they completed and their certificates. Public profiles are open to anyone, recruiter-only profiles to signed in recruiters
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.ProfileResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /users/{id}/profile [get]
+func (h *UserHandler) GetProfile(c *gin.Context) {
+	id := c.Param("id")
+
+	if id == "" {
+		c.Error(ierr.NewError("user id is required").
+			WithHint("User ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	profile, err := h.userService.GetProfile(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, profile)
+}
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type WalletHandler struct {
+	walletService service.WalletService
+	logger        *logger.Logger
+}
+
+func NewWalletHandler(walletService service.WalletService, logger *logger.Logger) *WalletHandler {
+	return &WalletHandler{
+		walletService: walletService,
+		logger:        logger,
+	}
+}
+
+// @Summary Get my wallet balance
+// @Description Get the current user's spendable store credit
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param currency query string false "Currency, defaults to the platform currency"
+// @Success 200 {object} dto.WalletBalanceResponse
+// @Failure 401 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wallet/balance [get]
+// @Security ApiKeyAuth
+func (h *WalletHandler) GetBalance(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	balance, err := h.walletService.GetBalance(ctx, types.GetUserID(ctx), c.Query("currency"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, balance)
+}
+
+// @Summary List wallet transactions
+// @Description List the current user's wallet ledger, or all ledgers for admins
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param filter query types.WalletTransactionFilter true "Filter options"
+// @Success 200 {object} dto.ListWalletTransactionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wallet/transactions [get]
+// @Security ApiKeyAuth
+func (h *WalletHandler) ListTransactions(c *gin.Context) {
+	filter := types.NewWalletTransactionFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := filter.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	txns, err := h.walletService.ListTransactions(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, txns)
+}
+
+// @Summary Grant wallet credit
+// @Description Grant store credit to a user
+// @Tags Wallet
+// @Accept json
+// @Produce json
+// @Param request body dto.GrantWalletCreditRequest true "Credit grant"
+// @Success 201 {object} dto.WalletTransactionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wallet/credits [post]
+// @Security ApiKeyAuth
+func (h *WalletHandler) GrantCredit(c *gin.Context) {
+	var req dto.GrantWalletCreditRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind request").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	txn, err := h.walletService.GrantCredit(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, txn)
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type WishlistHandler struct {
+	wishlistService service.WishlistService
+	logger          *logger.Logger
+}
+
+func NewWishlistHandler(wishlistService service.WishlistService, logger *logger.Logger) *WishlistHandler {
+	return &WishlistHandler{
+		wishlistService: wishlistService,
+		logger:          logger,
+	}
+}
+
+// @Summary Save an internship
+// @Description Save a published internship to your wishlist, you are notified when it announces a batch, drops its price or goes on sale
+// @Tags Wishlist
+// @Accept json
+// @Produce json
+// @Param request body dto.AddWishlistRequest true "Internship to save"
+// @Success 201 {object} dto.WishlistResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wishlist [post]
+// @Security ApiKeyAuth
+func (h *WishlistHandler) AddToWishlist(c *gin.Context) {
+	var req dto.AddWishlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	item, err := h.wishlistService.Add(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, item)
+}
+
+// @Summary List your wishlist
+// @Description List the internships you saved, most recently saved first
+// @Tags Wishlist
+// @Accept json
+// @Produce json
+// @Param filter query types.WishlistFilter false "Filter"
+// @Success 200 {object} dto.ListWishlistResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wishlist [get]
+// @Security ApiKeyAuth
+func (h *WishlistHandler) ListWishlist(c *gin.Context) {
+	filter := types.NewWishlistFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	items, err := h.wishlistService.List(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+// @Summary Remove an internship from your wishlist
+// @Description Remove a saved internship from your wishlist
+// @Tags Wishlist
+// @Accept json
+// @Produce json
+// @Param internship_id path string true "Internship ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wishlist/{internship_id} [delete]
+// @Security ApiKeyAuth
+func (h *WishlistHandler) RemoveFromWishlist(c *gin.Context) {
+	internshipID := c.Param("internship_id")
+
+	if internshipID == "" {
+		c.Error(ierr.NewError("internship id is required").
+			WithHint("Internship ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.wishlistService.Remove(c.Request.Context(), internshipID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Wishlist demand
+// @Description List how many users saved each published internship next to its upcoming batches, most wishlisted first, to plan batches by demand. Only admins can do this
+// @Tags Wishlist
+// @Accept json
+// @Produce json
+// @Param filter query types.WishlistFilter false "Filter"
+// @Success 200 {object} dto.ListWishlistDemandResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 403 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /wishlist/demand [get]
+// @Security ApiKeyAuth
+func (h *WishlistHandler) ListDemand(c *gin.Context) {
+	filter := types.NewNoLimitWishlistFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to bind query").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	demand, err := h.wishlistService.ListDemand(c.Request.Context(), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, demand)
+}
@@ -38,6 +38,7 @@ func (p *EnrollmentBasedAccessPolicy) Applies(request *auth.AccessRequest) bool
 	contentPermissions := []auth.Permission{
 		auth.PermissionViewLectures,
 		auth.PermissionViewAssignments,
+		auth.PermissionViewQuizzes,
 		auth.PermissionViewResources,
 		auth.PermissionDownloadContent,
 		auth.PermissionViewInternship,
@@ -63,44 +64,125 @@ func (p *EnrollmentBasedAccessPolicy) Evaluate(ctx context.Context, request *aut
 		}, nil
 	}
 
-	// Get user's enrolled internships from context or attributes
-	enrolledInternships, exists := request.Subject.Attributes["enrolled_internships"]
-	if !exists {
+	internshipIDStr, ok := internshipID.(string)
+	if !ok {
 		return Decision{
 			Allow:  false,
-			Reason: "Student not enrolled in any internships",
+			Reason: "Invalid internship_id format",
+		}, nil
+	}
+
+	// Get user's enrolled internships from context or attributes
+	if enrolledInternships, exists := request.Subject.Attributes["enrolled_internships"]; exists {
+		enrolledList, ok := enrolledInternships.([]string)
+		if !ok {
+			return Decision{
+				Allow:  false,
+				Reason: "Invalid enrolled_internships format",
+			}, nil
+		}
+
+		// Check if student is enrolled in this internship
+		for _, enrolledID := range enrolledList {
+			if enrolledID == internshipIDStr {
+				return Decision{
+					Allow:  true,
+					Reason: "Student enrolled in internship",
+				}, nil
+			}
+		}
+	}
+
+	// An active subscription covering one of the internship's categories also grants access
+	if hasSubscribedCategory(request) {
+		return Decision{
+			Allow:  true,
+			Reason: "Student subscribed to internship category",
 		}, nil
 	}
 
-	enrolledList, ok := enrolledInternships.([]string)
+	return Decision{
+		Allow:  false,
+		Reason: "Student not enrolled in this internship",
+	}, nil
+}
+
+// hasSubscribedCategory checks the subject's subscribed_categories against the resource's category_ids
+func hasSubscribedCategory(request *auth.AccessRequest) bool {
+	subscribed, ok := request.Subject.Attributes["subscribed_categories"].([]string)
+	if !ok {
+		return false
+	}
+
+	categoryIDs, ok := request.Resource.Attributes["category_ids"].([]string)
+	if !ok {
+		return false
+	}
+
+	for _, categoryID := range categoryIDs {
+		for _, subscribedID := range subscribed {
+			if subscribedID == categoryID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CompletionBasedReviewPolicy - Students can only review internships they completed
+type CompletionBasedReviewPolicy struct{}
+
+// NewCompletionBasedReviewPolicy creates a new completion-based review policy
+func NewCompletionBasedReviewPolicy() Policy {
+	return &CompletionBasedReviewPolicy{}
+}
+
+// GetName returns the policy name
+func (p *CompletionBasedReviewPolicy) GetName() string {
+	return "CompletionBasedReview"
+}
+
+// GetPriority returns the policy priority
+func (p *CompletionBasedReviewPolicy) GetPriority() int {
+	return 100 // High priority, same as enrollment checks
+}
+
+// Applies checks if this policy is applicable to the request
+func (p *CompletionBasedReviewPolicy) Applies(request *auth.AccessRequest) bool {
+	return request.Action == auth.PermissionReviewInternship
+}
+
+// Evaluate evaluates the completion-based review policy
+func (p *CompletionBasedReviewPolicy) Evaluate(ctx context.Context, request *auth.AccessRequest) (Decision, error) {
+	internshipID, ok := request.Resource.Attributes["internship_id"].(string)
 	if !ok {
 		return Decision{
 			Allow:  false,
-			Reason: "Invalid enrolled_internships format",
+			Reason: "internship_id not found in resource attributes",
 		}, nil
 	}
 
-	internshipIDStr, ok := internshipID.(string)
+	completed, ok := request.Subject.Attributes["completed_internships"].([]string)
 	if !ok {
 		return Decision{
 			Allow:  false,
-			Reason: "Invalid internship_id format",
+			Reason: "User has no completed internships recorded",
 		}, nil
 	}
 
-	// Check if student is enrolled in this internship
-	for _, enrolledID := range enrolledList {
-		if enrolledID == internshipIDStr {
+	for _, completedID := range completed {
+		if completedID == internshipID {
 			return Decision{
 				Allow:  true,
-				Reason: "Student enrolled in internship",
+				Reason: "Student completed the internship",
 			}, nil
 		}
 	}
 
 	return Decision{
 		Allow:  false,
-		Reason: "Student not enrolled in this internship",
+		Reason: "Student has not completed this internship",
 	}, nil
 }
 
@@ -149,6 +231,23 @@ func (p *OwnershipPolicy) Evaluate(ctx context.Context, request *auth.AccessRequ
 		}, nil
 	}
 
+	// Owners assigned to the resource take precedence over its creator
+	if ownerIDs, ok := request.Resource.Attributes["owner_ids"].([]string); ok {
+		for _, ownerID := range ownerIDs {
+			if ownerID == request.Subject.UserID {
+				return Decision{
+					Allow:  true,
+					Reason: "User is an owner of the resource",
+				}, nil
+			}
+		}
+
+		return Decision{
+			Allow:  false,
+			Reason: "User is not an owner of the resource",
+		}, nil
+	}
+
 	// Check ownership
 	resourceOwner, exists := request.Resource.Attributes["created_by"]
 	if !exists {
@@ -296,6 +395,12 @@ func (p *ProgressBasedPolicy) Evaluate(ctx context.Context, request *auth.Access
 		}, nil
 	}
 
+	// Progress is loaded per internship, the resource names the internship it belongs to
+	if progressByInternship, ok := userProgress.(map[string]float64); ok {
+		internshipID, _ := request.Resource.Attributes["internship_id"].(string)
+		userProgress = progressByInternship[internshipID]
+	}
+
 	// Compare progress
 	userProgressFloat, ok1 := userProgress.(float64)
 	requiredProgressFloat, ok2 := requiredProgress.(float64)
@@ -3,6 +3,7 @@ package abac
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +30,9 @@ type Service interface {
 
 	// SetPolicyCombiner sets the strategy for combining policy decisions
 	SetPolicyCombiner(combiner PolicyCombiner)
+
+	// InvalidateUser drops the cached decisions of a user, call it when their enrollments or progress change
+	InvalidateUser(userID string)
 }
 
 // Policy interface for ABAC policies
@@ -99,7 +103,7 @@ func NewService(logger *logger.Logger) Service {
 		attributeProviders: make([]AttributeProvider, 0),
 		policyCombiner:     NewAllMustAllowCombiner(),
 		decisionCache:      make(map[string]CachedDecision),
-		cacheTTL:           5 * time.Minute, // Cache decisions for 5 minutes
+		cacheTTL:           30 * time.Second, // Loaded attributes go stale, keep decisions briefly
 	}
 
 	// Register default policies
@@ -110,8 +114,9 @@ func NewService(logger *logger.Logger) Service {
 
 // Evaluate evaluates all applicable policies for an access request
 func (s *service) Evaluate(ctx context.Context, request *auth.AccessRequest) (bool, error) {
-	// Check cache first
-	if cached, found := s.getCachedDecision(request); found {
+	// Check cache before loading attributes, the loaded attributes are not part of the key
+	cacheKey := s.buildCacheKey(request)
+	if cached, found := s.getCachedDecision(cacheKey); found {
 		s.logger.Debugw("ABAC decision served from cache",
 			"user_id", request.Subject.UserID,
 			"action", request.Action,
@@ -120,7 +125,7 @@ func (s *service) Evaluate(ctx context.Context, request *auth.AccessRequest) (bo
 		return cached.Allow, nil
 	}
 
-	// Load additional attributes if needed
+	// Load additional attributes
 	if err := s.enrichRequestWithAttributes(ctx, request); err != nil {
 		s.logger.Errorw("Failed to load attributes for ABAC evaluation",
 			"error", err,
@@ -166,7 +171,7 @@ func (s *service) Evaluate(ctx context.Context, request *auth.AccessRequest) (bo
 			Allow:  true,
 			Reason: "No applicable ABAC policies",
 		}
-		s.cacheDecision(request, finalDecision)
+		s.cacheDecision(cacheKey, finalDecision)
 		return true, nil
 	}
 
@@ -174,7 +179,7 @@ func (s *service) Evaluate(ctx context.Context, request *auth.AccessRequest) (bo
 	finalDecision := s.policyCombiner.Combine(decisions)
 
 	// Cache the decision
-	s.cacheDecision(request, finalDecision)
+	s.cacheDecision(cacheKey, finalDecision)
 
 	s.logger.Infow("ABAC evaluation completed",
 		"user_id", request.Subject.UserID,
@@ -276,6 +281,13 @@ func (s *service) enrichRequestWithAttributes(ctx context.Context, request *auth
 	copy(providers, s.attributeProviders)
 	s.mu.RUnlock()
 
+	if request.Subject.Attributes == nil {
+		request.Subject.Attributes = make(map[string]interface{})
+	}
+	if request.Resource.Attributes == nil {
+		request.Resource.Attributes = make(map[string]interface{})
+	}
+
 	for _, provider := range providers {
 		// Load user attributes
 		userAttrs, err := provider.LoadUserAttributes(ctx, request.Subject.UserID)
@@ -319,11 +331,10 @@ func (s *service) enrichRequestWithAttributes(ctx context.Context, request *auth
 }
 
 // getCachedDecision retrieves a cached decision if available and not expired
-func (s *service) getCachedDecision(request *auth.AccessRequest) (Decision, bool) {
+func (s *service) getCachedDecision(cacheKey string) (Decision, bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	cacheKey := s.buildCacheKey(request)
 	cached, exists := s.decisionCache[cacheKey]
 
 	if !exists || time.Now().After(cached.ExpiresAt) {
@@ -334,11 +345,10 @@ func (s *service) getCachedDecision(request *auth.AccessRequest) (Decision, bool
 }
 
 // cacheDecision stores a decision in cache
-func (s *service) cacheDecision(request *auth.AccessRequest, decision Decision) {
+func (s *service) cacheDecision(cacheKey string, decision Decision) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	cacheKey := s.buildCacheKey(request)
 	s.decisionCache[cacheKey] = CachedDecision{
 		Decision:  decision,
 		ExpiresAt: time.Now().Add(s.cacheTTL),
@@ -351,12 +361,32 @@ func (s *service) cacheDecision(request *auth.AccessRequest, decision Decision)
 }
 
 // buildCacheKey creates a cache key for a request
+//
+// The key is built before the attribute providers ran, so it holds the attributes the caller
+// passed in, such as the progress a lesson requires, but not enrollments or progress. Those are
+// covered by the short TTL and by InvalidateUser. fmt prints maps in key order, which keeps the key stable.
 func (s *service) buildCacheKey(request *auth.AccessRequest) string {
-	return fmt.Sprintf("%s:%s:%s:%s",
+	return fmt.Sprintf("%s:%s:%s:%s:%s:%v:%v",
 		request.Subject.UserID,
+		request.Subject.Role,
 		request.Action,
 		request.Resource.Type,
-		request.Resource.ID)
+		request.Resource.ID,
+		request.Subject.Attributes,
+		request.Resource.Attributes)
+}
+
+// InvalidateUser drops the cached decisions of a user
+func (s *service) InvalidateUser(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := userID + ":"
+	for key := range s.decisionCache {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.decisionCache, key)
+		}
+	}
 }
 
 // cleanExpiredCacheEntries removes expired cache entries
@@ -376,6 +406,7 @@ func (s *service) registerDefaultPolicies() {
 		NewOwnershipPolicy(),
 		NewTimeBasedAccessPolicy(),
 		NewProgressBasedPolicy(),
+		NewCompletionBasedReviewPolicy(),
 	}
 
 	for _, policy := range defaultPolicies {
@@ -153,6 +153,7 @@ func initializeRolePermissions() map[types.UserRole][]auth.Permission {
 			auth.PermissionPublishInternship,
 			auth.PermissionViewLectures,
 			auth.PermissionViewAssignments,
+			auth.PermissionViewQuizzes,
 			auth.PermissionViewResources,
 			auth.PermissionDownloadContent,
 			auth.PermissionManageUsers,
@@ -164,17 +165,23 @@ func initializeRolePermissions() map[types.UserRole][]auth.Permission {
 			// Instructor permissions
 			auth.PermissionCreateInternship,
 			auth.PermissionUpdateInternship,
+			auth.PermissionDeleteInternship,
 			auth.PermissionViewInternship,
-			auth.PermissionPublishInternship,
 			auth.PermissionViewLectures,
 			auth.PermissionViewAssignments,
+			auth.PermissionViewQuizzes,
 			auth.PermissionViewResources,
 			auth.PermissionDownloadContent,
 			auth.PermissionViewAnalytics, // Can view their own analytics
 		},
 		types.UserRoleStudent: {
 			// Student permissions - very limited
-			auth.PermissionViewInternship, // Can view internships they're enrolled in
+			auth.PermissionViewInternship,   // Can view internships they're enrolled in
+			auth.PermissionReviewInternship, // Can review internships they completed
+		},
+		types.UserRoleRecruiter: {
+			// Recruiter permissions - browse the catalog
+			auth.PermissionViewInternship,
 		},
 	}
 }
@@ -206,6 +213,7 @@ func (p *EnrollmentBasedAccessPolicy) Evaluate(ctx context.Context, request *aut
 	contentPermissions := []auth.Permission{
 		auth.PermissionViewLectures,
 		auth.PermissionViewAssignments,
+		auth.PermissionViewQuizzes,
 		auth.PermissionViewResources,
 		auth.PermissionDownloadContent,
 	}
@@ -228,30 +236,51 @@ func (p *EnrollmentBasedAccessPolicy) Evaluate(ctx context.Context, request *aut
 		return false, fmt.Errorf("internship_id not found in resource attributes")
 	}
 
+	internshipIDStr, ok := internshipID.(string)
+	if !ok {
+		return false, fmt.Errorf("invalid internship_id format")
+	}
+
 	// Get user's enrolled internships from context or attributes
-	enrolledInternships, exists := request.Subject.Attributes["enrolled_internships"]
-	if !exists {
-		return false, nil // Student not enrolled in any internships
+	if enrolledInternships, exists := request.Subject.Attributes["enrolled_internships"]; exists {
+		enrolledList, ok := enrolledInternships.([]string)
+		if !ok {
+			return false, fmt.Errorf("invalid enrolled_internships format")
+		}
+
+		// Check if student is enrolled in this internship
+		for _, enrolledID := range enrolledList {
+			if enrolledID == internshipIDStr {
+				return true, nil
+			}
+		}
 	}
 
-	enrolledList, ok := enrolledInternships.([]string)
+	// An active subscription covering one of the internship's categories also grants access
+	return hasSubscribedCategory(request), nil
+}
+
+// hasSubscribedCategory checks the subject's subscribed_categories against the resource's category_ids
+func hasSubscribedCategory(request *auth.AccessRequest) bool {
+	subscribed, ok := request.Subject.Attributes["subscribed_categories"].([]string)
 	if !ok {
-		return false, fmt.Errorf("invalid enrolled_internships format")
+		return false
 	}
 
-	internshipIDStr, ok := internshipID.(string)
+	categoryIDs, ok := request.Resource.Attributes["category_ids"].([]string)
 	if !ok {
-		return false, fmt.Errorf("invalid internship_id format")
+		return false
 	}
 
-	// Check if student is enrolled in this internship
-	for _, enrolledID := range enrolledList {
-		if enrolledID == internshipIDStr {
-			return true, nil
+	for _, categoryID := range categoryIDs {
+		for _, subscribedID := range subscribed {
+			if subscribedID == categoryID {
+				return true
+			}
 		}
 	}
 
-	return false, nil // Student not enrolled in this internship
+	return false
 }
 
 // ContentAccessPolicy - Controls access to different types of content based on progress
@@ -360,6 +389,11 @@ func (p *OwnershipPolicy) Evaluate(ctx context.Context, request *auth.AccessRequ
 		return true, nil
 	}
 
+	// Owners assigned to the resource take precedence over its creator
+	if ownerIDs, ok := request.Resource.Attributes["owner_ids"].([]string); ok {
+		return isListedOwner(request.Subject.UserID, ownerIDs), nil
+	}
+
 	// Check ownership
 	resourceOwner, exists := request.Resource.Attributes["created_by"]
 	if !exists {
@@ -374,3 +408,13 @@ func (p *OwnershipPolicy) Evaluate(ctx context.Context, request *auth.AccessRequ
 	// User can only modify resources they created
 	return request.Subject.UserID == ownerID, nil
 }
+
+// isListedOwner checks if the user is one of the owners of a resource
+func isListedOwner(userID string, ownerIDs []string) bool {
+	for _, ownerID := range ownerIDs {
+		if ownerID == userID {
+			return true
+		}
+	}
+	return false
+}
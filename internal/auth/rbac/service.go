@@ -151,6 +151,7 @@ func initializeRolePermissions() map[types.UserRole][]auth.Permission {
 			// Content access
 			auth.PermissionViewLectures,
 			auth.PermissionViewAssignments,
+			auth.PermissionViewQuizzes,
 			auth.PermissionViewResources,
 			auth.PermissionDownloadContent,
 
@@ -170,11 +171,11 @@ func initializeRolePermissions() map[types.UserRole][]auth.Permission {
 			auth.PermissionUpdateInternship,
 			auth.PermissionDeleteInternship,
 			auth.PermissionViewInternship,
-			auth.PermissionPublishInternship,
 
 			// Content access
 			auth.PermissionViewLectures,
 			auth.PermissionViewAssignments,
+			auth.PermissionViewQuizzes,
 			auth.PermissionViewResources,
 			auth.PermissionDownloadContent,
 
@@ -190,8 +191,17 @@ func initializeRolePermissions() map[types.UserRole][]auth.Permission {
 			// Content access (filtered by ABAC for enrolled content only)
 			auth.PermissionViewLectures,
 			auth.PermissionViewAssignments,
+			auth.PermissionViewQuizzes,
 			auth.PermissionViewResources,
 			auth.PermissionDownloadContent,
+
+			// Reviews (filtered by ABAC for completed internships only)
+			auth.PermissionReviewInternship,
+		},
+
+		// RECRUITER: Browses the catalog and student profiles shared with recruiters
+		types.UserRoleRecruiter: {
+			auth.PermissionViewInternship,
 		},
 	}
 }
@@ -202,6 +212,7 @@ func ValidateRole(role types.UserRole) bool {
 		types.UserRoleAdmin:      true,
 		types.UserRoleInstructor: true,
 		types.UserRoleStudent:    true,
+		types.UserRoleRecruiter:  true,
 	}
 
 	if _, ok := roles[role]; ok {
@@ -212,12 +223,13 @@ func ValidateRole(role types.UserRole) bool {
 }
 
 // GetRoleHierarchy returns role hierarchy (for future use)
-// Admin > Instructor > Student
+// Admin > Instructor > Student, Recruiter
 func GetRoleHierarchy() map[types.UserRole]int {
 	return map[types.UserRole]int{
 		types.UserRoleAdmin:      3, // Highest level
 		types.UserRoleInstructor: 2, // Middle level
 		types.UserRoleStudent:    1, // Basic level
+		types.UserRoleRecruiter:  1, // Basic level
 	}
 }
 
@@ -24,6 +24,9 @@ type UnifiedAuthorizationService interface {
 	RegisterABACPolicy(policy abac.Policy) error
 	RegisterAttributeProvider(provider abac.AttributeProvider) error
 
+	// InvalidateUser drops the cached ABAC decisions of a user whose attributes changed
+	InvalidateUser(userID string)
+
 	// Access to underlying services
 	GetRBACService() rbac.Service
 	GetABACService() abac.Service
@@ -131,6 +134,11 @@ func (s *unifiedService) RegisterAttributeProvider(provider abac.AttributeProvid
 	return s.abacService.RegisterAttributeProvider(provider)
 }
 
+// InvalidateUser drops the cached ABAC decisions of a user whose attributes changed
+func (s *unifiedService) InvalidateUser(userID string) {
+	s.abacService.InvalidateUser(userID)
+}
+
 // GetRBACService returns the underlying RBAC service for direct access
 func (s *unifiedService) GetRBACService() rbac.Service {
 	return s.rbacService
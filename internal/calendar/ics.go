@@ -0,0 +1,114 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of rendered calendars
+const ContentType = "text/calendar; charset=utf-8"
+
+// dateTimeFormat is the UTC date-time form of RFC 5545
+const dateTimeFormat = "20060102T150405Z"
+
+// maxLineOctets is the longest a content line may be before it is folded
+const maxLineOctets = 75
+
+// Event is one entry of a calendar
+type Event struct {
+	// UID identifies the event across refreshes of the feed, it must stay the same for an event
+	UID         string
+	Summary     string
+	Description string
+	// URL is where the event takes place, such as the meeting link of an online class
+	URL       string
+	Start     time.Time
+	End       time.Time
+	UpdatedAt time.Time
+}
+
+// Calendar is a feed of events calendar apps can subscribe to
+type Calendar struct {
+	// ProductID names the application that produced the calendar
+	ProductID string
+	Name      string
+	Events    []Event
+}
+
+// Render returns the calendar in the iCalendar format of RFC 5545
+func (c *Calendar) Render() []byte {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+escapeText(c.ProductID))
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		writeLine(&buf, "BEGIN:VEVENT")
+		writeLine(&buf, "UID:"+escapeText(event.UID))
+		writeLine(&buf, "DTSTAMP:"+formatTime(event.UpdatedAt))
+		writeLine(&buf, "DTSTART:"+formatTime(event.Start))
+		writeLine(&buf, "DTEND:"+formatTime(event.End))
+		writeLine(&buf, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&buf, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.URL != "" {
+			writeLine(&buf, "URL:"+event.URL)
+			writeLine(&buf, "LOCATION:"+escapeText(event.URL))
+		}
+		writeLine(&buf, "END:VEVENT")
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// escapeText escapes the characters RFC 5545 reserves in text values
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// writeLine writes a content line, folding it into continuation lines that start with a
+// space once it grows past the line limit without splitting multi-byte characters
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+
+		// continuation lines lose an octet to the leading space
+		limit = maxLineOctets - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
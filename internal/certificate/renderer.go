@@ -0,0 +1,126 @@
+package certificate
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+)
+
+// Data is what a certificate shows
+type Data struct {
+	VerificationID  string
+	RecipientName   string
+	InternshipTitle string
+	IssuerName      string
+	CompletedAt     time.Time
+	IssuedAt        time.Time
+	VerifyURL       string
+	Signature       string
+}
+
+// Renderer renders certificates into documents students can download and share
+type Renderer interface {
+	// Render returns the certificate as a PDF
+	Render(data *Data) ([]byte, error)
+
+	// FileExtension returns the extension of the rendered documents
+	FileExtension() string
+}
+
+// block is one centered line of the certificate, its text is a template over Data
+type block struct {
+	font     string
+	style    string
+	size     float64
+	gap      float64
+	template string
+}
+
+// layout is the template certificates are rendered from, top to bottom
+var layout = []block{
+	{font: "Times", style: "B", size: 36, gap: 30, template: "Certificate of Completion"},
+	{font: "Helvetica", size: 14, gap: 22, template: "This is to certify that"},
+	{font: "Times", style: "BI", size: 30, gap: 16, template: "{{.RecipientName}}"},
+	{font: "Helvetica", size: 14, gap: 14, template: "has successfully completed the internship"},
+	{font: "Helvetica", style: "B", size: 20, gap: 14, template: "{{.InternshipTitle}}"},
+	{font: "Helvetica", size: 14, gap: 10, template: `on {{.CompletedAt.Format "January 2, 2006"}}`},
+	{font: "Helvetica", style: "B", size: 14, gap: 28, template: "{{.IssuerName}}"},
+	{font: "Helvetica", size: 9, gap: 8, template: "Certificate ID {{.VerificationID}}, issued {{.IssuedAt.Format \"2006-01-02\"}}"},
+	{font: "Helvetica", size: 9, gap: 5, template: "Verify at {{.VerifyURL}}"},
+	{font: "Courier", size: 7, gap: 5, template: "Signature {{.Signature}}"},
+}
+
+type pdfRenderer struct {
+	templates []*template.Template
+}
+
+// NewPDFRenderer creates a renderer drawing certificates on a landscape A4 page
+func NewPDFRenderer() (Renderer, error) {
+	templates := make([]*template.Template, len(layout))
+	for i, b := range layout {
+		t, err := template.New("certificate").Parse(b.template)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to parse certificate template").
+				Mark(ierr.ErrSystem)
+		}
+		templates[i] = t
+	}
+
+	return &pdfRenderer{
+		templates: templates,
+	}, nil
+}
+
+func (r *pdfRenderer) FileExtension() string {
+	return ".pdf"
+}
+
+func (r *pdfRenderer) Render(data *Data) ([]byte, error) {
+	pdf := fpdf.New("L", "mm", "A4", "")
+	pdf.SetTitle("Certificate of Completion", true)
+	pdf.SetAuthor(data.IssuerName, true)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	width, height := pdf.GetPageSize()
+
+	// double border
+	pdf.SetDrawColor(40, 60, 110)
+	pdf.SetLineWidth(1.5)
+	pdf.Rect(10, 10, width-20, height-20, "D")
+	pdf.SetLineWidth(0.4)
+	pdf.Rect(14, 14, width-28, height-28, "D")
+
+	// the core fonts only cover cp1252, names are translated from UTF-8
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	y := 30.0
+	for i, b := range layout {
+		var text bytes.Buffer
+		if err := r.templates[i].Execute(&text, data); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to render certificate").
+				Mark(ierr.ErrSystem)
+		}
+
+		y += b.gap
+		pdf.SetFont(b.font, b.style, b.size)
+		pdf.SetTextColor(30, 30, 30)
+		pdf.SetXY(20, y)
+		pdf.CellFormat(width-40, b.size/2, translate(strings.TrimSpace(text.String())), "", 0, "C", false, 0, "")
+	}
+
+	var out bytes.Buffer
+	if err := pdf.Output(&out); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to render certificate").
+			Mark(ierr.ErrSystem)
+	}
+
+	return out.Bytes(), nil
+}
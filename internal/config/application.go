@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+// ApplicationConfig represents the configuration for applications to selective internships
+type ApplicationConfig struct {
+	// How long accepted applicants have to enroll and pay before their offer lapses
+	OfferValidity time.Duration `mapstructure:"offer_validity" default:"168h"`
+}
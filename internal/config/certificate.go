@@ -0,0 +1,10 @@
+package config
+
+// CertificateConfig represents the configuration for completion certificates
+type CertificateConfig struct {
+	// Name of the organization issuing certificates, printed on them
+	IssuerName string `mapstructure:"issuer_name" default:"CodeGeeky"`
+
+	// Public page certificates are verified on, the verification id is appended to it
+	VerifyBaseURL string `mapstructure:"verify_base_url" default:"https://codegeeky.com/certificates"`
+}
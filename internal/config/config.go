@@ -13,15 +13,26 @@ import (
 )
 
 type Configuration struct {
-	Webhook    WebhookConfig    `validate:"required"`
-	Server     ServerConfig     `validate:"required"`
-	Logging    LoggingConfig    `validate:"required"`
-	Postgres   PostgresConfig   `validate:"required"`
-	Supabase   SupabaseConfig   `validate:"required"`
-	Secrets    SecretsConfig    `validate:"required"`
-	Cloudinary CloudinaryConfig `validate:"required"`
-	Cache      CacheConfig      `validate:"required"`
-	Razorpay   RazorpayConfig   `validate:"required"`
+	Webhook        WebhookConfig    `validate:"required"`
+	Server         ServerConfig     `validate:"required"`
+	Logging        LoggingConfig    `validate:"required"`
+	Postgres       PostgresConfig   `validate:"required"`
+	Supabase       SupabaseConfig   `validate:"required"`
+	Secrets        SecretsConfig    `validate:"required"`
+	Cloudinary     CloudinaryConfig `validate:"required"`
+	Cache          CacheConfig      `validate:"required"`
+	Razorpay       RazorpayConfig   `validate:"required"`
+	Referral       ReferralConfig
+	Wallet         WalletConfig
+	PaymentPlan    PaymentPlanConfig
+	Subscription   SubscriptionConfig
+	Batch          InternshipBatchConfig
+	Enrollment     EnrollmentConfig
+	Certificate    CertificateConfig
+	LiveSession    LiveSessionConfig
+	Application    ApplicationConfig
+	Recommendation RecommendationConfig
+	Notification   NotificationConfig
 }
 
 type CloudinaryConfig struct {
@@ -67,6 +78,9 @@ type PostgresConfig struct {
 
 type SecretsConfig struct {
 	EncryptionKey string `mapstructure:"encryption_key" validate:"required"`
+
+	// Key documents such as certificates are signed with, derived from the encryption key when empty
+	SigningKey string `mapstructure:"signing_key"`
 }
 
 type SupabaseConfig struct {
@@ -79,6 +93,9 @@ type SupabaseConfig struct {
 type RazorpayConfig struct {
 	APIKey    string `mapstructure:"api_key" validate:"required"`
 	APISecret string `mapstructure:"api_secret" validate:"required"`
+
+	// Secret used to verify webhook signatures, webhooks are rejected when unset
+	WebhookSecret string `mapstructure:"webhook_secret"`
 }
 
 func NewConfig() (*Configuration, error) {
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+// EnrollmentConfig represents the configuration for enrollment checkouts
+type EnrollmentConfig struct {
+	// How long an enrollment may wait for its payment before the checkout expires and
+	// the discount codes and wallet credit it holds are given back, zero keeps checkouts open
+	CheckoutExpiry time.Duration `mapstructure:"checkout_expiry" default:"24h"`
+
+	// How often expired checkouts are cleaned up
+	CheckoutCheckInterval time.Duration `mapstructure:"checkout_check_interval" default:"15m"`
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// InternshipBatchConfig represents the configuration for the internship batch lifecycle
+type InternshipBatchConfig struct {
+	// How long after a batch starts enrollment stays open, negative values close enrollment before the start
+	EnrollmentCutoff time.Duration `mapstructure:"enrollment_cutoff" default:"0s"`
+
+	// How often batches are moved along their lifecycle
+	LifecycleCheckInterval time.Duration `mapstructure:"lifecycle_check_interval" default:"15m"`
+
+	// How long before a batch starts enrolled students are reminded of it, zero turns reminders off
+	ReminderLeadTime time.Duration `mapstructure:"reminder_lead_time" default:"24h"`
+}
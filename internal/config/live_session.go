@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// LiveSessionConfig represents the configuration for live sessions of internship batches
+type LiveSessionConfig struct {
+	// How long before a session starts students can join it
+	JoinWindow time.Duration `mapstructure:"join_window" default:"15m"`
+
+	// Name calendar apps show for the session feed of a student
+	CalendarName string `mapstructure:"calendar_name" default:"CodeGeeky live sessions"`
+
+	// Public address session calendar feeds are served under, the feed path is appended to it
+	CalendarBaseURL string `mapstructure:"calendar_base_url" default:"https://api.codegeeky.com/v1/calendars"`
+}
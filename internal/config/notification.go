@@ -0,0 +1,49 @@
+package config
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// NotificationConfig represents the configuration for emails and in-app notifications sent to users
+type NotificationConfig struct {
+	// Emails are only sent when enabled, in-app notifications are created either way
+	Enabled bool `mapstructure:"enabled" default:"false"`
+
+	// Provider emails are sent through: smtp, or log to write them out locally
+	Provider types.NotificationProvider `mapstructure:"provider" default:"log"`
+
+	FromAddress string `mapstructure:"from_address" default:"no-reply@codegeeky.com"`
+	FromName    string `mapstructure:"from_name" default:"CodeGeeky"`
+
+	// Name of the app shown in emails
+	AppName string `mapstructure:"app_name" default:"CodeGeeky"`
+
+	// Locale of users who did not choose one, emails fall back to it when there is no template for the user's locale
+	DefaultLocale string `mapstructure:"default_locale" default:"en"`
+
+	// How often idle notification streams are pinged so proxies keep them open
+	StreamHeartbeat time.Duration `mapstructure:"stream_heartbeat" default:"30s"`
+
+	SMTP SMTPConfig            `mapstructure:"smtp"`
+	Log  NotificationLogConfig `mapstructure:"log"`
+}
+
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port" default:"587"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+
+	// Connect over TLS from the start, as on port 465, instead of upgrading with STARTTLS
+	ImplicitTLS bool `mapstructure:"implicit_tls" default:"false"`
+
+	// How long connecting to and talking with the server may take
+	Timeout time.Duration `mapstructure:"timeout" default:"10s"`
+}
+
+type NotificationLogConfig struct {
+	// Directory emails are written to as .eml files, emails are only logged when empty
+	Directory string `mapstructure:"directory"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+// PaymentPlanConfig represents the configuration for installment payment plans
+type PaymentPlanConfig struct {
+	// How long before its due date a reminder is sent for an unpaid installment
+	ReminderLeadTime time.Duration `mapstructure:"reminder_lead_time" default:"72h"`
+
+	// How often installments are checked for reminders and overdue handling
+	InstallmentCheckInterval time.Duration `mapstructure:"installment_check_interval" default:"1h"`
+}
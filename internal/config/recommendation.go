@@ -0,0 +1,17 @@
+package config
+
+// RecommendationConfig represents the weights internship recommendations are ranked with,
+// each signal scores between zero and its weight
+type RecommendationConfig struct {
+	// Share of the internship's skills the user already has
+	SkillWeight float64 `mapstructure:"skill_weight" default:"4"`
+
+	// Share of the internship's prerequisites the user has
+	PrerequisiteWeight float64 `mapstructure:"prerequisite_weight" default:"3"`
+
+	// Full weight for the level after the highest one the user completed, half for the same level
+	LevelWeight float64 `mapstructure:"level_weight" default:"2"`
+
+	// Enrollments relative to the most enrolled internship among the candidates
+	PopularityWeight float64 `mapstructure:"popularity_weight" default:"1"`
+}
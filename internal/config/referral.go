@@ -0,0 +1,27 @@
+package config
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// ReferralConfig represents the configuration for the referral program
+type ReferralConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Discount granted to the referee at signup
+	RefereeDiscountType     types.DiscountType `mapstructure:"referee_discount_type" default:"percentage"`
+	RefereeDiscountValue    float64            `mapstructure:"referee_discount_value" default:"10"`
+	RefereeDiscountValidity time.Duration      `mapstructure:"referee_discount_validity" default:"720h"`
+
+	// Reward earned by the referrer once the referee's first payment clears the refund window
+	RewardType         types.ReferralRewardType `mapstructure:"reward_type" default:"coupon"`
+	RewardDiscountType types.DiscountType       `mapstructure:"reward_discount_type" default:"flat"`
+	RewardValue        float64                  `mapstructure:"reward_value" default:"500"`
+	RewardValidity     time.Duration            `mapstructure:"reward_validity" default:"2160h"`
+
+	// Time after a successful payment during which a refund cancels the reward
+	RefundWindow        time.Duration `mapstructure:"refund_window" default:"168h"`
+	RewardCheckInterval time.Duration `mapstructure:"reward_check_interval" default:"1h"`
+}
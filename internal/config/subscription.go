@@ -0,0 +1,7 @@
+package config
+
+// SubscriptionConfig represents the configuration for recurring subscriptions
+type SubscriptionConfig struct {
+	// How many years a subscription keeps renewing before the gateway completes it
+	MaxDurationYears int `mapstructure:"max_duration_years" default:"10"`
+}
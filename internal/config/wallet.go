@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// WalletConfig represents the configuration for the store-credit wallet
+type WalletConfig struct {
+	// Currency used when a request or internship doesn't specify one
+	DefaultCurrency string `mapstructure:"default_currency" default:"INR"`
+
+	// Validity of credits issued for refunds, 0 means they never expire
+	RefundCreditValidity time.Duration `mapstructure:"refund_credit_validity" default:"8760h"`
+
+	// How often expired credits are written off the ledger
+	ExpiryCheckInterval time.Duration `mapstructure:"expiry_check_interval" default:"1h"`
+}
@@ -28,10 +28,12 @@ const (
 	PermissionDeleteInternship  Permission = "internship:delete"
 	PermissionViewInternship    Permission = "internship:view"
 	PermissionPublishInternship Permission = "internship:publish"
+	PermissionReviewInternship  Permission = "internship:review"
 
 	// Content permissions
 	PermissionViewLectures    Permission = "content:lectures:view"
 	PermissionViewAssignments Permission = "content:assignments:view"
+	PermissionViewQuizzes     Permission = "content:quizzes:view"
 	PermissionViewResources   Permission = "content:resources:view"
 	PermissionDownloadContent Permission = "content:download"
 
@@ -52,6 +54,7 @@ const (
 	ResourceTypeAnalytics  ResourceType = "analytics"
 	ResourceTypeSystem     ResourceType = "system"
 	ResourceTypeProgress   ResourceType = "progress"
+	ResourceTypeContent    ResourceType = "content"
 )
 
 // Resource represents a resource being accessed
@@ -0,0 +1,82 @@
+package certificate
+
+import (
+	"strings"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Certificate certifies that a student completed an internship
+type Certificate struct {
+	ID                string                  `json:"id,omitempty"`
+	VerificationID    string                  `json:"verification_id,omitempty"`
+	EnrollmentID      string                  `json:"enrollment_id,omitempty"`
+	UserID            string                  `json:"user_id,omitempty"`
+	InternshipID      string                  `json:"internship_id,omitempty"`
+	InternshipBatchID string                  `json:"internship_batch_id,omitempty"`
+	RecipientName     string                  `json:"recipient_name,omitempty"`
+	InternshipTitle   string                  `json:"internship_title,omitempty"`
+	CompletedAt       time.Time               `json:"completed_at,omitempty"`
+	IssuedAt          time.Time               `json:"issued_at,omitempty"`
+	Signature         string                  `json:"signature,omitempty"`
+	FileID            *string                 `json:"file_id,omitempty"`
+	CertificateStatus types.CertificateStatus `json:"certificate_status,omitempty"`
+	RevokedAt         *time.Time              `json:"revoked_at,omitempty"`
+	RevocationReason  *string                 `json:"revocation_reason,omitempty"`
+	types.BaseModel
+}
+
+// SigningPayload is the canonical form of what the certificate certifies, its signature is computed over it
+func (c *Certificate) SigningPayload() string {
+	return strings.Join([]string{
+		c.VerificationID,
+		c.EnrollmentID,
+		c.UserID,
+		c.InternshipID,
+		c.RecipientName,
+		c.InternshipTitle,
+		c.CompletedAt.UTC().Format(time.RFC3339),
+		c.IssuedAt.UTC().Format(time.RFC3339),
+	}, "|")
+}
+
+// IsRevoked reports whether the certificate was revoked
+func (c *Certificate) IsRevoked() bool {
+	return c.CertificateStatus == types.CertificateStatusRevoked
+}
+
+func (c *Certificate) FromEnt(ent *ent.Certificate) *Certificate {
+	return &Certificate{
+		ID:                ent.ID,
+		VerificationID:    ent.VerificationID,
+		EnrollmentID:      ent.EnrollmentID,
+		UserID:            ent.UserID,
+		InternshipID:      ent.InternshipID,
+		InternshipBatchID: ent.InternshipBatchID,
+		RecipientName:     ent.RecipientName,
+		InternshipTitle:   ent.InternshipTitle,
+		CompletedAt:       ent.CompletedAt,
+		IssuedAt:          ent.IssuedAt,
+		Signature:         ent.Signature,
+		FileID:            ent.FileID,
+		CertificateStatus: types.CertificateStatus(ent.CertificateStatus),
+		RevokedAt:         ent.RevokedAt,
+		RevocationReason:  ent.RevocationReason,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (c *Certificate) FromEntList(ents []*ent.Certificate) []*Certificate {
+	return lo.Map(ents, func(ent *ent.Certificate, _ int) *Certificate {
+		return c.FromEnt(ent)
+	})
+}
@@ -0,0 +1,18 @@
+package certificate
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, certificate *Certificate) error
+	Get(ctx context.Context, id string) (*Certificate, error)
+	GetByVerificationID(ctx context.Context, verificationID string) (*Certificate, error)
+	GetByEnrollmentID(ctx context.Context, enrollmentID string) (*Certificate, error)
+	Update(ctx context.Context, certificate *Certificate) error
+	Count(ctx context.Context, filter *types.CertificateFilter) (int, error)
+	List(ctx context.Context, filter *types.CertificateFilter) ([]*Certificate, error)
+	ListAll(ctx context.Context, filter *types.CertificateFilter) ([]*Certificate, error)
+}
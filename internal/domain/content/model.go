@@ -0,0 +1,280 @@
+package content
+
+import (
+	"math"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Module groups the content of an internship
+type Module struct {
+	ID           string `json:"id,omitempty"`
+	InternshipID string `json:"internship_id,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description,omitempty"`
+	SortOrder    int    `json:"sort_order"`
+
+	types.BaseModel
+}
+
+func (m *Module) FromEnt(ent *ent.Module) *Module {
+	return &Module{
+		ID:           ent.ID,
+		InternshipID: ent.InternshipID,
+		Title:        ent.Title,
+		Description:  ent.Description,
+		SortOrder:    ent.SortOrder,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (m *Module) FromEntList(ents []*ent.Module) []*Module {
+	return lo.Map(ents, func(ent *ent.Module, _ int) *Module {
+		return m.FromEnt(ent)
+	})
+}
+
+// Lesson is a video, text or file within a module
+type Lesson struct {
+	ID              string           `json:"id,omitempty"`
+	InternshipID    string           `json:"internship_id,omitempty"`
+	ModuleID        string           `json:"module_id,omitempty"`
+	Title           string           `json:"title,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	LessonType      types.LessonType `json:"lesson_type,omitempty"`
+	Content         string           `json:"content,omitempty"`
+	VideoURL        *string          `json:"video_url,omitempty"`
+	FileID          *string          `json:"file_id,omitempty"`
+	DurationSeconds int              `json:"duration_seconds,omitempty"`
+	IsPreview       bool             `json:"is_preview"`
+	SortOrder       int              `json:"sort_order"`
+
+	// RequiredProgress is the percentage of the internship a student must complete to unlock the lesson
+	RequiredProgress *float64 `json:"required_progress,omitempty"`
+
+	types.BaseModel
+}
+
+// Validate checks the lesson carries the content its type needs
+func (l *Lesson) Validate() error {
+	if err := l.LessonType.Validate(); err != nil {
+		return err
+	}
+
+	var missing string
+	switch l.LessonType {
+	case types.LessonTypeVideo:
+		if lo.FromPtr(l.VideoURL) == "" && lo.FromPtr(l.FileID) == "" {
+			missing = "video_url or file_id"
+		}
+	case types.LessonTypeText:
+		if l.Content == "" {
+			missing = "content"
+		}
+	case types.LessonTypeFile:
+		if lo.FromPtr(l.FileID) == "" {
+			missing = "file_id"
+		}
+	}
+
+	if missing != "" {
+		return ierr.NewError("lesson content is missing").
+			WithHintf("A %s lesson requires %s", l.LessonType, missing).
+			WithReportableDetails(map[string]any{
+				"lesson_type": l.LessonType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// HideContent strips what a lesson teaches, leaving what the outline shows
+func (l *Lesson) HideContent() {
+	l.Content = ""
+	l.VideoURL = nil
+	l.FileID = nil
+}
+
+func (l *Lesson) FromEnt(ent *ent.Lesson) *Lesson {
+	return &Lesson{
+		ID:               ent.ID,
+		InternshipID:     ent.InternshipID,
+		ModuleID:         ent.ModuleID,
+		Title:            ent.Title,
+		Description:      ent.Description,
+		LessonType:       types.LessonType(ent.LessonType),
+		Content:          ent.Content,
+		VideoURL:         ent.VideoURL,
+		FileID:           ent.FileID,
+		DurationSeconds:  ent.DurationSeconds,
+		IsPreview:        ent.IsPreview,
+		SortOrder:        ent.SortOrder,
+		RequiredProgress: ent.RequiredProgress,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (l *Lesson) FromEntList(ents []*ent.Lesson) []*Lesson {
+	return lo.Map(ents, func(ent *ent.Lesson, _ int) *Lesson {
+		return l.FromEnt(ent)
+	})
+}
+
+// Assignment is the project work of a module
+type Assignment struct {
+	ID           string `json:"id,omitempty"`
+	InternshipID string `json:"internship_id,omitempty"`
+	ModuleID     string `json:"module_id,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Instructions string `json:"instructions,omitempty"`
+
+	// DueDays is the number of days after the start of a batch the assignment is due
+	DueDays   *int `json:"due_days,omitempty"`
+	MaxScore  int  `json:"max_score,omitempty"`
+	SortOrder int  `json:"sort_order"`
+
+	// PassingScore is the score a submission needs for the assignment to count towards
+	// completing the enrollment, assignments without one don't hold completion back
+	PassingScore *int                    `json:"passing_score,omitempty"`
+	Rubric       []types.RubricCriterion `json:"rubric,omitempty"`
+
+	LatePolicy types.LatePolicy `json:"late_policy,omitempty"`
+	// LatePenaltyPercent is the percentage of the max score deducted per day late
+	LatePenaltyPercent float64 `json:"late_penalty_percent"`
+	// MaxSubmissions is how many times a student may submit, unlimited when nil
+	MaxSubmissions *int `json:"max_submissions,omitempty"`
+
+	types.BaseModel
+}
+
+// Validate checks the grading settings of the assignment fit together
+func (a *Assignment) Validate() error {
+	if err := a.LatePolicy.Validate(); err != nil {
+		return err
+	}
+
+	if a.PassingScore != nil && lo.FromPtr(a.PassingScore) > a.MaxScore {
+		return ierr.NewError("passing score is above the max score").
+			WithHintf("Passing score can be at most the max score of %d", a.MaxScore).
+			Mark(ierr.ErrValidation)
+	}
+
+	return types.ValidateRubric(a.Rubric, a.MaxScore)
+}
+
+// DueAt returns when the assignment is due in a batch starting at batchStart, nil when it has no due date
+func (a *Assignment) DueAt(batchStart time.Time) *time.Time {
+	if a.DueDays == nil {
+		return nil
+	}
+	return lo.ToPtr(batchStart.AddDate(0, 0, lo.FromPtr(a.DueDays)))
+}
+
+// LatePenalty returns the points deducted from a score for work that is lateDays late,
+// never more than the score itself
+func (a *Assignment) LatePenalty(score float64, lateDays int) float64 {
+	if a.LatePolicy != types.LatePolicyPenalize || lateDays == 0 {
+		return 0
+	}
+
+	penalty := float64(a.MaxScore) * a.LatePenaltyPercent / 100 * float64(lateDays)
+	return math.Min(penalty, score)
+}
+
+// HideContent strips the instructions, leaving what the outline shows
+func (a *Assignment) HideContent() {
+	a.Instructions = ""
+}
+
+func (a *Assignment) FromEnt(ent *ent.Assignment) *Assignment {
+	return &Assignment{
+		ID:                 ent.ID,
+		InternshipID:       ent.InternshipID,
+		ModuleID:           ent.ModuleID,
+		Title:              ent.Title,
+		Instructions:       ent.Instructions,
+		DueDays:            ent.DueDays,
+		MaxScore:           ent.MaxScore,
+		SortOrder:          ent.SortOrder,
+		PassingScore:       ent.PassingScore,
+		Rubric:             ent.Rubric,
+		LatePolicy:         types.LatePolicy(ent.LatePolicy),
+		LatePenaltyPercent: ent.LatePenaltyPercent,
+		MaxSubmissions:     ent.MaxSubmissions,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (a *Assignment) FromEntList(ents []*ent.Assignment) []*Assignment {
+	return lo.Map(ents, func(ent *ent.Assignment, _ int) *Assignment {
+		return a.FromEnt(ent)
+	})
+}
+
+// Resource is a downloadable file of an internship
+type Resource struct {
+	ID           string  `json:"id,omitempty"`
+	InternshipID string  `json:"internship_id,omitempty"`
+	ModuleID     *string `json:"module_id,omitempty"`
+	LessonID     *string `json:"lesson_id,omitempty"`
+	Title        string  `json:"title,omitempty"`
+	Description  string  `json:"description,omitempty"`
+	FileID       string  `json:"file_id,omitempty"`
+	SortOrder    int     `json:"sort_order"`
+
+	types.BaseModel
+}
+
+// HideContent strips the file reference, leaving what the outline shows
+func (r *Resource) HideContent() {
+	r.FileID = ""
+}
+
+func (r *Resource) FromEnt(ent *ent.Resource) *Resource {
+	return &Resource{
+		ID:           ent.ID,
+		InternshipID: ent.InternshipID,
+		ModuleID:     ent.ModuleID,
+		LessonID:     ent.LessonID,
+		Title:        ent.Title,
+		Description:  ent.Description,
+		FileID:       ent.FileID,
+		SortOrder:    ent.SortOrder,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (r *Resource) FromEntList(ents []*ent.Resource) []*Resource {
+	return lo.Map(ents, func(ent *ent.Resource, _ int) *Resource {
+		return r.FromEnt(ent)
+	})
+}
@@ -0,0 +1,52 @@
+package content
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// LessonProgress is how far a student got in a lesson
+type LessonProgress struct {
+	ID           string `json:"id,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	InternshipID string `json:"internship_id,omitempty"`
+	LessonID     string `json:"lesson_id,omitempty"`
+
+	// PositionSeconds is where playback of a video lesson resumes
+	PositionSeconds int        `json:"position_seconds"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+
+	types.BaseModel
+}
+
+// IsCompleted reports whether the student finished the lesson
+func (p *LessonProgress) IsCompleted() bool {
+	return p.CompletedAt != nil
+}
+
+func (p *LessonProgress) FromEnt(ent *ent.LessonProgress) *LessonProgress {
+	return &LessonProgress{
+		ID:              ent.ID,
+		UserID:          ent.UserID,
+		InternshipID:    ent.InternshipID,
+		LessonID:        ent.LessonID,
+		PositionSeconds: ent.PositionSeconds,
+		CompletedAt:     ent.CompletedAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (p *LessonProgress) FromEntList(ents []*ent.LessonProgress) []*LessonProgress {
+	return lo.Map(ents, func(ent *ent.LessonProgress, _ int) *LessonProgress {
+		return p.FromEnt(ent)
+	})
+}
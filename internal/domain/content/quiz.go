@@ -0,0 +1,219 @@
+package content
+
+import (
+	"math"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Quiz is an auto-graded assessment of a module
+type Quiz struct {
+	ID           string `json:"id,omitempty"`
+	InternshipID string `json:"internship_id,omitempty"`
+	ModuleID     string `json:"module_id,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description,omitempty"`
+
+	// Questions is the pool attempts draw from, it holds the answers
+	Questions []types.QuizQuestion `json:"questions,omitempty"`
+	// QuestionsPerAttempt is how many questions an attempt draws, the whole pool when nil
+	QuestionsPerAttempt *int `json:"questions_per_attempt,omitempty"`
+	ShuffleQuestions    bool `json:"shuffle_questions"`
+
+	// TimeLimitMinutes is how long an attempt may take, untimed when nil
+	TimeLimitMinutes *int `json:"time_limit_minutes,omitempty"`
+	// MaxAttempts is how many times a student may attempt the quiz, unlimited when nil
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	// PassingPercent is the percentage an attempt needs for the quiz to count towards
+	// completing the enrollment, quizzes without one don't hold completion back
+	PassingPercent *int `json:"passing_percent,omitempty"`
+	SortOrder      int  `json:"sort_order"`
+
+	types.BaseModel
+}
+
+// Validate checks the question pool and the settings of the quiz fit together
+func (q *Quiz) Validate() error {
+	if err := types.ValidateQuizQuestions(q.Questions); err != nil {
+		return err
+	}
+
+	if q.QuestionsPerAttempt != nil && lo.FromPtr(q.QuestionsPerAttempt) > len(q.Questions) {
+		return ierr.NewError("quiz draws more questions than its pool has").
+			WithHintf("Questions per attempt can be at most the %d questions of the pool", len(q.Questions)).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// AttemptQuestionCount is how many questions an attempt draws
+func (q *Quiz) AttemptQuestionCount() int {
+	if q.QuestionsPerAttempt == nil {
+		return len(q.Questions)
+	}
+	return min(lo.FromPtr(q.QuestionsPerAttempt), len(q.Questions))
+}
+
+// Passes reports whether a score percentage passes the quiz
+func (q *Quiz) Passes(percent float64) bool {
+	return q.PassingPercent == nil || percent >= float64(lo.FromPtr(q.PassingPercent))
+}
+
+// AttemptsExhausted reports whether a student with count attempts may not attempt the quiz again
+func (q *Quiz) AttemptsExhausted(count int) bool {
+	return q.MaxAttempts != nil && count >= lo.FromPtr(q.MaxAttempts)
+}
+
+// HideContent strips the question pool, leaving what students see before an attempt
+func (q *Quiz) HideContent() {
+	q.Questions = nil
+}
+
+func (q *Quiz) FromEnt(ent *ent.Quiz) *Quiz {
+	return &Quiz{
+		ID:                  ent.ID,
+		InternshipID:        ent.InternshipID,
+		ModuleID:            ent.ModuleID,
+		Title:               ent.Title,
+		Description:         ent.Description,
+		Questions:           ent.Questions,
+		QuestionsPerAttempt: ent.QuestionsPerAttempt,
+		ShuffleQuestions:    ent.ShuffleQuestions,
+		TimeLimitMinutes:    ent.TimeLimitMinutes,
+		MaxAttempts:         ent.MaxAttempts,
+		PassingPercent:      ent.PassingPercent,
+		SortOrder:           ent.SortOrder,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (q *Quiz) FromEntList(ents []*ent.Quiz) []*Quiz {
+	return lo.Map(ents, func(ent *ent.Quiz, _ int) *Quiz {
+		return q.FromEnt(ent)
+	})
+}
+
+// QuizAttempt is one attempt of a student at a quiz
+type QuizAttempt struct {
+	ID           string `json:"id,omitempty"`
+	QuizID       string `json:"quiz_id,omitempty"`
+	InternshipID string `json:"internship_id,omitempty"`
+	EnrollmentID string `json:"enrollment_id,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	Attempt      int    `json:"attempt,omitempty"`
+
+	// QuestionIDs are the questions drawn for the attempt in the order they are shown
+	QuestionIDs []string   `json:"question_ids,omitempty"`
+	StartedAt   time.Time  `json:"started_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+
+	AttemptStatus types.QuizAttemptStatus    `json:"attempt_status,omitempty"`
+	Answers       []types.QuizAnswer         `json:"answers,omitempty"`
+	Results       []types.QuizQuestionResult `json:"results,omitempty"`
+	Score         *float64                   `json:"score,omitempty"`
+	MaxScore      float64                    `json:"max_score"`
+	ScorePercent  *float64                   `json:"score_percent,omitempty"`
+
+	types.BaseModel
+}
+
+// IsOpen reports whether the attempt still takes answers
+func (a *QuizAttempt) IsOpen() bool {
+	return a.AttemptStatus == types.QuizAttemptStatusInProgress
+}
+
+// IsOverdue reports whether the time of a timed attempt ran out at now, including the grace period
+func (a *QuizAttempt) IsOverdue(now time.Time) bool {
+	return a.ExpiresAt != nil && now.After(a.ExpiresAt.Add(types.QuizAttemptGracePeriod))
+}
+
+// Grade scores the answers against the questions of the attempt, answers to questions
+// that were not drawn for it are ignored
+func (a *QuizAttempt) Grade(quiz *Quiz, answers []types.QuizAnswer) {
+	questions := lo.SliceToMap(quiz.Questions, func(question types.QuizQuestion) (string, types.QuizQuestion) {
+		return question.ID, question
+	})
+	given := lo.SliceToMap(answers, func(answer types.QuizAnswer) (string, types.QuizAnswer) {
+		return answer.QuestionID, answer
+	})
+
+	a.Answers = make([]types.QuizAnswer, 0, len(a.QuestionIDs))
+	a.Results = make([]types.QuizQuestionResult, 0, len(a.QuestionIDs))
+	a.MaxScore = 0
+	score := 0.0
+
+	for _, id := range a.QuestionIDs {
+		question, ok := questions[id]
+		if !ok {
+			// removed from the pool after the attempt started
+			continue
+		}
+
+		result := types.QuizQuestionResult{QuestionID: id}
+		if answer, ok := given[id]; ok {
+			a.Answers = append(a.Answers, answer)
+			if question.IsCorrect(&answer) {
+				result.Correct = true
+				result.Points = question.Points
+			}
+		}
+
+		a.Results = append(a.Results, result)
+		a.MaxScore += question.Points
+		score += result.Points
+	}
+
+	percent := 0.0
+	if a.MaxScore > 0 {
+		percent = math.Round(score*10000/a.MaxScore) / 100
+	}
+
+	a.Score = lo.ToPtr(score)
+	a.ScorePercent = lo.ToPtr(percent)
+}
+
+func (a *QuizAttempt) FromEnt(ent *ent.QuizAttempt) *QuizAttempt {
+	return &QuizAttempt{
+		ID:            ent.ID,
+		QuizID:        ent.QuizID,
+		InternshipID:  ent.InternshipID,
+		EnrollmentID:  ent.EnrollmentID,
+		UserID:        ent.UserID,
+		Attempt:       ent.Attempt,
+		QuestionIDs:   ent.QuestionIds,
+		StartedAt:     ent.StartedAt,
+		ExpiresAt:     ent.ExpiresAt,
+		SubmittedAt:   ent.SubmittedAt,
+		AttemptStatus: types.QuizAttemptStatus(ent.AttemptStatus),
+		Answers:       ent.Answers,
+		Results:       ent.Results,
+		Score:         ent.Score,
+		MaxScore:      ent.MaxScore,
+		ScorePercent:  ent.ScorePercent,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (a *QuizAttempt) FromEntList(ents []*ent.QuizAttempt) []*QuizAttempt {
+	return lo.Map(ents, func(ent *ent.QuizAttempt, _ int) *QuizAttempt {
+		return a.FromEnt(ent)
+	})
+}
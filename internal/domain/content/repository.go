@@ -0,0 +1,87 @@
+package content
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type ModuleRepository interface {
+	Create(ctx context.Context, module *Module) error
+	Get(ctx context.Context, id string) (*Module, error)
+	Update(ctx context.Context, module *Module) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.ModuleFilter) (int, error)
+	List(ctx context.Context, filter *types.ModuleFilter) ([]*Module, error)
+	ListAll(ctx context.Context, filter *types.ModuleFilter) ([]*Module, error)
+}
+
+type LessonRepository interface {
+	Create(ctx context.Context, lesson *Lesson) error
+	Get(ctx context.Context, id string) (*Lesson, error)
+	Update(ctx context.Context, lesson *Lesson) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.LessonFilter) (int, error)
+	List(ctx context.Context, filter *types.LessonFilter) ([]*Lesson, error)
+	ListAll(ctx context.Context, filter *types.LessonFilter) ([]*Lesson, error)
+}
+
+type AssignmentRepository interface {
+	Create(ctx context.Context, assignment *Assignment) error
+	Get(ctx context.Context, id string) (*Assignment, error)
+	Update(ctx context.Context, assignment *Assignment) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.AssignmentFilter) (int, error)
+	List(ctx context.Context, filter *types.AssignmentFilter) ([]*Assignment, error)
+	ListAll(ctx context.Context, filter *types.AssignmentFilter) ([]*Assignment, error)
+}
+
+type ResourceRepository interface {
+	Create(ctx context.Context, resource *Resource) error
+	Get(ctx context.Context, id string) (*Resource, error)
+	Update(ctx context.Context, resource *Resource) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.ResourceFilter) (int, error)
+	List(ctx context.Context, filter *types.ResourceFilter) ([]*Resource, error)
+	ListAll(ctx context.Context, filter *types.ResourceFilter) ([]*Resource, error)
+}
+
+type LessonProgressRepository interface {
+	Create(ctx context.Context, progress *LessonProgress) error
+	Get(ctx context.Context, id string) (*LessonProgress, error)
+	GetByUserAndLesson(ctx context.Context, userID string, lessonID string) (*LessonProgress, error)
+	Update(ctx context.Context, progress *LessonProgress) error
+	Count(ctx context.Context, filter *types.LessonProgressFilter) (int, error)
+	List(ctx context.Context, filter *types.LessonProgressFilter) ([]*LessonProgress, error)
+	ListAll(ctx context.Context, filter *types.LessonProgressFilter) ([]*LessonProgress, error)
+}
+
+type SubmissionRepository interface {
+	Create(ctx context.Context, submission *Submission) error
+	Get(ctx context.Context, id string) (*Submission, error)
+	Update(ctx context.Context, submission *Submission) error
+	Count(ctx context.Context, filter *types.SubmissionFilter) (int, error)
+	List(ctx context.Context, filter *types.SubmissionFilter) ([]*Submission, error)
+	ListAll(ctx context.Context, filter *types.SubmissionFilter) ([]*Submission, error)
+}
+
+type QuizRepository interface {
+	Create(ctx context.Context, quiz *Quiz) error
+	Get(ctx context.Context, id string) (*Quiz, error)
+	Update(ctx context.Context, quiz *Quiz) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.QuizFilter) (int, error)
+	List(ctx context.Context, filter *types.QuizFilter) ([]*Quiz, error)
+	ListAll(ctx context.Context, filter *types.QuizFilter) ([]*Quiz, error)
+}
+
+type QuizAttemptRepository interface {
+	Create(ctx context.Context, attempt *QuizAttempt) error
+	Get(ctx context.Context, id string) (*QuizAttempt, error)
+	Update(ctx context.Context, attempt *QuizAttempt) error
+	// Close saves a submitted or expired attempt, failing if it was closed in the meantime
+	Close(ctx context.Context, attempt *QuizAttempt) error
+	Count(ctx context.Context, filter *types.QuizAttemptFilter) (int, error)
+	List(ctx context.Context, filter *types.QuizAttemptFilter) ([]*QuizAttempt, error)
+	ListAll(ctx context.Context, filter *types.QuizAttemptFilter) ([]*QuizAttempt, error)
+}
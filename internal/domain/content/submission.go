@@ -0,0 +1,83 @@
+package content
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Submission is one attempt of a student at an assignment
+type Submission struct {
+	ID             string               `json:"id,omitempty"`
+	InternshipID   string               `json:"internship_id,omitempty"`
+	AssignmentID   string               `json:"assignment_id,omitempty"`
+	EnrollmentID   string               `json:"enrollment_id,omitempty"`
+	UserID         string               `json:"user_id,omitempty"`
+	Attempt        int                  `json:"attempt,omitempty"`
+	SubmissionType types.SubmissionType `json:"submission_type,omitempty"`
+	FileID         *string              `json:"file_id,omitempty"`
+	RepositoryURL  *string              `json:"repository_url,omitempty"`
+	Text           string               `json:"text,omitempty"`
+	SubmittedAt    time.Time            `json:"submitted_at,omitempty"`
+
+	// DueAt is the due date of the assignment in the batch of the student
+	DueAt    *time.Time `json:"due_at,omitempty"`
+	LateDays int        `json:"late_days"`
+
+	SubmissionStatus types.SubmissionStatus `json:"submission_status,omitempty"`
+
+	// Score is the final score, Penalty the points already deducted from it for being late
+	Score        *float64               `json:"score,omitempty"`
+	Penalty      float64                `json:"penalty"`
+	RubricScores []types.CriterionScore `json:"rubric_scores,omitempty"`
+	Feedback     string                 `json:"feedback,omitempty"`
+	GradedBy     *string                `json:"graded_by,omitempty"`
+	GradedAt     *time.Time             `json:"graded_at,omitempty"`
+
+	types.BaseModel
+}
+
+// IsGraded reports whether an instructor graded the submission
+func (s *Submission) IsGraded() bool {
+	return s.SubmissionStatus == types.SubmissionStatusGraded
+}
+
+func (s *Submission) FromEnt(ent *ent.Submission) *Submission {
+	return &Submission{
+		ID:               ent.ID,
+		InternshipID:     ent.InternshipID,
+		AssignmentID:     ent.AssignmentID,
+		EnrollmentID:     ent.EnrollmentID,
+		UserID:           ent.UserID,
+		Attempt:          ent.Attempt,
+		SubmissionType:   types.SubmissionType(ent.SubmissionType),
+		FileID:           ent.FileID,
+		RepositoryURL:    ent.RepositoryURL,
+		Text:             ent.Text,
+		SubmittedAt:      ent.SubmittedAt,
+		DueAt:            ent.DueAt,
+		LateDays:         ent.LateDays,
+		SubmissionStatus: types.SubmissionStatus(ent.SubmissionStatus),
+		Score:            ent.Score,
+		Penalty:          ent.Penalty,
+		RubricScores:     ent.RubricScores,
+		Feedback:         ent.Feedback,
+		GradedBy:         ent.GradedBy,
+		GradedAt:         ent.GradedAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (s *Submission) FromEntList(ents []*ent.Submission) []*Submission {
+	return lo.Map(ents, func(ent *ent.Submission, _ int) *Submission {
+		return s.FromEnt(ent)
+	})
+}
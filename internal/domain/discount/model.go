@@ -21,6 +21,9 @@ type Discount struct {
 	MaxUses        *int               `json:"max_uses"`
 	MinOrderValue  *decimal.Decimal   `json:"min_order_value"`
 	IsCombinable   bool               `json:"is_combinable"`
+	UsedCount      int                `json:"used_count"`
+	IsCampaign     bool               `json:"is_campaign"`
+	ParentID       *string            `json:"parent_id,omitempty"`
 	types.Metadata `json:"metadata"`
 	types.BaseModel
 }
@@ -38,6 +41,9 @@ func FromEnt(ent *ent.Discount) *Discount {
 		MaxUses:       ent.MaxUses,
 		MinOrderValue: ent.MinOrderValue,
 		IsCombinable:  ent.IsCombinable,
+		UsedCount:     ent.UsedCount,
+		IsCampaign:    ent.IsCampaign,
+		ParentID:      ent.ParentID,
 		Metadata:      ent.Metadata,
 		BaseModel: types.BaseModel{
 			Status:    types.Status(ent.Status),
@@ -54,3 +60,19 @@ func (d *Discount) FromEntList(ents []*ent.Discount) []*Discount {
 		return FromEnt(ent)
 	})
 }
+
+// IsRedeemable reports whether the discount can still be applied to an order
+func (d *Discount) IsRedeemable() bool {
+	if d.IsCampaign {
+		return false
+	}
+	return d.MaxUses == nil || *d.MaxUses <= 0 || d.UsedCount < *d.MaxUses
+}
+
+// CampaignStats holds usage numbers rolled up across the codes of a campaign
+type CampaignStats struct {
+	TotalCodes       int `json:"total_codes"`
+	ActiveCodes      int `json:"active_codes"`
+	RedeemedCodes    int `json:"redeemed_codes"`
+	TotalRedemptions int `json:"total_redemptions"`
+}
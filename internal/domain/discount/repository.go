@@ -15,4 +15,11 @@ type Repository interface {
 	Count(ctx context.Context, filter *types.DiscountFilter) (int, error)
 	List(ctx context.Context, filter *types.DiscountFilter) ([]*Discount, error)
 	ListAll(ctx context.Context, filter *types.DiscountFilter) ([]*Discount, error)
+
+	// Campaign code operations
+	CreateBulk(ctx context.Context, discounts []*Discount) error
+	DeactivateByParent(ctx context.Context, parentID string, codes []string) (int, error)
+	GetCampaignStats(ctx context.Context, parentID string) (*CampaignStats, error)
+	IncrementUsage(ctx context.Context, id string) error
+	DecrementUsage(ctx context.Context, id string) error
 }
@@ -10,13 +10,21 @@ import (
 
 // InternshipBatch is the model entity for the InternshipBatch schema.
 type InternshipBatch struct {
-	ID             string                      `json:"id,omitempty"`
-	InternshipID   string                      `json:"internship_id,omitempty"`
-	Name           string                      `json:"name,omitempty"`
-	Description    string                      `json:"description,omitempty"`
-	StartDate      time.Time                   `json:"start_date,omitempty"`
-	EndDate        time.Time                   `json:"end_date,omitempty"`
-	BatchStatus    types.InternshipBatchStatus `json:"batch_status,omitempty"`
+	ID           string                      `json:"id,omitempty"`
+	InternshipID string                      `json:"internship_id,omitempty"`
+	Name         string                      `json:"name,omitempty"`
+	Description  string                      `json:"description,omitempty"`
+	StartDate    time.Time                   `json:"start_date,omitempty"`
+	EndDate      time.Time                   `json:"end_date,omitempty"`
+	BatchStatus  types.InternshipBatchStatus `json:"batch_status,omitempty"`
+
+	// When enrollment closed, nil while the batch still accepts enrollments
+	EnrollmentClosedAt *time.Time `json:"enrollment_closed_at,omitempty"`
+	PausedAt           *time.Time `json:"paused_at,omitempty"`
+	RemindedAt         *time.Time `json:"reminded_at,omitempty"`
+	CancelledAt        *time.Time `json:"cancelled_at,omitempty"`
+	CancellationReason *string    `json:"cancellation_reason,omitempty"`
+
 	types.Metadata `json:"metadata,omitempty"`
 	types.BaseModel
 }
@@ -30,7 +38,14 @@ func (b *InternshipBatch) FromEnt(ent *ent.InternshipBatch) *InternshipBatch {
 		StartDate:    ent.StartDate,
 		EndDate:      ent.EndDate,
 		BatchStatus:  types.InternshipBatchStatus(ent.BatchStatus),
-		Metadata:     types.MetadataFromEnt(ent.Metadata),
+
+		EnrollmentClosedAt: ent.EnrollmentClosedAt,
+		PausedAt:           ent.PausedAt,
+		RemindedAt:         ent.RemindedAt,
+		CancelledAt:        ent.CancelledAt,
+		CancellationReason: ent.CancellationReason,
+
+		Metadata: types.MetadataFromEnt(ent.Metadata),
 		BaseModel: types.BaseModel{
 			Status:    types.Status(ent.Status),
 			CreatedAt: ent.CreatedAt,
@@ -41,6 +56,25 @@ func (b *InternshipBatch) FromEnt(ent *ent.InternshipBatch) *InternshipBatch {
 	}
 }
 
+// EnrollmentCutoffAt is when enrollment closes, cutoff is relative to the start date
+func (b *InternshipBatch) EnrollmentCutoffAt(cutoff time.Duration) time.Time {
+	return b.StartDate.Add(cutoff)
+}
+
+// IsEnrollmentOpen reports whether the batch accepts new enrollments at the given time
+func (b *InternshipBatch) IsEnrollmentOpen(now time.Time, cutoff time.Duration) bool {
+	if !b.BatchStatus.IsRunning() || b.EnrollmentClosedAt != nil {
+		return false
+	}
+
+	// batches without a start date stay open until closed otherwise
+	if b.StartDate.IsZero() {
+		return true
+	}
+
+	return now.Before(b.EnrollmentCutoffAt(cutoff))
+}
+
 func (b *InternshipBatch) FromEntList(ents []*ent.InternshipBatch) []*InternshipBatch {
 	return lo.Map(ents, func(ent *ent.InternshipBatch, _ int) *InternshipBatch {
 		return b.FromEnt(ent)
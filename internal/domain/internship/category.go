@@ -1,6 +1,8 @@
 package internship
 
 import (
+	"strings"
+
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/samber/lo"
@@ -19,6 +21,27 @@ type Category struct {
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty" db:"description"`
 
+	// ParentID is the id of the parent category, nil for top level categories.
+	ParentID *string `json:"parent_id,omitempty" db:"parent_id"`
+
+	// Slug is the unique URL friendly name of the category.
+	Slug string `json:"slug,omitempty" db:"slug"`
+
+	// Path holds the ids from the root down to the category as /root_id/.../id/.
+	Path string `json:"path,omitempty" db:"path"`
+
+	// Depth is the number of ancestors of the category.
+	Depth int `json:"depth" db:"depth"`
+
+	// SortWeight orders sibling categories, lower weights come first.
+	SortWeight int `json:"sort_weight" db:"sort_weight"`
+
+	// InternshipCount is the cached number of published internships in the category.
+	InternshipCount int `json:"internship_count" db:"internship_count"`
+
+	// TotalInternshipCount also counts published internships in descendant categories.
+	TotalInternshipCount int `json:"total_internship_count" db:"total_internship_count"`
+
 	// internships holds the value of the internships edge.
 	Internships []*Internship `json:"internships,omitempty" db:"internships"`
 
@@ -29,11 +52,18 @@ func (c *Category) FromEnt(category *ent.Category) *Category {
 	internship := &Internship{}
 
 	return &Category{
-		ID:          category.ID,
-		Name:        category.Name,
-		LookupKey:   category.LookupKey,
-		Description: category.Description,
-		Internships: internship.FromEntList(category.Edges.Internships),
+		ID:                   category.ID,
+		Name:                 category.Name,
+		LookupKey:            category.LookupKey,
+		Description:          category.Description,
+		ParentID:             category.ParentID,
+		Slug:                 category.Slug,
+		Path:                 category.Path,
+		Depth:                category.Depth,
+		SortWeight:           category.SortWeight,
+		InternshipCount:      category.InternshipCount,
+		TotalInternshipCount: category.TotalInternshipCount,
+		Internships:          internship.FromEntList(category.Edges.Internships),
 		BaseModel: types.BaseModel{
 			Status:    types.Status(category.Status),
 			CreatedAt: category.CreatedAt,
@@ -49,3 +79,21 @@ func (c *Category) FromEntList(categories []*ent.Category) []*Category {
 		return c.FromEnt(category)
 	})
 }
+
+// CategoryPath returns the path of a category with the given id under a parent path
+func CategoryPath(parentPath string, id string) string {
+	if parentPath == "" {
+		parentPath = "/"
+	}
+	return parentPath + id + "/"
+}
+
+// IsRoot returns true if the category has no parent
+func (c *Category) IsRoot() bool {
+	return c.ParentID == nil
+}
+
+// IsDescendantOf returns true if the category sits anywhere below the given category
+func (c *Category) IsDescendantOf(ancestorID string) bool {
+	return c.ID != ancestorID && strings.Contains(c.Path, "/"+ancestorID+"/")
+}
@@ -0,0 +1,44 @@
+package internship
+
+import (
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InternshipInstructor assigns an instructor user to an internship
+type InternshipInstructor struct {
+	ID           string                         `json:"id,omitempty"`
+	InternshipID string                         `json:"internship_id,omitempty"`
+	UserID       string                         `json:"user_id,omitempty"`
+	Role         types.InternshipInstructorRole `json:"role,omitempty"`
+
+	types.BaseModel
+}
+
+// IsOwner returns true if the instructor owns the internship
+func (i *InternshipInstructor) IsOwner() bool {
+	return i.Role == types.InternshipInstructorRoleOwner
+}
+
+func (i *InternshipInstructor) FromEnt(ent *ent.InternshipInstructor) *InternshipInstructor {
+	return &InternshipInstructor{
+		ID:           ent.ID,
+		InternshipID: ent.InternshipID,
+		UserID:       ent.UserID,
+		Role:         types.InternshipInstructorRole(ent.Role),
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (i *InternshipInstructor) FromEntList(ents []*ent.InternshipInstructor) []*InternshipInstructor {
+	return lo.Map(ents, func(ent *ent.InternshipInstructor, _ int) *InternshipInstructor {
+		return i.FromEnt(ent)
+	})
+}
@@ -1,6 +1,8 @@
 package internship
 
 import (
+	"time"
+
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/samber/lo"
@@ -27,6 +29,27 @@ type Internship struct {
 	Total              decimal.Decimal       `json:"total,omitempty"`
 	Categories         []*Category           `json:"categories,omitempty" db:"categories"`
 
+	// Editorial workflow
+	PublishStatus types.InternshipPublishStatus  `json:"publish_status,omitempty"`
+	Draft         *types.InternshipSnapshot      `json:"draft,omitempty"`
+	DraftStatus   *types.InternshipPublishStatus `json:"draft_status,omitempty"`
+	ReviewComment *string                        `json:"review_comment,omitempty"`
+	SubmittedAt   *time.Time                     `json:"submitted_at,omitempty"`
+	ReviewedAt    *time.Time                     `json:"reviewed_at,omitempty"`
+	ReviewedBy    *string                        `json:"reviewed_by,omitempty"`
+	PublishedAt   *time.Time                     `json:"published_at,omitempty"`
+
+	// Revision the live content was taken from
+	CurrentRevisionID *string `json:"current_revision_id,omitempty"`
+
+	// Screening, students of selective internships apply before they can enroll
+	ApplicationRequired bool                   `json:"application_required"`
+	ApplicationForm     *types.ApplicationForm `json:"application_form,omitempty"`
+
+	// Ratings of the published reviews, kept up to date by the review service
+	RatingAverage float64 `json:"rating_average"`
+	RatingCount   int     `json:"rating_count"`
+
 	types.BaseModel
 }
 
@@ -34,24 +57,37 @@ func (i *Internship) FromEnt(internship *ent.Internship) *Internship {
 	c := &Category{}
 
 	return &Internship{
-		ID:                 internship.ID,
-		Title:              internship.Title,
-		Description:        internship.Description,
-		LookupKey:          internship.LookupKey,
-		Skills:             internship.Skills,
-		Subtotal:           internship.Subtotal,
-		Total:              internship.Total,
-		Level:              types.InternshipLevel(internship.Level),
-		Mode:               types.InternshipMode(internship.Mode),
-		DurationInWeeks:    internship.DurationInWeeks,
-		LearningOutcomes:   internship.LearningOutcomes,
-		Prerequisites:      internship.Prerequisites,
-		Benefits:           internship.Benefits,
-		Currency:           internship.Currency,
-		Price:              internship.Price,
-		FlatDiscount:       internship.FlatDiscount,
-		PercentageDiscount: internship.PercentageDiscount,
-		Categories:         c.FromEntList(internship.Edges.Categories),
+		ID:                  internship.ID,
+		Title:               internship.Title,
+		Description:         internship.Description,
+		LookupKey:           internship.LookupKey,
+		Skills:              internship.Skills,
+		Subtotal:            internship.Subtotal,
+		Total:               internship.Total,
+		Level:               types.InternshipLevel(internship.Level),
+		Mode:                types.InternshipMode(internship.Mode),
+		DurationInWeeks:     internship.DurationInWeeks,
+		LearningOutcomes:    internship.LearningOutcomes,
+		Prerequisites:       internship.Prerequisites,
+		Benefits:            internship.Benefits,
+		Currency:            internship.Currency,
+		Price:               internship.Price,
+		FlatDiscount:        internship.FlatDiscount,
+		PercentageDiscount:  internship.PercentageDiscount,
+		Categories:          c.FromEntList(internship.Edges.Categories),
+		PublishStatus:       internship.PublishStatus,
+		Draft:               internship.Draft,
+		DraftStatus:         internship.DraftStatus,
+		ReviewComment:       internship.ReviewComment,
+		SubmittedAt:         internship.SubmittedAt,
+		ReviewedAt:          internship.ReviewedAt,
+		ReviewedBy:          internship.ReviewedBy,
+		PublishedAt:         internship.PublishedAt,
+		CurrentRevisionID:   internship.CurrentRevisionID,
+		ApplicationRequired: internship.ApplicationRequired,
+		ApplicationForm:     internship.ApplicationForm,
+		RatingAverage:       internship.RatingAverage,
+		RatingCount:         internship.RatingCount,
 		BaseModel: types.BaseModel{
 			Status:    types.Status(internship.Status),
 			CreatedAt: internship.CreatedAt,
@@ -62,6 +98,53 @@ func (i *Internship) FromEnt(internship *ent.Internship) *Internship {
 	}
 }
 
+// IsPublished reports whether the internship is live in the catalog
+func (i *Internship) IsPublished() bool {
+	return i.PublishStatus == types.InternshipPublishStatusPublished
+}
+
+// Snapshot returns the editable content of the internship
+func (i *Internship) Snapshot() *types.InternshipSnapshot {
+	return &types.InternshipSnapshot{
+		Title:              i.Title,
+		LookupKey:          i.LookupKey,
+		Description:        i.Description,
+		Skills:             i.Skills,
+		Level:              i.Level,
+		Mode:               i.Mode,
+		DurationInWeeks:    i.DurationInWeeks,
+		LearningOutcomes:   i.LearningOutcomes,
+		Prerequisites:      i.Prerequisites,
+		Benefits:           i.Benefits,
+		Currency:           i.Currency,
+		Price:              i.Price,
+		FlatDiscount:       i.FlatDiscount,
+		PercentageDiscount: i.PercentageDiscount,
+		Subtotal:           i.Subtotal,
+		Total:              i.Total,
+	}
+}
+
+// ApplySnapshot replaces the editable content of the internship
+func (i *Internship) ApplySnapshot(snapshot *types.InternshipSnapshot) {
+	i.Title = snapshot.Title
+	i.LookupKey = snapshot.LookupKey
+	i.Description = snapshot.Description
+	i.Skills = snapshot.Skills
+	i.Level = snapshot.Level
+	i.Mode = snapshot.Mode
+	i.DurationInWeeks = snapshot.DurationInWeeks
+	i.LearningOutcomes = snapshot.LearningOutcomes
+	i.Prerequisites = snapshot.Prerequisites
+	i.Benefits = snapshot.Benefits
+	i.Currency = snapshot.Currency
+	i.Price = snapshot.Price
+	i.FlatDiscount = snapshot.FlatDiscount
+	i.PercentageDiscount = snapshot.PercentageDiscount
+	i.Subtotal = snapshot.Subtotal
+	i.Total = snapshot.Total
+}
+
 func (i *Internship) FromEntList(internships []*ent.Internship) []*Internship {
 	return lo.Map(internships, func(internship *ent.Internship, _ int) *Internship {
 		return i.FromEnt(internship)
@@ -0,0 +1,179 @@
+package internship
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// RecommendationWeights caps how much each signal adds to a recommendation's score
+type RecommendationWeights struct {
+	Skill        float64
+	Prerequisite float64
+	Level        float64
+	Popularity   float64
+}
+
+// Learner is what recommendations know about the user they are ranked for
+type Learner struct {
+	// Skills from the user's profile
+	Skills []string
+	// Completed internships, their skills count as the user's and their levels set the progression
+	Completed []*Internship
+}
+
+// knownSkills returns the profile skills together with the ones taught by completed internships
+func (l *Learner) knownSkills() []string {
+	skills := append([]string{}, l.Skills...)
+	for _, internship := range l.Completed {
+		skills = append(skills, internship.Skills...)
+	}
+	return types.NormalizeSkills(skills)
+}
+
+// highestLevel returns the highest level among completed internships, ok is false when there are none
+func (l *Learner) highestLevel() (level types.InternshipLevel, ok bool) {
+	for _, internship := range l.Completed {
+		if internship.Level.Rank() > level.Rank() {
+			level, ok = internship.Level, true
+		}
+	}
+	return level, ok
+}
+
+// Recommendation is a ranked internship with the reasons it was recommended
+type Recommendation struct {
+	Internship  *Internship                  `json:"internship"`
+	Score       float64                      `json:"score"`
+	Enrollments int                          `json:"enrollments"`
+	Reasons     []types.RecommendationReason `json:"reasons"`
+}
+
+// Recommend scores candidates for the learner and returns them best first. Candidates the learner
+// already completed are left out, as are ones nothing recommends. enrollments holds the number of
+// enrollments of each candidate, keyed by internship id. Ties are broken by enrollments, then by
+// id, so the same input always gives the same order
+func Recommend(learner *Learner, candidates []*Internship, enrollments map[string]int, weights RecommendationWeights) []*Recommendation {
+	completed := lo.SliceToMap(learner.Completed, func(internship *Internship) (string, bool) {
+		return internship.ID, true
+	})
+	known := learner.knownSkills()
+	highest, hasLevel := learner.highestLevel()
+
+	mostEnrolled := lo.Max(lo.Map(candidates, func(internship *Internship, _ int) int {
+		return enrollments[internship.ID]
+	}))
+
+	recommendations := make([]*Recommendation, 0, len(candidates))
+	for _, internship := range candidates {
+		if completed[internship.ID] {
+			continue
+		}
+
+		recommendation := &Recommendation{
+			Internship:  internship,
+			Enrollments: enrollments[internship.ID],
+		}
+
+		if skills := types.NormalizeSkills(internship.Skills); len(skills) > 0 {
+			if matched := types.MatchSkills(skills, known); len(matched) > 0 {
+				recommendation.addReason(types.RecommendationReason{
+					Type:    types.RecommendationReasonSkillMatch,
+					Message: fmt.Sprintf("Builds on %s, which you already know", strings.Join(matched, ", ")),
+					Skills:  matched,
+					Score:   weights.Skill * float64(len(matched)) / float64(len(skills)),
+				})
+			}
+		}
+
+		if prerequisites := types.NormalizeSkills(internship.Prerequisites); len(prerequisites) > 0 {
+			met := types.MatchSkills(prerequisites, known)
+			if len(met) == len(prerequisites) {
+				recommendation.addReason(types.RecommendationReason{
+					Type:    types.RecommendationReasonPrerequisitesMet,
+					Message: "You meet all of its prerequisites",
+					Skills:  met,
+					Score:   weights.Prerequisite,
+				})
+			} else if len(met) > 0 {
+				recommendation.addReason(types.RecommendationReason{
+					Type:    types.RecommendationReasonPrerequisitesMet,
+					Message: fmt.Sprintf("You meet %d of its %d prerequisites", len(met), len(prerequisites)),
+					Skills:  met,
+					Score:   weights.Prerequisite * float64(len(met)) / float64(len(prerequisites)),
+				})
+			}
+		}
+
+		if reason, ok := levelReason(internship.Level, highest, hasLevel, weights.Level); ok {
+			recommendation.addReason(reason)
+		}
+
+		if mostEnrolled > 0 && recommendation.Enrollments > 0 {
+			recommendation.addReason(types.RecommendationReason{
+				Type:    types.RecommendationReasonPopular,
+				Message: fmt.Sprintf("%d students enrolled", recommendation.Enrollments),
+				Score:   weights.Popularity * float64(recommendation.Enrollments) / float64(mostEnrolled),
+			})
+		}
+
+		if len(recommendation.Reasons) == 0 {
+			continue
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Enrollments != b.Enrollments {
+			return a.Enrollments > b.Enrollments
+		}
+		return a.Internship.ID < b.Internship.ID
+	})
+
+	return recommendations
+}
+
+// levelReason scores the level of an internship against the highest level the learner completed,
+// the next level up scores full weight and the same level half. Learners who completed nothing
+// are pointed at beginner internships
+func levelReason(level, highest types.InternshipLevel, hasLevel bool, weight float64) (types.RecommendationReason, bool) {
+	if !hasLevel {
+		if level != types.InternshipLevelBeginner {
+			return types.RecommendationReason{}, false
+		}
+		return types.RecommendationReason{
+			Type:    types.RecommendationReasonLevelProgression,
+			Message: "A beginner internship to get you started",
+			Score:   weight,
+		}, true
+	}
+
+	switch level.Rank() - highest.Rank() {
+	case 1:
+		return types.RecommendationReason{
+			Type:    types.RecommendationReasonLevelProgression,
+			Message: fmt.Sprintf("The next step up from the %s internships you completed", highest),
+			Score:   weight,
+		}, true
+	case 0:
+		return types.RecommendationReason{
+			Type:    types.RecommendationReasonLevelProgression,
+			Message: fmt.Sprintf("Another %s internship like the ones you completed", highest),
+			Score:   weight / 2,
+		}, true
+	}
+
+	return types.RecommendationReason{}, false
+}
+
+func (r *Recommendation) addReason(reason types.RecommendationReason) {
+	r.Reasons = append(r.Reasons, reason)
+	r.Score += reason.Score
+}
@@ -15,17 +15,31 @@ type InternshipRepository interface {
 	Count(ctx context.Context, filter *types.InternshipFilter) (int, error)
 	List(ctx context.Context, filter *types.InternshipFilter) ([]*Internship, error)
 	ListAll(ctx context.Context, filter *types.InternshipFilter) ([]*Internship, error)
+
+	// UpdateRating stores the average rating and the number of published reviews of an internship
+	UpdateRating(ctx context.Context, id string, average float64, count int) error
+
+	// Search runs the catalog search, results are ordered by relevance when a query is given
+	Search(ctx context.Context, filter *types.InternshipSearchFilter) ([]*Internship, error)
+	SearchCount(ctx context.Context, filter *types.InternshipSearchFilter) (int, error)
+	SearchFacets(ctx context.Context, filter *types.InternshipSearchFilter) (*types.InternshipSearchFacets, error)
 }
 
 type CategoryRepository interface {
 	Create(ctx context.Context, category *Category) error
 	Get(ctx context.Context, id string) (*Category, error)
 	GetByLookupKey(ctx context.Context, lookupKey string) (*Category, error)
+	GetBySlug(ctx context.Context, slug string) (*Category, error)
 	Update(ctx context.Context, category *Category) error
 	Delete(ctx context.Context, id string) error
 	Count(ctx context.Context, filter *types.CategoryFilter) (int, error)
 	List(ctx context.Context, filter *types.CategoryFilter) ([]*Category, error)
 	ListAll(ctx context.Context, filter *types.CategoryFilter) ([]*Category, error)
+
+	// ListPublishedInternshipIDs returns the ids of the published internships
+	// directly in each category, keyed by category id
+	ListPublishedInternshipIDs(ctx context.Context) (map[string][]string, error)
+	UpdateInternshipCounts(ctx context.Context, id string, count int, totalCount int) error
 }
 
 type InternshipBatchRepository interface {
@@ -37,3 +51,24 @@ type InternshipBatchRepository interface {
 	List(ctx context.Context, filter *types.InternshipBatchFilter) ([]*InternshipBatch, error)
 	ListAll(ctx context.Context, filter *types.InternshipBatchFilter) ([]*InternshipBatch, error)
 }
+
+// InternshipRevisionRepository stores revisions, they are never updated once written
+type InternshipRevisionRepository interface {
+	Create(ctx context.Context, revision *InternshipRevision) error
+	Get(ctx context.Context, id string) (*InternshipRevision, error)
+	// GetLatest returns the highest numbered revision of an internship
+	GetLatest(ctx context.Context, internshipID string) (*InternshipRevision, error)
+	Count(ctx context.Context, filter *types.InternshipRevisionFilter) (int, error)
+	List(ctx context.Context, filter *types.InternshipRevisionFilter) ([]*InternshipRevision, error)
+}
+
+type InternshipInstructorRepository interface {
+	Create(ctx context.Context, instructor *InternshipInstructor) error
+	Get(ctx context.Context, id string) (*InternshipInstructor, error)
+	GetByInternshipAndUser(ctx context.Context, internshipID string, userID string) (*InternshipInstructor, error)
+	Update(ctx context.Context, instructor *InternshipInstructor) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.InternshipInstructorFilter) (int, error)
+	List(ctx context.Context, filter *types.InternshipInstructorFilter) ([]*InternshipInstructor, error)
+	ListAll(ctx context.Context, filter *types.InternshipInstructorFilter) ([]*InternshipInstructor, error)
+}
@@ -0,0 +1,41 @@
+package internship
+
+import (
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InternshipRevision is an immutable snapshot of the content of an internship
+type InternshipRevision struct {
+	ID             string                    `json:"id,omitempty"`
+	InternshipID   string                    `json:"internship_id,omitempty"`
+	Revision       int                       `json:"revision,omitempty"`
+	Snapshot       *types.InternshipSnapshot `json:"snapshot,omitempty"`
+	RestoredFromID *string                   `json:"restored_from_id,omitempty"`
+
+	types.BaseModel
+}
+
+func (r *InternshipRevision) FromEnt(ent *ent.InternshipRevision) *InternshipRevision {
+	return &InternshipRevision{
+		ID:             ent.ID,
+		InternshipID:   ent.InternshipID,
+		Revision:       ent.Revision,
+		Snapshot:       ent.Snapshot,
+		RestoredFromID: ent.RestoredFromID,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (r *InternshipRevision) FromEntList(ents []*ent.InternshipRevision) []*InternshipRevision {
+	return lo.Map(ents, func(ent *ent.InternshipRevision, _ int) *InternshipRevision {
+		return r.FromEnt(ent)
+	})
+}
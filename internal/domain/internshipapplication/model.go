@@ -0,0 +1,72 @@
+package internshipapplication
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InternshipApplication is a student applying to a batch of a selective internship
+type InternshipApplication struct {
+	ID                string                    `json:"id,omitempty"`
+	InternshipID      string                    `json:"internship_id,omitempty"`
+	InternshipBatchID string                    `json:"internship_batch_id,omitempty"`
+	UserID            string                    `json:"user_id,omitempty"`
+	Answers           []types.ApplicationAnswer `json:"answers"`
+	ResumeFileID      *string                   `json:"resume_file_id,omitempty"`
+	ApplicationStatus types.ApplicationStatus   `json:"application_status,omitempty"`
+	InterviewAt       *time.Time                `json:"interview_at,omitempty"`
+
+	// ReviewerNote is left by the instructor who moved the application last
+	ReviewerNote *string    `json:"reviewer_note,omitempty"`
+	ReviewedBy   *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+
+	// OfferExpiresAt is when accepted applicants lose their place if they haven't enrolled
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+
+	types.BaseModel
+}
+
+// InScreening reports whether instructors still have to decide on the application
+func (a *InternshipApplication) InScreening() bool {
+	return lo.Contains(types.ApplicationStatusesInScreening, a.ApplicationStatus)
+}
+
+// CanEnroll reports whether the applicant may enroll in the batch at now
+func (a *InternshipApplication) CanEnroll(now time.Time) bool {
+	return a.ApplicationStatus == types.ApplicationStatusAccepted &&
+		a.OfferExpiresAt != nil && now.Before(*a.OfferExpiresAt)
+}
+
+func (a *InternshipApplication) FromEnt(ent *ent.InternshipApplication) *InternshipApplication {
+	return &InternshipApplication{
+		ID:                ent.ID,
+		InternshipID:      ent.InternshipID,
+		InternshipBatchID: ent.InternshipBatchID,
+		UserID:            ent.UserID,
+		Answers:           ent.Answers,
+		ResumeFileID:      ent.ResumeFileID,
+		ApplicationStatus: types.ApplicationStatus(ent.ApplicationStatus),
+		InterviewAt:       ent.InterviewAt,
+		ReviewerNote:      ent.ReviewerNote,
+		ReviewedBy:        ent.ReviewedBy,
+		ReviewedAt:        ent.ReviewedAt,
+		OfferExpiresAt:    ent.OfferExpiresAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (a *InternshipApplication) FromEntList(ents []*ent.InternshipApplication) []*InternshipApplication {
+	return lo.Map(ents, func(ent *ent.InternshipApplication, _ int) *InternshipApplication {
+		return a.FromEnt(ent)
+	})
+}
@@ -0,0 +1,18 @@
+package internshipapplication
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, application *InternshipApplication) error
+	Get(ctx context.Context, id string) (*InternshipApplication, error)
+	// GetActive returns the application of a student to a batch that wasn't withdrawn
+	GetActive(ctx context.Context, batchID string, userID string) (*InternshipApplication, error)
+	Update(ctx context.Context, application *InternshipApplication) error
+	Count(ctx context.Context, filter *types.InternshipApplicationFilter) (int, error)
+	List(ctx context.Context, filter *types.InternshipApplicationFilter) ([]*InternshipApplication, error)
+	ListAll(ctx context.Context, filter *types.InternshipApplicationFilter) ([]*InternshipApplication, error)
+}
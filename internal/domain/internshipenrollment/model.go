@@ -12,32 +12,62 @@ type InternshipEnrollment struct {
 	ID                 string                           `json:"id,omitempty"`
 	UserID             string                           `json:"user_id,omitempty"`
 	InternshipID       string                           `json:"internship_id,omitempty"`
+	InternshipBatchID  string                           `json:"internship_batch_id,omitempty"`
 	EnrollmentStatus   types.InternshipEnrollmentStatus `json:"enrollment_status,omitempty"`
 	PaymentStatus      types.PaymentStatus              `json:"payment_status,omitempty"`
 	EnrolledAt         *time.Time                       `json:"enrolled_at,omitempty"`
 	PaymentID          *string                          `json:"payment_id,omitempty"`
 	RefundedAt         *time.Time                       `json:"refunded_at,omitempty"`
+	CompletedAt        *time.Time                       `json:"completed_at,omitempty"`
 	CancellationReason *string                          `json:"cancellation_reason,omitempty"`
 	RefundReason       *string                          `json:"refund_reason,omitempty"`
 	IdempotencyKey     *string                          `json:"idempotency_key,omitempty"`
-	types.Metadata     `json:"metadata,omitempty"`
+	PaymentPlanID      *string                          `json:"payment_plan_id,omitempty"`
+
+	// Revision of the internship the student enrolled under
+	InternshipRevisionID *string `json:"internship_revision_id,omitempty"`
+
+	types.Metadata `json:"metadata,omitempty"`
 	types.BaseModel
 }
 
+// IsActive reports whether the student is taking part in the batch and the batch has not completed yet.
+// Installments are not checked here, the installment job suspends enrollments that fall behind.
+func (e *InternshipEnrollment) IsActive() bool {
+	return e.EnrollmentStatus == types.InternshipEnrollmentStatusEnrolled
+}
+
+// HasCompleted reports whether the student completed the batch, completed_at is only set by the
+// batch lifecycle once the completion criteria are met
+func (e *InternshipEnrollment) HasCompleted() bool {
+	return e.EnrollmentStatus == types.InternshipEnrollmentStatusCompleted && e.CompletedAt != nil
+}
+
+// HasAccess reports whether the enrollment grants access to the internship content, current or past
+func (e *InternshipEnrollment) HasAccess() bool {
+	return e.IsActive() || e.EnrollmentStatus == types.InternshipEnrollmentStatusCompleted
+}
+
 func FromEnt(ent *ent.InternshipEnrollment) *InternshipEnrollment {
 	return &InternshipEnrollment{
-		ID:                 ent.ID,
-		UserID:             ent.UserID,
-		InternshipID:       ent.InternshipID,
-		EnrollmentStatus:   ent.EnrollmentStatus,
-		PaymentStatus:      ent.PaymentStatus,
-		EnrolledAt:         ent.EnrolledAt,
-		PaymentID:          ent.PaymentID,
-		RefundedAt:         ent.RefundedAt,
-		CancellationReason: ent.CancellationReason,
-		RefundReason:       ent.RefundReason,
-		Metadata:           types.MetadataFromEnt(ent.Metadata),
+		ID:                   ent.ID,
+		UserID:               ent.UserID,
+		InternshipID:         ent.InternshipID,
+		InternshipBatchID:    ent.InternshipBatchID,
+		InternshipRevisionID: ent.InternshipRevisionID,
+		EnrollmentStatus:     ent.EnrollmentStatus,
+		PaymentStatus:        ent.PaymentStatus,
+		EnrolledAt:           ent.EnrolledAt,
+		PaymentID:            ent.PaymentID,
+		RefundedAt:           ent.RefundedAt,
+		CompletedAt:          ent.CompletedAt,
+		CancellationReason:   ent.CancellationReason,
+		RefundReason:         ent.RefundReason,
+		IdempotencyKey:       ent.IdempotencyKey,
+		PaymentPlanID:        ent.PaymentPlanID,
+		Metadata:             types.MetadataFromEnt(ent.Metadata),
 		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
 			CreatedAt: ent.CreatedAt,
 			UpdatedAt: ent.UpdatedAt,
 			CreatedBy: ent.CreatedBy,
@@ -15,4 +15,8 @@ type Repository interface {
 	List(ctx context.Context, filter *types.InternshipEnrollmentFilter) ([]*InternshipEnrollment, error)
 	ListAll(ctx context.Context, filter *types.InternshipEnrollmentFilter) ([]*InternshipEnrollment, error)
 	GetByIdempotencyKey(ctx context.Context, idempotencyKey string) (*InternshipEnrollment, error)
+
+	// CountByInternship returns the number of enrollments in one of the statuses for each of the
+	// internships, keyed by internship id, internships without any are left out
+	CountByInternship(ctx context.Context, internshipIDs []string, statuses []types.InternshipEnrollmentStatus) (map[string]int, error)
 }
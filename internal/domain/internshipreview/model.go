@@ -0,0 +1,92 @@
+package internshipreview
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InternshipReview is the rating and review a student left for an internship they completed
+type InternshipReview struct {
+	ID           string             `json:"id,omitempty"`
+	InternshipID string             `json:"internship_id,omitempty"`
+	UserID       string             `json:"user_id,omitempty"`
+	EnrollmentID string             `json:"enrollment_id,omitempty"`
+	Rating       int                `json:"rating"`
+	Title        *string            `json:"title,omitempty"`
+	Body         string             `json:"body,omitempty"`
+	ReviewStatus types.ReviewStatus `json:"review_status,omitempty"`
+
+	// Reply of an instructor of the internship
+	Reply     *string    `json:"reply,omitempty"`
+	RepliedBy *string    `json:"replied_by,omitempty"`
+	RepliedAt *time.Time `json:"replied_at,omitempty"`
+
+	// Moderation
+	Reports        []types.ReviewReport `json:"reports,omitempty"`
+	ReportCount    int                  `json:"report_count"`
+	ModerationNote *string              `json:"moderation_note,omitempty"`
+	ModeratedBy    *string              `json:"moderated_by,omitempty"`
+	ModeratedAt    *time.Time           `json:"moderated_at,omitempty"`
+
+	types.BaseModel
+}
+
+// IsPublished reports whether the review is shown and counts towards the rating of its internship
+func (r *InternshipReview) IsPublished() bool {
+	return r.Status == types.StatusPublished && r.ReviewStatus == types.ReviewStatusPublished
+}
+
+// ReportedBy reports whether the user already reported the review
+func (r *InternshipReview) ReportedBy(userID string) bool {
+	return lo.ContainsBy(r.Reports, func(report types.ReviewReport) bool {
+		return report.UserID == userID
+	})
+}
+
+// Summarize returns the average rating and the number of the published reviews
+func Summarize(reviews []*InternshipReview) (average float64, count int) {
+	ratings := lo.FilterMap(reviews, func(review *InternshipReview, _ int) (int, bool) {
+		return review.Rating, review.IsPublished()
+	})
+	if len(ratings) == 0 {
+		return 0, 0
+	}
+	return float64(lo.Sum(ratings)) / float64(len(ratings)), len(ratings)
+}
+
+func (r *InternshipReview) FromEnt(ent *ent.InternshipReview) *InternshipReview {
+	return &InternshipReview{
+		ID:             ent.ID,
+		InternshipID:   ent.InternshipID,
+		UserID:         ent.UserID,
+		EnrollmentID:   ent.EnrollmentID,
+		Rating:         ent.Rating,
+		Title:          ent.Title,
+		Body:           ent.Body,
+		ReviewStatus:   types.ReviewStatus(ent.ReviewStatus),
+		Reply:          ent.Reply,
+		RepliedBy:      ent.RepliedBy,
+		RepliedAt:      ent.RepliedAt,
+		Reports:        ent.Reports,
+		ReportCount:    ent.ReportCount,
+		ModerationNote: ent.ModerationNote,
+		ModeratedBy:    ent.ModeratedBy,
+		ModeratedAt:    ent.ModeratedAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (r *InternshipReview) FromEntList(ents []*ent.InternshipReview) []*InternshipReview {
+	return lo.Map(ents, func(ent *ent.InternshipReview, _ int) *InternshipReview {
+		return r.FromEnt(ent)
+	})
+}
@@ -0,0 +1,19 @@
+package internshipreview
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, review *InternshipReview) error
+	Get(ctx context.Context, id string) (*InternshipReview, error)
+	// GetByInternshipAndUser returns the review a student left for an internship
+	GetByInternshipAndUser(ctx context.Context, internshipID string, userID string) (*InternshipReview, error)
+	Update(ctx context.Context, review *InternshipReview) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.InternshipReviewFilter) (int, error)
+	List(ctx context.Context, filter *types.InternshipReviewFilter) ([]*InternshipReview, error)
+	ListAll(ctx context.Context, filter *types.InternshipReviewFilter) ([]*InternshipReview, error)
+}
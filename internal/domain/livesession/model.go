@@ -0,0 +1,178 @@
+package livesession
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// LiveSession is a scheduled online class of an internship batch
+type LiveSession struct {
+	ID                string    `json:"id,omitempty"`
+	InternshipID      string    `json:"internship_id,omitempty"`
+	InternshipBatchID string    `json:"internship_batch_id,omitempty"`
+	Title             string    `json:"title,omitempty"`
+	Description       string    `json:"description,omitempty"`
+	StartsAt          time.Time `json:"starts_at,omitempty"`
+	EndsAt            time.Time `json:"ends_at,omitempty"`
+	MeetingURL        string    `json:"meeting_url,omitempty"`
+
+	// RecordingFileID is a recording uploaded to file storage, RecordingURL one hosted elsewhere
+	RecordingFileID *string `json:"recording_file_id,omitempty"`
+	RecordingURL    *string `json:"recording_url,omitempty"`
+
+	SessionStatus types.LiveSessionStatus `json:"session_status,omitempty"`
+	CancelledAt   *time.Time              `json:"cancelled_at,omitempty"`
+
+	types.BaseModel
+}
+
+// Validate checks the schedule and the recording of the session fit together
+func (s *LiveSession) Validate() error {
+	if !s.EndsAt.After(s.StartsAt) {
+		return ierr.NewError("live session ends before it starts").
+			WithHint("End time must be after the start time").
+			WithReportableDetails(map[string]any{
+				"starts_at": s.StartsAt,
+				"ends_at":   s.EndsAt,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if s.RecordingFileID != nil && s.RecordingURL != nil {
+		return ierr.NewError("live session has two recordings").
+			WithHint("Give either an uploaded recording or a recording link, not both").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// IsCancelled reports whether the session was called off
+func (s *LiveSession) IsCancelled() bool {
+	return s.SessionStatus == types.LiveSessionStatusCancelled
+}
+
+// HasEnded reports whether the session was over at now
+func (s *LiveSession) HasEnded(now time.Time) bool {
+	return !now.Before(s.EndsAt)
+}
+
+func (s *LiveSession) FromEnt(ent *ent.LiveSession) *LiveSession {
+	return &LiveSession{
+		ID:                ent.ID,
+		InternshipID:      ent.InternshipID,
+		InternshipBatchID: ent.InternshipBatchID,
+		Title:             ent.Title,
+		Description:       ent.Description,
+		StartsAt:          ent.StartsAt,
+		EndsAt:            ent.EndsAt,
+		MeetingURL:        ent.MeetingURL,
+		RecordingFileID:   ent.RecordingFileID,
+		RecordingURL:      ent.RecordingURL,
+		SessionStatus:     types.LiveSessionStatus(ent.SessionStatus),
+		CancelledAt:       ent.CancelledAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (s *LiveSession) FromEntList(ents []*ent.LiveSession) []*LiveSession {
+	return lo.Map(ents, func(ent *ent.LiveSession, _ int) *LiveSession {
+		return s.FromEnt(ent)
+	})
+}
+
+// Attendance is whether the student of an enrollment attended a live session
+type Attendance struct {
+	ID                string                 `json:"id,omitempty"`
+	LiveSessionID     string                 `json:"live_session_id,omitempty"`
+	InternshipBatchID string                 `json:"internship_batch_id,omitempty"`
+	EnrollmentID      string                 `json:"enrollment_id,omitempty"`
+	UserID            string                 `json:"user_id,omitempty"`
+	AttendanceStatus  types.AttendanceStatus `json:"attendance_status,omitempty"`
+
+	// JoinedAt is when the student first joined, LastJoinedAt when their latest stay started
+	JoinedAt     *time.Time `json:"joined_at,omitempty"`
+	LastJoinedAt *time.Time `json:"last_joined_at,omitempty"`
+	LeftAt       *time.Time `json:"left_at,omitempty"`
+	// DurationSeconds is the time spent in the session over every stay that ended
+	DurationSeconds int `json:"duration_seconds"`
+
+	// MarkedBy is the instructor who marked the attendance, nil when it comes from join events
+	MarkedBy *string `json:"marked_by,omitempty"`
+
+	types.BaseModel
+}
+
+// InSession reports whether the student joined and hasn't left since
+func (a *Attendance) InSession() bool {
+	return a.LastJoinedAt != nil && (a.LeftAt == nil || a.LeftAt.Before(lo.FromPtr(a.LastJoinedAt)))
+}
+
+// Join records the student entering the session, an instructor marking is kept
+func (a *Attendance) Join(now time.Time) {
+	if a.JoinedAt == nil {
+		a.JoinedAt = lo.ToPtr(now)
+	}
+	if a.MarkedBy == nil {
+		a.AttendanceStatus = types.AttendanceStatusPresent
+	}
+	if !a.InSession() {
+		a.LastJoinedAt = lo.ToPtr(now)
+	}
+}
+
+// Leave records the student leaving the session, adding their stay up to the end of the session
+func (a *Attendance) Leave(now time.Time, session *LiveSession) {
+	if !a.InSession() {
+		return
+	}
+
+	end := now
+	if end.After(session.EndsAt) {
+		end = session.EndsAt
+	}
+
+	if stay := end.Sub(lo.FromPtr(a.LastJoinedAt)); stay > 0 {
+		a.DurationSeconds += int(stay.Seconds())
+	}
+	a.LeftAt = lo.ToPtr(now)
+}
+
+func (a *Attendance) FromEnt(ent *ent.SessionAttendance) *Attendance {
+	return &Attendance{
+		ID:                ent.ID,
+		LiveSessionID:     ent.LiveSessionID,
+		InternshipBatchID: ent.InternshipBatchID,
+		EnrollmentID:      ent.EnrollmentID,
+		UserID:            ent.UserID,
+		AttendanceStatus:  types.AttendanceStatus(ent.AttendanceStatus),
+		JoinedAt:          ent.JoinedAt,
+		LastJoinedAt:      ent.LastJoinedAt,
+		LeftAt:            ent.LeftAt,
+		DurationSeconds:   ent.DurationSeconds,
+		MarkedBy:          ent.MarkedBy,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (a *Attendance) FromEntList(ents []*ent.SessionAttendance) []*Attendance {
+	return lo.Map(ents, func(ent *ent.SessionAttendance, _ int) *Attendance {
+		return a.FromEnt(ent)
+	})
+}
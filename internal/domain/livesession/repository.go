@@ -0,0 +1,27 @@
+package livesession
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, session *LiveSession) error
+	Get(ctx context.Context, id string) (*LiveSession, error)
+	Update(ctx context.Context, session *LiveSession) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.LiveSessionFilter) (int, error)
+	List(ctx context.Context, filter *types.LiveSessionFilter) ([]*LiveSession, error)
+	ListAll(ctx context.Context, filter *types.LiveSessionFilter) ([]*LiveSession, error)
+}
+
+type AttendanceRepository interface {
+	Create(ctx context.Context, attendance *Attendance) error
+	Get(ctx context.Context, id string) (*Attendance, error)
+	GetBySessionAndEnrollment(ctx context.Context, sessionID string, enrollmentID string) (*Attendance, error)
+	Update(ctx context.Context, attendance *Attendance) error
+	Count(ctx context.Context, filter *types.SessionAttendanceFilter) (int, error)
+	List(ctx context.Context, filter *types.SessionAttendanceFilter) ([]*Attendance, error)
+	ListAll(ctx context.Context, filter *types.SessionAttendanceFilter) ([]*Attendance, error)
+}
@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Notification is an in-app notification of a domain event shown in the notification bell of a user
+type Notification struct {
+	ID        string                     `json:"id,omitempty"`
+	UserID    string                     `json:"user_id,omitempty"`
+	EventID   string                     `json:"event_id,omitempty"`
+	EventName string                     `json:"event_name,omitempty"`
+	Category  types.NotificationCategory `json:"category,omitempty"`
+	Title     string                     `json:"title,omitempty"`
+	Body      string                     `json:"body,omitempty"`
+	Data      map[string]any             `json:"data,omitempty"`
+
+	// ReadAt is nil while the notification is unread
+	ReadAt *time.Time `json:"read_at,omitempty"`
+
+	types.BaseModel
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func (n *Notification) FromEnt(ent *ent.Notification) *Notification {
+	return &Notification{
+		ID:        ent.ID,
+		UserID:    ent.UserID,
+		EventID:   ent.EventID,
+		EventName: ent.EventName,
+		Category:  types.NotificationCategory(ent.Category),
+		Title:     ent.Title,
+		Body:      ent.Body,
+		Data:      ent.Data,
+		ReadAt:    ent.ReadAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (n *Notification) FromEntList(ents []*ent.Notification) []*Notification {
+	return lo.Map(ents, func(ent *ent.Notification, _ int) *Notification {
+		return n.FromEnt(ent)
+	})
+}
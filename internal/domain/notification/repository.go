@@ -0,0 +1,19 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, notification *Notification) error
+	Get(ctx context.Context, id string) (*Notification, error)
+	Update(ctx context.Context, notification *Notification) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.NotificationFilter) (int, error)
+	List(ctx context.Context, filter *types.NotificationFilter) ([]*Notification, error)
+	ListAll(ctx context.Context, filter *types.NotificationFilter) ([]*Notification, error)
+	// MarkAllRead marks every unread notification of the user read and returns how many it marked
+	MarkAllRead(ctx context.Context, userID string) (int, error)
+}
@@ -14,16 +14,6 @@ import (
 type Payment struct {
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
-	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// CreatedBy holds the value of the "created_by" field.
-	CreatedBy string `json:"created_by,omitempty"`
-	// UpdatedBy holds the value of the "updated_by" field.
-	UpdatedBy string `json:"updated_by,omitempty"`
 	// IdempotencyKey holds the value of the "idempotency_key" field.
 	IdempotencyKey string `json:"idempotency_key,omitempty"`
 	// DestinationType holds the value of the "destination_type" field.
@@ -54,6 +44,10 @@ type Payment struct {
 	FailedAt *time.Time `json:"failed_at,omitempty"`
 	// RefundedAt holds the value of the "refunded_at" field.
 	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	// InstallmentNumber holds the value of the "installment_number" field.
+	InstallmentNumber *int `json:"installment_number,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate *time.Time `json:"due_date,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage *string           `json:"error_message,omitempty"`
 	Attempts     []*PaymentAttempt `json:"attempts,omitempty"`
@@ -72,6 +66,29 @@ type PaymentAttempt struct {
 	types.BaseModel
 }
 
+// Refund is the refund of a payment to the payment method it was paid with. It is recorded with
+// the rest of the refund and sent to the gateway after, keyed by the payment id so sending it
+// again can't pay out twice
+type Refund struct {
+	ID                     string                       `json:"id"`
+	PaymentID              string                       `json:"payment_id"`
+	PaymentGatewayProvider types.PaymentGatewayProvider `json:"payment_gateway_provider"`
+	GatewayPaymentID       string                       `json:"gateway_payment_id"`
+	Amount                 decimal.Decimal              `json:"amount"`
+	Currency               types.Currency               `json:"currency"`
+	Reason                 string                       `json:"reason,omitempty"`
+	RefundStatus           types.PaymentRefundStatus    `json:"refund_status"`
+	GatewayRefundID        *string                      `json:"gateway_refund_id,omitempty"`
+	ErrorMessage           *string                      `json:"error_message,omitempty"`
+	ProcessedAt            *time.Time                   `json:"processed_at,omitempty"`
+	types.BaseModel
+}
+
+// IsProcessed reports whether the gateway accepted the refund
+func (r *Refund) IsProcessed() bool {
+	return r.RefundStatus == types.PaymentRefundStatusProcessed
+}
+
 // Validate validates the payment
 func (p *Payment) Validate() error {
 
@@ -169,6 +186,8 @@ func FromEnt(p *ent.Payment) *Payment {
 		SucceededAt:            p.SucceededAt,
 		FailedAt:               p.FailedAt,
 		RefundedAt:             p.RefundedAt,
+		InstallmentNumber:      p.InstallmentNumber,
+		DueDate:                p.DueDate,
 		ErrorMessage:           p.ErrorMessage,
 		Metadata:               p.Metadata,
 		BaseModel: types.BaseModel{
@@ -211,6 +230,34 @@ func FromEntAttempt(a *ent.PaymentAttempt) *PaymentAttempt {
 	}
 }
 
+// FromEntRefund converts an Ent payment refund to domain model
+func FromEntRefund(r *ent.PaymentRefund) *Refund {
+	if r == nil {
+		return nil
+	}
+
+	return &Refund{
+		ID:                     r.ID,
+		PaymentID:              r.PaymentID,
+		PaymentGatewayProvider: r.PaymentGatewayProvider,
+		GatewayPaymentID:       r.GatewayPaymentID,
+		Amount:                 r.Amount,
+		Currency:               r.Currency,
+		Reason:                 r.Reason,
+		RefundStatus:           r.RefundStatus,
+		GatewayRefundID:        r.GatewayRefundID,
+		ErrorMessage:           r.ErrorMessage,
+		ProcessedAt:            r.ProcessedAt,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(r.Status),
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+			CreatedBy: r.CreatedBy,
+			UpdatedBy: r.UpdatedBy,
+		},
+	}
+}
+
 // FromEntList converts a list of Ent payments to domain payments
 func FromEntList(payments []*ent.Payment) []*Payment {
 	result := make([]*Payment, 0, len(payments))
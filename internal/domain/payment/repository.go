@@ -23,4 +23,9 @@ type Repository interface {
 	UpdateAttempt(ctx context.Context, attempt *PaymentAttempt) error
 	ListAttempts(ctx context.Context, paymentID string) ([]*PaymentAttempt, error)
 	GetLatestAttempt(ctx context.Context, paymentID string) (*PaymentAttempt, error)
+
+	// Payment refund operations
+	CreateRefund(ctx context.Context, refund *Refund) error
+	GetRefundByPaymentID(ctx context.Context, paymentID string) (*Refund, error)
+	UpdateRefund(ctx context.Context, refund *Refund) error
 }
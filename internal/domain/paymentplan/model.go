@@ -0,0 +1,53 @@
+package paymentplan
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// PaymentPlan splits the price of an internship into installments
+type PaymentPlan struct {
+	ID              string                         `json:"id,omitempty"`
+	InternshipID    string                         `json:"internship_id,omitempty"`
+	Name            string                         `json:"name,omitempty"`
+	Description     string                         `json:"description,omitempty"`
+	Installments    []types.PaymentPlanInstallment `json:"installments,omitempty"`
+	GracePeriodDays int                            `json:"grace_period_days"`
+	IsActive        bool                           `json:"is_active"`
+	types.Metadata  `json:"metadata,omitempty"`
+	types.BaseModel
+}
+
+// GracePeriod is how long an installment may stay unpaid after its due date
+func (p *PaymentPlan) GracePeriod() time.Duration {
+	return time.Duration(p.GracePeriodDays) * 24 * time.Hour
+}
+
+func FromEnt(ent *ent.PaymentPlan) *PaymentPlan {
+	return &PaymentPlan{
+		ID:              ent.ID,
+		InternshipID:    ent.InternshipID,
+		Name:            ent.Name,
+		Description:     ent.Description,
+		Installments:    ent.Installments,
+		GracePeriodDays: ent.GracePeriodDays,
+		IsActive:        ent.IsActive,
+		Metadata:        types.MetadataFromEnt(ent.Metadata),
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(ents []*ent.PaymentPlan) []*PaymentPlan {
+	return lo.Map(ents, func(ent *ent.PaymentPlan, _ int) *PaymentPlan {
+		return FromEnt(ent)
+	})
+}
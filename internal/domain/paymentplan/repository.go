@@ -0,0 +1,17 @@
+package paymentplan
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, plan *PaymentPlan) error
+	Get(ctx context.Context, id string) (*PaymentPlan, error)
+	Update(ctx context.Context, plan *PaymentPlan) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.PaymentPlanFilter) (int, error)
+	List(ctx context.Context, filter *types.PaymentPlanFilter) ([]*PaymentPlan, error)
+	ListAll(ctx context.Context, filter *types.PaymentPlanFilter) ([]*PaymentPlan, error)
+}
@@ -0,0 +1,62 @@
+package referral
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Referral links a referrer to a user who signed up with their referral code
+type Referral struct {
+	ID                string               `json:"id,omitempty"`
+	ReferrerID        string               `json:"referrer_id,omitempty"`
+	RefereeID         string               `json:"referee_id,omitempty"`
+	ReferralCode      string               `json:"referral_code,omitempty"`
+	ReferralStatus    types.ReferralStatus `json:"referral_status,omitempty"`
+	RejectionReason   *string              `json:"rejection_reason,omitempty"`
+	RefereeEmail      string               `json:"-"`
+	RefereePhone      string               `json:"-"`
+	RefereeDiscountID *string              `json:"referee_discount_id,omitempty"`
+	PaymentID         *string              `json:"payment_id,omitempty"`
+	QualifiedAt       *time.Time           `json:"qualified_at,omitempty"`
+	RewardEligibleAt  *time.Time           `json:"reward_eligible_at,omitempty"`
+	RewardedAt        *time.Time           `json:"rewarded_at,omitempty"`
+	RewardDiscountID  *string              `json:"reward_discount_id,omitempty"`
+	types.Metadata    `json:"metadata,omitempty"`
+	types.BaseModel
+}
+
+func FromEnt(ent *ent.Referral) *Referral {
+	return &Referral{
+		ID:                ent.ID,
+		ReferrerID:        ent.ReferrerID,
+		RefereeID:         ent.RefereeID,
+		ReferralCode:      ent.ReferralCode,
+		ReferralStatus:    ent.ReferralStatus,
+		RejectionReason:   ent.RejectionReason,
+		RefereeEmail:      ent.RefereeEmail,
+		RefereePhone:      ent.RefereePhone,
+		RefereeDiscountID: ent.RefereeDiscountID,
+		PaymentID:         ent.PaymentID,
+		QualifiedAt:       ent.QualifiedAt,
+		RewardEligibleAt:  ent.RewardEligibleAt,
+		RewardedAt:        ent.RewardedAt,
+		RewardDiscountID:  ent.RewardDiscountID,
+		Metadata:          types.MetadataFromEnt(ent.Metadata),
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(ents []*ent.Referral) []*Referral {
+	return lo.Map(ents, func(ent *ent.Referral, _ int) *Referral {
+		return FromEnt(ent)
+	})
+}
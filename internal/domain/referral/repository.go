@@ -0,0 +1,24 @@
+package referral
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, referral *Referral) error
+	Get(ctx context.Context, id string) (*Referral, error)
+	GetByRefereeID(ctx context.Context, refereeID string) (*Referral, error)
+	Update(ctx context.Context, referral *Referral) error
+	Count(ctx context.Context, filter *types.ReferralFilter) (int, error)
+	List(ctx context.Context, filter *types.ReferralFilter) ([]*Referral, error)
+	ListAll(ctx context.Context, filter *types.ReferralFilter) ([]*Referral, error)
+
+	// ExistsForIdentity reports whether a referral was already recorded for the given normalized email or phone
+	ExistsForIdentity(ctx context.Context, email string, phone string) (bool, error)
+
+	// ListRewardable returns qualified referrals whose refund window has passed
+	ListRewardable(ctx context.Context, before time.Time) ([]*Referral, error)
+}
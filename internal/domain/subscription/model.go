@@ -0,0 +1,87 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Subscription is a user's recurring billing agreement for a plan
+type Subscription struct {
+	ID                    string                       `json:"id,omitempty"`
+	UserID                string                       `json:"user_id,omitempty"`
+	PlanID                string                       `json:"plan_id,omitempty"`
+	SubscriptionStatus    types.SubscriptionStatus     `json:"subscription_status,omitempty"`
+	GatewayProvider       types.PaymentGatewayProvider `json:"gateway_provider,omitempty"`
+	GatewaySubscriptionID *string                      `json:"gateway_subscription_id,omitempty"`
+	CheckoutURL           *string                      `json:"checkout_url,omitempty"`
+	CurrentPeriodStart    *time.Time                   `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd      *time.Time                   `json:"current_period_end,omitempty"`
+	CancelAtPeriodEnd     bool                         `json:"cancel_at_period_end"`
+	CancelledAt           *time.Time                   `json:"cancelled_at,omitempty"`
+	EndedAt               *time.Time                   `json:"ended_at,omitempty"`
+	FailedPaymentCount    int                          `json:"failed_payment_count"`
+	types.Metadata        `json:"metadata,omitempty"`
+	types.BaseModel
+}
+
+func FromEnt(ent *ent.Subscription) *Subscription {
+	return &Subscription{
+		ID:                    ent.ID,
+		UserID:                ent.UserID,
+		PlanID:                ent.PlanID,
+		SubscriptionStatus:    ent.SubscriptionStatus,
+		GatewayProvider:       ent.GatewayProvider,
+		GatewaySubscriptionID: ent.GatewaySubscriptionID,
+		CheckoutURL:           ent.CheckoutURL,
+		CurrentPeriodStart:    ent.CurrentPeriodStart,
+		CurrentPeriodEnd:      ent.CurrentPeriodEnd,
+		CancelAtPeriodEnd:     ent.CancelAtPeriodEnd,
+		CancelledAt:           ent.CancelledAt,
+		EndedAt:               ent.EndedAt,
+		FailedPaymentCount:    ent.FailedPaymentCount,
+		Metadata:              types.MetadataFromEnt(ent.Metadata),
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(ents []*ent.Subscription) []*Subscription {
+	return lo.Map(ents, func(ent *ent.Subscription, _ int) *Subscription {
+		return FromEnt(ent)
+	})
+}
+
+// Event is a subscription webhook that was applied, kept so a redelivery of it is ignored
+type Event struct {
+	ID              string                       `json:"id,omitempty"`
+	SubscriptionID  string                       `json:"subscription_id,omitempty"`
+	GatewayProvider types.PaymentGatewayProvider `json:"gateway_provider,omitempty"`
+	GatewayEventID  string                       `json:"gateway_event_id,omitempty"`
+	EventStatus     types.SubscriptionStatus     `json:"event_status,omitempty"`
+	types.BaseModel
+}
+
+func FromEntEvent(ent *ent.SubscriptionEvent) *Event {
+	return &Event{
+		ID:              ent.ID,
+		SubscriptionID:  ent.SubscriptionID,
+		GatewayProvider: ent.GatewayProvider,
+		GatewayEventID:  ent.GatewayEventID,
+		EventStatus:     ent.EventStatus,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package subscription
+
+import (
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// Plan is a recurring price granting access to every internship in its categories
+type Plan struct {
+	ID              string                       `json:"id,omitempty"`
+	Name            string                       `json:"name,omitempty"`
+	Description     string                       `json:"description,omitempty"`
+	BillingPeriod   types.BillingPeriod          `json:"billing_period,omitempty"`
+	Amount          decimal.Decimal              `json:"amount"`
+	Currency        types.Currency               `json:"currency,omitempty"`
+	CategoryIDs     []string                     `json:"category_ids,omitempty"`
+	GatewayProvider types.PaymentGatewayProvider `json:"gateway_provider,omitempty"`
+	GatewayPlanID   *string                      `json:"gateway_plan_id,omitempty"`
+	IsActive        bool                         `json:"is_active"`
+	types.Metadata  `json:"metadata,omitempty"`
+	types.BaseModel
+}
+
+// CoversCategory reports whether the plan grants access to internships in the category
+func (p *Plan) CoversCategory(categoryID string) bool {
+	return lo.Contains(p.CategoryIDs, categoryID)
+}
+
+func PlanFromEnt(ent *ent.SubscriptionPlan) *Plan {
+	return &Plan{
+		ID:              ent.ID,
+		Name:            ent.Name,
+		Description:     ent.Description,
+		BillingPeriod:   ent.BillingPeriod,
+		Amount:          ent.Amount,
+		Currency:        ent.Currency,
+		CategoryIDs:     ent.CategoryIds,
+		GatewayProvider: ent.GatewayProvider,
+		GatewayPlanID:   ent.GatewayPlanID,
+		IsActive:        ent.IsActive,
+		Metadata:        types.MetadataFromEnt(ent.Metadata),
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func PlanFromEntList(ents []*ent.SubscriptionPlan) []*Plan {
+	return lo.Map(ents, func(ent *ent.SubscriptionPlan, _ int) *Plan {
+		return PlanFromEnt(ent)
+	})
+}
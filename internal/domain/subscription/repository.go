@@ -0,0 +1,31 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type PlanRepository interface {
+	Create(ctx context.Context, plan *Plan) error
+	Get(ctx context.Context, id string) (*Plan, error)
+	Update(ctx context.Context, plan *Plan) error
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.SubscriptionPlanFilter) (int, error)
+	List(ctx context.Context, filter *types.SubscriptionPlanFilter) ([]*Plan, error)
+	ListAll(ctx context.Context, filter *types.SubscriptionPlanFilter) ([]*Plan, error)
+}
+
+type Repository interface {
+	Create(ctx context.Context, subscription *Subscription) error
+	Get(ctx context.Context, id string) (*Subscription, error)
+	GetByGatewaySubscriptionID(ctx context.Context, provider types.PaymentGatewayProvider, gatewaySubscriptionID string) (*Subscription, error)
+	Update(ctx context.Context, subscription *Subscription) error
+	Count(ctx context.Context, filter *types.SubscriptionFilter) (int, error)
+	List(ctx context.Context, filter *types.SubscriptionFilter) ([]*Subscription, error)
+	ListAll(ctx context.Context, filter *types.SubscriptionFilter) ([]*Subscription, error)
+
+	// Gateway event operations
+	CreateEvent(ctx context.Context, event *Event) error
+	GetEventByGatewayEventID(ctx context.Context, provider types.PaymentGatewayProvider, gatewayEventID string) (*Event, error)
+}
@@ -12,9 +12,41 @@ type User struct {
 	Phone    string         `json:"phone,omitempty" db:"phone"`
 	Role     types.UserRole `json:"role,omitempty" db:"role"`
 	FullName string         `json:"full_name,omitempty" db:"full_name"`
+
+	// referral
+	ReferralCode *string `json:"referral_code,omitempty" db:"referral_code"`
+	ReferredBy   *string `json:"referred_by,omitempty" db:"referred_by"`
+
+	// profile
+	Headline          string                  `json:"headline,omitempty" db:"headline"`
+	Bio               string                  `json:"bio,omitempty" db:"bio"`
+	Skills            []string                `json:"skills,omitempty" db:"skills"`
+	Education         []types.Education       `json:"education,omitempty" db:"education"`
+	Links             []types.ProfileLink     `json:"links,omitempty" db:"links"`
+	ResumeFileID      *string                 `json:"resume_file_id,omitempty" db:"resume_file_id"`
+	PhotoFileID       *string                 `json:"photo_file_id,omitempty" db:"photo_file_id"`
+	ProfileVisibility types.ProfileVisibility `json:"profile_visibility,omitempty" db:"profile_visibility"`
+
+	// notifications
+	NotificationPreferences types.NotificationPreferences `json:"notification_preferences" db:"notification_preferences"`
 	types.BaseModel
 }
 
+// CanViewProfile reports whether a viewer with the given id and role may see the profile,
+// anonymous viewers have neither
+func (u *User) CanViewProfile(viewerID string, viewerRole types.UserRole) bool {
+	switch {
+	case u.ProfileVisibility == types.ProfileVisibilityPublic:
+		return true
+	case viewerID == "":
+		return false
+	case viewerID == u.ID || viewerRole == types.UserRoleAdmin:
+		return true
+	default:
+		return u.ProfileVisibility == types.ProfileVisibilityRecruiters && viewerRole == types.UserRoleRecruiter
+	}
+}
+
 func FromEnt(user *ent.User) *User {
 	return &User{
 		ID:       user.ID,
@@ -22,6 +54,20 @@ func FromEnt(user *ent.User) *User {
 		Phone:    user.PhoneNumber,
 		FullName: user.FullName,
 		Role:     types.UserRole(user.Role),
+
+		ReferralCode: user.ReferralCode,
+		ReferredBy:   user.ReferredBy,
+
+		Headline:          user.Headline,
+		Bio:               user.Bio,
+		Skills:            user.Skills,
+		Education:         user.Education,
+		Links:             user.Links,
+		ResumeFileID:      user.ResumeFileID,
+		PhotoFileID:       user.PhotoFileID,
+		ProfileVisibility: types.ProfileVisibility(user.ProfileVisibility),
+
+		NotificationPreferences: user.NotificationPreferences,
 		BaseModel: types.BaseModel{
 			Status:    types.Status(user.Status),
 			CreatedAt: user.CreatedAt,
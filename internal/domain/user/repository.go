@@ -12,6 +12,7 @@ type Repository interface {
 	Create(ctx context.Context, user *User) error
 	Get(ctx context.Context, id string) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
+	GetByReferralCode(ctx context.Context, code string) (*User, error)
 
 	// List operations
 	List(ctx context.Context, filter *types.UserFilter) ([]*User, error)
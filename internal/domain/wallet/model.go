@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransaction is a single entry of a user's store-credit ledger
+type WalletTransaction struct {
+	ID                  string                        `json:"id,omitempty"`
+	UserID              string                        `json:"user_id,omitempty"`
+	TransactionType     types.WalletTransactionType   `json:"transaction_type,omitempty"`
+	TransactionReason   types.WalletTransactionReason `json:"transaction_reason,omitempty"`
+	Amount              decimal.Decimal               `json:"amount"`
+	Currency            string                        `json:"currency,omitempty"`
+	RemainingAmount     decimal.Decimal               `json:"remaining_amount"`
+	ExpiresAt           *time.Time                    `json:"expires_at,omitempty"`
+	SourceTransactionID *string                       `json:"source_transaction_id,omitempty"`
+	ReferenceID         *string                       `json:"reference_id,omitempty"`
+	Description         string                        `json:"description,omitempty"`
+	types.Metadata      `json:"metadata,omitempty"`
+	types.BaseModel
+}
+
+// IsExpired reports whether a credit can no longer be spent at the given time
+func (t *WalletTransaction) IsExpired(at time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(at)
+}
+
+// LedgerTotals is the sum of a user's ledger entries in one currency
+type LedgerTotals struct {
+	Credits decimal.Decimal `json:"credits"`
+	Debits  decimal.Decimal `json:"debits"`
+}
+
+func FromEnt(ent *ent.WalletTransaction) *WalletTransaction {
+	return &WalletTransaction{
+		ID:                  ent.ID,
+		UserID:              ent.UserID,
+		TransactionType:     ent.TransactionType,
+		TransactionReason:   ent.TransactionReason,
+		Amount:              ent.Amount,
+		Currency:            ent.Currency,
+		RemainingAmount:     ent.RemainingAmount,
+		ExpiresAt:           ent.ExpiresAt,
+		SourceTransactionID: ent.SourceTransactionID,
+		ReferenceID:         ent.ReferenceID,
+		Description:         ent.Description,
+		Metadata:            types.MetadataFromEnt(ent.Metadata),
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(ents []*ent.WalletTransaction) []*WalletTransaction {
+	return lo.Map(ents, func(ent *ent.WalletTransaction, _ int) *WalletTransaction {
+		return FromEnt(ent)
+	})
+}
@@ -0,0 +1,31 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+type Repository interface {
+	Create(ctx context.Context, txn *WalletTransaction) error
+	Get(ctx context.Context, id string) (*WalletTransaction, error)
+	Count(ctx context.Context, filter *types.WalletTransactionFilter) (int, error)
+	List(ctx context.Context, filter *types.WalletTransactionFilter) ([]*WalletTransaction, error)
+	ListAll(ctx context.Context, filter *types.WalletTransactionFilter) ([]*WalletTransaction, error)
+
+	// GetLedgerTotals sums the user's credit and debit entries in the given currency
+	GetLedgerTotals(ctx context.Context, userID string, currency string) (*LedgerTotals, error)
+
+	// ListSpendableCredits returns unexpired credits with a remaining amount, soonest expiring first
+	ListSpendableCredits(ctx context.Context, userID string, currency string, at time.Time) ([]*WalletTransaction, error)
+
+	// ListExpiredCredits returns credits that expired before the given time with a remaining amount.
+	// An empty userID lists expired credits of all users.
+	ListExpiredCredits(ctx context.Context, userID string, before time.Time) ([]*WalletTransaction, error)
+
+	// UpdateRemainingAmount moves a credit's remaining amount from expected to remaining.
+	// It fails with a version conflict if the credit was consumed concurrently.
+	UpdateRemainingAmount(ctx context.Context, id string, expected decimal.Decimal, remaining decimal.Decimal) error
+}
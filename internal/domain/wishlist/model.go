@@ -0,0 +1,37 @@
+package wishlist
+
+import (
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// Wishlist is an internship a user saved for later
+type Wishlist struct {
+	ID           string `json:"id,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	InternshipID string `json:"internship_id,omitempty"`
+
+	types.BaseModel
+}
+
+func (w *Wishlist) FromEnt(ent *ent.Wishlist) *Wishlist {
+	return &Wishlist{
+		ID:           ent.ID,
+		UserID:       ent.UserID,
+		InternshipID: ent.InternshipID,
+		BaseModel: types.BaseModel{
+			Status:    types.Status(ent.Status),
+			CreatedAt: ent.CreatedAt,
+			UpdatedAt: ent.UpdatedAt,
+			CreatedBy: ent.CreatedBy,
+			UpdatedBy: ent.UpdatedBy,
+		},
+	}
+}
+
+func (w *Wishlist) FromEntList(ents []*ent.Wishlist) []*Wishlist {
+	return lo.Map(ents, func(ent *ent.Wishlist, _ int) *Wishlist {
+		return w.FromEnt(ent)
+	})
+}
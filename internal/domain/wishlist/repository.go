@@ -0,0 +1,17 @@
+package wishlist
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type Repository interface {
+	Create(ctx context.Context, wishlist *Wishlist) error
+	// GetByUserAndInternship returns the wishlist entry a user saved an internship with
+	GetByUserAndInternship(ctx context.Context, userID string, internshipID string) (*Wishlist, error)
+	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context, filter *types.WishlistFilter) (int, error)
+	List(ctx context.Context, filter *types.WishlistFilter) ([]*Wishlist, error)
+	ListAll(ctx context.Context, filter *types.WishlistFilter) ([]*Wishlist, error)
+}
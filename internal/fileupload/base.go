@@ -29,6 +29,12 @@ func (p *baseProvider) UploadFile(ctx context.Context, file *FileUpload, fileDat
 		Mark(ierr.ErrSystem)
 }
 
+func (p *baseProvider) UploadContent(ctx context.Context, file *FileUpload, content []byte) (*FileUpload, error) {
+	return nil, ierr.WithError(errors.New("not implemented")).
+		WithHintf("UploadContent is not implemented for provider: %s", p.GetProvider()).
+		Mark(ierr.ErrSystem)
+}
+
 func (p *baseProvider) GetPresignedURL(ctx context.Context, externalID string) (string, error) {
 	return "", ierr.WithError(errors.New("not implemented")).
 		WithHintf("GetPresignedURL is not implemented for provider: %s", p.GetProvider()).
@@ -1,10 +1,10 @@
 package fileupload
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"mime/multipart"
-	"path/filepath"
-	"strings"
 	"time"
 
 	"github.com/cloudinary/cloudinary-go/v2"
@@ -56,18 +56,21 @@ func (p *cloudinaryProvider) UploadFile(ctx context.Context, file *FileUpload, f
 	}
 	defer src.Close()
 
-	// Extract file extension and set up upload parameters
-	ext := strings.ToLower(filepath.Ext(fileData.Filename))
-	if ext != "" && ext[0] == '.' {
-		ext = ext[1:] // Remove the leading dot
-	}
+	return p.upload(ctx, file, src, fileData.Filename, file.FileType)
+}
+
+// UploadContent uploads generated content, Cloudinary detects its resource type
+func (p *cloudinaryProvider) UploadContent(ctx context.Context, file *FileUpload, content []byte) (*FileUpload, error) {
+	return p.upload(ctx, file, bytes.NewReader(content), file.FileName, "auto")
+}
 
+func (p *cloudinaryProvider) upload(ctx context.Context, file *FileUpload, src io.Reader, filename string, resourceType string) (*FileUpload, error) {
 	// Set up Cloudinary upload parameters
 	useFilename := false
 	uniqueFilename := false
 	uploadParams := uploader.UploadParams{
 		PublicID:       file.ID, // Use the FileUpload ID as the public ID
-		ResourceType:   file.FileType,
+		ResourceType:   resourceType,
 		Folder:         "uploads",       // Optional: organize files in a folder
 		UseFilename:    &useFilename,    // Use our custom public ID instead
 		UniqueFilename: &uniqueFilename, // Don't add random characters
@@ -79,7 +82,7 @@ func (p *cloudinaryProvider) UploadFile(ctx context.Context, file *FileUpload, f
 		p.logger.Error("Failed to upload file to Cloudinary", map[string]interface{}{
 			"error":    err.Error(),
 			"file_id":  file.ID,
-			"filename": fileData.Filename,
+			"filename": filename,
 		})
 		return nil, ierr.WithError(err).
 			WithHintf("Failed to upload file to Cloudinary").
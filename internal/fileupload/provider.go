@@ -10,6 +10,8 @@ import (
 type Provider interface {
 	GetProvider() types.FileUploadProvider
 	UploadFile(ctx context.Context, file *FileUpload, fileData *multipart.FileHeader) (*FileUpload, error)
+	// UploadContent uploads a file the server generated, such as a certificate
+	UploadContent(ctx context.Context, file *FileUpload, content []byte) (*FileUpload, error)
 	GetPresignedURL(ctx context.Context, externalID string) (string, error)
 	DownloadFile(ctx context.Context, externalID string) ([]byte, error)
 	Exists(ctx context.Context, externalID string) (bool, error)
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/omkar273/codegeeky/internal/config"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/pubsub"
+	pubsubRouter "github.com/omkar273/codegeeky/internal/pubsub/router"
+	"github.com/omkar273/codegeeky/internal/service"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Handler notifies users in-app and by email of the domain events published on the webhook topic
+type Handler interface {
+	RegisterHandler(router *pubsubRouter.Router) error
+}
+
+type handler struct {
+	pubSub              pubsub.PubSub
+	config              *config.Configuration
+	notificationService service.NotificationService
+	logger              *logger.Logger
+}
+
+func NewHandler(
+	pubSub pubsub.PubSub,
+	config *config.Configuration,
+	notificationService service.NotificationService,
+	logger *logger.Logger,
+) Handler {
+	return &handler{
+		pubSub:              pubSub,
+		config:              config,
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+// RegisterHandler subscribes to domain events, failed notifications are retried by the router's retry middleware
+func (h *handler) RegisterHandler(router *pubsubRouter.Router) error {
+	router.AddNoPublishHandler(
+		"notification_handler",
+		h.config.Webhook.Topic,
+		h.pubSub,
+		h.processMessage,
+	)
+	return nil
+}
+
+func (h *handler) processMessage(msg *message.Message) error {
+	// the event outlives the request that published it
+	ctx := context.WithoutCancel(msg.Context())
+
+	var event types.WebhookEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		h.logger.Errorw("failed to unmarshal notification event",
+			"error", err,
+			"message_uuid", msg.UUID,
+		)
+		return nil // Don't retry on unmarshal errors
+	}
+
+	// retried events are recognized by their id
+	if event.ID == "" {
+		event.ID = msg.UUID
+	}
+
+	return h.notificationService.Notify(ctx, &event)
+}
@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/omkar273/codegeeky/internal/config"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type logProvider struct {
+	directory string
+	logger    *logger.Logger
+}
+
+// NewLogProvider creates a provider that logs emails instead of sending them, and writes
+// them to the configured directory as .eml files that mail clients can open
+func NewLogProvider(cfg *config.Configuration, logger *logger.Logger) (Provider, error) {
+	directory := cfg.Notification.Log.Directory
+	if directory != "" {
+		if err := os.MkdirAll(directory, 0o755); err != nil {
+			return nil, ierr.WithError(err).
+				WithHintf("Failed to create notification directory %s", directory).
+				Mark(ierr.ErrSystem)
+		}
+	}
+
+	return &logProvider{
+		directory: directory,
+		logger:    logger,
+	}, nil
+}
+
+func (p *logProvider) GetProvider() types.NotificationProvider {
+	return types.NotificationProviderLog
+}
+
+func (p *logProvider) Send(ctx context.Context, message *Message) error {
+	p.logger.Infow("email",
+		"message_id", message.ID,
+		"to", message.To.Address,
+		"category", message.Category,
+		"subject", message.Subject,
+		"text", message.Text)
+
+	if p.directory == "" {
+		return nil
+	}
+
+	content, err := message.Bytes()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(p.directory, message.ID+".eml")
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to write email").
+			WithReportableDetails(map[string]any{
+				"message_id": message.ID,
+				"path":       path,
+			}).
+			Mark(ierr.ErrSystem)
+	}
+
+	return nil
+}
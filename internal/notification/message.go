@@ -0,0 +1,94 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Message is an email to a single recipient
+type Message struct {
+	ID       string
+	From     mail.Address
+	To       mail.Address
+	Subject  string
+	Text     string
+	HTML     string
+	SentAt   time.Time
+	Category types.NotificationCategory
+}
+
+// Bytes encodes the message as a MIME email with a plain text and an HTML alternative
+func (m *Message) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	body := multipart.NewWriter(&buf)
+
+	header := []string{
+		"From: " + m.From.String(),
+		"To: " + m.To.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", m.Subject),
+		"Date: " + m.SentAt.Format(time.RFC1123Z),
+		fmt.Sprintf("Message-ID: <%s@%s>", m.ID, domainOf(m.From.Address)),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", body.Boundary()),
+	}
+	buf.WriteString(strings.Join(header, "\r\n") + "\r\n\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain; charset=utf-8", content: m.Text},
+		{contentType: "text/html; charset=utf-8", content: m.HTML},
+	} {
+		if part.content == "" {
+			continue
+		}
+
+		w, err := body.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to encode email").
+				Mark(ierr.ErrSystem)
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to encode email").
+				Mark(ierr.ErrSystem)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to encode email").
+				Mark(ierr.ErrSystem)
+		}
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode email").
+			Mark(ierr.ErrSystem)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func domainOf(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+	return "localhost"
+}
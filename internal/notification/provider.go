@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/config"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// Provider sends emails
+type Provider interface {
+	GetProvider() types.NotificationProvider
+	Send(ctx context.Context, message *Message) error
+}
+
+// NewProvider creates the provider the configuration selects
+func NewProvider(cfg *config.Configuration, logger *logger.Logger) (Provider, error) {
+	switch cfg.Notification.Provider {
+	case types.NotificationProviderSMTP:
+		return NewSMTPProvider(cfg, logger)
+	case types.NotificationProviderLog, "":
+		return NewLogProvider(cfg, logger)
+	default:
+		return nil, ierr.NewErrorf("unknown notification provider %s", cfg.Notification.Provider).
+			WithHintf("Notification provider must be %s or %s", types.NotificationProviderSMTP, types.NotificationProviderLog).
+			Mark(ierr.ErrSystem)
+	}
+}
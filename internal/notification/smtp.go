@@ -0,0 +1,129 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/config"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type smtpProvider struct {
+	cfg    *config.SMTPConfig
+	logger *logger.Logger
+}
+
+// NewSMTPProvider creates a provider sending emails through an SMTP server
+func NewSMTPProvider(cfg *config.Configuration, logger *logger.Logger) (Provider, error) {
+	if cfg.Notification.SMTP.Host == "" {
+		return nil, ierr.NewError("smtp host is required").
+			WithHint("Set notification.smtp.host to send emails over SMTP").
+			Mark(ierr.ErrSystem)
+	}
+
+	return &smtpProvider{
+		cfg:    &cfg.Notification.SMTP,
+		logger: logger,
+	}, nil
+}
+
+func (p *smtpProvider) GetProvider() types.NotificationProvider {
+	return types.NotificationProviderSMTP
+}
+
+func (p *smtpProvider) Send(ctx context.Context, message *Message) error {
+	content, err := message.Bytes()
+	if err != nil {
+		return err
+	}
+
+	timeout := p.cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	address := net.JoinHostPort(p.cfg.Host, strconv.Itoa(p.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: p.cfg.Host}
+
+	var conn net.Conn
+	if p.cfg.ImplicitTLS {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return p.sendError(err, "Failed to connect to the SMTP server", message)
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return p.sendError(err, "Failed to connect to the SMTP server", message)
+	}
+
+	client, err := smtp.NewClient(conn, p.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return p.sendError(err, "Failed to connect to the SMTP server", message)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok && !p.cfg.ImplicitTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return p.sendError(err, "Failed to secure the SMTP connection", message)
+		}
+	}
+
+	if p.cfg.Username != "" {
+		auth := smtp.PlainAuth("", p.cfg.Username, p.cfg.Password, p.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return p.sendError(err, "Failed to authenticate with the SMTP server", message)
+		}
+	}
+
+	if err := client.Mail(message.From.Address); err != nil {
+		return p.sendError(err, "SMTP server rejected the sender", message)
+	}
+	if err := client.Rcpt(message.To.Address); err != nil {
+		return p.sendError(err, "SMTP server rejected the recipient", message)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return p.sendError(err, "Failed to send email", message)
+	}
+	if _, err := w.Write(content); err != nil {
+		w.Close()
+		return p.sendError(err, "Failed to send email", message)
+	}
+	if err := w.Close(); err != nil {
+		return p.sendError(err, "Failed to send email", message)
+	}
+
+	if err := client.Quit(); err != nil {
+		// the server accepted the email, a failed goodbye doesn't unsend it
+		p.logger.Warnw("failed to close smtp connection",
+			"message_id", message.ID,
+			"error", err)
+	}
+
+	return nil
+}
+
+func (p *smtpProvider) sendError(err error, hint string, message *Message) error {
+	return ierr.WithError(err).
+		WithHint(hint).
+		WithReportableDetails(map[string]any{
+			"message_id": message.ID,
+			"host":       p.cfg.Host,
+		}).
+		Mark(ierr.ErrSystem)
+}
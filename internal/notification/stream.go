@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"sync"
+
+	domainNotification "github.com/omkar273/codegeeky/internal/domain/notification"
+	"github.com/omkar273/codegeeky/internal/logger"
+)
+
+// streamBuffer is how many notifications a slow connection may fall behind before they are dropped
+const streamBuffer = 16
+
+// Stream delivers in-app notifications to the users connected to this server as they are created.
+// Connections only receive notifications created on the server they are connected to, which is
+// every notification as long as domain events go through the in-memory pubsub.
+type Stream interface {
+	// Subscribe returns the notifications of the user as they are created until unsubscribe is called
+	Subscribe(userID string) (notifications <-chan *domainNotification.Notification, unsubscribe func())
+
+	// Publish delivers a notification to every connection of its user, connections that fell behind miss it
+	Publish(notification *domainNotification.Notification)
+}
+
+type memoryStream struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan *domainNotification.Notification]struct{}
+	logger      *logger.Logger
+}
+
+// NewStream creates a stream delivering notifications to connections of this server
+func NewStream(logger *logger.Logger) Stream {
+	return &memoryStream{
+		subscribers: make(map[string]map[chan *domainNotification.Notification]struct{}),
+		logger:      logger,
+	}
+}
+
+func (s *memoryStream) Subscribe(userID string) (<-chan *domainNotification.Notification, func()) {
+	ch := make(chan *domainNotification.Notification, streamBuffer)
+
+	s.mu.Lock()
+	if s.subscribers[userID] == nil {
+		s.subscribers[userID] = make(map[chan *domainNotification.Notification]struct{})
+	}
+	s.subscribers[userID][ch] = struct{}{}
+	s.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			delete(s.subscribers[userID], ch)
+			if len(s.subscribers[userID]) == 0 {
+				delete(s.subscribers, userID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func (s *memoryStream) Publish(notification *domainNotification.Notification) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for ch := range s.subscribers[notification.UserID] {
+		select {
+		case ch <- notification:
+		default:
+			// the connection catches up by listing notifications when it reconnects
+			s.logger.Warnw("dropped notification for slow connection",
+				"notification_id", notification.ID,
+				"user_id", notification.UserID)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/config"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// templateFS holds an email per event and locale at templates/<locale>/<event>.tmpl,
+// each defining a "subject", a one line "summary", a "text" and an "html" template
+//
+//go:embed templates
+var templateFS embed.FS
+
+// Data is what emails show
+type Data struct {
+	AppName         string
+	RecipientName   string
+	InternshipTitle string
+
+	// Payload of the event the email is sent for
+	Event map[string]any
+}
+
+// Content is a rendered email, the subject and summary also make up the in-app notification
+type Content struct {
+	Subject string
+	Summary string
+	Text    string
+	HTML    string
+}
+
+// Templates renders the emails sent for events
+type Templates interface {
+	// Render renders the email of the event in the locale, falling back to the language
+	// of the locale and then to the default locale. Events without an email fail with
+	// a not found error.
+	Render(eventName, locale string, data *Data) (*Content, error)
+}
+
+type localizedTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+type embeddedTemplates struct {
+	defaultLocale string
+
+	// keyed by locale, then event name
+	templates map[string]map[string]*localizedTemplate
+}
+
+var funcs = map[string]any{
+	// date formats a time of an event payload
+	"date": func(value any) string {
+		s := fmt.Sprint(value)
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return s
+		}
+		return t.Format("January 2, 2006")
+	},
+	// amount formats an amount of an event payload with two decimals
+	"amount": func(value any) string {
+		d, err := decimal.NewFromString(fmt.Sprint(value))
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return d.StringFixed(2)
+	},
+}
+
+// NewTemplates parses the embedded email templates
+func NewTemplates(cfg *config.Configuration) (Templates, error) {
+	defaultLocale := cfg.Notification.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = types.DefaultLocale
+	}
+
+	t := &embeddedTemplates{
+		defaultLocale: strings.ToLower(defaultLocale),
+		templates:     make(map[string]map[string]*localizedTemplate),
+	}
+
+	err := fs.WalkDir(templateFS, "templates", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(name) != ".tmpl" {
+			return err
+		}
+
+		content, err := templateFS.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		text, err := texttemplate.New(name).Funcs(funcs).Parse(string(content))
+		if err != nil {
+			return err
+		}
+		html, err := htmltemplate.New(name).Funcs(funcs).Parse(string(content))
+		if err != nil {
+			return err
+		}
+
+		locale := strings.ToLower(path.Base(path.Dir(name)))
+		eventName := strings.TrimSuffix(path.Base(name), ".tmpl")
+		if t.templates[locale] == nil {
+			t.templates[locale] = make(map[string]*localizedTemplate)
+		}
+		t.templates[locale][eventName] = &localizedTemplate{text: text, html: html}
+		return nil
+	})
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to parse email templates").
+			Mark(ierr.ErrSystem)
+	}
+
+	return t, nil
+}
+
+func (t *embeddedTemplates) Render(eventName, locale string, data *Data) (*Content, error) {
+	tmpl := t.lookup(eventName, locale)
+	if tmpl == nil {
+		return nil, ierr.NewErrorf("no email template for %s", eventName).
+			WithHint("No email is sent for this event").
+			WithReportableDetails(map[string]any{
+				"event_name": eventName,
+				"locale":     locale,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	var subject, summary, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, t.renderError(err, eventName, locale)
+	}
+	if tmpl.text.Lookup("summary") != nil {
+		if err := tmpl.text.ExecuteTemplate(&summary, "summary", data); err != nil {
+			return nil, t.renderError(err, eventName, locale)
+		}
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, t.renderError(err, eventName, locale)
+	}
+	if err := tmpl.html.ExecuteTemplate(&html, "html", data); err != nil {
+		return nil, t.renderError(err, eventName, locale)
+	}
+
+	return &Content{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Summary: strings.Join(strings.Fields(summary.String()), " "),
+		Text:    strings.TrimSpace(text.String()),
+		HTML:    strings.TrimSpace(html.String()),
+	}, nil
+}
+
+// lookup finds the template of the event for the locale, its language or the default locale
+func (t *embeddedTemplates) lookup(eventName, locale string) *localizedTemplate {
+	locale = strings.ToLower(locale)
+	language, _, _ := strings.Cut(locale, "-")
+
+	for _, candidate := range []string{locale, language, t.defaultLocale} {
+		if tmpl, ok := t.templates[candidate][eventName]; ok {
+			return tmpl
+		}
+	}
+
+	return nil
+}
+
+func (t *embeddedTemplates) renderError(err error, eventName, locale string) error {
+	return ierr.WithError(err).
+		WithHint("Failed to render email").
+		WithReportableDetails(map[string]any{
+			"event_name": eventName,
+			"locale":     locale,
+		}).
+		Mark(ierr.ErrSystem)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/omkar273/codegeeky/internal/httpclient"
 	razorpay "github.com/omkar273/codegeeky/internal/payment/providers"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
 )
 
 // GatewayRegistryService manages registered payment gateway providers
@@ -24,7 +25,7 @@ func NewGatewayRegistryService() GatewayRegistryService {
 	}
 }
 
-func InitializeProviders(ctx context.Context, client *httpclient.Client, config *config.Configuration) GatewayRegistryService {
+func InitializeProviders(client httpclient.Client, config *config.Configuration) GatewayRegistryService {
 	registry := NewGatewayRegistryService()
 
 	// razorpay
@@ -67,6 +68,46 @@ func (r *gatewayRegistryService) GetProviderByName(ctx context.Context, name typ
 	return provider, nil
 }
 
+// GetSubscriptionProvider returns a provider that supports recurring billing
+func (r *gatewayRegistryService) GetSubscriptionProvider(ctx context.Context, name types.PaymentGatewayProvider) (SubscriptionProvider, error) {
+	provider, err := r.GetProviderByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionProvider, ok := provider.(SubscriptionProvider)
+	if !ok || !lo.Contains(provider.SupportedFeatures(), types.PaymentGatewayFeaturesSubscriptions) {
+		return nil, ierr.NewError("provider does not support subscriptions").
+			WithHint("The selected payment gateway doesn't support subscriptions").
+			WithReportableDetails(map[string]any{
+				"name": name,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return subscriptionProvider, nil
+}
+
+// GetRefundProvider returns a provider that can refund payments to their source
+func (r *gatewayRegistryService) GetRefundProvider(ctx context.Context, name types.PaymentGatewayProvider) (RefundProvider, error) {
+	provider, err := r.GetProviderByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	refundProvider, ok := provider.(RefundProvider)
+	if !ok || !lo.Contains(provider.SupportedFeatures(), types.PaymentGatewayFeaturesRefunds) {
+		return nil, ierr.NewError("provider does not support refunds").
+			WithHint("The selected payment gateway doesn't support refunds").
+			WithReportableDetails(map[string]any{
+				"name": name,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return refundProvider, nil
+}
+
 func (r *gatewayRegistryService) ListAvailableProviders(ctx context.Context) ([]types.PaymentGatewayProvider, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
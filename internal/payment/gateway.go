@@ -28,8 +28,38 @@ type GatewayProvider interface {
 	VerifyPaymentStatus(ctx context.Context, providerPaymentID string) (*dto.PaymentStatus, error)
 }
 
+// SubscriptionProvider is implemented by providers supporting recurring billing,
+// they list types.PaymentGatewayFeaturesSubscriptions in SupportedFeatures
+type SubscriptionProvider interface {
+	GatewayProvider
+
+	// CreatePlan registers a recurring price with the provider
+	CreatePlan(ctx context.Context, input *dto.GatewayPlanRequest) (*dto.GatewayPlanResponse, error)
+
+	// CreateSubscription starts a subscription the customer still has to authorize
+	CreateSubscription(ctx context.Context, input *dto.GatewaySubscriptionRequest) (*dto.GatewaySubscriptionResponse, error)
+
+	// CancelSubscription cancels a subscription now or at the end of its current billing cycle
+	CancelSubscription(ctx context.Context, gatewaySubscriptionID string, atPeriodEnd bool) error
+
+	// ParseSubscriptionEvent verifies a webhook and normalizes it,
+	// returning nil for events that don't change a subscription
+	ParseSubscriptionEvent(ctx context.Context, payload []byte, headers map[string]string) (*types.GatewaySubscriptionEvent, error)
+}
+
+// RefundProvider is implemented by providers that can return a captured payment
+// to its source, they list types.PaymentGatewayFeaturesRefunds in SupportedFeatures
+type RefundProvider interface {
+	GatewayProvider
+
+	// RefundPayment refunds the given amount of a captured payment
+	RefundPayment(ctx context.Context, input *dto.GatewayRefundRequest) (*dto.GatewayRefundResponse, error)
+}
+
 type GatewayRegistryService interface {
 	GetProviderByName(ctx context.Context, name types.PaymentGatewayProvider) (GatewayProvider, error)
+	GetSubscriptionProvider(ctx context.Context, name types.PaymentGatewayProvider) (SubscriptionProvider, error)
+	GetRefundProvider(ctx context.Context, name types.PaymentGatewayProvider) (RefundProvider, error)
 	ListAvailableProviders(ctx context.Context) ([]types.PaymentGatewayProvider, error)
 	RegisterProvider(name types.PaymentGatewayProvider, provider GatewayProvider)
 }
@@ -8,21 +8,24 @@ import (
 	"github.com/omkar273/codegeeky/internal/api/dto"
 	"github.com/omkar273/codegeeky/internal/config"
 	"github.com/omkar273/codegeeky/internal/domain/payment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/httpclient"
 	"github.com/omkar273/codegeeky/internal/types"
 	"github.com/razorpay/razorpay-go"
 )
 
 type RazorpayProvider struct {
-	client         *httpclient.Client
+	client         httpclient.Client
 	razorpayClient *razorpay.Client
+	webhookSecret  string
 }
 
-func NewRazorpayProvider(client *httpclient.Client, config *config.Configuration) *RazorpayProvider {
+func NewRazorpayProvider(client httpclient.Client, config *config.Configuration) *RazorpayProvider {
 	razorpayClient := razorpay.NewClient(config.Razorpay.APIKey, config.Razorpay.APISecret)
 	return &RazorpayProvider{
 		client:         client,
 		razorpayClient: razorpayClient,
+		webhookSecret:  config.Razorpay.WebhookSecret,
 	}
 }
 
@@ -38,6 +41,7 @@ func (r *RazorpayProvider) SupportedFeatures() []types.PaymentGatewayFeatures {
 		types.PaymentGatewayFeaturesPayments,
 		types.PaymentGatewayFeaturesPayouts,
 		types.PaymentGatewayFeaturesPayments,
+		types.PaymentGatewayFeaturesSubscriptions,
 	}
 }
 
@@ -90,6 +94,54 @@ func (r *RazorpayProvider) CreatePaymentOrder(ctx context.Context, input *dto.Pa
 	}, nil
 }
 
+func (r *RazorpayProvider) RefundPayment(ctx context.Context, input *dto.GatewayRefundRequest) (*dto.GatewayRefundResponse, error) {
+	// refunds carry the idempotency key as their receipt, a retry finds the refund it already made
+	existing, err := r.razorpayClient.Payment.FetchMultipleRefund(input.GatewayPaymentID, nil, nil)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to fetch refunds at the payment gateway").
+			WithReportableDetails(map[string]any{
+				"gateway_payment_id": input.GatewayPaymentID,
+			}).
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	items, _ := existing["items"].([]interface{})
+	for _, item := range items {
+		refund, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if receipt, _ := refund["receipt"].(string); receipt != "" && receipt == input.IdempotencyKey {
+			return newGatewayRefundResponse(refund), nil
+		}
+	}
+
+	refund, err := r.razorpayClient.Payment.Refund(input.GatewayPaymentID, int(toSmallestUnit(input.Amount)), map[string]interface{}{
+		"receipt": input.IdempotencyKey,
+		"notes":   input.Notes,
+	}, nil)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to refund payment at the payment gateway").
+			WithReportableDetails(map[string]any{
+				"gateway_payment_id": input.GatewayPaymentID,
+			}).
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	return newGatewayRefundResponse(refund), nil
+}
+
+func newGatewayRefundResponse(refund map[string]interface{}) *dto.GatewayRefundResponse {
+	id, _ := refund["id"].(string)
+	status, _ := refund["status"].(string)
+	return &dto.GatewayRefundResponse{
+		GatewayRefundID: id,
+		Status:          status,
+	}
+}
+
 func (r *RazorpayProvider) VerifyPaymentStatus(ctx context.Context, providerPaymentID string) (*dto.PaymentStatus, error) {
 	// Call Razorpay API to verify payment
 	return &dto.PaymentStatus{
@@ -0,0 +1,182 @@
+package razorpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	headerSignature = "X-Razorpay-Signature"
+	headerEventID   = "X-Razorpay-Event-Id"
+)
+
+// razorpaySubscriptionStatuses maps subscription webhook events to subscription statuses,
+// events not listed here don't change a subscription
+var razorpaySubscriptionStatuses = map[string]types.SubscriptionStatus{
+	"subscription.activated": types.SubscriptionStatusActive,
+	"subscription.charged":   types.SubscriptionStatusActive,
+	"subscription.pending":   types.SubscriptionStatusPastDue,
+	"subscription.halted":    types.SubscriptionStatusHalted,
+	"subscription.cancelled": types.SubscriptionStatusCancelled,
+	"subscription.completed": types.SubscriptionStatusCompleted,
+}
+
+type razorpaySubscriptionWebhook struct {
+	Event   string `json:"event"`
+	Payload struct {
+		Subscription struct {
+			Entity struct {
+				ID           string `json:"id"`
+				CurrentStart *int64 `json:"current_start"`
+				CurrentEnd   *int64 `json:"current_end"`
+			} `json:"entity"`
+		} `json:"subscription"`
+		Payment *struct {
+			Entity struct {
+				ID       string `json:"id"`
+				Amount   int64  `json:"amount"`
+				Currency string `json:"currency"`
+			} `json:"entity"`
+		} `json:"payment"`
+	} `json:"payload"`
+}
+
+func (r *RazorpayProvider) CreatePlan(ctx context.Context, input *dto.GatewayPlanRequest) (*dto.GatewayPlanResponse, error) {
+	plan, err := r.razorpayClient.Plan.Create(map[string]interface{}{
+		"period":   input.BillingPeriod.String(),
+		"interval": 1,
+		"item": map[string]interface{}{
+			"name":        input.Name,
+			"description": input.Description,
+			"amount":      toSmallestUnit(input.Amount),
+			"currency":    input.Currency.String(),
+		},
+		"notes": input.Notes,
+	}, nil)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to create plan at the payment gateway").
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	id, _ := plan["id"].(string)
+	return &dto.GatewayPlanResponse{GatewayPlanID: id}, nil
+}
+
+func (r *RazorpayProvider) CreateSubscription(ctx context.Context, input *dto.GatewaySubscriptionRequest) (*dto.GatewaySubscriptionResponse, error) {
+	sub, err := r.razorpayClient.Subscription.Create(map[string]interface{}{
+		"plan_id":         input.GatewayPlanID,
+		"total_count":     input.TotalCount,
+		"customer_notify": 1,
+		"notes":           input.Notes,
+	}, nil)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to create subscription at the payment gateway").
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	id, _ := sub["id"].(string)
+	shortURL, _ := sub["short_url"].(string)
+	return &dto.GatewaySubscriptionResponse{
+		GatewaySubscriptionID: id,
+		CheckoutURL:           shortURL,
+	}, nil
+}
+
+func (r *RazorpayProvider) CancelSubscription(ctx context.Context, gatewaySubscriptionID string, atPeriodEnd bool) error {
+	_, err := r.razorpayClient.Subscription.Cancel(gatewaySubscriptionID, map[string]interface{}{
+		"cancel_at_cycle_end": lo.Ternary(atPeriodEnd, 1, 0),
+	}, nil)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to cancel subscription at the payment gateway").
+			WithReportableDetails(map[string]any{
+				"gateway_subscription_id": gatewaySubscriptionID,
+			}).
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	return nil
+}
+
+func (r *RazorpayProvider) ParseSubscriptionEvent(ctx context.Context, payload []byte, headers map[string]string) (*types.GatewaySubscriptionEvent, error) {
+	if err := r.verifyWebhookSignature(payload, headers); err != nil {
+		return nil, err
+	}
+
+	var webhook razorpaySubscriptionWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid webhook payload").
+			Mark(ierr.ErrValidation)
+	}
+
+	status, ok := razorpaySubscriptionStatuses[webhook.Event]
+	if !ok {
+		return nil, nil
+	}
+
+	entity := webhook.Payload.Subscription.Entity
+	event := &types.GatewaySubscriptionEvent{
+		EventID:               headers[headerEventID],
+		GatewaySubscriptionID: entity.ID,
+		Status:                status,
+		CurrentPeriodStart:    fromUnix(entity.CurrentStart),
+		CurrentPeriodEnd:      fromUnix(entity.CurrentEnd),
+	}
+
+	// only charges carry the renewal payment, a pending event references the failed one
+	if webhook.Event == "subscription.charged" && webhook.Payload.Payment != nil {
+		payment := webhook.Payload.Payment.Entity
+		event.GatewayPaymentID = lo.ToPtr(payment.ID)
+		event.Amount = decimal.NewFromInt(payment.Amount).Div(decimal.NewFromInt(100))
+		event.Currency = types.Currency(payment.Currency)
+	}
+
+	return event, nil
+}
+
+// verifyWebhookSignature checks the HMAC-SHA256 of the body against the signature header
+func (r *RazorpayProvider) verifyWebhookSignature(payload []byte, headers map[string]string) error {
+	if r.webhookSecret == "" {
+		return ierr.NewError("webhook secret not configured").
+			WithHint("Webhooks are not enabled for this payment gateway").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	mac := hmac.New(sha256.New, []byte(r.webhookSecret))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(headers[http.CanonicalHeaderKey(headerSignature)])) {
+		return ierr.NewError("invalid webhook signature").
+			WithHint("Webhook signature verification failed").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// toSmallestUnit converts an amount to paise, the unit razorpay amounts are in
+func toSmallestUnit(amount decimal.Decimal) int64 {
+	return amount.Mul(decimal.NewFromInt(100)).Round(0).IntPart()
+}
+
+func fromUnix(ts *int64) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	return lo.ToPtr(time.Unix(*ts, 0).UTC())
+}
@@ -91,6 +91,9 @@ func NewEntClient(config *config.Configuration, logger *logger.Logger) (*ent.Cli
 		if err := client.Schema.Create(context.Background()); err != nil {
 			return nil, fmt.Errorf("failed creating schema resources: %w", err)
 		}
+		if err := MigrateSearch(context.Background(), db); err != nil {
+			return nil, err
+		}
 	}
 
 	return client, nil
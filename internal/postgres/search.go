@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// SearchConfig is the text search configuration used for internship documents and queries
+const SearchConfig = "english"
+
+// InternshipSearchDocument builds the weighted tsvector of an internship, column
+// qualifies the column names for the query it's used in
+//
+// The index created by MigrateSearch uses the same expression, keep them in sync
+func InternshipSearchDocument(column func(string) string) string {
+	return fmt.Sprintf(
+		"setweight(to_tsvector('%[1]s', coalesce(%[2]s, '')), 'A') || "+
+			"setweight(jsonb_to_tsvector('%[1]s', coalesce(%[3]s, '[]'::jsonb), '[\"string\"]'), 'A') || "+
+			"setweight(to_tsvector('%[1]s', coalesce(%[4]s, '')), 'B') || "+
+			"setweight(jsonb_to_tsvector('%[1]s', coalesce(%[5]s, '[]'::jsonb), '[\"string\"]'), 'C')",
+		SearchConfig,
+		column("title"),
+		column("skills"),
+		column("description"),
+		column("learning_outcomes"),
+	)
+}
+
+// MigrateSearch creates the extension and indexes internship search relies on,
+// they can't be expressed in the ent schema so they run after the schema migration
+func MigrateSearch(ctx context.Context, db *sql.DB) error {
+	unqualified := func(column string) string { return column }
+
+	statements := []string{
+		// trigram similarity for typo tolerant matching
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS internship_search_document ON internships USING GIN ((%s))",
+			InternshipSearchDocument(unqualified)),
+		"CREATE INDEX IF NOT EXISTS internship_title_trgm ON internships USING GIN (title gin_trgm_ops)",
+	}
+
+	for _, statement := range statements {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to migrate internship search: %w", err)
+		}
+	}
+
+	return nil
+}
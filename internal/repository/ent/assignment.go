@@ -0,0 +1,363 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/assignment"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type assignmentRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts AssignmentQueryOptions
+}
+
+func NewAssignmentRepository(client postgres.IClient, logger *logger.Logger) domainContent.AssignmentRepository {
+	return &assignmentRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: AssignmentQueryOptions{},
+	}
+}
+
+func (r *assignmentRepository) Create(ctx context.Context, assignment *domainContent.Assignment) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating assignment",
+		"assignment_id", assignment.ID,
+		"internship_id", assignment.InternshipID,
+	)
+
+	_, err := client.Assignment.Create().
+		SetID(assignment.ID).
+		SetInternshipID(assignment.InternshipID).
+		SetModuleID(assignment.ModuleID).
+		SetTitle(assignment.Title).
+		SetInstructions(assignment.Instructions).
+		SetNillableDueDays(assignment.DueDays).
+		SetMaxScore(assignment.MaxScore).
+		SetSortOrder(assignment.SortOrder).
+		SetNillablePassingScore(assignment.PassingScore).
+		SetRubric(assignment.Rubric).
+		SetLatePolicy(string(assignment.LatePolicy)).
+		SetLatePenaltyPercent(assignment.LatePenaltyPercent).
+		SetNillableMaxSubmissions(assignment.MaxSubmissions).
+		SetStatus(string(assignment.Status)).
+		SetCreatedAt(assignment.CreatedAt).
+		SetUpdatedAt(assignment.UpdatedAt).
+		SetCreatedBy(assignment.CreatedBy).
+		SetUpdatedBy(assignment.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create assignment").
+			WithReportableDetails(map[string]any{
+				"internship_id": assignment.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *assignmentRepository) Get(ctx context.Context, id string) (*domainContent.Assignment, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting assignment", "assignment_id", id)
+
+	entAssignment, err := client.Assignment.Query().
+		Where(
+			assignment.ID(id),
+			assignment.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Assignment with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"assignment_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get assignment").
+			WithReportableDetails(map[string]any{
+				"assignment_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	assignment := &domainContent.Assignment{}
+	return assignment.FromEnt(entAssignment), nil
+}
+
+func (r *assignmentRepository) Update(ctx context.Context, assignment *domainContent.Assignment) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating assignment",
+		"assignment_id", assignment.ID,
+	)
+
+	update := client.Assignment.UpdateOneID(assignment.ID).
+		SetModuleID(assignment.ModuleID).
+		SetTitle(assignment.Title).
+		SetInstructions(assignment.Instructions).
+		SetNillableDueDays(assignment.DueDays).
+		SetMaxScore(assignment.MaxScore).
+		SetSortOrder(assignment.SortOrder).
+		SetNillablePassingScore(assignment.PassingScore).
+		SetRubric(assignment.Rubric).
+		SetLatePolicy(string(assignment.LatePolicy)).
+		SetLatePenaltyPercent(assignment.LatePenaltyPercent).
+		SetNillableMaxSubmissions(assignment.MaxSubmissions).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if assignment.DueDays == nil {
+		update.ClearDueDays()
+	}
+	if assignment.PassingScore == nil {
+		update.ClearPassingScore()
+	}
+	if assignment.Rubric == nil {
+		update.ClearRubric()
+	}
+	if assignment.MaxSubmissions == nil {
+		update.ClearMaxSubmissions()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Assignment with ID %s was not found", assignment.ID).
+				WithReportableDetails(map[string]any{
+					"assignment_id": assignment.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update assignment").
+			WithReportableDetails(map[string]any{
+				"assignment_id": assignment.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *assignmentRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting assignment",
+		"assignment_id", id,
+	)
+
+	_, err := client.Assignment.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Assignment with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"assignment_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete assignment").
+			WithReportableDetails(map[string]any{
+				"assignment_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *assignmentRepository) Count(ctx context.Context, filter *types.AssignmentFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting assignments")
+
+	query := client.Assignment.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count assignments").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *assignmentRepository) List(ctx context.Context, filter *types.AssignmentFilter) ([]*domainContent.Assignment, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing assignments",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, assignment.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Assignment.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	assignments, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list assignments").
+			Mark(ierr.ErrDatabase)
+	}
+
+	assignment := &domainContent.Assignment{}
+	assignments = ReverseIfBackward(filter, assignments)
+
+	return assignment.FromEntList(assignments), nil
+}
+
+func (r *assignmentRepository) ListAll(ctx context.Context, filter *types.AssignmentFilter) ([]*domainContent.Assignment, error) {
+	if filter == nil {
+		filter = types.NewNoLimitAssignmentFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	assignments, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// AssignmentQuery type alias for better readability
+type AssignmentQuery = *ent.AssignmentQuery
+
+// AssignmentQueryOptions implements query options for assignment queries
+type AssignmentQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure AssignmentQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[AssignmentQuery, *types.AssignmentFilter] = (*AssignmentQueryOptions)(nil)
+
+func (o AssignmentQueryOptions) ApplyStatusFilter(query AssignmentQuery, status string) AssignmentQuery {
+	if status == "" {
+		return query.Where(assignment.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(assignment.Status(status))
+}
+
+func (o AssignmentQueryOptions) ApplySortFilter(query AssignmentQuery, field string, order string) AssignmentQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(assignment.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(assignment.FieldID))
+}
+
+func (o AssignmentQueryOptions) ApplyPaginationFilter(query AssignmentQuery, limit int, offset int) AssignmentQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o AssignmentQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return assignment.FieldCreatedAt
+	case "updated_at":
+		return assignment.FieldUpdatedAt
+	case "title":
+		return assignment.FieldTitle
+	case "sort_order":
+		return assignment.FieldSortOrder
+	case "created_by":
+		return assignment.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o AssignmentQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query AssignmentQuery,
+	filter *types.AssignmentFilter,
+) AssignmentQuery {
+	if filter == nil {
+		return query.Where(assignment.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[AssignmentQuery, predicate.Assignment, assignment.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), assignment.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o AssignmentQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.AssignmentFilter,
+	query AssignmentQuery,
+) AssignmentQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(assignment.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.ModuleIDs) > 0 {
+		query = query.Where(assignment.ModuleIDIn(f.ModuleIDs...))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(assignment.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(assignment.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/cart"
 	"github.com/omkar273/codegeeky/ent/cartlineitems"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainCart "github.com/omkar273/codegeeky/internal/domain/cart"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -293,6 +294,10 @@ func (r *cartRepository) List(ctx context.Context, filter *types.CartFilter) ([]
 		"offset", filter.GetOffset(),
 	)
 
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, cart.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.Cart.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -307,6 +312,8 @@ func (r *cartRepository) List(ctx context.Context, filter *types.CartFilter) ([]
 	}
 
 	cartData := &domainCart.Cart{}
+	carts = ReverseIfBackward(filter, carts)
+
 	return cartData.FromEntList(carts), nil
 }
 
@@ -525,9 +532,9 @@ func (o CartQueryOptions) ApplySortFilter(query CartQuery, field string, order s
 	field, order = o.QueryOptionsHelper.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(cart.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(cart.FieldID))
 }
 
 func (o CartQueryOptions) ApplyPaginationFilter(query CartQuery, limit int, offset int) CartQuery {
@@ -578,7 +585,11 @@ func (o CartQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[CartQuery, predicate.Cart, cart.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), cart.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
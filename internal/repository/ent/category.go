@@ -7,11 +7,13 @@ import (
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/category"
 	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainCategory "github.com/omkar273/codegeeky/internal/domain/internship"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
 	"github.com/omkar273/codegeeky/internal/postgres"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
 )
 
 type categoryRepository struct {
@@ -42,6 +44,11 @@ func (r *categoryRepository) Create(ctx context.Context, categoryData *domainCat
 		SetName(categoryData.Name).
 		SetLookupKey(categoryData.LookupKey).
 		SetDescription(categoryData.Description).
+		SetNillableParentID(categoryData.ParentID).
+		SetSlug(categoryData.Slug).
+		SetPath(categoryData.Path).
+		SetDepth(categoryData.Depth).
+		SetSortWeight(categoryData.SortWeight).
 		SetStatus(string(categoryData.Status)).
 		SetCreatedAt(categoryData.CreatedAt).
 		SetUpdatedAt(categoryData.UpdatedAt).
@@ -52,10 +59,11 @@ func (r *categoryRepository) Create(ctx context.Context, categoryData *domainCat
 	if err != nil {
 		if ent.IsConstraintError(err) {
 			return ierr.WithError(err).
-				WithHint("Category with this lookup key already exists").
+				WithHint("Category with this name, lookup key or slug already exists").
 				WithReportableDetails(map[string]any{
 					"category_id": categoryData.ID,
 					"lookup_key":  categoryData.LookupKey,
+					"slug":        categoryData.Slug,
 				}).
 				Mark(ierr.ErrAlreadyExists)
 		}
@@ -134,6 +142,39 @@ func (r *categoryRepository) GetByLookupKey(ctx context.Context, lookupKey strin
 	return category.FromEnt(entCategory), nil
 }
 
+func (r *categoryRepository) GetBySlug(ctx context.Context, slug string) (*domainCategory.Category, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting category by slug", "slug", slug)
+
+	entCategory, err := client.Category.Query().
+		Where(
+			category.Slug(slug),
+			category.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Category with slug %s was not found", slug).
+				WithReportableDetails(map[string]any{
+					"slug": slug,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get category by slug").
+			WithReportableDetails(map[string]any{
+				"slug": slug,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	category := &domainCategory.Category{}
+	return category.FromEnt(entCategory), nil
+}
+
 func (r *categoryRepository) List(ctx context.Context, filter *types.CategoryFilter) ([]*domainCategory.Category, error) {
 	client := r.client.Querier(ctx)
 
@@ -142,6 +183,10 @@ func (r *categoryRepository) List(ctx context.Context, filter *types.CategoryFil
 		"offset", filter.GetOffset(),
 	)
 
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, category.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.Category.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -154,6 +199,8 @@ func (r *categoryRepository) List(ctx context.Context, filter *types.CategoryFil
 	}
 
 	category := &domainCategory.Category{}
+	categories = ReverseIfBackward(filter, categories)
+
 	return category.FromEntList(categories), nil
 }
 
@@ -201,14 +248,25 @@ func (r *categoryRepository) Update(ctx context.Context, categoryData *domainCat
 
 	client := r.client.Querier(ctx)
 
-	_, err := client.Category.UpdateOneID(categoryData.ID).
+	builder := client.Category.UpdateOneID(categoryData.ID).
 		SetName(categoryData.Name).
 		SetLookupKey(categoryData.LookupKey).
 		SetDescription(categoryData.Description).
+		SetSlug(categoryData.Slug).
+		SetPath(categoryData.Path).
+		SetDepth(categoryData.Depth).
+		SetSortWeight(categoryData.SortWeight).
 		SetStatus(string(categoryData.Status)).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if categoryData.ParentID != nil {
+		builder = builder.SetParentID(*categoryData.ParentID)
+	} else {
+		builder = builder.ClearParentID()
+	}
+
+	_, err := builder.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -221,10 +279,11 @@ func (r *categoryRepository) Update(ctx context.Context, categoryData *domainCat
 		}
 		if ent.IsConstraintError(err) {
 			return ierr.WithError(err).
-				WithHint("Category with this lookup key already exists").
+				WithHint("Category with this name, lookup key or slug already exists").
 				WithReportableDetails(map[string]any{
 					"category_id": categoryData.ID,
 					"lookup_key":  categoryData.LookupKey,
+					"slug":        categoryData.Slug,
 				}).
 				Mark(ierr.ErrAlreadyExists)
 		}
@@ -272,6 +331,79 @@ func (r *categoryRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+func (r *categoryRepository) ListPublishedInternshipIDs(ctx context.Context) (map[string][]string, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing published internships per category")
+
+	categories, err := client.Category.Query().
+		Where(category.StatusNotIn(string(types.StatusDeleted))).
+		WithInternships(func(q *ent.InternshipQuery) {
+			q.Where(
+				internship.StatusNotIn(string(types.StatusDeleted)),
+				internship.PublishStatus(types.InternshipPublishStatusPublished),
+			).Select(internship.FieldID)
+		}).
+		Select(category.FieldID).
+		All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internships per category").
+			Mark(ierr.ErrDatabase)
+	}
+
+	internshipIDs := make(map[string][]string, len(categories))
+	for _, c := range categories {
+		internshipIDs[c.ID] = lo.Map(c.Edges.Internships, func(i *ent.Internship, _ int) string {
+			return i.ID
+		})
+	}
+
+	return internshipIDs, nil
+}
+
+func (r *categoryRepository) UpdateInternshipCounts(ctx context.Context, id string, count int, totalCount int) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating category internship counts",
+		"category_id", id,
+		"internship_count", count,
+		"total_internship_count", totalCount,
+	)
+
+	// counts are a cache, updating them doesn't count as an edit of the category
+	_, err := client.Category.UpdateOneID(id).
+		SetInternshipCount(count).
+		SetTotalInternshipCount(totalCount).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Category with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"category_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update category internship counts").
+			WithReportableDetails(map[string]any{
+				"category_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// categorySubtree matches the given categories and all of their descendants
+func categorySubtree(ids []string) predicate.Category {
+	return category.Or(lo.Map(ids, func(id string, _ int) predicate.Category {
+		return category.PathContains("/" + id + "/")
+	})...)
+}
+
 // CategoryQuery type alias for better readability
 type CategoryQuery = *ent.CategoryQuery
 
@@ -294,9 +426,9 @@ func (o CategoryQueryOptions) ApplySortFilter(query CategoryQuery, field string,
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(category.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(category.FieldID))
 }
 
 func (o CategoryQueryOptions) ApplyPaginationFilter(query CategoryQuery, limit int, offset int) CategoryQuery {
@@ -312,6 +444,18 @@ func (o CategoryQueryOptions) GetFieldName(field string) string {
 		return category.FieldLookupKey
 	case "description":
 		return category.FieldDescription
+	case "parent_id":
+		return category.FieldParentID
+	case "slug":
+		return category.FieldSlug
+	case "depth":
+		return category.FieldDepth
+	case "sort_weight":
+		return category.FieldSortWeight
+	case "internship_count":
+		return category.FieldInternshipCount
+	case "total_internship_count":
+		return category.FieldTotalInternshipCount
 	case "internships":
 		return category.EdgeInternships
 	case "created_by":
@@ -343,7 +487,11 @@ func (o CategoryQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[CategoryQuery, predicate.Category, category.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), category.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -373,6 +521,25 @@ func (o CategoryQueryOptions) ApplyEntityQueryOptions(
 		query = query.Where(category.IDIn(f.CategoryIDs...))
 	}
 
+	// Apply slugs filter if specified
+	if len(f.Slugs) > 0 {
+		query = query.Where(category.SlugIn(f.Slugs...))
+	}
+
+	// Apply hierarchy filters if specified
+	if f.RootsOnly {
+		query = query.Where(category.ParentIDIsNil())
+	}
+	if f.ParentID != nil {
+		query = query.Where(category.ParentID(*f.ParentID))
+	}
+	if f.AncestorID != nil {
+		query = query.Where(
+			categorySubtree([]string{*f.AncestorID}),
+			category.IDNEQ(*f.AncestorID),
+		)
+	}
+
 	// Apply internship IDs filter if specified (through edge)
 	if len(f.InternshipIDs) > 0 {
 		query = query.Where(category.HasInternshipsWith(
@@ -0,0 +1,395 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/certificate"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainCertificate "github.com/omkar273/codegeeky/internal/domain/certificate"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type certificateRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts CertificateQueryOptions
+}
+
+func NewCertificateRepository(client postgres.IClient, logger *logger.Logger) domainCertificate.Repository {
+	return &certificateRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: CertificateQueryOptions{},
+	}
+}
+
+func (r *certificateRepository) Create(ctx context.Context, certificate *domainCertificate.Certificate) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating certificate",
+		"certificate_id", certificate.ID,
+		"enrollment_id", certificate.EnrollmentID,
+		"user_id", certificate.UserID,
+	)
+
+	_, err := client.Certificate.Create().
+		SetID(certificate.ID).
+		SetVerificationID(certificate.VerificationID).
+		SetEnrollmentID(certificate.EnrollmentID).
+		SetUserID(certificate.UserID).
+		SetInternshipID(certificate.InternshipID).
+		SetInternshipBatchID(certificate.InternshipBatchID).
+		SetRecipientName(certificate.RecipientName).
+		SetInternshipTitle(certificate.InternshipTitle).
+		SetCompletedAt(certificate.CompletedAt).
+		SetIssuedAt(certificate.IssuedAt).
+		SetSignature(certificate.Signature).
+		SetNillableFileID(certificate.FileID).
+		SetCertificateStatus(string(certificate.CertificateStatus)).
+		SetNillableRevokedAt(certificate.RevokedAt).
+		SetNillableRevocationReason(certificate.RevocationReason).
+		SetStatus(string(certificate.Status)).
+		SetCreatedAt(certificate.CreatedAt).
+		SetUpdatedAt(certificate.UpdatedAt).
+		SetCreatedBy(certificate.CreatedBy).
+		SetUpdatedBy(certificate.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A certificate was already issued for this enrollment").
+				WithReportableDetails(map[string]any{
+					"enrollment_id": certificate.EnrollmentID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create certificate").
+			WithReportableDetails(map[string]any{
+				"enrollment_id": certificate.EnrollmentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *certificateRepository) Get(ctx context.Context, id string) (*domainCertificate.Certificate, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting certificate", "certificate_id", id)
+
+	entCertificate, err := client.Certificate.Query().
+		Where(
+			certificate.ID(id),
+			certificate.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Certificate with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"certificate_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get certificate").
+			WithReportableDetails(map[string]any{
+				"certificate_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	certificate := &domainCertificate.Certificate{}
+	return certificate.FromEnt(entCertificate), nil
+}
+
+func (r *certificateRepository) GetByVerificationID(ctx context.Context, verificationID string) (*domainCertificate.Certificate, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting certificate by verification", "verification_id", verificationID)
+
+	entCertificate, err := client.Certificate.Query().
+		Where(
+			certificate.VerificationID(verificationID),
+			certificate.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Certificate %s was not found", verificationID).
+				WithReportableDetails(map[string]any{
+					"verification_id": verificationID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get certificate").
+			WithReportableDetails(map[string]any{
+				"verification_id": verificationID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	certificate := &domainCertificate.Certificate{}
+	return certificate.FromEnt(entCertificate), nil
+}
+
+func (r *certificateRepository) GetByEnrollmentID(ctx context.Context, enrollmentID string) (*domainCertificate.Certificate, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting certificate by enrollment", "enrollment_id", enrollmentID)
+
+	entCertificate, err := client.Certificate.Query().
+		Where(
+			certificate.EnrollmentID(enrollmentID),
+			certificate.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Certificate for enrollment %s was not found", enrollmentID).
+				WithReportableDetails(map[string]any{
+					"enrollment_id": enrollmentID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get certificate").
+			WithReportableDetails(map[string]any{
+				"enrollment_id": enrollmentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	certificate := &domainCertificate.Certificate{}
+	return certificate.FromEnt(entCertificate), nil
+}
+
+func (r *certificateRepository) Update(ctx context.Context, certificate *domainCertificate.Certificate) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating certificate",
+		"certificate_id", certificate.ID,
+	)
+
+	update := client.Certificate.UpdateOneID(certificate.ID).
+		SetCertificateStatus(string(certificate.CertificateStatus)).
+		SetNillableFileID(certificate.FileID).
+		SetNillableRevokedAt(certificate.RevokedAt).
+		SetNillableRevocationReason(certificate.RevocationReason).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Certificate with ID %s was not found", certificate.ID).
+				WithReportableDetails(map[string]any{
+					"certificate_id": certificate.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update certificate").
+			WithReportableDetails(map[string]any{
+				"certificate_id": certificate.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *certificateRepository) Count(ctx context.Context, filter *types.CertificateFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting certificates")
+
+	query := client.Certificate.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count certificates").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *certificateRepository) List(ctx context.Context, filter *types.CertificateFilter) ([]*domainCertificate.Certificate, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing certificates",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, certificate.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Certificate.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	certificates, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list certificates").
+			Mark(ierr.ErrDatabase)
+	}
+
+	certificate := &domainCertificate.Certificate{}
+	certificates = ReverseIfBackward(filter, certificates)
+
+	return certificate.FromEntList(certificates), nil
+}
+
+func (r *certificateRepository) ListAll(ctx context.Context, filter *types.CertificateFilter) ([]*domainCertificate.Certificate, error) {
+	if filter == nil {
+		filter = types.NewNoLimitCertificateFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	certificates, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return certificates, nil
+}
+
+// CertificateQuery type alias for better readability
+type CertificateQuery = *ent.CertificateQuery
+
+// CertificateQueryOptions implements query options for certificate queries
+type CertificateQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure CertificateQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[CertificateQuery, *types.CertificateFilter] = (*CertificateQueryOptions)(nil)
+
+func (o CertificateQueryOptions) ApplyStatusFilter(query CertificateQuery, status string) CertificateQuery {
+	if status == "" {
+		return query.Where(certificate.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(certificate.Status(status))
+}
+
+func (o CertificateQueryOptions) ApplySortFilter(query CertificateQuery, field string, order string) CertificateQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(certificate.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(certificate.FieldID))
+}
+
+func (o CertificateQueryOptions) ApplyPaginationFilter(query CertificateQuery, limit int, offset int) CertificateQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o CertificateQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return certificate.FieldCreatedAt
+	case "updated_at":
+		return certificate.FieldUpdatedAt
+	case "issued_at":
+		return certificate.FieldIssuedAt
+	case "completed_at":
+		return certificate.FieldCompletedAt
+	case "created_by":
+		return certificate.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o CertificateQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query CertificateQuery,
+	filter *types.CertificateFilter,
+) CertificateQuery {
+	if filter == nil {
+		return query.Where(certificate.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[CertificateQuery, predicate.Certificate, certificate.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), certificate.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o CertificateQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.CertificateFilter,
+	query CertificateQuery,
+) CertificateQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(certificate.UserIDIn(f.UserIDs...))
+	}
+
+	if len(f.EnrollmentIDs) > 0 {
+		query = query.Where(certificate.EnrollmentIDIn(f.EnrollmentIDs...))
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(certificate.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if f.CertificateStatus != "" {
+		query = query.Where(certificate.CertificateStatus(string(f.CertificateStatus)))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(certificate.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(certificate.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -3,8 +3,10 @@ package ent
 import (
 	"context"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/discount"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -54,11 +56,13 @@ func (r *discountRepository) Create(ctx context.Context, d *domainDiscount.Disco
 		SetDiscountType(d.DiscountType).
 		SetDiscountValue(d.DiscountValue).
 		SetValidFrom(d.ValidFrom).
-		SetValidUntil(lo.FromPtr(d.ValidUntil)).
+		SetNillableValidUntil(d.ValidUntil).
 		SetIsActive(d.IsActive).
-		SetMaxUses(lo.FromPtr(d.MaxUses)).
-		SetMinOrderValue(lo.FromPtr(d.MinOrderValue)).
+		SetNillableMaxUses(d.MaxUses).
+		SetNillableMinOrderValue(d.MinOrderValue).
 		SetIsCombinable(d.IsCombinable).
+		SetIsCampaign(d.IsCampaign).
+		SetNillableParentID(d.ParentID).
 		SetMetadata(d.Metadata).
 		SetStatus(string(types.StatusPublished)).
 		SetCreatedAt(d.CreatedAt).
@@ -117,6 +121,11 @@ func (r *discountRepository) Get(ctx context.Context, id string) (*domainDiscoun
 
 func (r *discountRepository) List(ctx context.Context, filter *types.DiscountFilter) ([]*domainDiscount.Discount, error) {
 	client := r.client.Querier(ctx)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, discount.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.Discount.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -141,6 +150,8 @@ func (r *discountRepository) List(ctx context.Context, filter *types.DiscountFil
 	}
 
 	discount := &domainDiscount.Discount{}
+	discounts = ReverseIfBackward(filter, discounts)
+
 	return discount.FromEntList(discounts), nil
 }
 
@@ -224,6 +235,193 @@ func (r *discountRepository) GetByCode(ctx context.Context, code string) (*domai
 	return domainDiscount.FromEnt(discount), nil
 }
 
+// CreateBulk creates the given discounts in a single batch insert
+func (r *discountRepository) CreateBulk(ctx context.Context, discounts []*domainDiscount.Discount) error {
+	client := r.client.Querier(ctx)
+
+	builders := make([]*ent.DiscountCreate, len(discounts))
+	for i, d := range discounts {
+		builders[i] = client.Discount.Create().
+			SetID(d.ID).
+			SetCode(d.Code).
+			SetDescription(d.Description).
+			SetDiscountType(d.DiscountType).
+			SetDiscountValue(d.DiscountValue).
+			SetValidFrom(d.ValidFrom).
+			SetNillableValidUntil(d.ValidUntil).
+			SetIsActive(d.IsActive).
+			SetNillableMaxUses(d.MaxUses).
+			SetNillableMinOrderValue(d.MinOrderValue).
+			SetIsCombinable(d.IsCombinable).
+			SetIsCampaign(d.IsCampaign).
+			SetNillableParentID(d.ParentID).
+			SetMetadata(d.Metadata).
+			SetStatus(string(types.StatusPublished)).
+			SetCreatedAt(d.CreatedAt).
+			SetUpdatedAt(d.UpdatedAt).
+			SetCreatedBy(d.CreatedBy).
+			SetUpdatedBy(d.UpdatedBy)
+	}
+
+	if _, err := client.Discount.CreateBulk(builders...).Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("One or more generated codes already exist").
+				WithReportableDetails(map[string]any{
+					"count": len(discounts),
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+
+		return ierr.WithError(err).
+			WithHint("Failed to create discounts").
+			WithReportableDetails(map[string]any{
+				"count": len(discounts),
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// DeactivateByParent deactivates the codes of a campaign, optionally limited to the given codes
+func (r *discountRepository) DeactivateByParent(ctx context.Context, parentID string, codes []string) (int, error) {
+	client := r.client.Querier(ctx)
+	update := client.Discount.Update().
+		Where(
+			discount.ParentID(parentID),
+			discount.IsActive(true),
+			discount.StatusNotIn(string(types.StatusDeleted)),
+		)
+
+	if len(codes) > 0 {
+		update = update.Where(discount.CodeIn(codes...))
+	}
+
+	affected, err := update.
+		SetIsActive(false).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to deactivate campaign codes").
+			WithReportableDetails(map[string]any{
+				"parent_id": parentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return affected, nil
+}
+
+// GetCampaignStats rolls up usage of all codes generated for a campaign
+func (r *discountRepository) GetCampaignStats(ctx context.Context, parentID string) (*domainDiscount.CampaignStats, error) {
+	client := r.client.Querier(ctx)
+	query := client.Discount.Query().
+		Where(
+			discount.ParentID(parentID),
+			discount.StatusNotIn(string(types.StatusDeleted)),
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to count campaign codes").
+			WithReportableDetails(map[string]any{
+				"parent_id": parentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	active, err := query.Clone().Where(discount.IsActive(true)).Count(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to count active campaign codes").
+			WithReportableDetails(map[string]any{
+				"parent_id": parentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	usage, err := query.Clone().
+		Where(discount.UsedCountGT(0)).
+		Select(discount.FieldUsedCount).
+		Ints(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get campaign code usage").
+			WithReportableDetails(map[string]any{
+				"parent_id": parentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return &domainDiscount.CampaignStats{
+		TotalCodes:       total,
+		ActiveCodes:      active,
+		RedeemedCodes:    len(usage),
+		TotalRedemptions: lo.Sum(usage),
+	}, nil
+}
+
+// IncrementUsage records a redemption, failing if the discount has reached its max uses
+func (r *discountRepository) IncrementUsage(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+	affected, err := client.Discount.Update().
+		Where(
+			discount.ID(id),
+			discount.Or(
+				discount.MaxUsesIsNil(),
+				discount.MaxUsesLTE(0),
+				predicate.Discount(func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(discount.FieldUsedCount), s.C(discount.FieldMaxUses)))
+				}),
+			),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to record discount usage").
+			WithReportableDetails(map[string]any{
+				"discount_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	if affected == 0 {
+		return ierr.NewError("discount usage limit reached").
+			WithHint("Discount has reached the maximum number of uses").
+			WithReportableDetails(map[string]any{
+				"discount_id": id,
+			}).
+			Mark(ierr.ErrBadRequest)
+	}
+
+	return nil
+}
+
+func (r *discountRepository) DecrementUsage(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+	_, err := client.Discount.Update().
+		Where(
+			discount.ID(id),
+			discount.UsedCountGT(0),
+		).
+		AddUsedCount(-1).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to release discount usage").
+			WithReportableDetails(map[string]any{
+				"discount_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
 // DiscountQuery type alias for better readability
 type DiscountQuery = *ent.DiscountQuery
 
@@ -246,9 +444,9 @@ func (o DiscountQueryOptions) ApplySortFilter(query DiscountQuery, field string,
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(discount.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(discount.FieldID))
 }
 
 func (o DiscountQueryOptions) ApplyPaginationFilter(query DiscountQuery, limit int, offset int) DiscountQuery {
@@ -282,6 +480,12 @@ func (o DiscountQueryOptions) GetFieldName(field string) string {
 		return discount.FieldMinOrderValue
 	case "is_combinable":
 		return discount.FieldIsCombinable
+	case "used_count":
+		return discount.FieldUsedCount
+	case "is_campaign":
+		return discount.FieldIsCampaign
+	case "parent_id":
+		return discount.FieldParentID
 	case "metadata":
 		return discount.FieldMetadata
 	case "created_by":
@@ -305,7 +509,11 @@ func (o DiscountQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[DiscountQuery, predicate.Discount, discount.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), discount.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -352,5 +560,13 @@ func (o DiscountQueryOptions) ApplyEntityQueryOptions(
 	if len(f.DiscountIDs) > 0 {
 		query = query.Where(discount.IDIn(f.DiscountIDs...))
 	}
+
+	if f.ParentID != "" {
+		query = query.Where(discount.ParentID(f.ParentID))
+	}
+
+	if f.IsCampaign != nil {
+		query = query.Where(discount.IsCampaign(*f.IsCampaign))
+	}
 	return query
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/fileupload"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	domainFileUpload "github.com/omkar273/codegeeky/internal/fileupload"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -210,9 +211,9 @@ func (o FileUploadQueryOptions) ApplySortFilter(query FileUploadQuery, field str
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(fileupload.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(fileupload.FieldID))
 }
 
 func (o FileUploadQueryOptions) ApplyPaginationFilter(query FileUploadQuery, limit int, offset int) FileUploadQuery {
@@ -271,7 +272,11 @@ func (o FileUploadQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[FileUploadQuery, predicate.FileUpload, fileupload.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), fileupload.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/omkar273/codegeeky/ent"
-	"github.com/omkar273/codegeeky/ent/category"
 	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -60,6 +62,9 @@ func (r *internshipRepository) Create(ctx context.Context, internshipData *domai
 		SetPrice(internshipData.Price).
 		SetFlatDiscount(lo.FromPtr(internshipData.FlatDiscount)).
 		SetPercentageDiscount(lo.FromPtr(internshipData.PercentageDiscount)).
+		SetPublishStatus(internshipData.PublishStatus).
+		SetNillablePublishedAt(internshipData.PublishedAt).
+		SetNillableCurrentRevisionID(internshipData.CurrentRevisionID).
 		SetStatus(string(internshipData.Status)).
 		SetCreatedAt(internshipData.CreatedAt).
 		SetUpdatedAt(internshipData.UpdatedAt).
@@ -163,6 +168,10 @@ func (r *internshipRepository) List(ctx context.Context, filter *types.Internshi
 		"offset", filter.GetOffset(),
 	)
 
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internship.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.Internship.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -177,6 +186,8 @@ func (r *internshipRepository) List(ctx context.Context, filter *types.Internshi
 	}
 
 	internship := &domainInternship.Internship{}
+	internships = ReverseIfBackward(filter, internships)
+
 	return internship.FromEntList(internships), nil
 }
 
@@ -223,7 +234,7 @@ func (r *internshipRepository) Update(ctx context.Context, internshipData *domai
 		"title", internshipData.Title,
 	)
 
-	_, err := client.Internship.UpdateOneID(internshipData.ID).
+	update := client.Internship.UpdateOneID(internshipData.ID).
 		SetTitle(internshipData.Title).
 		SetLookupKey(internshipData.LookupKey).
 		SetDescription(internshipData.Description).
@@ -238,10 +249,38 @@ func (r *internshipRepository) Update(ctx context.Context, internshipData *domai
 		SetPrice(internshipData.Price).
 		SetFlatDiscount(lo.FromPtr(internshipData.FlatDiscount)).
 		SetPercentageDiscount(lo.FromPtr(internshipData.PercentageDiscount)).
+		SetPublishStatus(internshipData.PublishStatus).
+		SetNillableDraftStatus(internshipData.DraftStatus).
+		SetNillableReviewComment(internshipData.ReviewComment).
+		SetNillableSubmittedAt(internshipData.SubmittedAt).
+		SetNillableReviewedAt(internshipData.ReviewedAt).
+		SetNillableReviewedBy(internshipData.ReviewedBy).
+		SetNillablePublishedAt(internshipData.PublishedAt).
+		SetNillableCurrentRevisionID(internshipData.CurrentRevisionID).
+		SetApplicationRequired(internshipData.ApplicationRequired).
 		SetStatus(string(internshipData.Status)).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// publishing or discarding a pending revision clears it
+	if internshipData.Draft != nil {
+		update.SetDraft(internshipData.Draft)
+	} else {
+		update.ClearDraft()
+	}
+	if internshipData.DraftStatus == nil {
+		update.ClearDraftStatus()
+	}
+	if internshipData.ReviewComment == nil {
+		update.ClearReviewComment()
+	}
+	if internshipData.ApplicationForm != nil {
+		update.SetApplicationForm(internshipData.ApplicationForm)
+	} else {
+		update.ClearApplicationForm()
+	}
+
+	_, err := update.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -272,6 +311,41 @@ func (r *internshipRepository) Update(ctx context.Context, internshipData *domai
 	return nil
 }
 
+func (r *internshipRepository) UpdateRating(ctx context.Context, id string, average float64, count int) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating internship rating",
+		"internship_id", id,
+		"rating_average", average,
+		"rating_count", count,
+	)
+
+	// ratings are a cache, updating them doesn't count as an edit of the internship
+	_, err := client.Internship.UpdateOneID(id).
+		SetRatingAverage(average).
+		SetRatingCount(count).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Internship with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"internship_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update internship rating").
+			WithReportableDetails(map[string]any{
+				"internship_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
 func (r *internshipRepository) Delete(ctx context.Context, id string) error {
 	client := r.client.Querier(ctx)
 
@@ -305,6 +379,24 @@ func (r *internshipRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// taughtBy matches internships the user authored or is assigned to as an instructor
+func taughtBy(userID string) predicate.Internship {
+	return func(s *entsql.Selector) {
+		t := entsql.Table(internshipinstructor.Table)
+		s.Where(entsql.Or(
+			entsql.EQ(s.C(internship.FieldCreatedBy), userID),
+			entsql.In(s.C(internship.FieldID),
+				entsql.Select(t.C(internshipinstructor.FieldInternshipID)).
+					From(t).
+					Where(entsql.And(
+						entsql.EQ(t.C(internshipinstructor.FieldUserID), userID),
+						entsql.NEQ(t.C(internshipinstructor.FieldStatus), string(types.StatusDeleted)),
+					)),
+			),
+		))
+	}
+}
+
 // InternshipQuery type alias for better readability
 type InternshipQuery = *ent.InternshipQuery
 
@@ -327,9 +419,9 @@ func (o InternshipQueryOptions) ApplySortFilter(query InternshipQuery, field str
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(internship.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(internship.FieldID))
 }
 
 func (o InternshipQueryOptions) ApplyPaginationFilter(query InternshipQuery, limit int, offset int) InternshipQuery {
@@ -373,6 +465,10 @@ func (o InternshipQueryOptions) GetFieldName(field string) string {
 		return internship.FieldPercentageDiscount
 	case "description":
 		return internship.FieldDescription
+	case "rating_average":
+		return internship.FieldRatingAverage
+	case "rating_count":
+		return internship.FieldRatingCount
 	case "created_by":
 		return internship.FieldCreatedBy
 	case "updated_by":
@@ -394,7 +490,11 @@ func (o InternshipQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[InternshipQuery, predicate.Internship, internship.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internship.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -432,9 +532,9 @@ func (o InternshipQueryOptions) ApplyEntityQueryOptions(
 		query = query.Where(internship.LevelIn(levels...))
 	}
 
-	// Apply category IDs filter if specified
+	// Apply category IDs filter if specified, a category also matches its descendants
 	if len(f.CategoryIDs) > 0 {
-		query = query.Where(internship.HasCategoriesWith(category.IDIn(f.CategoryIDs...)))
+		query = query.Where(internship.HasCategoriesWith(categorySubtree(f.CategoryIDs)))
 	}
 
 	// Apply internship IDs filter if specified
@@ -455,6 +555,28 @@ func (o InternshipQueryOptions) ApplyEntityQueryOptions(
 		query = query.Where(internship.DurationInWeeksEQ(f.DurationInWeeks))
 	}
 
+	// Apply publish status filter if specified
+	if len(f.PublishStatuses) > 0 {
+		query = query.Where(internship.PublishStatusIn(f.PublishStatuses...))
+	}
+
+	// Apply editorial status filter, matching the internship or its pending revision
+	if len(f.EditorialStatuses) > 0 {
+		query = query.Where(internship.Or(
+			internship.PublishStatusIn(f.EditorialStatuses...),
+			internship.DraftStatusIn(f.EditorialStatuses...),
+		))
+	}
+
+	// Apply author filter if specified
+	if f.CreatedBy != "" {
+		query = query.Where(internship.CreatedBy(f.CreatedBy))
+	}
+
+	if f.InstructorID != "" {
+		query = query.Where(taughtBy(f.InstructorID))
+	}
+
 	// Apply name filter if specified (search in title)
 	if f.Name != "" {
 		query = query.Where(internship.TitleContainsFold(f.Name))
@@ -0,0 +1,369 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/internshipapplication"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainApplication "github.com/omkar273/codegeeky/internal/domain/internshipapplication"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type internshipApplicationRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts InternshipApplicationQueryOptions
+}
+
+func NewInternshipApplicationRepository(client postgres.IClient, logger *logger.Logger) domainApplication.Repository {
+	return &internshipApplicationRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: InternshipApplicationQueryOptions{},
+	}
+}
+
+func (r *internshipApplicationRepository) Create(ctx context.Context, application *domainApplication.InternshipApplication) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating internship application",
+		"application_id", application.ID,
+		"internship_batch_id", application.InternshipBatchID,
+		"user_id", application.UserID,
+	)
+
+	_, err := client.InternshipApplication.Create().
+		SetID(application.ID).
+		SetInternshipID(application.InternshipID).
+		SetInternshipBatchID(application.InternshipBatchID).
+		SetUserID(application.UserID).
+		SetAnswers(application.Answers).
+		SetNillableResumeFileID(application.ResumeFileID).
+		SetApplicationStatus(string(application.ApplicationStatus)).
+		SetStatus(string(application.Status)).
+		SetCreatedAt(application.CreatedAt).
+		SetUpdatedAt(application.UpdatedAt).
+		SetCreatedBy(application.CreatedBy).
+		SetUpdatedBy(application.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("You already applied to this batch").
+				WithReportableDetails(map[string]any{
+					"internship_batch_id": application.InternshipBatchID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship application").
+			WithReportableDetails(map[string]any{
+				"internship_batch_id": application.InternshipBatchID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipApplicationRepository) Get(ctx context.Context, id string) (*domainApplication.InternshipApplication, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting internship application", "application_id", id)
+
+	entApplication, err := client.InternshipApplication.Query().
+		Where(
+			internshipapplication.ID(id),
+			internshipapplication.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Application with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"application_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship application").
+			WithReportableDetails(map[string]any{
+				"application_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	application := &domainApplication.InternshipApplication{}
+	return application.FromEnt(entApplication), nil
+}
+
+func (r *internshipApplicationRepository) GetActive(ctx context.Context, batchID string, userID string) (*domainApplication.InternshipApplication, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting active internship application",
+		"internship_batch_id", batchID,
+		"user_id", userID,
+	)
+
+	entApplication, err := client.InternshipApplication.Query().
+		Where(
+			internshipapplication.InternshipBatchID(batchID),
+			internshipapplication.UserID(userID),
+			internshipapplication.ApplicationStatusNEQ(string(types.ApplicationStatusWithdrawn)),
+			internshipapplication.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("No application to this batch was found").
+				WithReportableDetails(map[string]any{
+					"internship_batch_id": batchID,
+					"user_id":             userID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship application").
+			WithReportableDetails(map[string]any{
+				"internship_batch_id": batchID,
+				"user_id":             userID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	application := &domainApplication.InternshipApplication{}
+	return application.FromEnt(entApplication), nil
+}
+
+func (r *internshipApplicationRepository) Update(ctx context.Context, application *domainApplication.InternshipApplication) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating internship application",
+		"application_id", application.ID,
+		"application_status", application.ApplicationStatus,
+	)
+
+	update := client.InternshipApplication.UpdateOneID(application.ID).
+		SetApplicationStatus(string(application.ApplicationStatus)).
+		SetNillableInterviewAt(application.InterviewAt).
+		SetNillableReviewerNote(application.ReviewerNote).
+		SetNillableReviewedBy(application.ReviewedBy).
+		SetNillableReviewedAt(application.ReviewedAt).
+		SetNillableOfferExpiresAt(application.OfferExpiresAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// a later stage replaces the note of the previous one
+	if application.ReviewerNote == nil {
+		update.ClearReviewerNote()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Application with ID %s was not found", application.ID).
+				WithReportableDetails(map[string]any{
+					"application_id": application.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update internship application").
+			WithReportableDetails(map[string]any{
+				"application_id": application.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipApplicationRepository) Count(ctx context.Context, filter *types.InternshipApplicationFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting internship applications")
+
+	query := client.InternshipApplication.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship applications").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *internshipApplicationRepository) List(ctx context.Context, filter *types.InternshipApplicationFilter) ([]*domainApplication.InternshipApplication, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing internship applications",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internshipapplication.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.InternshipApplication.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	applications, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship applications").
+			Mark(ierr.ErrDatabase)
+	}
+
+	application := &domainApplication.InternshipApplication{}
+	applications = ReverseIfBackward(filter, applications)
+
+	return application.FromEntList(applications), nil
+}
+
+func (r *internshipApplicationRepository) ListAll(ctx context.Context, filter *types.InternshipApplicationFilter) ([]*domainApplication.InternshipApplication, error) {
+	if filter == nil {
+		filter = types.NewNoLimitInternshipApplicationFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	applications, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// InternshipApplicationQuery type alias for better readability
+type InternshipApplicationQuery = *ent.InternshipApplicationQuery
+
+// InternshipApplicationQueryOptions implements query options for application queries
+type InternshipApplicationQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure InternshipApplicationQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[InternshipApplicationQuery, *types.InternshipApplicationFilter] = (*InternshipApplicationQueryOptions)(nil)
+
+func (o InternshipApplicationQueryOptions) ApplyStatusFilter(query InternshipApplicationQuery, status string) InternshipApplicationQuery {
+	if status == "" {
+		return query.Where(internshipapplication.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(internshipapplication.Status(status))
+}
+
+func (o InternshipApplicationQueryOptions) ApplySortFilter(query InternshipApplicationQuery, field string, order string) InternshipApplicationQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(internshipapplication.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(internshipapplication.FieldID))
+}
+
+func (o InternshipApplicationQueryOptions) ApplyPaginationFilter(query InternshipApplicationQuery, limit int, offset int) InternshipApplicationQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o InternshipApplicationQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return internshipapplication.FieldCreatedAt
+	case "updated_at":
+		return internshipapplication.FieldUpdatedAt
+	case "reviewed_at":
+		return internshipapplication.FieldReviewedAt
+	case "interview_at":
+		return internshipapplication.FieldInterviewAt
+	case "created_by":
+		return internshipapplication.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o InternshipApplicationQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query InternshipApplicationQuery,
+	filter *types.InternshipApplicationFilter,
+) InternshipApplicationQuery {
+	if filter == nil {
+		return query.Where(internshipapplication.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[InternshipApplicationQuery, predicate.InternshipApplication, internshipapplication.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internshipapplication.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o InternshipApplicationQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.InternshipApplicationFilter,
+	query InternshipApplicationQuery,
+) InternshipApplicationQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(internshipapplication.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.InternshipBatchIDs) > 0 {
+		query = query.Where(internshipapplication.InternshipBatchIDIn(f.InternshipBatchIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(internshipapplication.UserIDIn(f.UserIDs...))
+	}
+
+	if f.ApplicationStatus != "" {
+		query = query.Where(internshipapplication.ApplicationStatus(string(f.ApplicationStatus)))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(internshipapplication.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(internshipapplication.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
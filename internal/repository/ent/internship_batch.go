@@ -6,6 +6,7 @@ import (
 
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/internshipbatch"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -44,6 +45,11 @@ func (r *internshipBatchRepository) Create(ctx context.Context, batch *domainInt
 		SetStartDate(batch.StartDate).
 		SetEndDate(batch.EndDate).
 		SetBatchStatus(string(batch.BatchStatus)).
+		SetNillableEnrollmentClosedAt(batch.EnrollmentClosedAt).
+		SetNillablePausedAt(batch.PausedAt).
+		SetNillableRemindedAt(batch.RemindedAt).
+		SetNillableCancelledAt(batch.CancelledAt).
+		SetNillableCancellationReason(batch.CancellationReason).
 		SetMetadata(batch.Metadata).
 		SetStatus(string(types.StatusPublished)).
 		SetCreatedAt(batch.CreatedAt).
@@ -112,16 +118,30 @@ func (r *internshipBatchRepository) Update(ctx context.Context, batch *domainInt
 		"name", batch.Name,
 	)
 
-	_, err := client.InternshipBatch.UpdateOneID(batch.ID).
+	update := client.InternshipBatch.UpdateOneID(batch.ID).
 		SetName(batch.Name).
 		SetDescription(batch.Description).
 		SetStartDate(batch.StartDate).
 		SetEndDate(batch.EndDate).
 		SetBatchStatus(string(batch.BatchStatus)).
+		SetNillableEnrollmentClosedAt(batch.EnrollmentClosedAt).
+		SetNillablePausedAt(batch.PausedAt).
+		SetNillableRemindedAt(batch.RemindedAt).
+		SetNillableCancelledAt(batch.CancelledAt).
+		SetNillableCancellationReason(batch.CancellationReason).
 		SetMetadata(batch.Metadata).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// resuming a batch clears its pause
+	if batch.PausedAt == nil {
+		update.ClearPausedAt()
+	}
+	if batch.RemindedAt == nil {
+		update.ClearRemindedAt()
+	}
+
+	_, err := update.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -203,6 +223,10 @@ func (r *internshipBatchRepository) List(ctx context.Context, filter *types.Inte
 		"offset", filter.GetOffset(),
 	)
 
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internshipbatch.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.InternshipBatch.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -215,6 +239,8 @@ func (r *internshipBatchRepository) List(ctx context.Context, filter *types.Inte
 	}
 
 	batch := &domainInternship.InternshipBatch{}
+	batches = ReverseIfBackward(filter, batches)
+
 	return batch.FromEntList(batches), nil
 }
 
@@ -256,9 +282,9 @@ func (o InternshipBatchQueryOptions) ApplySortFilter(query InternshipBatchQuery,
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(internshipbatch.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(internshipbatch.FieldID))
 }
 
 func (o InternshipBatchQueryOptions) ApplyPaginationFilter(query InternshipBatchQuery, limit int, offset int) InternshipBatchQuery {
@@ -305,7 +331,11 @@ func (o InternshipBatchQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[InternshipBatchQuery, predicate.InternshipBatch, internshipbatch.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internshipbatch.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
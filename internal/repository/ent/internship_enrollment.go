@@ -6,6 +6,7 @@ import (
 
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/internshipenrollment"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -41,13 +42,18 @@ func (r *internshipEnrollmentRepository) Create(ctx context.Context, enrollmentD
 		SetID(enrollmentData.ID).
 		SetUserID(enrollmentData.UserID).
 		SetInternshipID(enrollmentData.InternshipID).
+		SetInternshipBatchID(enrollmentData.InternshipBatchID).
+		SetNillableInternshipRevisionID(enrollmentData.InternshipRevisionID).
 		SetEnrollmentStatus(enrollmentData.EnrollmentStatus).
 		SetPaymentStatus(enrollmentData.PaymentStatus).
 		SetNillableEnrolledAt(enrollmentData.EnrolledAt).
 		SetNillablePaymentID(enrollmentData.PaymentID).
 		SetNillableRefundedAt(enrollmentData.RefundedAt).
+		SetNillableCompletedAt(enrollmentData.CompletedAt).
 		SetNillableCancellationReason(enrollmentData.CancellationReason).
 		SetNillableRefundReason(enrollmentData.RefundReason).
+		SetNillableIdempotencyKey(enrollmentData.IdempotencyKey).
+		SetNillablePaymentPlanID(enrollmentData.PaymentPlanID).
 		SetMetadata(enrollmentData.Metadata).
 		SetStatus(string(types.StatusPublished)).
 		SetCreatedAt(enrollmentData.CreatedAt).
@@ -118,7 +124,7 @@ func (r *internshipEnrollmentRepository) Update(ctx context.Context, enrollmentD
 		"internship_id", enrollmentData.InternshipID,
 	)
 
-	_, err := client.InternshipEnrollment.UpdateOneID(enrollmentData.ID).
+	update := client.InternshipEnrollment.UpdateOneID(enrollmentData.ID).
 		SetUserID(enrollmentData.UserID).
 		SetInternshipID(enrollmentData.InternshipID).
 		SetEnrollmentStatus(enrollmentData.EnrollmentStatus).
@@ -126,13 +132,20 @@ func (r *internshipEnrollmentRepository) Update(ctx context.Context, enrollmentD
 		SetNillableEnrolledAt(enrollmentData.EnrolledAt).
 		SetNillablePaymentID(enrollmentData.PaymentID).
 		SetNillableRefundedAt(enrollmentData.RefundedAt).
+		SetNillableCompletedAt(enrollmentData.CompletedAt).
 		SetNillableCancellationReason(enrollmentData.CancellationReason).
 		SetNillableRefundReason(enrollmentData.RefundReason).
 		SetMetadata(enrollmentData.Metadata).
 		SetUpdatedAt(time.Now().UTC()).
 		SetNillableIdempotencyKey(enrollmentData.IdempotencyKey).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// expired checkouts drop their key so the student can check out again
+	if enrollmentData.IdempotencyKey == nil {
+		update.ClearIdempotencyKey()
+	}
+
+	_, err := update.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -216,6 +229,42 @@ func (r *internshipEnrollmentRepository) Count(ctx context.Context, filter *type
 	return count, nil
 }
 
+func (r *internshipEnrollmentRepository) CountByInternship(ctx context.Context, internshipIDs []string, statuses []types.InternshipEnrollmentStatus) (map[string]int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting enrollments per internship", "internship_count", len(internshipIDs))
+
+	if len(internshipIDs) == 0 || len(statuses) == 0 {
+		return map[string]int{}, nil
+	}
+
+	var rows []struct {
+		InternshipID string `json:"internship_id"`
+		Count        int    `json:"count"`
+	}
+	err := client.InternshipEnrollment.Query().
+		Where(
+			internshipenrollment.StatusNotIn(string(types.StatusDeleted)),
+			internshipenrollment.InternshipIDIn(internshipIDs...),
+			internshipenrollment.EnrollmentStatusIn(statuses...),
+		).
+		GroupBy(internshipenrollment.FieldInternshipID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to count enrollments per internship").
+			Mark(ierr.ErrDatabase)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.InternshipID] = row.Count
+	}
+
+	return counts, nil
+}
+
 func (r *internshipEnrollmentRepository) List(ctx context.Context, filter *types.InternshipEnrollmentFilter) ([]*domainInternshipEnrollment.InternshipEnrollment, error) {
 	client := r.client.Querier(ctx)
 
@@ -224,6 +273,10 @@ func (r *internshipEnrollmentRepository) List(ctx context.Context, filter *types
 		"offset", filter.GetOffset(),
 	)
 
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internshipenrollment.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.InternshipEnrollment.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -235,6 +288,8 @@ func (r *internshipEnrollmentRepository) List(ctx context.Context, filter *types
 			Mark(ierr.ErrDatabase)
 	}
 
+	enrollments = ReverseIfBackward(filter, enrollments)
+
 	return domainInternshipEnrollment.FromEntList(enrollments), nil
 }
 
@@ -300,9 +355,9 @@ func (o EnrollmentQueryOptions) ApplySortFilter(query EnrollmentQuery, field str
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(internshipenrollment.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(internshipenrollment.FieldID))
 }
 
 func (o EnrollmentQueryOptions) ApplyPaginationFilter(query EnrollmentQuery, limit int, offset int) EnrollmentQuery {
@@ -355,7 +410,11 @@ func (o EnrollmentQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[EnrollmentQuery, predicate.InternshipEnrollment, internshipenrollment.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internshipenrollment.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -405,6 +464,11 @@ func (o EnrollmentQueryOptions) ApplyEntityQueryOptions(
 		query = query.Where(internshipenrollment.PaymentID(lo.FromPtr(f.PaymentID)))
 	}
 
+	// Apply internship batch ID filter if specified
+	if f.InternshipBatchID != nil && *f.InternshipBatchID != "" {
+		query = query.Where(internshipenrollment.InternshipBatchID(lo.FromPtr(f.InternshipBatchID)))
+	}
+
 	// Apply time range filters if specified
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil {
@@ -0,0 +1,390 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/internshipinstructor"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type internshipInstructorRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts InternshipInstructorQueryOptions
+}
+
+func NewInternshipInstructorRepository(client postgres.IClient, logger *logger.Logger) domainInternship.InternshipInstructorRepository {
+	return &internshipInstructorRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: InternshipInstructorQueryOptions{},
+	}
+}
+
+func (r *internshipInstructorRepository) Create(ctx context.Context, instructor *domainInternship.InternshipInstructor) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating internship instructor",
+		"internship_id", instructor.InternshipID,
+		"user_id", instructor.UserID,
+		"role", instructor.Role,
+	)
+
+	_, err := client.InternshipInstructor.Create().
+		SetID(instructor.ID).
+		SetInternshipID(instructor.InternshipID).
+		SetUserID(instructor.UserID).
+		SetRole(string(instructor.Role)).
+		SetStatus(string(instructor.Status)).
+		SetCreatedAt(instructor.CreatedAt).
+		SetUpdatedAt(instructor.UpdatedAt).
+		SetCreatedBy(instructor.CreatedBy).
+		SetUpdatedBy(instructor.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("The user is already an instructor of this internship").
+				WithReportableDetails(map[string]any{
+					"internship_id": instructor.InternshipID,
+					"user_id":       instructor.UserID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship instructor").
+			WithReportableDetails(map[string]any{
+				"internship_id": instructor.InternshipID,
+				"user_id":       instructor.UserID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipInstructorRepository) Get(ctx context.Context, id string) (*domainInternship.InternshipInstructor, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting internship instructor", "instructor_id", id)
+
+	entInstructor, err := client.InternshipInstructor.Query().
+		Where(
+			internshipinstructor.ID(id),
+			internshipinstructor.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Internship instructor with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"instructor_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship instructor").
+			WithReportableDetails(map[string]any{
+				"instructor_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	instructor := &domainInternship.InternshipInstructor{}
+	return instructor.FromEnt(entInstructor), nil
+}
+
+func (r *internshipInstructorRepository) GetByInternshipAndUser(ctx context.Context, internshipID string, userID string) (*domainInternship.InternshipInstructor, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting internship instructor",
+		"internship_id", internshipID,
+		"user_id", userID,
+	)
+
+	entInstructor, err := client.InternshipInstructor.Query().
+		Where(
+			internshipinstructor.InternshipID(internshipID),
+			internshipinstructor.UserID(userID),
+			internshipinstructor.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("User %s is not an instructor of internship %s", userID, internshipID).
+				WithReportableDetails(map[string]any{
+					"internship_id": internshipID,
+					"user_id":       userID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship instructor").
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+				"user_id":       userID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	instructor := &domainInternship.InternshipInstructor{}
+	return instructor.FromEnt(entInstructor), nil
+}
+
+func (r *internshipInstructorRepository) Update(ctx context.Context, instructor *domainInternship.InternshipInstructor) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating internship instructor",
+		"instructor_id", instructor.ID,
+		"role", instructor.Role,
+	)
+
+	_, err := client.InternshipInstructor.UpdateOneID(instructor.ID).
+		SetRole(string(instructor.Role)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Internship instructor with ID %s was not found", instructor.ID).
+				WithReportableDetails(map[string]any{
+					"instructor_id": instructor.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update internship instructor").
+			WithReportableDetails(map[string]any{
+				"instructor_id": instructor.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipInstructorRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting internship instructor",
+		"instructor_id", id,
+	)
+
+	_, err := client.InternshipInstructor.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Internship instructor with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"instructor_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete internship instructor").
+			WithReportableDetails(map[string]any{
+				"instructor_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipInstructorRepository) Count(ctx context.Context, filter *types.InternshipInstructorFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting internship instructors")
+
+	query := client.InternshipInstructor.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship instructors").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *internshipInstructorRepository) List(ctx context.Context, filter *types.InternshipInstructorFilter) ([]*domainInternship.InternshipInstructor, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing internship instructors",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internshipinstructor.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.InternshipInstructor.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	instructors, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship instructors").
+			Mark(ierr.ErrDatabase)
+	}
+
+	instructor := &domainInternship.InternshipInstructor{}
+	instructors = ReverseIfBackward(filter, instructors)
+
+	return instructor.FromEntList(instructors), nil
+}
+
+func (r *internshipInstructorRepository) ListAll(ctx context.Context, filter *types.InternshipInstructorFilter) ([]*domainInternship.InternshipInstructor, error) {
+	if filter == nil {
+		filter = types.NewNoLimitInternshipInstructorFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	instructors, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return instructors, nil
+}
+
+// InternshipInstructorQuery type alias for better readability
+type InternshipInstructorQuery = *ent.InternshipInstructorQuery
+
+// InternshipInstructorQueryOptions implements query options for internship instructor queries
+type InternshipInstructorQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure InternshipInstructorQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[InternshipInstructorQuery, *types.InternshipInstructorFilter] = (*InternshipInstructorQueryOptions)(nil)
+
+func (o InternshipInstructorQueryOptions) ApplyStatusFilter(query InternshipInstructorQuery, status string) InternshipInstructorQuery {
+	if status == "" {
+		return query.Where(internshipinstructor.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(internshipinstructor.Status(status))
+}
+
+func (o InternshipInstructorQueryOptions) ApplySortFilter(query InternshipInstructorQuery, field string, order string) InternshipInstructorQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(internshipinstructor.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(internshipinstructor.FieldID))
+}
+
+func (o InternshipInstructorQueryOptions) ApplyPaginationFilter(query InternshipInstructorQuery, limit int, offset int) InternshipInstructorQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o InternshipInstructorQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return internshipinstructor.FieldCreatedAt
+	case "updated_at":
+		return internshipinstructor.FieldUpdatedAt
+	case "internship_id":
+		return internshipinstructor.FieldInternshipID
+	case "user_id":
+		return internshipinstructor.FieldUserID
+	case "role":
+		return internshipinstructor.FieldRole
+	case "created_by":
+		return internshipinstructor.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o InternshipInstructorQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query InternshipInstructorQuery,
+	filter *types.InternshipInstructorFilter,
+) InternshipInstructorQuery {
+	if filter == nil {
+		return query.Where(internshipinstructor.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[InternshipInstructorQuery, predicate.InternshipInstructor, internshipinstructor.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internshipinstructor.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o InternshipInstructorQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.InternshipInstructorFilter,
+	query InternshipInstructorQuery,
+) InternshipInstructorQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(internshipinstructor.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(internshipinstructor.UserIDIn(f.UserIDs...))
+	}
+
+	if len(f.Roles) > 0 {
+		query = query.Where(internshipinstructor.RoleIn(lo.Map(f.Roles, func(role types.InternshipInstructorRole, _ int) string {
+			return string(role)
+		})...))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(internshipinstructor.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(internshipinstructor.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
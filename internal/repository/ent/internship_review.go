@@ -0,0 +1,416 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/internshipreview"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainReview "github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type internshipReviewRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts InternshipReviewQueryOptions
+}
+
+func NewInternshipReviewRepository(client postgres.IClient, logger *logger.Logger) domainReview.Repository {
+	return &internshipReviewRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: InternshipReviewQueryOptions{},
+	}
+}
+
+func (r *internshipReviewRepository) Create(ctx context.Context, review *domainReview.InternshipReview) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating internship review",
+		"review_id", review.ID,
+		"internship_id", review.InternshipID,
+		"user_id", review.UserID,
+	)
+
+	_, err := client.InternshipReview.Create().
+		SetID(review.ID).
+		SetInternshipID(review.InternshipID).
+		SetUserID(review.UserID).
+		SetEnrollmentID(review.EnrollmentID).
+		SetRating(review.Rating).
+		SetNillableTitle(review.Title).
+		SetBody(review.Body).
+		SetReviewStatus(string(review.ReviewStatus)).
+		SetStatus(string(review.Status)).
+		SetCreatedAt(review.CreatedAt).
+		SetUpdatedAt(review.UpdatedAt).
+		SetCreatedBy(review.CreatedBy).
+		SetUpdatedBy(review.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("You already reviewed this internship").
+				WithReportableDetails(map[string]any{
+					"internship_id": review.InternshipID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship review").
+			WithReportableDetails(map[string]any{
+				"internship_id": review.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipReviewRepository) Get(ctx context.Context, id string) (*domainReview.InternshipReview, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting internship review", "review_id", id)
+
+	entReview, err := client.InternshipReview.Query().
+		Where(
+			internshipreview.ID(id),
+			internshipreview.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Review with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"review_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship review").
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	review := &domainReview.InternshipReview{}
+	return review.FromEnt(entReview), nil
+}
+
+func (r *internshipReviewRepository) GetByInternshipAndUser(ctx context.Context, internshipID string, userID string) (*domainReview.InternshipReview, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting internship review of user",
+		"internship_id", internshipID,
+		"user_id", userID,
+	)
+
+	entReview, err := client.InternshipReview.Query().
+		Where(
+			internshipreview.InternshipID(internshipID),
+			internshipreview.UserID(userID),
+			internshipreview.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("No review of this internship was found").
+				WithReportableDetails(map[string]any{
+					"internship_id": internshipID,
+					"user_id":       userID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship review").
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+				"user_id":       userID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	review := &domainReview.InternshipReview{}
+	return review.FromEnt(entReview), nil
+}
+
+func (r *internshipReviewRepository) Update(ctx context.Context, review *domainReview.InternshipReview) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating internship review",
+		"review_id", review.ID,
+		"review_status", review.ReviewStatus,
+	)
+
+	update := client.InternshipReview.UpdateOneID(review.ID).
+		SetRating(review.Rating).
+		SetNillableTitle(review.Title).
+		SetBody(review.Body).
+		SetReviewStatus(string(review.ReviewStatus)).
+		SetNillableReply(review.Reply).
+		SetNillableRepliedBy(review.RepliedBy).
+		SetNillableRepliedAt(review.RepliedAt).
+		SetReports(review.Reports).
+		SetReportCount(review.ReportCount).
+		SetNillableModerationNote(review.ModerationNote).
+		SetNillableModeratedBy(review.ModeratedBy).
+		SetNillableModeratedAt(review.ModeratedAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if review.Title == nil {
+		update.ClearTitle()
+	}
+	// removing a reply or restoring a hidden review clears them
+	if review.Reply == nil {
+		update.ClearReply().ClearRepliedBy().ClearRepliedAt()
+	}
+	if review.ModerationNote == nil {
+		update.ClearModerationNote()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Review with ID %s was not found", review.ID).
+				WithReportableDetails(map[string]any{
+					"review_id": review.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update internship review").
+			WithReportableDetails(map[string]any{
+				"review_id": review.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipReviewRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting internship review", "review_id", id)
+
+	_, err := client.InternshipReview.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Review with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"review_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete internship review").
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipReviewRepository) Count(ctx context.Context, filter *types.InternshipReviewFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting internship reviews")
+
+	query := client.InternshipReview.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship reviews").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *internshipReviewRepository) List(ctx context.Context, filter *types.InternshipReviewFilter) ([]*domainReview.InternshipReview, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing internship reviews",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internshipreview.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.InternshipReview.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	reviews, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship reviews").
+			Mark(ierr.ErrDatabase)
+	}
+
+	review := &domainReview.InternshipReview{}
+	reviews = ReverseIfBackward(filter, reviews)
+
+	return review.FromEntList(reviews), nil
+}
+
+func (r *internshipReviewRepository) ListAll(ctx context.Context, filter *types.InternshipReviewFilter) ([]*domainReview.InternshipReview, error) {
+	if filter == nil {
+		filter = types.NewNoLimitInternshipReviewFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	reviews, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// InternshipReviewQuery type alias for better readability
+type InternshipReviewQuery = *ent.InternshipReviewQuery
+
+// InternshipReviewQueryOptions implements query options for review queries
+type InternshipReviewQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure InternshipReviewQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[InternshipReviewQuery, *types.InternshipReviewFilter] = (*InternshipReviewQueryOptions)(nil)
+
+func (o InternshipReviewQueryOptions) ApplyStatusFilter(query InternshipReviewQuery, status string) InternshipReviewQuery {
+	if status == "" {
+		return query.Where(internshipreview.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(internshipreview.Status(status))
+}
+
+func (o InternshipReviewQueryOptions) ApplySortFilter(query InternshipReviewQuery, field string, order string) InternshipReviewQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(internshipreview.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(internshipreview.FieldID))
+}
+
+func (o InternshipReviewQueryOptions) ApplyPaginationFilter(query InternshipReviewQuery, limit int, offset int) InternshipReviewQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o InternshipReviewQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return internshipreview.FieldCreatedAt
+	case "updated_at":
+		return internshipreview.FieldUpdatedAt
+	case "rating":
+		return internshipreview.FieldRating
+	case "report_count":
+		return internshipreview.FieldReportCount
+	case "created_by":
+		return internshipreview.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o InternshipReviewQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query InternshipReviewQuery,
+	filter *types.InternshipReviewFilter,
+) InternshipReviewQuery {
+	if filter == nil {
+		return query.Where(internshipreview.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[InternshipReviewQuery, predicate.InternshipReview, internshipreview.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internshipreview.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o InternshipReviewQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.InternshipReviewFilter,
+	query InternshipReviewQuery,
+) InternshipReviewQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(internshipreview.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(internshipreview.UserIDIn(f.UserIDs...))
+	}
+
+	if f.ReviewStatus != "" {
+		query = query.Where(internshipreview.ReviewStatus(string(f.ReviewStatus)))
+	}
+
+	if f.MinRating > 0 {
+		query = query.Where(internshipreview.RatingGTE(f.MinRating))
+	}
+
+	if f.Reported {
+		query = query.Where(internshipreview.ReportCountGT(0))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(internshipreview.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(internshipreview.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
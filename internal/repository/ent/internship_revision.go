@@ -0,0 +1,289 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/internshiprevision"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type internshipRevisionRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts InternshipRevisionQueryOptions
+}
+
+func NewInternshipRevisionRepository(client postgres.IClient, logger *logger.Logger) domainInternship.InternshipRevisionRepository {
+	return &internshipRevisionRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: InternshipRevisionQueryOptions{},
+	}
+}
+
+func (r *internshipRevisionRepository) Create(ctx context.Context, revision *domainInternship.InternshipRevision) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating internship revision",
+		"revision_id", revision.ID,
+		"internship_id", revision.InternshipID,
+		"revision", revision.Revision,
+	)
+
+	_, err := client.InternshipRevision.Create().
+		SetID(revision.ID).
+		SetInternshipID(revision.InternshipID).
+		SetRevision(revision.Revision).
+		SetSnapshot(revision.Snapshot).
+		SetNillableRestoredFromID(revision.RestoredFromID).
+		SetStatus(string(revision.Status)).
+		SetCreatedAt(revision.CreatedAt).
+		SetUpdatedAt(revision.UpdatedAt).
+		SetCreatedBy(revision.CreatedBy).
+		SetUpdatedBy(revision.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("The internship was changed concurrently, please retry").
+				WithReportableDetails(map[string]any{
+					"internship_id": revision.InternshipID,
+					"revision":      revision.Revision,
+				}).
+				Mark(ierr.ErrVersionConflict)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship revision").
+			WithReportableDetails(map[string]any{
+				"internship_id": revision.InternshipID,
+				"revision":      revision.Revision,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *internshipRevisionRepository) Get(ctx context.Context, id string) (*domainInternship.InternshipRevision, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting internship revision", "revision_id", id)
+
+	entRevision, err := client.InternshipRevision.Query().
+		Where(
+			internshiprevision.ID(id),
+			internshiprevision.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Internship revision with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"revision_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship revision").
+			WithReportableDetails(map[string]any{
+				"revision_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	revision := &domainInternship.InternshipRevision{}
+	return revision.FromEnt(entRevision), nil
+}
+
+func (r *internshipRevisionRepository) GetLatest(ctx context.Context, internshipID string) (*domainInternship.InternshipRevision, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting latest internship revision", "internship_id", internshipID)
+
+	entRevision, err := client.InternshipRevision.Query().
+		Where(
+			internshiprevision.InternshipID(internshipID),
+			internshiprevision.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Order(ent.Desc(internshiprevision.FieldRevision)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Internship %s has no revisions", internshipID).
+				WithReportableDetails(map[string]any{
+					"internship_id": internshipID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship revision").
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	revision := &domainInternship.InternshipRevision{}
+	return revision.FromEnt(entRevision), nil
+}
+
+func (r *internshipRevisionRepository) Count(ctx context.Context, filter *types.InternshipRevisionFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting internship revisions")
+
+	query := client.InternshipRevision.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship revisions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *internshipRevisionRepository) List(ctx context.Context, filter *types.InternshipRevisionFilter) ([]*domainInternship.InternshipRevision, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing internship revisions",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, internshiprevision.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.InternshipRevision.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	revisions, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship revisions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	revision := &domainInternship.InternshipRevision{}
+	revisions = ReverseIfBackward(filter, revisions)
+
+	return revision.FromEntList(revisions), nil
+}
+
+// InternshipRevisionQuery type alias for better readability
+type InternshipRevisionQuery = *ent.InternshipRevisionQuery
+
+// InternshipRevisionQueryOptions implements query options for internship revision queries
+type InternshipRevisionQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure InternshipRevisionQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[InternshipRevisionQuery, *types.InternshipRevisionFilter] = (*InternshipRevisionQueryOptions)(nil)
+
+func (o InternshipRevisionQueryOptions) ApplyStatusFilter(query InternshipRevisionQuery, status string) InternshipRevisionQuery {
+	if status == "" {
+		return query.Where(internshiprevision.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(internshiprevision.Status(status))
+}
+
+func (o InternshipRevisionQueryOptions) ApplySortFilter(query InternshipRevisionQuery, field string, order string) InternshipRevisionQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(internshiprevision.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(internshiprevision.FieldID))
+}
+
+func (o InternshipRevisionQueryOptions) ApplyPaginationFilter(query InternshipRevisionQuery, limit int, offset int) InternshipRevisionQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o InternshipRevisionQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return internshiprevision.FieldCreatedAt
+	case "updated_at":
+		return internshiprevision.FieldUpdatedAt
+	case "internship_id":
+		return internshiprevision.FieldInternshipID
+	case "revision":
+		return internshiprevision.FieldRevision
+	case "created_by":
+		return internshiprevision.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o InternshipRevisionQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query InternshipRevisionQuery,
+	filter *types.InternshipRevisionFilter,
+) InternshipRevisionQuery {
+	if filter == nil {
+		return query.Where(internshiprevision.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[InternshipRevisionQuery, predicate.InternshipRevision, internshiprevision.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), internshiprevision.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o InternshipRevisionQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.InternshipRevisionFilter,
+	query InternshipRevisionQuery,
+) InternshipRevisionQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.InternshipID != "" {
+		query = query.Where(internshiprevision.InternshipID(f.InternshipID))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(internshiprevision.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(internshiprevision.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
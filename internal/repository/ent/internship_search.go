@@ -0,0 +1,322 @@
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"unicode"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/category"
+	"github.com/omkar273/codegeeky/ent/internship"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// titleSimilarityThreshold is the minimum trigram word similarity between the query
+// and the title for a typo to still match
+const titleSimilarityThreshold = 0.4
+
+func (r *internshipRepository) Search(ctx context.Context, filter *types.InternshipSearchFilter) ([]*domainInternship.Internship, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("searching internships",
+		"query", filter.Query,
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	query := client.Internship.Query().
+		Where(searchPredicates(filter)...).
+		WithCategories()
+
+	if words := searchWords(filter.Query); len(words) > 0 {
+		query = query.Order(searchRank(words), ent.Desc(internship.FieldCreatedAt))
+	} else {
+		query = r.queryOpts.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+	}
+
+	if !filter.IsUnlimited() {
+		query = r.queryOpts.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	internships, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to search internships").
+			WithReportableDetails(map[string]any{
+				"query": filter.Query,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return (&domainInternship.Internship{}).FromEntList(internships), nil
+}
+
+func (r *internshipRepository) SearchCount(ctx context.Context, filter *types.InternshipSearchFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	count, err := client.Internship.Query().
+		Where(searchPredicates(filter)...).
+		Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship search results").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *internshipRepository) SearchFacets(ctx context.Context, filter *types.InternshipSearchFilter) (*types.InternshipSearchFacets, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting internship search facets", "query", filter.Query)
+
+	var levelRows []struct {
+		Level string `json:"level"`
+		Count int    `json:"count"`
+	}
+	err := client.Internship.Query().
+		Where(searchPredicates(filter.Without(types.InternshipFacetLevel))...).
+		GroupBy(internship.FieldLevel).
+		Aggregate(ent.Count()).
+		Scan(ctx, &levelRows)
+	if err != nil {
+		return nil, errSearchFacet(err, types.InternshipFacetLevel)
+	}
+
+	var modeRows []struct {
+		Mode  string `json:"mode"`
+		Count int    `json:"count"`
+	}
+	err = client.Internship.Query().
+		Where(searchPredicates(filter.Without(types.InternshipFacetMode))...).
+		GroupBy(internship.FieldMode).
+		Aggregate(ent.Count()).
+		Scan(ctx, &modeRows)
+	if err != nil {
+		return nil, errSearchFacet(err, types.InternshipFacetMode)
+	}
+
+	var durationRows []struct {
+		DurationInWeeks sql.NullInt64 `json:"duration_in_weeks"`
+		Count           int           `json:"count"`
+	}
+	err = client.Internship.Query().
+		Where(searchPredicates(filter.Without(types.InternshipFacetDuration))...).
+		GroupBy(internship.FieldDurationInWeeks).
+		Aggregate(ent.Count()).
+		Scan(ctx, &durationRows)
+	if err != nil {
+		return nil, errSearchFacet(err, types.InternshipFacetDuration)
+	}
+
+	var priceRows []struct {
+		Total decimal.Decimal `json:"total"`
+		Count int             `json:"count"`
+	}
+	err = client.Internship.Query().
+		Where(searchPredicates(filter.Without(types.InternshipFacetPrice))...).
+		GroupBy(internship.FieldTotal).
+		Aggregate(ent.Count()).
+		Scan(ctx, &priceRows)
+	if err != nil {
+		return nil, errSearchFacet(err, types.InternshipFacetPrice)
+	}
+
+	// categories hang off an edge, count the distinct matching internships per category
+	var categoryRows []categoryFacetRow
+	err = client.Internship.Query().
+		Where(searchPredicates(filter.Without(types.InternshipFacetCategory))...).
+		QueryCategories().
+		GroupBy(category.FieldID, category.FieldName).
+		Aggregate(func(s *entsql.Selector) string {
+			return entsql.As(entsql.Count(entsql.Distinct(s.C(internship.CategoriesColumn))), "count")
+		}).
+		Scan(ctx, &categoryRows)
+	if err != nil {
+		return nil, errSearchFacet(err, types.InternshipFacetCategory)
+	}
+
+	levelCounts := make(map[string]int, len(levelRows))
+	for _, row := range levelRows {
+		levelCounts[row.Level] += row.Count
+	}
+
+	modeCounts := make(map[string]int, len(modeRows))
+	for _, row := range modeRows {
+		modeCounts[row.Mode] += row.Count
+	}
+
+	durationCounts := make(map[string]int)
+	for _, row := range durationRows {
+		if !row.DurationInWeeks.Valid {
+			continue
+		}
+		durationCounts[string(types.DurationBucketFor(int(row.DurationInWeeks.Int64)))] += row.Count
+	}
+
+	priceCounts := make(map[string]int)
+	for _, row := range priceRows {
+		priceCounts[string(types.PriceBucketFor(row.Total))] += row.Count
+	}
+
+	return &types.InternshipSearchFacets{
+		Categories: categoryFacet(categoryRows),
+		Levels:     enumFacet(types.InternshipLevels, levelCounts),
+		Modes:      enumFacet(types.InternshipModes, modeCounts),
+		Durations:  enumFacet(types.InternshipDurationBuckets, durationCounts),
+		Prices:     enumFacet(types.InternshipPriceBuckets, priceCounts),
+	}, nil
+}
+
+// searchPredicates translates the search filter into predicates on internships
+func searchPredicates(f *types.InternshipSearchFilter) []predicate.Internship {
+	predicates := []predicate.Internship{
+		internship.StatusNotIn(string(types.StatusDeleted)),
+	}
+
+	if len(f.PublishStatuses) > 0 {
+		predicates = append(predicates, internship.PublishStatusIn(f.PublishStatuses...))
+	}
+
+	if words := searchWords(f.Query); len(words) > 0 {
+		predicates = append(predicates, searchMatch(words))
+	}
+
+	if len(f.CategoryIDs) > 0 {
+		predicates = append(predicates, internship.HasCategoriesWith(categorySubtree(f.CategoryIDs)))
+	}
+
+	if len(f.Levels) > 0 {
+		predicates = append(predicates, internship.LevelIn(lo.Map(f.Levels, func(l types.InternshipLevel, _ int) string {
+			return string(l)
+		})...))
+	}
+
+	if len(f.Modes) > 0 {
+		predicates = append(predicates, internship.ModeIn(lo.Map(f.Modes, func(m types.InternshipMode, _ int) string {
+			return string(m)
+		})...))
+	}
+
+	if len(f.DurationBuckets) > 0 {
+		predicates = append(predicates, internship.Or(lo.Map(f.DurationBuckets, func(b types.InternshipDurationBucket, _ int) predicate.Internship {
+			lower, upper := b.Range()
+			if upper == nil {
+				return internship.DurationInWeeksGTE(lower)
+			}
+			return internship.And(internship.DurationInWeeksGTE(lower), internship.DurationInWeeksLTE(*upper))
+		})...))
+	}
+
+	if len(f.PriceBuckets) > 0 {
+		predicates = append(predicates, internship.Or(lo.Map(f.PriceBuckets, func(b types.InternshipPriceBucket, _ int) predicate.Internship {
+			lower, upper := b.Range()
+			switch {
+			case b == types.InternshipPriceFree:
+				return internship.TotalLTE(decimal.Zero)
+			case upper == nil:
+				return internship.TotalGT(lower)
+			default:
+				return internship.And(internship.TotalGT(lower), internship.TotalLTE(*upper))
+			}
+		})...))
+	}
+
+	return predicates
+}
+
+// searchMatch matches the search document, or the title with a typo
+func searchMatch(words []string) predicate.Internship {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("((").
+				WriteString(postgres.InternshipSearchDocument(s.C)).
+				WriteString(") @@ to_tsquery('" + postgres.SearchConfig + "', ").Arg(toPrefixTSQuery(words)).WriteString(")").
+				WriteString(" OR word_similarity(").Arg(strings.Join(words, " ")).WriteString(", ").
+				WriteString(s.C(internship.FieldTitle)).
+				WriteString(") >= ").Arg(titleSimilarityThreshold).
+				WriteString(")")
+		}))
+	}
+}
+
+// searchRank orders by text relevance, with title similarity lifting typo matches
+//
+// ORDER BY expressions can't carry arguments, the words are inlined instead which is
+// safe as searchWords only keeps letters and digits
+func searchRank(words []string) internship.OrderOption {
+	return func(s *entsql.Selector) {
+		s.OrderExprFunc(func(b *entsql.Builder) {
+			b.WriteString("ts_rank_cd((").
+				WriteString(postgres.InternshipSearchDocument(s.C)).
+				WriteString("), to_tsquery('" + postgres.SearchConfig + "', '" + toPrefixTSQuery(words) + "'))").
+				WriteString(" + word_similarity('" + strings.Join(words, " ") + "', ").
+				WriteString(s.C(internship.FieldTitle)).
+				WriteString(") DESC")
+		})
+	}
+}
+
+// searchWords splits free text into lower case words of letters and digits
+func searchWords(query string) []string {
+	return lo.Uniq(strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// toPrefixTSQuery builds a tsquery matching every word as a prefix
+func toPrefixTSQuery(words []string) string {
+	terms := lo.Map(words, func(word string, _ int) string {
+		return word + ":*"
+	})
+	return strings.Join(terms, " & ")
+}
+
+func enumFacet[T ~string](values []T, counts map[string]int) []types.FacetCount {
+	return lo.Map(values, func(value T, _ int) types.FacetCount {
+		return types.FacetCount{
+			Value: string(value),
+			Count: counts[string(value)],
+		}
+	})
+}
+
+// categoryFacetRow is the number of matching internships in a category
+type categoryFacetRow struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func categoryFacet(rows []categoryFacetRow) []types.FacetCount {
+	facet := lo.Map(rows, func(row categoryFacetRow, _ int) types.FacetCount {
+		return types.FacetCount{Value: row.ID, Label: row.Name, Count: row.Count}
+	})
+	sort.Slice(facet, func(i, j int) bool {
+		if facet[i].Count != facet[j].Count {
+			return facet[i].Count > facet[j].Count
+		}
+		return facet[i].Label < facet[j].Label
+	})
+
+	return facet
+}
+
+func errSearchFacet(err error, facet string) error {
+	return ierr.WithError(err).
+		WithHint("Failed to count internship search facets").
+		WithReportableDetails(map[string]any{
+			"facet": facet,
+		}).
+		Mark(ierr.ErrDatabase)
+}
@@ -0,0 +1,372 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/lesson"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type lessonRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts LessonQueryOptions
+}
+
+func NewLessonRepository(client postgres.IClient, logger *logger.Logger) domainContent.LessonRepository {
+	return &lessonRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: LessonQueryOptions{},
+	}
+}
+
+func (r *lessonRepository) Create(ctx context.Context, lesson *domainContent.Lesson) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating lesson",
+		"lesson_id", lesson.ID,
+		"internship_id", lesson.InternshipID,
+	)
+
+	_, err := client.Lesson.Create().
+		SetID(lesson.ID).
+		SetInternshipID(lesson.InternshipID).
+		SetModuleID(lesson.ModuleID).
+		SetTitle(lesson.Title).
+		SetDescription(lesson.Description).
+		SetLessonType(string(lesson.LessonType)).
+		SetContent(lesson.Content).
+		SetNillableVideoURL(lesson.VideoURL).
+		SetNillableFileID(lesson.FileID).
+		SetDurationSeconds(lesson.DurationSeconds).
+		SetIsPreview(lesson.IsPreview).
+		SetSortOrder(lesson.SortOrder).
+		SetNillableRequiredProgress(lesson.RequiredProgress).
+		SetStatus(string(lesson.Status)).
+		SetCreatedAt(lesson.CreatedAt).
+		SetUpdatedAt(lesson.UpdatedAt).
+		SetCreatedBy(lesson.CreatedBy).
+		SetUpdatedBy(lesson.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create lesson").
+			WithReportableDetails(map[string]any{
+				"internship_id": lesson.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *lessonRepository) Get(ctx context.Context, id string) (*domainContent.Lesson, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting lesson", "lesson_id", id)
+
+	entLesson, err := client.Lesson.Query().
+		Where(
+			lesson.ID(id),
+			lesson.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Lesson with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"lesson_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get lesson").
+			WithReportableDetails(map[string]any{
+				"lesson_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	lesson := &domainContent.Lesson{}
+	return lesson.FromEnt(entLesson), nil
+}
+
+func (r *lessonRepository) Update(ctx context.Context, lesson *domainContent.Lesson) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating lesson",
+		"lesson_id", lesson.ID,
+	)
+
+	update := client.Lesson.UpdateOneID(lesson.ID).
+		SetModuleID(lesson.ModuleID).
+		SetTitle(lesson.Title).
+		SetDescription(lesson.Description).
+		SetLessonType(string(lesson.LessonType)).
+		SetContent(lesson.Content).
+		SetNillableVideoURL(lesson.VideoURL).
+		SetNillableFileID(lesson.FileID).
+		SetDurationSeconds(lesson.DurationSeconds).
+		SetIsPreview(lesson.IsPreview).
+		SetSortOrder(lesson.SortOrder).
+		SetNillableRequiredProgress(lesson.RequiredProgress).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// switching a lesson to another kind of content drops the old one
+	if lesson.VideoURL == nil {
+		update.ClearVideoURL()
+	}
+	if lesson.FileID == nil {
+		update.ClearFileID()
+	}
+	if lesson.RequiredProgress == nil {
+		update.ClearRequiredProgress()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Lesson with ID %s was not found", lesson.ID).
+				WithReportableDetails(map[string]any{
+					"lesson_id": lesson.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update lesson").
+			WithReportableDetails(map[string]any{
+				"lesson_id": lesson.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *lessonRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting lesson",
+		"lesson_id", id,
+	)
+
+	_, err := client.Lesson.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Lesson with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"lesson_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete lesson").
+			WithReportableDetails(map[string]any{
+				"lesson_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *lessonRepository) Count(ctx context.Context, filter *types.LessonFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting lessons")
+
+	query := client.Lesson.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count lessons").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *lessonRepository) List(ctx context.Context, filter *types.LessonFilter) ([]*domainContent.Lesson, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing lessons",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, lesson.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Lesson.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	lessons, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list lessons").
+			Mark(ierr.ErrDatabase)
+	}
+
+	lesson := &domainContent.Lesson{}
+	lessons = ReverseIfBackward(filter, lessons)
+
+	return lesson.FromEntList(lessons), nil
+}
+
+func (r *lessonRepository) ListAll(ctx context.Context, filter *types.LessonFilter) ([]*domainContent.Lesson, error) {
+	if filter == nil {
+		filter = types.NewNoLimitLessonFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	lessons, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return lessons, nil
+}
+
+// LessonQuery type alias for better readability
+type LessonQuery = *ent.LessonQuery
+
+// LessonQueryOptions implements query options for lesson queries
+type LessonQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure LessonQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[LessonQuery, *types.LessonFilter] = (*LessonQueryOptions)(nil)
+
+func (o LessonQueryOptions) ApplyStatusFilter(query LessonQuery, status string) LessonQuery {
+	if status == "" {
+		return query.Where(lesson.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(lesson.Status(status))
+}
+
+func (o LessonQueryOptions) ApplySortFilter(query LessonQuery, field string, order string) LessonQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(lesson.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(lesson.FieldID))
+}
+
+func (o LessonQueryOptions) ApplyPaginationFilter(query LessonQuery, limit int, offset int) LessonQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o LessonQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return lesson.FieldCreatedAt
+	case "updated_at":
+		return lesson.FieldUpdatedAt
+	case "title":
+		return lesson.FieldTitle
+	case "sort_order":
+		return lesson.FieldSortOrder
+	case "created_by":
+		return lesson.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o LessonQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query LessonQuery,
+	filter *types.LessonFilter,
+) LessonQuery {
+	if filter == nil {
+		return query.Where(lesson.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[LessonQuery, predicate.Lesson, lesson.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), lesson.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o LessonQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.LessonFilter,
+	query LessonQuery,
+) LessonQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(lesson.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.ModuleIDs) > 0 {
+		query = query.Where(lesson.ModuleIDIn(f.ModuleIDs...))
+	}
+
+	if len(f.LessonTypes) > 0 {
+		query = query.Where(lesson.LessonTypeIn(lo.Map(f.LessonTypes, func(lessonType types.LessonType, _ int) string {
+			return string(lessonType)
+		})...))
+	}
+
+	if f.IsPreview != nil {
+		query = query.Where(lesson.IsPreview(*f.IsPreview))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(lesson.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(lesson.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,364 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/lessonprogress"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type lessonProgressRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts LessonProgressQueryOptions
+}
+
+func NewLessonProgressRepository(client postgres.IClient, logger *logger.Logger) domainContent.LessonProgressRepository {
+	return &lessonProgressRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: LessonProgressQueryOptions{},
+	}
+}
+
+func (r *lessonProgressRepository) Create(ctx context.Context, lessonProgress *domainContent.LessonProgress) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating lesson progress",
+		"progress_id", lessonProgress.ID,
+		"user_id", lessonProgress.UserID,
+		"lesson_id", lessonProgress.LessonID,
+	)
+
+	_, err := client.LessonProgress.Create().
+		SetID(lessonProgress.ID).
+		SetUserID(lessonProgress.UserID).
+		SetInternshipID(lessonProgress.InternshipID).
+		SetLessonID(lessonProgress.LessonID).
+		SetPositionSeconds(lessonProgress.PositionSeconds).
+		SetNillableCompletedAt(lessonProgress.CompletedAt).
+		SetStatus(string(lessonProgress.Status)).
+		SetCreatedAt(lessonProgress.CreatedAt).
+		SetUpdatedAt(lessonProgress.UpdatedAt).
+		SetCreatedBy(lessonProgress.CreatedBy).
+		SetUpdatedBy(lessonProgress.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Progress for this lesson is already recorded").
+				WithReportableDetails(map[string]any{
+					"user_id":   lessonProgress.UserID,
+					"lesson_id": lessonProgress.LessonID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create lesson progress").
+			WithReportableDetails(map[string]any{
+				"internship_id": lessonProgress.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *lessonProgressRepository) Get(ctx context.Context, id string) (*domainContent.LessonProgress, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting lesson progress", "progress_id", id)
+
+	entLessonProgress, err := client.LessonProgress.Query().
+		Where(
+			lessonprogress.ID(id),
+			lessonprogress.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Lesson progress with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"progress_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get lesson progress").
+			WithReportableDetails(map[string]any{
+				"progress_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	lessonProgress := &domainContent.LessonProgress{}
+	return lessonProgress.FromEnt(entLessonProgress), nil
+}
+
+func (r *lessonProgressRepository) GetByUserAndLesson(ctx context.Context, userID string, lessonID string) (*domainContent.LessonProgress, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting lesson progress",
+		"user_id", userID,
+		"lesson_id", lessonID,
+	)
+
+	entLessonProgress, err := client.LessonProgress.Query().
+		Where(
+			lessonprogress.UserID(userID),
+			lessonprogress.LessonID(lessonID),
+			lessonprogress.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("User %s has no progress in lesson %s", userID, lessonID).
+				WithReportableDetails(map[string]any{
+					"user_id":   userID,
+					"lesson_id": lessonID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get lesson progress").
+			WithReportableDetails(map[string]any{
+				"user_id":   userID,
+				"lesson_id": lessonID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	lessonProgress := &domainContent.LessonProgress{}
+	return lessonProgress.FromEnt(entLessonProgress), nil
+}
+
+func (r *lessonProgressRepository) Update(ctx context.Context, lessonProgress *domainContent.LessonProgress) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating lesson progress",
+		"progress_id", lessonProgress.ID,
+	)
+
+	update := client.LessonProgress.UpdateOneID(lessonProgress.ID).
+		SetPositionSeconds(lessonProgress.PositionSeconds).
+		SetNillableCompletedAt(lessonProgress.CompletedAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if lessonProgress.CompletedAt == nil {
+		update.ClearCompletedAt()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Lesson progress with ID %s was not found", lessonProgress.ID).
+				WithReportableDetails(map[string]any{
+					"progress_id": lessonProgress.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update lesson progress").
+			WithReportableDetails(map[string]any{
+				"progress_id": lessonProgress.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *lessonProgressRepository) Count(ctx context.Context, filter *types.LessonProgressFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting lesson progress")
+
+	query := client.LessonProgress.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count lesson progress").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *lessonProgressRepository) List(ctx context.Context, filter *types.LessonProgressFilter) ([]*domainContent.LessonProgress, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing lesson progress",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, lessonprogress.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.LessonProgress.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	records, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list lesson progress").
+			Mark(ierr.ErrDatabase)
+	}
+
+	lessonProgress := &domainContent.LessonProgress{}
+	records = ReverseIfBackward(filter, records)
+
+	return lessonProgress.FromEntList(records), nil
+}
+
+func (r *lessonProgressRepository) ListAll(ctx context.Context, filter *types.LessonProgressFilter) ([]*domainContent.LessonProgress, error) {
+	if filter == nil {
+		filter = types.NewNoLimitLessonProgressFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	records, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// LessonProgressQuery type alias for better readability
+type LessonProgressQuery = *ent.LessonProgressQuery
+
+// LessonProgressQueryOptions implements query options for lesson progress queries
+type LessonProgressQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure LessonProgressQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[LessonProgressQuery, *types.LessonProgressFilter] = (*LessonProgressQueryOptions)(nil)
+
+func (o LessonProgressQueryOptions) ApplyStatusFilter(query LessonProgressQuery, status string) LessonProgressQuery {
+	if status == "" {
+		return query.Where(lessonprogress.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(lessonprogress.Status(status))
+}
+
+func (o LessonProgressQueryOptions) ApplySortFilter(query LessonProgressQuery, field string, order string) LessonProgressQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(lessonprogress.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(lessonprogress.FieldID))
+}
+
+func (o LessonProgressQueryOptions) ApplyPaginationFilter(query LessonProgressQuery, limit int, offset int) LessonProgressQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o LessonProgressQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return lessonprogress.FieldCreatedAt
+	case "updated_at":
+		return lessonprogress.FieldUpdatedAt
+	case "completed_at":
+		return lessonprogress.FieldCompletedAt
+	case "created_by":
+		return lessonprogress.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o LessonProgressQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query LessonProgressQuery,
+	filter *types.LessonProgressFilter,
+) LessonProgressQuery {
+	if filter == nil {
+		return query.Where(lessonprogress.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[LessonProgressQuery, predicate.LessonProgress, lessonprogress.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), lessonprogress.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o LessonProgressQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.LessonProgressFilter,
+	query LessonProgressQuery,
+) LessonProgressQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(lessonprogress.UserIDIn(f.UserIDs...))
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(lessonprogress.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.LessonIDs) > 0 {
+		query = query.Where(lessonprogress.LessonIDIn(f.LessonIDs...))
+	}
+
+	if f.Completed != nil {
+		if *f.Completed {
+			query = query.Where(lessonprogress.CompletedAtNotNil())
+		} else {
+			query = query.Where(lessonprogress.CompletedAtIsNil())
+		}
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(lessonprogress.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(lessonprogress.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,376 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/livesession"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainLiveSession "github.com/omkar273/codegeeky/internal/domain/livesession"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type liveSessionRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts LiveSessionQueryOptions
+}
+
+func NewLiveSessionRepository(client postgres.IClient, logger *logger.Logger) domainLiveSession.Repository {
+	return &liveSessionRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: LiveSessionQueryOptions{},
+	}
+}
+
+func (r *liveSessionRepository) Create(ctx context.Context, session *domainLiveSession.LiveSession) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating live session",
+		"live_session_id", session.ID,
+		"internship_id", session.InternshipID,
+	)
+
+	_, err := client.LiveSession.Create().
+		SetID(session.ID).
+		SetInternshipID(session.InternshipID).
+		SetInternshipBatchID(session.InternshipBatchID).
+		SetTitle(session.Title).
+		SetDescription(session.Description).
+		SetStartsAt(session.StartsAt).
+		SetEndsAt(session.EndsAt).
+		SetMeetingURL(session.MeetingURL).
+		SetNillableRecordingFileID(session.RecordingFileID).
+		SetNillableRecordingURL(session.RecordingURL).
+		SetSessionStatus(string(session.SessionStatus)).
+		SetNillableCancelledAt(session.CancelledAt).
+		SetStatus(string(session.Status)).
+		SetCreatedAt(session.CreatedAt).
+		SetUpdatedAt(session.UpdatedAt).
+		SetCreatedBy(session.CreatedBy).
+		SetUpdatedBy(session.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create live session").
+			WithReportableDetails(map[string]any{
+				"internship_batch_id": session.InternshipBatchID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *liveSessionRepository) Get(ctx context.Context, id string) (*domainLiveSession.LiveSession, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting live session", "live_session_id", id)
+
+	entSession, err := client.LiveSession.Query().
+		Where(
+			livesession.ID(id),
+			livesession.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Live session with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"live_session_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get live session").
+			WithReportableDetails(map[string]any{
+				"live_session_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	session := &domainLiveSession.LiveSession{}
+	return session.FromEnt(entSession), nil
+}
+
+func (r *liveSessionRepository) Update(ctx context.Context, session *domainLiveSession.LiveSession) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating live session",
+		"live_session_id", session.ID,
+	)
+
+	update := client.LiveSession.UpdateOneID(session.ID).
+		SetTitle(session.Title).
+		SetDescription(session.Description).
+		SetStartsAt(session.StartsAt).
+		SetEndsAt(session.EndsAt).
+		SetMeetingURL(session.MeetingURL).
+		SetNillableRecordingFileID(session.RecordingFileID).
+		SetNillableRecordingURL(session.RecordingURL).
+		SetSessionStatus(string(session.SessionStatus)).
+		SetNillableCancelledAt(session.CancelledAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if session.RecordingFileID == nil {
+		update.ClearRecordingFileID()
+	}
+	if session.RecordingURL == nil {
+		update.ClearRecordingURL()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Live session with ID %s was not found", session.ID).
+				WithReportableDetails(map[string]any{
+					"live_session_id": session.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update live session").
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *liveSessionRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting live session",
+		"live_session_id", id,
+	)
+
+	_, err := client.LiveSession.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Live session with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"live_session_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete live session").
+			WithReportableDetails(map[string]any{
+				"live_session_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *liveSessionRepository) Count(ctx context.Context, filter *types.LiveSessionFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting live sessions")
+
+	query := client.LiveSession.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count live sessions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *liveSessionRepository) List(ctx context.Context, filter *types.LiveSessionFilter) ([]*domainLiveSession.LiveSession, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing live sessions",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, livesession.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.LiveSession.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	sessions, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list live sessions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	session := &domainLiveSession.LiveSession{}
+	sessions = ReverseIfBackward(filter, sessions)
+
+	return session.FromEntList(sessions), nil
+}
+
+func (r *liveSessionRepository) ListAll(ctx context.Context, filter *types.LiveSessionFilter) ([]*domainLiveSession.LiveSession, error) {
+	if filter == nil {
+		filter = types.NewNoLimitLiveSessionFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	sessions, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// LiveSessionQuery type alias for better readability
+type LiveSessionQuery = *ent.LiveSessionQuery
+
+// LiveSessionQueryOptions implements query options for live session queries
+type LiveSessionQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure LiveSessionQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[LiveSessionQuery, *types.LiveSessionFilter] = (*LiveSessionQueryOptions)(nil)
+
+func (o LiveSessionQueryOptions) ApplyStatusFilter(query LiveSessionQuery, status string) LiveSessionQuery {
+	if status == "" {
+		return query.Where(livesession.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(livesession.Status(status))
+}
+
+func (o LiveSessionQueryOptions) ApplySortFilter(query LiveSessionQuery, field string, order string) LiveSessionQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(livesession.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(livesession.FieldID))
+}
+
+func (o LiveSessionQueryOptions) ApplyPaginationFilter(query LiveSessionQuery, limit int, offset int) LiveSessionQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o LiveSessionQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return livesession.FieldCreatedAt
+	case "updated_at":
+		return livesession.FieldUpdatedAt
+	case "title":
+		return livesession.FieldTitle
+	case "starts_at":
+		return livesession.FieldStartsAt
+	case "ends_at":
+		return livesession.FieldEndsAt
+	case "created_by":
+		return livesession.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o LiveSessionQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query LiveSessionQuery,
+	filter *types.LiveSessionFilter,
+) LiveSessionQuery {
+	if filter == nil {
+		return query.Where(livesession.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[LiveSessionQuery, predicate.LiveSession, livesession.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), livesession.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o LiveSessionQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.LiveSessionFilter,
+	query LiveSessionQuery,
+) LiveSessionQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(livesession.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.InternshipBatchIDs) > 0 {
+		query = query.Where(livesession.InternshipBatchIDIn(f.InternshipBatchIDs...))
+	}
+
+	if f.SessionStatus != "" {
+		query = query.Where(livesession.SessionStatus(string(f.SessionStatus)))
+	}
+
+	if f.StartsAfter != nil {
+		query = query.Where(livesession.StartsAtGTE(*f.StartsAfter))
+	}
+
+	if f.StartsBefore != nil {
+		query = query.Where(livesession.StartsAtLT(*f.StartsBefore))
+	}
+
+	if f.EndsBefore != nil {
+		query = query.Where(livesession.EndsAtLTE(*f.EndsBefore))
+	}
+
+	if f.EndsAfter != nil {
+		query = query.Where(livesession.EndsAtGT(*f.EndsAfter))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(livesession.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(livesession.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
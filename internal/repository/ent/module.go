@@ -0,0 +1,330 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/module"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type moduleRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts ModuleQueryOptions
+}
+
+func NewModuleRepository(client postgres.IClient, logger *logger.Logger) domainContent.ModuleRepository {
+	return &moduleRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: ModuleQueryOptions{},
+	}
+}
+
+func (r *moduleRepository) Create(ctx context.Context, module *domainContent.Module) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating module",
+		"module_id", module.ID,
+		"internship_id", module.InternshipID,
+	)
+
+	_, err := client.Module.Create().
+		SetID(module.ID).
+		SetInternshipID(module.InternshipID).
+		SetTitle(module.Title).
+		SetDescription(module.Description).
+		SetSortOrder(module.SortOrder).
+		SetStatus(string(module.Status)).
+		SetCreatedAt(module.CreatedAt).
+		SetUpdatedAt(module.UpdatedAt).
+		SetCreatedBy(module.CreatedBy).
+		SetUpdatedBy(module.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create module").
+			WithReportableDetails(map[string]any{
+				"internship_id": module.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *moduleRepository) Get(ctx context.Context, id string) (*domainContent.Module, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting module", "module_id", id)
+
+	entModule, err := client.Module.Query().
+		Where(
+			module.ID(id),
+			module.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Module with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"module_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get module").
+			WithReportableDetails(map[string]any{
+				"module_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	module := &domainContent.Module{}
+	return module.FromEnt(entModule), nil
+}
+
+func (r *moduleRepository) Update(ctx context.Context, module *domainContent.Module) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating module",
+		"module_id", module.ID,
+	)
+
+	update := client.Module.UpdateOneID(module.ID).
+		SetTitle(module.Title).
+		SetDescription(module.Description).
+		SetSortOrder(module.SortOrder).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Module with ID %s was not found", module.ID).
+				WithReportableDetails(map[string]any{
+					"module_id": module.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update module").
+			WithReportableDetails(map[string]any{
+				"module_id": module.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *moduleRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting module",
+		"module_id", id,
+	)
+
+	_, err := client.Module.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Module with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"module_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete module").
+			WithReportableDetails(map[string]any{
+				"module_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *moduleRepository) Count(ctx context.Context, filter *types.ModuleFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting modules")
+
+	query := client.Module.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count modules").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *moduleRepository) List(ctx context.Context, filter *types.ModuleFilter) ([]*domainContent.Module, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing modules",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, module.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Module.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	modules, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list modules").
+			Mark(ierr.ErrDatabase)
+	}
+
+	module := &domainContent.Module{}
+	modules = ReverseIfBackward(filter, modules)
+
+	return module.FromEntList(modules), nil
+}
+
+func (r *moduleRepository) ListAll(ctx context.Context, filter *types.ModuleFilter) ([]*domainContent.Module, error) {
+	if filter == nil {
+		filter = types.NewNoLimitModuleFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	modules, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+// ModuleQuery type alias for better readability
+type ModuleQuery = *ent.ModuleQuery
+
+// ModuleQueryOptions implements query options for module queries
+type ModuleQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure ModuleQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[ModuleQuery, *types.ModuleFilter] = (*ModuleQueryOptions)(nil)
+
+func (o ModuleQueryOptions) ApplyStatusFilter(query ModuleQuery, status string) ModuleQuery {
+	if status == "" {
+		return query.Where(module.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(module.Status(status))
+}
+
+func (o ModuleQueryOptions) ApplySortFilter(query ModuleQuery, field string, order string) ModuleQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(module.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(module.FieldID))
+}
+
+func (o ModuleQueryOptions) ApplyPaginationFilter(query ModuleQuery, limit int, offset int) ModuleQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o ModuleQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return module.FieldCreatedAt
+	case "updated_at":
+		return module.FieldUpdatedAt
+	case "title":
+		return module.FieldTitle
+	case "sort_order":
+		return module.FieldSortOrder
+	case "created_by":
+		return module.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o ModuleQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query ModuleQuery,
+	filter *types.ModuleFilter,
+) ModuleQuery {
+	if filter == nil {
+		return query.Where(module.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[ModuleQuery, predicate.Module, module.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), module.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o ModuleQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.ModuleFilter,
+	query ModuleQuery,
+) ModuleQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(module.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(module.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(module.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,383 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/notification"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainNotification "github.com/omkar273/codegeeky/internal/domain/notification"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type notificationRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts NotificationQueryOptions
+}
+
+func NewNotificationRepository(client postgres.IClient, logger *logger.Logger) domainNotification.Repository {
+	return &notificationRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: NotificationQueryOptions{},
+	}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, item *domainNotification.Notification) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating notification",
+		"notification_id", item.ID,
+		"user_id", item.UserID,
+		"event_id", item.EventID,
+	)
+
+	_, err := client.Notification.Create().
+		SetID(item.ID).
+		SetUserID(item.UserID).
+		SetEventID(item.EventID).
+		SetEventName(item.EventName).
+		SetCategory(string(item.Category)).
+		SetTitle(item.Title).
+		SetBody(item.Body).
+		SetData(item.Data).
+		SetNillableReadAt(item.ReadAt).
+		SetStatus(string(item.Status)).
+		SetCreatedAt(item.CreatedAt).
+		SetUpdatedAt(item.UpdatedAt).
+		SetCreatedBy(item.CreatedBy).
+		SetUpdatedBy(item.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("The user was already notified of this event").
+				WithReportableDetails(map[string]any{
+					"user_id":  item.UserID,
+					"event_id": item.EventID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create notification").
+			WithReportableDetails(map[string]any{
+				"user_id":  item.UserID,
+				"event_id": item.EventID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) Get(ctx context.Context, id string) (*domainNotification.Notification, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting notification", "notification_id", id)
+
+	entItem, err := client.Notification.Query().
+		Where(
+			notification.ID(id),
+			notification.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Notification with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"notification_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get notification").
+			WithReportableDetails(map[string]any{
+				"notification_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	item := &domainNotification.Notification{}
+	return item.FromEnt(entItem), nil
+}
+
+func (r *notificationRepository) Update(ctx context.Context, item *domainNotification.Notification) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating notification", "notification_id", item.ID)
+
+	update := client.Notification.UpdateOneID(item.ID).
+		SetTitle(item.Title).
+		SetBody(item.Body).
+		SetData(item.Data).
+		SetNillableReadAt(item.ReadAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// marking a notification unread clears when it was read
+	if item.ReadAt == nil {
+		update.ClearReadAt()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Notification with ID %s was not found", item.ID).
+				WithReportableDetails(map[string]any{
+					"notification_id": item.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update notification").
+			WithReportableDetails(map[string]any{
+				"notification_id": item.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting notification", "notification_id", id)
+
+	_, err := client.Notification.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Notification with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"notification_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete notification").
+			WithReportableDetails(map[string]any{
+				"notification_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("marking notifications read", "user_id", userID)
+
+	now := time.Now().UTC()
+	count, err := client.Notification.Update().
+		Where(
+			notification.UserID(userID),
+			notification.ReadAtIsNil(),
+			notification.StatusNotIn(string(types.StatusDeleted)),
+		).
+		SetReadAt(now).
+		SetUpdatedAt(now).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to mark notifications read").
+			WithReportableDetails(map[string]any{
+				"user_id": userID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *notificationRepository) Count(ctx context.Context, filter *types.NotificationFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting notifications")
+
+	query := client.Notification.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count notifications").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *notificationRepository) List(ctx context.Context, filter *types.NotificationFilter) ([]*domainNotification.Notification, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing notifications",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, notification.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Notification.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list notifications").
+			Mark(ierr.ErrDatabase)
+	}
+
+	item := &domainNotification.Notification{}
+	items = ReverseIfBackward(filter, items)
+
+	return item.FromEntList(items), nil
+}
+
+func (r *notificationRepository) ListAll(ctx context.Context, filter *types.NotificationFilter) ([]*domainNotification.Notification, error) {
+	if filter == nil {
+		filter = types.NewNoLimitNotificationFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	items, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// NotificationQuery type alias for better readability
+type NotificationQuery = *ent.NotificationQuery
+
+// NotificationQueryOptions implements query options for notification queries
+type NotificationQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure NotificationQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[NotificationQuery, *types.NotificationFilter] = (*NotificationQueryOptions)(nil)
+
+func (o NotificationQueryOptions) ApplyStatusFilter(query NotificationQuery, status string) NotificationQuery {
+	if status == "" {
+		return query.Where(notification.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(notification.Status(status))
+}
+
+func (o NotificationQueryOptions) ApplySortFilter(query NotificationQuery, field string, order string) NotificationQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(notification.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(notification.FieldID))
+}
+
+func (o NotificationQueryOptions) ApplyPaginationFilter(query NotificationQuery, limit int, offset int) NotificationQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o NotificationQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return notification.FieldCreatedAt
+	case "updated_at":
+		return notification.FieldUpdatedAt
+	case "read_at":
+		return notification.FieldReadAt
+	default:
+		return field
+	}
+}
+
+func (o NotificationQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query NotificationQuery,
+	filter *types.NotificationFilter,
+) NotificationQuery {
+	if filter == nil {
+		return query.Where(notification.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[NotificationQuery, predicate.Notification, notification.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), notification.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o NotificationQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.NotificationFilter,
+	query NotificationQuery,
+) NotificationQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(notification.UserIDIn(f.UserIDs...))
+	}
+
+	if len(f.Categories) > 0 {
+		query = query.Where(notification.CategoryIn(lo.Map(f.Categories, func(c types.NotificationCategory, _ int) string {
+			return string(c)
+		})...))
+	}
+
+	if f.Unread {
+		query = query.Where(notification.ReadAtIsNil())
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(notification.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(notification.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
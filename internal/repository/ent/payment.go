@@ -6,6 +6,8 @@ import (
 	"github.com/omkar273/codegeeky/ent"
 	"github.com/omkar273/codegeeky/ent/payment"
 	"github.com/omkar273/codegeeky/ent/paymentattempt"
+	"github.com/omkar273/codegeeky/ent/paymentrefund"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/logger"
@@ -71,6 +73,8 @@ func (r *paymentRepository) Create(ctx context.Context, p *domainPayment.Payment
 		SetNillableSucceededAt(p.SucceededAt).
 		SetNillableFailedAt(p.FailedAt).
 		SetNillableRefundedAt(p.RefundedAt).
+		SetNillableInstallmentNumber(p.InstallmentNumber).
+		SetNillableDueDate(p.DueDate).
 		SetNillableErrorMessage(p.ErrorMessage).
 		SetUpdatedBy(p.UpdatedBy).
 		SetNillablePaymentMethodType(p.PaymentMethodType).
@@ -127,6 +131,11 @@ func (r *paymentRepository) Get(ctx context.Context, id string) (*domainPayment.
 
 func (r *paymentRepository) List(ctx context.Context, filter *types.PaymentFilter) ([]*domainPayment.Payment, error) {
 	client := r.client.Querier(ctx)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, payment.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.Payment.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -150,6 +159,8 @@ func (r *paymentRepository) List(ctx context.Context, filter *types.PaymentFilte
 			Mark(ierr.ErrDatabase)
 	}
 
+	payments = ReverseIfBackward(filter, payments)
+
 	return domainPayment.FromEntList(payments), nil
 }
 
@@ -189,6 +200,10 @@ func (r *paymentRepository) Update(ctx context.Context, payment *domainPayment.P
 		builder = builder.SetErrorMessage(*payment.ErrorMessage)
 	}
 
+	if payment.DueDate != nil {
+		builder = builder.SetDueDate(*payment.DueDate)
+	}
+
 	_, err := builder.Save(ctx)
 
 	if err != nil {
@@ -410,6 +425,123 @@ func (r *paymentRepository) GetLatestAttempt(ctx context.Context, paymentID stri
 	return domainPayment.FromEntAttempt(attempt), nil
 }
 
+func (r *paymentRepository) CreateRefund(ctx context.Context, refund *domainPayment.Refund) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating payment refund",
+		"payment_refund_id", refund.ID,
+		"payment_id", refund.PaymentID,
+	)
+
+	_, err := client.PaymentRefund.Create().
+		SetID(refund.ID).
+		SetPaymentID(refund.PaymentID).
+		SetPaymentGatewayProvider(refund.PaymentGatewayProvider).
+		SetGatewayPaymentID(refund.GatewayPaymentID).
+		SetAmount(refund.Amount).
+		SetCurrency(refund.Currency).
+		SetReason(refund.Reason).
+		SetRefundStatus(refund.RefundStatus).
+		SetNillableGatewayRefundID(refund.GatewayRefundID).
+		SetNillableErrorMessage(refund.ErrorMessage).
+		SetNillableProcessedAt(refund.ProcessedAt).
+		SetStatus(string(refund.Status)).
+		SetCreatedAt(refund.CreatedAt).
+		SetUpdatedAt(refund.UpdatedAt).
+		SetCreatedBy(refund.CreatedBy).
+		SetUpdatedBy(refund.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("This payment was already refunded").
+				WithReportableDetails(map[string]any{
+					"payment_id": refund.PaymentID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create payment refund").
+			WithReportableDetails(map[string]any{
+				"payment_id": refund.PaymentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *paymentRepository) GetRefundByPaymentID(ctx context.Context, paymentID string) (*domainPayment.Refund, error) {
+	client := r.client.Querier(ctx)
+
+	refund, err := client.PaymentRefund.Query().
+		Where(
+			paymentrefund.PaymentID(paymentID),
+			paymentrefund.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Refund of payment %s was not found", paymentID).
+				WithReportableDetails(map[string]any{
+					"payment_id": paymentID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get payment refund").
+			WithReportableDetails(map[string]any{
+				"payment_id": paymentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainPayment.FromEntRefund(refund), nil
+}
+
+func (r *paymentRepository) UpdateRefund(ctx context.Context, refund *domainPayment.Refund) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating payment refund",
+		"payment_refund_id", refund.ID,
+		"refund_status", refund.RefundStatus,
+	)
+
+	update := client.PaymentRefund.UpdateOneID(refund.ID).
+		SetRefundStatus(refund.RefundStatus).
+		SetNillableGatewayRefundID(refund.GatewayRefundID).
+		SetNillableProcessedAt(refund.ProcessedAt).
+		SetUpdatedBy(types.GetUserID(ctx))
+	if refund.ErrorMessage == nil {
+		update = update.ClearErrorMessage()
+	} else {
+		update = update.SetErrorMessage(*refund.ErrorMessage)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Payment refund with ID %s was not found", refund.ID).
+				WithReportableDetails(map[string]any{
+					"payment_refund_id": refund.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update payment refund").
+			WithReportableDetails(map[string]any{
+				"payment_refund_id": refund.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
 // PaymentQuery type alias for better readability
 type PaymentQuery = *ent.PaymentQuery
 
@@ -432,9 +564,9 @@ func (o PaymentQueryOptions) ApplySortFilter(query PaymentQuery, field string, o
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(payment.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(payment.FieldID))
 }
 
 func (o PaymentQueryOptions) ApplyPaginationFilter(query PaymentQuery, limit int, offset int) PaymentQuery {
@@ -476,6 +608,10 @@ func (o PaymentQueryOptions) GetFieldName(field string) string {
 		return payment.FieldFailedAt
 	case "refunded_at":
 		return payment.FieldRefundedAt
+	case "installment_number":
+		return payment.FieldInstallmentNumber
+	case "due_date":
+		return payment.FieldDueDate
 	case "error_message":
 		return payment.FieldErrorMessage
 	case "created_by":
@@ -499,7 +635,11 @@ func (o PaymentQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[PaymentQuery, predicate.Payment, payment.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), payment.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -547,5 +687,9 @@ func (o PaymentQueryOptions) ApplyEntityQueryOptions(
 		query = query.Where(payment.Currency(types.Currency(*f.Currency)))
 	}
 
+	if f.DueDateBefore != nil {
+		query = query.Where(payment.DueDateLTE(*f.DueDateBefore))
+	}
+
 	return query
 }
@@ -0,0 +1,333 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/paymentplan"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	domainPaymentPlan "github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type paymentPlanRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts PaymentPlanQueryOptions
+}
+
+func NewPaymentPlanRepository(client postgres.IClient, logger *logger.Logger) domainPaymentPlan.Repository {
+	return &paymentPlanRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: PaymentPlanQueryOptions{},
+	}
+}
+
+func (r *paymentPlanRepository) Create(ctx context.Context, plan *domainPaymentPlan.PaymentPlan) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating payment plan",
+		"plan_id", plan.ID,
+		"internship_id", plan.InternshipID,
+	)
+
+	_, err := client.PaymentPlan.Create().
+		SetID(plan.ID).
+		SetInternshipID(plan.InternshipID).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetInstallments(plan.Installments).
+		SetGracePeriodDays(plan.GracePeriodDays).
+		SetIsActive(plan.IsActive).
+		SetMetadata(plan.Metadata).
+		SetStatus(string(types.StatusPublished)).
+		SetCreatedAt(plan.CreatedAt).
+		SetUpdatedAt(plan.UpdatedAt).
+		SetCreatedBy(plan.CreatedBy).
+		SetUpdatedBy(plan.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Payment plan already exists").
+				WithReportableDetails(map[string]any{
+					"plan_id": plan.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create payment plan").
+			WithReportableDetails(map[string]any{
+				"plan_id":       plan.ID,
+				"internship_id": plan.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *paymentPlanRepository) Get(ctx context.Context, id string) (*domainPaymentPlan.PaymentPlan, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting payment plan", "plan_id", id)
+
+	plan, err := client.PaymentPlan.Query().
+		Where(
+			paymentplan.ID(id),
+			paymentplan.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Payment plan with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"plan_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get payment plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainPaymentPlan.FromEnt(plan), nil
+}
+
+func (r *paymentPlanRepository) Update(ctx context.Context, plan *domainPaymentPlan.PaymentPlan) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating payment plan", "plan_id", plan.ID)
+
+	_, err := client.PaymentPlan.UpdateOneID(plan.ID).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetGracePeriodDays(plan.GracePeriodDays).
+		SetIsActive(plan.IsActive).
+		SetMetadata(plan.Metadata).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Payment plan with ID %s was not found", plan.ID).
+				WithReportableDetails(map[string]any{
+					"plan_id": plan.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update payment plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": plan.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *paymentPlanRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting payment plan", "plan_id", id)
+
+	_, err := client.PaymentPlan.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Payment plan with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"plan_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete payment plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *paymentPlanRepository) Count(ctx context.Context, filter *types.PaymentPlanFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting payment plans")
+
+	query := client.PaymentPlan.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count payment plans").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *paymentPlanRepository) List(ctx context.Context, filter *types.PaymentPlanFilter) ([]*domainPaymentPlan.PaymentPlan, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing payment plans",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, paymentplan.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.PaymentPlan.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	plans, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list payment plans").
+			Mark(ierr.ErrDatabase)
+	}
+
+	plans = ReverseIfBackward(filter, plans)
+
+	return domainPaymentPlan.FromEntList(plans), nil
+}
+
+func (r *paymentPlanRepository) ListAll(ctx context.Context, filter *types.PaymentPlanFilter) ([]*domainPaymentPlan.PaymentPlan, error) {
+	if filter == nil {
+		filter = types.NewNoLimitPaymentPlanFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	return r.List(ctx, filter)
+}
+
+// PaymentPlanQuery type alias for better readability
+type PaymentPlanQuery = *ent.PaymentPlanQuery
+
+// PaymentPlanQueryOptions implements query options for payment plan queries
+type PaymentPlanQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure PaymentPlanQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[PaymentPlanQuery, *types.PaymentPlanFilter] = (*PaymentPlanQueryOptions)(nil)
+
+func (o PaymentPlanQueryOptions) ApplyStatusFilter(query PaymentPlanQuery, status string) PaymentPlanQuery {
+	if status == "" {
+		return query.Where(paymentplan.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(paymentplan.Status(status))
+}
+
+func (o PaymentPlanQueryOptions) ApplySortFilter(query PaymentPlanQuery, field string, order string) PaymentPlanQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(paymentplan.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(paymentplan.FieldID))
+}
+
+func (o PaymentPlanQueryOptions) ApplyPaginationFilter(query PaymentPlanQuery, limit int, offset int) PaymentPlanQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o PaymentPlanQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return paymentplan.FieldCreatedAt
+	case "updated_at":
+		return paymentplan.FieldUpdatedAt
+	case "name":
+		return paymentplan.FieldName
+	default:
+		return field
+	}
+}
+
+func (o PaymentPlanQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query PaymentPlanQuery,
+	filter *types.PaymentPlanFilter,
+) PaymentPlanQuery {
+	if filter == nil {
+		return query.Where(paymentplan.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[PaymentPlanQuery, predicate.PaymentPlan, paymentplan.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), paymentplan.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o PaymentPlanQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.PaymentPlanFilter,
+	query PaymentPlanQuery,
+) PaymentPlanQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.InternshipID != "" {
+		query = query.Where(paymentplan.InternshipID(f.InternshipID))
+	}
+
+	if f.IsActive != nil {
+		query = query.Where(paymentplan.IsActive(*f.IsActive))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(paymentplan.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(paymentplan.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -3,7 +3,10 @@ package ent
 import (
 	"context"
 
+	entsql "entgo.io/ent/dialect/sql"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
 )
 
 // BaseQueryOptions defines the minimal interface that all query options must implement
@@ -68,3 +71,108 @@ func (h QueryOptionsHelper) ValidatePagination(limit, offset int) (int, int) {
 func (h QueryOptionsHelper) GetDefaultStatus() string {
 	return string(types.StatusPublished)
 }
+
+// CursorQuery is the part of a generated ent query that cursor pagination needs,
+// P and O are the entity's predicate and order option types
+type CursorQuery[Q any, P, O ~func(*entsql.Selector)] interface {
+	Where(ps ...P) Q
+	Order(o ...O) Q
+	Limit(limit int) Q
+}
+
+// ValidateCursorPagination rejects a cursor page that can't be fetched: a cursor that
+// doesn't decode or a sort field that isn't a column of the entity. Repositories call
+// it before listing so bad input is a validation error rather than a SQL error.
+func ValidateCursorPagination(filter types.BaseFilter, fieldName func(string) string, validColumn func(string) bool) error {
+	if lo.IsNil(filter) || filter.IsUnlimited() || filter.GetCursor() == "" {
+		return nil
+	}
+
+	cursor, err := types.DecodeCursor(filter.GetCursor())
+	if err != nil {
+		return err
+	}
+
+	if _, err := cursor.SortValue(); err != nil {
+		return err
+	}
+
+	if field := fieldName(filter.GetSort()); field == "" || !validColumn(field) {
+		return ierr.NewError("invalid sort field").
+			WithHint("The list can't be sorted by this field").
+			WithReportableDetails(map[string]any{
+				"sort": filter.GetSort(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ApplyCursorPagination pages a query by the filter's cursor instead of an offset.
+// Rows are keyed on (sort field, id) so the page boundary stays put under
+// concurrent inserts. A backward cursor fetches the rows before it in reverse
+// order, callers put the page back in sort order with ReverseIfBackward.
+func ApplyCursorPagination[Q CursorQuery[Q, P, O], P, O ~func(*entsql.Selector)](
+	query Q,
+	filter types.BaseFilter,
+	field string,
+	idField string,
+) Q {
+	var helper QueryOptionsHelper
+	limit, _ := helper.ValidatePagination(filter.GetLimit(), 0)
+
+	// ValidateCursorPagination rejects these up front, the page stays bounded regardless
+	cursor, err := types.DecodeCursor(filter.GetCursor())
+	if err != nil {
+		return query.Limit(limit)
+	}
+
+	value, err := cursor.SortValue()
+	if err != nil {
+		return query.Limit(limit)
+	}
+
+	// Walking forward through a descending list means going to smaller values
+	descending := (filter.GetOrder() == types.OrderDesc) != cursor.IsBackward()
+	op, order := ">", entsql.Asc
+	if descending {
+		op, order = "<", entsql.Desc
+	}
+
+	return query.
+		Where(P(func(s *entsql.Selector) {
+			s.Where(entsql.P(func(b *entsql.Builder) {
+				b.WriteString("(").
+					WriteString(s.C(field)).
+					WriteString(", ").
+					WriteString(s.C(idField)).
+					WriteString(") " + op + " (").
+					Arg(value).
+					WriteString(", ").
+					Arg(cursor.ID).
+					WriteString(")")
+			}))
+		})).
+		Order(O(func(s *entsql.Selector) {
+			s.OrderBy(order(s.C(field)), order(s.C(idField)))
+		})).
+		Limit(limit)
+}
+
+// ReverseIfBackward puts a page fetched with a backward cursor back in sort order
+func ReverseIfBackward[T any](filter types.BaseFilter, items []T) []T {
+	if lo.IsNil(filter) || filter.GetCursor() == "" {
+		return items
+	}
+
+	cursor, err := types.DecodeCursor(filter.GetCursor())
+	if err != nil || !cursor.IsBackward() {
+		return items
+	}
+
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	return items
+}
@@ -0,0 +1,361 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/quiz"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type quizRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts QuizQueryOptions
+}
+
+func NewQuizRepository(client postgres.IClient, logger *logger.Logger) domainContent.QuizRepository {
+	return &quizRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: QuizQueryOptions{},
+	}
+}
+
+func (r *quizRepository) Create(ctx context.Context, quiz *domainContent.Quiz) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating quiz",
+		"quiz_id", quiz.ID,
+		"internship_id", quiz.InternshipID,
+	)
+
+	_, err := client.Quiz.Create().
+		SetID(quiz.ID).
+		SetInternshipID(quiz.InternshipID).
+		SetModuleID(quiz.ModuleID).
+		SetTitle(quiz.Title).
+		SetDescription(quiz.Description).
+		SetQuestions(quiz.Questions).
+		SetNillableQuestionsPerAttempt(quiz.QuestionsPerAttempt).
+		SetShuffleQuestions(quiz.ShuffleQuestions).
+		SetNillableTimeLimitMinutes(quiz.TimeLimitMinutes).
+		SetNillableMaxAttempts(quiz.MaxAttempts).
+		SetNillablePassingPercent(quiz.PassingPercent).
+		SetSortOrder(quiz.SortOrder).
+		SetStatus(string(quiz.Status)).
+		SetCreatedAt(quiz.CreatedAt).
+		SetUpdatedAt(quiz.UpdatedAt).
+		SetCreatedBy(quiz.CreatedBy).
+		SetUpdatedBy(quiz.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create quiz").
+			WithReportableDetails(map[string]any{
+				"internship_id": quiz.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *quizRepository) Get(ctx context.Context, id string) (*domainContent.Quiz, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting quiz", "quiz_id", id)
+
+	entQuiz, err := client.Quiz.Query().
+		Where(
+			quiz.ID(id),
+			quiz.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Quiz with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"quiz_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get quiz").
+			WithReportableDetails(map[string]any{
+				"quiz_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	quiz := &domainContent.Quiz{}
+	return quiz.FromEnt(entQuiz), nil
+}
+
+func (r *quizRepository) Update(ctx context.Context, quiz *domainContent.Quiz) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating quiz",
+		"quiz_id", quiz.ID,
+	)
+
+	update := client.Quiz.UpdateOneID(quiz.ID).
+		SetModuleID(quiz.ModuleID).
+		SetTitle(quiz.Title).
+		SetDescription(quiz.Description).
+		SetQuestions(quiz.Questions).
+		SetNillableQuestionsPerAttempt(quiz.QuestionsPerAttempt).
+		SetShuffleQuestions(quiz.ShuffleQuestions).
+		SetNillableTimeLimitMinutes(quiz.TimeLimitMinutes).
+		SetNillableMaxAttempts(quiz.MaxAttempts).
+		SetNillablePassingPercent(quiz.PassingPercent).
+		SetSortOrder(quiz.SortOrder).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if quiz.QuestionsPerAttempt == nil {
+		update.ClearQuestionsPerAttempt()
+	}
+	if quiz.TimeLimitMinutes == nil {
+		update.ClearTimeLimitMinutes()
+	}
+	if quiz.MaxAttempts == nil {
+		update.ClearMaxAttempts()
+	}
+	if quiz.PassingPercent == nil {
+		update.ClearPassingPercent()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Quiz with ID %s was not found", quiz.ID).
+				WithReportableDetails(map[string]any{
+					"quiz_id": quiz.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update quiz").
+			WithReportableDetails(map[string]any{
+				"quiz_id": quiz.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *quizRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting quiz",
+		"quiz_id", id,
+	)
+
+	_, err := client.Quiz.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Quiz with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"quiz_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete quiz").
+			WithReportableDetails(map[string]any{
+				"quiz_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *quizRepository) Count(ctx context.Context, filter *types.QuizFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting quizzes")
+
+	query := client.Quiz.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count quizzes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *quizRepository) List(ctx context.Context, filter *types.QuizFilter) ([]*domainContent.Quiz, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing quizzes",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, quiz.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Quiz.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	quizzes, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list quizzes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	quiz := &domainContent.Quiz{}
+	quizzes = ReverseIfBackward(filter, quizzes)
+
+	return quiz.FromEntList(quizzes), nil
+}
+
+func (r *quizRepository) ListAll(ctx context.Context, filter *types.QuizFilter) ([]*domainContent.Quiz, error) {
+	if filter == nil {
+		filter = types.NewNoLimitQuizFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	quizzes, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return quizzes, nil
+}
+
+// QuizQuery type alias for better readability
+type QuizQuery = *ent.QuizQuery
+
+// QuizQueryOptions implements query options for quiz queries
+type QuizQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure QuizQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[QuizQuery, *types.QuizFilter] = (*QuizQueryOptions)(nil)
+
+func (o QuizQueryOptions) ApplyStatusFilter(query QuizQuery, status string) QuizQuery {
+	if status == "" {
+		return query.Where(quiz.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(quiz.Status(status))
+}
+
+func (o QuizQueryOptions) ApplySortFilter(query QuizQuery, field string, order string) QuizQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(quiz.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(quiz.FieldID))
+}
+
+func (o QuizQueryOptions) ApplyPaginationFilter(query QuizQuery, limit int, offset int) QuizQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o QuizQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return quiz.FieldCreatedAt
+	case "updated_at":
+		return quiz.FieldUpdatedAt
+	case "title":
+		return quiz.FieldTitle
+	case "sort_order":
+		return quiz.FieldSortOrder
+	case "created_by":
+		return quiz.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o QuizQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query QuizQuery,
+	filter *types.QuizFilter,
+) QuizQuery {
+	if filter == nil {
+		return query.Where(quiz.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[QuizQuery, predicate.Quiz, quiz.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), quiz.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o QuizQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.QuizFilter,
+	query QuizQuery,
+) QuizQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(quiz.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.ModuleIDs) > 0 {
+		query = query.Where(quiz.ModuleIDIn(f.ModuleIDs...))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(quiz.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(quiz.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,386 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/quizattempt"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type quizAttemptRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts QuizAttemptQueryOptions
+}
+
+func NewQuizAttemptRepository(client postgres.IClient, logger *logger.Logger) domainContent.QuizAttemptRepository {
+	return &quizAttemptRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: QuizAttemptQueryOptions{},
+	}
+}
+
+func (r *quizAttemptRepository) Create(ctx context.Context, attempt *domainContent.QuizAttempt) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating quiz attempt",
+		"quiz_attempt_id", attempt.ID,
+		"quiz_id", attempt.QuizID,
+		"user_id", attempt.UserID,
+	)
+
+	_, err := client.QuizAttempt.Create().
+		SetID(attempt.ID).
+		SetQuizID(attempt.QuizID).
+		SetInternshipID(attempt.InternshipID).
+		SetEnrollmentID(attempt.EnrollmentID).
+		SetUserID(attempt.UserID).
+		SetAttempt(attempt.Attempt).
+		SetQuestionIds(attempt.QuestionIDs).
+		SetStartedAt(attempt.StartedAt).
+		SetNillableExpiresAt(attempt.ExpiresAt).
+		SetNillableSubmittedAt(attempt.SubmittedAt).
+		SetAttemptStatus(string(attempt.AttemptStatus)).
+		SetAnswers(attempt.Answers).
+		SetResults(attempt.Results).
+		SetNillableScore(attempt.Score).
+		SetMaxScore(attempt.MaxScore).
+		SetNillableScorePercent(attempt.ScorePercent).
+		SetStatus(string(attempt.Status)).
+		SetCreatedAt(attempt.CreatedAt).
+		SetUpdatedAt(attempt.UpdatedAt).
+		SetCreatedBy(attempt.CreatedBy).
+		SetUpdatedBy(attempt.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("This attempt was already started, try again").
+				WithReportableDetails(map[string]any{
+					"quiz_id": attempt.QuizID,
+					"attempt": attempt.Attempt,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create quiz attempt").
+			WithReportableDetails(map[string]any{
+				"internship_id": attempt.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *quizAttemptRepository) Get(ctx context.Context, id string) (*domainContent.QuizAttempt, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting quiz attempt", "quiz_attempt_id", id)
+
+	entAttempt, err := client.QuizAttempt.Query().
+		Where(
+			quizattempt.ID(id),
+			quizattempt.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Quiz attempt with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"quiz_attempt_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get quiz attempt").
+			WithReportableDetails(map[string]any{
+				"quiz_attempt_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	attempt := &domainContent.QuizAttempt{}
+	return attempt.FromEnt(entAttempt), nil
+}
+
+func (r *quizAttemptRepository) Update(ctx context.Context, attempt *domainContent.QuizAttempt) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating quiz attempt",
+		"quiz_attempt_id", attempt.ID,
+	)
+
+	update := client.QuizAttempt.UpdateOneID(attempt.ID).
+		SetNillableSubmittedAt(attempt.SubmittedAt).
+		SetAttemptStatus(string(attempt.AttemptStatus)).
+		SetAnswers(attempt.Answers).
+		SetResults(attempt.Results).
+		SetNillableScore(attempt.Score).
+		SetMaxScore(attempt.MaxScore).
+		SetNillableScorePercent(attempt.ScorePercent).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Quiz attempt with ID %s was not found", attempt.ID).
+				WithReportableDetails(map[string]any{
+					"quiz_attempt_id": attempt.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update quiz attempt").
+			WithReportableDetails(map[string]any{
+				"quiz_attempt_id": attempt.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+// Close saves a submitted or expired attempt only while it is still in progress, so
+// concurrent submissions can't both grade the same attempt
+func (r *quizAttemptRepository) Close(ctx context.Context, attempt *domainContent.QuizAttempt) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("closing quiz attempt",
+		"quiz_attempt_id", attempt.ID,
+		"attempt_status", attempt.AttemptStatus,
+	)
+
+	affected, err := client.QuizAttempt.Update().
+		Where(
+			quizattempt.ID(attempt.ID),
+			quizattempt.AttemptStatus(string(types.QuizAttemptStatusInProgress)),
+		).
+		SetNillableSubmittedAt(attempt.SubmittedAt).
+		SetAttemptStatus(string(attempt.AttemptStatus)).
+		SetAnswers(attempt.Answers).
+		SetResults(attempt.Results).
+		SetNillableScore(attempt.Score).
+		SetMaxScore(attempt.MaxScore).
+		SetNillableScorePercent(attempt.ScorePercent).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to close quiz attempt").
+			WithReportableDetails(map[string]any{
+				"quiz_attempt_id": attempt.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	if affected == 0 {
+		return ierr.NewError("quiz attempt is already over").
+			WithHint("This attempt was already submitted or ran out of time").
+			WithReportableDetails(map[string]any{
+				"quiz_attempt_id": attempt.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return nil
+}
+
+func (r *quizAttemptRepository) Count(ctx context.Context, filter *types.QuizAttemptFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting quiz attempts")
+
+	query := client.QuizAttempt.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count quiz attempts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *quizAttemptRepository) List(ctx context.Context, filter *types.QuizAttemptFilter) ([]*domainContent.QuizAttempt, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing quiz attempts",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, quizattempt.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.QuizAttempt.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	attempts, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list quiz attempts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	attempt := &domainContent.QuizAttempt{}
+	attempts = ReverseIfBackward(filter, attempts)
+
+	return attempt.FromEntList(attempts), nil
+}
+
+func (r *quizAttemptRepository) ListAll(ctx context.Context, filter *types.QuizAttemptFilter) ([]*domainContent.QuizAttempt, error) {
+	if filter == nil {
+		filter = types.NewNoLimitQuizAttemptFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	attempts, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// QuizAttemptQuery type alias for better readability
+type QuizAttemptQuery = *ent.QuizAttemptQuery
+
+// QuizAttemptQueryOptions implements query options for attempt queries
+type QuizAttemptQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure QuizAttemptQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[QuizAttemptQuery, *types.QuizAttemptFilter] = (*QuizAttemptQueryOptions)(nil)
+
+func (o QuizAttemptQueryOptions) ApplyStatusFilter(query QuizAttemptQuery, status string) QuizAttemptQuery {
+	if status == "" {
+		return query.Where(quizattempt.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(quizattempt.Status(status))
+}
+
+func (o QuizAttemptQueryOptions) ApplySortFilter(query QuizAttemptQuery, field string, order string) QuizAttemptQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(quizattempt.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(quizattempt.FieldID))
+}
+
+func (o QuizAttemptQueryOptions) ApplyPaginationFilter(query QuizAttemptQuery, limit int, offset int) QuizAttemptQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o QuizAttemptQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return quizattempt.FieldCreatedAt
+	case "updated_at":
+		return quizattempt.FieldUpdatedAt
+	case "started_at":
+		return quizattempt.FieldStartedAt
+	case "submitted_at":
+		return quizattempt.FieldSubmittedAt
+	case "attempt":
+		return quizattempt.FieldAttempt
+	case "created_by":
+		return quizattempt.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o QuizAttemptQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query QuizAttemptQuery,
+	filter *types.QuizAttemptFilter,
+) QuizAttemptQuery {
+	if filter == nil {
+		return query.Where(quizattempt.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[QuizAttemptQuery, predicate.QuizAttempt, quizattempt.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), quizattempt.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o QuizAttemptQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.QuizAttemptFilter,
+	query QuizAttemptQuery,
+) QuizAttemptQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.QuizIDs) > 0 {
+		query = query.Where(quizattempt.QuizIDIn(f.QuizIDs...))
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(quizattempt.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.EnrollmentIDs) > 0 {
+		query = query.Where(quizattempt.EnrollmentIDIn(f.EnrollmentIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(quizattempt.UserIDIn(f.UserIDs...))
+	}
+
+	if f.AttemptStatus != "" {
+		query = query.Where(quizattempt.AttemptStatus(string(f.AttemptStatus)))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(quizattempt.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(quizattempt.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,397 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/referral"
+	domainReferral "github.com/omkar273/codegeeky/internal/domain/referral"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type referralRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts ReferralQueryOptions
+}
+
+func NewReferralRepository(client postgres.IClient, logger *logger.Logger) domainReferral.Repository {
+	return &referralRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: ReferralQueryOptions{},
+	}
+}
+
+func (r *referralRepository) Create(ctx context.Context, referralData *domainReferral.Referral) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating referral",
+		"referral_id", referralData.ID,
+		"referrer_id", referralData.ReferrerID,
+		"referee_id", referralData.RefereeID,
+	)
+
+	_, err := client.Referral.Create().
+		SetID(referralData.ID).
+		SetReferrerID(referralData.ReferrerID).
+		SetRefereeID(referralData.RefereeID).
+		SetReferralCode(referralData.ReferralCode).
+		SetReferralStatus(referralData.ReferralStatus).
+		SetNillableRejectionReason(referralData.RejectionReason).
+		SetRefereeEmail(referralData.RefereeEmail).
+		SetRefereePhone(referralData.RefereePhone).
+		SetNillableRefereeDiscountID(referralData.RefereeDiscountID).
+		SetNillablePaymentID(referralData.PaymentID).
+		SetNillableQualifiedAt(referralData.QualifiedAt).
+		SetNillableRewardEligibleAt(referralData.RewardEligibleAt).
+		SetNillableRewardedAt(referralData.RewardedAt).
+		SetNillableRewardDiscountID(referralData.RewardDiscountID).
+		SetMetadata(referralData.Metadata).
+		SetStatus(string(types.StatusPublished)).
+		SetCreatedAt(referralData.CreatedAt).
+		SetUpdatedAt(referralData.UpdatedAt).
+		SetCreatedBy(referralData.CreatedBy).
+		SetUpdatedBy(referralData.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Referral for this user already exists").
+				WithReportableDetails(map[string]any{
+					"referee_id": referralData.RefereeID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create referral").
+			WithReportableDetails(map[string]any{
+				"referral_id": referralData.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *referralRepository) Get(ctx context.Context, id string) (*domainReferral.Referral, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting referral", "referral_id", id)
+
+	entReferral, err := client.Referral.Query().
+		Where(referral.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Referral with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"referral_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get referral").
+			WithReportableDetails(map[string]any{
+				"referral_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainReferral.FromEnt(entReferral), nil
+}
+
+func (r *referralRepository) GetByRefereeID(ctx context.Context, refereeID string) (*domainReferral.Referral, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting referral by referee", "referee_id", refereeID)
+
+	entReferral, err := client.Referral.Query().
+		Where(
+			referral.RefereeID(refereeID),
+			referral.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Referral for user %s was not found", refereeID).
+				WithReportableDetails(map[string]any{
+					"referee_id": refereeID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get referral").
+			WithReportableDetails(map[string]any{
+				"referee_id": refereeID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainReferral.FromEnt(entReferral), nil
+}
+
+func (r *referralRepository) Update(ctx context.Context, referralData *domainReferral.Referral) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating referral",
+		"referral_id", referralData.ID,
+		"referral_status", referralData.ReferralStatus,
+	)
+
+	_, err := client.Referral.UpdateOneID(referralData.ID).
+		SetReferralStatus(referralData.ReferralStatus).
+		SetNillableRejectionReason(referralData.RejectionReason).
+		SetNillableRefereeDiscountID(referralData.RefereeDiscountID).
+		SetNillablePaymentID(referralData.PaymentID).
+		SetNillableQualifiedAt(referralData.QualifiedAt).
+		SetNillableRewardEligibleAt(referralData.RewardEligibleAt).
+		SetNillableRewardedAt(referralData.RewardedAt).
+		SetNillableRewardDiscountID(referralData.RewardDiscountID).
+		SetMetadata(referralData.Metadata).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Referral with ID %s was not found", referralData.ID).
+				WithReportableDetails(map[string]any{
+					"referral_id": referralData.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update referral").
+			WithReportableDetails(map[string]any{
+				"referral_id": referralData.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *referralRepository) Count(ctx context.Context, filter *types.ReferralFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting referrals")
+
+	query := client.Referral.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count referrals").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *referralRepository) List(ctx context.Context, filter *types.ReferralFilter) ([]*domainReferral.Referral, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing referrals",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, referral.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Referral.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	referrals, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list referrals").
+			Mark(ierr.ErrDatabase)
+	}
+
+	referrals = ReverseIfBackward(filter, referrals)
+
+	return domainReferral.FromEntList(referrals), nil
+}
+
+func (r *referralRepository) ListAll(ctx context.Context, filter *types.ReferralFilter) ([]*domainReferral.Referral, error) {
+	if filter == nil {
+		filter = types.NewNoLimitReferralFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	return r.List(ctx, filter)
+}
+
+func (r *referralRepository) ExistsForIdentity(ctx context.Context, email string, phone string) (bool, error) {
+	client := r.client.Querier(ctx)
+
+	predicates := []predicate.Referral{referral.RefereeEmail(email)}
+	if phone != "" {
+		predicates = append(predicates, referral.RefereePhone(phone))
+	}
+
+	exists, err := client.Referral.Query().
+		Where(referral.Or(predicates...)).
+		Exist(ctx)
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHint("Failed to check existing referrals").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return exists, nil
+}
+
+func (r *referralRepository) ListRewardable(ctx context.Context, before time.Time) ([]*domainReferral.Referral, error) {
+	client := r.client.Querier(ctx)
+
+	referrals, err := client.Referral.Query().
+		Where(
+			referral.ReferralStatus(types.ReferralStatusQualified),
+			referral.RewardEligibleAtLTE(before),
+			referral.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Order(ent.Asc(referral.FieldRewardEligibleAt)).
+		All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list rewardable referrals").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainReferral.FromEntList(referrals), nil
+}
+
+// ReferralQuery type alias for better readability
+type ReferralQuery = *ent.ReferralQuery
+
+// ReferralQueryOptions implements query options for referral queries
+type ReferralQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure ReferralQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[ReferralQuery, *types.ReferralFilter] = (*ReferralQueryOptions)(nil)
+
+func (o ReferralQueryOptions) ApplyStatusFilter(query ReferralQuery, status string) ReferralQuery {
+	if status == "" {
+		return query.Where(referral.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(referral.Status(status))
+}
+
+func (o ReferralQueryOptions) ApplySortFilter(query ReferralQuery, field string, order string) ReferralQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(referral.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(referral.FieldID))
+}
+
+func (o ReferralQueryOptions) ApplyPaginationFilter(query ReferralQuery, limit int, offset int) ReferralQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o ReferralQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return referral.FieldCreatedAt
+	case "updated_at":
+		return referral.FieldUpdatedAt
+	case "referral_status":
+		return referral.FieldReferralStatus
+	case "qualified_at":
+		return referral.FieldQualifiedAt
+	case "rewarded_at":
+		return referral.FieldRewardedAt
+	default:
+		return field
+	}
+}
+
+func (o ReferralQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query ReferralQuery,
+	filter *types.ReferralFilter,
+) ReferralQuery {
+	if filter == nil {
+		return query.Where(referral.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[ReferralQuery, predicate.Referral, referral.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), referral.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o ReferralQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.ReferralFilter,
+	query ReferralQuery,
+) ReferralQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.ReferrerID != "" {
+		query = query.Where(referral.ReferrerID(f.ReferrerID))
+	}
+
+	if f.RefereeID != "" {
+		query = query.Where(referral.RefereeID(f.RefereeID))
+	}
+
+	if f.ReferralStatus != "" {
+		query = query.Where(referral.ReferralStatus(f.ReferralStatus))
+	}
+
+	if f.PaymentID != "" {
+		query = query.Where(referral.PaymentID(f.PaymentID))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(referral.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(referral.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
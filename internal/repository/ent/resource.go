@@ -0,0 +1,351 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/resource"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type resourceRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts ResourceQueryOptions
+}
+
+func NewResourceRepository(client postgres.IClient, logger *logger.Logger) domainContent.ResourceRepository {
+	return &resourceRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: ResourceQueryOptions{},
+	}
+}
+
+func (r *resourceRepository) Create(ctx context.Context, resource *domainContent.Resource) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating resource",
+		"resource_id", resource.ID,
+		"internship_id", resource.InternshipID,
+	)
+
+	_, err := client.Resource.Create().
+		SetID(resource.ID).
+		SetInternshipID(resource.InternshipID).
+		SetNillableModuleID(resource.ModuleID).
+		SetNillableLessonID(resource.LessonID).
+		SetTitle(resource.Title).
+		SetDescription(resource.Description).
+		SetFileID(resource.FileID).
+		SetSortOrder(resource.SortOrder).
+		SetStatus(string(resource.Status)).
+		SetCreatedAt(resource.CreatedAt).
+		SetUpdatedAt(resource.UpdatedAt).
+		SetCreatedBy(resource.CreatedBy).
+		SetUpdatedBy(resource.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create resource").
+			WithReportableDetails(map[string]any{
+				"internship_id": resource.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *resourceRepository) Get(ctx context.Context, id string) (*domainContent.Resource, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting resource", "resource_id", id)
+
+	entResource, err := client.Resource.Query().
+		Where(
+			resource.ID(id),
+			resource.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Resource with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"resource_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get resource").
+			WithReportableDetails(map[string]any{
+				"resource_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	resource := &domainContent.Resource{}
+	return resource.FromEnt(entResource), nil
+}
+
+func (r *resourceRepository) Update(ctx context.Context, resource *domainContent.Resource) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating resource",
+		"resource_id", resource.ID,
+	)
+
+	update := client.Resource.UpdateOneID(resource.ID).
+		SetNillableModuleID(resource.ModuleID).
+		SetNillableLessonID(resource.LessonID).
+		SetTitle(resource.Title).
+		SetDescription(resource.Description).
+		SetFileID(resource.FileID).
+		SetSortOrder(resource.SortOrder).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if resource.ModuleID == nil {
+		update.ClearModuleID()
+	}
+	if resource.LessonID == nil {
+		update.ClearLessonID()
+	}
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Resource with ID %s was not found", resource.ID).
+				WithReportableDetails(map[string]any{
+					"resource_id": resource.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update resource").
+			WithReportableDetails(map[string]any{
+				"resource_id": resource.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *resourceRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting resource",
+		"resource_id", id,
+	)
+
+	_, err := client.Resource.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Resource with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"resource_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete resource").
+			WithReportableDetails(map[string]any{
+				"resource_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *resourceRepository) Count(ctx context.Context, filter *types.ResourceFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting resources")
+
+	query := client.Resource.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count resources").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *resourceRepository) List(ctx context.Context, filter *types.ResourceFilter) ([]*domainContent.Resource, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing resources",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, resource.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Resource.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	resources, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list resources").
+			Mark(ierr.ErrDatabase)
+	}
+
+	resource := &domainContent.Resource{}
+	resources = ReverseIfBackward(filter, resources)
+
+	return resource.FromEntList(resources), nil
+}
+
+func (r *resourceRepository) ListAll(ctx context.Context, filter *types.ResourceFilter) ([]*domainContent.Resource, error) {
+	if filter == nil {
+		filter = types.NewNoLimitResourceFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	resources, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// ResourceQuery type alias for better readability
+type ResourceQuery = *ent.ResourceQuery
+
+// ResourceQueryOptions implements query options for resource queries
+type ResourceQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure ResourceQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[ResourceQuery, *types.ResourceFilter] = (*ResourceQueryOptions)(nil)
+
+func (o ResourceQueryOptions) ApplyStatusFilter(query ResourceQuery, status string) ResourceQuery {
+	if status == "" {
+		return query.Where(resource.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(resource.Status(status))
+}
+
+func (o ResourceQueryOptions) ApplySortFilter(query ResourceQuery, field string, order string) ResourceQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(resource.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(resource.FieldID))
+}
+
+func (o ResourceQueryOptions) ApplyPaginationFilter(query ResourceQuery, limit int, offset int) ResourceQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o ResourceQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return resource.FieldCreatedAt
+	case "updated_at":
+		return resource.FieldUpdatedAt
+	case "title":
+		return resource.FieldTitle
+	case "sort_order":
+		return resource.FieldSortOrder
+	case "created_by":
+		return resource.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o ResourceQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query ResourceQuery,
+	filter *types.ResourceFilter,
+) ResourceQuery {
+	if filter == nil {
+		return query.Where(resource.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[ResourceQuery, predicate.Resource, resource.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), resource.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o ResourceQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.ResourceFilter,
+	query ResourceQuery,
+) ResourceQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(resource.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.ModuleIDs) > 0 {
+		query = query.Where(resource.ModuleIDIn(f.ModuleIDs...))
+	}
+
+	if len(f.LessonIDs) > 0 {
+		query = query.Where(resource.LessonIDIn(f.LessonIDs...))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(resource.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(resource.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,369 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/sessionattendance"
+	domainLiveSession "github.com/omkar273/codegeeky/internal/domain/livesession"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type sessionAttendanceRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts SessionAttendanceQueryOptions
+}
+
+func NewSessionAttendanceRepository(client postgres.IClient, logger *logger.Logger) domainLiveSession.AttendanceRepository {
+	return &sessionAttendanceRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: SessionAttendanceQueryOptions{},
+	}
+}
+
+func (r *sessionAttendanceRepository) Create(ctx context.Context, attendance *domainLiveSession.Attendance) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating session attendance",
+		"session_attendance_id", attendance.ID,
+		"live_session_id", attendance.LiveSessionID,
+		"enrollment_id", attendance.EnrollmentID,
+	)
+
+	_, err := client.SessionAttendance.Create().
+		SetID(attendance.ID).
+		SetLiveSessionID(attendance.LiveSessionID).
+		SetInternshipBatchID(attendance.InternshipBatchID).
+		SetEnrollmentID(attendance.EnrollmentID).
+		SetUserID(attendance.UserID).
+		SetAttendanceStatus(string(attendance.AttendanceStatus)).
+		SetNillableJoinedAt(attendance.JoinedAt).
+		SetNillableLastJoinedAt(attendance.LastJoinedAt).
+		SetNillableLeftAt(attendance.LeftAt).
+		SetDurationSeconds(attendance.DurationSeconds).
+		SetNillableMarkedBy(attendance.MarkedBy).
+		SetStatus(string(attendance.Status)).
+		SetCreatedAt(attendance.CreatedAt).
+		SetUpdatedAt(attendance.UpdatedAt).
+		SetCreatedBy(attendance.CreatedBy).
+		SetUpdatedBy(attendance.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Attendance for this session was already recorded, try again").
+				WithReportableDetails(map[string]any{
+					"live_session_id": attendance.LiveSessionID,
+					"enrollment_id":   attendance.EnrollmentID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create session attendance").
+			WithReportableDetails(map[string]any{
+				"live_session_id": attendance.LiveSessionID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *sessionAttendanceRepository) Get(ctx context.Context, id string) (*domainLiveSession.Attendance, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting session attendance", "session_attendance_id", id)
+
+	entAttendance, err := client.SessionAttendance.Query().
+		Where(
+			sessionattendance.ID(id),
+			sessionattendance.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Attendance with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"session_attendance_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get session attendance").
+			WithReportableDetails(map[string]any{
+				"session_attendance_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	attendance := &domainLiveSession.Attendance{}
+	return attendance.FromEnt(entAttendance), nil
+}
+
+func (r *sessionAttendanceRepository) GetBySessionAndEnrollment(ctx context.Context, sessionID string, enrollmentID string) (*domainLiveSession.Attendance, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting session attendance by enrollment",
+		"live_session_id", sessionID,
+		"enrollment_id", enrollmentID,
+	)
+
+	entAttendance, err := client.SessionAttendance.Query().
+		Where(
+			sessionattendance.LiveSessionID(sessionID),
+			sessionattendance.EnrollmentID(enrollmentID),
+			sessionattendance.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("No attendance was recorded for this session").
+				WithReportableDetails(map[string]any{
+					"live_session_id": sessionID,
+					"enrollment_id":   enrollmentID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get session attendance").
+			WithReportableDetails(map[string]any{
+				"live_session_id": sessionID,
+				"enrollment_id":   enrollmentID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	attendance := &domainLiveSession.Attendance{}
+	return attendance.FromEnt(entAttendance), nil
+}
+
+func (r *sessionAttendanceRepository) Update(ctx context.Context, attendance *domainLiveSession.Attendance) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating session attendance",
+		"session_attendance_id", attendance.ID,
+	)
+
+	update := client.SessionAttendance.UpdateOneID(attendance.ID).
+		SetAttendanceStatus(string(attendance.AttendanceStatus)).
+		SetNillableJoinedAt(attendance.JoinedAt).
+		SetNillableLastJoinedAt(attendance.LastJoinedAt).
+		SetNillableLeftAt(attendance.LeftAt).
+		SetDurationSeconds(attendance.DurationSeconds).
+		SetNillableMarkedBy(attendance.MarkedBy).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Attendance with ID %s was not found", attendance.ID).
+				WithReportableDetails(map[string]any{
+					"session_attendance_id": attendance.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update session attendance").
+			WithReportableDetails(map[string]any{
+				"session_attendance_id": attendance.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *sessionAttendanceRepository) Count(ctx context.Context, filter *types.SessionAttendanceFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting session attendances")
+
+	query := client.SessionAttendance.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count session attendances").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *sessionAttendanceRepository) List(ctx context.Context, filter *types.SessionAttendanceFilter) ([]*domainLiveSession.Attendance, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing session attendances",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, sessionattendance.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.SessionAttendance.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	attendances, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list session attendances").
+			Mark(ierr.ErrDatabase)
+	}
+
+	attendance := &domainLiveSession.Attendance{}
+	attendances = ReverseIfBackward(filter, attendances)
+
+	return attendance.FromEntList(attendances), nil
+}
+
+func (r *sessionAttendanceRepository) ListAll(ctx context.Context, filter *types.SessionAttendanceFilter) ([]*domainLiveSession.Attendance, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSessionAttendanceFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	attendances, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return attendances, nil
+}
+
+// SessionAttendanceQuery type alias for better readability
+type SessionAttendanceQuery = *ent.SessionAttendanceQuery
+
+// SessionAttendanceQueryOptions implements query options for attempt queries
+type SessionAttendanceQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure SessionAttendanceQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[SessionAttendanceQuery, *types.SessionAttendanceFilter] = (*SessionAttendanceQueryOptions)(nil)
+
+func (o SessionAttendanceQueryOptions) ApplyStatusFilter(query SessionAttendanceQuery, status string) SessionAttendanceQuery {
+	if status == "" {
+		return query.Where(sessionattendance.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(sessionattendance.Status(status))
+}
+
+func (o SessionAttendanceQueryOptions) ApplySortFilter(query SessionAttendanceQuery, field string, order string) SessionAttendanceQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(sessionattendance.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(sessionattendance.FieldID))
+}
+
+func (o SessionAttendanceQueryOptions) ApplyPaginationFilter(query SessionAttendanceQuery, limit int, offset int) SessionAttendanceQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o SessionAttendanceQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return sessionattendance.FieldCreatedAt
+	case "updated_at":
+		return sessionattendance.FieldUpdatedAt
+	case "joined_at":
+		return sessionattendance.FieldJoinedAt
+	case "created_by":
+		return sessionattendance.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o SessionAttendanceQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query SessionAttendanceQuery,
+	filter *types.SessionAttendanceFilter,
+) SessionAttendanceQuery {
+	if filter == nil {
+		return query.Where(sessionattendance.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[SessionAttendanceQuery, predicate.SessionAttendance, sessionattendance.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), sessionattendance.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o SessionAttendanceQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.SessionAttendanceFilter,
+	query SessionAttendanceQuery,
+) SessionAttendanceQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.LiveSessionIDs) > 0 {
+		query = query.Where(sessionattendance.LiveSessionIDIn(f.LiveSessionIDs...))
+	}
+
+	if len(f.InternshipBatchIDs) > 0 {
+		query = query.Where(sessionattendance.InternshipBatchIDIn(f.InternshipBatchIDs...))
+	}
+
+	if len(f.EnrollmentIDs) > 0 {
+		query = query.Where(sessionattendance.EnrollmentIDIn(f.EnrollmentIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(sessionattendance.UserIDIn(f.UserIDs...))
+	}
+
+	if f.AttendanceStatus != "" {
+		query = query.Where(sessionattendance.AttendanceStatus(string(f.AttendanceStatus)))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(sessionattendance.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(sessionattendance.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
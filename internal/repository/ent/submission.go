@@ -0,0 +1,344 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/submission"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type submissionRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts SubmissionQueryOptions
+}
+
+func NewSubmissionRepository(client postgres.IClient, logger *logger.Logger) domainContent.SubmissionRepository {
+	return &submissionRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: SubmissionQueryOptions{},
+	}
+}
+
+func (r *submissionRepository) Create(ctx context.Context, submission *domainContent.Submission) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating submission",
+		"submission_id", submission.ID,
+		"assignment_id", submission.AssignmentID,
+		"user_id", submission.UserID,
+	)
+
+	_, err := client.Submission.Create().
+		SetID(submission.ID).
+		SetInternshipID(submission.InternshipID).
+		SetAssignmentID(submission.AssignmentID).
+		SetEnrollmentID(submission.EnrollmentID).
+		SetUserID(submission.UserID).
+		SetAttempt(submission.Attempt).
+		SetSubmissionType(string(submission.SubmissionType)).
+		SetNillableFileID(submission.FileID).
+		SetNillableRepositoryURL(submission.RepositoryURL).
+		SetText(submission.Text).
+		SetSubmittedAt(submission.SubmittedAt).
+		SetNillableDueAt(submission.DueAt).
+		SetLateDays(submission.LateDays).
+		SetSubmissionStatus(string(submission.SubmissionStatus)).
+		SetNillableScore(submission.Score).
+		SetPenalty(submission.Penalty).
+		SetRubricScores(submission.RubricScores).
+		SetFeedback(submission.Feedback).
+		SetNillableGradedBy(submission.GradedBy).
+		SetNillableGradedAt(submission.GradedAt).
+		SetStatus(string(submission.Status)).
+		SetCreatedAt(submission.CreatedAt).
+		SetUpdatedAt(submission.UpdatedAt).
+		SetCreatedBy(submission.CreatedBy).
+		SetUpdatedBy(submission.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("This attempt was already submitted, try again").
+				WithReportableDetails(map[string]any{
+					"assignment_id": submission.AssignmentID,
+					"attempt":       submission.Attempt,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create submission").
+			WithReportableDetails(map[string]any{
+				"internship_id": submission.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *submissionRepository) Get(ctx context.Context, id string) (*domainContent.Submission, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting submission", "submission_id", id)
+
+	entSubmission, err := client.Submission.Query().
+		Where(
+			submission.ID(id),
+			submission.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Submission with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"submission_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get submission").
+			WithReportableDetails(map[string]any{
+				"submission_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	submission := &domainContent.Submission{}
+	return submission.FromEnt(entSubmission), nil
+}
+
+func (r *submissionRepository) Update(ctx context.Context, submission *domainContent.Submission) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating submission",
+		"submission_id", submission.ID,
+	)
+
+	update := client.Submission.UpdateOneID(submission.ID).
+		SetSubmissionStatus(string(submission.SubmissionStatus)).
+		SetNillableScore(submission.Score).
+		SetPenalty(submission.Penalty).
+		SetRubricScores(submission.RubricScores).
+		SetFeedback(submission.Feedback).
+		SetNillableGradedBy(submission.GradedBy).
+		SetNillableGradedAt(submission.GradedAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	_, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Submission with ID %s was not found", submission.ID).
+				WithReportableDetails(map[string]any{
+					"submission_id": submission.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update submission").
+			WithReportableDetails(map[string]any{
+				"submission_id": submission.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *submissionRepository) Count(ctx context.Context, filter *types.SubmissionFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting submissions")
+
+	query := client.Submission.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count submissions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *submissionRepository) List(ctx context.Context, filter *types.SubmissionFilter) ([]*domainContent.Submission, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing submissions",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, submission.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Submission.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	submissions, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list submissions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	submission := &domainContent.Submission{}
+	submissions = ReverseIfBackward(filter, submissions)
+
+	return submission.FromEntList(submissions), nil
+}
+
+func (r *submissionRepository) ListAll(ctx context.Context, filter *types.SubmissionFilter) ([]*domainContent.Submission, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSubmissionFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	submissions, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// SubmissionQuery type alias for better readability
+type SubmissionQuery = *ent.SubmissionQuery
+
+// SubmissionQueryOptions implements query options for submission queries
+type SubmissionQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure SubmissionQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[SubmissionQuery, *types.SubmissionFilter] = (*SubmissionQueryOptions)(nil)
+
+func (o SubmissionQueryOptions) ApplyStatusFilter(query SubmissionQuery, status string) SubmissionQuery {
+	if status == "" {
+		return query.Where(submission.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(submission.Status(status))
+}
+
+func (o SubmissionQueryOptions) ApplySortFilter(query SubmissionQuery, field string, order string) SubmissionQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(submission.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(submission.FieldID))
+}
+
+func (o SubmissionQueryOptions) ApplyPaginationFilter(query SubmissionQuery, limit int, offset int) SubmissionQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o SubmissionQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return submission.FieldCreatedAt
+	case "updated_at":
+		return submission.FieldUpdatedAt
+	case "submitted_at":
+		return submission.FieldSubmittedAt
+	case "graded_at":
+		return submission.FieldGradedAt
+	case "attempt":
+		return submission.FieldAttempt
+	case "created_by":
+		return submission.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o SubmissionQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query SubmissionQuery,
+	filter *types.SubmissionFilter,
+) SubmissionQuery {
+	if filter == nil {
+		return query.Where(submission.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[SubmissionQuery, predicate.Submission, submission.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), submission.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o SubmissionQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.SubmissionFilter,
+	query SubmissionQuery,
+) SubmissionQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(submission.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.AssignmentIDs) > 0 {
+		query = query.Where(submission.AssignmentIDIn(f.AssignmentIDs...))
+	}
+
+	if len(f.EnrollmentIDs) > 0 {
+		query = query.Where(submission.EnrollmentIDIn(f.EnrollmentIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(submission.UserIDIn(f.UserIDs...))
+	}
+
+	if f.SubmissionStatus != "" {
+		query = query.Where(submission.SubmissionStatus(string(f.SubmissionStatus)))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(submission.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(submission.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,442 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/subscription"
+	"github.com/omkar273/codegeeky/ent/subscriptionevent"
+	domainSubscription "github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type subscriptionRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts SubscriptionQueryOptions
+}
+
+func NewSubscriptionRepository(client postgres.IClient, logger *logger.Logger) domainSubscription.Repository {
+	return &subscriptionRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: SubscriptionQueryOptions{},
+	}
+}
+
+func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSubscription.Subscription) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating subscription",
+		"subscription_id", sub.ID,
+		"user_id", sub.UserID,
+		"plan_id", sub.PlanID,
+	)
+
+	_, err := client.Subscription.Create().
+		SetID(sub.ID).
+		SetUserID(sub.UserID).
+		SetPlanID(sub.PlanID).
+		SetSubscriptionStatus(sub.SubscriptionStatus).
+		SetGatewayProvider(sub.GatewayProvider).
+		SetNillableGatewaySubscriptionID(sub.GatewaySubscriptionID).
+		SetNillableCheckoutURL(sub.CheckoutURL).
+		SetNillableCurrentPeriodStart(sub.CurrentPeriodStart).
+		SetNillableCurrentPeriodEnd(sub.CurrentPeriodEnd).
+		SetCancelAtPeriodEnd(sub.CancelAtPeriodEnd).
+		SetFailedPaymentCount(sub.FailedPaymentCount).
+		SetMetadata(sub.Metadata).
+		SetStatus(string(types.StatusPublished)).
+		SetCreatedAt(sub.CreatedAt).
+		SetUpdatedAt(sub.UpdatedAt).
+		SetCreatedBy(sub.CreatedBy).
+		SetUpdatedBy(sub.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Subscription already exists").
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription").
+			WithReportableDetails(map[string]any{
+				"subscription_id": sub.ID,
+				"user_id":         sub.UserID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *subscriptionRepository) Get(ctx context.Context, id string) (*domainSubscription.Subscription, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting subscription", "subscription_id", id)
+
+	sub, err := client.Subscription.Query().
+		Where(
+			subscription.ID(id),
+			subscription.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Subscription with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"subscription_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get subscription").
+			WithReportableDetails(map[string]any{
+				"subscription_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainSubscription.FromEnt(sub), nil
+}
+
+func (r *subscriptionRepository) GetByGatewaySubscriptionID(ctx context.Context, provider types.PaymentGatewayProvider, gatewaySubscriptionID string) (*domainSubscription.Subscription, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting subscription by gateway subscription id",
+		"gateway_provider", provider,
+		"gateway_subscription_id", gatewaySubscriptionID,
+	)
+
+	sub, err := client.Subscription.Query().
+		Where(
+			subscription.GatewayProvider(provider),
+			subscription.GatewaySubscriptionID(gatewaySubscriptionID),
+			subscription.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Subscription %s was not found", gatewaySubscriptionID).
+				WithReportableDetails(map[string]any{
+					"gateway_provider":        provider,
+					"gateway_subscription_id": gatewaySubscriptionID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get subscription").
+			WithReportableDetails(map[string]any{
+				"gateway_subscription_id": gatewaySubscriptionID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainSubscription.FromEnt(sub), nil
+}
+
+func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSubscription.Subscription) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating subscription",
+		"subscription_id", sub.ID,
+		"subscription_status", sub.SubscriptionStatus,
+	)
+
+	_, err := client.Subscription.UpdateOneID(sub.ID).
+		SetSubscriptionStatus(sub.SubscriptionStatus).
+		SetNillableGatewaySubscriptionID(sub.GatewaySubscriptionID).
+		SetNillableCheckoutURL(sub.CheckoutURL).
+		SetNillableCurrentPeriodStart(sub.CurrentPeriodStart).
+		SetNillableCurrentPeriodEnd(sub.CurrentPeriodEnd).
+		SetCancelAtPeriodEnd(sub.CancelAtPeriodEnd).
+		SetNillableCancelledAt(sub.CancelledAt).
+		SetNillableEndedAt(sub.EndedAt).
+		SetFailedPaymentCount(sub.FailedPaymentCount).
+		SetMetadata(sub.Metadata).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Subscription with ID %s was not found", sub.ID).
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Gateway subscription is already linked to another subscription").
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update subscription").
+			WithReportableDetails(map[string]any{
+				"subscription_id": sub.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *subscriptionRepository) Count(ctx context.Context, filter *types.SubscriptionFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting subscriptions")
+
+	query := client.Subscription.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscriptions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *subscriptionRepository) List(ctx context.Context, filter *types.SubscriptionFilter) ([]*domainSubscription.Subscription, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing subscriptions",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, subscription.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Subscription.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	subs, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscriptions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	subs = ReverseIfBackward(filter, subs)
+
+	return domainSubscription.FromEntList(subs), nil
+}
+
+func (r *subscriptionRepository) ListAll(ctx context.Context, filter *types.SubscriptionFilter) ([]*domainSubscription.Subscription, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSubscriptionFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	return r.List(ctx, filter)
+}
+
+// SubscriptionQuery type alias for better readability
+type SubscriptionQuery = *ent.SubscriptionQuery
+
+// SubscriptionQueryOptions implements query options for subscription queries
+type SubscriptionQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure SubscriptionQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[SubscriptionQuery, *types.SubscriptionFilter] = (*SubscriptionQueryOptions)(nil)
+
+func (o SubscriptionQueryOptions) ApplyStatusFilter(query SubscriptionQuery, status string) SubscriptionQuery {
+	if status == "" {
+		return query.Where(subscription.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(subscription.Status(status))
+}
+
+func (o SubscriptionQueryOptions) ApplySortFilter(query SubscriptionQuery, field string, order string) SubscriptionQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(subscription.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(subscription.FieldID))
+}
+
+func (o SubscriptionQueryOptions) ApplyPaginationFilter(query SubscriptionQuery, limit int, offset int) SubscriptionQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o SubscriptionQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return subscription.FieldCreatedAt
+	case "updated_at":
+		return subscription.FieldUpdatedAt
+	case "current_period_end":
+		return subscription.FieldCurrentPeriodEnd
+	default:
+		return field
+	}
+}
+
+func (o SubscriptionQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query SubscriptionQuery,
+	filter *types.SubscriptionFilter,
+) SubscriptionQuery {
+	if filter == nil {
+		return query.Where(subscription.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[SubscriptionQuery, predicate.Subscription, subscription.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), subscription.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o SubscriptionQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.SubscriptionFilter,
+	query SubscriptionQuery,
+) SubscriptionQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.UserID != "" {
+		query = query.Where(subscription.UserID(f.UserID))
+	}
+
+	if f.PlanID != "" {
+		query = query.Where(subscription.PlanID(f.PlanID))
+	}
+
+	if len(f.SubscriptionStatuses) > 0 {
+		query = query.Where(subscription.SubscriptionStatusIn(f.SubscriptionStatuses...))
+	}
+
+	if f.GatewaySubscriptionID != "" {
+		query = query.Where(subscription.GatewaySubscriptionID(f.GatewaySubscriptionID))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(subscription.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(subscription.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
+
+func (r *subscriptionRepository) CreateEvent(ctx context.Context, event *domainSubscription.Event) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating subscription event",
+		"subscription_id", event.SubscriptionID,
+		"gateway_event_id", event.GatewayEventID,
+	)
+
+	_, err := client.SubscriptionEvent.Create().
+		SetID(event.ID).
+		SetSubscriptionID(event.SubscriptionID).
+		SetGatewayProvider(event.GatewayProvider).
+		SetGatewayEventID(event.GatewayEventID).
+		SetEventStatus(event.EventStatus).
+		SetStatus(string(event.Status)).
+		SetCreatedAt(event.CreatedAt).
+		SetUpdatedAt(event.UpdatedAt).
+		SetCreatedBy(event.CreatedBy).
+		SetUpdatedBy(event.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("This subscription event was already processed").
+				WithReportableDetails(map[string]any{
+					"gateway_provider": event.GatewayProvider,
+					"gateway_event_id": event.GatewayEventID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription event").
+			WithReportableDetails(map[string]any{
+				"gateway_event_id": event.GatewayEventID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *subscriptionRepository) GetEventByGatewayEventID(ctx context.Context, provider types.PaymentGatewayProvider, gatewayEventID string) (*domainSubscription.Event, error) {
+	client := r.client.Querier(ctx)
+
+	event, err := client.SubscriptionEvent.Query().
+		Where(
+			subscriptionevent.GatewayProvider(provider),
+			subscriptionevent.GatewayEventID(gatewayEventID),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Subscription event %s was not found", gatewayEventID).
+				WithReportableDetails(map[string]any{
+					"gateway_provider": provider,
+					"gateway_event_id": gatewayEventID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get subscription event").
+			WithReportableDetails(map[string]any{
+				"gateway_event_id": gatewayEventID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainSubscription.FromEntEvent(event), nil
+}
@@ -0,0 +1,346 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/subscriptionplan"
+	domainSubscription "github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type subscriptionPlanRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts SubscriptionPlanQueryOptions
+}
+
+func NewSubscriptionPlanRepository(client postgres.IClient, logger *logger.Logger) domainSubscription.PlanRepository {
+	return &subscriptionPlanRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: SubscriptionPlanQueryOptions{},
+	}
+}
+
+func (r *subscriptionPlanRepository) Create(ctx context.Context, plan *domainSubscription.Plan) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating subscription plan",
+		"plan_id", plan.ID,
+		"billing_period", plan.BillingPeriod,
+	)
+
+	_, err := client.SubscriptionPlan.Create().
+		SetID(plan.ID).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetBillingPeriod(plan.BillingPeriod).
+		SetAmount(plan.Amount).
+		SetCurrency(plan.Currency).
+		SetCategoryIds(plan.CategoryIDs).
+		SetGatewayProvider(plan.GatewayProvider).
+		SetNillableGatewayPlanID(plan.GatewayPlanID).
+		SetIsActive(plan.IsActive).
+		SetMetadata(plan.Metadata).
+		SetStatus(string(types.StatusPublished)).
+		SetCreatedAt(plan.CreatedAt).
+		SetUpdatedAt(plan.UpdatedAt).
+		SetCreatedBy(plan.CreatedBy).
+		SetUpdatedBy(plan.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Subscription plan already exists").
+				WithReportableDetails(map[string]any{
+					"plan_id": plan.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": plan.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *subscriptionPlanRepository) Get(ctx context.Context, id string) (*domainSubscription.Plan, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting subscription plan", "plan_id", id)
+
+	plan, err := client.SubscriptionPlan.Query().
+		Where(
+			subscriptionplan.ID(id),
+			subscriptionplan.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Subscription plan with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"plan_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get subscription plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainSubscription.PlanFromEnt(plan), nil
+}
+
+func (r *subscriptionPlanRepository) Update(ctx context.Context, plan *domainSubscription.Plan) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating subscription plan", "plan_id", plan.ID)
+
+	_, err := client.SubscriptionPlan.UpdateOneID(plan.ID).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetCategoryIds(plan.CategoryIDs).
+		SetNillableGatewayPlanID(plan.GatewayPlanID).
+		SetIsActive(plan.IsActive).
+		SetMetadata(plan.Metadata).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Subscription plan with ID %s was not found", plan.ID).
+				WithReportableDetails(map[string]any{
+					"plan_id": plan.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update subscription plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": plan.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *subscriptionPlanRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting subscription plan", "plan_id", id)
+
+	_, err := client.SubscriptionPlan.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Subscription plan with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"plan_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete subscription plan").
+			WithReportableDetails(map[string]any{
+				"plan_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *subscriptionPlanRepository) Count(ctx context.Context, filter *types.SubscriptionPlanFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting subscription plans")
+
+	query := client.SubscriptionPlan.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscription plans").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *subscriptionPlanRepository) List(ctx context.Context, filter *types.SubscriptionPlanFilter) ([]*domainSubscription.Plan, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing subscription plans",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, subscriptionplan.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.SubscriptionPlan.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	plans, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscription plans").
+			Mark(ierr.ErrDatabase)
+	}
+
+	plans = ReverseIfBackward(filter, plans)
+
+	return domainSubscription.PlanFromEntList(plans), nil
+}
+
+func (r *subscriptionPlanRepository) ListAll(ctx context.Context, filter *types.SubscriptionPlanFilter) ([]*domainSubscription.Plan, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSubscriptionPlanFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	return r.List(ctx, filter)
+}
+
+// SubscriptionPlanQuery type alias for better readability
+type SubscriptionPlanQuery = *ent.SubscriptionPlanQuery
+
+// SubscriptionPlanQueryOptions implements query options for subscription plan queries
+type SubscriptionPlanQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure SubscriptionPlanQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[SubscriptionPlanQuery, *types.SubscriptionPlanFilter] = (*SubscriptionPlanQueryOptions)(nil)
+
+func (o SubscriptionPlanQueryOptions) ApplyStatusFilter(query SubscriptionPlanQuery, status string) SubscriptionPlanQuery {
+	if status == "" {
+		return query.Where(subscriptionplan.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(subscriptionplan.Status(status))
+}
+
+func (o SubscriptionPlanQueryOptions) ApplySortFilter(query SubscriptionPlanQuery, field string, order string) SubscriptionPlanQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(subscriptionplan.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(subscriptionplan.FieldID))
+}
+
+func (o SubscriptionPlanQueryOptions) ApplyPaginationFilter(query SubscriptionPlanQuery, limit int, offset int) SubscriptionPlanQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o SubscriptionPlanQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return subscriptionplan.FieldCreatedAt
+	case "updated_at":
+		return subscriptionplan.FieldUpdatedAt
+	case "name":
+		return subscriptionplan.FieldName
+	case "amount":
+		return subscriptionplan.FieldAmount
+	default:
+		return field
+	}
+}
+
+func (o SubscriptionPlanQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query SubscriptionPlanQuery,
+	filter *types.SubscriptionPlanFilter,
+) SubscriptionPlanQuery {
+	if filter == nil {
+		return query.Where(subscriptionplan.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[SubscriptionPlanQuery, predicate.SubscriptionPlan, subscriptionplan.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), subscriptionplan.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o SubscriptionPlanQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.SubscriptionPlanFilter,
+	query SubscriptionPlanQuery,
+) SubscriptionPlanQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.CategoryID != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(subscriptionplan.FieldCategoryIds, f.CategoryID))
+		})
+	}
+
+	if f.BillingPeriod != "" {
+		query = query.Where(subscriptionplan.BillingPeriod(f.BillingPeriod))
+	}
+
+	if f.IsActive != nil {
+		query = query.Where(subscriptionplan.IsActive(*f.IsActive))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(subscriptionplan.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(subscriptionplan.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
 	"github.com/omkar273/codegeeky/ent/user"
 	domainUser "github.com/omkar273/codegeeky/internal/domain/user"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
@@ -43,6 +44,8 @@ func (r *UserRepository) Create(ctx context.Context, userData *domainUser.User)
 		SetPhoneNumber(userData.Phone).
 		SetFullName(userData.FullName).
 		SetRole(string(userData.Role)).
+		SetNillableReferralCode(userData.ReferralCode).
+		SetNillableReferredBy(userData.ReferredBy).
 		SetStatus(string(userData.Status)).
 		SetCreatedAt(userData.CreatedAt).
 		SetUpdatedAt(userData.UpdatedAt).
@@ -133,6 +136,38 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domainU
 	return domainUser.FromEnt(entUser), nil
 }
 
+func (r *UserRepository) GetByReferralCode(ctx context.Context, code string) (*domainUser.User, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting user by referral code", "referral_code", code)
+
+	entUser, err := client.User.Query().
+		Where(
+			user.ReferralCode(code),
+			user.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("User with referral code %s was not found", code).
+				WithReportableDetails(map[string]any{
+					"referral_code": code,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get user by referral code").
+			WithReportableDetails(map[string]any{
+				"referral_code": code,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainUser.FromEnt(entUser), nil
+}
+
 func (r *UserRepository) List(ctx context.Context, filter *types.UserFilter) ([]*domainUser.User, error) {
 	client := r.client.Querier(ctx)
 
@@ -141,6 +176,10 @@ func (r *UserRepository) List(ctx context.Context, filter *types.UserFilter) ([]
 		"offset", filter.GetOffset(),
 	)
 
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, user.ValidColumn); err != nil {
+		return nil, err
+	}
+
 	query := client.User.Query()
 	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
 	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
@@ -152,6 +191,8 @@ func (r *UserRepository) List(ctx context.Context, filter *types.UserFilter) ([]
 			Mark(ierr.ErrDatabase)
 	}
 
+	users = ReverseIfBackward(filter, users)
+
 	return domainUser.FromEntList(users), nil
 }
 
@@ -198,15 +239,34 @@ func (r *UserRepository) Update(ctx context.Context, userData *domainUser.User)
 		"email", userData.Email,
 	)
 
-	_, err := client.User.UpdateOneID(userData.ID).
+	update := client.User.UpdateOneID(userData.ID).
 		SetEmail(userData.Email).
 		SetPhoneNumber(userData.Phone).
 		SetFullName(userData.FullName).
 		SetRole(string(userData.Role)).
+		SetNillableReferralCode(userData.ReferralCode).
+		SetHeadline(userData.Headline).
+		SetBio(userData.Bio).
+		SetSkills(userData.Skills).
+		SetEducation(userData.Education).
+		SetLinks(userData.Links).
+		SetNillableResumeFileID(userData.ResumeFileID).
+		SetNillablePhotoFileID(userData.PhotoFileID).
+		SetProfileVisibility(string(userData.ProfileVisibility)).
+		SetNotificationPreferences(userData.NotificationPreferences).
 		SetStatus(string(userData.Status)).
 		SetUpdatedAt(time.Now().UTC()).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	// removing the resume or photo clears it
+	if userData.ResumeFileID == nil {
+		update.ClearResumeFileID()
+	}
+	if userData.PhotoFileID == nil {
+		update.ClearPhotoFileID()
+	}
+
+	_, err := update.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -292,9 +352,9 @@ func (o UserQueryOptions) ApplySortFilter(query UserQuery, field string, order s
 	field, order = o.ValidateSort(field, order)
 	fieldName := o.GetFieldName(field)
 	if order == types.OrderDesc {
-		return query.Order(ent.Desc(fieldName))
+		return query.Order(ent.Desc(fieldName), ent.Desc(user.FieldID))
 	}
-	return query.Order(ent.Asc(fieldName))
+	return query.Order(ent.Asc(fieldName), ent.Asc(user.FieldID))
 }
 
 func (o UserQueryOptions) ApplyPaginationFilter(query UserQuery, limit int, offset int) UserQuery {
@@ -331,7 +391,11 @@ func (o UserQueryOptions) ApplyBaseFilters(
 	// Apply status filter
 	query = o.ApplyStatusFilter(query, filter.GetStatus())
 
-	// Apply pagination
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[UserQuery, predicate.User, user.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), user.FieldID)
+	}
 	if !filter.IsUnlimited() {
 		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
 	}
@@ -0,0 +1,431 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/wallettransaction"
+	domainWallet "github.com/omkar273/codegeeky/internal/domain/wallet"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+type walletRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts WalletTransactionQueryOptions
+}
+
+func NewWalletRepository(client postgres.IClient, logger *logger.Logger) domainWallet.Repository {
+	return &walletRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: WalletTransactionQueryOptions{},
+	}
+}
+
+func (r *walletRepository) Create(ctx context.Context, txn *domainWallet.WalletTransaction) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating wallet transaction",
+		"transaction_id", txn.ID,
+		"user_id", txn.UserID,
+		"transaction_type", txn.TransactionType,
+		"amount", txn.Amount,
+	)
+
+	_, err := client.WalletTransaction.Create().
+		SetID(txn.ID).
+		SetUserID(txn.UserID).
+		SetTransactionType(txn.TransactionType).
+		SetTransactionReason(txn.TransactionReason).
+		SetAmount(txn.Amount).
+		SetCurrency(txn.Currency).
+		SetRemainingAmount(txn.RemainingAmount).
+		SetNillableExpiresAt(txn.ExpiresAt).
+		SetNillableSourceTransactionID(txn.SourceTransactionID).
+		SetNillableReferenceID(txn.ReferenceID).
+		SetDescription(txn.Description).
+		SetMetadata(txn.Metadata).
+		SetStatus(string(types.StatusPublished)).
+		SetCreatedAt(txn.CreatedAt).
+		SetUpdatedAt(txn.UpdatedAt).
+		SetCreatedBy(txn.CreatedBy).
+		SetUpdatedBy(txn.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("Wallet transaction already exists").
+				WithReportableDetails(map[string]any{
+					"transaction_id": txn.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create wallet transaction").
+			WithReportableDetails(map[string]any{
+				"transaction_id": txn.ID,
+				"user_id":        txn.UserID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *walletRepository) Get(ctx context.Context, id string) (*domainWallet.WalletTransaction, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting wallet transaction", "transaction_id", id)
+
+	txn, err := client.WalletTransaction.Query().
+		Where(wallettransaction.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Wallet transaction with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"transaction_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get wallet transaction").
+			WithReportableDetails(map[string]any{
+				"transaction_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWallet.FromEnt(txn), nil
+}
+
+func (r *walletRepository) Count(ctx context.Context, filter *types.WalletTransactionFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting wallet transactions")
+
+	query := client.WalletTransaction.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count wallet transactions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *walletRepository) List(ctx context.Context, filter *types.WalletTransactionFilter) ([]*domainWallet.WalletTransaction, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing wallet transactions",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, wallettransaction.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.WalletTransaction.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	txns, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list wallet transactions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	txns = ReverseIfBackward(filter, txns)
+
+	return domainWallet.FromEntList(txns), nil
+}
+
+func (r *walletRepository) ListAll(ctx context.Context, filter *types.WalletTransactionFilter) ([]*domainWallet.WalletTransaction, error) {
+	if filter == nil {
+		filter = types.NewNoLimitWalletTransactionFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	return r.List(ctx, filter)
+}
+
+func (r *walletRepository) GetLedgerTotals(ctx context.Context, userID string, currency string) (*domainWallet.LedgerTotals, error) {
+	client := r.client.Querier(ctx)
+
+	var rows []struct {
+		TransactionType types.WalletTransactionType `json:"transaction_type"`
+		Sum             decimal.NullDecimal         `json:"sum"`
+	}
+
+	err := client.WalletTransaction.Query().
+		Where(
+			wallettransaction.UserID(userID),
+			wallettransaction.Currency(currency),
+			wallettransaction.StatusNotIn(string(types.StatusDeleted)),
+		).
+		GroupBy(wallettransaction.FieldTransactionType).
+		Aggregate(ent.Sum(wallettransaction.FieldAmount)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to compute wallet balance").
+			WithReportableDetails(map[string]any{
+				"user_id":  userID,
+				"currency": currency,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	totals := &domainWallet.LedgerTotals{
+		Credits: decimal.Zero,
+		Debits:  decimal.Zero,
+	}
+	for _, row := range rows {
+		switch row.TransactionType {
+		case types.WalletTransactionTypeCredit:
+			totals.Credits = row.Sum.Decimal
+		case types.WalletTransactionTypeDebit:
+			totals.Debits = row.Sum.Decimal
+		}
+	}
+
+	return totals, nil
+}
+
+func (r *walletRepository) ListSpendableCredits(ctx context.Context, userID string, currency string, at time.Time) ([]*domainWallet.WalletTransaction, error) {
+	client := r.client.Querier(ctx)
+
+	txns, err := client.WalletTransaction.Query().
+		Where(
+			wallettransaction.UserID(userID),
+			wallettransaction.Currency(currency),
+			wallettransaction.TransactionType(types.WalletTransactionTypeCredit),
+			wallettransaction.RemainingAmountGT(decimal.Zero),
+			wallettransaction.Or(
+				wallettransaction.ExpiresAtIsNil(),
+				wallettransaction.ExpiresAtGT(at),
+			),
+			wallettransaction.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Order(
+			wallettransaction.ByExpiresAt(sql.OrderNullsLast()),
+			wallettransaction.ByCreatedAt(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list wallet credits").
+			WithReportableDetails(map[string]any{
+				"user_id":  userID,
+				"currency": currency,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWallet.FromEntList(txns), nil
+}
+
+func (r *walletRepository) ListExpiredCredits(ctx context.Context, userID string, before time.Time) ([]*domainWallet.WalletTransaction, error) {
+	client := r.client.Querier(ctx)
+
+	query := client.WalletTransaction.Query().
+		Where(
+			wallettransaction.TransactionType(types.WalletTransactionTypeCredit),
+			wallettransaction.RemainingAmountGT(decimal.Zero),
+			wallettransaction.ExpiresAtLTE(before),
+			wallettransaction.StatusNotIn(string(types.StatusDeleted)),
+		)
+
+	if userID != "" {
+		query = query.Where(wallettransaction.UserID(userID))
+	}
+
+	txns, err := query.Order(wallettransaction.ByExpiresAt()).All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list expired wallet credits").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return domainWallet.FromEntList(txns), nil
+}
+
+func (r *walletRepository) UpdateRemainingAmount(ctx context.Context, id string, expected decimal.Decimal, remaining decimal.Decimal) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("updating wallet credit remaining amount",
+		"transaction_id", id,
+		"expected", expected,
+		"remaining", remaining,
+	)
+
+	// compare-and-set so concurrent checkouts can't spend the same credit twice
+	affected, err := client.WalletTransaction.Update().
+		Where(
+			wallettransaction.ID(id),
+			wallettransaction.RemainingAmount(expected),
+		).
+		SetRemainingAmount(remaining).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update wallet credit").
+			WithReportableDetails(map[string]any{
+				"transaction_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	if affected == 0 {
+		return ierr.NewError("wallet credit changed concurrently").
+			WithHint("Wallet balance changed, please try again").
+			WithReportableDetails(map[string]any{
+				"transaction_id": id,
+			}).
+			Mark(ierr.ErrVersionConflict)
+	}
+
+	return nil
+}
+
+// WalletTransactionQuery type alias for better readability
+type WalletTransactionQuery = *ent.WalletTransactionQuery
+
+// WalletTransactionQueryOptions implements query options for wallet transaction queries
+type WalletTransactionQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure WalletTransactionQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[WalletTransactionQuery, *types.WalletTransactionFilter] = (*WalletTransactionQueryOptions)(nil)
+
+func (o WalletTransactionQueryOptions) ApplyStatusFilter(query WalletTransactionQuery, status string) WalletTransactionQuery {
+	if status == "" {
+		return query.Where(wallettransaction.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(wallettransaction.Status(status))
+}
+
+func (o WalletTransactionQueryOptions) ApplySortFilter(query WalletTransactionQuery, field string, order string) WalletTransactionQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(wallettransaction.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(wallettransaction.FieldID))
+}
+
+func (o WalletTransactionQueryOptions) ApplyPaginationFilter(query WalletTransactionQuery, limit int, offset int) WalletTransactionQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o WalletTransactionQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return wallettransaction.FieldCreatedAt
+	case "updated_at":
+		return wallettransaction.FieldUpdatedAt
+	case "amount":
+		return wallettransaction.FieldAmount
+	case "expires_at":
+		return wallettransaction.FieldExpiresAt
+	default:
+		return field
+	}
+}
+
+func (o WalletTransactionQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query WalletTransactionQuery,
+	filter *types.WalletTransactionFilter,
+) WalletTransactionQuery {
+	if filter == nil {
+		return query.Where(wallettransaction.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[WalletTransactionQuery, predicate.WalletTransaction, wallettransaction.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), wallettransaction.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o WalletTransactionQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.WalletTransactionFilter,
+	query WalletTransactionQuery,
+) WalletTransactionQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.UserID != "" {
+		query = query.Where(wallettransaction.UserID(f.UserID))
+	}
+
+	if f.Currency != "" {
+		query = query.Where(wallettransaction.Currency(f.Currency))
+	}
+
+	if f.TransactionType != "" {
+		query = query.Where(wallettransaction.TransactionType(f.TransactionType))
+	}
+
+	if f.TransactionReason != "" {
+		query = query.Where(wallettransaction.TransactionReason(f.TransactionReason))
+	}
+
+	if f.ReferenceID != "" {
+		query = query.Where(wallettransaction.ReferenceID(f.ReferenceID))
+	}
+
+	if f.SourceID != "" {
+		query = query.Where(wallettransaction.SourceTransactionID(f.SourceID))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(wallettransaction.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(wallettransaction.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,305 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/ent"
+	"github.com/omkar273/codegeeky/ent/predicate"
+	"github.com/omkar273/codegeeky/ent/wishlist"
+	domainWishlist "github.com/omkar273/codegeeky/internal/domain/wishlist"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+type wishlistRepository struct {
+	client    postgres.IClient
+	log       logger.Logger
+	queryOpts WishlistQueryOptions
+}
+
+func NewWishlistRepository(client postgres.IClient, logger *logger.Logger) domainWishlist.Repository {
+	return &wishlistRepository{
+		client:    client,
+		log:       *logger,
+		queryOpts: WishlistQueryOptions{},
+	}
+}
+
+func (r *wishlistRepository) Create(ctx context.Context, item *domainWishlist.Wishlist) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("creating wishlist entry",
+		"wishlist_id", item.ID,
+		"user_id", item.UserID,
+		"internship_id", item.InternshipID,
+	)
+
+	_, err := client.Wishlist.Create().
+		SetID(item.ID).
+		SetUserID(item.UserID).
+		SetInternshipID(item.InternshipID).
+		SetStatus(string(item.Status)).
+		SetCreatedAt(item.CreatedAt).
+		SetUpdatedAt(item.UpdatedAt).
+		SetCreatedBy(item.CreatedBy).
+		SetUpdatedBy(item.UpdatedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("This internship is already in your wishlist").
+				WithReportableDetails(map[string]any{
+					"internship_id": item.InternshipID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to add internship to wishlist").
+			WithReportableDetails(map[string]any{
+				"internship_id": item.InternshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *wishlistRepository) GetByUserAndInternship(ctx context.Context, userID string, internshipID string) (*domainWishlist.Wishlist, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("getting wishlist entry",
+		"user_id", userID,
+		"internship_id", internshipID,
+	)
+
+	entItem, err := client.Wishlist.Query().
+		Where(
+			wishlist.UserID(userID),
+			wishlist.InternshipID(internshipID),
+			wishlist.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("This internship is not in your wishlist").
+				WithReportableDetails(map[string]any{
+					"user_id":       userID,
+					"internship_id": internshipID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get wishlist entry").
+			WithReportableDetails(map[string]any{
+				"user_id":       userID,
+				"internship_id": internshipID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	item := &domainWishlist.Wishlist{}
+	return item.FromEnt(entItem), nil
+}
+
+func (r *wishlistRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("deleting wishlist entry", "wishlist_id", id)
+
+	_, err := client.Wishlist.UpdateOneID(id).
+		SetStatus(string(types.StatusDeleted)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Wishlist entry with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"wishlist_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to remove internship from wishlist").
+			WithReportableDetails(map[string]any{
+				"wishlist_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	return nil
+}
+
+func (r *wishlistRepository) Count(ctx context.Context, filter *types.WishlistFilter) (int, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("counting wishlist entries")
+
+	query := client.Wishlist.Query()
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+	query = r.queryOpts.ApplyStatusFilter(query, filter.GetStatus())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count wishlist entries").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return count, nil
+}
+
+func (r *wishlistRepository) List(ctx context.Context, filter *types.WishlistFilter) ([]*domainWishlist.Wishlist, error) {
+	client := r.client.Querier(ctx)
+
+	r.log.Debugw("listing wishlist entries",
+		"limit", filter.GetLimit(),
+		"offset", filter.GetOffset(),
+	)
+
+	if err := ValidateCursorPagination(filter, r.queryOpts.GetFieldName, wishlist.ValidColumn); err != nil {
+		return nil, err
+	}
+
+	query := client.Wishlist.Query()
+	query = r.queryOpts.ApplyBaseFilters(ctx, query, filter)
+	query = r.queryOpts.ApplyEntityQueryOptions(ctx, filter, query)
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list wishlist entries").
+			Mark(ierr.ErrDatabase)
+	}
+
+	item := &domainWishlist.Wishlist{}
+	items = ReverseIfBackward(filter, items)
+
+	return item.FromEntList(items), nil
+}
+
+func (r *wishlistRepository) ListAll(ctx context.Context, filter *types.WishlistFilter) ([]*domainWishlist.Wishlist, error) {
+	if filter == nil {
+		filter = types.NewNoLimitWishlistFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewNoLimitQueryFilter()
+	}
+
+	items, err := r.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// WishlistQuery type alias for better readability
+type WishlistQuery = *ent.WishlistQuery
+
+// WishlistQueryOptions implements query options for wishlist queries
+type WishlistQueryOptions struct {
+	QueryOptionsHelper
+}
+
+// Ensure WishlistQueryOptions implements EntityQueryOptions interface
+var _ EntityQueryOptions[WishlistQuery, *types.WishlistFilter] = (*WishlistQueryOptions)(nil)
+
+func (o WishlistQueryOptions) ApplyStatusFilter(query WishlistQuery, status string) WishlistQuery {
+	if status == "" {
+		return query.Where(wishlist.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(wishlist.Status(status))
+}
+
+func (o WishlistQueryOptions) ApplySortFilter(query WishlistQuery, field string, order string) WishlistQuery {
+	field, order = o.ValidateSort(field, order)
+	fieldName := o.GetFieldName(field)
+	if order == types.OrderDesc {
+		return query.Order(ent.Desc(fieldName), ent.Desc(wishlist.FieldID))
+	}
+	return query.Order(ent.Asc(fieldName), ent.Asc(wishlist.FieldID))
+}
+
+func (o WishlistQueryOptions) ApplyPaginationFilter(query WishlistQuery, limit int, offset int) WishlistQuery {
+	limit, offset = o.ValidatePagination(limit, offset)
+	return query.Offset(offset).Limit(limit)
+}
+
+func (o WishlistQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return wishlist.FieldCreatedAt
+	case "updated_at":
+		return wishlist.FieldUpdatedAt
+	case "created_by":
+		return wishlist.FieldCreatedBy
+	default:
+		return field
+	}
+}
+
+func (o WishlistQueryOptions) ApplyBaseFilters(
+	_ context.Context,
+	query WishlistQuery,
+	filter *types.WishlistFilter,
+) WishlistQuery {
+	if filter == nil {
+		return query.Where(wishlist.StatusNotIn(string(types.StatusDeleted)))
+	}
+
+	// Apply status filter
+	query = o.ApplyStatusFilter(query, filter.GetStatus())
+
+	// Apply pagination, keyed on the cursor when one is given
+	if !filter.IsUnlimited() && filter.GetCursor() != "" {
+		return ApplyCursorPagination[WishlistQuery, predicate.Wishlist, wishlist.OrderOption](
+			query, filter, o.GetFieldName(filter.GetSort()), wishlist.FieldID)
+	}
+	if !filter.IsUnlimited() {
+		query = o.ApplyPaginationFilter(query, filter.GetLimit(), filter.GetOffset())
+	}
+
+	// Apply sorting
+	query = o.ApplySortFilter(query, filter.GetSort(), filter.GetOrder())
+
+	return query
+}
+
+func (o WishlistQueryOptions) ApplyEntityQueryOptions(
+	_ context.Context,
+	f *types.WishlistFilter,
+	query WishlistQuery,
+) WishlistQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.InternshipIDs) > 0 {
+		query = query.Where(wishlist.InternshipIDIn(f.InternshipIDs...))
+	}
+
+	if len(f.UserIDs) > 0 {
+		query = query.Where(wishlist.UserIDIn(f.UserIDs...))
+	}
+
+	// Apply time range filters if specified
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(wishlist.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(wishlist.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
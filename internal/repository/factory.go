@@ -2,11 +2,23 @@ package repository
 
 import (
 	"github.com/omkar273/codegeeky/internal/config"
+	"github.com/omkar273/codegeeky/internal/domain/certificate"
+	"github.com/omkar273/codegeeky/internal/domain/content"
 	"github.com/omkar273/codegeeky/internal/domain/discount"
 	"github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipapplication"
 	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	"github.com/omkar273/codegeeky/internal/domain/notification"
 	"github.com/omkar273/codegeeky/internal/domain/payment"
+	"github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	"github.com/omkar273/codegeeky/internal/domain/referral"
+	"github.com/omkar273/codegeeky/internal/domain/subscription"
 	"github.com/omkar273/codegeeky/internal/domain/user"
+	"github.com/omkar273/codegeeky/internal/domain/wallet"
+	"github.com/omkar273/codegeeky/internal/domain/wishlist"
+	"github.com/omkar273/codegeeky/internal/fileupload"
 	"github.com/omkar273/codegeeky/internal/logger"
 	"github.com/omkar273/codegeeky/internal/postgres"
 	"github.com/omkar273/codegeeky/internal/repository/ent"
@@ -60,3 +72,95 @@ func NewInternshipEnrollmentRepository(params RepositoryParams) internshipenroll
 func NewInternshipBatchRepository(params RepositoryParams) internship.InternshipBatchRepository {
 	return ent.NewInternshipBatchRepository(params.Client, params.Logger)
 }
+
+func NewInternshipRevisionRepository(params RepositoryParams) internship.InternshipRevisionRepository {
+	return ent.NewInternshipRevisionRepository(params.Client, params.Logger)
+}
+
+func NewInternshipInstructorRepository(params RepositoryParams) internship.InternshipInstructorRepository {
+	return ent.NewInternshipInstructorRepository(params.Client, params.Logger)
+}
+
+func NewReferralRepository(params RepositoryParams) referral.Repository {
+	return ent.NewReferralRepository(params.Client, params.Logger)
+}
+
+func NewWalletRepository(params RepositoryParams) wallet.Repository {
+	return ent.NewWalletRepository(params.Client, params.Logger)
+}
+
+func NewPaymentPlanRepository(params RepositoryParams) paymentplan.Repository {
+	return ent.NewPaymentPlanRepository(params.Client, params.Logger)
+}
+
+func NewSubscriptionPlanRepository(params RepositoryParams) subscription.PlanRepository {
+	return ent.NewSubscriptionPlanRepository(params.Client, params.Logger)
+}
+
+func NewSubscriptionRepository(params RepositoryParams) subscription.Repository {
+	return ent.NewSubscriptionRepository(params.Client, params.Logger)
+}
+
+func NewModuleRepository(params RepositoryParams) content.ModuleRepository {
+	return ent.NewModuleRepository(params.Client, params.Logger)
+}
+
+func NewLessonRepository(params RepositoryParams) content.LessonRepository {
+	return ent.NewLessonRepository(params.Client, params.Logger)
+}
+
+func NewAssignmentRepository(params RepositoryParams) content.AssignmentRepository {
+	return ent.NewAssignmentRepository(params.Client, params.Logger)
+}
+
+func NewResourceRepository(params RepositoryParams) content.ResourceRepository {
+	return ent.NewResourceRepository(params.Client, params.Logger)
+}
+
+func NewLessonProgressRepository(params RepositoryParams) content.LessonProgressRepository {
+	return ent.NewLessonProgressRepository(params.Client, params.Logger)
+}
+
+func NewSubmissionRepository(params RepositoryParams) content.SubmissionRepository {
+	return ent.NewSubmissionRepository(params.Client, params.Logger)
+}
+
+func NewQuizRepository(params RepositoryParams) content.QuizRepository {
+	return ent.NewQuizRepository(params.Client, params.Logger)
+}
+
+func NewQuizAttemptRepository(params RepositoryParams) content.QuizAttemptRepository {
+	return ent.NewQuizAttemptRepository(params.Client, params.Logger)
+}
+
+func NewCertificateRepository(params RepositoryParams) certificate.Repository {
+	return ent.NewCertificateRepository(params.Client, params.Logger)
+}
+
+func NewLiveSessionRepository(params RepositoryParams) livesession.Repository {
+	return ent.NewLiveSessionRepository(params.Client, params.Logger)
+}
+
+func NewSessionAttendanceRepository(params RepositoryParams) livesession.AttendanceRepository {
+	return ent.NewSessionAttendanceRepository(params.Client, params.Logger)
+}
+
+func NewInternshipApplicationRepository(params RepositoryParams) internshipapplication.Repository {
+	return ent.NewInternshipApplicationRepository(params.Client, params.Logger)
+}
+
+func NewInternshipReviewRepository(params RepositoryParams) internshipreview.Repository {
+	return ent.NewInternshipReviewRepository(params.Client, params.Logger)
+}
+
+func NewWishlistRepository(params RepositoryParams) wishlist.Repository {
+	return ent.NewWishlistRepository(params.Client, params.Logger)
+}
+
+func NewNotificationRepository(params RepositoryParams) notification.Repository {
+	return ent.NewNotificationRepository(params.Client, params.Logger)
+}
+
+func NewFileUploadRepository(params RepositoryParams) fileupload.Repository {
+	return ent.NewFileUploadRepository(params.Client, params.Logger)
+}
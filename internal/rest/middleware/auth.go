@@ -81,3 +81,18 @@ func AuthenticateMiddleware(cfg *config.Configuration, logger *logger.Logger) gi
 		c.Next()
 	}
 }
+
+// OptionalAuthenticateMiddleware authenticates requests that carry an Authorization header the
+// same way AuthenticateMiddleware does, requests without one go through as guests
+func OptionalAuthenticateMiddleware(cfg *config.Configuration, logger *logger.Logger) gin.HandlerFunc {
+	authenticate := AuthenticateMiddleware(cfg, logger)
+
+	return func(c *gin.Context) {
+		if c.GetHeader(types.HeaderAuthorization) == "" {
+			GuestAuthenticateMiddleware(c)
+			return
+		}
+
+		authenticate(c)
+	}
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/logger"
+)
+
+// Job is a unit of background work run on a fixed interval
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs registered jobs periodically until stopped
+type Scheduler struct {
+	jobs   []Job
+	logger *logger.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a new scheduler
+func NewScheduler(logger *logger.Logger) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+	}
+}
+
+// Register adds a job to the scheduler. Jobs without a positive interval are ignored.
+func (s *Scheduler) Register(job Job) {
+	if job.Interval <= 0 || job.Run == nil {
+		s.logger.Warnw("skipping scheduled job", "job", job.Name, "interval", job.Interval)
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start launches every registered job in its own goroutine
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+// Stop cancels all running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.logger.Infow("started scheduled job", "job", job.Name, "interval", job.Interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Infow("stopped scheduled job", "job", job.Name)
+			return
+		case <-ticker.C:
+			s.execute(ctx, job)
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Errorw("scheduled job panicked", "job", job.Name, "panic", r)
+		}
+	}()
+
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		s.logger.Errorw("scheduled job failed", "job", job.Name, "error", err, "duration", time.Since(start))
+		return
+	}
+	s.logger.Debugw("scheduled job completed", "job", job.Name, "duration", time.Since(start))
+}
@@ -0,0 +1,69 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/omkar273/codegeeky/internal/config"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/logger"
+)
+
+// SigningService defines the interface for signing documents the server issues, such as
+// certificates, so they cannot be forged
+type SigningService interface {
+	// Sign returns the hex-encoded HMAC-SHA256 signature of the payload
+	Sign(payload string) string
+
+	// Verify reports whether the signature was made for the payload with the server key
+	Verify(payload string, signature string) bool
+}
+
+type hmacSigningService struct {
+	key    []byte
+	logger *logger.Logger
+}
+
+// NewSigningService creates a new signing service using the signing key from config,
+// the key is derived from the master encryption key when no signing key is configured
+func NewSigningService(cfg *config.Configuration, logger *logger.Logger) (SigningService, error) {
+	key := []byte(cfg.Secrets.SigningKey)
+
+	if len(key) == 0 {
+		if cfg.Secrets.EncryptionKey == "" {
+			return nil, ierr.NewError("signing key not configured").
+				WithHint("Signing key is not configured").
+				Mark(ierr.ErrSystem)
+		}
+
+		// Derive a separate key so signatures never reuse the encryption key itself
+		mac := hmac.New(sha256.New, []byte(cfg.Secrets.EncryptionKey))
+		mac.Write([]byte("signing"))
+		key = mac.Sum(nil)
+	}
+
+	return &hmacSigningService{
+		key:    key,
+		logger: logger,
+	}, nil
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 signature of the payload
+func (s *hmacSigningService) Sign(payload string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify compares signatures in constant time
+func (s *hmacSigningService) Verify(payload string, signature string) bool {
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return hmac.Equal(decoded, mac.Sum(nil))
+}
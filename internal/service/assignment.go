@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+)
+
+type AssignmentService interface {
+	Create(ctx context.Context, moduleID string, req *dto.CreateAssignmentRequest) (*dto.AssignmentResponse, error)
+	Get(ctx context.Context, id string) (*dto.AssignmentResponse, error)
+	Update(ctx context.Context, id string, req *dto.UpdateAssignmentRequest) (*dto.AssignmentResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type assignmentService struct {
+	ServiceParams
+}
+
+func NewAssignmentService(params ServiceParams) AssignmentService {
+	return &assignmentService{
+		ServiceParams: params,
+	}
+}
+
+func (s *assignmentService) Create(ctx context.Context, moduleID string, req *dto.CreateAssignmentRequest) (*dto.AssignmentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	module, err := s.ModuleRepo.Get(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeManage(ctx, module.InternshipID); err != nil {
+		return nil, err
+	}
+
+	assignment := req.ToAssignment(ctx, module)
+	if err := assignment.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.AssignmentRepo.Create(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	return &dto.AssignmentResponse{Assignment: *assignment}, nil
+}
+
+func (s *assignmentService) Get(ctx context.Context, id string) (*dto.AssignmentResponse, error) {
+	assignment, err := s.AssignmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, assignment.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeContent(ctx, s.ServiceParams, internship, domainAuth.PermissionViewAssignments); err != nil {
+		return nil, err
+	}
+
+	return &dto.AssignmentResponse{Assignment: *assignment}, nil
+}
+
+func (s *assignmentService) Update(ctx context.Context, id string, req *dto.UpdateAssignmentRequest) (*dto.AssignmentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.getManagedAssignment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(assignment)
+	if err := assignment.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateContentModule(ctx, s.ServiceParams, assignment.InternshipID, assignment.ModuleID); err != nil {
+		return nil, err
+	}
+
+	if err := s.AssignmentRepo.Update(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	return &dto.AssignmentResponse{Assignment: *assignment}, nil
+}
+
+func (s *assignmentService) Delete(ctx context.Context, id string) error {
+	assignment, err := s.getManagedAssignment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.AssignmentRepo.Delete(ctx, assignment.ID)
+}
+
+// getManagedAssignment returns an assignment the caller may change
+func (s *assignmentService) getManagedAssignment(ctx context.Context, id string) (*domainContent.Assignment, error) {
+	assignment, err := s.AssignmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeManage(ctx, assignment.InternshipID); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (s *assignmentService) authorizeManage(ctx context.Context, internshipID string) error {
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return err
+	}
+
+	return authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/auth/abac"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// enrollmentAttributeProvider loads the attributes the ABAC policies check from the database,
+// the enrollments, subscriptions and progress of users and the categories of internship content
+type enrollmentAttributeProvider struct {
+	ServiceParams
+}
+
+func NewEnrollmentAttributeProvider(params ServiceParams) abac.AttributeProvider {
+	return &enrollmentAttributeProvider{
+		ServiceParams: params,
+	}
+}
+
+func (p *enrollmentAttributeProvider) GetName() string {
+	return "enrollment"
+}
+
+// LoadUserAttributes loads enrolled_internships, completed_internships, subscribed_categories and
+// progress, the completed percentage of every internship the user is enrolled in keyed by internship id
+func (p *enrollmentAttributeProvider) LoadUserAttributes(ctx context.Context, userID string) (map[string]interface{}, error) {
+	if userID == "" {
+		return map[string]interface{}{}, nil
+	}
+
+	enrollmentFilter := types.NewNoLimitInternshipEnrollmentFilter()
+	enrollmentFilter.UserID = userID
+	enrollments, err := p.InternshipEnrollmentRepo.ListAll(ctx, enrollmentFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	enrolledInternships := lo.Uniq(lo.FilterMap(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) (string, bool) {
+		return enrollment.InternshipID, enrollment.HasAccess()
+	}))
+
+	completedInternships := lo.Uniq(lo.FilterMap(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) (string, bool) {
+		return enrollment.InternshipID, enrollment.HasCompleted()
+	}))
+
+	subscribedCategories, err := NewSubscriptionService(p.ServiceParams).GetEntitledCategoryIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := loadLessonCompletion(ctx, p.ServiceParams, []string{userID}, enrolledInternships)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := lo.SliceToMap(enrolledInternships, func(internshipID string) (string, float64) {
+		return internshipID, completion.percent(userID, internshipID)
+	})
+
+	return map[string]interface{}{
+		"enrolled_internships":  enrolledInternships,
+		"completed_internships": completedInternships,
+		"subscribed_categories": subscribedCategories,
+		"progress":              progress,
+	}, nil
+}
+
+// invalidateAccess drops the cached access decisions of a user whose enrollments or progress changed
+func invalidateAccess(params ServiceParams, userID string) {
+	if params.AuthzService != nil {
+		params.AuthzService.InvalidateUser(userID)
+	}
+}
+
+// LoadResourceAttributes loads internship_id and category_ids of internship content
+func (p *enrollmentAttributeProvider) LoadResourceAttributes(ctx context.Context, resourceType domainAuth.ResourceType, resourceID string) (map[string]interface{}, error) {
+	if resourceType != domainAuth.ResourceTypeContent {
+		return map[string]interface{}{}, nil
+	}
+
+	internship, err := p.InternshipRepo.Get(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"internship_id": internship.ID,
+		"category_ids": lo.Map(internship.Categories, func(category *domainInternship.Category, _ int) string {
+			return category.ID
+		}),
+	}, nil
+}
@@ -45,6 +45,13 @@ func (s *authService) Signup(ctx context.Context, req *dto.SignupRequest) (*dto.
 			Mark(ierr.ErrAlreadyExists)
 	}
 
+	// reject unknown referral codes before creating the account with the provider
+	if req.ReferralCode != "" && s.ServiceParams.Config.Referral.Enabled {
+		if _, err := NewReferralService(s.ServiceParams).ValidateReferralCode(ctx, req.ReferralCode); err != nil {
+			return nil, err
+		}
+	}
+
 	// check using supabase provider
 	claims, err := s.AuthProvider.SignUp(ctx, req)
 	if err != nil {
@@ -57,7 +64,7 @@ func (s *authService) Signup(ctx context.Context, req *dto.SignupRequest) (*dto.
 		// onboard user
 		// it handles the creation of user if not exists
 		onboardingService := NewOnboardingService(s.ServiceParams)
-		err = onboardingService.Onboard(ctx, &dto.OnboardingRequest{
+		onboarding, err := onboardingService.Onboard(ctx, &dto.OnboardingRequest{
 			SignupRequest:  *req,
 			ProviderUserID: claims.ID,
 		})
@@ -65,6 +72,7 @@ func (s *authService) Signup(ctx context.Context, req *dto.SignupRequest) (*dto.
 		if err != nil {
 			return err
 		}
+		claims.ReferralDiscountCode = onboarding.ReferralDiscountCode
 		s.ServiceParams.Logger.Debugw("onboarded user", "user", claims.ID)
 
 		return nil
@@ -2,18 +2,26 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
 )
 
 type CategoryService interface {
 	Create(ctx context.Context, req *dto.CreateCategoryRequest) (*dto.CategoryResponse, error)
 	GetByID(ctx context.Context, id string) (*dto.CategoryResponse, error)
+	GetBySlug(ctx context.Context, slug string) (*dto.CategoryResponse, error)
 	Update(ctx context.Context, id string, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error)
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.CategoryFilter) (*dto.ListCategoryResponse, error)
+	GetTree(ctx context.Context) (*dto.CategoryTreeResponse, error)
+
+	// RefreshInternshipCounts recomputes the cached published internship counts of every category
+	RefreshInternshipCounts(ctx context.Context) error
 }
 
 type categoryService struct {
@@ -36,6 +44,16 @@ func (s *categoryService) Create(ctx context.Context, req *dto.CreateCategoryReq
 
 	category := req.ToCategory(ctx)
 
+	var parent *domainInternship.Category
+	if category.ParentID != nil {
+		var err error
+		parent, err = s.getParent(ctx, *category.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	placeCategory(category, parent)
+
 	err := s.CategoryRepo.Create(ctx, category)
 	if err != nil {
 		return nil, err
@@ -57,6 +75,17 @@ func (s *categoryService) GetByID(ctx context.Context, id string) (*dto.Category
 	}, nil
 }
 
+func (s *categoryService) GetBySlug(ctx context.Context, slug string) (*dto.CategoryResponse, error) {
+	category, err := s.CategoryRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CategoryResponse{
+		Category: *category,
+	}, nil
+}
+
 func (s *categoryService) Update(ctx context.Context, id string, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error) {
 
 	if err := req.Validate(); err != nil {
@@ -68,8 +97,12 @@ func (s *categoryService) Update(ctx context.Context, id string, req *dto.Update
 		return nil, err
 	}
 
+	moved := req.ParentID != nil && lo.FromPtr(req.ParentID) != lo.FromPtr(category.ParentID)
+
 	// if no changes are made, return the category
-	if category.Name == req.Name && category.Description == req.Description && category.LookupKey == req.LookupKey {
+	if category.Name == req.Name && category.Description == req.Description && category.LookupKey == req.LookupKey &&
+		!moved && (req.Slug == "" || req.Slug == category.Slug) &&
+		(req.SortWeight == nil || *req.SortWeight == category.SortWeight) {
 		return &dto.CategoryResponse{
 			Category: *category,
 		}, nil
@@ -84,12 +117,77 @@ func (s *categoryService) Update(ctx context.Context, id string, req *dto.Update
 	if req.LookupKey != "" {
 		category.LookupKey = req.LookupKey
 	}
+	if req.Slug != "" {
+		category.Slug = req.Slug
+	}
+	if req.SortWeight != nil {
+		category.SortWeight = *req.SortWeight
+	}
+
+	if !moved {
+		err = s.CategoryRepo.Update(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dto.CategoryResponse{
+			Category: *category,
+		}, nil
+	}
 
-	err = s.CategoryRepo.Update(ctx, category)
+	var parent *domainInternship.Category
+	if *req.ParentID != "" {
+		parent, err = s.getParent(ctx, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		// a category can't be moved below itself
+		if parent.ID == category.ID || parent.IsDescendantOf(category.ID) {
+			return nil, ierr.NewError("category move would create a cycle").
+				WithHint("A category can't be moved under itself or one of its subcategories").
+				WithReportableDetails(map[string]any{
+					"category_id": category.ID,
+					"parent_id":   parent.ID,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		descendants, err := s.CategoryRepo.ListAll(ctx, &types.CategoryFilter{
+			QueryFilter: types.NewNoLimitQueryFilter(),
+			AncestorID:  lo.ToPtr(category.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		oldPath, oldDepth := category.Path, category.Depth
+		placeCategory(category, parent)
+
+		if err := s.CategoryRepo.Update(ctx, category); err != nil {
+			return err
+		}
+
+		// the whole subtree moves along, keeping its shape
+		for _, descendant := range descendants {
+			descendant.Path = category.Path + strings.TrimPrefix(descendant.Path, oldPath)
+			descendant.Depth += category.Depth - oldDepth
+			if err := s.CategoryRepo.Update(ctx, descendant); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// totals of the old and new ancestors change with the move
+	s.refreshInternshipCounts(ctx)
+
 	return &dto.CategoryResponse{
 		Category: *category,
 	}, nil
@@ -108,11 +206,31 @@ func (s *categoryService) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	children, err := s.CategoryRepo.Count(ctx, &types.CategoryFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		ParentID:    lo.ToPtr(id),
+	})
+	if err != nil {
+		return err
+	}
+
+	if children > 0 {
+		return ierr.NewError("category has subcategories").
+			WithHint("Move or delete the subcategories before deleting this category").
+			WithReportableDetails(map[string]any{
+				"category_id":   id,
+				"subcategories": children,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
 	err = s.CategoryRepo.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	s.refreshInternshipCounts(ctx)
+
 	return nil
 }
 
@@ -125,21 +243,21 @@ func (s *categoryService) List(ctx context.Context, filter *types.CategoryFilter
 		return nil, err
 	}
 
-	count, err := s.CategoryRepo.Count(ctx, filter)
+	// Get categories
+	categories, err := s.CategoryRepo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get categories
-	categories, err := s.CategoryRepo.List(ctx, filter)
+	pagination, err := paginate(ctx, filter, categories, s.CategoryRepo.Count)
 	if err != nil {
 		return nil, err
 	}
 
 	// Build response
 	response := &dto.ListCategoryResponse{
-		Items:      make([]*dto.CategoryResponse, count),
-		Pagination: types.NewPaginationResponse(count, filter.GetLimit(), filter.GetOffset()),
+		Items:      make([]*dto.CategoryResponse, len(categories)),
+		Pagination: pagination,
 	}
 
 	// Add items to response
@@ -149,3 +267,112 @@ func (s *categoryService) List(ctx context.Context, filter *types.CategoryFilter
 
 	return response, nil
 }
+
+func (s *categoryService) GetTree(ctx context.Context) (*dto.CategoryTreeResponse, error) {
+	filter := types.NewNoLimitCategoryFilter()
+	filter.Sort = lo.ToPtr("sort_weight")
+	filter.Order = lo.ToPtr(types.OrderAsc)
+
+	categories, err := s.CategoryRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]*dto.CategoryTreeNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &dto.CategoryTreeNode{Category: *category, Children: []*dto.CategoryTreeNode{}}
+	}
+
+	// categories are sorted by weight so children keep that order
+	response := &dto.CategoryTreeResponse{Items: []*dto.CategoryTreeNode{}}
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if parent, ok := nodes[lo.FromPtr(category.ParentID)]; ok {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		response.Items = append(response.Items, node)
+	}
+
+	return response, nil
+}
+
+func (s *categoryService) RefreshInternshipCounts(ctx context.Context) error {
+	internshipIDs, err := s.CategoryRepo.ListPublishedInternshipIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	categories, err := s.CategoryRepo.ListAll(ctx, types.NewNoLimitCategoryFilter())
+	if err != nil {
+		return err
+	}
+
+	// every internship counts towards each category on its category's path, once per
+	// subtree even when it is tagged with several categories of it
+	subtrees := make(map[string]map[string]struct{}, len(categories))
+	for _, category := range categories {
+		for _, ancestorID := range strings.Split(strings.Trim(category.Path, "/"), "/") {
+			if subtrees[ancestorID] == nil {
+				subtrees[ancestorID] = make(map[string]struct{})
+			}
+			for _, internshipID := range internshipIDs[category.ID] {
+				subtrees[ancestorID][internshipID] = struct{}{}
+			}
+		}
+	}
+
+	for _, category := range categories {
+		count, total := len(internshipIDs[category.ID]), len(subtrees[category.ID])
+		if category.InternshipCount == count && category.TotalInternshipCount == total {
+			continue
+		}
+
+		if err := s.CategoryRepo.UpdateInternshipCounts(ctx, category.ID, count, total); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refreshInternshipCounts refreshes the cached counts after a change has been saved,
+// a failure only leaves the counts stale until the next refresh
+func (s *categoryService) refreshInternshipCounts(ctx context.Context) {
+	if err := s.RefreshInternshipCounts(ctx); err != nil {
+		s.Logger.Errorw("failed to refresh category internship counts", "error", err)
+	}
+}
+
+func (s *categoryService) getParent(ctx context.Context, id string) (*domainInternship.Category, error) {
+	parent, err := s.CategoryRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.Status == types.StatusDeleted {
+		return nil, ierr.NewError("parent category not found").
+			WithHint("The parent category doesn't exist").
+			WithReportableDetails(map[string]any{
+				"parent_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return parent, nil
+}
+
+// placeCategory sets the parent, path and depth of a category for its place under parent,
+// a nil parent makes it a top level category
+func placeCategory(category *domainInternship.Category, parent *domainInternship.Category) {
+	if parent == nil {
+		category.ParentID = nil
+		category.Path = domainInternship.CategoryPath("", category.ID)
+		category.Depth = 0
+		return
+	}
+
+	category.ParentID = lo.ToPtr(parent.ID)
+	category.Path = domainInternship.CategoryPath(parent.Path, category.ID)
+	category.Depth = parent.Depth + 1
+}
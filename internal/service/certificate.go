@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	renderer "github.com/omkar273/codegeeky/internal/certificate"
+	domainCertificate "github.com/omkar273/codegeeky/internal/domain/certificate"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/fileupload"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+const (
+	certificateVerificationIDLength      = 12
+	certificateVerificationIDMaxAttempts = 5
+)
+
+type CertificateService interface {
+	// Issue issues the certificate of a completed enrollment, issuing again returns the existing certificate
+	Issue(ctx context.Context, enrollmentID string) (*dto.CertificateResponse, error)
+
+	// IssueForEnrollment issues the certificate of an enrollment the system completed
+	IssueForEnrollment(ctx context.Context, enrollment *internshipenrollment.InternshipEnrollment) (*domainCertificate.Certificate, error)
+
+	Get(ctx context.Context, id string) (*dto.CertificateResponse, error)
+
+	// List returns the certificates of the current user, admins see every certificate
+	List(ctx context.Context, filter *types.CertificateFilter) (*dto.ListCertificateResponse, error)
+
+	// GetDownloadURL returns where the rendered certificate can be downloaded
+	GetDownloadURL(ctx context.Context, id string) (*dto.CertificateDownloadResponse, error)
+
+	// Verify checks a certificate by its public verification id, anyone can verify a certificate
+	Verify(ctx context.Context, verificationID string) (*dto.VerifyCertificateResponse, error)
+
+	// RevokeForEnrollment revokes the certificate of an enrollment if one was issued
+	RevokeForEnrollment(ctx context.Context, enrollmentID string, reason string) error
+}
+
+type certificateService struct {
+	ServiceParams
+}
+
+func NewCertificateService(params ServiceParams) CertificateService {
+	return &certificateService{
+		ServiceParams: params,
+	}
+}
+
+func (s *certificateService) Issue(ctx context.Context, enrollmentID string) (*dto.CertificateResponse, error) {
+	enrollment, err := s.InternshipEnrollmentRepo.Get(ctx, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if types.GetUserRole(ctx) != types.UserRoleAdmin && enrollment.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("not the owner of the enrollment").
+			WithHint("You can only get certificates for your own enrollments").
+			WithReportableDetails(map[string]any{
+				"enrollment_id": enrollmentID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	certificate, err := s.IssueForEnrollment(ctx, enrollment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(certificate), nil
+}
+
+func (s *certificateService) IssueForEnrollment(ctx context.Context, enrollment *internshipenrollment.InternshipEnrollment) (*domainCertificate.Certificate, error) {
+	existing, err := s.CertificateRepo.GetByEnrollmentID(ctx, enrollment.ID)
+	if err == nil {
+		return existing, nil
+	}
+	if !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	if !enrollment.HasCompleted() {
+		return nil, ierr.NewError("enrollment is not completed").
+			WithHint("Certificates are issued once you complete the internship").
+			WithReportableDetails(map[string]any{
+				"enrollment_id":     enrollment.ID,
+				"enrollment_status": enrollment.EnrollmentStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	user, err := s.UserRepo.Get(ctx, enrollment.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, enrollment.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := s.generateVerificationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientName := strings.TrimSpace(user.FullName)
+	if recipientName == "" {
+		recipientName = user.Email
+	}
+
+	certificate := &domainCertificate.Certificate{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CERTIFICATE),
+		VerificationID:    verificationID,
+		EnrollmentID:      enrollment.ID,
+		UserID:            enrollment.UserID,
+		InternshipID:      enrollment.InternshipID,
+		InternshipBatchID: enrollment.InternshipBatchID,
+		RecipientName:     recipientName,
+		InternshipTitle:   internship.Title,
+		CompletedAt:       lo.FromPtr(enrollment.CompletedAt).UTC().Truncate(time.Second),
+		IssuedAt:          time.Now().UTC().Truncate(time.Second),
+		CertificateStatus: types.CertificateStatusIssued,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+
+	// timestamps are signed at second precision so they survive the database round trip
+	certificate.Signature = s.SigningService.Sign(certificate.SigningPayload())
+
+	file, err := s.renderCertificate(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+	certificate.FileID = lo.ToPtr(file.ID)
+
+	if err := s.CertificateRepo.Create(ctx, certificate); err != nil {
+		return nil, err
+	}
+
+	s.publishCertificateEvent(ctx, types.WebhookEventCertificateIssued, certificate)
+
+	return certificate, nil
+}
+
+func (s *certificateService) Get(ctx context.Context, id string) (*dto.CertificateResponse, error) {
+	certificate, err := s.getOwnCertificate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(certificate), nil
+}
+
+func (s *certificateService) List(ctx context.Context, filter *types.CertificateFilter) (*dto.ListCertificateResponse, error) {
+	if filter == nil {
+		filter = types.NewCertificateFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.UserIDs = []string{types.GetUserID(ctx)}
+	}
+
+	certificates, err := s.CertificateRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, certificates, s.CertificateRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListCertificateResponse{
+		Items: lo.Map(certificates, func(certificate *domainCertificate.Certificate, _ int) *dto.CertificateResponse {
+			return s.toResponse(certificate)
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *certificateService) GetDownloadURL(ctx context.Context, id string) (*dto.CertificateDownloadResponse, error) {
+	certificate, err := s.getOwnCertificate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate.IsRevoked() {
+		return nil, ierr.NewError("certificate is revoked").
+			WithHint("This certificate was revoked and can no longer be downloaded").
+			WithReportableDetails(map[string]any{
+				"certificate_id": certificate.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if certificate.FileID == nil {
+		return nil, ierr.NewError("certificate has no file").
+			WithHint("The certificate document is not available").
+			WithReportableDetails(map[string]any{
+				"certificate_id": certificate.ID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	file, err := s.FileUploadRepo.Get(ctx, lo.FromPtr(certificate.FileID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CertificateDownloadResponse{
+		CertificateID: certificate.ID,
+		URL:           lo.CoalesceOrEmpty(lo.FromPtr(file.SecureURL), file.PublicURL),
+	}, nil
+}
+
+func (s *certificateService) Verify(ctx context.Context, verificationID string) (*dto.VerifyCertificateResponse, error) {
+	certificate, err := s.CertificateRepo.GetByVerificationID(ctx, strings.ToUpper(strings.TrimSpace(verificationID)))
+	if err != nil {
+		return nil, err
+	}
+
+	// a certificate edited in the database no longer matches its signature
+	authentic := s.SigningService.Verify(certificate.SigningPayload(), certificate.Signature)
+	if !authentic {
+		s.Logger.Warnw("certificate signature does not match",
+			"certificate_id", certificate.ID,
+			"verification_id", certificate.VerificationID)
+	}
+
+	return &dto.VerifyCertificateResponse{
+		VerificationID:    certificate.VerificationID,
+		Valid:             authentic && !certificate.IsRevoked(),
+		CertificateStatus: certificate.CertificateStatus,
+		RecipientName:     certificate.RecipientName,
+		InternshipTitle:   certificate.InternshipTitle,
+		IssuerName:        s.Config.Certificate.IssuerName,
+		CompletedAt:       certificate.CompletedAt,
+		IssuedAt:          certificate.IssuedAt,
+		RevokedAt:         certificate.RevokedAt,
+		RevocationReason:  certificate.RevocationReason,
+	}, nil
+}
+
+func (s *certificateService) RevokeForEnrollment(ctx context.Context, enrollmentID string, reason string) error {
+	certificate, err := s.CertificateRepo.GetByEnrollmentID(ctx, enrollmentID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if certificate.IsRevoked() {
+		return nil
+	}
+
+	certificate.CertificateStatus = types.CertificateStatusRevoked
+	certificate.RevokedAt = lo.ToPtr(time.Now().UTC())
+	certificate.RevocationReason = lo.ToPtr(reason)
+
+	if err := s.CertificateRepo.Update(ctx, certificate); err != nil {
+		return err
+	}
+
+	s.publishCertificateEvent(ctx, types.WebhookEventCertificateRevoked, certificate)
+
+	return nil
+}
+
+// getOwnCertificate returns the certificate when it belongs to the caller, admins can see every certificate
+func (s *certificateService) getOwnCertificate(ctx context.Context, id string) (*domainCertificate.Certificate, error) {
+	certificate, err := s.CertificateRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if types.GetUserRole(ctx) != types.UserRoleAdmin && certificate.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("not the owner of the certificate").
+			WithHint("You can only access your own certificates").
+			WithReportableDetails(map[string]any{
+				"certificate_id": id,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return certificate, nil
+}
+
+// renderCertificate renders the certificate document and stores it through the file upload provider
+func (s *certificateService) renderCertificate(ctx context.Context, certificate *domainCertificate.Certificate) (*fileupload.FileUpload, error) {
+	content, err := s.CertificateRenderer.Render(&renderer.Data{
+		VerificationID:  certificate.VerificationID,
+		RecipientName:   certificate.RecipientName,
+		InternshipTitle: certificate.InternshipTitle,
+		IssuerName:      s.Config.Certificate.IssuerName,
+		CompletedAt:     certificate.CompletedAt,
+		IssuedAt:        certificate.IssuedAt,
+		VerifyURL:       s.verifyURL(certificate),
+		Signature:       certificate.Signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	file := &fileupload.FileUpload{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_FILE_UPLOAD),
+		FileName:  certificate.VerificationID + s.CertificateRenderer.FileExtension(),
+		FileType:  string(types.FileTypeCertificate),
+		Extension: types.FileExtensionPDF,
+		MimeType:  "application/pdf",
+		SizeBytes: int64(len(content)),
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+
+	file, err = s.FileUploadProvider.UploadContent(ctx, file, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FileUploadRepo.Create(ctx, file)
+}
+
+// generateVerificationID returns a verification id no other certificate uses
+func (s *certificateService) generateVerificationID(ctx context.Context) (string, error) {
+	for attempt := 0; attempt < certificateVerificationIDMaxAttempts; attempt++ {
+		id, err := generateRandomCode("", types.DiscountCodeCharsetDefault, certificateVerificationIDLength)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = s.CertificateRepo.GetByVerificationID(ctx, id)
+		if ierr.IsNotFound(err) {
+			return id, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "", ierr.NewError("failed to generate certificate verification id").
+		WithHint("Please try again").
+		Mark(ierr.ErrInternal)
+}
+
+func (s *certificateService) verifyURL(certificate *domainCertificate.Certificate) string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(s.Config.Certificate.VerifyBaseURL, "/"), certificate.VerificationID)
+}
+
+func (s *certificateService) toResponse(certificate *domainCertificate.Certificate) *dto.CertificateResponse {
+	return &dto.CertificateResponse{
+		Certificate: *certificate,
+		VerifyURL:   s.verifyURL(certificate),
+	}
+}
+
+func (s *certificateService) publishCertificateEvent(ctx context.Context, eventName string, certificate *domainCertificate.Certificate) {
+	payload, err := json.Marshal(&webhookDto.CertificateWebhookPayload{
+		CertificateID:     certificate.ID,
+		VerificationID:    certificate.VerificationID,
+		EnrollmentID:      certificate.EnrollmentID,
+		InternshipID:      certificate.InternshipID,
+		CertificateStatus: string(certificate.CertificateStatus),
+		VerifyURL:         s.verifyURL(certificate),
+		IssuedAt:          certificate.IssuedAt,
+		RevokedAt:         certificate.RevokedAt,
+		RevocationReason:  certificate.RevocationReason,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build certificate event",
+			"certificate_id", certificate.ID,
+			"event_name", eventName,
+			"error", err)
+		return
+	}
+
+	err = s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		UserID:    lo.ToPtr(certificate.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to publish certificate event",
+			"certificate_id", certificate.ID,
+			"event_name", eventName,
+			"error", err)
+	}
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	renderer "github.com/omkar273/codegeeky/internal/certificate"
+	"github.com/omkar273/codegeeky/internal/config"
+	domainCertificate "github.com/omkar273/codegeeky/internal/domain/certificate"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	domainUser "github.com/omkar273/codegeeky/internal/domain/user"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/security"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type CertificateServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service    CertificateService
+	publisher  *testutil.MockWebhookPublisher
+	internship *domainInternship.Internship
+}
+
+func TestCertificateService(t *testing.T) {
+	suite.Run(t, new(CertificateServiceSuite))
+}
+
+func (s *CertificateServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.Secrets.SigningKey = "test-signing-key"
+	cfg.Certificate = config.CertificateConfig{
+		IssuerName:    "CodeGeeky",
+		VerifyBaseURL: "https://codegeeky.com/certificates/",
+	}
+
+	signing, err := security.NewSigningService(cfg, s.GetLogger())
+	s.Require().NoError(err)
+	pdfRenderer, err := renderer.NewPDFRenderer()
+	s.Require().NoError(err)
+
+	s.publisher = testutil.NewMockWebhookPublisher()
+
+	stores := s.GetStores()
+	s.service = NewCertificateService(ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   cfg,
+		DB:                       s.GetDB(),
+		UserRepo:                 stores.UserRepo,
+		InternshipRepo:           stores.InternshipRepo,
+		InternshipEnrollmentRepo: stores.InternshipEnrollmentRepo,
+		CertificateRepo:          stores.CertificateRepo,
+		FileUploadRepo:           stores.FileUploadRepo,
+		WebhookPublisher:         s.publisher,
+		SigningService:           signing,
+		FileUploadProvider:       testutil.NewMockFileUploadProvider(),
+		CertificateRenderer:      pdfRenderer,
+	})
+
+	s.Require().NoError(stores.UserRepo.Create(s.GetContext(), &domainUser.User{
+		ID:        types.DefaultUserID,
+		Email:     "student@example.com",
+		FullName:  "Asha Rao",
+		Role:      types.UserRoleStudent,
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}))
+
+	s.internship = &domainInternship.Internship{
+		ID:        s.GetUUID(),
+		Title:     "Backend Engineering",
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(stores.InternshipRepo.Create(s.GetContext(), s.internship))
+}
+
+func (s *CertificateServiceSuite) enroll(userID string, status types.InternshipEnrollmentStatus) *domainInternshipEnrollment.InternshipEnrollment {
+	enrollment := &domainInternshipEnrollment.InternshipEnrollment{
+		ID:                s.GetUUID(),
+		UserID:            userID,
+		InternshipID:      s.internship.ID,
+		InternshipBatchID: s.GetUUID(),
+		EnrollmentStatus:  status,
+		PaymentStatus:     types.PaymentStatusSuccess,
+		Metadata:          types.Metadata{},
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	if status == types.InternshipEnrollmentStatusCompleted {
+		enrollment.CompletedAt = lo.ToPtr(s.GetNow().Add(-time.Hour))
+	}
+	s.Require().NoError(s.GetStores().InternshipEnrollmentRepo.Create(s.GetContext(), enrollment))
+	return enrollment
+}
+
+func (s *CertificateServiceSuite) issue() *domainCertificate.Certificate {
+	enrollment := s.enroll(types.DefaultUserID, types.InternshipEnrollmentStatusCompleted)
+
+	response, err := s.service.Issue(s.GetContext(), enrollment.ID)
+	s.Require().NoError(err)
+	return &response.Certificate
+}
+
+// studentContext is the context of a student who is not the default user
+func (s *CertificateServiceSuite) studentContext() context.Context {
+	ctx := context.WithValue(s.GetContext(), types.CtxUserID, s.GetUUID())
+	return context.WithValue(ctx, types.CtxUserRole, types.UserRoleStudent)
+}
+
+func (s *CertificateServiceSuite) TestIssueSignsCertificate() {
+	certificate := s.issue()
+
+	s.Len(certificate.VerificationID, certificateVerificationIDLength)
+	s.Equal("Asha Rao", certificate.RecipientName)
+	s.Equal("Backend Engineering", certificate.InternshipTitle)
+	s.Equal(types.CertificateStatusIssued, certificate.CertificateStatus)
+	s.NotEmpty(certificate.Signature)
+	s.Equal(1, lo.Count(s.publisher.Events(), types.WebhookEventCertificateIssued))
+
+	s.Require().NotNil(certificate.FileID)
+	file, err := s.GetStores().FileUploadRepo.Get(s.GetContext(), *certificate.FileID)
+	s.Require().NoError(err)
+	s.Equal(certificate.VerificationID+".pdf", file.FileName)
+	s.Positive(file.SizeBytes)
+
+	response, err := s.service.Verify(s.GetContext(), certificate.VerificationID)
+	s.Require().NoError(err)
+	s.True(response.Valid)
+	s.Equal("CodeGeeky", response.IssuerName)
+	s.Equal(certificate.RecipientName, response.RecipientName)
+}
+
+func (s *CertificateServiceSuite) TestIssueAgainReturnsSameCertificate() {
+	enrollment := s.enroll(types.DefaultUserID, types.InternshipEnrollmentStatusCompleted)
+
+	first, err := s.service.Issue(s.GetContext(), enrollment.ID)
+	s.Require().NoError(err)
+	second, err := s.service.Issue(s.GetContext(), enrollment.ID)
+	s.Require().NoError(err)
+
+	s.Equal(first.ID, second.ID)
+	s.Equal(first.Signature, second.Signature)
+	s.Equal(1, lo.Count(s.publisher.Events(), types.WebhookEventCertificateIssued))
+}
+
+func (s *CertificateServiceSuite) TestIssueRequiresCompletedEnrollment() {
+	enrollment := s.enroll(types.DefaultUserID, types.InternshipEnrollmentStatusEnrolled)
+
+	_, err := s.service.Issue(s.GetContext(), enrollment.ID)
+	s.True(ierr.IsInvalidOperation(err))
+	s.Empty(s.publisher.Events())
+}
+
+func (s *CertificateServiceSuite) TestIssueRejectsOtherStudentsEnrollment() {
+	enrollment := s.enroll(types.DefaultUserID, types.InternshipEnrollmentStatusCompleted)
+
+	_, err := s.service.Issue(s.studentContext(), enrollment.ID)
+	s.True(ierr.IsPermissionDenied(err))
+}
+
+func (s *CertificateServiceSuite) TestVerifyNormalizesVerificationID() {
+	certificate := s.issue()
+
+	response, err := s.service.Verify(s.GetContext(), "  "+strings.ToLower(certificate.VerificationID)+" ")
+	s.Require().NoError(err)
+	s.True(response.Valid)
+
+	_, err = s.service.Verify(s.GetContext(), "UNKNOWN")
+	s.True(ierr.IsNotFound(err))
+}
+
+func (s *CertificateServiceSuite) TestVerifyDetectsTampering() {
+	certificate := s.issue()
+
+	// edited in the database after it was signed
+	stored, err := s.GetStores().CertificateRepo.Get(s.GetContext(), certificate.ID)
+	s.Require().NoError(err)
+	stored.RecipientName = "Someone Else"
+	s.Require().NoError(s.GetStores().CertificateRepo.Update(s.GetContext(), stored))
+
+	response, err := s.service.Verify(s.GetContext(), certificate.VerificationID)
+	s.Require().NoError(err)
+	s.False(response.Valid)
+	s.Equal(types.CertificateStatusIssued, response.CertificateStatus)
+}
+
+func (s *CertificateServiceSuite) TestRevokedCertificateIsInvalid() {
+	certificate := s.issue()
+
+	s.Require().NoError(s.service.RevokeForEnrollment(s.GetContext(), certificate.EnrollmentID, "Refunded"))
+	s.Require().NoError(s.service.RevokeForEnrollment(s.GetContext(), certificate.EnrollmentID, "Refunded"))
+	s.Equal(1, lo.Count(s.publisher.Events(), types.WebhookEventCertificateRevoked))
+
+	response, err := s.service.Verify(s.GetContext(), certificate.VerificationID)
+	s.Require().NoError(err)
+	s.False(response.Valid)
+	s.Equal(types.CertificateStatusRevoked, response.CertificateStatus)
+	s.Equal("Refunded", lo.FromPtr(response.RevocationReason))
+	s.NotNil(response.RevokedAt)
+
+	_, err = s.service.GetDownloadURL(s.GetContext(), certificate.ID)
+	s.True(ierr.IsInvalidOperation(err))
+
+	// enrollments without a certificate have nothing to revoke
+	s.NoError(s.service.RevokeForEnrollment(s.GetContext(), s.GetUUID(), "Refunded"))
+}
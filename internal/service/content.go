@@ -0,0 +1,639 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type ContentService interface {
+	// GetOutline returns the modules of an internship with their lessons, assignments and resources.
+	// Only preview lessons carry their content, the rest is fetched one by one with an access check.
+	GetOutline(ctx context.Context, internshipID string) (*dto.ContentOutlineResponse, error)
+
+	CreateModule(ctx context.Context, internshipID string, req *dto.CreateModuleRequest) (*dto.ModuleResponse, error)
+	UpdateModule(ctx context.Context, id string, req *dto.UpdateModuleRequest) (*dto.ModuleResponse, error)
+	DeleteModule(ctx context.Context, id string) error
+
+	CreateLesson(ctx context.Context, moduleID string, req *dto.CreateLessonRequest) (*dto.LessonResponse, error)
+	GetLesson(ctx context.Context, id string) (*dto.LessonResponse, error)
+	UpdateLesson(ctx context.Context, id string, req *dto.UpdateLessonRequest) (*dto.LessonResponse, error)
+	DeleteLesson(ctx context.Context, id string) error
+
+	CreateResource(ctx context.Context, internshipID string, req *dto.CreateResourceRequest) (*dto.ResourceResponse, error)
+	UpdateResource(ctx context.Context, id string, req *dto.UpdateResourceRequest) (*dto.ResourceResponse, error)
+	DeleteResource(ctx context.Context, id string) error
+	DownloadResource(ctx context.Context, id string) (*dto.ResourceDownloadResponse, error)
+}
+
+type contentService struct {
+	ServiceParams
+}
+
+func NewContentService(params ServiceParams) ContentService {
+	return &contentService{
+		ServiceParams: params,
+	}
+}
+
+func (s *contentService) GetOutline(ctx context.Context, internshipID string) (*dto.ContentOutlineResponse, error) {
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleFilter := types.NewNoLimitModuleFilter()
+	moduleFilter.InternshipIDs = []string{internship.ID}
+	modules, err := s.ModuleRepo.ListAll(ctx, moduleFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	lessonFilter := types.NewNoLimitLessonFilter()
+	lessonFilter.InternshipIDs = []string{internship.ID}
+	lessons, err := s.LessonRepo.ListAll(ctx, lessonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentFilter := types.NewNoLimitAssignmentFilter()
+	assignmentFilter.InternshipIDs = []string{internship.ID}
+	assignments, err := s.AssignmentRepo.ListAll(ctx, assignmentFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	quizFilter := types.NewNoLimitQuizFilter()
+	quizFilter.InternshipIDs = []string{internship.ID}
+	quizzes, err := s.QuizRepo.ListAll(ctx, quizFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceFilter := types.NewNoLimitResourceFilter()
+	resourceFilter.InternshipIDs = []string{internship.ID}
+	resources, err := s.ResourceRepo.ListAll(ctx, resourceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	lessonsByModule := lo.GroupBy(lessons, func(lesson *domainContent.Lesson) string {
+		return lesson.ModuleID
+	})
+	assignmentsByModule := lo.GroupBy(assignments, func(assignment *domainContent.Assignment) string {
+		return assignment.ModuleID
+	})
+	quizzesByModule := lo.GroupBy(quizzes, func(quiz *domainContent.Quiz) string {
+		return quiz.ModuleID
+	})
+	resourcesByModule := lo.GroupBy(resources, func(resource *domainContent.Resource) string {
+		return lo.FromPtr(resource.ModuleID)
+	})
+
+	response := &dto.ContentOutlineResponse{
+		InternshipID: internship.ID,
+		Modules:      make([]*dto.ContentModuleResponse, len(modules)),
+		Resources:    toResourceResponses(resourcesByModule[""]),
+	}
+
+	for i, module := range modules {
+		response.Modules[i] = &dto.ContentModuleResponse{
+			Module: *module,
+			Lessons: lo.Map(lessonsByModule[module.ID], func(lesson *domainContent.Lesson, _ int) *dto.LessonResponse {
+				if !lesson.IsPreview {
+					lesson.HideContent()
+				}
+				return &dto.LessonResponse{Lesson: *lesson}
+			}),
+			Assignments: lo.Map(assignmentsByModule[module.ID], func(assignment *domainContent.Assignment, _ int) *dto.AssignmentResponse {
+				assignment.HideContent()
+				return &dto.AssignmentResponse{Assignment: *assignment}
+			}),
+			Quizzes: lo.Map(quizzesByModule[module.ID], func(quiz *domainContent.Quiz, _ int) *dto.QuizResponse {
+				return dto.NewQuizResponse(quiz, false)
+			}),
+			Resources: toResourceResponses(resourcesByModule[module.ID]),
+		}
+	}
+
+	return response, nil
+}
+
+func toResourceResponses(resources []*domainContent.Resource) []*dto.ResourceResponse {
+	return lo.Map(resources, func(resource *domainContent.Resource, _ int) *dto.ResourceResponse {
+		resource.HideContent()
+		return &dto.ResourceResponse{Resource: *resource}
+	})
+}
+
+func (s *contentService) CreateModule(ctx context.Context, internshipID string, req *dto.CreateModuleRequest) (*dto.ModuleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.getManagedInternship(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	module := req.ToModule(ctx, internship.ID)
+	if err := s.ModuleRepo.Create(ctx, module); err != nil {
+		return nil, err
+	}
+
+	return &dto.ModuleResponse{Module: *module}, nil
+}
+
+func (s *contentService) UpdateModule(ctx context.Context, id string, req *dto.UpdateModuleRequest) (*dto.ModuleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	module, err := s.getManagedModule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(module)
+	if err := s.ModuleRepo.Update(ctx, module); err != nil {
+		return nil, err
+	}
+
+	return &dto.ModuleResponse{Module: *module}, nil
+}
+
+func (s *contentService) DeleteModule(ctx context.Context, id string) error {
+	module, err := s.getManagedModule(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	lessonFilter := types.NewNoLimitLessonFilter()
+	lessonFilter.ModuleIDs = []string{module.ID}
+	lessons, err := s.LessonRepo.Count(ctx, lessonFilter)
+	if err != nil {
+		return err
+	}
+
+	assignmentFilter := types.NewNoLimitAssignmentFilter()
+	assignmentFilter.ModuleIDs = []string{module.ID}
+	assignments, err := s.AssignmentRepo.Count(ctx, assignmentFilter)
+	if err != nil {
+		return err
+	}
+
+	quizFilter := types.NewNoLimitQuizFilter()
+	quizFilter.ModuleIDs = []string{module.ID}
+	quizzes, err := s.QuizRepo.Count(ctx, quizFilter)
+	if err != nil {
+		return err
+	}
+
+	resourceFilter := types.NewNoLimitResourceFilter()
+	resourceFilter.ModuleIDs = []string{module.ID}
+	resources, err := s.ResourceRepo.Count(ctx, resourceFilter)
+	if err != nil {
+		return err
+	}
+
+	if lessons+assignments+quizzes+resources > 0 {
+		return ierr.NewError("module is not empty").
+			WithHint("Move or delete the lessons, assignments, quizzes and resources of the module first").
+			WithReportableDetails(map[string]any{
+				"module_id":   module.ID,
+				"lessons":     lessons,
+				"assignments": assignments,
+				"quizzes":     quizzes,
+				"resources":   resources,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return s.ModuleRepo.Delete(ctx, module.ID)
+}
+
+func (s *contentService) CreateLesson(ctx context.Context, moduleID string, req *dto.CreateLessonRequest) (*dto.LessonResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	module, err := s.getManagedModule(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson := req.ToLesson(ctx, module)
+	if err := s.validateLesson(ctx, lesson, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.LessonRepo.Create(ctx, lesson); err != nil {
+		return nil, err
+	}
+
+	return &dto.LessonResponse{Lesson: *lesson}, nil
+}
+
+func (s *contentService) GetLesson(ctx context.Context, id string) (*dto.LessonResponse, error) {
+	lesson, err := s.LessonRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, lesson.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	// preview lessons are open to everyone who can see the internship
+	if !lesson.IsPreview {
+		access := newContentAccess(ctx, s.ServiceParams, internship)
+		access.requireProgress(lesson.RequiredProgress)
+
+		if err := access.authorize(ctx, domainAuth.PermissionViewLectures); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.LessonResponse{Lesson: *lesson}, nil
+}
+
+func (s *contentService) UpdateLesson(ctx context.Context, id string, req *dto.UpdateLessonRequest) (*dto.LessonResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	lesson, err := s.LessonRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getManagedInternship(ctx, lesson.InternshipID); err != nil {
+		return nil, err
+	}
+
+	currentFileID := lesson.FileID
+	req.ApplyTo(lesson)
+	if err := s.validateLesson(ctx, lesson, currentFileID); err != nil {
+		return nil, err
+	}
+
+	if err := s.LessonRepo.Update(ctx, lesson); err != nil {
+		return nil, err
+	}
+
+	return &dto.LessonResponse{Lesson: *lesson}, nil
+}
+
+func (s *contentService) DeleteLesson(ctx context.Context, id string) error {
+	lesson, err := s.LessonRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.getManagedInternship(ctx, lesson.InternshipID); err != nil {
+		return err
+	}
+
+	resourceFilter := types.NewNoLimitResourceFilter()
+	resourceFilter.LessonIDs = []string{lesson.ID}
+	resources, err := s.ResourceRepo.Count(ctx, resourceFilter)
+	if err != nil {
+		return err
+	}
+
+	if resources > 0 {
+		return ierr.NewError("lesson has resources").
+			WithHint("Move or delete the resources of the lesson first").
+			WithReportableDetails(map[string]any{
+				"lesson_id": lesson.ID,
+				"resources": resources,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return s.LessonRepo.Delete(ctx, lesson.ID)
+}
+
+func (s *contentService) CreateResource(ctx context.Context, internshipID string, req *dto.CreateResourceRequest) (*dto.ResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.getManagedInternship(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := req.ToResource(ctx, internship.ID)
+	if err := s.validateResource(ctx, resource, ""); err != nil {
+		return nil, err
+	}
+
+	if err := s.ResourceRepo.Create(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	return &dto.ResourceResponse{Resource: *resource}, nil
+}
+
+func (s *contentService) UpdateResource(ctx context.Context, id string, req *dto.UpdateResourceRequest) (*dto.ResourceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	resource, err := s.ResourceRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getManagedInternship(ctx, resource.InternshipID); err != nil {
+		return nil, err
+	}
+
+	currentFileID := resource.FileID
+	req.ApplyTo(resource)
+	if err := s.validateResource(ctx, resource, currentFileID); err != nil {
+		return nil, err
+	}
+
+	if err := s.ResourceRepo.Update(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	return &dto.ResourceResponse{Resource: *resource}, nil
+}
+
+func (s *contentService) DeleteResource(ctx context.Context, id string) error {
+	resource, err := s.ResourceRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.getManagedInternship(ctx, resource.InternshipID); err != nil {
+		return err
+	}
+
+	return s.ResourceRepo.Delete(ctx, resource.ID)
+}
+
+func (s *contentService) DownloadResource(ctx context.Context, id string) (*dto.ResourceDownloadResponse, error) {
+	resource, err := s.ResourceRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, resource.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeContent(ctx, s.ServiceParams, internship, domainAuth.PermissionDownloadContent); err != nil {
+		return nil, err
+	}
+
+	file, err := s.FileUploadRepo.Get(ctx, resource.FileID)
+	if err != nil {
+		return nil, err
+	}
+
+	url := file.PublicURL
+	if lo.FromPtr(file.SecureURL) != "" {
+		url = lo.FromPtr(file.SecureURL)
+	}
+
+	return &dto.ResourceDownloadResponse{
+		ResourceID: resource.ID,
+		FileName:   file.FileName,
+		URL:        url,
+	}, nil
+}
+
+// getManagedInternship returns an internship the caller may change the content of
+func (s *contentService) getManagedInternship(ctx context.Context, id string) (*domainInternship.Internship, error) {
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship); err != nil {
+		return nil, err
+	}
+
+	return internship, nil
+}
+
+// getManagedModule returns a module the caller may change the content of
+func (s *contentService) getManagedModule(ctx context.Context, id string) (*domainContent.Module, error) {
+	module, err := s.ModuleRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getManagedInternship(ctx, module.InternshipID); err != nil {
+		return nil, err
+	}
+
+	return module, nil
+}
+
+// validateLesson checks a lesson and the file it was given, an unchanged file was checked when it was set
+func (s *contentService) validateLesson(ctx context.Context, lesson *domainContent.Lesson, currentFileID *string) error {
+	if err := lesson.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateContentModule(ctx, s.ServiceParams, lesson.InternshipID, lesson.ModuleID); err != nil {
+		return err
+	}
+
+	if lesson.FileID != nil && lo.FromPtr(lesson.FileID) != lo.FromPtr(currentFileID) {
+		return s.validateFile(ctx, lo.FromPtr(lesson.FileID))
+	}
+
+	return nil
+}
+
+// validateResource checks a resource and the file it was given, an unchanged file was checked when it was set
+func (s *contentService) validateResource(ctx context.Context, resource *domainContent.Resource, currentFileID string) error {
+	// a resource of a lesson belongs to the module of that lesson
+	if resource.LessonID != nil {
+		lesson, err := s.LessonRepo.Get(ctx, lo.FromPtr(resource.LessonID))
+		if err != nil {
+			return err
+		}
+
+		if lesson.InternshipID != resource.InternshipID {
+			return ierr.NewError("lesson belongs to another internship").
+				WithHint("Resources can only be attached to lessons of the same internship").
+				WithReportableDetails(map[string]any{
+					"internship_id": resource.InternshipID,
+					"lesson_id":     lesson.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		resource.ModuleID = lo.ToPtr(lesson.ModuleID)
+	}
+
+	if resource.ModuleID != nil {
+		if err := validateContentModule(ctx, s.ServiceParams, resource.InternshipID, lo.FromPtr(resource.ModuleID)); err != nil {
+			return err
+		}
+	}
+
+	if resource.FileID == currentFileID {
+		return nil
+	}
+
+	return s.validateFile(ctx, resource.FileID)
+}
+
+// validateFile checks a file being attached exists and was uploaded by the caller, admins may
+// attach any file
+func (s *contentService) validateFile(ctx context.Context, fileID string) error {
+	file, err := s.FileUploadRepo.Get(ctx, fileID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return ierr.NewError("file not found").
+				WithHintf("File with ID %s was not found, upload it first", fileID).
+				WithReportableDetails(map[string]any{
+					"file_id": fileID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		return err
+	}
+
+	if types.GetUserRole(ctx) != types.UserRoleAdmin && file.CreatedBy != types.GetUserID(ctx) {
+		return ierr.NewError("file was uploaded by another user").
+			WithHint("Only files you uploaded yourself can be attached").
+			WithReportableDetails(map[string]any{
+				"file_id": fileID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// validateContentModule checks the module exists and is part of the internship
+func validateContentModule(ctx context.Context, params ServiceParams, internshipID string, moduleID string) error {
+	module, err := params.ModuleRepo.Get(ctx, moduleID)
+	if err != nil {
+		return err
+	}
+
+	if module.InternshipID != internshipID {
+		return ierr.NewError("module belongs to another internship").
+			WithHint("Content can only be moved between modules of the same internship").
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+				"module_id":     moduleID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// getVisibleInternship returns the internship when the caller may see it, unpublished internships
+// are only visible to their instructors and admins
+func getVisibleInternship(ctx context.Context, params ServiceParams, id string) (*domainInternship.Internship, error) {
+	internship, err := NewInternshipService(params).GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &internship.Internship, nil
+}
+
+// contentAccess evaluates the access of the caller to the content of an internship through the
+// authorization service, students see the content of internships they are enrolled in or whose
+// category their subscription covers. Their enrollments, subscriptions and progress are loaded
+// by the enrollment attribute provider.
+type contentAccess struct {
+	params   ServiceParams
+	subject  *domainAuth.AuthContext
+	resource *domainAuth.Resource
+}
+
+func newContentAccess(ctx context.Context, params ServiceParams, internship *domainInternship.Internship) *contentAccess {
+	access := &contentAccess{
+		params: params,
+		resource: &domainAuth.Resource{
+			Type: domainAuth.ResourceTypeContent,
+			ID:   internship.ID,
+			Attributes: map[string]interface{}{
+				"internship_id": internship.ID,
+				"category_ids": lo.Map(internship.Categories, func(category *domainInternship.Category, _ int) string {
+					return category.ID
+				}),
+			},
+		},
+	}
+
+	userID := types.GetUserID(ctx)
+	if userID == "" {
+		return access
+	}
+
+	access.subject = &domainAuth.AuthContext{
+		UserID:     userID,
+		Email:      types.GetUserEmail(ctx),
+		Role:       types.GetUserRole(ctx),
+		Attributes: map[string]interface{}{},
+	}
+
+	return access
+}
+
+// requireProgress restricts the access to students who completed the given percentage of the internship
+func (a *contentAccess) requireProgress(requiredProgress *float64) {
+	if requiredProgress != nil {
+		a.resource.Attributes["required_progress"] = lo.FromPtr(requiredProgress)
+	}
+}
+
+// allows reports whether the caller may perform the action, guests never see paid content
+func (a *contentAccess) allows(ctx context.Context, action domainAuth.Permission) (bool, error) {
+	if a.subject == nil {
+		return false, nil
+	}
+
+	return a.params.AuthzService.IsAuthorized(ctx, &domainAuth.AccessRequest{
+		Subject:  a.subject,
+		Resource: a.resource,
+		Action:   action,
+	})
+}
+
+// authorize fails with a permission error when the caller may not perform the action
+func (a *contentAccess) authorize(ctx context.Context, action domainAuth.Permission) error {
+	allowed, err := a.allows(ctx, action)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return ierr.NewError("no access to internship content").
+			WithHint("Enroll in the internship and complete its earlier lessons to access this content").
+			WithReportableDetails(map[string]any{
+				"internship_id": a.resource.ID,
+				"action":        action,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// authorizeContent checks the caller may perform the action on the content of the internship
+func authorizeContent(ctx context.Context, params ServiceParams, internship *domainInternship.Internship, action domainAuth.Permission) error {
+	return newContentAccess(ctx, params, internship).authorize(ctx, action)
+}
@@ -1,13 +1,21 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/csv"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
 	"github.com/omkar273/codegeeky/internal/domain/internship"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -19,8 +27,19 @@ type DiscountService interface {
 	List(ctx context.Context, filter *types.DiscountFilter) (*dto.ListDiscountResponse, error)
 	GetByCode(ctx context.Context, code string) (*dto.DiscountResponse, error)
 	ValidateDiscountCode(ctx context.Context, code string, internship *internship.Internship) error
+	RedeemDiscountCodes(ctx context.Context, codes []string) error
+	ReleaseDiscountCodes(ctx context.Context, codes []string) error
+
+	// Campaign operations
+	GenerateCodes(ctx context.Context, campaignID string, req *dto.GenerateDiscountCodesRequest) (*dto.GenerateDiscountCodesResponse, error)
+	ExportCodes(ctx context.Context, campaignID string) ([]byte, error)
+	DeactivateCodes(ctx context.Context, campaignID string, req *dto.DeactivateDiscountCodesRequest) (*dto.DeactivateDiscountCodesResponse, error)
+	GetCampaignStats(ctx context.Context, campaignID string) (*dto.CampaignStatsResponse, error)
 }
 
+// discountCodeBatchSize bounds the number of rows written per bulk insert
+const discountCodeBatchSize = 1000
+
 type discountService struct {
 	ServiceParams
 }
@@ -118,19 +137,19 @@ func (s *discountService) List(ctx context.Context, filter *types.DiscountFilter
 		return nil, err
 	}
 
-	count, err := s.ServiceParams.DiscountRepo.Count(ctx, filter)
+	discounts, err := s.ServiceParams.DiscountRepo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	discounts, err := s.ServiceParams.DiscountRepo.List(ctx, filter)
+	pagination, err := paginate(ctx, filter, discounts, s.ServiceParams.DiscountRepo.Count)
 	if err != nil {
 		return nil, err
 	}
 
 	response := &dto.ListDiscountResponse{
-		Items:      make([]*dto.DiscountResponse, count),
-		Pagination: types.NewPaginationResponse(count, filter.GetLimit(), filter.GetOffset()),
+		Items:      make([]*dto.DiscountResponse, len(discounts)),
+		Pagination: pagination,
 	}
 
 	for i, discount := range discounts {
@@ -174,6 +193,33 @@ func (s *discountService) ValidateDiscountCode(ctx context.Context, code string,
 			Mark(ierr.ErrBadRequest)
 	}
 
+	if !discount.IsRedeemable() {
+		return ierr.NewError("discount cannot be redeemed").
+			WithHint("Discount has reached the maximum number of uses").
+			Mark(ierr.ErrBadRequest)
+	}
+
+	// Personal codes (e.g. referral rewards) can only be redeemed by their owner
+	if ownerID, ok := discount.Metadata[types.DiscountMetadataKeyUserID]; ok && ownerID != types.GetUserID(ctx) {
+		return ierr.NewError("discount belongs to another user").
+			WithHint("Discount is not valid for this account").
+			Mark(ierr.ErrBadRequest)
+	}
+
+	// Codes generated for a campaign are only usable while the campaign is active
+	if discount.ParentID != nil {
+		campaign, err := s.ServiceParams.DiscountRepo.Get(ctx, *discount.ParentID)
+		if err != nil {
+			return err
+		}
+
+		if !campaign.IsActive || campaign.Status != types.StatusPublished {
+			return ierr.NewError("discount campaign is not active").
+				WithHint("Discount is not active").
+				Mark(ierr.ErrBadRequest)
+		}
+	}
+
 	// Check if discount is within valid time range
 	now := time.Now()
 	if discount.ValidFrom.After(now) {
@@ -197,16 +243,306 @@ func (s *discountService) ValidateDiscountCode(ctx context.Context, code string,
 		}
 	}
 
-	// TODO: Implement max uses validation by counting actual usage from payments/enrollments
-	// For now, we'll skip this validation to avoid the UsedCount error
-	// if discount.MaxUses != nil && *discount.MaxUses > 0 {
-	//     actualUsage := s.getDiscountUsageCount(ctx, discount.ID)
-	//     if actualUsage >= *discount.MaxUses {
-	//         return ierr.NewError("discount has reached the maximum number of uses").
-	//             WithHint("Discount has reached the maximum number of uses").
-	//             Mark(ierr.ErrBadRequest)
-	//     }
-	// }
-
 	return nil
 }
+
+// RedeemDiscountCodes records one use of each code, failing if any code is used up
+func (s *discountService) RedeemDiscountCodes(ctx context.Context, codes []string) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		for _, code := range codes {
+			discount, err := s.ServiceParams.DiscountRepo.GetByCode(ctx, code)
+			if err != nil {
+				return err
+			}
+
+			if err := s.ServiceParams.DiscountRepo.IncrementUsage(ctx, discount.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ReleaseDiscountCodes gives back one use of each code redeemed by a checkout that never got paid
+func (s *discountService) ReleaseDiscountCodes(ctx context.Context, codes []string) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		for _, code := range codes {
+			discount, err := s.ServiceParams.DiscountRepo.GetByCode(ctx, code)
+			if err != nil {
+				// deleted codes have nothing to give back
+				if ierr.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+
+			if err := s.ServiceParams.DiscountRepo.DecrementUsage(ctx, discount.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GenerateCodes creates single-use child codes for a campaign discount
+func (s *discountService) GenerateCodes(ctx context.Context, campaignID string, req *dto.GenerateDiscountCodesRequest) (*dto.GenerateDiscountCodesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	campaign, err := s.getCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := s.generateUniqueCodes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts := make([]*domainDiscount.Discount, len(codes))
+	for i, code := range codes {
+		discounts[i] = &domainDiscount.Discount{
+			ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_DISCOUNT),
+			Code:          code,
+			Description:   campaign.Description,
+			DiscountType:  campaign.DiscountType,
+			DiscountValue: campaign.DiscountValue,
+			ValidFrom:     campaign.ValidFrom,
+			ValidUntil:    campaign.ValidUntil,
+			IsActive:      true,
+			MaxUses:       lo.ToPtr(1),
+			MinOrderValue: campaign.MinOrderValue,
+			IsCombinable:  campaign.IsCombinable,
+			ParentID:      lo.ToPtr(campaign.ID),
+			Metadata:      types.Metadata{},
+			BaseModel:     types.GetDefaultBaseModel(ctx),
+		}
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		for _, chunk := range lo.Chunk(discounts, discountCodeBatchSize) {
+			if err := s.ServiceParams.DiscountRepo.CreateBulk(ctx, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GenerateDiscountCodesResponse{
+		CampaignID: campaign.ID,
+		Count:      len(codes),
+		Codes:      codes,
+	}, nil
+}
+
+// ExportCodes renders all codes of a campaign as CSV
+func (s *discountService) ExportCodes(ctx context.Context, campaignID string) ([]byte, error) {
+	campaign, err := s.getCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := types.NewNoLimitDiscountFilter()
+	filter.ParentID = campaign.ID
+	filter.Sort = lo.ToPtr("created_at")
+	filter.Order = lo.ToPtr(types.OrderAsc)
+
+	codes, err := s.ServiceParams.DiscountRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+	if err := writer.Write([]string{"code", "is_active", "used_count", "max_uses", "valid_until", "created_at"}); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to export discount codes").
+			Mark(ierr.ErrInternal)
+	}
+
+	for _, code := range codes {
+		validUntil := ""
+		if code.ValidUntil != nil {
+			validUntil = code.ValidUntil.Format(time.RFC3339)
+		}
+
+		row := []string{
+			code.Code,
+			strconv.FormatBool(code.IsActive),
+			strconv.Itoa(code.UsedCount),
+			strconv.Itoa(lo.FromPtr(code.MaxUses)),
+			validUntil,
+			code.CreatedAt.Format(time.RFC3339),
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to export discount codes").
+				Mark(ierr.ErrInternal)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to export discount codes").
+			Mark(ierr.ErrInternal)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DeactivateCodes deactivates the given codes of a campaign, or all of them if none are given
+func (s *discountService) DeactivateCodes(ctx context.Context, campaignID string, req *dto.DeactivateDiscountCodesRequest) (*dto.DeactivateDiscountCodesResponse, error) {
+	campaign, err := s.getCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	deactivated, err := s.ServiceParams.DiscountRepo.DeactivateByParent(ctx, campaign.ID, req.Codes)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("deactivated campaign discount codes",
+		"campaign_id", campaign.ID,
+		"deactivated", deactivated)
+
+	return &dto.DeactivateDiscountCodesResponse{
+		CampaignID:  campaign.ID,
+		Deactivated: deactivated,
+	}, nil
+}
+
+// GetCampaignStats returns usage stats rolled up across all codes of a campaign
+func (s *discountService) GetCampaignStats(ctx context.Context, campaignID string) (*dto.CampaignStatsResponse, error) {
+	campaign, err := s.getCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.ServiceParams.DiscountRepo.GetCampaignStats(ctx, campaign.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	redemptionRate := decimal.Zero
+	if stats.TotalCodes > 0 {
+		redemptionRate = decimal.NewFromInt(int64(stats.RedeemedCodes)).
+			Div(decimal.NewFromInt(int64(stats.TotalCodes))).
+			Mul(decimal.NewFromInt(100)).
+			Round(2)
+	}
+
+	return &dto.CampaignStatsResponse{
+		CampaignID:     campaign.ID,
+		CampaignStats:  *stats,
+		RedemptionRate: redemptionRate,
+	}, nil
+}
+
+func (s *discountService) getCampaign(ctx context.Context, id string) (*domainDiscount.Discount, error) {
+	campaign, err := s.ServiceParams.DiscountRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.IsCampaign {
+		return nil, ierr.NewError("discount is not a campaign").
+			WithHint("Codes can only be managed for campaign discounts").
+			WithReportableDetails(map[string]any{
+				"discount_id": id,
+			}).
+			Mark(ierr.ErrBadRequest)
+	}
+
+	return campaign, nil
+}
+
+// generateUniqueCodes generates codes that are unique within the request and not already taken
+func (s *discountService) generateUniqueCodes(ctx context.Context, req *dto.GenerateDiscountCodesRequest) ([]string, error) {
+	const maxAttempts = 5
+
+	charset := req.GetCharset()
+	length := req.GetCodeLength()
+	prefix := strings.ToUpper(req.Prefix)
+
+	seen := make(map[string]struct{}, req.Count)
+	codes := make([]string, 0, req.Count)
+
+	for attempt := 0; attempt < maxAttempts && len(codes) < req.Count; attempt++ {
+		candidates := make([]string, 0, req.Count-len(codes))
+		for len(candidates) < req.Count-len(codes) {
+			code, err := generateRandomCode(prefix, charset, length)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := seen[code]; ok {
+				continue
+			}
+			seen[code] = struct{}{}
+			candidates = append(candidates, code)
+		}
+
+		// Drop candidates that collide with existing codes
+		for _, chunk := range lo.Chunk(candidates, discountCodeBatchSize) {
+			filter := types.NewNoLimitDiscountFilter()
+			filter.Codes = chunk
+
+			existing, err := s.ServiceParams.DiscountRepo.ListAll(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+
+			taken := lo.SliceToMap(existing, func(d *domainDiscount.Discount) (string, struct{}) {
+				return d.Code, struct{}{}
+			})
+
+			for _, code := range chunk {
+				if _, ok := taken[code]; !ok {
+					codes = append(codes, code)
+				}
+			}
+		}
+	}
+
+	if len(codes) < req.Count {
+		return nil, ierr.NewError("failed to generate enough unique codes").
+			WithHint("Code space is exhausted, try a longer code length or a larger charset").
+			WithReportableDetails(map[string]any{
+				"requested": req.Count,
+				"generated": len(codes),
+			}).
+			Mark(ierr.ErrBadRequest)
+	}
+
+	return codes, nil
+}
+
+// generateRandomCode builds a random code from the charset using crypto/rand
+func generateRandomCode(prefix string, charset string, length int) (string, error) {
+	runes := []rune(charset)
+	max := big.NewInt(int64(len(runes)))
+
+	var sb strings.Builder
+	if prefix != "" {
+		sb.WriteString(prefix)
+		sb.WriteString("-")
+	}
+
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", ierr.WithError(err).
+				WithHint("Failed to generate discount code").
+				Mark(ierr.ErrInternal)
+		}
+		sb.WriteRune(runes[n.Int64()])
+	}
+
+	return sb.String(), nil
+}
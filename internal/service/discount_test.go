@@ -0,0 +1,184 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscountServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service    DiscountService
+	internship *domainInternship.Internship
+}
+
+func TestDiscountService(t *testing.T) {
+	suite.Run(t, new(DiscountServiceSuite))
+}
+
+func (s *DiscountServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	stores := s.GetStores()
+	s.service = NewDiscountService(ServiceParams{
+		Logger:       s.GetLogger(),
+		Config:       s.GetConfig(),
+		DB:           s.GetDB(),
+		DiscountRepo: stores.DiscountRepo,
+	})
+
+	s.internship = &domainInternship.Internship{
+		ID:    "internship",
+		Price: decimal.NewFromInt(1000),
+	}
+}
+
+func (s *DiscountServiceSuite) createDiscount(code string, maxUses *int, campaign bool) *domainDiscount.Discount {
+	discount := &domainDiscount.Discount{
+		ID:            s.GetUUID(),
+		Code:          code,
+		DiscountType:  types.DiscountTypePercentage,
+		DiscountValue: decimal.NewFromInt(10),
+		ValidFrom:     s.GetNow().Add(-time.Hour),
+		IsActive:      true,
+		MaxUses:       maxUses,
+		IsCampaign:    campaign,
+		Metadata:      types.Metadata{},
+		BaseModel:     types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().DiscountRepo.Create(s.GetContext(), discount))
+	return discount
+}
+
+func (s *DiscountServiceSuite) generateCodes(campaignID string, count int) []string {
+	response, err := s.service.GenerateCodes(s.GetContext(), campaignID, &dto.GenerateDiscountCodesRequest{
+		Count:  count,
+		Prefix: "summer",
+	})
+	s.Require().NoError(err)
+	return response.Codes
+}
+
+func (s *DiscountServiceSuite) usedCount(code string) int {
+	discount, err := s.GetStores().DiscountRepo.GetByCode(s.GetContext(), code)
+	s.Require().NoError(err)
+	return discount.UsedCount
+}
+
+func (s *DiscountServiceSuite) TestGeneratesUniqueSingleUseCodes() {
+	campaign := s.createDiscount("SUMMER", nil, true)
+
+	codes := s.generateCodes(campaign.ID, 20)
+
+	s.Len(codes, 20)
+	s.Len(lo.Uniq(codes), 20)
+	for _, code := range codes {
+		s.True(strings.HasPrefix(code, "SUMMER-"), code)
+
+		discount, err := s.GetStores().DiscountRepo.GetByCode(s.GetContext(), code)
+		s.Require().NoError(err)
+		s.Equal(1, lo.FromPtr(discount.MaxUses))
+		s.Equal(campaign.ID, lo.FromPtr(discount.ParentID))
+		s.True(discount.DiscountValue.Equal(campaign.DiscountValue))
+	}
+}
+
+func (s *DiscountServiceSuite) TestGeneratesCodesOnlyForCampaigns() {
+	discount := s.createDiscount("WELCOME", nil, false)
+
+	_, err := s.service.GenerateCodes(s.GetContext(), discount.ID, &dto.GenerateDiscountCodesRequest{Count: 1})
+	s.True(errors.Is(err, ierr.ErrBadRequest))
+}
+
+func (s *DiscountServiceSuite) TestRedeemsSingleUseCodeOnce() {
+	campaign := s.createDiscount("SUMMER", nil, true)
+	code := s.generateCodes(campaign.ID, 1)[0]
+
+	s.Require().NoError(s.service.ValidateDiscountCode(s.GetContext(), code, s.internship))
+	s.Require().NoError(s.service.RedeemDiscountCodes(s.GetContext(), []string{code}))
+	s.Equal(1, s.usedCount(code))
+
+	// used up, it no longer validates and a concurrent checkout can't redeem it again
+	s.True(errors.Is(s.service.ValidateDiscountCode(s.GetContext(), code, s.internship), ierr.ErrBadRequest))
+	s.True(errors.Is(s.service.RedeemDiscountCodes(s.GetContext(), []string{code}), ierr.ErrBadRequest))
+	s.Equal(1, s.usedCount(code))
+}
+
+func (s *DiscountServiceSuite) TestIncrementUsageStopsAtMaxUses() {
+	discount := s.createDiscount("TWICE", lo.ToPtr(2), false)
+	repo := s.GetStores().DiscountRepo
+
+	s.Require().NoError(repo.IncrementUsage(s.GetContext(), discount.ID))
+	s.Require().NoError(repo.IncrementUsage(s.GetContext(), discount.ID))
+	s.True(errors.Is(repo.IncrementUsage(s.GetContext(), discount.ID), ierr.ErrBadRequest))
+	s.Equal(2, s.usedCount("TWICE"))
+}
+
+func (s *DiscountServiceSuite) TestIncrementUsageWithoutMaxUses() {
+	discount := s.createDiscount("ALWAYS", nil, false)
+
+	for i := 0; i < 5; i++ {
+		s.Require().NoError(s.GetStores().DiscountRepo.IncrementUsage(s.GetContext(), discount.ID))
+	}
+	s.Equal(5, s.usedCount("ALWAYS"))
+}
+
+func (s *DiscountServiceSuite) TestReleaseGivesBackUse() {
+	campaign := s.createDiscount("SUMMER", nil, true)
+	code := s.generateCodes(campaign.ID, 1)[0]
+
+	s.Require().NoError(s.service.RedeemDiscountCodes(s.GetContext(), []string{code}))
+	s.Require().NoError(s.service.ReleaseDiscountCodes(s.GetContext(), []string{code}))
+	s.Equal(0, s.usedCount(code))
+
+	// releasing again never goes below zero, unknown codes are skipped
+	s.Require().NoError(s.service.ReleaseDiscountCodes(s.GetContext(), []string{code, "UNKNOWN"}))
+	s.Equal(0, s.usedCount(code))
+
+	s.Require().NoError(s.service.RedeemDiscountCodes(s.GetContext(), []string{code}))
+	s.Equal(1, s.usedCount(code))
+}
+
+func (s *DiscountServiceSuite) TestCodesStopWorkingWithTheirCampaign() {
+	campaign := s.createDiscount("SUMMER", nil, true)
+	codes := s.generateCodes(campaign.ID, 2)
+
+	campaign.IsActive = false
+	s.Require().NoError(s.GetStores().DiscountRepo.Update(s.GetContext(), campaign))
+	s.True(errors.Is(s.service.ValidateDiscountCode(s.GetContext(), codes[0], s.internship), ierr.ErrBadRequest))
+
+	campaign.IsActive = true
+	s.Require().NoError(s.GetStores().DiscountRepo.Update(s.GetContext(), campaign))
+	response, err := s.service.DeactivateCodes(s.GetContext(), campaign.ID, &dto.DeactivateDiscountCodesRequest{
+		Codes: codes[:1],
+	})
+	s.Require().NoError(err)
+	s.Equal(1, response.Deactivated)
+	s.True(errors.Is(s.service.ValidateDiscountCode(s.GetContext(), codes[0], s.internship), ierr.ErrBadRequest))
+	s.NoError(s.service.ValidateDiscountCode(s.GetContext(), codes[1], s.internship))
+}
+
+func (s *DiscountServiceSuite) TestCampaignStats() {
+	campaign := s.createDiscount("SUMMER", nil, true)
+	codes := s.generateCodes(campaign.ID, 4)
+
+	s.Require().NoError(s.service.RedeemDiscountCodes(s.GetContext(), codes[:1]))
+
+	stats, err := s.service.GetCampaignStats(s.GetContext(), campaign.ID)
+	s.Require().NoError(err)
+	s.Equal(4, stats.TotalCodes)
+	s.Equal(1, stats.RedeemedCodes)
+	s.Equal(1, stats.TotalRedemptions)
+	s.True(stats.RedemptionRate.Equal(decimal.NewFromInt(25)), stats.RedemptionRate.String())
+}
@@ -1,15 +1,32 @@
 package service
 
 import (
+	"github.com/omkar273/codegeeky/internal/auth"
+	renderer "github.com/omkar273/codegeeky/internal/certificate"
 	"github.com/omkar273/codegeeky/internal/config"
+	"github.com/omkar273/codegeeky/internal/domain/certificate"
+	"github.com/omkar273/codegeeky/internal/domain/content"
 	"github.com/omkar273/codegeeky/internal/domain/discount"
 	"github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipapplication"
 	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	domainNotification "github.com/omkar273/codegeeky/internal/domain/notification"
 	"github.com/omkar273/codegeeky/internal/domain/payment"
+	"github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	"github.com/omkar273/codegeeky/internal/domain/referral"
+	"github.com/omkar273/codegeeky/internal/domain/subscription"
 	"github.com/omkar273/codegeeky/internal/domain/user"
+	"github.com/omkar273/codegeeky/internal/domain/wallet"
+	"github.com/omkar273/codegeeky/internal/domain/wishlist"
+	"github.com/omkar273/codegeeky/internal/fileupload"
 	"github.com/omkar273/codegeeky/internal/httpclient"
 	"github.com/omkar273/codegeeky/internal/logger"
+	"github.com/omkar273/codegeeky/internal/notification"
+	gateway "github.com/omkar273/codegeeky/internal/payment"
 	"github.com/omkar273/codegeeky/internal/postgres"
+	"github.com/omkar273/codegeeky/internal/security"
 	"github.com/omkar273/codegeeky/internal/webhook/publisher"
 	"go.uber.org/fx"
 )
@@ -28,11 +45,46 @@ type ServiceParams struct {
 	PaymentRepo              payment.Repository
 	InternshipRepo           internship.InternshipRepository
 	InternshipBatchRepo      internship.InternshipBatchRepository
+	InternshipRevisionRepo   internship.InternshipRevisionRepository
+	InternshipInstructorRepo internship.InternshipInstructorRepository
 	CategoryRepo             internship.CategoryRepository
 	InternshipEnrollmentRepo internshipenrollment.Repository
+	ReferralRepo             referral.Repository
+	WalletRepo               wallet.Repository
+	PaymentPlanRepo          paymentplan.Repository
+	SubscriptionPlanRepo     subscription.PlanRepository
+	SubscriptionRepo         subscription.Repository
+	ModuleRepo               content.ModuleRepository
+	LessonRepo               content.LessonRepository
+	AssignmentRepo           content.AssignmentRepository
+	ResourceRepo             content.ResourceRepository
+	LessonProgressRepo       content.LessonProgressRepository
+	SubmissionRepo           content.SubmissionRepository
+	QuizRepo                 content.QuizRepository
+	QuizAttemptRepo          content.QuizAttemptRepository
+	CertificateRepo          certificate.Repository
+	LiveSessionRepo          livesession.Repository
+	SessionAttendanceRepo    livesession.AttendanceRepository
+	ApplicationRepo          internshipapplication.Repository
+	ReviewRepo               internshipreview.Repository
+	WishlistRepo             wishlist.Repository
+	NotificationRepo         domainNotification.Repository
+	FileUploadRepo           fileupload.Repository
 
 	// Service dependencies
+	AuthzService     auth.UnifiedAuthorizationService
 	WebhookPublisher publisher.WebhookPublisher
+	GatewayRegistry  gateway.GatewayRegistryService
+	SigningService   security.SigningService
+
+	// Certificate documents
+	FileUploadProvider  fileupload.Provider
+	CertificateRenderer renderer.Renderer
+
+	// Emails and in-app notifications
+	NotificationProvider  notification.Provider
+	NotificationTemplates notification.Templates
+	NotificationStream    notification.Stream
 
 	// http client
 	HTTPClient httpclient.Client
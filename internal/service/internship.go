@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
 	"github.com/samber/lo"
 )
 
@@ -15,6 +20,22 @@ type InternshipService interface {
 	Update(ctx context.Context, id string, req *dto.UpdateInternshipRequest) (*dto.InternshipResponse, error)
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.InternshipFilter) (*dto.ListInternshipResponse, error)
+	Search(ctx context.Context, filter *types.InternshipSearchFilter) (*dto.InternshipSearchResponse, error)
+
+	// Editorial workflow
+	Submit(ctx context.Context, id string) (*dto.InternshipResponse, error)
+	Approve(ctx context.Context, id string) (*dto.InternshipResponse, error)
+	Reject(ctx context.Context, id string, req *dto.RejectInternshipRequest) (*dto.InternshipResponse, error)
+	ListDrafts(ctx context.Context, filter *types.InternshipFilter) (*dto.ListInternshipResponse, error)
+
+	// ListMine lists the internships the caller authored or teaches, in any editorial state
+	ListMine(ctx context.Context, filter *types.InternshipFilter) (*dto.ListInternshipResponse, error)
+
+	// Change history
+	ListRevisions(ctx context.Context, id string, filter *types.InternshipRevisionFilter) (*dto.ListInternshipRevisionResponse, error)
+	GetRevision(ctx context.Context, id string, revisionID string) (*dto.InternshipRevisionResponse, error)
+	DiffRevisions(ctx context.Context, id string, req *dto.DiffInternshipRevisionsRequest) (*dto.InternshipRevisionDiffResponse, error)
+	RestoreRevision(ctx context.Context, id string, revisionID string) (*dto.InternshipResponse, error)
 }
 
 type internshipService struct {
@@ -37,7 +58,29 @@ func (s *internshipService) Create(ctx context.Context, req *dto.CreateInternshi
 
 	internship := req.ToInternship(ctx)
 
-	err := s.InternshipRepo.Create(ctx, internship)
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		revision, err := s.recordRevision(ctx, internship.ID, internship.Snapshot(), nil)
+		if err != nil {
+			return err
+		}
+		internship.CurrentRevisionID = lo.ToPtr(revision.ID)
+
+		if err := s.InternshipRepo.Create(ctx, internship); err != nil {
+			return err
+		}
+
+		// the author owns the internship until ownership is handed over
+		if internship.CreatedBy == "" {
+			return nil
+		}
+		return s.InternshipInstructorRepo.Create(ctx, &domainInternship.InternshipInstructor{
+			ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_INSTRUCTOR),
+			InternshipID: internship.ID,
+			UserID:       internship.CreatedBy,
+			Role:         types.InternshipInstructorRoleOwner,
+			BaseModel:    types.GetDefaultBaseModel(ctx),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +101,21 @@ func (s *internshipService) GetByID(ctx context.Context, id string) (*dto.Intern
 		return nil, ierr.ErrNotFound
 	}
 
+	// unpublished internships are only visible to their instructors and admins
+	canReview := s.canReview(ctx, internship)
+	if !internship.IsPublished() && !canReview {
+		return nil, ierr.NewError("internship not found").
+			WithHintf("Internship with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"internship_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	if !canReview {
+		hideEditorial(internship)
+	}
+
 	return &dto.InternshipResponse{
 		Internship: *internship,
 	}, nil
@@ -74,68 +132,145 @@ func (s *internshipService) Update(ctx context.Context, id string, req *dto.Upda
 		return nil, err
 	}
 
-	if req.Title != nil {
-		existingInternship.Title = lo.FromPtr(req.Title)
-	}
-	if req.Description != nil {
-		existingInternship.Description = lo.FromPtr(req.Description)
-	}
-	if req.Level != nil {
-		existingInternship.Level = lo.FromPtr(req.Level)
-	}
-	if req.Mode != nil {
-		existingInternship.Mode = lo.FromPtr(req.Mode)
-	}
-	if req.DurationInWeeks != nil {
-		existingInternship.DurationInWeeks = lo.FromPtr(req.DurationInWeeks)
-	}
-	if req.Currency != nil {
-		existingInternship.Currency = lo.FromPtr(req.Currency)
-	}
-	if req.Price != nil {
-		existingInternship.Price = lo.FromPtr(req.Price)
-	}
-	if req.FlatDiscount != nil {
-		existingInternship.FlatDiscount = req.FlatDiscount
+	if err := authorizeInternship(ctx, s.ServiceParams, existingInternship, domainAuth.PermissionUpdateInternship); err != nil {
+		return nil, err
 	}
-	if req.PercentageDiscount != nil {
-		existingInternship.PercentageDiscount = req.PercentageDiscount
+
+	if err := s.applyChange(ctx, existingInternship, req.ApplyTo, nil); err != nil {
+		return nil, err
 	}
-	if req.Skills != nil {
-		existingInternship.Skills = req.Skills
+
+	return &dto.InternshipResponse{
+		Internship: *existingInternship,
+	}, nil
+}
+
+// applyChange edits the content of an internship and records the result as a new revision,
+// a published internship keeps its live content and collects the change in its pending revision
+func (s *internshipService) applyChange(
+	ctx context.Context,
+	internship *domainInternship.Internship,
+	change func(snapshot *types.InternshipSnapshot),
+	restoredFromID *string,
+) error {
+	var snapshot *types.InternshipSnapshot
+	switch {
+	case internship.IsPublished():
+		if lo.FromPtr(internship.DraftStatus) == types.InternshipPublishStatusInReview {
+			return ierr.NewError("internship revision is under review").
+				WithHint("Changes can't be made while the pending revision is under review").
+				WithReportableDetails(map[string]any{
+					"internship_id": internship.ID,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		snapshot = internship.Draft
+		if snapshot == nil {
+			snapshot = internship.Snapshot()
+		}
+		change(snapshot)
+
+		internship.Draft = snapshot
+		internship.DraftStatus = lo.ToPtr(types.InternshipPublishStatusDraft)
+	case internship.PublishStatus.IsEditable():
+		snapshot = internship.Snapshot()
+		change(snapshot)
+
+		internship.ApplySnapshot(snapshot)
+		internship.PublishStatus = types.InternshipPublishStatusDraft
+	default:
+		return ierr.NewError("internship is under review").
+			WithHint("Changes can't be made while the internship is under review").
+			WithReportableDetails(map[string]any{
+				"internship_id":  internship.ID,
+				"publish_status": internship.PublishStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
 	}
-	if req.LearningOutcomes != nil {
-		existingInternship.LearningOutcomes = req.LearningOutcomes
+
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		// internships created before versioning get their original content recorded first
+		if internship.CurrentRevisionID == nil {
+			existing, err := s.InternshipRepo.Get(ctx, internship.ID)
+			if err != nil {
+				return err
+			}
+
+			baseline, err := s.recordRevision(ctx, internship.ID, existing.Snapshot(), nil)
+			if err != nil {
+				return err
+			}
+			internship.CurrentRevisionID = lo.ToPtr(baseline.ID)
+		}
+
+		revision, err := s.recordRevision(ctx, internship.ID, snapshot, restoredFromID)
+		if err != nil {
+			return err
+		}
+
+		if !internship.IsPublished() {
+			internship.CurrentRevisionID = lo.ToPtr(revision.ID)
+		}
+
+		return s.InternshipRepo.Update(ctx, internship)
+	})
+}
+
+// recordRevision writes the next revision of an internship
+func (s *internshipService) recordRevision(
+	ctx context.Context,
+	internshipID string,
+	snapshot *types.InternshipSnapshot,
+	restoredFromID *string,
+) (*domainInternship.InternshipRevision, error) {
+	number := 1
+	latest, err := s.InternshipRevisionRepo.GetLatest(ctx, internshipID)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
 	}
-	if req.Prerequisites != nil {
-		existingInternship.Prerequisites = req.Prerequisites
+	if latest != nil {
+		number = latest.Revision + 1
 	}
-	if req.Benefits != nil {
-		existingInternship.Benefits = req.Benefits
+
+	revision := &domainInternship.InternshipRevision{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_REVISION),
+		InternshipID:   internshipID,
+		Revision:       number,
+		Snapshot:       snapshot,
+		RestoredFromID: restoredFromID,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
 	}
 
-	err = s.InternshipRepo.Update(ctx, existingInternship)
-	if err != nil {
+	if err := s.InternshipRevisionRepo.Create(ctx, revision); err != nil {
 		return nil, err
 	}
 
-	return &dto.InternshipResponse{
-		Internship: *existingInternship,
-	}, nil
+	return revision, nil
 }
 
 func (s *internshipService) Delete(ctx context.Context, id string) error {
 
-	_, err := s.InternshipRepo.Get(ctx, id)
+	internship, err := s.InternshipRepo.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionDeleteInternship); err != nil {
+		return err
+	}
+
 	err = s.InternshipRepo.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if internship.IsPublished() {
+		if err := NewCategoryService(s.ServiceParams).RefreshInternshipCounts(ctx); err != nil {
+			s.Logger.Errorw("failed to refresh category internship counts", "error", err, "internship_id", internship.ID)
+		}
+	}
+
 	return nil
 }
 
@@ -149,24 +284,547 @@ func (s *internshipService) List(ctx context.Context, filter *types.InternshipFi
 		return nil, err
 	}
 
-	count, err := s.InternshipRepo.Count(ctx, filter)
+	// the catalog only lists published internships, admins can browse everything
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.PublishStatuses = []types.InternshipPublishStatus{types.InternshipPublishStatusPublished}
+	}
+
+	return s.list(ctx, filter)
+}
+
+func (s *internshipService) list(ctx context.Context, filter *types.InternshipFilter) (*dto.ListInternshipResponse, error) {
+	internships, err := s.InternshipRepo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	internships, err := s.InternshipRepo.List(ctx, filter)
+	pagination, err := paginate(ctx, filter, internships, s.InternshipRepo.Count)
 	if err != nil {
 		return nil, err
 	}
 
 	response := &dto.ListInternshipResponse{
-		Items:      make([]*dto.InternshipResponse, count),
-		Pagination: types.NewPaginationResponse(count, filter.GetLimit(), filter.GetOffset()),
+		Items:      make([]*dto.InternshipResponse, len(internships)),
+		Pagination: pagination,
 	}
 
+	reviewable := s.reviewable(ctx, internships...)
 	for i, internship := range internships {
+		if !reviewable[internship.ID] {
+			hideEditorial(internship)
+		}
 		response.Items[i] = &dto.InternshipResponse{Internship: *internship}
 	}
 
 	return response, nil
 }
+
+func (s *internshipService) Search(ctx context.Context, filter *types.InternshipSearchFilter) (*dto.InternshipSearchResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipSearchFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// search serves the public catalog
+	filter.PublishStatuses = []types.InternshipPublishStatus{types.InternshipPublishStatusPublished}
+
+	internships, err := s.InternshipRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Results are ranked by relevance, which has no stable key to hand out cursors for
+	pagination := types.NewPaginationResponse(0, filter.GetLimit(), filter.GetOffset())
+	pagination.TotalSkipped = filter.IsCountSkipped()
+	if !pagination.TotalSkipped {
+		pagination.Total, err = s.InternshipRepo.SearchCount(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	facets, err := s.InternshipRepo.SearchFacets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.InternshipSearchResponse{
+		Items:      make([]*dto.InternshipResponse, len(internships)),
+		Pagination: pagination,
+		Facets:     facets,
+	}
+
+	for i, internship := range internships {
+		hideEditorial(internship)
+		response.Items[i] = &dto.InternshipResponse{Internship: *internship}
+	}
+
+	return response, nil
+}
+
+func (s *internshipService) ListDrafts(ctx context.Context, filter *types.InternshipFilter) (*dto.ListInternshipResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// instructors only see their own work, admins see the whole review queue
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.InstructorID = types.GetUserID(ctx)
+	}
+
+	if len(filter.EditorialStatuses) == 0 {
+		filter.EditorialStatuses = []types.InternshipPublishStatus{
+			types.InternshipPublishStatusDraft,
+			types.InternshipPublishStatusInReview,
+			types.InternshipPublishStatusRejected,
+		}
+	}
+
+	return s.list(ctx, filter)
+}
+
+func (s *internshipService) ListMine(ctx context.Context, filter *types.InternshipFilter) (*dto.ListInternshipResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter.InstructorID = types.GetUserID(ctx)
+
+	return s.list(ctx, filter)
+}
+
+func (s *internshipService) Submit(ctx context.Context, id string) (*dto.InternshipResponse, error) {
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, ierr.NewError("not allowed to submit internship").
+			WithHint("Only instructors of the internship can submit it for review").
+			WithReportableDetails(map[string]any{
+				"internship_id": id,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	revision := internship.IsPublished()
+	if revision {
+		if internship.Draft == nil || !lo.FromPtr(internship.DraftStatus).IsEditable() {
+			return nil, ierr.NewError("no pending changes to submit").
+				WithHint("Only a pending draft or rejected revision can be submitted for review").
+				WithReportableDetails(map[string]any{
+					"internship_id": id,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+		internship.DraftStatus = lo.ToPtr(types.InternshipPublishStatusInReview)
+	} else {
+		if !internship.PublishStatus.IsEditable() {
+			return nil, ierr.NewError("internship is already under review").
+				WithHint("Only draft or rejected internships can be submitted for review").
+				WithReportableDetails(map[string]any{
+					"internship_id":  id,
+					"publish_status": internship.PublishStatus,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+		internship.PublishStatus = types.InternshipPublishStatusInReview
+	}
+
+	internship.SubmittedAt = lo.ToPtr(time.Now().UTC())
+	if err := s.InternshipRepo.Update(ctx, internship); err != nil {
+		return nil, err
+	}
+
+	s.publishReviewEvent(ctx, types.WebhookEventInternshipSubmitted, internship, revision)
+
+	return &dto.InternshipResponse{Internship: *internship}, nil
+}
+
+func (s *internshipService) Approve(ctx context.Context, id string) (*dto.InternshipResponse, error) {
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	revision := internship.IsPublished()
+	// live content before the approval, wishlists are told when the approved revision lowers the price
+	previous := internship.Snapshot()
+	switch {
+	case revision && lo.FromPtr(internship.DraftStatus) == types.InternshipPublishStatusInReview:
+		// every change to the pending revision is recorded, so the latest revision is the one approved
+		latest, err := s.InternshipRevisionRepo.GetLatest(ctx, internship.ID)
+		if err != nil && !ierr.IsNotFound(err) {
+			return nil, err
+		}
+		if latest != nil {
+			internship.CurrentRevisionID = lo.ToPtr(latest.ID)
+		}
+
+		internship.ApplySnapshot(internship.Draft)
+		internship.Draft = nil
+		internship.DraftStatus = nil
+	case internship.PublishStatus == types.InternshipPublishStatusInReview:
+		internship.PublishStatus = types.InternshipPublishStatusPublished
+		internship.PublishedAt = &now
+	default:
+		return nil, errNotUnderReview(internship)
+	}
+
+	internship.ReviewedAt = &now
+	internship.ReviewedBy = lo.ToPtr(types.GetUserID(ctx))
+	internship.ReviewComment = nil
+
+	if err := s.InternshipRepo.Update(ctx, internship); err != nil {
+		return nil, err
+	}
+
+	// a first publish adds the internship to its categories' counts
+	if !revision {
+		if err := NewCategoryService(s.ServiceParams).RefreshInternshipCounts(ctx); err != nil {
+			s.Logger.Errorw("failed to refresh category internship counts", "error", err, "internship_id", internship.ID)
+		}
+	}
+
+	if revision {
+		if err := NewWishlistService(s.ServiceParams).NotifyPriceChange(ctx, previous, internship); err != nil {
+			s.Logger.Errorw("failed to notify wishlists of price change", "error", err, "internship_id", internship.ID)
+		}
+	}
+
+	s.publishReviewEvent(ctx, types.WebhookEventInternshipPublished, internship, revision)
+
+	return &dto.InternshipResponse{Internship: *internship}, nil
+}
+
+func (s *internshipService) Reject(ctx context.Context, id string, req *dto.RejectInternshipRequest) (*dto.InternshipResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := internship.IsPublished()
+	switch {
+	case revision && lo.FromPtr(internship.DraftStatus) == types.InternshipPublishStatusInReview:
+		internship.DraftStatus = lo.ToPtr(types.InternshipPublishStatusRejected)
+	case internship.PublishStatus == types.InternshipPublishStatusInReview:
+		internship.PublishStatus = types.InternshipPublishStatusRejected
+	default:
+		return nil, errNotUnderReview(internship)
+	}
+
+	internship.ReviewedAt = lo.ToPtr(time.Now().UTC())
+	internship.ReviewedBy = lo.ToPtr(types.GetUserID(ctx))
+	internship.ReviewComment = lo.ToPtr(req.Comment)
+
+	if err := s.InternshipRepo.Update(ctx, internship); err != nil {
+		return nil, err
+	}
+
+	s.publishReviewEvent(ctx, types.WebhookEventInternshipRejected, internship, revision)
+
+	return &dto.InternshipResponse{Internship: *internship}, nil
+}
+
+// canReview reports whether the caller may see the editorial state of the internship
+func (s *internshipService) canReview(ctx context.Context, internship *domainInternship.Internship) bool {
+	return s.reviewable(ctx, internship)[internship.ID]
+}
+
+// reviewable returns the ids of the internships whose editorial state the caller may see,
+// admins see every internship and instructors the ones they authored or are assigned to
+func (s *internshipService) reviewable(ctx context.Context, internships ...*domainInternship.Internship) map[string]bool {
+	reviewable := make(map[string]bool, len(internships))
+
+	userID := types.GetUserID(ctx)
+	if userID == "" {
+		return reviewable
+	}
+
+	isAdmin := types.GetUserRole(ctx) == types.UserRoleAdmin
+	unassigned := make([]string, 0, len(internships))
+	for _, internship := range internships {
+		if isAdmin || internship.CreatedBy == userID {
+			reviewable[internship.ID] = true
+			continue
+		}
+		unassigned = append(unassigned, internship.ID)
+	}
+
+	if len(unassigned) == 0 {
+		return reviewable
+	}
+
+	filter := types.NewNoLimitInternshipInstructorFilter()
+	filter.InternshipIDs = unassigned
+	filter.UserIDs = []string{userID}
+
+	instructors, err := s.InternshipInstructorRepo.ListAll(ctx, filter)
+	if err != nil {
+		s.Logger.Errorw("failed to list internship instructors", "error", err, "user_id", userID)
+		return reviewable
+	}
+
+	for _, instructor := range instructors {
+		reviewable[instructor.InternshipID] = true
+	}
+
+	return reviewable
+}
+
+// hideEditorial strips pending revisions and review notes before showing an internship publicly
+func hideEditorial(internship *domainInternship.Internship) {
+	internship.Draft = nil
+	internship.DraftStatus = nil
+	internship.ReviewComment = nil
+	internship.ReviewedBy = nil
+}
+
+func errNotUnderReview(internship *domainInternship.Internship) error {
+	return ierr.NewError("internship is not under review").
+		WithHint("Only internships or revisions submitted for review can be approved or rejected").
+		WithReportableDetails(map[string]any{
+			"internship_id":  internship.ID,
+			"publish_status": internship.PublishStatus,
+			"draft_status":   internship.DraftStatus,
+		}).
+		Mark(ierr.ErrInvalidOperation)
+}
+
+func (s *internshipService) publishReviewEvent(ctx context.Context, eventName string, internship *domainInternship.Internship, revision bool) {
+	payload, err := json.Marshal(&webhookDto.InternshipReviewWebhookPayload{
+		InternshipID:  internship.ID,
+		Title:         internship.Title,
+		PublishStatus: internship.PublishStatus,
+		Revision:      revision,
+		ReviewComment: internship.ReviewComment,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build internship review event",
+			"internship_id", internship.ID,
+			"event_name", eventName,
+			"error", err)
+		return
+	}
+
+	err = s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		UserID:    lo.ToPtr(internship.CreatedBy),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to publish internship review event",
+			"internship_id", internship.ID,
+			"event_name", eventName,
+			"error", err)
+	}
+}
+
+func (s *internshipService) ListRevisions(ctx context.Context, id string, filter *types.InternshipRevisionFilter) (*dto.ListInternshipRevisionResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipRevisionFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, errRevisionAccessDenied(internship.ID)
+	}
+
+	filter.InternshipID = internship.ID
+
+	revisions, err := s.InternshipRevisionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, revisions, s.InternshipRevisionRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListInternshipRevisionResponse{
+		Items:      make([]*dto.InternshipRevisionResponse, len(revisions)),
+		Pagination: pagination,
+	}
+
+	for i, revision := range revisions {
+		response.Items[i] = &dto.InternshipRevisionResponse{InternshipRevision: *revision}
+	}
+
+	return response, nil
+}
+
+func (s *internshipService) GetRevision(ctx context.Context, id string, revisionID string) (*dto.InternshipRevisionResponse, error) {
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.getRevision(ctx, internship.ID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// students can read the revision they enrolled under
+	if !s.canReview(ctx, internship) {
+		enrolled, err := s.enrolledUnder(ctx, revision)
+		if err != nil {
+			return nil, err
+		}
+		if !enrolled {
+			return nil, errRevisionAccessDenied(internship.ID)
+		}
+	}
+
+	return &dto.InternshipRevisionResponse{InternshipRevision: *revision}, nil
+}
+
+func (s *internshipService) DiffRevisions(ctx context.Context, id string, req *dto.DiffInternshipRevisionsRequest) (*dto.InternshipRevisionDiffResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, errRevisionAccessDenied(internship.ID)
+	}
+
+	from, err := s.getRevision(ctx, internship.ID, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.getRevision(ctx, internship.ID, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := from.Snapshot.Diff(to.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.InternshipRevisionDiffResponse{
+		InternshipID:   internship.ID,
+		FromRevisionID: from.ID,
+		FromRevision:   from.Revision,
+		ToRevisionID:   to.ID,
+		ToRevision:     to.Revision,
+		Changes:        changes,
+	}, nil
+}
+
+func (s *internshipService) RestoreRevision(ctx context.Context, id string, revisionID string) (*dto.InternshipResponse, error) {
+	internship, err := s.InternshipRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, errRevisionAccessDenied(internship.ID)
+	}
+
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.getRevision(ctx, internship.ID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// restoring is an edit like any other, it goes through review for published internships
+	restore := func(snapshot *types.InternshipSnapshot) {
+		*snapshot = *revision.Snapshot
+	}
+	if err := s.applyChange(ctx, internship, restore, lo.ToPtr(revision.ID)); err != nil {
+		return nil, err
+	}
+
+	return &dto.InternshipResponse{Internship: *internship}, nil
+}
+
+// getRevision loads a revision and checks that it belongs to the internship
+func (s *internshipService) getRevision(ctx context.Context, internshipID string, revisionID string) (*domainInternship.InternshipRevision, error) {
+	revision, err := s.InternshipRevisionRepo.Get(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if revision.InternshipID != internshipID || revision.Snapshot == nil {
+		return nil, ierr.NewError("internship revision not found").
+			WithHintf("Internship revision with ID %s was not found", revisionID).
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+				"revision_id":   revisionID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return revision, nil
+}
+
+// enrolledUnder reports whether the caller holds an enrollment recorded against the revision
+func (s *internshipService) enrolledUnder(ctx context.Context, revision *domainInternship.InternshipRevision) (bool, error) {
+	userID := types.GetUserID(ctx)
+	if userID == "" {
+		return false, nil
+	}
+
+	filter := types.NewNoLimitInternshipEnrollmentFilter()
+	filter.UserID = userID
+	filter.InternshipIDs = []string{revision.InternshipID}
+
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	for _, enrollment := range enrollments {
+		if lo.FromPtr(enrollment.InternshipRevisionID) == revision.ID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func errRevisionAccessDenied(internshipID string) error {
+	return ierr.NewError("not allowed to view internship revisions").
+		WithHint("Only instructors of the internship can view its change history").
+		WithReportableDetails(map[string]any{
+			"internship_id": internshipID,
+		}).
+		Mark(ierr.ErrPermissionDenied)
+}
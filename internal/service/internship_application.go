@@ -0,0 +1,432 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipapplication"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+type InternshipApplicationService interface {
+	// UpdateForm turns screening of an internship on or off and sets its application form,
+	// only owners and admins can do this
+	UpdateForm(ctx context.Context, internshipID string, req *dto.UpdateApplicationFormRequest) (*dto.ApplicationFormResponse, error)
+
+	// Submit applies the current student to a batch of a selective internship
+	Submit(ctx context.Context, req *dto.SubmitApplicationRequest) (*dto.ApplicationResponse, error)
+
+	// Get returns an application to the applicant and instructors of the internship
+	Get(ctx context.Context, id string) (*dto.ApplicationResponse, error)
+
+	// List returns the applications to an internship, for its instructors
+	List(ctx context.Context, internshipID string, filter *types.InternshipApplicationFilter) (*dto.ListApplicationResponse, error)
+
+	// ListMine returns the applications of the current student
+	ListMine(ctx context.Context, filter *types.InternshipApplicationFilter) (*dto.ListApplicationResponse, error)
+
+	Shortlist(ctx context.Context, id string, req *dto.ReviewApplicationRequest) (*dto.ApplicationResponse, error)
+
+	// ScheduleInterview invites the applicant to an interview or moves an interview already scheduled
+	ScheduleInterview(ctx context.Context, id string, req *dto.ScheduleInterviewRequest) (*dto.ApplicationResponse, error)
+
+	// Accept lets the applicant enroll in the batch until the offer expires
+	Accept(ctx context.Context, id string, req *dto.ReviewApplicationRequest) (*dto.ApplicationResponse, error)
+
+	Reject(ctx context.Context, id string, req *dto.ReviewApplicationRequest) (*dto.ApplicationResponse, error)
+
+	// Withdraw takes back an application of the current student, they may apply again
+	Withdraw(ctx context.Context, id string) (*dto.ApplicationResponse, error)
+}
+
+type internshipApplicationService struct {
+	ServiceParams
+}
+
+func NewInternshipApplicationService(params ServiceParams) InternshipApplicationService {
+	return &internshipApplicationService{
+		ServiceParams: params,
+	}
+}
+
+func (s *internshipApplicationService) UpdateForm(ctx context.Context, internshipID string, req *dto.UpdateApplicationFormRequest) (*dto.ApplicationFormResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship); err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(internship)
+
+	if err := s.InternshipRepo.Update(ctx, internship); err != nil {
+		return nil, err
+	}
+
+	return dto.NewApplicationFormResponse(internship), nil
+}
+
+func (s *internshipApplicationService) Submit(ctx context.Context, req *dto.SubmitApplicationRequest) (*dto.ApplicationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, req.InternshipBatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, batch.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !internship.IsPublished() || !internship.ApplicationRequired {
+		return nil, ierr.NewError("internship does not take applications").
+			WithHint("This internship doesn't take applications, enroll in it directly").
+			WithReportableDetails(map[string]any{
+				"internship_id":        internship.ID,
+				"application_required": internship.ApplicationRequired,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// applications close with enrollment, accepted applicants have to be able to enroll
+	if !batch.IsEnrollmentOpen(time.Now().UTC(), s.Config.Batch.EnrollmentCutoff) {
+		return nil, ierr.NewError("enrollment is closed").
+			WithHint("This batch is no longer accepting applications").
+			WithReportableDetails(map[string]any{
+				"batch_id":     batch.ID,
+				"batch_status": batch.BatchStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	form := lo.FromPtr(internship.ApplicationForm)
+	if err := form.ValidateAnswers(req.Answers); err != nil {
+		return nil, err
+	}
+
+	application := req.ToApplication(ctx, batch)
+	if application.ResumeFileID == nil && form.ResumeRequired {
+		return nil, ierr.NewError("resume is required").
+			WithHint("Upload your resume with the application").
+			Mark(ierr.ErrValidation)
+	}
+
+	if application.ResumeFileID != nil {
+		if err := (&contentService{ServiceParams: s.ServiceParams}).validateFile(ctx, lo.FromPtr(application.ResumeFileID)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.ApplicationRepo.Create(ctx, application); err != nil {
+		return nil, err
+	}
+
+	s.publishApplicationEvent(ctx, types.WebhookEventApplicationSubmitted, application)
+
+	return &dto.ApplicationResponse{InternshipApplication: *application}, nil
+}
+
+func (s *internshipApplicationService) Get(ctx context.Context, id string) (*dto.ApplicationResponse, error) {
+	application, err := s.ApplicationRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.UserID != types.GetUserID(ctx) {
+		if _, err := s.getReviewedInternship(ctx, application); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.ApplicationResponse{InternshipApplication: *application}, nil
+}
+
+func (s *internshipApplicationService) List(ctx context.Context, internshipID string, filter *types.InternshipApplicationFilter) (*dto.ListApplicationResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipApplicationFilter()
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, ierr.NewError("not an instructor of the internship").
+			WithHint("Only instructors of the internship and admins can see its applications").
+			WithReportableDetails(map[string]any{
+				"internship_id": internship.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	filter.InternshipIDs = []string{internship.ID}
+	return s.list(ctx, filter)
+}
+
+func (s *internshipApplicationService) ListMine(ctx context.Context, filter *types.InternshipApplicationFilter) (*dto.ListApplicationResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipApplicationFilter()
+	}
+
+	filter.UserIDs = []string{types.GetUserID(ctx)}
+	return s.list(ctx, filter)
+}
+
+func (s *internshipApplicationService) Shortlist(ctx context.Context, id string, req *dto.ReviewApplicationRequest) (*dto.ApplicationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, id, types.WebhookEventApplicationShortlisted,
+		[]types.ApplicationStatus{types.ApplicationStatusSubmitted},
+		func(application *internshipapplication.InternshipApplication) {
+			application.ApplicationStatus = types.ApplicationStatusShortlisted
+			application.ReviewerNote = req.Note
+		})
+}
+
+func (s *internshipApplicationService) ScheduleInterview(ctx context.Context, id string, req *dto.ScheduleInterviewRequest) (*dto.ApplicationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, id, types.WebhookEventApplicationInterviewScheduled,
+		types.ApplicationStatusesInScreening,
+		func(application *internshipapplication.InternshipApplication) {
+			application.ApplicationStatus = types.ApplicationStatusInterview
+			application.InterviewAt = lo.ToPtr(req.InterviewAt.UTC())
+			application.ReviewerNote = req.Note
+		})
+}
+
+func (s *internshipApplicationService) Accept(ctx context.Context, id string, req *dto.ReviewApplicationRequest) (*dto.ApplicationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, id, types.WebhookEventApplicationAccepted,
+		types.ApplicationStatusesInScreening,
+		func(application *internshipapplication.InternshipApplication) {
+			application.ApplicationStatus = types.ApplicationStatusAccepted
+			application.OfferExpiresAt = lo.ToPtr(time.Now().UTC().Add(s.Config.Application.OfferValidity))
+			application.ReviewerNote = req.Note
+		})
+}
+
+func (s *internshipApplicationService) Reject(ctx context.Context, id string, req *dto.ReviewApplicationRequest) (*dto.ApplicationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, id, types.WebhookEventApplicationRejected,
+		types.ApplicationStatusesInScreening,
+		func(application *internshipapplication.InternshipApplication) {
+			application.ApplicationStatus = types.ApplicationStatusRejected
+			application.ReviewerNote = req.Note
+		})
+}
+
+func (s *internshipApplicationService) Withdraw(ctx context.Context, id string) (*dto.ApplicationResponse, error) {
+	application, err := s.ApplicationRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("not the applicant").
+			WithHint("Only the applicant can withdraw an application").
+			WithReportableDetails(map[string]any{
+				"application_id": application.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	if !application.InScreening() && application.ApplicationStatus != types.ApplicationStatusAccepted {
+		return nil, ierr.NewError("application can't be withdrawn").
+			WithHintf("A %s application can't be withdrawn", application.ApplicationStatus).
+			WithReportableDetails(map[string]any{
+				"application_id":     application.ID,
+				"application_status": application.ApplicationStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	application.ApplicationStatus = types.ApplicationStatusWithdrawn
+
+	if err := s.ApplicationRepo.Update(ctx, application); err != nil {
+		return nil, err
+	}
+
+	s.publishApplicationEvent(ctx, types.WebhookEventApplicationWithdrawn, application)
+
+	return &dto.ApplicationResponse{InternshipApplication: *application}, nil
+}
+
+func (s *internshipApplicationService) list(ctx context.Context, filter *types.InternshipApplicationFilter) (*dto.ListApplicationResponse, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	applications, err := s.ApplicationRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, applications, s.ApplicationRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListApplicationResponse{
+		Items: lo.Map(applications, func(application *internshipapplication.InternshipApplication, _ int) *dto.ApplicationResponse {
+			return &dto.ApplicationResponse{InternshipApplication: *application}
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+// review moves an application in one of the from statuses to its next stage and tells the applicant
+func (s *internshipApplicationService) review(
+	ctx context.Context,
+	id string,
+	eventName string,
+	from []types.ApplicationStatus,
+	apply func(application *internshipapplication.InternshipApplication),
+) (*dto.ApplicationResponse, error) {
+	application, err := s.ApplicationRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getReviewedInternship(ctx, application); err != nil {
+		return nil, err
+	}
+
+	if !lo.Contains(from, application.ApplicationStatus) {
+		return nil, ierr.NewError("application can't move to this stage").
+			WithHintf("A %s application can't be moved to this stage", application.ApplicationStatus).
+			WithReportableDetails(map[string]any{
+				"application_id":     application.ID,
+				"application_status": application.ApplicationStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	apply(application)
+	application.ReviewedBy = lo.ToPtr(types.GetUserID(ctx))
+	application.ReviewedAt = lo.ToPtr(time.Now().UTC())
+
+	if err := s.ApplicationRepo.Update(ctx, application); err != nil {
+		return nil, err
+	}
+
+	s.publishApplicationEvent(ctx, eventName, application)
+
+	return &dto.ApplicationResponse{InternshipApplication: *application}, nil
+}
+
+// getReviewedInternship returns the internship of the application when the caller screens its applicants
+func (s *internshipApplicationService) getReviewedInternship(ctx context.Context, application *internshipapplication.InternshipApplication) (*domainInternship.Internship, error) {
+	internship, err := s.InternshipRepo.Get(ctx, application.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, ierr.NewError("not an instructor of the internship").
+			WithHint("Only the applicant, instructors of the internship and admins can do this").
+			WithReportableDetails(map[string]any{
+				"application_id": application.ID,
+				"internship_id":  internship.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return internship, nil
+}
+
+// canReview reports whether the caller screens applicants of the internship, every instructor does
+func (s *internshipApplicationService) canReview(ctx context.Context, internship *domainInternship.Internship) bool {
+	return (&internshipService{ServiceParams: s.ServiceParams}).canReview(ctx, internship)
+}
+
+func (s *internshipApplicationService) publishApplicationEvent(ctx context.Context, eventName string, application *internshipapplication.InternshipApplication) {
+	payload, err := json.Marshal(&webhookDto.ApplicationWebhookPayload{
+		ApplicationID:     application.ID,
+		InternshipID:      application.InternshipID,
+		InternshipBatchID: application.InternshipBatchID,
+		ApplicationStatus: string(application.ApplicationStatus),
+		InterviewAt:       application.InterviewAt,
+		OfferExpiresAt:    application.OfferExpiresAt,
+		ReviewerNote:      application.ReviewerNote,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build application event",
+			"application_id", application.ID,
+			"event_name", eventName,
+			"error", err)
+		return
+	}
+
+	err = s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		UserID:    lo.ToPtr(application.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to publish application event",
+			"application_id", application.ID,
+			"event_name", eventName,
+			"error", err)
+	}
+}
+
+// checkAcceptedApplication makes sure students of selective internships were accepted to the
+// batch and still hold their offer before they enroll
+func checkAcceptedApplication(ctx context.Context, params ServiceParams, internship *domainInternship.Internship, batchID string) error {
+	if !internship.ApplicationRequired {
+		return nil
+	}
+
+	application, err := params.ApplicationRepo.GetActive(ctx, batchID, types.GetUserID(ctx))
+	if err != nil && !ierr.IsNotFound(err) {
+		return err
+	}
+
+	if application == nil || !application.CanEnroll(time.Now().UTC()) {
+		details := map[string]any{
+			"internship_id": internship.ID,
+			"batch_id":      batchID,
+		}
+		if application != nil {
+			details["application_id"] = application.ID
+			details["application_status"] = application.ApplicationStatus
+		}
+
+		return ierr.NewError("application not accepted").
+			WithHint("This internship is selective, apply to the batch and enroll once your application is accepted").
+			WithReportableDetails(details).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return nil
+}
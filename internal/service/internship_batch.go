@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
 	"github.com/samber/lo"
 )
 
@@ -14,6 +20,23 @@ type InternshipBatchService interface {
 	Update(ctx context.Context, id string, req *dto.UpdateInternshipBatchRequest) (*dto.InternshipBatchResponse, error)
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter *types.InternshipBatchFilter) (*dto.ListInternshipBatchResponse, error)
+
+	// ListMine lists the batches of the internships the caller authored or teaches
+	ListMine(ctx context.Context, filter *types.InternshipBatchFilter) (*dto.ListInternshipBatchResponse, error)
+
+	// Pause holds an upcoming or ongoing batch in place until it is resumed
+	Pause(ctx context.Context, id string) (*dto.InternshipBatchResponse, error)
+
+	// Resume puts a paused batch back on the lifecycle its dates dictate
+	Resume(ctx context.Context, id string) (*dto.InternshipBatchResponse, error)
+
+	// Cancel cancels a batch and refunds every enrollment of it.
+	// Cancelling a cancelled batch again retries the refunds that failed.
+	Cancel(ctx context.Context, id string, req *dto.CancelInternshipBatchRequest) (*dto.CancelInternshipBatchResponse, error)
+
+	// ProcessLifecycle starts, closes enrollment for and completes batches as their dates pass.
+	// It returns the number of batches that changed.
+	ProcessLifecycle(ctx context.Context) (int, error)
 }
 
 type internshipBatchService struct {
@@ -40,6 +63,10 @@ func (s *internshipBatchService) Create(ctx context.Context, req *dto.CreateInte
 		return nil, err
 	}
 
+	if err := NewWishlistService(s.ServiceParams).NotifyBatchAnnounced(ctx, batch); err != nil {
+		s.Logger.Errorw("failed to notify wishlists of new batch", "error", err, "batch_id", batch.ID)
+	}
+
 	return &dto.InternshipBatchResponse{
 		InternshipBatch: *batch,
 	}, nil
@@ -73,6 +100,10 @@ func (s *internshipBatchService) Update(ctx context.Context, id string, req *dto
 		existingBatch.Description = lo.FromPtr(req.Description)
 	}
 	if req.StartDate != nil {
+		// students are reminded again of a batch that moved
+		if !existingBatch.StartDate.Equal(lo.FromPtr(req.StartDate)) {
+			existingBatch.RemindedAt = nil
+		}
 		existingBatch.StartDate = lo.FromPtr(req.StartDate)
 	}
 	if req.EndDate != nil {
@@ -115,19 +146,19 @@ func (s *internshipBatchService) List(ctx context.Context, filter *types.Interns
 		return nil, err
 	}
 
-	count, err := s.InternshipBatchRepo.Count(ctx, filter)
+	batches, err := s.InternshipBatchRepo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	batches, err := s.InternshipBatchRepo.List(ctx, filter)
+	pagination, err := paginate(ctx, filter, batches, s.InternshipBatchRepo.Count)
 	if err != nil {
 		return nil, err
 	}
 
 	response := &dto.ListInternshipBatchResponse{
-		Items:      make([]*dto.InternshipBatchResponse, count),
-		Pagination: types.NewPaginationResponse(count, filter.GetLimit(), filter.GetOffset()),
+		Items:      make([]*dto.InternshipBatchResponse, len(batches)),
+		Pagination: pagination,
 	}
 
 	for i, batch := range batches {
@@ -136,3 +167,595 @@ func (s *internshipBatchService) List(ctx context.Context, filter *types.Interns
 
 	return response, nil
 }
+
+func (s *internshipBatchService) ListMine(ctx context.Context, filter *types.InternshipBatchFilter) (*dto.ListInternshipBatchResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipBatchFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	internshipFilter := types.NewNoLimitInternshipFilter()
+	internshipFilter.InstructorID = types.GetUserID(ctx)
+	internshipFilter.InternshipIDs = filter.InternshipIDs
+
+	internships, err := s.InternshipRepo.ListAll(ctx, internshipFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(internships) == 0 {
+		return &dto.ListInternshipBatchResponse{
+			Items:      []*dto.InternshipBatchResponse{},
+			Pagination: types.NewPaginationResponse(0, filter.GetLimit(), filter.GetOffset()),
+		}, nil
+	}
+
+	filter.InternshipIDs = lo.Map(internships, func(internship *domainInternship.Internship, _ int) string {
+		return internship.ID
+	})
+
+	return s.List(ctx, filter)
+}
+
+func (s *internshipBatchService) Pause(ctx context.Context, id string) (*dto.InternshipBatchResponse, error) {
+	batch, err := s.InternshipBatchRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !batch.BatchStatus.IsRunning() {
+		return nil, ierr.NewError("batch can't be paused").
+			WithHint("Only upcoming or ongoing batches can be paused").
+			WithReportableDetails(map[string]any{
+				"batch_id":     batch.ID,
+				"batch_status": batch.BatchStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	batch.BatchStatus = types.InternshipBatchStatusPaused
+	batch.PausedAt = lo.ToPtr(time.Now().UTC())
+
+	if err := s.InternshipBatchRepo.Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err := s.publishBatchEvent(ctx, types.WebhookEventBatchPaused, batch); err != nil {
+		s.Logger.Errorw("failed to publish internship batch event",
+			"batch_id", batch.ID,
+			"event_name", types.WebhookEventBatchPaused,
+			"error", err)
+	}
+
+	return &dto.InternshipBatchResponse{InternshipBatch: *batch}, nil
+}
+
+func (s *internshipBatchService) Resume(ctx context.Context, id string) (*dto.InternshipBatchResponse, error) {
+	batch, err := s.InternshipBatchRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch.BatchStatus != types.InternshipBatchStatusPaused {
+		return nil, ierr.NewError("batch is not paused").
+			WithHint("Only paused batches can be resumed").
+			WithReportableDetails(map[string]any{
+				"batch_id":     batch.ID,
+				"batch_status": batch.BatchStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// the next lifecycle run completes the batch if its end date passed while paused
+	batch.BatchStatus = types.InternshipBatchStatusUpcoming
+	if !batch.StartDate.IsZero() && !time.Now().UTC().Before(batch.StartDate) {
+		batch.BatchStatus = types.InternshipBatchStatusOngoing
+	}
+	batch.PausedAt = nil
+
+	if err := s.InternshipBatchRepo.Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err := s.publishBatchEvent(ctx, types.WebhookEventBatchResumed, batch); err != nil {
+		s.Logger.Errorw("failed to publish internship batch event",
+			"batch_id", batch.ID,
+			"event_name", types.WebhookEventBatchResumed,
+			"error", err)
+	}
+
+	return &dto.InternshipBatchResponse{InternshipBatch: *batch}, nil
+}
+
+func (s *internshipBatchService) Cancel(ctx context.Context, id string, req *dto.CancelInternshipBatchRequest) (*dto.CancelInternshipBatchResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// a cancelled batch is cancelled again to retry the refunds that failed
+	retry := batch.BatchStatus == types.InternshipBatchStatusCancelled
+	if batch.BatchStatus.IsTerminal() && !retry {
+		return nil, ierr.NewError("batch already ended").
+			WithHint("Completed batches can't be cancelled").
+			WithReportableDetails(map[string]any{
+				"batch_id":     batch.ID,
+				"batch_status": batch.BatchStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	reason := req.Reason
+	if retry {
+		reason = lo.FromPtrOr(batch.CancellationReason, req.Reason)
+	} else {
+		// cancel first so no one enrolls while the refunds run
+		now := time.Now().UTC()
+		batch.BatchStatus = types.InternshipBatchStatusCancelled
+		batch.CancelledAt = lo.ToPtr(now)
+		batch.CancellationReason = lo.ToPtr(req.Reason)
+		if batch.EnrollmentClosedAt == nil {
+			batch.EnrollmentClosedAt = lo.ToPtr(now)
+		}
+
+		if err := s.InternshipBatchRepo.Update(ctx, batch); err != nil {
+			return nil, err
+		}
+	}
+
+	// refunded enrollments are done, the ones left over are still waiting for theirs
+	enrollments, err := s.listBatchEnrollments(ctx, batch.ID,
+		types.InternshipEnrollmentStatusPending,
+		types.InternshipEnrollmentStatusEnrolled,
+		types.InternshipEnrollmentStatusSuspended,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	destination := req.RefundDestination
+	if destination == "" {
+		destination = types.RefundDestinationSource
+	}
+
+	response := &dto.CancelInternshipBatchResponse{
+		InternshipBatchResponse: dto.InternshipBatchResponse{InternshipBatch: *batch},
+	}
+
+	// refunds are independent, a failed one is reported back for a retry instead of blocking the rest
+	for _, enrollment := range enrollments {
+		failedPaymentIDs, err := s.refundEnrollment(ctx, enrollment, destination, reason)
+		if err != nil || len(failedPaymentIDs) > 0 {
+			s.Logger.Errorw("failed to refund enrollment of cancelled batch",
+				"batch_id", batch.ID,
+				"enrollment_id", enrollment.ID,
+				"failed_payment_ids", failedPaymentIDs,
+				"error", err)
+			response.FailedEnrollmentIDs = append(response.FailedEnrollmentIDs, enrollment.ID)
+			response.FailedPaymentIDs = append(response.FailedPaymentIDs, failedPaymentIDs...)
+			continue
+		}
+		response.RefundedEnrollments++
+	}
+
+	if retry {
+		return response, nil
+	}
+
+	if err := s.publishBatchEvent(ctx, types.WebhookEventBatchCancelled, batch); err != nil {
+		s.Logger.Errorw("failed to publish internship batch event",
+			"batch_id", batch.ID,
+			"event_name", types.WebhookEventBatchCancelled,
+			"error", err)
+	}
+
+	return response, nil
+}
+
+// refundEnrollment refunds everything paid for an enrollment, cancels its unpaid
+// installments and returns the wallet credit spent on it. Every payment is refunded
+// on its own, as a refund sent to the gateway can't be taken back, and the payments
+// that failed are returned. The enrollment only moves on once all of them went through,
+// so refunding it again picks up where the last attempt stopped.
+func (s *internshipBatchService) refundEnrollment(ctx context.Context, enrollment *domainInternshipEnrollment.InternshipEnrollment, destination types.RefundDestination, reason string) ([]string, error) {
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeEnrollment))
+	filter.DestinationID = lo.ToPtr(enrollment.ID)
+
+	payments, err := s.PaymentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentService := NewPaymentService(s.ServiceParams)
+	refunded := false
+	failedPaymentIDs := make([]string, 0)
+	for _, payment := range payments {
+		switch payment.PaymentStatus {
+		case types.PaymentStatusSuccess:
+			if _, err := paymentService.Refund(ctx, payment.ID, &dto.RefundPaymentRequest{
+				Destination: destination,
+				Reason:      reason,
+			}); err != nil {
+				s.Logger.Errorw("failed to refund payment of enrollment",
+					"enrollment_id", enrollment.ID,
+					"payment_id", payment.ID,
+					"error", err)
+				failedPaymentIDs = append(failedPaymentIDs, payment.ID)
+				continue
+			}
+			refunded = true
+
+		case types.PaymentStatusRefunded:
+			// refunded by an earlier attempt, its gateway refund may still have to be sent
+			sent, err := s.isRefundSent(ctx, payment.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !sent {
+				if _, err := paymentService.Refund(ctx, payment.ID, &dto.RefundPaymentRequest{
+					Destination: types.RefundDestinationSource,
+					Reason:      reason,
+				}); err != nil {
+					s.Logger.Errorw("failed to resend refund of enrollment payment",
+						"enrollment_id", enrollment.ID,
+						"payment_id", payment.ID,
+						"error", err)
+					failedPaymentIDs = append(failedPaymentIDs, payment.ID)
+					continue
+				}
+			}
+			refunded = true
+
+		case types.PaymentStatusPending:
+			payment.PaymentStatus = types.PaymentStatusCancelled
+			if err := s.PaymentRepo.Update(ctx, payment); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(failedPaymentIDs) > 0 {
+		return failedPaymentIDs, nil
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		// enrollments paid entirely with wallet credit have no payment to refund
+		walletService := NewWalletService(s.ServiceParams)
+		reversed, err := walletService.ReverseDebits(ctx, enrollment.ID)
+		if err != nil {
+			return err
+		}
+
+		if refunded || reversed.IsPositive() {
+			enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusRefunded
+			enrollment.PaymentStatus = types.PaymentStatusRefunded
+			enrollment.RefundedAt = lo.ToPtr(time.Now().UTC())
+			enrollment.RefundReason = lo.ToPtr(reason)
+		} else {
+			// nothing was paid, so the discount codes the checkout redeemed go back too
+			if err := releaseCheckout(ctx, s.ServiceParams, enrollment); err != nil {
+				return err
+			}
+			enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusCancelled
+			enrollment.CancellationReason = lo.ToPtr(reason)
+		}
+
+		return s.InternshipEnrollmentRepo.Update(ctx, enrollment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateAccess(s.ServiceParams, enrollment.UserID)
+	return nil, nil
+}
+
+// isRefundSent reports whether nothing of a refunded payment is left to send to the gateway,
+// payments refunded to the wallet have no gateway refund at all
+func (s *internshipBatchService) isRefundSent(ctx context.Context, paymentID string) (bool, error) {
+	refund, err := s.PaymentRepo.GetRefundByPaymentID(ctx, paymentID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return refund.IsProcessed(), nil
+}
+
+func (s *internshipBatchService) ProcessLifecycle(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	cutoff := s.Config.Batch.EnrollmentCutoff
+
+	batches := make([]*domainInternship.InternshipBatch, 0)
+	for _, status := range []types.InternshipBatchStatus{
+		types.InternshipBatchStatusUpcoming,
+		types.InternshipBatchStatusOngoing,
+	} {
+		filter := types.NewNoLimitInternshipBatchFilter()
+		filter.BatchStatus = status
+
+		list, err := s.InternshipBatchRepo.ListAll(ctx, filter)
+		if err != nil {
+			return 0, err
+		}
+		batches = append(batches, list...)
+	}
+
+	changed := 0
+	for _, batch := range batches {
+		// batches without dates are moved along by hand
+		if batch.StartDate.IsZero() {
+			continue
+		}
+
+		events, err := s.advanceBatch(ctx, batch, now, cutoff)
+		if err != nil {
+			s.Logger.Errorw("failed to advance internship batch",
+				"batch_id", batch.ID,
+				"batch_status", batch.BatchStatus,
+				"error", err)
+			continue
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+		changed++
+
+		for _, eventName := range events {
+			if eventName == types.WebhookEventBatchReminder {
+				s.publishBatchReminders(ctx, batch)
+				continue
+			}
+
+			if err := s.publishBatchEvent(ctx, eventName, batch); err != nil {
+				s.Logger.Errorw("failed to publish internship batch event",
+					"batch_id", batch.ID,
+					"event_name", eventName,
+					"error", err)
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// advanceBatch applies every transition that is due for the batch and returns the events to publish
+func (s *internshipBatchService) advanceBatch(ctx context.Context, batch *domainInternship.InternshipBatch, now time.Time, cutoff time.Duration) ([]string, error) {
+	events := make([]string, 0)
+
+	leadTime := s.Config.Batch.ReminderLeadTime
+	if batch.BatchStatus == types.InternshipBatchStatusUpcoming && batch.RemindedAt == nil && leadTime > 0 &&
+		now.Before(batch.StartDate) && !now.Before(batch.StartDate.Add(-leadTime)) {
+		batch.RemindedAt = lo.ToPtr(now)
+		events = append(events, types.WebhookEventBatchReminder)
+	}
+
+	if batch.BatchStatus == types.InternshipBatchStatusUpcoming && !now.Before(batch.StartDate) {
+		batch.BatchStatus = types.InternshipBatchStatusOngoing
+		events = append(events, types.WebhookEventBatchStarted)
+	}
+
+	if batch.EnrollmentClosedAt == nil && !now.Before(batch.EnrollmentCutoffAt(cutoff)) {
+		batch.EnrollmentClosedAt = lo.ToPtr(now)
+		events = append(events, types.WebhookEventBatchEnrollmentClosed)
+	}
+
+	if batch.BatchStatus == types.InternshipBatchStatusOngoing && !batch.EndDate.IsZero() && !now.Before(batch.EndDate) {
+		batch.BatchStatus = types.InternshipBatchStatusCompleted
+		events = append(events, types.WebhookEventBatchCompleted)
+	}
+
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	var completed []*domainInternshipEnrollment.InternshipEnrollment
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.InternshipBatchRepo.Update(ctx, batch); err != nil {
+			return err
+		}
+
+		if batch.BatchStatus != types.InternshipBatchStatusCompleted {
+			return nil
+		}
+
+		var err error
+		completed, err = s.completeEnrollments(ctx, batch, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	certificateService := NewCertificateService(s.ServiceParams)
+	for _, enrollment := range completed {
+		if err := s.publishEnrollmentCompleted(ctx, enrollment); err != nil {
+			s.Logger.Errorw("failed to publish enrollment completed event",
+				"enrollment_id", enrollment.ID,
+				"error", err)
+		}
+
+		// students can still request the certificate themselves when issuing fails here
+		if _, err := certificateService.IssueForEnrollment(ctx, enrollment); err != nil {
+			s.Logger.Errorw("failed to issue certificate",
+				"enrollment_id", enrollment.ID,
+				"error", err)
+		}
+	}
+
+	return events, nil
+}
+
+// completeEnrollments completes the enrollments of an ended batch that meet the completion criteria
+func (s *internshipBatchService) completeEnrollments(ctx context.Context, batch *domainInternship.InternshipBatch, now time.Time) ([]*domainInternshipEnrollment.InternshipEnrollment, error) {
+	enrollments, err := s.listBatchEnrollments(ctx, batch.ID, types.InternshipEnrollmentStatusEnrolled)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := make([]*domainInternshipEnrollment.InternshipEnrollment, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		ok, err := s.meetsCompletionCriteria(ctx, enrollment)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			s.Logger.Infow("enrollment does not meet completion criteria",
+				"batch_id", batch.ID,
+				"enrollment_id", enrollment.ID)
+			continue
+		}
+
+		enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusCompleted
+		enrollment.CompletedAt = lo.ToPtr(now)
+		if err := s.InternshipEnrollmentRepo.Update(ctx, enrollment); err != nil {
+			return nil, err
+		}
+		invalidateAccess(s.ServiceParams, enrollment.UserID)
+
+		completed = append(completed, enrollment)
+	}
+
+	return completed, nil
+}
+
+// meetsCompletionCriteria reports whether an enrollment can be completed. Enrollments
+// with installments still unpaid stay enrolled until they are settled, and students
+// must have passed every assignment that has a passing score and every quiz that has
+// a passing percentage.
+func (s *internshipBatchService) meetsCompletionCriteria(ctx context.Context, enrollment *domainInternshipEnrollment.InternshipEnrollment) (bool, error) {
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeEnrollment))
+	filter.DestinationID = lo.ToPtr(enrollment.ID)
+	filter.PaymentStatus = lo.ToPtr(string(types.PaymentStatusPending))
+
+	unpaid, err := s.PaymentRepo.Count(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	if unpaid > 0 {
+		return false, nil
+	}
+
+	passed, err := passesAssignments(ctx, s.ServiceParams, enrollment)
+	if err != nil || !passed {
+		return false, err
+	}
+
+	return passesQuizzes(ctx, s.ServiceParams, enrollment)
+}
+
+func (s *internshipBatchService) listBatchEnrollments(ctx context.Context, batchID string, statuses ...types.InternshipEnrollmentStatus) ([]*domainInternshipEnrollment.InternshipEnrollment, error) {
+	filter := types.NewNoLimitInternshipEnrollmentFilter()
+	filter.InternshipBatchID = lo.ToPtr(batchID)
+
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(enrollments, func(enrollment *domainInternshipEnrollment.InternshipEnrollment, _ int) bool {
+		return lo.Contains(statuses, enrollment.EnrollmentStatus)
+	}), nil
+}
+
+func (s *internshipBatchService) publishBatchEvent(ctx context.Context, eventName string, batch *domainInternship.InternshipBatch) error {
+	payload, err := json.Marshal(&webhookDto.InternshipBatchWebhookPayload{
+		InternshipBatchID: batch.ID,
+		InternshipID:      batch.InternshipID,
+		Name:              batch.Name,
+		BatchStatus:       batch.BatchStatus,
+		StartDate:         batch.StartDate,
+		EndDate:           batch.EndDate,
+		Reason:            batch.CancellationReason,
+	})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build internship batch event").
+			Mark(ierr.ErrInternal)
+	}
+
+	return s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// publishBatchReminders reminds every student enrolled in the batch that it is about to start
+func (s *internshipBatchService) publishBatchReminders(ctx context.Context, batch *domainInternship.InternshipBatch) {
+	enrollments, err := s.listBatchEnrollments(ctx, batch.ID, types.InternshipEnrollmentStatusEnrolled)
+	if err != nil {
+		s.Logger.Errorw("failed to list enrollments to remind",
+			"batch_id", batch.ID,
+			"error", err)
+		return
+	}
+
+	payload, err := json.Marshal(&webhookDto.InternshipBatchWebhookPayload{
+		InternshipBatchID: batch.ID,
+		InternshipID:      batch.InternshipID,
+		Name:              batch.Name,
+		BatchStatus:       batch.BatchStatus,
+		StartDate:         batch.StartDate,
+		EndDate:           batch.EndDate,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build internship batch reminder",
+			"batch_id", batch.ID,
+			"error", err)
+		return
+	}
+
+	for _, enrollment := range enrollments {
+		err := s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+			ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+			EventName: types.WebhookEventBatchReminder,
+			UserID:    lo.ToPtr(enrollment.UserID),
+			Payload:   payload,
+			Timestamp: time.Now().UTC(),
+		})
+		if err != nil {
+			s.Logger.Errorw("failed to publish internship batch reminder",
+				"batch_id", batch.ID,
+				"enrollment_id", enrollment.ID,
+				"error", err)
+		}
+	}
+}
+
+func (s *internshipBatchService) publishEnrollmentCompleted(ctx context.Context, enrollment *domainInternshipEnrollment.InternshipEnrollment) error {
+	payload, err := json.Marshal(&webhookDto.EnrollmentCompletedWebhookPayload{
+		EnrollmentID:      enrollment.ID,
+		InternshipID:      enrollment.InternshipID,
+		InternshipBatchID: enrollment.InternshipBatchID,
+		CompletedAt:       lo.FromPtr(enrollment.CompletedAt),
+	})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build enrollment completed event").
+			Mark(ierr.ErrInternal)
+	}
+
+	return s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: types.WebhookEventEnrollmentCompleted,
+		UserID:    lo.ToPtr(enrollment.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+}
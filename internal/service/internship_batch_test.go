@@ -0,0 +1,235 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/config"
+	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InternshipBatchServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service   InternshipBatchService
+	wallet    WalletService
+	gateway   *testutil.MockPaymentGateway
+	publisher *testutil.MockWebhookPublisher
+	batch     *domainInternship.InternshipBatch
+}
+
+func TestInternshipBatchService(t *testing.T) {
+	suite.Run(t, new(InternshipBatchServiceSuite))
+}
+
+func (s *InternshipBatchServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.Wallet = config.WalletConfig{DefaultCurrency: "INR"}
+
+	s.gateway = testutil.NewMockPaymentGateway()
+	s.publisher = testutil.NewMockWebhookPublisher()
+
+	stores := s.GetStores()
+	params := ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   cfg,
+		DB:                       s.GetDB(),
+		InternshipBatchRepo:      stores.InternshipBatchRepo,
+		InternshipEnrollmentRepo: stores.InternshipEnrollmentRepo,
+		PaymentRepo:              stores.PaymentRepo,
+		WalletRepo:               stores.WalletRepo,
+		DiscountRepo:             stores.DiscountRepo,
+		ReferralRepo:             stores.ReferralRepo,
+		CertificateRepo:          stores.CertificateRepo,
+		WebhookPublisher:         s.publisher,
+		GatewayRegistry:          testutil.NewMockGatewayRegistry(s.gateway),
+	}
+	s.service = NewInternshipBatchService(params)
+	s.wallet = NewWalletService(params)
+
+	s.batch = &domainInternship.InternshipBatch{
+		ID:           s.GetUUID(),
+		InternshipID: s.GetUUID(),
+		Name:         "Spring",
+		StartDate:    s.GetNow().Add(7 * 24 * time.Hour),
+		EndDate:      s.GetNow().Add(60 * 24 * time.Hour),
+		BatchStatus:  types.InternshipBatchStatusUpcoming,
+		Metadata:     types.Metadata{},
+		BaseModel:    types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(stores.InternshipBatchRepo.Create(s.GetContext(), s.batch))
+}
+
+func (s *InternshipBatchServiceSuite) enroll(status types.InternshipEnrollmentStatus, metadata types.Metadata) *domainInternshipEnrollment.InternshipEnrollment {
+	enrollment := &domainInternshipEnrollment.InternshipEnrollment{
+		ID:                s.GetUUID(),
+		UserID:            types.DefaultUserID,
+		InternshipID:      s.batch.InternshipID,
+		InternshipBatchID: s.batch.ID,
+		EnrollmentStatus:  status,
+		PaymentStatus:     types.PaymentStatusPending,
+		Metadata:          metadata,
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().InternshipEnrollmentRepo.Create(s.GetContext(), enrollment))
+	return enrollment
+}
+
+func (s *InternshipBatchServiceSuite) addPayment(enrollment *domainInternshipEnrollment.InternshipEnrollment, status types.PaymentStatus) *domainPayment.Payment {
+	payment := &domainPayment.Payment{
+		ID:                     s.GetUUID(),
+		IdempotencyKey:         s.GetUUID(),
+		DestinationType:        types.PaymentDestinationTypeEnrollment,
+		DestinationID:          enrollment.ID,
+		PaymentGatewayProvider: types.PaymentGatewayProviderRazorpay,
+		GatewayPaymentID:       lo.ToPtr("pay_" + s.GetUUID()),
+		Amount:                 decimal.NewFromInt(500),
+		Currency:               "INR",
+		PaymentStatus:          status,
+		Metadata:               map[string]string{},
+		BaseModel:              types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().PaymentRepo.Create(s.GetContext(), payment))
+	return payment
+}
+
+func (s *InternshipBatchServiceSuite) cancel() *dto.CancelInternshipBatchResponse {
+	response, err := s.service.Cancel(s.GetContext(), s.batch.ID, &dto.CancelInternshipBatchRequest{
+		Reason: "Instructor unavailable",
+	})
+	s.Require().NoError(err)
+	return response
+}
+
+func (s *InternshipBatchServiceSuite) enrollment(id string) *domainInternshipEnrollment.InternshipEnrollment {
+	enrollment, err := s.GetStores().InternshipEnrollmentRepo.Get(s.GetContext(), id)
+	s.Require().NoError(err)
+	return enrollment
+}
+
+func (s *InternshipBatchServiceSuite) paymentStatus(payment *domainPayment.Payment) types.PaymentStatus {
+	stored, err := s.GetStores().PaymentRepo.Get(s.GetContext(), payment.ID)
+	s.Require().NoError(err)
+	return stored.PaymentStatus
+}
+
+func (s *InternshipBatchServiceSuite) published(eventName string) int {
+	return lo.Count(s.publisher.Events(), eventName)
+}
+
+func (s *InternshipBatchServiceSuite) TestCancelRefundsEveryEnrollment() {
+	paid := s.enroll(types.InternshipEnrollmentStatusEnrolled, types.Metadata{})
+	payment := s.addPayment(paid, types.PaymentStatusSuccess)
+
+	// a checkout that was never paid, its discount code goes back
+	discount := &domainDiscount.Discount{
+		ID:            s.GetUUID(),
+		Code:          "WELCOME",
+		DiscountType:  types.DiscountTypePercentage,
+		DiscountValue: decimal.NewFromInt(10),
+		ValidFrom:     s.GetNow().Add(-time.Hour),
+		IsActive:      true,
+		UsedCount:     1,
+		Metadata:      types.Metadata{},
+		BaseModel:     types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().DiscountRepo.Create(s.GetContext(), discount))
+	unpaid := s.enroll(types.InternshipEnrollmentStatusPending, types.Metadata{
+		types.EnrollmentMetadataDiscountCodes: "WELCOME",
+	})
+	checkout := s.addPayment(unpaid, types.PaymentStatusPending)
+
+	// paid entirely with wallet credit
+	walletPaid := s.enroll(types.InternshipEnrollmentStatusEnrolled, types.Metadata{})
+	_, err := s.wallet.Credit(s.GetContext(), &dto.WalletCreditRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(300),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonAdminGrant,
+	})
+	s.Require().NoError(err)
+	_, err = s.wallet.Debit(s.GetContext(), &dto.WalletDebitRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(300),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonCheckout,
+		ReferenceID:       lo.ToPtr(walletPaid.ID),
+	})
+	s.Require().NoError(err)
+
+	response := s.cancel()
+
+	s.Equal(3, response.RefundedEnrollments)
+	s.Empty(response.FailedEnrollmentIDs)
+	s.Equal(types.InternshipBatchStatusCancelled, response.BatchStatus)
+	s.NotNil(response.CancelledAt)
+	s.NotNil(response.EnrollmentClosedAt)
+	s.Equal("Instructor unavailable", lo.FromPtr(response.CancellationReason))
+	s.Equal(1, s.published(types.WebhookEventBatchCancelled))
+
+	s.Equal(types.InternshipEnrollmentStatusRefunded, s.enrollment(paid.ID).EnrollmentStatus)
+	s.Equal(types.PaymentStatusRefunded, s.paymentStatus(payment))
+	s.Contains(s.gateway.Refunds(), payment.ID)
+
+	s.Equal(types.InternshipEnrollmentStatusCancelled, s.enrollment(unpaid.ID).EnrollmentStatus)
+	s.Equal(types.PaymentStatusCancelled, s.paymentStatus(checkout))
+	stored, err := s.GetStores().DiscountRepo.GetByCode(s.GetContext(), "WELCOME")
+	s.Require().NoError(err)
+	s.Equal(0, stored.UsedCount)
+
+	s.Equal(types.InternshipEnrollmentStatusRefunded, s.enrollment(walletPaid.ID).EnrollmentStatus)
+	balance, err := s.wallet.GetBalance(s.GetContext(), types.DefaultUserID, "")
+	s.Require().NoError(err)
+	s.True(balance.Balance.Equal(decimal.NewFromInt(300)))
+}
+
+func (s *InternshipBatchServiceSuite) TestCancelAgainRetriesFailedRefunds() {
+	enrollment := s.enroll(types.InternshipEnrollmentStatusEnrolled, types.Metadata{})
+	first := s.addPayment(enrollment, types.PaymentStatusSuccess)
+	second := s.addPayment(enrollment, types.PaymentStatusSuccess)
+	s.gateway.RefundErr = errors.New("gateway unavailable")
+
+	response := s.cancel()
+
+	s.Equal(0, response.RefundedEnrollments)
+	s.Equal([]string{enrollment.ID}, response.FailedEnrollmentIDs)
+	s.ElementsMatch([]string{first.ID, second.ID}, response.FailedPaymentIDs)
+	s.Equal(types.InternshipEnrollmentStatusEnrolled, s.enrollment(enrollment.ID).EnrollmentStatus)
+
+	// the refunds were recorded, cancelling again only sends them to the gateway
+	s.gateway.RefundErr = nil
+	response = s.cancel()
+
+	s.Equal(1, response.RefundedEnrollments)
+	s.Empty(response.FailedEnrollmentIDs)
+	s.Equal(types.InternshipEnrollmentStatusRefunded, s.enrollment(enrollment.ID).EnrollmentStatus)
+	s.Len(s.gateway.Refunds(), 2)
+	s.Equal(1, s.published(types.WebhookEventBatchCancelled))
+
+	// nothing is left to refund
+	response = s.cancel()
+	s.Equal(0, response.RefundedEnrollments)
+	s.Len(s.gateway.Refunds(), 2)
+}
+
+func (s *InternshipBatchServiceSuite) TestCancelRejectsCompletedBatch() {
+	s.batch.BatchStatus = types.InternshipBatchStatusCompleted
+	s.Require().NoError(s.GetStores().InternshipBatchRepo.Update(s.GetContext(), s.batch))
+
+	_, err := s.service.Cancel(s.GetContext(), s.batch.ID, &dto.CancelInternshipBatchRequest{
+		Reason: "Instructor unavailable",
+	})
+	s.True(ierr.IsInvalidOperation(err))
+}
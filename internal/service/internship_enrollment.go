@@ -2,17 +2,30 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
+	"time"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
 	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	domainPaymentPlan "github.com/omkar273/codegeeky/internal/domain/paymentplan"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/idempotency"
 	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // InternshipEnrollmentService is the service for managing internship enrollments
 type InternshipEnrollmentService interface {
 	InitializeEnrollment(ctx context.Context, req *dto.InitializeEnrollmentRequest) (*dto.InitializeEnrollmentResponse, error)
+
+	// ExpireCheckouts cancels enrollments still waiting for their payment past the
+	// checkout expiry, releases the discount codes they redeemed and gives back
+	// the wallet credit they were debited
+	ExpireCheckouts(ctx context.Context) (int, error)
 }
 
 // internshipEnrollmentService is the implementation of the InternshipEnrollmentService interface
@@ -40,6 +53,53 @@ func (s *internshipEnrollmentService) InitializeEnrollment(ctx context.Context,
 		return nil, err
 	}
 
+	if !batch.IsEnrollmentOpen(time.Now().UTC(), s.Config.Batch.EnrollmentCutoff) {
+		return nil, ierr.NewError("enrollment is closed").
+			WithHint("This batch is no longer accepting enrollments").
+			WithReportableDetails(map[string]any{
+				"batch_id":     batch.ID,
+				"batch_status": batch.BatchStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	internship, err := s.ServiceParams.InternshipRepo.Get(ctx, batch.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !internship.IsPublished() {
+		return nil, ierr.NewError("internship is not published").
+			WithHint("This internship is not open for enrollment yet").
+			WithReportableDetails(map[string]any{
+				"internship_id":  internship.ID,
+				"publish_status": internship.PublishStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if err := checkAcceptedApplication(ctx, s.ServiceParams, internship, batch.ID); err != nil {
+		return nil, err
+	}
+
+	var plan *domainPaymentPlan.PaymentPlan
+	if req.PaymentPlanID != nil {
+		plan, err = s.ServiceParams.PaymentPlanRepo.Get(ctx, *req.PaymentPlanID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !plan.IsActive || plan.InternshipID != batch.InternshipID {
+			return nil, ierr.NewError("payment plan is not available").
+				WithHint("The selected payment plan can't be used for this internship").
+				WithReportableDetails(map[string]any{
+					"payment_plan_id": plan.ID,
+					"internship_id":   batch.InternshipID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
 	// Generate idempotency key
 	generator := idempotency.NewGenerator()
 	idempotencyKey := generator.GenerateKey(idempotency.ScopeInternship, map[string]interface{}{
@@ -70,40 +130,254 @@ func (s *internshipEnrollmentService) InitializeEnrollment(ctx context.Context,
 	}
 
 	// Calculate pricing with coupon (use first coupon code if multiple provided)
-	var discountCode string
-	if len(req.CouponCodes) > 0 {
-		discountCode = req.CouponCodes[0]
+	var discountCodes []string
+	if len(req.CouponCodes) > 0 && req.CouponCodes[0] != "" {
+		discountCodes = []string{req.CouponCodes[0]}
 	}
 
-	pricingResponse, err := s.PricingService.CalculateEnrollmentPricing(ctx, req.InternshipBatchID, []string{discountCode})
+	pricingResponse, err := s.PricingService.CalculateEnrollmentPricing(ctx, batch.InternshipID, discountCodes)
 	if err != nil {
 		return nil, err
 	}
 
+	// Pay as much as possible from wallet credit, the rest goes through the gateway
+	currency := pricingResponse.Currency
+	if currency == "" {
+		currency = s.Config.Wallet.DefaultCurrency
+	}
+
+	walletService := NewWalletService(s.ServiceParams)
+	walletAmount := decimal.Zero
+	if req.UseWalletCredit && pricingResponse.Total.IsPositive() {
+		balance, err := walletService.GetBalance(ctx, types.GetUserID(ctx), currency)
+		if err != nil {
+			return nil, err
+		}
+
+		if balance.Balance.IsPositive() {
+			walletAmount = decimal.Min(balance.Balance, pricingResponse.Total)
+		}
+	}
+	amountDue := pricingResponse.Total.Sub(walletAmount)
+	paymentRequired := amountDue.IsPositive()
+
+	if plan != nil && !paymentRequired {
+		return nil, ierr.NewError("payment plan requires a positive total").
+			WithHint("Free enrollments can't be paid in installments").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// Remember the codes the checkout redeems so they can be released if it expires unpaid
+	appliedCodes := lo.Map(pricingResponse.AppliedDiscounts, func(d *dto.DiscountInfo, _ int) string {
+		return d.Code
+	})
+	metadata := types.Metadata(lo.OmitByKeys(req.Metadata, []string{types.EnrollmentMetadataDiscountCodes}))
+	if len(appliedCodes) > 0 {
+		metadata[types.EnrollmentMetadataDiscountCodes] = strings.Join(appliedCodes, ",")
+	}
+
 	// Create enrollment
 	enrollmentData := &domainInternshipEnrollment.InternshipEnrollment{
-		UserID:           types.GetUserID(ctx),
-		InternshipID:     batch.InternshipID,
-		EnrollmentStatus: types.InternshipEnrollmentStatusPending,
-		PaymentStatus:    types.PaymentStatusPending,
-		IdempotencyKey:   &idempotencyKey,
-		Metadata:         req.Metadata,
+		ID:                   types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INTERNSHIP_ENROLLMENT),
+		UserID:               types.GetUserID(ctx),
+		InternshipID:         batch.InternshipID,
+		InternshipBatchID:    batch.ID,
+		InternshipRevisionID: internship.CurrentRevisionID,
+		EnrollmentStatus:     types.InternshipEnrollmentStatusPending,
+		PaymentStatus:        types.PaymentStatusPending,
+		IdempotencyKey:       &idempotencyKey,
+		PaymentPlanID:        req.PaymentPlanID,
+		Metadata:             metadata,
+		BaseModel:            types.GetDefaultBaseModel(ctx),
 	}
 
-	// If payment is not required, mark as completed
-	if !pricingResponse.PaymentRequired {
-		enrollmentData.EnrollmentStatus = types.InternshipEnrollmentStatusCompleted
+	// If payment is not required, the student is enrolled right away. Completion is
+	// left to the batch lifecycle like for paid enrollments.
+	if !paymentRequired {
+		enrollmentData.EnrollmentStatus = types.InternshipEnrollmentStatusEnrolled
 		enrollmentData.PaymentStatus = types.PaymentStatusSuccess
+		enrollmentData.EnrolledAt = lo.ToPtr(time.Now().UTC())
 	}
 
-	err = s.ServiceParams.InternshipEnrollmentRepo.Create(ctx, enrollmentData)
+	var installments []*domainPayment.Payment
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.ServiceParams.InternshipEnrollmentRepo.Create(ctx, enrollmentData); err != nil {
+			return err
+		}
+
+		// Each installment is its own payment, due relative to the batch start
+		if plan != nil {
+			installments, err = NewPaymentPlanService(s.ServiceParams).
+				ScheduleInstallments(ctx, enrollmentData, plan, batch.StartDate, amountDue, currency)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Wallet debits reference the enrollment so they can be reversed on refund
+		// or when the checkout expires before the rest is paid
+		if walletAmount.IsPositive() {
+			_, err := walletService.Debit(ctx, &dto.WalletDebitRequest{
+				UserID:            enrollmentData.UserID,
+				Amount:            walletAmount,
+				Currency:          currency,
+				TransactionReason: types.WalletTransactionReasonCheckout,
+				ReferenceID:       lo.ToPtr(enrollmentData.ID),
+				Description:       "Internship enrollment",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Single-use codes are held by the enrollment until it is paid or its checkout expires
+		return NewDiscountService(s.ServiceParams).RedeemDiscountCodes(ctx, appliedCodes)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.InitializeEnrollmentResponse{
+	if !paymentRequired {
+		invalidateAccess(s.ServiceParams, enrollmentData.UserID)
+
+		if err := publishEnrollmentConfirmed(ctx, s.ServiceParams, enrollmentData); err != nil {
+			s.Logger.Errorw("failed to publish enrollment confirmed event",
+				"enrollment_id", enrollmentData.ID,
+				"error", err)
+		}
+	}
+
+	response := &dto.InitializeEnrollmentResponse{
 		EnrollmentID:     enrollmentData.ID,
 		EnrollmentStatus: enrollmentData.EnrollmentStatus,
-		PaymentRequired:  pricingResponse.PaymentRequired,
-	}, nil
+		PaymentRequired:  paymentRequired,
+		Total:            pricingResponse.Total,
+		WalletAmount:     walletAmount,
+		AmountDue:        amountDue,
+		Currency:         currency,
+	}
+
+	if len(installments) > 0 {
+		response.AmountDue = installments[0].Amount
+		response.Installments = lo.Map(installments, func(p *domainPayment.Payment, _ int) *dto.InstallmentResponse {
+			return &dto.InstallmentResponse{
+				PaymentID:         p.ID,
+				InstallmentNumber: lo.FromPtr(p.InstallmentNumber),
+				Amount:            p.Amount,
+				Currency:          p.Currency.String(),
+				DueDate:           lo.FromPtr(p.DueDate),
+				PaymentStatus:     p.PaymentStatus,
+			}
+		})
+	}
+
+	return response, nil
+}
+
+func (s *internshipEnrollmentService) ExpireCheckouts(ctx context.Context) (int, error) {
+	expiry := s.Config.Enrollment.CheckoutExpiry
+	if expiry <= 0 {
+		return 0, nil
+	}
+
+	filter := types.NewNoLimitInternshipEnrollmentFilter()
+	filter.EnrollmentStatus = types.InternshipEnrollmentStatusPending
+	filter.EndTime = lo.ToPtr(time.Now().UTC().Add(-expiry))
+
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, enrollment := range enrollments {
+		if err := s.expireCheckout(ctx, enrollment); err != nil {
+			s.Logger.Errorw("failed to expire checkout",
+				"enrollment_id", enrollment.ID,
+				"error", err)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// expireCheckout cancels a pending enrollment together with its unpaid payments
+func (s *internshipEnrollmentService) expireCheckout(ctx context.Context, enrollment *domainInternshipEnrollment.InternshipEnrollment) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		filter := types.NewNoLimitPaymentFilter()
+		filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeEnrollment))
+		filter.DestinationID = lo.ToPtr(enrollment.ID)
+
+		payments, err := s.PaymentRepo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, payment := range payments {
+			if payment.PaymentStatus != types.PaymentStatusPending {
+				continue
+			}
+
+			payment.PaymentStatus = types.PaymentStatusExpired
+			if err := s.PaymentRepo.Update(ctx, payment); err != nil {
+				return err
+			}
+		}
+
+		if err := releaseCheckout(ctx, s.ServiceParams, enrollment); err != nil {
+			return err
+		}
+
+		enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusCancelled
+		enrollment.PaymentStatus = types.PaymentStatusExpired
+		enrollment.CancellationReason = lo.ToPtr("Checkout expired before payment")
+		enrollment.IdempotencyKey = nil
+		return s.InternshipEnrollmentRepo.Update(ctx, enrollment)
+	})
+}
+
+// releaseCheckout gives back what an enrollment that never got paid held on to
+func releaseCheckout(ctx context.Context, params ServiceParams, enrollment *domainInternshipEnrollment.InternshipEnrollment) error {
+	if _, err := NewWalletService(params).ReverseDebits(ctx, enrollment.ID); err != nil {
+		return err
+	}
+
+	codes := enrollment.Metadata[types.EnrollmentMetadataDiscountCodes]
+	if codes == "" {
+		return nil
+	}
+
+	if err := NewDiscountService(params).ReleaseDiscountCodes(ctx, strings.Split(codes, ",")); err != nil {
+		return err
+	}
+
+	delete(enrollment.Metadata, types.EnrollmentMetadataDiscountCodes)
+	return nil
+}
+
+// publishEnrollmentConfirmed tells the student their enrollment went through,
+// once for free enrollments and once the first payment for it succeeds
+func publishEnrollmentConfirmed(ctx context.Context, params ServiceParams, enrollment *domainInternshipEnrollment.InternshipEnrollment) error {
+	payload, err := json.Marshal(&webhookDto.EnrollmentConfirmedWebhookPayload{
+		EnrollmentID:      enrollment.ID,
+		InternshipID:      enrollment.InternshipID,
+		InternshipBatchID: enrollment.InternshipBatchID,
+		EnrollmentStatus:  enrollment.EnrollmentStatus,
+		EnrolledAt:        enrollment.EnrolledAt,
+	})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build enrollment confirmed event").
+			Mark(ierr.ErrInternal)
+	}
+
+	return params.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: types.WebhookEventEnrollmentConfirmed,
+		UserID:    lo.ToPtr(enrollment.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
 }
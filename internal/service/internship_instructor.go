@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type InternshipInstructorService interface {
+	List(ctx context.Context, internshipID string, filter *types.InternshipInstructorFilter) (*dto.ListInternshipInstructorResponse, error)
+	Add(ctx context.Context, internshipID string, req *dto.AddInternshipInstructorRequest) (*dto.InternshipInstructorResponse, error)
+	Update(ctx context.Context, internshipID string, userID string, req *dto.UpdateInternshipInstructorRequest) (*dto.InternshipInstructorResponse, error)
+	Remove(ctx context.Context, internshipID string, userID string) error
+}
+
+type internshipInstructorService struct {
+	ServiceParams
+}
+
+func NewInternshipInstructorService(params ServiceParams) InternshipInstructorService {
+	return &internshipInstructorService{
+		ServiceParams: params,
+	}
+}
+
+func (s *internshipInstructorService) List(ctx context.Context, internshipID string, filter *types.InternshipInstructorFilter) (*dto.ListInternshipInstructorResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipInstructorFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// instructors are listed wherever the internship itself is visible
+	if _, err := NewInternshipService(s.ServiceParams).GetByID(ctx, internshipID); err != nil {
+		return nil, err
+	}
+
+	filter.InternshipIDs = []string{internshipID}
+
+	instructors, err := s.InternshipInstructorRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, instructors, s.InternshipInstructorRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListInternshipInstructorResponse{
+		Items:      make([]*dto.InternshipInstructorResponse, len(instructors)),
+		Pagination: pagination,
+	}
+
+	for i, instructor := range instructors {
+		response.Items[i] = &dto.InternshipInstructorResponse{InternshipInstructor: *instructor}
+	}
+
+	return response, nil
+}
+
+func (s *internshipInstructorService) Add(ctx context.Context, internshipID string, req *dto.AddInternshipInstructorRequest) (*dto.InternshipInstructorResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship); err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.Get(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != types.UserRoleInstructor && user.Role != types.UserRoleAdmin {
+		return nil, ierr.NewError("user is not an instructor").
+			WithHint("Only instructors can be assigned to an internship").
+			WithReportableDetails(map[string]any{
+				"user_id": req.UserID,
+				"role":    user.Role,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	instructor := req.ToInternshipInstructor(ctx, internship.ID)
+	if err := s.InternshipInstructorRepo.Create(ctx, instructor); err != nil {
+		return nil, err
+	}
+
+	return &dto.InternshipInstructorResponse{InternshipInstructor: *instructor}, nil
+}
+
+func (s *internshipInstructorService) Update(ctx context.Context, internshipID string, userID string, req *dto.UpdateInternshipInstructorRequest) (*dto.InternshipInstructorResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship); err != nil {
+		return nil, err
+	}
+
+	var instructor *domainInternship.InternshipInstructor
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		instructor, err = s.InternshipInstructorRepo.GetByInternshipAndUser(ctx, internship.ID, userID)
+		if err != nil {
+			return err
+		}
+
+		if instructor.IsOwner() && req.Role != types.InternshipInstructorRoleOwner {
+			if err := s.ensureAnotherOwner(ctx, instructor); err != nil {
+				return err
+			}
+		}
+
+		instructor.Role = req.Role
+		return s.InternshipInstructorRepo.Update(ctx, instructor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.InternshipInstructorResponse{InternshipInstructor: *instructor}, nil
+}
+
+func (s *internshipInstructorService) Remove(ctx context.Context, internshipID string, userID string) error {
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship); err != nil {
+		return err
+	}
+
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		instructor, err := s.InternshipInstructorRepo.GetByInternshipAndUser(ctx, internship.ID, userID)
+		if err != nil {
+			return err
+		}
+
+		if instructor.IsOwner() {
+			if err := s.ensureAnotherOwner(ctx, instructor); err != nil {
+				return err
+			}
+		}
+
+		return s.InternshipInstructorRepo.Delete(ctx, instructor.ID)
+	})
+}
+
+// ensureAnotherOwner keeps an internship from losing its last owner
+func (s *internshipInstructorService) ensureAnotherOwner(ctx context.Context, owner *domainInternship.InternshipInstructor) error {
+	filter := types.NewNoLimitInternshipInstructorFilter()
+	filter.InternshipIDs = []string{owner.InternshipID}
+	filter.Roles = []types.InternshipInstructorRole{types.InternshipInstructorRoleOwner}
+
+	count, err := s.InternshipInstructorRepo.Count(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if count <= 1 {
+		return ierr.NewError("internship must keep an owner").
+			WithHint("Assign another owner before removing or demoting the last one").
+			WithReportableDetails(map[string]any{
+				"internship_id": owner.InternshipID,
+				"user_id":       owner.UserID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return nil
+}
+
+// authorizeInternship checks the caller may perform the action on the internship through the
+// authorization service. Internships created before instructors were tracked have no owners,
+// the ownership policy falls back to their creator for those.
+func authorizeInternship(ctx context.Context, params ServiceParams, internship *domainInternship.Internship, action domainAuth.Permission) error {
+	filter := types.NewNoLimitInternshipInstructorFilter()
+	filter.InternshipIDs = []string{internship.ID}
+	filter.Roles = []types.InternshipInstructorRole{types.InternshipInstructorRoleOwner}
+
+	owners, err := params.InternshipInstructorRepo.ListAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	attributes := map[string]interface{}{
+		"created_by": internship.CreatedBy,
+	}
+	if len(owners) > 0 {
+		attributes["owner_ids"] = lo.Map(owners, func(owner *domainInternship.InternshipInstructor, _ int) string {
+			return owner.UserID
+		})
+	}
+
+	allowed, err := params.AuthzService.IsAuthorized(ctx, &domainAuth.AccessRequest{
+		Subject: &domainAuth.AuthContext{
+			UserID: types.GetUserID(ctx),
+			Email:  types.GetUserEmail(ctx),
+			Role:   types.GetUserRole(ctx),
+		},
+		Resource: &domainAuth.Resource{
+			Type:       domainAuth.ResourceTypeInternship,
+			ID:         internship.ID,
+			Attributes: attributes,
+		},
+		Action: action,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return ierr.NewError("not allowed to modify internship").
+			WithHint("Only owners of the internship and admins can change it").
+			WithReportableDetails(map[string]any{
+				"internship_id": internship.ID,
+				"action":        action,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return nil
+}
@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+type InternshipReviewService interface {
+	// Create rates and reviews an internship, only students who completed it can review it and
+	// they review it once
+	Create(ctx context.Context, internshipID string, req *dto.CreateReviewRequest) (*dto.ReviewResponse, error)
+
+	// Get returns a published review, hidden reviews are shown to their author, instructors and admins
+	Get(ctx context.Context, id string) (*dto.ReviewResponse, error)
+
+	// List returns the published reviews of an internship, instructors and admins also see hidden ones
+	List(ctx context.Context, internshipID string, filter *types.InternshipReviewFilter) (*dto.ListReviewResponse, error)
+
+	// ListAll returns the reviews of every internship for moderators, filter by reported to
+	// work through the reported ones
+	ListAll(ctx context.Context, filter *types.InternshipReviewFilter) (*dto.ListReviewResponse, error)
+
+	// Update changes the review of the current student
+	Update(ctx context.Context, id string, req *dto.UpdateReviewRequest) (*dto.ReviewResponse, error)
+
+	// Delete removes a review, authors and admins can do this
+	Delete(ctx context.Context, id string) error
+
+	// Reply answers a review on behalf of the internship, its instructors can do this
+	Reply(ctx context.Context, id string, req *dto.ReplyReviewRequest) (*dto.ReviewResponse, error)
+
+	// Report flags a review for moderators, every user reports a review once
+	Report(ctx context.Context, id string, req *dto.ReportReviewRequest) (*dto.ReviewResponse, error)
+
+	// Hide takes a review off its internship and out of its rating, admins can do this
+	Hide(ctx context.Context, id string, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error)
+
+	// Restore shows a hidden review again and dismisses its reports, admins can do this
+	Restore(ctx context.Context, id string, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error)
+}
+
+type internshipReviewService struct {
+	ServiceParams
+}
+
+func NewInternshipReviewService(params ServiceParams) InternshipReviewService {
+	return &internshipReviewService{
+		ServiceParams: params,
+	}
+}
+
+func (s *internshipReviewService) Create(ctx context.Context, internshipID string, req *dto.CreateReviewRequest) (*dto.ReviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeReview(ctx, internship); err != nil {
+		return nil, err
+	}
+
+	enrollmentFilter := types.NewNoLimitInternshipEnrollmentFilter()
+	enrollmentFilter.UserID = types.GetUserID(ctx)
+	enrollmentFilter.InternshipIDs = []string{internship.ID}
+	enrollmentFilter.EnrollmentStatus = types.InternshipEnrollmentStatusCompleted
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, enrollmentFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, ok := lo.Find(enrollments, (*internshipenrollment.InternshipEnrollment).HasCompleted)
+	if !ok {
+		return nil, errNotCompleted(internship)
+	}
+
+	if _, err := s.ReviewRepo.GetByInternshipAndUser(ctx, internship.ID, types.GetUserID(ctx)); err == nil {
+		return nil, ierr.NewError("internship already reviewed").
+			WithHint("You already reviewed this internship, update your review instead").
+			WithReportableDetails(map[string]any{
+				"internship_id": internship.ID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	} else if !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	review := req.ToReview(ctx, enrollment)
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.ReviewRepo.Create(ctx, review); err != nil {
+			return err
+		}
+		return s.refreshRating(ctx, internship.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewReviewResponse(review, s.isModerator(ctx)), nil
+}
+
+func (s *internshipReviewService) Get(ctx context.Context, id string) (*dto.ReviewResponse, error) {
+	review, err := s.ReviewRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !review.IsPublished() && review.UserID != types.GetUserID(ctx) {
+		internship, err := s.InternshipRepo.Get(ctx, review.InternshipID)
+		if err != nil {
+			return nil, err
+		}
+
+		// hidden reviews look the same as missing ones
+		if !s.canManage(ctx, internship) {
+			return nil, ierr.NewError("review not found").
+				WithHintf("Review with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"review_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+	}
+
+	return dto.NewReviewResponse(review, s.isModerator(ctx)), nil
+}
+
+func (s *internshipReviewService) List(ctx context.Context, internshipID string, filter *types.InternshipReviewFilter) (*dto.ListReviewResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipReviewFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.InternshipIDs = []string{internship.ID}
+	if !s.canManage(ctx, internship) {
+		filter.ReviewStatus = types.ReviewStatusPublished
+		filter.Reported = false
+	}
+
+	return s.list(ctx, filter)
+}
+
+func (s *internshipReviewService) ListAll(ctx context.Context, filter *types.InternshipReviewFilter) (*dto.ListReviewResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipReviewFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !s.isModerator(ctx) {
+		return nil, errNotModerator()
+	}
+
+	return s.list(ctx, filter)
+}
+
+func (s *internshipReviewService) Update(ctx context.Context, id string, req *dto.UpdateReviewRequest) (*dto.ReviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	review, err := s.ReviewRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("not the author of the review").
+			WithHint("Only the author of a review can change it").
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	req.ApplyTo(review)
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.ReviewRepo.Update(ctx, review); err != nil {
+			return err
+		}
+		return s.refreshRating(ctx, review.InternshipID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewReviewResponse(review, s.isModerator(ctx)), nil
+}
+
+func (s *internshipReviewService) Delete(ctx context.Context, id string) error {
+	review, err := s.ReviewRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if review.UserID != types.GetUserID(ctx) && !s.isModerator(ctx) {
+		return ierr.NewError("not allowed to delete review").
+			WithHint("Only the author of a review and admins can delete it").
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.ReviewRepo.Delete(ctx, review.ID); err != nil {
+			return err
+		}
+		return s.refreshRating(ctx, review.InternshipID)
+	})
+}
+
+func (s *internshipReviewService) Reply(ctx context.Context, id string, req *dto.ReplyReviewRequest) (*dto.ReviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	review, err := s.ReviewRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, review.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canManage(ctx, internship) {
+		return nil, ierr.NewError("not an instructor of the internship").
+			WithHint("Only instructors of the internship and admins can reply to its reviews").
+			WithReportableDetails(map[string]any{
+				"review_id":     review.ID,
+				"internship_id": internship.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	review.Reply = lo.ToPtr(req.Reply)
+	review.RepliedBy = lo.ToPtr(types.GetUserID(ctx))
+	review.RepliedAt = lo.ToPtr(time.Now().UTC())
+
+	if err := s.ReviewRepo.Update(ctx, review); err != nil {
+		return nil, err
+	}
+
+	s.publishReviewEvent(ctx, types.WebhookEventReviewReplied, review)
+
+	return dto.NewReviewResponse(review, s.isModerator(ctx)), nil
+}
+
+func (s *internshipReviewService) Report(ctx context.Context, id string, req *dto.ReportReviewRequest) (*dto.ReviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	review, err := s.ReviewRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := types.GetUserID(ctx)
+	if review.UserID == userID {
+		return nil, ierr.NewError("cannot report own review").
+			WithHint("You can't report your own review").
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if review.ReportedBy(userID) {
+		return nil, ierr.NewError("review already reported").
+			WithHint("You already reported this review").
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	review.Reports = append(review.Reports, types.ReviewReport{
+		UserID:     userID,
+		Reason:     req.Reason,
+		ReportedAt: time.Now().UTC(),
+	})
+	review.ReportCount = len(review.Reports)
+
+	if err := s.ReviewRepo.Update(ctx, review); err != nil {
+		return nil, err
+	}
+
+	return dto.NewReviewResponse(review, s.isModerator(ctx)), nil
+}
+
+func (s *internshipReviewService) Hide(ctx context.Context, id string, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error) {
+	return s.moderate(ctx, id, req, types.ReviewStatusPublished, types.ReviewStatusHidden, types.WebhookEventReviewHidden)
+}
+
+func (s *internshipReviewService) Restore(ctx context.Context, id string, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error) {
+	return s.moderate(ctx, id, req, types.ReviewStatusHidden, types.ReviewStatusPublished, types.WebhookEventReviewRestored)
+}
+
+func (s *internshipReviewService) list(ctx context.Context, filter *types.InternshipReviewFilter) (*dto.ListReviewResponse, error) {
+	reviews, err := s.ReviewRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, reviews, s.ReviewRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	moderator := s.isModerator(ctx)
+	return &dto.ListReviewResponse{
+		Items: lo.Map(reviews, func(review *internshipreview.InternshipReview, _ int) *dto.ReviewResponse {
+			return dto.NewReviewResponse(review, moderator)
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+// moderate moves a review from one review status to the other and tells its author
+func (s *internshipReviewService) moderate(
+	ctx context.Context,
+	id string,
+	req *dto.ModerateReviewRequest,
+	from types.ReviewStatus,
+	to types.ReviewStatus,
+	eventName string,
+) (*dto.ReviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !s.isModerator(ctx) {
+		return nil, errNotModerator()
+	}
+
+	review, err := s.ReviewRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.ReviewStatus != from {
+		return nil, ierr.NewErrorf("review is not %s", from).
+			WithHintf("Only %s reviews can be %s", from, to).
+			WithReportableDetails(map[string]any{
+				"review_id":     review.ID,
+				"review_status": review.ReviewStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	review.ReviewStatus = to
+	review.ModerationNote = req.Note
+	review.ModeratedBy = lo.ToPtr(types.GetUserID(ctx))
+	review.ModeratedAt = lo.ToPtr(time.Now().UTC())
+
+	// restoring a review settles what it was reported for
+	if to == types.ReviewStatusPublished {
+		review.Reports = []types.ReviewReport{}
+		review.ReportCount = 0
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.ReviewRepo.Update(ctx, review); err != nil {
+			return err
+		}
+		return s.refreshRating(ctx, review.InternshipID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishReviewEvent(ctx, eventName, review)
+
+	return dto.NewReviewResponse(review, true), nil
+}
+
+// authorizeReview checks through the authorization service that the caller completed the
+// internship, their completed internships are loaded by the enrollment attribute provider
+func (s *internshipReviewService) authorizeReview(ctx context.Context, internship *domainInternship.Internship) error {
+	allowed, err := s.AuthzService.IsAuthorized(ctx, &domainAuth.AccessRequest{
+		Subject: &domainAuth.AuthContext{
+			UserID:     types.GetUserID(ctx),
+			Email:      types.GetUserEmail(ctx),
+			Role:       types.GetUserRole(ctx),
+			Attributes: map[string]interface{}{},
+		},
+		Resource: &domainAuth.Resource{
+			Type: domainAuth.ResourceTypeInternship,
+			ID:   internship.ID,
+			Attributes: map[string]interface{}{
+				"internship_id": internship.ID,
+			},
+		},
+		Action: domainAuth.PermissionReviewInternship,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return errNotCompleted(internship)
+	}
+
+	return nil
+}
+
+// refreshRating stores the rating of the published reviews on the internship
+func (s *internshipReviewService) refreshRating(ctx context.Context, internshipID string) error {
+	filter := types.NewNoLimitInternshipReviewFilter()
+	filter.InternshipIDs = []string{internshipID}
+	filter.ReviewStatus = types.ReviewStatusPublished
+
+	reviews, err := s.ReviewRepo.ListAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	average, count := internshipreview.Summarize(reviews)
+	return s.InternshipRepo.UpdateRating(ctx, internshipID, average, count)
+}
+
+// canManage reports whether the caller sees hidden reviews of the internship and replies to
+// its reviews, its instructors and admins do
+func (s *internshipReviewService) canManage(ctx context.Context, internship *domainInternship.Internship) bool {
+	return (&internshipService{ServiceParams: s.ServiceParams}).canReview(ctx, internship)
+}
+
+// isModerator reports whether the caller moderates reviews, admins do
+func (s *internshipReviewService) isModerator(ctx context.Context) bool {
+	return types.GetUserRole(ctx) == types.UserRoleAdmin
+}
+
+func (s *internshipReviewService) publishReviewEvent(ctx context.Context, eventName string, review *internshipreview.InternshipReview) {
+	payload, err := json.Marshal(&webhookDto.ReviewWebhookPayload{
+		ReviewID:       review.ID,
+		InternshipID:   review.InternshipID,
+		Rating:         review.Rating,
+		ReviewStatus:   string(review.ReviewStatus),
+		Reply:          review.Reply,
+		ModerationNote: review.ModerationNote,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build review event",
+			"review_id", review.ID,
+			"event_name", eventName,
+			"error", err)
+		return
+	}
+
+	err = s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		UserID:    lo.ToPtr(review.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to publish review event",
+			"review_id", review.ID,
+			"event_name", eventName,
+			"error", err)
+	}
+}
+
+func errNotCompleted(internship *domainInternship.Internship) error {
+	return ierr.NewError("internship not completed").
+		WithHint("Only students who completed the internship can review it").
+		WithReportableDetails(map[string]any{
+			"internship_id": internship.ID,
+		}).
+		Mark(ierr.ErrPermissionDenied)
+}
+
+func errNotModerator() error {
+	return ierr.NewError("not a moderator").
+		WithHint("Only admins can moderate reviews").
+		Mark(ierr.ErrPermissionDenied)
+}
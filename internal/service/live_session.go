@@ -0,0 +1,760 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/calendar"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type LiveSessionService interface {
+	// Create schedules a live session for a batch
+	Create(ctx context.Context, batchID string, req *dto.CreateLiveSessionRequest) (*dto.LiveSessionResponse, error)
+
+	// Get returns a live session to the students of its batch and instructors of the internship
+	Get(ctx context.Context, id string) (*dto.LiveSessionResponse, error)
+
+	// List returns the schedule of a batch in the order the sessions take place
+	List(ctx context.Context, batchID string, filter *types.LiveSessionFilter) (*dto.ListLiveSessionResponse, error)
+
+	Update(ctx context.Context, id string, req *dto.UpdateLiveSessionRequest) (*dto.LiveSessionResponse, error)
+
+	// Cancel calls off a session, it no longer counts towards attendance
+	Cancel(ctx context.Context, id string) (*dto.LiveSessionResponse, error)
+
+	Delete(ctx context.Context, id string) error
+
+	// GetRecording returns where the recording of a session can be watched
+	GetRecording(ctx context.Context, id string) (*dto.LiveSessionRecordingResponse, error)
+
+	// Join records the current student joining a session and returns its meeting link
+	Join(ctx context.Context, id string) (*dto.JoinLiveSessionResponse, error)
+
+	// Leave records the current student leaving a session
+	Leave(ctx context.Context, id string) (*dto.AttendanceResponse, error)
+
+	// MarkAttendance records the attendance of students of the batch, for instructors
+	MarkAttendance(ctx context.Context, id string, req *dto.MarkAttendanceRequest) (*dto.ListAttendanceResponse, error)
+
+	// ListAttendance returns the attendance of a session, students only see their own
+	ListAttendance(ctx context.Context, id string, filter *types.SessionAttendanceFilter) (*dto.ListAttendanceResponse, error)
+
+	// ListBatchAttendance returns the attendance percentage of each enrollment of a batch,
+	// students only see their own
+	ListBatchAttendance(ctx context.Context, batchID string, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentAttendanceResponse, error)
+
+	// GetCalendarFeed returns the private calendar feed of the current student
+	GetCalendarFeed(ctx context.Context) (*dto.CalendarFeedResponse, error)
+
+	// RenderCalendar renders the upcoming sessions of the batches of a student as an iCalendar
+	// feed, the token of the feed stands in for authentication
+	RenderCalendar(ctx context.Context, userID string, token string) ([]byte, error)
+}
+
+type liveSessionService struct {
+	ServiceParams
+}
+
+func NewLiveSessionService(params ServiceParams) LiveSessionService {
+	return &liveSessionService{
+		ServiceParams: params,
+	}
+}
+
+func (s *liveSessionService) Create(ctx context.Context, batchID string, req *dto.CreateLiveSessionRequest) (*dto.LiveSessionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeManage(ctx, batch.InternshipID); err != nil {
+		return nil, err
+	}
+
+	session := req.ToLiveSession(ctx, batch)
+	if err := s.validate(ctx, session); err != nil {
+		return nil, err
+	}
+
+	if err := s.LiveSessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &dto.LiveSessionResponse{LiveSession: *session}, nil
+}
+
+func (s *liveSessionService) Get(ctx context.Context, id string) (*dto.LiveSessionResponse, error) {
+	session, err := s.getVisibleSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LiveSessionResponse{LiveSession: *session}, nil
+}
+
+func (s *liveSessionService) List(ctx context.Context, batchID string, filter *types.LiveSessionFilter) (*dto.ListLiveSessionResponse, error) {
+	if filter == nil {
+		filter = types.NewLiveSessionFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizeView(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	filter.InternshipBatchIDs = []string{batch.ID}
+
+	sessions, err := s.LiveSessionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, sessions, s.LiveSessionRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListLiveSessionResponse{
+		Items: lo.Map(sessions, func(session *livesession.LiveSession, _ int) *dto.LiveSessionResponse {
+			return &dto.LiveSessionResponse{LiveSession: *session}
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *liveSessionService) Update(ctx context.Context, id string, req *dto.UpdateLiveSessionRequest) (*dto.LiveSessionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, err := s.getManagedSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.IsCancelled() {
+		return nil, ierr.NewError("live session is cancelled").
+			WithHint("Cancelled sessions can't be changed, schedule a new one instead").
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	recordingFileID := session.RecordingFileID
+	req.ApplyTo(session)
+
+	if lo.FromPtr(session.RecordingFileID) == lo.FromPtr(recordingFileID) {
+		// an unchanged upload was checked when it was set
+		recordingFileID = nil
+	} else {
+		recordingFileID = session.RecordingFileID
+	}
+
+	if err := session.Validate(); err != nil {
+		return nil, err
+	}
+
+	if recordingFileID != nil {
+		if err := (&contentService{ServiceParams: s.ServiceParams}).validateFile(ctx, lo.FromPtr(recordingFileID)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.LiveSessionRepo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &dto.LiveSessionResponse{LiveSession: *session}, nil
+}
+
+func (s *liveSessionService) Cancel(ctx context.Context, id string) (*dto.LiveSessionResponse, error) {
+	session, err := s.getManagedSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.IsCancelled() {
+		return nil, ierr.NewError("live session is already cancelled").
+			WithHint("This session was already cancelled").
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	session.SessionStatus = types.LiveSessionStatusCancelled
+	session.CancelledAt = lo.ToPtr(time.Now().UTC())
+
+	if err := s.LiveSessionRepo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &dto.LiveSessionResponse{LiveSession: *session}, nil
+}
+
+func (s *liveSessionService) Delete(ctx context.Context, id string) error {
+	session, err := s.getManagedSession(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.LiveSessionRepo.Delete(ctx, session.ID)
+}
+
+func (s *liveSessionService) GetRecording(ctx context.Context, id string) (*dto.LiveSessionRecordingResponse, error) {
+	session, err := s.getVisibleSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.RecordingURL != nil {
+		return &dto.LiveSessionRecordingResponse{
+			LiveSessionID: session.ID,
+			URL:           lo.FromPtr(session.RecordingURL),
+		}, nil
+	}
+
+	if session.RecordingFileID == nil {
+		return nil, ierr.NewError("live session has no recording").
+			WithHint("No recording was added for this session yet").
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	file, err := s.FileUploadRepo.Get(ctx, lo.FromPtr(session.RecordingFileID))
+	if err != nil {
+		return nil, err
+	}
+
+	url := file.PublicURL
+	if lo.FromPtr(file.SecureURL) != "" {
+		url = lo.FromPtr(file.SecureURL)
+	}
+
+	return &dto.LiveSessionRecordingResponse{
+		LiveSessionID: session.ID,
+		URL:           url,
+	}, nil
+}
+
+func (s *liveSessionService) Join(ctx context.Context, id string) (*dto.JoinLiveSessionResponse, error) {
+	session, err := s.LiveSessionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if session.IsCancelled() || now.Before(session.StartsAt.Add(-s.Config.LiveSession.JoinWindow)) || session.HasEnded(now) {
+		return nil, ierr.NewError("live session is not open").
+			WithHintf("This session can be joined from %s before it starts until it ends", s.Config.LiveSession.JoinWindow).
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+				"session_status":  session.SessionStatus,
+				"starts_at":       session.StartsAt,
+				"ends_at":         session.EndsAt,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	enrollment, err := s.getBatchEnrollment(ctx, session.InternshipBatchID, (*internshipenrollment.InternshipEnrollment).IsActive)
+	if err != nil {
+		return nil, err
+	}
+
+	var attendance *livesession.Attendance
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		attendance, err = s.SessionAttendanceRepo.GetBySessionAndEnrollment(ctx, session.ID, enrollment.ID)
+		if err != nil && !ierr.IsNotFound(err) {
+			return err
+		}
+
+		if attendance == nil {
+			attendance = newAttendance(ctx, session, enrollment)
+			attendance.Join(now)
+			return s.SessionAttendanceRepo.Create(ctx, attendance)
+		}
+
+		attendance.Join(now)
+		return s.SessionAttendanceRepo.Update(ctx, attendance)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.JoinLiveSessionResponse{
+		LiveSessionID: session.ID,
+		MeetingURL:    session.MeetingURL,
+		Attendance:    *attendance,
+	}, nil
+}
+
+func (s *liveSessionService) Leave(ctx context.Context, id string) (*dto.AttendanceResponse, error) {
+	session, err := s.LiveSessionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.getBatchEnrollment(ctx, session.InternshipBatchID, (*internshipenrollment.InternshipEnrollment).IsActive)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance, err := s.SessionAttendanceRepo.GetBySessionAndEnrollment(ctx, session.ID, enrollment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance.Leave(time.Now().UTC(), session)
+
+	if err := s.SessionAttendanceRepo.Update(ctx, attendance); err != nil {
+		return nil, err
+	}
+
+	return &dto.AttendanceResponse{Attendance: *attendance}, nil
+}
+
+func (s *liveSessionService) MarkAttendance(ctx context.Context, id string, req *dto.MarkAttendanceRequest) (*dto.ListAttendanceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, err := s.LiveSessionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, session.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canReview(ctx, internship) {
+		return nil, ierr.NewError("not an instructor of the internship").
+			WithHint("Only instructors of the internship and admins can mark attendance").
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+				"internship_id":   internship.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	if session.IsCancelled() || time.Now().UTC().Before(session.StartsAt) {
+		return nil, ierr.NewError("live session has not taken place").
+			WithHint("Attendance can only be marked once the session started, and not for cancelled sessions").
+			WithReportableDetails(map[string]any{
+				"live_session_id": session.ID,
+				"session_status":  session.SessionStatus,
+				"starts_at":       session.StartsAt,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	enrollmentFilter := types.NewNoLimitInternshipEnrollmentFilter()
+	enrollmentFilter.InternshipBatchID = lo.ToPtr(session.InternshipBatchID)
+	enrollmentFilter.EnrollmentIDs = lo.Map(req.Marks, func(mark dto.AttendanceMark, _ int) string {
+		return mark.EnrollmentID
+	})
+
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, enrollmentFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := lo.KeyBy(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment) string {
+		return enrollment.ID
+	})
+
+	for _, mark := range req.Marks {
+		if _, ok := byID[mark.EnrollmentID]; !ok {
+			return nil, ierr.NewError("enrollment is not part of the batch").
+				WithHintf("Enrollment %s is not part of the batch of this session", mark.EnrollmentID).
+				WithReportableDetails(map[string]any{
+					"live_session_id": session.ID,
+					"enrollment_id":   mark.EnrollmentID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	markedBy := types.GetUserID(ctx)
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		for _, mark := range req.Marks {
+			attendance, err := s.SessionAttendanceRepo.GetBySessionAndEnrollment(ctx, session.ID, mark.EnrollmentID)
+			if err != nil && !ierr.IsNotFound(err) {
+				return err
+			}
+
+			if attendance == nil {
+				attendance = newAttendance(ctx, session, byID[mark.EnrollmentID])
+				attendance.AttendanceStatus = mark.AttendanceStatus
+				attendance.MarkedBy = lo.ToPtr(markedBy)
+				if err := s.SessionAttendanceRepo.Create(ctx, attendance); err != nil {
+					return err
+				}
+				continue
+			}
+
+			attendance.AttendanceStatus = mark.AttendanceStatus
+			attendance.MarkedBy = lo.ToPtr(markedBy)
+			if err := s.SessionAttendanceRepo.Update(ctx, attendance); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ListAttendance(ctx, session.ID, nil)
+}
+
+func (s *liveSessionService) ListAttendance(ctx context.Context, id string, filter *types.SessionAttendanceFilter) (*dto.ListAttendanceResponse, error) {
+	if filter == nil {
+		filter = types.NewSessionAttendanceFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, err := s.LiveSessionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, session.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.LiveSessionIDs = []string{session.ID}
+	if !s.canReview(ctx, internship) {
+		filter.UserIDs = []string{types.GetUserID(ctx)}
+	}
+
+	attendances, err := s.SessionAttendanceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, attendances, s.SessionAttendanceRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListAttendanceResponse{
+		Items: lo.Map(attendances, func(attendance *livesession.Attendance, _ int) *dto.AttendanceResponse {
+			return &dto.AttendanceResponse{Attendance: *attendance}
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *liveSessionService) ListBatchAttendance(ctx context.Context, batchID string, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentAttendanceResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipEnrollmentFilter()
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, batch.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.InternshipBatchID = lo.ToPtr(batch.ID)
+	if !s.canReview(ctx, internship) {
+		filter.UserID = types.GetUserID(ctx)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	enrollments, err := s.InternshipEnrollmentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, enrollments, s.InternshipEnrollmentRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionFilter := types.NewNoLimitLiveSessionFilter()
+	sessionFilter.InternshipBatchIDs = []string{batch.ID}
+	sessionFilter.SessionStatus = types.LiveSessionStatusScheduled
+	sessionFilter.EndsBefore = lo.ToPtr(time.Now().UTC())
+	held, err := s.LiveSessionRepo.ListAll(ctx, sessionFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	attendances := make([]*livesession.Attendance, 0)
+	if len(held) > 0 && len(enrollments) > 0 {
+		attendanceFilter := types.NewNoLimitSessionAttendanceFilter()
+		attendanceFilter.LiveSessionIDs = lo.Map(held, func(session *livesession.LiveSession, _ int) string {
+			return session.ID
+		})
+		attendanceFilter.EnrollmentIDs = lo.Map(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) string {
+			return enrollment.ID
+		})
+
+		attendances, err = s.SessionAttendanceRepo.ListAll(ctx, attendanceFilter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	byEnrollment := lo.GroupBy(attendances, func(attendance *livesession.Attendance) string {
+		return attendance.EnrollmentID
+	})
+
+	return &dto.ListEnrollmentAttendanceResponse{
+		Items: lo.Map(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) *dto.EnrollmentAttendanceResponse {
+			return summarizeAttendance(enrollment, len(held), byEnrollment[enrollment.ID])
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *liveSessionService) GetCalendarFeed(ctx context.Context) (*dto.CalendarFeedResponse, error) {
+	userID := types.GetUserID(ctx)
+	if userID == "" {
+		return nil, ierr.NewError("user not authenticated").
+			WithHint("Sign in to get your calendar feed").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return &dto.CalendarFeedResponse{
+		URL: fmt.Sprintf("%s/%s/sessions.ics?token=%s",
+			strings.TrimRight(s.Config.LiveSession.CalendarBaseURL, "/"),
+			url.PathEscape(userID),
+			s.SigningService.Sign(calendarFeedPayload(userID))),
+	}, nil
+}
+
+func (s *liveSessionService) RenderCalendar(ctx context.Context, userID string, token string) ([]byte, error) {
+	if !s.SigningService.Verify(calendarFeedPayload(userID), token) {
+		return nil, ierr.NewError("invalid calendar token").
+			WithHint("This calendar link is not valid, copy it again from your account").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	enrollmentFilter := types.NewNoLimitInternshipEnrollmentFilter()
+	enrollmentFilter.UserID = userID
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, enrollmentFilter)
+	if err != nil {
+		return nil, err
+	}
+	enrollments = lo.Filter(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) bool {
+		return enrollment.IsActive()
+	})
+
+	sessions := make([]*livesession.LiveSession, 0)
+	if len(enrollments) > 0 {
+		sessionFilter := types.NewNoLimitLiveSessionFilter()
+		sessionFilter.InternshipBatchIDs = lo.Uniq(lo.Map(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) string {
+			return enrollment.InternshipBatchID
+		}))
+		sessionFilter.SessionStatus = types.LiveSessionStatusScheduled
+		sessionFilter.EndsAfter = lo.ToPtr(time.Now().UTC())
+
+		sessions, err = s.LiveSessionRepo.ListAll(ctx, sessionFilter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feed := &calendar.Calendar{
+		ProductID: fmt.Sprintf("-//%s//Live Sessions//EN", s.Config.Certificate.IssuerName),
+		Name:      s.Config.LiveSession.CalendarName,
+		Events: lo.Map(sessions, func(session *livesession.LiveSession, _ int) calendar.Event {
+			return calendar.Event{
+				UID:         session.ID,
+				Summary:     session.Title,
+				Description: session.Description,
+				URL:         session.MeetingURL,
+				Start:       session.StartsAt,
+				End:         session.EndsAt,
+				UpdatedAt:   session.UpdatedAt,
+			}
+		}),
+	}
+
+	return feed.Render(), nil
+}
+
+// validate checks a session and the recording it was given
+func (s *liveSessionService) validate(ctx context.Context, session *livesession.LiveSession) error {
+	if err := session.Validate(); err != nil {
+		return err
+	}
+
+	if session.RecordingFileID != nil {
+		return (&contentService{ServiceParams: s.ServiceParams}).validateFile(ctx, lo.FromPtr(session.RecordingFileID))
+	}
+
+	return nil
+}
+
+// getVisibleSession returns a session the caller may see
+func (s *liveSessionService) getVisibleSession(ctx context.Context, id string) (*livesession.LiveSession, error) {
+	session, err := s.LiveSessionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, session.InternshipBatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizeView(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// authorizeView checks the caller is an instructor of the internship or a student of the batch,
+// current or past
+func (s *liveSessionService) authorizeView(ctx context.Context, batch *domainInternship.InternshipBatch) (*domainInternship.Internship, error) {
+	internship, err := s.InternshipRepo.Get(ctx, batch.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.canReview(ctx, internship) {
+		return internship, nil
+	}
+
+	if _, err := s.getBatchEnrollment(ctx, batch.ID, (*internshipenrollment.InternshipEnrollment).HasAccess); err != nil {
+		return nil, err
+	}
+
+	return internship, nil
+}
+
+// getBatchEnrollment returns the enrollment of the current student in the batch that satisfies the predicate
+func (s *liveSessionService) getBatchEnrollment(ctx context.Context, batchID string, predicate func(*internshipenrollment.InternshipEnrollment) bool) (*internshipenrollment.InternshipEnrollment, error) {
+	filter := types.NewNoLimitInternshipEnrollmentFilter()
+	filter.UserID = types.GetUserID(ctx)
+	filter.InternshipBatchID = lo.ToPtr(batchID)
+
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, ok := lo.Find(enrollments, predicate)
+	if !ok {
+		return nil, ierr.NewError("not enrolled in the batch").
+			WithHint("Only students of the batch can take part in its live sessions").
+			WithReportableDetails(map[string]any{
+				"internship_batch_id": batchID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return enrollment, nil
+}
+
+// getManagedSession returns a session the caller may change
+func (s *liveSessionService) getManagedSession(ctx context.Context, id string) (*livesession.LiveSession, error) {
+	session, err := s.LiveSessionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeManage(ctx, session.InternshipID); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *liveSessionService) authorizeManage(ctx context.Context, internshipID string) error {
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return err
+	}
+
+	return authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship)
+}
+
+// canReview reports whether the caller follows every student of the internship, every instructor does
+func (s *liveSessionService) canReview(ctx context.Context, internship *domainInternship.Internship) bool {
+	return (&internshipService{ServiceParams: s.ServiceParams}).canReview(ctx, internship)
+}
+
+func newAttendance(ctx context.Context, session *livesession.LiveSession, enrollment *internshipenrollment.InternshipEnrollment) *livesession.Attendance {
+	return &livesession.Attendance{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SESSION_ATTENDANCE),
+		LiveSessionID:     session.ID,
+		InternshipBatchID: session.InternshipBatchID,
+		EnrollmentID:      enrollment.ID,
+		UserID:            enrollment.UserID,
+		AttendanceStatus:  types.AttendanceStatusPresent,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// summarizeAttendance counts the attendance of an enrollment over the sessions its batch held,
+// sessions without a record count as missed
+func summarizeAttendance(enrollment *internshipenrollment.InternshipEnrollment, held int, attendances []*livesession.Attendance) *dto.EnrollmentAttendanceResponse {
+	summary := &dto.EnrollmentAttendanceResponse{
+		EnrollmentID:      enrollment.ID,
+		UserID:            enrollment.UserID,
+		InternshipBatchID: enrollment.InternshipBatchID,
+		HeldSessions:      held,
+		AttendedSessions: lo.CountBy(attendances, func(attendance *livesession.Attendance) bool {
+			return attendance.AttendanceStatus == types.AttendanceStatusPresent
+		}),
+		ExcusedSessions: lo.CountBy(attendances, func(attendance *livesession.Attendance) bool {
+			return attendance.AttendanceStatus == types.AttendanceStatusExcused
+		}),
+	}
+
+	if counted := held - summary.ExcusedSessions; counted > 0 {
+		summary.AttendancePercent = math.Round(float64(summary.AttendedSessions)*10000/float64(counted)) / 100
+	}
+
+	return summary
+}
+
+// calendarFeedPayload is what the token of the calendar feed of a user signs
+func calendarFeedPayload(userID string) string {
+	return "calendar|" + userID
+}
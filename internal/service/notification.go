@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/mail"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainNotification "github.com/omkar273/codegeeky/internal/domain/notification"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/notification"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type NotificationService interface {
+	// Notify creates the in-app notification of a domain event and emails it to the user, when
+	// they want to be notified of it. Errors worth retrying are returned, events that can't be
+	// notified are skipped and retried events don't notify twice
+	Notify(ctx context.Context, event *types.WebhookEvent) error
+
+	// List returns the notifications of the current user, newest first
+	List(ctx context.Context, filter *types.NotificationFilter) (*dto.ListNotificationResponse, error)
+
+	// MarkRead marks a notification of the current user read
+	MarkRead(ctx context.Context, id string) (*dto.NotificationResponse, error)
+
+	// MarkAllRead marks every notification of the current user read
+	MarkAllRead(ctx context.Context) (*dto.MarkAllNotificationsReadResponse, error)
+
+	// Delete deletes a notification of the current user
+	Delete(ctx context.Context, id string) error
+
+	// Subscribe returns the notifications of the current user as they are created, until unsubscribe is called
+	Subscribe(ctx context.Context) (notifications <-chan *domainNotification.Notification, unsubscribe func())
+
+	// GetPreferences returns how the current user is notified
+	GetPreferences(ctx context.Context) (*dto.NotificationPreferencesResponse, error)
+
+	// UpdatePreferences changes how the current user is notified
+	UpdatePreferences(ctx context.Context, req *dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error)
+}
+
+type notificationService struct {
+	ServiceParams
+}
+
+func NewNotificationService(params ServiceParams) NotificationService {
+	return &notificationService{
+		ServiceParams: params,
+	}
+}
+
+func (s *notificationService) Notify(ctx context.Context, event *types.WebhookEvent) error {
+	category, ok := types.GetNotificationCategory(event.EventName)
+	if !ok || lo.FromPtr(event.UserID) == "" {
+		return nil
+	}
+
+	user, err := s.UserRepo.Get(ctx, lo.FromPtr(event.UserID))
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			s.Logger.Warnw("user to notify not found",
+				"event_id", event.ID,
+				"user_id", lo.FromPtr(event.UserID))
+			return nil
+		}
+		return err
+	}
+
+	preferences := user.NotificationPreferences
+	if !preferences.Allows(category) {
+		return nil
+	}
+
+	data := &notification.Data{
+		AppName:       s.Config.Notification.AppName,
+		RecipientName: user.FullName,
+		Event:         make(map[string]any),
+	}
+	if err := json.Unmarshal(event.Payload, &data.Event); err != nil {
+		s.Logger.Errorw("failed to read notification event payload",
+			"event_id", event.ID,
+			"event_name", event.EventName,
+			"error", err)
+		return nil
+	}
+
+	// most events are about an internship, notifications name it
+	if internshipID, ok := data.Event["internship_id"].(string); ok && internshipID != "" {
+		internship, err := s.InternshipRepo.Get(ctx, internshipID)
+		if err != nil && !ierr.IsNotFound(err) {
+			return err
+		}
+		if internship != nil {
+			data.InternshipTitle = internship.Title
+		}
+	}
+
+	content, err := s.NotificationTemplates.Render(event.EventName, preferences.Locale, data)
+	if err != nil {
+		if !ierr.IsNotFound(err) {
+			s.Logger.Errorw("failed to render notification",
+				"event_id", event.ID,
+				"event_name", event.EventName,
+				"error", err)
+		}
+		return nil
+	}
+
+	if err := s.createInApp(ctx, event, category, user.ID, content, data.Event); err != nil {
+		return err
+	}
+
+	if !s.Config.Notification.Enabled || !preferences.AllowsEmail(category) || user.Email == "" {
+		return nil
+	}
+
+	message := &notification.Message{
+		ID: event.ID,
+		From: mail.Address{
+			Name:    s.Config.Notification.FromName,
+			Address: s.Config.Notification.FromAddress,
+		},
+		To: mail.Address{
+			Name:    user.FullName,
+			Address: user.Email,
+		},
+		Subject:  content.Subject,
+		Text:     content.Text,
+		HTML:     content.HTML,
+		SentAt:   time.Now().UTC(),
+		Category: category,
+	}
+
+	if err := s.NotificationProvider.Send(ctx, message); err != nil {
+		s.Logger.Errorw("failed to send notification",
+			"event_id", event.ID,
+			"event_name", event.EventName,
+			"user_id", user.ID,
+			"provider", s.NotificationProvider.GetProvider(),
+			"error", err)
+		return err
+	}
+
+	return nil
+}
+
+// createInApp stores the notification shown in the notification bell and streams it to the
+// user's open connections, events retried after a failed email were already stored
+func (s *notificationService) createInApp(ctx context.Context, event *types.WebhookEvent, category types.NotificationCategory, userID string, content *notification.Content, payload map[string]any) error {
+	item := &domainNotification.Notification{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_NOTIFICATION),
+		UserID:    userID,
+		EventID:   event.ID,
+		EventName: event.EventName,
+		Category:  category,
+		Title:     content.Subject,
+		Body:      content.Summary,
+		Data:      payload,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+
+	if err := s.NotificationRepo.Create(ctx, item); err != nil {
+		if ierr.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+
+	s.NotificationStream.Publish(item)
+	return nil
+}
+
+func (s *notificationService) List(ctx context.Context, filter *types.NotificationFilter) (*dto.ListNotificationResponse, error) {
+	if filter == nil {
+		filter = types.NewNotificationFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	userID := types.GetUserID(ctx)
+	filter.UserIDs = []string{userID}
+
+	items, err := s.NotificationRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, items, s.NotificationRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadFilter := types.NewNoLimitNotificationFilter()
+	unreadFilter.UserIDs = []string{userID}
+	unreadFilter.Unread = true
+
+	unread, err := s.NotificationRepo.Count(ctx, unreadFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListNotificationResponse{UnreadCount: unread}
+	response.Items = lo.Map(items, func(item *domainNotification.Notification, _ int) *dto.NotificationResponse {
+		return &dto.NotificationResponse{Notification: *item}
+	})
+	response.Pagination = pagination
+
+	return response, nil
+}
+
+func (s *notificationService) MarkRead(ctx context.Context, id string) (*dto.NotificationResponse, error) {
+	item, err := s.getOwn(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !item.IsRead() {
+		item.ReadAt = lo.ToPtr(time.Now().UTC())
+		if err := s.NotificationRepo.Update(ctx, item); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.NotificationResponse{Notification: *item}, nil
+}
+
+func (s *notificationService) MarkAllRead(ctx context.Context) (*dto.MarkAllNotificationsReadResponse, error) {
+	updated, err := s.NotificationRepo.MarkAllRead(ctx, types.GetUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MarkAllNotificationsReadResponse{Updated: updated}, nil
+}
+
+func (s *notificationService) Delete(ctx context.Context, id string) error {
+	if _, err := s.getOwn(ctx, id); err != nil {
+		return err
+	}
+
+	return s.NotificationRepo.Delete(ctx, id)
+}
+
+func (s *notificationService) Subscribe(ctx context.Context) (<-chan *domainNotification.Notification, func()) {
+	return s.NotificationStream.Subscribe(types.GetUserID(ctx))
+}
+
+// getOwn returns a notification of the current user, notifications of other users are not found
+func (s *notificationService) getOwn(ctx context.Context, id string) (*domainNotification.Notification, error) {
+	item, err := s.NotificationRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("notification not found").
+			WithHintf("Notification with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"notification_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return item, nil
+}
+
+func (s *notificationService) GetPreferences(ctx context.Context) (*dto.NotificationPreferencesResponse, error) {
+	user, err := s.UserRepo.Get(ctx, types.GetUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewNotificationPreferencesResponse(user.NotificationPreferences), nil
+}
+
+func (s *notificationService) UpdatePreferences(ctx context.Context, req *dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.Get(ctx, types.GetUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(&user.NotificationPreferences)
+	if err := user.NotificationPreferences.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.UserRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return dto.NewNotificationPreferencesResponse(user.NotificationPreferences), nil
+}
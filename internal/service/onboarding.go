@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/samber/lo"
 )
 
 type OnboardingService interface {
-	Onboard(ctx context.Context, req *dto.OnboardingRequest) error
+	Onboard(ctx context.Context, req *dto.OnboardingRequest) (*dto.OnboardingResponse, error)
 }
 
 type onboardingService struct {
@@ -20,11 +21,11 @@ func NewOnboardingService(params ServiceParams) OnboardingService {
 	}
 }
 
-func (s *onboardingService) Onboard(ctx context.Context, req *dto.OnboardingRequest) error {
+func (s *onboardingService) Onboard(ctx context.Context, req *dto.OnboardingRequest) (*dto.OnboardingResponse, error) {
 
 	// validate request
 	if err := req.Validate(); err != nil {
-		return err
+		return nil, err
 	}
 
 	user := req.ToUser(ctx)
@@ -32,13 +33,46 @@ func (s *onboardingService) Onboard(ctx context.Context, req *dto.OnboardingRequ
 	// update user with provider user id
 	user.ID = req.ProviderUserID
 
+	referralService := NewReferralService(s.ServiceParams)
+
+	// every user gets a referral code to share
+	referralCode, err := referralService.GenerateReferralCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user.ReferralCode = lo.ToPtr(referralCode)
+
+	applyReferral := s.Config.Referral.Enabled && req.ReferralCode != ""
+	if applyReferral {
+		referrer, err := referralService.ValidateReferralCode(ctx, req.ReferralCode)
+		if err != nil {
+			return nil, err
+		}
+		user.ReferredBy = lo.ToPtr(referrer.ID)
+	}
+
 	// create user
-	err := s.ServiceParams.UserRepo.Create(ctx, user)
+	err = s.ServiceParams.UserRepo.Create(ctx, user)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	response := &dto.OnboardingResponse{
+		SignupResponse: dto.SignupResponse{
+			ID: user.ID,
+		},
+	}
+
+	if applyReferral {
+		referral, err := referralService.ApplyReferral(ctx, user, req.ReferralCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if referral.RefereeDiscount != nil {
+			response.ReferralDiscountCode = referral.RefereeDiscount.Code
+		}
 	}
 
-	// NOTE: Currently we are only craeting user during onboarding
-	// We will also have functionality to give user credits, goodies, etc.
-	return nil
+	return response, nil
 }
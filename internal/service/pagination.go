@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// paginate builds the pagination of a listed page. The total is counted unless
+// the filter skips it, or pages by cursor where a count would only cover the
+// rows after the cursor.
+func paginate[T any, F types.BaseFilter](
+	ctx context.Context,
+	filter F,
+	items []T,
+	count func(context.Context, F) (int, error),
+) (types.PaginationResponse, error) {
+	if filter.IsCountSkipped() || filter.GetCursor() != "" {
+		return types.NewCursorPaginationResponse(items, filter, nil), nil
+	}
+
+	total, err := count(ctx, filter)
+	if err != nil {
+		return types.PaginationResponse{}, err
+	}
+
+	return types.NewCursorPaginationResponse(items, filter, &total), nil
+}
@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
 	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
 	"github.com/samber/lo"
 )
 
@@ -32,6 +35,9 @@ type PaymentService interface {
 	MarkAsSuccess(ctx context.Context, paymentID string, gatewayPaymentID *string, metadata map[string]string) (*dto.PaymentResponse, error)
 	MarkAsFailed(ctx context.Context, paymentID string, errorMessage string, metadata map[string]string) (*dto.PaymentResponse, error)
 	MarkAsRefunded(ctx context.Context, paymentID string, metadata map[string]string) (*dto.PaymentResponse, error)
+
+	// Refund refunds a successful payment to its source or to the payer's wallet
+	Refund(ctx context.Context, paymentID string, req *dto.RefundPaymentRequest) (*dto.PaymentResponse, error)
 }
 
 type paymentService struct {
@@ -136,6 +142,8 @@ func (s *paymentService) Update(ctx context.Context, id string, req *dto.UpdateP
 	}
 
 	var updatedPayment *domainPayment.Payment
+	var previousStatus types.PaymentStatus
+	var confirmedEnrollment *domainInternshipEnrollment.InternshipEnrollment
 
 	err := s.ServiceParams.DB.WithTx(ctx, func(ctx context.Context) error {
 		// Get existing payment
@@ -143,6 +151,7 @@ func (s *paymentService) Update(ctx context.Context, id string, req *dto.UpdateP
 		if err != nil {
 			return err
 		}
+		previousStatus = existingPayment.PaymentStatus
 
 		// Update fields
 		if req.PaymentStatus != nil {
@@ -177,6 +186,14 @@ func (s *paymentService) Update(ctx context.Context, id string, req *dto.UpdateP
 			return err
 		}
 
+		// the status only changes together with its side effects
+		if existingPayment.PaymentStatus != previousStatus {
+			confirmedEnrollment, err = s.handleStatusTransition(ctx, existingPayment)
+			if err != nil {
+				return err
+			}
+		}
+
 		updatedPayment = existingPayment
 		return nil
 	})
@@ -185,11 +202,88 @@ func (s *paymentService) Update(ctx context.Context, id string, req *dto.UpdateP
 		return nil, err
 	}
 
+	if updatedPayment.PaymentStatus != previousStatus && updatedPayment.PaymentStatus == types.PaymentStatusSuccess {
+		if err := s.publishPaymentSucceeded(ctx, updatedPayment); err != nil {
+			s.ServiceParams.Logger.Errorw("failed to publish payment succeeded event",
+				"payment_id", updatedPayment.ID,
+				"error", err)
+		}
+	}
+
+	if confirmedEnrollment != nil {
+		if err := publishEnrollmentConfirmed(ctx, s.ServiceParams, confirmedEnrollment); err != nil {
+			s.ServiceParams.Logger.Errorw("failed to publish enrollment confirmed event",
+				"enrollment_id", confirmedEnrollment.ID,
+				"error", err)
+		}
+	}
+
 	return &dto.PaymentResponse{
 		Payment: *updatedPayment,
 	}, nil
 }
 
+// handleStatusTransition runs the side effects of a payment status change. It runs in
+// the transaction of the update, a failure rolls the status change back with it.
+// It returns the enrollment the payment confirmed, announced once the update committed.
+func (s *paymentService) handleStatusTransition(ctx context.Context, payment *domainPayment.Payment) (*domainInternshipEnrollment.InternshipEnrollment, error) {
+	referralService := NewReferralService(s.ServiceParams)
+
+	switch payment.PaymentStatus {
+	case types.PaymentStatusSuccess:
+		if err := referralService.HandlePaymentSucceeded(ctx, payment); err != nil {
+			return nil, err
+		}
+		return NewPaymentPlanService(s.ServiceParams).HandleEnrollmentPaid(ctx, payment)
+	case types.PaymentStatusRefunded:
+		if err := referralService.HandlePaymentRefunded(ctx, payment); err != nil {
+			return nil, err
+		}
+		if payment.DestinationType == types.PaymentDestinationTypeEnrollment {
+			return nil, NewCertificateService(s.ServiceParams).RevokeForEnrollment(ctx, payment.DestinationID, "Enrollment was refunded")
+		}
+	}
+
+	return nil, nil
+}
+
+// publishPaymentSucceeded sends the payer a receipt of the payment
+func (s *paymentService) publishPaymentSucceeded(ctx context.Context, payment *domainPayment.Payment) error {
+	receipt := &webhookDto.PaymentWebhookPayload{
+		PaymentID:         payment.ID,
+		DestinationType:   payment.DestinationType,
+		DestinationID:     payment.DestinationID,
+		Amount:            payment.Amount,
+		Currency:          payment.Currency.String(),
+		GatewayPaymentID:  payment.GatewayPaymentID,
+		InstallmentNumber: payment.InstallmentNumber,
+		SucceededAt:       lo.FromPtrOr(payment.SucceededAt, time.Now().UTC()),
+	}
+
+	if payment.DestinationType == types.PaymentDestinationTypeEnrollment {
+		enrollment, err := s.ServiceParams.InternshipEnrollmentRepo.Get(ctx, payment.DestinationID)
+		if err != nil {
+			return err
+		}
+		receipt.InternshipID = enrollment.InternshipID
+	}
+
+	payload, err := json.Marshal(receipt)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build payment succeeded event").
+			Mark(ierr.ErrInternal)
+	}
+
+	return s.ServiceParams.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: types.WebhookEventPaymentSucceeded,
+		UserID:    lo.ToPtr(payment.CreatedBy),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
 // Delete deletes a payment by its ID
 func (s *paymentService) Delete(ctx context.Context, id string) error {
 	// Verify payment exists
@@ -211,19 +305,19 @@ func (s *paymentService) List(ctx context.Context, filter *types.PaymentFilter)
 		return nil, err
 	}
 
-	count, err := s.ServiceParams.PaymentRepo.Count(ctx, filter)
+	payments, err := s.ServiceParams.PaymentRepo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	payments, err := s.ServiceParams.PaymentRepo.List(ctx, filter)
+	pagination, err := paginate(ctx, filter, payments, s.ServiceParams.PaymentRepo.Count)
 	if err != nil {
 		return nil, err
 	}
 
 	response := &dto.ListPaymentResponse{
 		Items:      make([]*dto.PaymentResponse, len(payments)),
-		Pagination: lo.ToPtr(types.NewPaginationResponse(count, filter.GetLimit(), filter.GetOffset())),
+		Pagination: &pagination,
 	}
 
 	for i, payment := range payments {
@@ -336,3 +430,191 @@ func (s *paymentService) MarkAsRefunded(ctx context.Context, paymentID string, m
 	}
 	return s.Update(ctx, paymentID, req)
 }
+
+// Refund refunds a successful payment. Wallet credit spent on the same checkout
+// always goes back to the wallet, the gateway amount goes to the requested destination.
+// The refund is recorded first and a refund to the source is only sent to the gateway once
+// that committed, as a gateway refund can't be rolled back. Refunding a payment again sends
+// a refund to the source the gateway has not accepted yet, keyed by the payment so it is
+// never paid out twice.
+func (s *paymentService) Refund(ctx context.Context, paymentID string, req *dto.RefundPaymentRequest) (*dto.PaymentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if s.ServiceParams.DB.TxFromContext(ctx) != nil {
+		return nil, ierr.NewError("refund started inside a transaction").
+			WithHint("Refunds reach the payment gateway and have to be made outside of a transaction").
+			WithReportableDetails(map[string]any{
+				"payment_id": paymentID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	var refund *domainPayment.Refund
+
+	err := s.ServiceParams.DB.WithTx(ctx, func(ctx context.Context) error {
+		payment, err := s.ServiceParams.PaymentRepo.Get(ctx, paymentID)
+		if err != nil {
+			return err
+		}
+
+		if payment.PaymentStatus == types.PaymentStatusRefunded {
+			refund, err = s.getUnsentRefund(ctx, payment)
+			return err
+		}
+
+		if payment.PaymentStatus != types.PaymentStatusSuccess {
+			return errNotRefundable(payment)
+		}
+
+		if req.Destination == types.RefundDestinationSource {
+			if payment.GatewayPaymentID == nil || payment.PaymentGatewayProvider == "" {
+				return ierr.NewError("payment was not made through a payment gateway").
+					WithHint("This payment can only be refunded to the wallet").
+					WithReportableDetails(map[string]any{
+						"payment_id": payment.ID,
+					}).
+					Mark(ierr.ErrInvalidOperation)
+			}
+		}
+
+		walletService := NewWalletService(s.ServiceParams)
+
+		if req.Destination == types.RefundDestinationWallet {
+			cfg := s.ServiceParams.Config.Wallet
+			credit := &dto.WalletCreditRequest{
+				UserID:            payment.CreatedBy,
+				Amount:            payment.Amount,
+				Currency:          payment.Currency.String(),
+				TransactionReason: types.WalletTransactionReasonRefund,
+				ReferenceID:       lo.ToPtr(payment.ID),
+				Description:       req.Reason,
+			}
+			if cfg.RefundCreditValidity > 0 {
+				credit.ExpiresAt = lo.ToPtr(time.Now().UTC().Add(cfg.RefundCreditValidity))
+			}
+
+			if _, err := walletService.Credit(ctx, credit); err != nil {
+				return err
+			}
+		}
+
+		if payment.DestinationType == types.PaymentDestinationTypeEnrollment {
+			if _, err := walletService.ReverseDebits(ctx, payment.DestinationID); err != nil {
+				return err
+			}
+		}
+
+		metadata := types.MetadataFromEnt(payment.Metadata)
+		metadata["refund_destination"] = string(req.Destination)
+		if req.Reason != "" {
+			metadata["refund_reason"] = req.Reason
+		}
+
+		if _, err := s.MarkAsRefunded(ctx, payment.ID, metadata); err != nil {
+			return err
+		}
+
+		if req.Destination == types.RefundDestinationSource {
+			refund = newRefund(ctx, payment, req.Reason)
+			return s.ServiceParams.PaymentRepo.CreateRefund(ctx, refund)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if refund != nil {
+		if err := s.sendRefund(ctx, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetByID(ctx, paymentID)
+}
+
+// getUnsentRefund returns the refund of a refunded payment the gateway has not accepted yet
+func (s *paymentService) getUnsentRefund(ctx context.Context, payment *domainPayment.Payment) (*domainPayment.Refund, error) {
+	refund, err := s.ServiceParams.PaymentRepo.GetRefundByPaymentID(ctx, payment.ID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, errNotRefundable(payment)
+		}
+		return nil, err
+	}
+
+	if refund.IsProcessed() {
+		return nil, errNotRefundable(payment)
+	}
+
+	return refund, nil
+}
+
+// sendRefund refunds a recorded refund at the gateway it was paid through and records the outcome
+func (s *paymentService) sendRefund(ctx context.Context, refund *domainPayment.Refund) error {
+	provider, err := s.ServiceParams.GatewayRegistry.GetRefundProvider(ctx, refund.PaymentGatewayProvider)
+	if err != nil {
+		return err
+	}
+
+	response, err := provider.RefundPayment(ctx, &dto.GatewayRefundRequest{
+		IdempotencyKey:   refund.PaymentID,
+		GatewayPaymentID: refund.GatewayPaymentID,
+		Amount:           refund.Amount,
+		Currency:         refund.Currency,
+		Notes: map[string]string{
+			"payment_id": refund.PaymentID,
+			"reason":     refund.Reason,
+		},
+	})
+	if err != nil {
+		refund.RefundStatus = types.PaymentRefundStatusFailed
+		refund.ErrorMessage = lo.ToPtr(err.Error())
+		if updateErr := s.ServiceParams.PaymentRepo.UpdateRefund(ctx, refund); updateErr != nil {
+			s.ServiceParams.Logger.Errorw("failed to record failed gateway refund",
+				"payment_id", refund.PaymentID,
+				"error", updateErr)
+		}
+		return err
+	}
+
+	refund.RefundStatus = types.PaymentRefundStatusProcessed
+	refund.GatewayRefundID = lo.ToPtr(response.GatewayRefundID)
+	refund.ErrorMessage = nil
+	refund.ProcessedAt = lo.ToPtr(time.Now().UTC())
+
+	s.ServiceParams.Logger.Infow("refunded payment at the payment gateway",
+		"payment_id", refund.PaymentID,
+		"gateway_refund_id", response.GatewayRefundID,
+		"gateway_refund_status", response.Status)
+
+	// the gateway holds the refund now, sending it again returns the same refund
+	return s.ServiceParams.PaymentRepo.UpdateRefund(ctx, refund)
+}
+
+// newRefund records the refund of a payment to the payment method it was paid with
+func newRefund(ctx context.Context, payment *domainPayment.Payment, reason string) *domainPayment.Refund {
+	return &domainPayment.Refund{
+		ID:                     types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT_REFUND),
+		PaymentID:              payment.ID,
+		PaymentGatewayProvider: payment.PaymentGatewayProvider,
+		GatewayPaymentID:       lo.FromPtr(payment.GatewayPaymentID),
+		Amount:                 payment.Amount,
+		Currency:               payment.Currency,
+		Reason:                 reason,
+		RefundStatus:           types.PaymentRefundStatusPending,
+		BaseModel:              types.GetDefaultBaseModel(ctx),
+	}
+}
+
+func errNotRefundable(payment *domainPayment.Payment) error {
+	return ierr.NewError("payment is not refundable").
+		WithHint("Only successful payments can be refunded").
+		WithReportableDetails(map[string]any{
+			"payment_id":     payment.ID,
+			"payment_status": payment.PaymentStatus,
+		}).
+		Mark(ierr.ErrInvalidOperation)
+}
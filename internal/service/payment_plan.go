@@ -0,0 +1,540 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	domainPaymentPlan "github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/idempotency"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentPlanService manages installment plans and the installments scheduled against them
+type PaymentPlanService interface {
+	Create(ctx context.Context, req *dto.CreatePaymentPlanRequest) (*dto.PaymentPlanResponse, error)
+	GetByID(ctx context.Context, id string) (*dto.PaymentPlanResponse, error)
+	Update(ctx context.Context, id string, req *dto.UpdatePaymentPlanRequest) (*dto.PaymentPlanResponse, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.PaymentPlanFilter) (*dto.ListPaymentPlanResponse, error)
+
+	// ScheduleInstallments creates one pending enrollment payment per installment of the plan
+	ScheduleInstallments(
+		ctx context.Context,
+		enrollment *domainInternshipEnrollment.InternshipEnrollment,
+		plan *domainPaymentPlan.PaymentPlan,
+		batchStart time.Time,
+		total decimal.Decimal,
+		currency string,
+	) ([]*domainPayment.Payment, error)
+
+	// GetEnrollmentInstallments returns the installment schedule of an enrollment
+	GetEnrollmentInstallments(ctx context.Context, enrollmentID string) (*dto.EnrollmentInstallmentsResponse, error)
+
+	// HandleEnrollmentPaid activates or reinstates the enrollment a payment was made for
+	// and marks it paid once its single payment or every installment succeeded. It returns
+	// the enrollment the payment confirmed, for the caller to announce once it committed.
+	HandleEnrollmentPaid(ctx context.Context, payment *domainPayment.Payment) (*domainInternshipEnrollment.InternshipEnrollment, error)
+
+	// ProcessInstallments sends reminders for upcoming and overdue installments and
+	// suspends enrollments with an installment overdue past the grace period. Content access
+	// follows the enrollment status, so a student keeps access until this run suspends them.
+	ProcessInstallments(ctx context.Context) (int, error)
+}
+
+type paymentPlanService struct {
+	ServiceParams
+}
+
+func NewPaymentPlanService(params ServiceParams) PaymentPlanService {
+	return &paymentPlanService{
+		ServiceParams: params,
+	}
+}
+
+func (s *paymentPlanService) Create(ctx context.Context, req *dto.CreatePaymentPlanRequest) (*dto.PaymentPlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	// make sure the plan belongs to an existing internship
+	if _, err := s.InternshipRepo.Get(ctx, req.InternshipID); err != nil {
+		return nil, err
+	}
+
+	plan := req.ToPaymentPlan(ctx)
+	if err := s.PaymentPlanRepo.Create(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return &dto.PaymentPlanResponse{PaymentPlan: *plan}, nil
+}
+
+func (s *paymentPlanService) GetByID(ctx context.Context, id string) (*dto.PaymentPlanResponse, error) {
+	plan, err := s.PaymentPlanRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PaymentPlanResponse{PaymentPlan: *plan}, nil
+}
+
+func (s *paymentPlanService) Update(ctx context.Context, id string, req *dto.UpdatePaymentPlanRequest) (*dto.PaymentPlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.PaymentPlanRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		plan.Name = *req.Name
+	}
+
+	if req.Description != nil {
+		plan.Description = *req.Description
+	}
+
+	if req.GracePeriodDays != nil {
+		plan.GracePeriodDays = *req.GracePeriodDays
+	}
+
+	if req.IsActive != nil {
+		plan.IsActive = *req.IsActive
+	}
+
+	if req.Metadata != nil {
+		plan.Metadata = *req.Metadata
+	}
+
+	if err := s.PaymentPlanRepo.Update(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return &dto.PaymentPlanResponse{PaymentPlan: *plan}, nil
+}
+
+func (s *paymentPlanService) Delete(ctx context.Context, id string) error {
+	if _, err := s.PaymentPlanRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.PaymentPlanRepo.Delete(ctx, id)
+}
+
+func (s *paymentPlanService) List(ctx context.Context, filter *types.PaymentPlanFilter) (*dto.ListPaymentPlanResponse, error) {
+	if filter == nil {
+		filter = types.NewPaymentPlanFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// only admins can see plans that are no longer offered
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.IsActive = lo.ToPtr(true)
+	}
+
+	plans, err := s.PaymentPlanRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, plans, s.PaymentPlanRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListPaymentPlanResponse{
+		Items: lo.Map(plans, func(plan *domainPaymentPlan.PaymentPlan, _ int) *dto.PaymentPlanResponse {
+			return &dto.PaymentPlanResponse{PaymentPlan: *plan}
+		}),
+		Pagination: pagination,
+	}
+
+	return response, nil
+}
+
+func (s *paymentPlanService) ScheduleInstallments(
+	ctx context.Context,
+	enrollment *domainInternshipEnrollment.InternshipEnrollment,
+	plan *domainPaymentPlan.PaymentPlan,
+	batchStart time.Time,
+	total decimal.Decimal,
+	currency string,
+) ([]*domainPayment.Payment, error) {
+	if !total.IsPositive() {
+		return nil, ierr.NewError("payment plan requires a positive total").
+			WithHint("Free enrollments can't be paid in installments").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	generator := idempotency.NewGenerator()
+	hundred := decimal.NewFromInt(100)
+	scheduled := decimal.Zero
+
+	payments := make([]*domainPayment.Payment, 0, len(plan.Installments))
+	for i, installment := range plan.Installments {
+		number := i + 1
+
+		// the last installment takes the rounding remainder so the installments add up to the total
+		amount := total.Mul(installment.Percentage).Div(hundred).Round(2)
+		if number == len(plan.Installments) {
+			amount = total.Sub(scheduled)
+		}
+		scheduled = scheduled.Add(amount)
+
+		payment := &domainPayment.Payment{
+			ID: types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT),
+			IdempotencyKey: generator.GenerateKey(idempotency.ScopePayment, map[string]interface{}{
+				"enrollment_id":      enrollment.ID,
+				"installment_number": number,
+			}),
+			DestinationType:        types.PaymentDestinationTypeEnrollment,
+			DestinationID:          enrollment.ID,
+			PaymentGatewayProvider: types.PaymentGatewayProviderRazorpay,
+			Amount:                 amount,
+			Currency:               types.Currency(currency),
+			PaymentStatus:          types.PaymentStatusPending,
+			TrackAttempts:          true,
+			InstallmentNumber:      lo.ToPtr(number),
+			DueDate:                lo.ToPtr(installment.DueDate(batchStart)),
+			Metadata: map[string]string{
+				"payment_plan_id": plan.ID,
+			},
+			BaseModel: types.GetDefaultBaseModel(ctx),
+		}
+
+		if err := s.PaymentRepo.Create(ctx, payment); err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+func (s *paymentPlanService) GetEnrollmentInstallments(ctx context.Context, enrollmentID string) (*dto.EnrollmentInstallmentsResponse, error) {
+	enrollment, err := s.InternshipEnrollmentRepo.Get(ctx, enrollmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// students can only see their own schedule
+	if types.GetUserRole(ctx) != types.UserRoleAdmin && enrollment.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("enrollment not found").
+			WithHintf("Enrollment with ID %s was not found", enrollmentID).
+			Mark(ierr.ErrNotFound)
+	}
+
+	if enrollment.PaymentPlanID == nil {
+		return nil, ierr.NewError("enrollment is not paid in installments").
+			WithHint("This enrollment has no installment schedule").
+			WithReportableDetails(map[string]any{
+				"enrollment_id": enrollmentID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	plan, err := s.PaymentPlanRepo.Get(ctx, *enrollment.PaymentPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	installments, err := s.listInstallments(ctx, enrollment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &dto.EnrollmentInstallmentsResponse{
+		EnrollmentID:     enrollment.ID,
+		PaymentPlanID:    plan.ID,
+		EnrollmentStatus: enrollment.EnrollmentStatus,
+		Installments: lo.Map(installments, func(p *domainPayment.Payment, _ int) *dto.InstallmentResponse {
+			return &dto.InstallmentResponse{
+				PaymentID:         p.ID,
+				InstallmentNumber: lo.FromPtr(p.InstallmentNumber),
+				Amount:            p.Amount,
+				Currency:          p.Currency.String(),
+				DueDate:           lo.FromPtr(p.DueDate),
+				PaymentStatus:     p.PaymentStatus,
+				PaidAt:            p.SucceededAt,
+				IsOverdue:         isInstallmentOverdue(p, now, 0),
+			}
+		}),
+		ContentAccess: enrollment.HasAccess(),
+	}, nil
+}
+
+func (s *paymentPlanService) HandleEnrollmentPaid(ctx context.Context, payment *domainPayment.Payment) (*domainInternshipEnrollment.InternshipEnrollment, error) {
+	if payment.DestinationType != types.PaymentDestinationTypeEnrollment {
+		return nil, nil
+	}
+
+	// set when the payment takes the enrollment out of pending
+	var confirmed *domainInternshipEnrollment.InternshipEnrollment
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		enrollment, err := s.InternshipEnrollmentRepo.Get(ctx, payment.DestinationID)
+		if err != nil {
+			return err
+		}
+
+		switch enrollment.EnrollmentStatus {
+		case types.InternshipEnrollmentStatusPending,
+			types.InternshipEnrollmentStatusEnrolled,
+			types.InternshipEnrollmentStatusSuspended:
+		default:
+			s.Logger.Warnw("payment succeeded for inactive enrollment",
+				"enrollment_id", enrollment.ID,
+				"enrollment_status", enrollment.EnrollmentStatus,
+				"payment_id", payment.ID)
+			return nil
+		}
+
+		now := time.Now().UTC()
+		if enrollment.EnrolledAt == nil {
+			enrollment.EnrolledAt = lo.ToPtr(now)
+		}
+		pending := enrollment.EnrollmentStatus == types.InternshipEnrollmentStatusPending
+
+		// a single payment settles the enrollment, installments only once all of them are paid
+		paid, overdue := true, false
+		if enrollment.PaymentPlanID != nil {
+			plan, err := s.PaymentPlanRepo.Get(ctx, *enrollment.PaymentPlanID)
+			if err != nil {
+				return err
+			}
+
+			installments, err := s.listInstallments(ctx, enrollment.ID)
+			if err != nil {
+				return err
+			}
+
+			paid = lo.EveryBy(installments, func(p *domainPayment.Payment) bool {
+				return p.PaymentStatus == types.PaymentStatusSuccess
+			})
+			overdue = lo.SomeBy(installments, func(p *domainPayment.Payment) bool {
+				return isInstallmentOverdue(p, now, plan.GracePeriod())
+			})
+		}
+
+		switch {
+		case paid:
+			enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusEnrolled
+			enrollment.PaymentStatus = types.PaymentStatusSuccess
+		case !overdue:
+			enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusEnrolled
+		default:
+			// another installment is still overdue past the grace period
+			return nil
+		}
+
+		if err := s.InternshipEnrollmentRepo.Update(ctx, enrollment); err != nil {
+			return err
+		}
+		invalidateAccess(s.ServiceParams, enrollment.UserID)
+
+		if pending {
+			confirmed = enrollment
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return confirmed, nil
+}
+
+func (s *paymentPlanService) ProcessInstallments(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeEnrollment))
+	filter.PaymentStatus = lo.ToPtr(string(types.PaymentStatusPending))
+	filter.DueDateBefore = lo.ToPtr(now.Add(s.Config.PaymentPlan.ReminderLeadTime))
+
+	installments, err := s.PaymentRepo.List(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	plans := make(map[string]*domainPaymentPlan.PaymentPlan)
+	suspended := 0
+	for _, installment := range installments {
+		enrollment, err := s.InternshipEnrollmentRepo.Get(ctx, installment.DestinationID)
+		if err != nil {
+			s.Logger.Errorw("failed to get enrollment for installment",
+				"payment_id", installment.ID,
+				"error", err)
+			continue
+		}
+
+		// installments of enrollments that never started or already ended need no follow-up
+		if enrollment.EnrollmentStatus != types.InternshipEnrollmentStatusEnrolled &&
+			enrollment.EnrollmentStatus != types.InternshipEnrollmentStatusSuspended {
+			continue
+		}
+
+		planID := lo.FromPtr(enrollment.PaymentPlanID)
+		plan, ok := plans[planID]
+		if !ok {
+			plan, err = s.PaymentPlanRepo.Get(ctx, planID)
+			if err != nil {
+				s.Logger.Errorw("failed to get payment plan for installment",
+					"payment_id", installment.ID,
+					"payment_plan_id", planID,
+					"error", err)
+				continue
+			}
+			plans[planID] = plan
+		}
+
+		wasSuspended, err := s.processInstallment(ctx, enrollment, plan, installment, now)
+		if err != nil {
+			s.Logger.Errorw("failed to process installment",
+				"payment_id", installment.ID,
+				"enrollment_id", enrollment.ID,
+				"error", err)
+			continue
+		}
+		if wasSuspended {
+			suspended++
+		}
+	}
+
+	return suspended, nil
+}
+
+// processInstallment sends the notice an installment is due for and suspends its
+// enrollment once the grace period is over. It reports whether the enrollment was suspended.
+func (s *paymentPlanService) processInstallment(
+	ctx context.Context,
+	enrollment *domainInternshipEnrollment.InternshipEnrollment,
+	plan *domainPaymentPlan.PaymentPlan,
+	installment *domainPayment.Payment,
+	now time.Time,
+) (bool, error) {
+	if installment.Metadata == nil {
+		installment.Metadata = map[string]string{}
+	}
+
+	dueDate := lo.FromPtr(installment.DueDate)
+	notice, event := types.PaymentMetadataInstallmentReminderSent, types.WebhookEventInstallmentReminder
+	if dueDate.Before(now) {
+		notice, event = types.PaymentMetadataInstallmentOverdueSent, types.WebhookEventInstallmentOverdue
+	}
+
+	if _, sent := installment.Metadata[notice]; !sent {
+		if err := s.publishInstallmentEvent(ctx, event, enrollment, plan, installment); err != nil {
+			return false, err
+		}
+
+		installment.Metadata[notice] = strconv.FormatInt(now.Unix(), 10)
+		if err := s.PaymentRepo.Update(ctx, installment); err != nil {
+			return false, err
+		}
+	}
+
+	if enrollment.EnrollmentStatus != types.InternshipEnrollmentStatusEnrolled ||
+		!isInstallmentOverdue(installment, now, plan.GracePeriod()) {
+		return false, nil
+	}
+
+	enrollment.EnrollmentStatus = types.InternshipEnrollmentStatusSuspended
+	if err := s.InternshipEnrollmentRepo.Update(ctx, enrollment); err != nil {
+		return false, err
+	}
+	invalidateAccess(s.ServiceParams, enrollment.UserID)
+
+	s.Logger.Infow("suspended enrollment for overdue installment",
+		"enrollment_id", enrollment.ID,
+		"payment_id", installment.ID,
+		"due_date", dueDate)
+
+	if err := s.publishInstallmentEvent(ctx, types.WebhookEventEnrollmentSuspended, enrollment, plan, installment); err != nil {
+		// the suspension stands even if the notice couldn't be sent
+		s.Logger.Errorw("failed to publish enrollment suspension",
+			"enrollment_id", enrollment.ID,
+			"error", err)
+	}
+
+	return true, nil
+}
+
+func (s *paymentPlanService) publishInstallmentEvent(
+	ctx context.Context,
+	eventName string,
+	enrollment *domainInternshipEnrollment.InternshipEnrollment,
+	plan *domainPaymentPlan.PaymentPlan,
+	installment *domainPayment.Payment,
+) error {
+	dueDate := lo.FromPtr(installment.DueDate)
+	payload, err := json.Marshal(&webhookDto.InstallmentWebhookPayload{
+		EnrollmentID:      enrollment.ID,
+		InternshipID:      enrollment.InternshipID,
+		EnrollmentStatus:  enrollment.EnrollmentStatus,
+		PaymentID:         installment.ID,
+		InstallmentNumber: lo.FromPtr(installment.InstallmentNumber),
+		Amount:            installment.Amount,
+		Currency:          installment.Currency.String(),
+		DueDate:           dueDate,
+		GraceEndsAt:       dueDate.Add(plan.GracePeriod()),
+	})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build installment event").
+			Mark(ierr.ErrInternal)
+	}
+
+	return s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		UserID:    lo.ToPtr(enrollment.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// listInstallments returns the installment payments of an enrollment in installment order
+func (s *paymentPlanService) listInstallments(ctx context.Context, enrollmentID string) ([]*domainPayment.Payment, error) {
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationType = lo.ToPtr(string(types.PaymentDestinationTypeEnrollment))
+	filter.DestinationID = lo.ToPtr(enrollmentID)
+
+	payments, err := s.PaymentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	installments := lo.Filter(payments, func(p *domainPayment.Payment, _ int) bool {
+		return p.InstallmentNumber != nil
+	})
+	sort.Slice(installments, func(i, j int) bool {
+		return *installments[i].InstallmentNumber < *installments[j].InstallmentNumber
+	})
+
+	return installments, nil
+}
+
+// isInstallmentOverdue reports whether an unpaid installment is past its due date plus grace
+func isInstallmentOverdue(installment *domainPayment.Payment, at time.Time, grace time.Duration) bool {
+	if installment.PaymentStatus == types.PaymentStatusSuccess || installment.DueDate == nil {
+		return false
+	}
+	return installment.DueDate.Add(grace).Before(at)
+}
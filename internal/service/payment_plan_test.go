@@ -0,0 +1,243 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/config"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	domainPaymentPlan "github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentPlanServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service   PaymentPlanService
+	payments  PaymentService
+	publisher *testutil.MockWebhookPublisher
+}
+
+func TestPaymentPlanService(t *testing.T) {
+	suite.Run(t, new(PaymentPlanServiceSuite))
+}
+
+func (s *PaymentPlanServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.PaymentPlan = config.PaymentPlanConfig{ReminderLeadTime: 72 * time.Hour}
+
+	s.publisher = testutil.NewMockWebhookPublisher()
+
+	stores := s.GetStores()
+	params := ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   cfg,
+		DB:                       s.GetDB(),
+		PaymentRepo:              stores.PaymentRepo,
+		PaymentPlanRepo:          stores.PaymentPlanRepo,
+		InternshipEnrollmentRepo: stores.InternshipEnrollmentRepo,
+		ReferralRepo:             stores.ReferralRepo,
+		CertificateRepo:          stores.CertificateRepo,
+		WalletRepo:               stores.WalletRepo,
+		WebhookPublisher:         s.publisher,
+		GatewayRegistry:          testutil.NewMockGatewayRegistry(testutil.NewMockPaymentGateway()),
+	}
+	s.service = NewPaymentPlanService(params)
+	s.payments = NewPaymentService(params)
+}
+
+// createPlan creates a plan with one installment per percentage, due the matching days after the batch start
+func (s *PaymentPlanServiceSuite) createPlan(graceDays int, percentages []int64, offsets []int) *domainPaymentPlan.PaymentPlan {
+	plan := &domainPaymentPlan.PaymentPlan{
+		ID:              s.GetUUID(),
+		InternshipID:    s.GetUUID(),
+		Name:            "Installments",
+		GracePeriodDays: graceDays,
+		IsActive:        true,
+		Metadata:        types.Metadata{},
+		BaseModel:       types.GetDefaultBaseModel(s.GetContext()),
+	}
+	for i, offset := range offsets {
+		plan.Installments = append(plan.Installments, types.PaymentPlanInstallment{
+			Percentage:    decimal.NewFromInt(percentages[i]),
+			DueOffsetDays: offset,
+		})
+	}
+	s.Require().NoError(s.GetStores().PaymentPlanRepo.Create(s.GetContext(), plan))
+	return plan
+}
+
+func (s *PaymentPlanServiceSuite) createEnrollment(status types.InternshipEnrollmentStatus, plan *domainPaymentPlan.PaymentPlan) *domainInternshipEnrollment.InternshipEnrollment {
+	enrollment := &domainInternshipEnrollment.InternshipEnrollment{
+		ID:                s.GetUUID(),
+		UserID:            types.DefaultUserID,
+		InternshipID:      plan.InternshipID,
+		InternshipBatchID: s.GetUUID(),
+		EnrollmentStatus:  status,
+		PaymentStatus:     types.PaymentStatusPending,
+		PaymentPlanID:     lo.ToPtr(plan.ID),
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().InternshipEnrollmentRepo.Create(s.GetContext(), enrollment))
+	return enrollment
+}
+
+func (s *PaymentPlanServiceSuite) schedule(enrollment *domainInternshipEnrollment.InternshipEnrollment, plan *domainPaymentPlan.PaymentPlan, batchStart time.Time) []*domainPayment.Payment {
+	payments, err := s.service.ScheduleInstallments(s.GetContext(), enrollment, plan, batchStart, decimal.NewFromInt(1000), "INR")
+	s.Require().NoError(err)
+	return payments
+}
+
+func (s *PaymentPlanServiceSuite) pay(payment *domainPayment.Payment) {
+	_, err := s.payments.MarkAsSuccess(s.GetContext(), payment.ID, lo.ToPtr("pay_"+payment.ID), nil)
+	s.Require().NoError(err)
+}
+
+func (s *PaymentPlanServiceSuite) enrollment(id string) *domainInternshipEnrollment.InternshipEnrollment {
+	enrollment, err := s.GetStores().InternshipEnrollmentRepo.Get(s.GetContext(), id)
+	s.Require().NoError(err)
+	return enrollment
+}
+
+func (s *PaymentPlanServiceSuite) published(eventName string) int {
+	return lo.Count(s.publisher.Events(), eventName)
+}
+
+func (s *PaymentPlanServiceSuite) TestScheduleInstallmentsSplitsTotal() {
+	plan := s.createPlan(3, []int64{33, 33, 34}, []int{-7, 0, 30})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusPending, plan)
+	batchStart := s.GetNow().Add(30 * 24 * time.Hour)
+
+	payments, err := s.service.ScheduleInstallments(s.GetContext(), enrollment, plan, batchStart, decimal.RequireFromString("999.99"), "INR")
+	s.Require().NoError(err)
+	s.Require().Len(payments, 3)
+
+	// rounded to the paisa, the last installment takes the remainder
+	s.Equal("330.00", payments[0].Amount.StringFixed(2))
+	s.Equal("330.00", payments[1].Amount.StringFixed(2))
+	s.Equal("339.99", payments[2].Amount.StringFixed(2))
+
+	for i, payment := range payments {
+		s.Equal(i+1, lo.FromPtr(payment.InstallmentNumber))
+		s.Equal(types.PaymentStatusPending, payment.PaymentStatus)
+		s.Equal(enrollment.ID, payment.DestinationID)
+		s.Equal(plan.ID, payment.Metadata["payment_plan_id"])
+		s.True(plan.Installments[i].DueDate(batchStart).Equal(lo.FromPtr(payment.DueDate)))
+	}
+
+	_, err = s.service.ScheduleInstallments(s.GetContext(), enrollment, plan, batchStart, decimal.Zero, "INR")
+	s.True(ierr.IsInvalidOperation(err))
+}
+
+func (s *PaymentPlanServiceSuite) TestFirstInstallmentConfirmsEnrollment() {
+	plan := s.createPlan(3, []int64{50, 50}, []int{0, 30})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusPending, plan)
+	payments := s.schedule(enrollment, plan, s.GetNow().Add(7*24*time.Hour))
+
+	s.pay(payments[0])
+
+	confirmed := s.enrollment(enrollment.ID)
+	s.Equal(types.InternshipEnrollmentStatusEnrolled, confirmed.EnrollmentStatus)
+	s.Equal(types.PaymentStatusPending, confirmed.PaymentStatus)
+	s.NotNil(confirmed.EnrolledAt)
+	s.Equal(1, s.published(types.WebhookEventEnrollmentConfirmed))
+
+	// the last installment settles the enrollment, it was confirmed already
+	s.pay(payments[1])
+
+	paid := s.enrollment(enrollment.ID)
+	s.Equal(types.InternshipEnrollmentStatusEnrolled, paid.EnrollmentStatus)
+	s.Equal(types.PaymentStatusSuccess, paid.PaymentStatus)
+	s.Equal(1, s.published(types.WebhookEventEnrollmentConfirmed))
+}
+
+func (s *PaymentPlanServiceSuite) TestProcessInstallmentsSuspendsOverdueEnrollment() {
+	plan := s.createPlan(3, []int64{40, 30, 30}, []int{0, 5, 30})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusEnrolled, plan)
+	payments := s.schedule(enrollment, plan, s.GetNow().Add(-10*24*time.Hour))
+	s.pay(payments[0])
+
+	// the second installment is five days overdue, past the three day grace period
+	suspended, err := s.service.ProcessInstallments(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(1, suspended)
+	s.Equal(types.InternshipEnrollmentStatusSuspended, s.enrollment(enrollment.ID).EnrollmentStatus)
+	s.False(s.enrollment(enrollment.ID).HasAccess())
+	s.Equal(1, s.published(types.WebhookEventInstallmentOverdue))
+	s.Equal(1, s.published(types.WebhookEventEnrollmentSuspended))
+	s.Equal(0, s.published(types.WebhookEventInstallmentReminder))
+
+	// notices are only sent once
+	suspended, err = s.service.ProcessInstallments(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(0, suspended)
+	s.Equal(1, s.published(types.WebhookEventInstallmentOverdue))
+
+	// paying the overdue installment reinstates the enrollment without confirming it again
+	s.pay(payments[1])
+
+	reinstated := s.enrollment(enrollment.ID)
+	s.Equal(types.InternshipEnrollmentStatusEnrolled, reinstated.EnrollmentStatus)
+	s.Equal(types.PaymentStatusPending, reinstated.PaymentStatus)
+	s.True(reinstated.HasAccess())
+	s.Equal(0, s.published(types.WebhookEventEnrollmentConfirmed))
+}
+
+func (s *PaymentPlanServiceSuite) TestProcessInstallmentsWithinGracePeriod() {
+	plan := s.createPlan(3, []int64{50, 50}, []int{0, 10})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusEnrolled, plan)
+	s.schedule(enrollment, plan, s.GetNow().Add(-25*time.Hour))
+
+	// the first installment is a day overdue and the second not due for more than a week
+	suspended, err := s.service.ProcessInstallments(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(0, suspended)
+	s.Equal(types.InternshipEnrollmentStatusEnrolled, s.enrollment(enrollment.ID).EnrollmentStatus)
+	s.Equal(1, s.published(types.WebhookEventInstallmentOverdue))
+	s.Equal(0, s.published(types.WebhookEventInstallmentReminder))
+}
+
+func (s *PaymentPlanServiceSuite) TestProcessInstallmentsRemindsOfUpcomingInstallment() {
+	plan := s.createPlan(3, []int64{50, 50}, []int{0, 30})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusEnrolled, plan)
+	payments := s.schedule(enrollment, plan, s.GetNow().Add(48*time.Hour))
+
+	_, err := s.service.ProcessInstallments(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(1, s.published(types.WebhookEventInstallmentReminder))
+	s.Equal(0, s.published(types.WebhookEventInstallmentOverdue))
+
+	installment, err := s.GetStores().PaymentRepo.Get(s.GetContext(), payments[0].ID)
+	s.Require().NoError(err)
+	s.Contains(installment.Metadata, types.PaymentMetadataInstallmentReminderSent)
+}
+
+func (s *PaymentPlanServiceSuite) TestPaymentKeepsSuspensionWhileAnotherInstallmentIsOverdue() {
+	plan := s.createPlan(3, []int64{30, 30, 40}, []int{0, 2, 30})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusSuspended, plan)
+	payments := s.schedule(enrollment, plan, s.GetNow().Add(-10*24*time.Hour))
+
+	s.pay(payments[0])
+	s.Equal(types.InternshipEnrollmentStatusSuspended, s.enrollment(enrollment.ID).EnrollmentStatus)
+
+	s.pay(payments[1])
+	s.Equal(types.InternshipEnrollmentStatusEnrolled, s.enrollment(enrollment.ID).EnrollmentStatus)
+}
+
+func (s *PaymentPlanServiceSuite) TestPaymentLeavesEndedEnrollmentAlone() {
+	plan := s.createPlan(3, []int64{50, 50}, []int{0, 30})
+	enrollment := s.createEnrollment(types.InternshipEnrollmentStatusCancelled, plan)
+	payments := s.schedule(enrollment, plan, s.GetNow())
+
+	confirmed, err := s.service.HandleEnrollmentPaid(s.GetContext(), payments[0])
+	s.Require().NoError(err)
+	s.Nil(confirmed)
+	s.Equal(types.InternshipEnrollmentStatusCancelled, s.enrollment(enrollment.ID).EnrollmentStatus)
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/config"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service PaymentService
+	wallet  WalletService
+	gateway *testutil.MockPaymentGateway
+}
+
+func TestPaymentService(t *testing.T) {
+	suite.Run(t, new(PaymentServiceSuite))
+}
+
+func (s *PaymentServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.Wallet = config.WalletConfig{
+		DefaultCurrency:      "INR",
+		RefundCreditValidity: 30 * 24 * time.Hour,
+	}
+
+	s.gateway = testutil.NewMockPaymentGateway()
+
+	stores := s.GetStores()
+	params := ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   cfg,
+		DB:                       s.GetDB(),
+		PaymentRepo:              stores.PaymentRepo,
+		WalletRepo:               stores.WalletRepo,
+		ReferralRepo:             stores.ReferralRepo,
+		CertificateRepo:          stores.CertificateRepo,
+		InternshipEnrollmentRepo: stores.InternshipEnrollmentRepo,
+		WebhookPublisher:         testutil.NewMockWebhookPublisher(),
+		GatewayRegistry:          testutil.NewMockGatewayRegistry(s.gateway),
+	}
+	s.service = NewPaymentService(params)
+	s.wallet = NewWalletService(params)
+}
+
+func (s *PaymentServiceSuite) createPayment(status types.PaymentStatus) *domainPayment.Payment {
+	payment := &domainPayment.Payment{
+		ID:                     s.GetUUID(),
+		IdempotencyKey:         s.GetUUID(),
+		DestinationType:        types.PaymentDestinationTypeEnrollment,
+		DestinationID:          s.GetUUID(),
+		PaymentGatewayProvider: types.PaymentGatewayProviderRazorpay,
+		GatewayPaymentID:       lo.ToPtr("pay_" + s.GetUUID()),
+		Amount:                 decimal.NewFromInt(1000),
+		Currency:               "INR",
+		PaymentStatus:          status,
+		Metadata:               map[string]string{},
+		BaseModel:              types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().PaymentRepo.Create(s.GetContext(), payment))
+	return payment
+}
+
+func (s *PaymentServiceSuite) refund(payment *domainPayment.Payment, destination types.RefundDestination) (*dto.PaymentResponse, error) {
+	return s.service.Refund(s.GetContext(), payment.ID, &dto.RefundPaymentRequest{
+		Destination: destination,
+		Reason:      "Batch cancelled",
+	})
+}
+
+func (s *PaymentServiceSuite) walletBalance() decimal.Decimal {
+	response, err := s.wallet.GetBalance(s.GetContext(), types.DefaultUserID, "")
+	s.Require().NoError(err)
+	return response.Balance
+}
+
+func (s *PaymentServiceSuite) TestRefundToSourceReachesGatewayOnce() {
+	payment := s.createPayment(types.PaymentStatusSuccess)
+
+	response, err := s.refund(payment, types.RefundDestinationSource)
+	s.Require().NoError(err)
+	s.Equal(types.PaymentStatusRefunded, response.Payment.PaymentStatus)
+	s.NotNil(response.Payment.RefundedAt)
+	s.Equal("source", response.Payment.Metadata["refund_destination"])
+
+	refunds := s.gateway.Refunds()
+	s.Require().Len(refunds, 1)
+	s.Equal(lo.FromPtr(payment.GatewayPaymentID), refunds[payment.ID].GatewayPaymentID)
+	s.True(refunds[payment.ID].Amount.Equal(payment.Amount))
+
+	refund, err := s.GetStores().PaymentRepo.GetRefundByPaymentID(s.GetContext(), payment.ID)
+	s.Require().NoError(err)
+	s.True(refund.IsProcessed())
+	s.Equal("rfnd_"+payment.ID, lo.FromPtr(refund.GatewayRefundID))
+
+	// the refund went through, refunding again is refused
+	_, err = s.refund(payment, types.RefundDestinationSource)
+	s.True(ierr.IsInvalidOperation(err))
+	s.Len(s.gateway.Refunds(), 1)
+}
+
+func (s *PaymentServiceSuite) TestRefundRetriesFailedGatewayRefund() {
+	payment := s.createPayment(types.PaymentStatusSuccess)
+	s.gateway.RefundErr = errors.New("gateway unavailable")
+
+	_, err := s.refund(payment, types.RefundDestinationSource)
+	s.Error(err)
+
+	// the refund is recorded before the gateway is called and kept for a retry
+	stored, err := s.GetStores().PaymentRepo.Get(s.GetContext(), payment.ID)
+	s.Require().NoError(err)
+	s.Equal(types.PaymentStatusRefunded, stored.PaymentStatus)
+
+	refund, err := s.GetStores().PaymentRepo.GetRefundByPaymentID(s.GetContext(), payment.ID)
+	s.Require().NoError(err)
+	s.Equal(types.PaymentRefundStatusFailed, refund.RefundStatus)
+	s.Equal("gateway unavailable", lo.FromPtr(refund.ErrorMessage))
+	s.Empty(s.gateway.Refunds())
+
+	s.gateway.RefundErr = nil
+	_, err = s.refund(payment, types.RefundDestinationSource)
+	s.Require().NoError(err)
+
+	refund, err = s.GetStores().PaymentRepo.GetRefundByPaymentID(s.GetContext(), payment.ID)
+	s.Require().NoError(err)
+	s.True(refund.IsProcessed())
+	s.Nil(refund.ErrorMessage)
+	s.Len(s.gateway.Refunds(), 1)
+}
+
+func (s *PaymentServiceSuite) TestRefundToWalletCreditsPayer() {
+	payment := s.createPayment(types.PaymentStatusSuccess)
+
+	response, err := s.refund(payment, types.RefundDestinationWallet)
+	s.Require().NoError(err)
+	s.Equal(types.PaymentStatusRefunded, response.Payment.PaymentStatus)
+	s.Empty(s.gateway.Refunds())
+
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.TransactionReason = types.WalletTransactionReasonRefund
+	credits, err := s.GetStores().WalletRepo.ListAll(s.GetContext(), filter)
+	s.Require().NoError(err)
+	s.Require().Len(credits, 1)
+	s.Equal(types.DefaultUserID, credits[0].UserID)
+	s.Equal(payment.ID, lo.FromPtr(credits[0].ReferenceID))
+	s.True(credits[0].Amount.Equal(payment.Amount))
+	s.Require().NotNil(credits[0].ExpiresAt)
+	s.WithinDuration(s.GetNow().Add(30*24*time.Hour), *credits[0].ExpiresAt, time.Minute)
+
+	// a wallet refund can't be sent again
+	_, err = s.refund(payment, types.RefundDestinationWallet)
+	s.True(ierr.IsInvalidOperation(err))
+	s.True(s.walletBalance().Equal(payment.Amount))
+}
+
+func (s *PaymentServiceSuite) TestRefundGivesBackWalletCreditSpentOnCheckout() {
+	payment := s.createPayment(types.PaymentStatusSuccess)
+
+	_, err := s.wallet.Credit(s.GetContext(), &dto.WalletCreditRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(200),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonReferralReward,
+	})
+	s.Require().NoError(err)
+	_, err = s.wallet.Debit(s.GetContext(), &dto.WalletDebitRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(200),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonCheckout,
+		ReferenceID:       lo.ToPtr(payment.DestinationID),
+	})
+	s.Require().NoError(err)
+	s.True(s.walletBalance().IsZero())
+
+	_, err = s.refund(payment, types.RefundDestinationSource)
+	s.Require().NoError(err)
+	s.True(s.walletBalance().Equal(decimal.NewFromInt(200)))
+
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.ReferenceID = payment.DestinationID
+	filter.TransactionReason = types.WalletTransactionReasonCheckoutReversal
+	reversals, err := s.GetStores().WalletRepo.ListAll(s.GetContext(), filter)
+	s.Require().NoError(err)
+	s.Require().Len(reversals, 1)
+	s.True(reversals[0].Amount.Equal(decimal.NewFromInt(200)))
+}
+
+func (s *PaymentServiceSuite) TestRefundRequiresSuccessfulPayment() {
+	for _, status := range []types.PaymentStatus{
+		types.PaymentStatusPending,
+		types.PaymentStatusFailed,
+		types.PaymentStatusCancelled,
+	} {
+		payment := s.createPayment(status)
+
+		_, err := s.refund(payment, types.RefundDestinationSource)
+		s.True(ierr.IsInvalidOperation(err), status)
+	}
+	s.Empty(s.gateway.Refunds())
+}
+
+func (s *PaymentServiceSuite) TestRefundToSourceNeedsGatewayPayment() {
+	payment := s.createPayment(types.PaymentStatusSuccess)
+	payment.GatewayPaymentID = nil
+	s.Require().NoError(s.GetStores().PaymentRepo.Update(s.GetContext(), payment))
+
+	_, err := s.refund(payment, types.RefundDestinationSource)
+	s.True(ierr.IsInvalidOperation(err))
+
+	// the wallet still takes it
+	_, err = s.refund(payment, types.RefundDestinationWallet)
+	s.NoError(err)
+}
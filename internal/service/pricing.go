@@ -135,6 +135,7 @@ func (s *pricingService) calculatePricingWithDiscounts(internship *internship.In
 		Subtotal:         internship.Subtotal,
 		Total:            total,
 		DiscountAmount:   discountAmount,
+		Currency:         internship.Currency,
 		AppliedDiscounts: discountsApplied,
 		PaymentRequired:  total.GreaterThan(decimal.Zero),
 		SavingsPercent:   savingsPercent.InexactFloat64(),
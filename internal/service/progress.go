@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+type ProgressService interface {
+	// UpdateLessonProgress records the resume position of the current student in a lesson
+	// and whether they completed it
+	UpdateLessonProgress(ctx context.Context, lessonID string, req *dto.UpdateLessonProgressRequest) (*dto.LessonProgressResponse, error)
+
+	// GetInternshipProgress returns the progress of the current student through an internship
+	GetInternshipProgress(ctx context.Context, internshipID string) (*dto.InternshipProgressResponse, error)
+
+	// ListMyProgress returns the progress of the current student in each of their enrollments
+	ListMyProgress(ctx context.Context, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentProgressResponse, error)
+
+	// ListBatchProgress returns the progress of every student of a batch, for its instructors
+	ListBatchProgress(ctx context.Context, batchID string, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentProgressResponse, error)
+}
+
+type progressService struct {
+	ServiceParams
+}
+
+func NewProgressService(params ServiceParams) ProgressService {
+	return &progressService{
+		ServiceParams: params,
+	}
+}
+
+func (s *progressService) UpdateLessonProgress(ctx context.Context, lessonID string, req *dto.UpdateLessonProgressRequest) (*dto.LessonProgressResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	// progress is only recorded for lessons the student may open
+	lesson, err := NewContentService(s.ServiceParams).GetLesson(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := types.GetUserID(ctx)
+
+	var progress *domainContent.LessonProgress
+	var completedNow bool
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		progress, err = s.LessonProgressRepo.GetByUserAndLesson(ctx, userID, lesson.ID)
+		if err != nil && !ierr.IsNotFound(err) {
+			return err
+		}
+
+		if progress == nil {
+			progress = &domainContent.LessonProgress{
+				ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_LESSON_PROGRESS),
+				UserID:       userID,
+				InternshipID: lesson.InternshipID,
+				LessonID:     lesson.ID,
+				BaseModel:    types.GetDefaultBaseModel(ctx),
+			}
+
+			req.ApplyTo(progress)
+			completedNow = progress.IsCompleted()
+			return s.LessonProgressRepo.Create(ctx, progress)
+		}
+
+		wasCompleted := progress.IsCompleted()
+		req.ApplyTo(progress)
+		completedNow = !wasCompleted && progress.IsCompleted()
+		return s.LessonProgressRepo.Update(ctx, progress)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// progress unlocks lessons
+	invalidateAccess(s.ServiceParams, userID)
+
+	if completedNow {
+		s.publishLessonCompleted(ctx, &lesson.Lesson, progress)
+	}
+
+	return &dto.LessonProgressResponse{LessonProgress: *progress}, nil
+}
+
+func (s *progressService) GetInternshipProgress(ctx context.Context, internshipID string) (*dto.InternshipProgressResponse, error) {
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, internshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleFilter := types.NewNoLimitModuleFilter()
+	moduleFilter.InternshipIDs = []string{internship.ID}
+	modules, err := s.ModuleRepo.ListAll(ctx, moduleFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	lessonFilter := types.NewNoLimitLessonFilter()
+	lessonFilter.InternshipIDs = []string{internship.ID}
+	lessons, err := s.LessonRepo.ListAll(ctx, lessonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := types.GetUserID(ctx)
+
+	progressFilter := types.NewNoLimitLessonProgressFilter()
+	progressFilter.UserIDs = []string{userID}
+	progressFilter.InternshipIDs = []string{internship.ID}
+	records, err := s.LessonProgressRepo.ListAll(ctx, progressFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	quizzes, attempts, err := loadQuizResults(ctx, s.ServiceParams, []string{userID}, []string{internship.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	completion := newLessonCompletion(lessons, records, quizzes, attempts)
+
+	response := &dto.InternshipProgressResponse{
+		InternshipID:     internship.ID,
+		TotalLessons:     completion.total[internship.ID],
+		CompletedLessons: completion.completed[userID][internship.ID],
+		TotalQuizzes:     completion.totalQuizzes[internship.ID],
+		PassedQuizzes:    completion.passedQuizzes[userID][internship.ID],
+		ProgressPercent:  completion.percent(userID, internship.ID),
+		Resume:           resumePosition(modules, lessons, records),
+		Lessons: lo.Map(records, func(progress *domainContent.LessonProgress, _ int) *dto.LessonProgressResponse {
+			return &dto.LessonProgressResponse{LessonProgress: *progress}
+		}),
+	}
+
+	return response, nil
+}
+
+func (s *progressService) ListMyProgress(ctx context.Context, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentProgressResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipEnrollmentFilter()
+	}
+
+	filter.UserID = types.GetUserID(ctx)
+
+	return s.listEnrollmentProgress(ctx, filter)
+}
+
+func (s *progressService) ListBatchProgress(ctx context.Context, batchID string, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentProgressResponse, error) {
+	if filter == nil {
+		filter = types.NewInternshipEnrollmentFilter()
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, batch.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	// every instructor of the internship follows its students, not only the owners
+	if !(&internshipService{ServiceParams: s.ServiceParams}).canReview(ctx, internship) {
+		return nil, ierr.NewError("not an instructor of the internship").
+			WithHint("Only instructors of the internship and admins can see the progress of its students").
+			WithReportableDetails(map[string]any{
+				"internship_id":       internship.ID,
+				"internship_batch_id": batch.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	filter.InternshipBatchID = lo.ToPtr(batch.ID)
+
+	return s.listEnrollmentProgress(ctx, filter)
+}
+
+func (s *progressService) listEnrollmentProgress(ctx context.Context, filter *types.InternshipEnrollmentFilter) (*dto.ListEnrollmentProgressResponse, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	enrollments, err := s.InternshipEnrollmentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, enrollments, s.InternshipEnrollmentRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := loadLessonCompletion(ctx, s.ServiceParams,
+		lo.Uniq(lo.Map(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) string {
+			return enrollment.UserID
+		})),
+		lo.Uniq(lo.Map(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) string {
+			return enrollment.InternshipID
+		})),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListEnrollmentProgressResponse{
+		Items:      make([]*dto.EnrollmentProgressResponse, len(enrollments)),
+		Pagination: pagination,
+	}
+
+	for i, enrollment := range enrollments {
+		response.Items[i] = &dto.EnrollmentProgressResponse{
+			EnrollmentID:      enrollment.ID,
+			UserID:            enrollment.UserID,
+			InternshipID:      enrollment.InternshipID,
+			InternshipBatchID: enrollment.InternshipBatchID,
+			EnrollmentStatus:  enrollment.EnrollmentStatus,
+			TotalLessons:      completion.total[enrollment.InternshipID],
+			CompletedLessons:  completion.completed[enrollment.UserID][enrollment.InternshipID],
+			TotalQuizzes:      completion.totalQuizzes[enrollment.InternshipID],
+			PassedQuizzes:     completion.passedQuizzes[enrollment.UserID][enrollment.InternshipID],
+			ProgressPercent:   completion.percent(enrollment.UserID, enrollment.InternshipID),
+			LastActivityAt:    completion.lastActivity(enrollment.UserID, enrollment.InternshipID),
+		}
+	}
+
+	return response, nil
+}
+
+func (s *progressService) publishLessonCompleted(ctx context.Context, lesson *domainContent.Lesson, progress *domainContent.LessonProgress) {
+	completion, err := loadLessonCompletion(ctx, s.ServiceParams, []string{progress.UserID}, []string{lesson.InternshipID})
+	if err != nil {
+		s.Logger.Errorw("failed to load lesson completion",
+			"lesson_id", lesson.ID,
+			"user_id", progress.UserID,
+			"error", err)
+		return
+	}
+
+	payload, err := json.Marshal(&webhookDto.LessonCompletedWebhookPayload{
+		LessonID:         lesson.ID,
+		ModuleID:         lesson.ModuleID,
+		InternshipID:     lesson.InternshipID,
+		CompletedAt:      lo.FromPtr(progress.CompletedAt),
+		CompletedLessons: completion.completed[progress.UserID][lesson.InternshipID],
+		TotalLessons:     completion.total[lesson.InternshipID],
+		ProgressPercent:  completion.percent(progress.UserID, lesson.InternshipID),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build lesson completed event",
+			"lesson_id", lesson.ID,
+			"user_id", progress.UserID,
+			"error", err)
+		return
+	}
+
+	err = s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: types.WebhookEventLessonCompleted,
+		UserID:    lo.ToPtr(progress.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to publish lesson completed event",
+			"lesson_id", lesson.ID,
+			"user_id", progress.UserID,
+			"error", err)
+	}
+}
+
+// resumePosition picks the lesson a student continues with, the one they last left unfinished
+// or else the first one they haven't completed yet. Nil once every lesson is completed.
+func resumePosition(modules []*domainContent.Module, lessons []*domainContent.Lesson, records []*domainContent.LessonProgress) *dto.ResumePosition {
+	live := lo.SliceToMap(lessons, func(lesson *domainContent.Lesson) (string, bool) {
+		return lesson.ID, true
+	})
+
+	var unfinished *domainContent.LessonProgress
+	for _, progress := range records {
+		if progress.IsCompleted() || !live[progress.LessonID] {
+			continue
+		}
+		if unfinished == nil || progress.UpdatedAt.After(unfinished.UpdatedAt) {
+			unfinished = progress
+		}
+	}
+
+	if unfinished != nil {
+		return &dto.ResumePosition{
+			LessonID:        unfinished.LessonID,
+			PositionSeconds: unfinished.PositionSeconds,
+		}
+	}
+
+	completed := lo.SliceToMap(lo.Filter(records, func(progress *domainContent.LessonProgress, _ int) bool {
+		return progress.IsCompleted()
+	}), func(progress *domainContent.LessonProgress) (string, bool) {
+		return progress.LessonID, true
+	})
+
+	lessonsByModule := lo.GroupBy(lessons, func(lesson *domainContent.Lesson) string {
+		return lesson.ModuleID
+	})
+	for _, module := range modules {
+		for _, lesson := range lessonsByModule[module.ID] {
+			if !completed[lesson.ID] {
+				return &dto.ResumePosition{LessonID: lesson.ID}
+			}
+		}
+	}
+
+	return nil
+}
+
+// lessonCompletion counts the lessons and quizzes of internships and how many of them students
+// completed, progress in lessons or quizzes that were deleted since doesn't count
+type lessonCompletion struct {
+	// total lessons per internship
+	total map[string]int
+
+	// completed lessons per user and internship
+	completed map[string]map[string]int
+
+	// total quizzes per internship
+	totalQuizzes map[string]int
+
+	// quizzes with a passing submitted attempt per user and internship
+	passedQuizzes map[string]map[string]int
+
+	// latest progress update per user and internship
+	activity map[string]map[string]time.Time
+}
+
+func newLessonCompletion(lessons []*domainContent.Lesson, records []*domainContent.LessonProgress, quizzes []*domainContent.Quiz, attempts []*domainContent.QuizAttempt) *lessonCompletion {
+	completion := &lessonCompletion{
+		total:         lo.CountValuesBy(lessons, func(lesson *domainContent.Lesson) string { return lesson.InternshipID }),
+		completed:     make(map[string]map[string]int),
+		totalQuizzes:  lo.CountValuesBy(quizzes, func(quiz *domainContent.Quiz) string { return quiz.InternshipID }),
+		passedQuizzes: make(map[string]map[string]int),
+		activity:      make(map[string]map[string]time.Time),
+	}
+
+	live := lo.SliceToMap(lessons, func(lesson *domainContent.Lesson) (string, bool) {
+		return lesson.ID, true
+	})
+
+	for _, progress := range records {
+		if !live[progress.LessonID] {
+			continue
+		}
+
+		completion.track(progress.UserID, progress.InternshipID, progress.UpdatedAt)
+
+		if progress.IsCompleted() {
+			completion.completed[progress.UserID][progress.InternshipID]++
+		}
+	}
+
+	liveQuizzes := lo.KeyBy(quizzes, func(quiz *domainContent.Quiz) string { return quiz.ID })
+	passed := make(map[string]bool)
+
+	for _, attempt := range attempts {
+		quiz, ok := liveQuizzes[attempt.QuizID]
+		if !ok || attempt.SubmittedAt == nil {
+			continue
+		}
+
+		completion.track(attempt.UserID, attempt.InternshipID, lo.FromPtr(attempt.SubmittedAt))
+
+		key := attempt.UserID + "|" + quiz.ID
+		if !passed[key] && quiz.Passes(lo.FromPtr(attempt.ScorePercent)) {
+			passed[key] = true
+			completion.passedQuizzes[attempt.UserID][attempt.InternshipID]++
+		}
+	}
+
+	return completion
+}
+
+// track prepares the counts of a user and moves their last activity in the internship forward
+func (c *lessonCompletion) track(userID string, internshipID string, at time.Time) {
+	if c.activity[userID] == nil {
+		c.activity[userID] = make(map[string]time.Time)
+		c.completed[userID] = make(map[string]int)
+		c.passedQuizzes[userID] = make(map[string]int)
+	}
+
+	if at.After(c.activity[userID][internshipID]) {
+		c.activity[userID][internshipID] = at
+	}
+}
+
+func loadLessonCompletion(ctx context.Context, params ServiceParams, userIDs []string, internshipIDs []string) (*lessonCompletion, error) {
+	if len(userIDs) == 0 || len(internshipIDs) == 0 {
+		return newLessonCompletion(nil, nil, nil, nil), nil
+	}
+
+	lessonFilter := types.NewNoLimitLessonFilter()
+	lessonFilter.InternshipIDs = internshipIDs
+	lessons, err := params.LessonRepo.ListAll(ctx, lessonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	progressFilter := types.NewNoLimitLessonProgressFilter()
+	progressFilter.UserIDs = userIDs
+	progressFilter.InternshipIDs = internshipIDs
+	records, err := params.LessonProgressRepo.ListAll(ctx, progressFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	quizzes, attempts, err := loadQuizResults(ctx, params, userIDs, internshipIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLessonCompletion(lessons, records, quizzes, attempts), nil
+}
+
+// loadQuizResults returns the quizzes of the internships and the submitted attempts of the users at them
+func loadQuizResults(ctx context.Context, params ServiceParams, userIDs []string, internshipIDs []string) ([]*domainContent.Quiz, []*domainContent.QuizAttempt, error) {
+	quizFilter := types.NewNoLimitQuizFilter()
+	quizFilter.InternshipIDs = internshipIDs
+	quizzes, err := params.QuizRepo.ListAll(ctx, quizFilter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(quizzes) == 0 {
+		return quizzes, nil, nil
+	}
+
+	attemptFilter := types.NewNoLimitQuizAttemptFilter()
+	attemptFilter.UserIDs = userIDs
+	attemptFilter.InternshipIDs = internshipIDs
+	attemptFilter.AttemptStatus = types.QuizAttemptStatusSubmitted
+	attempts, err := params.QuizAttemptRepo.ListAll(ctx, attemptFilter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return quizzes, attempts, nil
+}
+
+// percent is the share of the lessons and quizzes of the internship the user completed or passed,
+// rounded to two decimals
+func (c *lessonCompletion) percent(userID string, internshipID string) float64 {
+	total := c.total[internshipID] + c.totalQuizzes[internshipID]
+	if total == 0 {
+		return 0
+	}
+
+	done := c.completed[userID][internshipID] + c.passedQuizzes[userID][internshipID]
+	return math.Round(float64(done)*10000/float64(total)) / 100
+}
+
+func (c *lessonCompletion) lastActivity(userID string, internshipID string) *time.Time {
+	at, ok := c.activity[userID][internshipID]
+	if !ok {
+		return nil
+	}
+	return &at
+}
@@ -0,0 +1,513 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainAuth "github.com/omkar273/codegeeky/internal/domain/auth"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+type QuizService interface {
+	Create(ctx context.Context, moduleID string, req *dto.CreateQuizRequest) (*dto.QuizResponse, error)
+
+	// Get returns a quiz, the question pool is only included for those who manage it
+	Get(ctx context.Context, id string) (*dto.QuizResponse, error)
+
+	Update(ctx context.Context, id string, req *dto.UpdateQuizRequest) (*dto.QuizResponse, error)
+	Delete(ctx context.Context, id string) error
+
+	// StartAttempt draws the questions of a new attempt for the current student, or resumes
+	// the attempt they have open
+	StartAttempt(ctx context.Context, quizID string) (*dto.QuizAttemptResponse, error)
+
+	// SubmitAttempt grades the answers of an open attempt of the current student
+	SubmitAttempt(ctx context.Context, attemptID string, req *dto.SubmitQuizAttemptRequest) (*dto.QuizAttemptResponse, error)
+
+	GetAttempt(ctx context.Context, id string) (*dto.QuizAttemptResponse, error)
+
+	// ListAttempts returns the attempts at a quiz, students only see their own
+	ListAttempts(ctx context.Context, quizID string, filter *types.QuizAttemptFilter) (*dto.ListQuizAttemptResponse, error)
+}
+
+type quizService struct {
+	ServiceParams
+}
+
+func NewQuizService(params ServiceParams) QuizService {
+	return &quizService{
+		ServiceParams: params,
+	}
+}
+
+func (s *quizService) Create(ctx context.Context, moduleID string, req *dto.CreateQuizRequest) (*dto.QuizResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	module, err := s.ModuleRepo.Get(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeManage(ctx, module.InternshipID); err != nil {
+		return nil, err
+	}
+
+	quiz := req.ToQuiz(ctx, module)
+	if err := quiz.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.QuizRepo.Create(ctx, quiz); err != nil {
+		return nil, err
+	}
+
+	return dto.NewQuizResponse(quiz, true), nil
+}
+
+func (s *quizService) Get(ctx context.Context, id string) (*dto.QuizResponse, error) {
+	quiz, err := s.QuizRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, quiz.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeContent(ctx, s.ServiceParams, internship, domainAuth.PermissionViewQuizzes); err != nil {
+		return nil, err
+	}
+
+	return dto.NewQuizResponse(quiz, s.canReview(ctx, internship)), nil
+}
+
+func (s *quizService) Update(ctx context.Context, id string, req *dto.UpdateQuizRequest) (*dto.QuizResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	quiz, err := s.getManagedQuiz(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyTo(quiz)
+	if err := quiz.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateContentModule(ctx, s.ServiceParams, quiz.InternshipID, quiz.ModuleID); err != nil {
+		return nil, err
+	}
+
+	if err := s.QuizRepo.Update(ctx, quiz); err != nil {
+		return nil, err
+	}
+
+	return dto.NewQuizResponse(quiz, true), nil
+}
+
+func (s *quizService) Delete(ctx context.Context, id string) error {
+	quiz, err := s.getManagedQuiz(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.QuizRepo.Delete(ctx, quiz.ID)
+}
+
+func (s *quizService) StartAttempt(ctx context.Context, quizID string) (*dto.QuizAttemptResponse, error) {
+	quiz, err := s.QuizRepo.Get(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := getVisibleInternship(ctx, s.ServiceParams, quiz.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeContent(ctx, s.ServiceParams, internship, domainAuth.PermissionViewQuizzes); err != nil {
+		return nil, err
+	}
+
+	enrollment, err := getActiveEnrollment(ctx, s.ServiceParams, quiz.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := s.startAttempt(ctx, quiz, enrollment)
+	if ierr.IsAlreadyExists(err) {
+		// a concurrent start took the attempt number first, the unique index on it keeps the
+		// attempt limit, starting over resumes that attempt or reports the limit
+		attempt, err = s.startAttempt(ctx, quiz, enrollment)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewQuizAttemptResponse(quiz, attempt, false), nil
+}
+
+func (s *quizService) SubmitAttempt(ctx context.Context, attemptID string, req *dto.SubmitQuizAttemptRequest) (*dto.QuizAttemptResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	attempt, err := s.QuizAttemptRepo.Get(ctx, attemptID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("not the owner of the quiz attempt").
+			WithHint("Only the student taking the attempt can submit it").
+			WithReportableDetails(map[string]any{
+				"attempt_id": attempt.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	if !attempt.IsOpen() {
+		return nil, ierr.NewError("quiz attempt is already over").
+			WithHint("This attempt was already submitted or ran out of time").
+			WithReportableDetails(map[string]any{
+				"attempt_id":     attempt.ID,
+				"attempt_status": attempt.AttemptStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	quiz, err := s.QuizRepo.Get(ctx, attempt.QuizID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if attempt.IsOverdue(now) {
+		if err := s.expireOverdue(ctx, quiz, attempt, now); err != nil {
+			return nil, err
+		}
+
+		return nil, ierr.NewError("quiz attempt ran out of time").
+			WithHint("The time limit of this attempt has passed, its answers were not accepted").
+			WithReportableDetails(map[string]any{
+				"attempt_id": attempt.ID,
+				"expires_at": attempt.ExpiresAt,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	attempt.Grade(quiz, req.Answers)
+	attempt.AttemptStatus = types.QuizAttemptStatusSubmitted
+	attempt.SubmittedAt = lo.ToPtr(now)
+
+	// a concurrent submission or expiry may have closed the attempt since it was read
+	if err := s.QuizAttemptRepo.Close(ctx, attempt); err != nil {
+		return nil, err
+	}
+
+	reveal, err := s.revealAnswers(ctx, quiz, attempt.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewQuizAttemptResponse(quiz, attempt, reveal), nil
+}
+
+func (s *quizService) GetAttempt(ctx context.Context, id string) (*dto.QuizAttemptResponse, error) {
+	attempt, err := s.QuizAttemptRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer := false
+	if attempt.UserID != types.GetUserID(ctx) {
+		internship, err := s.InternshipRepo.Get(ctx, attempt.InternshipID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !s.canReview(ctx, internship) {
+			return nil, ierr.NewError("not an instructor of the internship").
+				WithHint("Only the student and instructors of the internship can view this attempt").
+				WithReportableDetails(map[string]any{
+					"attempt_id":    attempt.ID,
+					"internship_id": internship.ID,
+				}).
+				Mark(ierr.ErrPermissionDenied)
+		}
+		reviewer = true
+	}
+
+	quiz, err := s.QuizRepo.Get(ctx, attempt.QuizID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.expireOverdue(ctx, quiz, attempt, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	reveal, err := s.revealAnswers(ctx, quiz, attempt.UserID, reviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewQuizAttemptResponse(quiz, attempt, reveal), nil
+}
+
+func (s *quizService) ListAttempts(ctx context.Context, quizID string, filter *types.QuizAttemptFilter) (*dto.ListQuizAttemptResponse, error) {
+	if filter == nil {
+		filter = types.NewQuizAttemptFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	quiz, err := s.QuizRepo.Get(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, quiz.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.QuizIDs = []string{quiz.ID}
+	reviewer := s.canReview(ctx, internship)
+	if !reviewer {
+		filter.UserIDs = []string{types.GetUserID(ctx)}
+	}
+
+	reveal, err := s.revealAnswers(ctx, quiz, types.GetUserID(ctx), reviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.QuizAttemptRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, attempts, s.QuizAttemptRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListQuizAttemptResponse{
+		Items: lo.Map(attempts, func(attempt *domainContent.QuizAttempt, _ int) *dto.QuizAttemptResponse {
+			return dto.NewQuizAttemptResponse(quiz, attempt, reveal)
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+// startAttempt resumes the open attempt of the student or draws a new one within the attempt limit
+func (s *quizService) startAttempt(ctx context.Context, quiz *domainContent.Quiz, enrollment *internshipenrollment.InternshipEnrollment) (*domainContent.QuizAttempt, error) {
+	var attempt *domainContent.QuizAttempt
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		filter := types.NewNoLimitQuizAttemptFilter()
+		filter.QuizIDs = []string{quiz.ID}
+		filter.UserIDs = []string{enrollment.UserID}
+
+		attempts, err := s.QuizAttemptRepo.ListAll(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		for _, existing := range attempts {
+			if existing.IsOpen() && !existing.IsOverdue(now) {
+				attempt = existing
+				return nil
+			}
+
+			if err := s.expireOverdue(ctx, quiz, existing, now); err != nil {
+				return err
+			}
+		}
+
+		if quiz.AttemptsExhausted(len(attempts)) {
+			return ierr.NewError("attempt limit reached").
+				WithHintf("This quiz can be attempted at most %d times", lo.FromPtr(quiz.MaxAttempts)).
+				WithReportableDetails(map[string]any{
+					"quiz_id":      quiz.ID,
+					"max_attempts": lo.FromPtr(quiz.MaxAttempts),
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		attempt = newQuizAttempt(ctx, quiz, enrollment, len(attempts)+1, now)
+		return s.QuizAttemptRepo.Create(ctx, attempt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attempt, nil
+}
+
+// revealAnswers reports whether answers may be shown with the closed attempts of a student,
+// instructors always see them and students once they have used up their attempts
+func (s *quizService) revealAnswers(ctx context.Context, quiz *domainContent.Quiz, userID string, reviewer bool) (bool, error) {
+	if reviewer || quiz.MaxAttempts == nil {
+		return reviewer, nil
+	}
+
+	filter := types.NewNoLimitQuizAttemptFilter()
+	filter.QuizIDs = []string{quiz.ID}
+	filter.UserIDs = []string{userID}
+
+	attempts, err := s.QuizAttemptRepo.ListAll(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	return quiz.AttemptsExhausted(len(attempts)) && !lo.SomeBy(attempts, (*domainContent.QuizAttempt).IsOpen), nil
+}
+
+// expireOverdue closes an open attempt whose time ran out, it scores nothing
+func (s *quizService) expireOverdue(ctx context.Context, quiz *domainContent.Quiz, attempt *domainContent.QuizAttempt, now time.Time) error {
+	if !attempt.IsOpen() || !attempt.IsOverdue(now) {
+		return nil
+	}
+
+	attempt.Grade(quiz, nil)
+	attempt.AttemptStatus = types.QuizAttemptStatusExpired
+
+	err := s.QuizAttemptRepo.Close(ctx, attempt)
+	if err == nil || !ierr.IsInvalidOperation(err) {
+		return err
+	}
+
+	// submitted or expired concurrently, the attempt is over either way so the stored one stands
+	current, err := s.QuizAttemptRepo.Get(ctx, attempt.ID)
+	if err != nil {
+		return err
+	}
+	*attempt = *current
+	return nil
+}
+
+// getManagedQuiz returns a quiz the caller may change
+func (s *quizService) getManagedQuiz(ctx context.Context, id string) (*domainContent.Quiz, error) {
+	quiz, err := s.QuizRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeManage(ctx, quiz.InternshipID); err != nil {
+		return nil, err
+	}
+
+	return quiz, nil
+}
+
+func (s *quizService) authorizeManage(ctx context.Context, internshipID string) error {
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return err
+	}
+
+	return authorizeInternship(ctx, s.ServiceParams, internship, domainAuth.PermissionUpdateInternship)
+}
+
+// canReview reports whether the caller sees the answers and the attempts of every student
+func (s *quizService) canReview(ctx context.Context, internship *domainInternship.Internship) bool {
+	return (&internshipService{ServiceParams: s.ServiceParams}).canReview(ctx, internship)
+}
+
+// newQuizAttempt draws the questions of an attempt, a random selection of the pool in pool order
+// unless the quiz shuffles them
+func newQuizAttempt(ctx context.Context, quiz *domainContent.Quiz, enrollment *internshipenrollment.InternshipEnrollment, number int, now time.Time) *domainContent.QuizAttempt {
+	drawn := rand.Perm(len(quiz.Questions))[:quiz.AttemptQuestionCount()]
+	if !quiz.ShuffleQuestions {
+		slices.Sort(drawn)
+	}
+
+	attempt := &domainContent.QuizAttempt{
+		ID:           types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUIZ_ATTEMPT),
+		QuizID:       quiz.ID,
+		InternshipID: quiz.InternshipID,
+		EnrollmentID: enrollment.ID,
+		UserID:       enrollment.UserID,
+		Attempt:      number,
+		QuestionIDs: lo.Map(drawn, func(index int, _ int) string {
+			return quiz.Questions[index].ID
+		}),
+		StartedAt:     now,
+		AttemptStatus: types.QuizAttemptStatusInProgress,
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+
+	if quiz.TimeLimitMinutes != nil {
+		attempt.ExpiresAt = lo.ToPtr(now.Add(time.Duration(lo.FromPtr(quiz.TimeLimitMinutes)) * time.Minute))
+	}
+
+	return attempt
+}
+
+// passesQuizzes reports whether the student of an enrollment passed every quiz of the internship
+// that has a passing percentage, their best submitted attempt counts
+func passesQuizzes(ctx context.Context, params ServiceParams, enrollment *internshipenrollment.InternshipEnrollment) (bool, error) {
+	quizFilter := types.NewNoLimitQuizFilter()
+	quizFilter.InternshipIDs = []string{enrollment.InternshipID}
+	quizzes, err := params.QuizRepo.ListAll(ctx, quizFilter)
+	if err != nil {
+		return false, err
+	}
+
+	graded := lo.Filter(quizzes, func(quiz *domainContent.Quiz, _ int) bool {
+		return quiz.PassingPercent != nil
+	})
+	if len(graded) == 0 {
+		return true, nil
+	}
+
+	passed, err := passedQuizzes(ctx, params, enrollment.ID, graded)
+	if err != nil {
+		return false, err
+	}
+
+	return len(passed) == len(graded), nil
+}
+
+// passedQuizzes returns the ids of the quizzes an enrollment has a passing submitted attempt at
+func passedQuizzes(ctx context.Context, params ServiceParams, enrollmentID string, quizzes []*domainContent.Quiz) (map[string]bool, error) {
+	passed := make(map[string]bool)
+	if len(quizzes) == 0 {
+		return passed, nil
+	}
+
+	filter := types.NewNoLimitQuizAttemptFilter()
+	filter.EnrollmentIDs = []string{enrollmentID}
+	filter.AttemptStatus = types.QuizAttemptStatusSubmitted
+	attempts, err := params.QuizAttemptRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := lo.KeyBy(quizzes, func(quiz *domainContent.Quiz) string { return quiz.ID })
+	for _, attempt := range attempts {
+		quiz, ok := byID[attempt.QuizID]
+		if ok && quiz.Passes(lo.FromPtr(attempt.ScorePercent)) {
+			passed[quiz.ID] = true
+		}
+	}
+
+	return passed, nil
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/auth"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	domainInternshipEnrollment "github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type QuizServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service    QuizService
+	ctx        context.Context
+	internship *domainInternship.Internship
+}
+
+func TestQuizService(t *testing.T) {
+	suite.Run(t, new(QuizServiceSuite))
+}
+
+func (s *QuizServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	stores := s.GetStores()
+	params := ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   s.GetConfig(),
+		DB:                       s.GetDB(),
+		InternshipRepo:           stores.InternshipRepo,
+		InternshipInstructorRepo: stores.InternshipInstructorRepo,
+		InternshipEnrollmentRepo: stores.InternshipEnrollmentRepo,
+		SubscriptionRepo:         stores.SubscriptionRepo,
+		SubscriptionPlanRepo:     stores.SubscriptionPlanRepo,
+		LessonRepo:               stores.LessonRepo,
+		LessonProgressRepo:       stores.LessonProgressRepo,
+		QuizRepo:                 stores.QuizRepo,
+		QuizAttemptRepo:          stores.QuizAttemptRepo,
+		AuthzService:             auth.NewUnifiedAuthorizationService(s.GetLogger()),
+	}
+	s.Require().NoError(params.AuthzService.RegisterAttributeProvider(NewEnrollmentAttributeProvider(params)))
+	s.service = NewQuizService(params)
+
+	// quizzes are taken by students, the default user of the suite is an admin
+	s.ctx = context.WithValue(s.GetContext(), types.CtxUserRole, types.UserRoleStudent)
+
+	s.internship = &domainInternship.Internship{
+		ID:            s.GetUUID(),
+		Title:         "Backend Engineering",
+		PublishStatus: types.InternshipPublishStatusPublished,
+		BaseModel:     types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.internship.CreatedBy = s.GetUUID()
+	s.Require().NoError(stores.InternshipRepo.Create(s.GetContext(), s.internship))
+}
+
+func (s *QuizServiceSuite) enroll() *domainInternshipEnrollment.InternshipEnrollment {
+	enrollment := &domainInternshipEnrollment.InternshipEnrollment{
+		ID:                s.GetUUID(),
+		UserID:            types.DefaultUserID,
+		InternshipID:      s.internship.ID,
+		InternshipBatchID: s.GetUUID(),
+		EnrollmentStatus:  types.InternshipEnrollmentStatusEnrolled,
+		PaymentStatus:     types.PaymentStatusSuccess,
+		Metadata:          types.Metadata{},
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().InternshipEnrollmentRepo.Create(s.GetContext(), enrollment))
+	return enrollment
+}
+
+// createQuiz creates a quiz with a multiple choice, a multi select and a short answer question
+func (s *QuizServiceSuite) createQuiz(configure func(quiz *domainContent.Quiz)) *domainContent.Quiz {
+	quiz := &domainContent.Quiz{
+		ID:           s.GetUUID(),
+		InternshipID: s.internship.ID,
+		ModuleID:     s.GetUUID(),
+		Title:        "HTTP basics",
+		Questions: []types.QuizQuestion{
+			{
+				ID:           "q_status",
+				QuestionType: types.QuestionTypeMultipleChoice,
+				Prompt:       "Which status code means not found?",
+				Points:       2,
+				Options: []types.QuizOption{
+					{ID: "o_200", Text: "200"},
+					{ID: "o_404", Text: "404", Correct: true},
+				},
+			},
+			{
+				ID:           "q_safe",
+				QuestionType: types.QuestionTypeMultiSelect,
+				Prompt:       "Which methods are safe?",
+				Points:       1,
+				Options: []types.QuizOption{
+					{ID: "o_get", Text: "GET", Correct: true},
+					{ID: "o_head", Text: "HEAD", Correct: true},
+					{ID: "o_post", Text: "POST"},
+				},
+			},
+			{
+				ID:              "q_port",
+				QuestionType:    types.QuestionTypeShortAnswer,
+				Prompt:          "Which protocol runs on port 443?",
+				Points:          1,
+				AcceptedAnswers: []string{"https"},
+				AnswerMatch:     types.AnswerMatchExact,
+			},
+		},
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}
+	if configure != nil {
+		configure(quiz)
+	}
+	s.Require().NoError(s.GetStores().QuizRepo.Create(s.GetContext(), quiz))
+	return quiz
+}
+
+func (s *QuizServiceSuite) start(quiz *domainContent.Quiz) *dto.QuizAttemptResponse {
+	attempt, err := s.service.StartAttempt(s.ctx, quiz.ID)
+	s.Require().NoError(err)
+	return attempt
+}
+
+func (s *QuizServiceSuite) submit(attempt *dto.QuizAttemptResponse, answers ...types.QuizAnswer) (*dto.QuizAttemptResponse, error) {
+	return s.service.SubmitAttempt(s.ctx, attempt.ID, &dto.SubmitQuizAttemptRequest{Answers: answers})
+}
+
+// revealed reports whether the response shows which options are correct
+func (s *QuizServiceSuite) revealed(response *dto.QuizAttemptResponse) bool {
+	return lo.SomeBy(response.Questions, func(question *dto.AttemptQuestion) bool {
+		return lo.SomeBy(question.Options, func(option dto.AttemptOption) bool {
+			return option.Correct != nil
+		})
+	})
+}
+
+func (s *QuizServiceSuite) TestSubmitGradesAnswers() {
+	s.enroll()
+	quiz := s.createQuiz(nil)
+
+	attempt := s.start(quiz)
+	s.Equal(1, attempt.Attempt)
+	s.Equal([]string{"q_status", "q_safe", "q_port"}, attempt.QuestionIDs)
+	s.False(s.revealed(attempt))
+
+	graded, err := s.submit(attempt,
+		types.QuizAnswer{QuestionID: "q_status", OptionIDs: []string{"o_404"}},
+		types.QuizAnswer{QuestionID: "q_safe", OptionIDs: []string{"o_get"}},
+		types.QuizAnswer{QuestionID: "q_port", Text: "  HTTPS "},
+	)
+	s.Require().NoError(err)
+
+	s.Equal(types.QuizAttemptStatusSubmitted, graded.AttemptStatus)
+	s.NotNil(graded.SubmittedAt)
+	s.Equal(3.0, lo.FromPtr(graded.Score))
+	s.Equal(4.0, graded.MaxScore)
+	s.Equal(75.0, lo.FromPtr(graded.ScorePercent))
+	s.Equal([]types.QuizQuestionResult{
+		{QuestionID: "q_status", Correct: true, Points: 2},
+		{QuestionID: "q_safe"},
+		{QuestionID: "q_port", Correct: true, Points: 1},
+	}, graded.Results)
+
+	// unlimited quizzes never reveal their answers
+	s.False(s.revealed(graded))
+
+	_, err = s.submit(attempt)
+	s.True(ierr.IsInvalidOperation(err))
+}
+
+func (s *QuizServiceSuite) TestSubmitIgnoresQuestionsNotDrawn() {
+	s.enroll()
+	quiz := s.createQuiz(func(quiz *domainContent.Quiz) {
+		quiz.QuestionsPerAttempt = lo.ToPtr(1)
+	})
+
+	attempt := s.start(quiz)
+	s.Require().Len(attempt.QuestionIDs, 1)
+	s.Len(attempt.Questions, 1)
+
+	graded, err := s.submit(attempt,
+		types.QuizAnswer{QuestionID: "q_status", OptionIDs: []string{"o_404"}},
+		types.QuizAnswer{QuestionID: "q_safe", OptionIDs: []string{"o_get", "o_head"}},
+		types.QuizAnswer{QuestionID: "q_port", Text: "https"},
+	)
+	s.Require().NoError(err)
+
+	s.Len(graded.Answers, 1)
+	s.Len(graded.Results, 1)
+	s.Equal(attempt.QuestionIDs[0], graded.Results[0].QuestionID)
+	s.Equal(100.0, lo.FromPtr(graded.ScorePercent))
+}
+
+func (s *QuizServiceSuite) TestStartResumesOpenAttempt() {
+	s.enroll()
+	quiz := s.createQuiz(nil)
+
+	first := s.start(quiz)
+	s.Equal(first.ID, s.start(quiz).ID)
+
+	_, err := s.submit(first)
+	s.Require().NoError(err)
+
+	second := s.start(quiz)
+	s.NotEqual(first.ID, second.ID)
+	s.Equal(2, second.Attempt)
+}
+
+func (s *QuizServiceSuite) TestAttemptLimit() {
+	s.enroll()
+	quiz := s.createQuiz(func(quiz *domainContent.Quiz) {
+		quiz.MaxAttempts = lo.ToPtr(2)
+	})
+
+	first, err := s.submit(s.start(quiz))
+	s.Require().NoError(err)
+	s.False(s.revealed(first))
+
+	// the answers are shown once the last attempt is over
+	last, err := s.submit(s.start(quiz))
+	s.Require().NoError(err)
+	s.True(s.revealed(last))
+
+	_, err = s.service.StartAttempt(s.ctx, quiz.ID)
+	s.True(ierr.IsInvalidOperation(err))
+}
+
+func (s *QuizServiceSuite) TestOverdueAttemptExpires() {
+	s.enroll()
+	quiz := s.createQuiz(func(quiz *domainContent.Quiz) {
+		quiz.TimeLimitMinutes = lo.ToPtr(10)
+		quiz.MaxAttempts = lo.ToPtr(2)
+	})
+
+	attempt := s.start(quiz)
+	s.Require().NotNil(attempt.ExpiresAt)
+	s.WithinDuration(s.GetNow().Add(10*time.Minute), *attempt.ExpiresAt, time.Minute)
+
+	// the time ran out past the grace period
+	stored, err := s.GetStores().QuizAttemptRepo.Get(s.GetContext(), attempt.ID)
+	s.Require().NoError(err)
+	stored.ExpiresAt = lo.ToPtr(s.GetNow().Add(-types.QuizAttemptGracePeriod - time.Second))
+	s.Require().NoError(s.GetStores().QuizAttemptRepo.Update(s.GetContext(), stored))
+
+	_, err = s.submit(attempt, types.QuizAnswer{QuestionID: "q_status", OptionIDs: []string{"o_404"}})
+	s.True(ierr.IsInvalidOperation(err))
+
+	expired, err := s.GetStores().QuizAttemptRepo.Get(s.GetContext(), attempt.ID)
+	s.Require().NoError(err)
+	s.Equal(types.QuizAttemptStatusExpired, expired.AttemptStatus)
+	s.Equal(0.0, lo.FromPtr(expired.Score))
+	s.Nil(expired.SubmittedAt)
+
+	// the expired attempt counts towards the limit
+	s.Equal(2, s.start(quiz).Attempt)
+}
+
+func (s *QuizServiceSuite) TestSubmitRejectsOtherStudentsAttempt() {
+	s.enroll()
+	quiz := s.createQuiz(nil)
+	attempt := s.start(quiz)
+
+	other := context.WithValue(s.ctx, types.CtxUserID, s.GetUUID())
+	_, err := s.service.SubmitAttempt(other, attempt.ID, &dto.SubmitQuizAttemptRequest{})
+	s.True(ierr.IsPermissionDenied(err))
+}
+
+func (s *QuizServiceSuite) TestStartRequiresEnrollment() {
+	quiz := s.createQuiz(nil)
+
+	_, err := s.service.StartAttempt(s.ctx, quiz.ID)
+	s.True(ierr.IsPermissionDenied(err))
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// enrollmentStatusesTaken are the statuses of enrollments a student has taken or is taking the
+// internship in, they count towards its popularity and keep it out of the student's recommendations
+var enrollmentStatusesTaken = []types.InternshipEnrollmentStatus{
+	types.InternshipEnrollmentStatusPending,
+	types.InternshipEnrollmentStatusEnrolled,
+	types.InternshipEnrollmentStatusSuspended,
+	types.InternshipEnrollmentStatusCompleted,
+}
+
+type RecommendationService interface {
+	// Recommend ranks published internships for the current user by their profile skills,
+	// the internships they completed and how popular each internship is
+	Recommend(ctx context.Context, filter *types.RecommendationFilter) (*dto.ListRecommendationResponse, error)
+}
+
+type recommendationService struct {
+	ServiceParams
+}
+
+func NewRecommendationService(params ServiceParams) RecommendationService {
+	return &recommendationService{
+		ServiceParams: params,
+	}
+}
+
+func (s *recommendationService) Recommend(ctx context.Context, filter *types.RecommendationFilter) (*dto.ListRecommendationResponse, error) {
+	if filter == nil {
+		filter = types.NewRecommendationFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.Get(ctx, types.GetUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	enrollmentFilter := types.NewNoLimitInternshipEnrollmentFilter()
+	enrollmentFilter.UserID = user.ID
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, enrollmentFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := lo.FilterMap(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) (string, bool) {
+		return enrollment.InternshipID, lo.Contains(enrollmentStatusesTaken, enrollment.EnrollmentStatus)
+	})
+	completedIDs := lo.FilterMap(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) (string, bool) {
+		return enrollment.InternshipID, enrollment.HasCompleted()
+	})
+
+	learner := &domainInternship.Learner{Skills: user.Skills}
+	if len(completedIDs) > 0 {
+		completedFilter := types.NewNoLimitInternshipFilter()
+		completedFilter.InternshipIDs = lo.Uniq(completedIDs)
+		learner.Completed, err = s.InternshipRepo.ListAll(ctx, completedFilter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	candidateFilter := types.NewNoLimitInternshipFilter()
+	candidateFilter.PublishStatuses = []types.InternshipPublishStatus{types.InternshipPublishStatusPublished}
+	candidateFilter.Levels = filter.Levels
+	candidateFilter.Modes = filter.Modes
+	candidates, err := s.InternshipRepo.ListAll(ctx, candidateFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates = lo.Filter(candidates, func(internship *domainInternship.Internship, _ int) bool {
+		return !lo.Contains(taken, internship.ID)
+	})
+
+	popularity, err := s.countEnrollments(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := domainInternship.RecommendationWeights{
+		Skill:        s.Config.Recommendation.SkillWeight,
+		Prerequisite: s.Config.Recommendation.PrerequisiteWeight,
+		Level:        s.Config.Recommendation.LevelWeight,
+		Popularity:   s.Config.Recommendation.PopularityWeight,
+	}
+
+	recommendations := domainInternship.Recommend(learner, candidates, popularity, weights)
+	if len(recommendations) > filter.GetLimit() {
+		recommendations = recommendations[:filter.GetLimit()]
+	}
+
+	response := &dto.ListRecommendationResponse{
+		Items: make([]*dto.RecommendationResponse, len(recommendations)),
+	}
+	for i, recommendation := range recommendations {
+		hideEditorial(recommendation.Internship)
+		response.Items[i] = dto.NewRecommendationResponse(recommendation)
+	}
+
+	return response, nil
+}
+
+// countEnrollments returns the number of students who took each internship, keyed by internship id
+func (s *recommendationService) countEnrollments(ctx context.Context, internships []*domainInternship.Internship) (map[string]int, error) {
+	return s.InternshipEnrollmentRepo.CountByInternship(ctx, lo.Map(internships, func(internship *domainInternship.Internship, _ int) string {
+		return internship.ID
+	}), enrollmentStatusesTaken)
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/config"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/user"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type RecommendationServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service RecommendationService
+}
+
+func TestRecommendationService(t *testing.T) {
+	suite.Run(t, new(RecommendationServiceSuite))
+}
+
+func (s *RecommendationServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.Recommendation = config.RecommendationConfig{
+		SkillWeight:        4,
+		PrerequisiteWeight: 3,
+		LevelWeight:        2,
+		PopularityWeight:   1,
+	}
+
+	stores := s.GetStores()
+	s.service = NewRecommendationService(ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   cfg,
+		DB:                       s.GetDB(),
+		UserRepo:                 stores.UserRepo,
+		InternshipRepo:           stores.InternshipRepo,
+		InternshipEnrollmentRepo: stores.InternshipEnrollmentRepo,
+	})
+
+	s.Require().NoError(stores.UserRepo.Create(s.GetContext(), &user.User{
+		ID:        types.DefaultUserID,
+		Email:     types.DefaultUserEmail,
+		Role:      types.UserRoleStudent,
+		Skills:    []string{"Go", "SQL"},
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}))
+}
+
+func (s *RecommendationServiceSuite) createInternship(id string, level types.InternshipLevel, skills []string, prerequisites []string) *domainInternship.Internship {
+	internship := &domainInternship.Internship{
+		ID:            id,
+		Title:         id,
+		Skills:        skills,
+		Prerequisites: prerequisites,
+		Level:         level,
+		Mode:          types.InternshipModeRemote,
+		PublishStatus: types.InternshipPublishStatusPublished,
+		BaseModel:     types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().InternshipRepo.Create(s.GetContext(), internship))
+	return internship
+}
+
+func (s *RecommendationServiceSuite) enroll(userID string, internshipID string, status types.InternshipEnrollmentStatus) *internshipenrollment.InternshipEnrollment {
+	enrollment := &internshipenrollment.InternshipEnrollment{
+		ID:                s.GetUUID(),
+		UserID:            userID,
+		InternshipID:      internshipID,
+		InternshipBatchID: s.GetUUID(),
+		EnrollmentStatus:  status,
+		PaymentStatus:     types.PaymentStatusSuccess,
+		BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+	}
+	if status == types.InternshipEnrollmentStatusCompleted {
+		enrollment.CompletedAt = lo.ToPtr(s.GetNow().Add(-24 * time.Hour))
+	}
+	s.Require().NoError(s.GetStores().InternshipEnrollmentRepo.Create(s.GetContext(), enrollment))
+	return enrollment
+}
+
+func (s *RecommendationServiceSuite) recommend() []*dto.RecommendationResponse {
+	response, err := s.service.Recommend(s.GetContext(), nil)
+	s.Require().NoError(err)
+	return response.Items
+}
+
+func recommendedIDs(items []*dto.RecommendationResponse) []string {
+	return lo.Map(items, func(item *dto.RecommendationResponse, _ int) string {
+		return item.Internship.ID
+	})
+}
+
+func reasonTypes(item *dto.RecommendationResponse) []types.RecommendationReasonType {
+	return lo.Map(item.Reasons, func(reason types.RecommendationReason, _ int) types.RecommendationReasonType {
+		return reason.Type
+	})
+}
+
+func (s *RecommendationServiceSuite) TestRanksBySkillsPrerequisitesAndLevel() {
+	s.createInternship("beginner-go", types.InternshipLevelBeginner, []string{"go", "sql"}, nil)
+	s.createInternship("intermediate-go", types.InternshipLevelIntermediate, []string{"go", "docker"}, []string{"go", "kubernetes"})
+	s.createInternship("advanced-rust", types.InternshipLevelAdvanced, []string{"rust"}, nil)
+
+	items := s.recommend()
+
+	s.Equal([]string{"beginner-go", "intermediate-go"}, recommendedIDs(items))
+
+	// every skill matched and the beginner level for a learner who completed nothing
+	s.InDelta(6.0, items[0].Score, 1e-9)
+	s.Equal([]types.RecommendationReasonType{
+		types.RecommendationReasonSkillMatch,
+		types.RecommendationReasonLevelProgression,
+	}, reasonTypes(items[0]))
+	s.Equal([]string{"go", "sql"}, items[0].Reasons[0].Skills)
+	s.Equal("Builds on go, sql, which you already know", items[0].Reasons[0].Message)
+
+	// half the skills and half the prerequisites
+	s.InDelta(3.5, items[1].Score, 1e-9)
+	s.Equal([]types.RecommendationReasonType{
+		types.RecommendationReasonSkillMatch,
+		types.RecommendationReasonPrerequisitesMet,
+	}, reasonTypes(items[1]))
+	s.Equal("You meet 1 of its 2 prerequisites", items[1].Reasons[1].Message)
+	s.InDelta(1.5, items[1].Reasons[1].Score, 1e-9)
+}
+
+func (s *RecommendationServiceSuite) TestExcludesTakenAndUnpublishedInternships() {
+	s.createInternship("pending", types.InternshipLevelBeginner, []string{"go"}, nil)
+	s.createInternship("enrolled", types.InternshipLevelBeginner, []string{"go"}, nil)
+	s.createInternship("cancelled", types.InternshipLevelBeginner, []string{"go"}, nil)
+	draft := s.createInternship("draft", types.InternshipLevelBeginner, []string{"go"}, nil)
+	draft.PublishStatus = types.InternshipPublishStatusDraft
+	s.Require().NoError(s.GetStores().InternshipRepo.Update(s.GetContext(), draft))
+
+	s.enroll(types.DefaultUserID, "pending", types.InternshipEnrollmentStatusPending)
+	s.enroll(types.DefaultUserID, "enrolled", types.InternshipEnrollmentStatusEnrolled)
+	s.enroll(types.DefaultUserID, "cancelled", types.InternshipEnrollmentStatusCancelled)
+
+	s.Equal([]string{"cancelled"}, recommendedIDs(s.recommend()))
+}
+
+func (s *RecommendationServiceSuite) TestCountsPopularityFromTakenEnrollments() {
+	s.createInternship("popular", types.InternshipLevelAdvanced, []string{"rust"}, nil)
+	s.createInternship("niche", types.InternshipLevelAdvanced, []string{"rust"}, nil)
+	s.createInternship("abandoned", types.InternshipLevelAdvanced, []string{"rust"}, nil)
+
+	s.enroll(s.GetUUID(), "popular", types.InternshipEnrollmentStatusEnrolled)
+	s.enroll(s.GetUUID(), "popular", types.InternshipEnrollmentStatusCompleted)
+	s.enroll(s.GetUUID(), "niche", types.InternshipEnrollmentStatusEnrolled)
+	s.enroll(s.GetUUID(), "abandoned", types.InternshipEnrollmentStatusCancelled)
+
+	items := s.recommend()
+
+	// nothing but popularity recommends these, cancelled enrollments don't count
+	s.Equal([]string{"popular", "niche"}, recommendedIDs(items))
+	s.Equal(2, items[0].Enrollments)
+	s.InDelta(1.0, items[0].Score, 1e-9)
+	s.Equal("2 students enrolled", items[0].Reasons[0].Message)
+	s.Equal(1, items[1].Enrollments)
+	s.InDelta(0.5, items[1].Score, 1e-9)
+}
+
+func (s *RecommendationServiceSuite) TestProgressesFromCompletedInternships() {
+	s.createInternship("done", types.InternshipLevelIntermediate, []string{"docker"}, nil)
+	s.createInternship("next", types.InternshipLevelAdvanced, []string{"docker", "kubernetes"}, nil)
+	s.createInternship("same", types.InternshipLevelIntermediate, []string{"terraform"}, nil)
+	s.createInternship("beginner", types.InternshipLevelBeginner, []string{"html"}, nil)
+
+	s.enroll(types.DefaultUserID, "done", types.InternshipEnrollmentStatusCompleted)
+
+	items := s.recommend()
+
+	// skills of the completed internship count as known, the next level up scores full weight
+	s.Equal([]string{"next", "same"}, recommendedIDs(items))
+	s.InDelta(4.0, items[0].Score, 1e-9)
+	s.Equal([]string{"docker"}, items[0].Reasons[0].Skills)
+	s.Equal("The next step up from the intermediate internships you completed", items[0].Reasons[1].Message)
+	s.InDelta(1.0, items[1].Score, 1e-9)
+}
+
+func (s *RecommendationServiceSuite) TestIgnoresCompletionsWithoutCompletionDate() {
+	s.createInternship("done", types.InternshipLevelIntermediate, []string{"docker"}, nil)
+	s.createInternship("next", types.InternshipLevelAdvanced, []string{"docker"}, nil)
+	s.createInternship("beginner", types.InternshipLevelBeginner, []string{"html"}, nil)
+
+	enrollment := s.enroll(types.DefaultUserID, "done", types.InternshipEnrollmentStatusCompleted)
+	enrollment.CompletedAt = nil
+	s.Require().NoError(s.GetStores().InternshipEnrollmentRepo.Update(s.GetContext(), enrollment))
+
+	// the learner still counts as having completed nothing, so only the beginner level is suggested
+	s.Equal([]string{"beginner"}, recommendedIDs(s.recommend()))
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainDiscount "github.com/omkar273/codegeeky/internal/domain/discount"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	domainReferral "github.com/omkar273/codegeeky/internal/domain/referral"
+	domainUser "github.com/omkar273/codegeeky/internal/domain/user"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// ReferralService manages referral codes and the rewards earned through them
+type ReferralService interface {
+	// GenerateReferralCode returns a referral code not yet assigned to any user
+	GenerateReferralCode(ctx context.Context) (string, error)
+	// ValidateReferralCode resolves the referrer owning the code
+	ValidateReferralCode(ctx context.Context, code string) (*domainUser.User, error)
+	// ApplyReferral records the referral of a newly onboarded user and grants the referee discount
+	ApplyReferral(ctx context.Context, referee *domainUser.User, code string) (*dto.ReferralResponse, error)
+
+	GetSummary(ctx context.Context) (*dto.ReferralSummaryResponse, error)
+	List(ctx context.Context, filter *types.ReferralFilter) (*dto.ListReferralResponse, error)
+
+	// Payment lifecycle hooks
+	HandlePaymentSucceeded(ctx context.Context, payment *domainPayment.Payment) error
+	HandlePaymentRefunded(ctx context.Context, payment *domainPayment.Payment) error
+
+	// ProcessRewards rewards referrers whose referee payment has cleared the refund window
+	ProcessRewards(ctx context.Context) (int, error)
+}
+
+const (
+	referralCodeLength         = 8
+	referralCodeMaxAttempts    = 5
+	referralDiscountCodePrefix = "REF"
+	referralRewardCodePrefix   = "RWD"
+)
+
+type referralService struct {
+	ServiceParams
+}
+
+func NewReferralService(params ServiceParams) ReferralService {
+	return &referralService{
+		ServiceParams: params,
+	}
+}
+
+func (s *referralService) GenerateReferralCode(ctx context.Context) (string, error) {
+	for attempt := 0; attempt < referralCodeMaxAttempts; attempt++ {
+		code, err := generateRandomCode("", types.DiscountCodeCharsetDefault, referralCodeLength)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = s.UserRepo.GetByReferralCode(ctx, code)
+		if ierr.IsNotFound(err) {
+			return code, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "", ierr.NewError("failed to generate referral code").
+		WithHint("Please try again").
+		Mark(ierr.ErrInternal)
+}
+
+func (s *referralService) ValidateReferralCode(ctx context.Context, code string) (*domainUser.User, error) {
+	referrer, err := s.UserRepo.GetByReferralCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, ierr.NewError("invalid referral code").
+				WithHint("The referral code is not valid").
+				WithReportableDetails(map[string]any{
+					"referral_code": code,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		return nil, err
+	}
+
+	return referrer, nil
+}
+
+func (s *referralService) ApplyReferral(ctx context.Context, referee *domainUser.User, code string) (*dto.ReferralResponse, error) {
+	referrer, err := s.ValidateReferralCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	email := normalizeEmail(referee.Email)
+	phone := normalizePhone(referee.Phone)
+
+	referral := &domainReferral.Referral{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_REFERRAL),
+		ReferrerID:     referrer.ID,
+		RefereeID:      referee.ID,
+		ReferralCode:   lo.FromPtr(referrer.ReferralCode),
+		ReferralStatus: types.ReferralStatusPending,
+		RefereeEmail:   email,
+		RefereePhone:   phone,
+		Metadata:       types.Metadata{},
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+
+	rejectionReason, err := s.detectAbuse(ctx, referrer, referee, email, phone)
+	if err != nil {
+		return nil, err
+	}
+
+	var refereeDiscount *domainDiscount.Discount
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if rejectionReason != "" {
+			referral.ReferralStatus = types.ReferralStatusRejected
+			referral.RejectionReason = lo.ToPtr(rejectionReason)
+			return s.ReferralRepo.Create(ctx, referral)
+		}
+
+		cfg := s.Config.Referral
+		refereeDiscount, err = s.issuePersonalDiscount(ctx, referee.ID, referralDiscountCodePrefix,
+			cfg.RefereeDiscountType, decimal.NewFromFloat(cfg.RefereeDiscountValue), cfg.RefereeDiscountValidity,
+			"Referral signup discount", referral.ID)
+		if err != nil {
+			return err
+		}
+
+		referral.RefereeDiscountID = lo.ToPtr(refereeDiscount.ID)
+		return s.ReferralRepo.Create(ctx, referral)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rejectionReason != "" {
+		s.Logger.Warnw("referral rejected",
+			"referral_id", referral.ID,
+			"referrer_id", referrer.ID,
+			"referee_id", referee.ID,
+			"reason", rejectionReason)
+	}
+
+	return &dto.ReferralResponse{
+		Referral:        *referral,
+		RefereeDiscount: refereeDiscount,
+	}, nil
+}
+
+// detectAbuse returns the rejection reason for self referrals and referrals from duplicate accounts
+func (s *referralService) detectAbuse(ctx context.Context, referrer *domainUser.User, referee *domainUser.User, email string, phone string) (string, error) {
+	if referrer.ID == referee.ID ||
+		normalizeEmail(referrer.Email) == email ||
+		(phone != "" && normalizePhone(referrer.Phone) == phone) {
+		return types.ReferralRejectionReasonSelfReferral, nil
+	}
+
+	// The same person signing up again with an email alias or the same phone
+	exists, err := s.ReferralRepo.ExistsForIdentity(ctx, email, phone)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return types.ReferralRejectionReasonDuplicateAccount, nil
+	}
+
+	if referee.Phone != "" {
+		filter := types.NewNoLimitUserFilter()
+		filter.Phone = []string{referee.Phone}
+
+		users, err := s.UserRepo.ListAll(ctx, filter)
+		if err != nil {
+			return "", err
+		}
+
+		if lo.SomeBy(users, func(u *domainUser.User) bool { return u.ID != referee.ID }) {
+			return types.ReferralRejectionReasonDuplicateAccount, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (s *referralService) GetSummary(ctx context.Context) (*dto.ReferralSummaryResponse, error) {
+	userID := types.GetUserID(ctx)
+	if userID == "" {
+		return nil, ierr.NewError("user not authenticated").
+			WithHint("User not authenticated").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	user, err := s.UserRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users created before the referral program get their code on first access
+	if user.ReferralCode == nil {
+		code, err := s.GenerateReferralCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		user.ReferralCode = lo.ToPtr(code)
+		if err := s.UserRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := types.NewNoLimitReferralFilter()
+	filter.ReferrerID = user.ID
+
+	referrals, err := s.ReferralRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := lo.CountValuesBy(referrals, func(r *domainReferral.Referral) types.ReferralStatus {
+		return r.ReferralStatus
+	})
+
+	return &dto.ReferralSummaryResponse{
+		ReferralCode: lo.FromPtr(user.ReferralCode),
+		Total:        len(referrals),
+		Pending:      counts[types.ReferralStatusPending],
+		Qualified:    counts[types.ReferralStatusQualified],
+		Rewarded:     counts[types.ReferralStatusRewarded],
+		Rejected:     counts[types.ReferralStatusRejected],
+		Cancelled:    counts[types.ReferralStatusCancelled],
+	}, nil
+}
+
+func (s *referralService) List(ctx context.Context, filter *types.ReferralFilter) (*dto.ListReferralResponse, error) {
+	if filter == nil {
+		filter = types.NewReferralFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// non-admins can only see the referrals they made
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.ReferrerID = types.GetUserID(ctx)
+	}
+
+	referrals, err := s.ReferralRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, referrals, s.ReferralRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListReferralResponse{
+		Items: lo.Map(referrals, func(r *domainReferral.Referral, _ int) *dto.ReferralResponse {
+			return &dto.ReferralResponse{Referral: *r}
+		}),
+		Pagination: pagination,
+	}
+
+	return response, nil
+}
+
+// HandlePaymentSucceeded qualifies a pending referral on the referee's first successful payment
+func (s *referralService) HandlePaymentSucceeded(ctx context.Context, payment *domainPayment.Payment) error {
+	if !s.Config.Referral.Enabled || payment.CreatedBy == "" {
+		return nil
+	}
+
+	referral, err := s.ReferralRepo.GetByRefereeID(ctx, payment.CreatedBy)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if referral.ReferralStatus != types.ReferralStatusPending {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	referral.ReferralStatus = types.ReferralStatusQualified
+	referral.PaymentID = lo.ToPtr(payment.ID)
+	referral.QualifiedAt = lo.ToPtr(now)
+	referral.RewardEligibleAt = lo.ToPtr(now.Add(s.Config.Referral.RefundWindow))
+
+	return s.ReferralRepo.Update(ctx, referral)
+}
+
+// HandlePaymentRefunded cancels a not yet rewarded referral whose qualifying payment was refunded
+func (s *referralService) HandlePaymentRefunded(ctx context.Context, payment *domainPayment.Payment) error {
+	filter := types.NewNoLimitReferralFilter()
+	filter.PaymentID = payment.ID
+	filter.ReferralStatus = types.ReferralStatusQualified
+
+	referrals, err := s.ReferralRepo.ListAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for _, referral := range referrals {
+		referral.ReferralStatus = types.ReferralStatusCancelled
+		if err := s.ReferralRepo.Update(ctx, referral); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *referralService) ProcessRewards(ctx context.Context) (int, error) {
+	if !s.Config.Referral.Enabled {
+		return 0, nil
+	}
+
+	referrals, err := s.ReferralRepo.ListRewardable(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	rewarded := 0
+	for _, referral := range referrals {
+		if err := s.rewardReferral(ctx, referral); err != nil {
+			s.Logger.Errorw("failed to reward referral",
+				"referral_id", referral.ID,
+				"error", err)
+			continue
+		}
+
+		if referral.ReferralStatus == types.ReferralStatusRewarded {
+			rewarded++
+		}
+	}
+
+	return rewarded, nil
+}
+
+func (s *referralService) rewardReferral(ctx context.Context, referral *domainReferral.Referral) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		// Guard against refunds that were not reported through the payment hooks
+		payment, err := s.PaymentRepo.Get(ctx, lo.FromPtr(referral.PaymentID))
+		if err != nil {
+			return err
+		}
+
+		if payment.PaymentStatus != types.PaymentStatusSuccess {
+			referral.ReferralStatus = types.ReferralStatusCancelled
+			return s.ReferralRepo.Update(ctx, referral)
+		}
+
+		cfg := s.Config.Referral
+		switch cfg.RewardType {
+		case types.ReferralRewardTypeCoupon, "":
+			reward, err := s.issuePersonalDiscount(ctx, referral.ReferrerID, referralRewardCodePrefix,
+				cfg.RewardDiscountType, decimal.NewFromFloat(cfg.RewardValue), cfg.RewardValidity,
+				"Referral reward", referral.ID)
+			if err != nil {
+				return err
+			}
+			referral.RewardDiscountID = lo.ToPtr(reward.ID)
+		case types.ReferralRewardTypeCredit:
+			credit := &dto.WalletCreditRequest{
+				UserID:            referral.ReferrerID,
+				Amount:            decimal.NewFromFloat(cfg.RewardValue),
+				Currency:          payment.Currency.String(),
+				TransactionReason: types.WalletTransactionReasonReferralReward,
+				ReferenceID:       lo.ToPtr(referral.ID),
+				Description:       "Referral reward",
+			}
+			if cfg.RewardValidity > 0 {
+				credit.ExpiresAt = lo.ToPtr(time.Now().UTC().Add(cfg.RewardValidity))
+			}
+
+			if _, err := NewWalletService(s.ServiceParams).Credit(ctx, credit); err != nil {
+				return err
+			}
+		default:
+			return cfg.RewardType.Validate()
+		}
+
+		referral.ReferralStatus = types.ReferralStatusRewarded
+		referral.RewardedAt = lo.ToPtr(time.Now().UTC())
+		return s.ReferralRepo.Update(ctx, referral)
+	})
+}
+
+// issuePersonalDiscount creates a single-use discount only the given user can redeem
+func (s *referralService) issuePersonalDiscount(
+	ctx context.Context,
+	userID string,
+	prefix string,
+	discountType types.DiscountType,
+	value decimal.Decimal,
+	validity time.Duration,
+	description string,
+	referralID string,
+) (*domainDiscount.Discount, error) {
+	if discountType == "" {
+		discountType = types.DiscountTypePercentage
+	}
+
+	if err := discountType.Validate(); err != nil {
+		return nil, err
+	}
+
+	var code string
+	for attempt := 0; attempt < referralCodeMaxAttempts && code == ""; attempt++ {
+		candidate, err := generateRandomCode(prefix, types.DiscountCodeCharsetDefault, types.DiscountCodeLengthDefault)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.DiscountRepo.GetByCode(ctx, candidate)
+		if ierr.IsNotFound(err) {
+			code = candidate
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	if code == "" {
+		return nil, ierr.NewError("failed to generate discount code").
+			WithHint("Please try again").
+			Mark(ierr.ErrInternal)
+	}
+
+	now := time.Now().UTC()
+	discount := &domainDiscount.Discount{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_DISCOUNT),
+		Code:          code,
+		Description:   description,
+		DiscountType:  discountType,
+		DiscountValue: value,
+		ValidFrom:     now,
+		IsActive:      true,
+		MaxUses:       lo.ToPtr(1),
+		Metadata: types.Metadata{
+			types.DiscountMetadataKeyUserID:     userID,
+			types.DiscountMetadataKeyReferralID: referralID,
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+
+	if validity > 0 {
+		discount.ValidUntil = lo.ToPtr(now.Add(validity))
+	}
+
+	if err := s.DiscountRepo.Create(ctx, discount); err != nil {
+		return nil, err
+	}
+
+	return discount, nil
+}
+
+// normalizeEmail folds email aliases (case, +tags, gmail dots) onto a single address
+func normalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		return email
+	}
+
+	local, _, _ = strings.Cut(local, "+")
+	if domain == "gmail.com" || domain == "googlemail.com" {
+		local = strings.ReplaceAll(local, ".", "")
+		domain = "gmail.com"
+	}
+
+	return local + "@" + domain
+}
+
+// normalizePhone keeps only the digits of a phone number
+func normalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
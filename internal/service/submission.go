@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainContent "github.com/omkar273/codegeeky/internal/domain/content"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+type SubmissionService interface {
+	// Submit hands in work of the current student for an assignment
+	Submit(ctx context.Context, assignmentID string, req *dto.CreateSubmissionRequest) (*dto.SubmissionResponse, error)
+
+	// List returns the submissions for an assignment, students only see their own
+	List(ctx context.Context, assignmentID string, filter *types.SubmissionFilter) (*dto.ListSubmissionResponse, error)
+
+	Get(ctx context.Context, id string) (*dto.SubmissionResponse, error)
+
+	// Grade scores a submission and notifies the student, instructors can grade again to correct a grade
+	Grade(ctx context.Context, id string, req *dto.GradeSubmissionRequest) (*dto.SubmissionResponse, error)
+}
+
+type submissionService struct {
+	ServiceParams
+}
+
+func NewSubmissionService(params ServiceParams) SubmissionService {
+	return &submissionService{
+		ServiceParams: params,
+	}
+}
+
+func (s *submissionService) Submit(ctx context.Context, assignmentID string, req *dto.CreateSubmissionRequest) (*dto.SubmissionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.AssignmentRepo.Get(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := getActiveEnrollment(ctx, s.ServiceParams, assignment.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.InternshipBatchRepo.Get(ctx, enrollment.InternshipBatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SubmissionType == types.SubmissionTypeFile {
+		if err := (&contentService{ServiceParams: s.ServiceParams}).validateFile(ctx, lo.FromPtr(req.FileID)); err != nil {
+			return nil, err
+		}
+	}
+
+	submission := req.ToSubmission(ctx, assignment, enrollment.ID)
+	submission.DueAt = assignment.DueAt(batch.StartDate)
+	submission.LateDays = lateDays(submission.DueAt, submission.SubmittedAt)
+
+	if submission.LateDays > 0 && assignment.LatePolicy == types.LatePolicyReject {
+		return nil, ierr.NewError("assignment is past its due date").
+			WithHint("This assignment no longer accepts submissions after its due date").
+			WithReportableDetails(map[string]any{
+				"assignment_id": assignment.ID,
+				"due_at":        submission.DueAt,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		filter := types.NewNoLimitSubmissionFilter()
+		filter.AssignmentIDs = []string{assignment.ID}
+		filter.UserIDs = []string{submission.UserID}
+
+		attempts, err := s.SubmissionRepo.Count(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		if assignment.MaxSubmissions != nil && attempts >= lo.FromPtr(assignment.MaxSubmissions) {
+			return ierr.NewError("submission limit reached").
+				WithHintf("This assignment can be submitted at most %d times", lo.FromPtr(assignment.MaxSubmissions)).
+				WithReportableDetails(map[string]any{
+					"assignment_id":   assignment.ID,
+					"max_submissions": lo.FromPtr(assignment.MaxSubmissions),
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		submission.Attempt = attempts + 1
+		return s.SubmissionRepo.Create(ctx, submission)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SubmissionResponse{Submission: *submission}, nil
+}
+
+func (s *submissionService) List(ctx context.Context, assignmentID string, filter *types.SubmissionFilter) (*dto.ListSubmissionResponse, error) {
+	if filter == nil {
+		filter = types.NewSubmissionFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.AssignmentRepo.Get(ctx, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, assignment.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.AssignmentIDs = []string{assignment.ID}
+	if !s.canGrade(ctx, internship) {
+		filter.UserIDs = []string{types.GetUserID(ctx)}
+	}
+
+	submissions, err := s.SubmissionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, submissions, s.SubmissionRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListSubmissionResponse{
+		Items: lo.Map(submissions, func(submission *domainContent.Submission, _ int) *dto.SubmissionResponse {
+			return &dto.SubmissionResponse{Submission: *submission}
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *submissionService) Get(ctx context.Context, id string) (*dto.SubmissionResponse, error) {
+	submission, err := s.SubmissionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if submission.UserID != types.GetUserID(ctx) {
+		if _, err := s.getGradableInternship(ctx, submission); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.SubmissionResponse{Submission: *submission}, nil
+}
+
+func (s *submissionService) Grade(ctx context.Context, id string, req *dto.GradeSubmissionRequest) (*dto.SubmissionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	submission, err := s.SubmissionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getGradableInternship(ctx, submission); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.AssignmentRepo.Get(ctx, submission.AssignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	score, err := rawScore(assignment, req)
+	if err != nil {
+		return nil, err
+	}
+
+	submission.Penalty = roundScore(assignment.LatePenalty(score, submission.LateDays))
+	submission.Score = lo.ToPtr(roundScore(score - submission.Penalty))
+	submission.RubricScores = req.RubricScores
+	submission.Feedback = req.Feedback
+	submission.SubmissionStatus = types.SubmissionStatusGraded
+	submission.GradedBy = lo.ToPtr(types.GetUserID(ctx))
+	submission.GradedAt = lo.ToPtr(time.Now().UTC())
+
+	if err := s.SubmissionRepo.Update(ctx, submission); err != nil {
+		return nil, err
+	}
+
+	s.publishSubmissionGraded(ctx, assignment, submission)
+
+	return &dto.SubmissionResponse{Submission: *submission}, nil
+}
+
+// getActiveEnrollment returns the active enrollment of the current student coursework is done under
+func getActiveEnrollment(ctx context.Context, params ServiceParams, internshipID string) (*internshipenrollment.InternshipEnrollment, error) {
+	filter := types.NewNoLimitInternshipEnrollmentFilter()
+	filter.UserID = types.GetUserID(ctx)
+	filter.InternshipIDs = []string{internshipID}
+
+	enrollments, err := params.InternshipEnrollmentRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, ok := lo.Find(enrollments, (*internshipenrollment.InternshipEnrollment).IsActive)
+	if !ok {
+		return nil, ierr.NewError("not enrolled in the internship").
+			WithHint("Enroll in the internship to take part in its coursework").
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return enrollment, nil
+}
+
+// getGradableInternship returns the internship of the submission when the caller may grade it
+func (s *submissionService) getGradableInternship(ctx context.Context, submission *domainContent.Submission) (*domainInternship.Internship, error) {
+	internship, err := s.InternshipRepo.Get(ctx, submission.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.canGrade(ctx, internship) {
+		return nil, ierr.NewError("not an instructor of the internship").
+			WithHint("Only instructors of the internship and admins can grade submissions").
+			WithReportableDetails(map[string]any{
+				"submission_id": submission.ID,
+				"internship_id": internship.ID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	return internship, nil
+}
+
+// canGrade reports whether the caller grades the work of the internship, every instructor does
+func (s *submissionService) canGrade(ctx context.Context, internship *domainInternship.Internship) bool {
+	return (&internshipService{ServiceParams: s.ServiceParams}).canReview(ctx, internship)
+}
+
+func (s *submissionService) publishSubmissionGraded(ctx context.Context, assignment *domainContent.Assignment, submission *domainContent.Submission) {
+	var passed *bool
+	if assignment.PassingScore != nil {
+		passed = lo.ToPtr(lo.FromPtr(submission.Score) >= float64(lo.FromPtr(assignment.PassingScore)))
+	}
+
+	payload, err := json.Marshal(&webhookDto.SubmissionGradedWebhookPayload{
+		SubmissionID: submission.ID,
+		AssignmentID: assignment.ID,
+		InternshipID: submission.InternshipID,
+		Attempt:      submission.Attempt,
+		Score:        lo.FromPtr(submission.Score),
+		MaxScore:     assignment.MaxScore,
+		Penalty:      submission.Penalty,
+		GradedAt:     lo.FromPtr(submission.GradedAt),
+		Passed:       passed,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to build submission graded event",
+			"submission_id", submission.ID,
+			"error", err)
+		return
+	}
+
+	err = s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: types.WebhookEventSubmissionGraded,
+		UserID:    lo.ToPtr(submission.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to publish submission graded event",
+			"submission_id", submission.ID,
+			"error", err)
+	}
+}
+
+// rawScore is the score the grader gave before any late penalty, checked against the
+// rubric of the assignment or its max score
+func rawScore(assignment *domainContent.Assignment, req *dto.GradeSubmissionRequest) (float64, error) {
+	if len(assignment.Rubric) == 0 {
+		if len(req.RubricScores) > 0 || req.Score == nil {
+			return 0, ierr.NewError("assignment has no rubric").
+				WithHint("Grade this assignment with a score").
+				Mark(ierr.ErrValidation)
+		}
+
+		score := lo.FromPtr(req.Score)
+		if score > float64(assignment.MaxScore) {
+			return 0, ierr.NewError("score is above the max score").
+				WithHintf("Score can be at most %d", assignment.MaxScore).
+				Mark(ierr.ErrValidation)
+		}
+
+		return score, nil
+	}
+
+	scores := lo.SliceToMap(req.RubricScores, func(score types.CriterionScore) (string, float64) {
+		return score.Criterion, score.Points
+	})
+	if len(scores) != len(req.RubricScores) || len(scores) != len(assignment.Rubric) {
+		return 0, ierr.NewError("rubric scores don't match the rubric").
+			WithHint("Score every rubric criterion exactly once").
+			Mark(ierr.ErrValidation)
+	}
+
+	total := 0.0
+	for _, criterion := range assignment.Rubric {
+		points, ok := scores[criterion.Name]
+		if !ok {
+			return 0, ierr.NewError("rubric criterion not scored").
+				WithHintf("Rubric criterion %s is missing a score", criterion.Name).
+				WithReportableDetails(map[string]any{"criterion": criterion.Name}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if points > float64(criterion.MaxPoints) {
+			return 0, ierr.NewError("rubric score is above the criterion points").
+				WithHintf("Rubric criterion %s can score at most %d", criterion.Name, criterion.MaxPoints).
+				WithReportableDetails(map[string]any{"criterion": criterion.Name}).
+				Mark(ierr.ErrValidation)
+		}
+
+		total += points
+	}
+
+	return total, nil
+}
+
+// lateDays counts the started days between the due date and the submission
+func lateDays(dueAt *time.Time, submittedAt time.Time) int {
+	if dueAt == nil || !submittedAt.After(lo.FromPtr(dueAt)) {
+		return 0
+	}
+	return int(math.Ceil(submittedAt.Sub(lo.FromPtr(dueAt)).Hours() / 24))
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
+
+// passesAssignments reports whether the student of an enrollment passed every assignment of the
+// internship that has a passing score, their best graded attempt counts
+func passesAssignments(ctx context.Context, params ServiceParams, enrollment *internshipenrollment.InternshipEnrollment) (bool, error) {
+	assignmentFilter := types.NewNoLimitAssignmentFilter()
+	assignmentFilter.InternshipIDs = []string{enrollment.InternshipID}
+	assignments, err := params.AssignmentRepo.ListAll(ctx, assignmentFilter)
+	if err != nil {
+		return false, err
+	}
+
+	graded := lo.Filter(assignments, func(assignment *domainContent.Assignment, _ int) bool {
+		return assignment.PassingScore != nil
+	})
+	if len(graded) == 0 {
+		return true, nil
+	}
+
+	submissionFilter := types.NewNoLimitSubmissionFilter()
+	submissionFilter.EnrollmentIDs = []string{enrollment.ID}
+	submissionFilter.SubmissionStatus = types.SubmissionStatusGraded
+	submissions, err := params.SubmissionRepo.ListAll(ctx, submissionFilter)
+	if err != nil {
+		return false, err
+	}
+
+	best := make(map[string]float64, len(submissions))
+	for _, submission := range submissions {
+		best[submission.AssignmentID] = math.Max(best[submission.AssignmentID], lo.FromPtr(submission.Score))
+	}
+
+	return lo.EveryBy(graded, func(assignment *domainContent.Assignment) bool {
+		score, ok := best[assignment.ID]
+		return ok && score >= float64(lo.FromPtr(assignment.PassingScore))
+	}), nil
+}
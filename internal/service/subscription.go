@@ -0,0 +1,729 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainPayment "github.com/omkar273/codegeeky/internal/domain/payment"
+	domainSubscription "github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/idempotency"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+// SubscriptionService manages subscription plans and the recurring subscriptions to them
+type SubscriptionService interface {
+	CreatePlan(ctx context.Context, req *dto.CreateSubscriptionPlanRequest) (*dto.SubscriptionPlanResponse, error)
+	GetPlan(ctx context.Context, id string) (*dto.SubscriptionPlanResponse, error)
+	UpdatePlan(ctx context.Context, id string, req *dto.UpdateSubscriptionPlanRequest) (*dto.SubscriptionPlanResponse, error)
+	DeletePlan(ctx context.Context, id string) error
+	ListPlans(ctx context.Context, filter *types.SubscriptionPlanFilter) (*dto.ListSubscriptionPlanResponse, error)
+
+	// Subscribe starts a subscription for the current user, it activates once
+	// the user authorizes recurring charges at the returned checkout URL
+	Subscribe(ctx context.Context, req *dto.CreateSubscriptionRequest) (*dto.SubscriptionResponse, error)
+	GetSubscription(ctx context.Context, id string) (*dto.SubscriptionResponse, error)
+	ListSubscriptions(ctx context.Context, filter *types.SubscriptionFilter) (*dto.ListSubscriptionResponse, error)
+	Cancel(ctx context.Context, id string, req *dto.CancelSubscriptionRequest) (*dto.SubscriptionResponse, error)
+
+	// ProcessGatewayWebhook applies a subscription webhook from the payment gateway
+	ProcessGatewayWebhook(ctx context.Context, provider types.PaymentGatewayProvider, payload []byte, headers map[string]string) error
+
+	// GetEntitledCategoryIDs returns the categories the user's active subscriptions grant access to,
+	// including every descendant of a subscribed category. It is the source of the
+	// subscribed_categories attribute of the enrollment access policy.
+	GetEntitledCategoryIDs(ctx context.Context, userID string) ([]string, error)
+
+	// HasInternshipAccess reports whether an active subscription covers one of the internship's
+	// categories, directly or through one of their ancestors
+	HasInternshipAccess(ctx context.Context, userID string, internshipID string) (bool, error)
+}
+
+type subscriptionService struct {
+	ServiceParams
+}
+
+func NewSubscriptionService(params ServiceParams) SubscriptionService {
+	return &subscriptionService{
+		ServiceParams: params,
+	}
+}
+
+func (s *subscriptionService) CreatePlan(ctx context.Context, req *dto.CreateSubscriptionPlanRequest) (*dto.SubscriptionPlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := req.ToPlan(ctx)
+	if err := s.validateCategories(ctx, plan.CategoryIDs); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.GatewayRegistry.GetSubscriptionProvider(ctx, plan.GatewayProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayPlan, err := provider.CreatePlan(ctx, &dto.GatewayPlanRequest{
+		Name:          plan.Name,
+		Description:   plan.Description,
+		BillingPeriod: plan.BillingPeriod,
+		Amount:        plan.Amount,
+		Currency:      plan.Currency,
+		Notes: map[string]string{
+			"plan_id": plan.ID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	plan.GatewayPlanID = lo.ToPtr(gatewayPlan.GatewayPlanID)
+
+	if err := s.SubscriptionPlanRepo.Create(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return &dto.SubscriptionPlanResponse{Plan: *plan}, nil
+}
+
+func (s *subscriptionService) GetPlan(ctx context.Context, id string) (*dto.SubscriptionPlanResponse, error) {
+	plan, err := s.SubscriptionPlanRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SubscriptionPlanResponse{Plan: *plan}, nil
+}
+
+func (s *subscriptionService) UpdatePlan(ctx context.Context, id string, req *dto.UpdateSubscriptionPlanRequest) (*dto.SubscriptionPlanResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.SubscriptionPlanRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		plan.Name = *req.Name
+	}
+
+	if req.Description != nil {
+		plan.Description = *req.Description
+	}
+
+	if len(req.CategoryIDs) > 0 {
+		categoryIDs := lo.Uniq(req.CategoryIDs)
+		if err := s.validateCategories(ctx, categoryIDs); err != nil {
+			return nil, err
+		}
+		plan.CategoryIDs = categoryIDs
+	}
+
+	if req.IsActive != nil {
+		plan.IsActive = *req.IsActive
+	}
+
+	if req.Metadata != nil {
+		plan.Metadata = *req.Metadata
+	}
+
+	if err := s.SubscriptionPlanRepo.Update(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return &dto.SubscriptionPlanResponse{Plan: *plan}, nil
+}
+
+func (s *subscriptionService) DeletePlan(ctx context.Context, id string) error {
+	if _, err := s.SubscriptionPlanRepo.Get(ctx, id); err != nil {
+		return err
+	}
+
+	// subscribers keep their access until their subscriptions end
+	filter := types.NewSubscriptionFilter()
+	filter.PlanID = id
+	filter.SubscriptionStatuses = []types.SubscriptionStatus{
+		types.SubscriptionStatusCreated,
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusPastDue,
+	}
+
+	count, err := s.SubscriptionRepo.Count(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return ierr.NewError("subscription plan has active subscriptions").
+			WithHint("Deactivate the plan instead, it has subscriptions that are still renewing").
+			WithReportableDetails(map[string]any{
+				"plan_id":       id,
+				"subscriptions": count,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return s.SubscriptionPlanRepo.Delete(ctx, id)
+}
+
+func (s *subscriptionService) ListPlans(ctx context.Context, filter *types.SubscriptionPlanFilter) (*dto.ListSubscriptionPlanResponse, error) {
+	if filter == nil {
+		filter = types.NewSubscriptionPlanFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// only admins can see plans that are no longer offered
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.IsActive = lo.ToPtr(true)
+	}
+
+	plans, err := s.SubscriptionPlanRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, plans, s.SubscriptionPlanRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListSubscriptionPlanResponse{
+		Items: lo.Map(plans, func(plan *domainSubscription.Plan, _ int) *dto.SubscriptionPlanResponse {
+			return &dto.SubscriptionPlanResponse{Plan: *plan}
+		}),
+		Pagination: pagination,
+	}
+
+	return response, nil
+}
+
+func (s *subscriptionService) Subscribe(ctx context.Context, req *dto.CreateSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.SubscriptionPlanRepo.Get(ctx, req.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !plan.IsActive || plan.GatewayPlanID == nil {
+		return nil, ierr.NewError("subscription plan is not available").
+			WithHint("This plan is no longer offered").
+			WithReportableDetails(map[string]any{
+				"plan_id": plan.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	userID := types.GetUserID(ctx)
+
+	// a user can hold one live subscription per plan
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.UserID = userID
+	filter.PlanID = plan.ID
+	filter.SubscriptionStatuses = []types.SubscriptionStatus{
+		types.SubscriptionStatusCreated,
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusPastDue,
+	}
+
+	existing, err := s.SubscriptionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existing) > 0 {
+		// hand back the pending checkout instead of starting another one
+		if existing[0].SubscriptionStatus == types.SubscriptionStatusCreated {
+			return &dto.SubscriptionResponse{Subscription: *existing[0], Plan: plan}, nil
+		}
+
+		return nil, ierr.NewError("already subscribed").
+			WithHint("You already have an active subscription to this plan").
+			WithReportableDetails(map[string]any{
+				"plan_id":         plan.ID,
+				"subscription_id": existing[0].ID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	provider, err := s.GatewayRegistry.GetSubscriptionProvider(ctx, plan.GatewayProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &domainSubscription.Subscription{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION),
+		UserID:             userID,
+		PlanID:             plan.ID,
+		SubscriptionStatus: types.SubscriptionStatusCreated,
+		GatewayProvider:    plan.GatewayProvider,
+		Metadata:           req.Metadata,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+
+	totalCount := s.Config.Subscription.MaxDurationYears
+	if plan.BillingPeriod == types.BillingPeriodMonthly {
+		totalCount *= 12
+	}
+
+	gatewaySub, err := provider.CreateSubscription(ctx, &dto.GatewaySubscriptionRequest{
+		GatewayPlanID: *plan.GatewayPlanID,
+		TotalCount:    totalCount,
+		Notes: map[string]string{
+			"subscription_id": sub.ID,
+			"user_id":         userID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sub.GatewaySubscriptionID = lo.ToPtr(gatewaySub.GatewaySubscriptionID)
+	sub.CheckoutURL = lo.ToPtr(gatewaySub.CheckoutURL)
+
+	if err := s.SubscriptionRepo.Create(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return &dto.SubscriptionResponse{Subscription: *sub, Plan: plan}, nil
+}
+
+func (s *subscriptionService) GetSubscription(ctx context.Context, id string) (*dto.SubscriptionResponse, error) {
+	sub, err := s.getOwnSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.SubscriptionPlanRepo.Get(ctx, sub.PlanID)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	return &dto.SubscriptionResponse{Subscription: *sub, Plan: plan}, nil
+}
+
+func (s *subscriptionService) ListSubscriptions(ctx context.Context, filter *types.SubscriptionFilter) (*dto.ListSubscriptionResponse, error) {
+	if filter == nil {
+		filter = types.NewSubscriptionFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// students only see their own subscriptions
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.UserID = types.GetUserID(ctx)
+	}
+
+	subs, err := s.SubscriptionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, subs, s.SubscriptionRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListSubscriptionResponse{
+		Items: lo.Map(subs, func(sub *domainSubscription.Subscription, _ int) *dto.SubscriptionResponse {
+			return &dto.SubscriptionResponse{Subscription: *sub}
+		}),
+		Pagination: pagination,
+	}
+
+	return response, nil
+}
+
+func (s *subscriptionService) Cancel(ctx context.Context, id string, req *dto.CancelSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.getOwnSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus.IsTerminal() {
+		return nil, ierr.NewError("subscription already ended").
+			WithHint("This subscription is no longer renewing").
+			WithReportableDetails(map[string]any{
+				"subscription_id":     sub.ID,
+				"subscription_status": sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	// a subscription that was never paid for has no period to run out
+	atPeriodEnd := lo.FromPtrOr(req.AtPeriodEnd, true) && sub.SubscriptionStatus != types.SubscriptionStatusCreated
+
+	provider, err := s.GatewayRegistry.GetSubscriptionProvider(ctx, sub.GatewayProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.CancelSubscription(ctx, lo.FromPtr(sub.GatewaySubscriptionID), atPeriodEnd); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	sub.CancelledAt = lo.ToPtr(now)
+	if atPeriodEnd {
+		// the gateway confirms the cancellation with a webhook once the period ends
+		sub.CancelAtPeriodEnd = true
+	} else {
+		sub.SubscriptionStatus = types.SubscriptionStatusCancelled
+		sub.EndedAt = lo.ToPtr(now)
+	}
+
+	if err := s.SubscriptionRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return &dto.SubscriptionResponse{Subscription: *sub}, nil
+}
+
+func (s *subscriptionService) ProcessGatewayWebhook(ctx context.Context, providerName types.PaymentGatewayProvider, payload []byte, headers map[string]string) error {
+	provider, err := s.GatewayRegistry.GetSubscriptionProvider(ctx, providerName)
+	if err != nil {
+		return err
+	}
+
+	event, err := provider.ParseSubscriptionEvent(ctx, payload, headers)
+	if err != nil {
+		return err
+	}
+
+	// acknowledge events that don't concern subscriptions
+	if event == nil {
+		return nil
+	}
+
+	var (
+		sub       *domainSubscription.Subscription
+		eventName string
+	)
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		sub, err = s.SubscriptionRepo.GetByGatewaySubscriptionID(ctx, providerName, event.GatewaySubscriptionID)
+		if err != nil {
+			return err
+		}
+
+		eventName, err = s.applyGatewayEvent(ctx, sub, event)
+		return err
+	})
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			// subscriptions created outside the platform are none of our business
+			s.Logger.Warnw("received webhook for unknown subscription",
+				"gateway_provider", providerName,
+				"gateway_subscription_id", event.GatewaySubscriptionID,
+				"event_id", event.EventID)
+			return nil
+		}
+		return err
+	}
+
+	if eventName != "" {
+		if err := s.publishSubscriptionEvent(ctx, eventName, sub); err != nil {
+			s.Logger.Errorw("failed to publish subscription event",
+				"subscription_id", sub.ID,
+				"event_name", eventName,
+				"error", err)
+		}
+	}
+
+	return nil
+}
+
+// applyGatewayEvent moves the subscription to the status reported by the gateway and
+// returns the event to notify the subscriber with, if any
+func (s *subscriptionService) applyGatewayEvent(ctx context.Context, sub *domainSubscription.Subscription, event *types.GatewaySubscriptionEvent) (string, error) {
+	// redeliveries would count the same failed charge again
+	recorded, err := s.recordGatewayEvent(ctx, sub, event)
+	if err != nil {
+		return "", err
+	}
+	if !recorded {
+		s.Logger.Infow("ignoring redelivered subscription webhook",
+			"subscription_id", sub.ID,
+			"event_id", event.EventID)
+		return "", nil
+	}
+
+	previous := sub.SubscriptionStatus
+
+	// ignore late deliveries for subscriptions that already ended
+	if previous.IsTerminal() && event.Status != previous {
+		s.Logger.Infow("ignoring webhook for ended subscription",
+			"subscription_id", sub.ID,
+			"subscription_status", previous,
+			"event_status", event.Status,
+			"event_id", event.EventID)
+		return "", nil
+	}
+
+	now := time.Now().UTC()
+	if event.CurrentPeriodStart != nil {
+		sub.CurrentPeriodStart = event.CurrentPeriodStart
+	}
+	if event.CurrentPeriodEnd != nil {
+		sub.CurrentPeriodEnd = event.CurrentPeriodEnd
+	}
+	sub.SubscriptionStatus = event.Status
+
+	var eventName string
+	switch event.Status {
+	case types.SubscriptionStatusActive:
+		sub.FailedPaymentCount = 0
+
+		renewed := false
+		if event.GatewayPaymentID != nil {
+			renewed, err = s.recordRenewalPayment(ctx, sub, event)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		switch {
+		case previous == types.SubscriptionStatusCreated:
+			eventName = types.WebhookEventSubscriptionActivated
+		case renewed:
+			eventName = types.WebhookEventSubscriptionRenewed
+		}
+
+	case types.SubscriptionStatusPastDue:
+		// dunning, the gateway keeps retrying the charge
+		sub.FailedPaymentCount++
+		eventName = types.WebhookEventSubscriptionPaymentFailed
+
+	case types.SubscriptionStatusHalted:
+		sub.EndedAt = lo.ToPtr(now)
+		if previous != types.SubscriptionStatusHalted {
+			eventName = types.WebhookEventSubscriptionHalted
+		}
+
+	case types.SubscriptionStatusCancelled:
+		if sub.CancelledAt == nil {
+			sub.CancelledAt = lo.ToPtr(now)
+		}
+		sub.EndedAt = lo.ToPtr(now)
+		if previous != types.SubscriptionStatusCancelled {
+			eventName = types.WebhookEventSubscriptionCancelled
+		}
+
+	case types.SubscriptionStatusCompleted:
+		sub.EndedAt = lo.ToPtr(now)
+	}
+
+	if err := s.SubscriptionRepo.Update(ctx, sub); err != nil {
+		return "", err
+	}
+
+	return eventName, nil
+}
+
+// recordGatewayEvent stores the id of a gateway event applied to the subscription.
+// It reports false when the event was already applied by an earlier delivery.
+func (s *subscriptionService) recordGatewayEvent(ctx context.Context, sub *domainSubscription.Subscription, event *types.GatewaySubscriptionEvent) (bool, error) {
+	// events without an id can't be told apart, they are applied every time
+	if event.EventID == "" {
+		return true, nil
+	}
+
+	if _, err := s.SubscriptionRepo.GetEventByGatewayEventID(ctx, sub.GatewayProvider, event.EventID); err == nil {
+		return false, nil
+	} else if !ierr.IsNotFound(err) {
+		return false, err
+	}
+
+	if err := s.SubscriptionRepo.CreateEvent(ctx, &domainSubscription.Event{
+		ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_EVENT),
+		SubscriptionID:  sub.ID,
+		GatewayProvider: sub.GatewayProvider,
+		GatewayEventID:  event.EventID,
+		EventStatus:     event.Status,
+		BaseModel:       types.GetDefaultBaseModel(ctx),
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// recordRenewalPayment stores the charge collected by the gateway for a billing period.
+// It reports false when the charge was already recorded by an earlier delivery.
+func (s *subscriptionService) recordRenewalPayment(ctx context.Context, sub *domainSubscription.Subscription, event *types.GatewaySubscriptionEvent) (bool, error) {
+	idempotencyKey := idempotency.NewGenerator().GenerateKey(idempotency.ScopePayment, map[string]interface{}{
+		"gateway_provider":   sub.GatewayProvider,
+		"gateway_payment_id": *event.GatewayPaymentID,
+	})
+
+	if _, err := s.PaymentRepo.GetByIdempotencyKey(ctx, idempotencyKey); err == nil {
+		return false, nil
+	} else if !ierr.IsNotFound(err) {
+		return false, err
+	}
+
+	now := time.Now().UTC()
+	payment := &domainPayment.Payment{
+		ID:                     types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PAYMENT),
+		IdempotencyKey:         idempotencyKey,
+		DestinationType:        types.PaymentDestinationTypeSubscription,
+		DestinationID:          sub.ID,
+		PaymentGatewayProvider: sub.GatewayProvider,
+		GatewayPaymentID:       event.GatewayPaymentID,
+		Amount:                 event.Amount,
+		Currency:               event.Currency,
+		PaymentStatus:          types.PaymentStatusSuccess,
+		SucceededAt:            lo.ToPtr(now),
+		Metadata: map[string]string{
+			"subscription_plan_id": sub.PlanID,
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+
+	if err := s.PaymentRepo.Create(ctx, payment); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *subscriptionService) GetEntitledCategoryIDs(ctx context.Context, userID string) ([]string, error) {
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.UserID = userID
+	filter.SubscriptionStatuses = []types.SubscriptionStatus{
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusPastDue,
+	}
+
+	subs, err := s.SubscriptionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs := make([]string, 0)
+	for _, planID := range lo.Uniq(lo.Map(subs, func(sub *domainSubscription.Subscription, _ int) string {
+		return sub.PlanID
+	})) {
+		plan, err := s.SubscriptionPlanRepo.Get(ctx, planID)
+		if err != nil {
+			// a deleted plan grants nothing, the remaining subscriptions still count
+			if ierr.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		categoryIDs = append(categoryIDs, plan.CategoryIDs...)
+	}
+
+	if len(categoryIDs) == 0 {
+		return categoryIDs, nil
+	}
+
+	// a subscription to a category covers its whole subtree
+	categories, err := s.CategoryRepo.ListAll(ctx, types.NewNoLimitCategoryFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	subscribed := lo.Uniq(categoryIDs)
+	for _, category := range categories {
+		if lo.SomeBy(subscribed, category.IsDescendantOf) {
+			categoryIDs = append(categoryIDs, category.ID)
+		}
+	}
+
+	return lo.Uniq(categoryIDs), nil
+}
+
+func (s *subscriptionService) HasInternshipAccess(ctx context.Context, userID string, internshipID string) (bool, error) {
+	internship, err := s.InternshipRepo.Get(ctx, internshipID)
+	if err != nil {
+		return false, err
+	}
+
+	categoryIDs, err := s.GetEntitledCategoryIDs(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, category := range internship.Categories {
+		if lo.Contains(categoryIDs, category.ID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getOwnSubscription returns a subscription of the current user, admins can get any
+func (s *subscriptionService) getOwnSubscription(ctx context.Context, id string) (*domainSubscription.Subscription, error) {
+	sub, err := s.SubscriptionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if types.GetUserRole(ctx) != types.UserRoleAdmin && sub.UserID != types.GetUserID(ctx) {
+		return nil, ierr.NewError("subscription not found").
+			WithHintf("Subscription with ID %s was not found", id).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return sub, nil
+}
+
+func (s *subscriptionService) validateCategories(ctx context.Context, categoryIDs []string) error {
+	for _, categoryID := range categoryIDs {
+		if _, err := s.CategoryRepo.Get(ctx, categoryID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *subscriptionService) publishSubscriptionEvent(ctx context.Context, eventName string, sub *domainSubscription.Subscription) error {
+	plan, err := s.SubscriptionPlanRepo.Get(ctx, sub.PlanID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(&webhookDto.SubscriptionWebhookPayload{
+		SubscriptionID:     sub.ID,
+		PlanID:             plan.ID,
+		PlanName:           plan.Name,
+		SubscriptionStatus: sub.SubscriptionStatus,
+		Amount:             plan.Amount,
+		Currency:           plan.Currency.String(),
+		CurrentPeriodEnd:   sub.CurrentPeriodEnd,
+		CancelAtPeriodEnd:  sub.CancelAtPeriodEnd,
+		FailedPaymentCount: sub.FailedPaymentCount,
+	})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build subscription event").
+			Mark(ierr.ErrInternal)
+	}
+
+	return s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+		EventName: eventName,
+		UserID:    lo.ToPtr(sub.UserID),
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/config"
+	domainSubscription "github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service   SubscriptionService
+	gateway   *testutil.MockPaymentGateway
+	publisher *testutil.MockWebhookPublisher
+	plan      *domainSubscription.Plan
+}
+
+func TestSubscriptionService(t *testing.T) {
+	suite.Run(t, new(SubscriptionServiceSuite))
+}
+
+func (s *SubscriptionServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.Subscription = config.SubscriptionConfig{MaxDurationYears: 1}
+
+	s.gateway = testutil.NewMockPaymentGateway()
+	s.publisher = testutil.NewMockWebhookPublisher()
+
+	stores := s.GetStores()
+	s.service = NewSubscriptionService(ServiceParams{
+		Logger:               s.GetLogger(),
+		Config:               cfg,
+		DB:                   s.GetDB(),
+		SubscriptionRepo:     stores.SubscriptionRepo,
+		SubscriptionPlanRepo: stores.SubscriptionPlanRepo,
+		PaymentRepo:          stores.PaymentRepo,
+		WebhookPublisher:     s.publisher,
+		GatewayRegistry:      testutil.NewMockGatewayRegistry(s.gateway),
+	})
+
+	s.plan = &domainSubscription.Plan{
+		ID:              s.GetUUID(),
+		Name:            "All access",
+		BillingPeriod:   types.BillingPeriodMonthly,
+		Amount:          decimal.NewFromInt(499),
+		Currency:        "INR",
+		CategoryIDs:     []string{s.GetUUID()},
+		GatewayProvider: types.PaymentGatewayProviderRazorpay,
+		GatewayPlanID:   lo.ToPtr("plan_monthly"),
+		IsActive:        true,
+		Metadata:        types.Metadata{},
+		BaseModel:       types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(stores.SubscriptionPlanRepo.Create(s.GetContext(), s.plan))
+}
+
+func (s *SubscriptionServiceSuite) subscribe() *domainSubscription.Subscription {
+	response, err := s.service.Subscribe(s.GetContext(), &dto.CreateSubscriptionRequest{PlanID: s.plan.ID})
+	s.Require().NoError(err)
+	return &response.Subscription
+}
+
+// deliver hands the event to the service the way the gateway webhook would
+func (s *SubscriptionServiceSuite) deliver(sub *domainSubscription.Subscription, eventID string, status types.SubscriptionStatus) {
+	s.deliverEvent(&types.GatewaySubscriptionEvent{
+		EventID:               eventID,
+		GatewaySubscriptionID: lo.FromPtr(sub.GatewaySubscriptionID),
+		Status:                status,
+	})
+}
+
+func (s *SubscriptionServiceSuite) deliverEvent(event *types.GatewaySubscriptionEvent) {
+	s.gateway.SubscriptionEvent = event
+	s.Require().NoError(s.service.ProcessGatewayWebhook(s.GetContext(), types.PaymentGatewayProviderRazorpay, []byte("{}"), nil))
+}
+
+func (s *SubscriptionServiceSuite) get(id string) *domainSubscription.Subscription {
+	sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), id)
+	s.Require().NoError(err)
+	return sub
+}
+
+func (s *SubscriptionServiceSuite) published(eventName string) int {
+	return lo.Count(s.publisher.Events(), eventName)
+}
+
+func (s *SubscriptionServiceSuite) TestSubscribeHandsBackPendingCheckout() {
+	sub := s.subscribe()
+	s.Equal(types.SubscriptionStatusCreated, sub.SubscriptionStatus)
+	s.NotEmpty(lo.FromPtr(sub.GatewaySubscriptionID))
+	s.NotEmpty(lo.FromPtr(sub.CheckoutURL))
+
+	s.Equal(sub.ID, s.subscribe().ID)
+
+	s.deliver(sub, "evt_1", types.SubscriptionStatusActive)
+
+	_, err := s.service.Subscribe(s.GetContext(), &dto.CreateSubscriptionRequest{PlanID: s.plan.ID})
+	s.True(ierr.IsAlreadyExists(err))
+}
+
+func (s *SubscriptionServiceSuite) TestActivationAndRenewal() {
+	sub := s.subscribe()
+	periodEnd := s.GetNow().Add(30 * 24 * time.Hour)
+
+	s.deliverEvent(&types.GatewaySubscriptionEvent{
+		EventID:               "evt_1",
+		GatewaySubscriptionID: lo.FromPtr(sub.GatewaySubscriptionID),
+		Status:                types.SubscriptionStatusActive,
+		CurrentPeriodStart:    lo.ToPtr(s.GetNow()),
+		CurrentPeriodEnd:      lo.ToPtr(periodEnd),
+	})
+
+	active := s.get(sub.ID)
+	s.Equal(types.SubscriptionStatusActive, active.SubscriptionStatus)
+	s.True(periodEnd.Equal(lo.FromPtr(active.CurrentPeriodEnd)))
+	s.Equal(1, s.published(types.WebhookEventSubscriptionActivated))
+
+	renewal := &types.GatewaySubscriptionEvent{
+		EventID:               "evt_2",
+		GatewaySubscriptionID: lo.FromPtr(sub.GatewaySubscriptionID),
+		Status:                types.SubscriptionStatusActive,
+		GatewayPaymentID:      lo.ToPtr("pay_renewal"),
+		Amount:                decimal.NewFromInt(499),
+		Currency:              "INR",
+	}
+	s.deliverEvent(renewal)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionRenewed))
+
+	// the same charge reported by another event is recorded once
+	renewal.EventID = "evt_3"
+	s.deliverEvent(renewal)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionRenewed))
+	s.Equal(1, s.published(types.WebhookEventSubscriptionActivated))
+
+	filter := types.NewNoLimitPaymentFilter()
+	filter.DestinationID = lo.ToPtr(sub.ID)
+	payments, err := s.GetStores().PaymentRepo.List(s.GetContext(), filter)
+	s.Require().NoError(err)
+	s.Require().Len(payments, 1)
+	s.Equal(types.PaymentDestinationTypeSubscription, payments[0].DestinationType)
+	s.Equal(types.PaymentStatusSuccess, payments[0].PaymentStatus)
+	s.True(payments[0].Amount.Equal(decimal.NewFromInt(499)))
+}
+
+func (s *SubscriptionServiceSuite) TestRedeliveredFailureIsCountedOnce() {
+	sub := s.subscribe()
+	s.deliver(sub, "evt_1", types.SubscriptionStatusActive)
+
+	s.deliver(sub, "evt_2", types.SubscriptionStatusPastDue)
+	s.deliver(sub, "evt_2", types.SubscriptionStatusPastDue)
+
+	pastDue := s.get(sub.ID)
+	s.Equal(types.SubscriptionStatusPastDue, pastDue.SubscriptionStatus)
+	s.Equal(1, pastDue.FailedPaymentCount)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionPaymentFailed))
+
+	s.deliver(sub, "evt_3", types.SubscriptionStatusPastDue)
+	s.Equal(2, s.get(sub.ID).FailedPaymentCount)
+
+	// a successful retry clears the dunning count
+	s.deliver(sub, "evt_4", types.SubscriptionStatusActive)
+	s.Equal(0, s.get(sub.ID).FailedPaymentCount)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionActivated))
+}
+
+func (s *SubscriptionServiceSuite) TestEventsWithoutIDAreAlwaysApplied() {
+	sub := s.subscribe()
+	s.deliver(sub, "", types.SubscriptionStatusActive)
+
+	s.deliver(sub, "", types.SubscriptionStatusPastDue)
+	s.deliver(sub, "", types.SubscriptionStatusPastDue)
+
+	s.Equal(2, s.get(sub.ID).FailedPaymentCount)
+}
+
+func (s *SubscriptionServiceSuite) TestEndedSubscriptionIgnoresLateEvents() {
+	sub := s.subscribe()
+	s.deliver(sub, "evt_1", types.SubscriptionStatusActive)
+	s.deliver(sub, "evt_2", types.SubscriptionStatusCancelled)
+
+	cancelled := s.get(sub.ID)
+	s.Equal(types.SubscriptionStatusCancelled, cancelled.SubscriptionStatus)
+	s.NotNil(cancelled.CancelledAt)
+	s.NotNil(cancelled.EndedAt)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionCancelled))
+
+	s.deliver(sub, "evt_3", types.SubscriptionStatusActive)
+	s.deliver(sub, "evt_4", types.SubscriptionStatusCancelled)
+
+	s.Equal(types.SubscriptionStatusCancelled, s.get(sub.ID).SubscriptionStatus)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionCancelled))
+	s.Equal(1, s.published(types.WebhookEventSubscriptionActivated))
+}
+
+func (s *SubscriptionServiceSuite) TestHaltedSubscriptionEnds() {
+	sub := s.subscribe()
+	s.deliver(sub, "evt_1", types.SubscriptionStatusActive)
+	s.deliver(sub, "evt_2", types.SubscriptionStatusHalted)
+
+	halted := s.get(sub.ID)
+	s.Equal(types.SubscriptionStatusHalted, halted.SubscriptionStatus)
+	s.NotNil(halted.EndedAt)
+	s.Equal(1, s.published(types.WebhookEventSubscriptionHalted))
+}
+
+func (s *SubscriptionServiceSuite) TestAcknowledgesWebhooksItCanNotApply() {
+	// events that don't concern subscriptions
+	s.deliverEvent(nil)
+
+	// subscriptions created outside the platform
+	s.deliverEvent(&types.GatewaySubscriptionEvent{
+		EventID:               "evt_1",
+		GatewaySubscriptionID: "sub_unknown",
+		Status:                types.SubscriptionStatusActive,
+	})
+
+	s.Empty(s.publisher.Events())
+}
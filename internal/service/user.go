@@ -4,13 +4,21 @@ import (
 	"context"
 
 	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainCertificate "github.com/omkar273/codegeeky/internal/domain/certificate"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
 )
 
 type UserService interface {
 	Me(ctx context.Context) (*dto.MeResponse, error)
 	Update(ctx context.Context, req *dto.UpdateUserRequest) (*dto.MeResponse, error)
+
+	// GetProfile returns the profile of a user with the internships they completed and their
+	// certificates, as far as the visibility of the profile lets the caller see it
+	GetProfile(ctx context.Context, id string) (*dto.ProfileResponse, error)
 }
 
 type userService struct {
@@ -38,13 +46,7 @@ func (s *userService) Me(ctx context.Context) (*dto.MeResponse, error) {
 			WithHint("Failed to get user").
 			Mark(ierr.ErrDatabase)
 	}
-	return &dto.MeResponse{
-		ID:       user.ID,
-		Email:    user.Email,
-		FullName: user.FullName,
-		Role:     string(user.Role),
-		Phone:    user.Phone,
-	}, nil
+	return dto.NewMeResponse(user), nil
 }
 
 // Update updates the current user
@@ -57,6 +59,10 @@ func (s *userService) Update(ctx context.Context, req *dto.UpdateUserRequest) (*
 			Mark(ierr.ErrPermissionDenied)
 	}
 
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	user, err := s.UserRepo.Get(ctx, userID)
 	if err != nil {
 		return nil, ierr.WithError(err).
@@ -64,23 +70,146 @@ func (s *userService) Update(ctx context.Context, req *dto.UpdateUserRequest) (*
 			Mark(ierr.ErrDatabase)
 	}
 
-	if req.FullName != "" {
-		user.FullName = req.FullName
+	if err := s.validateFileChange(ctx, req.ResumeFileID, user.ResumeFileID); err != nil {
+		return nil, err
 	}
-	if req.Phone != "" {
-		user.Phone = req.Phone
+
+	if err := s.validateFileChange(ctx, req.PhotoFileID, user.PhotoFileID); err != nil {
+		return nil, err
 	}
 
+	req.ApplyTo(user)
+
 	err = s.UserRepo.Update(ctx, user)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.MeResponse{
-		ID:       user.ID,
-		Email:    user.Email,
-		FullName: user.FullName,
-		Role:     string(user.Role),
-		Phone:    user.Phone,
+	return dto.NewMeResponse(user), nil
+}
+
+func (s *userService) GetProfile(ctx context.Context, id string) (*dto.ProfileResponse, error) {
+	user, err := s.UserRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// hidden profiles look the same as missing ones
+	if !user.CanViewProfile(types.GetUserID(ctx), types.GetUserRole(ctx)) {
+		return nil, ierr.NewError("profile not found").
+			WithHintf("Profile with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"user_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	enrollmentFilter := types.NewNoLimitInternshipEnrollmentFilter()
+	enrollmentFilter.UserID = user.ID
+	enrollmentFilter.EnrollmentStatus = types.InternshipEnrollmentStatusCompleted
+	enrollments, err := s.InternshipEnrollmentRepo.ListAll(ctx, enrollmentFilter)
+	if err != nil {
+		return nil, err
+	}
+	enrollments = lo.Filter(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) bool {
+		return enrollment.HasCompleted()
+	})
+
+	internships := make(map[string]*domainInternship.Internship)
+	if len(enrollments) > 0 {
+		internshipFilter := types.NewNoLimitInternshipFilter()
+		internshipFilter.InternshipIDs = lo.Uniq(lo.Map(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) string {
+			return enrollment.InternshipID
+		}))
+
+		items, err := s.InternshipRepo.ListAll(ctx, internshipFilter)
+		if err != nil {
+			return nil, err
+		}
+		internships = lo.KeyBy(items, func(internship *domainInternship.Internship) string {
+			return internship.ID
+		})
+	}
+
+	certificateFilter := types.NewNoLimitCertificateFilter()
+	certificateFilter.UserIDs = []string{user.ID}
+	certificateFilter.CertificateStatus = types.CertificateStatusIssued
+	certificates, err := s.CertificateRepo.ListAll(ctx, certificateFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	photoURL, err := s.fileURL(ctx, user.PhotoFileID)
+	if err != nil {
+		return nil, err
+	}
+
+	resumeURL, err := s.fileURL(ctx, user.ResumeFileID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := lo.FilterMap(enrollments, func(enrollment *internshipenrollment.InternshipEnrollment, _ int) (*dto.CompletedInternshipResponse, bool) {
+		internship, ok := internships[enrollment.InternshipID]
+		if !ok {
+			return nil, false
+		}
+		return &dto.CompletedInternshipResponse{
+			InternshipID:      internship.ID,
+			InternshipBatchID: enrollment.InternshipBatchID,
+			Title:             internship.Title,
+			Skills:            internship.Skills,
+			CompletedAt:       enrollment.CompletedAt,
+		}, true
+	})
+
+	taught := lo.FlatMap(completed, func(internship *dto.CompletedInternshipResponse, _ int) []string {
+		return internship.Skills
+	})
+
+	certificateService := &certificateService{ServiceParams: s.ServiceParams}
+
+	return &dto.ProfileResponse{
+		ID:                   user.ID,
+		FullName:             user.FullName,
+		Headline:             user.Headline,
+		Bio:                  user.Bio,
+		Skills:               user.Skills,
+		VerifiedSkills:       types.MatchSkills(user.Skills, taught),
+		Education:            user.Education,
+		Links:                user.Links,
+		PhotoURL:             photoURL,
+		ResumeURL:            resumeURL,
+		CompletedInternships: completed,
+		Certificates: lo.Map(certificates, func(certificate *domainCertificate.Certificate, _ int) *dto.CertificateResponse {
+			return certificateService.toResponse(certificate)
+		}),
 	}, nil
 }
+
+// validateFileChange checks a file newly set on the profile exists and was uploaded by the caller,
+// removing or keeping a file needs no check
+func (s *userService) validateFileChange(ctx context.Context, fileID *string, current *string) error {
+	if lo.FromPtr(fileID) == "" || lo.FromPtr(fileID) == lo.FromPtr(current) {
+		return nil
+	}
+
+	return (&contentService{ServiceParams: s.ServiceParams}).validateFile(ctx, lo.FromPtr(fileID))
+}
+
+// fileURL returns where a file of the profile can be fetched, files removed from storage are left out
+func (s *userService) fileURL(ctx context.Context, fileID *string) (string, error) {
+	if fileID == nil {
+		return "", nil
+	}
+
+	file, err := s.FileUploadRepo.Get(ctx, lo.FromPtr(fileID))
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return lo.CoalesceOrEmpty(lo.FromPtr(file.SecureURL), file.PublicURL), nil
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainWallet "github.com/omkar273/codegeeky/internal/domain/wallet"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// WalletService manages the per-user store-credit ledger
+type WalletService interface {
+	GetBalance(ctx context.Context, userID string, currency string) (*dto.WalletBalanceResponse, error)
+	ListTransactions(ctx context.Context, filter *types.WalletTransactionFilter) (*dto.ListWalletTransactionResponse, error)
+
+	// GrantCredit is an admin grant of store credit
+	GrantCredit(ctx context.Context, req *dto.GrantWalletCreditRequest) (*dto.WalletTransactionResponse, error)
+
+	// Credit books a single credit entry
+	Credit(ctx context.Context, req *dto.WalletCreditRequest) (*domainWallet.WalletTransaction, error)
+
+	// Debit spends credits soonest expiring first, booking one debit entry per credit drawn from
+	Debit(ctx context.Context, req *dto.WalletDebitRequest) ([]*domainWallet.WalletTransaction, error)
+
+	// ReverseDebits returns everything debited for a reference back to the wallet
+	// with the expiry of the credits it was drawn from
+	ReverseDebits(ctx context.Context, referenceID string) (decimal.Decimal, error)
+
+	// ExpireCredits writes off the unspent part of expired credits
+	ExpireCredits(ctx context.Context) (int, error)
+}
+
+type walletService struct {
+	ServiceParams
+}
+
+func NewWalletService(params ServiceParams) WalletService {
+	return &walletService{
+		ServiceParams: params,
+	}
+}
+
+func (s *walletService) GetBalance(ctx context.Context, userID string, currency string) (*dto.WalletBalanceResponse, error) {
+	if userID == "" {
+		return nil, ierr.NewError("user id is required").
+			WithHint("User id is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	currency = s.currency(currency)
+	now := time.Now().UTC()
+
+	totals, err := s.WalletRepo.GetLedgerTotals(ctx, userID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// credits that expired since the last expiry run are not spendable anymore
+	expired, err := s.WalletRepo.ListExpiredCredits(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingExpiry := decimal.Zero
+	for _, credit := range expired {
+		if credit.Currency == currency {
+			pendingExpiry = pendingExpiry.Add(credit.RemainingAmount)
+		}
+	}
+
+	response := &dto.WalletBalanceResponse{
+		UserID:       userID,
+		Currency:     currency,
+		Balance:      totals.Credits.Sub(totals.Debits).Sub(pendingExpiry),
+		TotalCredits: totals.Credits,
+		TotalDebits:  totals.Debits,
+	}
+
+	credits, err := s.WalletRepo.ListSpendableCredits(ctx, userID, currency, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if next, ok := lo.Find(credits, func(c *domainWallet.WalletTransaction) bool { return c.ExpiresAt != nil }); ok {
+		response.NextExpiryAmount = lo.ToPtr(next.RemainingAmount)
+		response.NextExpiryAt = next.ExpiresAt
+	}
+
+	return response, nil
+}
+
+func (s *walletService) ListTransactions(ctx context.Context, filter *types.WalletTransactionFilter) (*dto.ListWalletTransactionResponse, error) {
+	if filter == nil {
+		filter = types.NewWalletTransactionFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// non-admins can only see their own ledger
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		filter.UserID = types.GetUserID(ctx)
+	}
+
+	if filter.Currency != "" {
+		filter.Currency = strings.ToUpper(filter.Currency)
+	}
+
+	txns, err := s.WalletRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, txns, s.WalletRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListWalletTransactionResponse{
+		Items: lo.Map(txns, func(txn *domainWallet.WalletTransaction, _ int) *dto.WalletTransactionResponse {
+			return &dto.WalletTransactionResponse{WalletTransaction: *txn}
+		}),
+		Pagination: pagination,
+	}
+
+	return response, nil
+}
+
+func (s *walletService) GrantCredit(ctx context.Context, req *dto.GrantWalletCreditRequest) (*dto.WalletTransactionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	// make sure the credit is granted to an existing user
+	if _, err := s.UserRepo.Get(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	txn, err := s.Credit(ctx, req.ToWalletCreditRequest(s.Config.Wallet.DefaultCurrency))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WalletTransactionResponse{WalletTransaction: *txn}, nil
+}
+
+func (s *walletService) Credit(ctx context.Context, req *dto.WalletCreditRequest) (*domainWallet.WalletTransaction, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	txn := req.ToWalletTransaction(ctx)
+	if err := s.WalletRepo.Create(ctx, txn); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("credited wallet",
+		"user_id", txn.UserID,
+		"amount", txn.Amount,
+		"currency", txn.Currency,
+		"reason", txn.TransactionReason)
+
+	return txn, nil
+}
+
+func (s *walletService) Debit(ctx context.Context, req *dto.WalletDebitRequest) ([]*domainWallet.WalletTransaction, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	currency := s.currency(req.Currency)
+	var debits []*domainWallet.WalletTransaction
+
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		credits, err := s.WalletRepo.ListSpendableCredits(ctx, req.UserID, currency, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+
+		outstanding := req.Amount
+		for _, credit := range credits {
+			if !outstanding.IsPositive() {
+				break
+			}
+
+			amount := decimal.Min(credit.RemainingAmount, outstanding)
+			debit, err := s.drawFromCredit(ctx, credit, amount, req.TransactionReason, req.ReferenceID, req.Description)
+			if err != nil {
+				return err
+			}
+
+			debits = append(debits, debit)
+			outstanding = outstanding.Sub(amount)
+		}
+
+		if outstanding.IsPositive() {
+			return ierr.NewError("insufficient wallet balance").
+				WithHint("Wallet balance is not enough for this payment").
+				WithReportableDetails(map[string]any{
+					"user_id":   req.UserID,
+					"currency":  currency,
+					"requested": req.Amount,
+					"shortfall": outstanding,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return debits, nil
+}
+
+func (s *walletService) ReverseDebits(ctx context.Context, referenceID string) (decimal.Decimal, error) {
+	total := decimal.Zero
+
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		filter := types.NewNoLimitWalletTransactionFilter()
+		filter.ReferenceID = referenceID
+		filter.TransactionReason = types.WalletTransactionReasonCheckoutReversal
+
+		reversals, err := s.WalletRepo.Count(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		// already reversed
+		if reversals > 0 {
+			return nil
+		}
+
+		filter.TransactionReason = types.WalletTransactionReasonCheckout
+		debits, err := s.WalletRepo.ListAll(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, debit := range debits {
+			var expiresAt *time.Time
+			if debit.SourceTransactionID != nil {
+				source, err := s.WalletRepo.Get(ctx, *debit.SourceTransactionID)
+				if err != nil {
+					return err
+				}
+				expiresAt = source.ExpiresAt
+			}
+
+			credit := (&dto.WalletCreditRequest{
+				UserID:            debit.UserID,
+				Amount:            debit.Amount,
+				Currency:          debit.Currency,
+				TransactionReason: types.WalletTransactionReasonCheckoutReversal,
+				ReferenceID:       lo.ToPtr(referenceID),
+				Description:       "Reversal of " + debit.ID,
+			}).ToWalletTransaction(ctx)
+			credit.ExpiresAt = expiresAt
+
+			if err := s.WalletRepo.Create(ctx, credit); err != nil {
+				return err
+			}
+
+			total = total.Add(debit.Amount)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return total, nil
+}
+
+func (s *walletService) ExpireCredits(ctx context.Context) (int, error) {
+	credits, err := s.WalletRepo.ListExpiredCredits(ctx, "", time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, credit := range credits {
+		err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+			_, err := s.drawFromCredit(ctx, credit, credit.RemainingAmount,
+				types.WalletTransactionReasonExpiry, lo.ToPtr(credit.ID), "Expired credit")
+			return err
+		})
+		if err != nil {
+			s.Logger.Errorw("failed to expire wallet credit",
+				"transaction_id", credit.ID,
+				"error", err)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// drawFromCredit takes amount out of a credit and books the matching debit entry
+func (s *walletService) drawFromCredit(
+	ctx context.Context,
+	credit *domainWallet.WalletTransaction,
+	amount decimal.Decimal,
+	reason types.WalletTransactionReason,
+	referenceID *string,
+	description string,
+) (*domainWallet.WalletTransaction, error) {
+	if err := s.WalletRepo.UpdateRemainingAmount(ctx, credit.ID, credit.RemainingAmount, credit.RemainingAmount.Sub(amount)); err != nil {
+		return nil, err
+	}
+	credit.RemainingAmount = credit.RemainingAmount.Sub(amount)
+
+	debit := &domainWallet.WalletTransaction{
+		ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WALLET_TRANSACTION),
+		UserID:              credit.UserID,
+		TransactionType:     types.WalletTransactionTypeDebit,
+		TransactionReason:   reason,
+		Amount:              amount,
+		Currency:            credit.Currency,
+		RemainingAmount:     decimal.Zero,
+		SourceTransactionID: lo.ToPtr(credit.ID),
+		ReferenceID:         referenceID,
+		Description:         description,
+		Metadata:            types.Metadata{},
+		BaseModel:           types.GetDefaultBaseModel(ctx),
+	}
+
+	if err := s.WalletRepo.Create(ctx, debit); err != nil {
+		return nil, err
+	}
+
+	return debit, nil
+}
+
+func (s *walletService) currency(currency string) string {
+	if currency == "" {
+		currency = s.Config.Wallet.DefaultCurrency
+	}
+	return strings.ToUpper(currency)
+}
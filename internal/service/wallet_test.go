@@ -0,0 +1,189 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	"github.com/omkar273/codegeeky/internal/config"
+	domainWallet "github.com/omkar273/codegeeky/internal/domain/wallet"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/testutil"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type WalletServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service WalletService
+}
+
+func TestWalletService(t *testing.T) {
+	suite.Run(t, new(WalletServiceSuite))
+}
+
+func (s *WalletServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := s.GetConfig()
+	cfg.Wallet = config.WalletConfig{DefaultCurrency: "INR"}
+
+	stores := s.GetStores()
+	s.service = NewWalletService(ServiceParams{
+		Logger:     s.GetLogger(),
+		Config:     cfg,
+		DB:         s.GetDB(),
+		UserRepo:   stores.UserRepo,
+		WalletRepo: stores.WalletRepo,
+	})
+}
+
+func (s *WalletServiceSuite) credit(amount int64, validity time.Duration) *domainWallet.WalletTransaction {
+	req := &dto.WalletCreditRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(amount),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonAdminGrant,
+	}
+	if validity > 0 {
+		req.ExpiresAt = lo.ToPtr(s.GetNow().Add(validity))
+	}
+
+	credit, err := s.service.Credit(s.GetContext(), req)
+	s.Require().NoError(err)
+	return credit
+}
+
+// expiredCredit books a credit that expired before the expiry job wrote it off
+func (s *WalletServiceSuite) expiredCredit(amount int64) *domainWallet.WalletTransaction {
+	credit := (&dto.WalletCreditRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(amount),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonAdminGrant,
+	}).ToWalletTransaction(s.GetContext())
+	credit.ExpiresAt = lo.ToPtr(s.GetNow().Add(-time.Hour))
+
+	s.Require().NoError(s.GetStores().WalletRepo.Create(s.GetContext(), credit))
+	return credit
+}
+
+func (s *WalletServiceSuite) debit(amount int64, referenceID string) ([]*domainWallet.WalletTransaction, error) {
+	return s.service.Debit(s.GetContext(), &dto.WalletDebitRequest{
+		UserID:            types.DefaultUserID,
+		Amount:            decimal.NewFromInt(amount),
+		Currency:          "INR",
+		TransactionReason: types.WalletTransactionReasonCheckout,
+		ReferenceID:       lo.ToPtr(referenceID),
+	})
+}
+
+func (s *WalletServiceSuite) remaining(credit *domainWallet.WalletTransaction) decimal.Decimal {
+	txn, err := s.GetStores().WalletRepo.Get(s.GetContext(), credit.ID)
+	s.Require().NoError(err)
+	return txn.RemainingAmount
+}
+
+func (s *WalletServiceSuite) balance() decimal.Decimal {
+	response, err := s.service.GetBalance(s.GetContext(), types.DefaultUserID, "")
+	s.Require().NoError(err)
+	return response.Balance
+}
+
+func (s *WalletServiceSuite) TestDebitSpendsSoonestExpiringFirst() {
+	forever := s.credit(100, 0)
+	month := s.credit(50, 30*24*time.Hour)
+	week := s.credit(30, 7*24*time.Hour)
+	expired := s.expiredCredit(500)
+
+	debits, err := s.debit(60, "enrollment")
+	s.Require().NoError(err)
+
+	// one debit per credit drawn from, the expired credit is never spent
+	s.Require().Len(debits, 2)
+	s.Equal(week.ID, lo.FromPtr(debits[0].SourceTransactionID))
+	s.True(debits[0].Amount.Equal(decimal.NewFromInt(30)))
+	s.Equal(month.ID, lo.FromPtr(debits[1].SourceTransactionID))
+	s.True(debits[1].Amount.Equal(decimal.NewFromInt(30)))
+
+	s.True(s.remaining(week).IsZero())
+	s.True(s.remaining(month).Equal(decimal.NewFromInt(20)))
+	s.True(s.remaining(forever).Equal(decimal.NewFromInt(100)))
+	s.True(s.remaining(expired).Equal(decimal.NewFromInt(500)))
+	s.True(s.balance().Equal(decimal.NewFromInt(120)), s.balance().String())
+}
+
+func (s *WalletServiceSuite) TestDebitFailsWithoutEnoughBalance() {
+	s.credit(50, 0)
+	s.expiredCredit(500)
+
+	_, err := s.debit(80, "enrollment")
+	s.True(ierr.IsInvalidOperation(err))
+}
+
+func (s *WalletServiceSuite) TestReverseDebitsRestoresExpiry() {
+	week := s.credit(30, 7*24*time.Hour)
+	s.credit(100, 0)
+
+	_, err := s.debit(60, "enrollment")
+	s.Require().NoError(err)
+	s.True(s.balance().Equal(decimal.NewFromInt(70)))
+
+	total, err := s.service.ReverseDebits(s.GetContext(), "enrollment")
+	s.Require().NoError(err)
+	s.True(total.Equal(decimal.NewFromInt(60)))
+	s.True(s.balance().Equal(decimal.NewFromInt(130)))
+
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.TransactionReason = types.WalletTransactionReasonCheckoutReversal
+	reversals, err := s.GetStores().WalletRepo.ListAll(s.GetContext(), filter)
+	s.Require().NoError(err)
+	s.Require().Len(reversals, 2)
+
+	// each reversal keeps the expiry of the credit its debit was drawn from
+	expiries := lo.Map(reversals, func(txn *domainWallet.WalletTransaction, _ int) *time.Time {
+		return txn.ExpiresAt
+	})
+	s.Contains(expiries, (*time.Time)(nil))
+	s.Contains(expiries, week.ExpiresAt)
+}
+
+func (s *WalletServiceSuite) TestReverseDebitsIsIdempotent() {
+	s.credit(100, 0)
+
+	_, err := s.debit(40, "enrollment")
+	s.Require().NoError(err)
+
+	_, err = s.service.ReverseDebits(s.GetContext(), "enrollment")
+	s.Require().NoError(err)
+
+	total, err := s.service.ReverseDebits(s.GetContext(), "enrollment")
+	s.Require().NoError(err)
+	s.True(total.IsZero())
+	s.True(s.balance().Equal(decimal.NewFromInt(100)))
+
+	// nothing was debited for other references
+	total, err = s.service.ReverseDebits(s.GetContext(), "other")
+	s.Require().NoError(err)
+	s.True(total.IsZero())
+}
+
+func (s *WalletServiceSuite) TestExpireCreditsWritesOffUnspentPart() {
+	s.credit(100, 0)
+	expired := s.expiredCredit(40)
+
+	// expired credit is left out of the balance before the job runs
+	s.True(s.balance().Equal(decimal.NewFromInt(100)))
+
+	count, err := s.service.ExpireCredits(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(1, count)
+	s.True(s.remaining(expired).IsZero())
+	s.True(s.balance().Equal(decimal.NewFromInt(100)))
+
+	count, err = s.service.ExpireCredits(s.GetContext())
+	s.Require().NoError(err)
+	s.Equal(0, count)
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	domainInternship "github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/wishlist"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	webhookDto "github.com/omkar273/codegeeky/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+type WishlistService interface {
+	// Add saves a published internship to the wishlist of the current user
+	Add(ctx context.Context, req *dto.AddWishlistRequest) (*dto.WishlistResponse, error)
+
+	// Remove takes an internship off the wishlist of the current user
+	Remove(ctx context.Context, internshipID string) error
+
+	// List returns the wishlist of the current user, most recently saved first
+	List(ctx context.Context, filter *types.WishlistFilter) (*dto.ListWishlistResponse, error)
+
+	// ListDemand returns how many users saved each internship next to its upcoming batches,
+	// most wishlisted first. Only admins can see it
+	ListDemand(ctx context.Context, filter *types.WishlistFilter) (*dto.ListWishlistDemandResponse, error)
+
+	// NotifyBatchAnnounced tells the users who saved an internship that it has a new batch
+	NotifyBatchAnnounced(ctx context.Context, batch *domainInternship.InternshipBatch) error
+
+	// NotifyPriceChange tells the users who saved an internship that it went on sale or got
+	// cheaper, previous is the content the internship had before its new content went live
+	NotifyPriceChange(ctx context.Context, previous *types.InternshipSnapshot, internship *domainInternship.Internship) error
+}
+
+type wishlistService struct {
+	ServiceParams
+}
+
+func NewWishlistService(params ServiceParams) WishlistService {
+	return &wishlistService{
+		ServiceParams: params,
+	}
+}
+
+func (s *wishlistService) Add(ctx context.Context, req *dto.AddWishlistRequest) (*dto.WishlistResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	internship, err := s.InternshipRepo.Get(ctx, req.InternshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !internship.IsPublished() {
+		return nil, ierr.NewError("internship is not published").
+			WithHint("Only published internships can be saved to the wishlist").
+			WithReportableDetails(map[string]any{
+				"internship_id": internship.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	item := req.ToWishlist(ctx)
+	if err := s.WishlistRepo.Create(ctx, item); err != nil {
+		return nil, err
+	}
+
+	hideEditorial(internship)
+	return &dto.WishlistResponse{
+		Wishlist:   *item,
+		Internship: &dto.InternshipResponse{Internship: *internship},
+	}, nil
+}
+
+func (s *wishlistService) Remove(ctx context.Context, internshipID string) error {
+	item, err := s.WishlistRepo.GetByUserAndInternship(ctx, types.GetUserID(ctx), internshipID)
+	if err != nil {
+		return err
+	}
+
+	return s.WishlistRepo.Delete(ctx, item.ID)
+}
+
+func (s *wishlistService) List(ctx context.Context, filter *types.WishlistFilter) (*dto.ListWishlistResponse, error) {
+	if filter == nil {
+		filter = types.NewWishlistFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter.UserIDs = []string{types.GetUserID(ctx)}
+
+	items, err := s.WishlistRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := paginate(ctx, filter, items, s.WishlistRepo.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	internships, err := s.publishedInternships(ctx, lo.Map(items, func(item *wishlist.Wishlist, _ int) string {
+		return item.InternshipID
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListWishlistResponse{
+		Items: lo.Map(items, func(item *wishlist.Wishlist, _ int) *dto.WishlistResponse {
+			response := &dto.WishlistResponse{Wishlist: *item}
+			if internship, ok := internships[item.InternshipID]; ok {
+				response.Internship = &dto.InternshipResponse{Internship: *internship}
+			}
+			return response
+		}),
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *wishlistService) ListDemand(ctx context.Context, filter *types.WishlistFilter) (*dto.ListWishlistDemandResponse, error) {
+	if filter == nil {
+		filter = types.NewNoLimitWishlistFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if types.GetUserRole(ctx) != types.UserRoleAdmin {
+		return nil, ierr.NewError("not an admin").
+			WithHint("Only admins can see wishlist demand").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	items, err := s.WishlistRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlists := lo.CountValuesBy(items, func(item *wishlist.Wishlist) string {
+		return item.InternshipID
+	})
+
+	internships, err := s.publishedInternships(ctx, lo.Keys(wishlists))
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := map[string]int{}
+	if len(internships) > 0 {
+		batchFilter := types.NewNoLimitInternshipBatchFilter()
+		batchFilter.InternshipIDs = lo.Keys(internships)
+		batchFilter.BatchStatus = types.InternshipBatchStatusUpcoming
+		batches, err := s.InternshipBatchRepo.ListAll(ctx, batchFilter)
+		if err != nil {
+			return nil, err
+		}
+		upcoming = lo.CountValuesBy(batches, func(batch *domainInternship.InternshipBatch) string {
+			return batch.InternshipID
+		})
+	}
+
+	response := &dto.ListWishlistDemandResponse{
+		Items: make([]*dto.WishlistDemandResponse, 0, len(internships)),
+	}
+	for id, internship := range internships {
+		response.Items = append(response.Items, &dto.WishlistDemandResponse{
+			Internship:      &dto.InternshipResponse{Internship: *internship},
+			Wishlists:       wishlists[id],
+			UpcomingBatches: upcoming[id],
+		})
+	}
+
+	sort.Slice(response.Items, func(i, j int) bool {
+		a, b := response.Items[i], response.Items[j]
+		if a.Wishlists != b.Wishlists {
+			return a.Wishlists > b.Wishlists
+		}
+		return a.Internship.ID < b.Internship.ID
+	})
+
+	return response, nil
+}
+
+func (s *wishlistService) NotifyBatchAnnounced(ctx context.Context, batch *domainInternship.InternshipBatch) error {
+	internship, err := s.InternshipRepo.Get(ctx, batch.InternshipID)
+	if err != nil {
+		return err
+	}
+
+	if !internship.IsPublished() {
+		return nil
+	}
+
+	return s.notify(ctx, types.WebhookEventWishlistBatchAnnounced, internship, &webhookDto.WishlistWebhookPayload{
+		BatchID:   lo.ToPtr(batch.ID),
+		BatchName: lo.ToPtr(batch.Name),
+		StartDate: lo.ToPtr(batch.StartDate),
+	})
+}
+
+func (s *wishlistService) NotifyPriceChange(ctx context.Context, previous *types.InternshipSnapshot, internship *domainInternship.Internship) error {
+	if !internship.IsPublished() || previous.Currency != internship.Currency {
+		return nil
+	}
+
+	var eventName string
+	switch {
+	case !onSale(previous.FlatDiscount, previous.PercentageDiscount) && onSale(internship.FlatDiscount, internship.PercentageDiscount):
+		eventName = types.WebhookEventWishlistSaleStarted
+	case internship.Total.LessThan(previous.Total):
+		eventName = types.WebhookEventWishlistPriceDropped
+	default:
+		return nil
+	}
+
+	return s.notify(ctx, eventName, internship, &webhookDto.WishlistWebhookPayload{
+		PreviousTotal: lo.ToPtr(previous.Total),
+	})
+}
+
+// notify publishes the event to every user who saved the internship, the payload is completed
+// with the internship's details
+func (s *wishlistService) notify(ctx context.Context, eventName string, internship *domainInternship.Internship, payload *webhookDto.WishlistWebhookPayload) error {
+	filter := types.NewNoLimitWishlistFilter()
+	filter.InternshipIDs = []string{internship.ID}
+	items, err := s.WishlistRepo.ListAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	payload.InternshipID = internship.ID
+	payload.Title = internship.Title
+	payload.Currency = internship.Currency
+	payload.Total = internship.Total
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to build wishlist event").
+			Mark(ierr.ErrInternal)
+	}
+
+	for _, item := range items {
+		err := s.WebhookPublisher.PublishWebhook(ctx, &types.WebhookEvent{
+			ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EVENT),
+			EventName: eventName,
+			UserID:    lo.ToPtr(item.UserID),
+			Payload:   data,
+			Timestamp: time.Now().UTC(),
+		})
+		if err != nil {
+			s.Logger.Errorw("failed to publish wishlist event",
+				"internship_id", internship.ID,
+				"user_id", item.UserID,
+				"event_name", eventName,
+				"error", err)
+		}
+	}
+
+	return nil
+}
+
+// publishedInternships loads the published internships among the ids, keyed by id and
+// stripped of their editorial state
+func (s *wishlistService) publishedInternships(ctx context.Context, ids []string) (map[string]*domainInternship.Internship, error) {
+	if len(ids) == 0 {
+		return map[string]*domainInternship.Internship{}, nil
+	}
+
+	filter := types.NewNoLimitInternshipFilter()
+	filter.InternshipIDs = lo.Uniq(ids)
+	filter.PublishStatuses = []types.InternshipPublishStatus{types.InternshipPublishStatusPublished}
+	internships, err := s.InternshipRepo.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.SliceToMap(internships, func(internship *domainInternship.Internship) (string, *domainInternship.Internship) {
+		hideEditorial(internship)
+		return internship.ID, internship
+	}), nil
+}
+
+// onSale reports whether an internship with these discounts is sold below its price
+func onSale(flat *decimal.Decimal, percentage *decimal.Decimal) bool {
+	return lo.FromPtr(flat).IsPositive() || lo.FromPtr(percentage).IsPositive()
+}
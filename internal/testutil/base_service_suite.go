@@ -4,13 +4,25 @@ import (
 	"context"
 	"time"
 
-	"github.com/omkar273/codegeeky/internal/cache"
 	"github.com/omkar273/codegeeky/internal/config"
 	"github.com/omkar273/codegeeky/internal/domain/cart"
+	"github.com/omkar273/codegeeky/internal/domain/certificate"
+	"github.com/omkar273/codegeeky/internal/domain/content"
 	"github.com/omkar273/codegeeky/internal/domain/discount"
 	"github.com/omkar273/codegeeky/internal/domain/internship"
+	"github.com/omkar273/codegeeky/internal/domain/internshipapplication"
 	"github.com/omkar273/codegeeky/internal/domain/internshipenrollment"
+	"github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	"github.com/omkar273/codegeeky/internal/domain/notification"
+	"github.com/omkar273/codegeeky/internal/domain/payment"
+	"github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	"github.com/omkar273/codegeeky/internal/domain/referral"
+	"github.com/omkar273/codegeeky/internal/domain/subscription"
 	"github.com/omkar273/codegeeky/internal/domain/user"
+	"github.com/omkar273/codegeeky/internal/domain/wallet"
+	"github.com/omkar273/codegeeky/internal/domain/wishlist"
+	"github.com/omkar273/codegeeky/internal/fileupload"
 	"github.com/omkar273/codegeeky/internal/logger"
 	"github.com/omkar273/codegeeky/internal/postgres"
 	"github.com/omkar273/codegeeky/internal/types"
@@ -25,7 +37,31 @@ type Stores struct {
 	DiscountRepo             discount.Repository
 	InternshipRepo           internship.InternshipRepository
 	InternshipBatchRepo      internship.InternshipBatchRepository
+	InternshipRevisionRepo   internship.InternshipRevisionRepository
+	InternshipInstructorRepo internship.InternshipInstructorRepository
 	InternshipEnrollmentRepo internshipenrollment.Repository
+	ReferralRepo             referral.Repository
+	WalletRepo               wallet.Repository
+	PaymentRepo              payment.Repository
+	PaymentPlanRepo          paymentplan.Repository
+	SubscriptionPlanRepo     subscription.PlanRepository
+	SubscriptionRepo         subscription.Repository
+	ModuleRepo               content.ModuleRepository
+	LessonRepo               content.LessonRepository
+	AssignmentRepo           content.AssignmentRepository
+	ResourceRepo             content.ResourceRepository
+	LessonProgressRepo       content.LessonProgressRepository
+	SubmissionRepo           content.SubmissionRepository
+	QuizRepo                 content.QuizRepository
+	QuizAttemptRepo          content.QuizAttemptRepository
+	CertificateRepo          certificate.Repository
+	LiveSessionRepo          livesession.Repository
+	SessionAttendanceRepo    livesession.AttendanceRepository
+	ApplicationRepo          internshipapplication.Repository
+	ReviewRepo               internshipreview.Repository
+	WishlistRepo             wishlist.Repository
+	NotificationRepo         notification.Repository
+	FileUploadRepo           fileupload.Repository
 }
 
 // BaseServiceTestSuite provides common functionality for all service test suites
@@ -56,9 +92,6 @@ func (s *BaseServiceTestSuite) SetupSuite() {
 	if err != nil {
 		s.T().Fatalf("failed to create logger: %v", err)
 	}
-
-	// Initialize cache
-	cache.Initialize(s.logger)
 }
 
 // SetupTest is called before each test
@@ -90,7 +123,31 @@ func (s *BaseServiceTestSuite) setupStores() {
 		DiscountRepo:             NewInMemoryDiscountStore(),
 		InternshipRepo:           NewInMemoryInternshipStore(),
 		InternshipBatchRepo:      NewInMemoryInternshipBatchStore(),
+		InternshipRevisionRepo:   NewInMemoryInternshipRevisionStore(),
+		InternshipInstructorRepo: NewInMemoryInternshipInstructorStore(),
 		InternshipEnrollmentRepo: NewInMemoryInternshipEnrollmentStore(),
+		ReferralRepo:             NewInMemoryReferralStore(),
+		WalletRepo:               NewInMemoryWalletStore(),
+		PaymentRepo:              NewInMemoryPaymentStore(),
+		PaymentPlanRepo:          NewInMemoryPaymentPlanStore(),
+		SubscriptionPlanRepo:     NewInMemorySubscriptionPlanStore(),
+		SubscriptionRepo:         NewInMemorySubscriptionStore(),
+		ModuleRepo:               NewInMemoryModuleStore(),
+		LessonRepo:               NewInMemoryLessonStore(),
+		AssignmentRepo:           NewInMemoryAssignmentStore(),
+		ResourceRepo:             NewInMemoryResourceStore(),
+		LessonProgressRepo:       NewInMemoryLessonProgressStore(),
+		SubmissionRepo:           NewInMemorySubmissionStore(),
+		QuizRepo:                 NewInMemoryQuizStore(),
+		QuizAttemptRepo:          NewInMemoryQuizAttemptStore(),
+		CertificateRepo:          NewInMemoryCertificateStore(),
+		LiveSessionRepo:          NewInMemoryLiveSessionStore(),
+		SessionAttendanceRepo:    NewInMemorySessionAttendanceStore(),
+		ApplicationRepo:          NewInMemoryInternshipApplicationStore(),
+		ReviewRepo:               NewInMemoryInternshipReviewStore(),
+		WishlistRepo:             NewInMemoryWishlistStore(),
+		NotificationRepo:         NewInMemoryNotificationStore(),
+		FileUploadRepo:           NewInMemoryFileUploadStore(),
 	}
 
 	s.db = NewMockPostgresClient(s.logger)
@@ -102,7 +159,31 @@ func (s *BaseServiceTestSuite) clearStores() {
 	s.stores.DiscountRepo.(*InMemoryDiscountStore).Clear()
 	s.stores.InternshipRepo.(*InMemoryInternshipStore).Clear()
 	s.stores.InternshipBatchRepo.(*InMemoryInternshipBatchStore).Clear()
+	s.stores.InternshipRevisionRepo.(*InMemoryInternshipRevisionStore).Clear()
+	s.stores.InternshipInstructorRepo.(*InMemoryInternshipInstructorStore).Clear()
 	s.stores.InternshipEnrollmentRepo.(*InMemoryInternshipEnrollmentStore).Clear()
+	s.stores.ReferralRepo.(*InMemoryReferralStore).Clear()
+	s.stores.WalletRepo.(*InMemoryWalletStore).Clear()
+	s.stores.PaymentRepo.(*InMemoryPaymentStore).Clear()
+	s.stores.PaymentPlanRepo.(*InMemoryPaymentPlanStore).Clear()
+	s.stores.SubscriptionPlanRepo.(*InMemorySubscriptionPlanStore).Clear()
+	s.stores.SubscriptionRepo.(*InMemorySubscriptionStore).Clear()
+	s.stores.ModuleRepo.(*InMemoryModuleStore).Clear()
+	s.stores.LessonRepo.(*InMemoryLessonStore).Clear()
+	s.stores.AssignmentRepo.(*InMemoryAssignmentStore).Clear()
+	s.stores.ResourceRepo.(*InMemoryResourceStore).Clear()
+	s.stores.LessonProgressRepo.(*InMemoryLessonProgressStore).Clear()
+	s.stores.SubmissionRepo.(*InMemorySubmissionStore).Clear()
+	s.stores.QuizRepo.(*InMemoryQuizStore).Clear()
+	s.stores.QuizAttemptRepo.(*InMemoryQuizAttemptStore).Clear()
+	s.stores.CertificateRepo.(*InMemoryCertificateStore).Clear()
+	s.stores.LiveSessionRepo.(*InMemoryLiveSessionStore).Clear()
+	s.stores.SessionAttendanceRepo.(*InMemorySessionAttendanceStore).Clear()
+	s.stores.ApplicationRepo.(*InMemoryInternshipApplicationStore).Clear()
+	s.stores.ReviewRepo.(*InMemoryInternshipReviewStore).Clear()
+	s.stores.WishlistRepo.(*InMemoryWishlistStore).Clear()
+	s.stores.NotificationRepo.(*InMemoryNotificationStore).Clear()
+	s.stores.FileUploadRepo.(*InMemoryFileUploadStore).Clear()
 }
 
 func (s *BaseServiceTestSuite) ClearStores() {
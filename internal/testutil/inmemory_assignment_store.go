@@ -0,0 +1,201 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryAssignmentStore implements content.AssignmentRepository
+type InMemoryAssignmentStore struct {
+	*InMemoryStore[*content.Assignment]
+}
+
+// NewInMemoryAssignmentStore creates a new in-memory assignment store
+func NewInMemoryAssignmentStore() *InMemoryAssignmentStore {
+	return &InMemoryAssignmentStore{
+		InMemoryStore: NewInMemoryStore[*content.Assignment](),
+	}
+}
+
+// assignmentFilterFn implements filtering logic for assignments
+func assignmentFilterFn(ctx context.Context, m *content.Assignment, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.AssignmentFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.ModuleIDs) > 0 && !lo.Contains(filter_.ModuleIDs, m.ModuleID) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted assignments
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// assignmentSortFn implements sorting logic for assignments
+func assignmentSortFn(i, j *content.Assignment) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	if i.SortOrder != j.SortOrder {
+		return i.SortOrder < j.SortOrder
+	}
+	return i.CreatedAt.Before(j.CreatedAt)
+}
+
+func (s *InMemoryAssignmentStore) Create(ctx context.Context, m *content.Assignment) error {
+	if m == nil {
+		return ierr.NewError("assignment cannot be nil").
+			WithHint("Assignment data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A assignment with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"assignment_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create assignment").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryAssignmentStore) Get(ctx context.Context, id string) (*content.Assignment, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("assignment not found").
+			WithHintf("Assignment with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"assignment_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryAssignmentStore) Update(ctx context.Context, m *content.Assignment) error {
+	if m == nil {
+		return ierr.NewError("assignment cannot be nil").
+			WithHint("Assignment data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Assignment with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"assignment_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update assignment with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryAssignmentStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryAssignmentStore) Count(ctx context.Context, filter *types.AssignmentFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, assignmentFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count assignments").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryAssignmentStore) List(ctx context.Context, filter *types.AssignmentFilter) ([]*content.Assignment, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, assignmentFilterFn, assignmentSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list assignments").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryAssignmentStore) ListAll(ctx context.Context, filter *types.AssignmentFilter) ([]*content.Assignment, error) {
+	if filter == nil {
+		filter = types.NewNoLimitAssignmentFilter()
+	}
+
+	unlimitedFilter := &types.AssignmentFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		ModuleIDs:       filter.ModuleIDs,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the assignment store
+func (s *InMemoryAssignmentStore) Clear() {
+	s.InMemoryStore.Clear()
+}
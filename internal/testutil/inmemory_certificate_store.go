@@ -0,0 +1,239 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/certificate"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryCertificateStore implements certificate.Repository
+type InMemoryCertificateStore struct {
+	*InMemoryStore[*certificate.Certificate]
+}
+
+// NewInMemoryCertificateStore creates a new in-memory certificate store
+func NewInMemoryCertificateStore() *InMemoryCertificateStore {
+	return &InMemoryCertificateStore{
+		InMemoryStore: NewInMemoryStore[*certificate.Certificate](),
+	}
+}
+
+// certificateFilterFn implements filtering logic for certificates
+func certificateFilterFn(ctx context.Context, m *certificate.Certificate, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.CertificateFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if len(filter_.EnrollmentIDs) > 0 && !lo.Contains(filter_.EnrollmentIDs, m.EnrollmentID) {
+		return false
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if filter_.CertificateStatus != "" && m.CertificateStatus != filter_.CertificateStatus {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted certificates
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// certificateSortFn implements sorting logic for certificates
+func certificateSortFn(i, j *certificate.Certificate) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryCertificateStore) Create(ctx context.Context, m *certificate.Certificate) error {
+	if m == nil {
+		return ierr.NewError("certificate cannot be nil").
+			WithHint("Certificate data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A certificate with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"certificate_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create certificate").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryCertificateStore) Get(ctx context.Context, id string) (*certificate.Certificate, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("certificate not found").
+			WithHintf("Certificate with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"certificate_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryCertificateStore) GetByVerificationID(ctx context.Context, verificationID string) (*certificate.Certificate, error) {
+	certificates, err := s.InMemoryStore.List(ctx, nil, certificateFilterFn, certificateSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get certificate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, c := range certificates {
+		if c.VerificationID == verificationID && c.Status != types.StatusDeleted {
+			return c, nil
+		}
+	}
+
+	return nil, ierr.NewError("certificate not found").
+		WithHintf("Certificate %s was not found", verificationID).
+		WithReportableDetails(map[string]any{
+			"verification_id": verificationID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemoryCertificateStore) GetByEnrollmentID(ctx context.Context, enrollmentID string) (*certificate.Certificate, error) {
+	certificates, err := s.InMemoryStore.List(ctx, nil, certificateFilterFn, certificateSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get certificate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, c := range certificates {
+		if c.EnrollmentID == enrollmentID && c.Status != types.StatusDeleted {
+			return c, nil
+		}
+	}
+
+	return nil, ierr.NewError("certificate not found").
+		WithHintf("Certificate for enrollment %s was not found", enrollmentID).
+		WithReportableDetails(map[string]any{
+			"enrollment_id": enrollmentID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemoryCertificateStore) Update(ctx context.Context, m *certificate.Certificate) error {
+	if m == nil {
+		return ierr.NewError("certificate cannot be nil").
+			WithHint("Certificate data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Certificate with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"certificate_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update certificate with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryCertificateStore) Count(ctx context.Context, filter *types.CertificateFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, certificateFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count certificates").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryCertificateStore) List(ctx context.Context, filter *types.CertificateFilter) ([]*certificate.Certificate, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, certificateFilterFn, certificateSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list certificates").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryCertificateStore) ListAll(ctx context.Context, filter *types.CertificateFilter) ([]*certificate.Certificate, error) {
+	if filter == nil {
+		filter = types.NewNoLimitCertificateFilter()
+	}
+
+	unlimitedFilter := &types.CertificateFilter{
+		QueryFilter:       types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:   filter.TimeRangeFilter,
+		UserIDs:           filter.UserIDs,
+		EnrollmentIDs:     filter.EnrollmentIDs,
+		InternshipIDs:     filter.InternshipIDs,
+		CertificateStatus: filter.CertificateStatus,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the certificate store
+func (s *InMemoryCertificateStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -61,6 +61,19 @@ func discountFilterFn(ctx context.Context, d *discount.Discount, filter interfac
 		}
 	}
 
+	// Filter by campaign
+	if filter_.ParentID != "" {
+		if lo.FromPtr(d.ParentID) != filter_.ParentID {
+			return false
+		}
+	}
+
+	if filter_.IsCampaign != nil {
+		if d.IsCampaign != *filter_.IsCampaign {
+			return false
+		}
+	}
+
 	// Filter by status - if no status is specified, only show active discounts
 	if filter_.GetStatus() != "" {
 		if string(d.Status) != filter_.GetStatus() {
@@ -262,11 +275,108 @@ func (s *InMemoryDiscountStore) ListAll(ctx context.Context, filter *types.Disco
 		IsCombinable:    filter.IsCombinable,
 		Codes:           filter.Codes,
 		DiscountIDs:     filter.DiscountIDs,
+		ParentID:        filter.ParentID,
+		IsCampaign:      filter.IsCampaign,
 	}
 
 	return s.List(ctx, unlimitedFilter)
 }
 
+func (s *InMemoryDiscountStore) CreateBulk(ctx context.Context, discounts []*discount.Discount) error {
+	for _, d := range discounts {
+		if _, err := s.GetByCode(ctx, d.Code); err == nil {
+			return ierr.NewError("discount code already exists").
+				WithHint("One or more generated codes already exist").
+				WithReportableDetails(map[string]any{
+					"code": d.Code,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+
+		if err := s.Create(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InMemoryDiscountStore) DeactivateByParent(ctx context.Context, parentID string, codes []string) (int, error) {
+	filter := types.NewNoLimitDiscountFilter()
+	filter.ParentID = parentID
+	children, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	affected := 0
+	for _, d := range children {
+		if !d.IsActive || (len(codes) > 0 && !lo.Contains(codes, d.Code)) {
+			continue
+		}
+
+		d.IsActive = false
+		if err := s.Update(ctx, d); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+func (s *InMemoryDiscountStore) GetCampaignStats(ctx context.Context, parentID string) (*discount.CampaignStats, error) {
+	filter := types.NewNoLimitDiscountFilter()
+	filter.ParentID = parentID
+	children, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &discount.CampaignStats{TotalCodes: len(children)}
+	for _, d := range children {
+		if d.IsActive {
+			stats.ActiveCodes++
+		}
+		if d.UsedCount > 0 {
+			stats.RedeemedCodes++
+			stats.TotalRedemptions += d.UsedCount
+		}
+	}
+	return stats, nil
+}
+
+func (s *InMemoryDiscountStore) IncrementUsage(ctx context.Context, id string) error {
+	d, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if d.MaxUses != nil && *d.MaxUses > 0 && d.UsedCount >= *d.MaxUses {
+		return ierr.NewError("discount usage limit reached").
+			WithHint("Discount has reached the maximum number of uses").
+			WithReportableDetails(map[string]any{
+				"discount_id": id,
+			}).
+			Mark(ierr.ErrBadRequest)
+	}
+
+	d.UsedCount++
+	return s.Update(ctx, d)
+}
+
+func (s *InMemoryDiscountStore) DecrementUsage(ctx context.Context, id string) error {
+	d, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if d.UsedCount == 0 {
+		return nil
+	}
+
+	d.UsedCount--
+	return s.Update(ctx, d)
+}
+
 // Clear clears the discount store
 func (s *InMemoryDiscountStore) Clear() {
 	s.InMemoryStore.Clear()
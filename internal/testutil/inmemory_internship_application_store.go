@@ -0,0 +1,228 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/internshipapplication"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryInternshipApplicationStore implements internshipapplication.Repository
+type InMemoryInternshipApplicationStore struct {
+	*InMemoryStore[*internshipapplication.InternshipApplication]
+}
+
+// NewInMemoryInternshipApplicationStore creates a new in-memory internship application store
+func NewInMemoryInternshipApplicationStore() *InMemoryInternshipApplicationStore {
+	return &InMemoryInternshipApplicationStore{
+		InMemoryStore: NewInMemoryStore[*internshipapplication.InternshipApplication](),
+	}
+}
+
+// internshipApplicationFilterFn implements filtering logic for internship applications
+func internshipApplicationFilterFn(ctx context.Context, m *internshipapplication.InternshipApplication, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.InternshipApplicationFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.InternshipBatchIDs) > 0 && !lo.Contains(filter_.InternshipBatchIDs, m.InternshipBatchID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if filter_.ApplicationStatus != "" && m.ApplicationStatus != filter_.ApplicationStatus {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted internship applications
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// internshipApplicationSortFn implements sorting logic for internship applications
+func internshipApplicationSortFn(i, j *internshipapplication.InternshipApplication) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryInternshipApplicationStore) Create(ctx context.Context, m *internshipapplication.InternshipApplication) error {
+	if m == nil {
+		return ierr.NewError("internship application cannot be nil").
+			WithHint("Application data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetActive(ctx, m.InternshipBatchID, m.UserID); err == nil {
+		return ierr.NewError("internship application already exists").
+			WithHint("You already applied to this batch").
+			WithReportableDetails(map[string]any{
+				"internship_batch_id": m.InternshipBatchID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("An application with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"application_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship application").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipApplicationStore) Get(ctx context.Context, id string) (*internshipapplication.InternshipApplication, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("internship application not found").
+			WithHintf("Application with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"application_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryInternshipApplicationStore) GetActive(ctx context.Context, batchID string, userID string) (*internshipapplication.InternshipApplication, error) {
+	applications, err := s.InMemoryStore.List(ctx, nil, internshipApplicationFilterFn, internshipApplicationSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship application").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, a := range applications {
+		if a.InternshipBatchID == batchID && a.UserID == userID &&
+			a.ApplicationStatus != types.ApplicationStatusWithdrawn && a.Status != types.StatusDeleted {
+			return a, nil
+		}
+	}
+
+	return nil, ierr.NewError("internship application not found").
+		WithHint("No application to this batch was found").
+		WithReportableDetails(map[string]any{
+			"internship_batch_id": batchID,
+			"user_id":             userID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemoryInternshipApplicationStore) Update(ctx context.Context, m *internshipapplication.InternshipApplication) error {
+	if m == nil {
+		return ierr.NewError("internship application cannot be nil").
+			WithHint("Application data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Application with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"application_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update internship application with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipApplicationStore) Count(ctx context.Context, filter *types.InternshipApplicationFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, internshipApplicationFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship applications").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryInternshipApplicationStore) List(ctx context.Context, filter *types.InternshipApplicationFilter) ([]*internshipapplication.InternshipApplication, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, internshipApplicationFilterFn, internshipApplicationSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship applications").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryInternshipApplicationStore) ListAll(ctx context.Context, filter *types.InternshipApplicationFilter) ([]*internshipapplication.InternshipApplication, error) {
+	if filter == nil {
+		filter = types.NewNoLimitInternshipApplicationFilter()
+	}
+
+	unlimitedFilter := &types.InternshipApplicationFilter{
+		QueryFilter:        types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:    filter.TimeRangeFilter,
+		InternshipIDs:      filter.InternshipIDs,
+		InternshipBatchIDs: filter.InternshipBatchIDs,
+		UserIDs:            filter.UserIDs,
+		ApplicationStatus:  filter.ApplicationStatus,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the internship application store
+func (s *InMemoryInternshipApplicationStore) Clear() {
+	s.InMemoryStore.Clear()
+}
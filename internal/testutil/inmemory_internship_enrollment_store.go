@@ -75,6 +75,13 @@ func internshipEnrollmentFilterFn(ctx context.Context, e *internshipenrollment.I
 		}
 	}
 
+	// Filter by internship batch ID
+	if filter_.InternshipBatchID != nil {
+		if e.InternshipBatchID != *filter_.InternshipBatchID {
+			return false
+		}
+	}
+
 	// Filter by status - if no status is specified, only show active enrollments
 	if filter_.GetStatus() != "" {
 		if string(e.Status) != filter_.GetStatus() {
@@ -282,6 +289,29 @@ func (s *InMemoryInternshipEnrollmentStore) GetByIdempotencyKey(ctx context.Cont
 		Mark(ierr.ErrNotFound)
 }
 
+func (s *InMemoryInternshipEnrollmentStore) CountByInternship(ctx context.Context, internshipIDs []string, statuses []types.InternshipEnrollmentStatus) (map[string]int, error) {
+	filter := types.NewNoLimitInternshipEnrollmentFilter()
+	filter.InternshipIDs = internshipIDs
+
+	enrollments, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	if len(internshipIDs) == 0 {
+		return counts, nil
+	}
+
+	for _, e := range enrollments {
+		if lo.Contains(statuses, e.EnrollmentStatus) {
+			counts[e.InternshipID]++
+		}
+	}
+
+	return counts, nil
+}
+
 // Clear clears the internship enrollment store
 func (s *InMemoryInternshipEnrollmentStore) Clear() {
 	s.InMemoryStore.Clear()
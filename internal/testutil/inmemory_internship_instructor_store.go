@@ -0,0 +1,236 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryInternshipInstructorStore implements internship.InternshipInstructorRepository
+type InMemoryInternshipInstructorStore struct {
+	*InMemoryStore[*internship.InternshipInstructor]
+}
+
+// NewInMemoryInternshipInstructorStore creates a new in-memory internship instructor store
+func NewInMemoryInternshipInstructorStore() *InMemoryInternshipInstructorStore {
+	return &InMemoryInternshipInstructorStore{
+		InMemoryStore: NewInMemoryStore[*internship.InternshipInstructor](),
+	}
+}
+
+// internshipInstructorFilterFn implements filtering logic for internship instructors
+func internshipInstructorFilterFn(ctx context.Context, i *internship.InternshipInstructor, filter interface{}) bool {
+	if i == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.InternshipInstructorFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, i.InternshipID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, i.UserID) {
+		return false
+	}
+
+	if len(filter_.Roles) > 0 && !lo.Contains(filter_.Roles, i.Role) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted instructors
+	if filter_.GetStatus() != "" {
+		if string(i.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if i.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && i.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && i.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// internshipInstructorSortFn implements sorting logic for internship instructors
+func internshipInstructorSortFn(i, j *internship.InternshipInstructor) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryInternshipInstructorStore) Create(ctx context.Context, i *internship.InternshipInstructor) error {
+	if i == nil {
+		return ierr.NewError("internship instructor cannot be nil").
+			WithHint("Internship instructor data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetByInternshipAndUser(ctx, i.InternshipID, i.UserID); err == nil {
+		return ierr.NewError("internship instructor already exists").
+			WithHint("The user is already an instructor of this internship").
+			WithReportableDetails(map[string]any{
+				"internship_id": i.InternshipID,
+				"user_id":       i.UserID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if i.CreatedAt.IsZero() {
+		i.CreatedAt = now
+	}
+	if i.UpdatedAt.IsZero() {
+		i.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, i.ID, i)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("An internship instructor with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"instructor_id": i.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship instructor").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipInstructorStore) Get(ctx context.Context, id string) (*internship.InternshipInstructor, error) {
+	instructor, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || instructor.Status == types.StatusDeleted {
+		return nil, ierr.NewError("internship instructor not found").
+			WithHintf("Internship instructor with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"instructor_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return instructor, nil
+}
+
+func (s *InMemoryInternshipInstructorStore) GetByInternshipAndUser(ctx context.Context, internshipID string, userID string) (*internship.InternshipInstructor, error) {
+	filter := types.NewNoLimitInternshipInstructorFilter()
+	filter.InternshipIDs = []string{internshipID}
+	filter.UserIDs = []string{userID}
+
+	instructors, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instructors) == 0 {
+		return nil, ierr.NewError("internship instructor not found").
+			WithHintf("User %s is not an instructor of internship %s", userID, internshipID).
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+				"user_id":       userID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return instructors[0], nil
+}
+
+func (s *InMemoryInternshipInstructorStore) Update(ctx context.Context, i *internship.InternshipInstructor) error {
+	if i == nil {
+		return ierr.NewError("internship instructor cannot be nil").
+			WithHint("Internship instructor data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	i.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, i.ID, i)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Internship instructor with ID %s was not found", i.ID).
+				WithReportableDetails(map[string]any{
+					"instructor_id": i.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update internship instructor with ID %s", i.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipInstructorStore) Delete(ctx context.Context, id string) error {
+	i, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	i.Status = types.StatusDeleted
+	i.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, i)
+}
+
+func (s *InMemoryInternshipInstructorStore) Count(ctx context.Context, filter *types.InternshipInstructorFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, internshipInstructorFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship instructors").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryInternshipInstructorStore) List(ctx context.Context, filter *types.InternshipInstructorFilter) ([]*internship.InternshipInstructor, error) {
+	instructors, err := s.InMemoryStore.List(ctx, filter, internshipInstructorFilterFn, internshipInstructorSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship instructors").
+			Mark(ierr.ErrDatabase)
+	}
+	return instructors, nil
+}
+
+func (s *InMemoryInternshipInstructorStore) ListAll(ctx context.Context, filter *types.InternshipInstructorFilter) ([]*internship.InternshipInstructor, error) {
+	if filter == nil {
+		filter = types.NewNoLimitInternshipInstructorFilter()
+	}
+
+	unlimitedFilter := &types.InternshipInstructorFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		UserIDs:         filter.UserIDs,
+		Roles:           filter.Roles,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the internship instructor store
+func (s *InMemoryInternshipInstructorStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,245 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/internshipreview"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryInternshipReviewStore implements internshipreview.Repository
+type InMemoryInternshipReviewStore struct {
+	*InMemoryStore[*internshipreview.InternshipReview]
+}
+
+// NewInMemoryInternshipReviewStore creates a new in-memory internship review store
+func NewInMemoryInternshipReviewStore() *InMemoryInternshipReviewStore {
+	return &InMemoryInternshipReviewStore{
+		InMemoryStore: NewInMemoryStore[*internshipreview.InternshipReview](),
+	}
+}
+
+// internshipReviewFilterFn implements filtering logic for internship reviews
+func internshipReviewFilterFn(ctx context.Context, m *internshipreview.InternshipReview, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.InternshipReviewFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if filter_.ReviewStatus != "" && m.ReviewStatus != filter_.ReviewStatus {
+		return false
+	}
+
+	if filter_.MinRating > 0 && m.Rating < filter_.MinRating {
+		return false
+	}
+
+	if filter_.Reported && m.ReportCount == 0 {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted internship reviews
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// internshipReviewSortFn implements sorting logic for internship reviews
+func internshipReviewSortFn(i, j *internshipreview.InternshipReview) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryInternshipReviewStore) Create(ctx context.Context, m *internshipreview.InternshipReview) error {
+	if m == nil {
+		return ierr.NewError("internship review cannot be nil").
+			WithHint("Review data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetByInternshipAndUser(ctx, m.InternshipID, m.UserID); err == nil {
+		return ierr.NewError("internship review already exists").
+			WithHint("You already reviewed this internship").
+			WithReportableDetails(map[string]any{
+				"internship_id": m.InternshipID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A review with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"review_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship review").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipReviewStore) Get(ctx context.Context, id string) (*internshipreview.InternshipReview, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("internship review not found").
+			WithHintf("Review with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"review_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryInternshipReviewStore) GetByInternshipAndUser(ctx context.Context, internshipID string, userID string) (*internshipreview.InternshipReview, error) {
+	reviews, err := s.InMemoryStore.List(ctx, nil, internshipReviewFilterFn, internshipReviewSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get internship review").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, r := range reviews {
+		if r.InternshipID == internshipID && r.UserID == userID && r.Status != types.StatusDeleted {
+			return r, nil
+		}
+	}
+
+	return nil, ierr.NewError("internship review not found").
+		WithHint("No review of this internship was found").
+		WithReportableDetails(map[string]any{
+			"internship_id": internshipID,
+			"user_id":       userID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemoryInternshipReviewStore) Update(ctx context.Context, m *internshipreview.InternshipReview) error {
+	if m == nil {
+		return ierr.NewError("internship review cannot be nil").
+			WithHint("Review data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Review with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"review_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update internship review with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipReviewStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryInternshipReviewStore) Count(ctx context.Context, filter *types.InternshipReviewFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, internshipReviewFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship reviews").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryInternshipReviewStore) List(ctx context.Context, filter *types.InternshipReviewFilter) ([]*internshipreview.InternshipReview, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, internshipReviewFilterFn, internshipReviewSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship reviews").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryInternshipReviewStore) ListAll(ctx context.Context, filter *types.InternshipReviewFilter) ([]*internshipreview.InternshipReview, error) {
+	if filter == nil {
+		filter = types.NewNoLimitInternshipReviewFilter()
+	}
+
+	unlimitedFilter := &types.InternshipReviewFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		UserIDs:         filter.UserIDs,
+		ReviewStatus:    filter.ReviewStatus,
+		MinRating:       filter.MinRating,
+		Reported:        filter.Reported,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the internship review store
+func (s *InMemoryInternshipReviewStore) Clear() {
+	s.InMemoryStore.Clear()
+}
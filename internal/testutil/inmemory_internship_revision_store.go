@@ -0,0 +1,164 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/internship"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InMemoryInternshipRevisionStore implements internship.InternshipRevisionRepository
+type InMemoryInternshipRevisionStore struct {
+	*InMemoryStore[*internship.InternshipRevision]
+}
+
+// NewInMemoryInternshipRevisionStore creates a new in-memory internship revision store
+func NewInMemoryInternshipRevisionStore() *InMemoryInternshipRevisionStore {
+	return &InMemoryInternshipRevisionStore{
+		InMemoryStore: NewInMemoryStore[*internship.InternshipRevision](),
+	}
+}
+
+// internshipRevisionFilterFn implements filtering logic for internship revisions
+func internshipRevisionFilterFn(ctx context.Context, revision *internship.InternshipRevision, filter interface{}) bool {
+	if revision == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.InternshipRevisionFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if filter_.InternshipID != "" && revision.InternshipID != filter_.InternshipID {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted revisions
+	if filter_.GetStatus() != "" {
+		if string(revision.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if revision.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && revision.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && revision.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// internshipRevisionSortFn sorts revisions newest first
+func internshipRevisionSortFn(i, j *internship.InternshipRevision) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.Revision > j.Revision
+}
+
+func (s *InMemoryInternshipRevisionStore) Create(ctx context.Context, revision *internship.InternshipRevision) error {
+	if revision == nil {
+		return ierr.NewError("internship revision cannot be nil").
+			WithHint("Internship revision data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	latest, err := s.GetLatest(ctx, revision.InternshipID)
+	if err == nil && latest.Revision >= revision.Revision {
+		return ierr.NewError("internship revision already exists").
+			WithHint("The internship was changed concurrently, please retry").
+			WithReportableDetails(map[string]any{
+				"internship_id": revision.InternshipID,
+				"revision":      revision.Revision,
+			}).
+			Mark(ierr.ErrVersionConflict)
+	}
+
+	now := time.Now().UTC()
+	if revision.CreatedAt.IsZero() {
+		revision.CreatedAt = now
+	}
+	if revision.UpdatedAt.IsZero() {
+		revision.UpdatedAt = now
+	}
+
+	err = s.InMemoryStore.Create(ctx, revision.ID, revision)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("An internship revision with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"revision_id": revision.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create internship revision").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryInternshipRevisionStore) Get(ctx context.Context, id string) (*internship.InternshipRevision, error) {
+	revision, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || revision.Status == types.StatusDeleted {
+		return nil, ierr.NewError("internship revision not found").
+			WithHintf("Internship revision with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"revision_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return revision, nil
+}
+
+func (s *InMemoryInternshipRevisionStore) GetLatest(ctx context.Context, internshipID string) (*internship.InternshipRevision, error) {
+	filter := types.NewNoLimitInternshipRevisionFilter()
+	filter.InternshipID = internshipID
+
+	revisions, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revisions) == 0 {
+		return nil, ierr.NewError("internship revision not found").
+			WithHintf("Internship %s has no revisions", internshipID).
+			WithReportableDetails(map[string]any{
+				"internship_id": internshipID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return revisions[0], nil
+}
+
+func (s *InMemoryInternshipRevisionStore) Count(ctx context.Context, filter *types.InternshipRevisionFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, internshipRevisionFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship revisions").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryInternshipRevisionStore) List(ctx context.Context, filter *types.InternshipRevisionFilter) ([]*internship.InternshipRevision, error) {
+	revisions, err := s.InMemoryStore.List(ctx, filter, internshipRevisionFilterFn, internshipRevisionSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list internship revisions").
+			Mark(ierr.ErrDatabase)
+	}
+	return revisions, nil
+}
@@ -66,7 +66,7 @@ func internshipFilterFn(ctx context.Context, i *internship.Internship, filter in
 	if len(filter_.CategoryIDs) > 0 {
 		hasCategory := false
 		for _, category := range i.Categories {
-			if lo.Contains(filter_.CategoryIDs, category.ID) {
+			if inCategories(category, filter_.CategoryIDs) {
 				hasCategory = true
 				break
 			}
@@ -91,6 +91,23 @@ func internshipFilterFn(ctx context.Context, i *internship.Internship, filter in
 		return false
 	}
 
+	// Filter by editorial workflow
+	if len(filter_.PublishStatuses) > 0 && !lo.Contains(filter_.PublishStatuses, i.PublishStatus) {
+		return false
+	}
+	if len(filter_.EditorialStatuses) > 0 &&
+		!lo.Contains(filter_.EditorialStatuses, i.PublishStatus) &&
+		(i.DraftStatus == nil || !lo.Contains(filter_.EditorialStatuses, *i.DraftStatus)) {
+		return false
+	}
+	if filter_.CreatedBy != "" && i.CreatedBy != filter_.CreatedBy {
+		return false
+	}
+	// instructor assignments live in their own store, only authorship is matched here
+	if filter_.InstructorID != "" && i.CreatedBy != filter_.InstructorID {
+		return false
+	}
+
 	// Filter by status - if no status is specified, only show active internships
 	if filter_.GetStatus() != "" {
 		if string(i.Status) != filter_.GetStatus() {
@@ -222,6 +239,24 @@ func (s *InMemoryInternshipStore) Update(ctx context.Context, i *internship.Inte
 	return nil
 }
 
+func (s *InMemoryInternshipStore) UpdateRating(ctx context.Context, id string, average float64, count int) error {
+	i, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// ratings are a cache, updating them doesn't count as an edit of the internship
+	i.RatingAverage = average
+	i.RatingCount = count
+
+	if err := s.InMemoryStore.Update(ctx, i.ID, i); err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to update rating of internship with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
 func (s *InMemoryInternshipStore) Delete(ctx context.Context, id string) error {
 	// Get the internship first
 	i, err := s.Get(ctx, id)
@@ -283,16 +318,20 @@ func (s *InMemoryInternshipStore) ListAll(ctx context.Context, filter *types.Int
 
 	// Create an unlimited filter
 	unlimitedFilter := &types.InternshipFilter{
-		QueryFilter:     types.NewNoLimitQueryFilter(),
-		TimeRangeFilter: filter.TimeRangeFilter,
-		Name:            filter.Name,
-		CategoryIDs:     filter.CategoryIDs,
-		Levels:          filter.Levels,
-		Modes:           filter.Modes,
-		InternshipIDs:   filter.InternshipIDs,
-		DurationInWeeks: filter.DurationInWeeks,
-		MaxPrice:        filter.MaxPrice,
-		MinPrice:        filter.MinPrice,
+		QueryFilter:       types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:   filter.TimeRangeFilter,
+		Name:              filter.Name,
+		CategoryIDs:       filter.CategoryIDs,
+		Levels:            filter.Levels,
+		Modes:             filter.Modes,
+		InternshipIDs:     filter.InternshipIDs,
+		DurationInWeeks:   filter.DurationInWeeks,
+		MaxPrice:          filter.MaxPrice,
+		MinPrice:          filter.MinPrice,
+		PublishStatuses:   filter.PublishStatuses,
+		EditorialStatuses: filter.EditorialStatuses,
+		CreatedBy:         filter.CreatedBy,
+		InstructorID:      filter.InstructorID,
 	}
 
 	return s.List(ctx, unlimitedFilter)
@@ -302,3 +341,131 @@ func (s *InMemoryInternshipStore) ListAll(ctx context.Context, filter *types.Int
 func (s *InMemoryInternshipStore) Clear() {
 	s.InMemoryStore.Clear()
 }
+
+// internshipSearchFn matches internships against a search filter, every query word
+// has to prefix a word of the title, skills, description or learning outcomes
+func internshipSearchFn(ctx context.Context, i *internship.Internship, filter interface{}) bool {
+	if i == nil || i.Status == types.StatusDeleted {
+		return false
+	}
+
+	filter_, ok := filter.(*types.InternshipSearchFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.PublishStatuses) > 0 && !lo.Contains(filter_.PublishStatuses, i.PublishStatus) {
+		return false
+	}
+
+	if filter_.Query != "" {
+		fields := append([]string{i.Title, i.Description}, i.Skills...)
+		fields = append(fields, i.LearningOutcomes...)
+		document := strings.Fields(strings.ToLower(strings.Join(fields, " ")))
+		for _, term := range strings.Fields(strings.ToLower(filter_.Query)) {
+			if !lo.SomeBy(document, func(word string) bool { return strings.HasPrefix(word, term) }) {
+				return false
+			}
+		}
+	}
+
+	if len(filter_.CategoryIDs) > 0 && !lo.SomeBy(i.Categories, func(c *internship.Category) bool {
+		return inCategories(c, filter_.CategoryIDs)
+	}) {
+		return false
+	}
+
+	if len(filter_.Levels) > 0 && !lo.Contains(filter_.Levels, i.Level) {
+		return false
+	}
+
+	if len(filter_.Modes) > 0 && !lo.Contains(filter_.Modes, i.Mode) {
+		return false
+	}
+
+	if len(filter_.DurationBuckets) > 0 && !lo.Contains(filter_.DurationBuckets, types.DurationBucketFor(i.DurationInWeeks)) {
+		return false
+	}
+
+	if len(filter_.PriceBuckets) > 0 && !lo.Contains(filter_.PriceBuckets, types.PriceBucketFor(i.Total)) {
+		return false
+	}
+
+	return true
+}
+
+func (s *InMemoryInternshipStore) Search(ctx context.Context, filter *types.InternshipSearchFilter) ([]*internship.Internship, error) {
+	internships, err := s.InMemoryStore.List(ctx, filter, internshipSearchFn, internshipSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to search internships").
+			Mark(ierr.ErrDatabase)
+	}
+	return internships, nil
+}
+
+func (s *InMemoryInternshipStore) SearchCount(ctx context.Context, filter *types.InternshipSearchFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, internshipSearchFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count internship search results").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryInternshipStore) SearchFacets(ctx context.Context, filter *types.InternshipSearchFilter) (*types.InternshipSearchFacets, error) {
+	matching := func(facet string) []*internship.Internship {
+		without := filter.Without(facet)
+		without.QueryFilter = types.NewNoLimitQueryFilter()
+		internships, _ := s.InMemoryStore.List(ctx, without, internshipSearchFn, nil)
+		return internships
+	}
+
+	count := func(internships []*internship.Internship, key func(i *internship.Internship) string) map[string]int {
+		counts := make(map[string]int)
+		for _, i := range internships {
+			counts[key(i)]++
+		}
+		return counts
+	}
+
+	facetOf := func(values []string, counts map[string]int) []types.FacetCount {
+		return lo.Map(values, func(value string, _ int) types.FacetCount {
+			return types.FacetCount{Value: value, Count: counts[value]}
+		})
+	}
+
+	categories := make(map[string]*types.FacetCount)
+	for _, i := range matching(types.InternshipFacetCategory) {
+		for _, c := range i.Categories {
+			if _, ok := categories[c.ID]; !ok {
+				categories[c.ID] = &types.FacetCount{Value: c.ID, Label: c.Name}
+			}
+			categories[c.ID].Count++
+		}
+	}
+
+	return &types.InternshipSearchFacets{
+		Categories: lo.Map(lo.Values(categories), func(c *types.FacetCount, _ int) types.FacetCount { return *c }),
+		Levels: facetOf(lo.Map(types.InternshipLevels, func(l types.InternshipLevel, _ int) string { return string(l) }),
+			count(matching(types.InternshipFacetLevel), func(i *internship.Internship) string { return string(i.Level) })),
+		Modes: facetOf(lo.Map(types.InternshipModes, func(m types.InternshipMode, _ int) string { return string(m) }),
+			count(matching(types.InternshipFacetMode), func(i *internship.Internship) string { return string(i.Mode) })),
+		Durations: facetOf(lo.Map(types.InternshipDurationBuckets, func(b types.InternshipDurationBucket, _ int) string { return string(b) }),
+			count(matching(types.InternshipFacetDuration), func(i *internship.Internship) string {
+				return string(types.DurationBucketFor(i.DurationInWeeks))
+			})),
+		Prices: facetOf(lo.Map(types.InternshipPriceBuckets, func(b types.InternshipPriceBucket, _ int) string { return string(b) }),
+			count(matching(types.InternshipFacetPrice), func(i *internship.Internship) string {
+				return string(types.PriceBucketFor(i.Total))
+			})),
+	}, nil
+}
+
+// inCategories reports whether a category is one of the given categories or a descendant of one
+func inCategories(c *internship.Category, ids []string) bool {
+	return lo.SomeBy(ids, func(id string) bool {
+		return c.ID == id || c.IsDescendantOf(id)
+	})
+}
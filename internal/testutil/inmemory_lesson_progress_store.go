@@ -0,0 +1,228 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryLessonProgressStore implements content.LessonProgressRepository
+type InMemoryLessonProgressStore struct {
+	*InMemoryStore[*content.LessonProgress]
+}
+
+// NewInMemoryLessonProgressStore creates a new in-memory lesson progress store
+func NewInMemoryLessonProgressStore() *InMemoryLessonProgressStore {
+	return &InMemoryLessonProgressStore{
+		InMemoryStore: NewInMemoryStore[*content.LessonProgress](),
+	}
+}
+
+// lessonProgressFilterFn implements filtering logic for lesson progress
+func lessonProgressFilterFn(ctx context.Context, m *content.LessonProgress, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.LessonProgressFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.LessonIDs) > 0 && !lo.Contains(filter_.LessonIDs, m.LessonID) {
+		return false
+	}
+
+	if filter_.Completed != nil && m.IsCompleted() != *filter_.Completed {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted lesson progress
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lessonProgressSortFn implements sorting logic for lesson progress
+func lessonProgressSortFn(i, j *content.LessonProgress) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryLessonProgressStore) Create(ctx context.Context, m *content.LessonProgress) error {
+	if m == nil {
+		return ierr.NewError("lesson progress cannot be nil").
+			WithHint("Lesson progress data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetByUserAndLesson(ctx, m.UserID, m.LessonID); err == nil {
+		return ierr.NewError("lesson progress already exists").
+			WithHint("Progress for this lesson is already recorded").
+			WithReportableDetails(map[string]any{
+				"user_id":   m.UserID,
+				"lesson_id": m.LessonID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A lesson progress with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"progress_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create lesson progress").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryLessonProgressStore) Get(ctx context.Context, id string) (*content.LessonProgress, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("lesson progress not found").
+			WithHintf("Lesson progress with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"progress_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryLessonProgressStore) GetByUserAndLesson(ctx context.Context, userID string, lessonID string) (*content.LessonProgress, error) {
+	filter := types.NewNoLimitLessonProgressFilter()
+	filter.UserIDs = []string{userID}
+	filter.LessonIDs = []string{lessonID}
+
+	records, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, ierr.NewError("lesson progress not found").
+			WithHintf("User %s has no progress in lesson %s", userID, lessonID).
+			WithReportableDetails(map[string]any{
+				"user_id":   userID,
+				"lesson_id": lessonID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return records[0], nil
+}
+
+func (s *InMemoryLessonProgressStore) Update(ctx context.Context, m *content.LessonProgress) error {
+	if m == nil {
+		return ierr.NewError("lesson progress cannot be nil").
+			WithHint("Lesson progress data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Lesson progress with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"progress_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update lesson progress with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryLessonProgressStore) Count(ctx context.Context, filter *types.LessonProgressFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, lessonProgressFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count lesson progress").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryLessonProgressStore) List(ctx context.Context, filter *types.LessonProgressFilter) ([]*content.LessonProgress, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, lessonProgressFilterFn, lessonProgressSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list lesson progress").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryLessonProgressStore) ListAll(ctx context.Context, filter *types.LessonProgressFilter) ([]*content.LessonProgress, error) {
+	if filter == nil {
+		filter = types.NewNoLimitLessonProgressFilter()
+	}
+
+	unlimitedFilter := &types.LessonProgressFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		UserIDs:         filter.UserIDs,
+		InternshipIDs:   filter.InternshipIDs,
+		LessonIDs:       filter.LessonIDs,
+		Completed:       filter.Completed,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the lesson progress store
+func (s *InMemoryLessonProgressStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,211 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryLessonStore implements content.LessonRepository
+type InMemoryLessonStore struct {
+	*InMemoryStore[*content.Lesson]
+}
+
+// NewInMemoryLessonStore creates a new in-memory lesson store
+func NewInMemoryLessonStore() *InMemoryLessonStore {
+	return &InMemoryLessonStore{
+		InMemoryStore: NewInMemoryStore[*content.Lesson](),
+	}
+}
+
+// lessonFilterFn implements filtering logic for lessons
+func lessonFilterFn(ctx context.Context, m *content.Lesson, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.LessonFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.ModuleIDs) > 0 && !lo.Contains(filter_.ModuleIDs, m.ModuleID) {
+		return false
+	}
+
+	if len(filter_.LessonTypes) > 0 && !lo.Contains(filter_.LessonTypes, m.LessonType) {
+		return false
+	}
+
+	if filter_.IsPreview != nil && m.IsPreview != *filter_.IsPreview {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted lessons
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lessonSortFn implements sorting logic for lessons
+func lessonSortFn(i, j *content.Lesson) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	if i.SortOrder != j.SortOrder {
+		return i.SortOrder < j.SortOrder
+	}
+	return i.CreatedAt.Before(j.CreatedAt)
+}
+
+func (s *InMemoryLessonStore) Create(ctx context.Context, m *content.Lesson) error {
+	if m == nil {
+		return ierr.NewError("lesson cannot be nil").
+			WithHint("Lesson data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A lesson with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"lesson_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create lesson").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryLessonStore) Get(ctx context.Context, id string) (*content.Lesson, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("lesson not found").
+			WithHintf("Lesson with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"lesson_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryLessonStore) Update(ctx context.Context, m *content.Lesson) error {
+	if m == nil {
+		return ierr.NewError("lesson cannot be nil").
+			WithHint("Lesson data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Lesson with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"lesson_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update lesson with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryLessonStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryLessonStore) Count(ctx context.Context, filter *types.LessonFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, lessonFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count lessons").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryLessonStore) List(ctx context.Context, filter *types.LessonFilter) ([]*content.Lesson, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, lessonFilterFn, lessonSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list lessons").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryLessonStore) ListAll(ctx context.Context, filter *types.LessonFilter) ([]*content.Lesson, error) {
+	if filter == nil {
+		filter = types.NewNoLimitLessonFilter()
+	}
+
+	unlimitedFilter := &types.LessonFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		ModuleIDs:       filter.ModuleIDs,
+		LessonTypes:     filter.LessonTypes,
+		IsPreview:       filter.IsPreview,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the lesson store
+func (s *InMemoryLessonStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,223 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryLiveSessionStore implements livesession.Repository
+type InMemoryLiveSessionStore struct {
+	*InMemoryStore[*livesession.LiveSession]
+}
+
+// NewInMemoryLiveSessionStore creates a new in-memory live session store
+func NewInMemoryLiveSessionStore() *InMemoryLiveSessionStore {
+	return &InMemoryLiveSessionStore{
+		InMemoryStore: NewInMemoryStore[*livesession.LiveSession](),
+	}
+}
+
+// liveSessionFilterFn implements filtering logic for live sessions
+func liveSessionFilterFn(ctx context.Context, m *livesession.LiveSession, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.LiveSessionFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.InternshipBatchIDs) > 0 && !lo.Contains(filter_.InternshipBatchIDs, m.InternshipBatchID) {
+		return false
+	}
+
+	if filter_.SessionStatus != "" && m.SessionStatus != filter_.SessionStatus {
+		return false
+	}
+
+	if filter_.StartsAfter != nil && m.StartsAt.Before(*filter_.StartsAfter) {
+		return false
+	}
+
+	if filter_.StartsBefore != nil && !m.StartsAt.Before(*filter_.StartsBefore) {
+		return false
+	}
+
+	if filter_.EndsBefore != nil && m.EndsAt.After(*filter_.EndsBefore) {
+		return false
+	}
+
+	if filter_.EndsAfter != nil && !m.EndsAt.After(*filter_.EndsAfter) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted live sessions
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// liveSessionSortFn implements sorting logic for live sessions
+func liveSessionSortFn(i, j *livesession.LiveSession) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.StartsAt.Before(j.StartsAt)
+}
+
+func (s *InMemoryLiveSessionStore) Create(ctx context.Context, m *livesession.LiveSession) error {
+	if m == nil {
+		return ierr.NewError("live session cannot be nil").
+			WithHint("Live session data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A live session with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"live_session_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create live session").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryLiveSessionStore) Get(ctx context.Context, id string) (*livesession.LiveSession, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("live session not found").
+			WithHintf("Live session with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"live_session_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryLiveSessionStore) Update(ctx context.Context, m *livesession.LiveSession) error {
+	if m == nil {
+		return ierr.NewError("live session cannot be nil").
+			WithHint("Live session data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Live session with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"live_session_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update live session with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryLiveSessionStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryLiveSessionStore) Count(ctx context.Context, filter *types.LiveSessionFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, liveSessionFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count live sessions").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryLiveSessionStore) List(ctx context.Context, filter *types.LiveSessionFilter) ([]*livesession.LiveSession, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, liveSessionFilterFn, liveSessionSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list live sessions").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryLiveSessionStore) ListAll(ctx context.Context, filter *types.LiveSessionFilter) ([]*livesession.LiveSession, error) {
+	if filter == nil {
+		filter = types.NewNoLimitLiveSessionFilter()
+	}
+
+	unlimitedFilter := &types.LiveSessionFilter{
+		QueryFilter:        types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:    filter.TimeRangeFilter,
+		InternshipIDs:      filter.InternshipIDs,
+		InternshipBatchIDs: filter.InternshipBatchIDs,
+		SessionStatus:      filter.SessionStatus,
+		StartsAfter:        filter.StartsAfter,
+		StartsBefore:       filter.StartsBefore,
+		EndsBefore:         filter.EndsBefore,
+		EndsAfter:          filter.EndsAfter,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the live session store
+func (s *InMemoryLiveSessionStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,196 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryModuleStore implements content.ModuleRepository
+type InMemoryModuleStore struct {
+	*InMemoryStore[*content.Module]
+}
+
+// NewInMemoryModuleStore creates a new in-memory module store
+func NewInMemoryModuleStore() *InMemoryModuleStore {
+	return &InMemoryModuleStore{
+		InMemoryStore: NewInMemoryStore[*content.Module](),
+	}
+}
+
+// moduleFilterFn implements filtering logic for modules
+func moduleFilterFn(ctx context.Context, m *content.Module, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.ModuleFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted modules
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// moduleSortFn implements sorting logic for modules
+func moduleSortFn(i, j *content.Module) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	if i.SortOrder != j.SortOrder {
+		return i.SortOrder < j.SortOrder
+	}
+	return i.CreatedAt.Before(j.CreatedAt)
+}
+
+func (s *InMemoryModuleStore) Create(ctx context.Context, m *content.Module) error {
+	if m == nil {
+		return ierr.NewError("module cannot be nil").
+			WithHint("Module data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A module with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"module_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create module").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryModuleStore) Get(ctx context.Context, id string) (*content.Module, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("module not found").
+			WithHintf("Module with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"module_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryModuleStore) Update(ctx context.Context, m *content.Module) error {
+	if m == nil {
+		return ierr.NewError("module cannot be nil").
+			WithHint("Module data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Module with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"module_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update module with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryModuleStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryModuleStore) Count(ctx context.Context, filter *types.ModuleFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, moduleFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count modules").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryModuleStore) List(ctx context.Context, filter *types.ModuleFilter) ([]*content.Module, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, moduleFilterFn, moduleSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list modules").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryModuleStore) ListAll(ctx context.Context, filter *types.ModuleFilter) ([]*content.Module, error) {
+	if filter == nil {
+		filter = types.NewNoLimitModuleFilter()
+	}
+
+	unlimitedFilter := &types.ModuleFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the module store
+func (s *InMemoryModuleStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,236 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/notification"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryNotificationStore implements notification.Repository
+type InMemoryNotificationStore struct {
+	*InMemoryStore[*notification.Notification]
+}
+
+// NewInMemoryNotificationStore creates a new in-memory notification store
+func NewInMemoryNotificationStore() *InMemoryNotificationStore {
+	return &InMemoryNotificationStore{
+		InMemoryStore: NewInMemoryStore[*notification.Notification](),
+	}
+}
+
+// notificationFilterFn implements filtering logic for notifications
+func notificationFilterFn(ctx context.Context, n *notification.Notification, filter interface{}) bool {
+	if n == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.NotificationFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, n.UserID) {
+		return false
+	}
+
+	if len(filter_.Categories) > 0 && !lo.Contains(filter_.Categories, n.Category) {
+		return false
+	}
+
+	if filter_.Unread && n.IsRead() {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted notifications
+	if filter_.GetStatus() != "" {
+		if string(n.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if n.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && n.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && n.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// notificationSortFn implements sorting logic for notifications
+func notificationSortFn(i, j *notification.Notification) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryNotificationStore) Create(ctx context.Context, n *notification.Notification) error {
+	if n == nil {
+		return ierr.NewError("notification cannot be nil").
+			WithHint("Notification data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	items, err := s.InMemoryStore.List(ctx, nil, notificationFilterFn, notificationSortFn)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create notification").
+			Mark(ierr.ErrDatabase)
+	}
+	if lo.SomeBy(items, func(item *notification.Notification) bool {
+		return item.UserID == n.UserID && item.EventID == n.EventID
+	}) {
+		return ierr.NewError("notification already exists").
+			WithHint("The user was already notified of this event").
+			WithReportableDetails(map[string]any{
+				"user_id":  n.UserID,
+				"event_id": n.EventID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = now
+	}
+	if n.UpdatedAt.IsZero() {
+		n.UpdatedAt = now
+	}
+
+	err = s.InMemoryStore.Create(ctx, n.ID, n)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A notification with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"notification_id": n.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create notification").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryNotificationStore) Get(ctx context.Context, id string) (*notification.Notification, error) {
+	n, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || n.Status == types.StatusDeleted {
+		return nil, ierr.NewError("notification not found").
+			WithHintf("Notification with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"notification_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return n, nil
+}
+
+func (s *InMemoryNotificationStore) Update(ctx context.Context, n *notification.Notification) error {
+	if n == nil {
+		return ierr.NewError("notification cannot be nil").
+			WithHint("Notification data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	n.UpdatedAt = time.Now().UTC()
+
+	if err := s.InMemoryStore.Update(ctx, n.ID, n); err != nil {
+		return ierr.WithError(err).
+			WithHintf("Notification with ID %s was not found", n.ID).
+			Mark(ierr.ErrNotFound)
+	}
+	return nil
+}
+
+func (s *InMemoryNotificationStore) Delete(ctx context.Context, id string) error {
+	n, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	n.Status = types.StatusDeleted
+	n.UpdatedAt = time.Now().UTC()
+
+	if err := s.InMemoryStore.Update(ctx, n.ID, n); err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to delete notification with ID %s", n.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryNotificationStore) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	filter := types.NewNoLimitNotificationFilter()
+	filter.UserIDs = []string{userID}
+	filter.Unread = true
+
+	items, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+	for _, n := range items {
+		n.ReadAt = lo.ToPtr(now)
+		if err := s.Update(ctx, n); err != nil {
+			return 0, err
+		}
+	}
+	return len(items), nil
+}
+
+func (s *InMemoryNotificationStore) Count(ctx context.Context, filter *types.NotificationFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, notificationFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count notifications").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryNotificationStore) List(ctx context.Context, filter *types.NotificationFilter) ([]*notification.Notification, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, notificationFilterFn, notificationSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list notifications").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryNotificationStore) ListAll(ctx context.Context, filter *types.NotificationFilter) ([]*notification.Notification, error) {
+	if filter == nil {
+		filter = types.NewNoLimitNotificationFilter()
+	}
+
+	unlimitedFilter := &types.NotificationFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		UserIDs:         filter.UserIDs,
+		Categories:      filter.Categories,
+		Unread:          filter.Unread,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the notification store
+func (s *InMemoryNotificationStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,187 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/paymentplan"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InMemoryPaymentPlanStore implements paymentplan.Repository
+type InMemoryPaymentPlanStore struct {
+	*InMemoryStore[*paymentplan.PaymentPlan]
+}
+
+// NewInMemoryPaymentPlanStore creates a new in-memory payment plan store
+func NewInMemoryPaymentPlanStore() *InMemoryPaymentPlanStore {
+	return &InMemoryPaymentPlanStore{
+		InMemoryStore: NewInMemoryStore[*paymentplan.PaymentPlan](),
+	}
+}
+
+// paymentPlanFilterFn implements filtering logic for payment plans
+func paymentPlanFilterFn(ctx context.Context, p *paymentplan.PaymentPlan, filter interface{}) bool {
+	if p == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.PaymentPlanFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if filter_.InternshipID != "" && p.InternshipID != filter_.InternshipID {
+		return false
+	}
+
+	if filter_.IsActive != nil && p.IsActive != *filter_.IsActive {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted plans
+	if filter_.GetStatus() != "" {
+		if string(p.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if p.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && p.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && p.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// paymentPlanSortFn implements sorting logic for payment plans
+func paymentPlanSortFn(i, j *paymentplan.PaymentPlan) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryPaymentPlanStore) Create(ctx context.Context, p *paymentplan.PaymentPlan) error {
+	if p == nil {
+		return ierr.NewError("payment plan cannot be nil").
+			WithHint("Payment plan data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = now
+	}
+	if p.UpdatedAt.IsZero() {
+		p.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, p.ID, p)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A payment plan with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"plan_id": p.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create payment plan").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryPaymentPlanStore) Get(ctx context.Context, id string) (*paymentplan.PaymentPlan, error) {
+	p, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || p.Status == types.StatusDeleted {
+		return nil, ierr.NewError("payment plan not found").
+			WithHintf("Payment plan with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"plan_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return p, nil
+}
+
+func (s *InMemoryPaymentPlanStore) Update(ctx context.Context, p *paymentplan.PaymentPlan) error {
+	if p == nil {
+		return ierr.NewError("payment plan cannot be nil").
+			WithHint("Payment plan data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	p.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, p.ID, p)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Payment plan with ID %s was not found", p.ID).
+				WithReportableDetails(map[string]any{
+					"plan_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update payment plan with ID %s", p.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryPaymentPlanStore) Delete(ctx context.Context, id string) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p.Status = types.StatusDeleted
+	return s.Update(ctx, p)
+}
+
+func (s *InMemoryPaymentPlanStore) Count(ctx context.Context, filter *types.PaymentPlanFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, paymentPlanFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count payment plans").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryPaymentPlanStore) List(ctx context.Context, filter *types.PaymentPlanFilter) ([]*paymentplan.PaymentPlan, error) {
+	plans, err := s.InMemoryStore.List(ctx, filter, paymentPlanFilterFn, paymentPlanSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list payment plans").
+			Mark(ierr.ErrDatabase)
+	}
+	return plans, nil
+}
+
+func (s *InMemoryPaymentPlanStore) ListAll(ctx context.Context, filter *types.PaymentPlanFilter) ([]*paymentplan.PaymentPlan, error) {
+	if filter == nil {
+		filter = types.NewNoLimitPaymentPlanFilter()
+	}
+
+	unlimitedFilter := &types.PaymentPlanFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipID:    filter.InternshipID,
+		IsActive:        filter.IsActive,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
@@ -14,6 +14,8 @@ import (
 type InMemoryPaymentStore struct {
 	*InMemoryStore[*payment.Payment]
 	attempts *InMemoryStore[*payment.PaymentAttempt]
+	// refunds are keyed by payment id, a payment is refunded at most once
+	refunds *InMemoryStore[*payment.Refund]
 }
 
 // NewInMemoryPaymentStore creates a new in-memory payment store
@@ -21,6 +23,7 @@ func NewInMemoryPaymentStore() *InMemoryPaymentStore {
 	return &InMemoryPaymentStore{
 		InMemoryStore: NewInMemoryStore[*payment.Payment](),
 		attempts:      NewInMemoryStore[*payment.PaymentAttempt](),
+		refunds:       NewInMemoryStore[*payment.Refund](),
 	}
 }
 
@@ -77,6 +80,13 @@ func paymentFilterFn(ctx context.Context, p *payment.Payment, filter interface{}
 		}
 	}
 
+	// Filter by installment due date
+	if filter_.DueDateBefore != nil {
+		if p.DueDate == nil || p.DueDate.After(*filter_.DueDateBefore) {
+			return false
+		}
+	}
+
 	// Filter by payment IDs
 	if len(filter_.PaymentIDs) > 0 {
 		if !lo.Contains(filter_.PaymentIDs, p.ID) {
@@ -209,7 +219,7 @@ func (s *InMemoryPaymentStore) Delete(ctx context.Context, id string) error {
 	}
 
 	// Soft delete by setting status to deleted
-	p.Status = string(types.StatusDeleted)
+	p.Status = types.StatusDeleted
 	p.UpdatedAt = time.Now().UTC()
 
 	return s.Update(ctx, p)
@@ -386,8 +396,87 @@ func (s *InMemoryPaymentStore) GetLatestAttempt(ctx context.Context, paymentID s
 	return latestAttempt, nil
 }
 
-// Clear clears both payment and attempt stores
+// Payment Refund methods
+
+func (s *InMemoryPaymentStore) CreateRefund(ctx context.Context, refund *payment.Refund) error {
+	if refund == nil {
+		return ierr.NewError("payment refund cannot be nil").
+			WithHint("Payment refund data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if refund.CreatedAt.IsZero() {
+		refund.CreatedAt = now
+	}
+	if refund.UpdatedAt.IsZero() {
+		refund.UpdatedAt = now
+	}
+
+	err := s.refunds.Create(ctx, refund.PaymentID, refund)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("This payment was already refunded").
+				WithReportableDetails(map[string]any{
+					"payment_id": refund.PaymentID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create payment refund").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryPaymentStore) GetRefundByPaymentID(ctx context.Context, paymentID string) (*payment.Refund, error) {
+	refund, err := s.refunds.Get(ctx, paymentID)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return nil, ierr.WithError(err).
+				WithHintf("Refund of payment %s was not found", paymentID).
+				WithReportableDetails(map[string]any{
+					"payment_id": paymentID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get payment refund").
+			Mark(ierr.ErrDatabase)
+	}
+	return refund, nil
+}
+
+func (s *InMemoryPaymentStore) UpdateRefund(ctx context.Context, refund *payment.Refund) error {
+	if refund == nil {
+		return ierr.NewError("payment refund cannot be nil").
+			WithHint("Payment refund data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	refund.UpdatedAt = time.Now().UTC()
+
+	err := s.refunds.Update(ctx, refund.PaymentID, refund)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Payment refund with ID %s was not found", refund.ID).
+				WithReportableDetails(map[string]any{
+					"payment_refund_id": refund.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update payment refund").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+// Clear clears the payment, attempt and refund stores
 func (s *InMemoryPaymentStore) Clear() {
 	s.InMemoryStore.Clear()
 	s.attempts.Clear()
+	s.refunds.Clear()
 }
@@ -0,0 +1,232 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryQuizAttemptStore implements content.QuizAttemptRepository
+type InMemoryQuizAttemptStore struct {
+	*InMemoryStore[*content.QuizAttempt]
+
+	// ids of closed attempts, the stored attempts are shared with the caller
+	// so their status is changed before Close sees them
+	mu     sync.Mutex
+	closed map[string]bool
+}
+
+// NewInMemoryQuizAttemptStore creates a new in-memory quiz attempt store
+func NewInMemoryQuizAttemptStore() *InMemoryQuizAttemptStore {
+	return &InMemoryQuizAttemptStore{
+		InMemoryStore: NewInMemoryStore[*content.QuizAttempt](),
+		closed:        make(map[string]bool),
+	}
+}
+
+// quizAttemptFilterFn implements filtering logic for quiz attempts
+func quizAttemptFilterFn(ctx context.Context, m *content.QuizAttempt, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.QuizAttemptFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.QuizIDs) > 0 && !lo.Contains(filter_.QuizIDs, m.QuizID) {
+		return false
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.EnrollmentIDs) > 0 && !lo.Contains(filter_.EnrollmentIDs, m.EnrollmentID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if filter_.AttemptStatus != "" && m.AttemptStatus != filter_.AttemptStatus {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted quiz attempts
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// quizAttemptSortFn implements sorting logic for quiz attempts
+func quizAttemptSortFn(i, j *content.QuizAttempt) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryQuizAttemptStore) Create(ctx context.Context, m *content.QuizAttempt) error {
+	if m == nil {
+		return ierr.NewError("quiz attempt cannot be nil").
+			WithHint("Quiz attempt data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A quiz attempt with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"quiz_attempt_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create quiz attempt").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryQuizAttemptStore) Get(ctx context.Context, id string) (*content.QuizAttempt, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("quiz attempt not found").
+			WithHintf("Quiz attempt with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"quiz_attempt_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryQuizAttemptStore) Update(ctx context.Context, m *content.QuizAttempt) error {
+	if m == nil {
+		return ierr.NewError("quiz attempt cannot be nil").
+			WithHint("Quiz attempt data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Quiz attempt with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"quiz_attempt_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update quiz attempt with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryQuizAttemptStore) Close(ctx context.Context, m *content.QuizAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed[m.ID] {
+		return ierr.NewError("quiz attempt is already over").
+			WithHint("This attempt was already submitted or ran out of time").
+			WithReportableDetails(map[string]any{
+				"quiz_attempt_id": m.ID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if err := s.Update(ctx, m); err != nil {
+		return err
+	}
+
+	s.closed[m.ID] = true
+	return nil
+}
+
+func (s *InMemoryQuizAttemptStore) Count(ctx context.Context, filter *types.QuizAttemptFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, quizAttemptFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count quiz attempts").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryQuizAttemptStore) List(ctx context.Context, filter *types.QuizAttemptFilter) ([]*content.QuizAttempt, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, quizAttemptFilterFn, quizAttemptSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list quiz attempts").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryQuizAttemptStore) ListAll(ctx context.Context, filter *types.QuizAttemptFilter) ([]*content.QuizAttempt, error) {
+	if filter == nil {
+		filter = types.NewNoLimitQuizAttemptFilter()
+	}
+
+	unlimitedFilter := &types.QuizAttemptFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		QuizIDs:         filter.QuizIDs,
+		InternshipIDs:   filter.InternshipIDs,
+		EnrollmentIDs:   filter.EnrollmentIDs,
+		UserIDs:         filter.UserIDs,
+		AttemptStatus:   filter.AttemptStatus,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the quiz attempt store
+func (s *InMemoryQuizAttemptStore) Clear() {
+	s.InMemoryStore.Clear()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = make(map[string]bool)
+}
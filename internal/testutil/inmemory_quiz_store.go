@@ -0,0 +1,201 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryQuizStore implements content.QuizRepository
+type InMemoryQuizStore struct {
+	*InMemoryStore[*content.Quiz]
+}
+
+// NewInMemoryQuizStore creates a new in-memory quiz store
+func NewInMemoryQuizStore() *InMemoryQuizStore {
+	return &InMemoryQuizStore{
+		InMemoryStore: NewInMemoryStore[*content.Quiz](),
+	}
+}
+
+// quizFilterFn implements filtering logic for quizzes
+func quizFilterFn(ctx context.Context, m *content.Quiz, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.QuizFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.ModuleIDs) > 0 && !lo.Contains(filter_.ModuleIDs, m.ModuleID) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted quizzes
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// quizSortFn implements sorting logic for quizzes
+func quizSortFn(i, j *content.Quiz) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	if i.SortOrder != j.SortOrder {
+		return i.SortOrder < j.SortOrder
+	}
+	return i.CreatedAt.Before(j.CreatedAt)
+}
+
+func (s *InMemoryQuizStore) Create(ctx context.Context, m *content.Quiz) error {
+	if m == nil {
+		return ierr.NewError("quiz cannot be nil").
+			WithHint("Quiz data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A quiz with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"quiz_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create quiz").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryQuizStore) Get(ctx context.Context, id string) (*content.Quiz, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("quiz not found").
+			WithHintf("Quiz with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"quiz_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryQuizStore) Update(ctx context.Context, m *content.Quiz) error {
+	if m == nil {
+		return ierr.NewError("quiz cannot be nil").
+			WithHint("Quiz data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Quiz with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"quiz_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update quiz with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryQuizStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryQuizStore) Count(ctx context.Context, filter *types.QuizFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, quizFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count quizzes").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryQuizStore) List(ctx context.Context, filter *types.QuizFilter) ([]*content.Quiz, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, quizFilterFn, quizSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list quizzes").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryQuizStore) ListAll(ctx context.Context, filter *types.QuizFilter) ([]*content.Quiz, error) {
+	if filter == nil {
+		filter = types.NewNoLimitQuizFilter()
+	}
+
+	unlimitedFilter := &types.QuizFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		ModuleIDs:       filter.ModuleIDs,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the quiz store
+func (s *InMemoryQuizStore) Clear() {
+	s.InMemoryStore.Clear()
+}
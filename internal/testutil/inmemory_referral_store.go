@@ -0,0 +1,252 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/referral"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryReferralStore implements referral.Repository
+type InMemoryReferralStore struct {
+	*InMemoryStore[*referral.Referral]
+}
+
+// NewInMemoryReferralStore creates a new in-memory referral store
+func NewInMemoryReferralStore() *InMemoryReferralStore {
+	return &InMemoryReferralStore{
+		InMemoryStore: NewInMemoryStore[*referral.Referral](),
+	}
+}
+
+// referralFilterFn implements filtering logic for referrals
+func referralFilterFn(ctx context.Context, r *referral.Referral, filter interface{}) bool {
+	if r == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.ReferralFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if filter_.ReferrerID != "" && r.ReferrerID != filter_.ReferrerID {
+		return false
+	}
+
+	if filter_.RefereeID != "" && r.RefereeID != filter_.RefereeID {
+		return false
+	}
+
+	if filter_.ReferralStatus != "" && r.ReferralStatus != filter_.ReferralStatus {
+		return false
+	}
+
+	if filter_.PaymentID != "" && lo.FromPtr(r.PaymentID) != filter_.PaymentID {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted referrals
+	if filter_.GetStatus() != "" {
+		if string(r.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if r.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && r.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && r.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// referralSortFn implements sorting logic for referrals
+func referralSortFn(i, j *referral.Referral) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryReferralStore) Create(ctx context.Context, r *referral.Referral) error {
+	if r == nil {
+		return ierr.NewError("referral cannot be nil").
+			WithHint("Referral data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetByRefereeID(ctx, r.RefereeID); err == nil {
+		return ierr.NewError("referral already exists").
+			WithHint("User has already been referred").
+			WithReportableDetails(map[string]any{
+				"referee_id": r.RefereeID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	now := time.Now().UTC()
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = now
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, r.ID, r)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A referral with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"referral_id": r.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create referral").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryReferralStore) Get(ctx context.Context, id string) (*referral.Referral, error) {
+	r, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return nil, ierr.WithError(err).
+				WithHintf("Referral with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"referral_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get referral with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+	return r, nil
+}
+
+func (s *InMemoryReferralStore) GetByRefereeID(ctx context.Context, refereeID string) (*referral.Referral, error) {
+	referrals, err := s.InMemoryStore.List(ctx, nil, referralFilterFn, referralSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get referral by referee").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, r := range referrals {
+		if r.RefereeID == refereeID && r.Status != types.StatusDeleted {
+			return r, nil
+		}
+	}
+
+	return nil, ierr.NewError("referral not found").
+		WithHintf("Referral for user %s was not found", refereeID).
+		WithReportableDetails(map[string]any{
+			"referee_id": refereeID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemoryReferralStore) Update(ctx context.Context, r *referral.Referral) error {
+	if r == nil {
+		return ierr.NewError("referral cannot be nil").
+			WithHint("Referral data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	r.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, r.ID, r)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Referral with ID %s was not found", r.ID).
+				WithReportableDetails(map[string]any{
+					"referral_id": r.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update referral with ID %s", r.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryReferralStore) Count(ctx context.Context, filter *types.ReferralFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, referralFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count referrals").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryReferralStore) List(ctx context.Context, filter *types.ReferralFilter) ([]*referral.Referral, error) {
+	referrals, err := s.InMemoryStore.List(ctx, filter, referralFilterFn, referralSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list referrals").
+			Mark(ierr.ErrDatabase)
+	}
+	return referrals, nil
+}
+
+func (s *InMemoryReferralStore) ListAll(ctx context.Context, filter *types.ReferralFilter) ([]*referral.Referral, error) {
+	if filter == nil {
+		filter = types.NewNoLimitReferralFilter()
+	}
+
+	unlimitedFilter := &types.ReferralFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		ReferrerID:      filter.ReferrerID,
+		RefereeID:       filter.RefereeID,
+		ReferralStatus:  filter.ReferralStatus,
+		PaymentID:       filter.PaymentID,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+func (s *InMemoryReferralStore) ExistsForIdentity(ctx context.Context, email string, phone string) (bool, error) {
+	referrals, err := s.InMemoryStore.List(ctx, nil, referralFilterFn, referralSortFn)
+	if err != nil {
+		return false, ierr.WithError(err).
+			WithHint("Failed to check referral identity").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return lo.SomeBy(referrals, func(r *referral.Referral) bool {
+		return r.Status != types.StatusDeleted &&
+			(r.RefereeEmail == email || (phone != "" && r.RefereePhone == phone))
+	}), nil
+}
+
+func (s *InMemoryReferralStore) ListRewardable(ctx context.Context, before time.Time) ([]*referral.Referral, error) {
+	filter := types.NewNoLimitReferralFilter()
+	filter.ReferralStatus = types.ReferralStatusQualified
+
+	referrals, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(referrals, func(r *referral.Referral, _ int) bool {
+		return r.RewardEligibleAt != nil && !r.RewardEligibleAt.After(before)
+	}), nil
+}
@@ -0,0 +1,206 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryResourceStore implements content.ResourceRepository
+type InMemoryResourceStore struct {
+	*InMemoryStore[*content.Resource]
+}
+
+// NewInMemoryResourceStore creates a new in-memory resource store
+func NewInMemoryResourceStore() *InMemoryResourceStore {
+	return &InMemoryResourceStore{
+		InMemoryStore: NewInMemoryStore[*content.Resource](),
+	}
+}
+
+// resourceFilterFn implements filtering logic for resources
+func resourceFilterFn(ctx context.Context, m *content.Resource, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.ResourceFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.ModuleIDs) > 0 && (m.ModuleID == nil || !lo.Contains(filter_.ModuleIDs, *m.ModuleID)) {
+		return false
+	}
+
+	if len(filter_.LessonIDs) > 0 && (m.LessonID == nil || !lo.Contains(filter_.LessonIDs, *m.LessonID)) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted resources
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceSortFn implements sorting logic for resources
+func resourceSortFn(i, j *content.Resource) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	if i.SortOrder != j.SortOrder {
+		return i.SortOrder < j.SortOrder
+	}
+	return i.CreatedAt.Before(j.CreatedAt)
+}
+
+func (s *InMemoryResourceStore) Create(ctx context.Context, m *content.Resource) error {
+	if m == nil {
+		return ierr.NewError("resource cannot be nil").
+			WithHint("Resource data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A resource with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"resource_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create resource").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryResourceStore) Get(ctx context.Context, id string) (*content.Resource, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("resource not found").
+			WithHintf("Resource with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"resource_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemoryResourceStore) Update(ctx context.Context, m *content.Resource) error {
+	if m == nil {
+		return ierr.NewError("resource cannot be nil").
+			WithHint("Resource data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Resource with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"resource_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update resource with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryResourceStore) Delete(ctx context.Context, id string) error {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	return s.Update(ctx, m)
+}
+
+func (s *InMemoryResourceStore) Count(ctx context.Context, filter *types.ResourceFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, resourceFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count resources").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryResourceStore) List(ctx context.Context, filter *types.ResourceFilter) ([]*content.Resource, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, resourceFilterFn, resourceSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list resources").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryResourceStore) ListAll(ctx context.Context, filter *types.ResourceFilter) ([]*content.Resource, error) {
+	if filter == nil {
+		filter = types.NewNoLimitResourceFilter()
+	}
+
+	unlimitedFilter := &types.ResourceFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		ModuleIDs:       filter.ModuleIDs,
+		LessonIDs:       filter.LessonIDs,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the resource store
+func (s *InMemoryResourceStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,223 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/livesession"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySessionAttendanceStore implements livesession.AttendanceRepository
+type InMemorySessionAttendanceStore struct {
+	*InMemoryStore[*livesession.Attendance]
+}
+
+// NewInMemorySessionAttendanceStore creates a new in-memory session attendance store
+func NewInMemorySessionAttendanceStore() *InMemorySessionAttendanceStore {
+	return &InMemorySessionAttendanceStore{
+		InMemoryStore: NewInMemoryStore[*livesession.Attendance](),
+	}
+}
+
+// sessionAttendanceFilterFn implements filtering logic for session attendances
+func sessionAttendanceFilterFn(ctx context.Context, m *livesession.Attendance, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.SessionAttendanceFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.LiveSessionIDs) > 0 && !lo.Contains(filter_.LiveSessionIDs, m.LiveSessionID) {
+		return false
+	}
+
+	if len(filter_.InternshipBatchIDs) > 0 && !lo.Contains(filter_.InternshipBatchIDs, m.InternshipBatchID) {
+		return false
+	}
+
+	if len(filter_.EnrollmentIDs) > 0 && !lo.Contains(filter_.EnrollmentIDs, m.EnrollmentID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if filter_.AttendanceStatus != "" && m.AttendanceStatus != filter_.AttendanceStatus {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted session attendances
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sessionAttendanceSortFn implements sorting logic for session attendances
+func sessionAttendanceSortFn(i, j *livesession.Attendance) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemorySessionAttendanceStore) Create(ctx context.Context, m *livesession.Attendance) error {
+	if m == nil {
+		return ierr.NewError("session attendance cannot be nil").
+			WithHint("Attendance data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("An attendance with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"session_attendance_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create session attendance").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySessionAttendanceStore) Get(ctx context.Context, id string) (*livesession.Attendance, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("session attendance not found").
+			WithHintf("Attendance with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"session_attendance_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemorySessionAttendanceStore) GetBySessionAndEnrollment(ctx context.Context, sessionID string, enrollmentID string) (*livesession.Attendance, error) {
+	attendances, err := s.InMemoryStore.List(ctx, nil, sessionAttendanceFilterFn, sessionAttendanceSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get session attendance").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, a := range attendances {
+		if a.LiveSessionID == sessionID && a.EnrollmentID == enrollmentID && a.Status != types.StatusDeleted {
+			return a, nil
+		}
+	}
+
+	return nil, ierr.NewError("session attendance not found").
+		WithHint("No attendance was recorded for this session").
+		WithReportableDetails(map[string]any{
+			"live_session_id": sessionID,
+			"enrollment_id":   enrollmentID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemorySessionAttendanceStore) Update(ctx context.Context, m *livesession.Attendance) error {
+	if m == nil {
+		return ierr.NewError("session attendance cannot be nil").
+			WithHint("Attendance data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Attendance with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"session_attendance_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update session attendance with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySessionAttendanceStore) Count(ctx context.Context, filter *types.SessionAttendanceFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, sessionAttendanceFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count session attendances").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemorySessionAttendanceStore) List(ctx context.Context, filter *types.SessionAttendanceFilter) ([]*livesession.Attendance, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, sessionAttendanceFilterFn, sessionAttendanceSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list session attendances").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemorySessionAttendanceStore) ListAll(ctx context.Context, filter *types.SessionAttendanceFilter) ([]*livesession.Attendance, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSessionAttendanceFilter()
+	}
+
+	unlimitedFilter := &types.SessionAttendanceFilter{
+		QueryFilter:        types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:    filter.TimeRangeFilter,
+		LiveSessionIDs:     filter.LiveSessionIDs,
+		InternshipBatchIDs: filter.InternshipBatchIDs,
+		EnrollmentIDs:      filter.EnrollmentIDs,
+		UserIDs:            filter.UserIDs,
+		AttendanceStatus:   filter.AttendanceStatus,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the session attendance store
+func (s *InMemorySessionAttendanceStore) Clear() {
+	s.InMemoryStore.Clear()
+}
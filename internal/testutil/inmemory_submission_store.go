@@ -0,0 +1,200 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/content"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySubmissionStore implements content.SubmissionRepository
+type InMemorySubmissionStore struct {
+	*InMemoryStore[*content.Submission]
+}
+
+// NewInMemorySubmissionStore creates a new in-memory submission store
+func NewInMemorySubmissionStore() *InMemorySubmissionStore {
+	return &InMemorySubmissionStore{
+		InMemoryStore: NewInMemoryStore[*content.Submission](),
+	}
+}
+
+// submissionFilterFn implements filtering logic for submissions
+func submissionFilterFn(ctx context.Context, m *content.Submission, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.SubmissionFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.AssignmentIDs) > 0 && !lo.Contains(filter_.AssignmentIDs, m.AssignmentID) {
+		return false
+	}
+
+	if len(filter_.EnrollmentIDs) > 0 && !lo.Contains(filter_.EnrollmentIDs, m.EnrollmentID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	if filter_.SubmissionStatus != "" && m.SubmissionStatus != filter_.SubmissionStatus {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted submissions
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// submissionSortFn implements sorting logic for submissions
+func submissionSortFn(i, j *content.Submission) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemorySubmissionStore) Create(ctx context.Context, m *content.Submission) error {
+	if m == nil {
+		return ierr.NewError("submission cannot be nil").
+			WithHint("Submission data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A submission with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"submission_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create submission").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubmissionStore) Get(ctx context.Context, id string) (*content.Submission, error) {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return nil, ierr.NewError("submission not found").
+			WithHintf("Submission with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"submission_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return m, nil
+}
+
+func (s *InMemorySubmissionStore) Update(ctx context.Context, m *content.Submission) error {
+	if m == nil {
+		return ierr.NewError("submission cannot be nil").
+			WithHint("Submission data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Update timestamp
+	m.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Submission with ID %s was not found", m.ID).
+				WithReportableDetails(map[string]any{
+					"submission_id": m.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update submission with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubmissionStore) Count(ctx context.Context, filter *types.SubmissionFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, submissionFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count submissions").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemorySubmissionStore) List(ctx context.Context, filter *types.SubmissionFilter) ([]*content.Submission, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, submissionFilterFn, submissionSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list submissions").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemorySubmissionStore) ListAll(ctx context.Context, filter *types.SubmissionFilter) ([]*content.Submission, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSubmissionFilter()
+	}
+
+	unlimitedFilter := &types.SubmissionFilter{
+		QueryFilter:      types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:  filter.TimeRangeFilter,
+		InternshipIDs:    filter.InternshipIDs,
+		AssignmentIDs:    filter.AssignmentIDs,
+		EnrollmentIDs:    filter.EnrollmentIDs,
+		UserIDs:          filter.UserIDs,
+		SubmissionStatus: filter.SubmissionStatus,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the submission store
+func (s *InMemorySubmissionStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,193 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySubscriptionPlanStore implements subscription.PlanRepository
+type InMemorySubscriptionPlanStore struct {
+	*InMemoryStore[*subscription.Plan]
+}
+
+// NewInMemorySubscriptionPlanStore creates a new in-memory subscription plan store
+func NewInMemorySubscriptionPlanStore() *InMemorySubscriptionPlanStore {
+	return &InMemorySubscriptionPlanStore{
+		InMemoryStore: NewInMemoryStore[*subscription.Plan](),
+	}
+}
+
+// subscriptionPlanFilterFn implements filtering logic for subscription plans
+func subscriptionPlanFilterFn(ctx context.Context, p *subscription.Plan, filter interface{}) bool {
+	if p == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.SubscriptionPlanFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if filter_.CategoryID != "" && !lo.Contains(p.CategoryIDs, filter_.CategoryID) {
+		return false
+	}
+
+	if filter_.BillingPeriod != "" && p.BillingPeriod != filter_.BillingPeriod {
+		return false
+	}
+
+	if filter_.IsActive != nil && p.IsActive != *filter_.IsActive {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted plans
+	if filter_.GetStatus() != "" {
+		if string(p.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if p.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && p.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && p.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// subscriptionPlanSortFn implements sorting logic for subscription plans
+func subscriptionPlanSortFn(i, j *subscription.Plan) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemorySubscriptionPlanStore) Create(ctx context.Context, p *subscription.Plan) error {
+	if p == nil {
+		return ierr.NewError("subscription plan cannot be nil").
+			WithHint("Subscription plan data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = now
+	}
+	if p.UpdatedAt.IsZero() {
+		p.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, p.ID, p)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A subscription plan with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"plan_id": p.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription plan").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubscriptionPlanStore) Get(ctx context.Context, id string) (*subscription.Plan, error) {
+	p, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || p.Status == types.StatusDeleted {
+		return nil, ierr.NewError("subscription plan not found").
+			WithHintf("Subscription plan with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"plan_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return p, nil
+}
+
+func (s *InMemorySubscriptionPlanStore) Update(ctx context.Context, p *subscription.Plan) error {
+	if p == nil {
+		return ierr.NewError("subscription plan cannot be nil").
+			WithHint("Subscription plan data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	p.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, p.ID, p)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Subscription plan with ID %s was not found", p.ID).
+				WithReportableDetails(map[string]any{
+					"plan_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update subscription plan with ID %s", p.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubscriptionPlanStore) Delete(ctx context.Context, id string) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p.Status = types.StatusDeleted
+	return s.Update(ctx, p)
+}
+
+func (s *InMemorySubscriptionPlanStore) Count(ctx context.Context, filter *types.SubscriptionPlanFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, subscriptionPlanFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscription plans").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemorySubscriptionPlanStore) List(ctx context.Context, filter *types.SubscriptionPlanFilter) ([]*subscription.Plan, error) {
+	plans, err := s.InMemoryStore.List(ctx, filter, subscriptionPlanFilterFn, subscriptionPlanSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscription plans").
+			Mark(ierr.ErrDatabase)
+	}
+	return plans, nil
+}
+
+func (s *InMemorySubscriptionPlanStore) ListAll(ctx context.Context, filter *types.SubscriptionPlanFilter) ([]*subscription.Plan, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSubscriptionPlanFilter()
+	}
+
+	unlimitedFilter := &types.SubscriptionPlanFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		CategoryID:      filter.CategoryID,
+		BillingPeriod:   filter.BillingPeriod,
+		IsActive:        filter.IsActive,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
@@ -0,0 +1,273 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/subscription"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySubscriptionStore implements subscription.Repository
+type InMemorySubscriptionStore struct {
+	*InMemoryStore[*subscription.Subscription]
+
+	// events are keyed by gateway provider and gateway event id
+	events *InMemoryStore[*subscription.Event]
+}
+
+// NewInMemorySubscriptionStore creates a new in-memory subscription store
+func NewInMemorySubscriptionStore() *InMemorySubscriptionStore {
+	return &InMemorySubscriptionStore{
+		InMemoryStore: NewInMemoryStore[*subscription.Subscription](),
+		events:        NewInMemoryStore[*subscription.Event](),
+	}
+}
+
+// subscriptionFilterFn implements filtering logic for subscriptions
+func subscriptionFilterFn(ctx context.Context, sub *subscription.Subscription, filter interface{}) bool {
+	if sub == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.SubscriptionFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if filter_.UserID != "" && sub.UserID != filter_.UserID {
+		return false
+	}
+
+	if filter_.PlanID != "" && sub.PlanID != filter_.PlanID {
+		return false
+	}
+
+	if len(filter_.SubscriptionStatuses) > 0 && !lo.Contains(filter_.SubscriptionStatuses, sub.SubscriptionStatus) {
+		return false
+	}
+
+	if filter_.GatewaySubscriptionID != "" && lo.FromPtr(sub.GatewaySubscriptionID) != filter_.GatewaySubscriptionID {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted subscriptions
+	if filter_.GetStatus() != "" {
+		if string(sub.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if sub.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && sub.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && sub.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// subscriptionSortFn implements sorting logic for subscriptions
+func subscriptionSortFn(i, j *subscription.Subscription) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemorySubscriptionStore) Create(ctx context.Context, sub *subscription.Subscription) error {
+	if sub == nil {
+		return ierr.NewError("subscription cannot be nil").
+			WithHint("Subscription data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = now
+	}
+	if sub.UpdatedAt.IsZero() {
+		sub.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, sub.ID, sub)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A subscription with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubscriptionStore) Get(ctx context.Context, id string) (*subscription.Subscription, error) {
+	sub, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || sub.Status == types.StatusDeleted {
+		return nil, ierr.NewError("subscription not found").
+			WithHintf("Subscription with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"subscription_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return sub, nil
+}
+
+func (s *InMemorySubscriptionStore) GetByGatewaySubscriptionID(ctx context.Context, provider types.PaymentGatewayProvider, gatewaySubscriptionID string) (*subscription.Subscription, error) {
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.GatewaySubscriptionID = gatewaySubscriptionID
+
+	subs, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sub := range subs {
+		if sub.GatewayProvider == provider {
+			return sub, nil
+		}
+	}
+
+	return nil, ierr.NewError("subscription not found").
+		WithHintf("Subscription %s was not found", gatewaySubscriptionID).
+		WithReportableDetails(map[string]any{
+			"gateway_provider":        provider,
+			"gateway_subscription_id": gatewaySubscriptionID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemorySubscriptionStore) Update(ctx context.Context, sub *subscription.Subscription) error {
+	if sub == nil {
+		return ierr.NewError("subscription cannot be nil").
+			WithHint("Subscription data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	sub.UpdatedAt = time.Now().UTC()
+
+	err := s.InMemoryStore.Update(ctx, sub.ID, sub)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return ierr.WithError(err).
+				WithHintf("Subscription with ID %s was not found", sub.ID).
+				WithReportableDetails(map[string]any{
+					"subscription_id": sub.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHintf("Failed to update subscription with ID %s", sub.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubscriptionStore) Count(ctx context.Context, filter *types.SubscriptionFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, subscriptionFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscriptions").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemorySubscriptionStore) List(ctx context.Context, filter *types.SubscriptionFilter) ([]*subscription.Subscription, error) {
+	subs, err := s.InMemoryStore.List(ctx, filter, subscriptionFilterFn, subscriptionSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscriptions").
+			Mark(ierr.ErrDatabase)
+	}
+	return subs, nil
+}
+
+func (s *InMemorySubscriptionStore) ListAll(ctx context.Context, filter *types.SubscriptionFilter) ([]*subscription.Subscription, error) {
+	if filter == nil {
+		filter = types.NewNoLimitSubscriptionFilter()
+	}
+
+	unlimitedFilter := &types.SubscriptionFilter{
+		QueryFilter:           types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:       filter.TimeRangeFilter,
+		UserID:                filter.UserID,
+		PlanID:                filter.PlanID,
+		SubscriptionStatuses:  filter.SubscriptionStatuses,
+		GatewaySubscriptionID: filter.GatewaySubscriptionID,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+func subscriptionEventKey(provider types.PaymentGatewayProvider, gatewayEventID string) string {
+	return string(provider) + ":" + gatewayEventID
+}
+
+func (s *InMemorySubscriptionStore) CreateEvent(ctx context.Context, event *subscription.Event) error {
+	if event == nil {
+		return ierr.NewError("subscription event cannot be nil").
+			WithHint("Subscription event data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = now
+	}
+	if event.UpdatedAt.IsZero() {
+		event.UpdatedAt = now
+	}
+
+	err := s.events.Create(ctx, subscriptionEventKey(event.GatewayProvider, event.GatewayEventID), event)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("This subscription event was already processed").
+				WithReportableDetails(map[string]any{
+					"gateway_provider": event.GatewayProvider,
+					"gateway_event_id": event.GatewayEventID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription event").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemorySubscriptionStore) GetEventByGatewayEventID(ctx context.Context, provider types.PaymentGatewayProvider, gatewayEventID string) (*subscription.Event, error) {
+	event, err := s.events.Get(ctx, subscriptionEventKey(provider, gatewayEventID))
+	if err != nil {
+		return nil, ierr.NewError("subscription event not found").
+			WithHintf("Subscription event %s was not found", gatewayEventID).
+			WithReportableDetails(map[string]any{
+				"gateway_provider": provider,
+				"gateway_event_id": gatewayEventID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return event, nil
+}
+
+// Clear clears the subscription store
+func (s *InMemorySubscriptionStore) Clear() {
+	s.InMemoryStore.Clear()
+	s.events.Clear()
+}
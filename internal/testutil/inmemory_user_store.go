@@ -158,6 +158,28 @@ func (s *InMemoryUserStore) GetByEmail(ctx context.Context, email string) (*user
 		Mark(ierr.ErrNotFound)
 }
 
+func (s *InMemoryUserStore) GetByReferralCode(ctx context.Context, code string) (*user.User, error) {
+	users, err := s.InMemoryStore.List(ctx, nil, userFilterFn, userSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get user by referral code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, u := range users {
+		if lo.FromPtr(u.ReferralCode) == code && u.Status != types.StatusDeleted {
+			return u, nil
+		}
+	}
+
+	return nil, ierr.NewError("user not found").
+		WithHintf("User with referral code %s was not found", code).
+		WithReportableDetails(map[string]any{
+			"referral_code": code,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
 func (s *InMemoryUserStore) List(ctx context.Context, filter *types.UserFilter) ([]*user.User, error) {
 	users, err := s.InMemoryStore.List(ctx, filter, userFilterFn, userSortFn)
 	if err != nil {
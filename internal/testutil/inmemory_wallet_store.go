@@ -0,0 +1,276 @@
+package testutil
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/wallet"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// InMemoryWalletStore implements wallet.Repository
+type InMemoryWalletStore struct {
+	*InMemoryStore[*wallet.WalletTransaction]
+}
+
+// NewInMemoryWalletStore creates a new in-memory wallet store
+func NewInMemoryWalletStore() *InMemoryWalletStore {
+	return &InMemoryWalletStore{
+		InMemoryStore: NewInMemoryStore[*wallet.WalletTransaction](),
+	}
+}
+
+// walletTransactionFilterFn implements filtering logic for wallet transactions
+func walletTransactionFilterFn(ctx context.Context, t *wallet.WalletTransaction, filter interface{}) bool {
+	if t == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.WalletTransactionFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if filter_.UserID != "" && t.UserID != filter_.UserID {
+		return false
+	}
+
+	if filter_.Currency != "" && t.Currency != filter_.Currency {
+		return false
+	}
+
+	if filter_.TransactionType != "" && t.TransactionType != filter_.TransactionType {
+		return false
+	}
+
+	if filter_.TransactionReason != "" && t.TransactionReason != filter_.TransactionReason {
+		return false
+	}
+
+	if filter_.ReferenceID != "" && lo.FromPtr(t.ReferenceID) != filter_.ReferenceID {
+		return false
+	}
+
+	if filter_.SourceID != "" && lo.FromPtr(t.SourceTransactionID) != filter_.SourceID {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted transactions
+	if filter_.GetStatus() != "" {
+		if string(t.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if t.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && t.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && t.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// walletTransactionSortFn implements sorting logic for wallet transactions
+func walletTransactionSortFn(i, j *wallet.WalletTransaction) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryWalletStore) Create(ctx context.Context, t *wallet.WalletTransaction) error {
+	if t == nil {
+		return ierr.NewError("wallet transaction cannot be nil").
+			WithHint("Wallet transaction data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = now
+	}
+	if t.UpdatedAt.IsZero() {
+		t.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, t.ID, t)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("Wallet transaction already exists").
+				WithReportableDetails(map[string]any{
+					"transaction_id": t.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create wallet transaction").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryWalletStore) Get(ctx context.Context, id string) (*wallet.WalletTransaction, error) {
+	t, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		if err.Error() == "item not found" {
+			return nil, ierr.WithError(err).
+				WithHintf("Wallet transaction with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"transaction_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get wallet transaction with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+	return t, nil
+}
+
+func (s *InMemoryWalletStore) Count(ctx context.Context, filter *types.WalletTransactionFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, walletTransactionFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count wallet transactions").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryWalletStore) List(ctx context.Context, filter *types.WalletTransactionFilter) ([]*wallet.WalletTransaction, error) {
+	txns, err := s.InMemoryStore.List(ctx, filter, walletTransactionFilterFn, walletTransactionSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list wallet transactions").
+			Mark(ierr.ErrDatabase)
+	}
+	return txns, nil
+}
+
+func (s *InMemoryWalletStore) ListAll(ctx context.Context, filter *types.WalletTransactionFilter) ([]*wallet.WalletTransaction, error) {
+	if filter == nil {
+		filter = types.NewNoLimitWalletTransactionFilter()
+	}
+
+	unlimitedFilter := &types.WalletTransactionFilter{
+		QueryFilter:       types.NewNoLimitQueryFilter(),
+		TimeRangeFilter:   filter.TimeRangeFilter,
+		UserID:            filter.UserID,
+		Currency:          filter.Currency,
+		TransactionType:   filter.TransactionType,
+		TransactionReason: filter.TransactionReason,
+		ReferenceID:       filter.ReferenceID,
+		SourceID:          filter.SourceID,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+func (s *InMemoryWalletStore) GetLedgerTotals(ctx context.Context, userID string, currency string) (*wallet.LedgerTotals, error) {
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.UserID = userID
+	filter.Currency = currency
+
+	txns, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := &wallet.LedgerTotals{
+		Credits: decimal.Zero,
+		Debits:  decimal.Zero,
+	}
+	for _, t := range txns {
+		switch t.TransactionType {
+		case types.WalletTransactionTypeCredit:
+			totals.Credits = totals.Credits.Add(t.Amount)
+		case types.WalletTransactionTypeDebit:
+			totals.Debits = totals.Debits.Add(t.Amount)
+		}
+	}
+
+	return totals, nil
+}
+
+func (s *InMemoryWalletStore) ListSpendableCredits(ctx context.Context, userID string, currency string, at time.Time) ([]*wallet.WalletTransaction, error) {
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.UserID = userID
+	filter.Currency = currency
+	filter.TransactionType = types.WalletTransactionTypeCredit
+
+	txns, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	credits := lo.Filter(txns, func(t *wallet.WalletTransaction, _ int) bool {
+		return t.RemainingAmount.IsPositive() && !t.IsExpired(at)
+	})
+
+	// soonest expiring first, credits without expiry last
+	sort.SliceStable(credits, func(i, j int) bool {
+		a, b := credits[i].ExpiresAt, credits[j].ExpiresAt
+		switch {
+		case a == nil && b == nil:
+			return credits[i].CreatedAt.Before(credits[j].CreatedAt)
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		default:
+			return a.Before(*b)
+		}
+	})
+
+	return credits, nil
+}
+
+func (s *InMemoryWalletStore) ListExpiredCredits(ctx context.Context, userID string, before time.Time) ([]*wallet.WalletTransaction, error) {
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.UserID = userID
+	filter.TransactionType = types.WalletTransactionTypeCredit
+
+	txns, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(txns, func(t *wallet.WalletTransaction, _ int) bool {
+		return t.RemainingAmount.IsPositive() && t.IsExpired(before)
+	}), nil
+}
+
+func (s *InMemoryWalletStore) UpdateRemainingAmount(ctx context.Context, id string, expected decimal.Decimal, remaining decimal.Decimal) error {
+	t, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !t.RemainingAmount.Equal(expected) {
+		return ierr.NewError("wallet credit changed concurrently").
+			WithHint("Wallet balance changed, please try again").
+			WithReportableDetails(map[string]any{
+				"transaction_id": id,
+			}).
+			Mark(ierr.ErrVersionConflict)
+	}
+
+	// store a copy, callers hold the credit they read and update it themselves like with a database
+	updated := *t
+	updated.RemainingAmount = remaining
+	updated.UpdatedAt = time.Now().UTC()
+
+	return s.InMemoryStore.Update(ctx, id, &updated)
+}
@@ -0,0 +1,200 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/domain/wishlist"
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryWishlistStore implements wishlist.Repository
+type InMemoryWishlistStore struct {
+	*InMemoryStore[*wishlist.Wishlist]
+}
+
+// NewInMemoryWishlistStore creates a new in-memory wishlist store
+func NewInMemoryWishlistStore() *InMemoryWishlistStore {
+	return &InMemoryWishlistStore{
+		InMemoryStore: NewInMemoryStore[*wishlist.Wishlist](),
+	}
+}
+
+// wishlistFilterFn implements filtering logic for wishlist entries
+func wishlistFilterFn(ctx context.Context, m *wishlist.Wishlist, filter interface{}) bool {
+	if m == nil {
+		return false
+	}
+
+	filter_, ok := filter.(*types.WishlistFilter)
+	if !ok {
+		return true // No filter applied
+	}
+
+	if len(filter_.InternshipIDs) > 0 && !lo.Contains(filter_.InternshipIDs, m.InternshipID) {
+		return false
+	}
+
+	if len(filter_.UserIDs) > 0 && !lo.Contains(filter_.UserIDs, m.UserID) {
+		return false
+	}
+
+	// Filter by status - if no status is specified, exclude deleted wishlist entries
+	if filter_.GetStatus() != "" {
+		if string(m.Status) != filter_.GetStatus() {
+			return false
+		}
+	} else if m.Status == types.StatusDeleted {
+		return false
+	}
+
+	// Filter by time range
+	if filter_.TimeRangeFilter != nil {
+		if filter_.StartTime != nil && m.CreatedAt.Before(*filter_.StartTime) {
+			return false
+		}
+		if filter_.EndTime != nil && m.CreatedAt.After(*filter_.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wishlistSortFn implements sorting logic for wishlist entries
+func wishlistSortFn(i, j *wishlist.Wishlist) bool {
+	if i == nil || j == nil {
+		return false
+	}
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+func (s *InMemoryWishlistStore) Create(ctx context.Context, m *wishlist.Wishlist) error {
+	if m == nil {
+		return ierr.NewError("wishlist entry cannot be nil").
+			WithHint("Wishlist data is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetByUserAndInternship(ctx, m.UserID, m.InternshipID); err == nil {
+		return ierr.NewError("wishlist entry already exists").
+			WithHint("This internship is already in your wishlist").
+			WithReportableDetails(map[string]any{
+				"internship_id": m.InternshipID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = now
+	}
+	if m.UpdatedAt.IsZero() {
+		m.UpdatedAt = now
+	}
+
+	err := s.InMemoryStore.Create(ctx, m.ID, m)
+	if err != nil {
+		if err.Error() == "item already exists" {
+			return ierr.WithError(err).
+				WithHint("A wishlist entry with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"wishlist_id": m.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to add internship to wishlist").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryWishlistStore) GetByUserAndInternship(ctx context.Context, userID string, internshipID string) (*wishlist.Wishlist, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, wishlistFilterFn, wishlistSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get wishlist entry").
+			Mark(ierr.ErrDatabase)
+	}
+
+	for _, w := range items {
+		if w.UserID == userID && w.InternshipID == internshipID && w.Status != types.StatusDeleted {
+			return w, nil
+		}
+	}
+
+	return nil, ierr.NewError("wishlist entry not found").
+		WithHint("This internship is not in your wishlist").
+		WithReportableDetails(map[string]any{
+			"user_id":       userID,
+			"internship_id": internshipID,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+func (s *InMemoryWishlistStore) Delete(ctx context.Context, id string) error {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || m.Status == types.StatusDeleted {
+		return ierr.NewError("wishlist entry not found").
+			WithHintf("Wishlist entry with ID %s was not found", id).
+			WithReportableDetails(map[string]any{
+				"wishlist_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	// Soft delete by setting status to deleted
+	m.Status = types.StatusDeleted
+	m.UpdatedAt = time.Now().UTC()
+
+	if err := s.InMemoryStore.Update(ctx, m.ID, m); err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to delete wishlist entry with ID %s", m.ID).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (s *InMemoryWishlistStore) Count(ctx context.Context, filter *types.WishlistFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, wishlistFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count wishlist entries").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+func (s *InMemoryWishlistStore) List(ctx context.Context, filter *types.WishlistFilter) ([]*wishlist.Wishlist, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, wishlistFilterFn, wishlistSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list wishlist entries").
+			Mark(ierr.ErrDatabase)
+	}
+	return items, nil
+}
+
+func (s *InMemoryWishlistStore) ListAll(ctx context.Context, filter *types.WishlistFilter) ([]*wishlist.Wishlist, error) {
+	if filter == nil {
+		filter = types.NewNoLimitWishlistFilter()
+	}
+
+	unlimitedFilter := &types.WishlistFilter{
+		QueryFilter:     types.NewNoLimitQueryFilter(),
+		TimeRangeFilter: filter.TimeRangeFilter,
+		InternshipIDs:   filter.InternshipIDs,
+		UserIDs:         filter.UserIDs,
+	}
+
+	return s.List(ctx, unlimitedFilter)
+}
+
+// Clear clears the wishlist store
+func (s *InMemoryWishlistStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -0,0 +1,80 @@
+package testutil
+
+import (
+	"context"
+	"mime/multipart"
+	"sync"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/fileupload"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+var _ fileupload.Provider = (*MockFileUploadProvider)(nil) // Ensure MockFileUploadProvider implements Provider
+
+// MockFileUploadProvider keeps uploaded files in memory for testing
+type MockFileUploadProvider struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+// NewMockFileUploadProvider creates a new mock file upload provider
+func NewMockFileUploadProvider() *MockFileUploadProvider {
+	return &MockFileUploadProvider{
+		files: make(map[string][]byte),
+	}
+}
+
+func (p *MockFileUploadProvider) GetProvider() types.FileUploadProvider {
+	return types.FileUploadProviderS3
+}
+
+// UploadFile stores the file without its content
+func (p *MockFileUploadProvider) UploadFile(ctx context.Context, file *fileupload.FileUpload, fileData *multipart.FileHeader) (*fileupload.FileUpload, error) {
+	return p.UploadContent(ctx, file, nil)
+}
+
+// UploadContent stores the content under the file id
+func (p *MockFileUploadProvider) UploadContent(ctx context.Context, file *fileupload.FileUpload, content []byte) (*fileupload.FileUpload, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	file.Provider = p.GetProvider()
+	file.ExternalID = file.ID
+	file.PublicURL = "https://files.example.com/" + file.ID
+	p.files[file.ExternalID] = content
+	return file, nil
+}
+
+func (p *MockFileUploadProvider) GetPresignedURL(ctx context.Context, externalID string) (string, error) {
+	return "https://files.example.com/" + externalID, nil
+}
+
+func (p *MockFileUploadProvider) DownloadFile(ctx context.Context, externalID string) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	content, ok := p.files[externalID]
+	if !ok {
+		return nil, ierr.NewError("file not found").
+			WithHintf("File %s not found", externalID).
+			Mark(ierr.ErrNotFound)
+	}
+	return content, nil
+}
+
+func (p *MockFileUploadProvider) Exists(ctx context.Context, externalID string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.files[externalID]
+	return ok, nil
+}
+
+func (p *MockFileUploadProvider) DeleteFile(ctx context.Context, externalID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.files, externalID)
+	return nil
+}
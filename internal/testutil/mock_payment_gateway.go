@@ -0,0 +1,134 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/omkar273/codegeeky/internal/api/dto"
+	gateway "github.com/omkar273/codegeeky/internal/payment"
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+var (
+	_ gateway.SubscriptionProvider = (*MockPaymentGateway)(nil) // Ensure MockPaymentGateway implements SubscriptionProvider
+	_ gateway.RefundProvider       = (*MockPaymentGateway)(nil) // Ensure MockPaymentGateway implements RefundProvider
+)
+
+// MockPaymentGateway is a payment gateway for testing, it records refunds and cancellations
+// and hands out the subscription event it was given for every webhook
+type MockPaymentGateway struct {
+	mu sync.Mutex
+
+	// RefundErr is returned by RefundPayment until it is reset
+	RefundErr error
+	// SubscriptionEvent is returned by ParseSubscriptionEvent
+	SubscriptionEvent *types.GatewaySubscriptionEvent
+
+	refunds       map[string]*dto.GatewayRefundRequest
+	cancellations []string
+}
+
+// NewMockPaymentGateway creates a new mock payment gateway
+func NewMockPaymentGateway() *MockPaymentGateway {
+	return &MockPaymentGateway{
+		refunds: make(map[string]*dto.GatewayRefundRequest),
+	}
+}
+
+// NewMockGatewayRegistry creates a registry serving the mock gateway as razorpay
+func NewMockGatewayRegistry(provider *MockPaymentGateway) gateway.GatewayRegistryService {
+	registry := gateway.NewGatewayRegistryService()
+	registry.RegisterProvider(types.PaymentGatewayProviderRazorpay, provider)
+	return registry
+}
+
+func (g *MockPaymentGateway) ProviderName() types.PaymentGatewayProvider {
+	return types.PaymentGatewayProviderRazorpay
+}
+
+func (g *MockPaymentGateway) SupportedFeatures() []types.PaymentGatewayFeatures {
+	return []types.PaymentGatewayFeatures{
+		types.PaymentGatewayFeaturesPayments,
+		types.PaymentGatewayFeaturesRefunds,
+		types.PaymentGatewayFeaturesSubscriptions,
+	}
+}
+
+func (g *MockPaymentGateway) Initialize(ctx context.Context) error {
+	return nil
+}
+
+func (g *MockPaymentGateway) ProcessWebhook(ctx context.Context, payload []byte, headers map[string]string) (*dto.WebhookResult, error) {
+	return &dto.WebhookResult{}, nil
+}
+
+func (g *MockPaymentGateway) CreatePaymentOrder(ctx context.Context, input *dto.PaymentRequest) (*dto.PaymentResponse, error) {
+	return &dto.PaymentResponse{}, nil
+}
+
+func (g *MockPaymentGateway) VerifyPaymentStatus(ctx context.Context, providerPaymentID string) (*dto.PaymentStatus, error) {
+	return &dto.PaymentStatus{}, nil
+}
+
+func (g *MockPaymentGateway) CreatePlan(ctx context.Context, input *dto.GatewayPlanRequest) (*dto.GatewayPlanResponse, error) {
+	return &dto.GatewayPlanResponse{GatewayPlanID: types.GenerateUUIDWithPrefix("plan")}, nil
+}
+
+func (g *MockPaymentGateway) CreateSubscription(ctx context.Context, input *dto.GatewaySubscriptionRequest) (*dto.GatewaySubscriptionResponse, error) {
+	return &dto.GatewaySubscriptionResponse{
+		GatewaySubscriptionID: types.GenerateUUIDWithPrefix("sub"),
+		CheckoutURL:           "https://checkout.example.com",
+	}, nil
+}
+
+func (g *MockPaymentGateway) CancelSubscription(ctx context.Context, gatewaySubscriptionID string, atPeriodEnd bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.cancellations = append(g.cancellations, gatewaySubscriptionID)
+	return nil
+}
+
+func (g *MockPaymentGateway) ParseSubscriptionEvent(ctx context.Context, payload []byte, headers map[string]string) (*types.GatewaySubscriptionEvent, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.SubscriptionEvent, nil
+}
+
+// RefundPayment records the refund, a refund already made under the same
+// idempotency key is returned instead of refunding again like a real gateway does
+func (g *MockPaymentGateway) RefundPayment(ctx context.Context, input *dto.GatewayRefundRequest) (*dto.GatewayRefundResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.RefundErr != nil {
+		return nil, g.RefundErr
+	}
+
+	if _, ok := g.refunds[input.IdempotencyKey]; !ok {
+		g.refunds[input.IdempotencyKey] = input
+	}
+
+	return &dto.GatewayRefundResponse{
+		GatewayRefundID: "rfnd_" + input.IdempotencyKey,
+		Status:          "processed",
+	}, nil
+}
+
+// Refunds returns the refunds made, keyed by idempotency key
+func (g *MockPaymentGateway) Refunds() map[string]*dto.GatewayRefundRequest {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	refunds := make(map[string]*dto.GatewayRefundRequest, len(g.refunds))
+	for key, refund := range g.refunds {
+		refunds[key] = refund
+	}
+	return refunds
+}
+
+// Cancellations returns the gateway ids of the cancelled subscriptions
+func (g *MockPaymentGateway) Cancellations() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.cancellations...)
+}
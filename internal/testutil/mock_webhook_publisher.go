@@ -0,0 +1,52 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/omkar273/codegeeky/internal/webhook/publisher"
+	"github.com/samber/lo"
+)
+
+var _ publisher.WebhookPublisher = (*MockWebhookPublisher)(nil) // Ensure MockWebhookPublisher implements WebhookPublisher
+
+// MockWebhookPublisher records published webhook events for testing
+type MockWebhookPublisher struct {
+	mu     sync.Mutex
+	events []*types.WebhookEvent
+}
+
+// NewMockWebhookPublisher creates a new mock webhook publisher
+func NewMockWebhookPublisher() *MockWebhookPublisher {
+	return &MockWebhookPublisher{}
+}
+
+// PublishWebhook records the event
+func (p *MockWebhookPublisher) PublishWebhook(ctx context.Context, event *types.WebhookEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Close is a no-op
+func (p *MockWebhookPublisher) Close() error {
+	return nil
+}
+
+// Events returns the names of the published events, oldest first
+func (p *MockWebhookPublisher) Events() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return lo.Map(p.events, func(event *types.WebhookEvent, _ int) string {
+		return event.EventName
+	})
+}
+
+// Clear forgets the published events
+func (p *MockWebhookPublisher) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = nil
+}
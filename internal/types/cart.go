@@ -150,3 +150,19 @@ func (f *CartFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *CartFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *CartFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+)
+
 type CategoryFilter struct {
 	*QueryFilter
 	*TimeRangeFilter
@@ -8,6 +12,12 @@ type CategoryFilter struct {
 	Name          string   `json:"name,omitempty" form:"name" validate:"omitempty"`
 	CategoryIDs   []string `json:"category_ids,omitempty" form:"category_ids" validate:"omitempty"`
 	InternshipIDs []string `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+
+	// These fields are used to filter categories by their place in the hierarchy
+	ParentID   *string  `json:"parent_id,omitempty" form:"parent_id" validate:"omitempty"`
+	RootsOnly  bool     `json:"roots_only,omitempty" form:"roots_only"`
+	AncestorID *string  `json:"ancestor_id,omitempty" form:"ancestor_id" validate:"omitempty"`
+	Slugs      []string `json:"slugs,omitempty" form:"slugs" validate:"omitempty"`
 }
 
 func (f *CategoryFilter) Validate() error {
@@ -19,6 +29,16 @@ func (f *CategoryFilter) Validate() error {
 		return err
 	}
 
+	if f.RootsOnly && f.ParentID != nil {
+		return ierr.NewError("roots_only and parent_id can't be combined").
+			WithHint("Filter either by parent category or for top level categories").
+			WithReportableDetails(map[string]any{
+				"roots_only": f.RootsOnly,
+				"parent_id":  *f.ParentID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
 	return nil
 }
 
@@ -90,3 +110,19 @@ func (f *CategoryFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *CategoryFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *CategoryFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// CertificateStatus is whether a certificate is still valid
+type CertificateStatus string
+
+const (
+	CertificateStatusIssued  CertificateStatus = "issued"
+	CertificateStatusRevoked CertificateStatus = "revoked"
+)
+
+var CertificateStatuses = []CertificateStatus{
+	CertificateStatusIssued,
+	CertificateStatusRevoked,
+}
+
+func (s CertificateStatus) Validate() error {
+	if !lo.Contains(CertificateStatuses, s) {
+		return ierr.NewError("invalid certificate status").
+			WithHintf("Certificate status must be one of %v", CertificateStatuses).
+			WithReportableDetails(map[string]any{"certificate_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type CertificateFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	UserIDs           []string          `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	EnrollmentIDs     []string          `json:"enrollment_ids,omitempty" form:"enrollment_ids" validate:"omitempty"`
+	InternshipIDs     []string          `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	CertificateStatus CertificateStatus `json:"certificate_status,omitempty" form:"certificate_status" validate:"omitempty"`
+}
+
+func (f *CertificateFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.CertificateStatus != "" {
+		return f.CertificateStatus.Validate()
+	}
+
+	return nil
+}
+
+func NewCertificateFilter() *CertificateFilter {
+	return &CertificateFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitCertificateFilter() *CertificateFilter {
+	return &CertificateFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *CertificateFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *CertificateFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *CertificateFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *CertificateFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *CertificateFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *CertificateFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *CertificateFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *CertificateFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *CertificateFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,487 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// LessonType is the kind of content a lesson carries
+type LessonType string
+
+const (
+	LessonTypeVideo LessonType = "video"
+	LessonTypeText  LessonType = "text"
+	LessonTypeFile  LessonType = "file"
+)
+
+var LessonTypes = []LessonType{
+	LessonTypeVideo,
+	LessonTypeText,
+	LessonTypeFile,
+}
+
+func (t LessonType) Validate() error {
+	if !lo.Contains(LessonTypes, t) {
+		return ierr.NewError("invalid lesson type").
+			WithHintf("Lesson type must be one of %v", LessonTypes).
+			WithReportableDetails(map[string]any{"lesson_type": t}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// DefaultAssignmentMaxScore is the score an assignment is graded out of unless set
+const DefaultAssignmentMaxScore = 100
+
+// newContentQueryFilter sorts content the way it is laid out in the internship
+func newContentQueryFilter(queryFilter *QueryFilter) *QueryFilter {
+	queryFilter.Sort = lo.ToPtr("sort_order")
+	queryFilter.Order = lo.ToPtr(OrderAsc)
+	return queryFilter
+}
+
+type ModuleFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+}
+
+func (f *ModuleFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewModuleFilter and the other content filters list in content order by default
+func NewModuleFilter() *ModuleFilter {
+	return &ModuleFilter{
+		QueryFilter:     newContentQueryFilter(NewDefaultQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitModuleFilter() *ModuleFilter {
+	return &ModuleFilter{
+		QueryFilter:     newContentQueryFilter(NewNoLimitQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *ModuleFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *ModuleFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *ModuleFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *ModuleFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *ModuleFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *ModuleFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *ModuleFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *ModuleFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *ModuleFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
+
+type LessonFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string     `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	ModuleIDs     []string     `json:"module_ids,omitempty" form:"module_ids" validate:"omitempty"`
+	LessonTypes   []LessonType `json:"lesson_types,omitempty" form:"lesson_types" validate:"omitempty"`
+	IsPreview     *bool        `json:"is_preview,omitempty" form:"is_preview" validate:"omitempty"`
+}
+
+func (f *LessonFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, lessonType := range f.LessonTypes {
+		if err := lessonType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewLessonFilter() *LessonFilter {
+	return &LessonFilter{
+		QueryFilter:     newContentQueryFilter(NewDefaultQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitLessonFilter() *LessonFilter {
+	return &LessonFilter{
+		QueryFilter:     newContentQueryFilter(NewNoLimitQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *LessonFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *LessonFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *LessonFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *LessonFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *LessonFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *LessonFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *LessonFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *LessonFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *LessonFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
+
+type AssignmentFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	ModuleIDs     []string `json:"module_ids,omitempty" form:"module_ids" validate:"omitempty"`
+}
+
+func (f *AssignmentFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewAssignmentFilter() *AssignmentFilter {
+	return &AssignmentFilter{
+		QueryFilter:     newContentQueryFilter(NewDefaultQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitAssignmentFilter() *AssignmentFilter {
+	return &AssignmentFilter{
+		QueryFilter:     newContentQueryFilter(NewNoLimitQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *AssignmentFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *AssignmentFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *AssignmentFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *AssignmentFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *AssignmentFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *AssignmentFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *AssignmentFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *AssignmentFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *AssignmentFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
+
+type ResourceFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	ModuleIDs     []string `json:"module_ids,omitempty" form:"module_ids" validate:"omitempty"`
+	LessonIDs     []string `json:"lesson_ids,omitempty" form:"lesson_ids" validate:"omitempty"`
+}
+
+func (f *ResourceFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewResourceFilter() *ResourceFilter {
+	return &ResourceFilter{
+		QueryFilter:     newContentQueryFilter(NewDefaultQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitResourceFilter() *ResourceFilter {
+	return &ResourceFilter{
+		QueryFilter:     newContentQueryFilter(NewNoLimitQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *ResourceFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *ResourceFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *ResourceFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *ResourceFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *ResourceFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *ResourceFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *ResourceFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *ResourceFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *ResourceFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+)
+
+// CursorDirection is the direction a cursor pages in relative to the sort order
+type CursorDirection string
+
+const (
+	CursorDirectionNext CursorDirection = "next"
+	CursorDirectionPrev CursorDirection = "prev"
+)
+
+// Cursor is the decoded form of an opaque pagination cursor. It points at the
+// last row of a page by its sort value and id, so the next page starts right
+// after it no matter how many rows were inserted in between.
+type Cursor struct {
+	Sort      string          `json:"s"`
+	Order     string          `json:"o"`
+	Value     json.RawMessage `json:"v"`
+	ID        string          `json:"id"`
+	Direction CursorDirection `json:"d"`
+}
+
+// IsBackward returns true if the cursor pages towards the start of the list
+func (c *Cursor) IsBackward() bool {
+	return c.Direction == CursorDirectionPrev
+}
+
+// SortValue returns the sort value of the row the cursor points at. Numbers
+// are kept as their literal text so they round trip without losing precision.
+func (c *Cursor) SortValue() (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(c.Value))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if number, ok := value.(json.Number); ok {
+		return number.String(), nil
+	}
+	return value, nil
+}
+
+// Encode returns the opaque string form of the cursor
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses an opaque cursor string
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidCursor(err)
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errInvalidCursor(err)
+	}
+
+	if cursor.ID == "" || len(cursor.Value) == 0 ||
+		(cursor.Direction != CursorDirectionNext && cursor.Direction != CursorDirectionPrev) {
+		return nil, errInvalidCursor(nil)
+	}
+
+	return &cursor, nil
+}
+
+// NewCursorAt builds a cursor pointing at the given item for the filter's sort
+// order. The sort value is read from the item's JSON form, so it works for any
+// domain model whose JSON keys match its sortable fields. It returns nil if the
+// item has no usable sort value.
+func NewCursorAt(item any, filter BaseFilter, direction CursorDirection) *Cursor {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	var id string
+	if err := json.Unmarshal(fields["id"], &id); err != nil || id == "" {
+		return nil
+	}
+
+	value, ok := fields[filter.GetSort()]
+	if !ok || string(value) == "null" {
+		return nil
+	}
+
+	return &Cursor{
+		Sort:      filter.GetSort(),
+		Order:     filter.GetOrder(),
+		Value:     value,
+		ID:        id,
+		Direction: direction,
+	}
+}
+
+func errInvalidCursor(err error) error {
+	builder := ierr.NewError("invalid cursor")
+	if err != nil {
+		builder = ierr.WithError(err)
+	}
+	return builder.
+		WithHint("The pagination cursor is invalid, please start again from the first page").
+		WithReportableDetails(map[string]any{
+			"cursor": "is invalid",
+		}).
+		Mark(ierr.ErrValidation)
+}
@@ -14,6 +14,21 @@ const (
 	DiscountTypePercentage DiscountType = "percentage"
 )
 
+const (
+	// DiscountCodeCharsetDefault leaves out characters that are easily confused (0/O, 1/I)
+	DiscountCodeCharsetDefault = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	DiscountCodeLengthDefault  = 8
+	DiscountCodeLengthMin      = 4
+	DiscountCodeLengthMax      = 32
+	DiscountCodesPerRequestMax = 10000
+)
+
+// Metadata keys set on discounts issued to a single user
+const (
+	DiscountMetadataKeyUserID     = "user_id"
+	DiscountMetadataKeyReferralID = "referral_id"
+)
+
 func (d DiscountType) String() string {
 	return string(d)
 }
@@ -40,6 +55,8 @@ type DiscountFilter struct {
 	IsCombinable  bool             `json:"is_combinable,omitempty" form:"is_combinable" validate:"omitempty"`
 	Codes         []string         `json:"codes,omitempty" form:"codes" validate:"omitempty"`
 	DiscountIDs   []string         `json:"discount_ids,omitempty" form:"discount_ids" validate:"omitempty"`
+	ParentID      string           `json:"parent_id,omitempty" form:"parent_id" validate:"omitempty"`
+	IsCampaign    *bool            `json:"is_campaign,omitempty" form:"is_campaign" validate:"omitempty"`
 }
 
 func (f *DiscountFilter) Validate() error {
@@ -122,3 +139,19 @@ func (f *DiscountFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *DiscountFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *DiscountFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
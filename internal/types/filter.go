@@ -55,6 +55,8 @@ type BaseFilter interface {
 	GetExpand() Expand
 	Validate() error
 	IsUnlimited() bool
+	GetCursor() string
+	IsCountSkipped() bool
 }
 
 // QueryFilter represents a generic query filter with optional fields
@@ -65,6 +67,11 @@ type QueryFilter struct {
 	Sort   *string `json:"sort,omitempty" form:"sort"`
 	Order  *string `json:"order,omitempty" form:"order" validate:"omitempty,oneof=asc desc"`
 	Expand *string `json:"expand,omitempty" form:"expand"`
+
+	// Cursor pages by (sort field, id) instead of offset, see Cursor
+	Cursor *string `json:"cursor,omitempty" form:"cursor"`
+	// SkipCount leaves the total out of the pagination, which saves a count query
+	SkipCount *bool `json:"skip_count,omitempty" form:"skip_count"`
 }
 
 // DefaultQueryFilter defines default values for query filters
@@ -154,6 +161,19 @@ func (f QueryFilter) GetStatus() string {
 	return string(*f.Status)
 }
 
+// GetCursor returns the opaque pagination cursor, if any
+func (f QueryFilter) GetCursor() string {
+	if f.Cursor == nil {
+		return ""
+	}
+	return *f.Cursor
+}
+
+// IsCountSkipped returns true if the total count should not be computed
+func (f QueryFilter) IsCountSkipped() bool {
+	return f.SkipCount != nil && *f.SkipCount
+}
+
 // Validate validates the filter fields
 func (f QueryFilter) Validate() error {
 	if !f.IsUnlimited() {
@@ -178,6 +198,20 @@ func (f QueryFilter) Validate() error {
 				"order": "must be either 'asc' or 'desc'",
 			}).Mark(ierr.ErrValidation)
 	}
+	if f.GetCursor() != "" {
+		cursor, err := DecodeCursor(f.GetCursor())
+		if err != nil {
+			return err
+		}
+		if cursor.Sort != f.GetSort() || cursor.Order != f.GetOrder() {
+			return ierr.NewError("cursor does not match the sort order").
+				WithHint("Keep the same sort and order when paging with a cursor").
+				WithReportableDetails(map[string]any{
+					"cursor": "was issued for a different sort order",
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
 	return nil
 }
 
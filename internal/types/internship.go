@@ -74,6 +74,15 @@ type InternshipFilter struct {
 	// These fields are used to filter internships by price
 	MaxPrice *decimal.Decimal `json:"max_price,omitempty" form:"max_price" validate:"omitempty"`
 	MinPrice *decimal.Decimal `json:"min_price,omitempty" form:"min_price" validate:"omitempty"`
+
+	// These fields are used to filter internships by editorial workflow, EditorialStatuses
+	// also matches published internships with a pending revision in one of the statuses
+	PublishStatuses   []InternshipPublishStatus `json:"publish_statuses,omitempty" form:"publish_statuses" validate:"omitempty"`
+	EditorialStatuses []InternshipPublishStatus `json:"editorial_statuses,omitempty" form:"editorial_statuses" validate:"omitempty"`
+	CreatedBy         string                    `json:"created_by,omitempty" form:"created_by" validate:"omitempty"`
+
+	// InstructorID matches internships the user authored or teaches as an assigned instructor
+	InstructorID string `json:"instructor_id,omitempty" form:"instructor_id" validate:"omitempty"`
 }
 
 func (f *InternshipFilter) Validate() error {
@@ -97,6 +106,18 @@ func (f *InternshipFilter) Validate() error {
 		}
 	}
 
+	if len(f.PublishStatuses) > 0 {
+		if err := validator.ValidateEnums(f.PublishStatuses, InternshipPublishStatuses, "publish_status"); err != nil {
+			return err
+		}
+	}
+
+	if len(f.EditorialStatuses) > 0 {
+		if err := validator.ValidateEnums(f.EditorialStatuses, InternshipPublishStatuses, "editorial_status"); err != nil {
+			return err
+		}
+	}
+
 	if f.MaxPrice != nil && !f.MaxPrice.GreaterThan(decimal.Zero) {
 		return ierr.NewErrorf("price must be greater than 0").
 			WithReportableDetails(map[string]any{
@@ -196,3 +217,19 @@ func (f *InternshipFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
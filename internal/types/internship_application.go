@@ -0,0 +1,251 @@
+package types
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// ApplicationStatus is where an internship application is in screening
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted   ApplicationStatus = "submitted"
+	ApplicationStatusShortlisted ApplicationStatus = "shortlisted"
+	ApplicationStatusInterview   ApplicationStatus = "interview"
+	// ApplicationStatusAccepted applicants may enroll in the batch until their offer expires
+	ApplicationStatusAccepted  ApplicationStatus = "accepted"
+	ApplicationStatusRejected  ApplicationStatus = "rejected"
+	ApplicationStatusWithdrawn ApplicationStatus = "withdrawn"
+)
+
+var ApplicationStatuses = []ApplicationStatus{
+	ApplicationStatusSubmitted,
+	ApplicationStatusShortlisted,
+	ApplicationStatusInterview,
+	ApplicationStatusAccepted,
+	ApplicationStatusRejected,
+	ApplicationStatusWithdrawn,
+}
+
+// ApplicationStatusesInScreening are the statuses instructors still have to decide on
+var ApplicationStatusesInScreening = []ApplicationStatus{
+	ApplicationStatusSubmitted,
+	ApplicationStatusShortlisted,
+	ApplicationStatusInterview,
+}
+
+func (s ApplicationStatus) Validate() error {
+	if !lo.Contains(ApplicationStatuses, s) {
+		return ierr.NewError("invalid application status").
+			WithHintf("Application status must be one of %v", ApplicationStatuses).
+			WithReportableDetails(map[string]any{"application_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ApplicationQuestion is a custom question applicants to an internship answer
+type ApplicationQuestion struct {
+	ID       string `json:"id,omitempty"`
+	Prompt   string `json:"prompt" validate:"required"`
+	Required bool   `json:"required"`
+
+	// Options restrict the answer to one of them, any text is accepted without options
+	Options []string `json:"options,omitempty"`
+
+	// MaxLength caps the characters of free text answers, zero leaves them uncapped
+	MaxLength int `json:"max_length,omitempty" validate:"min=0"`
+}
+
+// ApplicationForm is what students applying to an internship fill in
+type ApplicationForm struct {
+	Questions      []ApplicationQuestion `json:"questions" validate:"omitempty,dive"`
+	ResumeRequired bool                  `json:"resume_required"`
+}
+
+// EnsureIDs gives the questions of the form ids when they don't have one yet
+func (f *ApplicationForm) EnsureIDs() {
+	for i := range f.Questions {
+		if f.Questions[i].ID == "" {
+			f.Questions[i].ID = GenerateUUIDWithPrefix(UUID_PREFIX_APPLICATION_QUESTION)
+		}
+	}
+}
+
+// ApplicationAnswer answers a question of the application form
+type ApplicationAnswer struct {
+	QuestionID string `json:"question_id" validate:"required"`
+	Answer     string `json:"answer"`
+}
+
+// ValidateAnswers checks the answers fit the form, every required question must be answered
+func (f *ApplicationForm) ValidateAnswers(answers []ApplicationAnswer) error {
+	byQuestion := lo.KeyBy(answers, func(answer ApplicationAnswer) string {
+		return answer.QuestionID
+	})
+	if len(byQuestion) != len(answers) {
+		return ierr.NewError("question answered more than once").
+			WithHint("Answer each question once").
+			Mark(ierr.ErrValidation)
+	}
+
+	questions := lo.KeyBy(f.Questions, func(question ApplicationQuestion) string {
+		return question.ID
+	})
+	for _, answer := range answers {
+		if _, ok := questions[answer.QuestionID]; !ok {
+			return ierr.NewError("answer to an unknown question").
+				WithHint("Answers must refer to questions of the application form").
+				WithReportableDetails(map[string]any{"question_id": answer.QuestionID}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	for _, question := range f.Questions {
+		answer := strings.TrimSpace(byQuestion[question.ID].Answer)
+		if answer == "" {
+			if question.Required {
+				return ierr.NewError("required question not answered").
+					WithHintf("Please answer %q", question.Prompt).
+					WithReportableDetails(map[string]any{"question_id": question.ID}).
+					Mark(ierr.ErrValidation)
+			}
+			continue
+		}
+
+		if len(question.Options) > 0 && !lo.Contains(question.Options, answer) {
+			return ierr.NewError("answer is not one of the options").
+				WithHintf("Answer %q with one of %v", question.Prompt, question.Options).
+				WithReportableDetails(map[string]any{"question_id": question.ID}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if question.MaxLength > 0 && utf8.RuneCountInString(answer) > question.MaxLength {
+			return ierr.NewError("answer is too long").
+				WithHintf("Answer %q in at most %d characters", question.Prompt, question.MaxLength).
+				WithReportableDetails(map[string]any{"question_id": question.ID}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+type InternshipApplicationFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs      []string          `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	InternshipBatchIDs []string          `json:"internship_batch_ids,omitempty" form:"internship_batch_ids" validate:"omitempty"`
+	UserIDs            []string          `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	ApplicationStatus  ApplicationStatus `json:"application_status,omitempty" form:"application_status" validate:"omitempty"`
+}
+
+func (f *InternshipApplicationFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.ApplicationStatus != "" {
+		return f.ApplicationStatus.Validate()
+	}
+
+	return nil
+}
+
+func NewInternshipApplicationFilter() *InternshipApplicationFilter {
+	return &InternshipApplicationFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitInternshipApplicationFilter() *InternshipApplicationFilter {
+	return &InternshipApplicationFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *InternshipApplicationFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipApplicationFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipApplicationFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
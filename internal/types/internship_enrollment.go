@@ -5,6 +5,13 @@ package types
 //
 //		   -> failed
 //	       -> cancelled
+//
+// free enrollments start enrolled and paid ones become enrolled once their payment
+// or first installment succeeds. Only the batch lifecycle completes an enrollment,
+// when the batch ends and the completion criteria are met, and sets completed_at.
+//
+// enrollments paid in installments are suspended while an installment is overdue
+// past the plan's grace period and go back to enrolled once it is paid
 type InternshipEnrollmentStatus string
 
 const (
@@ -14,8 +21,13 @@ const (
 	InternshipEnrollmentStatusRefunded  InternshipEnrollmentStatus = "refunded"
 	InternshipEnrollmentStatusCancelled InternshipEnrollmentStatus = "cancelled"
 	InternshipEnrollmentStatusFailed    InternshipEnrollmentStatus = "failed"
+	InternshipEnrollmentStatusSuspended InternshipEnrollmentStatus = "suspended"
 )
 
+// EnrollmentMetadataDiscountCodes holds the comma separated discount codes a checkout
+// redeemed, they are released again if the checkout expires unpaid
+const EnrollmentMetadataDiscountCodes = "discount_codes"
+
 type InternshipEnrollmentFilter struct {
 	*QueryFilter
 	*TimeRangeFilter
@@ -111,3 +123,19 @@ func (f *InternshipEnrollmentFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipEnrollmentFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipEnrollmentFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
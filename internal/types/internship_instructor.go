@@ -0,0 +1,150 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// InternshipInstructorRole is the role of an instructor on an internship.
+// Owners manage the internship and its instructors, co-instructors and TAs
+// help teach it.
+type InternshipInstructorRole string
+
+const (
+	InternshipInstructorRoleOwner        InternshipInstructorRole = "owner"
+	InternshipInstructorRoleCoInstructor InternshipInstructorRole = "co_instructor"
+	InternshipInstructorRoleTA           InternshipInstructorRole = "ta"
+)
+
+var InternshipInstructorRoles = []InternshipInstructorRole{
+	InternshipInstructorRoleOwner,
+	InternshipInstructorRoleCoInstructor,
+	InternshipInstructorRoleTA,
+}
+
+func (r InternshipInstructorRole) Validate() error {
+	if !lo.Contains(InternshipInstructorRoles, r) {
+		return ierr.NewError("invalid internship instructor role").
+			WithHintf("Role must be one of %v", InternshipInstructorRoles).
+			WithReportableDetails(map[string]any{"role": r}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type InternshipInstructorFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string                   `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	UserIDs       []string                   `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	Roles         []InternshipInstructorRole `json:"roles,omitempty" form:"roles" validate:"omitempty"`
+}
+
+func (f *InternshipInstructorFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, role := range f.Roles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewInternshipInstructorFilter() *InternshipInstructorFilter {
+	return &InternshipInstructorFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitInternshipInstructorFilter() *InternshipInstructorFilter {
+	return &InternshipInstructorFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *InternshipInstructorFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipInstructorFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipInstructorFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// editorial workflow of an internship
+// draft -> in_review -> published
+//
+//	-> rejected -> draft
+//
+// edits to a published internship go into a pending revision that runs through
+// the same draft -> in_review -> published steps while the live version stays untouched
+type InternshipPublishStatus string
+
+const (
+	InternshipPublishStatusDraft     InternshipPublishStatus = "draft"
+	InternshipPublishStatusInReview  InternshipPublishStatus = "in_review"
+	InternshipPublishStatusPublished InternshipPublishStatus = "published"
+	InternshipPublishStatusRejected  InternshipPublishStatus = "rejected"
+)
+
+var InternshipPublishStatuses = []InternshipPublishStatus{
+	InternshipPublishStatusDraft,
+	InternshipPublishStatusInReview,
+	InternshipPublishStatusPublished,
+	InternshipPublishStatusRejected,
+}
+
+func (s InternshipPublishStatus) String() string {
+	return string(s)
+}
+
+func (s InternshipPublishStatus) Validate() error {
+	if !lo.Contains(InternshipPublishStatuses, s) {
+		return ierr.NewError("invalid internship publish status").
+			WithHint("Please provide a valid publish status").
+			WithReportableDetails(map[string]any{
+				"publish_status": s,
+				"allowed":        InternshipPublishStatuses,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// IsEditable reports whether content in this status can still be changed by its author
+func (s InternshipPublishStatus) IsEditable() bool {
+	return s == InternshipPublishStatusDraft || s == InternshipPublishStatusRejected
+}
+
+// InternshipSnapshot is the editable content of an internship, used to hold
+// a pending revision of a published internship
+type InternshipSnapshot struct {
+	Title              string           `json:"title"`
+	LookupKey          string           `json:"lookup_key"`
+	Description        string           `json:"description"`
+	Skills             []string         `json:"skills,omitempty"`
+	Level              InternshipLevel  `json:"level,omitempty"`
+	Mode               InternshipMode   `json:"mode,omitempty"`
+	DurationInWeeks    int              `json:"duration_in_weeks,omitempty"`
+	LearningOutcomes   []string         `json:"learning_outcomes,omitempty"`
+	Prerequisites      []string         `json:"prerequisites,omitempty"`
+	Benefits           []string         `json:"benefits,omitempty"`
+	Currency           string           `json:"currency,omitempty"`
+	Price              decimal.Decimal  `json:"price"`
+	FlatDiscount       *decimal.Decimal `json:"flat_discount,omitempty"`
+	PercentageDiscount *decimal.Decimal `json:"percentage_discount,omitempty"`
+	Subtotal           decimal.Decimal  `json:"subtotal"`
+	Total              decimal.Decimal  `json:"total"`
+}
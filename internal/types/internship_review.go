@@ -0,0 +1,167 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+const (
+	ReviewRatingMin = 1
+	ReviewRatingMax = 5
+)
+
+// ReviewStatus is whether a review is shown on its internship, moderators hide abusive reviews
+type ReviewStatus string
+
+const (
+	ReviewStatusPublished ReviewStatus = "published"
+	ReviewStatusHidden    ReviewStatus = "hidden"
+)
+
+var ReviewStatuses = []ReviewStatus{
+	ReviewStatusPublished,
+	ReviewStatusHidden,
+}
+
+func (s ReviewStatus) Validate() error {
+	if !lo.Contains(ReviewStatuses, s) {
+		return ierr.NewError("invalid review status").
+			WithHintf("Review status must be one of %v", ReviewStatuses).
+			WithReportableDetails(map[string]any{"review_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ReviewReport flags a review for moderators, every user reports a review at most once
+type ReviewReport struct {
+	UserID     string    `json:"user_id"`
+	Reason     string    `json:"reason"`
+	ReportedAt time.Time `json:"reported_at"`
+}
+
+type InternshipReviewFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string     `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	UserIDs       []string     `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	ReviewStatus  ReviewStatus `json:"review_status,omitempty" form:"review_status" validate:"omitempty"`
+	MinRating     int          `json:"min_rating,omitempty" form:"min_rating" validate:"omitempty,min=1,max=5"`
+
+	// Reported only matches reviews at least one user reported
+	Reported bool `json:"reported,omitempty" form:"reported" validate:"omitempty"`
+}
+
+func (f *InternshipReviewFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validator.ValidateRequest(f); err != nil {
+		return err
+	}
+
+	if f.ReviewStatus != "" {
+		return f.ReviewStatus.Validate()
+	}
+
+	return nil
+}
+
+func NewInternshipReviewFilter() *InternshipReviewFilter {
+	return &InternshipReviewFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitInternshipReviewFilter() *InternshipReviewFilter {
+	return &InternshipReviewFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *InternshipReviewFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *InternshipReviewFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *InternshipReviewFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *InternshipReviewFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *InternshipReviewFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *InternshipReviewFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *InternshipReviewFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipReviewFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipReviewFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// InternshipFieldChange is a single field that differs between two revisions
+type InternshipFieldChange struct {
+	Field string          `json:"field"`
+	From  json.RawMessage `json:"from,omitempty"`
+	To    json.RawMessage `json:"to,omitempty"`
+}
+
+// Diff compares the snapshot field by field with another one, fields are
+// compared on their JSON representation and returned in name order
+func (s *InternshipSnapshot) Diff(other *InternshipSnapshot) ([]InternshipFieldChange, error) {
+	from, err := s.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := other.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(from)+len(to))
+	for name := range from {
+		names = append(names, name)
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]InternshipFieldChange, 0)
+	for _, name := range names {
+		if bytes.Equal(from[name], to[name]) {
+			continue
+		}
+		changes = append(changes, InternshipFieldChange{
+			Field: name,
+			From:  from[name],
+			To:    to[name],
+		})
+	}
+
+	return changes, nil
+}
+
+func (s *InternshipSnapshot) fields() (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if s == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read internship revision").
+			Mark(ierr.ErrInternal)
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to read internship revision").
+			Mark(ierr.ErrInternal)
+	}
+
+	return fields, nil
+}
+
+type InternshipRevisionFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipID string `json:"internship_id,omitempty" form:"internship_id" validate:"omitempty"`
+}
+
+func (f *InternshipRevisionFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewInternshipRevisionFilter lists revisions newest first by default
+func NewInternshipRevisionFilter() *InternshipRevisionFilter {
+	queryFilter := NewDefaultQueryFilter()
+	queryFilter.Sort = lo.ToPtr("revision")
+
+	return &InternshipRevisionFilter{
+		QueryFilter:     queryFilter,
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitInternshipRevisionFilter() *InternshipRevisionFilter {
+	queryFilter := NewNoLimitQueryFilter()
+	queryFilter.Sort = lo.ToPtr("revision")
+
+	return &InternshipRevisionFilter{
+		QueryFilter:     queryFilter,
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *InternshipRevisionFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipRevisionFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipRevisionFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
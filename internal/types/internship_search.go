@@ -0,0 +1,290 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// InternshipDurationBucket groups internships by their length for search facets
+type InternshipDurationBucket string
+
+const (
+	InternshipDurationUpTo4Weeks  InternshipDurationBucket = "up_to_4_weeks"
+	InternshipDurationUpTo8Weeks  InternshipDurationBucket = "5_to_8_weeks"
+	InternshipDurationUpTo12Weeks InternshipDurationBucket = "9_to_12_weeks"
+	InternshipDurationOver12Weeks InternshipDurationBucket = "over_12_weeks"
+)
+
+var InternshipDurationBuckets = []InternshipDurationBucket{
+	InternshipDurationUpTo4Weeks,
+	InternshipDurationUpTo8Weeks,
+	InternshipDurationUpTo12Weeks,
+	InternshipDurationOver12Weeks,
+}
+
+// Range returns the inclusive bounds in weeks, max is nil for the open ended bucket
+func (b InternshipDurationBucket) Range() (int, *int) {
+	switch b {
+	case InternshipDurationUpTo4Weeks:
+		return 0, lo.ToPtr(4)
+	case InternshipDurationUpTo8Weeks:
+		return 5, lo.ToPtr(8)
+	case InternshipDurationUpTo12Weeks:
+		return 9, lo.ToPtr(12)
+	default:
+		return 13, nil
+	}
+}
+
+// DurationBucketFor returns the bucket a duration in weeks falls in
+func DurationBucketFor(weeks int) InternshipDurationBucket {
+	for _, bucket := range InternshipDurationBuckets {
+		_, upper := bucket.Range()
+		if upper == nil || weeks <= *upper {
+			return bucket
+		}
+	}
+	return InternshipDurationOver12Weeks
+}
+
+// InternshipPriceBucket groups internships by their total price for search facets
+type InternshipPriceBucket string
+
+const (
+	InternshipPriceFree       InternshipPriceBucket = "free"
+	InternshipPriceUnder1000  InternshipPriceBucket = "under_1000"
+	InternshipPriceUpTo5000   InternshipPriceBucket = "1000_to_5000"
+	InternshipPriceUpTo10000  InternshipPriceBucket = "5000_to_10000"
+	InternshipPriceAbove10000 InternshipPriceBucket = "over_10000"
+)
+
+var InternshipPriceBuckets = []InternshipPriceBucket{
+	InternshipPriceFree,
+	InternshipPriceUnder1000,
+	InternshipPriceUpTo5000,
+	InternshipPriceUpTo10000,
+	InternshipPriceAbove10000,
+}
+
+// Range returns the bounds of the bucket, min is exclusive except for free and
+// max is inclusive, max is nil for the open ended bucket
+func (b InternshipPriceBucket) Range() (decimal.Decimal, *decimal.Decimal) {
+	switch b {
+	case InternshipPriceFree:
+		return decimal.Zero, lo.ToPtr(decimal.Zero)
+	case InternshipPriceUnder1000:
+		return decimal.Zero, lo.ToPtr(decimal.NewFromInt(1000))
+	case InternshipPriceUpTo5000:
+		return decimal.NewFromInt(1000), lo.ToPtr(decimal.NewFromInt(5000))
+	case InternshipPriceUpTo10000:
+		return decimal.NewFromInt(5000), lo.ToPtr(decimal.NewFromInt(10000))
+	default:
+		return decimal.NewFromInt(10000), nil
+	}
+}
+
+// Contains reports whether a total price falls in the bucket
+func (b InternshipPriceBucket) Contains(total decimal.Decimal) bool {
+	if b == InternshipPriceFree {
+		return total.LessThanOrEqual(decimal.Zero)
+	}
+	lower, upper := b.Range()
+	return total.GreaterThan(lower) && (upper == nil || total.LessThanOrEqual(*upper))
+}
+
+// PriceBucketFor returns the bucket a total price falls in
+func PriceBucketFor(total decimal.Decimal) InternshipPriceBucket {
+	for _, bucket := range InternshipPriceBuckets {
+		if bucket.Contains(total) {
+			return bucket
+		}
+	}
+	return InternshipPriceAbove10000
+}
+
+// search facets, each one is counted with every other facet applied but not itself
+// so the catalog can show how many results picking another value would give
+const (
+	InternshipFacetCategory = "category"
+	InternshipFacetLevel    = "level"
+	InternshipFacetMode     = "mode"
+	InternshipFacetDuration = "duration"
+	InternshipFacetPrice    = "price"
+)
+
+// FacetCount is the number of results for one value of a facet
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int    `json:"count"`
+}
+
+// InternshipSearchFacets holds the facet counts of a search
+type InternshipSearchFacets struct {
+	Categories []FacetCount `json:"categories"`
+	Levels     []FacetCount `json:"levels"`
+	Modes      []FacetCount `json:"modes"`
+	Durations  []FacetCount `json:"durations"`
+	Prices     []FacetCount `json:"prices"`
+}
+
+// InternshipSearchFilter is the filter for the catalog search
+type InternshipSearchFilter struct {
+	*QueryFilter
+
+	// Free text query, matched on title, skills, description and learning outcomes
+	Query string `json:"q,omitempty" form:"q" validate:"omitempty,max=200"`
+
+	CategoryIDs     []string                   `json:"category_ids,omitempty" form:"category_ids" validate:"omitempty"`
+	Levels          []InternshipLevel          `json:"levels,omitempty" form:"levels" validate:"omitempty"`
+	Modes           []InternshipMode           `json:"modes,omitempty" form:"modes" validate:"omitempty"`
+	DurationBuckets []InternshipDurationBucket `json:"duration_buckets,omitempty" form:"duration_buckets" validate:"omitempty"`
+	PriceBuckets    []InternshipPriceBucket    `json:"price_buckets,omitempty" form:"price_buckets" validate:"omitempty"`
+
+	// Set by the service, the public catalog only searches published internships
+	PublishStatuses []InternshipPublishStatus `json:"-" form:"-"`
+}
+
+func NewInternshipSearchFilter() *InternshipSearchFilter {
+	return &InternshipSearchFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+func (f *InternshipSearchFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validator.ValidateRequest(f); err != nil {
+		return err
+	}
+
+	if f.GetCursor() != "" {
+		return ierr.NewError("cursor pagination is not supported for search").
+			WithHint("Search results are ranked, page through them with offset instead").
+			WithReportableDetails(map[string]any{
+				"cursor": "is not supported",
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if len(f.Levels) > 0 {
+		if err := validator.ValidateEnums(f.Levels, InternshipLevels, "level"); err != nil {
+			return err
+		}
+	}
+
+	if len(f.Modes) > 0 {
+		if err := validator.ValidateEnums(f.Modes, InternshipModes, "mode"); err != nil {
+			return err
+		}
+	}
+
+	if len(f.DurationBuckets) > 0 {
+		if err := validator.ValidateEnums(f.DurationBuckets, InternshipDurationBuckets, "duration_bucket"); err != nil {
+			return err
+		}
+	}
+
+	if len(f.PriceBuckets) > 0 {
+		if err := validator.ValidateEnums(f.PriceBuckets, InternshipPriceBuckets, "price_bucket"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Without returns a copy of the filter without the selection of one facet
+func (f *InternshipSearchFilter) Without(facet string) *InternshipSearchFilter {
+	clone := *f
+	switch facet {
+	case InternshipFacetCategory:
+		clone.CategoryIDs = nil
+	case InternshipFacetLevel:
+		clone.Levels = nil
+	case InternshipFacetMode:
+		clone.Modes = nil
+	case InternshipFacetDuration:
+		clone.DurationBuckets = nil
+	case InternshipFacetPrice:
+		clone.PriceBuckets = nil
+	}
+	return &clone
+}
+
+// GetLimit implements BaseFilter interface
+func (f *InternshipSearchFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *InternshipSearchFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *InternshipSearchFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *InternshipSearchFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *InternshipSearchFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *InternshipSearchFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *InternshipSearchFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipSearchFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipSearchFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -7,11 +7,17 @@ import (
 	"github.com/samber/lo"
 )
 
+// lifecycle of a batch, driven by its start and end dates
+// upcoming -> ongoing -> completed
+//
+// upcoming and ongoing batches can be paused, which holds the lifecycle until
+// resumed, or cancelled, which refunds every enrollment of the batch
 type InternshipBatchStatus string
 
 const (
 	InternshipBatchStatusUpcoming  InternshipBatchStatus = "upcoming"
 	InternshipBatchStatusOngoing   InternshipBatchStatus = "ongoing"
+	InternshipBatchStatusPaused    InternshipBatchStatus = "paused"
 	InternshipBatchStatusCompleted InternshipBatchStatus = "completed"
 	InternshipBatchStatusCancelled InternshipBatchStatus = "cancelled"
 )
@@ -19,10 +25,21 @@ const (
 var InternshipBatchStatuses = []InternshipBatchStatus{
 	InternshipBatchStatusUpcoming,
 	InternshipBatchStatusOngoing,
+	InternshipBatchStatusPaused,
 	InternshipBatchStatusCompleted,
 	InternshipBatchStatusCancelled,
 }
 
+// IsRunning reports whether the batch is moving through its lifecycle
+func (s InternshipBatchStatus) IsRunning() bool {
+	return s == InternshipBatchStatusUpcoming || s == InternshipBatchStatusOngoing
+}
+
+// IsTerminal reports whether the batch has ended
+func (s InternshipBatchStatus) IsTerminal() bool {
+	return s == InternshipBatchStatusCompleted || s == InternshipBatchStatusCancelled
+}
+
 func (s InternshipBatchStatus) Validate() error {
 	if !lo.Contains(InternshipBatchStatuses, s) {
 		return ierr.NewErrorf("invalid internship batch status").
@@ -144,3 +161,19 @@ func (f *InternshipBatchFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *InternshipBatchFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *InternshipBatchFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,309 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// LiveSessionStatus is whether a live session still takes place
+type LiveSessionStatus string
+
+const (
+	LiveSessionStatusScheduled LiveSessionStatus = "scheduled"
+	LiveSessionStatusCancelled LiveSessionStatus = "cancelled"
+)
+
+var LiveSessionStatuses = []LiveSessionStatus{
+	LiveSessionStatusScheduled,
+	LiveSessionStatusCancelled,
+}
+
+func (s LiveSessionStatus) Validate() error {
+	if !lo.Contains(LiveSessionStatuses, s) {
+		return ierr.NewError("invalid live session status").
+			WithHintf("Live session status must be one of %v", LiveSessionStatuses).
+			WithReportableDetails(map[string]any{"session_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// AttendanceStatus is whether a student attended a live session
+type AttendanceStatus string
+
+const (
+	AttendanceStatusPresent AttendanceStatus = "present"
+	AttendanceStatusAbsent  AttendanceStatus = "absent"
+	// AttendanceStatusExcused leaves the session out of the attendance percentage of the student
+	AttendanceStatusExcused AttendanceStatus = "excused"
+)
+
+var AttendanceStatuses = []AttendanceStatus{
+	AttendanceStatusPresent,
+	AttendanceStatusAbsent,
+	AttendanceStatusExcused,
+}
+
+func (s AttendanceStatus) Validate() error {
+	if !lo.Contains(AttendanceStatuses, s) {
+		return ierr.NewError("invalid attendance status").
+			WithHintf("Attendance status must be one of %v", AttendanceStatuses).
+			WithReportableDetails(map[string]any{"attendance_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type LiveSessionFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs      []string          `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	InternshipBatchIDs []string          `json:"internship_batch_ids,omitempty" form:"internship_batch_ids" validate:"omitempty"`
+	SessionStatus      LiveSessionStatus `json:"session_status,omitempty" form:"session_status" validate:"omitempty"`
+
+	// StartsAfter and StartsBefore limit the sessions to those starting in the window
+	StartsAfter  *time.Time `json:"starts_after,omitempty" form:"starts_after" validate:"omitempty"`
+	StartsBefore *time.Time `json:"starts_before,omitempty" form:"starts_before" validate:"omitempty"`
+	// EndsBefore and EndsAfter limit the sessions to those over or still running at the time
+	EndsBefore *time.Time `json:"-" form:"-"`
+	EndsAfter  *time.Time `json:"-" form:"-"`
+}
+
+func (f *LiveSessionFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.StartsAfter != nil && f.StartsBefore != nil && f.StartsBefore.Before(*f.StartsAfter) {
+		return ierr.NewError("starts_before must be after starts_after").
+			WithHint("The end of the window must be after its start").
+			Mark(ierr.ErrValidation)
+	}
+
+	if f.SessionStatus != "" {
+		return f.SessionStatus.Validate()
+	}
+
+	return nil
+}
+
+// newScheduleQueryFilter lists live sessions in the order they take place
+func newScheduleQueryFilter(queryFilter *QueryFilter) *QueryFilter {
+	queryFilter.Sort = lo.ToPtr("starts_at")
+	queryFilter.Order = lo.ToPtr(OrderAsc)
+	return queryFilter
+}
+
+func NewLiveSessionFilter() *LiveSessionFilter {
+	return &LiveSessionFilter{
+		QueryFilter:     newScheduleQueryFilter(NewDefaultQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitLiveSessionFilter() *LiveSessionFilter {
+	return &LiveSessionFilter{
+		QueryFilter:     newScheduleQueryFilter(NewNoLimitQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *LiveSessionFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *LiveSessionFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *LiveSessionFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *LiveSessionFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *LiveSessionFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *LiveSessionFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *LiveSessionFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *LiveSessionFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *LiveSessionFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
+
+type SessionAttendanceFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	LiveSessionIDs     []string         `json:"live_session_ids,omitempty" form:"live_session_ids" validate:"omitempty"`
+	InternshipBatchIDs []string         `json:"internship_batch_ids,omitempty" form:"internship_batch_ids" validate:"omitempty"`
+	EnrollmentIDs      []string         `json:"enrollment_ids,omitempty" form:"enrollment_ids" validate:"omitempty"`
+	UserIDs            []string         `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	AttendanceStatus   AttendanceStatus `json:"attendance_status,omitempty" form:"attendance_status" validate:"omitempty"`
+}
+
+func (f *SessionAttendanceFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.AttendanceStatus != "" {
+		return f.AttendanceStatus.Validate()
+	}
+
+	return nil
+}
+
+func NewSessionAttendanceFilter() *SessionAttendanceFilter {
+	return &SessionAttendanceFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitSessionAttendanceFilter() *SessionAttendanceFilter {
+	return &SessionAttendanceFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *SessionAttendanceFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *SessionAttendanceFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *SessionAttendanceFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,236 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// NotificationProvider is the service emails are sent through
+type NotificationProvider string
+
+const (
+	NotificationProviderSMTP NotificationProvider = "smtp"
+	// NotificationProviderLog logs emails and writes them to files instead of sending them, for local use
+	NotificationProviderLog NotificationProvider = "log"
+)
+
+// DefaultLocale is the locale notifications are sent in when there is none for the user's locale
+const DefaultLocale = "en"
+
+// NotificationCategory groups the notifications users can mute together
+type NotificationCategory string
+
+const (
+	NotificationCategoryEnrollment  NotificationCategory = "enrollment"
+	NotificationCategoryPayment     NotificationCategory = "payment"
+	NotificationCategoryBatch       NotificationCategory = "batch"
+	NotificationCategoryLearning    NotificationCategory = "learning"
+	NotificationCategoryApplication NotificationCategory = "application"
+	NotificationCategoryReview      NotificationCategory = "review"
+	NotificationCategoryWishlist    NotificationCategory = "wishlist"
+)
+
+var NotificationCategories = []NotificationCategory{
+	NotificationCategoryEnrollment,
+	NotificationCategoryPayment,
+	NotificationCategoryBatch,
+	NotificationCategoryLearning,
+	NotificationCategoryApplication,
+	NotificationCategoryReview,
+	NotificationCategoryWishlist,
+}
+
+// notificationCategories maps the events users are notified of to their category,
+// events left out are not notified
+var notificationCategories = map[string]NotificationCategory{
+	WebhookEventEnrollmentConfirmed:           NotificationCategoryEnrollment,
+	WebhookEventEnrollmentSuspended:           NotificationCategoryEnrollment,
+	WebhookEventEnrollmentCompleted:           NotificationCategoryEnrollment,
+	WebhookEventPaymentSucceeded:              NotificationCategoryPayment,
+	WebhookEventInstallmentReminder:           NotificationCategoryPayment,
+	WebhookEventInstallmentOverdue:            NotificationCategoryPayment,
+	WebhookEventSubscriptionActivated:         NotificationCategoryPayment,
+	WebhookEventSubscriptionPaymentFailed:     NotificationCategoryPayment,
+	WebhookEventBatchReminder:                 NotificationCategoryBatch,
+	WebhookEventSubmissionGraded:              NotificationCategoryLearning,
+	WebhookEventCertificateIssued:             NotificationCategoryLearning,
+	WebhookEventApplicationShortlisted:        NotificationCategoryApplication,
+	WebhookEventApplicationInterviewScheduled: NotificationCategoryApplication,
+	WebhookEventApplicationAccepted:           NotificationCategoryApplication,
+	WebhookEventApplicationRejected:           NotificationCategoryApplication,
+	WebhookEventReviewReplied:                 NotificationCategoryReview,
+	WebhookEventReviewHidden:                  NotificationCategoryReview,
+	WebhookEventWishlistBatchAnnounced:        NotificationCategoryWishlist,
+	WebhookEventWishlistPriceDropped:          NotificationCategoryWishlist,
+	WebhookEventWishlistSaleStarted:           NotificationCategoryWishlist,
+}
+
+// GetNotificationCategory returns the category of an event, ok is false for events users are not notified of
+func GetNotificationCategory(eventName string) (category NotificationCategory, ok bool) {
+	category, ok = notificationCategories[eventName]
+	return category, ok
+}
+
+// NotificationPreferences is how a user wants to be notified
+type NotificationPreferences struct {
+	// Locale notifications are written in, such as en or hi-IN
+	Locale string `json:"locale" validate:"omitempty,bcp47_language_tag"`
+
+	// DisableEmail turns every email off, in-app notifications are still created
+	DisableEmail bool `json:"disable_email"`
+
+	// MutedCategories are the categories the user is not notified of, by email or in-app
+	MutedCategories []NotificationCategory `json:"muted_categories"`
+}
+
+// DefaultNotificationPreferences are the preferences of users who did not change theirs
+func DefaultNotificationPreferences() NotificationPreferences {
+	return NotificationPreferences{
+		Locale:          DefaultLocale,
+		MutedCategories: []NotificationCategory{},
+	}
+}
+
+func (p NotificationPreferences) Validate() error {
+	if err := validator.ValidateRequest(p); err != nil {
+		return err
+	}
+
+	return validator.ValidateEnums(p.MutedCategories, NotificationCategories, "muted_categories")
+}
+
+// Allows reports whether the user wants to be notified of the category at all
+func (p NotificationPreferences) Allows(category NotificationCategory) bool {
+	return !lo.Contains(p.MutedCategories, category)
+}
+
+// AllowsEmail reports whether the user wants emails of the category
+func (p NotificationPreferences) AllowsEmail(category NotificationCategory) bool {
+	return !p.DisableEmail && p.Allows(category)
+}
+
+// GetLocale returns the locale of the preferences, the default locale when none is set
+func (p NotificationPreferences) GetLocale() string {
+	if strings.TrimSpace(p.Locale) == "" {
+		return DefaultLocale
+	}
+	return p.Locale
+}
+
+type NotificationFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	UserIDs    []string               `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	Categories []NotificationCategory `json:"categories,omitempty" form:"categories" validate:"omitempty"`
+
+	// Unread only returns notifications that were not read yet
+	Unread bool `json:"unread,omitempty" form:"unread"`
+}
+
+func (f *NotificationFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validator.ValidateEnums(f.Categories, NotificationCategories, "categories"); err != nil {
+		return err
+	}
+
+	return validator.ValidateRequest(f)
+}
+
+func NewNotificationFilter() *NotificationFilter {
+	return &NotificationFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitNotificationFilter() *NotificationFilter {
+	return &NotificationFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *NotificationFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *NotificationFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *NotificationFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *NotificationFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *NotificationFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *NotificationFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *NotificationFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *NotificationFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *NotificationFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
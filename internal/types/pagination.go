@@ -5,6 +5,13 @@ type PaginationResponse struct {
 	Total  int `json:"total"`
 	Limit  int `json:"limit"`
 	Offset int `json:"offset"`
+
+	// NextCursor and PrevCursor page from the edges of the current page
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	// TotalSkipped is set when the total was not counted, either on request
+	// or because the page was fetched with a cursor
+	TotalSkipped bool `json:"total_skipped,omitempty"`
 }
 
 // ListResponse represents a paginated response with items
@@ -22,6 +29,47 @@ func NewPaginationResponse(total, limit, offset int) PaginationResponse {
 	}
 }
 
+// NewCursorPaginationResponse creates a pagination response for a page of items,
+// with cursors pointing at its first and last item. A total of nil means it was
+// not counted.
+func NewCursorPaginationResponse[T any](items []T, filter BaseFilter, total *int) PaginationResponse {
+	response := NewPaginationResponse(0, filter.GetLimit(), filter.GetOffset())
+	if total != nil {
+		response.Total = *total
+	} else {
+		response.TotalSkipped = true
+	}
+
+	if filter.IsUnlimited() || len(items) == 0 {
+		return response
+	}
+
+	backward := false
+	if filter.GetCursor() != "" {
+		if cursor, err := DecodeCursor(filter.GetCursor()); err == nil {
+			backward = cursor.IsBackward()
+		}
+	}
+
+	// A full page may have more rows after it, a backward page always does
+	if len(items) == filter.GetLimit() || backward {
+		if cursor := NewCursorAt(items[len(items)-1], filter, CursorDirectionNext); cursor != nil {
+			response.NextCursor = cursor.Encode()
+		}
+	}
+
+	// Rows before the page exist once we've moved past the first one
+	hasPrev := filter.GetOffset() > 0 || (filter.GetCursor() != "" && !backward) ||
+		(backward && len(items) == filter.GetLimit())
+	if hasPrev {
+		if cursor := NewCursorAt(items[0], filter, CursorDirectionPrev); cursor != nil {
+			response.PrevCursor = cursor.Encode()
+		}
+	}
+
+	return response
+}
+
 // NewListResponse creates a new list response with pagination
 func NewListResponse[T any](items []T, total, limit, offset int) ListResponse[T] {
 	return ListResponse[T]{
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	ierr "github.com/omkar273/codegeeky/internal/errors"
 	"github.com/samber/lo"
 )
@@ -29,6 +31,7 @@ func (s PaymentStatus) Validate() error {
 		PaymentStatusPending,
 		PaymentStatusSuccess,
 		PaymentStatusFailed,
+		PaymentStatusRefunded,
 	}
 	if !lo.Contains(allowed, s) {
 		return ierr.NewError("invalid payment status").
@@ -41,6 +44,18 @@ func (s PaymentStatus) Validate() error {
 	return nil
 }
 
+// PaymentRefundStatus is where a refund to the source of a payment stands at the gateway
+type PaymentRefundStatus string
+
+const (
+	// PaymentRefundStatusPending means the refund was recorded but not yet accepted by the gateway
+	PaymentRefundStatusPending PaymentRefundStatus = "pending"
+	// PaymentRefundStatusProcessed means the gateway accepted the refund
+	PaymentRefundStatusProcessed PaymentRefundStatus = "processed"
+	// PaymentRefundStatusFailed means the gateway turned the refund down, it can be sent again
+	PaymentRefundStatusFailed PaymentRefundStatus = "failed"
+)
+
 type PaymentMethodType string
 
 const (
@@ -104,10 +119,11 @@ func (s PaymentGatewayProvider) Validate() error {
 type PaymentDestinationType string
 
 const (
-	PaymentDestinationTypeInternship PaymentDestinationType = "internship"
-	PaymentDestinationTypeEnrollment PaymentDestinationType = "enrollment"
-	PaymentDestinationTypeCourse     PaymentDestinationType = "course"
-	PaymentDestinationTypeOrder      PaymentDestinationType = "order"
+	PaymentDestinationTypeInternship   PaymentDestinationType = "internship"
+	PaymentDestinationTypeEnrollment   PaymentDestinationType = "enrollment"
+	PaymentDestinationTypeCourse       PaymentDestinationType = "course"
+	PaymentDestinationTypeOrder        PaymentDestinationType = "order"
+	PaymentDestinationTypeSubscription PaymentDestinationType = "subscription"
 )
 
 func (s PaymentDestinationType) Validate() error {
@@ -116,6 +132,7 @@ func (s PaymentDestinationType) Validate() error {
 		PaymentDestinationTypeEnrollment,
 		PaymentDestinationTypeCourse,
 		PaymentDestinationTypeOrder,
+		PaymentDestinationTypeSubscription,
 	}
 	if !lo.Contains(allowed, s) {
 		return ierr.NewError("invalid payment destination type").
@@ -161,6 +178,9 @@ type PaymentFilter struct {
 	PaymentStatus     *string  `form:"payment_status"`
 	PaymentGateway    *string  `form:"payment_gateway"`
 	Currency          *string  `form:"currency"`
+
+	// DueDateBefore limits the list to installments due at or before the given time
+	DueDateBefore *time.Time `form:"due_date_before"`
 }
 
 // NewNoLimitPaymentFilter creates a new payment filter with no limit
@@ -239,3 +259,19 @@ func (f *PaymentFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *PaymentFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *PaymentFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// PaymentPlanInstallmentsMin is the least number of installments a plan can split a price into
+	PaymentPlanInstallmentsMin = 2
+	// PaymentPlanInstallmentsMax is the most number of installments a plan can split a price into
+	PaymentPlanInstallmentsMax = 12
+)
+
+// Payment metadata keys used to remember which installment notices were sent
+const (
+	PaymentMetadataInstallmentReminderSent = "installment_reminder_sent"
+	PaymentMetadataInstallmentOverdueSent  = "installment_overdue_sent"
+)
+
+// PaymentPlanInstallment is one part of a payment plan
+type PaymentPlanInstallment struct {
+	// Share of the enrollment total, in percent
+	Percentage decimal.Decimal `json:"percentage"`
+
+	// Days relative to the batch start date the installment is due, negative is before the start
+	DueOffsetDays int `json:"due_offset_days"`
+}
+
+// DueDate returns when the installment is due for a batch starting at batchStart
+func (i PaymentPlanInstallment) DueDate(batchStart time.Time) time.Time {
+	return batchStart.AddDate(0, 0, i.DueOffsetDays)
+}
+
+// ValidatePaymentPlanInstallments checks that installments add up to the full price and are in due order
+func ValidatePaymentPlanInstallments(installments []PaymentPlanInstallment) error {
+	if len(installments) < PaymentPlanInstallmentsMin || len(installments) > PaymentPlanInstallmentsMax {
+		return ierr.NewError("invalid number of installments").
+			WithHintf("A payment plan must have between %d and %d installments", PaymentPlanInstallmentsMin, PaymentPlanInstallmentsMax).
+			Mark(ierr.ErrValidation)
+	}
+
+	total := decimal.Zero
+	for i, installment := range installments {
+		if !installment.Percentage.IsPositive() {
+			return ierr.NewError("installment percentage must be greater than zero").
+				WithHint("Every installment must cover part of the price").
+				WithReportableDetails(map[string]any{
+					"installment": i + 1,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if i > 0 && installment.DueOffsetDays < installments[i-1].DueOffsetDays {
+			return ierr.NewError("installments are not in due order").
+				WithHint("Installments must be ordered by due date").
+				WithReportableDetails(map[string]any{
+					"installment": i + 1,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		total = total.Add(installment.Percentage)
+	}
+
+	if !total.Equal(decimal.NewFromInt(100)) {
+		return ierr.NewError("installment percentages must add up to 100").
+			WithHint("Installments must cover the full price").
+			WithReportableDetails(map[string]any{
+				"total": total,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+type PaymentPlanFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipID string `json:"internship_id,omitempty" form:"internship_id" validate:"omitempty"`
+	IsActive     *bool  `json:"is_active,omitempty" form:"is_active" validate:"omitempty"`
+}
+
+func (f *PaymentPlanFilter) Validate() error {
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.TimeRangeFilter.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewPaymentPlanFilter() *PaymentPlanFilter {
+	return &PaymentPlanFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitPaymentPlanFilter() *PaymentPlanFilter {
+	return &PaymentPlanFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *PaymentPlanFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *PaymentPlanFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *PaymentPlanFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *PaymentPlanFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *PaymentPlanFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *PaymentPlanFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *PaymentPlanFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *PaymentPlanFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *PaymentPlanFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
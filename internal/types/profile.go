@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strings"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// ProfileVisibility is who can see the profile of a user
+type ProfileVisibility string
+
+const (
+	ProfileVisibilityPublic ProfileVisibility = "public"
+	// ProfileVisibilityRecruiters profiles are only shown to signed in recruiters and admins
+	ProfileVisibilityRecruiters ProfileVisibility = "recruiters"
+	ProfileVisibilityPrivate    ProfileVisibility = "private"
+)
+
+var ProfileVisibilities = []ProfileVisibility{
+	ProfileVisibilityPublic,
+	ProfileVisibilityRecruiters,
+	ProfileVisibilityPrivate,
+}
+
+func (v ProfileVisibility) Validate() error {
+	if !lo.Contains(ProfileVisibilities, v) {
+		return ierr.NewError("invalid profile visibility").
+			WithHintf("Profile visibility must be one of %v", ProfileVisibilities).
+			WithReportableDetails(map[string]any{"profile_visibility": v}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// Education is a degree or course a student took
+type Education struct {
+	Institution  string `json:"institution" validate:"required,max=255"`
+	Degree       string `json:"degree,omitempty" validate:"omitempty,max=255"`
+	FieldOfStudy string `json:"field_of_study,omitempty" validate:"omitempty,max=255"`
+	StartYear    int    `json:"start_year,omitempty" validate:"omitempty,min=1900,max=2100"`
+
+	// EndYear is left out while the student is still studying
+	EndYear *int   `json:"end_year,omitempty" validate:"omitempty,min=1900,max=2100,gtefield=StartYear"`
+	Grade   string `json:"grade,omitempty" validate:"omitempty,max=50"`
+}
+
+// ProfileLink points to a portfolio, repository or social profile of a user
+type ProfileLink struct {
+	Label string `json:"label" validate:"required,max=100"`
+	URL   string `json:"url" validate:"required,url"`
+}
+
+// NormalizeSkills trims skills and drops empty ones and ones listed twice in another case
+func NormalizeSkills(skills []string) []string {
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.TrimSpace(skill)
+		key := strings.ToLower(skill)
+		if skill == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, skill)
+	}
+	return normalized
+}
+
+// MatchSkills returns the skills also found in against, regardless of case
+func MatchSkills(skills []string, against []string) []string {
+	wanted := lo.SliceToMap(against, func(skill string) (string, bool) {
+		return strings.ToLower(strings.TrimSpace(skill)), true
+	})
+	return lo.Filter(skills, func(skill string, _ int) bool {
+		return wanted[strings.ToLower(strings.TrimSpace(skill))]
+	})
+}
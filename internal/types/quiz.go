@@ -0,0 +1,484 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// QuizAttemptGracePeriod is how long after a timed attempt expires its answers are still
+// accepted, covering the time the submission takes to reach the server
+const QuizAttemptGracePeriod = 30 * time.Second
+
+// QuestionType is how a quiz question is answered
+type QuestionType string
+
+const (
+	// QuestionTypeMultipleChoice has exactly one correct option
+	QuestionTypeMultipleChoice QuestionType = "multiple_choice"
+	// QuestionTypeMultiSelect has one or more correct options, all of them must be picked
+	QuestionTypeMultiSelect QuestionType = "multi_select"
+	// QuestionTypeShortAnswer is answered with text matched against the accepted answers
+	QuestionTypeShortAnswer QuestionType = "short_answer"
+)
+
+var QuestionTypes = []QuestionType{
+	QuestionTypeMultipleChoice,
+	QuestionTypeMultiSelect,
+	QuestionTypeShortAnswer,
+}
+
+func (t QuestionType) Validate() error {
+	if !lo.Contains(QuestionTypes, t) {
+		return ierr.NewError("invalid question type").
+			WithHintf("Question type must be one of %v", QuestionTypes).
+			WithReportableDetails(map[string]any{"question_type": t}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// AnswerMatch is how short answers are compared to the accepted answers
+type AnswerMatch string
+
+const (
+	AnswerMatchExact AnswerMatch = "exact"
+	// AnswerMatchRegex treats the accepted answers as regular expressions the whole answer must match
+	AnswerMatchRegex AnswerMatch = "regex"
+)
+
+var AnswerMatches = []AnswerMatch{
+	AnswerMatchExact,
+	AnswerMatchRegex,
+}
+
+func (m AnswerMatch) Validate() error {
+	if !lo.Contains(AnswerMatches, m) {
+		return ierr.NewError("invalid answer match").
+			WithHintf("Answer match must be one of %v", AnswerMatches).
+			WithReportableDetails(map[string]any{"answer_match": m}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// QuizAttemptStatus is where a quiz attempt is
+type QuizAttemptStatus string
+
+const (
+	QuizAttemptStatusInProgress QuizAttemptStatus = "in_progress"
+	QuizAttemptStatusSubmitted  QuizAttemptStatus = "submitted"
+	// QuizAttemptStatusExpired attempts ran out of time before they were submitted and score nothing
+	QuizAttemptStatusExpired QuizAttemptStatus = "expired"
+)
+
+var QuizAttemptStatuses = []QuizAttemptStatus{
+	QuizAttemptStatusInProgress,
+	QuizAttemptStatusSubmitted,
+	QuizAttemptStatusExpired,
+}
+
+func (s QuizAttemptStatus) Validate() error {
+	if !lo.Contains(QuizAttemptStatuses, s) {
+		return ierr.NewError("invalid quiz attempt status").
+			WithHintf("Quiz attempt status must be one of %v", QuizAttemptStatuses).
+			WithReportableDetails(map[string]any{"attempt_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// QuizOption is one of the options of a choice question
+type QuizOption struct {
+	ID      string `json:"id,omitempty"`
+	Text    string `json:"text" validate:"required"`
+	Correct bool   `json:"correct"`
+}
+
+// QuizQuestion is a question of the pool of a quiz, with its answer
+type QuizQuestion struct {
+	ID           string       `json:"id,omitempty"`
+	QuestionType QuestionType `json:"question_type" validate:"required"`
+	Prompt       string       `json:"prompt" validate:"required"`
+	Points       float64      `json:"points" validate:"min=0"`
+
+	// Options of multiple choice and multi select questions
+	Options []QuizOption `json:"options,omitempty" validate:"omitempty,dive"`
+
+	// AcceptedAnswers of short answer questions, compared as AnswerMatch says
+	AcceptedAnswers []string    `json:"accepted_answers,omitempty"`
+	AnswerMatch     AnswerMatch `json:"answer_match,omitempty"`
+	CaseSensitive   bool        `json:"case_sensitive,omitempty"`
+
+	// Explanation is shown with the answer once an attempt is submitted
+	Explanation string `json:"explanation,omitempty"`
+}
+
+// Validate checks the question can be answered and graded
+func (q *QuizQuestion) Validate() error {
+	if err := q.QuestionType.Validate(); err != nil {
+		return err
+	}
+
+	switch q.QuestionType {
+	case QuestionTypeMultipleChoice, QuestionTypeMultiSelect:
+		if len(q.Options) < 2 {
+			return ierr.NewError("question needs at least two options").
+				WithHint("Choice questions need at least two options").
+				WithReportableDetails(map[string]any{"question_id": q.ID}).
+				Mark(ierr.ErrValidation)
+		}
+
+		correct := lo.CountBy(q.Options, func(option QuizOption) bool { return option.Correct })
+		if correct == 0 || (q.QuestionType == QuestionTypeMultipleChoice && correct > 1) {
+			return ierr.NewError("question has the wrong number of correct options").
+				WithHint("Multiple choice questions need exactly one correct option and multi select questions at least one").
+				WithReportableDetails(map[string]any{"question_id": q.ID}).
+				Mark(ierr.ErrValidation)
+		}
+
+	case QuestionTypeShortAnswer:
+		if len(q.AcceptedAnswers) == 0 {
+			return ierr.NewError("question has no accepted answers").
+				WithHint("Short answer questions need at least one accepted answer").
+				WithReportableDetails(map[string]any{"question_id": q.ID}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if err := q.AnswerMatch.Validate(); err != nil {
+			return err
+		}
+
+		if q.AnswerMatch == AnswerMatchRegex {
+			for _, accepted := range q.AcceptedAnswers {
+				if _, err := q.compile(accepted); err != nil {
+					return ierr.WithError(err).
+						WithHintf("Accepted answer %s is not a valid regular expression", accepted).
+						WithReportableDetails(map[string]any{"question_id": q.ID}).
+						Mark(ierr.ErrValidation)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EnsureIDs gives the question and its options ids when they don't have one yet
+func (q *QuizQuestion) EnsureIDs() {
+	if q.ID == "" {
+		q.ID = GenerateUUIDWithPrefix(UUID_PREFIX_QUIZ_QUESTION)
+	}
+
+	for i := range q.Options {
+		if q.Options[i].ID == "" {
+			q.Options[i].ID = GenerateUUIDWithPrefix(UUID_PREFIX_QUIZ_OPTION)
+		}
+	}
+}
+
+// IsCorrect grades an answer to the question, multi select answers must pick every correct
+// option and nothing else
+func (q *QuizQuestion) IsCorrect(answer *QuizAnswer) bool {
+	if answer == nil {
+		return false
+	}
+
+	switch q.QuestionType {
+	case QuestionTypeMultipleChoice, QuestionTypeMultiSelect:
+		correct := lo.FilterMap(q.Options, func(option QuizOption, _ int) (string, bool) {
+			return option.ID, option.Correct
+		})
+		picked := lo.Uniq(answer.OptionIDs)
+		return len(picked) == len(correct) && lo.Every(correct, picked)
+
+	case QuestionTypeShortAnswer:
+		text := strings.TrimSpace(answer.Text)
+		return lo.SomeBy(q.AcceptedAnswers, func(accepted string) bool {
+			if q.AnswerMatch == AnswerMatchRegex {
+				pattern, err := q.compile(accepted)
+				return err == nil && pattern.MatchString(text)
+			}
+			if q.CaseSensitive {
+				return text == strings.TrimSpace(accepted)
+			}
+			return strings.EqualFold(text, strings.TrimSpace(accepted))
+		})
+	}
+
+	return false
+}
+
+// compile anchors an accepted answer pattern so it has to match the whole answer
+func (q *QuizQuestion) compile(pattern string) (*regexp.Regexp, error) {
+	flags := ""
+	if !q.CaseSensitive {
+		flags = "(?i)"
+	}
+	return regexp.Compile(flags + "^(?:" + pattern + ")$")
+}
+
+// ValidateQuizQuestions checks every question of a quiz pool and that their ids are distinct
+func ValidateQuizQuestions(questions []QuizQuestion) error {
+	ids := make(map[string]bool, len(questions))
+	for i := range questions {
+		if err := questions[i].Validate(); err != nil {
+			return err
+		}
+
+		if ids[questions[i].ID] {
+			return ierr.NewError("duplicate quiz question").
+				WithHintf("Question %s is listed more than once", questions[i].ID).
+				WithReportableDetails(map[string]any{"question_id": questions[i].ID}).
+				Mark(ierr.ErrValidation)
+		}
+		ids[questions[i].ID] = true
+	}
+
+	return nil
+}
+
+// QuizAnswer is the answer of a student to one question, OptionIDs for choice questions and Text for short answers
+type QuizAnswer struct {
+	QuestionID string   `json:"question_id" validate:"required"`
+	OptionIDs  []string `json:"option_ids,omitempty"`
+	Text       string   `json:"text,omitempty"`
+}
+
+// QuizQuestionResult is how an answer to one question was graded
+type QuizQuestionResult struct {
+	QuestionID string  `json:"question_id"`
+	Correct    bool    `json:"correct"`
+	Points     float64 `json:"points"`
+}
+
+type QuizFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs []string `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	ModuleIDs     []string `json:"module_ids,omitempty" form:"module_ids" validate:"omitempty"`
+}
+
+func (f *QuizFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewQuizFilter() *QuizFilter {
+	return &QuizFilter{
+		QueryFilter:     newContentQueryFilter(NewDefaultQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitQuizFilter() *QuizFilter {
+	return &QuizFilter{
+		QueryFilter:     newContentQueryFilter(NewNoLimitQueryFilter()),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *QuizFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *QuizFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *QuizFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *QuizFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *QuizFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *QuizFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *QuizFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *QuizFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *QuizFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
+
+type QuizAttemptFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	QuizIDs       []string          `json:"quiz_ids,omitempty" form:"quiz_ids" validate:"omitempty"`
+	InternshipIDs []string          `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	EnrollmentIDs []string          `json:"enrollment_ids,omitempty" form:"enrollment_ids" validate:"omitempty"`
+	UserIDs       []string          `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	AttemptStatus QuizAttemptStatus `json:"attempt_status,omitempty" form:"attempt_status" validate:"omitempty"`
+}
+
+func (f *QuizAttemptFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.AttemptStatus != "" {
+		return f.AttemptStatus.Validate()
+	}
+
+	return nil
+}
+
+func NewQuizAttemptFilter() *QuizAttemptFilter {
+	return &QuizAttemptFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitQuizAttemptFilter() *QuizAttemptFilter {
+	return &QuizAttemptFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *QuizAttemptFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *QuizAttemptFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *QuizAttemptFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *QuizAttemptFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *QuizAttemptFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *QuizAttemptFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *QuizAttemptFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *QuizAttemptFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *QuizAttemptFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"github.com/omkar273/codegeeky/internal/validator"
+	"github.com/samber/lo"
+)
+
+// RecommendationReasonType says which signal put an internship in a user's recommendations
+type RecommendationReasonType string
+
+const (
+	// RecommendationReasonSkillMatch means the internship teaches skills the user already has
+	RecommendationReasonSkillMatch RecommendationReasonType = "skill_match"
+	// RecommendationReasonPrerequisitesMet means the user has every prerequisite of the internship
+	RecommendationReasonPrerequisitesMet RecommendationReasonType = "prerequisites_met"
+	// RecommendationReasonLevelProgression means the internship is the next level up from what the user completed
+	RecommendationReasonLevelProgression RecommendationReasonType = "level_progression"
+	// RecommendationReasonPopular means many students enrolled in the internship
+	RecommendationReasonPopular RecommendationReasonType = "popular"
+)
+
+// RecommendationReason explains one part of a recommendation's score
+type RecommendationReason struct {
+	Type    RecommendationReasonType `json:"type"`
+	Message string                   `json:"message"`
+	// Skills lists the skills the reason is about, for skill matches and prerequisites
+	Skills []string `json:"skills,omitempty"`
+	// Score is what the reason added to the recommendation's score
+	Score float64 `json:"score"`
+}
+
+// RecommendationFilter narrows down the internships recommended to a user
+type RecommendationFilter struct {
+	Limit  int               `json:"limit,omitempty" form:"limit" validate:"omitempty,min=1,max=50"`
+	Levels []InternshipLevel `json:"levels,omitempty" form:"levels" validate:"omitempty"`
+	Modes  []InternshipMode  `json:"modes,omitempty" form:"modes" validate:"omitempty"`
+}
+
+func NewRecommendationFilter() *RecommendationFilter {
+	return &RecommendationFilter{Limit: 10}
+}
+
+func (f *RecommendationFilter) Validate() error {
+	if err := validator.ValidateRequest(f); err != nil {
+		return err
+	}
+
+	if len(f.Levels) > 0 {
+		if err := validator.ValidateEnums(f.Levels, InternshipLevels, "level"); err != nil {
+			return err
+		}
+	}
+
+	if len(f.Modes) > 0 {
+		if err := validator.ValidateEnums(f.Modes, InternshipModes, "mode"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *RecommendationFilter) GetLimit() int {
+	if f.Limit == 0 {
+		return NewRecommendationFilter().Limit
+	}
+	return f.Limit
+}
+
+// Rank orders levels from beginner up, unknown levels rank below beginner
+func (l InternshipLevel) Rank() int {
+	return lo.IndexOf(InternshipLevels, l)
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// lifecycle of referral
+// pending -> qualified -> rewarded
+//
+//	-> rejected (self referral / duplicate account)
+//	-> cancelled (qualifying payment refunded within the refund window)
+type ReferralStatus string
+
+const (
+	ReferralStatusPending   ReferralStatus = "pending"
+	ReferralStatusQualified ReferralStatus = "qualified"
+	ReferralStatusRewarded  ReferralStatus = "rewarded"
+	ReferralStatusRejected  ReferralStatus = "rejected"
+	ReferralStatusCancelled ReferralStatus = "cancelled"
+)
+
+func (s ReferralStatus) String() string {
+	return string(s)
+}
+
+func (s ReferralStatus) Validate() error {
+	allowed := []ReferralStatus{
+		ReferralStatusPending,
+		ReferralStatusQualified,
+		ReferralStatusRewarded,
+		ReferralStatusRejected,
+		ReferralStatusCancelled,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid referral status").
+			WithHint("Please provide a valid referral status").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+const (
+	ReferralRejectionReasonSelfReferral     = "self_referral"
+	ReferralRejectionReasonDuplicateAccount = "duplicate_account"
+)
+
+// ReferralRewardType is what the referrer earns once a referral is rewarded
+type ReferralRewardType string
+
+const (
+	ReferralRewardTypeCoupon ReferralRewardType = "coupon"
+	ReferralRewardTypeCredit ReferralRewardType = "credit"
+)
+
+func (t ReferralRewardType) Validate() error {
+	allowed := []ReferralRewardType{
+		ReferralRewardTypeCoupon,
+		ReferralRewardTypeCredit,
+	}
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid referral reward type").
+			WithHint("Please provide a valid referral reward type").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+type ReferralFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	ReferrerID     string         `json:"referrer_id,omitempty" form:"referrer_id" validate:"omitempty"`
+	RefereeID      string         `json:"referee_id,omitempty" form:"referee_id" validate:"omitempty"`
+	ReferralStatus ReferralStatus `json:"referral_status,omitempty" form:"referral_status" validate:"omitempty"`
+	PaymentID      string         `json:"payment_id,omitempty" form:"payment_id" validate:"omitempty"`
+}
+
+func (f *ReferralFilter) Validate() error {
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.TimeRangeFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.ReferralStatus != "" {
+		if err := f.ReferralStatus.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewReferralFilter() *ReferralFilter {
+	return &ReferralFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitReferralFilter() *ReferralFilter {
+	return &ReferralFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *ReferralFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *ReferralFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *ReferralFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *ReferralFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *ReferralFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *ReferralFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *ReferralFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *ReferralFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *ReferralFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
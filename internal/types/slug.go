@@ -0,0 +1,43 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+)
+
+// slugPattern matches lowercase words of letters and digits joined by single hyphens
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// Slugify turns a display name into a URL friendly slug, e.g. "Web & Mobile" becomes "web-mobile"
+func Slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
+
+// ValidateSlug checks that a slug is made of lowercase letters, digits and single hyphens
+func ValidateSlug(slug string) error {
+	if len(slug) > 255 || !slugPattern.MatchString(slug) {
+		return ierr.NewError("invalid slug").
+			WithHint("Slugs may only contain lowercase letters, digits and single hyphens").
+			WithReportableDetails(map[string]any{
+				"slug": slug,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
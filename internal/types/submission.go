@@ -0,0 +1,247 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// SubmissionType is the form a student hands in their work in
+type SubmissionType string
+
+const (
+	SubmissionTypeFile       SubmissionType = "file"
+	SubmissionTypeRepository SubmissionType = "repository"
+	SubmissionTypeText       SubmissionType = "text"
+)
+
+var SubmissionTypes = []SubmissionType{
+	SubmissionTypeFile,
+	SubmissionTypeRepository,
+	SubmissionTypeText,
+}
+
+func (t SubmissionType) Validate() error {
+	if !lo.Contains(SubmissionTypes, t) {
+		return ierr.NewError("invalid submission type").
+			WithHintf("Submission type must be one of %v", SubmissionTypes).
+			WithReportableDetails(map[string]any{"submission_type": t}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// SubmissionStatus is where a submission is in grading
+type SubmissionStatus string
+
+const (
+	SubmissionStatusSubmitted SubmissionStatus = "submitted"
+	SubmissionStatusGraded    SubmissionStatus = "graded"
+)
+
+var SubmissionStatuses = []SubmissionStatus{
+	SubmissionStatusSubmitted,
+	SubmissionStatusGraded,
+}
+
+func (s SubmissionStatus) Validate() error {
+	if !lo.Contains(SubmissionStatuses, s) {
+		return ierr.NewError("invalid submission status").
+			WithHintf("Submission status must be one of %v", SubmissionStatuses).
+			WithReportableDetails(map[string]any{"submission_status": s}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// LatePolicy decides what happens to work submitted after the due date
+type LatePolicy string
+
+const (
+	// LatePolicyAccept grades late work like work on time
+	LatePolicyAccept LatePolicy = "accept"
+	// LatePolicyPenalize deducts the late penalty for every day the work is late
+	LatePolicyPenalize LatePolicy = "penalize"
+	// LatePolicyReject refuses work after the due date
+	LatePolicyReject LatePolicy = "reject"
+)
+
+var LatePolicies = []LatePolicy{
+	LatePolicyAccept,
+	LatePolicyPenalize,
+	LatePolicyReject,
+}
+
+func (p LatePolicy) Validate() error {
+	if !lo.Contains(LatePolicies, p) {
+		return ierr.NewError("invalid late policy").
+			WithHintf("Late policy must be one of %v", LatePolicies).
+			WithReportableDetails(map[string]any{"late_policy": p}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// RubricCriterion is one part of the work an assignment is graded on
+type RubricCriterion struct {
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description,omitempty"`
+	MaxPoints   int    `json:"max_points" validate:"required,min=1"`
+}
+
+// ValidateRubric checks the criteria have distinct names and their points add up to the max score
+func ValidateRubric(rubric []RubricCriterion, maxScore int) error {
+	if len(rubric) == 0 {
+		return nil
+	}
+
+	total := 0
+	names := make(map[string]bool, len(rubric))
+	for _, criterion := range rubric {
+		if names[criterion.Name] {
+			return ierr.NewError("duplicate rubric criterion").
+				WithHintf("Rubric criterion %s is listed more than once", criterion.Name).
+				WithReportableDetails(map[string]any{"criterion": criterion.Name}).
+				Mark(ierr.ErrValidation)
+		}
+		names[criterion.Name] = true
+		total += criterion.MaxPoints
+	}
+
+	if total != maxScore {
+		return ierr.NewError("rubric does not add up to the max score").
+			WithHintf("The points of the rubric criteria must add up to the max score of %d", maxScore).
+			WithReportableDetails(map[string]any{
+				"rubric_points": total,
+				"max_score":     maxScore,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// CriterionScore is the grade a submission got on one rubric criterion
+type CriterionScore struct {
+	Criterion string  `json:"criterion" validate:"required"`
+	Points    float64 `json:"points" validate:"min=0"`
+	Comment   string  `json:"comment,omitempty"`
+}
+
+type SubmissionFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	InternshipIDs    []string         `json:"internship_ids,omitempty" form:"internship_ids" validate:"omitempty"`
+	AssignmentIDs    []string         `json:"assignment_ids,omitempty" form:"assignment_ids" validate:"omitempty"`
+	EnrollmentIDs    []string         `json:"enrollment_ids,omitempty" form:"enrollment_ids" validate:"omitempty"`
+	UserIDs          []string         `json:"user_ids,omitempty" form:"user_ids" validate:"omitempty"`
+	SubmissionStatus SubmissionStatus `json:"submission_status,omitempty" form:"submission_status" validate:"omitempty"`
+}
+
+func (f *SubmissionFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.SubmissionStatus != "" {
+		return f.SubmissionStatus.Validate()
+	}
+
+	return nil
+}
+
+func NewSubmissionFilter() *SubmissionFilter {
+	return &SubmissionFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitSubmissionFilter() *SubmissionFilter {
+	return &SubmissionFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *SubmissionFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *SubmissionFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *SubmissionFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *SubmissionFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *SubmissionFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *SubmissionFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *SubmissionFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *SubmissionFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *SubmissionFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -0,0 +1,318 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// BillingPeriod is how often a subscription plan renews
+type BillingPeriod string
+
+const (
+	BillingPeriodMonthly BillingPeriod = "monthly"
+	BillingPeriodYearly  BillingPeriod = "yearly"
+)
+
+func (p BillingPeriod) String() string {
+	return string(p)
+}
+
+func (p BillingPeriod) Validate() error {
+	allowed := []BillingPeriod{
+		BillingPeriodMonthly,
+		BillingPeriodYearly,
+	}
+	if !lo.Contains(allowed, p) {
+		return ierr.NewError("invalid billing period").
+			WithHint("Please provide a valid billing period").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// lifecycle of subscription
+// created -> active -> cancelled (at period end or immediately)
+//
+//	active -> past_due (renewal failed, gateway retrying) -> active (retry succeeded)
+//	past_due -> halted (retries exhausted)
+//	active -> completed (all billing cycles charged)
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusCreated   SubscriptionStatus = "created"
+	SubscriptionStatusActive    SubscriptionStatus = "active"
+	SubscriptionStatusPastDue   SubscriptionStatus = "past_due"
+	SubscriptionStatusHalted    SubscriptionStatus = "halted"
+	SubscriptionStatusCancelled SubscriptionStatus = "cancelled"
+	SubscriptionStatusCompleted SubscriptionStatus = "completed"
+)
+
+func (s SubscriptionStatus) String() string {
+	return string(s)
+}
+
+func (s SubscriptionStatus) Validate() error {
+	allowed := []SubscriptionStatus{
+		SubscriptionStatusCreated,
+		SubscriptionStatusActive,
+		SubscriptionStatusPastDue,
+		SubscriptionStatusHalted,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusCompleted,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid subscription status").
+			WithHint("Please provide a valid subscription status").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// GrantsAccess reports whether a subscription in this status entitles its categories.
+// Access is kept while the gateway retries a failed renewal.
+func (s SubscriptionStatus) GrantsAccess() bool {
+	return s == SubscriptionStatusActive || s == SubscriptionStatusPastDue
+}
+
+// IsTerminal reports whether the subscription can no longer renew
+func (s SubscriptionStatus) IsTerminal() bool {
+	return s == SubscriptionStatusHalted ||
+		s == SubscriptionStatusCancelled ||
+		s == SubscriptionStatusCompleted
+}
+
+// GatewaySubscriptionEvent is a subscription webhook normalized by the gateway provider
+type GatewaySubscriptionEvent struct {
+	EventID               string
+	GatewaySubscriptionID string
+	Status                SubscriptionStatus
+	CurrentPeriodStart    *time.Time
+	CurrentPeriodEnd      *time.Time
+
+	// Set for charge events, the renewal payment collected by the gateway
+	GatewayPaymentID *string
+	Amount           decimal.Decimal
+	Currency         Currency
+}
+
+type SubscriptionPlanFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	CategoryID    string        `json:"category_id,omitempty" form:"category_id" validate:"omitempty"`
+	BillingPeriod BillingPeriod `json:"billing_period,omitempty" form:"billing_period" validate:"omitempty"`
+	IsActive      *bool         `json:"is_active,omitempty" form:"is_active" validate:"omitempty"`
+}
+
+func (f *SubscriptionPlanFilter) Validate() error {
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.TimeRangeFilter.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewSubscriptionPlanFilter() *SubscriptionPlanFilter {
+	return &SubscriptionPlanFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitSubscriptionPlanFilter() *SubscriptionPlanFilter {
+	return &SubscriptionPlanFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *SubscriptionPlanFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *SubscriptionPlanFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *SubscriptionPlanFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
+
+type SubscriptionFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	UserID                string               `json:"user_id,omitempty" form:"user_id" validate:"omitempty"`
+	PlanID                string               `json:"plan_id,omitempty" form:"plan_id" validate:"omitempty"`
+	SubscriptionStatuses  []SubscriptionStatus `json:"subscription_statuses,omitempty" form:"subscription_statuses" validate:"omitempty"`
+	GatewaySubscriptionID string               `json:"gateway_subscription_id,omitempty" form:"gateway_subscription_id" validate:"omitempty"`
+}
+
+func (f *SubscriptionFilter) Validate() error {
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.TimeRangeFilter.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewSubscriptionFilter() *SubscriptionFilter {
+	return &SubscriptionFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitSubscriptionFilter() *SubscriptionFilter {
+	return &SubscriptionFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *SubscriptionFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *SubscriptionFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *SubscriptionFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *SubscriptionFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *SubscriptionFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *SubscriptionFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *SubscriptionFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *SubscriptionFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *SubscriptionFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
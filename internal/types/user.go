@@ -12,12 +12,15 @@ const (
 	UserRoleStudent    UserRole = "STUDENT"
 	UserRoleInstructor UserRole = "INSTRUCTOR"
 	UserRoleAdmin      UserRole = "ADMIN"
+	// UserRoleRecruiter is a hiring partner browsing student profiles
+	UserRoleRecruiter UserRole = "RECRUITER"
 )
 
 var UserRoles = []string{
 	string(UserRoleStudent),
 	string(UserRoleInstructor),
 	string(UserRoleAdmin),
+	string(UserRoleRecruiter),
 }
 
 type UserFilter struct {
@@ -119,3 +122,19 @@ func (f *UserFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// GetCursor implements BaseFilter interface
+func (f *UserFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *UserFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
@@ -46,8 +46,36 @@ const (
 	UUID_PREFIX_DISCOUNT_APPLIED            = "da"
 	UUID_PREFIX_INTERNSHIP_ENROLLMENT       = "ie"
 	UUID_PREFIX_PAYMENT_ATTEMPT             = "pa"
+	UUID_PREFIX_PAYMENT_REFUND              = "pref"
 	UUID_PREFIX_INTERNSHIP_BATCH            = "batch"
 	UUID_PREFIX_CART                        = "cart"
 	UUID_PREFIX_CART_LINE_ITEM              = "cli"
 	UUID_PREFIX_INTERNSHIP_BATCH_ENROLLMENT = "enrollment"
+	UUID_PREFIX_REFERRAL                    = "referral"
+	UUID_PREFIX_WALLET_TRANSACTION          = "wtxn"
+	UUID_PREFIX_PAYMENT_PLAN                = "plan"
+	UUID_PREFIX_EVENT                       = "event"
+	UUID_PREFIX_SUBSCRIPTION_PLAN           = "splan"
+	UUID_PREFIX_SUBSCRIPTION                = "sub"
+	UUID_PREFIX_SUBSCRIPTION_EVENT          = "subevt"
+	UUID_PREFIX_INTERNSHIP_REVISION         = "irev"
+	UUID_PREFIX_INTERNSHIP_INSTRUCTOR       = "iins"
+	UUID_PREFIX_MODULE                      = "module"
+	UUID_PREFIX_LESSON                      = "lesson"
+	UUID_PREFIX_ASSIGNMENT                  = "assignment"
+	UUID_PREFIX_RESOURCE                    = "resource"
+	UUID_PREFIX_LESSON_PROGRESS             = "lprog"
+	UUID_PREFIX_SUBMISSION                  = "submission"
+	UUID_PREFIX_QUIZ                        = "quiz"
+	UUID_PREFIX_QUIZ_QUESTION               = "qq"
+	UUID_PREFIX_QUIZ_ATTEMPT                = "qattempt"
+	UUID_PREFIX_QUIZ_OPTION                 = "qopt"
+	UUID_PREFIX_CERTIFICATE                 = "cert"
+	UUID_PREFIX_LIVE_SESSION                = "lsess"
+	UUID_PREFIX_SESSION_ATTENDANCE          = "attend"
+	UUID_PREFIX_INTERNSHIP_APPLICATION      = "iapp"
+	UUID_PREFIX_APPLICATION_QUESTION        = "aq"
+	UUID_PREFIX_INTERNSHIP_REVIEW           = "irev"
+	UUID_PREFIX_WISHLIST                    = "wish"
+	UUID_PREFIX_NOTIFICATION                = "ntf"
 )
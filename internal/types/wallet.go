@@ -0,0 +1,230 @@
+package types
+
+import (
+	ierr "github.com/omkar273/codegeeky/internal/errors"
+	"github.com/samber/lo"
+)
+
+// WalletTransactionType is the side of the ledger an entry is booked on
+type WalletTransactionType string
+
+const (
+	WalletTransactionTypeCredit WalletTransactionType = "credit"
+	WalletTransactionTypeDebit  WalletTransactionType = "debit"
+)
+
+func (t WalletTransactionType) String() string {
+	return string(t)
+}
+
+func (t WalletTransactionType) Validate() error {
+	allowed := []WalletTransactionType{
+		WalletTransactionTypeCredit,
+		WalletTransactionTypeDebit,
+	}
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid wallet transaction type").
+			WithHint("Please provide a valid wallet transaction type").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// WalletTransactionReason explains why a ledger entry was booked
+//
+// credits: refund, referral_reward, admin_grant, checkout_reversal
+// debits: checkout, expiry
+type WalletTransactionReason string
+
+const (
+	WalletTransactionReasonRefund           WalletTransactionReason = "refund"
+	WalletTransactionReasonReferralReward   WalletTransactionReason = "referral_reward"
+	WalletTransactionReasonAdminGrant       WalletTransactionReason = "admin_grant"
+	WalletTransactionReasonCheckoutReversal WalletTransactionReason = "checkout_reversal"
+	WalletTransactionReasonCheckout         WalletTransactionReason = "checkout"
+	WalletTransactionReasonExpiry           WalletTransactionReason = "expiry"
+)
+
+func (r WalletTransactionReason) String() string {
+	return string(r)
+}
+
+func (r WalletTransactionReason) Validate() error {
+	allowed := []WalletTransactionReason{
+		WalletTransactionReasonRefund,
+		WalletTransactionReasonReferralReward,
+		WalletTransactionReasonAdminGrant,
+		WalletTransactionReasonCheckoutReversal,
+		WalletTransactionReasonCheckout,
+		WalletTransactionReasonExpiry,
+	}
+	if !lo.Contains(allowed, r) {
+		return ierr.NewError("invalid wallet transaction reason").
+			WithHint("Please provide a valid wallet transaction reason").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// TransactionType returns the ledger side entries with this reason are booked on
+func (r WalletTransactionReason) TransactionType() WalletTransactionType {
+	switch r {
+	case WalletTransactionReasonCheckout, WalletTransactionReasonExpiry:
+		return WalletTransactionTypeDebit
+	default:
+		return WalletTransactionTypeCredit
+	}
+}
+
+// RefundDestination is where the money of a refunded payment goes
+type RefundDestination string
+
+const (
+	// RefundDestinationSource refunds the gateway amount to the original payment method
+	RefundDestinationSource RefundDestination = "source"
+	// RefundDestinationWallet refunds the gateway amount as wallet credit
+	RefundDestinationWallet RefundDestination = "wallet"
+)
+
+func (d RefundDestination) Validate() error {
+	allowed := []RefundDestination{
+		RefundDestinationSource,
+		RefundDestinationWallet,
+	}
+	if !lo.Contains(allowed, d) {
+		return ierr.NewError("invalid refund destination").
+			WithHint("Please provide a valid refund destination").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+type WalletTransactionFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	UserID            string                  `json:"user_id,omitempty" form:"user_id" validate:"omitempty"`
+	Currency          string                  `json:"currency,omitempty" form:"currency" validate:"omitempty"`
+	TransactionType   WalletTransactionType   `json:"transaction_type,omitempty" form:"transaction_type" validate:"omitempty"`
+	TransactionReason WalletTransactionReason `json:"transaction_reason,omitempty" form:"transaction_reason" validate:"omitempty"`
+	ReferenceID       string                  `json:"reference_id,omitempty" form:"reference_id" validate:"omitempty"`
+	SourceID          string                  `json:"source_transaction_id,omitempty" form:"source_transaction_id" validate:"omitempty"`
+}
+
+func (f *WalletTransactionFilter) Validate() error {
+	if err := f.QueryFilter.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.TimeRangeFilter.Validate(); err != nil {
+		return err
+	}
+
+	if f.TransactionType != "" {
+		if err := f.TransactionType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TransactionReason != "" {
+		if err := f.TransactionReason.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewWalletTransactionFilter() *WalletTransactionFilter {
+	return &WalletTransactionFilter{
+		QueryFilter:     NewDefaultQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+func NewNoLimitWalletTransactionFilter() *WalletTransactionFilter {
+	return &WalletTransactionFilter{
+		QueryFilter:     NewNoLimitQueryFilter(),
+		TimeRangeFilter: &TimeRangeFilter{},
+	}
+}
+
+// GetLimit implements BaseFilter interface
+func (f *WalletTransactionFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *WalletTransactionFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *WalletTransactionFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetSort implements BaseFilter interface
+func (f *WalletTransactionFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *WalletTransactionFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *WalletTransactionFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *WalletTransactionFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// GetCursor implements BaseFilter interface
+func (f *WalletTransactionFilter) GetCursor() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetCursor()
+	}
+	return f.QueryFilter.GetCursor()
+}
+
+// IsCountSkipped implements BaseFilter interface
+func (f *WalletTransactionFilter) IsCountSkipped() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsCountSkipped()
+	}
+	return f.QueryFilter.IsCountSkipped()
+}
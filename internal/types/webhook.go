@@ -23,6 +23,83 @@ const (
 	WebhookEventUserLogout  = "user.logout"
 )
 
+// installment events
+const (
+	WebhookEventInstallmentReminder = "installment.reminder"
+	WebhookEventInstallmentOverdue  = "installment.overdue"
+	WebhookEventEnrollmentSuspended = "enrollment.suspended"
+)
+
+// subscription events
+const (
+	WebhookEventSubscriptionActivated     = "subscription.activated"
+	WebhookEventSubscriptionRenewed       = "subscription.renewed"
+	WebhookEventSubscriptionPaymentFailed = "subscription.payment_failed"
+	WebhookEventSubscriptionHalted        = "subscription.halted"
+	WebhookEventSubscriptionCancelled     = "subscription.cancelled"
+)
+
+// internship batch events
+const (
+	WebhookEventBatchStarted          = "internship_batch.started"
+	WebhookEventBatchEnrollmentClosed = "internship_batch.enrollment_closed"
+	WebhookEventBatchCompleted        = "internship_batch.completed"
+	WebhookEventBatchPaused           = "internship_batch.paused"
+	WebhookEventBatchResumed          = "internship_batch.resumed"
+	WebhookEventBatchCancelled        = "internship_batch.cancelled"
+	WebhookEventEnrollmentCompleted   = "enrollment.completed"
+	WebhookEventBatchReminder         = "internship_batch.reminder"
+)
+
+// enrollment and payment events, published to the student
+const (
+	WebhookEventEnrollmentConfirmed = "enrollment.confirmed"
+	WebhookEventPaymentSucceeded    = "payment.succeeded"
+)
+
+// internship review events
+const (
+	WebhookEventInternshipSubmitted = "internship.submitted"
+	WebhookEventInternshipPublished = "internship.published"
+	WebhookEventInternshipRejected  = "internship.rejected"
+)
+
+// learner progress events
+const (
+	WebhookEventLessonCompleted  = "lesson.completed"
+	WebhookEventSubmissionGraded = "submission.graded"
+)
+
+// certificate events
+const (
+	WebhookEventCertificateIssued  = "certificate.issued"
+	WebhookEventCertificateRevoked = "certificate.revoked"
+)
+
+// internship application events
+const (
+	WebhookEventApplicationSubmitted          = "application.submitted"
+	WebhookEventApplicationShortlisted        = "application.shortlisted"
+	WebhookEventApplicationInterviewScheduled = "application.interview_scheduled"
+	WebhookEventApplicationAccepted           = "application.accepted"
+	WebhookEventApplicationRejected           = "application.rejected"
+	WebhookEventApplicationWithdrawn          = "application.withdrawn"
+)
+
+// internship review events
+const (
+	WebhookEventReviewReplied  = "review.replied"
+	WebhookEventReviewHidden   = "review.hidden"
+	WebhookEventReviewRestored = "review.restored"
+)
+
+// wishlist events, published to every user who saved the internship
+const (
+	WebhookEventWishlistBatchAnnounced = "wishlist.batch_announced"
+	WebhookEventWishlistPriceDropped   = "wishlist.price_dropped"
+	WebhookEventWishlistSaleStarted    = "wishlist.sale_started"
+)
+
 // EventSource defines the source of an event
 type EventSource string
 
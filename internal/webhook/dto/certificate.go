@@ -0,0 +1,18 @@
+package webhookdto
+
+import "time"
+
+// CertificateWebhookPayload is published to the student when their certificate is issued or revoked
+type CertificateWebhookPayload struct {
+	CertificateID     string    `json:"certificate_id"`
+	VerificationID    string    `json:"verification_id"`
+	EnrollmentID      string    `json:"enrollment_id"`
+	InternshipID      string    `json:"internship_id"`
+	CertificateStatus string    `json:"certificate_status"`
+	VerifyURL         string    `json:"verify_url"`
+	IssuedAt          time.Time `json:"issued_at"`
+
+	// RevokedAt and RevocationReason are set once the certificate is revoked
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	RevocationReason *string    `json:"revocation_reason,omitempty"`
+}
@@ -0,0 +1,23 @@
+package webhookdto
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// InstallmentWebhookPayload is published for installment reminders, overdue notices and suspensions
+type InstallmentWebhookPayload struct {
+	EnrollmentID      string                           `json:"enrollment_id"`
+	InternshipID      string                           `json:"internship_id"`
+	EnrollmentStatus  types.InternshipEnrollmentStatus `json:"enrollment_status"`
+	PaymentID         string                           `json:"payment_id"`
+	InstallmentNumber int                              `json:"installment_number"`
+	Amount            decimal.Decimal                  `json:"amount"`
+	Currency          string                           `json:"currency"`
+	DueDate           time.Time                        `json:"due_date"`
+
+	// End of the grace period, after which the enrollment is suspended
+	GraceEndsAt time.Time `json:"grace_ends_at"`
+}
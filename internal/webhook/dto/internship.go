@@ -0,0 +1,15 @@
+package webhookdto
+
+import "github.com/omkar273/codegeeky/internal/types"
+
+// InternshipReviewWebhookPayload is published when an internship or a revision of it
+// is submitted for review, published or rejected
+type InternshipReviewWebhookPayload struct {
+	InternshipID  string                        `json:"internship_id"`
+	Title         string                        `json:"title"`
+	PublishStatus types.InternshipPublishStatus `json:"publish_status"`
+
+	// Whether the review concerns a pending revision of a published internship
+	Revision      bool    `json:"revision"`
+	ReviewComment *string `json:"review_comment,omitempty"`
+}
@@ -0,0 +1,19 @@
+package webhookdto
+
+import "time"
+
+// ApplicationWebhookPayload is published to the applicant at every stage of their application
+type ApplicationWebhookPayload struct {
+	ApplicationID     string `json:"application_id"`
+	InternshipID      string `json:"internship_id"`
+	InternshipBatchID string `json:"internship_batch_id"`
+	ApplicationStatus string `json:"application_status"`
+
+	// InterviewAt is set once an interview is scheduled
+	InterviewAt *time.Time `json:"interview_at,omitempty"`
+
+	// OfferExpiresAt is set on acceptance, the applicant has to enroll before it
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+
+	ReviewerNote *string `json:"reviewer_note,omitempty"`
+}
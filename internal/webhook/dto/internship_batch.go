@@ -0,0 +1,35 @@
+package webhookdto
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+)
+
+// InternshipBatchWebhookPayload is published for every lifecycle transition of a batch
+type InternshipBatchWebhookPayload struct {
+	InternshipBatchID string                      `json:"internship_batch_id"`
+	InternshipID      string                      `json:"internship_id"`
+	Name              string                      `json:"name"`
+	BatchStatus       types.InternshipBatchStatus `json:"batch_status"`
+	StartDate         time.Time                   `json:"start_date"`
+	EndDate           time.Time                   `json:"end_date"`
+	Reason            *string                     `json:"reason,omitempty"`
+}
+
+// EnrollmentConfirmedWebhookPayload is published to the student once an enrollment is paid for or free
+type EnrollmentConfirmedWebhookPayload struct {
+	EnrollmentID      string                           `json:"enrollment_id"`
+	InternshipID      string                           `json:"internship_id"`
+	InternshipBatchID string                           `json:"internship_batch_id"`
+	EnrollmentStatus  types.InternshipEnrollmentStatus `json:"enrollment_status"`
+	EnrolledAt        *time.Time                       `json:"enrolled_at,omitempty"`
+}
+
+// EnrollmentCompletedWebhookPayload is published for each enrollment completed at the end of its batch
+type EnrollmentCompletedWebhookPayload struct {
+	EnrollmentID      string    `json:"enrollment_id"`
+	InternshipID      string    `json:"internship_id"`
+	InternshipBatchID string    `json:"internship_batch_id"`
+	CompletedAt       time.Time `json:"completed_at"`
+}
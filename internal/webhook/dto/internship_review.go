@@ -0,0 +1,12 @@
+package webhookdto
+
+// ReviewWebhookPayload is published to the author of a review when it was replied to or moderated
+type ReviewWebhookPayload struct {
+	ReviewID     string `json:"review_id"`
+	InternshipID string `json:"internship_id"`
+	Rating       int    `json:"rating"`
+	ReviewStatus string `json:"review_status"`
+
+	Reply          *string `json:"reply,omitempty"`
+	ModerationNote *string `json:"moderation_note,omitempty"`
+}
@@ -0,0 +1,23 @@
+package webhookdto
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentWebhookPayload is published to the payer when a payment succeeds
+type PaymentWebhookPayload struct {
+	PaymentID         string                       `json:"payment_id"`
+	DestinationType   types.PaymentDestinationType `json:"destination_type"`
+	DestinationID     string                       `json:"destination_id"`
+	Amount            decimal.Decimal              `json:"amount"`
+	Currency          string                       `json:"currency"`
+	GatewayPaymentID  *string                      `json:"gateway_payment_id,omitempty"`
+	InstallmentNumber *int                         `json:"installment_number,omitempty"`
+	SucceededAt       time.Time                    `json:"succeeded_at"`
+
+	// Internship of the enrollment paid for, empty for other destinations
+	InternshipID string `json:"internship_id,omitempty"`
+}
@@ -0,0 +1,16 @@
+package webhookdto
+
+import "time"
+
+// LessonCompletedWebhookPayload is published when a student completes a lesson
+type LessonCompletedWebhookPayload struct {
+	LessonID     string    `json:"lesson_id"`
+	ModuleID     string    `json:"module_id"`
+	InternshipID string    `json:"internship_id"`
+	CompletedAt  time.Time `json:"completed_at"`
+
+	// Progress of the student in the internship after completing the lesson
+	CompletedLessons int     `json:"completed_lessons"`
+	TotalLessons     int     `json:"total_lessons"`
+	ProgressPercent  float64 `json:"progress_percent"`
+}
@@ -0,0 +1,18 @@
+package webhookdto
+
+import "time"
+
+// SubmissionGradedWebhookPayload is published to the student when their submission is graded
+type SubmissionGradedWebhookPayload struct {
+	SubmissionID string    `json:"submission_id"`
+	AssignmentID string    `json:"assignment_id"`
+	InternshipID string    `json:"internship_id"`
+	Attempt      int       `json:"attempt"`
+	Score        float64   `json:"score"`
+	MaxScore     int       `json:"max_score"`
+	Penalty      float64   `json:"penalty"`
+	GradedAt     time.Time `json:"graded_at"`
+
+	// Passed is set when the assignment has a passing score
+	Passed *bool `json:"passed,omitempty"`
+}
@@ -0,0 +1,23 @@
+package webhookdto
+
+import (
+	"time"
+
+	"github.com/omkar273/codegeeky/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionWebhookPayload is published when a subscription activates, renews, fails to renew or ends
+type SubscriptionWebhookPayload struct {
+	SubscriptionID     string                   `json:"subscription_id"`
+	PlanID             string                   `json:"plan_id"`
+	PlanName           string                   `json:"plan_name"`
+	SubscriptionStatus types.SubscriptionStatus `json:"subscription_status"`
+	Amount             decimal.Decimal          `json:"amount"`
+	Currency           string                   `json:"currency"`
+	CurrentPeriodEnd   *time.Time               `json:"current_period_end,omitempty"`
+	CancelAtPeriodEnd  bool                     `json:"cancel_at_period_end"`
+
+	// Renewal charges that failed in a row, set while the gateway retries
+	FailedPaymentCount int `json:"failed_payment_count"`
+}
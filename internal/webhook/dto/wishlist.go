@@ -0,0 +1,24 @@
+package webhookdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// WishlistWebhookPayload is published to a user when an internship they saved announced a batch,
+// dropped its price or went on sale
+type WishlistWebhookPayload struct {
+	InternshipID string          `json:"internship_id"`
+	Title        string          `json:"title"`
+	Currency     string          `json:"currency"`
+	Total        decimal.Decimal `json:"total"`
+
+	// Set for price drops and sales
+	PreviousTotal *decimal.Decimal `json:"previous_total,omitempty"`
+
+	// Set for announced batches
+	BatchID   *string    `json:"batch_id,omitempty"`
+	BatchName *string    `json:"batch_name,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty"`
+}
@@ -2,6 +2,8 @@ package payload
 
 import (
 	"fmt"
+
+	"github.com/omkar273/codegeeky/internal/types"
 )
 
 // PayloadBuilderFactory interface for getting event-specific payload builders
@@ -25,6 +27,50 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	// 	return &userCreatedPayloadBuilder{services: f.services}
 	// }
 
+	for _, event := range []string{
+		types.WebhookEventInstallmentReminder,
+		types.WebhookEventInstallmentOverdue,
+		types.WebhookEventEnrollmentSuspended,
+		types.WebhookEventSubscriptionActivated,
+		types.WebhookEventSubscriptionRenewed,
+		types.WebhookEventSubscriptionPaymentFailed,
+		types.WebhookEventSubscriptionHalted,
+		types.WebhookEventSubscriptionCancelled,
+		types.WebhookEventBatchStarted,
+		types.WebhookEventBatchEnrollmentClosed,
+		types.WebhookEventBatchCompleted,
+		types.WebhookEventBatchPaused,
+		types.WebhookEventBatchResumed,
+		types.WebhookEventBatchCancelled,
+		types.WebhookEventEnrollmentCompleted,
+		types.WebhookEventInternshipSubmitted,
+		types.WebhookEventInternshipPublished,
+		types.WebhookEventInternshipRejected,
+		types.WebhookEventLessonCompleted,
+		types.WebhookEventSubmissionGraded,
+		types.WebhookEventCertificateIssued,
+		types.WebhookEventCertificateRevoked,
+		types.WebhookEventApplicationSubmitted,
+		types.WebhookEventApplicationShortlisted,
+		types.WebhookEventApplicationInterviewScheduled,
+		types.WebhookEventApplicationAccepted,
+		types.WebhookEventApplicationRejected,
+		types.WebhookEventApplicationWithdrawn,
+		types.WebhookEventReviewReplied,
+		types.WebhookEventReviewHidden,
+		types.WebhookEventReviewRestored,
+		types.WebhookEventWishlistBatchAnnounced,
+		types.WebhookEventWishlistPriceDropped,
+		types.WebhookEventWishlistSaleStarted,
+		types.WebhookEventEnrollmentConfirmed,
+		types.WebhookEventPaymentSucceeded,
+		types.WebhookEventBatchReminder,
+	} {
+		f.builders[event] = func() PayloadBuilder {
+			return &passthroughPayloadBuilder{}
+		}
+	}
+
 	return f
 }
 
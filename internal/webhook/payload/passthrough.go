@@ -0,0 +1,14 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// passthroughPayloadBuilder forwards events as published, for events
+// whose payload already carries everything the receiver needs
+type passthroughPayloadBuilder struct{}
+
+func (b *passthroughPayloadBuilder) BuildPayload(_ context.Context, _ string, data json.RawMessage) (json.RawMessage, error) {
+	return data, nil
+}